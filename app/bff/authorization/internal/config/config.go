@@ -35,6 +35,7 @@ type Config struct {
 	StatusClient              zrpc.RpcClientConf
 	UsernameClient            zrpc.RpcClientConf
 	MsgClient                 zrpc.RpcClientConf
+	TwofaClient               zrpc.RpcClientConf
 	SyncClient                *kafka.KafkaProducerConf
 	SignInServiceNotification []conf.MessageEntityConfig `json:",optional"`
 	SignInMessage             []conf.MessageEntityConfig `json:",optional"`
@@ -20,13 +20,25 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 )
 
 // AuthBindTempAuthKey
 // auth.bindTempAuthKey#cdd42a05 perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
 func (c *AuthorizationCore) AuthBindTempAuthKey(in *mtproto.TLAuthBindTempAuthKey) (*mtproto.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("auth.bindTempAuthKey blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	rValue, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionBindTempAuthKey(c.ctx, &authsession.TLAuthsessionBindTempAuthKey{
+		PermAuthKeyId:    in.PermAuthKeyId,
+		Nonce:            in.Nonce,
+		ExpiresAt:        in.ExpiresAt,
+		EncryptedMessage: in.EncryptedMessage,
+		TempAuthKeyId:    c.MD.AuthId,
+		TempSessionId:    c.MD.SessionId,
+		MsgId:            c.MD.ClientMsgId,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
+		return nil, err
+	}
 
-	return mtproto.BoolTrue, nil
+	return rValue, nil
 }
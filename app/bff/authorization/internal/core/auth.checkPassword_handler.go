@@ -20,24 +20,32 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
-	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
 )
 
 // AuthCheckPassword
 // auth.checkPassword#d18b4d16 password:InputCheckPasswordSRP = auth.Authorization;
 func (c *AuthorizationCore) AuthCheckPassword(in *mtproto.TLAuthCheckPassword) (*mtproto.Auth_Authorization, error) {
-	// TODO: check password
-	c.Logger.Errorf("auth.checkPassword blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, err := c.getPendingSignInUserId()
+	if err != nil {
+		c.Logger.Errorf("auth.checkPassword - error: %v", err)
+		return nil, err
+	}
 
-	user, err := c.svcCtx.UserClient.UserGetImmutableUser(c.ctx, &userpb.TLUserGetImmutableUser{
-		Id: c.MD.UserId,
+	_, err = c.svcCtx.Dao.TwofaClient.TwofaCheckPassword(c.ctx, &twofa.TLTwofaCheckPassword{
+		UserId:   userId,
+		Password: in.Password,
 	})
 	if err != nil {
 		c.Logger.Errorf("auth.checkPassword - error: %v", err)
 		return nil, err
 	}
 
-	return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
-		User: user.ToSelfUser(),
-	}).To_Auth_Authorization(), nil
+	rV, err := c.completeSignIn(userId)
+	if err != nil {
+		c.Logger.Errorf("auth.checkPassword - error: %v", err)
+		return nil, err
+	}
+
+	return rV, nil
 }
@@ -20,13 +20,26 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
 )
 
 // AuthCheckRecoveryPassword
 // auth.checkRecoveryPassword#d36bf79 code:string = Bool;
 func (c *AuthorizationCore) AuthCheckRecoveryPassword(in *mtproto.TLAuthCheckRecoveryPassword) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("auth.checkRecoveryPassword blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, err := c.getPendingSignInUserId()
+	if err != nil {
+		c.Logger.Errorf("auth.checkRecoveryPassword - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	rV, err := c.svcCtx.Dao.TwofaClient.TwofaCheckRecoveryPassword(c.ctx, &twofa.TLTwofaCheckRecoveryPassword{
+		UserId: userId,
+		Code:   in.Code,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.checkRecoveryPassword - error: %v", err)
+		return nil, err
+	}
+
+	return rV, nil
 }
@@ -20,13 +20,26 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 )
 
 // AuthDropTempAuthKeys
 // auth.dropTempAuthKeys#8e48a188 except_auth_keys:Vector<long> = Bool;
 func (c *AuthorizationCore) AuthDropTempAuthKeys(in *mtproto.TLAuthDropTempAuthKeys) (*mtproto.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("auth.dropTempAuthKeys blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if c.MD.PermAuthKeyId == 0 {
+		err := mtproto.ErrAuthKeyPermEmpty
+		c.Logger.Errorf("auth.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	}
 
-	return mtproto.BoolTrue, nil
+	rValue, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionDropTempAuthKeys(c.ctx, &authsession.TLAuthsessionDropTempAuthKeys{
+		PermAuthKeyId:  c.MD.PermAuthKeyId,
+		ExceptAuthKeys: in.ExceptAuthKeys,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
 }
@@ -20,13 +20,55 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // AuthImportBotAuthorization
 // auth.importBotAuthorization#67a3ff2c flags:int api_id:int api_hash:string bot_auth_token:string = auth.Authorization;
 func (c *AuthorizationCore) AuthImportBotAuthorization(in *mtproto.TLAuthImportBotAuthorization) (*mtproto.Auth_Authorization, error) {
-	// TODO: not impl
-	c.Logger.Errorf("auth.importBotAuthorization blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if err := c.svcCtx.Dao.CheckApiIdAndHash(in.ApiId, in.ApiHash); err != nil {
+		c.Logger.Errorf("auth.importBotAuthorization - invalid api: {api_id: %d, api_hash: %s}", in.ApiId, in.ApiHash)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	if in.BotAuthToken == "" {
+		err := mtproto.ErrAccessTokenInvalid
+		c.Logger.Errorf("auth.importBotAuthorization - error: %v", err)
+		return nil, err
+	}
+
+	botId, err := c.svcCtx.Dao.UserClient.UserGetBotIdByToken(c.ctx, &userpb.TLUserGetBotIdByToken{
+		Token: in.BotAuthToken,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.importBotAuthorization - error: %v", err)
+		return nil, err
+	}
+
+	bot, err := c.svcCtx.Dao.UserClient.UserGetImmutableUser(c.ctx, &userpb.TLUserGetImmutableUser{
+		Id: botId.GetV(),
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.importBotAuthorization - error: %v", err)
+		return nil, err
+	} else if !bot.IsBot() {
+		err = mtproto.ErrAccessTokenInvalid
+		c.Logger.Errorf("auth.importBotAuthorization - error: %v", err)
+		return nil, err
+	}
+
+	// bots have no other sessions to notify, only bind authKeyId and botId
+	_, err = c.svcCtx.Dao.AuthsessionClient.AuthsessionBindAuthKeyUser(c.ctx, &authsession.TLAuthsessionBindAuthKeyUser{
+		AuthKeyId: c.MD.AuthId,
+		UserId:    bot.Id(),
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.importBotAuthorization - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
+		User: bot.ToSelfUser(),
+	}).To_Auth_Authorization(), nil
 }
@@ -20,13 +20,33 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
 )
 
 // AuthRecoverPassword
 // auth.recoverPassword#37096c70 flags:# code:string new_settings:flags.0?account.PasswordInputSettings = auth.Authorization;
 func (c *AuthorizationCore) AuthRecoverPassword(in *mtproto.TLAuthRecoverPassword) (*mtproto.Auth_Authorization, error) {
-	// TODO: not impl
-	c.Logger.Errorf("auth.recoverPassword blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, err := c.getPendingSignInUserId()
+	if err != nil {
+		c.Logger.Errorf("auth.recoverPassword - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	_, err = c.svcCtx.Dao.TwofaClient.TwofaRecoverPassword(c.ctx, &twofa.TLTwofaRecoverPassword{
+		UserId:      userId,
+		Code:        in.Code,
+		NewSettings: in.NewSettings,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.recoverPassword - error: %v", err)
+		return nil, err
+	}
+
+	rV, err := c.completeSignIn(userId)
+	if err != nil {
+		c.Logger.Errorf("auth.recoverPassword - error: %v", err)
+		return nil, err
+	}
+
+	return rV, nil
 }
@@ -20,13 +20,25 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
 )
 
 // AuthRequestPasswordRecovery
 // auth.requestPasswordRecovery#d897bc66 = auth.PasswordRecovery;
 func (c *AuthorizationCore) AuthRequestPasswordRecovery(in *mtproto.TLAuthRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
-	// TODO: not impl
-	c.Logger.Errorf("auth.requestPasswordRecovery blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, err := c.getPendingSignInUserId()
+	if err != nil {
+		c.Logger.Errorf("auth.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	rV, err := c.svcCtx.Dao.TwofaClient.TwofaRequestPasswordRecovery(c.ctx, &twofa.TLTwofaRequestPasswordRecovery{
+		UserId: userId,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	}
+
+	return rV, nil
 }
@@ -20,19 +20,15 @@ package core
 
 import (
 	"context"
-	"fmt"
-	"time"
 
 	"github.com/teamgram/marmota/pkg/threading2"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/mtproto/crypto"
 	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/logic"
 	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/model"
-	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
 	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	"github.com/teamgram/teamgram-server/app/service/biz/username/username"
-	"github.com/teamgram/teamgram-server/pkg/code/conf"
 	"github.com/teamgram/teamgram-server/pkg/env2"
 	"github.com/teamgram/teamgram-server/pkg/phonenumber"
 )
@@ -230,64 +226,19 @@ func (c *AuthorizationCore) AuthSignIn(in *mtproto.TLAuthSignIn) (*mtproto.Auth_
 		return nil, err
 	}
 
-	// Bind authKeyId and userId
-	c.svcCtx.Dao.AuthsessionClient.AuthsessionBindAuthKeyUser(c.ctx, &authsession.TLAuthsessionBindAuthKeyUser{
-		AuthKeyId: c.MD.AuthId,
-		UserId:    user.User.Id,
-	})
-
-	// Check SESSION_PASSWORD_NEEDED
-	if c.svcCtx.Plugin != nil {
-		if c.svcCtx.Plugin.CheckSessionPasswordNeeded(c.ctx, user.User.Id) {
-			err = mtproto.ErrSessionPasswordNeeded
-			c.Logger.Infof("auth.signIn - registered, next step auth.checkPassword: %v", err)
-			return nil, err
-		}
+	// Check SESSION_PASSWORD_NEEDED, the key is bound by auth.checkPassword
+	needed, err := c.checkSessionPasswordNeeded(user.User.Id)
+	if err != nil {
+		c.Logger.Errorf("auth.signIn - error: %v", err)
+		return nil, err
+	} else if needed {
+		c.svcCtx.AuthLogic.DeletePhoneCode(c.ctx, c.MD.AuthId, in.PhoneNumber, phoneCodeHash)
+		err = mtproto.ErrSessionPasswordNeeded
+		c.Logger.Infof("auth.signIn - registered, next step auth.checkPassword: %v", err)
+		return nil, err
 	}
 
-	selfUser := user.ToSelfUser()
-
 	c.svcCtx.AuthLogic.DeletePhoneCode(c.ctx, c.MD.AuthId, in.PhoneNumber, phoneCodeHash)
-	region, _ := c.svcCtx.Dao.GetCountryAndRegionByIp(c.MD.ClientAddr)
-
-	var (
-		now     = time.Now()
-		signInN *mtproto.Update
-	)
-
-	if len(c.svcCtx.Config.SignInServiceNotification) == 0 {
-		signInN = mtproto.MakeSignInServiceNotification(selfUser, c.MD.AuthId, c.MD.Client, region, c.MD.ClientAddr)
-	} else {
-		signInN = mtproto.MakeTLUpdateServiceNotification(&mtproto.Update{
-			Popup:          false,
-			InboxDate:      mtproto.MakeFlagsInt32(int32(now.Unix())),
-			Type:           fmt.Sprintf("auth%d_%d", c.MD.AuthId, now.Unix()),
-			Message_STRING: "",
-			Media:          mtproto.MakeTLMessageMediaEmpty(nil).To_MessageMedia(),
-			Entities:       nil,
-		}).To_Update()
-
-		builder := conf.ToMessageBuildHelper(
-			c.svcCtx.Config.SignInServiceNotification,
-			map[string]interface{}{
-				"name":     mtproto.GetUserName(selfUser),
-				"now":      now.UTC(),
-				"client":   c.MD.Client,
-				"region":   region,
-				"clientIp": c.MD.ClientAddr,
-			})
-		signInN.Message_STRING, signInN.Entities = mtproto.MakeTextAndMessageEntities(builder)
-	}
-
-	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
-		c.ctx,
-		&sync.TLSyncUpdatesNotMe{
-			UserId:    user.Id(),
-			AuthKeyId: c.MD.AuthId,
-			Updates:   mtproto.MakeUpdatesByUpdates(signInN),
-		})
 
-	return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
-		User: selfUser,
-	}).To_Auth_Authorization(), nil
+	return c.signInAuthorization(user), nil
 }
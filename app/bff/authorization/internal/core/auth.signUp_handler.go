@@ -222,6 +222,20 @@ func (c *AuthorizationCore) onContactSignUp(ctx context.Context, authKeyId, user
 
 	for _, c2 := range importers.GetDatas() {
 		c.Logger.Infof("importer: %v", c2)
+
+		// the phone book entry saved by contacts.importContacts becomes a contact now
+		_, err := c.svcCtx.Dao.UserClient.UserAddContact(ctx, &userpb.TLUserAddContact{
+			UserId:                   c2.ClientId,
+			AddPhonePrivacyException: mtproto.BoolFalse,
+			Id:                       userId,
+			FirstName:                c2.FirstName,
+			LastName:                 c2.LastName,
+			Phone:                    phone,
+		})
+		if err != nil {
+			c.Logger.Errorf("onContactSignUp - error: %v", err)
+		}
+
 		v, _ := c.svcCtx.Dao.UserClient.UserGetContactSignUpNotification(ctx, &userpb.TLUserGetContactSignUpNotification{
 			UserId: c2.ClientId,
 		})
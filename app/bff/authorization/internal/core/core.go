@@ -29,6 +29,10 @@ import (
 	"github.com/teamgram/proto/mtproto/rpc/metadata"
 	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/svc"
 	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	"github.com/teamgram/teamgram-server/pkg/code/conf"
 	"github.com/teamgram/teamgram-server/pkg/env2"
 	"github.com/teamgram/teamgram-server/pkg/phonenumber"
@@ -135,3 +139,120 @@ func (c *AuthorizationCore) pushSignInMessage(ctx context.Context, signInUserId
 			})
 	})
 }
+
+// checkSessionPasswordNeeded reports whether userId has 2fa on, in which case
+// the sign in is only completed by auth.checkPassword or auth.recoverPassword.
+// On error the sign in has to stop, a user with 2fa must not get in on the
+// phone code alone.
+func (c *AuthorizationCore) checkSessionPasswordNeeded(userId int64) (bool, error) {
+	if c.svcCtx.Plugin != nil && c.svcCtx.Plugin.CheckSessionPasswordNeeded(c.ctx, userId) {
+		return true, nil
+	}
+
+	needed, err := c.svcCtx.Dao.TwofaClient.TwofaCheckSessionPasswordNeeded(c.ctx, &twofa.TLTwofaCheckSessionPasswordNeeded{
+		UserId: userId,
+	})
+	if err != nil {
+		c.Logger.Errorf("checkSessionPasswordNeeded(%d) - error: %v", userId, err)
+		return false, err
+	} else if !mtproto.FromBool(needed) {
+		return false, nil
+	}
+
+	// auth.checkPassword only binds a key with a pending sign in
+	_, err = c.svcCtx.Dao.TwofaClient.TwofaSetPendingSignIn(c.ctx, &twofa.TLTwofaSetPendingSignIn{
+		AuthKeyId: c.MD.AuthId,
+		UserId:    userId,
+	})
+	if err != nil {
+		c.Logger.Errorf("checkSessionPasswordNeeded(%d) - error: %v", userId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// signInAuthorization binds the auth key to user and notifies the other sessions.
+func (c *AuthorizationCore) signInAuthorization(user *mtproto.ImmutableUser) *mtproto.Auth_Authorization {
+	// Bind authKeyId and userId
+	c.svcCtx.Dao.AuthsessionClient.AuthsessionBindAuthKeyUser(c.ctx, &authsession.TLAuthsessionBindAuthKeyUser{
+		AuthKeyId: c.MD.AuthId,
+		UserId:    user.User.Id,
+	})
+
+	selfUser := user.ToSelfUser()
+
+	region, _ := c.svcCtx.Dao.GetCountryAndRegionByIp(c.MD.ClientAddr)
+
+	var (
+		now     = time.Now()
+		signInN *mtproto.Update
+	)
+
+	if len(c.svcCtx.Config.SignInServiceNotification) == 0 {
+		signInN = mtproto.MakeSignInServiceNotification(selfUser, c.MD.AuthId, c.MD.Client, region, c.MD.ClientAddr)
+	} else {
+		signInN = mtproto.MakeTLUpdateServiceNotification(&mtproto.Update{
+			Popup:          false,
+			InboxDate:      mtproto.MakeFlagsInt32(int32(now.Unix())),
+			Type:           fmt.Sprintf("auth%d_%d", c.MD.AuthId, now.Unix()),
+			Message_STRING: "",
+			Media:          mtproto.MakeTLMessageMediaEmpty(nil).To_MessageMedia(),
+			Entities:       nil,
+		}).To_Update()
+
+		builder := conf.ToMessageBuildHelper(
+			c.svcCtx.Config.SignInServiceNotification,
+			map[string]interface{}{
+				"name":     mtproto.GetUserName(selfUser),
+				"now":      now.UTC(),
+				"client":   c.MD.Client,
+				"region":   region,
+				"clientIp": c.MD.ClientAddr,
+			})
+		signInN.Message_STRING, signInN.Entities = mtproto.MakeTextAndMessageEntities(builder)
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
+		c.ctx,
+		&sync.TLSyncUpdatesNotMe{
+			UserId:    user.Id(),
+			AuthKeyId: c.MD.AuthId,
+			Updates:   mtproto.MakeUpdatesByUpdates(signInN),
+		})
+
+	return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
+		User: selfUser,
+	}).To_Auth_Authorization()
+}
+
+// getPendingSignInUserId returns the user waiting for auth.checkPassword on
+// this auth key.
+func (c *AuthorizationCore) getPendingSignInUserId() (int64, error) {
+	id, err := c.svcCtx.Dao.TwofaClient.TwofaGetPendingSignIn(c.ctx, &twofa.TLTwofaGetPendingSignIn{
+		AuthKeyId: c.MD.AuthId,
+	})
+	if err != nil {
+		return 0, err
+	} else if id.GetV() == 0 {
+		return 0, mtproto.ErrAuthKeyUnregistered
+	}
+
+	return id.GetV(), nil
+}
+
+// completeSignIn finishes a sign in held back by SESSION_PASSWORD_NEEDED.
+func (c *AuthorizationCore) completeSignIn(userId int64) (*mtproto.Auth_Authorization, error) {
+	user, err := c.svcCtx.Dao.UserClient.UserGetImmutableUser(c.ctx, &userpb.TLUserGetImmutableUser{
+		Id: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.svcCtx.Dao.TwofaClient.TwofaDeletePendingSignIn(c.ctx, &twofa.TLTwofaDeletePendingSignIn{
+		AuthKeyId: c.MD.AuthId,
+	})
+
+	return c.signInAuthorization(user), nil
+}
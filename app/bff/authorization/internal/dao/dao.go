@@ -21,16 +21,17 @@ package dao
 import (
 	"flag"
 	"github.com/oschwald/geoip2-golang"
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/config"
 	msg_client "github.com/teamgram/teamgram-server/app/messenger/msg/msg/client"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
 	authsession_client "github.com/teamgram/teamgram-server/app/service/authsession/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
+	twofa_client "github.com/teamgram/teamgram-server/app/service/biz/twofa/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	username_client "github.com/teamgram/teamgram-server/app/service/biz/username/client"
 	status_client "github.com/teamgram/teamgram-server/app/service/status/client"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 )
 
@@ -52,6 +53,7 @@ type Dao struct {
 	status_client.StatusClient
 	msg_client.MsgClient
 	username_client.UsernameClient
+	twofa_client.TwofaClient
 }
 
 func New(c config.Config) *Dao {
@@ -65,9 +67,10 @@ func New(c config.Config) *Dao {
 		UserClient:        user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
 		AuthsessionClient: authsession_client.NewAuthsessionClient(rpcx.GetCachedRpcClient(c.AuthsessionClient)),
 		ChatClient:        chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
-		SyncClient:        sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+		SyncClient:        sync_client.NewSyncMqClient(mqx.MustProducer(c.SyncClient)),
 		StatusClient:      status_client.NewStatusClient(rpcx.GetCachedRpcClient(c.StatusClient)),
 		MsgClient:         msg_client.NewMsgClient(rpcx.GetCachedRpcClient(c.MsgClient)),
 		UsernameClient:    username_client.NewUsernameClient(rpcx.GetCachedRpcClient(c.UsernameClient)),
+		TwofaClient:       twofa_client.NewTwofaClient(rpcx.GetCachedRpcClient(c.TwofaClient)),
 	}
 }
@@ -20,7 +20,10 @@ package config
 
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
+	messages_helper "github.com/teamgram/teamgram-server/app/bff/messages"
+	notification_helper "github.com/teamgram/teamgram-server/app/bff/notification"
 	"github.com/teamgram/teamgram-server/pkg/code/conf"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -34,9 +37,16 @@ type Config struct {
 	MediaClient               zrpc.RpcClientConf
 	IdgenClient               zrpc.RpcClientConf
 	MsgClient                 zrpc.RpcClientConf
+	MessageBus                mqx.BusConf
 	SyncClient                *kafka.KafkaProducerConf
 	DfsClient                 zrpc.RpcClientConf
 	StatusClient              zrpc.RpcClientConf
-	SignInServiceNotification []conf.MessageEntityConfig `json:",optional"`
-	SignInMessage             []conf.MessageEntityConfig `json:",optional"`
+	SignInServiceNotification []conf.MessageEntityConfig       `json:",optional"`
+	SignInMessage             []conf.MessageEntityConfig       `json:",optional"`
+	Push                      *notification_helper.PushConfig  `json:",optional"`
+	PushConsumer              *kafka.KafkaConsumerConf         `json:",optional"`
+	BotFather                 *messages_helper.BotFatherConfig `json:",optional"`
+	AvailableReactions        []string                         `json:",optional"`
+	Webrtc                    []conf.WebrtcConfig              `json:",optional"`
+	CallConfig                string                           `json:",optional"`
 }
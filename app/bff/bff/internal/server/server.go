@@ -20,11 +20,14 @@ package server
 
 import (
 	"flag"
+
 	"github.com/teamgram/proto/mtproto"
 	account_helper "github.com/teamgram/teamgram-server/app/bff/account"
 	authorization_helper "github.com/teamgram/teamgram-server/app/bff/authorization"
 	autodownload_helper "github.com/teamgram/teamgram-server/app/bff/autodownload"
 	"github.com/teamgram/teamgram-server/app/bff/bff/internal/config"
+	bots_helper "github.com/teamgram/teamgram-server/app/bff/bots"
+	channels_helper "github.com/teamgram/teamgram-server/app/bff/channels"
 	chatinvites_helper "github.com/teamgram/teamgram-server/app/bff/chatinvites"
 	chats_helper "github.com/teamgram/teamgram-server/app/bff/chats"
 	configuration_helper "github.com/teamgram/teamgram-server/app/bff/configuration"
@@ -37,13 +40,21 @@ import (
 	notification_helper "github.com/teamgram/teamgram-server/app/bff/notification"
 	nsfw_helper "github.com/teamgram/teamgram-server/app/bff/nsfw"
 	photos_helper "github.com/teamgram/teamgram-server/app/bff/photos"
+	polls_helper "github.com/teamgram/teamgram-server/app/bff/polls"
 	premium_helper "github.com/teamgram/teamgram-server/app/bff/premium"
 	qrcode_helper "github.com/teamgram/teamgram-server/app/bff/qrcode"
+	reactions_helper "github.com/teamgram/teamgram-server/app/bff/reactions"
+	scheduledmessages_helper "github.com/teamgram/teamgram-server/app/bff/scheduledmessages"
+	secretchats_helper "github.com/teamgram/teamgram-server/app/bff/secretchats"
 	sponsoredmessages_helper "github.com/teamgram/teamgram-server/app/bff/sponsoredmessages"
+	stickers_helper "github.com/teamgram/teamgram-server/app/bff/stickers"
 	tos_helper "github.com/teamgram/teamgram-server/app/bff/tos"
+	twofa_helper "github.com/teamgram/teamgram-server/app/bff/twofa"
 	updates_helper "github.com/teamgram/teamgram-server/app/bff/updates"
 	usernames_helper "github.com/teamgram/teamgram-server/app/bff/usernames"
 	users_helper "github.com/teamgram/teamgram-server/app/bff/users"
+	voipcalls_helper "github.com/teamgram/teamgram-server/app/bff/voipcalls"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -55,6 +66,7 @@ var configFile = flag.String("f", "etc/bff.yaml", "the config file")
 
 type Server struct {
 	grpcSrv *zrpc.RpcServer
+	pushMq  mqx.Consumer
 }
 
 func New() *Server {
@@ -66,9 +78,36 @@ func (s *Server) Initialize() error {
 	conf.MustLoad(*configFile, &c)
 
 	logx.Infov(c)
+	mqx.MustSetUp(c.MessageBus)
 	// ctx := svc.NewServiceContext(c)
 	// s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
 
+	channelsConfig := channels_helper.Config{
+		RpcServerConf: c.RpcServerConf,
+		UserClient:    c.BizServiceClient,
+		ChannelClient: c.BizServiceClient,
+		DialogClient:  c.BizServiceClient,
+		SyncClient:    c.SyncClient,
+		MediaClient:   c.MediaClient,
+		MsgClient:     c.MsgClient,
+	}
+	channelsPlugin := channels_helper.NewPlugin(channelsConfig)
+
+	stickersConfig := stickers_helper.Config{
+		RpcServerConf: c.RpcServerConf,
+		MediaClient:   c.MediaClient,
+	}
+
+	notificationConf := notification_helper.Config{
+		RpcServerConf:     c.RpcServerConf,
+		UserClient:        c.BizServiceClient,
+		ChatClient:        c.BizServiceClient,
+		SyncClient:        c.SyncClient,
+		AuthsessionClient: c.AuthSessionClient,
+		Push:              c.Push,
+		PushConsumer:      c.PushConsumer,
+	}
+
 	s.grpcSrv = zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		// tos_helper
 		mtproto.RegisterRPCTosServer(
@@ -119,10 +158,19 @@ func (s *Server) Initialize() error {
 					SignInMessage:             c.SignInMessage,
 					SignInServiceNotification: c.SignInServiceNotification,
 					UsernameClient:            c.BizServiceClient,
+					TwofaClient:               c.BizServiceClient,
 				},
 				nil,
 				nil))
 
+		// twofa_helper
+		mtproto.RegisterRPCTwoFaServer(
+			grpcServer,
+			twofa_helper.New(twofa_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				TwofaClient:   c.BizServiceClient,
+			}))
+
 		// premium_helper
 		mtproto.RegisterRPCPremiumServer(
 			grpcServer,
@@ -157,6 +205,11 @@ func (s *Server) Initialize() error {
 				MessageClient:     c.BizServiceClient,
 			}))
 
+		// channels_helper
+		mtproto.RegisterRPCChannelsServer(
+			grpcServer,
+			channels_helper.New(channelsConfig))
+
 		// files_helper
 		mtproto.RegisterRPCFilesServer(
 			grpcServer,
@@ -165,7 +218,7 @@ func (s *Server) Initialize() error {
 				DfsClient:     c.DfsClient,
 				UserClient:    c.BizServiceClient,
 				MediaClient:   c.MediaClient,
-			}, nil))
+			}, stickers_helper.NewPlugin(stickersConfig)))
 
 		// updates_helper
 		mtproto.RegisterRPCUpdatesServer(
@@ -176,6 +229,20 @@ func (s *Server) Initialize() error {
 				UserClient:        c.BizServiceClient,
 				ChatClient:        c.BizServiceClient,
 				AuthsessionClient: c.AuthSessionClient,
+				ChannelClient:     c.BizServiceClient,
+				DialogClient:      c.BizServiceClient,
+				SecretchatClient:  c.BizServiceClient,
+			}))
+
+		// secretchats_helper
+		mtproto.RegisterRPCSecretChatsServer(
+			grpcServer,
+			secretchats_helper.New(secretchats_helper.Config{
+				RpcServerConf:    c.RpcServerConf,
+				UserClient:       c.BizServiceClient,
+				SecretchatClient: c.BizServiceClient,
+				SyncClient:       c.SyncClient,
+				MediaClient:      c.MediaClient,
 			}))
 
 		// contacts_helper
@@ -189,20 +256,21 @@ func (s *Server) Initialize() error {
 					UsernameClient: c.BizServiceClient,
 					SyncClient:     c.SyncClient,
 				},
-				nil))
+				channelsPlugin))
 
 		// dialogs_helper
-		mtproto.RegisterRPCDialogsServer(
-			grpcServer,
-			dialogs_helper.New(dialogs_helper.Config{
-				RpcServerConf: c.RpcServerConf,
-				UpdatesClient: c.BizServiceClient,
-				UserClient:    c.BizServiceClient,
-				ChatClient:    c.BizServiceClient,
-				DialogClient:  c.BizServiceClient,
-				SyncClient:    c.SyncClient,
-				MessageClient: c.BizServiceClient,
-			}, nil))
+		dialogsService := dialogs_helper.New(dialogs_helper.Config{
+			RpcServerConf: c.RpcServerConf,
+			UpdatesClient: c.BizServiceClient,
+			UserClient:    c.BizServiceClient,
+			ChatClient:    c.BizServiceClient,
+			DialogClient:  c.BizServiceClient,
+			SyncClient:    c.SyncClient,
+			MessageClient: c.BizServiceClient,
+			MsgClient:     c.MsgClient,
+		}, channelsPlugin)
+		mtproto.RegisterRPCDialogsServer(grpcServer, dialogsService)
+		mtproto.RegisterRPCFoldersServer(grpcServer, dialogsService)
 
 		// drafts_helper
 		mtproto.RegisterRPCDraftsServer(
@@ -213,7 +281,7 @@ func (s *Server) Initialize() error {
 				UserClient:    c.BizServiceClient,
 				SyncClient:    c.SyncClient,
 				ChatClient:    c.BizServiceClient,
-			}, nil))
+			}, channelsPlugin))
 
 		// autodownload_helper
 		mtproto.RegisterRPCAutoDownloadServer(
@@ -229,6 +297,7 @@ func (s *Server) Initialize() error {
 				RpcServerConf:  c.RpcServerConf,
 				UserClient:     c.BizServiceClient,
 				ChatClient:     c.BizServiceClient,
+				ChannelClient:  c.BizServiceClient,
 				MsgClient:      c.MsgClient,
 				DialogClient:   c.BizServiceClient,
 				IdgenClient:    c.IdgenClient,
@@ -236,17 +305,13 @@ func (s *Server) Initialize() error {
 				MediaClient:    c.MediaClient,
 				UsernameClient: c.BizServiceClient,
 				SyncClient:     c.SyncClient,
-			}, nil))
+				BotFather:      c.BotFather,
+			}, channelsPlugin))
 
 		// notification_helper
 		mtproto.RegisterRPCNotificationServer(
 			grpcServer,
-			notification_helper.New(notification_helper.Config{
-				RpcServerConf: c.RpcServerConf,
-				UserClient:    c.BizServiceClient,
-				ChatClient:    c.BizServiceClient,
-				SyncClient:    c.SyncClient,
-			}, nil))
+			notification_helper.New(notificationConf, channelsPlugin))
 
 		// users_helper
 		mtproto.RegisterRPCUsersServer(
@@ -303,11 +368,76 @@ func (s *Server) Initialize() error {
 				UsernameClient: c.BizServiceClient,
 				ChatClient:     c.BizServiceClient,
 				SyncClient:     c.SyncClient,
-			}, nil))
+			}, channelsPlugin))
+
+		// bots_helper
+		mtproto.RegisterRPCBotsServer(
+			grpcServer,
+			bots_helper.New(bots_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				UserClient:    c.BizServiceClient,
+			}))
+
+		// reactions_helper
+		mtproto.RegisterRPCReactionsServer(
+			grpcServer,
+			reactions_helper.New(reactions_helper.Config{
+				RpcServerConf:      c.RpcServerConf,
+				UserClient:         c.BizServiceClient,
+				ChatClient:         c.BizServiceClient,
+				MsgClient:          c.MsgClient,
+				MessageClient:      c.BizServiceClient,
+				SyncClient:         c.SyncClient,
+				AvailableReactions: c.AvailableReactions,
+			}))
+
+		// polls_helper
+		mtproto.RegisterRPCPollsServer(
+			grpcServer,
+			polls_helper.New(polls_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				UserClient:    c.BizServiceClient,
+				ChatClient:    c.BizServiceClient,
+				MessageClient: c.BizServiceClient,
+				SyncClient:    c.SyncClient,
+			}))
+
+		// scheduledmessages_helper
+		mtproto.RegisterRPCScheduledMessagesServer(
+			grpcServer,
+			scheduledmessages_helper.New(scheduledmessages_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				UserClient:    c.BizServiceClient,
+				ChatClient:    c.BizServiceClient,
+				MsgClient:     c.MsgClient,
+				MessageClient: c.BizServiceClient,
+			}))
+
+		// stickers_helper
+		mtproto.RegisterRPCStickersServer(
+			grpcServer,
+			stickers_helper.New(stickersConfig))
+
+		// voipcalls_helper
+		mtproto.RegisterRPCVoipCallsServer(
+			grpcServer,
+			voipcalls_helper.New(voipcalls_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				KV:            c.KV,
+				UserClient:    c.BizServiceClient,
+				MsgClient:     c.MsgClient,
+				SyncClient:    c.SyncClient,
+				Webrtc:        c.Webrtc,
+				CallConfig:    c.CallConfig,
+			}))
 	})
 
 	// logx.Must(err)
 
+	if s.pushMq = notification_helper.NewPushConsumer(notificationConf, channelsPlugin); s.pushMq != nil {
+		go s.pushMq.Start()
+	}
+
 	go func() {
 		s.grpcSrv.Start()
 	}()
@@ -319,4 +449,7 @@ func (s *Server) RunLoop() {
 
 func (s *Server) Destroy() {
 	s.grpcSrv.Stop()
+	if s.pushMq != nil {
+		s.pushMq.Stop()
+	}
 }
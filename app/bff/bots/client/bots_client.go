@@ -0,0 +1,58 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package bots_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type BotsClient interface {
+	BotsSetBotCommands(ctx context.Context, in *mtproto.TLBotsSetBotCommands) (*mtproto.Bool, error)
+	BotsResetBotCommands(ctx context.Context, in *mtproto.TLBotsResetBotCommands) (*mtproto.Bool, error)
+	BotsGetBotCommands(ctx context.Context, in *mtproto.TLBotsGetBotCommands) (*mtproto.Vector_BotCommand, error)
+}
+
+type defaultBotsClient struct {
+	cli zrpc.Client
+}
+
+func NewBotsClient(cli zrpc.Client) BotsClient {
+	return &defaultBotsClient{
+		cli: cli,
+	}
+}
+
+// BotsSetBotCommands
+// bots.setBotCommands#517165a scope:BotCommandScope lang_code:string commands:Vector<BotCommand> = Bool;
+func (m *defaultBotsClient) BotsSetBotCommands(ctx context.Context, in *mtproto.TLBotsSetBotCommands) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCBotsClient(m.cli.Conn())
+	return client.BotsSetBotCommands(ctx, in)
+}
+
+// BotsResetBotCommands
+// bots.resetBotCommands#3d8de0f9 scope:BotCommandScope lang_code:string = Bool;
+func (m *defaultBotsClient) BotsResetBotCommands(ctx context.Context, in *mtproto.TLBotsResetBotCommands) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCBotsClient(m.cli.Conn())
+	return client.BotsResetBotCommands(ctx, in)
+}
+
+// BotsGetBotCommands
+// bots.getBotCommands#e34c0dd6 scope:BotCommandScope lang_code:string = Vector<BotCommand>;
+func (m *defaultBotsClient) BotsGetBotCommands(ctx context.Context, in *mtproto.TLBotsGetBotCommands) (*mtproto.Vector_BotCommand, error) {
+	client := mtproto.NewRPCBotsClient(m.cli.Conn())
+	return client.BotsGetBotCommands(ctx, in)
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// BotsGetBotCommands
+// bots.getBotCommands#e34c0dd6 scope:BotCommandScope lang_code:string = Vector<BotCommand>;
+func (c *BotsCore) BotsGetBotCommands(in *mtproto.TLBotsGetBotCommands) (*mtproto.Vector_BotCommand, error) {
+	if !c.MD.IsBot {
+		err := mtproto.ErrUserBotRequired
+		c.Logger.Errorf("bots.getBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	rValues := &mtproto.Vector_BotCommand{
+		Datas: []*mtproto.BotCommand{},
+	}
+
+	// commands of other scopes and languages are never stored
+	if checkBotCommandScope(in.GetScope(), in.GetLangCode()) != nil {
+		return rValues, nil
+	}
+
+	botInfo, err := c.svcCtx.Dao.UserClient.UserGetBotInfo(c.ctx, &userpb.TLUserGetBotInfo{
+		BotId: c.MD.UserId,
+	})
+	if err != nil {
+		c.Logger.Errorf("bots.getBotCommands - error: %v", err)
+		return nil, err
+	}
+	rValues.Datas = append(rValues.Datas, botInfo.GetCommands()...)
+
+	return rValues, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// BotsResetBotCommands
+// bots.resetBotCommands#3d8de0f9 scope:BotCommandScope lang_code:string = Bool;
+func (c *BotsCore) BotsResetBotCommands(in *mtproto.TLBotsResetBotCommands) (*mtproto.Bool, error) {
+	if !c.MD.IsBot {
+		err := mtproto.ErrUserBotRequired
+		c.Logger.Errorf("bots.resetBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	if err := checkBotCommandScope(in.GetScope(), in.GetLangCode()); err != nil {
+		c.Logger.Errorf("bots.resetBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	_, err := c.svcCtx.Dao.UserClient.UserSetBotCommands(c.ctx, &userpb.TLUserSetBotCommands{
+		UserId:   c.MD.UserId,
+		BotId:    c.MD.UserId,
+		Commands: []*mtproto.BotCommand{},
+	})
+	if err != nil {
+		c.Logger.Errorf("bots.resetBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+const (
+	maxBotCommands           = 100
+	maxBotCommandLen         = 32
+	maxBotCommandDescription = 256
+)
+
+// BotsSetBotCommands
+// bots.setBotCommands#517165a scope:BotCommandScope lang_code:string commands:Vector<BotCommand> = Bool;
+func (c *BotsCore) BotsSetBotCommands(in *mtproto.TLBotsSetBotCommands) (*mtproto.Bool, error) {
+	if !c.MD.IsBot {
+		err := mtproto.ErrUserBotRequired
+		c.Logger.Errorf("bots.setBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	if err := checkBotCommandScope(in.GetScope(), in.GetLangCode()); err != nil {
+		c.Logger.Errorf("bots.setBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	if len(in.GetCommands()) > maxBotCommands {
+		err := mtproto.ErrBotCommandInvalid
+		c.Logger.Errorf("bots.setBotCommands - error: %v", err)
+		return nil, err
+	}
+	for _, cmd := range in.GetCommands() {
+		if !checkBotCommand(cmd.GetCommand()) {
+			err := mtproto.ErrBotCommandInvalid
+			c.Logger.Errorf("bots.setBotCommands - error: invalid command(%s)", cmd.GetCommand())
+			return nil, err
+		}
+		if l := len([]rune(cmd.GetDescription())); l == 0 || l > maxBotCommandDescription {
+			err := mtproto.ErrBotCommandDescriptionInvalid
+			c.Logger.Errorf("bots.setBotCommands - error: %v", err)
+			return nil, err
+		}
+	}
+
+	_, err := c.svcCtx.Dao.UserClient.UserSetBotCommands(c.ctx, &userpb.TLUserSetBotCommands{
+		UserId:   c.MD.UserId,
+		BotId:    c.MD.UserId,
+		Commands: in.GetCommands(),
+	})
+	if err != nil {
+		c.Logger.Errorf("bots.setBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
+
+// checkBotCommandScope only the default scope is stored, commands of other
+// scopes and languages are not supported yet.
+func checkBotCommandScope(scope *mtproto.BotCommandScope, langCode string) error {
+	if scope != nil && scope.GetPredicateName() != mtproto.Predicate_botCommandScopeDefault {
+		return mtproto.ErrBotCommandInvalid
+	}
+	if langCode != "" {
+		return mtproto.ErrLangCodeInvalid
+	}
+
+	return nil
+}
+
+// checkBotCommand command is 1-32 chars of lowercase letters, digits and underscores.
+func checkBotCommand(command string) bool {
+	if len(command) == 0 || len(command) > maxBotCommandLen {
+		return false
+	}
+	for _, ch := range command {
+		if !(ch >= 'a' && ch <= 'z' || ch >= '0' && ch <= '9' || ch == '_') {
+			return false
+		}
+	}
+
+	return true
+}
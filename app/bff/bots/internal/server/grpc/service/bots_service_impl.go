@@ -0,0 +1,63 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/core"
+)
+
+// BotsSetBotCommands
+// bots.setBotCommands#517165a scope:BotCommandScope lang_code:string commands:Vector<BotCommand> = Bool;
+func (s *Service) BotsSetBotCommands(ctx context.Context, request *mtproto.TLBotsSetBotCommands) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("bots.setBotCommands - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.BotsSetBotCommands(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("bots.setBotCommands - reply: %s", r.DebugString())
+	return r, err
+}
+
+// BotsResetBotCommands
+// bots.resetBotCommands#3d8de0f9 scope:BotCommandScope lang_code:string = Bool;
+func (s *Service) BotsResetBotCommands(ctx context.Context, request *mtproto.TLBotsResetBotCommands) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("bots.resetBotCommands - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.BotsResetBotCommands(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("bots.resetBotCommands - reply: %s", r.DebugString())
+	return r, err
+}
+
+// BotsGetBotCommands
+// bots.getBotCommands#e34c0dd6 scope:BotCommandScope lang_code:string = Vector<BotCommand>;
+func (s *Service) BotsGetBotCommands(ctx context.Context, request *mtproto.TLBotsGetBotCommands) (*mtproto.Vector_BotCommand, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("bots.getBotCommands - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.BotsGetBotCommands(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("bots.getBotCommands - reply: %s", r.DebugString())
+	return r, err
+}
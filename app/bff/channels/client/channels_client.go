@@ -0,0 +1,290 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package channels_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type ChannelsClient interface {
+	ChannelsReadHistory(ctx context.Context, in *mtproto.TLChannelsReadHistory) (*mtproto.Bool, error)
+	ChannelsDeleteMessages(ctx context.Context, in *mtproto.TLChannelsDeleteMessages) (*mtproto.Messages_AffectedMessages, error)
+	ChannelsGetMessages(ctx context.Context, in *mtproto.TLChannelsGetMessages) (*mtproto.Messages_Messages, error)
+	ChannelsGetParticipants(ctx context.Context, in *mtproto.TLChannelsGetParticipants) (*mtproto.Channels_ChannelParticipants, error)
+	ChannelsGetParticipant(ctx context.Context, in *mtproto.TLChannelsGetParticipant) (*mtproto.Channels_ChannelParticipant, error)
+	ChannelsGetChannels(ctx context.Context, in *mtproto.TLChannelsGetChannels) (*mtproto.Messages_Chats, error)
+	ChannelsGetFullChannel(ctx context.Context, in *mtproto.TLChannelsGetFullChannel) (*mtproto.Messages_ChatFull, error)
+	ChannelsCreateChannel(ctx context.Context, in *mtproto.TLChannelsCreateChannel) (*mtproto.Updates, error)
+	ChannelsEditAdmin(ctx context.Context, in *mtproto.TLChannelsEditAdmin) (*mtproto.Updates, error)
+	ChannelsEditTitle(ctx context.Context, in *mtproto.TLChannelsEditTitle) (*mtproto.Updates, error)
+	ChannelsEditPhoto(ctx context.Context, in *mtproto.TLChannelsEditPhoto) (*mtproto.Updates, error)
+	ChannelsJoinChannel(ctx context.Context, in *mtproto.TLChannelsJoinChannel) (*mtproto.Updates, error)
+	ChannelsLeaveChannel(ctx context.Context, in *mtproto.TLChannelsLeaveChannel) (*mtproto.Updates, error)
+	ChannelsInviteToChannel(ctx context.Context, in *mtproto.TLChannelsInviteToChannel) (*mtproto.Updates, error)
+	ChannelsDeleteChannel(ctx context.Context, in *mtproto.TLChannelsDeleteChannel) (*mtproto.Updates, error)
+	ChannelsExportMessageLink(ctx context.Context, in *mtproto.TLChannelsExportMessageLink) (*mtproto.ExportedMessageLink, error)
+	ChannelsToggleSignatures(ctx context.Context, in *mtproto.TLChannelsToggleSignatures) (*mtproto.Updates, error)
+	ChannelsGetAdminedPublicChannels(ctx context.Context, in *mtproto.TLChannelsGetAdminedPublicChannels) (*mtproto.Messages_Chats, error)
+	ChannelsEditBanned(ctx context.Context, in *mtproto.TLChannelsEditBanned) (*mtproto.Updates, error)
+	ChannelsGetAdminLog(ctx context.Context, in *mtproto.TLChannelsGetAdminLog) (*mtproto.Channels_AdminLogResults, error)
+	ChannelsSetStickers(ctx context.Context, in *mtproto.TLChannelsSetStickers) (*mtproto.Bool, error)
+	ChannelsReadMessageContents(ctx context.Context, in *mtproto.TLChannelsReadMessageContents) (*mtproto.Bool, error)
+	ChannelsDeleteHistory9BAA9647(ctx context.Context, in *mtproto.TLChannelsDeleteHistory9BAA9647) (*mtproto.Updates, error)
+	ChannelsTogglePreHistoryHidden(ctx context.Context, in *mtproto.TLChannelsTogglePreHistoryHidden) (*mtproto.Updates, error)
+	ChannelsGetGroupsForDiscussion(ctx context.Context, in *mtproto.TLChannelsGetGroupsForDiscussion) (*mtproto.Messages_Chats, error)
+	ChannelsSetDiscussionGroup(ctx context.Context, in *mtproto.TLChannelsSetDiscussionGroup) (*mtproto.Bool, error)
+	ChannelsEditCreator(ctx context.Context, in *mtproto.TLChannelsEditCreator) (*mtproto.Updates, error)
+	ChannelsEditLocation(ctx context.Context, in *mtproto.TLChannelsEditLocation) (*mtproto.Bool, error)
+	ChannelsToggleSlowMode(ctx context.Context, in *mtproto.TLChannelsToggleSlowMode) (*mtproto.Updates, error)
+	ChannelsGetInactiveChannels(ctx context.Context, in *mtproto.TLChannelsGetInactiveChannels) (*mtproto.Messages_InactiveChats, error)
+	ChannelsDeleteParticipantHistory(ctx context.Context, in *mtproto.TLChannelsDeleteParticipantHistory) (*mtproto.Messages_AffectedHistory, error)
+	ChannelsDeleteHistoryAF369D42(ctx context.Context, in *mtproto.TLChannelsDeleteHistoryAF369D42) (*mtproto.Bool, error)
+}
+
+type defaultChannelsClient struct {
+	cli zrpc.Client
+}
+
+func NewChannelsClient(cli zrpc.Client) ChannelsClient {
+	return &defaultChannelsClient{
+		cli: cli,
+	}
+}
+
+// ChannelsReadHistory
+// channels.readHistory#cc104937 channel:InputChannel max_id:int = Bool;
+func (m *defaultChannelsClient) ChannelsReadHistory(ctx context.Context, in *mtproto.TLChannelsReadHistory) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsReadHistory(ctx, in)
+}
+
+// ChannelsDeleteMessages
+// channels.deleteMessages#84c1fd4e channel:InputChannel id:Vector<int> = messages.AffectedMessages;
+func (m *defaultChannelsClient) ChannelsDeleteMessages(ctx context.Context, in *mtproto.TLChannelsDeleteMessages) (*mtproto.Messages_AffectedMessages, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsDeleteMessages(ctx, in)
+}
+
+// ChannelsGetMessages
+// channels.getMessages#ad8c9a23 channel:InputChannel id:Vector<InputMessage> = messages.Messages;
+func (m *defaultChannelsClient) ChannelsGetMessages(ctx context.Context, in *mtproto.TLChannelsGetMessages) (*mtproto.Messages_Messages, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetMessages(ctx, in)
+}
+
+// ChannelsGetParticipants
+// channels.getParticipants#77ced9d0 channel:InputChannel filter:ChannelParticipantsFilter offset:int limit:int hash:long = channels.ChannelParticipants;
+func (m *defaultChannelsClient) ChannelsGetParticipants(ctx context.Context, in *mtproto.TLChannelsGetParticipants) (*mtproto.Channels_ChannelParticipants, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetParticipants(ctx, in)
+}
+
+// ChannelsGetParticipant
+// channels.getParticipant#a0ab6cc6 channel:InputChannel participant:InputPeer = channels.ChannelParticipant;
+func (m *defaultChannelsClient) ChannelsGetParticipant(ctx context.Context, in *mtproto.TLChannelsGetParticipant) (*mtproto.Channels_ChannelParticipant, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetParticipant(ctx, in)
+}
+
+// ChannelsGetChannels
+// channels.getChannels#a7f6bbb id:Vector<InputChannel> = messages.Chats;
+func (m *defaultChannelsClient) ChannelsGetChannels(ctx context.Context, in *mtproto.TLChannelsGetChannels) (*mtproto.Messages_Chats, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetChannels(ctx, in)
+}
+
+// ChannelsGetFullChannel
+// channels.getFullChannel#8736a09 channel:InputChannel = messages.ChatFull;
+func (m *defaultChannelsClient) ChannelsGetFullChannel(ctx context.Context, in *mtproto.TLChannelsGetFullChannel) (*mtproto.Messages_ChatFull, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetFullChannel(ctx, in)
+}
+
+// ChannelsCreateChannel
+// channels.createChannel#91006707 flags:# broadcast:flags.0?true megagroup:flags.1?true for_import:flags.3?true title:string about:string geo_point:flags.2?InputGeoPoint address:flags.2?string ttl_period:flags.4?int = Updates;
+func (m *defaultChannelsClient) ChannelsCreateChannel(ctx context.Context, in *mtproto.TLChannelsCreateChannel) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsCreateChannel(ctx, in)
+}
+
+// ChannelsEditAdmin
+// channels.editAdmin#d33c8902 channel:InputChannel user_id:InputUser admin_rights:ChatAdminRights rank:string = Updates;
+func (m *defaultChannelsClient) ChannelsEditAdmin(ctx context.Context, in *mtproto.TLChannelsEditAdmin) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsEditAdmin(ctx, in)
+}
+
+// ChannelsEditTitle
+// channels.editTitle#566decd0 channel:InputChannel title:string = Updates;
+func (m *defaultChannelsClient) ChannelsEditTitle(ctx context.Context, in *mtproto.TLChannelsEditTitle) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsEditTitle(ctx, in)
+}
+
+// ChannelsEditPhoto
+// channels.editPhoto#f12e57c9 channel:InputChannel photo:InputChatPhoto = Updates;
+func (m *defaultChannelsClient) ChannelsEditPhoto(ctx context.Context, in *mtproto.TLChannelsEditPhoto) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsEditPhoto(ctx, in)
+}
+
+// ChannelsJoinChannel
+// channels.joinChannel#24b524c5 channel:InputChannel = Updates;
+func (m *defaultChannelsClient) ChannelsJoinChannel(ctx context.Context, in *mtproto.TLChannelsJoinChannel) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsJoinChannel(ctx, in)
+}
+
+// ChannelsLeaveChannel
+// channels.leaveChannel#f836aa95 channel:InputChannel = Updates;
+func (m *defaultChannelsClient) ChannelsLeaveChannel(ctx context.Context, in *mtproto.TLChannelsLeaveChannel) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsLeaveChannel(ctx, in)
+}
+
+// ChannelsInviteToChannel
+// channels.inviteToChannel#199f3a6c channel:InputChannel users:Vector<InputUser> = Updates;
+func (m *defaultChannelsClient) ChannelsInviteToChannel(ctx context.Context, in *mtproto.TLChannelsInviteToChannel) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsInviteToChannel(ctx, in)
+}
+
+// ChannelsDeleteChannel
+// channels.deleteChannel#c0111fe3 channel:InputChannel = Updates;
+func (m *defaultChannelsClient) ChannelsDeleteChannel(ctx context.Context, in *mtproto.TLChannelsDeleteChannel) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsDeleteChannel(ctx, in)
+}
+
+// ChannelsExportMessageLink
+// channels.exportMessageLink#e63fadeb flags:# grouped:flags.0?true thread:flags.1?true channel:InputChannel id:int = ExportedMessageLink;
+func (m *defaultChannelsClient) ChannelsExportMessageLink(ctx context.Context, in *mtproto.TLChannelsExportMessageLink) (*mtproto.ExportedMessageLink, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsExportMessageLink(ctx, in)
+}
+
+// ChannelsToggleSignatures
+// channels.toggleSignatures#1f69b606 channel:InputChannel enabled:Bool = Updates;
+func (m *defaultChannelsClient) ChannelsToggleSignatures(ctx context.Context, in *mtproto.TLChannelsToggleSignatures) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsToggleSignatures(ctx, in)
+}
+
+// ChannelsGetAdminedPublicChannels
+// channels.getAdminedPublicChannels#f8b036af flags:# by_location:flags.0?true check_limit:flags.1?true = messages.Chats;
+func (m *defaultChannelsClient) ChannelsGetAdminedPublicChannels(ctx context.Context, in *mtproto.TLChannelsGetAdminedPublicChannels) (*mtproto.Messages_Chats, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetAdminedPublicChannels(ctx, in)
+}
+
+// ChannelsEditBanned
+// channels.editBanned#96e6cd81 channel:InputChannel participant:InputPeer banned_rights:ChatBannedRights = Updates;
+func (m *defaultChannelsClient) ChannelsEditBanned(ctx context.Context, in *mtproto.TLChannelsEditBanned) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsEditBanned(ctx, in)
+}
+
+// ChannelsGetAdminLog
+// channels.getAdminLog#33ddf480 flags:# channel:InputChannel q:string events_filter:flags.0?ChannelAdminLogEventsFilter admins:flags.1?Vector<InputUser> max_id:long min_id:long limit:int = channels.AdminLogResults;
+func (m *defaultChannelsClient) ChannelsGetAdminLog(ctx context.Context, in *mtproto.TLChannelsGetAdminLog) (*mtproto.Channels_AdminLogResults, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetAdminLog(ctx, in)
+}
+
+// ChannelsSetStickers
+// channels.setStickers#ea8ca4f9 channel:InputChannel stickerset:InputStickerSet = Bool;
+func (m *defaultChannelsClient) ChannelsSetStickers(ctx context.Context, in *mtproto.TLChannelsSetStickers) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsSetStickers(ctx, in)
+}
+
+// ChannelsReadMessageContents
+// channels.readMessageContents#eab5dc38 channel:InputChannel id:Vector<int> = Bool;
+func (m *defaultChannelsClient) ChannelsReadMessageContents(ctx context.Context, in *mtproto.TLChannelsReadMessageContents) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsReadMessageContents(ctx, in)
+}
+
+// ChannelsDeleteHistory9BAA9647
+// channels.deleteHistory9BAA9647#9baa9647 flags:# for_everyone:flags.0?true channel:InputChannel max_id:int = Updates;
+func (m *defaultChannelsClient) ChannelsDeleteHistory9BAA9647(ctx context.Context, in *mtproto.TLChannelsDeleteHistory9BAA9647) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsDeleteHistory9BAA9647(ctx, in)
+}
+
+// ChannelsTogglePreHistoryHidden
+// channels.togglePreHistoryHidden#eabbb94c channel:InputChannel enabled:Bool = Updates;
+func (m *defaultChannelsClient) ChannelsTogglePreHistoryHidden(ctx context.Context, in *mtproto.TLChannelsTogglePreHistoryHidden) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsTogglePreHistoryHidden(ctx, in)
+}
+
+// ChannelsGetGroupsForDiscussion
+// channels.getGroupsForDiscussion#f5dad378 = messages.Chats;
+func (m *defaultChannelsClient) ChannelsGetGroupsForDiscussion(ctx context.Context, in *mtproto.TLChannelsGetGroupsForDiscussion) (*mtproto.Messages_Chats, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetGroupsForDiscussion(ctx, in)
+}
+
+// ChannelsSetDiscussionGroup
+// channels.setDiscussionGroup#40582bb2 broadcast:InputChannel group:InputChannel = Bool;
+func (m *defaultChannelsClient) ChannelsSetDiscussionGroup(ctx context.Context, in *mtproto.TLChannelsSetDiscussionGroup) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsSetDiscussionGroup(ctx, in)
+}
+
+// ChannelsEditCreator
+// channels.editCreator#8f38cd1f channel:InputChannel user_id:InputUser password:InputCheckPasswordSRP = Updates;
+func (m *defaultChannelsClient) ChannelsEditCreator(ctx context.Context, in *mtproto.TLChannelsEditCreator) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsEditCreator(ctx, in)
+}
+
+// ChannelsEditLocation
+// channels.editLocation#58e63f6d channel:InputChannel geo_point:InputGeoPoint address:string = Bool;
+func (m *defaultChannelsClient) ChannelsEditLocation(ctx context.Context, in *mtproto.TLChannelsEditLocation) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsEditLocation(ctx, in)
+}
+
+// ChannelsToggleSlowMode
+// channels.toggleSlowMode#edd49ef0 channel:InputChannel seconds:int = Updates;
+func (m *defaultChannelsClient) ChannelsToggleSlowMode(ctx context.Context, in *mtproto.TLChannelsToggleSlowMode) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsToggleSlowMode(ctx, in)
+}
+
+// ChannelsGetInactiveChannels
+// channels.getInactiveChannels#11e831ee = messages.InactiveChats;
+func (m *defaultChannelsClient) ChannelsGetInactiveChannels(ctx context.Context, in *mtproto.TLChannelsGetInactiveChannels) (*mtproto.Messages_InactiveChats, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsGetInactiveChannels(ctx, in)
+}
+
+// ChannelsDeleteParticipantHistory
+// channels.deleteParticipantHistory#367544db channel:InputChannel participant:InputPeer = messages.AffectedHistory;
+func (m *defaultChannelsClient) ChannelsDeleteParticipantHistory(ctx context.Context, in *mtproto.TLChannelsDeleteParticipantHistory) (*mtproto.Messages_AffectedHistory, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsDeleteParticipantHistory(ctx, in)
+}
+
+// ChannelsDeleteHistoryAF369D42
+// channels.deleteHistoryAF369D42#af369d42 channel:InputChannel max_id:int = Bool;
+func (m *defaultChannelsClient) ChannelsDeleteHistoryAF369D42(ctx context.Context, in *mtproto.TLChannelsDeleteHistoryAF369D42) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCChannelsClient(m.cli.Conn())
+	return client.ChannelsDeleteHistoryAF369D42(ctx, in)
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// ChannelsCreateChannel
+// channels.createChannel#91006707 flags:# broadcast:flags.0?true megagroup:flags.1?true for_import:flags.3?true title:string about:string geo_point:flags.2?InputGeoPoint address:flags.2?string ttl_period:flags.4?int = Updates;
+func (c *ChannelsCore) ChannelsCreateChannel(in *mtproto.TLChannelsCreateChannel) (*mtproto.Updates, error) {
+	if in.GetTitle() == "" {
+		err := mtproto.ErrChatTitleEmpty
+		c.Logger.Errorf("channels.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	// exactly one of broadcast and megagroup
+	if in.GetBroadcast() == in.GetMegagroup() {
+		err := mtproto.ErrInputRequestInvalid
+		c.Logger.Errorf("channels.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	channel, err := c.svcCtx.Dao.ChannelClient.ChannelCreateChannel(c.ctx, &channelpb.TLChannelCreateChannel{
+		CreatorId: c.MD.UserId,
+		Broadcast: in.GetBroadcast(),
+		Megagroup: in.GetMegagroup(),
+		Title:     in.GetTitle(),
+		About:     in.GetAbout(),
+		Date:      int32(now),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	c.svcCtx.Dao.DialogClient.DialogInsertOrUpdateDialog(c.ctx, &dialog.TLDialogInsertOrUpdateDialog{
+		UserId:      c.MD.UserId,
+		PeerType:    mtproto.PEER_CHANNEL,
+		PeerId:      channel.Id(),
+		TopMessage:  &types.Int32Value{Value: 0},
+		UnreadCount: &types.Int32Value{Value: 0},
+		Date2:       &types.Int64Value{Value: now},
+	})
+
+	users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId},
+	})
+
+	rUpdates := mtproto.MakeUpdatesByUpdatesUsersChats(
+		users.GetUserListByIdList(c.MD.UserId, c.MD.UserId),
+		[]*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		mtproto.MakeUpdateChannel(channel.Id()))
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates:   rUpdates,
+	})
+
+	return rUpdates, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// ChannelsDeleteMessages
+// channels.deleteMessages#84c1fd4e channel:InputChannel id:Vector<int> = messages.AffectedMessages;
+func (c *ChannelsCore) ChannelsDeleteMessages(in *mtproto.TLChannelsDeleteMessages) (*mtproto.Messages_AffectedMessages, error) {
+	channel, err := c.getMutableChannel(in.GetChannel())
+	if err != nil {
+		c.Logger.Errorf("channels.deleteMessages - error: %v", err)
+		return nil, err
+	}
+
+	if !channel.IsChannelParticipant(c.MD.UserId) {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.deleteMessages - error: %v", err)
+		return nil, err
+	}
+
+	affectedMessages, err := c.svcCtx.Dao.MsgClient.MsgDeleteMessages(c.ctx, &msgpb.TLMsgDeleteMessages{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		PeerType:  mtproto.PEER_CHANNEL,
+		PeerId:    channel.Id(),
+		Revoke:    true,
+		Id:        in.GetId(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.deleteMessages - error: %v", err)
+		return nil, err
+	}
+
+	return affectedMessages, nil
+}
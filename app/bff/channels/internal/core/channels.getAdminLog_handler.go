@@ -0,0 +1,104 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// ChannelsGetAdminLog
+// channels.getAdminLog#33ddf480 flags:# channel:InputChannel q:string events_filter:flags.0?ChannelAdminLogEventsFilter admins:flags.1?Vector<InputUser> max_id:long min_id:long limit:int = channels.AdminLogResults;
+func (c *ChannelsCore) ChannelsGetAdminLog(in *mtproto.TLChannelsGetAdminLog) (*mtproto.Channels_AdminLogResults, error) {
+	channel, err := c.getMutableChannel(in.GetChannel())
+	if err != nil {
+		c.Logger.Errorf("channels.getAdminLog - error: %v", err)
+		return nil, err
+	}
+
+	rValues := mtproto.MakeTLChannelsAdminLogResults(&mtproto.Channels_AdminLogResults{
+		Events: []*mtproto.ChannelAdminLogEvent{},
+		Chats:  []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:  []*mtproto.User{},
+	}).To_Channels_AdminLogResults()
+
+	// only message edits are recorded for now
+	if in.GetEventsFilter() != nil && !in.GetEventsFilter().GetEdit() {
+		return rValues, nil
+	}
+
+	events, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelAdminLog(c.ctx, &channelpb.TLChannelGetChannelAdminLog{
+		ChannelId: channel.Id(),
+		UserId:    c.MD.UserId,
+		MaxId:     in.GetMaxId(),
+		MinId:     in.GetMinId(),
+		Limit:     in.GetLimit(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getAdminLog - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		admins     map[int64]bool
+		userIdList []int64
+	)
+
+	if len(in.GetAdmins()) > 0 {
+		admins = make(map[int64]bool, len(in.GetAdmins()))
+		for _, admin := range in.GetAdmins() {
+			switch admin.GetPredicateName() {
+			case mtproto.Predicate_inputUserSelf:
+				admins[c.MD.UserId] = true
+			case mtproto.Predicate_inputUser:
+				admins[admin.GetUserId()] = true
+			}
+		}
+	}
+
+	for _, event := range events.GetDatas() {
+		if admins != nil && !admins[event.UserId] {
+			continue
+		}
+		if in.GetQ() != "" &&
+			!strings.Contains(event.GetAction().GetNewMessage().GetMessage(), in.GetQ()) &&
+			!strings.Contains(event.GetAction().GetPrevMessage().GetMessage(), in.GetQ()) {
+			continue
+		}
+
+		rValues.Events = append(rValues.Events, event)
+		userIdList = append(userIdList, event.UserId)
+		if fromId := event.GetAction().GetNewMessage().GetFromId().GetUserId(); fromId != 0 {
+			userIdList = append(userIdList, fromId)
+		}
+	}
+
+	if len(userIdList) > 0 {
+		mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+			&userpb.TLUserGetMutableUsers{
+				Id: userIdList,
+			})
+		rValues.Users = append(rValues.Users, mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)...)
+	}
+
+	return rValues, nil
+}
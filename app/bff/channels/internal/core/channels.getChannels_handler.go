@@ -0,0 +1,56 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelsGetChannels
+// channels.getChannels#a7f6bbb id:Vector<InputChannel> = messages.Chats;
+func (c *ChannelsCore) ChannelsGetChannels(in *mtproto.TLChannelsGetChannels) (*mtproto.Messages_Chats, error) {
+	idList := make([]int64, 0, len(in.GetId()))
+	for _, id := range in.GetId() {
+		switch id.GetPredicateName() {
+		case mtproto.Predicate_inputChannel, mtproto.Predicate_inputChannelFromMessage:
+			idList = append(idList, id.GetChannelId())
+		}
+	}
+
+	rValues := mtproto.MakeTLMessagesChats(&mtproto.Messages_Chats{
+		Chats: []*mtproto.Chat{},
+	}).To_Messages_Chats()
+
+	if len(idList) == 0 {
+		return rValues, nil
+	}
+
+	channels, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx, &channelpb.TLChannelGetChannelListByIdList{
+		SelfId: c.MD.UserId,
+		Id:     idList,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getChannels - error: %v", err)
+		return nil, err
+	}
+	rValues.Chats = channels.GetChatListByIdList(c.MD.UserId, idList...)
+
+	return rValues, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// ChannelsGetFullChannel
+// channels.getFullChannel#8736a09 channel:InputChannel = messages.ChatFull;
+func (c *ChannelsCore) ChannelsGetFullChannel(in *mtproto.TLChannelsGetFullChannel) (*mtproto.Messages_ChatFull, error) {
+	channel, err := c.getMutableChannel(in.GetChannel())
+	if err != nil {
+		c.Logger.Errorf("channels.getFullChannel - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		channelData = channel.GetChannel()
+		me, _       = channel.GetChannelParticipant(c.MD.UserId)
+		isAdmin     = channel.IsChannelParticipant(c.MD.UserId) && me.IsAdmin()
+		chatPhoto   = channelData.GetPhoto()
+	)
+
+	if chatPhoto == nil {
+		chatPhoto = mtproto.MakeTLPhotoEmpty(nil).To_Photo()
+	}
+
+	channelFull := mtproto.MakeTLChannelFull(&mtproto.ChatFull{
+		CanViewParticipants: channel.IsMegagroup() || isAdmin,
+		CanSetUsername:      false, // TODO
+		Id:                  channel.Id(),
+		About:               channelData.GetAbout(),
+		ParticipantsCount:   &types.Int32Value{Value: channelData.GetParticipantsCount()},
+		ChatPhoto:           chatPhoto,
+		NotifySettings:      mtproto.MakeTLPeerNotifySettings(nil).To_PeerNotifySettings(),
+		BotInfo:             []*mtproto.BotInfo{},
+		Pts:                 channelData.GetPts(),
+	}).To_ChatFull()
+
+	if isAdmin {
+		channelFull.CanDeleteChannel = me.IsCreator()
+	}
+
+	if dlg, _ := c.svcCtx.Dao.DialogClient.DialogGetDialogById(c.ctx, &dialog.TLDialogGetDialogById{
+		UserId:   c.MD.UserId,
+		PeerType: mtproto.PEER_CHANNEL,
+		PeerId:   channel.Id(),
+	}); dlg != nil {
+		channelFull.ReadInboxMaxId = dlg.GetDialog().GetReadInboxMaxId()
+		channelFull.ReadOutboxMaxId = dlg.GetDialog().GetReadOutboxMaxId()
+		channelFull.UnreadCount = dlg.GetDialog().GetUnreadCount()
+		channelFull.FolderId = dlg.GetDialog().GetFolderId()
+	}
+
+	if settings, _ := c.svcCtx.Dao.UserClient.UserGetNotifySettings(c.ctx, &userpb.TLUserGetNotifySettings{
+		UserId:   c.MD.UserId,
+		PeerType: mtproto.PEER_CHANNEL,
+		PeerId:   channel.Id(),
+	}); settings != nil {
+		channelFull.NotifySettings = settings
+	}
+
+	mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId},
+	})
+
+	return mtproto.MakeTLMessagesChatFull(&mtproto.Messages_ChatFull{
+		FullChat: channelFull,
+		Chats:    []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:    mUsers.GetUserListByIdList(c.MD.UserId, c.MD.UserId),
+	}).To_Messages_ChatFull(), nil
+}
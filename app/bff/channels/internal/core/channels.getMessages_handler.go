@@ -0,0 +1,91 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// ChannelsGetMessages
+// channels.getMessages#ad8c9a23 channel:InputChannel id:Vector<InputMessage> = messages.Messages;
+func (c *ChannelsCore) ChannelsGetMessages(in *mtproto.TLChannelsGetMessages) (*mtproto.Messages_Messages, error) {
+	channel, err := c.getMutableChannel(in.GetChannel())
+	if err != nil {
+		c.Logger.Errorf("channels.getMessages - error: %v", err)
+		return nil, err
+	}
+
+	if !channel.IsChannelParticipant(c.MD.UserId) {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.getMessages - error: %v", err)
+		return nil, err
+	}
+
+	idList := make([]int32, 0, len(in.GetId_VECTORINPUTMESSAGE()))
+	for _, id := range in.GetId_VECTORINPUTMESSAGE() {
+		switch id.GetPredicateName() {
+		case mtproto.Predicate_inputMessageID:
+			idList = append(idList, id.GetId())
+		default:
+			c.Logger.Errorf("channels.getMessages - not impl inputMessage: %s", id.DebugString())
+		}
+	}
+	idList = append(idList, in.GetId_VECTORINT32()...)
+
+	rValues := mtproto.MakeTLMessagesChannelMessages(&mtproto.Messages_Messages{
+		Inexact:  false,
+		Pts:      channel.GetChannel().GetPts(),
+		Count:    0,
+		Messages: []*mtproto.Message{},
+		Chats:    []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:    []*mtproto.User{},
+	}).To_Messages_Messages()
+
+	if len(idList) == 0 {
+		return rValues, nil
+	}
+
+	boxList, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelMessageList(c.ctx, &channelpb.TLChannelGetChannelMessageList{
+		ChannelId: channel.Id(),
+		Id:        idList,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getMessages - error: %v", err)
+		return nil, err
+	}
+
+	boxList.Visit(c.MD.UserId,
+		func(messageList []*mtproto.Message) {
+			rValues.Messages = messageList
+		},
+		func(userIdList []int64) {
+			mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rValues.Users = append(rValues.Users, mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)...)
+		},
+		nil,
+		nil)
+	rValues.Count = int32(len(rValues.Messages))
+
+	return rValues, nil
+}
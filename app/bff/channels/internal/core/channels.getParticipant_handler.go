@@ -0,0 +1,64 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// ChannelsGetParticipant
+// channels.getParticipant#a0ab6cc6 channel:InputChannel participant:InputPeer = channels.ChannelParticipant;
+func (c *ChannelsCore) ChannelsGetParticipant(in *mtproto.TLChannelsGetParticipant) (*mtproto.Channels_ChannelParticipant, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.GetParticipant())
+	if !peer.IsUser() {
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	channel, err := c.getMutableChannel(in.GetChannel(), peer.PeerId)
+	if err != nil {
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	if !channel.IsChannelParticipant(c.MD.UserId) {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	participant, ok := channel.GetChannelParticipant(peer.PeerId)
+	if !ok {
+		err = mtproto.ErrUserNotParticipant
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId, peer.PeerId},
+	})
+
+	return mtproto.MakeTLChannelsChannelParticipant(&mtproto.Channels_ChannelParticipant{
+		Participant: participant.ToChannelParticipant(c.MD.UserId),
+		Chats:       []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:       mUsers.GetUserListByIdList(c.MD.UserId, peer.PeerId),
+	}).To_Channels_ChannelParticipant(), nil
+}
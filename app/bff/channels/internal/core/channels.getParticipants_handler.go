@@ -0,0 +1,103 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// ChannelsGetParticipants
+// channels.getParticipants#77ced9d0 channel:InputChannel filter:ChannelParticipantsFilter offset:int limit:int hash:long = channels.ChannelParticipants;
+func (c *ChannelsCore) ChannelsGetParticipants(in *mtproto.TLChannelsGetParticipants) (*mtproto.Channels_ChannelParticipants, error) {
+	channel, err := c.getMutableChannel(in.GetChannel())
+	if err != nil {
+		c.Logger.Errorf("channels.getParticipants - error: %v", err)
+		return nil, err
+	}
+
+	if !channel.IsChannelParticipant(c.MD.UserId) {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.getParticipants - error: %v", err)
+		return nil, err
+	}
+
+	rValues := mtproto.MakeTLChannelsChannelParticipants(&mtproto.Channels_ChannelParticipants{
+		Count:        channel.GetChannel().GetParticipantsCount(),
+		Participants: []*mtproto.ChannelParticipant{},
+		Chats:        []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:        []*mtproto.User{},
+	}).To_Channels_ChannelParticipants()
+
+	var (
+		adminsOnly bool
+	)
+
+	switch in.GetFilter().GetPredicateName() {
+	case mtproto.Predicate_channelParticipantsRecent:
+	case mtproto.Predicate_channelParticipantsAdmins:
+		adminsOnly = true
+	default:
+		// kicked, banned, bots, search, contacts and mentions are not supported yet
+		c.Logger.Errorf("channels.getParticipants - not impl filter: %s", in.GetFilter().DebugString())
+		rValues.Count = 0
+		return rValues, nil
+	}
+
+	// only admins can list the members of a broadcast channel
+	if channel.IsBroadcast() && !adminsOnly {
+		if me, _ := channel.GetChannelParticipant(c.MD.UserId); !me.IsAdmin() {
+			err = mtproto.ErrChatAdminRequired
+			c.Logger.Errorf("channels.getParticipants - error: %v", err)
+			return nil, err
+		}
+	}
+
+	participants, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelParticipants(c.ctx, &channelpb.TLChannelGetChannelParticipants{
+		ChannelId: channel.Id(),
+		Offset:    in.GetOffset(),
+		Limit:     in.GetLimit(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getParticipants - error: %v", err)
+		return nil, err
+	}
+
+	idList := make([]int64, 0, len(participants.GetDatas()))
+	for _, p := range participants.GetDatas() {
+		if adminsOnly && !p.IsAdmin() {
+			continue
+		}
+		rValues.Participants = append(rValues.Participants, p.ToChannelParticipant(c.MD.UserId))
+		idList = append(idList, p.UserId)
+	}
+	if adminsOnly {
+		rValues.Count = int32(len(rValues.Participants))
+	}
+
+	if len(idList) > 0 {
+		mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+			Id: idList,
+		})
+		rValues.Users = mUsers.GetUserListByIdList(c.MD.UserId, idList...)
+	}
+
+	return rValues, nil
+}
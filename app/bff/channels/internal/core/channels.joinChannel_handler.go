@@ -0,0 +1,80 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// ChannelsJoinChannel
+// channels.joinChannel#24b524c5 channel:InputChannel = Updates;
+func (c *ChannelsCore) ChannelsJoinChannel(in *mtproto.TLChannelsJoinChannel) (*mtproto.Updates, error) {
+	channel, err := c.getMutableChannel(in.GetChannel())
+	if err != nil {
+		c.Logger.Errorf("channels.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	channel, err = c.svcCtx.Dao.ChannelClient.ChannelJoinChannel(c.ctx, &channelpb.TLChannelJoinChannel{
+		ChannelId: channel.Id(),
+		UserId:    c.MD.UserId,
+		InviterId: 0,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	// history posted before joining is not counted as unread
+	topMessage := channel.GetChannel().GetTopMessage()
+	c.svcCtx.Dao.DialogClient.DialogInsertOrUpdateDialog(c.ctx, &dialog.TLDialogInsertOrUpdateDialog{
+		UserId:         c.MD.UserId,
+		PeerType:       mtproto.PEER_CHANNEL,
+		PeerId:         channel.Id(),
+		TopMessage:     &types.Int32Value{Value: topMessage},
+		ReadInboxMaxId: &types.Int32Value{Value: topMessage},
+		UnreadCount:    &types.Int32Value{Value: 0},
+		Date2:          &types.Int64Value{Value: time.Now().Unix()},
+	})
+
+	users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId},
+	})
+
+	rUpdates := mtproto.MakeUpdatesByUpdatesUsersChats(
+		users.GetUserListByIdList(c.MD.UserId, c.MD.UserId),
+		[]*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		mtproto.MakeUpdateChannel(channel.Id()))
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates:   rUpdates,
+	})
+
+	return rUpdates, nil
+}
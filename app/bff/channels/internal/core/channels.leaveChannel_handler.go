@@ -0,0 +1,63 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// ChannelsLeaveChannel
+// channels.leaveChannel#f836aa95 channel:InputChannel = Updates;
+func (c *ChannelsCore) ChannelsLeaveChannel(in *mtproto.TLChannelsLeaveChannel) (*mtproto.Updates, error) {
+	channel, err := c.getMutableChannel(in.GetChannel())
+	if err != nil {
+		c.Logger.Errorf("channels.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	channel, err = c.svcCtx.Dao.ChannelClient.ChannelLeaveChannel(c.ctx, &channelpb.TLChannelLeaveChannel{
+		ChannelId: channel.Id(),
+		UserId:    c.MD.UserId,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	c.svcCtx.Dao.DialogClient.DialogDeleteDialog(c.ctx, &dialog.TLDialogDeleteDialog{
+		UserId:   c.MD.UserId,
+		PeerType: mtproto.PEER_CHANNEL,
+		PeerId:   channel.Id(),
+	})
+
+	rUpdates := mtproto.MakeUpdatesByUpdatesChats(
+		[]*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		mtproto.MakeUpdateChannel(channel.Id()))
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates:   rUpdates,
+	})
+
+	return rUpdates, nil
+}
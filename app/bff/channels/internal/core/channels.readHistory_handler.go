@@ -0,0 +1,91 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// ChannelsReadHistory
+// channels.readHistory#cc104937 channel:InputChannel max_id:int = Bool;
+func (c *ChannelsCore) ChannelsReadHistory(in *mtproto.TLChannelsReadHistory) (*mtproto.Bool, error) {
+	channel, err := c.getMutableChannel(in.GetChannel())
+	if err != nil {
+		c.Logger.Errorf("channels.readHistory - error: %v", err)
+		return nil, err
+	}
+
+	if !channel.IsChannelParticipant(c.MD.UserId) {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.readHistory - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		topMessage = channel.GetChannel().GetTopMessage()
+		maxId      = in.GetMaxId()
+	)
+	if maxId <= 0 || maxId > topMessage {
+		maxId = topMessage
+	}
+
+	if me, _ := channel.GetChannelParticipant(c.MD.UserId); me.GetReadInboxMaxId() >= maxId {
+		return mtproto.BoolTrue, nil
+	}
+
+	_, err = c.svcCtx.Dao.ChannelClient.ChannelReadChannelHistory(c.ctx, &channelpb.TLChannelReadChannelHistory{
+		ChannelId: channel.Id(),
+		UserId:    c.MD.UserId,
+		MaxId:     maxId,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.readHistory - error: %v", err)
+		return nil, err
+	}
+
+	// channel message ids are allocated sequentially, so the gap to the
+	// top message is the number of messages still unread.
+	stillUnreadCount := topMessage - maxId
+	c.svcCtx.Dao.DialogClient.DialogInsertOrUpdateDialog(c.ctx, &dialog.TLDialogInsertOrUpdateDialog{
+		UserId:         c.MD.UserId,
+		PeerType:       mtproto.PEER_CHANNEL,
+		PeerId:         channel.Id(),
+		ReadInboxMaxId: &types.Int32Value{Value: maxId},
+		UnreadCount:    &types.Int32Value{Value: stillUnreadCount},
+	})
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateReadChannelInbox(&mtproto.Update{
+			FolderId:         nil,
+			ChannelId:        channel.Id(),
+			MaxId:            maxId,
+			StillUnreadCount: stillUnreadCount,
+			Pts_INT32:        channel.GetChannel().GetPts(),
+		}).To_Update()),
+	})
+
+	return mtproto.BoolTrue, nil
+}
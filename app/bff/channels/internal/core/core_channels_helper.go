@@ -0,0 +1,50 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// getMutableChannel loads the channel referenced by inputChannel together with
+// the participant records of selfId and idList, and checks the access hash.
+func (c *ChannelsCore) getMutableChannel(inputChannel *mtproto.InputChannel, idList ...int64) (*channelpb.MutableChannel, error) {
+	switch inputChannel.GetPredicateName() {
+	case mtproto.Predicate_inputChannel:
+	case mtproto.Predicate_inputChannelFromMessage:
+	default:
+		return nil, mtproto.ErrChannelInvalid
+	}
+
+	channel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: inputChannel.GetChannelId(),
+		Id:        append([]int64{c.MD.UserId}, idList...),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if inputChannel.GetPredicateName() == mtproto.Predicate_inputChannel &&
+		inputChannel.GetAccessHash() != channel.GetChannel().GetAccessHash() {
+		return nil, mtproto.ErrChannelInvalid
+	}
+
+	return channel, nil
+}
@@ -0,0 +1,498 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/channels/internal/core"
+)
+
+// ChannelsReadHistory
+// channels.readHistory#cc104937 channel:InputChannel max_id:int = Bool;
+func (s *Service) ChannelsReadHistory(ctx context.Context, request *mtproto.TLChannelsReadHistory) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.readHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsReadHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.readHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsDeleteMessages
+// channels.deleteMessages#84c1fd4e channel:InputChannel id:Vector<int> = messages.AffectedMessages;
+func (s *Service) ChannelsDeleteMessages(ctx context.Context, request *mtproto.TLChannelsDeleteMessages) (*mtproto.Messages_AffectedMessages, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.deleteMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsDeleteMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.deleteMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetMessages
+// channels.getMessages#ad8c9a23 channel:InputChannel id:Vector<InputMessage> = messages.Messages;
+func (s *Service) ChannelsGetMessages(ctx context.Context, request *mtproto.TLChannelsGetMessages) (*mtproto.Messages_Messages, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetParticipants
+// channels.getParticipants#77ced9d0 channel:InputChannel filter:ChannelParticipantsFilter offset:int limit:int hash:long = channels.ChannelParticipants;
+func (s *Service) ChannelsGetParticipants(ctx context.Context, request *mtproto.TLChannelsGetParticipants) (*mtproto.Channels_ChannelParticipants, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getParticipants - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetParticipants(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getParticipants - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetParticipant
+// channels.getParticipant#a0ab6cc6 channel:InputChannel participant:InputPeer = channels.ChannelParticipant;
+func (s *Service) ChannelsGetParticipant(ctx context.Context, request *mtproto.TLChannelsGetParticipant) (*mtproto.Channels_ChannelParticipant, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getParticipant - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetParticipant(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getParticipant - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetChannels
+// channels.getChannels#a7f6bbb id:Vector<InputChannel> = messages.Chats;
+func (s *Service) ChannelsGetChannels(ctx context.Context, request *mtproto.TLChannelsGetChannels) (*mtproto.Messages_Chats, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getChannels - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetChannels(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getChannels - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetFullChannel
+// channels.getFullChannel#8736a09 channel:InputChannel = messages.ChatFull;
+func (s *Service) ChannelsGetFullChannel(ctx context.Context, request *mtproto.TLChannelsGetFullChannel) (*mtproto.Messages_ChatFull, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getFullChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetFullChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getFullChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsCreateChannel
+// channels.createChannel#91006707 flags:# broadcast:flags.0?true megagroup:flags.1?true for_import:flags.3?true title:string about:string geo_point:flags.2?InputGeoPoint address:flags.2?string ttl_period:flags.4?int = Updates;
+func (s *Service) ChannelsCreateChannel(ctx context.Context, request *mtproto.TLChannelsCreateChannel) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.createChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsCreateChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.createChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsEditAdmin
+// channels.editAdmin#d33c8902 channel:InputChannel user_id:InputUser admin_rights:ChatAdminRights rank:string = Updates;
+func (s *Service) ChannelsEditAdmin(ctx context.Context, request *mtproto.TLChannelsEditAdmin) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.editAdmin - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsEditAdmin(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.editAdmin - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsEditTitle
+// channels.editTitle#566decd0 channel:InputChannel title:string = Updates;
+func (s *Service) ChannelsEditTitle(ctx context.Context, request *mtproto.TLChannelsEditTitle) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.editTitle - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsEditTitle(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.editTitle - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsEditPhoto
+// channels.editPhoto#f12e57c9 channel:InputChannel photo:InputChatPhoto = Updates;
+func (s *Service) ChannelsEditPhoto(ctx context.Context, request *mtproto.TLChannelsEditPhoto) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.editPhoto - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsEditPhoto(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.editPhoto - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsJoinChannel
+// channels.joinChannel#24b524c5 channel:InputChannel = Updates;
+func (s *Service) ChannelsJoinChannel(ctx context.Context, request *mtproto.TLChannelsJoinChannel) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.joinChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsJoinChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.joinChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsLeaveChannel
+// channels.leaveChannel#f836aa95 channel:InputChannel = Updates;
+func (s *Service) ChannelsLeaveChannel(ctx context.Context, request *mtproto.TLChannelsLeaveChannel) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.leaveChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsLeaveChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.leaveChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsInviteToChannel
+// channels.inviteToChannel#199f3a6c channel:InputChannel users:Vector<InputUser> = Updates;
+func (s *Service) ChannelsInviteToChannel(ctx context.Context, request *mtproto.TLChannelsInviteToChannel) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.inviteToChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsInviteToChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.inviteToChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsDeleteChannel
+// channels.deleteChannel#c0111fe3 channel:InputChannel = Updates;
+func (s *Service) ChannelsDeleteChannel(ctx context.Context, request *mtproto.TLChannelsDeleteChannel) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.deleteChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsDeleteChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.deleteChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsExportMessageLink
+// channels.exportMessageLink#e63fadeb flags:# grouped:flags.0?true thread:flags.1?true channel:InputChannel id:int = ExportedMessageLink;
+func (s *Service) ChannelsExportMessageLink(ctx context.Context, request *mtproto.TLChannelsExportMessageLink) (*mtproto.ExportedMessageLink, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.exportMessageLink - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsExportMessageLink(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.exportMessageLink - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsToggleSignatures
+// channels.toggleSignatures#1f69b606 channel:InputChannel enabled:Bool = Updates;
+func (s *Service) ChannelsToggleSignatures(ctx context.Context, request *mtproto.TLChannelsToggleSignatures) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.toggleSignatures - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsToggleSignatures(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.toggleSignatures - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetAdminedPublicChannels
+// channels.getAdminedPublicChannels#f8b036af flags:# by_location:flags.0?true check_limit:flags.1?true = messages.Chats;
+func (s *Service) ChannelsGetAdminedPublicChannels(ctx context.Context, request *mtproto.TLChannelsGetAdminedPublicChannels) (*mtproto.Messages_Chats, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getAdminedPublicChannels - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetAdminedPublicChannels(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getAdminedPublicChannels - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsEditBanned
+// channels.editBanned#96e6cd81 channel:InputChannel participant:InputPeer banned_rights:ChatBannedRights = Updates;
+func (s *Service) ChannelsEditBanned(ctx context.Context, request *mtproto.TLChannelsEditBanned) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.editBanned - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsEditBanned(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.editBanned - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetAdminLog
+// channels.getAdminLog#33ddf480 flags:# channel:InputChannel q:string events_filter:flags.0?ChannelAdminLogEventsFilter admins:flags.1?Vector<InputUser> max_id:long min_id:long limit:int = channels.AdminLogResults;
+func (s *Service) ChannelsGetAdminLog(ctx context.Context, request *mtproto.TLChannelsGetAdminLog) (*mtproto.Channels_AdminLogResults, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getAdminLog - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetAdminLog(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getAdminLog - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsSetStickers
+// channels.setStickers#ea8ca4f9 channel:InputChannel stickerset:InputStickerSet = Bool;
+func (s *Service) ChannelsSetStickers(ctx context.Context, request *mtproto.TLChannelsSetStickers) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.setStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsSetStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.setStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsReadMessageContents
+// channels.readMessageContents#eab5dc38 channel:InputChannel id:Vector<int> = Bool;
+func (s *Service) ChannelsReadMessageContents(ctx context.Context, request *mtproto.TLChannelsReadMessageContents) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.readMessageContents - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsReadMessageContents(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.readMessageContents - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsDeleteHistory9BAA9647
+// channels.deleteHistory9BAA9647#9baa9647 flags:# for_everyone:flags.0?true channel:InputChannel max_id:int = Updates;
+func (s *Service) ChannelsDeleteHistory9BAA9647(ctx context.Context, request *mtproto.TLChannelsDeleteHistory9BAA9647) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.deleteHistory9BAA9647 - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsDeleteHistory9BAA9647(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.deleteHistory9BAA9647 - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsTogglePreHistoryHidden
+// channels.togglePreHistoryHidden#eabbb94c channel:InputChannel enabled:Bool = Updates;
+func (s *Service) ChannelsTogglePreHistoryHidden(ctx context.Context, request *mtproto.TLChannelsTogglePreHistoryHidden) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.togglePreHistoryHidden - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsTogglePreHistoryHidden(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.togglePreHistoryHidden - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetGroupsForDiscussion
+// channels.getGroupsForDiscussion#f5dad378 = messages.Chats;
+func (s *Service) ChannelsGetGroupsForDiscussion(ctx context.Context, request *mtproto.TLChannelsGetGroupsForDiscussion) (*mtproto.Messages_Chats, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getGroupsForDiscussion - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetGroupsForDiscussion(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getGroupsForDiscussion - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsSetDiscussionGroup
+// channels.setDiscussionGroup#40582bb2 broadcast:InputChannel group:InputChannel = Bool;
+func (s *Service) ChannelsSetDiscussionGroup(ctx context.Context, request *mtproto.TLChannelsSetDiscussionGroup) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.setDiscussionGroup - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsSetDiscussionGroup(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.setDiscussionGroup - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsEditCreator
+// channels.editCreator#8f38cd1f channel:InputChannel user_id:InputUser password:InputCheckPasswordSRP = Updates;
+func (s *Service) ChannelsEditCreator(ctx context.Context, request *mtproto.TLChannelsEditCreator) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.editCreator - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsEditCreator(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.editCreator - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsEditLocation
+// channels.editLocation#58e63f6d channel:InputChannel geo_point:InputGeoPoint address:string = Bool;
+func (s *Service) ChannelsEditLocation(ctx context.Context, request *mtproto.TLChannelsEditLocation) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.editLocation - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsEditLocation(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.editLocation - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsToggleSlowMode
+// channels.toggleSlowMode#edd49ef0 channel:InputChannel seconds:int = Updates;
+func (s *Service) ChannelsToggleSlowMode(ctx context.Context, request *mtproto.TLChannelsToggleSlowMode) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.toggleSlowMode - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsToggleSlowMode(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.toggleSlowMode - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsGetInactiveChannels
+// channels.getInactiveChannels#11e831ee = messages.InactiveChats;
+func (s *Service) ChannelsGetInactiveChannels(ctx context.Context, request *mtproto.TLChannelsGetInactiveChannels) (*mtproto.Messages_InactiveChats, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.getInactiveChannels - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsGetInactiveChannels(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.getInactiveChannels - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsDeleteParticipantHistory
+// channels.deleteParticipantHistory#367544db channel:InputChannel participant:InputPeer = messages.AffectedHistory;
+func (s *Service) ChannelsDeleteParticipantHistory(ctx context.Context, request *mtproto.TLChannelsDeleteParticipantHistory) (*mtproto.Messages_AffectedHistory, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.deleteParticipantHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsDeleteParticipantHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.deleteParticipantHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelsDeleteHistoryAF369D42
+// channels.deleteHistoryAF369D42#af369d42 channel:InputChannel max_id:int = Bool;
+func (s *Service) ChannelsDeleteHistoryAF369D42(ctx context.Context, request *mtproto.TLChannelsDeleteHistoryAF369D42) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channels.deleteHistoryAF369D42 - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelsDeleteHistoryAF369D42(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channels.deleteHistoryAF369D42 - reply: %s", r.DebugString())
+	return r, err
+}
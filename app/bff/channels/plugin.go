@@ -0,0 +1,150 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package channels_helper
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/channels/internal/dao"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// Plugin implements the channel hooks of the dialogs, drafts, messages,
+// notification, contacts and usernames bff services on top of the channel
+// biz service.
+type Plugin struct {
+	*dao.Dao
+}
+
+func NewPlugin(c Config) *Plugin {
+	return &Plugin{
+		Dao: dao.New(c),
+	}
+}
+
+func (p *Plugin) GetChannelListByIdList(ctx context.Context, selfId int64, id ...int64) []*mtproto.Chat {
+	if len(id) == 0 {
+		return []*mtproto.Chat{}
+	}
+
+	channels, _ := p.ChannelClient.ChannelGetChannelListByIdList(ctx, &channelpb.TLChannelGetChannelListByIdList{
+		SelfId: selfId,
+		Id:     id,
+	})
+
+	return channels.GetChatListByIdList(selfId, id...)
+}
+
+func (p *Plugin) GetChannelById(ctx context.Context, selfId int64, id int64) (*mtproto.Chat, error) {
+	channel, err := p.ChannelClient.ChannelGetMutableChannel(ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: id,
+		Id:        []int64{selfId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channel.ToUnsafeChat(selfId), nil
+}
+
+func (p *Plugin) GetChannelDialogById(ctx context.Context, selfId int64, id int64) (*dialog.DialogExt, error) {
+	channel, err := p.ChannelClient.ChannelGetMutableChannel(ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: id,
+		Id:        []int64{selfId},
+	})
+	if err != nil {
+		return nil, err
+	} else if !channel.IsChannelParticipant(selfId) {
+		return nil, mtproto.ErrChannelPrivate
+	}
+
+	dlgExt, err := p.DialogClient.DialogGetDialogById(ctx, &dialog.TLDialogGetDialogById{
+		UserId:   selfId,
+		PeerType: mtproto.PEER_CHANNEL,
+		PeerId:   id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	dlgExt.GetDialog().Pts = mtproto.MakeFlagsInt32(channel.GetChannel().GetPts())
+
+	return dlgExt, nil
+}
+
+func (p *Plugin) GetChannelMessage(ctx context.Context, selfId, channelId int64, id int32) (*mtproto.MessageBox, error) {
+	boxList, err := p.ChannelClient.ChannelGetChannelMessageList(ctx, &channelpb.TLChannelGetChannelMessageList{
+		ChannelId: channelId,
+		Id:        []int32{id},
+	})
+	if err != nil {
+		return nil, err
+	} else if boxList.Length() == 0 {
+		return nil, mtproto.ErrMessageIdInvalid
+	}
+
+	return boxList.GetDatas()[0], nil
+}
+
+// GetWebpagePreview
+// Link previews are not fetched by the open source build.
+func (p *Plugin) GetWebpagePreview(ctx context.Context, url string) (*mtproto.WebPage, error) {
+	return nil, nil
+}
+
+func (p *Plugin) GetMessageMedia(ctx context.Context, ownerId int64, media *mtproto.InputMedia) (*mtproto.MessageMedia, error) {
+	switch media.GetPredicateName() {
+	case mtproto.Predicate_inputMediaEmpty:
+		return mtproto.MakeTLMessageMediaEmpty(nil).To_MessageMedia(), nil
+	case mtproto.Predicate_inputMediaUploadedPhoto:
+		photo, err := p.MediaClient.MediaUploadPhotoFile(ctx, &mediapb.TLMediaUploadPhotoFile{
+			OwnerId: ownerId,
+			File:    media.GetFile(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return mtproto.MakeTLMessageMediaPhoto(&mtproto.MessageMedia{
+			Photo_FLAGPHOTO: photo,
+			TtlSeconds:      media.GetTtlSeconds(),
+		}).To_MessageMedia(), nil
+	case mtproto.Predicate_inputMediaUploadedDocument:
+		return p.MediaClient.MediaUploadedDocumentMedia(ctx, &mediapb.TLMediaUploadedDocumentMedia{
+			OwnerId: ownerId,
+			Media:   media,
+		})
+	case mtproto.Predicate_inputMediaDocument:
+		document, err := p.MediaClient.MediaGetDocument(ctx, &mediapb.TLMediaGetDocument{
+			Id: media.GetId_INPUTDOCUMENT().GetId(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return mtproto.MakeTLMessageMediaDocument(&mtproto.MessageMedia{
+			Document:   document,
+			TtlSeconds: media.GetTtlSeconds(),
+		}).To_MessageMedia(), nil
+	default:
+		return nil, mtproto.ErrMediaInvalid
+	}
+}
@@ -132,6 +132,21 @@ func (c *ChatsCore) MessagesCreateChat(in *mtproto.TLMessagesCreateChat) (*mtpro
 		return nil, err
 	}
 
+	// a new chat starts with the creator's default auto-delete timer
+	if ttl, _ := c.svcCtx.Dao.UserClient.UserGetDefaultHistoryTTL(c.ctx, &userpb.TLUserGetDefaultHistoryTTL{
+		UserId: c.MD.UserId,
+	}); ttl.GetPeriod() > 0 {
+		chat, err = c.svcCtx.Dao.ChatClient.Client().ChatSetHistoryTTL(c.ctx, &chatpb.TLChatSetHistoryTTL{
+			SelfId:    c.MD.UserId,
+			ChatId:    chat.Id(),
+			TtlPeriod: ttl.GetPeriod(),
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.createChat - error: %v", err)
+			return nil, err
+		}
+	}
+
 	// TODO: add attach_data (chat and chat_participants)
 	rValue, err := c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
 		UserId:    c.MD.UserId,
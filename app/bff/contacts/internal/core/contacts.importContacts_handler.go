@@ -20,18 +20,85 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/pkg/phonenumber"
 )
 
+// maxImportContacts bounds one contacts.importContacts, the rest are returned in retry_contacts.
+const maxImportContacts = 500
+
 // ContactsImportContacts
 // contacts.importContacts#2c800be5 contacts:Vector<InputContact> = contacts.ImportedContacts;
 func (c *ContactsCore) ContactsImportContacts(in *mtproto.TLContactsImportContacts) (*mtproto.Contacts_ImportedContacts, error) {
-	// TODO: not impl
-	c.Logger.Errorf("contacts.importContacts blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	var (
+		contacts     = make([]*mtproto.InputContact, 0, len(in.Contacts))
+		phoneList    = make(map[int64]string, len(in.Contacts))
+		clientIdList = make(map[string][]int64, len(in.Contacts))
+		retryList    = make([]int64, 0)
+	)
+
+	for i, c2 := range in.Contacts {
+		if i >= maxImportContacts {
+			retryList = append(retryList, c2.ClientId)
+			continue
+		}
+
+		phone, err := phonenumber.CheckAndGetPhoneNumber(c2.Phone)
+		if err != nil {
+			c.Logger.Infof("contacts.importContacts - ignore contact(%v): %v", c2, err)
+			continue
+		}
+
+		// the same phone may be imported under several client_id
+		if _, ok := clientIdList[phone]; !ok {
+			contacts = append(contacts, mtproto.MakeTLInputPhoneContact(&mtproto.InputContact{
+				ClientId:  c2.ClientId,
+				Phone:     phone,
+				FirstName: c2.FirstName,
+				LastName:  c2.LastName,
+			}).To_InputContact())
+			phoneList[c2.ClientId] = phone
+		}
+		clientIdList[phone] = append(clientIdList[phone], c2.ClientId)
+	}
 
-	return mtproto.MakeTLContactsImportedContacts(&mtproto.Contacts_ImportedContacts{
+	rImportedContacts := mtproto.MakeTLContactsImportedContacts(&mtproto.Contacts_ImportedContacts{
 		Imported:       []*mtproto.ImportedContact{},
 		PopularInvites: []*mtproto.PopularContact{},
-		RetryContacts:  []int64{},
+		RetryContacts:  retryList,
 		Users:          []*mtproto.User{},
-	}).To_Contacts_ImportedContacts(), nil
+	}).To_Contacts_ImportedContacts()
+
+	if len(contacts) == 0 {
+		return rImportedContacts, nil
+	}
+
+	imported, err := c.svcCtx.Dao.UserClient.UserImportContacts(c.ctx, &userpb.TLUserImportContacts{
+		UserId:   c.MD.UserId,
+		Contacts: contacts,
+	})
+	if err != nil {
+		c.Logger.Errorf("contacts.importContacts - error: %v", err)
+		return nil, err
+	}
+
+	for _, v := range imported.GetImported() {
+		for _, clientId := range clientIdList[phoneList[v.ClientId]] {
+			rImportedContacts.Imported = append(rImportedContacts.Imported, mtproto.MakeTLImportedContact(&mtproto.ImportedContact{
+				UserId:   v.UserId,
+				ClientId: clientId,
+			}).To_ImportedContact())
+		}
+	}
+	for _, v := range imported.GetPopularInvites() {
+		for _, clientId := range clientIdList[phoneList[v.ClientId]] {
+			rImportedContacts.PopularInvites = append(rImportedContacts.PopularInvites, mtproto.MakeTLPopularContact(&mtproto.PopularContact{
+				ClientId:  clientId,
+				Importers: v.Importers,
+			}).To_PopularContact())
+		}
+	}
+	rImportedContacts.Users = imported.GetUsers()
+
+	return rImportedContacts, nil
 }
@@ -19,13 +19,13 @@
 package dao
 
 import (
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/teamgram-server/app/bff/contacts/internal/config"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	username_client "github.com/teamgram/teamgram-server/app/service/biz/username/client"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 )
 
 type Dao struct {
@@ -40,6 +40,6 @@ func New(c config.Config) *Dao {
 		UserClient:     user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
 		ChatClient:     chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
 		UsernameClient: username_client.NewUsernameClient(rpcx.GetCachedRpcClient(c.UsernameClient)),
-		SyncClient:     sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+		SyncClient:     sync_client.NewSyncMqClient(mqx.MustProducer(c.SyncClient)),
 	}
 }
@@ -31,5 +31,6 @@ type Config struct {
 	UpdatesClient zrpc.RpcClientConf
 	SyncClient    *kafka.KafkaProducerConf
 	MessageClient zrpc.RpcClientConf
+	MsgClient     zrpc.RpcClientConf
 	// ChannelClient zrpc.RpcClientConf
 }
@@ -0,0 +1,67 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// setChannelDialogPts fills the channel pts of a channel dialog.
+func (c *DialogsCore) setChannelDialogPts(dialogEx *dialog.DialogExt) {
+	if c.svcCtx.Plugin == nil {
+		c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return
+	}
+
+	channelDialog, err := c.svcCtx.Plugin.GetChannelDialogById(c.ctx, c.MD.UserId, dialogEx.GetDialog().GetPeer().GetChannelId())
+	if err != nil {
+		c.Logger.Errorf("getChannelDialogById - error: %v", err)
+		return
+	}
+	dialogEx.Dialog.Pts = channelDialog.GetDialog().GetPts()
+}
+
+func (c *DialogsCore) getChannelTopMessageList(id ...dialog.TopMessageId) []*mtproto.Message {
+	msgList := make([]*mtproto.Message, 0, len(id))
+	if c.svcCtx.Plugin == nil {
+		c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return msgList
+	}
+
+	for _, id2 := range id {
+		box, err := c.svcCtx.Plugin.GetChannelMessage(c.ctx, c.MD.UserId, id2.Peer.PeerId, id2.TopMessage)
+		if err != nil {
+			c.Logger.Errorf("getChannelMessage - error: %v", err)
+			continue
+		}
+		msgList = append(msgList, box.ToMessage(c.MD.UserId))
+	}
+
+	return msgList
+}
+
+func (c *DialogsCore) getChannelListByIdList(id ...int64) []*mtproto.Chat {
+	if c.svcCtx.Plugin == nil {
+		c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return []*mtproto.Chat{}
+	}
+
+	return c.svcCtx.Plugin.GetChannelListByIdList(c.ctx, c.MD.UserId, id...)
+}
@@ -0,0 +1,244 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+const (
+	// folder ids 0 (main list) and 1 (archive) are reserved,
+	// dialog filter ids start from 2.
+	minDialogFilterId = 2
+
+	// pinned peers of a dialog filter are ordered before all other dialogs.
+	dialogFilterPinnedOrder int64 = 1 << 62
+)
+
+// dialogFilterPeers holds what a dialog filter needs to know about the peers
+// of a dialog list besides the dialogs themselves.
+type dialogFilterPeers struct {
+	selfId     int64
+	contacts   map[int64]bool
+	bots       map[int64]bool
+	broadcasts map[int64]bool
+	now        int32
+}
+
+// isDialogFilterPeer returns the index of peer in peers, or -1.
+func isDialogFilterPeer(selfId int64, peers []*mtproto.InputPeer, peer *mtproto.PeerUtil) int {
+	for i, v := range peers {
+		if v == nil {
+			continue
+		}
+		p := mtproto.FromInputPeer2(selfId, v)
+		if p.PeerId != peer.PeerId {
+			continue
+		}
+		if (p.IsUser() && peer.IsUser()) || p.PeerType == peer.PeerType {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// match reports whether a dialog belongs to the dialog filter.
+//
+// exclude_peers always win over everything else, pinned_peers and include_peers
+// are always shown, and the remaining dialogs must match one of the peer
+// categories and pass the exclude_muted, exclude_read and exclude_archived flags.
+func (m *dialogFilterPeers) match(filter *mtproto.DialogFilter, dlg *mtproto.Dialog) bool {
+	peer := mtproto.FromPeer(dlg.GetPeer())
+
+	if isDialogFilterPeer(m.selfId, filter.GetExcludePeers(), peer) >= 0 {
+		return false
+	}
+	if isDialogFilterPeer(m.selfId, filter.GetPinnedPeers(), peer) >= 0 ||
+		isDialogFilterPeer(m.selfId, filter.GetIncludePeers(), peer) >= 0 {
+		return true
+	}
+
+	var (
+		matched bool
+	)
+	switch {
+	case peer.IsUser():
+		if m.bots[peer.PeerId] {
+			matched = filter.GetBots()
+		} else if peer.PeerId == m.selfId || m.contacts[peer.PeerId] {
+			matched = filter.GetContacts()
+		} else {
+			matched = filter.GetNonContacts()
+		}
+	case peer.IsChat():
+		matched = filter.GetGroups()
+	case peer.IsChannel():
+		if m.broadcasts[peer.PeerId] {
+			matched = filter.GetBroadcasts()
+		} else {
+			matched = filter.GetGroups()
+		}
+	}
+	if !matched {
+		return false
+	}
+
+	if filter.GetExcludeArchived() && dlg.GetFolderId().GetValue() == 1 {
+		return false
+	}
+	if filter.GetExcludeMuted() && dlg.GetNotifySettings().GetMuteUntil().GetValue() > m.now {
+		return false
+	}
+	if filter.GetExcludeRead() && dlg.GetUnreadCount() == 0 && !dlg.GetUnreadMark() {
+		return false
+	}
+
+	return true
+}
+
+// getDialogFilter returns the dialog filter with the given id, or nil if
+// the user has no such filter.
+func (c *DialogsCore) getDialogFilter(id int32) (*mtproto.DialogFilter, error) {
+	filters, err := c.svcCtx.Dao.DialogClient.DialogGetDialogFilters(c.ctx, &dialog.TLDialogGetDialogFilters{
+		UserId: c.MD.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range filters.GetDatas() {
+		if v.GetId() == id {
+			return v.GetDialogFilter(), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getDialogFilterDialogList loads the dialogs a dialog filter picks from: the
+// main list and, unless exclude_archived is set, the archive.
+func (c *DialogsCore) getDialogFilterDialogList(filter *mtproto.DialogFilter) dialog.DialogExtList {
+	var (
+		folderIdList  = []int32{0, 1}
+		dialogExtList dialog.DialogExtList
+	)
+
+	if filter.GetExcludeArchived() {
+		folderIdList = folderIdList[:1]
+	}
+
+	for _, folderId := range folderIdList {
+		dialogs, err := c.svcCtx.Dao.DialogClient.DialogGetDialogs(c.ctx, &dialog.TLDialogGetDialogs{
+			UserId:        c.MD.UserId,
+			ExcludePinned: mtproto.BoolFalse,
+			FolderId:      folderId,
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.getDialogs - error: %v", err)
+			continue
+		}
+		dialogExtList = append(dialogExtList, dialogs.GetDatas()...)
+	}
+
+	return dialogExtList
+}
+
+// filterDialogList keeps the dialogs matching the dialog filter. The notify
+// settings of the dialogs must already be filled in.
+func (c *DialogsCore) filterDialogList(filter *mtproto.DialogFilter, dialogExtList dialog.DialogExtList, excludePinned bool) dialog.DialogExtList {
+	if len(dialogExtList) == 0 {
+		return dialogExtList
+	}
+
+	var (
+		peers = &dialogFilterPeers{
+			selfId:     c.MD.UserId,
+			contacts:   make(map[int64]bool),
+			bots:       make(map[int64]bool),
+			broadcasts: make(map[int64]bool),
+			now:        int32(time.Now().Unix()),
+		}
+		userIdList    = []int64{c.MD.UserId}
+		channelIdList []int64
+	)
+
+	for _, dialogEx := range dialogExtList {
+		peer := mtproto.FromPeer(dialogEx.GetDialog().GetPeer())
+		switch {
+		case peer.IsUser():
+			userIdList = append(userIdList, peer.PeerId)
+		case peer.IsChannel():
+			channelIdList = append(channelIdList, peer.PeerId)
+		}
+	}
+
+	users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: userIdList,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getDialogs - error: %v", err)
+	} else if me, ok := users.GetImmutableUser(c.MD.UserId); ok {
+		for _, id := range userIdList[1:] {
+			if u, ok := users.GetImmutableUser(id); ok && u.IsBot() {
+				peers.bots[id] = true
+			}
+			if contact, _ := me.CheckContact(id); contact {
+				peers.contacts[id] = true
+			}
+		}
+	}
+
+	if len(channelIdList) > 0 {
+		for _, ch := range c.getChannelListByIdList(channelIdList...) {
+			if ch.GetBroadcast() {
+				peers.broadcasts[ch.GetId()] = true
+			}
+		}
+	}
+
+	var (
+		filterDialogList = make(dialog.DialogExtList, 0, len(dialogExtList))
+	)
+	for _, dialogEx := range dialogExtList {
+		if !peers.match(filter, dialogEx.GetDialog()) {
+			continue
+		}
+
+		// pinned state inside a filter comes from its pinned_peers,
+		// not from the main list or the archive.
+		idx := isDialogFilterPeer(c.MD.UserId, filter.GetPinnedPeers(), mtproto.FromPeer(dialogEx.GetDialog().GetPeer()))
+		dialogEx.Dialog.Pinned = idx >= 0
+		if dialogEx.Dialog.Pinned {
+			if excludePinned {
+				continue
+			}
+			dialogEx.Order = dialogFilterPinnedOrder - int64(idx)
+		} else {
+			dialogEx.Order = dialogEx.GetDate()
+		}
+		filterDialogList = append(filterDialogList, dialogEx)
+	}
+
+	return filterDialogList
+}
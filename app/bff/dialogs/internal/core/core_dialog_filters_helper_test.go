@@ -0,0 +1,95 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func makeFilterTestDialog(peer *mtproto.Peer, folderId, unreadCount, muteUntil int32) *mtproto.Dialog {
+	return mtproto.MakeTLDialog(&mtproto.Dialog{
+		Peer:        peer,
+		UnreadCount: unreadCount,
+		FolderId:    mtproto.MakeFlagsInt32(folderId),
+		NotifySettings: mtproto.MakeTLPeerNotifySettings(&mtproto.PeerNotifySettings{
+			MuteUntil: mtproto.MakeFlagsInt32(muteUntil),
+		}).To_PeerNotifySettings(),
+	}).To_Dialog()
+}
+
+func TestDialogFilterPeersMatch(t *testing.T) {
+	peers := &dialogFilterPeers{
+		selfId:     1,
+		contacts:   map[int64]bool{2: true},
+		bots:       map[int64]bool{4: true},
+		broadcasts: map[int64]bool{20: true},
+		now:        1000,
+	}
+
+	var (
+		contact    = makeFilterTestDialog(mtproto.MakePeerUser(2), 0, 1, 0)
+		nonContact = makeFilterTestDialog(mtproto.MakePeerUser(3), 0, 1, 0)
+		bot        = makeFilterTestDialog(mtproto.MakePeerUser(4), 0, 1, 0)
+		chat       = makeFilterTestDialog(mtproto.MakePeerChat(10), 0, 1, 0)
+		broadcast  = makeFilterTestDialog(mtproto.MakePeerChannel(20), 0, 1, 0)
+		megagroup  = makeFilterTestDialog(mtproto.MakePeerChannel(21), 0, 1, 0)
+		muted      = makeFilterTestDialog(mtproto.MakePeerUser(2), 0, 1, 2000)
+		read       = makeFilterTestDialog(mtproto.MakePeerUser(2), 0, 0, 0)
+		archived   = makeFilterTestDialog(mtproto.MakePeerUser(2), 1, 1, 0)
+	)
+
+	tests := []struct {
+		name   string
+		filter *mtproto.DialogFilter
+		dialog *mtproto.Dialog
+		want   bool
+	}{
+		{"contacts", &mtproto.DialogFilter{Contacts: true}, contact, true},
+		{"contacts excludes non contacts", &mtproto.DialogFilter{Contacts: true}, nonContact, false},
+		{"non contacts", &mtproto.DialogFilter{NonContacts: true}, nonContact, true},
+		{"bots are not contacts", &mtproto.DialogFilter{Contacts: true, NonContacts: true}, bot, false},
+		{"bots", &mtproto.DialogFilter{Bots: true}, bot, true},
+		{"groups include chats", &mtproto.DialogFilter{Groups: true}, chat, true},
+		{"groups include megagroups", &mtproto.DialogFilter{Groups: true}, megagroup, true},
+		{"groups exclude broadcasts", &mtproto.DialogFilter{Groups: true}, broadcast, false},
+		{"broadcasts", &mtproto.DialogFilter{Broadcasts: true}, broadcast, true},
+		{"exclude muted", &mtproto.DialogFilter{Contacts: true, ExcludeMuted: true}, muted, false},
+		{"exclude read", &mtproto.DialogFilter{Contacts: true, ExcludeRead: true}, read, false},
+		{"exclude archived", &mtproto.DialogFilter{Contacts: true, ExcludeArchived: true}, archived, false},
+		{"include peers", &mtproto.DialogFilter{
+			IncludePeers: []*mtproto.InputPeer{mtproto.MakeTLInputPeerChat(&mtproto.InputPeer{ChatId: 10}).To_InputPeer()},
+		}, chat, true},
+		{"include peers skip exclude flags", &mtproto.DialogFilter{
+			ExcludeMuted: true,
+			IncludePeers: []*mtproto.InputPeer{mtproto.MakeTLInputPeerUser(&mtproto.InputPeer{UserId: 2}).To_InputPeer()},
+		}, muted, true},
+		{"exclude peers", &mtproto.DialogFilter{
+			Contacts:     true,
+			ExcludePeers: []*mtproto.InputPeer{mtproto.MakeTLInputPeerUser(&mtproto.InputPeer{UserId: 2}).To_InputPeer()},
+		}, contact, false},
+	}
+
+	for _, tt := range tests {
+		if got := peers.match(tt.filter, tt.dialog); got != tt.want {
+			t.Errorf("%s: match() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
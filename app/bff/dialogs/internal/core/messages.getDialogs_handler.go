@@ -40,14 +40,33 @@ func (c *DialogsCore) MessagesGetDialogs(in *mtproto.TLMessagesGetDialogs) (*mtp
 		limit              = in.Limit
 		dialogExtList      dialog.DialogExtList
 		notifySettingsList []*userpb.PeerPeerNotifySettings
+		dialogFilter       *mtproto.DialogFilter
 	)
 
 	if limit > 500 {
 		limit = 500
 	}
 
+	// folder_id >= 2 selects a dialog filter (chat folder)
+	if folderId >= minDialogFilterId {
+		filter, err := c.getDialogFilter(folderId)
+		if err != nil {
+			c.Logger.Errorf("messages.getDialogs - error: %v", err)
+			return nil, err
+		} else if filter == nil {
+			err = mtproto.ErrFolderIdInvalid
+			c.Logger.Errorf("messages.getDialogs - error: %v", err)
+			return nil, err
+		}
+		dialogFilter = filter
+	}
+
 	mr.FinishVoid(
 		func() {
+			if dialogFilter != nil {
+				dialogExtList = c.getDialogFilterDialogList(dialogFilter)
+				return
+			}
 			dialogs, err := c.svcCtx.Dao.DialogClient.DialogGetDialogs(c.ctx, &dialog.TLDialogGetDialogs{
 				UserId:        c.MD.UserId,
 				ExcludePinned: mtproto.ToBool(in.ExcludePinned),
@@ -86,14 +105,14 @@ func (c *DialogsCore) MessagesGetDialogs(in *mtproto.TLMessagesGetDialogs) (*mtp
 
 	for _, dialogEx := range dialogExtList {
 		peer2 := mtproto.FromPeer(dialogEx.GetDialog().GetPeer())
-
-		if peer2.IsChannel() {
-			c.Logger.Errorf("messages.getDialogs blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		}
-
 		dialogEx.Dialog.NotifySettings = userpb.FindPeerPeerNotifySettings(notifySettingsList, peer2)
 	}
 
+	if dialogFilter != nil {
+		dialogExtList = c.filterDialogList(dialogFilter, dialogExtList, in.ExcludePinned)
+		dialogCount = int32(dialogExtList.Len())
+	}
+
 	sort.Sort(sort.Reverse(dialogExtList))
 
 	dialogExtList = dialogExtList.GetDialogsByOffsetLimit(
@@ -102,19 +121,26 @@ func (c *DialogsCore) MessagesGetDialogs(in *mtproto.TLMessagesGetDialogs) (*mtp
 		offsetPeer,
 		in.Limit)
 
+	for _, dialogEx := range dialogExtList {
+		if mtproto.FromPeer(dialogEx.GetDialog().GetPeer()).IsChannel() {
+			c.setChannelDialogPts(dialogEx)
+		}
+	}
+
 	messageDialogs := dialogExtList.DoGetMessagesDialogs(
 		c.ctx,
 		c.MD.UserId,
 		func(ctx context.Context, selfUserId int64, id ...dialog.TopMessageId) []*mtproto.Message {
 			var (
-				msgList   = make([]*mtproto.Message, 0, len(id))
-				msgIdList = make([]int32, 0, len(id))
+				msgList          = make([]*mtproto.Message, 0, len(id))
+				msgIdList        = make([]int32, 0, len(id))
+				channelTopIdList = make([]dialog.TopMessageId, 0)
 			)
 			for _, id2 := range id {
-				if !id2.Peer.IsChannel() {
-					msgIdList = append(msgIdList, id2.TopMessage)
+				if id2.Peer.IsChannel() {
+					channelTopIdList = append(channelTopIdList, id2)
 				} else {
-					c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+					msgIdList = append(msgIdList, id2.TopMessage)
 				}
 			}
 			if len(msgIdList) > 0 {
@@ -126,6 +152,9 @@ func (c *DialogsCore) MessagesGetDialogs(in *mtproto.TLMessagesGetDialogs) (*mtp
 					msgList = append(msgList, v.ToMessage(c.MD.UserId))
 				})
 			}
+			if len(channelTopIdList) > 0 {
+				msgList = append(msgList, c.getChannelTopMessageList(channelTopIdList...)...)
+			}
 
 			return msgList
 		},
@@ -146,8 +175,7 @@ func (c *DialogsCore) MessagesGetDialogs(in *mtproto.TLMessagesGetDialogs) (*mtp
 			return chats.GetChatListByIdList(c.MD.UserId, id...)
 		},
 		func(ctx context.Context, selfUserId int64, id ...int64) []*mtproto.Chat {
-			c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
-			return []*mtproto.Chat{}
+			return c.getChannelListByIdList(id...)
 		})
 
 	return messageDialogs.ToMessagesDialogs(dialogCount), nil
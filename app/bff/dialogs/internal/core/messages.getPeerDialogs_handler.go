@@ -91,8 +91,10 @@ func (c *DialogsCore) MessagesGetPeerDialogs(in *mtproto.TLMessagesGetPeerDialog
 			case mtproto.PEER_USER:
 			case mtproto.PEER_CHAT:
 			case mtproto.PEER_CHANNEL:
-				c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
-				continue
+				if c.svcCtx.Plugin == nil {
+					c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+					continue
+				}
 			default:
 				err := mtproto.ErrInputConstructorInvalid
 				c.Logger.Errorf("messages.getPeerDialogs - getPeerDialogs error: %v", err)
@@ -188,7 +190,7 @@ func (c *DialogsCore) MessagesGetPeerDialogs(in *mtproto.TLMessagesGetPeerDialog
 		peer2 := mtproto.FromPeer(dialogEx.GetDialog().GetPeer())
 		dialogEx.Dialog.NotifySettings = userpb.FindPeerPeerNotifySettings(notifySettingsList, peer2)
 		if peer2.IsChannel() {
-			c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+			c.setChannelDialogPts(dialogEx)
 		}
 	}
 
@@ -197,12 +199,13 @@ func (c *DialogsCore) MessagesGetPeerDialogs(in *mtproto.TLMessagesGetPeerDialog
 		c.MD.UserId,
 		func(ctx context.Context, selfUserId int64, id ...dialog.TopMessageId) []*mtproto.Message {
 			var (
-				msgList   = make([]*mtproto.Message, 0, len(id))
-				msgIdList = make([]int32, 0, len(id))
+				msgList          = make([]*mtproto.Message, 0, len(id))
+				msgIdList        = make([]int32, 0, len(id))
+				channelTopIdList = make([]dialog.TopMessageId, 0)
 			)
 			for _, id2 := range id {
 				if id2.Peer.IsChannel() {
-					c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+					channelTopIdList = append(channelTopIdList, id2)
 				} else {
 					msgIdList = append(msgIdList, id2.TopMessage)
 				}
@@ -216,6 +219,9 @@ func (c *DialogsCore) MessagesGetPeerDialogs(in *mtproto.TLMessagesGetPeerDialog
 					msgList = append(msgList, v.ToMessage(c.MD.UserId))
 				})
 			}
+			if len(channelTopIdList) > 0 {
+				msgList = append(msgList, c.getChannelTopMessageList(channelTopIdList...)...)
+			}
 
 			return msgList
 		},
@@ -236,8 +242,7 @@ func (c *DialogsCore) MessagesGetPeerDialogs(in *mtproto.TLMessagesGetPeerDialog
 			return chats.GetChatListByIdList(c.MD.UserId, id...)
 		},
 		func(ctx context.Context, selfUserId int64, id ...int64) []*mtproto.Chat {
-			c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
-			return []*mtproto.Chat{}
+			return c.getChannelListByIdList(id...)
 		})
 
 	return messageDialogs.ToMessagesPeerDialogs(state), nil
@@ -101,9 +101,6 @@ func (c *DialogsCore) MessagesGetPinnedDialogs(in *mtproto.TLMessagesGetPinnedDi
 	for _, dialogEx := range dialogExtList {
 		peer2 := mtproto.FromPeer(dialogEx.GetDialog().GetPeer())
 		peers = append(peers, peer2)
-		if peer2.IsChannel() {
-			c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		}
 	}
 
 	if len(peers) > 0 {
@@ -123,7 +120,7 @@ func (c *DialogsCore) MessagesGetPinnedDialogs(in *mtproto.TLMessagesGetPinnedDi
 		peer2 := mtproto.FromPeer(dialogEx.GetDialog().GetPeer())
 		dialogEx.Dialog.NotifySettings = userpb.FindPeerPeerNotifySettings(notifySettingsList, peer2)
 		if peer2.IsChannel() {
-			c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+			c.setChannelDialogPts(dialogEx)
 		}
 	}
 
@@ -134,12 +131,13 @@ func (c *DialogsCore) MessagesGetPinnedDialogs(in *mtproto.TLMessagesGetPinnedDi
 		c.MD.UserId,
 		func(ctx context.Context, selfUserId int64, id ...dialog.TopMessageId) []*mtproto.Message {
 			var (
-				msgList   = make([]*mtproto.Message, 0, len(id))
-				msgIdList = make([]int32, 0, len(id))
+				msgList          = make([]*mtproto.Message, 0, len(id))
+				msgIdList        = make([]int32, 0, len(id))
+				channelTopIdList = make([]dialog.TopMessageId, 0)
 			)
 			for _, id2 := range id {
 				if id2.Peer.IsChannel() {
-					c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
+					channelTopIdList = append(channelTopIdList, id2)
 				} else {
 					msgIdList = append(msgIdList, id2.TopMessage)
 				}
@@ -153,6 +151,9 @@ func (c *DialogsCore) MessagesGetPinnedDialogs(in *mtproto.TLMessagesGetPinnedDi
 					msgList = append(msgList, v.ToMessage(c.MD.UserId))
 				})
 			}
+			if len(channelTopIdList) > 0 {
+				msgList = append(msgList, c.getChannelTopMessageList(channelTopIdList...)...)
+			}
 
 			return msgList
 		},
@@ -173,8 +174,7 @@ func (c *DialogsCore) MessagesGetPinnedDialogs(in *mtproto.TLMessagesGetPinnedDi
 			return chats.GetChatListByIdList(c.MD.UserId, id...)
 		},
 		func(ctx context.Context, selfUserId int64, id ...int64) []*mtproto.Chat {
-			c.Logger.Errorf("blocked, License key from https://teamgram.net required to unlock enterprise features.")
-			return []*mtproto.Chat{}
+			return c.getChannelListByIdList(id...)
 		})
 
 	return messageDialogs.ToMessagesPeerDialogs(state), nil
@@ -0,0 +1,92 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// suggestedDialogFilters are offered to users who don't have a filter with the same title yet.
+var suggestedDialogFilters = []*mtproto.DialogFilterSuggested{
+	mtproto.MakeTLDialogFilterSuggested(&mtproto.DialogFilterSuggested{
+		Filter: mtproto.MakeTLDialogFilter(&mtproto.DialogFilter{
+			Contacts:    true,
+			NonContacts: true,
+			Groups:      true,
+			Broadcasts:  true,
+			Bots:        true,
+			ExcludeRead: true,
+			Title:       "Unread",
+		}).To_DialogFilter(),
+		Description: "New messages from all chats.",
+	}).To_DialogFilterSuggested(),
+	mtproto.MakeTLDialogFilterSuggested(&mtproto.DialogFilterSuggested{
+		Filter: mtproto.MakeTLDialogFilter(&mtproto.DialogFilter{
+			Contacts:    true,
+			NonContacts: true,
+			Title:       "Personal",
+		}).To_DialogFilter(),
+		Description: "Only messages from personal chats.",
+	}).To_DialogFilterSuggested(),
+	mtproto.MakeTLDialogFilterSuggested(&mtproto.DialogFilterSuggested{
+		Filter: mtproto.MakeTLDialogFilter(&mtproto.DialogFilter{
+			Groups: true,
+			Title:  "Groups",
+		}).To_DialogFilter(),
+		Description: "Only messages from groups.",
+	}).To_DialogFilterSuggested(),
+	mtproto.MakeTLDialogFilterSuggested(&mtproto.DialogFilterSuggested{
+		Filter: mtproto.MakeTLDialogFilter(&mtproto.DialogFilter{
+			Broadcasts: true,
+			Title:      "Channels",
+		}).To_DialogFilter(),
+		Description: "Only messages from channels.",
+	}).To_DialogFilterSuggested(),
+}
+
+// MessagesGetSuggestedDialogFilters
+// messages.getSuggestedDialogFilters#a29cd42c = Vector<DialogFilterSuggested>;
+func (c *DialogsCore) MessagesGetSuggestedDialogFilters(in *mtproto.TLMessagesGetSuggestedDialogFilters) (*mtproto.Vector_DialogFilterSuggested, error) {
+	filters, err := c.svcCtx.Dao.DialogClient.DialogGetDialogFilters(c.ctx, &dialog.TLDialogGetDialogFilters{
+		UserId: c.MD.UserId,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getSuggestedDialogFilters - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		titles = make(map[string]bool, len(filters.GetDatas()))
+	)
+	for _, v := range filters.GetDatas() {
+		titles[v.GetDialogFilter().GetTitle()] = true
+	}
+
+	rValues := &mtproto.Vector_DialogFilterSuggested{
+		Datas: make([]*mtproto.DialogFilterSuggested, 0, len(suggestedDialogFilters)),
+	}
+	for _, v := range suggestedDialogFilters {
+		if !titles[v.GetFilter().GetTitle()] {
+			rValues.Datas = append(rValues.Datas, v)
+		}
+	}
+
+	return rValues, nil
+}
@@ -19,14 +19,82 @@
 package core
 
 import (
+	"math/rand"
+	"time"
+
 	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
 )
 
 // MessagesSetHistoryTTL
 // messages.setHistoryTTL#b80e5fe4 peer:InputPeer period:int = Updates;
 func (c *DialogsCore) MessagesSetHistoryTTL(in *mtproto.TLMessagesSetHistoryTTL) (*mtproto.Updates, error) {
-	// TODO: not impl
-	c.Logger.Errorf("messages.setHistoryTTL - error: method MessagesSetHistoryTTL not impl")
+	var (
+		peer = mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+		err  error
+	)
+
+	if !dialog.CheckHistoryTTLPeriod(in.Period) {
+		err = mtproto.ErrTtlPeriodInvalid
+		c.Logger.Errorf("messages.setHistoryTTL - error: %v", err)
+		return nil, err
+	}
+
+	switch peer.PeerType {
+	case mtproto.PEER_USER:
+		if peer.PeerId == c.MD.UserId {
+			err = mtproto.ErrPeerIdInvalid
+			c.Logger.Errorf("messages.setHistoryTTL - error: %v", err)
+			return nil, err
+		}
+		_, err = c.svcCtx.Dao.DialogClient.DialogSetHistoryTTL(c.ctx, &dialog.TLDialogSetHistoryTTL{
+			UserId:    c.MD.UserId,
+			PeerType:  peer.PeerType,
+			PeerId:    peer.PeerId,
+			TtlPeriod: in.Period,
+		})
+	case mtproto.PEER_CHAT:
+		_, err = c.svcCtx.Dao.ChatClient.ChatSetHistoryTTL(c.ctx, &chatpb.TLChatSetHistoryTTL{
+			SelfId:    c.MD.UserId,
+			ChatId:    peer.PeerId,
+			TtlPeriod: in.Period,
+		})
+	default:
+		// saved messages and channels have no auto-delete timer
+		err = mtproto.ErrPeerIdInvalid
+	}
+	if err != nil {
+		c.Logger.Errorf("messages.setHistoryTTL - error: %v", err)
+		return nil, err
+	}
+
+	// the service message tells both sides about the new timer, a dialog
+	// created by it picks up the period from its action.
+	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		PeerType:  peer.PeerType,
+		PeerId:    peer.PeerId,
+		Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+			NoWebpage:  true,
+			Background: false,
+			RandomId:   rand.Int63(),
+			Message: mtproto.MakeTLMessageService(&mtproto.Message{
+				Out:    true,
+				FromId: mtproto.MakePeerUser(c.MD.UserId),
+				PeerId: peer.ToPeer(),
+				Date:   int32(time.Now().Unix()),
+				Action: mtproto.MakeMessageActionSetMessagesTTL(in.Period),
+			}).To_Message(),
+			ScheduleDate: nil,
+		}).To_OutboxMessage(),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.setHistoryTTL - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return rUpdates, nil
 }
@@ -0,0 +1,88 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// MessagesUpdateDialogFilter
+// messages.updateDialogFilter#1ad4a04a flags:# id:int filter:flags.0?DialogFilter = Bool;
+func (c *DialogsCore) MessagesUpdateDialogFilter(in *mtproto.TLMessagesUpdateDialogFilter) (*mtproto.Bool, error) {
+	var (
+		filter = in.GetFilter()
+	)
+
+	if in.GetId() < minDialogFilterId {
+		err := mtproto.ErrFilterIdInvalid
+		c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+		return nil, err
+	}
+
+	if filter == nil {
+		// no filter means delete
+		if _, err := c.svcCtx.Dao.DialogClient.DialogDeleteDialogFilter(c.ctx, &dialog.TLDialogDeleteDialogFilter{
+			UserId: c.MD.UserId,
+			Id:     in.GetId(),
+		}); err != nil {
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+	} else {
+		if filter.GetTitle() == "" {
+			err := mtproto.ErrFilterTitleEmpty
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+		if !filter.GetContacts() &&
+			!filter.GetNonContacts() &&
+			!filter.GetGroups() &&
+			!filter.GetBroadcasts() &&
+			!filter.GetBots() &&
+			len(filter.GetIncludePeers()) == 0 &&
+			len(filter.GetPinnedPeers()) == 0 {
+			err := mtproto.ErrFilterIncludeEmpty
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+
+		filter.Id = in.GetId()
+		if _, err := c.svcCtx.Dao.DialogClient.DialogInsertOrUpdateDialogFilter(c.ctx, &dialog.TLDialogInsertOrUpdateDialogFilter{
+			UserId:       c.MD.UserId,
+			Id:           in.GetId(),
+			DialogFilter: filter,
+		}); err != nil {
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateDialogFilter(&mtproto.Update{
+			Id_INT32: in.GetId(),
+			Filter:   filter,
+		}).To_Update()),
+	})
+
+	return mtproto.BoolTrue, nil
+}
@@ -23,7 +23,9 @@ import (
 // New new a grpc server.
 func New(ctx *svc.ServiceContext, c zrpc.RpcServerConf) *zrpc.RpcServer {
 	s, err := zrpc.NewServer(c, func(grpcServer *grpc.Server) {
-		mtproto.RegisterRPCDialogsServer(grpcServer, service.New(ctx))
+		srv := service.New(ctx)
+		mtproto.RegisterRPCDialogsServer(grpcServer, srv)
+		mtproto.RegisterRPCFoldersServer(grpcServer, srv)
 	})
 	logx.Must(err)
 	return s
@@ -20,13 +20,20 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
 )
 
 // MessagesUploadEncryptedFile
 // messages.uploadEncryptedFile#5057c497 peer:InputEncryptedChat file:InputEncryptedFile = EncryptedFile;
 func (c *FilesCore) MessagesUploadEncryptedFile(in *mtproto.TLMessagesUploadEncryptedFile) (*mtproto.EncryptedFile, error) {
-	// TODO: not impl
-	c.Logger.Errorf("messages.uploadEncryptedFile blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	file, err := c.svcCtx.Dao.MediaClient.MediaUploadEncryptedFile(c.ctx, &mediapb.TLMediaUploadEncryptedFile{
+		OwnerId: c.MD.UserId,
+		File:    in.GetFile(),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.uploadEncryptedFile - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	return file, nil
 }
@@ -18,7 +18,8 @@ import (
 )
 
 type (
-	Config = config.Config
+	Config          = config.Config
+	BotFatherConfig = config.BotFatherConfig
 )
 
 func New(c Config, plugin plugin.MessagesPlugin) *service.Service {
@@ -28,6 +28,7 @@ type Config struct {
 
 	UserClient     zrpc.RpcClientConf
 	ChatClient     zrpc.RpcClientConf
+	ChannelClient  zrpc.RpcClientConf
 	MsgClient      zrpc.RpcClientConf
 	DialogClient   zrpc.RpcClientConf
 	IdgenClient    zrpc.RpcClientConf
@@ -35,4 +36,12 @@ type Config struct {
 	MediaClient    zrpc.RpcClientConf
 	UsernameClient zrpc.RpcClientConf
 	SyncClient     *kafka.KafkaProducerConf
+	BotFather      *BotFatherConfig `json:",optional"`
+}
+
+// BotFatherConfig BotFather runs as the predefined user of Phone, users create
+// and manage their bots by messaging it.
+type BotFatherConfig struct {
+	Phone   string
+	MaxBots int `json:",default=20"`
 }
@@ -0,0 +1,229 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/app/service/biz/username/username"
+)
+
+const (
+	botFatherHelp = `I can help you create and manage your bots.
+
+/newbot <username> <name> - create a new bot, the username must end in 'bot'
+/mybots - list your bots
+/token @username - get the token of your bot
+/revoke @username - revoke the token of your bot and generate a new one`
+
+	botFatherNewBotDone = `Done! Congratulations on your new bot. You will find it at t.me/%s.
+
+Use this token to access the HTTP API:
+%s
+
+Keep your token secure and store it safely, it can be used by anyone to control your bot.`
+)
+
+// onBotFatherMessage BotFather commands take their arguments inline, there is no
+// conversation state between two messages.
+func (c *MessagesCore) onBotFatherMessage(ctx context.Context, botFatherId, fromId int64, text string) {
+	args := strings.Fields(text)
+	if len(args) == 0 {
+		return
+	}
+
+	// /cmd@BotFather
+	cmd := args[0]
+	if idx := strings.IndexByte(cmd, '@'); idx > 0 {
+		cmd = cmd[:idx]
+	}
+
+	var reply string
+	switch cmd {
+	case "/start", "/help":
+		reply = botFatherHelp
+	case "/newbot":
+		reply = c.botFatherNewBot(ctx, fromId, args[1:])
+	case "/mybots":
+		reply = c.botFatherMyBots(ctx, fromId)
+	case "/token":
+		reply = c.botFatherToken(ctx, fromId, args[1:], false)
+	case "/revoke":
+		reply = c.botFatherToken(ctx, fromId, args[1:], true)
+	default:
+		reply = "Unrecognized command. Say what?\n\n" + botFatherHelp
+	}
+
+	c.svcCtx.Dao.MsgClient.MsgPushUserMessage(
+		ctx,
+		&msgpb.TLMsgPushUserMessage{
+			UserId:    botFatherId,
+			AuthKeyId: 0,
+			PeerType:  mtproto.PEER_USER,
+			PeerId:    fromId,
+			PushType:  1,
+			Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+				NoWebpage:  true,
+				Background: false,
+				RandomId:   rand.Int63(),
+				Message: mtproto.MakeTLMessage(&mtproto.Message{
+					Out:     true,
+					Date:    int32(time.Now().Unix()),
+					FromId:  mtproto.MakePeerUser(botFatherId),
+					PeerId:  mtproto.MakePeerUser(fromId),
+					Message: reply,
+				}).To_Message(),
+				ScheduleDate: nil,
+			}).To_OutboxMessage(),
+		})
+}
+
+func (c *MessagesCore) botFatherNewBot(ctx context.Context, fromId int64, args []string) string {
+	if len(args) < 2 {
+		return "Usage: /newbot <username> <name>"
+	}
+
+	var (
+		botUsername = strings.TrimPrefix(args[0], "@")
+		botName     = strings.Join(args[1:], " ")
+	)
+
+	if !username.CheckUsernameInvalid(botUsername) || !strings.HasSuffix(strings.ToLower(botUsername), "bot") {
+		return "Sorry, this username is invalid. It must end in 'bot', e.g. 'tetris_bot'."
+	}
+
+	bots, err := c.svcCtx.Dao.UserClient.UserGetBotsByCreator(ctx, &userpb.TLUserGetBotsByCreator{
+		CreatorUserId: fromId,
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.newbot - error: %v", err)
+		return "Sorry, something went wrong. Please try again later."
+	} else if len(bots.GetDatas()) >= c.svcCtx.Config.BotFather.MaxBots {
+		return fmt.Sprintf("That I cannot do. You already have %d bots.", len(bots.GetDatas()))
+	}
+
+	existed, err := c.svcCtx.Dao.UsernameClient.UsernameCheckUsername(ctx, &username.TLUsernameCheckUsername{
+		Username: botUsername,
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.newbot - error: %v", err)
+		return "Sorry, something went wrong. Please try again later."
+	} else if existed.GetPredicateName() != username.Predicate_usernameNotExisted {
+		return "Sorry, this username is already taken."
+	}
+
+	bot, err := c.svcCtx.Dao.UserClient.UserCreateBot(ctx, &userpb.TLUserCreateBot{
+		CreatorUserId: fromId,
+		FirstName:     botName,
+		Username:      botUsername,
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.newbot - error: %v", err)
+		return "Sorry, something went wrong. Please try again later."
+	}
+
+	ok, err := c.svcCtx.Dao.UsernameClient.UsernameUpdateUsername(ctx, &username.TLUsernameUpdateUsername{
+		PeerType: mtproto.PEER_USER,
+		PeerId:   bot.Id(),
+		Username: botUsername,
+	})
+	if err != nil || !mtproto.FromBool(ok) {
+		// taken between check and update, the bot keeps no username
+		c.Logger.Errorf("botFather.newbot - update username(%s) error: %v", botUsername, err)
+		c.svcCtx.Dao.UserClient.UserUpdateUsername(ctx, &userpb.TLUserUpdateUsername{
+			UserId:   bot.Id(),
+			Username: "",
+		})
+		return "Sorry, this username is already taken."
+	}
+
+	return fmt.Sprintf(botFatherNewBotDone, botUsername, bot.GetUser().GetBot().GetToken())
+}
+
+func (c *MessagesCore) botFatherMyBots(ctx context.Context, fromId int64) string {
+	bots, err := c.svcCtx.Dao.UserClient.UserGetBotsByCreator(ctx, &userpb.TLUserGetBotsByCreator{
+		CreatorUserId: fromId,
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.mybots - error: %v", err)
+		return "Sorry, something went wrong. Please try again later."
+	} else if len(bots.GetDatas()) == 0 {
+		return "You have currently no bots, use /newbot to create one."
+	}
+
+	users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(ctx, &userpb.TLUserGetMutableUsers{
+		Id: bots.GetDatas(),
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.mybots - error: %v", err)
+		return "Sorry, something went wrong. Please try again later."
+	}
+
+	b := strings.Builder{}
+	b.WriteString("Your bots:\n")
+	for _, u := range users.GetDatas() {
+		b.WriteString(fmt.Sprintf("\n@%s - %s", u.Username(), u.FirstName()))
+	}
+
+	return b.String()
+}
+
+// botFatherToken replies the token of the bot, a new one if revoke.
+func (c *MessagesCore) botFatherToken(ctx context.Context, fromId int64, args []string, revoke bool) string {
+	if len(args) != 1 {
+		if revoke {
+			return "Usage: /revoke @username"
+		}
+		return "Usage: /token @username"
+	}
+
+	peer, err := c.svcCtx.Dao.UsernameClient.UsernameResolveUsername(ctx, &username.TLUsernameResolveUsername{
+		Username: strings.TrimPrefix(args[0], "@"),
+	})
+	if err != nil || peer.GetPredicateName() != mtproto.Predicate_peerUser {
+		return "Invalid bot selected."
+	}
+
+	bot, err := c.svcCtx.Dao.UserClient.UserGetImmutableUser(ctx, &userpb.TLUserGetImmutableUser{
+		Id: peer.GetUserId(),
+	})
+	if err != nil || !bot.IsBot() || bot.GetUser().GetBot().GetCreator() != fromId {
+		return "Invalid bot selected."
+	}
+
+	if revoke {
+		rToken, err := c.svcCtx.Dao.UserClient.UserResetBotToken(ctx, &userpb.TLUserResetBotToken{
+			BotId: bot.Id(),
+		})
+		if err != nil {
+			c.Logger.Errorf("botFather.revoke - error: %v", err)
+			return "Sorry, something went wrong. Please try again later."
+		}
+		return fmt.Sprintf("Your token was replaced with a new one. You can use this token to access the HTTP API:\n%s", rToken.GetV())
+	}
+
+	return fmt.Sprintf("You can use this token to access the HTTP API:\n%s", bot.GetUser().GetBot().GetToken())
+}
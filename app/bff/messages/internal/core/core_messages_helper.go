@@ -16,6 +16,7 @@ import (
 	"github.com/teamgram/proto/mtproto/rpc/metadata"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
 	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	"github.com/teamgram/teamgram-server/app/service/biz/username/username"
 	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
@@ -222,8 +223,21 @@ func (c *MessagesCore) makeMediaByInputMedia(media *mtproto.InputMedia) (message
 	case mtproto.Predicate_inputMediaPoll:
 		// inputMediaPoll#f94e5f1 flags:# poll:Poll correct_answers:flags.0?Vector<bytes> solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = InputMedia;
 
-		// TODO(@benqi): Not impl inputMediaPoll
-		messageMedia = mtproto.MakeTLMessageMediaUnsupported(nil).To_MessageMedia()
+		correctAnswers := make([]string, 0, len(media.CorrectAnswers))
+		for _, v := range media.CorrectAnswers {
+			correctAnswers = append(correctAnswers, string(v))
+		}
+		messageMedia, err = c.svcCtx.Dao.MessageClient.MessageCreatePoll(c.ctx, &message.TLMessageCreatePoll{
+			UserId:           c.MD.UserId,
+			Poll:             media.Poll,
+			CorrectAnswers:   correctAnswers,
+			Solution:         media.GetSolution().GetValue(),
+			SolutionEntities: media.SolutionEntities,
+		})
+		if err != nil {
+			c.Logger.Errorf("CreatePoll error: %v, by %s", err, media.DebugString())
+			return
+		}
 	case mtproto.Predicate_inputMediaDice:
 		// inputMediaDice#e66fbf7b emoticon:string = InputMedia;
 
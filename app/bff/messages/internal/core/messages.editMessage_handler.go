@@ -24,6 +24,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/teamgram/proto/mtproto"
 	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 )
 
@@ -33,7 +34,7 @@ func (c *MessagesCore) MessagesEditMessage(in *mtproto.TLMessagesEditMessage) (*
 	var (
 		hasBot       = c.MD.IsBot
 		peer         = mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
-		editMessages *message.Vector_MessageBox
+		editMessages []*mtproto.MessageBox
 		err          error
 	)
 
@@ -47,67 +48,107 @@ func (c *MessagesCore) MessagesEditMessage(in *mtproto.TLMessagesEditMessage) (*
 			//	hasBot = s.UserFacade.IsBot(ctx, peer.PeerId)
 			//}
 		}
-		editMessages, _ = c.svcCtx.MessageClient.MessageGetUserMessageList(c.ctx, &message.TLMessageGetUserMessageList{
+		boxList, _ := c.svcCtx.MessageClient.MessageGetUserMessageList(c.ctx, &message.TLMessageGetUserMessageList{
 			UserId: c.MD.UserId,
 			IdList: []int32{in.Id},
 		})
+		editMessages = boxList.GetDatas()
 	case mtproto.PEER_CHANNEL:
-		c.Logger.Errorf("messages.editMessage blocked, License key from https://teamgram.net required to unlock enterprise features.")
-
-		return nil, mtproto.ErrEnterpriseIsBlocked
+		boxList, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelMessageList(c.ctx, &channelpb.TLChannelGetChannelMessageList{
+			ChannelId: peer.PeerId,
+			Id:        []int32{in.Id},
+		})
+		editMessages = boxList.GetDatas()
 	default:
 		c.Logger.Errorf("invalid peer: %v", in.Peer)
 		err = mtproto.ErrPeerIdInvalid
 		return nil, err
 	}
 
-	if len(editMessages.GetDatas()) != 1 {
+	if len(editMessages) != 1 {
 		err = mtproto.ErrMessageEmpty
 		c.Logger.Errorf("messages.editMessage - emptyMessage(%d)", in.Id)
 		return nil, err
 	}
 
-	// TODO(@benqi): check
-	// editMessage := editMessages[0]
-	if editMessages.Datas[0].UserId != c.MD.UserId {
+	// channel admins may edit channel posts, the channel service checks the rights.
+	if peer.PeerType != mtproto.PEER_CHANNEL && editMessages[0].UserId != c.MD.UserId {
 		err = mtproto.ErrMessageAuthorRequired
 		c.Logger.Errorf("messages.editMessage - emptyMessage(%d)", in.Id)
 		return nil, err
 	}
-	// ...
 
-	outMessage := editMessages.Datas[0].Message
+	outMessage := editMessages[0].Message
+	if peer.PeerType != mtproto.PEER_CHANNEL && !outMessage.Out {
+		err = mtproto.ErrMessageAuthorRequired
+		c.Logger.Errorf("messages.editMessage - error: %v", err)
+		return nil, err
+	}
+
 	// edit_date
 	outMessage.EditDate = &types.Int32Value{Value: int32(time.Now().Unix())}
 	outMessage.EditHide = false
 
-	// entities
-	if in.Entities != nil {
-		outMessage.Entities = in.Entities
-	}
-
 	// reply_markup
 	if in.ReplyMarkup != nil {
+		if !hasBot {
+			err = mtproto.ErrReplyMarkupInvalid
+			c.Logger.Errorf("messages.editMessage - error: %v", err)
+			return nil, err
+		}
 		outMessage.ReplyMarkup = in.ReplyMarkup
 	}
 
-	if in.Media != nil {
+	// media
+	if in.Media != nil && isClosePollMedia(outMessage.Media, in.Media) {
+		// closing is the only edit a poll allows
+		var media *mtproto.MessageMedia
+		media, err = c.svcCtx.MessageClient.MessageClosePoll(c.ctx, &message.TLMessageClosePoll{
+			UserId: c.MD.UserId,
+			PollId: outMessage.Media.GetPoll().GetId(),
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.editMessage - close poll error: %v", err)
+			return nil, err
+		}
+		media.Results = message.MakeMinPollResults(media.Results)
+		outMessage.Media = media
+	} else if in.Media != nil {
+		if !canReplaceMessageMedia(outMessage.Media) {
+			err = mtproto.ErrMediaPrevInvalid
+			c.Logger.Errorf("messages.editMessage - error: %v", err)
+			return nil, err
+		}
+		if !canEditToInputMedia(in.Media) {
+			err = mtproto.ErrMediaNewInvalid
+			c.Logger.Errorf("messages.editMessage - error: %v", err)
+			return nil, err
+		}
 		outMessage.Media, err = c.makeMediaByInputMedia(in.Media)
 		if err != nil {
 			c.Logger.Errorf("messages.editMessage - media error: %v", err)
 			return nil, err
 		}
 	}
-	// message
-	if in.Message != nil {
-		if in.Message.Value == "" {
-			err = mtproto.ErrMessageEmpty
-			c.Logger.Errorf("message empty: %v", err)
-			return nil, err
+
+	// message and entities
+	if in.Message != nil || in.Entities != nil {
+		if in.Message != nil {
+			if in.Message.Value == "" && !hasCaptionMedia(outMessage.Media) {
+				err = mtproto.ErrMessageEmpty
+				c.Logger.Errorf("message empty: %v", err)
+				return nil, err
+			}
+			if in.Media == nil && outMessage.GetMedia().GetPredicateName() == mtproto.Predicate_messageMediaWebPage {
+				// the link preview follows the new text
+				outMessage.Media = nil
+			}
+			outMessage.Message = in.Message.Value
 		}
-		outMessage.Message = in.Message.Value
-		outMessage.Entities = nil
-		outMessage, _ = c.fixMessageEntities(c.MD.UserId, peer, in.NoWebpage, outMessage, hasBot)
+		outMessage.Entities = in.Entities
+
+		noWebpage := in.NoWebpage || hasCaptionMedia(outMessage.Media)
+		outMessage, _ = c.fixMessageEntities(c.MD.UserId, peer, noWebpage, outMessage, hasBot)
 	}
 
 	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgEditMessage(c.ctx, &msgpb.TLMsgEditMessage{
@@ -130,3 +171,50 @@ func (c *MessagesCore) MessagesEditMessage(in *mtproto.TLMessagesEditMessage) (*
 
 	return rUpdates, nil
 }
+
+// canReplaceMessageMedia only photos, documents and link previews can be replaced.
+func canReplaceMessageMedia(media *mtproto.MessageMedia) bool {
+	switch media.GetPredicateName() {
+	case "",
+		mtproto.Predicate_messageMediaEmpty,
+		mtproto.Predicate_messageMediaPhoto,
+		mtproto.Predicate_messageMediaDocument,
+		mtproto.Predicate_messageMediaWebPage:
+		return true
+	default:
+		return false
+	}
+}
+
+// canEditToInputMedia polls, contacts, locations, games and dice can't be set by editing.
+func canEditToInputMedia(media *mtproto.InputMedia) bool {
+	switch media.GetPredicateName() {
+	case mtproto.Predicate_inputMediaUploadedPhoto,
+		mtproto.Predicate_inputMediaPhoto,
+		mtproto.Predicate_inputMediaPhotoExternal,
+		mtproto.Predicate_inputMediaUploadedDocument,
+		mtproto.Predicate_inputMediaDocument,
+		mtproto.Predicate_inputMediaDocumentExternal:
+		return true
+	default:
+		return false
+	}
+}
+
+// isClosePollMedia the creator closes a poll by editing it to an inputMediaPoll with poll.closed set.
+func isClosePollMedia(media *mtproto.MessageMedia, inputMedia *mtproto.InputMedia) bool {
+	return media.GetPredicateName() == mtproto.Predicate_messageMediaPoll &&
+		inputMedia.GetPredicateName() == mtproto.Predicate_inputMediaPoll &&
+		inputMedia.GetPoll().GetClosed()
+}
+
+// hasCaptionMedia photos and documents keep the message text as caption.
+func hasCaptionMedia(media *mtproto.MessageMedia) bool {
+	switch media.GetPredicateName() {
+	case mtproto.Predicate_messageMediaPhoto,
+		mtproto.Predicate_messageMediaDocument:
+		return true
+	default:
+		return false
+	}
+}
@@ -20,13 +20,19 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // MessagesGetDefaultHistoryTTL
 // messages.getDefaultHistoryTTL#658b7188 = DefaultHistoryTTL;
 func (c *MessagesCore) MessagesGetDefaultHistoryTTL(in *mtproto.TLMessagesGetDefaultHistoryTTL) (*mtproto.DefaultHistoryTTL, error) {
-	// TODO: not impl
-	c.Logger.Errorf("messages.getDefaultHistoryTTL blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	rValue, err := c.svcCtx.Dao.UserClient.UserGetDefaultHistoryTTL(c.ctx, &userpb.TLUserGetDefaultHistoryTTL{
+		UserId: c.MD.UserId,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getDefaultHistoryTTL - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	return rValue, nil
 }
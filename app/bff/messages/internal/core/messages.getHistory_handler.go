@@ -20,6 +20,7 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
@@ -31,11 +32,9 @@ import (
 func (c *MessagesCore) MessagesGetHistory(in *mtproto.TLMessagesGetHistory) (*mtproto.Messages_Messages, error) {
 	// TODO(@benqi): 重复FromInputPeer2
 	var (
-		err  error
-		peer = mtproto.FromInputPeer2(c.MD.UserId, in.GetPeer())
-		chat *mtproto.MutableChat
-		//channel   *channelpb.MutableChannel
-		//isChannel bool
+		err   error
+		peer  = mtproto.FromInputPeer2(c.MD.UserId, in.GetPeer())
+		chat  *mtproto.MutableChat
 		limit = in.Limit
 	)
 
@@ -58,27 +57,7 @@ func (c *MessagesCore) MessagesGetHistory(in *mtproto.TLMessagesGetHistory) (*mt
 			_ = chat
 		}
 	case mtproto.PEER_CHANNEL:
-		//// 400	CHANNEL_INVALID	The provided channel is invalid
-		//// 400	CHANNEL_PRIVATE	You haven't joined this channel/supergroup
-		//channel, err = c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(
-		//	c.ctx,
-		//	&channelpb.TLChannelGetMutableChannel{
-		//		ChannelId: peer.PeerId,
-		//		Id:        []int64{c.MD.UserId},
-		//	})
-		//if err != nil {
-		//	err = mtproto.ErrChannelInvalid
-		//	c.Logger.Errorf("messages.getHistory - error: %v", err)
-		//	return nil, err
-		//}
-		//
-		//me := channel.GetImmutableChannelParticipant(c.MD.UserId)
-		//if me != nil {
-		//	// TODO
-		//	// minId = mathx.MaxInt(int(me.AvailableMinId), int(in.MinId))
-		//}
-		//
-		//isChannel = true
+		return c.getChannelHistory(peer.PeerId, in.OffsetId, limit)
 	default:
 		err = mtproto.ErrPeerIdInvalid
 		c.Logger.Errorf("messages.getHistory - error: %v", err)
@@ -151,18 +130,12 @@ func (c *MessagesCore) MessagesGetHistory(in *mtproto.TLMessagesGetHistory) (*mt
 				})
 			chats = append(chats, mChats.GetChatListByIdList(c.MD.UserId, chatIdList...)...)
 		},
-		func(channelIdList []int64) {
-			//// TODO: handler other...
-			//if channel != nil {
-			//	chats = append(chats, channel.ToUnsafeChat(c.MD.UserId))
-			//}
-		})
+		nil)
 
 	var (
 		rValues *mtproto.Messages_Messages
 	)
 
-	//if !isChannel {
 	if boxList.Length() == limit {
 		rValues = mtproto.MakeTLMessagesMessagesSlice(&mtproto.Messages_Messages{
 			Inexact:        false, // TODO: ???
@@ -180,17 +153,58 @@ func (c *MessagesCore) MessagesGetHistory(in *mtproto.TLMessagesGetHistory) (*mt
 			Chats:    mtproto.ToSafeChats(chats),
 		}).To_Messages_Messages()
 	}
-	//} else {
-	//	rValues = mtproto.MakeTLMessagesChannelMessages(&mtproto.Messages_Messages{
-	//		Inexact:        false, // TODO: ???
-	//		Pts:            channel.Pts(),
-	//		Count:          count.V,
-	//		OffsetIdOffset: &types.Int32Value{Value: channel.TopMessage() - in.OffsetId}, // TODO: ???
-	//		Messages:       messages,
-	//		Chats:          mtproto.ToSafeChats(chats),
-	//		Users:          mtproto.ToSafeUsers(users),
-	//	}).To_Messages_Messages()
-	//}
+
+	return rValues, nil
+}
+
+func (c *MessagesCore) getChannelHistory(channelId int64, offsetId, limit int32) (*mtproto.Messages_Messages, error) {
+	// 400	CHANNEL_INVALID	The provided channel is invalid
+	// 400	CHANNEL_PRIVATE	You haven't joined this channel/supergroup
+	channel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getHistory - error: %v", err)
+		return nil, mtproto.ErrChannelInvalid
+	} else if !channel.IsChannelParticipant(c.MD.UserId) {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("messages.getHistory - error: %v", err)
+		return nil, err
+	}
+
+	boxList, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelHistory(c.ctx, &channelpb.TLChannelGetChannelHistory{
+		ChannelId: channelId,
+		OffsetId:  offsetId,
+		Limit:     limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getHistory - error: %v", err)
+		return nil, err
+	}
+
+	rValues := mtproto.MakeTLMessagesChannelMessages(&mtproto.Messages_Messages{
+		Inexact:  false,
+		Pts:      channel.GetChannel().GetPts(),
+		Count:    channel.GetChannel().GetTopMessage(), // message ids are sequential per channel
+		Messages: []*mtproto.Message{},
+		Chats:    []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:    []*mtproto.User{},
+	}).To_Messages_Messages()
+
+	boxList.Visit(c.MD.UserId,
+		func(messageList []*mtproto.Message) {
+			rValues.Messages = messageList
+		},
+		func(userIdList []int64) {
+			mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rValues.Users = mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)
+		},
+		nil,
+		nil)
 
 	return rValues, nil
 }
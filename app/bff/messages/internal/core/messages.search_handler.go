@@ -22,6 +22,7 @@ import (
 	"math"
 
 	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
@@ -55,11 +56,6 @@ func (c *MessagesCore) MessagesSearch(in *mtproto.TLMessagesSearch) (*mtproto.Me
 	}
 
 	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
-	if peer.IsChannel() {
-		// TODO: not impl
-		c.Logger.Errorf("messages.search blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		return nil, mtproto.ErrEnterpriseIsBlocked
-	}
 
 	if in.GetFromId() != nil {
 		// 1. peer must chat and channel
@@ -81,6 +77,10 @@ func (c *MessagesCore) MessagesSearch(in *mtproto.TLMessagesSearch) (*mtproto.Me
 		// 400	INPUT_USER_DEACTIVATED	The specified user was deleted.
 	}
 
+	if peer.IsChannel() {
+		return c.searchChannelMessages(peer.PeerId, in, fromId, offsetId, limit)
+	}
+
 	rValues = mtproto.MakeTLMessagesMessages(&mtproto.Messages_Messages{
 		Messages: []*mtproto.Message{},
 		Chats:    []*mtproto.Chat{},
@@ -306,3 +306,71 @@ func (c *MessagesCore) MessagesSearch(in *mtproto.TLMessagesSearch) (*mtproto.Me
 
 	return rValues, nil
 }
+
+func (c *MessagesCore) searchChannelMessages(channelId int64, in *mtproto.TLMessagesSearch, fromId *mtproto.PeerUtil, offsetId, limit int32) (*mtproto.Messages_Messages, error) {
+	channel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.search - error: %v", err)
+		return nil, mtproto.ErrChannelInvalid
+	} else if !channel.IsChannelParticipant(c.MD.UserId) {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("messages.search - error: %v", err)
+		return nil, err
+	}
+
+	rValues := mtproto.MakeTLMessagesChannelMessages(&mtproto.Messages_Messages{
+		Inexact:  false,
+		Pts:      channel.GetChannel().GetPts(),
+		Count:    0,
+		Messages: []*mtproto.Message{},
+		Chats:    []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:    []*mtproto.User{},
+	}).To_Messages_Messages()
+
+	// only text search is indexed for channels
+	if mtproto.FromMessagesFilter(in.Filter) != mtproto.FilterEmpty || in.Q == "" {
+		return rValues, nil
+	}
+
+	var (
+		fId int64
+	)
+	if fromId != nil {
+		fId = fromId.PeerId
+	}
+
+	boxList, err := c.svcCtx.Dao.ChannelClient.ChannelSearchChannelMessages(c.ctx, &channelpb.TLChannelSearchChannelMessages{
+		ChannelId: channelId,
+		UserId:    c.MD.UserId,
+		Q:         in.Q,
+		FromId:    fId,
+		OffsetId:  offsetId,
+		MinDate:   in.MinDate,
+		MaxDate:   in.MaxDate,
+		Limit:     limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.search - error: %v", err)
+		return nil, err
+	}
+
+	boxList.Visit(c.MD.UserId,
+		func(messageList []*mtproto.Message) {
+			rValues.Messages = messageList
+		},
+		func(userIdList []int64) {
+			mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rValues.Users = mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)
+		},
+		nil,
+		nil)
+	rValues.Count = int32(len(rValues.Messages))
+
+	return rValues, nil
+}
@@ -36,9 +36,9 @@ func (c *MessagesCore) MessagesSendMessage(in *mtproto.TLMessagesSendMessage) (*
 		peer   = mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
 	)
 
-	if !peer.IsChatOrUser() {
+	if !peer.IsChatOrUser() && !peer.IsChannel() {
 		c.Logger.Errorf("invalid peer: %v", in.Peer)
-		err := mtproto.ErrEnterpriseIsBlocked
+		err := mtproto.ErrPeerIdInvalid
 		return nil, err
 	}
 
@@ -136,5 +136,14 @@ func (c *MessagesCore) MessagesSendMessage(in *mtproto.TLMessagesSendMessage) (*
 		})
 	}
 
+	if peer.IsUser() && !c.MD.IsBot {
+		if botFatherId := c.svcCtx.Dao.GetBotFatherId(c.ctx); botFatherId != 0 && peer.PeerId == botFatherId {
+			ctx := contextx.ValueOnlyFrom(c.ctx)
+			threading.GoSafe(func() {
+				c.onBotFatherMessage(ctx, botFatherId, c.MD.UserId, in.Message)
+			})
+		}
+	}
+
 	return rUpdate, nil
 }
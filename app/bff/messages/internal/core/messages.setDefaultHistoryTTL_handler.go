@@ -20,13 +20,27 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // MessagesSetDefaultHistoryTTL
 // messages.setDefaultHistoryTTL#9eb51445 period:int = Bool;
 func (c *MessagesCore) MessagesSetDefaultHistoryTTL(in *mtproto.TLMessagesSetDefaultHistoryTTL) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("messages.setDefaultHistoryTTL blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if !dialog.CheckHistoryTTLPeriod(in.Period) {
+		err := mtproto.ErrTtlPeriodInvalid
+		c.Logger.Errorf("messages.setDefaultHistoryTTL - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	rValue, err := c.svcCtx.Dao.UserClient.UserSetDefaultHistoryTTL(c.ctx, &userpb.TLUserSetDefaultHistoryTTL{
+		UserId: c.MD.UserId,
+		Ttl:    in.Period,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.setDefaultHistoryTTL - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
 }
@@ -0,0 +1,95 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/app/service/biz/username/username"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// GetBotFatherId returns the user id of BotFather, 0 if BotFather is not configured.
+// The first call registers the predefined user the same way auth.signIn does.
+func (d *Dao) GetBotFatherId(ctx context.Context) int64 {
+	if d.botFather == nil {
+		return 0
+	}
+	if id := atomic.LoadInt64(&d.botFatherId); id != 0 {
+		return id
+	}
+
+	d.botFatherMu.Lock()
+	defer d.botFatherMu.Unlock()
+
+	if d.botFatherId != 0 {
+		return d.botFatherId
+	}
+
+	predefinedUser, err := d.UserClient.UserGetPredefinedUser(ctx, &userpb.TLUserGetPredefinedUser{
+		Phone: d.botFather.Phone,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("botFather - predefined user(%s) error: %v", d.botFather.Phone, err)
+		return 0
+	}
+
+	id := predefinedUser.GetRegisteredUserId().GetValue()
+	if id == 0 {
+		user, err := d.UserClient.UserCreateNewUser(ctx, &userpb.TLUserCreateNewUser{
+			Phone:     predefinedUser.GetPhone(),
+			FirstName: predefinedUser.GetFirstName().GetValue(),
+			LastName:  predefinedUser.GetLastName().GetValue(),
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("botFather - create user error: %v", err)
+			return 0
+		}
+		id = user.Id()
+
+		d.UserClient.UserPredefinedBindRegisteredUserId(ctx, &userpb.TLUserPredefinedBindRegisteredUserId{
+			Phone:            predefinedUser.GetPhone(),
+			RegisteredUserId: id,
+		})
+		if predefinedUser.GetUsername().GetValue() != "" {
+			d.UserClient.UserUpdateUsername(ctx, &userpb.TLUserUpdateUsername{
+				UserId:   id,
+				Username: predefinedUser.GetUsername().GetValue(),
+			})
+			d.UsernameClient.UsernameUpdateUsername(ctx, &username.TLUsernameUpdateUsername{
+				PeerType: mtproto.PEER_USER,
+				PeerId:   id,
+				Username: predefinedUser.GetUsername().GetValue(),
+			})
+		}
+		if predefinedUser.Verified {
+			d.UserClient.UserUpdateVerified(ctx, &userpb.TLUserUpdateVerified{
+				UserId:   id,
+				Verified: mtproto.ToBool(predefinedUser.Verified),
+			})
+		}
+	}
+
+	atomic.StoreInt64(&d.botFatherId, id)
+	return id
+}
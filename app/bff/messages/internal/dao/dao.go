@@ -19,11 +19,13 @@
 package dao
 
 import (
-	kafka "github.com/teamgram/marmota/pkg/mq"
+	"sync"
+
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/teamgram-server/app/bff/messages/internal/config"
 	msg_client "github.com/teamgram/teamgram-server/app/messenger/msg/msg/client"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
 	message_client "github.com/teamgram/teamgram-server/app/service/biz/message/client"
@@ -31,18 +33,24 @@ import (
 	username_client "github.com/teamgram/teamgram-server/app/service/biz/username/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 	media_client "github.com/teamgram/teamgram-server/app/service/media/client"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 )
 
 type Dao struct {
 	msg_client.MsgClient
 	user_client.UserClient
 	ChatClient *chat_client.ChatClientHelper
+	channel_client.ChannelClient
 	media_client.MediaClient
 	username_client.UsernameClient
 	message_client.MessageClient
 	idgen_client.IDGenClient2
 	dialog_client.DialogClient
 	sync_client.SyncClient
+
+	botFather   *config.BotFatherConfig
+	botFatherMu sync.Mutex
+	botFatherId int64
 }
 
 func New(c config.Config) *Dao {
@@ -50,11 +58,13 @@ func New(c config.Config) *Dao {
 		MsgClient:      msg_client.NewMsgClient(rpcx.GetCachedRpcClient(c.MsgClient)),
 		UserClient:     user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
 		ChatClient:     chat_client.NewChatClientHelper(rpcx.GetCachedRpcClient(c.ChatClient)),
+		ChannelClient:  channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
 		MediaClient:    media_client.NewMediaClient(rpcx.GetCachedRpcClient(c.MediaClient)),
 		DialogClient:   dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
 		IDGenClient2:   idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
 		MessageClient:  message_client.NewMessageClient(rpcx.GetCachedRpcClient(c.MessageClient)),
 		UsernameClient: username_client.NewUsernameClient(rpcx.GetCachedRpcClient(c.UsernameClient)),
-		SyncClient:     sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+		SyncClient:     sync_client.NewSyncMqClient(mqx.MustProducer(c.SyncClient)),
+		botFather:      c.BotFather,
 	}
 }
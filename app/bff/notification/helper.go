@@ -12,15 +12,27 @@ package notification_helper
 
 import (
 	"github.com/teamgram/teamgram-server/app/bff/notification/internal/config"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/push"
 	"github.com/teamgram/teamgram-server/app/bff/notification/internal/server/grpc/service"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/server/mq"
 	"github.com/teamgram/teamgram-server/app/bff/notification/internal/svc"
 	"github.com/teamgram/teamgram-server/app/bff/notification/plugin"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 )
 
 type (
-	Config = config.Config
+	Config     = config.Config
+	PushConfig = push.Config
 )
 
 func New(c Config, plugin plugin.NotificationPlugin) *service.Service {
 	return service.New(svc.NewServiceContext(c, plugin))
 }
+
+// NewPushConsumer returns the consumer of sync.pushUpdatesIfNot, nil if c.PushConsumer is not configured.
+func NewPushConsumer(c Config, plugin plugin.NotificationPlugin) mqx.Consumer {
+	if c.PushConsumer == nil {
+		return nil
+	}
+	return mq.New(svc.NewServiceContext(c, plugin), *c.PushConsumer)
+}
@@ -20,12 +20,16 @@ package config
 
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/push"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
-	UserClient zrpc.RpcClientConf
-	ChatClient zrpc.RpcClientConf
-	SyncClient *kafka.KafkaProducerConf
+	UserClient        zrpc.RpcClientConf
+	ChatClient        zrpc.RpcClientConf
+	AuthsessionClient zrpc.RpcClientConf
+	SyncClient        *kafka.KafkaProducerConf
+	Push              *push.Config             `json:",optional"`
+	PushConsumer      *kafka.KafkaConsumerConf `json:",optional"`
 }
@@ -20,13 +20,44 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/push"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 )
 
 // AccountRegisterDevice
 // account.registerDevice#ec86017a flags:# no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
 func (c *NotificationCore) AccountRegisterDevice(in *mtproto.TLAccountRegisterDevice) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("account.registerDevice blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if in.GetToken() == "" {
+		err := mtproto.ErrTokenInvalid
+		c.Logger.Errorf("account.registerDevice - error: %v", err)
+		return nil, err
+	}
+	if secret := in.GetSecret(); len(secret) != 0 && len(secret) != 256 {
+		err := mtproto.ErrTokenInvalid
+		c.Logger.Errorf("account.registerDevice - error: invalid secret size %d", len(secret))
+		return nil, err
+	}
+	if in.GetTokenType() == push.TokenTypeWebPush {
+		if err := push.ValidateWebPushToken(in.GetToken()); err != nil {
+			c.Logger.Errorf("account.registerDevice - error: %v", err)
+			return nil, err
+		}
+	}
+
+	_, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionRegisterDevice(c.ctx, &authsession.TLAuthsessionRegisterDevice{
+		NoMuted:    in.GetNoMuted(),
+		AppSandbox: mtproto.FromBool(in.GetAppSandbox()),
+		AuthKeyId:  c.permAuthKeyId(),
+		UserId:     c.MD.UserId,
+		TokenType:  in.GetTokenType(),
+		Token:      in.GetToken(),
+		Secret:     in.GetSecret(),
+		OtherUids:  in.GetOtherUids(),
+	})
+	if err != nil {
+		c.Logger.Errorf("account.registerDevice - error: %v", err)
+		return nil, err
+	}
 
 	return mtproto.BoolTrue, nil
 }
@@ -20,13 +20,23 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 )
 
 // AccountUnregisterDevice
 // account.unregisterDevice#6a0d3206 token_type:int token:string other_uids:Vector<long> = Bool;
 func (c *NotificationCore) AccountUnregisterDevice(in *mtproto.TLAccountUnregisterDevice) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("account.unregisterDevice blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	_, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionUnregisterDevice(c.ctx, &authsession.TLAuthsessionUnregisterDevice{
+		AuthKeyId: c.permAuthKeyId(),
+		UserId:    c.MD.UserId,
+		TokenType: in.GetTokenType(),
+		Token:     in.GetToken(),
+		OtherUids: in.GetOtherUids(),
+	})
+	if err != nil {
+		c.Logger.Errorf("account.unregisterDevice - error: %v", err)
+		return nil, err
+	}
 
 	return mtproto.BoolTrue, nil
 }
@@ -20,13 +20,21 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 )
 
 // AccountUpdateDeviceLocked
 // account.updateDeviceLocked#38df3532 period:int = Bool;
 func (c *NotificationCore) AccountUpdateDeviceLocked(in *mtproto.TLAccountUpdateDeviceLocked) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("account.updateDeviceLocked blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	_, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionUpdateDeviceLocked(c.ctx, &authsession.TLAuthsessionUpdateDeviceLocked{
+		AuthKeyId: c.permAuthKeyId(),
+		UserId:    c.MD.UserId,
+		Period:    in.GetPeriod(),
+	})
+	if err != nil {
+		c.Logger.Errorf("account.updateDeviceLocked - error: %v", err)
+		return nil, err
+	}
 
 	return mtproto.BoolTrue, nil
 }
@@ -0,0 +1,302 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/push"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// pushMessage is a notification rendered for one update, devices pick the
+// preview or the hidden variant.
+type pushMessage struct {
+	preview *push.Payload
+	hidden  *push.Payload
+	muted   bool
+	silent  bool
+}
+
+// permAuthKeyId is the key devices are registered with, sync excludes online devices by it.
+func (c *NotificationCore) permAuthKeyId() int64 {
+	if c.MD.PermAuthKeyId != 0 {
+		return c.MD.PermAuthKeyId
+	}
+	return c.MD.AuthId
+}
+
+// PushUpdatesIfNot
+// sync.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+// sync sends it for every user update, excludes are the perm auth keys which have an online session.
+func (c *NotificationCore) PushUpdatesIfNot(in *sync.TLSyncPushUpdatesIfNot) error {
+	if c.svcCtx.Dao.Pusher == nil {
+		return nil
+	}
+
+	messages := c.makePushMessages(in.GetUserId(), in.GetUpdates())
+	if len(messages) == 0 {
+		return nil
+	}
+
+	devices, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionGetUserPushDevices(c.ctx, &authsession.TLAuthsessionGetUserPushDevices{
+		UserId: in.GetUserId(),
+	})
+	if err != nil {
+		c.Logger.Errorf("sync.pushUpdatesIfNot - error: %v", err)
+		return err
+	}
+
+	for _, device := range devices.GetDatas() {
+		if !c.svcCtx.Dao.Pusher.Supports(device.GetTokenType()) {
+			continue
+		}
+		if containsInt64(in.GetExcludes(), device.GetAuthKeyId()) {
+			continue
+		}
+
+		for _, m := range messages {
+			if m.muted && device.GetNoMuted() {
+				continue
+			}
+
+			// the device is offline, so once a lock period is set it is locked
+			payload := m.preview
+			if device.GetLockedPeriod() > 0 {
+				payload = m.hidden
+			}
+
+			err = c.svcCtx.Dao.Pusher.Push(c.ctx, device, &push.Notification{
+				Payload: payload,
+				Silent:  m.muted || m.silent,
+			})
+			if err == push.ErrInvalidToken {
+				c.Logger.Infof("sync.pushUpdatesIfNot - unregister device: {user_id: %d, token_type: %d}", device.GetUserId(), device.GetTokenType())
+				c.svcCtx.Dao.AuthsessionClient.AuthsessionUnregisterDevice(c.ctx, &authsession.TLAuthsessionUnregisterDevice{
+					AuthKeyId: device.GetAuthKeyId(),
+					UserId:    device.GetUserId(),
+					TokenType: device.GetTokenType(),
+					Token:     device.GetToken(),
+				})
+				break
+			} else if err != nil {
+				c.Logger.Errorf("sync.pushUpdatesIfNot - push error: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *NotificationCore) makePushMessages(userId int64, updates *mtproto.Updates) []*pushMessage {
+	var (
+		messages []*pushMessage
+	)
+
+	onNewMessage := func(
+		userId int64,
+		update *mtproto.Update,
+		users []*mtproto.User,
+		chats []*mtproto.Chat,
+		date int32,
+	) {
+		if m := c.makeMessagePush(userId, update.GetMessage_MESSAGE(), users, chats); m != nil {
+			messages = append(messages, m)
+		}
+	}
+
+	mtproto.VisitUpdates(userId, updates, map[string]mtproto.UpdateVisitedFunc{
+		mtproto.Predicate_updateNewMessage:        onNewMessage,
+		mtproto.Predicate_updateNewChannelMessage: onNewMessage,
+		mtproto.Predicate_updatePhoneCall: func(
+			userId int64,
+			update *mtproto.Update,
+			users []*mtproto.User,
+			chats []*mtproto.Chat,
+			date int32,
+		) {
+			call := update.GetPhoneCall()
+			if call.GetPredicateName() != mtproto.Predicate_phoneCallRequested {
+				return
+			}
+			payload := &push.Payload{
+				LocKey:  "PHONE_CALL_REQUEST",
+				LocArgs: []string{getUserName(users, call.GetAdminId())},
+				Custom: map[string]string{
+					"from_id": strconv.FormatInt(call.GetAdminId(), 10),
+					"call_id": strconv.FormatInt(call.GetId(), 10),
+					"call_ah": strconv.FormatInt(call.GetAccessHash(), 10),
+				},
+				Sound:  "default",
+				UserId: userId,
+			}
+			messages = append(messages, &pushMessage{preview: payload, hidden: payload})
+		},
+	})
+
+	return messages
+}
+
+func (c *NotificationCore) makeMessagePush(userId int64, message *mtproto.Message, users []*mtproto.User, chats []*mtproto.Chat) *pushMessage {
+	if message.GetPredicateName() != mtproto.Predicate_message || message.GetOut() || message.GetPeerId() == nil {
+		return nil
+	}
+
+	var (
+		fromId    = message.GetFromId().GetUserId()
+		peer      = mtproto.FromPeer(message.GetPeerId())
+		classType = int32(mtproto.PEER_USERS)
+		locPrefix = "MESSAGE"
+		locArgs   []string
+		custom    = map[string]string{
+			"msg_id": strconv.FormatInt(int64(message.GetId()), 10),
+		}
+	)
+
+	switch peer.PeerType {
+	case mtproto.PEER_USER:
+		fromId = peer.PeerId
+		locArgs = []string{getUserName(users, fromId)}
+		custom["from_id"] = strconv.FormatInt(fromId, 10)
+	case mtproto.PEER_CHAT:
+		classType = mtproto.PEER_CHATS
+		locPrefix = "CHAT_MESSAGE"
+		locArgs = []string{getUserName(users, fromId), getChatTitle(chats, peer.PeerId)}
+		custom["chat_id"] = strconv.FormatInt(peer.PeerId, 10)
+		custom["from_id"] = strconv.FormatInt(fromId, 10)
+	case mtproto.PEER_CHANNEL:
+		classType = mtproto.PEER_CHATS
+		locPrefix = "CHAT_MESSAGE"
+		locArgs = []string{getUserName(users, fromId), getChatTitle(chats, peer.PeerId)}
+		for _, chat := range chats {
+			if chat.GetId() == peer.PeerId && chat.GetBroadcast() {
+				classType = mtproto.PEER_BROADCASTS
+				locPrefix = "CHANNEL_MESSAGE"
+				locArgs = locArgs[1:]
+			}
+		}
+		custom["channel_id"] = strconv.FormatInt(peer.PeerId, 10)
+		if fromId != 0 {
+			custom["from_id"] = strconv.FormatInt(fromId, 10)
+		}
+	default:
+		return nil
+	}
+
+	settings := c.getNotifySettings(userId, peer, classType)
+	m := &pushMessage{
+		hidden: &push.Payload{
+			LocKey:  locPrefix + "_NOTEXT",
+			LocArgs: locArgs,
+			Custom:  custom,
+			UserId:  userId,
+		},
+		muted:  settings.GetMuteUntil().GetValue() > int32(time.Now().Unix()),
+		silent: message.GetSilent() || mtproto.FromBool(settings.GetSilent()),
+	}
+	if !m.silent {
+		m.hidden.Sound = settings.GetSound().GetValue()
+	}
+
+	m.preview = m.hidden
+	if message.GetMessage() != "" && mtproto.FromBool(settings.GetShowPreviews()) {
+		m.preview = &push.Payload{
+			LocKey:  locPrefix + "_TEXT",
+			LocArgs: append(append([]string{}, locArgs...), message.GetMessage()),
+			Custom:  custom,
+			Sound:   m.hidden.Sound,
+			UserId:  userId,
+		}
+	}
+
+	return m
+}
+
+// getNotifySettings returns the settings of peer, unset fields fall back to the
+// default of its class (users, chats or broadcasts).
+func (c *NotificationCore) getNotifySettings(userId int64, peer *mtproto.PeerUtil, classType int32) *mtproto.PeerNotifySettings {
+	settings, err := c.svcCtx.Dao.UserClient.UserGetNotifySettings(c.ctx, &userpb.TLUserGetNotifySettings{
+		UserId:   userId,
+		PeerType: peer.PeerType,
+		PeerId:   peer.PeerId,
+	})
+	if err != nil {
+		c.Logger.Errorf("user.getNotifySettings - error: %v", err)
+		return mtproto.DefaultPeerNotifySettings
+	}
+	if settings.ShowPreviews != nil && settings.Silent != nil && settings.MuteUntil != nil && settings.Sound != nil {
+		return settings
+	}
+
+	defaults, err := c.svcCtx.Dao.UserClient.UserGetNotifySettings(c.ctx, &userpb.TLUserGetNotifySettings{
+		UserId:   userId,
+		PeerType: classType,
+		PeerId:   0,
+	})
+	if err != nil {
+		c.Logger.Errorf("user.getNotifySettings - error: %v", err)
+		defaults = mtproto.DefaultPeerNotifySettings
+	}
+	if settings.ShowPreviews == nil {
+		settings.ShowPreviews = defaults.ShowPreviews
+	}
+	if settings.Silent == nil {
+		settings.Silent = defaults.Silent
+	}
+	if settings.MuteUntil == nil {
+		settings.MuteUntil = defaults.MuteUntil
+	}
+	if settings.Sound == nil {
+		settings.Sound = defaults.Sound
+	}
+
+	return settings
+}
+
+func getUserName(users []*mtproto.User, id int64) string {
+	for _, u := range users {
+		if u.GetId() == id {
+			return strings.TrimSpace(u.GetFirstName().GetValue() + " " + u.GetLastName().GetValue())
+		}
+	}
+	return ""
+}
+
+func getChatTitle(chats []*mtproto.Chat, id int64) string {
+	for _, chat := range chats {
+		if chat.GetId() == id {
+			return chat.GetTitle()
+		}
+	}
+	return ""
+}
+
+func containsInt64(list []int64, v int64) bool {
+	for _, i := range list {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
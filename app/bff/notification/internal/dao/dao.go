@@ -19,24 +19,34 @@
 package dao
 
 import (
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/teamgram-server/app/bff/notification/internal/config"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/push"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
+	authsession_client "github.com/teamgram/teamgram-server/app/service/authsession/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 )
 
 type Dao struct {
 	user_client.UserClient
 	chat_client.ChatClient
 	sync_client.SyncClient
+	authsession_client.AuthsessionClient
+	Pusher *push.Pusher
 }
 
 func New(c config.Config) *Dao {
-	return &Dao{
-		UserClient: user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
-		ChatClient: chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
-		SyncClient: sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+	d := &Dao{
+		UserClient:        user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		ChatClient:        chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
+		SyncClient:        sync_client.NewSyncMqClient(mqx.MustProducer(c.SyncClient)),
+		AuthsessionClient: authsession_client.NewAuthsessionClient(rpcx.GetCachedRpcClient(c.AuthsessionClient)),
 	}
+	if c.Push != nil {
+		d.Pusher = push.MustNew(*c.Push)
+	}
+
+	return d
 }
@@ -0,0 +1,196 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+const (
+	apnsProductionEndpoint = "https://api.push.apple.com"
+	apnsSandboxEndpoint    = "https://api.sandbox.push.apple.com"
+
+	// apple rejects provider tokens older than one hour
+	apnsTokenRefreshInterval = 50 * time.Minute
+)
+
+type APNsConfig struct {
+	KeyFile         string // .p8 auth key downloaded from the apple developer account
+	KeyId           string
+	TeamId          string
+	Topic           string // the app bundle id, voip pushes go to Topic + ".voip"
+	Endpoint        string `json:",optional"`
+	SandboxEndpoint string `json:",optional"`
+}
+
+// APNsSender talks to the APNs HTTP/2 provider API with token based authentication.
+type APNsSender struct {
+	conf   APNsConfig
+	client *http.Client
+	key    *ecdsa.PrivateKey
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+func NewAPNsSender(c APNsConfig, client *http.Client) (*APNsSender, error) {
+	key, err := loadECPrivateKey(c.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Endpoint == "" {
+		c.Endpoint = apnsProductionEndpoint
+	}
+	if c.SandboxEndpoint == "" {
+		c.SandboxEndpoint = apnsSandboxEndpoint
+	}
+
+	return &APNsSender{
+		conf:   c,
+		client: client,
+		key:    key,
+	}, nil
+}
+
+func (s *APNsSender) providerToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Since(s.issuedAt) < apnsTokenRefreshInterval {
+		return s.token, nil
+	}
+
+	now := time.Now()
+	token, err := signES256(
+		s.key,
+		map[string]interface{}{"kid": s.conf.KeyId},
+		map[string]interface{}{"iss": s.conf.TeamId, "iat": now.Unix()})
+	if err != nil {
+		return "", err
+	}
+	s.token, s.issuedAt = token, now
+
+	return token, nil
+}
+
+func (s *APNsSender) Send(ctx context.Context, device *authsession.PushDevice, n *Notification) error {
+	var (
+		isVoip   = device.GetTokenType() == TokenTypeAPNSVoIP
+		topic    = s.conf.Topic
+		pushType = "alert"
+		priority = "10"
+		endpoint = s.conf.Endpoint
+	)
+
+	data, err := encodePayload(device, n.Payload)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case isVoip:
+		topic += ".voip"
+		pushType = "voip"
+	case n.Silent:
+		pushType = "background"
+		priority = "5"
+		data["aps"] = map[string]interface{}{"content-available": 1}
+	default:
+		aps := map[string]interface{}{}
+		if _, ok := data["p"]; ok {
+			// decrypted and rendered by the notification service extension
+			aps["alert"] = map[string]interface{}{"body": "You have a new message"}
+			aps["mutable-content"] = 1
+		} else {
+			aps["alert"] = map[string]interface{}{
+				"loc-key":  n.Payload.LocKey,
+				"loc-args": n.Payload.LocArgs,
+			}
+			delete(data, "loc_key")
+			delete(data, "loc_args")
+		}
+		if n.Payload.Sound != "" {
+			aps["sound"] = n.Payload.Sound
+		}
+		data["aps"] = aps
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if device.GetAppSandbox() {
+		endpoint = s.conf.SandboxEndpoint
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/3/device/"+device.GetToken(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	token, err := s.providerToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", "bearer "+token)
+	req.Header.Set("apns-topic", topic)
+	req.Header.Set("apns-push-type", pushType)
+	req.Header.Set("apns-priority", priority)
+	if n.TTL > 0 {
+		req.Header.Set("apns-expiration", strconv.FormatInt(time.Now().Add(n.TTL).Unix(), 10))
+	}
+	req.Header.Set("content-type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var r struct {
+		Reason string `json:"reason"`
+	}
+	json.NewDecoder(resp.Body).Decode(&r)
+
+	switch {
+	case resp.StatusCode == http.StatusGone,
+		r.Reason == "BadDeviceToken",
+		r.Reason == "Unregistered",
+		r.Reason == "DeviceTokenNotForTopic":
+		return ErrInvalidToken
+	}
+
+	return fmt.Errorf("apns: status %d, reason %s", resp.StatusCode, r.Reason)
+}
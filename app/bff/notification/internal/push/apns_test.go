@@ -0,0 +1,153 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+func writeP8Key(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := filepath.Join(t.TempDir(), "AuthKey_TEST.p8")
+	if err = ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestAPNsSender(t *testing.T) {
+	type request struct {
+		path   string
+		header http.Header
+		body   map[string]interface{}
+	}
+	var requests []request
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			t.Errorf("expected HTTP/2, got %s", r.Proto)
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, request{r.URL.Path, r.Header, body})
+
+		if strings.HasSuffix(r.URL.Path, "/expired") {
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte(`{"reason":"Unregistered"}`))
+		}
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	s, err := NewAPNsSender(APNsConfig{
+		KeyFile:         writeP8Key(t),
+		KeyId:           "KEYID",
+		TeamId:          "TEAMID",
+		Topic:           "org.teamgram.app",
+		Endpoint:        srv.URL,
+		SandboxEndpoint: srv.URL + "/sandbox",
+	}, srv.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := &Notification{Payload: &Payload{
+		LocKey:  "MESSAGE_TEXT",
+		LocArgs: []string{"Alice", "hi"},
+		Custom:  map[string]string{"from_id": "1"},
+		Sound:   "default",
+		UserId:  2,
+	}}
+
+	device := authsession.MakeTLPushDevice(&authsession.PushDevice{
+		TokenType: TokenTypeAPNS,
+		Token:     "token1",
+	}).To_PushDevice()
+	if err = s.Send(context.Background(), device, n); err != nil {
+		t.Fatal(err)
+	}
+
+	device.AppSandbox = true
+	device.TokenType = TokenTypeAPNSVoIP
+	device.Secret = newSecret(t)
+	if err = s.Send(context.Background(), device, n); err != nil {
+		t.Fatal(err)
+	}
+
+	device.Token = "expired"
+	if err = s.Send(context.Background(), device, n); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(requests))
+	}
+
+	r := requests[0]
+	if r.path != "/3/device/token1" {
+		t.Errorf("unexpected path %s", r.path)
+	}
+	if r.header.Get("apns-topic") != "org.teamgram.app" || r.header.Get("apns-push-type") != "alert" {
+		t.Errorf("unexpected headers %v", r.header)
+	}
+	if !strings.HasPrefix(r.header.Get("authorization"), "bearer ") {
+		t.Errorf("missing provider token")
+	}
+	alert := r.body["aps"].(map[string]interface{})["alert"].(map[string]interface{})
+	if alert["loc-key"] != "MESSAGE_TEXT" {
+		t.Errorf("unexpected alert %v", alert)
+	}
+
+	r = requests[1]
+	if r.path != "/sandbox/3/device/token1" {
+		t.Errorf("unexpected path %s", r.path)
+	}
+	if r.header.Get("apns-topic") != "org.teamgram.app.voip" || r.header.Get("apns-push-type") != "voip" {
+		t.Errorf("unexpected headers %v", r.header)
+	}
+	var payload Payload
+	json.Unmarshal(decryptPayload(t, device.Secret, r.body["p"].(string)), &payload)
+	if payload.LocKey != "MESSAGE_TEXT" || payload.UserId != 2 {
+		t.Errorf("unexpected payload %v", payload)
+	}
+}
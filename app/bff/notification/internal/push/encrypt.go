@@ -0,0 +1,72 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto/crypto"
+)
+
+const pushSecretSize = 256
+
+// EncryptPayload
+/*
+## android client, PushListenerController.processRemoteMessage:
+
+	byte[] bytes = Base64.decode(data, Base64.URL_SAFE);
+	NativeByteBuffer buffer = new NativeByteBuffer(bytes.length);
+	...
+	byte[] authKeyId = new byte[8];
+	buffer.readBytes(authKeyId, true);
+	byte[] messageKey = new byte[16];
+	buffer.readBytes(messageKey, true);
+	MessageKeyData messageKeyData = MessageKeyData.generateMessageKeyData(SharedConfig.pushAuthKey, messageKey, true, 2);
+	Utilities.aesIgeEncryption(buffer.buffer, messageKeyData.aesKey, messageKeyData.aesIv, false, false, 24, bytes.length - 24);
+	...
+	int len = buffer.readInt32(true);
+	String jsonString = new String(strBytes, 0, len);
+
+the secret is used as an mtproto 2.0 auth_key (x = 8, server to client).
+*/
+func EncryptPayload(secret, data []byte) (string, error) {
+	if len(secret) != pushSecretSize {
+		return "", fmt.Errorf("push: invalid secret size %d", len(secret))
+	}
+
+	key := crypto.NewAuthKey(0, secret)
+
+	rawData := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(rawData, uint32(len(data)))
+	copy(rawData[4:], data)
+
+	msgKey, encrypted, err := key.AesIgeEncrypt(rawData)
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 8+16+len(encrypted))
+	binary.LittleEndian.PutUint64(buf, uint64(key.AuthKeyId()))
+	copy(buf[8:], msgKey)
+	copy(buf[24:], encrypted)
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto/crypto"
+)
+
+// decryptPayload mirrors the android client, see EncryptPayload.
+func decryptPayload(t *testing.T, secret []byte, p string) []byte {
+	buf, err := base64.RawURLEncoding.DecodeString(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyHash := sha1.Sum(secret)
+	if !bytes.Equal(buf[:8], keyHash[12:]) {
+		t.Fatalf("auth_key_id mismatch")
+	}
+
+	const x = 8
+	msgKey := buf[8:24]
+	a := sha256.Sum256(append(append([]byte{}, msgKey...), secret[x:x+36]...))
+	b := sha256.Sum256(append(append([]byte{}, secret[40+x:40+x+36]...), msgKey...))
+	aesKey := append(append(append([]byte{}, a[:8]...), b[8:24]...), a[24:32]...)
+	aesIV := append(append(append([]byte{}, b[:8]...), a[8:24]...), b[24:32]...)
+
+	plain, err := crypto.NewAES256IGECryptor(aesKey, aesIV).Decrypt(buf[24:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := sha256.Sum256(append(append([]byte{}, secret[88+x:88+x+32]...), plain...))
+	if !bytes.Equal(h[8:24], msgKey) {
+		t.Fatalf("msg_key mismatch")
+	}
+
+	return plain[4 : 4+binary.LittleEndian.Uint32(plain)]
+}
+
+func newSecret(t *testing.T) []byte {
+	secret := make([]byte, pushSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatal(err)
+	}
+	return secret
+}
+
+func TestEncryptPayload(t *testing.T) {
+	secret := newSecret(t)
+	data := []byte(`{"loc_key":"MESSAGE_TEXT","loc_args":["Alice","hi"]}`)
+
+	p, err := EncryptPayload(secret, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := decryptPayload(t, secret, p); !bytes.Equal(got, data) {
+		t.Fatalf("got %s, want %s", got, data)
+	}
+
+	if _, err = EncryptPayload(secret[:32], data); err == nil {
+		t.Fatal("expected error for short secret")
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+const fcmDefaultEndpoint = "https://fcm.googleapis.com/fcm/send"
+
+type FCMConfig struct {
+	ServerKey string
+	Endpoint  string `json:",optional"`
+}
+
+// FCMSender sends data messages through the FCM HTTP API.
+type FCMSender struct {
+	conf   FCMConfig
+	client *http.Client
+}
+
+func NewFCMSender(c FCMConfig, client *http.Client) *FCMSender {
+	if c.Endpoint == "" {
+		c.Endpoint = fcmDefaultEndpoint
+	}
+
+	return &FCMSender{
+		conf:   c,
+		client: client,
+	}
+}
+
+type fcmMessage struct {
+	To         string                 `json:"to"`
+	Priority   string                 `json:"priority"`
+	TimeToLive int64                  `json:"time_to_live,omitempty"`
+	Data       map[string]interface{} `json:"data"`
+}
+
+type fcmResponse struct {
+	Success int `json:"success"`
+	Failure int `json:"failure"`
+	Results []struct {
+		MessageId string `json:"message_id"`
+		Error     string `json:"error"`
+	} `json:"results"`
+}
+
+func (s *FCMSender) Send(ctx context.Context, device *authsession.PushDevice, n *Notification) error {
+	data, err := encodePayload(device, n.Payload)
+	if err != nil {
+		return err
+	}
+
+	msg := &fcmMessage{
+		To:         device.GetToken(),
+		Priority:   "high",
+		TimeToLive: int64(n.TTL.Seconds()),
+		Data:       data,
+	}
+	if n.Silent {
+		msg.Priority = "normal"
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.conf.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "key="+s.conf.ServerKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fcm: status %d", resp.StatusCode)
+	}
+
+	var r fcmResponse
+	if err = json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return err
+	}
+	if r.Failure == 0 {
+		return nil
+	}
+
+	for _, result := range r.Results {
+		switch result.Error {
+		case "":
+		case "NotRegistered", "InvalidRegistration", "MismatchSenderId":
+			return ErrInvalidToken
+		default:
+			return fmt.Errorf("fcm: %s", result.Error)
+		}
+	}
+
+	return fmt.Errorf("fcm: failure %d", r.Failure)
+}
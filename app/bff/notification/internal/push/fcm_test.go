@@ -0,0 +1,86 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+func TestFCMSender(t *testing.T) {
+	var messages []fcmMessage
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "key=server-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var msg fcmMessage
+		json.NewDecoder(r.Body).Decode(&msg)
+		messages = append(messages, msg)
+
+		if msg.To == "stale" {
+			w.Write([]byte(`{"success":0,"failure":1,"results":[{"error":"NotRegistered"}]}`))
+		} else {
+			w.Write([]byte(`{"success":1,"failure":0,"results":[{"message_id":"1"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	s := NewFCMSender(FCMConfig{ServerKey: "server-key", Endpoint: srv.URL}, srv.Client())
+
+	device := authsession.MakeTLPushDevice(&authsession.PushDevice{
+		TokenType: TokenTypeFCM,
+		Token:     "token1",
+		Secret:    newSecret(t),
+	}).To_PushDevice()
+	n := &Notification{
+		Payload: &Payload{LocKey: "MESSAGE_NOTEXT", LocArgs: []string{"Alice"}, UserId: 2},
+		Silent:  true,
+	}
+
+	if err := s.Send(context.Background(), device, n); err != nil {
+		t.Fatal(err)
+	}
+
+	device.Token = "stale"
+	if err := s.Send(context.Background(), device, n); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+	msg := messages[0]
+	if msg.To != "token1" || msg.Priority != "normal" {
+		t.Errorf("unexpected message %v", msg)
+	}
+
+	var payload Payload
+	json.Unmarshal(decryptPayload(t, device.Secret, msg.Data["p"].(string)), &payload)
+	if payload.LocKey != "MESSAGE_NOTEXT" || payload.LocArgs[0] != "Alice" {
+		t.Errorf("unexpected payload %v", payload)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// token_type values of account.registerDevice, see https://core.telegram.org/api/push-updates
+const (
+	TokenTypeAPNS      = 1
+	TokenTypeFCM       = 2
+	TokenTypeMTProto   = 7
+	TokenTypeAPNSVoIP  = 9
+	TokenTypeWebPush   = 10
+	defaultSendTimeout = 10 * time.Second
+)
+
+var (
+	// ErrInvalidToken is returned by a Sender when the provider reports the token
+	// as expired or unknown, the device should be unregistered.
+	ErrInvalidToken = errors.New("push: invalid device token")
+
+	// ErrNoSender is returned when no Sender is configured for the token type.
+	ErrNoSender = errors.New("push: no sender for token type")
+)
+
+// Payload is the telegram push notification body, clients render it from loc_key/loc_args.
+type Payload struct {
+	LocKey  string            `json:"loc_key"`
+	LocArgs []string          `json:"loc_args"`
+	Custom  map[string]string `json:"custom,omitempty"`
+	Sound   string            `json:"sound,omitempty"`
+	UserId  int64             `json:"user_id,string"`
+}
+
+// Notification is what a Sender delivers to a single device.
+type Notification struct {
+	Payload *Payload
+	// Silent notifications are delivered without alert and sound
+	Silent bool
+	// TTL of the notification on the provider side
+	TTL time.Duration
+}
+
+// Sender delivers notifications to one provider (APNs, FCM, WebPush...).
+type Sender interface {
+	Send(ctx context.Context, device *authsession.PushDevice, n *Notification) error
+}
+
+// Config of all senders, a nil entry disables the provider.
+type Config struct {
+	Timeout time.Duration  `json:",default=10s"`
+	APNs    *APNsConfig    `json:",optional"`
+	FCM     *FCMConfig     `json:",optional"`
+	WebPush *WebPushConfig `json:",optional"`
+}
+
+type Pusher struct {
+	senders map[int32]Sender
+}
+
+func New(c Config) (*Pusher, error) {
+	p := &Pusher{
+		senders: make(map[int32]Sender),
+	}
+
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = defaultSendTimeout
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:             http.ProxyFromEnvironment,
+			ForceAttemptHTTP2: true,
+			MaxIdleConns:      100,
+			IdleConnTimeout:   90 * time.Second,
+		},
+		Timeout: timeout,
+	}
+
+	if c.APNs != nil {
+		s, err := NewAPNsSender(*c.APNs, client)
+		if err != nil {
+			return nil, err
+		}
+		p.Register(TokenTypeAPNS, s)
+		p.Register(TokenTypeAPNSVoIP, s)
+	}
+	if c.FCM != nil {
+		p.Register(TokenTypeFCM, NewFCMSender(*c.FCM, client))
+	}
+	if c.WebPush != nil {
+		s, err := NewWebPushSender(*c.WebPush, client)
+		if err != nil {
+			return nil, err
+		}
+		p.Register(TokenTypeWebPush, s)
+	}
+
+	return p, nil
+}
+
+func MustNew(c Config) *Pusher {
+	p, err := New(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// Register installs s for tokenType, replacing the previous one.
+func (p *Pusher) Register(tokenType int32, s Sender) {
+	p.senders[tokenType] = s
+}
+
+func (p *Pusher) Supports(tokenType int32) bool {
+	_, ok := p.senders[tokenType]
+	return ok
+}
+
+func (p *Pusher) Push(ctx context.Context, device *authsession.PushDevice, n *Notification) error {
+	s, ok := p.senders[device.GetTokenType()]
+	if !ok {
+		return ErrNoSender
+	}
+
+	return s.Send(ctx, device, n)
+}
+
+// encodePayload returns the json body delivered to the client app, when the device
+// registered a secret the payload is wrapped as {"p": encrypted} so only the client can read it.
+func encodePayload(device *authsession.PushDevice, payload *Payload) (map[string]interface{}, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(device.GetSecret()) == 0 {
+		var m map[string]interface{}
+		if err = json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	p, err := EncryptPayload(device.GetSecret(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"p": p}, nil
+}
@@ -0,0 +1,274 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	webPushRecordSize = 4096
+	webPushDefaultTTL = 24 * time.Hour
+)
+
+var errWebPushPayloadTooLarge = errors.New("webpush: payload too large")
+
+type WebPushConfig struct {
+	Subject    string // mailto: or https: contact of the application server
+	PrivateKey string // base64url encoded P-256 private key of the VAPID key pair
+}
+
+// webPushSubscription is the token of token_type 10, the PushSubscription
+// json of the browser.
+type webPushSubscription struct {
+	Endpoint string `json:"endpoint"`
+	Keys     struct {
+		P256dh string `json:"p256dh"`
+		Auth   string `json:"auth"`
+	} `json:"keys"`
+}
+
+// ValidateWebPushToken checks the subscription json registered by account.registerDevice.
+func ValidateWebPushToken(token string) error {
+	var sub webPushSubscription
+	if err := json.Unmarshal([]byte(token), &sub); err != nil {
+		return mtproto.ErrWebpushTokenInvalid
+	}
+	if endpoint, err := url.Parse(sub.Endpoint); err != nil || endpoint.Host == "" {
+		return mtproto.ErrWebpushTokenInvalid
+	}
+	if key, err := decodeBase64(sub.Keys.P256dh); err != nil {
+		return mtproto.ErrWebpushKeyInvalid
+	} else if x, _ := elliptic.Unmarshal(elliptic.P256(), key); x == nil {
+		return mtproto.ErrWebpushKeyInvalid
+	}
+	if auth, err := decodeBase64(sub.Keys.Auth); err != nil || len(auth) != 16 {
+		return mtproto.ErrWebpushAuthInvalid
+	}
+
+	return nil
+}
+
+// WebPushSender sends RFC 8291 encrypted messages with VAPID (RFC 8292) authentication.
+type WebPushSender struct {
+	conf      WebPushConfig
+	client    *http.Client
+	key       *ecdsa.PrivateKey
+	publicKey string
+}
+
+func NewWebPushSender(c WebPushConfig, client *http.Client) (*WebPushSender, error) {
+	d, err := decodeBase64(c.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	curve := elliptic.P256()
+	key := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(d)}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(d)
+
+	return &WebPushSender{
+		conf:      c,
+		client:    client,
+		key:       key,
+		publicKey: base64.RawURLEncoding.EncodeToString(elliptic.Marshal(curve, key.X, key.Y)),
+	}, nil
+}
+
+func (s *WebPushSender) Send(ctx context.Context, device *authsession.PushDevice, n *Notification) error {
+	var sub webPushSubscription
+	if err := json.Unmarshal([]byte(device.GetToken()), &sub); err != nil {
+		return ErrInvalidToken
+	}
+	endpoint, err := url.Parse(sub.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return ErrInvalidToken
+	}
+
+	data, err := encodePayload(device, n.Payload)
+	if err != nil {
+		return err
+	}
+	plaintext, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	body, err := encryptWebPush(sub.Keys.P256dh, sub.Keys.Auth, plaintext)
+	if err == errWebPushPayloadTooLarge {
+		return err
+	} else if err != nil {
+		return ErrInvalidToken
+	}
+
+	token, err := signES256(
+		s.key,
+		map[string]interface{}{"typ": "JWT"},
+		map[string]interface{}{
+			"aud": endpoint.Scheme + "://" + endpoint.Host,
+			"exp": time.Now().Add(12 * time.Hour).Unix(),
+			"sub": s.conf.Subject,
+		})
+	if err != nil {
+		return err
+	}
+
+	ttl := n.TTL
+	if ttl == 0 {
+		ttl = webPushDefaultTTL
+	}
+	urgency := "high"
+	if n.Silent {
+		urgency = "low"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "vapid t="+token+", k="+s.publicKey)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.FormatInt(int64(ttl.Seconds()), 10))
+	req.Header.Set("Urgency", urgency)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted:
+		return nil
+	case http.StatusNotFound, http.StatusGone:
+		return ErrInvalidToken
+	default:
+		return fmt.Errorf("webpush: status %d", resp.StatusCode)
+	}
+}
+
+// encryptWebPush encrypts plaintext as a single aes128gcm record (RFC 8188) with
+// the key derivation of RFC 8291.
+func encryptWebPush(p256dh, auth string, plaintext []byte) ([]byte, error) {
+	uaPublic, err := decodeBase64(p256dh)
+	if err != nil {
+		return nil, err
+	}
+	authSecret, err := decodeBase64(auth)
+	if err != nil {
+		return nil, err
+	}
+
+	curve := elliptic.P256()
+	uaX, uaY := elliptic.Unmarshal(curve, uaPublic)
+	if uaX == nil {
+		return nil, errors.New("webpush: invalid p256dh key")
+	}
+
+	asKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := elliptic.Marshal(curve, asKey.X, asKey.Y)
+
+	sx, _ := curve.ScalarMult(uaX, uaY, asKey.D.Bytes())
+	ecdhSecret := sx.FillBytes(make([]byte, 32))
+
+	salt := make([]byte, 16)
+	if _, err = io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPublic...), asPublic...)
+	ikm, err := hkdfExpand(ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdfExpand(ikm, salt, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdfExpand(ikm, salt, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// 0x02 is the padding delimiter of the last record
+	record := append(append([]byte{}, plaintext...), 0x02)
+	if len(record)+gcm.Overhead() > webPushRecordSize {
+		return nil, errWebPushPayloadTooLarge
+	}
+
+	header := make([]byte, 16+4+1+len(asPublic))
+	copy(header, salt)
+	binary.BigEndian.PutUint32(header[16:], webPushRecordSize)
+	header[20] = byte(len(asPublic))
+	copy(header[21:], asPublic)
+
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+func hkdfExpand(secret, salt, info []byte, size int) ([]byte, error) {
+	out := make([]byte, size)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// decodeBase64 accepts both padded and unpadded, url and std alphabets, browsers are not consistent.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	s = strings.NewReplacer("+", "-", "/", "_").Replace(s)
+	return base64.RawURLEncoding.DecodeString(s)
+}
@@ -0,0 +1,164 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package push
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// decryptWebPush is the user agent side of RFC 8291.
+func decryptWebPush(t *testing.T, uaKey *ecdsa.PrivateKey, authSecret, body []byte) []byte {
+	curve := elliptic.P256()
+	salt := body[:16]
+	idLen := int(body[20])
+	asPublic := body[21 : 21+idLen]
+	ciphertext := body[21+idLen:]
+
+	asX, asY := elliptic.Unmarshal(curve, asPublic)
+	sx, _ := curve.ScalarMult(asX, asY, uaKey.D.Bytes())
+	uaPublic := elliptic.Marshal(curve, uaKey.X, uaKey.Y)
+
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPublic...), asPublic...)
+	ikm, _ := hkdfExpand(sx.FillBytes(make([]byte, 32)), authSecret, keyInfo, 32)
+	cek, _ := hkdfExpand(ikm, salt, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	nonce, _ := hkdfExpand(ikm, salt, []byte("Content-Encoding: nonce\x00"), 12)
+
+	block, _ := aes.NewCipher(cek)
+	gcm, _ := cipher.NewGCM(block)
+	plain, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain[len(plain)-1] != 0x02 {
+		t.Fatalf("missing record delimiter")
+	}
+
+	return plain[:len(plain)-1]
+}
+
+func verifyVapid(t *testing.T, header string) {
+	if !strings.HasPrefix(header, "vapid t=") {
+		t.Fatalf("unexpected Authorization %s", header)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(header, "vapid t="), ", k=", 2)
+	jwt, k := parts[0], parts[1]
+
+	pub, _ := base64.RawURLEncoding.DecodeString(k)
+	x, y := elliptic.Unmarshal(elliptic.P256(), pub)
+	segs := strings.Split(jwt, ".")
+	sig, _ := base64.RawURLEncoding.DecodeString(segs[2])
+	digest := sha256.Sum256([]byte(segs[0] + "." + segs[1]))
+	if !ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])) {
+		t.Fatalf("invalid vapid signature")
+	}
+}
+
+func TestWebPushSender(t *testing.T) {
+	var bodies [][]byte
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		if r.Header.Get("Content-Encoding") != "aes128gcm" || r.Header.Get("TTL") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		verifyVapid(t, r.Header.Get("Authorization"))
+
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	vapidKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s, err := NewWebPushSender(WebPushConfig{
+		Subject:    "mailto:admin@teamgram.io",
+		PrivateKey: base64.RawURLEncoding.EncodeToString(vapidKey.D.FillBytes(make([]byte, 32))),
+	}, srv.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uaKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	authSecret := make([]byte, 16)
+	rand.Read(authSecret)
+
+	subscription := func(path string) string {
+		var sub webPushSubscription
+		sub.Endpoint = srv.URL + path
+		sub.Keys.P256dh = base64.RawURLEncoding.EncodeToString(elliptic.Marshal(elliptic.P256(), uaKey.X, uaKey.Y))
+		sub.Keys.Auth = base64.URLEncoding.EncodeToString(authSecret)
+		b, _ := json.Marshal(sub)
+		return string(b)
+	}
+
+	device := authsession.MakeTLPushDevice(&authsession.PushDevice{
+		TokenType: TokenTypeWebPush,
+		Token:     subscription("/push/1"),
+		Secret:    newSecret(t),
+	}).To_PushDevice()
+	n := &Notification{Payload: &Payload{LocKey: "CHAT_MESSAGE_TEXT", LocArgs: []string{"Alice", "Group", "hi"}, UserId: 2}}
+
+	if err = s.Send(context.Background(), device, n); err != nil {
+		t.Fatal(err)
+	}
+
+	device.Token = subscription("/gone")
+	if err = s.Send(context.Background(), device, n); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+
+	device.Token = "not a subscription"
+	if err = s.Send(context.Background(), device, n); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+
+	if len(bodies) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(bodies))
+	}
+
+	var data map[string]string
+	if err = json.Unmarshal(decryptWebPush(t, uaKey, authSecret, bodies[0]), &data); err != nil {
+		t.Fatal(err)
+	}
+	var payload Payload
+	json.Unmarshal(decryptPayload(t, device.Secret, data["p"]), &payload)
+	if payload.LocKey != "CHAT_MESSAGE_TEXT" || payload.LocArgs[2] != "hi" {
+		t.Errorf("unexpected payload %v", payload)
+	}
+}
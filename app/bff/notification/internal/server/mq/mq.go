@@ -0,0 +1,51 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/core"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/svc"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// New consumes the sync.pushUpdatesIfNot messages sync produces for users' offline devices.
+func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf) mqx.Consumer {
+	s := mqx.MustConsumer(&conf)
+	s.RegisterHandlers(
+		conf.Topics[0],
+		func(ctx context.Context, key string, value []byte) {
+			logx.WithContext(ctx).Debugf("key: %s, value: %s", key, value)
+
+			switch strings.Split(key, "#")[0] {
+			case proto.MessageName((*sync.TLSyncPushUpdatesIfNot)(nil)):
+				c := core.New(ctx, svcCtx)
+
+				r := new(sync.TLSyncPushUpdatesIfNot)
+				if err := json.Unmarshal(value, r); err != nil {
+					c.Logger.Error(err.Error())
+					return
+				}
+				c.Logger.Debugf("sync.pushUpdatesIfNot - request: %s", r.DebugString())
+
+				c.PushUpdatesIfNot(r)
+			default:
+				err := fmt.Errorf("invalid key: %s", key)
+				logx.Error(err.Error())
+			}
+		})
+	return s
+}
@@ -23,7 +23,9 @@ import (
 
 	"github.com/teamgram/teamgram-server/app/bff/notification/internal/config"
 	"github.com/teamgram/teamgram-server/app/bff/notification/internal/server/grpc"
+	"github.com/teamgram/teamgram-server/app/bff/notification/internal/server/mq"
 	"github.com/teamgram/teamgram-server/app/bff/notification/internal/svc"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -34,6 +36,7 @@ var configFile = flag.String("f", "etc/notification.yaml", "the config file")
 
 type Server struct {
 	grpcSrv *zrpc.RpcServer
+	mq      mqx.Consumer
 }
 
 func New() *Server {
@@ -48,6 +51,11 @@ func (s *Server) Initialize() error {
 	ctx := svc.NewServiceContext(c, nil)
 	s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
 
+	if c.PushConsumer != nil {
+		s.mq = mq.New(ctx, *c.PushConsumer)
+		go s.mq.Start()
+	}
+
 	go func() {
 		go s.grpcSrv.Start()
 	}()
@@ -59,4 +67,7 @@ func (s *Server) RunLoop() {
 
 func (s *Server) Destroy() {
 	s.grpcSrv.Stop()
+	if s.mq != nil {
+		s.mq.Stop()
+	}
 }
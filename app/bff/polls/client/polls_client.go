@@ -0,0 +1,58 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package polls_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type PollsClient interface {
+	MessagesSendVote(ctx context.Context, in *mtproto.TLMessagesSendVote) (*mtproto.Updates, error)
+	MessagesGetPollResults(ctx context.Context, in *mtproto.TLMessagesGetPollResults) (*mtproto.Updates, error)
+	MessagesGetPollVotes(ctx context.Context, in *mtproto.TLMessagesGetPollVotes) (*mtproto.Messages_VotesList, error)
+}
+
+type defaultPollsClient struct {
+	cli zrpc.Client
+}
+
+func NewPollsClient(cli zrpc.Client) PollsClient {
+	return &defaultPollsClient{
+		cli: cli,
+	}
+}
+
+// MessagesSendVote
+// messages.sendVote#10ea6184 peer:InputPeer msg_id:int options:Vector<bytes> = Updates;
+func (m *defaultPollsClient) MessagesSendVote(ctx context.Context, in *mtproto.TLMessagesSendVote) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCPollsClient(m.cli.Conn())
+	return client.MessagesSendVote(ctx, in)
+}
+
+// MessagesGetPollResults
+// messages.getPollResults#73bb643b peer:InputPeer msg_id:int = Updates;
+func (m *defaultPollsClient) MessagesGetPollResults(ctx context.Context, in *mtproto.TLMessagesGetPollResults) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCPollsClient(m.cli.Conn())
+	return client.MessagesGetPollResults(ctx, in)
+}
+
+// MessagesGetPollVotes
+// messages.getPollVotes#b86e380e flags:# peer:InputPeer id:int option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+func (m *defaultPollsClient) MessagesGetPollVotes(ctx context.Context, in *mtproto.TLMessagesGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	client := mtproto.NewRPCPollsClient(m.cli.Conn())
+	return client.MessagesGetPollVotes(ctx, in)
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// getMessagePollId returns the id of the poll in the message msgId of the caller's box.
+func (c *PollsCore) getMessagePollId(peer *mtproto.PeerUtil, msgId int32) (int64, error) {
+	box, err := c.svcCtx.Dao.MessageClient.MessageGetUserMessage(c.ctx, &message.TLMessageGetUserMessage{
+		UserId: c.MD.UserId,
+		Id:     msgId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	peerType := peer.PeerType
+	if peerType == mtproto.PEER_SELF {
+		peerType = mtproto.PEER_USER
+	}
+	if box.PeerType != peerType || box.PeerId != peer.PeerId {
+		return 0, mtproto.ErrMsgIdInvalid
+	}
+
+	return mtproto.GetPollIdByMessage(box.GetMessage().GetMedia())
+}
+
+// makeUpdatesMessagePoll wraps media into an updateMessagePoll.
+func makeUpdatesMessagePoll(media *mtproto.MessageMedia) *mtproto.Updates {
+	return mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateMessagePoll(&mtproto.Update{
+		PollId:  media.GetPoll().GetId(),
+		Poll:    media.GetPoll(),
+		Results: media.GetResults(),
+	}).To_Update())
+}
+
+// pushUpdateMessagePoll sends the caller's results to the caller's other sessions and
+// min results to every other participant of peer.
+func (c *PollsCore) pushUpdateMessagePoll(peer *mtproto.PeerUtil, media *mtproto.MessageMedia) {
+	var (
+		idList []int64
+	)
+
+	switch peer.PeerType {
+	case mtproto.PEER_USER:
+		idList = []int64{peer.PeerId}
+	case mtproto.PEER_CHAT:
+		rV, err := c.svcCtx.Dao.ChatClient.Client().ChatGetChatParticipantIdList(c.ctx, &chatpb.TLChatGetChatParticipantIdList{
+			ChatId: peer.PeerId,
+		})
+		if err != nil {
+			c.Logger.Errorf("pushUpdateMessagePoll - error: %v", err)
+		}
+		idList = rV.GetDatas()
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates:   makeUpdatesMessagePoll(media),
+	})
+
+	minUpdates := makeUpdatesMessagePoll(mtproto.MakeTLMessageMediaPoll(&mtproto.MessageMedia{
+		Poll:    media.GetPoll(),
+		Results: message.MakeMinPollResults(media.GetResults()),
+	}).To_MessageMedia())
+	for _, id := range idList {
+		if id == c.MD.UserId {
+			continue
+		}
+		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+			UserId:  id,
+			Updates: minUpdates,
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessagesGetPollResults
+// messages.getPollResults#73bb643b peer:InputPeer msg_id:int = Updates;
+func (c *PollsCore) MessagesGetPollResults(in *mtproto.TLMessagesGetPollResults) (*mtproto.Updates, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		c.Logger.Errorf("messages.getPollResults blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return nil, mtproto.ErrEnterpriseIsBlocked
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.getPollResults - error: %v", err)
+		return nil, err
+	}
+
+	pollId, err := c.getMessagePollId(peer, in.MsgId)
+	if err != nil {
+		c.Logger.Errorf("messages.getPollResults - error: %v", err)
+		return nil, err
+	}
+
+	media, err := c.svcCtx.Dao.MessageClient.MessageGetMediaPoll(c.ctx, &message.TLMessageGetMediaPoll{
+		UserId: c.MD.UserId,
+		PollId: pollId,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getPollResults - error: %v", err)
+		return nil, err
+	}
+
+	return makeUpdatesMessagePoll(media), nil
+}
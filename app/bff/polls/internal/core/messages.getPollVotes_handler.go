@@ -0,0 +1,75 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MessagesGetPollVotes
+// messages.getPollVotes#b86e380e flags:# peer:InputPeer id:int option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+func (c *PollsCore) MessagesGetPollVotes(in *mtproto.TLMessagesGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		c.Logger.Errorf("messages.getPollVotes blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return nil, mtproto.ErrEnterpriseIsBlocked
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	pollId, err := c.getMessagePollId(peer, in.Id)
+	if err != nil {
+		c.Logger.Errorf("messages.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	rValues, err := c.svcCtx.Dao.MessageClient.MessageGetPollVotes(c.ctx, &message.TLMessageGetPollVotes{
+		UserId: c.MD.UserId,
+		PollId: pollId,
+		Option: string(in.Option),
+		Offset: in.GetOffset().GetValue(),
+		Limit:  in.Limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	if len(rValues.Votes) > 0 {
+		idList := make([]int64, 0, len(rValues.Votes))
+		for _, v := range rValues.Votes {
+			idList = append(idList, v.UserId)
+		}
+
+		mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+			Id: append(idList, c.MD.UserId),
+			To: []int64{c.MD.UserId},
+		})
+		rValues.Users = mUsers.GetUserListByIdList(c.MD.UserId, idList...)
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,63 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/polls/internal/core"
+)
+
+// MessagesSendVote
+// messages.sendVote#10ea6184 peer:InputPeer msg_id:int options:Vector<bytes> = Updates;
+func (s *Service) MessagesSendVote(ctx context.Context, request *mtproto.TLMessagesSendVote) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.sendVote - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesSendVote(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.sendVote - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetPollResults
+// messages.getPollResults#73bb643b peer:InputPeer msg_id:int = Updates;
+func (s *Service) MessagesGetPollResults(ctx context.Context, request *mtproto.TLMessagesGetPollResults) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.getPollResults - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetPollResults(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.getPollResults - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetPollVotes
+// messages.getPollVotes#b86e380e flags:# peer:InputPeer id:int option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+func (s *Service) MessagesGetPollVotes(ctx context.Context, request *mtproto.TLMessagesGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.getPollVotes - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetPollVotes(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.getPollVotes - reply: %s", r.DebugString())
+	return r, err
+}
@@ -0,0 +1,130 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package reactions_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type ReactionsClient interface {
+	MessagesSendReaction(ctx context.Context, in *mtproto.TLMessagesSendReaction) (*mtproto.Updates, error)
+	MessagesGetMessagesReactions(ctx context.Context, in *mtproto.TLMessagesGetMessagesReactions) (*mtproto.Updates, error)
+	MessagesGetMessageReactionsList(ctx context.Context, in *mtproto.TLMessagesGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error)
+	MessagesSetChatAvailableReactions(ctx context.Context, in *mtproto.TLMessagesSetChatAvailableReactions) (*mtproto.Updates, error)
+	MessagesGetAvailableReactions(ctx context.Context, in *mtproto.TLMessagesGetAvailableReactions) (*mtproto.Messages_AvailableReactions, error)
+	MessagesSetDefaultReaction(ctx context.Context, in *mtproto.TLMessagesSetDefaultReaction) (*mtproto.Bool, error)
+	MessagesGetUnreadReactions(ctx context.Context, in *mtproto.TLMessagesGetUnreadReactions) (*mtproto.Messages_Messages, error)
+	MessagesReadReactions(ctx context.Context, in *mtproto.TLMessagesReadReactions) (*mtproto.Messages_AffectedHistory, error)
+	MessagesReportReaction(ctx context.Context, in *mtproto.TLMessagesReportReaction) (*mtproto.Bool, error)
+	MessagesGetTopReactions(ctx context.Context, in *mtproto.TLMessagesGetTopReactions) (*mtproto.Messages_Reactions, error)
+	MessagesGetRecentReactions(ctx context.Context, in *mtproto.TLMessagesGetRecentReactions) (*mtproto.Messages_Reactions, error)
+	MessagesClearRecentReactions(ctx context.Context, in *mtproto.TLMessagesClearRecentReactions) (*mtproto.Bool, error)
+}
+
+type defaultReactionsClient struct {
+	cli zrpc.Client
+}
+
+func NewReactionsClient(cli zrpc.Client) ReactionsClient {
+	return &defaultReactionsClient{
+		cli: cli,
+	}
+}
+
+// MessagesSendReaction
+// messages.sendReaction#d30d78d4 flags:# big:flags.1?true add_to_recent:flags.2?true peer:InputPeer msg_id:int reaction:flags.0?Vector<Reaction> = Updates;
+func (m *defaultReactionsClient) MessagesSendReaction(ctx context.Context, in *mtproto.TLMessagesSendReaction) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesSendReaction(ctx, in)
+}
+
+// MessagesGetMessagesReactions
+// messages.getMessagesReactions#8bba90e6 peer:InputPeer id:Vector<int> = Updates;
+func (m *defaultReactionsClient) MessagesGetMessagesReactions(ctx context.Context, in *mtproto.TLMessagesGetMessagesReactions) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesGetMessagesReactions(ctx, in)
+}
+
+// MessagesGetMessageReactionsList
+// messages.getMessageReactionsList#461b3f48 flags:# peer:InputPeer id:int reaction:flags.0?Reaction offset:flags.1?string limit:int = messages.MessageReactionsList;
+func (m *defaultReactionsClient) MessagesGetMessageReactionsList(ctx context.Context, in *mtproto.TLMessagesGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesGetMessageReactionsList(ctx, in)
+}
+
+// MessagesSetChatAvailableReactions
+// messages.setChatAvailableReactions#feb16771 peer:InputPeer available_reactions:ChatReactions = Updates;
+func (m *defaultReactionsClient) MessagesSetChatAvailableReactions(ctx context.Context, in *mtproto.TLMessagesSetChatAvailableReactions) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesSetChatAvailableReactions(ctx, in)
+}
+
+// MessagesGetAvailableReactions
+// messages.getAvailableReactions#18dea0ac hash:int = messages.AvailableReactions;
+func (m *defaultReactionsClient) MessagesGetAvailableReactions(ctx context.Context, in *mtproto.TLMessagesGetAvailableReactions) (*mtproto.Messages_AvailableReactions, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesGetAvailableReactions(ctx, in)
+}
+
+// MessagesSetDefaultReaction
+// messages.setDefaultReaction#4f47a016 reaction:Reaction = Bool;
+func (m *defaultReactionsClient) MessagesSetDefaultReaction(ctx context.Context, in *mtproto.TLMessagesSetDefaultReaction) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesSetDefaultReaction(ctx, in)
+}
+
+// MessagesGetUnreadReactions
+// messages.getUnreadReactions#3223495b flags:# peer:InputPeer top_msg_id:flags.0?int offset_id:int add_offset:int limit:int max_id:int min_id:int = messages.Messages;
+func (m *defaultReactionsClient) MessagesGetUnreadReactions(ctx context.Context, in *mtproto.TLMessagesGetUnreadReactions) (*mtproto.Messages_Messages, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesGetUnreadReactions(ctx, in)
+}
+
+// MessagesReadReactions
+// messages.readReactions#54aa7f8e flags:# peer:InputPeer top_msg_id:flags.0?int = messages.AffectedHistory;
+func (m *defaultReactionsClient) MessagesReadReactions(ctx context.Context, in *mtproto.TLMessagesReadReactions) (*mtproto.Messages_AffectedHistory, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesReadReactions(ctx, in)
+}
+
+// MessagesReportReaction
+// messages.reportReaction#3f64c076 peer:InputPeer id:int reaction_peer:InputPeer = Bool;
+func (m *defaultReactionsClient) MessagesReportReaction(ctx context.Context, in *mtproto.TLMessagesReportReaction) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesReportReaction(ctx, in)
+}
+
+// MessagesGetTopReactions
+// messages.getTopReactions#bb8125ba limit:int hash:long = messages.Reactions;
+func (m *defaultReactionsClient) MessagesGetTopReactions(ctx context.Context, in *mtproto.TLMessagesGetTopReactions) (*mtproto.Messages_Reactions, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesGetTopReactions(ctx, in)
+}
+
+// MessagesGetRecentReactions
+// messages.getRecentReactions#39461db2 limit:int hash:long = messages.Reactions;
+func (m *defaultReactionsClient) MessagesGetRecentReactions(ctx context.Context, in *mtproto.TLMessagesGetRecentReactions) (*mtproto.Messages_Reactions, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesGetRecentReactions(ctx, in)
+}
+
+// MessagesClearRecentReactions
+// messages.clearRecentReactions#9dfeefb4 = Bool;
+func (m *defaultReactionsClient) MessagesClearRecentReactions(ctx context.Context, in *mtproto.TLMessagesClearRecentReactions) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCReactionsClient(m.cli.Conn())
+	return client.MessagesClearRecentReactions(ctx, in)
+}
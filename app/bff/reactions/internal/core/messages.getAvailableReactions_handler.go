@@ -0,0 +1,51 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// MessagesGetAvailableReactions
+// messages.getAvailableReactions#18dea0ac hash:int = messages.AvailableReactions;
+func (c *ReactionsCore) MessagesGetAvailableReactions(in *mtproto.TLMessagesGetAvailableReactions) (*mtproto.Messages_AvailableReactions, error) {
+	if in.Hash != 0 && in.Hash == c.svcCtx.Dao.AvailableReactionsHash {
+		return mtproto.MakeTLMessagesAvailableReactionsNotModified(nil).To_Messages_AvailableReactions(), nil
+	}
+
+	rValues := mtproto.MakeTLMessagesAvailableReactions(&mtproto.Messages_AvailableReactions{
+		Hash:      c.svcCtx.Dao.AvailableReactionsHash,
+		Reactions: make([]*mtproto.AvailableReaction, 0, len(c.svcCtx.Dao.AvailableReactions)),
+	}).To_Messages_AvailableReactions()
+
+	// TODO: serve the animations from an emoji sticker set
+	for _, r := range c.svcCtx.Dao.AvailableReactions {
+		rValues.Reactions = append(rValues.Reactions, mtproto.MakeTLAvailableReaction(&mtproto.AvailableReaction{
+			Reaction:          r,
+			Title:             r,
+			StaticIcon:        mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			AppearAnimation:   mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			SelectAnimation:   mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			ActivateAnimation: mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			EffectAnimation:   mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+		}).To_AvailableReaction())
+	}
+
+	return rValues, nil
+}
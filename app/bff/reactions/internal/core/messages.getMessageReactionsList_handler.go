@@ -0,0 +1,75 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MessagesGetMessageReactionsList
+// messages.getMessageReactionsList#461b3f48 flags:# peer:InputPeer id:int reaction:flags.0?Reaction offset:flags.1?string limit:int = messages.MessageReactionsList;
+func (c *ReactionsCore) MessagesGetMessageReactionsList(in *mtproto.TLMessagesGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		c.Logger.Errorf("messages.getMessageReactionsList blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return nil, mtproto.ErrEnterpriseIsBlocked
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.getMessageReactionsList - error: %v", err)
+		return nil, err
+	}
+
+	reaction := in.GetReaction_FLAGREACTION().ToString()
+	if v := in.GetReaction_FLAGSTRING().GetValue(); v != "" {
+		// layer < 145
+		reaction = v
+	}
+
+	rValues, err := c.svcCtx.Dao.MessageClient.MessageGetMessageReactionsList(c.ctx, &message.TLMessageGetMessageReactionsList{
+		UserId:   c.MD.UserId,
+		MsgId:    in.Id,
+		Reaction: reaction,
+		Offset:   in.GetOffset().GetValue(),
+		Limit:    in.Limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getMessageReactionsList - error: %v", err)
+		return nil, err
+	}
+
+	if len(rValues.Reactions) > 0 {
+		idList := make([]int64, 0, len(rValues.Reactions))
+		for _, r := range rValues.Reactions {
+			idList = append(idList, r.GetPeerId().GetUserId())
+		}
+
+		mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+			Id: append(idList, c.MD.UserId),
+			To: []int64{c.MD.UserId},
+		})
+		rValues.Users = mUsers.GetUserListByIdList(c.MD.UserId, idList...)
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessagesGetMessagesReactions
+// messages.getMessagesReactions#8bba90e6 peer:InputPeer id:Vector<int> = Updates;
+func (c *ReactionsCore) MessagesGetMessagesReactions(in *mtproto.TLMessagesGetMessagesReactions) (*mtproto.Updates, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		c.Logger.Errorf("messages.getMessagesReactions blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return nil, mtproto.ErrEnterpriseIsBlocked
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.getMessagesReactions - error: %v", err)
+		return nil, err
+	}
+
+	boxList, err := c.svcCtx.Dao.MessageClient.MessageGetUserMessageList(c.ctx, &message.TLMessageGetUserMessageList{
+		UserId: c.MD.UserId,
+		IdList: in.Id,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getMessagesReactions - error: %v", err)
+		return nil, err
+	}
+
+	updateList := make([]*mtproto.Update, 0, len(boxList.GetDatas()))
+	for _, box := range boxList.GetDatas() {
+		reactions := box.GetMessage().GetReactions()
+		if reactions == nil {
+			reactions = mtproto.MakeTLMessageReactions(&mtproto.MessageReactions{
+				Results: []*mtproto.ReactionCount{},
+			}).To_MessageReactions()
+		}
+		updateList = append(updateList, mtproto.MakeTLUpdateMessageReactions(&mtproto.Update{
+			Peer_PEER:   mtproto.MakePeerUtil(box.PeerType, box.PeerId).ToPeer(),
+			MsgId_INT32: box.MessageId,
+			Reactions:   reactions,
+		}).To_Update())
+	}
+
+	return mtproto.MakeUpdatesByUpdates(updateList...), nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"google.golang.org/grpc/status"
+)
+
+const (
+	reactionsUserMaxDefault = 1
+	reactionsUserMaxPremium = 3
+)
+
+var (
+	// ErrReactionsTooMany
+	// | 400 | REACTIONS_TOO_MANY | The message already has exactly reactions_uniq_max reaction emojis, you can't react with a new emoji, see the docs for more info. |
+	ErrReactionsTooMany = status.Error(mtproto.ErrBadRequest, "REACTIONS_TOO_MANY")
+
+	// ErrPremiumAccountRequired
+	// | 403 | PREMIUM_ACCOUNT_REQUIRED | A premium account is required to execute this action. |
+	ErrPremiumAccountRequired = status.Error(mtproto.ErrForbidden, "PREMIUM_ACCOUNT_REQUIRED")
+)
+
+// MessagesSendReaction
+// messages.sendReaction#d30d78d4 flags:# big:flags.1?true add_to_recent:flags.2?true peer:InputPeer msg_id:int reaction:flags.0?Vector<Reaction> = Updates;
+func (c *ReactionsCore) MessagesSendReaction(in *mtproto.TLMessagesSendReaction) (*mtproto.Updates, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		c.Logger.Errorf("messages.sendReaction blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return nil, mtproto.ErrEnterpriseIsBlocked
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	reactions := in.GetReaction_FLAGVECTORREACTION()
+	if v := in.GetReaction_FLAGSTRING().GetValue(); v != "" {
+		// layer < 145
+		reactions = []*mtproto.Reaction{mtproto.FromReaction(v)}
+	}
+
+	var (
+		premium bool
+		custom  bool
+	)
+	for _, r := range reactions {
+		switch r.GetPredicateName() {
+		case mtproto.Predicate_reactionEmoji:
+			if !c.svcCtx.Dao.IsAvailableReaction(r.Emoticon) {
+				err := msgpb.ErrReactionInvalid
+				c.Logger.Errorf("messages.sendReaction - error: %v", err)
+				return nil, err
+			}
+		case mtproto.Predicate_reactionCustomEmoji:
+			custom = true
+		}
+	}
+
+	if custom || len(reactions) > reactionsUserMaxDefault {
+		me, err := c.svcCtx.Dao.UserClient.UserGetImmutableUser(c.ctx, &userpb.TLUserGetImmutableUser{
+			Id: c.MD.UserId,
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.sendReaction - error: %v", err)
+			return nil, err
+		}
+		premium = me.Premium()
+	}
+
+	if custom && !premium {
+		err := ErrPremiumAccountRequired
+		c.Logger.Errorf("messages.sendReaction - error: %v", err)
+		return nil, err
+	}
+	if (!premium && len(reactions) > reactionsUserMaxDefault) || len(reactions) > reactionsUserMaxPremium {
+		err := ErrReactionsTooMany
+		c.Logger.Errorf("messages.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgSendReaction(c.ctx, &msgpb.TLMsgSendReaction{
+		UserId:      c.MD.UserId,
+		AuthKeyId:   c.MD.AuthId,
+		Big:         in.Big,
+		AddToRecent: in.AddToRecent,
+		PeerType:    peer.PeerType,
+		PeerId:      peer.PeerId,
+		MsgId:       in.MsgId,
+		Reaction:    reactions,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+)
+
+// MessagesSetChatAvailableReactions
+// messages.setChatAvailableReactions#feb16771 peer:InputPeer available_reactions:ChatReactions = Updates;
+func (c *ReactionsCore) MessagesSetChatAvailableReactions(in *mtproto.TLMessagesSetChatAvailableReactions) (*mtproto.Updates, error) {
+	var (
+		peer               = mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+		reactionsType      int32
+		availableReactions []string
+	)
+
+	switch peer.PeerType {
+	case mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		c.Logger.Errorf("messages.setChatAvailableReactions blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return nil, mtproto.ErrEnterpriseIsBlocked
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+		return nil, err
+	}
+
+	if in.AvailableReactions_CHATREACTIONS != nil {
+		reactionsType, availableReactions = in.AvailableReactions_CHATREACTIONS.ToChatReactions()
+	} else if len(in.AvailableReactions_VECTORSTRING) == 0 {
+		// layer < 145
+		reactionsType = mtproto.ChatReactionsTypeNone
+	} else {
+		reactionsType, availableReactions = mtproto.ChatReactionsTypeSome, in.AvailableReactions_VECTORSTRING
+	}
+
+	if reactionsType == mtproto.ChatReactionsTypeNotDefined {
+		err := msgpb.ErrReactionInvalid
+		c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+		return nil, err
+	}
+	for _, r := range availableReactions {
+		if mtproto.FromReaction(r).GetDocumentId() == 0 && !c.svcCtx.Dao.IsAvailableReaction(r) {
+			err := msgpb.ErrReactionInvalid
+			c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+			return nil, err
+		}
+	}
+
+	chat, err := c.svcCtx.Dao.ChatClient.Client().ChatSetChatAvailableReactions(c.ctx, &chatpb.TLChatSetChatAvailableReactions{
+		SelfId:                 c.MD.UserId,
+		ChatId:                 peer.PeerId,
+		AvailableReactionsType: reactionsType,
+		AvailableReactions:     availableReactions,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+		return nil, err
+	}
+
+	chatUpdates := mtproto.MakeTLUpdateShort(&mtproto.Updates{
+		Update: mtproto.MakeTLUpdateChat(&mtproto.Update{
+			ChatId_INT64: peer.PeerId,
+		}).To_Update(),
+		Date: int32(time.Now().Unix()),
+	}).To_Updates()
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates:   chatUpdates,
+	})
+	chat.Walk(func(userId int64, participant *mtproto.ImmutableChatParticipant) error {
+		if userId != c.MD.UserId && participant.IsChatMemberStateNormal() {
+			c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+				UserId:  userId,
+				Updates: chatUpdates,
+			})
+		}
+		return nil
+	})
+
+	return mtproto.MakeUpdatesByUpdatesChats(
+		[]*mtproto.Chat{chat.ToUnsafeChat(c.MD.UserId)},
+		mtproto.MakeTLUpdateChat(&mtproto.Update{
+			ChatId_INT64: peer.PeerId,
+		}).To_Update()), nil
+}
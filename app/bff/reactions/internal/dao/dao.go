@@ -0,0 +1,78 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"hash/crc32"
+	"strings"
+
+	"github.com/teamgram/marmota/pkg/net/rpcx"
+	"github.com/teamgram/teamgram-server/app/bff/reactions/internal/config"
+	msg_client "github.com/teamgram/teamgram-server/app/messenger/msg/msg/client"
+	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
+	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
+	message_client "github.com/teamgram/teamgram-server/app/service/biz/message/client"
+	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
+)
+
+// defaultAvailableReactions is served when no AvailableReactions are configured.
+var defaultAvailableReactions = []string{
+	"👍", "👎", "❤", "🔥", "🥰", "👏", "😁", "🤔",
+	"🤯", "😱", "🤬", "😢", "🎉", "🤩", "🤮", "💩",
+}
+
+type Dao struct {
+	msg_client.MsgClient
+	user_client.UserClient
+	ChatClient *chat_client.ChatClientHelper
+	message_client.MessageClient
+	sync_client.SyncClient
+
+	AvailableReactions     []string
+	AvailableReactionsHash int32
+}
+
+func New(c config.Config) *Dao {
+	reactions := c.AvailableReactions
+	if len(reactions) == 0 {
+		reactions = defaultAvailableReactions
+	}
+
+	return &Dao{
+		MsgClient:              msg_client.NewMsgClient(rpcx.GetCachedRpcClient(c.MsgClient)),
+		UserClient:             user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		ChatClient:             chat_client.NewChatClientHelper(rpcx.GetCachedRpcClient(c.ChatClient)),
+		MessageClient:          message_client.NewMessageClient(rpcx.GetCachedRpcClient(c.MessageClient)),
+		SyncClient:             sync_client.NewSyncMqClient(mqx.MustProducer(c.SyncClient)),
+		AvailableReactions:     reactions,
+		AvailableReactionsHash: int32(crc32.ChecksumIEEE([]byte(strings.Join(reactions, ",")))),
+	}
+}
+
+// IsAvailableReaction reports whether the emoji reaction is in the configured list.
+func (d *Dao) IsAvailableReaction(reaction string) bool {
+	for _, r := range d.AvailableReactions {
+		if r == reaction {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,198 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/reactions/internal/core"
+)
+
+// MessagesSendReaction
+// messages.sendReaction#d30d78d4 flags:# big:flags.1?true add_to_recent:flags.2?true peer:InputPeer msg_id:int reaction:flags.0?Vector<Reaction> = Updates;
+func (s *Service) MessagesSendReaction(ctx context.Context, request *mtproto.TLMessagesSendReaction) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.sendReaction - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesSendReaction(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.sendReaction - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetMessagesReactions
+// messages.getMessagesReactions#8bba90e6 peer:InputPeer id:Vector<int> = Updates;
+func (s *Service) MessagesGetMessagesReactions(ctx context.Context, request *mtproto.TLMessagesGetMessagesReactions) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.getMessagesReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetMessagesReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.getMessagesReactions - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetMessageReactionsList
+// messages.getMessageReactionsList#461b3f48 flags:# peer:InputPeer id:int reaction:flags.0?Reaction offset:flags.1?string limit:int = messages.MessageReactionsList;
+func (s *Service) MessagesGetMessageReactionsList(ctx context.Context, request *mtproto.TLMessagesGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.getMessageReactionsList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetMessageReactionsList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.getMessageReactionsList - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesSetChatAvailableReactions
+// messages.setChatAvailableReactions#feb16771 peer:InputPeer available_reactions:ChatReactions = Updates;
+func (s *Service) MessagesSetChatAvailableReactions(ctx context.Context, request *mtproto.TLMessagesSetChatAvailableReactions) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.setChatAvailableReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesSetChatAvailableReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.setChatAvailableReactions - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetAvailableReactions
+// messages.getAvailableReactions#18dea0ac hash:int = messages.AvailableReactions;
+func (s *Service) MessagesGetAvailableReactions(ctx context.Context, request *mtproto.TLMessagesGetAvailableReactions) (*mtproto.Messages_AvailableReactions, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.getAvailableReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetAvailableReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.getAvailableReactions - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesSetDefaultReaction
+// messages.setDefaultReaction#4f47a016 reaction:Reaction = Bool;
+func (s *Service) MessagesSetDefaultReaction(ctx context.Context, request *mtproto.TLMessagesSetDefaultReaction) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.setDefaultReaction - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesSetDefaultReaction(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.setDefaultReaction - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetUnreadReactions
+// messages.getUnreadReactions#3223495b flags:# peer:InputPeer top_msg_id:flags.0?int offset_id:int add_offset:int limit:int max_id:int min_id:int = messages.Messages;
+func (s *Service) MessagesGetUnreadReactions(ctx context.Context, request *mtproto.TLMessagesGetUnreadReactions) (*mtproto.Messages_Messages, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.getUnreadReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetUnreadReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.getUnreadReactions - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesReadReactions
+// messages.readReactions#54aa7f8e flags:# peer:InputPeer top_msg_id:flags.0?int = messages.AffectedHistory;
+func (s *Service) MessagesReadReactions(ctx context.Context, request *mtproto.TLMessagesReadReactions) (*mtproto.Messages_AffectedHistory, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.readReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesReadReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.readReactions - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesReportReaction
+// messages.reportReaction#3f64c076 peer:InputPeer id:int reaction_peer:InputPeer = Bool;
+func (s *Service) MessagesReportReaction(ctx context.Context, request *mtproto.TLMessagesReportReaction) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.reportReaction - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesReportReaction(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.reportReaction - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetTopReactions
+// messages.getTopReactions#bb8125ba limit:int hash:long = messages.Reactions;
+func (s *Service) MessagesGetTopReactions(ctx context.Context, request *mtproto.TLMessagesGetTopReactions) (*mtproto.Messages_Reactions, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.getTopReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetTopReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.getTopReactions - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetRecentReactions
+// messages.getRecentReactions#39461db2 limit:int hash:long = messages.Reactions;
+func (s *Service) MessagesGetRecentReactions(ctx context.Context, request *mtproto.TLMessagesGetRecentReactions) (*mtproto.Messages_Reactions, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.getRecentReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetRecentReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.getRecentReactions - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesClearRecentReactions
+// messages.clearRecentReactions#9dfeefb4 = Bool;
+func (s *Service) MessagesClearRecentReactions(ctx context.Context, request *mtproto.TLMessagesClearRecentReactions) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.clearRecentReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesClearRecentReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.clearRecentReactions - reply: %s", r.DebugString())
+	return r, err
+}
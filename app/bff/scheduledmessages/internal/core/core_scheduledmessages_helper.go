@@ -0,0 +1,74 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// checkScheduledPeer resolves inputPeer, scheduled messages live in private chats and chats only.
+func (c *ScheduledMessagesCore) checkScheduledPeer(inputPeer *mtproto.InputPeer) (*mtproto.PeerUtil, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, inputPeer)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		c.Logger.Errorf("scheduled messages blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return nil, mtproto.ErrEnterpriseIsBlocked
+	default:
+		return nil, mtproto.ErrPeerIdInvalid
+	}
+
+	return peer, nil
+}
+
+func (c *ScheduledMessagesCore) makeMessagesMessages(boxList *message.Vector_MessageBox) *mtproto.Messages_Messages {
+	rValues := mtproto.MakeTLMessagesMessages(&mtproto.Messages_Messages{
+		Messages: []*mtproto.Message{},
+		Users:    []*mtproto.User{},
+		Chats:    []*mtproto.Chat{},
+	}).To_Messages_Messages()
+
+	boxList.Visit(c.MD.UserId,
+		func(messageList []*mtproto.Message) {
+			rValues.Messages = messageList
+		},
+		func(userIdList []int64) {
+			mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(
+				c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rValues.Users = append(rValues.Users, mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)...)
+		},
+		func(chatIdList []int64) {
+			mChats, _ := c.svcCtx.Dao.ChatClient.Client().ChatGetChatListByIdList(
+				c.ctx,
+				&chatpb.TLChatGetChatListByIdList{
+					IdList: chatIdList,
+				})
+			rValues.Chats = append(rValues.Chats, mChats.GetChatListByIdList(c.MD.UserId, chatIdList...)...)
+		},
+		nil)
+
+	return rValues
+}
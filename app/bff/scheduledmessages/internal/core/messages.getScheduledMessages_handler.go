@@ -0,0 +1,47 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessagesGetScheduledMessages
+// messages.getScheduledMessages#bdbb0464 peer:InputPeer id:Vector<int> = messages.Messages;
+func (c *ScheduledMessagesCore) MessagesGetScheduledMessages(in *mtproto.TLMessagesGetScheduledMessages) (*mtproto.Messages_Messages, error) {
+	peer, err := c.checkScheduledPeer(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("messages.getScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	boxList, err := c.svcCtx.Dao.MessageClient.MessageGetScheduledMessages(c.ctx, &message.TLMessageGetScheduledMessages{
+		UserId:   c.MD.UserId,
+		PeerType: peer.PeerType,
+		PeerId:   peer.PeerId,
+		Id:       in.Id,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeMessagesMessages(boxList), nil
+}
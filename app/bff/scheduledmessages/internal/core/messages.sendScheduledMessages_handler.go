@@ -0,0 +1,54 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// MessagesSendScheduledMessages
+// messages.sendScheduledMessages#bd38850a peer:InputPeer id:Vector<int> = Updates;
+func (c *ScheduledMessagesCore) MessagesSendScheduledMessages(in *mtproto.TLMessagesSendScheduledMessages) (*mtproto.Updates, error) {
+	peer, err := c.checkScheduledPeer(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("messages.sendScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	if len(in.Id) == 0 {
+		err = mtproto.ErrMessageIdInvalid
+		c.Logger.Errorf("messages.sendScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgSendScheduledMessages(c.ctx, &msg.TLMsgSendScheduledMessages{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		PeerType:  peer.PeerType,
+		PeerId:    peer.PeerId,
+		Id:        in.Id,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.sendScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+)
+
+const (
+	// dhConfigVersion changes only if srp.P or srp.G change.
+	dhConfigVersion = 1
+
+	// maxRandomLength bounds messages.getDhConfig random_length.
+	maxRandomLength = 256
+)
+
+var (
+	bigDhP = new(big.Int).SetBytes(srp.P)
+
+	// 2^{2048-64}, g_a and g_b must be in [2^{2048-64}, p - 2^{2048-64}].
+	bigDhSafetyRange = new(big.Int).Lsh(big.NewInt(1), 2048-64)
+)
+
+// checkDhGAOrB validates g_a/g_b as required by
+// https://core.telegram.org/api/end-to-end#sending-a-request
+func checkDhGAOrB(gAOrB []byte) bool {
+	if len(gAOrB) == 0 || len(gAOrB) > srp.PrimeSize {
+		return false
+	}
+
+	v := new(big.Int).SetBytes(gAOrB)
+	if v.Cmp(bigDhSafetyRange) < 0 {
+		return false
+	}
+	return v.Cmp(new(big.Int).Sub(bigDhP, bigDhSafetyRange)) <= 0
+}
+
+// permAuthKeyId is the key secret chats are bound to, it survives temp key rotations.
+func (c *SecretchatsCore) permAuthKeyId() int64 {
+	if c.MD.PermAuthKeyId != 0 {
+		return c.MD.PermAuthKeyId
+	}
+	return c.MD.AuthId
+}
+
+func (c *SecretchatsCore) getSecretChat(peer *mtproto.InputEncryptedChat) (*secretchat.SecretChatData, error) {
+	chat, err := c.svcCtx.Dao.SecretchatClient.SecretchatGetSecretChat(c.ctx, &secretchat.TLSecretchatGetSecretChat{
+		ChatId: peer.GetChatId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.AccessHash != peer.GetAccessHash() || !chat.IsParticipant(c.MD.UserId) {
+		return nil, mtproto.ErrEncryptionIdInvalid
+	}
+
+	return chat, nil
+}
+
+// pushUpdatesToDevice delivers updates only to the device a secret chat is bound to.
+func (c *SecretchatsCore) pushUpdatesToDevice(userId, authKeyId int64, updates *mtproto.Updates) {
+	c.svcCtx.Dao.SyncClient.SyncUpdatesMe(
+		c.ctx,
+		&sync.TLSyncUpdatesMe{
+			UserId:    userId,
+			AuthKeyId: authKeyId,
+			ServerId:  "",
+			SessionId: nil,
+			Updates:   updates,
+		})
+}
+
+func (c *SecretchatsCore) sendEncryptedMessage(message *mtproto.EncryptedMessage) (*secretchat.EncryptedMessageData, error) {
+	if len(message.GetBytes()) == 0 {
+		return nil, mtproto.ErrDataInvalid
+	}
+
+	r, err := c.svcCtx.Dao.SecretchatClient.SecretchatSendEncryptedMessage(c.ctx, &secretchat.TLSecretchatSendEncryptedMessage{
+		UserId:  c.MD.UserId,
+		ChatId:  message.GetChatId(),
+		Message: message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.pushUpdatesToDevice(
+		r.GetUserId(),
+		r.GetAuthKeyId(),
+		mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateNewEncryptedMessage(&mtproto.Update{
+			Message_ENCRYPTEDMESSAGE: r.GetMessage(),
+			Qts:                      r.GetQts(),
+		}).To_Update()))
+
+	return r, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// MessagesAcceptEncryption
+// messages.acceptEncryption#3dbc0415 peer:InputEncryptedChat g_b:bytes key_fingerprint:long = EncryptedChat;
+func (c *SecretchatsCore) MessagesAcceptEncryption(in *mtproto.TLMessagesAcceptEncryption) (*mtproto.EncryptedChat, error) {
+	chat, err := c.getSecretChat(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("messages.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if !checkDhGAOrB(in.GB) {
+		err = mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("messages.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	chat, err = c.svcCtx.Dao.SecretchatClient.SecretchatAcceptEncryption(c.ctx, &secretchat.TLSecretchatAcceptEncryption{
+		ChatId:               chat.Id,
+		ParticipantId:        c.MD.UserId,
+		ParticipantAuthKeyId: c.permAuthKeyId(),
+		GB:                   in.GB,
+		KeyFingerprint:       in.KeyFingerprint,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	date := int32(time.Now().Unix())
+
+	// g_b goes to the device the request was made from
+	c.pushUpdatesToDevice(
+		chat.AdminId,
+		chat.AdminAuthKeyId,
+		mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateEncryption(&mtproto.Update{
+			Chat: chat.ToEncryptedChat(chat.AdminId),
+			Date: date,
+		}).To_Update()))
+
+	// the other devices of the participant drop the request
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateEncryption(&mtproto.Update{
+			Chat: mtproto.MakeTLEncryptedChatDiscarded(&mtproto.EncryptedChat{
+				Id: chat.Id,
+			}).To_EncryptedChat(),
+			Date: date,
+		}).To_Update()),
+	})
+
+	return chat.ToEncryptedChat(c.MD.UserId), nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// MessagesDiscardEncryption
+// messages.discardEncryption#f393aea0 flags:# delete_history:flags.0?true chat_id:int = Bool;
+func (c *SecretchatsCore) MessagesDiscardEncryption(in *mtproto.TLMessagesDiscardEncryption) (*mtproto.Bool, error) {
+	chat, err := c.svcCtx.Dao.SecretchatClient.SecretchatDiscardEncryption(c.ctx, &secretchat.TLSecretchatDiscardEncryption{
+		ChatId:        in.ChatId,
+		UserId:        c.MD.UserId,
+		DeleteHistory: mtproto.ToBool(in.DeleteHistory),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.discardEncryption - error: %v", err)
+		return nil, err
+	}
+
+	updates := mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateEncryption(&mtproto.Update{
+		Chat: mtproto.MakeTLEncryptedChatDiscarded(&mtproto.EncryptedChat{
+			Id:             chat.Id,
+			HistoryDeleted: in.DeleteHistory,
+		}).To_EncryptedChat(),
+		Date: int32(time.Now().Unix()),
+	}).To_Update())
+
+	peerId, peerAuthKeyId := chat.GetPeer(c.MD.UserId)
+	if peerAuthKeyId != 0 {
+		c.pushUpdatesToDevice(peerId, peerAuthKeyId, updates)
+	} else {
+		// not accepted yet, every device of the participant got the request
+		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+			UserId:  peerId,
+			Updates: updates,
+		})
+	}
+
+	if !chat.IsAdmin(c.MD.UserId) && chat.ParticipantAuthKeyId == 0 {
+		// a declined request, same as above for the other devices of the participant
+		c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+			UserId:    c.MD.UserId,
+			AuthKeyId: c.MD.AuthId,
+			Updates:   updates,
+		})
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"crypto/rand"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+)
+
+// MessagesGetDhConfig
+// messages.getDhConfig#26cf8950 version:int random_length:int = messages.DhConfig;
+func (c *SecretchatsCore) MessagesGetDhConfig(in *mtproto.TLMessagesGetDhConfig) (*mtproto.Messages_DhConfig, error) {
+	if in.RandomLength < 0 || in.RandomLength > maxRandomLength {
+		err := mtproto.ErrRandomLengthInvalid
+		c.Logger.Errorf("messages.getDhConfig - error: %v", err)
+		return nil, err
+	}
+
+	random := make([]byte, in.RandomLength)
+	if _, err := rand.Read(random); err != nil {
+		c.Logger.Errorf("messages.getDhConfig - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	if in.Version == dhConfigVersion {
+		return mtproto.MakeTLMessagesDhConfigNotModified(&mtproto.Messages_DhConfig{
+			Random: random,
+		}).To_Messages_DhConfig(), nil
+	}
+
+	return mtproto.MakeTLMessagesDhConfig(&mtproto.Messages_DhConfig{
+		G:       srp.G,
+		P:       srp.P,
+		Version: dhConfigVersion,
+		Random:  random,
+	}).To_Messages_DhConfig(), nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+// MessagesReadEncryptedHistory
+// messages.readEncryptedHistory#7f4b690a peer:InputEncryptedChat max_date:int = Bool;
+func (c *SecretchatsCore) MessagesReadEncryptedHistory(in *mtproto.TLMessagesReadEncryptedHistory) (*mtproto.Bool, error) {
+	chat, err := c.getSecretChat(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("messages.readEncryptedHistory - error: %v", err)
+		return nil, err
+	}
+
+	if peerId, peerAuthKeyId := chat.GetPeer(c.MD.UserId); peerAuthKeyId != 0 {
+		c.pushUpdatesToDevice(
+			peerId,
+			peerAuthKeyId,
+			mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateEncryptedMessagesRead(&mtproto.Update{
+				ChatId_INT32: chat.Id,
+				MaxDate:      in.MaxDate,
+				Date:         int32(time.Now().Unix()),
+			}).To_Update()))
+	}
+
+	return mtproto.BoolTrue, nil
+}
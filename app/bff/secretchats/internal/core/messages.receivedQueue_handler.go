@@ -0,0 +1,41 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// MessagesReceivedQueue
+// messages.receivedQueue#55a5bb66 max_qts:int = Vector<long>;
+func (c *SecretchatsCore) MessagesReceivedQueue(in *mtproto.TLMessagesReceivedQueue) (*mtproto.Vector_Long, error) {
+	rList, err := c.svcCtx.Dao.SecretchatClient.SecretchatReceivedQueue(c.ctx, &secretchat.TLSecretchatReceivedQueue{
+		AuthKeyId: c.permAuthKeyId(),
+		MaxQts:    in.MaxQts,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.receivedQueue - error: %v", err)
+		return nil, err
+	}
+
+	return &mtproto.Vector_Long{
+		Datas: rList.GetDatas(),
+	}, nil
+}
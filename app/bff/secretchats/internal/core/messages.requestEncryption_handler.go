@@ -0,0 +1,92 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MessagesRequestEncryption
+// messages.requestEncryption#f64daf43 user_id:InputUser random_id:int g_a:bytes = EncryptedChat;
+func (c *SecretchatsCore) MessagesRequestEncryption(in *mtproto.TLMessagesRequestEncryption) (*mtproto.EncryptedChat, error) {
+	peer := mtproto.FromInputUser(c.MD.UserId, in.UserId)
+	if peer.PeerType != mtproto.PEER_USER {
+		err := mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if !checkDhGAOrB(in.GA) {
+		err := mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId, peer.PeerId},
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	} else if !users.CheckExistUser(c.MD.UserId, peer.PeerId) {
+		err = mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	blocked, _ := c.svcCtx.Dao.UserClient.UserBlockedByUser(c.ctx, &userpb.TLUserBlockedByUser{
+		UserId:     peer.PeerId,
+		PeerUserId: c.MD.UserId,
+	})
+	if mtproto.FromBool(blocked) {
+		err = mtproto.ErrUserIsBlocked
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	chat, err := c.svcCtx.Dao.SecretchatClient.SecretchatRequestEncryption(c.ctx, &secretchat.TLSecretchatRequestEncryption{
+		AdminId:        c.MD.UserId,
+		AdminAuthKeyId: c.permAuthKeyId(),
+		ParticipantId:  peer.PeerId,
+		RandomId:       in.RandomId,
+		GA:             in.GA,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	// the request goes to every device of the participant, the first one to accept wins
+	c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+		UserId: peer.PeerId,
+		Updates: mtproto.MakeUpdatesByUpdatesUsers(
+			users.GetUserListByIdList(peer.PeerId, c.MD.UserId),
+			mtproto.MakeTLUpdateEncryption(&mtproto.Update{
+				Chat: chat.ToEncryptedChat(peer.PeerId),
+				Date: int32(time.Now().Unix()),
+			}).To_Update()),
+	})
+
+	return chat.ToEncryptedChat(c.MD.UserId), nil
+}
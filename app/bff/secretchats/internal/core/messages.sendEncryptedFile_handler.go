@@ -0,0 +1,62 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MessagesSendEncryptedFile
+// messages.sendEncryptedFile#5559481d flags:# silent:flags.0?true peer:InputEncryptedChat random_id:long data:bytes file:InputEncryptedFile = messages.SentEncryptedMessage;
+func (c *SecretchatsCore) MessagesSendEncryptedFile(in *mtproto.TLMessagesSendEncryptedFile) (*mtproto.Messages_SentEncryptedMessage, error) {
+	if _, err := c.getSecretChat(in.Peer); err != nil {
+		c.Logger.Errorf("messages.sendEncryptedFile - error: %v", err)
+		return nil, err
+	}
+
+	file, err := c.svcCtx.Dao.MediaClient.MediaUploadEncryptedFile(c.ctx, &mediapb.TLMediaUploadEncryptedFile{
+		OwnerId: c.MD.UserId,
+		File:    in.GetFile(),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.sendEncryptedFile - error: %v", err)
+		return nil, err
+	}
+
+	date := int32(time.Now().Unix())
+	_, err = c.sendEncryptedMessage(mtproto.MakeTLEncryptedMessage(&mtproto.EncryptedMessage{
+		RandomId: in.RandomId,
+		ChatId:   in.GetPeer().GetChatId(),
+		Date:     date,
+		Bytes:    in.Data,
+		File:     file,
+	}).To_EncryptedMessage())
+	if err != nil {
+		c.Logger.Errorf("messages.sendEncryptedFile - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLMessagesSentEncryptedFile(&mtproto.Messages_SentEncryptedMessage{
+		Date: date,
+		File: file,
+	}).To_Messages_SentEncryptedMessage(), nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+// MessagesSendEncryptedService
+// messages.sendEncryptedService#32d439a4 peer:InputEncryptedChat random_id:long data:bytes = messages.SentEncryptedMessage;
+func (c *SecretchatsCore) MessagesSendEncryptedService(in *mtproto.TLMessagesSendEncryptedService) (*mtproto.Messages_SentEncryptedMessage, error) {
+	if _, err := c.getSecretChat(in.Peer); err != nil {
+		c.Logger.Errorf("messages.sendEncryptedService - error: %v", err)
+		return nil, err
+	}
+
+	date := int32(time.Now().Unix())
+	_, err := c.sendEncryptedMessage(mtproto.MakeTLEncryptedMessageService(&mtproto.EncryptedMessage{
+		RandomId: in.RandomId,
+		ChatId:   in.GetPeer().GetChatId(),
+		Date:     date,
+		Bytes:    in.Data,
+	}).To_EncryptedMessage())
+	if err != nil {
+		c.Logger.Errorf("messages.sendEncryptedService - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLMessagesSentEncryptedMessage(&mtproto.Messages_SentEncryptedMessage{
+		Date: date,
+	}).To_Messages_SentEncryptedMessage(), nil
+}
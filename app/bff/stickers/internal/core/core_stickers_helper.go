@@ -0,0 +1,54 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+const (
+	shortNameMaxLen          = 64
+	suggestShortNameMaxTries = 10
+)
+
+func getInputDocumentId(document *mtproto.InputDocument) (int64, error) {
+	if document.GetPredicateName() != mtproto.Predicate_inputDocument {
+		return 0, mtproto.ErrStickerIdInvalid
+	}
+
+	return document.GetId(), nil
+}
+
+// makeShortNameFromTitle keeps the latin letters and digits of title and
+// joins the words with '_', leaving room for a numeric suffix.
+func makeShortNameFromTitle(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+
+	shortName := strings.Join(words, "_")
+	shortName = strings.TrimLeft(shortName, "0123456789_")
+	if len(shortName) > shortNameMaxLen-3 {
+		shortName = strings.TrimRight(shortName[:shortNameMaxLen-3], "_")
+	}
+
+	return shortName
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MessagesGetArchivedStickers
+// messages.getArchivedStickers#4e9abead flags:# masks:flags.0?true emojis:flags.1?true offset_id:long limit:int = messages.ArchivedStickers;
+func (c *StickersCore) MessagesGetArchivedStickers(in *mtproto.TLMessagesGetArchivedStickers) (*mtproto.Messages_ArchivedStickers, error) {
+	rValues, err := c.svcCtx.Dao.MediaClient.MediaGetArchivedStickers(c.ctx, &mediapb.TLMediaGetArchivedStickers{
+		Masks:    in.Masks,
+		Emojis:   in.Emojis,
+		UserId:   c.MD.UserId,
+		OffsetId: in.OffsetId,
+		Limit:    in.Limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getArchivedStickers - error: %v", err)
+		return nil, err
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MessagesGetFavedStickers
+// messages.getFavedStickers#4f1aaa9 hash:long = messages.FavedStickers;
+func (c *StickersCore) MessagesGetFavedStickers(in *mtproto.TLMessagesGetFavedStickers) (*mtproto.Messages_FavedStickers, error) {
+	rValues, err := c.svcCtx.Dao.MediaClient.MediaGetFavedStickers(c.ctx, &mediapb.TLMediaGetFavedStickers{
+		UserId: c.MD.UserId,
+		Hash:   in.Hash,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getFavedStickers - error: %v", err)
+		return nil, err
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MessagesGetRecentStickers
+// messages.getRecentStickers#bd271877 flags:# attached:flags.0?true hash:long = messages.RecentStickers;
+func (c *StickersCore) MessagesGetRecentStickers(in *mtproto.TLMessagesGetRecentStickers) (*mtproto.Messages_RecentStickers, error) {
+	if in.Attached {
+		return mtproto.MakeTLMessagesRecentStickers(&mtproto.Messages_RecentStickers{
+			Hash:     0,
+			Packs:    []*mtproto.StickerPack{},
+			Stickers: []*mtproto.Document{},
+			Dates:    []int32{},
+		}).To_Messages_RecentStickers(), nil
+	}
+
+	rValues, err := c.svcCtx.Dao.MediaClient.MediaGetRecentStickers(c.ctx, &mediapb.TLMediaGetRecentStickers{
+		UserId: c.MD.UserId,
+		Hash:   in.Hash,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MessagesGetStickerSet
+// messages.getStickerSet#c8a0ec74 stickerset:InputStickerSet hash:int = messages.StickerSet;
+func (c *StickersCore) MessagesGetStickerSet(in *mtproto.TLMessagesGetStickerSet) (*mtproto.Messages_StickerSet, error) {
+	stickerSet, err := c.svcCtx.Dao.MediaClient.MediaGetStickerSet(c.ctx, &mediapb.TLMediaGetStickerSet{
+		UserId:     c.MD.UserId,
+		Stickerset: in.Stickerset,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	if in.Hash != 0 && stickerSet.GetSet().GetHash() == in.Hash {
+		return mtproto.MakeTLMessagesStickerSetNotModified(nil).To_Messages_StickerSet(), nil
+	}
+
+	return stickerSet, nil
+}
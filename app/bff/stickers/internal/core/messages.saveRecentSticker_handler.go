@@ -0,0 +1,50 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MessagesSaveRecentSticker
+// messages.saveRecentSticker#efb5d984 flags:# attached:flags.0?true id:InputDocument unsave:Bool = Bool;
+func (c *StickersCore) MessagesSaveRecentSticker(in *mtproto.TLMessagesSaveRecentSticker) (*mtproto.Bool, error) {
+	if in.Attached {
+		return mtproto.BoolTrue, nil
+	}
+
+	id, err := getInputDocumentId(in.Id)
+	if err != nil {
+		c.Logger.Errorf("messages.saveRecentSticker - error: %v", err)
+		return nil, err
+	}
+
+	rValue, err := c.svcCtx.Dao.MediaClient.MediaSaveRecentSticker(c.ctx, &mediapb.TLMediaSaveRecentSticker{
+		Unsave: mtproto.FromBool(in.Unsave),
+		UserId: c.MD.UserId,
+		Id:     id,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.saveRecentSticker - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MessagesToggleStickerSets
+// messages.toggleStickerSets#56aa05af flags:# uninstall:flags.0?true archive:flags.1?true unarchive:flags.2?true stickersets:Vector<InputStickerSet> = Bool;
+func (c *StickersCore) MessagesToggleStickerSets(in *mtproto.TLMessagesToggleStickerSets) (*mtproto.Bool, error) {
+	if !in.Uninstall && !in.Archive && !in.Unarchive {
+		err := mtproto.ErrInputRequestInvalid
+		c.Logger.Errorf("messages.toggleStickerSets - error: %v", err)
+		return nil, err
+	}
+
+	for _, stickerset := range in.Stickersets {
+		var err error
+		if in.Uninstall {
+			_, err = c.svcCtx.Dao.MediaClient.MediaUninstallStickerSet(c.ctx, &mediapb.TLMediaUninstallStickerSet{
+				UserId:     c.MD.UserId,
+				Stickerset: stickerset,
+			})
+		} else {
+			_, err = c.svcCtx.Dao.MediaClient.MediaInstallStickerSet(c.ctx, &mediapb.TLMediaInstallStickerSet{
+				Archived:   in.Archive,
+				UserId:     c.MD.UserId,
+				Stickerset: stickerset,
+			})
+		}
+		if err != nil {
+			c.Logger.Errorf("messages.toggleStickerSets - error: %v", err)
+			return nil, err
+		}
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// StickersAddStickerToSet
+// stickers.addStickerToSet#8653febe stickerset:InputStickerSet sticker:InputStickerSetItem = messages.StickerSet;
+func (c *StickersCore) StickersAddStickerToSet(in *mtproto.TLStickersAddStickerToSet) (*mtproto.Messages_StickerSet, error) {
+	stickerSet, err := c.svcCtx.Dao.MediaClient.MediaAddStickerToSet(c.ctx, &mediapb.TLMediaAddStickerToSet{
+		OwnerId:    c.MD.UserId,
+		Stickerset: in.Stickerset,
+		Sticker:    in.Sticker,
+	})
+	if err != nil {
+		c.Logger.Errorf("stickers.addStickerToSet - error: %v", err)
+		return nil, err
+	}
+
+	return stickerSet, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// StickersCreateStickerSet
+// stickers.createStickerSet#3a864023 flags:# masks:flags.0?true animated:flags.1?true videos:flags.4?true user_id:InputUser title:string short_name:string thumb:flags.2?InputDocument stickers:Vector<InputStickerSetItem> software:flags.3?string = messages.StickerSet;
+func (c *StickersCore) StickersCreateStickerSet(in *mtproto.TLStickersCreateStickerSet) (*mtproto.Messages_StickerSet, error) {
+	// bots may create sets on behalf of a user, a regular client only for itself
+	if !mtproto.FromInputUser(c.MD.UserId, in.UserId).IsSelfUser(c.MD.UserId) {
+		err := mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("stickers.createStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		thumbId int64
+		err     error
+	)
+	if in.Thumb != nil {
+		thumbId, err = getInputDocumentId(in.Thumb)
+		if err != nil {
+			c.Logger.Errorf("stickers.createStickerSet - error: %v", err)
+			return nil, err
+		}
+	}
+
+	stickerSet, err := c.svcCtx.Dao.MediaClient.MediaCreateStickerSet(c.ctx, &mediapb.TLMediaCreateStickerSet{
+		Masks:           in.Masks,
+		Animated:        in.Animated,
+		Videos:          in.Videos,
+		OwnerId:         c.MD.UserId,
+		Title:           in.Title,
+		ShortName:       in.ShortName,
+		ThumbDocumentId: thumbId,
+		Stickers:        in.Stickers,
+	})
+	if err != nil {
+		c.Logger.Errorf("stickers.createStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	return stickerSet, nil
+}
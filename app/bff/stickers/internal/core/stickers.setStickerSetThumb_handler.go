@@ -0,0 +1,46 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// StickersSetStickerSetThumb
+// stickers.setStickerSetThumb#9a364e30 stickerset:InputStickerSet thumb:InputDocument = messages.StickerSet;
+func (c *StickersCore) StickersSetStickerSetThumb(in *mtproto.TLStickersSetStickerSetThumb) (*mtproto.Messages_StickerSet, error) {
+	thumbId, err := getInputDocumentId(in.Thumb)
+	if err != nil {
+		c.Logger.Errorf("stickers.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	stickerSet, err := c.svcCtx.Dao.MediaClient.MediaSetStickerSetThumb(c.ctx, &mediapb.TLMediaSetStickerSetThumb{
+		OwnerId:         c.MD.UserId,
+		Stickerset:      in.Stickerset,
+		ThumbDocumentId: thumbId,
+	})
+	if err != nil {
+		c.Logger.Errorf("stickers.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	return stickerSet, nil
+}
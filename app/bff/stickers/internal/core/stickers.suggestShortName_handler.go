@@ -0,0 +1,58 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// StickersSuggestShortName
+// stickers.suggestShortName#4dafc503 title:string = stickers.SuggestedShortName;
+func (c *StickersCore) StickersSuggestShortName(in *mtproto.TLStickersSuggestShortName) (*mtproto.Stickers_SuggestedShortName, error) {
+	base := makeShortNameFromTitle(in.Title)
+	if base == "" {
+		err := mtproto.ErrTitleInvalid
+		c.Logger.Errorf("stickers.suggestShortName - error: %v", err)
+		return nil, err
+	}
+
+	shortName := base
+	for i := 1; i <= suggestShortNameMaxTries; i++ {
+		available, err := c.svcCtx.Dao.MediaClient.MediaCheckStickerSetShortName(c.ctx, &mediapb.TLMediaCheckStickerSetShortName{
+			ShortName: shortName,
+		})
+		if err != nil {
+			c.Logger.Errorf("stickers.suggestShortName - error: %v", err)
+			return nil, err
+		}
+		if mtproto.FromBool(available) {
+			return mtproto.MakeTLStickersSuggestedShortName(&mtproto.Stickers_SuggestedShortName{
+				ShortName: shortName,
+			}).To_Stickers_SuggestedShortName(), nil
+		}
+		shortName = base + "_" + strconv.Itoa(i)
+	}
+
+	err := mtproto.ErrShortNameOccupied
+	c.Logger.Errorf("stickers.suggestShortName - error: %v", err)
+	return nil, err
+}
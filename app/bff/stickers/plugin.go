@@ -0,0 +1,63 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package stickers_helper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/stickers/internal/dao"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// Plugin implements the sticker hooks of the files bff service on top of
+// the media service.
+type Plugin struct {
+	*dao.Dao
+}
+
+func NewPlugin(c Config) *Plugin {
+	return &Plugin{
+		Dao: dao.New(c),
+	}
+}
+
+// GetStickerSetThumbFileLocation
+// inputStickerSetThumb is resolved to the set's thumb document, the thumb_version
+// only busts the client cache.
+func (p *Plugin) GetStickerSetThumbFileLocation(ctx context.Context, userId int64, stickerset *mtproto.InputStickerSet, version int32) (*mtproto.InputFileLocation, error) {
+	document, err := p.MediaClient.MediaGetStickerSetThumbDocument(ctx, &mediapb.TLMediaGetStickerSetThumbDocument{
+		Stickerset: stickerset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mtproto.MakeTLInputDocumentFileLocation(&mtproto.InputFileLocation{
+		Id:            document.GetId(),
+		AccessHash:    document.GetAccessHash(),
+		FileReference: document.GetFileReference(),
+		ThumbSize:     "",
+	}).To_InputFileLocation(), nil
+}
+
+func (p *Plugin) GetGroupCallStreamFile(ctx context.Context, userId int64, file *mtproto.InputFileLocation) (*mtproto.Upload_File, error) {
+	return nil, errors.New("group call streams not supported")
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// AccountGetPassword
+// account.getPassword#548a30f5 = account.Password;
+func (c *TwofaCore) AccountGetPassword(in *mtproto.TLAccountGetPassword) (*mtproto.Account_Password, error) {
+	userId, err := c.getUserId()
+	if err != nil {
+		c.Logger.Errorf("account.getPassword - error: %v", err)
+		return nil, err
+	}
+
+	rV, err := c.svcCtx.Dao.TwofaClient.TwofaGetPassword(c.ctx, &twofa.TLTwofaGetPassword{
+		UserId: userId,
+	})
+	if err != nil {
+		c.Logger.Errorf("account.getPassword - error: %v", err)
+		return nil, err
+	}
+
+	return rV, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// getUserId returns the current user, or between auth.signIn and
+// auth.checkPassword the user waiting for the password check.
+func (c *TwofaCore) getUserId() (int64, error) {
+	if c.MD.UserId != 0 {
+		return c.MD.UserId, nil
+	}
+
+	id, err := c.svcCtx.Dao.TwofaClient.TwofaGetPendingSignIn(c.ctx, &twofa.TLTwofaGetPendingSignIn{
+		AuthKeyId: c.MD.AuthId,
+	})
+	if err != nil {
+		return 0, err
+	} else if id.GetV() == 0 {
+		return 0, mtproto.ErrAuthKeyUnregistered
+	}
+
+	return id.GetV(), nil
+}
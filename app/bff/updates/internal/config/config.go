@@ -28,4 +28,7 @@ type Config struct {
 	UserClient        zrpc.RpcClientConf
 	ChatClient        zrpc.RpcClientConf
 	AuthsessionClient zrpc.RpcClientConf
+	ChannelClient     zrpc.RpcClientConf
+	DialogClient      zrpc.RpcClientConf
+	SecretchatClient  zrpc.RpcClientConf
 }
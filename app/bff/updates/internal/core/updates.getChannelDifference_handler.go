@@ -14,19 +14,206 @@
 // limitations under the License.
 //
 // Author: teamgramio (teamgram.io@gmail.com)
-//
 
 package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	"github.com/teamgram/teamgram-server/app/service/biz/updates/updates"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	channelDifferenceLimit   = 100
+	channelDifferenceTooLong = 1000
+	channelDifferenceTimeout = 30
 )
 
 // UpdatesGetChannelDifference
 // updates.getChannelDifference#3173d78 flags:# force:flags.0?true channel:InputChannel filter:ChannelMessagesFilter pts:int limit:int = updates.ChannelDifference;
 func (c *UpdatesCore) UpdatesGetChannelDifference(in *mtproto.TLUpdatesGetChannelDifference) (*mtproto.Updates_ChannelDifference, error) {
-	// TODO: not impl
-	c.Logger.Errorf("updates.getChannelDifference blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	var (
+		inputChannel = in.GetChannel()
+		limit        = in.GetLimit()
+	)
+
+	switch inputChannel.GetPredicateName() {
+	case mtproto.Predicate_inputChannel:
+	case mtproto.Predicate_inputChannelFromMessage:
+	default:
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+
+	if limit <= 0 || limit > channelDifferenceLimit {
+		limit = channelDifferenceLimit
+	}
+
+	// 400	CHANNEL_INVALID	The provided channel is invalid
+	// 400	CHANNEL_PRIVATE	You haven't joined this channel/supergroup
+	channel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: inputChannel.GetChannelId(),
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, mtproto.ErrChannelInvalid
+	}
+	if inputChannel.GetPredicateName() == mtproto.Predicate_inputChannel &&
+		inputChannel.GetAccessHash() != channel.GetChannel().GetAccessHash() {
+		err = mtproto.ErrChannelInvalid
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+	if !channel.IsChannelParticipant(c.MD.UserId) {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+
+	channelPts := channel.GetChannel().GetPts()
+	if in.GetPts() >= channelPts {
+		// updates.channelDifferenceEmpty#3e11affb flags:# final:flags.0?true pts:int timeout:flags.1?int = updates.ChannelDifference;
+		return mtproto.MakeTLUpdatesChannelDifferenceEmpty(&mtproto.Updates_ChannelDifference{
+			Final:   true,
+			Pts:     channelPts,
+			Timeout: &types.Int32Value{Value: channelDifferenceTimeout},
+		}).To_Updates_ChannelDifference(), nil
+	}
+
+	if in.GetPts() <= 0 || channelPts-in.GetPts() > channelDifferenceTooLong {
+		return c.makeChannelDifferenceTooLong(channel, limit)
+	}
+
+	diff, err := c.svcCtx.Dao.UpdatesClient.UpdatesGetChannelDifferenceV2(c.ctx, &updates.TLUpdatesGetChannelDifferenceV2{
+		AuthKeyId: c.MD.PermAuthKeyId,
+		UserId:    c.MD.UserId,
+		ChannelId: channel.Id(),
+		Pts:       in.GetPts(),
+		Limit:     limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+
+	// the log has been truncated, the client must refetch the channel
+	if len(diff.GetNewMessages()) == 0 && len(diff.GetOtherUpdates()) == 0 {
+		return c.makeChannelDifferenceTooLong(channel, limit)
+	}
+
+	newMessages := make([]*mtproto.Message, 0, len(diff.GetNewMessages()))
+	if !in.GetFilter().GetExcludeNewMessages() {
+		for _, message := range diff.GetNewMessages() {
+			if !checkMessageRanges(in.GetFilter().GetRanges(), message.GetId()) {
+				continue
+			}
+			if message.GetFromId().GetUserId() == c.MD.UserId {
+				message.Out = true
+			}
+			newMessages = append(newMessages, message)
+		}
+	}
+
+	// updates.channelDifference#2064674e flags:# final:flags.0?true pts:int timeout:flags.1?int new_messages:Vector<Message> other_updates:Vector<Update> chats:Vector<Chat> users:Vector<User> = updates.ChannelDifference;
+	rDifference := mtproto.MakeTLUpdatesChannelDifference(&mtproto.Updates_ChannelDifference{
+		Final:        diff.GetFinal(),
+		Pts:          diff.GetPts(),
+		Timeout:      &types.Int32Value{Value: channelDifferenceTimeout},
+		NewMessages:  newMessages,
+		OtherUpdates: diff.GetOtherUpdates(),
+		Chats:        []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:        []*mtproto.User{},
+	}).To_Updates_ChannelDifference()
+
+	idHelper := mtproto.NewIDListHelper(c.MD.UserId)
+	idHelper.PickByMessages(rDifference.NewMessages...)
+	idHelper.PickByUpdates(rDifference.OtherUpdates...)
+	idHelper.Visit(
+		func(userIdList []int64) {
+			users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: append(userIdList, c.MD.UserId),
+				})
+			rDifference.Users = users.GetUserListByIdList(c.MD.UserId, userIdList...)
+		},
+		func(chatIdList []int64) {
+			chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
+				&chatpb.TLChatGetChatListByIdList{
+					IdList: chatIdList,
+				})
+			rDifference.Chats = append(rDifference.Chats, chats.GetChatListByIdList(c.MD.UserId, chatIdList...)...)
+		},
+		nil)
+
+	return rDifference, nil
+}
+
+// makeChannelDifferenceTooLong
+// updates.channelDifferenceTooLong#a4bcc6fe flags:# final:flags.0?true timeout:flags.1?int dialog:Dialog messages:Vector<Message> chats:Vector<Chat> users:Vector<User> = updates.ChannelDifference;
+func (c *UpdatesCore) makeChannelDifferenceTooLong(channel *channelpb.MutableChannel, limit int32) (*mtproto.Updates_ChannelDifference, error) {
+	dlgExt, err := c.svcCtx.Dao.DialogClient.DialogGetDialogById(c.ctx, &dialog.TLDialogGetDialogById{
+		UserId:   c.MD.UserId,
+		PeerType: mtproto.PEER_CHANNEL,
+		PeerId:   channel.Id(),
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+	dlgExt.GetDialog().Pts = mtproto.MakeFlagsInt32(channel.GetChannel().GetPts())
+
+	boxList, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelHistory(c.ctx, &channelpb.TLChannelGetChannelHistory{
+		ChannelId: channel.Id(),
+		OffsetId:  0,
+		Limit:     limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+
+	rDifference := mtproto.MakeTLUpdatesChannelDifferenceTooLong(&mtproto.Updates_ChannelDifference{
+		Final:    true,
+		Timeout:  &types.Int32Value{Value: channelDifferenceTimeout},
+		Dialog:   dlgExt.GetDialog(),
+		Messages: []*mtproto.Message{},
+		Chats:    []*mtproto.Chat{channel.ToUnsafeChat(c.MD.UserId)},
+		Users:    []*mtproto.User{},
+	}).To_Updates_ChannelDifference()
+
+	boxList.Visit(c.MD.UserId,
+		func(messageList []*mtproto.Message) {
+			rDifference.Messages = messageList
+		},
+		func(userIdList []int64) {
+			users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: append(userIdList, c.MD.UserId),
+				})
+			rDifference.Users = users.GetUserListByIdList(c.MD.UserId, userIdList...)
+		},
+		nil,
+		nil)
+
+	return rDifference, nil
+}
+
+func checkMessageRanges(ranges []*mtproto.MessageRange, id int32) bool {
+	if len(ranges) == 0 {
+		return true
+	}
+	for _, r := range ranges {
+		if id >= r.GetMinId() && id <= r.GetMaxId() {
+			return true
+		}
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	return false
 }
@@ -24,10 +24,14 @@ import (
 
 	"github.com/teamgram/proto/mtproto"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
 	"github.com/teamgram/teamgram-server/app/service/biz/updates/updates"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
+// encryptedDifferenceLimit bounds the new_encrypted_messages of one updates.getDifference.
+const encryptedDifferenceLimit = 100
+
 // UpdatesGetDifference
 // updates.getDifference#25939651 flags:# pts:int pts_total_limit:flags.0?int date:int qts:int = updates.Difference;
 func (c *UpdatesCore) UpdatesGetDifference(in *mtproto.TLUpdatesGetDifference) (*mtproto.Updates_Difference, error) {
@@ -52,6 +56,17 @@ func (c *UpdatesCore) UpdatesGetDifference(in *mtproto.TLUpdatesGetDifference) (
 		return nil, err
 	}
 
+	// secret chats are bound to the perm key of a device
+	secretDiff, err := c.svcCtx.Dao.SecretchatClient.SecretchatGetDifference(c.ctx, &secretchat.TLSecretchatGetDifference{
+		AuthKeyId: keyId.GetV(),
+		Qts:       in.Qts,
+		Limit:     encryptedDifferenceLimit,
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getDifference - error: %v", err)
+		return nil, err
+	}
+
 	var (
 		idHelper    = mtproto.NewIDListHelper(c.MD.UserId)
 		rDifference *mtproto.Updates_Difference
@@ -59,26 +74,45 @@ func (c *UpdatesCore) UpdatesGetDifference(in *mtproto.TLUpdatesGetDifference) (
 
 	switch updatesDiff.GetPredicateName() {
 	case updates.Predicate_differenceEmpty:
-		return mtproto.MakeTLUpdatesDifferenceEmpty(&mtproto.Updates_Difference{
-			Date: updatesDiff.GetState().GetDate(),
-			Seq:  updatesDiff.GetState().GetSeq(),
-		}).To_Updates_Difference(), nil
+		if len(secretDiff.GetNewEncryptedMessages()) == 0 && secretDiff.GetQts() == in.Qts {
+			return mtproto.MakeTLUpdatesDifferenceEmpty(&mtproto.Updates_Difference{
+				Date: updatesDiff.GetState().GetDate(),
+				Seq:  updatesDiff.GetState().GetSeq(),
+			}).To_Updates_Difference(), nil
+		}
+
+		updatesDiff.State.Date = int32(time.Now().Unix())
+		updatesDiff.State.Qts = secretDiff.GetQts()
+
+		rDifference = mtproto.MakeTLUpdatesDifference(&mtproto.Updates_Difference{
+			NewMessages:          []*mtproto.Message{},
+			NewEncryptedMessages: secretDiff.GetNewEncryptedMessages(),
+			OtherUpdates:         []*mtproto.Update{},
+			Chats:                nil,
+			Users:                nil,
+			State:                updatesDiff.State,
+		}).To_Updates_Difference()
 	case updates.Predicate_difference:
 		// TODO: fix date
 		updatesDiff.State.Date = int32(time.Now().Unix())
+		updatesDiff.State.Qts = secretDiff.GetQts()
 
 		rDifference = mtproto.MakeTLUpdatesDifference(&mtproto.Updates_Difference{
 			NewMessages:          updatesDiff.NewMessages,
-			NewEncryptedMessages: []*mtproto.EncryptedMessage{},
+			NewEncryptedMessages: secretDiff.GetNewEncryptedMessages(),
 			OtherUpdates:         updatesDiff.OtherUpdates,
 			Chats:                nil,
 			Users:                nil,
 			State:                updatesDiff.State,
 		}).To_Updates_Difference()
 	case updates.Predicate_differenceSlice:
+		if updatesDiff.IntermediateState != nil {
+			updatesDiff.IntermediateState.Qts = secretDiff.GetQts()
+		}
+
 		rDifference = mtproto.MakeTLUpdatesDifferenceSlice(&mtproto.Updates_Difference{
 			NewMessages:          updatesDiff.NewMessages,
-			NewEncryptedMessages: []*mtproto.EncryptedMessage{},
+			NewEncryptedMessages: secretDiff.GetNewEncryptedMessages(),
 			OtherUpdates:         updatesDiff.OtherUpdates,
 			Chats:                nil,
 			Users:                nil,
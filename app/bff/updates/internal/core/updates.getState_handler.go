@@ -20,6 +20,7 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
 	"github.com/teamgram/teamgram-server/app/service/biz/updates/updates"
 )
 
@@ -35,5 +36,18 @@ func (c *UpdatesCore) UpdatesGetState(in *mtproto.TLUpdatesGetState) (*mtproto.U
 		return nil, err
 	}
 
+	permAuthKeyId := c.MD.PermAuthKeyId
+	if permAuthKeyId == 0 {
+		permAuthKeyId = c.MD.AuthId
+	}
+	qts, err := c.svcCtx.Dao.SecretchatClient.SecretchatGetCurrentQts(c.ctx, &secretchat.TLSecretchatGetCurrentQts{
+		AuthKeyId: permAuthKeyId,
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getState - error: %v", err)
+		return nil, err
+	}
+	rValue.Qts = qts.GetV()
+
 	return rValue, nil
 }
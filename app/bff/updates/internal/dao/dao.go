@@ -22,7 +22,10 @@ import (
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/teamgram-server/app/bff/updates/internal/config"
 	authsession_client "github.com/teamgram/teamgram-server/app/service/authsession/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
+	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
+	secretchat_client "github.com/teamgram/teamgram-server/app/service/biz/secretchat/client"
 	updates_client "github.com/teamgram/teamgram-server/app/service/biz/updates/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 )
@@ -32,6 +35,9 @@ type Dao struct {
 	user_client.UserClient
 	chat_client.ChatClient
 	authsession_client.AuthsessionClient
+	channel_client.ChannelClient
+	dialog_client.DialogClient
+	secretchat_client.SecretchatClient
 }
 
 func New(c config.Config) *Dao {
@@ -40,5 +46,8 @@ func New(c config.Config) *Dao {
 		UserClient:        user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
 		ChatClient:        chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
 		AuthsessionClient: authsession_client.NewAuthsessionClient(rpcx.GetCachedRpcClient(c.AuthsessionClient)),
+		ChannelClient:     channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
+		DialogClient:      dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
+		SecretchatClient:  secretchat_client.NewSecretchatClient(rpcx.GetCachedRpcClient(c.SecretchatClient)),
 	}
 }
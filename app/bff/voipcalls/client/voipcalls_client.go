@@ -0,0 +1,122 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package voipcalls_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type VoipCallsClient interface {
+	MessagesDeletePhoneCallHistory(ctx context.Context, in *mtproto.TLMessagesDeletePhoneCallHistory) (*mtproto.Messages_AffectedFoundMessages, error)
+	PhoneGetCallConfig(ctx context.Context, in *mtproto.TLPhoneGetCallConfig) (*mtproto.DataJSON, error)
+	PhoneRequestCall(ctx context.Context, in *mtproto.TLPhoneRequestCall) (*mtproto.Phone_PhoneCall, error)
+	PhoneAcceptCall(ctx context.Context, in *mtproto.TLPhoneAcceptCall) (*mtproto.Phone_PhoneCall, error)
+	PhoneConfirmCall(ctx context.Context, in *mtproto.TLPhoneConfirmCall) (*mtproto.Phone_PhoneCall, error)
+	PhoneReceivedCall(ctx context.Context, in *mtproto.TLPhoneReceivedCall) (*mtproto.Bool, error)
+	PhoneDiscardCall(ctx context.Context, in *mtproto.TLPhoneDiscardCall) (*mtproto.Updates, error)
+	PhoneSetCallRating(ctx context.Context, in *mtproto.TLPhoneSetCallRating) (*mtproto.Updates, error)
+	PhoneSaveCallDebug(ctx context.Context, in *mtproto.TLPhoneSaveCallDebug) (*mtproto.Bool, error)
+	PhoneSendSignalingData(ctx context.Context, in *mtproto.TLPhoneSendSignalingData) (*mtproto.Bool, error)
+	PhoneSaveCallLog(ctx context.Context, in *mtproto.TLPhoneSaveCallLog) (*mtproto.Bool, error)
+}
+
+type defaultVoipCallsClient struct {
+	cli zrpc.Client
+}
+
+func NewVoipCallsClient(cli zrpc.Client) VoipCallsClient {
+	return &defaultVoipCallsClient{
+		cli: cli,
+	}
+}
+
+// MessagesDeletePhoneCallHistory
+// messages.deletePhoneCallHistory#f9cbe409 flags:# revoke:flags.0?true = messages.AffectedFoundMessages;
+func (m *defaultVoipCallsClient) MessagesDeletePhoneCallHistory(ctx context.Context, in *mtproto.TLMessagesDeletePhoneCallHistory) (*mtproto.Messages_AffectedFoundMessages, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.MessagesDeletePhoneCallHistory(ctx, in)
+}
+
+// PhoneGetCallConfig
+// phone.getCallConfig#55451fa9 = DataJSON;
+func (m *defaultVoipCallsClient) PhoneGetCallConfig(ctx context.Context, in *mtproto.TLPhoneGetCallConfig) (*mtproto.DataJSON, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneGetCallConfig(ctx, in)
+}
+
+// PhoneRequestCall
+// phone.requestCall#42ff96ed flags:# video:flags.0?true user_id:InputUser random_id:int g_a_hash:bytes protocol:PhoneCallProtocol = phone.PhoneCall;
+func (m *defaultVoipCallsClient) PhoneRequestCall(ctx context.Context, in *mtproto.TLPhoneRequestCall) (*mtproto.Phone_PhoneCall, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneRequestCall(ctx, in)
+}
+
+// PhoneAcceptCall
+// phone.acceptCall#3bd2b4a0 peer:InputPhoneCall g_b:bytes protocol:PhoneCallProtocol = phone.PhoneCall;
+func (m *defaultVoipCallsClient) PhoneAcceptCall(ctx context.Context, in *mtproto.TLPhoneAcceptCall) (*mtproto.Phone_PhoneCall, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneAcceptCall(ctx, in)
+}
+
+// PhoneConfirmCall
+// phone.confirmCall#2efe1722 peer:InputPhoneCall g_a:bytes key_fingerprint:long protocol:PhoneCallProtocol = phone.PhoneCall;
+func (m *defaultVoipCallsClient) PhoneConfirmCall(ctx context.Context, in *mtproto.TLPhoneConfirmCall) (*mtproto.Phone_PhoneCall, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneConfirmCall(ctx, in)
+}
+
+// PhoneReceivedCall
+// phone.receivedCall#17d54f61 peer:InputPhoneCall = Bool;
+func (m *defaultVoipCallsClient) PhoneReceivedCall(ctx context.Context, in *mtproto.TLPhoneReceivedCall) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneReceivedCall(ctx, in)
+}
+
+// PhoneDiscardCall
+// phone.discardCall#b2cbc1c0 flags:# video:flags.0?true peer:InputPhoneCall duration:int reason:PhoneCallDiscardReason connection_id:long = Updates;
+func (m *defaultVoipCallsClient) PhoneDiscardCall(ctx context.Context, in *mtproto.TLPhoneDiscardCall) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneDiscardCall(ctx, in)
+}
+
+// PhoneSetCallRating
+// phone.setCallRating#59ead627 flags:# user_initiative:flags.0?true peer:InputPhoneCall rating:int comment:string = Updates;
+func (m *defaultVoipCallsClient) PhoneSetCallRating(ctx context.Context, in *mtproto.TLPhoneSetCallRating) (*mtproto.Updates, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneSetCallRating(ctx, in)
+}
+
+// PhoneSaveCallDebug
+// phone.saveCallDebug#277add7e peer:InputPhoneCall debug:DataJSON = Bool;
+func (m *defaultVoipCallsClient) PhoneSaveCallDebug(ctx context.Context, in *mtproto.TLPhoneSaveCallDebug) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneSaveCallDebug(ctx, in)
+}
+
+// PhoneSendSignalingData
+// phone.sendSignalingData#ff7a9383 peer:InputPhoneCall data:bytes = Bool;
+func (m *defaultVoipCallsClient) PhoneSendSignalingData(ctx context.Context, in *mtproto.TLPhoneSendSignalingData) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneSendSignalingData(ctx, in)
+}
+
+// PhoneSaveCallLog
+// phone.saveCallLog#41248786 peer:InputPhoneCall file:InputFile = Bool;
+func (m *defaultVoipCallsClient) PhoneSaveCallLog(ctx context.Context, in *mtproto.TLPhoneSaveCallLog) (*mtproto.Bool, error) {
+	client := mtproto.NewRPCVoipCallsClient(m.cli.Conn())
+	return client.PhoneSaveCallLog(ctx, in)
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+)
+
+const (
+	// a call that is neither confirmed nor discarded by then is gone
+	phoneCallPendingTimeout = 90
+	// an ongoing call, clients discard it when it ends
+	phoneCallActiveTimeout = 24 * 60 * 60
+)
+
+var (
+	bigDhP = new(big.Int).SetBytes(srp.P)
+
+	// 2^{2048-64}, g_a and g_b must be in [2^{2048-64}, p - 2^{2048-64}].
+	bigDhSafetyRange = new(big.Int).Lsh(big.NewInt(1), 2048-64)
+)
+
+// checkDhGAOrB validates g_a/g_b as required by
+// https://core.telegram.org/api/end-to-end/voice-calls#key-generation
+func checkDhGAOrB(gAOrB []byte) bool {
+	if len(gAOrB) == 0 || len(gAOrB) > srp.PrimeSize {
+		return false
+	}
+
+	v := new(big.Int).SetBytes(gAOrB)
+	if v.Cmp(bigDhSafetyRange) < 0 {
+		return false
+	}
+	return v.Cmp(new(big.Int).Sub(bigDhP, bigDhSafetyRange)) <= 0
+}
+
+// checkGAHash checks g_a against the g_a_hash the call was requested with.
+func checkGAHash(gA, gAHash []byte) bool {
+	h := sha256.Sum256(gA)
+	return string(h[:]) == string(gAHash)
+}
+
+func checkProtocol(protocol *mtproto.PhoneCallProtocol) bool {
+	if protocol == nil {
+		return false
+	}
+	return (protocol.UdpP2P || protocol.UdpReflector) && protocol.MinLayer <= protocol.MaxLayer
+}
+
+// permAuthKeyId is the key a call is bound to, it survives temp key rotations.
+func (c *VoipCallsCore) permAuthKeyId() int64 {
+	if c.MD.PermAuthKeyId != 0 {
+		return c.MD.PermAuthKeyId
+	}
+	return c.MD.AuthId
+}
+
+func (c *VoipCallsCore) getPhoneCall(peer *mtproto.InputPhoneCall) (*model.PhoneCallSession, error) {
+	session, err := c.svcCtx.Dao.GetCachePhoneCall(c.ctx, peer.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if session == nil || session.AccessHash != peer.GetAccessHash() || !session.IsParticipant(c.MD.UserId) {
+		return nil, mtproto.ErrCallPeerInvalid
+	}
+
+	return session, nil
+}
+
+// isBusy reports whether userId is in another call.
+func (c *VoipCallsCore) isBusy(userId int64) bool {
+	id := c.svcCtx.Dao.GetCacheUserPhoneCallId(c.ctx, userId)
+	if id == 0 {
+		return false
+	}
+
+	session, _ := c.svcCtx.Dao.GetCachePhoneCall(c.ctx, id)
+	return session != nil
+}
+
+func (c *VoipCallsCore) makeConnections() []*mtproto.PhoneConnection {
+	connections := make([]*mtproto.PhoneConnection, 0, len(c.svcCtx.Config.Webrtc))
+	for i, v := range c.svcCtx.Config.Webrtc {
+		connections = append(connections, mtproto.MakeTLPhoneConnectionWebrtc(&mtproto.PhoneConnection{
+			Turn:     v.Turn,
+			Stun:     v.Stun,
+			Id:       int64(i + 1),
+			Ip:       v.Ip,
+			Ipv6:     v.Ipv6,
+			Port:     int32(v.Port),
+			Username: v.Username,
+			Password: v.Password,
+		}).To_PhoneConnection())
+	}
+
+	return connections
+}
+
+func makeUpdatePhoneCall(phoneCall *mtproto.PhoneCall) *mtproto.Update {
+	return mtproto.MakeTLUpdatePhoneCall(&mtproto.Update{
+		PhoneCall: phoneCall,
+	}).To_Update()
+}
+
+// pushUpdatesToDevice delivers updates to the device a call runs on,
+// or to every device of userId while the call is not picked up yet.
+func (c *VoipCallsCore) pushUpdatesToDevice(userId, authKeyId int64, updates *mtproto.Updates) {
+	if authKeyId == 0 {
+		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+			UserId:  userId,
+			Updates: updates,
+		})
+		return
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesMe(
+		c.ctx,
+		&sync.TLSyncUpdatesMe{
+			UserId:    userId,
+			AuthKeyId: authKeyId,
+			ServerId:  "",
+			SessionId: nil,
+			Updates:   updates,
+		})
+}
+
+// sendPhoneCallMessage leaves the messageActionPhoneCall of a finished call in the
+// dialog of both sides, it is always sent by the admin of the call.
+func (c *VoipCallsCore) sendPhoneCallMessage(session *model.PhoneCallSession, authKeyId int64, reason *mtproto.PhoneCallDiscardReason, duration int32) (*mtproto.Updates, error) {
+	message := mtproto.MakeTLMessageService(&mtproto.Message{
+		Out:    true,
+		Id:     0,
+		FromId: mtproto.MakePeerUser(session.AdminId),
+		PeerId: mtproto.MakePeerUser(session.ParticipantId),
+		Date:   int32(time.Now().Unix()),
+		Action: mtproto.MakeMessageActionPhoneCall(session.Video, session.Id, reason, duration),
+	}).To_Message()
+
+	return c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
+		UserId:    session.AdminId,
+		AuthKeyId: authKeyId,
+		PeerType:  mtproto.PEER_USER,
+		PeerId:    session.ParticipantId,
+		Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+			NoWebpage:    true,
+			Background:   false,
+			RandomId:     rand.Int63(),
+			Message:      message,
+			ScheduleDate: nil,
+		}).To_OutboxMessage(),
+	})
+}
+
+func (c *VoipCallsCore) getUserList(session *model.PhoneCallSession) []*mtproto.User {
+	users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{session.AdminId, session.ParticipantId},
+	})
+
+	return users.GetUserListByIdList(c.MD.UserId, session.AdminId, session.ParticipantId)
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+)
+
+// PhoneAcceptCall
+// phone.acceptCall#3bd2b4a0 peer:InputPhoneCall g_b:bytes protocol:PhoneCallProtocol = phone.PhoneCall;
+func (c *VoipCallsCore) PhoneAcceptCall(in *mtproto.TLPhoneAcceptCall) (*mtproto.Phone_PhoneCall, error) {
+	session, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	} else if session.IsAdmin(c.MD.UserId) {
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	if !checkDhGAOrB(in.GB) {
+		err = mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	if !checkProtocol(in.Protocol) {
+		err = mtproto.ErrCallProtocolFlagsInvalid
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	if session.State != model.PhoneCallStateRequested ||
+		!c.svcCtx.Dao.TryAcceptCachePhoneCall(c.ctx, session.Id, phoneCallPendingTimeout) {
+		err = mtproto.ErrCallAlreadyAccepted
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	session.ParticipantAuthKeyId = c.permAuthKeyId()
+	session.GB = in.GB
+	session.ParticipantProtocol = in.Protocol
+	session.State = model.PhoneCallStateAccepted
+	if session.ReceiveDate == 0 {
+		session.ReceiveDate = int32(time.Now().Unix())
+	}
+	if err = c.svcCtx.Dao.PutCachePhoneCall(c.ctx, session, phoneCallPendingTimeout); err != nil {
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	c.pushUpdatesToDevice(
+		session.AdminId,
+		session.AdminAuthKeyId,
+		mtproto.MakeUpdatesByUpdates(makeUpdatePhoneCall(session.ToPhoneCallAccepted())))
+
+	// the other devices of the participant stop ringing
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates:   mtproto.MakeUpdatesByUpdates(makeUpdatePhoneCall(session.ToPhoneCallDiscarded(nil, 0))),
+	})
+
+	return mtproto.MakeTLPhonePhoneCall(&mtproto.Phone_PhoneCall{
+		PhoneCall: session.ToPhoneCallWaiting(),
+		Users:     c.getUserList(session),
+	}).To_Phone_PhoneCall(), nil
+}
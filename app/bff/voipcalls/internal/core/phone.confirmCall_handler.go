@@ -0,0 +1,75 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+)
+
+// PhoneConfirmCall
+// phone.confirmCall#2efe1722 peer:InputPhoneCall g_a:bytes key_fingerprint:long protocol:PhoneCallProtocol = phone.PhoneCall;
+func (c *VoipCallsCore) PhoneConfirmCall(in *mtproto.TLPhoneConfirmCall) (*mtproto.Phone_PhoneCall, error) {
+	session, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	} else if !session.IsAdmin(c.MD.UserId) || session.State == model.PhoneCallStateRequested {
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	} else if session.State == model.PhoneCallStateConfirmed {
+		err = mtproto.ErrCallAlreadyAccepted
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	if !checkDhGAOrB(in.GA) || !checkGAHash(in.GA, session.GAHash) {
+		err = mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	if !checkProtocol(in.Protocol) {
+		err = mtproto.ErrCallProtocolFlagsInvalid
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	session.StartDate = int32(time.Now().Unix())
+	session.State = model.PhoneCallStateConfirmed
+	if err = c.svcCtx.Dao.PutCachePhoneCall(c.ctx, session, phoneCallActiveTimeout); err != nil {
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	// each side gets the key material of the other one
+	connections := c.makeConnections()
+	c.pushUpdatesToDevice(
+		session.ParticipantId,
+		session.ParticipantAuthKeyId,
+		mtproto.MakeUpdatesByUpdates(makeUpdatePhoneCall(session.ToPhoneCall(in.GA, in.KeyFingerprint, connections))))
+
+	return mtproto.MakeTLPhonePhoneCall(&mtproto.Phone_PhoneCall{
+		PhoneCall: session.ToPhoneCall(session.GB, in.KeyFingerprint, connections),
+		Users:     c.getUserList(session),
+	}).To_Phone_PhoneCall(), nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+)
+
+// PhoneDiscardCall
+// phone.discardCall#b2cbc1c0 flags:# video:flags.0?true peer:InputPhoneCall duration:int reason:PhoneCallDiscardReason connection_id:long = Updates;
+func (c *VoipCallsCore) PhoneDiscardCall(in *mtproto.TLPhoneDiscardCall) (*mtproto.Updates, error) {
+	session, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.discardCall - error: %v", err)
+		return nil, err
+	}
+
+	if !c.svcCtx.Dao.DeleteCachePhoneCall(c.ctx, session) {
+		// the other side hung up at the same time
+		return mtproto.MakeEmptyUpdates(), nil
+	}
+
+	var (
+		reason        = in.Reason
+		historyReason = in.Reason
+		duration      int32
+	)
+	if reason == nil {
+		reason = mtproto.MakeTLPhoneCallDiscardReasonHangup(nil).To_PhoneCallDiscardReason()
+		historyReason = reason
+	}
+
+	if session.StartDate == 0 {
+		// never picked up, the admin cancelled it or the participant declined it
+		if session.IsAdmin(c.MD.UserId) {
+			historyReason = mtproto.MakeTLPhoneCallDiscardReasonMissed(nil).To_PhoneCallDiscardReason()
+		} else {
+			historyReason = mtproto.MakeTLPhoneCallDiscardReasonBusy(nil).To_PhoneCallDiscardReason()
+		}
+	} else {
+		duration = in.Duration
+		if maxDuration := int32(time.Now().Unix()) - session.StartDate; duration > maxDuration || duration < 0 {
+			duration = maxDuration
+		}
+	}
+
+	update := makeUpdatePhoneCall(session.ToPhoneCallDiscarded(reason, duration))
+
+	peerId, peerAuthKeyId := session.GetPeer(c.MD.UserId)
+	c.pushUpdatesToDevice(peerId, peerAuthKeyId, mtproto.MakeUpdatesByUpdates(update))
+
+	if !session.IsAdmin(c.MD.UserId) && session.ParticipantAuthKeyId == 0 {
+		// a declined call, the other devices of the participant stop ringing
+		c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+			UserId:    c.MD.UserId,
+			AuthKeyId: c.MD.AuthId,
+			Updates:   mtproto.MakeUpdatesByUpdates(update),
+		})
+	}
+
+	if !session.IsAdmin(c.MD.UserId) {
+		// the history message is the admin's, the participant gets it through its inbox
+		if _, err = c.sendPhoneCallMessage(session, 0, historyReason, duration); err != nil {
+			c.Logger.Errorf("phone.discardCall - error: %v", err)
+		}
+		return mtproto.MakeUpdatesByUpdates(update), nil
+	}
+
+	rUpdates, err := c.sendPhoneCallMessage(session, c.MD.AuthId, historyReason, duration)
+	if err != nil {
+		c.Logger.Errorf("phone.discardCall - error: %v", err)
+		return mtproto.MakeUpdatesByUpdates(update), nil
+	}
+	rUpdates.Updates = append([]*mtproto.Update{update}, rUpdates.Updates...)
+
+	return rUpdates, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+)
+
+// PhoneReceivedCall
+// phone.receivedCall#17d54f61 peer:InputPhoneCall = Bool;
+func (c *VoipCallsCore) PhoneReceivedCall(in *mtproto.TLPhoneReceivedCall) (*mtproto.Bool, error) {
+	session, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.receivedCall - error: %v", err)
+		return nil, err
+	} else if session.IsAdmin(c.MD.UserId) {
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.receivedCall - error: %v", err)
+		return nil, err
+	}
+
+	if session.State != model.PhoneCallStateRequested || session.ReceiveDate != 0 {
+		// another device of the participant got it first
+		return mtproto.BoolTrue, nil
+	}
+
+	session.ReceiveDate = int32(time.Now().Unix())
+	if err = c.svcCtx.Dao.PutCachePhoneCall(c.ctx, session, phoneCallPendingTimeout); err != nil {
+		c.Logger.Errorf("phone.receivedCall - error: %v", err)
+		return nil, err
+	}
+
+	c.pushUpdatesToDevice(
+		session.AdminId,
+		session.AdminAuthKeyId,
+		mtproto.MakeUpdatesByUpdates(makeUpdatePhoneCall(session.ToPhoneCallWaiting())))
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"crypto/sha256"
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// PhoneRequestCall
+// phone.requestCall#42ff96ed flags:# video:flags.0?true user_id:InputUser random_id:int g_a_hash:bytes protocol:PhoneCallProtocol = phone.PhoneCall;
+func (c *VoipCallsCore) PhoneRequestCall(in *mtproto.TLPhoneRequestCall) (*mtproto.Phone_PhoneCall, error) {
+	peer := mtproto.FromInputUser(c.MD.UserId, in.UserId)
+	if peer.PeerType != mtproto.PEER_USER {
+		err := mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	if len(in.GAHash) != sha256.Size {
+		err := mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	if !checkProtocol(in.Protocol) {
+		err := mtproto.ErrCallProtocolFlagsInvalid
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId, peer.PeerId},
+	})
+	if err != nil {
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	} else if !users.CheckExistUser(c.MD.UserId, peer.PeerId) {
+		err = mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	blocked, _ := c.svcCtx.Dao.UserClient.UserBlockedByUser(c.ctx, &userpb.TLUserBlockedByUser{
+		UserId:     peer.PeerId,
+		PeerUserId: c.MD.UserId,
+	})
+	if mtproto.FromBool(blocked) {
+		err = mtproto.ErrUserPrivacyRestricted
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	session := &model.PhoneCallSession{
+		Id:             rand.Int63(),
+		AccessHash:     rand.Int63(),
+		Video:          in.Video,
+		AdminId:        c.MD.UserId,
+		AdminAuthKeyId: c.permAuthKeyId(),
+		ParticipantId:  peer.PeerId,
+		RandomId:       in.RandomId,
+		GAHash:         in.GAHash,
+		AdminProtocol:  in.Protocol,
+		Date:           int32(time.Now().Unix()),
+		State:          model.PhoneCallStateRequested,
+	}
+
+	if c.isBusy(peer.PeerId) {
+		// the participant does not ring, both sides get the busy call in the history
+		reason := mtproto.MakeTLPhoneCallDiscardReasonBusy(nil).To_PhoneCallDiscardReason()
+		if _, err = c.sendPhoneCallMessage(session, 0, reason, 0); err != nil {
+			c.Logger.Errorf("phone.requestCall - error: %v", err)
+		}
+
+		return mtproto.MakeTLPhonePhoneCall(&mtproto.Phone_PhoneCall{
+			PhoneCall: session.ToPhoneCallDiscarded(reason, 0),
+			Users:     users.GetUserListByIdList(c.MD.UserId, c.MD.UserId, peer.PeerId),
+		}).To_Phone_PhoneCall(), nil
+	}
+
+	if err = c.svcCtx.Dao.PutCachePhoneCall(c.ctx, session, phoneCallPendingTimeout); err != nil {
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	// the call rings on every device of the participant, the first one to accept wins
+	c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+		UserId: peer.PeerId,
+		Updates: mtproto.MakeUpdatesByUpdatesUsers(
+			users.GetUserListByIdList(peer.PeerId, c.MD.UserId, peer.PeerId),
+			makeUpdatePhoneCall(session.ToPhoneCallRequested())),
+	})
+
+	return mtproto.MakeTLPhonePhoneCall(&mtproto.Phone_PhoneCall{
+		PhoneCall: session.ToPhoneCallWaiting(),
+		Users:     users.GetUserListByIdList(c.MD.UserId, c.MD.UserId, peer.PeerId),
+	}).To_Phone_PhoneCall(), nil
+}
@@ -0,0 +1,30 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneSaveCallLog
+// phone.saveCallLog#41248786 peer:InputPhoneCall file:InputFile = Bool;
+func (c *VoipCallsCore) PhoneSaveCallLog(in *mtproto.TLPhoneSaveCallLog) (*mtproto.Bool, error) {
+	// TODO(@benqi): upload the log to dfs
+	return mtproto.BoolTrue, nil
+}
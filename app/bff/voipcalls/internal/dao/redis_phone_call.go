@@ -0,0 +1,138 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	cachePhoneCallPrefix     = "phone_calls"
+	cacheUserPhoneCallPrefix = "user_phone_calls"
+)
+
+func genPhoneCallKey(id int64) string {
+	return fmt.Sprintf("%s_%d", cachePhoneCallPrefix, id)
+}
+
+func genUserPhoneCallKey(userId int64) string {
+	return fmt.Sprintf("%s_%d", cacheUserPhoneCallPrefix, userId)
+}
+
+func (d *Dao) GetCachePhoneCall(ctx context.Context, id int64) (*model.PhoneCallSession, error) {
+	key := genPhoneCallKey(id)
+
+	v, err := d.kv.GetCtx(ctx, key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", key, err)
+		return nil, err
+	} else if v == "" {
+		return nil, nil
+	}
+
+	session := new(model.PhoneCallSession)
+	if err = jsonx.UnmarshalFromString(v, session); err != nil {
+		logx.WithContext(ctx).Errorf("jsonx.UnmarshalFromString(%s) error(%v)", v, err)
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// PutCachePhoneCall saves session and marks both sides as busy with it for expiredIn seconds.
+func (d *Dao) PutCachePhoneCall(ctx context.Context, session *model.PhoneCallSession, expiredIn int) error {
+	v, err := jsonx.MarshalToString(session)
+	if err != nil {
+		return err
+	}
+
+	key := genPhoneCallKey(session.Id)
+	if err = d.kv.SetexCtx(ctx, key, v, expiredIn); err != nil {
+		logx.WithContext(ctx).Errorf("conn.SETEX(%s) error(%v)", key, err)
+		return err
+	}
+
+	id := strconv.FormatInt(session.Id, 10)
+	for _, userId := range []int64{session.AdminId, session.ParticipantId} {
+		key = genUserPhoneCallKey(userId)
+		if err = d.kv.SetexCtx(ctx, key, id, expiredIn); err != nil {
+			logx.WithContext(ctx).Errorf("conn.SETEX(%s) error(%v)", key, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TryAcceptCachePhoneCall lets only the first device of the participant accept the call id.
+func (d *Dao) TryAcceptCachePhoneCall(ctx context.Context, id int64, expiredIn int) bool {
+	key := fmt.Sprintf("%s_accepted", genPhoneCallKey(id))
+
+	ok, err := d.kv.SetnxExCtx(ctx, key, "1", expiredIn)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.SETNX(%s) error(%v)", key, err)
+		return false
+	}
+
+	return ok
+}
+
+// GetCacheUserPhoneCallId returns the call userId is busy with, or 0.
+func (d *Dao) GetCacheUserPhoneCallId(ctx context.Context, userId int64) int64 {
+	key := genUserPhoneCallKey(userId)
+
+	v, err := d.kv.GetCtx(ctx, key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", key, err)
+		return 0
+	}
+
+	id, _ := strconv.ParseInt(v, 10, 64)
+	return id
+}
+
+// DeleteCachePhoneCall removes session, it returns false if someone else already did.
+func (d *Dao) DeleteCachePhoneCall(ctx context.Context, session *model.PhoneCallSession) bool {
+	key := genPhoneCallKey(session.Id)
+	n, err := d.kv.DelCtx(ctx, key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.DEL(%s) error(%v)", key, err)
+		return false
+	}
+
+	for _, userId := range []int64{session.AdminId, session.ParticipantId} {
+		// either side may be busy with a newer call by now
+		if d.GetCacheUserPhoneCallId(ctx, userId) != session.Id {
+			continue
+		}
+		key = genUserPhoneCallKey(userId)
+		if _, err = d.kv.DelCtx(ctx, key); err != nil {
+			logx.WithContext(ctx).Errorf("conn.DEL(%s) error(%v)", key, err)
+		}
+	}
+
+	return n > 0
+}
@@ -0,0 +1,161 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	PhoneCallStateRequested = 1
+	PhoneCallStateAccepted  = 2
+	PhoneCallStateConfirmed = 3
+)
+
+// PhoneCallSession is a call between its admin (the caller) and its participant,
+// it lives in the cache until the call is discarded or expires.
+type PhoneCallSession struct {
+	Id                   int64                      `json:"id"`
+	AccessHash           int64                      `json:"access_hash"`
+	Video                bool                       `json:"video"`
+	AdminId              int64                      `json:"admin_id"`
+	AdminAuthKeyId       int64                      `json:"admin_auth_key_id"`
+	ParticipantId        int64                      `json:"participant_id"`
+	ParticipantAuthKeyId int64                      `json:"participant_auth_key_id"`
+	RandomId             int32                      `json:"random_id"`
+	GAHash               []byte                     `json:"g_a_hash"`
+	GB                   []byte                     `json:"g_b"`
+	AdminProtocol        *mtproto.PhoneCallProtocol `json:"admin_protocol"`
+	ParticipantProtocol  *mtproto.PhoneCallProtocol `json:"participant_protocol"`
+	Date                 int32                      `json:"date"`
+	ReceiveDate          int32                      `json:"receive_date"`
+	StartDate            int32                      `json:"start_date"`
+	State                int                        `json:"state"`
+}
+
+func (m *PhoneCallSession) IsAdmin(userId int64) bool {
+	return m.AdminId == userId
+}
+
+func (m *PhoneCallSession) IsParticipant(userId int64) bool {
+	return m.AdminId == userId || m.ParticipantId == userId
+}
+
+// GetPeer returns the other side of the call and the device it runs on,
+// peerAuthKeyId is 0 while the participant has not accepted.
+func (m *PhoneCallSession) GetPeer(userId int64) (peerId, peerAuthKeyId int64) {
+	if m.IsAdmin(userId) {
+		return m.ParticipantId, m.ParticipantAuthKeyId
+	}
+	return m.AdminId, m.AdminAuthKeyId
+}
+
+// P2PAllowed reports whether both sides allow peer-to-peer connections.
+func (m *PhoneCallSession) P2PAllowed() bool {
+	return m.AdminProtocol.GetUdpP2P() && m.ParticipantProtocol.GetUdpP2P()
+}
+
+// ToPhoneCallWaiting
+// phoneCallWaiting#c5226f17 flags:# video:flags.6?true id:long access_hash:long date:int admin_id:long participant_id:long protocol:PhoneCallProtocol receive_date:flags.0?int = PhoneCall;
+func (m *PhoneCallSession) ToPhoneCallWaiting() *mtproto.PhoneCall {
+	phoneCall := mtproto.MakeTLPhoneCallWaiting(&mtproto.PhoneCall{
+		Video:         m.Video,
+		Id:            m.Id,
+		AccessHash:    m.AccessHash,
+		Date:          m.Date,
+		AdminId:       m.AdminId,
+		ParticipantId: m.ParticipantId,
+		Protocol:      m.AdminProtocol,
+		ReceiveDate:   nil,
+	}).To_PhoneCall()
+	if m.ReceiveDate != 0 {
+		phoneCall.ReceiveDate = &types.Int32Value{Value: m.ReceiveDate}
+	}
+
+	return phoneCall
+}
+
+// ToPhoneCallRequested
+// phoneCallRequested#14b0ed0c flags:# video:flags.6?true id:long access_hash:long date:int admin_id:long participant_id:long g_a_hash:bytes protocol:PhoneCallProtocol = PhoneCall;
+func (m *PhoneCallSession) ToPhoneCallRequested() *mtproto.PhoneCall {
+	return mtproto.MakeTLPhoneCallRequested(&mtproto.PhoneCall{
+		Video:         m.Video,
+		Id:            m.Id,
+		AccessHash:    m.AccessHash,
+		Date:          m.Date,
+		AdminId:       m.AdminId,
+		ParticipantId: m.ParticipantId,
+		GAHash:        m.GAHash,
+		Protocol:      m.AdminProtocol,
+	}).To_PhoneCall()
+}
+
+// ToPhoneCallAccepted
+// phoneCallAccepted#3660c311 flags:# video:flags.6?true id:long access_hash:long date:int admin_id:long participant_id:long g_b:bytes protocol:PhoneCallProtocol = PhoneCall;
+func (m *PhoneCallSession) ToPhoneCallAccepted() *mtproto.PhoneCall {
+	return mtproto.MakeTLPhoneCallAccepted(&mtproto.PhoneCall{
+		Video:         m.Video,
+		Id:            m.Id,
+		AccessHash:    m.AccessHash,
+		Date:          m.Date,
+		AdminId:       m.AdminId,
+		ParticipantId: m.ParticipantId,
+		GB:            m.GB,
+		Protocol:      m.ParticipantProtocol,
+	}).To_PhoneCall()
+}
+
+// ToPhoneCall
+// phoneCall#967f7c67 flags:# p2p_allowed:flags.5?true video:flags.6?true id:long access_hash:long date:int admin_id:long participant_id:long g_a_or_b:bytes key_fingerprint:long protocol:PhoneCallProtocol connections:Vector<PhoneConnection> start_date:int = PhoneCall;
+func (m *PhoneCallSession) ToPhoneCall(gAOrB []byte, keyFingerprint int64, connections []*mtproto.PhoneConnection) *mtproto.PhoneCall {
+	return mtproto.MakeTLPhoneCall(&mtproto.PhoneCall{
+		P2PAllowed:     m.P2PAllowed(),
+		Video:          m.Video,
+		Id:             m.Id,
+		AccessHash:     m.AccessHash,
+		Date:           m.Date,
+		AdminId:        m.AdminId,
+		ParticipantId:  m.ParticipantId,
+		GAOrB:          gAOrB,
+		KeyFingerprint: keyFingerprint,
+		Protocol:       m.ParticipantProtocol,
+		Connections:    connections,
+		StartDate:      m.StartDate,
+	}).To_PhoneCall()
+}
+
+// ToPhoneCallDiscarded
+// phoneCallDiscarded#50ca4de1 flags:# need_rating:flags.2?true need_debug:flags.3?true video:flags.6?true id:long reason:flags.0?PhoneCallDiscardReason duration:flags.1?int = PhoneCall;
+func (m *PhoneCallSession) ToPhoneCallDiscarded(reason *mtproto.PhoneCallDiscardReason, duration int32) *mtproto.PhoneCall {
+	phoneCall := mtproto.MakeTLPhoneCallDiscarded(&mtproto.PhoneCall{
+		NeedRating: m.StartDate != 0,
+		NeedDebug:  false,
+		Video:      m.Video,
+		Id:         m.Id,
+		Reason:     reason,
+		Duration:   nil,
+	}).To_PhoneCall()
+	if duration > 0 {
+		phoneCall.Duration = &types.Int32Value{Value: duration}
+	}
+
+	return phoneCall
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestPhoneCallSession(t *testing.T) {
+	session := &PhoneCallSession{
+		Id:                   1,
+		AdminId:              10,
+		AdminAuthKeyId:       100,
+		ParticipantId:        20,
+		ParticipantAuthKeyId: 0,
+		AdminProtocol:        &mtproto.PhoneCallProtocol{UdpP2P: true, UdpReflector: true},
+		ParticipantProtocol:  &mtproto.PhoneCallProtocol{UdpP2P: false, UdpReflector: true},
+	}
+
+	if peerId, peerAuthKeyId := session.GetPeer(10); peerId != 20 || peerAuthKeyId != 0 {
+		t.Errorf("GetPeer(10) = (%d, %d), want (20, 0)", peerId, peerAuthKeyId)
+	}
+	if peerId, peerAuthKeyId := session.GetPeer(20); peerId != 10 || peerAuthKeyId != 100 {
+		t.Errorf("GetPeer(20) = (%d, %d), want (10, 100)", peerId, peerAuthKeyId)
+	}
+	if session.IsParticipant(30) {
+		t.Errorf("IsParticipant(30) = true, want false")
+	}
+	if session.P2PAllowed() {
+		t.Errorf("P2PAllowed() = true, want false")
+	}
+
+	if phoneCall := session.ToPhoneCallWaiting(); phoneCall.ReceiveDate != nil {
+		t.Errorf("ReceiveDate = %v, want nil", phoneCall.ReceiveDate)
+	}
+
+	discarded := session.ToPhoneCallDiscarded(nil, 0)
+	if discarded.NeedRating || discarded.Duration != nil {
+		t.Errorf("ToPhoneCallDiscarded() of a call never started = %v", discarded)
+	}
+
+	session.StartDate = 1
+	discarded = session.ToPhoneCallDiscarded(nil, 30)
+	if !discarded.NeedRating || discarded.Duration.GetValue() != 30 {
+		t.Errorf("ToPhoneCallDiscarded() of a started call = %v", discarded)
+	}
+}
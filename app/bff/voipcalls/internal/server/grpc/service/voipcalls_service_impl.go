@@ -0,0 +1,183 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/core"
+)
+
+// MessagesDeletePhoneCallHistory
+// messages.deletePhoneCallHistory#f9cbe409 flags:# revoke:flags.0?true = messages.AffectedFoundMessages;
+func (s *Service) MessagesDeletePhoneCallHistory(ctx context.Context, request *mtproto.TLMessagesDeletePhoneCallHistory) (*mtproto.Messages_AffectedFoundMessages, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("messages.deletePhoneCallHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesDeletePhoneCallHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("messages.deletePhoneCallHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneGetCallConfig
+// phone.getCallConfig#55451fa9 = DataJSON;
+func (s *Service) PhoneGetCallConfig(ctx context.Context, request *mtproto.TLPhoneGetCallConfig) (*mtproto.DataJSON, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.getCallConfig - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneGetCallConfig(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.getCallConfig - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneRequestCall
+// phone.requestCall#42ff96ed flags:# video:flags.0?true user_id:InputUser random_id:int g_a_hash:bytes protocol:PhoneCallProtocol = phone.PhoneCall;
+func (s *Service) PhoneRequestCall(ctx context.Context, request *mtproto.TLPhoneRequestCall) (*mtproto.Phone_PhoneCall, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.requestCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneRequestCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.requestCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneAcceptCall
+// phone.acceptCall#3bd2b4a0 peer:InputPhoneCall g_b:bytes protocol:PhoneCallProtocol = phone.PhoneCall;
+func (s *Service) PhoneAcceptCall(ctx context.Context, request *mtproto.TLPhoneAcceptCall) (*mtproto.Phone_PhoneCall, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.acceptCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneAcceptCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.acceptCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneConfirmCall
+// phone.confirmCall#2efe1722 peer:InputPhoneCall g_a:bytes key_fingerprint:long protocol:PhoneCallProtocol = phone.PhoneCall;
+func (s *Service) PhoneConfirmCall(ctx context.Context, request *mtproto.TLPhoneConfirmCall) (*mtproto.Phone_PhoneCall, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.confirmCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneConfirmCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.confirmCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneReceivedCall
+// phone.receivedCall#17d54f61 peer:InputPhoneCall = Bool;
+func (s *Service) PhoneReceivedCall(ctx context.Context, request *mtproto.TLPhoneReceivedCall) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.receivedCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneReceivedCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.receivedCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneDiscardCall
+// phone.discardCall#b2cbc1c0 flags:# video:flags.0?true peer:InputPhoneCall duration:int reason:PhoneCallDiscardReason connection_id:long = Updates;
+func (s *Service) PhoneDiscardCall(ctx context.Context, request *mtproto.TLPhoneDiscardCall) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.discardCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneDiscardCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.discardCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneSetCallRating
+// phone.setCallRating#59ead627 flags:# user_initiative:flags.0?true peer:InputPhoneCall rating:int comment:string = Updates;
+func (s *Service) PhoneSetCallRating(ctx context.Context, request *mtproto.TLPhoneSetCallRating) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.setCallRating - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneSetCallRating(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.setCallRating - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneSaveCallDebug
+// phone.saveCallDebug#277add7e peer:InputPhoneCall debug:DataJSON = Bool;
+func (s *Service) PhoneSaveCallDebug(ctx context.Context, request *mtproto.TLPhoneSaveCallDebug) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.saveCallDebug - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneSaveCallDebug(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.saveCallDebug - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneSendSignalingData
+// phone.sendSignalingData#ff7a9383 peer:InputPhoneCall data:bytes = Bool;
+func (s *Service) PhoneSendSignalingData(ctx context.Context, request *mtproto.TLPhoneSendSignalingData) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.sendSignalingData - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneSendSignalingData(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.sendSignalingData - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneSaveCallLog
+// phone.saveCallLog#41248786 peer:InputPhoneCall file:InputFile = Bool;
+func (s *Service) PhoneSaveCallLog(ctx context.Context, request *mtproto.TLPhoneSaveCallLog) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("phone.saveCallLog - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneSaveCallLog(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("phone.saveCallLog - reply: %s", r.DebugString())
+	return r, err
+}
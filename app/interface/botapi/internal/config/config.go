@@ -0,0 +1,41 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package config
+
+import (
+	"github.com/teamgram/teamgram-server/pkg/conf"
+
+	"github.com/zeromicro/go-zero/core/stores/kv"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	// sync pushes the updates of the bots served here to this server, it
+	// registers under its own etcd key, see BotApiClient of sync.
+	zrpc.RpcServerConf
+	Http            rest.RestConf
+	KV              kv.KvConf
+	AuthSession     zrpc.RpcClientConf
+	StatusClient    zrpc.RpcClientConf
+	UserClient      zrpc.RpcClientConf
+	BFFProxyClients conf.BFFProxyClients
+	// MaxPendingUpdates is the number of updates kept per bot until they are confirmed
+	MaxPendingUpdates int `json:",default=1000"`
+}
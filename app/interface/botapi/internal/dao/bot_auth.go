@@ -0,0 +1,96 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/crypto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	botAuthKeysKey  = "botapi_auth_keys"
+	botUpdateIdsKey = "botapi_update_ids"
+)
+
+// GetBotAuthKeyId returns the auth key the http requests of botId are invoked with,
+// it is created and bound to the bot on first use and kept across restarts.
+func (d *Dao) GetBotAuthKeyId(ctx context.Context, botId int64) (int64, error) {
+	field := strconv.FormatInt(botId, 10)
+
+	v, err := d.kv.HgetCtx(ctx, botAuthKeysKey, field)
+	if err == nil {
+		if authKeyId, err2 := strconv.ParseInt(v, 10, 64); err2 == nil && authKeyId != 0 {
+			return authKeyId, nil
+		}
+	} else if err != redis.Nil {
+		logx.WithContext(ctx).Errorf("conn.HGET(%s, %s) error(%v)", botAuthKeysKey, field, err)
+		return 0, err
+	}
+
+	key := crypto.CreateAuthKey()
+	_, err = d.AuthsessionClient.AuthsessionSetAuthKey(ctx, &authsession.TLAuthsessionSetAuthKey{
+		AuthKey: &mtproto.AuthKeyInfo{
+			AuthKeyId:          key.AuthKeyId(),
+			AuthKey:            key.AuthKey(),
+			AuthKeyType:        mtproto.AuthKeyTypePerm,
+			PermAuthKeyId:      key.AuthKeyId(),
+			TempAuthKeyId:      0,
+			MediaTempAuthKeyId: 0,
+		},
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("authsession.setAuthKey - error: %v", err)
+		return 0, err
+	}
+
+	_, err = d.AuthsessionClient.AuthsessionBindAuthKeyUser(ctx, &authsession.TLAuthsessionBindAuthKeyUser{
+		AuthKeyId: key.AuthKeyId(),
+		UserId:    botId,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("authsession.bindAuthKeyUser - error: %v", err)
+		return 0, err
+	}
+
+	if err = d.kv.HsetCtx(ctx, botAuthKeysKey, field, strconv.FormatInt(key.AuthKeyId(), 10)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.HSET(%s, %s) error(%v)", botAuthKeysKey, field, err)
+		return 0, err
+	}
+
+	return key.AuthKeyId(), nil
+}
+
+// NextUpdateId returns the next update_id of botId, ids keep growing across
+// restarts so the offset of getUpdates stays valid.
+func (d *Dao) NextUpdateId(ctx context.Context, botId int64) (int64, error) {
+	id, err := d.kv.HincrbyCtx(ctx, botUpdateIdsKey, strconv.FormatInt(botId, 10), 1)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.HINCRBY(%s, %d) error(%v)", botUpdateIdsKey, botId, err)
+		return 0, err
+	}
+
+	return int64(id), nil
+}
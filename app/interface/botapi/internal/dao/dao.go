@@ -0,0 +1,48 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	bff_proxy_client "github.com/teamgram/teamgram-server/app/bff/bff/client"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/config"
+	authsession_client "github.com/teamgram/teamgram-server/app/service/authsession/client"
+	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	status_client "github.com/teamgram/teamgram-server/app/service/status/client"
+
+	"github.com/zeromicro/go-zero/core/stores/kv"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Dao struct {
+	kv kv.Store
+	authsession_client.AuthsessionClient
+	status_client.StatusClient
+	user_client.UserClient
+	*bff_proxy_client.BFFProxyClient
+}
+
+func New(c config.Config) *Dao {
+	return &Dao{
+		kv:                kv.NewStore(c.KV),
+		AuthsessionClient: authsession_client.NewAuthsessionClient(zrpc.MustNewClient(c.AuthSession)),
+		StatusClient:      status_client.NewStatusClient(zrpc.MustNewClient(c.StatusClient)),
+		UserClient:        user_client.NewUserClient(zrpc.MustNewClient(c.UserClient)),
+		BFFProxyClient:    bff_proxy_client.NewBFFProxyClients(c.BFFProxyClients.Clients, c.BFFProxyClients.IDMap),
+	}
+}
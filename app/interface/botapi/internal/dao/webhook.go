@@ -0,0 +1,84 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	botWebhooksKey = "botapi_webhooks"
+)
+
+// Webhook is what setWebhook stored for a bot.
+type Webhook struct {
+	Url            string   `json:"url"`
+	SecretToken    string   `json:"secret_token,omitempty"`
+	MaxConnections int      `json:"max_connections,omitempty"`
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+func (d *Dao) SetWebhook(ctx context.Context, botId int64, webhook *Webhook) error {
+	b, _ := json.Marshal(webhook)
+	if err := d.kv.HsetCtx(ctx, botWebhooksKey, strconv.FormatInt(botId, 10), string(b)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.HSET(%s, %d) error(%v)", botWebhooksKey, botId, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) DeleteWebhook(ctx context.Context, botId int64) error {
+	if _, err := d.kv.HdelCtx(ctx, botWebhooksKey, strconv.FormatInt(botId, 10)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.HDEL(%s, %d) error(%v)", botWebhooksKey, botId, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetAllWebhooks returns the webhooks of all bots by bot id, they are loaded on start.
+func (d *Dao) GetAllWebhooks(ctx context.Context) (map[int64]*Webhook, error) {
+	m, err := d.kv.HgetallCtx(ctx, botWebhooksKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.HGETALL(%s) error(%v)", botWebhooksKey, err)
+		return nil, err
+	}
+
+	webhooks := make(map[int64]*Webhook, len(m))
+	for k, v := range m {
+		botId, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			continue
+		}
+		webhook := new(Webhook)
+		if err = jsonx.UnmarshalFromString(v, webhook); err != nil {
+			logx.WithContext(ctx).Errorf("invalid webhook of bot %d: %s", botId, v)
+			continue
+		}
+		webhooks[botId] = webhook
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// channelChatIdShift is added to channel ids, as in the Bot API a channel or
+// supergroup chat_id is -100<channel_id>.
+const channelChatIdShift = 1000000000000
+
+// ChatIdFromPeer returns the Bot API chat_id of peer: users are positive, basic
+// groups are -id and channels -100<id>.
+func ChatIdFromPeer(peerType int32, peerId int64) int64 {
+	switch peerType {
+	case mtproto.PEER_CHAT:
+		return -peerId
+	case mtproto.PEER_CHANNEL:
+		return -(channelChatIdShift + peerId)
+	default:
+		return peerId
+	}
+}
+
+// PeerFromChatId is the reverse of ChatIdFromPeer, nil if chatId is 0.
+func PeerFromChatId(chatId int64) *mtproto.PeerUtil {
+	switch {
+	case chatId > 0:
+		return mtproto.MakeUserPeerUtil(chatId)
+	case chatId < -channelChatIdShift:
+		return mtproto.MakeChannelPeerUtil(-chatId - channelChatIdShift)
+	case chatId < 0:
+		return mtproto.MakeChatPeerUtil(-chatId)
+	default:
+		return nil
+	}
+}
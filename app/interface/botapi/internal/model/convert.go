@@ -0,0 +1,226 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+// UserLookup returns the user id as seen by the bot, it is asked for the users
+// missing in the pushed updates, short messages carry none.
+type UserLookup func(id int64) *mtproto.User
+
+type converter struct {
+	users  map[int64]*mtproto.User
+	chats  map[int64]*mtproto.Chat
+	lookup UserLookup
+}
+
+// MakeUpdates converts the updates pushed to botId to Bot API updates, the
+// update_id is assigned by the caller. Outgoing messages and the updates which
+// have no Bot API counterpart are dropped.
+func MakeUpdates(botId int64, updates *mtproto.Updates, lookup UserLookup) []*Update {
+	c := &converter{
+		users:  make(map[int64]*mtproto.User),
+		chats:  make(map[int64]*mtproto.Chat),
+		lookup: lookup,
+	}
+	for _, u := range updates.GetUsers() {
+		c.users[u.GetId()] = u
+	}
+	for _, chat := range updates.GetChats() {
+		c.chats[chat.GetId()] = chat
+	}
+
+	var (
+		result []*Update
+	)
+
+	onMessage := func(edited bool) mtproto.UpdateVisitedFunc {
+		return func(
+			userId int64,
+			update *mtproto.Update,
+			users []*mtproto.User,
+			chats []*mtproto.Chat,
+			date int32,
+		) {
+			m := c.makeMessage(update.GetMessage_MESSAGE())
+			if m == nil {
+				return
+			}
+
+			u := new(Update)
+			switch {
+			case m.Chat.Type == ChatTypeChannel && edited:
+				u.EditedChannelPost = m
+			case m.Chat.Type == ChatTypeChannel:
+				u.ChannelPost = m
+			case edited:
+				u.EditedMessage = m
+			default:
+				u.Message = m
+			}
+			result = append(result, u)
+		}
+	}
+
+	mtproto.VisitUpdates(botId, updates, map[string]mtproto.UpdateVisitedFunc{
+		mtproto.Predicate_updateNewMessage:         onMessage(false),
+		mtproto.Predicate_updateNewChannelMessage:  onMessage(false),
+		mtproto.Predicate_updateEditMessage:        onMessage(true),
+		mtproto.Predicate_updateEditChannelMessage: onMessage(true),
+	})
+
+	return result
+}
+
+// MakeMessage converts a message sent by the bot itself, e.g. the result of sendMessage.
+func MakeMessage(m *mtproto.Message, users []*mtproto.User, chats []*mtproto.Chat, lookup UserLookup) *Message {
+	c := &converter{
+		users:  make(map[int64]*mtproto.User),
+		chats:  make(map[int64]*mtproto.Chat),
+		lookup: lookup,
+	}
+	for _, u := range users {
+		c.users[u.GetId()] = u
+	}
+	for _, chat := range chats {
+		c.chats[chat.GetId()] = chat
+	}
+
+	return c.toMessage(m)
+}
+
+func (c *converter) makeMessage(m *mtproto.Message) *Message {
+	if m.GetOut() {
+		return nil
+	}
+
+	return c.toMessage(m)
+}
+
+func (c *converter) toMessage(m *mtproto.Message) *Message {
+	if m.GetPredicateName() != mtproto.Predicate_message || m.GetPeerId() == nil {
+		return nil
+	}
+
+	peer := mtproto.FromPeer(m.GetPeerId())
+	chat := c.makeChat(peer.PeerType, peer.PeerId)
+	if chat == nil {
+		return nil
+	}
+
+	message := &Message{
+		MessageId: m.GetId(),
+		Date:      m.GetDate(),
+		Chat:      chat,
+		EditDate:  m.GetEditDate().GetValue(),
+	}
+
+	switch from := m.GetFromId(); from.GetPredicateName() {
+	case mtproto.Predicate_peerUser:
+		message.From = c.makeUser(from.GetUserId())
+	case mtproto.Predicate_peerChannel:
+		message.SenderChat = c.makeChat(mtproto.PEER_CHANNEL, from.GetChannelId())
+	default:
+		switch peer.PeerType {
+		case mtproto.PEER_USER:
+			message.From = c.makeUser(peer.PeerId)
+		case mtproto.PEER_CHANNEL:
+			message.SenderChat = chat
+		}
+	}
+
+	entities := makeEntities(m.GetEntities(), c.makeUser)
+	if media := m.GetMedia(); media != nil && media.GetPredicateName() != mtproto.Predicate_messageMediaEmpty {
+		message.Caption = m.GetMessage()
+		message.CaptionEntities = entities
+	} else {
+		message.Text = m.GetMessage()
+		message.Entities = entities
+	}
+
+	return message
+}
+
+func (c *converter) makeUser(id int64) *User {
+	u, ok := c.users[id]
+	if !ok && c.lookup != nil {
+		u = c.lookup(id)
+		c.users[id] = u
+	}
+	if u == nil {
+		return &User{Id: id}
+	}
+
+	return MakeUser(u)
+}
+
+func (c *converter) makeChat(peerType int32, peerId int64) *Chat {
+	chat := &Chat{
+		Id: ChatIdFromPeer(peerType, peerId),
+	}
+
+	switch peerType {
+	case mtproto.PEER_USER:
+		u := c.makeUser(peerId)
+		chat.Type = ChatTypePrivate
+		chat.FirstName = u.FirstName
+		chat.LastName = u.LastName
+		chat.Username = u.Username
+	case mtproto.PEER_CHAT:
+		chat.Type = ChatTypeGroup
+		chat.Title = c.chats[peerId].GetTitle()
+	case mtproto.PEER_CHANNEL:
+		chat.Type = ChatTypeSupergroup
+		if c2, ok := c.chats[peerId]; ok {
+			if c2.GetBroadcast() {
+				chat.Type = ChatTypeChannel
+			}
+			chat.Title = c2.GetTitle()
+			chat.Username = c2.GetUsername().GetValue()
+		}
+	default:
+		return nil
+	}
+
+	return chat
+}
+
+func MakeUser(u *mtproto.User) *User {
+	return &User{
+		Id:           u.GetId(),
+		IsBot:        u.GetBot(),
+		FirstName:    u.GetFirstName().GetValue(),
+		LastName:     u.GetLastName().GetValue(),
+		Username:     u.GetUsername().GetValue(),
+		LanguageCode: u.GetLangCode().GetValue(),
+		IsPremium:    u.GetPremium(),
+	}
+}
+
+func parseInt64(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func formatInt64(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
@@ -0,0 +1,159 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+func TestChatId(t *testing.T) {
+	cases := []struct {
+		peerType int32
+		peerId   int64
+		chatId   int64
+	}{
+		{mtproto.PEER_USER, 136817688, 136817688},
+		{mtproto.PEER_CHAT, 123456, -123456},
+		{mtproto.PEER_CHANNEL, 1234567890, -1001234567890},
+	}
+
+	for _, c := range cases {
+		if chatId := ChatIdFromPeer(c.peerType, c.peerId); chatId != c.chatId {
+			t.Errorf("ChatIdFromPeer(%d, %d) = %d, want %d", c.peerType, c.peerId, chatId, c.chatId)
+		}
+		peer := PeerFromChatId(c.chatId)
+		if peer.PeerType != c.peerType || peer.PeerId != c.peerId {
+			t.Errorf("PeerFromChatId(%d) = {%d, %d}, want {%d, %d}", c.chatId, peer.PeerType, peer.PeerId, c.peerType, c.peerId)
+		}
+	}
+
+	if PeerFromChatId(0) != nil {
+		t.Error("PeerFromChatId(0) != nil")
+	}
+}
+
+func TestMakeUpdates(t *testing.T) {
+	const (
+		botId        = 1001
+		userId       = 2002
+		channel      = 3003
+		startCommand = "/start"
+	)
+
+	user := mtproto.MakeTLUser(&mtproto.User{
+		Id:        userId,
+		FirstName: &types.StringValue{Value: "Alice"},
+		Username:  &types.StringValue{Value: "alice"},
+	}).To_User()
+	chat := mtproto.MakeTLChannel(&mtproto.Chat{
+		Id:        channel,
+		Title:     "News",
+		Broadcast: true,
+	}).To_Chat()
+
+	updates := mtproto.MakeTLUpdates(&mtproto.Updates{
+		Updates: []*mtproto.Update{
+			mtproto.MakeTLUpdateNewMessage(&mtproto.Update{
+				Message_MESSAGE: mtproto.MakeTLMessage(&mtproto.Message{
+					Id:      1,
+					PeerId:  mtproto.MakePeerUser(userId),
+					Date:    100,
+					Message: startCommand,
+					Entities: []*mtproto.MessageEntity{
+						mtproto.MakeTLMessageEntityBotCommand(&mtproto.MessageEntity{Offset: 0, Length: 6}).To_MessageEntity(),
+					},
+				}).To_Message(),
+			}).To_Update(),
+			mtproto.MakeTLUpdateNewMessage(&mtproto.Update{
+				Message_MESSAGE: mtproto.MakeTLMessage(&mtproto.Message{
+					Id:      2,
+					Out:     true,
+					PeerId:  mtproto.MakePeerUser(userId),
+					Date:    101,
+					Message: "reply",
+				}).To_Message(),
+			}).To_Update(),
+			mtproto.MakeTLUpdateEditChannelMessage(&mtproto.Update{
+				Message_MESSAGE: mtproto.MakeTLMessage(&mtproto.Message{
+					Id:       3,
+					PeerId:   mtproto.MakePeerChannel(channel),
+					Date:     102,
+					EditDate: &types.Int32Value{Value: 103},
+					Message:  "post",
+				}).To_Message(),
+			}).To_Update(),
+		},
+		Users: []*mtproto.User{user},
+		Chats: []*mtproto.Chat{chat},
+	}).To_Updates()
+
+	result := MakeUpdates(botId, updates, nil)
+	if len(result) != 2 {
+		t.Fatalf("len(MakeUpdates()) = %d, want 2", len(result))
+	}
+
+	m := result[0].Message
+	if m == nil || result[0].Type() != UpdateTypeMessage {
+		t.Fatalf("update 0 is %q, want message", result[0].Type())
+	}
+	if m.MessageId != 1 || m.Text != startCommand || m.From.Id != userId || m.From.FirstName != "Alice" {
+		t.Errorf("unexpected message: %+v", m)
+	}
+	if m.Chat.Id != userId || m.Chat.Type != ChatTypePrivate || m.Chat.Username != "alice" {
+		t.Errorf("unexpected chat: %+v", m.Chat)
+	}
+	if len(m.Entities) != 1 || m.Entities[0].Type != "bot_command" || m.Entities[0].Length != 6 {
+		t.Errorf("unexpected entities: %+v", m.Entities)
+	}
+
+	post := result[1].EditedChannelPost
+	if post == nil {
+		t.Fatalf("update 1 is %q, want edited_channel_post", result[1].Type())
+	}
+	if post.Chat.Id != -1000000003003 || post.Chat.Type != ChatTypeChannel || post.Chat.Title != "News" {
+		t.Errorf("unexpected chat: %+v", post.Chat)
+	}
+	if post.SenderChat == nil || post.SenderChat.Id != post.Chat.Id || post.EditDate != 103 {
+		t.Errorf("unexpected post: %+v", post)
+	}
+}
+
+func TestToMTProtoEntities(t *testing.T) {
+	entities, err := ToMTProtoEntities([]*MessageEntity{
+		{Type: "bold", Offset: 0, Length: 4},
+		{Type: "text_link", Offset: 5, Length: 3, Url: "https://teamgram.net"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entities[0].GetPredicateName() != mtproto.Predicate_messageEntityBold {
+		t.Errorf("entities[0] = %s", entities[0].GetPredicateName())
+	}
+	if entities[1].GetPredicateName() != mtproto.Predicate_messageEntityTextUrl || entities[1].GetUrl() != "https://teamgram.net" {
+		t.Errorf("entities[1] = %s", entities[1].DebugString())
+	}
+
+	if _, err = ToMTProtoEntities([]*MessageEntity{{Type: "blink"}}); err == nil {
+		t.Error("unknown entity type accepted")
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+var (
+	entityTypes = map[string]string{
+		mtproto.Predicate_messageEntityMention:     "mention",
+		mtproto.Predicate_messageEntityHashtag:     "hashtag",
+		mtproto.Predicate_messageEntityCashtag:     "cashtag",
+		mtproto.Predicate_messageEntityBotCommand:  "bot_command",
+		mtproto.Predicate_messageEntityUrl:         "url",
+		mtproto.Predicate_messageEntityEmail:       "email",
+		mtproto.Predicate_messageEntityPhone:       "phone_number",
+		mtproto.Predicate_messageEntityBold:        "bold",
+		mtproto.Predicate_messageEntityItalic:      "italic",
+		mtproto.Predicate_messageEntityUnderline:   "underline",
+		mtproto.Predicate_messageEntityStrike:      "strikethrough",
+		mtproto.Predicate_messageEntitySpoiler:     "spoiler",
+		mtproto.Predicate_messageEntityCode:        "code",
+		mtproto.Predicate_messageEntityPre:         "pre",
+		mtproto.Predicate_messageEntityTextUrl:     "text_link",
+		mtproto.Predicate_messageEntityMentionName: "text_mention",
+		mtproto.Predicate_messageEntityCustomEmoji: "custom_emoji",
+	}
+
+	entityMakers = map[string]func(e *mtproto.MessageEntity) *mtproto.MessageEntity{
+		"mention": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityMention(e).To_MessageEntity()
+		},
+		"hashtag": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityHashtag(e).To_MessageEntity()
+		},
+		"cashtag": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityCashtag(e).To_MessageEntity()
+		},
+		"bot_command": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityBotCommand(e).To_MessageEntity()
+		},
+		"url": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityUrl(e).To_MessageEntity()
+		},
+		"email": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityEmail(e).To_MessageEntity()
+		},
+		"phone_number": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityPhone(e).To_MessageEntity()
+		},
+		"bold": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityBold(e).To_MessageEntity()
+		},
+		"italic": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityItalic(e).To_MessageEntity()
+		},
+		"underline": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityUnderline(e).To_MessageEntity()
+		},
+		"strikethrough": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityStrike(e).To_MessageEntity()
+		},
+		"spoiler": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntitySpoiler(e).To_MessageEntity()
+		},
+		"code": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityCode(e).To_MessageEntity()
+		},
+		"pre": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityPre(e).To_MessageEntity()
+		},
+		"text_link": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityTextUrl(e).To_MessageEntity()
+		},
+		"text_mention": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLInputMessageEntityMentionName(e).To_MessageEntity()
+		},
+		"custom_emoji": func(e *mtproto.MessageEntity) *mtproto.MessageEntity {
+			return mtproto.MakeTLMessageEntityCustomEmoji(e).To_MessageEntity()
+		},
+	}
+)
+
+// ToMTProtoEntities converts the entities parameter of sendMessage.
+func ToMTProtoEntities(entities []*MessageEntity) ([]*mtproto.MessageEntity, error) {
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*mtproto.MessageEntity, 0, len(entities))
+	for _, e := range entities {
+		makeF, ok := entityMakers[e.Type]
+		if !ok {
+			return nil, NewBadRequest("can't parse entities: unsupported entity type " + e.Type)
+		}
+		e2 := &mtproto.MessageEntity{
+			Offset:   e.Offset,
+			Length:   e.Length,
+			Language: e.Language,
+			Url:      e.Url,
+		}
+		switch e.Type {
+		case "text_mention":
+			if e.User == nil {
+				return nil, NewBadRequest("can't parse entities: field \"user\" must be specified for text_mention")
+			}
+			e2.UserId_INPUTUSER = mtproto.MakeTLInputUser(&mtproto.InputUser{UserId: e.User.Id}).To_InputUser()
+		case "custom_emoji":
+			e2.DocumentId, _ = parseInt64(e.CustomEmojiId)
+		}
+		result = append(result, makeF(e2))
+	}
+
+	return result, nil
+}
+
+func makeEntities(entities []*mtproto.MessageEntity, lookupUser func(id int64) *User) []*MessageEntity {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	result := make([]*MessageEntity, 0, len(entities))
+	for _, e := range entities {
+		t, ok := entityTypes[e.GetPredicateName()]
+		if !ok {
+			continue
+		}
+		e2 := &MessageEntity{
+			Type:     t,
+			Offset:   e.GetOffset(),
+			Length:   e.GetLength(),
+			Language: e.GetLanguage(),
+			Url:      e.GetUrl(),
+		}
+		switch e.GetPredicateName() {
+		case mtproto.Predicate_messageEntityMentionName:
+			e2.User = lookupUser(e.GetUserId_INT64())
+			if e2.User == nil {
+				continue
+			}
+		case mtproto.Predicate_messageEntityCustomEmoji:
+			e2.CustomEmojiId = formatInt64(e.GetDocumentId())
+		}
+		result = append(result, e2)
+	}
+
+	return result
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"google.golang.org/grpc/status"
+)
+
+// Error is a failed Bot API call, Code is also the http status.
+type Error struct {
+	Code        int
+	Description string
+	RetryAfter  int
+}
+
+func (e *Error) Error() string {
+	return e.Description
+}
+
+func NewBadRequest(description string) *Error {
+	return &Error{
+		Code:        http.StatusBadRequest,
+		Description: "Bad Request: " + description,
+	}
+}
+
+var (
+	ErrUnauthorized  = &Error{Code: http.StatusUnauthorized, Description: "Unauthorized"}
+	ErrNotFound      = &Error{Code: http.StatusNotFound, Description: "Not Found"}
+	ErrWebhookActive = &Error{
+		Code:        http.StatusConflict,
+		Description: "Conflict: can't use getUpdates method while webhook is active; use deleteWebhook to delete the webhook first",
+	}
+	ErrInternal = &Error{Code: http.StatusInternalServerError, Description: "Internal Server Error"}
+)
+
+// FromError converts the rpc error of a BFF or service call, the descriptions
+// follow the Bot API ones, e.g. "Bad Request: PEER_ID_INVALID".
+func FromError(err error) *Error {
+	var rpcErr *mtproto.TLRpcError
+
+	switch e := err.(type) {
+	case *Error:
+		return e
+	case *mtproto.TLRpcError:
+		rpcErr = e
+	default:
+		rpcErr = mtproto.NewRpcError(status.Convert(err))
+	}
+
+	msg := rpcErr.GetErrorMessage()
+	switch rpcErr.GetErrorCode() {
+	case http.StatusBadRequest:
+		return NewBadRequest(msg)
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return &Error{Code: http.StatusForbidden, Description: "Forbidden: " + msg}
+	case http.StatusNotFound:
+		return ErrNotFound
+	case 420:
+		retryAfter, _ := strconv.Atoi(strings.TrimPrefix(msg, "FLOOD_WAIT_"))
+		return &Error{
+			Code:        http.StatusTooManyRequests,
+			Description: "Too Many Requests: retry after " + strconv.Itoa(retryAfter),
+			RetryAfter:  retryAfter,
+		}
+	default:
+		return ErrInternal
+	}
+}
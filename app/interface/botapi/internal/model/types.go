@@ -0,0 +1,135 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+// The objects of the Bot API, https://core.telegram.org/bots/api#available-types.
+// Only the fields which can be filled from the mtproto updates are declared.
+
+type User struct {
+	Id                      int64  `json:"id"`
+	IsBot                   bool   `json:"is_bot"`
+	FirstName               string `json:"first_name"`
+	LastName                string `json:"last_name,omitempty"`
+	Username                string `json:"username,omitempty"`
+	LanguageCode            string `json:"language_code,omitempty"`
+	IsPremium               bool   `json:"is_premium,omitempty"`
+	CanJoinGroups           *bool  `json:"can_join_groups,omitempty"`
+	CanReadAllGroupMessages *bool  `json:"can_read_all_group_messages,omitempty"`
+	SupportsInlineQueries   *bool  `json:"supports_inline_queries,omitempty"`
+}
+
+// chat types
+const (
+	ChatTypePrivate    = "private"
+	ChatTypeGroup      = "group"
+	ChatTypeSupergroup = "supergroup"
+	ChatTypeChannel    = "channel"
+)
+
+type Chat struct {
+	Id        int64  `json:"id"`
+	Type      string `json:"type"`
+	Title     string `json:"title,omitempty"`
+	Username  string `json:"username,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+type MessageEntity struct {
+	Type          string `json:"type"`
+	Offset        int32  `json:"offset"`
+	Length        int32  `json:"length"`
+	Url           string `json:"url,omitempty"`
+	User          *User  `json:"user,omitempty"`
+	Language      string `json:"language,omitempty"`
+	CustomEmojiId string `json:"custom_emoji_id,omitempty"`
+}
+
+type Message struct {
+	MessageId       int32            `json:"message_id"`
+	From            *User            `json:"from,omitempty"`
+	SenderChat      *Chat            `json:"sender_chat,omitempty"`
+	Date            int32            `json:"date"`
+	Chat            *Chat            `json:"chat"`
+	EditDate        int32            `json:"edit_date,omitempty"`
+	Text            string           `json:"text,omitempty"`
+	Entities        []*MessageEntity `json:"entities,omitempty"`
+	Caption         string           `json:"caption,omitempty"`
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+}
+
+// update types, the values of allowed_updates
+const (
+	UpdateTypeMessage           = "message"
+	UpdateTypeEditedMessage     = "edited_message"
+	UpdateTypeChannelPost       = "channel_post"
+	UpdateTypeEditedChannelPost = "edited_channel_post"
+)
+
+type Update struct {
+	UpdateId          int64    `json:"update_id"`
+	Message           *Message `json:"message,omitempty"`
+	EditedMessage     *Message `json:"edited_message,omitempty"`
+	ChannelPost       *Message `json:"channel_post,omitempty"`
+	EditedChannelPost *Message `json:"edited_channel_post,omitempty"`
+}
+
+// Type returns the allowed_updates name of u.
+func (u *Update) Type() string {
+	switch {
+	case u.Message != nil:
+		return UpdateTypeMessage
+	case u.EditedMessage != nil:
+		return UpdateTypeEditedMessage
+	case u.ChannelPost != nil:
+		return UpdateTypeChannelPost
+	case u.EditedChannelPost != nil:
+		return UpdateTypeEditedChannelPost
+	default:
+		return ""
+	}
+}
+
+type BotCommand struct {
+	Command     string `json:"command"`
+	Description string `json:"description"`
+}
+
+type WebhookInfo struct {
+	Url                  string   `json:"url"`
+	HasCustomCertificate bool     `json:"has_custom_certificate"`
+	PendingUpdateCount   int      `json:"pending_update_count"`
+	LastErrorDate        int64    `json:"last_error_date,omitempty"`
+	LastErrorMessage     string   `json:"last_error_message,omitempty"`
+	MaxConnections       int      `json:"max_connections,omitempty"`
+	AllowedUpdates       []string `json:"allowed_updates,omitempty"`
+}
+
+type ResponseParameters struct {
+	RetryAfter int `json:"retry_after,omitempty"`
+}
+
+// Response is the body of every Bot API reply.
+type Response struct {
+	Ok          bool                `json:"ok"`
+	Result      interface{}         `json:"result,omitempty"`
+	ErrorCode   int                 `json:"error_code,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/service"
+
+	"github.com/zeromicro/go-zero/rest"
+)
+
+const (
+	// must cover the longest getUpdates long polling
+	requestTimeout = 60 * time.Second
+)
+
+// New new a http server serving https://core.telegram.org/bots/api requests,
+// /bot<token>/<method>.
+func New(c rest.RestConf, svc *service.Service) *rest.Server {
+	srv := rest.MustNewServer(c)
+
+	go func() {
+		defer srv.Stop()
+
+		srv.AddRoutes(
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/:token/:method",
+					Handler: BotApi(svc),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/:token/:method",
+					Handler: BotApi(svc),
+				},
+			},
+			rest.WithTimeout(requestTimeout))
+
+		srv.Start()
+	}()
+	return srv
+}
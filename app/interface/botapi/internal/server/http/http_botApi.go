@@ -0,0 +1,94 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package http
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func BotApi(svc *service.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var (
+			path struct {
+				Token  string `path:"token"`
+				Method string `path:"method"`
+			}
+		)
+
+		if err := httpx.ParsePath(r, &path); err != nil || !strings.HasPrefix(path.Token, "bot") {
+			writeError(w, model.ErrNotFound)
+			return
+		}
+
+		params, err := service.ParseParams(r)
+		if err != nil {
+			writeError(w, model.FromError(err))
+			return
+		}
+
+		clientAddr, _, _ := net.SplitHostPort(r.RemoteAddr)
+		result, err := svc.Invoke(r.Context(), strings.TrimPrefix(path.Token, "bot"), path.Method, params, clientAddr)
+		if err != nil {
+			logx.WithContext(r.Context()).Infof("botApi.%s - error: %v", path.Method, err)
+			writeError(w, model.FromError(err))
+			return
+		}
+
+		writeJson(w, http.StatusOK, &model.Response{
+			Ok:     true,
+			Result: result,
+		})
+	}
+}
+
+func writeError(w http.ResponseWriter, err *model.Error) {
+	resp := &model.Response{
+		Ok:          false,
+		ErrorCode:   err.Code,
+		Description: err.Description,
+	}
+	if err.RetryAfter > 0 {
+		resp.Parameters = &model.ResponseParameters{
+			RetryAfter: err.RetryAfter,
+		}
+	}
+
+	writeJson(w, err.Code, resp)
+}
+
+func writeJson(w http.ResponseWriter, code int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(body)
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/dao"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+// bot is the update queue of a bot, filled by the pushes of sync and drained
+// by getUpdates or the webhook.
+type bot struct {
+	id        int64
+	authKeyId int64
+
+	mu             sync.Mutex
+	updates        []*model.Update
+	notify         chan struct{}
+	allowedUpdates []string
+	lastPollAt     int64
+	onlineExpired  int64
+
+	webhook          *dao.Webhook
+	webhookCancel    context.CancelFunc
+	lastErrorDate    int64
+	lastErrorMessage string
+}
+
+func newBot(id, authKeyId int64) *bot {
+	return &bot{
+		id:        id,
+		authKeyId: authKeyId,
+		notify:    make(chan struct{}),
+	}
+}
+
+// push appends u unless its type was filtered out by allowed_updates, the
+// oldest updates are dropped once maxPending are queued.
+func (b *bot) push(u *model.Update, maxPending int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !isAllowedUpdate(b.allowedUpdates, u.Type()) {
+		return
+	}
+
+	b.updates = append(b.updates, u)
+	if maxPending > 0 && len(b.updates) > maxPending {
+		b.updates = b.updates[len(b.updates)-maxPending:]
+	}
+	close(b.notify)
+	b.notify = make(chan struct{})
+}
+
+// fetch confirms the updates below offset and returns at most limit of the
+// remaining ones, a negative offset keeps only the last -offset updates. The
+// channel is closed by the next push.
+func (b *bot) fetch(offset int64, limit int) ([]*model.Update, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if offset < 0 {
+		if n := int(-offset); n < len(b.updates) {
+			b.updates = b.updates[len(b.updates)-n:]
+		}
+	} else {
+		i := 0
+		for i < len(b.updates) && b.updates[i].UpdateId < offset {
+			i++
+		}
+		b.updates = b.updates[i:]
+	}
+
+	if limit > len(b.updates) {
+		limit = len(b.updates)
+	}
+
+	return append([]*model.Update(nil), b.updates[:limit]...), b.notify
+}
+
+func (b *bot) dropPending() {
+	b.mu.Lock()
+	b.updates = nil
+	b.mu.Unlock()
+}
+
+func (b *bot) setAllowedUpdates(allowedUpdates []string) {
+	b.mu.Lock()
+	b.allowedUpdates = allowedUpdates
+	b.mu.Unlock()
+}
+
+func (b *bot) setLastError(date int64, message string) {
+	b.mu.Lock()
+	b.lastErrorDate = date
+	b.lastErrorMessage = message
+	b.mu.Unlock()
+}
+
+func (b *bot) getWebhook() *dao.Webhook {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.webhook
+}
+
+func (b *bot) getWebhookInfo() *model.WebhookInfo {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	info := &model.WebhookInfo{
+		PendingUpdateCount: len(b.updates),
+	}
+	if b.webhook != nil {
+		info.Url = b.webhook.Url
+		info.MaxConnections = b.webhook.MaxConnections
+		info.AllowedUpdates = b.webhook.AllowedUpdates
+		info.LastErrorDate = b.lastErrorDate
+		info.LastErrorMessage = b.lastErrorMessage
+	}
+
+	return info
+}
+
+// isAllowedUpdate checks t against allowed_updates, empty means all types.
+func isAllowedUpdate(allowedUpdates []string, t string) bool {
+	if len(allowedUpdates) == 0 {
+		return true
+	}
+	for _, v := range allowedUpdates {
+		if v == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,408 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"context"
+	"math/rand"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/rpc/metadata"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/dao"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	getUpdatesMaxLimit   = 100
+	getUpdatesMaxTimeout = 50
+	webhookMaxConnection = 100
+)
+
+type methodFunc func(s *Service, ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error)
+
+// methods by lower case name, method names of the Bot API are case-insensitive.
+var methods = map[string]methodFunc{
+	"getme":            (*Service).getMe,
+	"getupdates":       (*Service).getUpdates,
+	"setwebhook":       (*Service).setWebhook,
+	"deletewebhook":    (*Service).deleteWebhook,
+	"getwebhookinfo":   (*Service).getWebhookInfo,
+	"sendmessage":      (*Service).sendMessage,
+	"setmycommands":    (*Service).setMyCommands,
+	"getmycommands":    (*Service).getMyCommands,
+	"deletemycommands": (*Service).deleteMyCommands,
+}
+
+// Invoke runs the Bot API method of the bot owning token, the result is the
+// "result" field of the reply.
+func (s *Service) Invoke(ctx context.Context, token, method string, params Params, clientAddr string) (interface{}, error) {
+	methodF, ok := methods[strings.ToLower(method)]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+
+	b, err := s.getBot(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return methodF(s, ctx, b, s.makeMetadata(b, clientAddr), params)
+}
+
+func (s *Service) makeMetadata(b *bot, clientAddr string) *metadata.RpcMetadata {
+	return &metadata.RpcMetadata{
+		ServerId:      s.serverId,
+		ClientAddr:    clientAddr,
+		AuthId:        b.authKeyId,
+		ReceiveTime:   time.Now().Unix(),
+		UserId:        b.id,
+		IsBot:         true,
+		Client:        "botapi",
+		PermAuthKeyId: b.authKeyId,
+	}
+}
+
+func (s *Service) invoke(ctx context.Context, md *metadata.RpcMetadata, object mtproto.TLObject) (mtproto.TLObject, error) {
+	r, err := s.svcCtx.Dao.BFFProxyClient.InvokeContext(ctx, md, object)
+	if err != nil {
+		return nil, model.FromError(err)
+	}
+
+	return r, nil
+}
+
+func (s *Service) getMe(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	me, err := s.svcCtx.Dao.UserClient.UserGetImmutableUser(ctx, &userpb.TLUserGetImmutableUser{
+		Id: b.id,
+	})
+	if err != nil {
+		return nil, model.FromError(err)
+	}
+
+	u := me.ToSelfUser()
+	var (
+		canJoinGroups           = !u.GetBotNochats()
+		canReadAllGroupMessages = u.GetBotChatHistory()
+		supportsInlineQueries   = u.GetBotInlinePlaceholder() != nil
+	)
+
+	user := model.MakeUser(u)
+	user.CanJoinGroups = &canJoinGroups
+	user.CanReadAllGroupMessages = &canReadAllGroupMessages
+	user.SupportsInlineQueries = &supportsInlineQueries
+
+	return user, nil
+}
+
+func (s *Service) getUpdates(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	if b.getWebhook() != nil {
+		return nil, model.ErrWebhookActive
+	}
+
+	offset, err := params.Int64("offset")
+	if err != nil {
+		return nil, err
+	}
+	limit, err := params.Int64("limit")
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > getUpdatesMaxLimit {
+		limit = getUpdatesMaxLimit
+	}
+	timeout, err := params.Int64("timeout")
+	if err != nil {
+		return nil, err
+	}
+	if timeout < 0 {
+		timeout = 0
+	} else if timeout > getUpdatesMaxTimeout {
+		timeout = getUpdatesMaxTimeout
+	}
+
+	var allowedUpdates []string
+	if ok, err := params.JSON("allowed_updates", &allowedUpdates); err != nil {
+		return nil, err
+	} else if ok {
+		b.setAllowedUpdates(allowedUpdates)
+	}
+
+	s.touch(ctx, b)
+
+	timer := time.NewTimer(time.Duration(timeout) * time.Second)
+	defer timer.Stop()
+
+	for {
+		updates, notify := b.fetch(offset, int(limit))
+		if len(updates) > 0 || timeout == 0 {
+			return updates, nil
+		}
+
+		select {
+		case <-notify:
+		case <-timer.C:
+			return []*model.Update{}, nil
+		case <-ctx.Done():
+			return []*model.Update{}, nil
+		}
+	}
+}
+
+func (s *Service) setWebhook(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	rawUrl := params.String("url")
+	if rawUrl == "" {
+		return s.deleteWebhook(ctx, b, md, params)
+	}
+
+	if u, err := url.Parse(rawUrl); err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil, model.NewBadRequest("bad webhook: invalid webhook URL specified")
+	}
+
+	maxConnections, err := params.Int64("max_connections")
+	if err != nil {
+		return nil, err
+	}
+	if maxConnections <= 0 || maxConnections > webhookMaxConnection {
+		maxConnections = 40
+	}
+
+	webhook := &dao.Webhook{
+		Url:            rawUrl,
+		SecretToken:    params.String("secret_token"),
+		MaxConnections: int(maxConnections),
+	}
+	if _, err = params.JSON("allowed_updates", &webhook.AllowedUpdates); err != nil {
+		return nil, err
+	}
+
+	if err = s.svcCtx.Dao.SetWebhook(ctx, b.id, webhook); err != nil {
+		return nil, model.ErrInternal
+	}
+	if params.Bool("drop_pending_updates") {
+		b.dropPending()
+	}
+	s.startWebhook(b, webhook)
+	s.setOnline(ctx, b, time.Now().Unix())
+
+	return true, nil
+}
+
+func (s *Service) deleteWebhook(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	if err := s.svcCtx.Dao.DeleteWebhook(ctx, b.id); err != nil {
+		return nil, model.ErrInternal
+	}
+	s.stopWebhook(b)
+	if params.Bool("drop_pending_updates") {
+		b.dropPending()
+	}
+
+	return true, nil
+}
+
+func (s *Service) getWebhookInfo(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	return b.getWebhookInfo(), nil
+}
+
+func (s *Service) sendMessage(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	peer, err := s.resolveChatId(ctx, md, params.String("chat_id"))
+	if err != nil {
+		return nil, err
+	}
+
+	text := params.String("text")
+	if text == "" {
+		return nil, model.NewBadRequest("message text is empty")
+	}
+	// TODO: parse_mode, until then the formatting has to be passed as entities
+	if parseMode := params.String("parse_mode"); parseMode != "" {
+		return nil, model.NewBadRequest("can't parse entities: unsupported parse_mode " + parseMode)
+	}
+
+	var entities []*model.MessageEntity
+	if _, err = params.JSON("entities", &entities); err != nil {
+		return nil, err
+	}
+	mEntities, err := model.ToMTProtoEntities(entities)
+	if err != nil {
+		return nil, err
+	}
+
+	replyToMessageId, err := params.Int64("reply_to_message_id")
+	if err != nil {
+		return nil, err
+	}
+
+	request := &mtproto.TLMessagesSendMessage{
+		NoWebpage:  params.Bool("disable_web_page_preview"),
+		Silent:     params.Bool("disable_notification"),
+		Noforwards: params.Bool("protect_content"),
+		Peer:       peer.ToInputPeer(),
+		Message:    text,
+		RandomId:   rand.Int63(),
+		Entities:   mEntities,
+	}
+	if replyToMessageId != 0 {
+		request.ReplyToMsgId = &types.Int32Value{Value: int32(replyToMessageId)}
+	}
+
+	r, err := s.invoke(ctx, md, request)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, _ := r.(*mtproto.Updates)
+	for _, update := range updates.GetUpdates() {
+		switch update.GetPredicateName() {
+		case mtproto.Predicate_updateNewMessage, mtproto.Predicate_updateNewChannelMessage:
+			m := model.MakeMessage(
+				update.GetMessage_MESSAGE(),
+				updates.GetUsers(),
+				updates.GetChats(),
+				s.lookupUser(ctx, b.id))
+			if m != nil {
+				return m, nil
+			}
+		}
+	}
+
+	return nil, model.ErrInternal
+}
+
+// resolveChatId accepts the chat_id of the Bot API, an id or @username.
+func (s *Service) resolveChatId(ctx context.Context, md *metadata.RpcMetadata, chatId string) (*mtproto.PeerUtil, error) {
+	if chatId == "" {
+		return nil, model.NewBadRequest("chat_id is empty")
+	}
+
+	if strings.HasPrefix(chatId, "@") {
+		r, err := s.invoke(ctx, md, &mtproto.TLContactsResolveUsername{
+			Username: chatId[1:],
+		})
+		if err != nil {
+			return nil, model.NewBadRequest("chat not found")
+		}
+		resolved, _ := r.(*mtproto.Contacts_ResolvedPeer)
+		if resolved.GetPeer() == nil {
+			return nil, model.NewBadRequest("chat not found")
+		}
+
+		return mtproto.FromPeer(resolved.GetPeer()), nil
+	}
+
+	id, err := strconv.ParseInt(chatId, 10, 64)
+	if err != nil || id == 0 {
+		return nil, model.NewBadRequest("chat not found")
+	}
+
+	return model.PeerFromChatId(id), nil
+}
+
+func (s *Service) setMyCommands(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	var commands []*model.BotCommand
+	if ok, err := params.JSON("commands", &commands); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, model.NewBadRequest("parameter \"commands\" is required")
+	}
+	if err := checkDefaultScope(params); err != nil {
+		return nil, err
+	}
+
+	mCommands := make([]*mtproto.BotCommand, 0, len(commands))
+	for _, c := range commands {
+		mCommands = append(mCommands, mtproto.MakeTLBotCommand(&mtproto.BotCommand{
+			Command:     c.Command,
+			Description: c.Description,
+		}).To_BotCommand())
+	}
+
+	_, err := s.invoke(ctx, md, &mtproto.TLBotsSetBotCommands{
+		Scope:    mtproto.MakeTLBotCommandScopeDefault(nil).To_BotCommandScope(),
+		LangCode: params.String("language_code"),
+		Commands: mCommands,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+func (s *Service) getMyCommands(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	if err := checkDefaultScope(params); err != nil {
+		return nil, err
+	}
+
+	r, err := s.invoke(ctx, md, &mtproto.TLBotsGetBotCommands{
+		Scope:    mtproto.MakeTLBotCommandScopeDefault(nil).To_BotCommandScope(),
+		LangCode: params.String("language_code"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vCommands, _ := r.(*mtproto.Vector_BotCommand)
+	commands := make([]*model.BotCommand, 0, len(vCommands.GetDatas()))
+	for _, c := range vCommands.GetDatas() {
+		commands = append(commands, &model.BotCommand{
+			Command:     c.GetCommand(),
+			Description: c.GetDescription(),
+		})
+	}
+
+	return commands, nil
+}
+
+func (s *Service) deleteMyCommands(ctx context.Context, b *bot, md *metadata.RpcMetadata, params Params) (interface{}, error) {
+	if err := checkDefaultScope(params); err != nil {
+		return nil, err
+	}
+
+	_, err := s.invoke(ctx, md, &mtproto.TLBotsResetBotCommands{
+		Scope:    mtproto.MakeTLBotCommandScopeDefault(nil).To_BotCommandScope(),
+		LangCode: params.String("language_code"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// checkDefaultScope rejects the command scopes other than default, the bots
+// service only stores the default commands.
+func checkDefaultScope(params Params) error {
+	var scope struct {
+		Type string `json:"type"`
+	}
+	if ok, err := params.JSON("scope", &scope); err != nil {
+		return err
+	} else if ok && scope.Type != "default" {
+		return model.NewBadRequest("BOT_COMMAND_SCOPE_INVALID")
+	}
+
+	return nil
+}
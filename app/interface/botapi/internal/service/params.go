@@ -0,0 +1,130 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+const (
+	maxMultipartMemory = 10 << 20
+)
+
+// Params are the parameters of a Bot API call, which may come in the query
+// string, an urlencoded or multipart form or a json body. Json values which
+// are not strings are kept as json text, the Bot API passes objects such as
+// reply_markup the same way in forms.
+type Params map[string]string
+
+func ParseParams(r *http.Request) (Params, error) {
+	params := make(Params)
+	for k, v := range r.URL.Query() {
+		params[k] = v[0]
+	}
+
+	if r.Method != http.MethodPost || r.Body == nil {
+		return params, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(body) == 0 {
+			return params, nil
+		}
+		var values map[string]json.RawMessage
+		if err = json.Unmarshal(body, &values); err != nil {
+			return nil, model.NewBadRequest("can't parse JSON object")
+		}
+		for k, v := range values {
+			var s string
+			if err = json.Unmarshal(v, &s); err == nil {
+				params[k] = s
+			} else {
+				params[k] = string(v)
+			}
+		}
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(maxMultipartMemory); err != nil {
+			return nil, model.NewBadRequest("can't parse multipart form")
+		}
+		for k, v := range r.MultipartForm.Value {
+			params[k] = v[0]
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return nil, model.NewBadRequest("can't parse form")
+		}
+		for k, v := range r.PostForm {
+			params[k] = v[0]
+		}
+	}
+
+	return params, nil
+}
+
+func (p Params) String(name string) string {
+	return p[name]
+}
+
+func (p Params) Int64(name string) (int64, error) {
+	v, ok := p[name]
+	if !ok || v == "" {
+		return 0, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, model.NewBadRequest("field \"" + name + "\" must be an Integer")
+	}
+
+	return i, nil
+}
+
+func (p Params) Bool(name string) bool {
+	switch strings.ToLower(p[name]) {
+	case "true", "1":
+		return true
+	default:
+		return false
+	}
+}
+
+// JSON decodes the json text of name into v, false if name is absent.
+func (p Params) JSON(name string, v interface{}) (bool, error) {
+	s, ok := p[name]
+	if !ok || s == "" {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(s), v); err != nil {
+		return false, model.NewBadRequest("can't parse " + name + " JSON object")
+	}
+
+	return true, nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/net/ip"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/svc"
+	"github.com/teamgram/teamgram-server/app/interface/session/session"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/app/service/status/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	onlineTimeout      = 60
+	onlineRefreshAhead = 30
+	// a bot which neither polls nor has a webhook stops receiving updates after botIdleTimeout
+	botIdleTimeout = 10 * 60
+	keepaliveTick  = 15 * time.Second
+)
+
+// Service serves the Bot API over http and receives the updates of the bots
+// from sync as a session server does: every bot has one auth key which is set
+// online in status with this server as gateway while the bot is being polled
+// or has a webhook.
+//
+// The update queues are kept in memory, a bot must be served by a single
+// botapi instance.
+type Service struct {
+	session.UnimplementedRPCSessionServer
+	svcCtx   *svc.ServiceContext
+	serverId string
+
+	mu       sync.RWMutex
+	bots     map[int64]*bot
+	authKeys map[int64]*bot
+	loading  sync.Mutex
+}
+
+func New(svcCtx *svc.ServiceContext) *Service {
+	s := &Service{
+		svcCtx:   svcCtx,
+		serverId: ip.FigureOutListenOn(svcCtx.Config.ListenOn),
+		bots:     make(map[int64]*bot),
+		authKeys: make(map[int64]*bot),
+	}
+
+	s.loadWebhooks()
+	go s.keepalive()
+
+	return s
+}
+
+// SessionPushUpdatesData
+// session.pushUpdatesData flags:# auth_key_id:long notification:flags.0?true updates:Updates = Bool;
+func (s *Service) SessionPushUpdatesData(ctx context.Context, request *session.TLSessionPushUpdatesData) (*mtproto.Bool, error) {
+	s.mu.RLock()
+	b, ok := s.authKeys[request.GetAuthKeyId()]
+	s.mu.RUnlock()
+	if !ok {
+		logx.WithContext(ctx).Infof("session.pushUpdatesData - not found bot by auth_key_id: %d", request.GetAuthKeyId())
+		return mtproto.BoolFalse, nil
+	}
+
+	updates := model.MakeUpdates(b.id, request.GetUpdates(), s.lookupUser(ctx, b.id))
+	for _, u := range updates {
+		updateId, err := s.svcCtx.Dao.NextUpdateId(ctx, b.id)
+		if err != nil {
+			return nil, err
+		}
+		u.UpdateId = updateId
+		b.push(u, s.svcCtx.Config.MaxPendingUpdates)
+	}
+
+	return mtproto.BoolTrue, nil
+}
+
+// SessionPushSessionUpdatesData
+// the updates of requests made by the bot itself, nothing to deliver.
+func (s *Service) SessionPushSessionUpdatesData(ctx context.Context, request *session.TLSessionPushSessionUpdatesData) (*mtproto.Bool, error) {
+	return mtproto.BoolTrue, nil
+}
+
+// SessionPushRpcResultData
+// http requests are answered synchronously, nothing to deliver.
+func (s *Service) SessionPushRpcResultData(ctx context.Context, request *session.TLSessionPushRpcResultData) (*mtproto.Bool, error) {
+	return mtproto.BoolTrue, nil
+}
+
+// getBot returns the bot owning token.
+func (s *Service) getBot(ctx context.Context, token string) (*bot, error) {
+	if token == "" {
+		return nil, model.ErrUnauthorized
+	}
+
+	botId, err := s.svcCtx.Dao.UserClient.UserGetBotIdByToken(ctx, &userpb.TLUserGetBotIdByToken{
+		Token: token,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("user.getBotIdByToken - error: %v", err)
+		return nil, model.ErrUnauthorized
+	}
+
+	return s.loadBot(ctx, botId.GetV())
+}
+
+func (s *Service) loadBot(ctx context.Context, botId int64) (*bot, error) {
+	s.mu.RLock()
+	b, ok := s.bots[botId]
+	s.mu.RUnlock()
+	if ok {
+		return b, nil
+	}
+
+	// the auth key is created on first use, serialize it
+	s.loading.Lock()
+	defer s.loading.Unlock()
+
+	s.mu.RLock()
+	b, ok = s.bots[botId]
+	s.mu.RUnlock()
+	if ok {
+		return b, nil
+	}
+
+	authKeyId, err := s.svcCtx.Dao.GetBotAuthKeyId(ctx, botId)
+	if err != nil {
+		return nil, err
+	}
+
+	b = newBot(botId, authKeyId)
+	s.mu.Lock()
+	s.bots[botId] = b
+	s.authKeys[authKeyId] = b
+	s.mu.Unlock()
+
+	return b, nil
+}
+
+// lookupUser resolves the users missing in pushed updates as seen by botId.
+func (s *Service) lookupUser(ctx context.Context, botId int64) model.UserLookup {
+	return func(id int64) *mtproto.User {
+		u, err := s.svcCtx.Dao.UserClient.UserGetImmutableUser(ctx, &userpb.TLUserGetImmutableUser{
+			Id: id,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("user.getImmutableUser(%d) - error: %v", id, err)
+			return nil
+		}
+
+		return u.ToUser(botId)
+	}
+}
+
+// touch marks b as polled and sets it online right away, so the updates start
+// flowing before the next keepalive tick.
+func (s *Service) touch(ctx context.Context, b *bot) {
+	now := time.Now().Unix()
+
+	b.mu.Lock()
+	b.lastPollAt = now
+	needOnline := now > b.onlineExpired-onlineRefreshAhead
+	b.mu.Unlock()
+
+	if needOnline {
+		s.setOnline(ctx, b, now)
+	}
+}
+
+func (s *Service) setOnline(ctx context.Context, b *bot, now int64) {
+	_, err := s.svcCtx.Dao.StatusClient.StatusSetSessionOnline(ctx, &status.TLStatusSetSessionOnline{
+		UserId: b.id,
+		Session: &status.SessionEntry{
+			UserId:        b.id,
+			AuthKeyId:     b.authKeyId,
+			Gateway:       s.serverId,
+			Expired:       now + onlineTimeout,
+			PermAuthKeyId: b.authKeyId,
+			Client:        "botapi",
+		},
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("status.setSessionOnline(%d) - error: %v", b.id, err)
+		return
+	}
+
+	b.mu.Lock()
+	b.onlineExpired = now + onlineTimeout
+	b.mu.Unlock()
+}
+
+func (s *Service) setOffline(ctx context.Context, b *bot) {
+	_, err := s.svcCtx.Dao.StatusClient.StatusSetSessionOffline(ctx, &status.TLStatusSetSessionOffline{
+		UserId:    b.id,
+		AuthKeyId: b.authKeyId,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("status.setSessionOffline(%d) - error: %v", b.id, err)
+	}
+
+	b.mu.Lock()
+	b.onlineExpired = 0
+	b.mu.Unlock()
+}
+
+// keepalive refreshes the online state of the active bots and releases the idle ones.
+func (s *Service) keepalive() {
+	ticker := time.NewTicker(keepaliveTick)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		var (
+			ctx  = context.Background()
+			now  = time.Now().Unix()
+			bots []*bot
+		)
+
+		s.mu.RLock()
+		for _, b := range s.bots {
+			bots = append(bots, b)
+		}
+		s.mu.RUnlock()
+
+		for _, b := range bots {
+			b.mu.Lock()
+			active := b.webhook != nil || now-b.lastPollAt < botIdleTimeout
+			onlineExpired := b.onlineExpired
+			b.mu.Unlock()
+
+			switch {
+			case active && now > onlineExpired-onlineRefreshAhead:
+				s.setOnline(ctx, b, now)
+			case !active && onlineExpired != 0:
+				s.setOffline(ctx, b)
+			}
+		}
+	}
+}
+
+// loadWebhooks restarts the delivery of the webhooks set before a restart.
+func (s *Service) loadWebhooks() {
+	ctx := context.Background()
+
+	webhooks, err := s.svcCtx.Dao.GetAllWebhooks(ctx)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().Unix()
+	for botId, webhook := range webhooks {
+		b, err := s.loadBot(ctx, botId)
+		if err != nil {
+			continue
+		}
+		s.startWebhook(b, webhook)
+		s.setOnline(ctx, b, now)
+	}
+}
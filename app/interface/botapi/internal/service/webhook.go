@@ -0,0 +1,139 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/dao"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	webhookTimeout    = 10 * time.Second
+	webhookMinBackoff = time.Second
+	webhookMaxBackoff = time.Minute
+)
+
+var webhookClient = &http.Client{
+	Timeout: webhookTimeout,
+}
+
+// startWebhook replaces the webhook of b and starts delivering to it.
+func (s *Service) startWebhook(b *bot, webhook *dao.Webhook) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b.mu.Lock()
+	if b.webhookCancel != nil {
+		b.webhookCancel()
+	}
+	b.webhook = webhook
+	b.webhookCancel = cancel
+	b.allowedUpdates = webhook.AllowedUpdates
+	b.lastErrorDate = 0
+	b.lastErrorMessage = ""
+	b.mu.Unlock()
+
+	go s.runWebhook(ctx, b, webhook)
+}
+
+func (s *Service) stopWebhook(b *bot) {
+	b.mu.Lock()
+	if b.webhookCancel != nil {
+		b.webhookCancel()
+	}
+	b.webhook = nil
+	b.webhookCancel = nil
+	b.mu.Unlock()
+}
+
+// runWebhook posts the pending updates one by one in update_id order, an update
+// is confirmed once the webhook answers 2xx, failures are retried with backoff.
+func (s *Service) runWebhook(ctx context.Context, b *bot, webhook *dao.Webhook) {
+	backoff := webhookMinBackoff
+
+	for {
+		updates, notify := b.fetch(0, 1)
+		if len(updates) == 0 {
+			select {
+			case <-notify:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		err := postWebhook(ctx, webhook, updates[0])
+		if err == nil {
+			b.fetch(updates[0].UpdateId+1, 0)
+			backoff = webhookMinBackoff
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		logx.Errorf("webhook of bot %d - error: %v", b.id, err)
+		b.setLastError(time.Now().Unix(), err.Error())
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		if backoff *= 2; backoff > webhookMaxBackoff {
+			backoff = webhookMaxBackoff
+		}
+	}
+}
+
+func postWebhook(ctx context.Context, webhook *dao.Webhook, u *model.Update) error {
+	body, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if webhook.SecretToken != "" {
+		req.Header.Set("X-Telegram-Bot-Api-Secret-Token", webhook.SecretToken)
+	}
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Wrong response from the webhook: %s", resp.Status)
+	}
+
+	return nil
+}
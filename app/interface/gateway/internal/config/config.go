@@ -20,6 +20,7 @@ type Config struct {
 	KeyFile        string
 	KeyFingerprint string
 	Server         *net2.TcpServerConfig
+	Websocket      *net2.WebsocketConfig `json:",optional"`
 	Session        zrpc.RpcClientConf
 }
 
@@ -19,19 +19,25 @@
 package server
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/mtproto/crypto"
 )
 
 type authKeyUtil struct {
-	keyData *mtproto.AuthKeyInfo
-	key     *crypto.AuthKey
+	keyData   *mtproto.AuthKeyInfo
+	key       *crypto.AuthKey
+	expiresAt int64
 }
 
-func newAuthKeyUtil(k *mtproto.AuthKeyInfo) *authKeyUtil {
+func newAuthKeyUtil(k *mtproto.AuthKeyInfo, expiresAt int64) *authKeyUtil {
 	return &authKeyUtil{
-		keyData: k,
-		key:     crypto.NewAuthKey(k.AuthKeyId, k.AuthKey),
+		keyData:   k,
+		key:       crypto.NewAuthKey(k.AuthKeyId, k.AuthKey),
+		expiresAt: expiresAt,
 	}
 }
 
@@ -59,6 +65,20 @@ func (k *authKeyUtil) MediaTempAuthKeyId() int64 {
 	return k.keyData.MediaTempAuthKeyId
 }
 
+func (k *authKeyUtil) ExpiresAt() int64 {
+	return k.expiresAt
+}
+
+func (k *authKeyUtil) setExpiresAt(expiresAt int64) {
+	k.expiresAt = expiresAt
+}
+
+// Expired
+// temp keys created by p_q_inner_data_temp are usable for expires_in seconds only.
+func (k *authKeyUtil) Expired() bool {
+	return k.expiresAt != 0 && k.expiresAt <= time.Now().Unix()
+}
+
 func (k *authKeyUtil) AesIgeEncrypt(rawData []byte) ([]byte, []byte, error) {
 	return k.key.AesIgeEncrypt(rawData)
 }
@@ -66,3 +86,13 @@ func (k *authKeyUtil) AesIgeEncrypt(rawData []byte) ([]byte, []byte, error) {
 func (k *authKeyUtil) AesIgeDecrypt(msgKey, rawData []byte) ([]byte, error) {
 	return k.key.AesIgeDecrypt(msgKey, rawData)
 }
+
+// QuickAckToken
+// the 32 higher-order bits of SHA256(substr(auth_key, 88, 32) + plaintext),
+// the same hash as computed for verifying the client's msg_key.
+func (k *authKeyUtil) QuickAckToken(plaintext []byte) uint32 {
+	h := sha256.New()
+	h.Write(k.keyData.AuthKey[88 : 88+32])
+	h.Write(plaintext)
+	return binary.LittleEndian.Uint32(h.Sum(nil)[:4])
+}
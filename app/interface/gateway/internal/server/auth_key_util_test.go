@@ -0,0 +1,80 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestQuickAckToken(t *testing.T) {
+	authKey := make([]byte, 256)
+	for i := range authKey {
+		authKey[i] = byte(i)
+	}
+	k := newAuthKeyUtil(&mtproto.AuthKeyInfo{AuthKeyId: 1, AuthKey: authKey}, 0)
+
+	cases := []struct {
+		plaintext []byte
+		want      uint32
+	}{
+		{
+			plaintext: []byte{
+				0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+				0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
+			},
+			want: 0xd57f3e82,
+		},
+		{
+			plaintext: append(bytes.Repeat([]byte{0}, 16), []byte("hello quick ack!")...),
+			want:      0x89df55b7,
+		},
+	}
+
+	for i, tc := range cases {
+		if got := k.QuickAckToken(tc.plaintext); got != tc.want {
+			t.Errorf("case %d: QuickAckToken = %#x, want %#x", i, got, tc.want)
+		}
+	}
+}
+
+func TestAuthKeyExpired(t *testing.T) {
+	now := time.Now().Unix()
+
+	cases := []struct {
+		name      string
+		expiresAt int64
+		want      bool
+	}{
+		{name: "perm key", expiresAt: 0, want: false},
+		{name: "temp key", expiresAt: now + 60, want: false},
+		{name: "temp key expires now", expiresAt: now, want: true},
+		{name: "expired temp key", expiresAt: now - 60, want: true},
+	}
+
+	for _, tc := range cases {
+		k := newAuthKeyUtil(&mtproto.AuthKeyInfo{AuthKeyId: 1, AuthKey: make([]byte, 256)}, tc.expiresAt)
+		if got := k.Expired(); got != tc.want {
+			t.Errorf("%s: Expired() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
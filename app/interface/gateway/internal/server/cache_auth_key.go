@@ -19,29 +19,75 @@
 package server
 
 import (
+	"context"
 	"strconv"
+	"time"
 
 	"github.com/teamgram/proto/mtproto"
+	sessionpb "github.com/teamgram/teamgram-server/app/interface/session/session"
 )
 
-func (s *Server) GetAuthKey(authKeyId int64) *mtproto.AuthKeyInfo {
+// cacheAuthKeyValue
+// expiresAt is 0 for perm keys, temp keys are evicted once expired.
+type cacheAuthKeyValue struct {
+	keyInfo   *mtproto.AuthKeyInfo
+	expiresAt int64
+}
+
+// Size Impl cache.Value interface
+func (v *cacheAuthKeyValue) Size() int {
+	return v.keyInfo.Size()
+}
+
+func (s *Server) GetAuthKey(authKeyId int64) (*mtproto.AuthKeyInfo, int64) {
 	var (
 		cacheK = strconv.Itoa(int(authKeyId))
-		value  *mtproto.AuthKeyInfo
 	)
 
-	if v, ok := s.cache.Get(cacheK); ok {
-		value = v.(*mtproto.AuthKeyInfo)
+	v, ok := s.cache.Get(cacheK)
+	if !ok {
+		return nil, 0
 	}
 
-	return value
+	value := v.(*cacheAuthKeyValue)
+	if value.expiresAt != 0 && value.expiresAt <= time.Now().Unix() {
+		s.cache.Delete(cacheK)
+		return nil, 0
+	}
+
+	return value.keyInfo, value.expiresAt
 }
 
-func (s *Server) PutAuthKey(keyInfo *mtproto.AuthKeyInfo) {
+func (s *Server) PutAuthKey(keyInfo *mtproto.AuthKeyInfo, expiresAt int64) {
 	var (
 		cacheK = strconv.Itoa(int(keyInfo.AuthKeyId))
 	)
 
-	// TODO: expires_in
-	s.cache.Set(cacheK, keyInfo)
+	s.cache.Set(cacheK, &cacheAuthKeyValue{
+		keyInfo:   keyInfo,
+		expiresAt: expiresAt,
+	})
+}
+
+func (s *Server) DeleteAuthKey(authKeyId int64) {
+	s.cache.Delete(strconv.Itoa(int(authKeyId)))
+}
+
+// getAuthKeyExpiresAt
+// authsession drops temp keys and shortens their expires_at on rebinding,
+// so temp keys served from cache are re-checked against it.
+func (s *Server) getAuthKeyExpiresAt(authKeyId int64) (int64, error) {
+	sessClient, err := s.session.getSessionClient(strconv.FormatInt(authKeyId, 10))
+	if err != nil {
+		return 0, err
+	}
+
+	rExpiresAt, err := sessClient.SessionGetAuthKeyExpiresAt(context.Background(), &sessionpb.TLSessionGetAuthKeyExpiresAt{
+		AuthKeyId: authKeyId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(rExpiresAt.GetV()), nil
 }
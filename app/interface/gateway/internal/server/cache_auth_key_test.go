@@ -0,0 +1,68 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/cache"
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestGetAuthKeyExpired(t *testing.T) {
+	var (
+		s   = &Server{cache: cache.NewLRUCache(1024 * 1024)}
+		now = time.Now().Unix()
+	)
+
+	cases := []struct {
+		name      string
+		authKeyId int64
+		keyType   int32
+		expiresAt int64
+		found     bool
+	}{
+		{name: "perm key", authKeyId: 1, keyType: mtproto.AuthKeyTypePerm, expiresAt: 0, found: true},
+		{name: "temp key", authKeyId: 2, keyType: mtproto.AuthKeyTypeTemp, expiresAt: now + 60, found: true},
+		{name: "expired temp key", authKeyId: 3, keyType: mtproto.AuthKeyTypeTemp, expiresAt: now - 60, found: false},
+	}
+
+	for _, tc := range cases {
+		s.PutAuthKey(&mtproto.AuthKeyInfo{
+			AuthKeyId:   tc.authKeyId,
+			AuthKey:     make([]byte, 256),
+			AuthKeyType: tc.keyType,
+		}, tc.expiresAt)
+
+		key, expiresAt := s.GetAuthKey(tc.authKeyId)
+		if (key != nil) != tc.found {
+			t.Errorf("%s: GetAuthKey found = %v, want %v", tc.name, key != nil, tc.found)
+			continue
+		}
+		if tc.found && expiresAt != tc.expiresAt {
+			t.Errorf("%s: GetAuthKey expiresAt = %d, want %d", tc.name, expiresAt, tc.expiresAt)
+		}
+	}
+
+	// expired temp keys are evicted, not just hidden
+	if _, ok := s.cache.Get("3"); ok {
+		t.Errorf("expired temp key still cached")
+	}
+}
@@ -62,7 +62,6 @@ func (c *AbridgedCodec) Receive() (interface{}, error) {
 
 	// log.Info("first_byte: ", hex.EncodeToString(b[:1]))
 	needAck := b[0]>>7 == 1
-	_ = needAck
 
 	b[0] = b[0] & 0x7f
 
@@ -100,18 +99,26 @@ func (c *AbridgedCodec) Receive() (interface{}, error) {
 	// TODO(@benqi): process report ack and quickack
 	// 截断QuickAck消息，客户端有问题
 	if size == 4 {
-		log.Errorf("server response error: ", int32(binary.LittleEndian.Uint32(buf)))
+		log.Errorf("server response error: %d", int32(binary.LittleEndian.Uint32(buf)))
 		// return nil, fmt.Errorf("Recv QuickAckMessage, ignore!!!!") //  connId: ", c.stream, ", by client ", m.RemoteAddr())
 		return nil, nil
 	}
 
 	authKeyId := int64(binary.LittleEndian.Uint64(buf))
-	message := mtproto.NewMTPRawMessage(authKeyId, 0, TRANSPORT_TCP)
+	message := mtproto.NewMTPRawMessage(authKeyId, quickAckId(needAck), TRANSPORT_TCP)
 	message.Decode(buf)
 	return message, nil
 }
 
 func (c *AbridgedCodec) Send(msg interface{}) error {
+	if ack, ok := msg.(*QuickAckMessage); ok {
+		_, err := c.conn.Write(ack.encodeAbridged())
+		if err != nil {
+			log.Errorf("Send quick ack error: %s", err)
+		}
+		return err
+	}
+
 	message, ok := msg.(*mtproto.MTPRawMessage)
 	if !ok {
 		err := fmt.Errorf("msg type error, only MTPRawMessage, msg: {%v}", msg)
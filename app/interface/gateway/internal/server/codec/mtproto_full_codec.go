@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"hash/crc32"
 	"io"
 
 	"github.com/teamgram/proto/mtproto"
@@ -41,7 +42,8 @@ import (
 // and 4 CRC32 bytes at the end (length, sequence number, and payload together).
 //
 type FullCodec struct {
-	conn io.ReadWriteCloser
+	conn       io.ReadWriteCloser
+	sendSeqNum uint32
 }
 
 func NewMTProtoFullCodec(conn io.ReadWriteCloser) *FullCodec {
@@ -52,16 +54,19 @@ func NewMTProtoFullCodec(conn io.ReadWriteCloser) *FullCodec {
 
 func (c *FullCodec) Receive() (interface{}, error) {
 	var size int
-	var n int
 	var err error
 
 	b := make([]byte, 4)
-	n, err = io.ReadFull(c.conn, b)
+	_, err = io.ReadFull(c.conn, b)
 	if err != nil {
 		return nil, err
 	}
 
-	size = int(binary.LittleEndian.Uint32(b))
+	size2 := binary.LittleEndian.Uint32(b)
+
+	needAck := size2>>31 == 1
+
+	size = int(size2 & 0x7fffffff)
 	// Check bufLen
 	if size < 12 {
 		err = fmt.Errorf("invalid len: %d", size)
@@ -70,15 +75,12 @@ func (c *FullCodec) Receive() (interface{}, error) {
 
 	log.Infof("size1: %d", size)
 
-	left := size
+	// seq_num + payload + crc32
 	buf := make([]byte, size-4)
-	for left > 0 {
-		n, err = io.ReadFull(c.conn, buf[size-left:])
-		if err != nil {
-			log.Errorf("ReadFull2 error: %v", err)
-			return nil, err
-		}
-		left -= n
+	_, err = io.ReadFull(c.conn, buf)
+	if err != nil {
+		log.Errorf("ReadFull2 error: %v", err)
+		return nil, err
 	}
 
 	if size > 4096 {
@@ -89,17 +91,38 @@ func (c *FullCodec) Receive() (interface{}, error) {
 	// TODO(@benqi): check seqNum, save last seq_num
 	_ = seqNum
 
-	crc32 := binary.LittleEndian.Uint32(buf[len(buf)-4:])
-	// TODO(@benqi): check crc32
-	_ = crc32
+	// crc32 covers the length, the sequence number and the payload, the
+	// length is hashed as sent, quick ack bit included.
+	crc := crc32.NewIEEE()
+	crc.Write(b)
+	crc.Write(buf[:len(buf)-4])
+	if crc.Sum32() != binary.LittleEndian.Uint32(buf[len(buf)-4:]) {
+		err = fmt.Errorf("invalid crc32")
+		log.Errorf("FullCodec - error: %v", err)
+		return nil, err
+	}
 
-	authKeyId := int64(binary.LittleEndian.Uint64(buf[4:]))
-	message := mtproto.NewMTPRawMessage(authKeyId, 0, TRANSPORT_TCP)
-	message.Decode(buf)
+	payload := buf[4 : len(buf)-4]
+	if len(payload) < 8 {
+		err = fmt.Errorf("invalid payload len: %d", len(payload))
+		return nil, err
+	}
+
+	authKeyId := int64(binary.LittleEndian.Uint64(payload))
+	message := mtproto.NewMTPRawMessage(authKeyId, quickAckId(needAck), TRANSPORT_TCP)
+	message.Decode(payload)
 	return message, nil
 }
 
 func (c *FullCodec) Send(msg interface{}) error {
+	if ack, ok := msg.(*QuickAckMessage); ok {
+		_, err := c.conn.Write(ack.encodeLittleEndian())
+		if err != nil {
+			log.Errorf("Send quick ack error: %s", err)
+		}
+		return err
+	}
+
 	message, ok := msg.(*mtproto.MTPRawMessage)
 	if !ok {
 		err := fmt.Errorf("msg type error, only MTPRawMessage, msg: {%v}", msg)
@@ -109,21 +132,15 @@ func (c *FullCodec) Send(msg interface{}) error {
 
 	b := message.Encode()
 
-	sb := make([]byte, 8)
-	// minus padding
-	size := len(b) / 4
-
-	binary.LittleEndian.PutUint32(sb, uint32(size))
-	// TODO(@benqi): gen seq_num
-	var seqNum uint32 = 0
-	binary.LittleEndian.PutUint32(sb[4:], seqNum)
-	b = append(sb, b...)
-	var crc32Buf = make([]byte, 4)
-	var crc32 uint32 = 0
-	binary.LittleEndian.PutUint32(crc32Buf, crc32)
-	b = append(sb, crc32Buf...)
-
-	_, err := c.conn.Write(b)
+	// length + seq_num + payload + crc32
+	buf := make([]byte, len(b)+12)
+	binary.LittleEndian.PutUint32(buf, uint32(len(buf)))
+	binary.LittleEndian.PutUint32(buf[4:], c.sendSeqNum)
+	c.sendSeqNum++
+	copy(buf[8:], b)
+	binary.LittleEndian.PutUint32(buf[len(buf)-4:], crc32.ChecksumIEEE(buf[:len(buf)-4]))
+
+	_, err := c.conn.Write(buf)
 	if err != nil {
 		log.Errorf("Send msg error: %s", err)
 	}
@@ -63,7 +63,6 @@ func (c *IntermediateCodec) Receive() (interface{}, error) {
 	size2 := binary.LittleEndian.Uint32(b)
 
 	needAck := size2>>31 == 1
-	_ = needAck
 
 	size = int(size2 & 0xffffff)
 
@@ -89,17 +88,25 @@ func (c *IntermediateCodec) Receive() (interface{}, error) {
 	// TODO(@benqi): process report ack and quickack
 	// 截断QuickAck消息，客户端有问题
 	if size == 4 {
-		log.Errorf("Server response error: ", int32(binary.LittleEndian.Uint32(buf)))
+		log.Errorf("Server response error: %d", int32(binary.LittleEndian.Uint32(buf)))
 		return nil, nil
 	}
 
 	authKeyId := int64(binary.LittleEndian.Uint64(buf))
-	message := mtproto.NewMTPRawMessage(authKeyId, 0, TRANSPORT_TCP)
+	message := mtproto.NewMTPRawMessage(authKeyId, quickAckId(needAck), TRANSPORT_TCP)
 	message.Decode(buf)
 	return message, nil
 }
 
 func (c *IntermediateCodec) Send(msg interface{}) error {
+	if ack, ok := msg.(*QuickAckMessage); ok {
+		_, err := c.conn.Write(ack.encodeLittleEndian())
+		if err != nil {
+			log.Errorf("Send quick ack error: %s", err)
+		}
+		return err
+	}
+
 	message, ok := msg.(*mtproto.MTPRawMessage)
 	if !ok {
 		err := fmt.Errorf("msg type error, only MTPRawMessage, msg: {%v}", msg)
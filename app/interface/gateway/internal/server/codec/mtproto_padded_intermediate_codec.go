@@ -62,7 +62,11 @@ func (c *PaddedIntermediateCodec) Receive() (interface{}, error) {
 		return nil, err
 	}
 
-	size = int(binary.LittleEndian.Uint32(b))
+	size2 := binary.LittleEndian.Uint32(b)
+
+	needAck := size2>>31 == 1
+
+	size = int(size2 & 0xffffff)
 	log.Infof("size1: %d", size)
 
 	left := size
@@ -83,18 +87,26 @@ func (c *PaddedIntermediateCodec) Receive() (interface{}, error) {
 	// TODO(@benqi): process report ack and quickack
 	// 截断QuickAck消息，客户端有问题
 	if size == 4 {
-		log.Errorf("Server response error: ", int32(binary.LittleEndian.Uint32(buf)))
+		log.Errorf("Server response error: %d", int32(binary.LittleEndian.Uint32(buf)))
 		// return nil, fmt.Errorf("Recv QuickAckMessage, ignore!!!!") //  connId: ", c.stream, ", by client ", m.RemoteAddr())
 		return nil, nil
 	}
 
 	authKeyId := int64(binary.LittleEndian.Uint64(buf))
-	message := mtproto.NewMTPRawMessage(authKeyId, 0, TRANSPORT_TCP)
+	message := mtproto.NewMTPRawMessage(authKeyId, quickAckId(needAck), TRANSPORT_TCP)
 	message.Decode(buf)
 	return message, nil
 }
 
 func (c *PaddedIntermediateCodec) Send(msg interface{}) error {
+	if ack, ok := msg.(*QuickAckMessage); ok {
+		_, err := c.conn.Write(ack.encodeLittleEndian())
+		if err != nil {
+			log.Errorf("Send quick ack error: %s", err)
+		}
+		return err
+	}
+
 	message, ok := msg.(*mtproto.MTPRawMessage)
 	if !ok {
 		err := fmt.Errorf("msg type error, only MTPRawMessage, msg: {%v}", msg)
@@ -0,0 +1,67 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+
+package codec
+
+import (
+	"encoding/binary"
+)
+
+// Quick ack (https://core.telegram.org/mtproto#tcp-transport)
+//
+// The full, the intermediate and the abridged versions of the protocol have support for quick acknowledgment.
+// In this case, the client sets the highest-order length bit in the query packet,
+// and the server responds with a special 4 bytes as a separate packet.
+// They are the 32 higher-order bits of SHA256 of the encrypted
+// portion of the packet prepended by 32 bytes from the authorization key
+// (the same hash as computed for verifying the message key),
+// with the most significant bit set to make clear that this is not the length of a regular server response packet;
+// if the abridged version is used, bswap is applied to these four bytes.
+//
+
+const (
+	quickAckFlag = 0x80000000
+
+	// quickAckMarker is stored in MTPRawMessage.quickAckId by the codecs,
+	// the real token needs the auth key and is computed by the gateway.
+	quickAckMarker = 1
+)
+
+// QuickAckMessage is written back to the client as a separate 4 bytes packet.
+type QuickAckMessage struct {
+	Token uint32
+}
+
+func (m *QuickAckMessage) encodeLittleEndian() []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, m.Token|quickAckFlag)
+	return b
+}
+
+// encodeAbridged abridged version, bswap is applied to these four bytes.
+func (m *QuickAckMessage) encodeAbridged() []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, m.Token|quickAckFlag)
+	return b
+}
+
+func quickAckId(needAck bool) int32 {
+	if needAck {
+		return quickAckMarker
+	}
+	return 0
+}
@@ -0,0 +1,226 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+type testConn struct {
+	r io.Reader
+	w bytes.Buffer
+}
+
+func newTestConn(b []byte) *testConn {
+	return &testConn{r: bytes.NewReader(b)}
+}
+
+func (c *testConn) Read(p []byte) (int, error)  { return c.r.Read(p) }
+func (c *testConn) Write(p []byte) (int, error) { return c.w.Write(p) }
+func (c *testConn) Close() error                { return nil }
+
+type testCodec interface {
+	Receive() (interface{}, error)
+	Send(msg interface{}) error
+}
+
+func abridgedFrame(payload []byte, ack bool) []byte {
+	l := byte(len(payload) / 4)
+	if ack {
+		l |= 0x80
+	}
+	return append([]byte{l}, payload...)
+}
+
+func intermediateFrame(payload []byte, ack bool) []byte {
+	l := uint32(len(payload))
+	if ack {
+		l |= quickAckFlag
+	}
+	b := make([]byte, 4, 4+len(payload))
+	binary.LittleEndian.PutUint32(b, l)
+	return append(b, payload...)
+}
+
+func fullFrame(payload []byte, ack bool) []byte {
+	l := uint32(len(payload) + 12)
+	if ack {
+		l |= quickAckFlag
+	}
+	b := make([]byte, 8, len(payload)+12)
+	binary.LittleEndian.PutUint32(b, l)
+	binary.LittleEndian.PutUint32(b[4:], 0)
+	b = append(b, payload...)
+	crc := make([]byte, 4)
+	binary.LittleEndian.PutUint32(crc, crc32.ChecksumIEEE(b))
+	return append(b, crc...)
+}
+
+func testPayload() []byte {
+	p := make([]byte, 32)
+	binary.LittleEndian.PutUint64(p, 0x1122334455667788)
+	for i := 8; i < len(p); i++ {
+		p[i] = byte(i)
+	}
+	return p
+}
+
+func TestQuickAck(t *testing.T) {
+	const token = 0x01020304
+
+	codecs := []struct {
+		name     string
+		newCodec func(rw io.ReadWriteCloser) testCodec
+		frame    func(payload []byte, ack bool) []byte
+		wantAck  []byte
+	}{
+		{
+			name:     "abridged",
+			newCodec: func(rw io.ReadWriteCloser) testCodec { return NewMTProtoAbridgedCodec(rw) },
+			frame:    abridgedFrame,
+			// bswap is applied for the abridged version
+			wantAck: []byte{0x81, 0x02, 0x03, 0x04},
+		},
+		{
+			name:     "intermediate",
+			newCodec: func(rw io.ReadWriteCloser) testCodec { return NewMTProtoIntermediateCodec(rw) },
+			frame:    intermediateFrame,
+			wantAck:  []byte{0x04, 0x03, 0x02, 0x81},
+		},
+		{
+			name:     "padded_intermediate",
+			newCodec: func(rw io.ReadWriteCloser) testCodec { return NewMTProtoPaddedIntermediateCodec(rw) },
+			frame:    intermediateFrame,
+			wantAck:  []byte{0x04, 0x03, 0x02, 0x81},
+		},
+		{
+			name:     "full",
+			newCodec: func(rw io.ReadWriteCloser) testCodec { return NewMTProtoFullCodec(rw) },
+			frame:    fullFrame,
+			wantAck:  []byte{0x04, 0x03, 0x02, 0x81},
+		},
+	}
+
+	for _, tc := range codecs {
+		for _, ack := range []bool{false, true} {
+			payload := testPayload()
+			conn := newTestConn(tc.frame(payload, ack))
+			c := tc.newCodec(conn)
+
+			msg, err := c.Receive()
+			if err != nil {
+				t.Fatalf("%s(ack=%v): Receive error: %v", tc.name, ack, err)
+			}
+			raw, ok := msg.(*mtproto.MTPRawMessage)
+			if !ok {
+				t.Fatalf("%s(ack=%v): Receive returned %T", tc.name, ack, msg)
+			}
+			if raw.AuthKeyId() != 0x1122334455667788 {
+				t.Errorf("%s(ack=%v): auth_key_id = %x", tc.name, ack, raw.AuthKeyId())
+			}
+			if !bytes.Equal(raw.Payload, payload) {
+				t.Errorf("%s(ack=%v): payload mismatch", tc.name, ack)
+			}
+			if got := raw.QuickAckId() != 0; got != ack {
+				t.Errorf("%s(ack=%v): need quick ack = %v", tc.name, ack, got)
+			}
+		}
+
+		conn := newTestConn(nil)
+		if err := tc.newCodec(conn).Send(&QuickAckMessage{Token: token}); err != nil {
+			t.Fatalf("%s: Send quick ack error: %v", tc.name, err)
+		}
+		if !bytes.Equal(conn.w.Bytes(), tc.wantAck) {
+			t.Errorf("%s: quick ack = %x, want %x", tc.name, conn.w.Bytes(), tc.wantAck)
+		}
+	}
+}
+
+func TestFullCodecCrc32(t *testing.T) {
+	cases := []struct {
+		name    string
+		frame   func() []byte
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			frame: func() []byte { return fullFrame(testPayload(), false) },
+		},
+		{
+			name:  "valid with quick ack bit hashed",
+			frame: func() []byte { return fullFrame(testPayload(), true) },
+		},
+		{
+			name: "corrupted payload",
+			frame: func() []byte {
+				b := fullFrame(testPayload(), false)
+				b[20] ^= 0xff
+				return b
+			},
+			wantErr: true,
+		},
+		{
+			name: "corrupted crc",
+			frame: func() []byte {
+				b := fullFrame(testPayload(), false)
+				b[len(b)-1] ^= 0xff
+				return b
+			},
+			wantErr: true,
+		},
+		{
+			name: "quick ack bit not hashed",
+			frame: func() []byte {
+				b := fullFrame(testPayload(), false)
+				b[3] |= 0x80
+				return b
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := NewMTProtoFullCodec(newTestConn(tc.frame())).Receive()
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: Receive error = %v, wantErr %v", tc.name, err, tc.wantErr)
+		}
+	}
+
+	// frames sent by the server must pass the same check
+	conn := newTestConn(nil)
+	c := NewMTProtoFullCodec(conn)
+	if err := c.Send(mtproto.NewMTPRawMessage(0, 0, TRANSPORT_TCP)); err != nil {
+		t.Fatal(err)
+	}
+	m := mtproto.NewMTPRawMessage(0x1122334455667788, 0, TRANSPORT_TCP)
+	m.Decode(testPayload())
+	if err := c.Send(m); err != nil {
+		t.Fatal(err)
+	}
+	sent := conn.w.Bytes()[12:]
+	if _, err := NewMTProtoFullCodec(newTestConn(sent)).Receive(); err != nil {
+		t.Errorf("server frame rejected: %v", err)
+	}
+}
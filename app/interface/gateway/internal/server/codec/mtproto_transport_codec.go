@@ -34,18 +34,6 @@ import (
 	log "github.com/zeromicro/go-zero/core/logx"
 )
 
-// TODO(@benqi): Quick ack (https://core.telegram.org/mtproto#tcp-transport)
-//
-// The full, the intermediate and the abridged versions of the protocol have support for quick acknowledgment.
-// In this case, the client sets the highest-order length bit in the query packet,
-// and the server responds with a special 4 bytes as a separate packet.
-// They are the 32 higher-order bits of SHA256 of the encrypted
-// portion of the packet prepended by 32 bytes from the authorization key
-// (the same hash as computed for verifying the message key),
-// with the most significant bit set to make clear that this is not the length of a regular server response packet;
-// if the abridged version is used, bswap is applied to these four bytes.
-//
-
 // Transport类型，不支持UDP
 const (
 	TRANSPORT_TCP  = 1 // TCP
@@ -68,7 +56,8 @@ const (
 	PVRG_FLAG                = 0x47725650 // PVrG
 	FULL_FLAG                = 0x00000000
 
-	// PROXY_FLAG websocket flag
+	// PROXY_FLAG the real client ip is carried in the first int32, websocket
+	// clients are accepted by websocketServer and use the obfuscated codecs.
 	PROXY_FLAG = 0xaaaaaaaa
 
 	// HTTP_HEAD_FLAG Http Transport
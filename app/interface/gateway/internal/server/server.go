@@ -27,6 +27,7 @@ import (
 	sessionpb "github.com/teamgram/teamgram-server/app/interface/session/session"
 	"github.com/zeromicro/go-zero/core/logx"
 	"strconv"
+	"time"
 
 	"github.com/teamgram/marmota/pkg/cache"
 	"github.com/teamgram/teamgram-server/app/interface/gateway/internal/config"
@@ -40,6 +41,7 @@ var (
 type Server struct {
 	c      *config.Config
 	server *net2.TcpServer2
+	ws     *websocketServer
 	// pool           *goroutine.Pool
 	cache          *cache.LRUCache
 	handshake      *handshake
@@ -87,13 +89,18 @@ func New(c config.Config) *Server {
 				err2 = fmt.Errorf("saveAuthKeyInfo error")
 				return err2
 			} else {
+				var expiresAt int64
+				if expiresIn > 0 {
+					expiresAt = time.Now().Unix() + int64(expiresIn)
+				}
 				s.PutAuthKey(&mtproto.AuthKeyInfo{
 					AuthKeyId:          key.AuthKeyId,
 					AuthKey:            key.AuthKey,
 					AuthKeyType:        key.AuthKeyType,
 					PermAuthKeyId:      key.PermAuthKeyId,
 					TempAuthKeyId:      key.TempAuthKeyId,
-					MediaTempAuthKeyId: key.MediaTempAuthKeyId})
+					MediaTempAuthKeyId: key.MediaTempAuthKeyId},
+					expiresAt)
 			}
 			return nil
 		})
@@ -113,6 +120,9 @@ func New(c config.Config) *Server {
 }
 
 func (s *Server) Close() {
+	if s.ws != nil {
+		s.ws.Stop()
+	}
 	s.server.Stop()
 }
 
@@ -127,7 +137,27 @@ func (s *Server) Serve() error {
 		panic(err)
 	}
 	s.server = serv
+
+	if s.c.Websocket != nil && len(s.c.Websocket.Addrs) > 0 {
+		s.ws, err = newWebsocketServer(s.c.Websocket, s.c.MaxProc, s)
+		if err != nil {
+			panic(err)
+		}
+		s.ws.Serve()
+	}
+
 	s.server.Serve()
 
 	return nil
 }
+
+// getConnection lookup a connection accepted by the tcp or the websocket server.
+func (s *Server) getConnection(connID uint64) *net2.TcpConnection {
+	if conn := s.server.GetConnection(connID); conn != nil {
+		return conn
+	}
+	if s.ws != nil {
+		return s.ws.GetConnection(connID)
+	}
+	return nil
+}
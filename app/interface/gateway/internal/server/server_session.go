@@ -92,7 +92,7 @@ func (s *Server) GatewaySendDataToGateway(ctx context.Context, in *gateway.TLGat
 
 	for _, connId := range connIdList {
 		logger.Debugf("[keyId: %d, sessionId: %d]: %v", in.AuthKeyId, in.SessionId, connId)
-		conn2 := s.server.GetConnection(connId)
+		conn2 := s.getConnection(connId)
 		if conn2 != nil {
 			ctx2, _ := conn2.Context.(*connContext)
 			authKey = ctx2.getAuthKey(in.AuthKeyId)
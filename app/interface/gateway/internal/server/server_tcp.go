@@ -27,6 +27,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/teamgram/marmota/pkg/hack"
 	"github.com/teamgram/marmota/pkg/net2"
@@ -138,6 +139,17 @@ func (ctx *connContext) putAuthKey(k *authKeyUtil) {
 	ctx.authKeys = append(ctx.authKeys, k)
 }
 
+func (ctx *connContext) removeAuthKey(k *authKeyUtil) {
+	ctx.Lock()
+	defer ctx.Unlock()
+	for i, key := range ctx.authKeys {
+		if key.Equal(k) {
+			ctx.authKeys = append(ctx.authKeys[:i], ctx.authKeys[i+1:]...)
+			return
+		}
+	}
+}
+
 func (ctx *connContext) getAllAuthKeyId() (idList []int64) {
 	ctx.Lock()
 	defer ctx.Unlock()
@@ -236,8 +248,33 @@ func (s *Server) OnConnectionDataArrived(conn *net2.TcpConnection, msg interface
 		//} else {
 		//	if ctx.state != STATE_AUTH_KEY {
 		authKey := ctx.getAuthKey(msg2.AuthKeyId())
+		if authKey != nil && authKey.ExpiresAt() != 0 {
+			expiresAt, err2 := s.getAuthKeyExpiresAt(msg2.AuthKeyId())
+			if err2 != nil {
+				logx.Errorf("conn(%s) getAuthKeyExpiresAt error: %v, {authKeyId: %d}", conn.String(), err2, msg2.AuthKeyId())
+				expiresAt = time.Now().Unix()
+			}
+			if expiresAt != authKey.ExpiresAt() {
+				authKey.setExpiresAt(expiresAt)
+				s.DeleteAuthKey(msg2.AuthKeyId())
+			}
+		}
+		if authKey != nil && authKey.Expired() {
+			logx.Infof("conn(%s) auth_key_id expired: {%d}", conn.String(), msg2.AuthKeyId())
+			ctx.removeAuthKey(authKey)
+			authKey = nil
+		}
 		if authKey == nil {
-			key := s.GetAuthKey(msg2.AuthKeyId())
+			key, expiresAt := s.GetAuthKey(msg2.AuthKeyId())
+			if key != nil && expiresAt != 0 {
+				var err2 error
+				expiresAt, err2 = s.getAuthKeyExpiresAt(msg2.AuthKeyId())
+				if err2 != nil {
+					logx.Errorf("conn(%s) getAuthKeyExpiresAt error: %v, {authKeyId: %d}", conn.String(), err2, msg2.AuthKeyId())
+					s.DeleteAuthKey(msg2.AuthKeyId())
+					key = nil
+				}
+			}
 			if key == nil {
 				sessClient, err2 := s.session.getSessionClient(strconv.FormatInt(msg2.AuthKeyId(), 10))
 				if err2 != nil {
@@ -248,13 +285,19 @@ func (s *Server) OnConnectionDataArrived(conn *net2.TcpConnection, msg interface
 					})
 					if err2 != nil {
 						logx.Errorf("conn(%s) sessionQueryAuthKey error: %v", conn.String(), err2)
+					} else if key.AuthKeyType != mtproto.AuthKeyTypePerm {
+						expiresAt, err2 = s.getAuthKeyExpiresAt(msg2.AuthKeyId())
+						if err2 != nil {
+							logx.Errorf("conn(%s) getAuthKeyExpiresAt error: %v", conn.String(), err2)
+							key = nil
+						}
 					}
 				}
 			}
 			// key := s.GetAuthKey(msg2.AuthKeyId())
 			if key == nil {
 				err = fmt.Errorf("invalid auth_key_id: {%d}", msg2.AuthKeyId())
-				logx.Error("invalid auth_key_id: {%v} - {peer: %s, ctx: %s, msg: %s}", err, conn, ctx.DebugString(), msg2.DebugString())
+				logx.Errorf("invalid auth_key_id: {%v} - {peer: %s, ctx: %s, msg: %s}", err, conn, ctx.DebugString(), msg2.DebugString())
 				var code = int32(-404)
 				cData := make([]byte, 4)
 				binary.LittleEndian.PutUint32(cData, uint32(code))
@@ -262,8 +305,8 @@ func (s *Server) OnConnectionDataArrived(conn *net2.TcpConnection, msg interface
 				// conn.Close()
 				return err
 			}
-			authKey = newAuthKeyUtil(key)
-			s.PutAuthKey(key)
+			authKey = newAuthKeyUtil(key, expiresAt)
+			s.PutAuthKey(key, expiresAt)
 			ctx.putAuthKey(authKey)
 		}
 
@@ -275,7 +318,7 @@ func (s *Server) OnConnectionDataArrived(conn *net2.TcpConnection, msg interface
 
 func (s *Server) OnConnectionClosed(conn *net2.TcpConnection) {
 	ctx, _ := conn.Context.(*connContext)
-	logx.Info("onServerConnectionClosed - {peer:%s, ctx:%s}", conn, ctx.DebugString())
+	logx.Infof("onServerConnectionClosed - {peer:%s, ctx:%s}", conn, ctx.DebugString())
 
 	if ctx.trd != nil {
 		s.timer.Del(ctx.trd)
@@ -294,7 +337,7 @@ func (s *Server) OnConnectionClosed(conn *net2.TcpConnection) {
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 func (s *Server) onUnencryptedMessage(ctx *connContext, conn *net2.TcpConnection, mmsg *mtproto.MTPRawMessage) error {
-	logx.Info("receive unencryptedRawMessage: {peer: %s, ctx: %s, mmsg: %s}", conn, ctx.DebugString(), mmsg.DebugString())
+	logx.Infof("receive unencryptedRawMessage: {peer: %s, ctx: %s, mmsg: %s}", conn, ctx.DebugString(), mmsg.DebugString())
 
 	if len(mmsg.Payload) < 8 {
 		err := fmt.Errorf("invalid data len < 8")
@@ -387,6 +430,13 @@ func (s *Server) onEncryptedMessage(ctx *connContext, conn *net2.TcpConnection,
 		return err
 	}
 
+	// quick ack is sent before the session service processes the message
+	if mmsg.QuickAckId() != 0 {
+		if err = conn.Send(&codec.QuickAckMessage{Token: authKey.QuickAckToken(mtpRwaData)}); err != nil {
+			logx.Errorf("conn(%s) send quick ack error: {%v}", conn.String(), err)
+		}
+	}
+
 	var (
 		sessionId = int64(binary.LittleEndian.Uint64(mtpRwaData[8:]))
 		isNew     = ctx.sessionId != sessionId
@@ -433,7 +483,7 @@ func (s *Server) onEncryptedMessage(ctx *connContext, conn *net2.TcpConnection,
 }
 
 func (s *Server) GetConnByConnID(id uint64) *net2.TcpConnection {
-	return s.server.GetConnection(id)
+	return s.getConnection(id)
 }
 
 func (s *Server) SendToClient(conn *net2.TcpConnection, authKey *authKeyUtil, b []byte) error {
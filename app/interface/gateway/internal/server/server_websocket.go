@@ -0,0 +1,220 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+
+package server
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/net2"
+	"github.com/teamgram/marmota/pkg/net2/websocket"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// websocket endpoints used by the web clients (Telegram Web K/A), the test
+// dc endpoint is served by the same listener.
+var websocketPaths = []string{"/apiws", "/apiws_test"}
+
+// websocketServer accepts websocket upgrades and feeds the binary frames
+// into the mtproto transport codec, so the obfuscated, intermediate and
+// abridged codecs work unchanged over websocket.
+type websocketServer struct {
+	c        *net2.WebsocketConfig
+	accepts  int
+	lsnList  []*net.TCPListener
+	conns    sync.Map
+	callback net2.TcpConnectionCallback
+	running  bool
+}
+
+func newWebsocketServer(c *net2.WebsocketConfig, accepts int, cb net2.TcpConnectionCallback) (*websocketServer, error) {
+	var (
+		lsnList []*net.TCPListener
+	)
+
+	for _, bind := range c.Addrs {
+		addr, err := net.ResolveTCPAddr("tcp", bind)
+		if err != nil {
+			logx.Errorf("net.ResolveTCPAddr(\"tcp\", \"%s\") error(%v)", bind, err)
+			return nil, err
+		}
+		listener, err := net.ListenTCP("tcp", addr)
+		if err != nil {
+			logx.Errorf("net.ListenTCP(\"tcp\", \"%s\") error(%v)", bind, err)
+			return nil, err
+		}
+		lsnList = append(lsnList, listener)
+	}
+
+	return &websocketServer{
+		c:        c,
+		accepts:  accepts,
+		lsnList:  lsnList,
+		callback: cb,
+	}, nil
+}
+
+func (s *websocketServer) Serve() {
+	if s.running {
+		return
+	}
+	s.running = true
+
+	for _, lsn := range s.lsnList {
+		for i := 0; i < s.accepts; i++ {
+			go func(lsn2 *net.TCPListener) {
+				for {
+					conn, err := net2.AcceptTCP(lsn2)
+					if err != nil {
+						// if listener close then return
+						logx.Errorf("listener.Accept(\"%s\") error(%v)", lsn2.Addr().String(), err)
+						return
+					}
+					if err = conn.SetKeepAlive(s.c.Keepalive); err != nil {
+						logx.Errorf("conn.SetKeepAlive() error(%v)", err)
+						conn.Close()
+						continue
+					}
+					if s.c.ReceiveBuf > 0 {
+						conn.SetReadBuffer(s.c.ReceiveBuf)
+					}
+					if s.c.SendBuf > 0 {
+						conn.SetWriteBuffer(s.c.SendBuf)
+					}
+
+					go s.establishWebsocketConnection(conn)
+				}
+			}(lsn)
+		}
+	}
+}
+
+func (s *websocketServer) Stop() {
+	if !s.running {
+		return
+	}
+	s.running = false
+
+	for _, lsn := range s.lsnList {
+		lsn.Close()
+	}
+	s.conns.Range(func(key, value interface{}) bool {
+		value.(*net2.TcpConnection).Close()
+		return true
+	})
+}
+
+func (s *websocketServer) GetConnection(connID uint64) *net2.TcpConnection {
+	if v, ok := s.conns.Load(connID); ok {
+		return v.(*net2.TcpConnection)
+	}
+	return nil
+}
+
+func (s *websocketServer) OnConnectionClosed(conn net2.Connection) {
+	tcpConn := conn.(*net2.TcpConnection)
+	s.conns.Delete(tcpConn.GetConnID())
+
+	if s.callback != nil {
+		s.callback.OnConnectionClosed(tcpConn)
+	}
+}
+
+func (s *websocketServer) establishWebsocketConnection(conn net.Conn) {
+	rr := bufio.NewReader(conn)
+	req, err := websocket.ReadRequest(rr)
+	if err != nil {
+		if err != io.EOF {
+			logx.Errorf("websocket.ReadRequest(rr) error(%v)", err)
+		}
+		conn.Close()
+		return
+	}
+	if !checkWebsocketPath(req.RequestURI) {
+		logx.Errorf("invalid websocket request uri: %s", req.RequestURI)
+		conn.Close()
+		return
+	}
+
+	wsConn, err := websocket.Upgrade(conn, rr, bufio.NewWriter(conn), req)
+	if err != nil {
+		logx.Errorf("websocket.Upgrade(rr) error(%v)", err)
+		conn.Close()
+		return
+	}
+
+	codec, err := net2.NewCodecByName(s.c.ProtoName, wsConn)
+	if err != nil {
+		logx.Errorf("newCodecByName(%s) error(%v)", s.c.ProtoName, err)
+		conn.Close()
+		return
+	}
+
+	tcpConn := net2.NewTcpConnection2(s.c.ServerName, wsConn, s.c.SendChanSize, codec, true, s)
+	defer func() {
+		if err := recover(); err != nil {
+			logx.Errorf("websocket_server handle panic: %v\n%s", err, debug.Stack())
+			tcpConn.Close()
+		}
+	}()
+
+	s.conns.Store(tcpConn.GetConnID(), tcpConn)
+	if s.callback != nil {
+		s.callback.OnNewConnection(tcpConn)
+	}
+
+	for {
+		wsConn.SetReadDeadline(time.Now().Add(time.Minute * 6))
+		msg, err := tcpConn.Receive()
+		if err != nil {
+			// Receive has closed tcpConn, but the codec may not have been
+			// selected yet, so make sure the socket is released.
+			logx.Errorf("conn: %s recv error: %v", tcpConn, err)
+			wsConn.Close()
+			return
+		}
+
+		if msg == nil {
+			logx.Errorf("recv a nil msg by conn: %s", tcpConn)
+			continue
+		}
+
+		if s.callback != nil {
+			s.callback.OnConnectionDataArrived(tcpConn, msg)
+		}
+	}
+}
+
+func checkWebsocketPath(uri string) bool {
+	if i := strings.IndexByte(uri, '?'); i >= 0 {
+		uri = uri[:i]
+	}
+	for _, p := range websocketPaths {
+		if uri == p {
+			return true
+		}
+	}
+
+	return false
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/teamgram/teamgram-server/app/interface/gateway/internal/config"
 	"github.com/teamgram/teamgram-server/app/interface/session/client"
@@ -69,6 +70,7 @@ type Session struct {
 	gatewayId   string
 	dispatcher  *hash.ConsistentHash
 	errNotFound error
+	mu          sync.RWMutex
 	sessions    map[string]session_client.SessionClient
 }
 
@@ -93,8 +95,8 @@ func (sess *Session) watch(c zrpc.RpcClientConf) {
 		}
 
 		var (
-			addClis    []session_client.SessionClient
-			removeClis []session_client.SessionClient
+			addNodes    []string
+			removeNodes []string
 		)
 
 		sessions := map[string]session_client.SessionClient{}
@@ -106,30 +108,34 @@ func (sess *Session) watch(c zrpc.RpcClientConf) {
 			c.Endpoints = []string{v}
 			cli, err := zrpc.NewClient(c)
 			if err != nil {
-				logx.Error("watchComet NewClient(%+v) error(%v)", values, err)
+				logx.Errorf("watchComet NewClient(%+v) error(%v)", values, err)
 				return
 			}
 			sessionCli := session_client.NewSessionClient(cli)
 			sessions[v] = sessionCli
 
-			addClis = append(addClis, sessionCli)
+			addNodes = append(addNodes, v)
 		}
 
-		for key, old := range sess.sessions {
+		for key := range sess.sessions {
 			if !stringx.Contains(values, key) {
-				removeClis = append(removeClis, old)
+				removeNodes = append(removeNodes, key)
 			}
 		}
 
-		for _, n := range addClis {
+		// the ring is keyed by the session server address, session servers build
+		// the same ring to find out which auth keys they own.
+		sess.mu.Lock()
+		for _, n := range addNodes {
 			sess.dispatcher.Add(n)
 		}
 
-		for _, n := range removeClis {
+		for _, n := range removeNodes {
 			sess.dispatcher.Remove(n)
 		}
 
 		sess.sessions = sessions
+		sess.mu.Unlock()
 	}
 
 	sub.AddListener(update)
@@ -137,10 +143,18 @@ func (sess *Session) watch(c zrpc.RpcClientConf) {
 }
 
 func (sess *Session) getSessionClient(key string) (session_client.SessionClient, error) {
+	sess.mu.RLock()
+	defer sess.mu.RUnlock()
+
 	val, ok := sess.dispatcher.Get(key)
 	if !ok {
 		return nil, ErrSessionNotFound
 	}
 
-	return val.(session_client.SessionClient), nil
+	cli, ok := sess.sessions[val.(string)]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+
+	return cli, nil
 }
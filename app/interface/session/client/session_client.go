@@ -31,6 +31,8 @@ type SessionClient interface {
 	SessionPushUpdatesData(ctx context.Context, in *session.TLSessionPushUpdatesData) (*mtproto.Bool, error)
 	SessionPushSessionUpdatesData(ctx context.Context, in *session.TLSessionPushSessionUpdatesData) (*mtproto.Bool, error)
 	SessionPushRpcResultData(ctx context.Context, in *session.TLSessionPushRpcResultData) (*mtproto.Bool, error)
+	SessionGetAuthKeyExpiresAt(ctx context.Context, in *session.TLSessionGetAuthKeyExpiresAt) (*mtproto.Int32, error)
+	SessionDrainSessions(ctx context.Context, in *session.TLSessionDrainSessions) (*mtproto.Bool, error)
 }
 
 type defaultSessionClient struct {
@@ -51,7 +53,7 @@ func (m *defaultSessionClient) SessionQueryAuthKey(ctx context.Context, in *sess
 }
 
 // SessionSetAuthKey
-// session.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt = Bool;
+// session.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
 func (m *defaultSessionClient) SessionSetAuthKey(ctx context.Context, in *session.TLSessionSetAuthKey) (*mtproto.Bool, error) {
 	client := session.NewRPCSessionClient(m.cli.Conn())
 	return client.SessionSetAuthKey(ctx, in)
@@ -105,3 +107,17 @@ func (m *defaultSessionClient) SessionPushRpcResultData(ctx context.Context, in
 	client := session.NewRPCSessionClient(m.cli.Conn())
 	return client.SessionPushRpcResultData(ctx, in)
 }
+
+// SessionGetAuthKeyExpiresAt
+// session.getAuthKeyExpiresAt auth_key_id:long = Int32;
+func (m *defaultSessionClient) SessionGetAuthKeyExpiresAt(ctx context.Context, in *session.TLSessionGetAuthKeyExpiresAt) (*mtproto.Int32, error) {
+	client := session.NewRPCSessionClient(m.cli.Conn())
+	return client.SessionGetAuthKeyExpiresAt(ctx, in)
+}
+
+// SessionDrainSessions
+// session.drainSessions = Bool;
+func (m *defaultSessionClient) SessionDrainSessions(ctx context.Context, in *session.TLSessionDrainSessions) (*mtproto.Bool, error) {
+	client := session.NewRPCSessionClient(m.cli.Conn())
+	return client.SessionDrainSessions(ctx, in)
+}
@@ -20,6 +20,7 @@ type Config struct {
 	Cache           cache.CacheConf
 	AuthSession     zrpc.RpcClientConf
 	StatusClient    zrpc.RpcClientConf
+	UserClient      zrpc.RpcClientConf
 	GatewayClient   zrpc.RpcClientConf
 	BFFProxyClients conf.BFFProxyClients
 }
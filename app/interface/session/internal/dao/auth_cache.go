@@ -27,6 +27,7 @@ import (
 	"github.com/teamgram/marmota/pkg/hack"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -39,6 +40,8 @@ type cacheAuthValue struct {
 	langpack      string
 	SaltList      []*mtproto.TLFutureSalt
 	PermAuthKeyId int64
+	botUserId     int64 // the user isBot was resolved for
+	isBot         bool
 }
 
 // Size Impl cache.Value interface
@@ -171,6 +174,26 @@ func (d *Dao) GetCachePermAuthKeyId(ctx context.Context, authKeyId int64) int64
 	return cv.PermAuthKeyId
 }
 
+// GetCacheIsBot returns whether userId, the user bound to authKeyId, is a bot.
+func (d *Dao) GetCacheIsBot(ctx context.Context, authKeyId int64, userId int64) bool {
+	cv := d.getCacheValue(authKeyId)
+	if cv.botUserId != userId {
+		r, err := d.UserClient.UserIsBot(ctx, &user.TLUserIsBot{
+			Id: userId,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf(err.Error())
+			return false
+		}
+
+		// update to cache
+		cv.botUserId = userId
+		cv.isBot = mtproto.FromBool(r)
+	}
+
+	return cv.isBot
+}
+
 func (d *Dao) PutCacheApiLayer(ctx context.Context, authKeyId int64, layer int32) {
 	cv := d.getCacheValue(authKeyId)
 	cv.Layer = layer
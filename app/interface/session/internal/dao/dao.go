@@ -16,24 +16,30 @@ import (
 	bff_proxy_client "github.com/teamgram/teamgram-server/app/bff/bff/client"
 	"github.com/teamgram/teamgram-server/app/interface/session/internal/config"
 	authsession_client "github.com/teamgram/teamgram-server/app/service/authsession/client"
+	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	status_client "github.com/teamgram/teamgram-server/app/service/status/client"
 
+	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Dao struct {
 	cache *cache.LRUCache
+	kv    kv.Store
 	authsession_client.AuthsessionClient
 	status_client.StatusClient
+	user_client.UserClient
 	*bff_proxy_client.BFFProxyClient
 }
 
 func New(c config.Config) *Dao {
 	return &Dao{
 		cache:             cache.NewLRUCache(1024 * 1024 * 1024),
+		kv:                kv.NewStore(c.Cache),
 		AuthsessionClient: authsession_client.NewAuthsessionClient(zrpc.MustNewClient(c.AuthSession)),
 		BFFProxyClient:    bff_proxy_client.NewBFFProxyClients(c.BFFProxyClients.Clients, c.BFFProxyClients.IDMap),
 		StatusClient:      status_client.NewStatusClient(zrpc.MustNewClient(c.StatusClient)),
+		UserClient:        user_client.NewUserClient(zrpc.MustNewClient(c.UserClient)),
 	}
 }
 
@@ -0,0 +1,116 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/teamgram/teamgram-server/app/interface/session/internal/model"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	cacheAuthSessionsStatePrefix   = "session_handoff_state"
+	cacheAuthSessionsHandoffPrefix = "session_handoff_lock"
+)
+
+func genAuthSessionsStateKey(authKeyId int64) string {
+	return fmt.Sprintf("%s_%d", cacheAuthSessionsStatePrefix, authKeyId)
+}
+
+func genAuthSessionsHandoffKey(authKeyId int64) string {
+	return fmt.Sprintf("%s_%d", cacheAuthSessionsHandoffPrefix, authKeyId)
+}
+
+// LockCacheAuthSessionsHandoff tells the next owner of authKeyId to wait for
+// its state, the lock goes away with PutCacheAuthSessionsState or after expiredIn seconds.
+func (d *Dao) LockCacheAuthSessionsHandoff(ctx context.Context, authKeyId int64, expiredIn int) error {
+	key := genAuthSessionsHandoffKey(authKeyId)
+	if err := d.kv.SetexCtx(ctx, key, "1", expiredIn); err != nil {
+		logx.WithContext(ctx).Errorf("conn.SETEX(%s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+// PutCacheAuthSessionsState saves state for the next owner of its auth key and releases the handoff lock.
+func (d *Dao) PutCacheAuthSessionsState(ctx context.Context, state *model.AuthSessionsState, expiredIn int) error {
+	v, err := jsonx.MarshalToString(state)
+	if err != nil {
+		return err
+	}
+
+	key := genAuthSessionsStateKey(state.AuthKeyId)
+	if err = d.kv.SetexCtx(ctx, key, v, expiredIn); err != nil {
+		logx.WithContext(ctx).Errorf("conn.SETEX(%s) error(%v)", key, err)
+		return err
+	}
+
+	key = genAuthSessionsHandoffKey(state.AuthKeyId)
+	if _, err = d.kv.DelCtx(ctx, key); err != nil {
+		logx.WithContext(ctx).Errorf("conn.DEL(%s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+// TakeCacheAuthSessionsState returns and removes the state handed over for authKeyId,
+// pending is true while the previous owner is still handing it over.
+func (d *Dao) TakeCacheAuthSessionsState(ctx context.Context, authKeyId int64) (state *model.AuthSessionsState, pending bool, err error) {
+	var (
+		key = genAuthSessionsStateKey(authKeyId)
+		v   string
+	)
+
+	for i := 0; i < 2; i++ {
+		v, err = d.kv.GetCtx(ctx, key)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", key, err)
+			return
+		} else if v != "" {
+			break
+		} else if i > 0 {
+			return
+		}
+
+		// the state is saved before the lock is released, look again once the lock is gone.
+		pending, err = d.kv.ExistsCtx(ctx, genAuthSessionsHandoffKey(authKeyId))
+		if err != nil || pending {
+			return
+		}
+	}
+
+	if _, err = d.kv.DelCtx(ctx, key); err != nil {
+		logx.WithContext(ctx).Errorf("conn.DEL(%s) error(%v)", key, err)
+		return
+	}
+
+	state = new(model.AuthSessionsState)
+	if err = jsonx.UnmarshalFromString(v, state); err != nil {
+		logx.WithContext(ctx).Errorf("jsonx.UnmarshalFromString(%s) error(%v)", v, err)
+		return nil, false, err
+	}
+
+	return
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// AuthSessionsState is what a session server hands over to the next owner of
+// an auth key, it lives in the cache between the two servers.
+type AuthSessionsState struct {
+	AuthKeyId     int64                 `json:"auth_key_id"`
+	PermAuthKeyId int64                 `json:"perm_auth_key_id"`
+	Layer         int32                 `json:"layer"`
+	Client        string                `json:"client"`
+	Langpack      string                `json:"langpack"`
+	AuthUserId    int64                 `json:"auth_user_id"`
+	CacheSalt     *mtproto.TLFutureSalt `json:"cache_salt"`
+	CacheLastSalt *mtproto.TLFutureSalt `json:"cache_last_salt"`
+	PushSessionId int64                 `json:"push_session_id"`
+	NextNotifyId  int64                 `json:"next_notify_id"`
+	NextPushId    int64                 `json:"next_push_id"`
+	Sessions      []*SessionState       `json:"sessions"`
+}
+
+// SessionState is a session of an auth key, with the msg ids it has received
+// and the messages not acked by the client yet.
+type SessionState struct {
+	SessionId     int64              `json:"session_id"`
+	SessionState  int                `json:"session_state"`
+	GatewayIdList []string           `json:"gateway_id_list"`
+	NextSeqNo     uint32             `json:"next_seq_no"`
+	FirstMsgId    int64              `json:"first_msg_id"`
+	ConnState     int                `json:"conn_state"`
+	CloseDate     int64              `json:"close_date"`
+	IsAndroidPush bool               `json:"is_android_push"`
+	IsGeneric     bool               `json:"is_generic"`
+	InMinMsgId    int64              `json:"in_min_msg_id"`
+	InMaxMsgId    int64              `json:"in_max_msg_id"`
+	InMsgs        []*InboxMsgState   `json:"in_msgs"`
+	OutMsgs       []*OutboxMsgState  `json:"out_msgs"`
+	AckedIdList   []int64            `json:"acked_id_list"`
+	PendingRpcs   []*PendingRpcState `json:"pending_rpcs"`
+}
+
+type InboxMsgState struct {
+	MsgId int64 `json:"msg_id"`
+	SeqNo int32 `json:"seq_no"`
+	State byte  `json:"state"`
+}
+
+type OutboxMsgState struct {
+	MsgId int64                     `json:"msg_id"`
+	Sent  int64                     `json:"sent"`
+	State byte                      `json:"state"`
+	Msg   *mtproto.TLMessageRawData `json:"msg"`
+}
+
+// PendingRpcState is a request whose rpc_result is pushed later by sync.
+type PendingRpcState struct {
+	MsgId int64 `json:"msg_id"`
+	Date  int64 `json:"date"`
+}
@@ -24,6 +24,7 @@ var configFile = flag.String("f", "etc/session.yaml", "the config file")
 
 type Server struct {
 	grpcSrv *zrpc.RpcServer
+	svc     *service.Service
 }
 
 func New() *Server {
@@ -36,7 +37,8 @@ func (s *Server) Initialize() error {
 
 	logx.Infov(c)
 	// ctx := svc.NewServiceContext(c)
-	s.grpcSrv = grpc.New(c.RpcServerConf, service.New(c))
+	s.svc = service.New(c)
+	s.grpcSrv = grpc.New(c.RpcServerConf, s.svc)
 
 	go func() {
 		s.grpcSrv.Start()
@@ -48,5 +50,7 @@ func (s *Server) RunLoop() {
 }
 
 func (s *Server) Destroy() {
+	// hand the auth keys over before leaving the ring
+	s.svc.Drain()
 	s.grpcSrv.Stop()
 }
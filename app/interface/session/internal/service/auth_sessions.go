@@ -108,7 +108,7 @@ type connData struct {
 }
 
 func (c *connData) DebugString() string {
-	return fmt.Sprintf("{isNew: %d, gatewayId: %s, sessionId: %d}", c.isNew, c.gatewayId, c.sessionId)
+	return fmt.Sprintf("{isNew: %v, gatewayId: %s, sessionId: %d}", c.isNew, c.gatewayId, c.sessionId)
 }
 
 ///////////////////////////////////////////////////////////////////////////////////
@@ -148,6 +148,9 @@ type authSessions struct {
 	clientType      int
 	nextNotifyId    int64
 	nextPushId      int64
+	inflight        int // rpc requests waiting for their results
+	handoffReq      *handoffData
+	handoffDeadline int64
 	*Service
 }
 
@@ -225,6 +228,13 @@ func (s *authSessions) setUserId(userId int64) {
 	s.onBindUser(userId)
 }
 
+func (s *authSessions) isBot() bool {
+	if s.AuthUserId == 0 {
+		return false
+	}
+	return s.Dao.GetCacheIsBot(context.Background(), s.authKeyId, s.AuthUserId)
+}
+
 func (s *authSessions) getCacheSalt() *mtproto.TLFutureSalt {
 	return s.cacheSalt
 }
@@ -283,6 +293,7 @@ func (s *authSessions) destroySession(sessionId int64) bool {
 }
 
 func (s *authSessions) sendToRpcQueue(rpcMessage *rpcApiMessage) {
+	s.inflight++
 	s.rpcQueue.Push(rpcMessage)
 }
 
@@ -413,6 +424,8 @@ func (s *authSessions) runLoop() {
 		s.finish.Wait()
 	}()
 
+	s.loadHandoffState()
+
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 
@@ -440,12 +453,16 @@ func (s *authSessions) runLoop() {
 				} else {
 					s.onSessionClosed(sessionMsg.(*connData))
 				}
+			case *handoffData:
+				s.onHandoff(sessionMsg.(*handoffData))
 			default:
 				panic("receive invalid type msg")
 			}
 		case rpcMessages, _ := <-s.rpcDataChan:
 			result, _ := rpcMessages.(*rpcApiMessage)
+			s.inflight--
 			s.onRpcResult(result)
+			s.tryFinishHandoff()
 		// case <-time.After(100 * time.Millisecond):
 		case <-ticker.C:
 			s.onTimer()
@@ -472,6 +489,11 @@ func (s *authSessions) rpcRunLoop() {
 }
 
 func (s *authSessions) onTimer() {
+	if s.handoffReq != nil {
+		s.tryFinishHandoff()
+		return
+	}
+
 	for _, sess := range s.sessions {
 		if (sess.isGeneric && sess.sessionOnline()) ||
 			sess.isAndroidPush && sess.sessionOnline() {
@@ -583,7 +605,7 @@ func (s *authSessions) onSessionData(sessionMsg *sessionData) {
 	if err != nil {
 		// TODO(@benqi): close frontend conn??
 		// log.Error(err)
-		logx.Errorf("onSessionData - error: {%s}, data: {sessions: %s, gate_id: %d}", err, s, sessionMsg.gatewayId)
+		logx.Errorf("onSessionData - error: {%s}, data: {sessions: %s, gate_id: %s}", err, s, sessionMsg.gatewayId)
 		return
 	}
 
@@ -624,7 +646,7 @@ func (s *authSessions) onSessionHttpData(sessionMsg *sessionHttpData) {
 	if err != nil {
 		// TODO(@benqi): close frontend conn??
 		// log.Error(err)
-		logx.Errorf("onSessionData - error: {%s}, data: {sessions: %s, gate_id: %d}", err, s, sessionMsg.gatewayId)
+		logx.Errorf("onSessionData - error: {%s}, data: {sessions: %s, gate_id: %s}", err, s, sessionMsg.gatewayId)
 		return
 	}
 
@@ -687,9 +709,9 @@ func (s *authSessions) onSyncData(syncMsg *syncData) {
 	logx.Info("authSessions - ", reflect.TypeOf(syncMsg.data.obj))
 	if upds, ok := syncMsg.data.obj.(*mtproto.Updates); ok {
 		if upds.PredicateName == mtproto.Predicate_updateAccountResetAuthorization {
-			logx.Infof("recv updateAccountResetAuthorization - ", reflect.TypeOf(syncMsg.data.obj))
+			logx.Infof("recv updateAccountResetAuthorization - %v", reflect.TypeOf(syncMsg.data.obj))
 			if s.AuthUserId != upds.GetUserId() {
-				logx.Errorf("upds -- ", upds)
+				logx.Errorf("upds -- %v", upds)
 			}
 			s.Dao.PutCacheUserId(context.Background(), s.authKeyId, 0)
 			s.DeleteByAuthKeyId(s.authKeyId)
@@ -732,7 +754,7 @@ func (s *authSessions) onRpcResult(rpcResult *rpcApiMessage) {
 		// log.Debugf("onRpcResult result: %s", rpcResult.DebugString())
 		sess.onRpcResult(rpcResult)
 	} else {
-		logx.Errorf("onRpcResult - not found rpcSession by sessionId: ", rpcResult.sessionId)
+		logx.Errorf("onRpcResult - not found rpcSession by sessionId: %d", rpcResult.sessionId)
 	}
 }
 
@@ -755,6 +777,7 @@ func (s *authSessions) onRpcRequest(request *rpcApiMessage) bool {
 		Client:        s.getClient(),
 		Langpack:      s.getLangpack(),
 		PermAuthKeyId: s.getPermAuthKeyId(),
+		IsBot:         s.isBot(),
 	}
 
 	// TODO(@benqi): change state.
@@ -768,10 +791,20 @@ func (s *authSessions) onRpcRequest(request *rpcApiMessage) bool {
 				Nonce:            r.Nonce,
 				ExpiresAt:        r.ExpiresAt,
 				EncryptedMessage: r.EncryptedMessage,
+				TempAuthKeyId:    s.authKeyId,
+				TempSessionId:    request.sessionId,
+				MsgId:            request.reqMsgId,
 			})
-		s.Dao.PutCachePermAuthKeyId(context.Background(), s.authKeyId, r.PermAuthKeyId)
+		if err == nil {
+			// user, layer and client lookups of this temp key now resolve through the perm key
+			s.setPermAuthKeyId(r.PermAuthKeyId)
+		}
 	default:
-		rpcResult, err = s.Service.Dao.Invoke(rpcMetadata, request.reqMsg)
+		if rpcMetadata.IsBot && checkRpcForbiddenForBot(request.reqMsg) {
+			err = mtproto.ErrBotMethodInvalid
+		} else {
+			rpcResult, err = s.Service.Dao.Invoke(rpcMetadata, request.reqMsg)
+		}
 	}
 
 	reply := &mtproto.TLRpcResult{
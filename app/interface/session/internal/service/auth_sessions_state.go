@@ -0,0 +1,271 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/interface/session/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// handoffTimeout is how long the previous owner waits for in-flight rpc requests,
+	// and how long the next owner waits for the state being handed over.
+	handoffTimeout = 5
+	// handoffGrace is how long the next owner of a key that just moved waits for
+	// its previous owner to start handing it over.
+	handoffGrace = 1
+
+	handoffLockExpiredIn  = handoffTimeout * 2
+	handoffStateExpiredIn = 60
+)
+
+type handoffData struct {
+	done chan struct{}
+}
+
+// handoff hands this auth key over to its next owner, done is closed once its
+// state is in the cache. done is nil if the key was too busy to take the request before ctx is done.
+func (s *authSessions) handoff(ctx context.Context) (done chan struct{}) {
+	done = make(chan struct{})
+	select {
+	case s.sessionDataChan <- &handoffData{done: done}:
+		return done
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+func (s *authSessions) onHandoff(h *handoffData) {
+	logx.Infof("onHandoff - handoff: %s, inflight: %d", s, s.inflight)
+
+	if s.handoffReq != nil {
+		close(h.done)
+		return
+	}
+	s.handoffReq = h
+	s.handoffDeadline = time.Now().Unix() + handoffTimeout
+
+	if err := s.Dao.LockCacheAuthSessionsHandoff(context.Background(), s.authKeyId, handoffLockExpiredIn); err != nil {
+		logx.Errorf("onHandoff - lock error: %v, sess: %s", err, s)
+	}
+
+	s.tryFinishHandoff()
+}
+
+// tryFinishHandoff saves the state once the rpc results this key waits for arrived,
+// the key stays online, the next owner takes it over.
+func (s *authSessions) tryFinishHandoff() {
+	if s.handoffReq == nil {
+		return
+	}
+
+	if s.inflight > 0 && time.Now().Unix() < s.handoffDeadline {
+		return
+	}
+
+	if s.inflight > 0 {
+		logx.Errorf("tryFinishHandoff - timeout, sess: %s, inflight: %d", s, s.inflight)
+	}
+
+	if err := s.Dao.PutCacheAuthSessionsState(context.Background(), s.toState(), handoffStateExpiredIn); err != nil {
+		logx.Errorf("tryFinishHandoff - put state error: %v, sess: %s", err, s)
+	}
+
+	s.running.Set(0)
+	s.rpcQueue.Close()
+	close(s.handoffReq.done)
+}
+
+// loadHandoffState restores the state the previous owner of this key handed over.
+func (s *authSessions) loadHandoffState() {
+	var (
+		now      = time.Now().Unix()
+		deadline = now + handoffTimeout
+		grace    int64
+	)
+
+	if s.Service.isMovedRecently(s.authKeyId) {
+		grace = now + handoffGrace
+	}
+
+	for {
+		state, pending, err := s.Dao.TakeCacheAuthSessionsState(context.Background(), s.authKeyId)
+		if err != nil {
+			return
+		} else if state != nil {
+			logx.Infof("loadHandoffState - restore: %s, sessions: %d", s, len(state.Sessions))
+			s.fromState(state)
+			return
+		}
+
+		now = time.Now().Unix()
+		if !pending && now >= grace {
+			return
+		} else if now >= deadline {
+			logx.Errorf("loadHandoffState - timeout: %s", s)
+			return
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (s *authSessions) toState() *model.AuthSessionsState {
+	state := &model.AuthSessionsState{
+		AuthKeyId:     s.authKeyId,
+		PermAuthKeyId: s.permAuthKeyId,
+		Layer:         s.Layer,
+		Client:        s.Client,
+		Langpack:      s.Langpack,
+		AuthUserId:    s.AuthUserId,
+		CacheSalt:     s.cacheSalt,
+		CacheLastSalt: s.cacheLastSalt,
+		PushSessionId: s.pushSessionId,
+		NextNotifyId:  s.nextNotifyId,
+		NextPushId:    s.nextPushId,
+		Sessions:      make([]*model.SessionState, 0, len(s.sessions)),
+	}
+
+	for _, sess := range s.sessions {
+		state.Sessions = append(state.Sessions, sess.toState())
+	}
+
+	return state
+}
+
+func (s *authSessions) fromState(state *model.AuthSessionsState) {
+	s.permAuthKeyId = state.PermAuthKeyId
+	s.Layer = state.Layer
+	s.Client = state.Client
+	s.Langpack = state.Langpack
+	s.AuthUserId = state.AuthUserId
+	s.cacheSalt = state.CacheSalt
+	s.cacheLastSalt = state.CacheLastSalt
+	s.pushSessionId = state.PushSessionId
+	s.nextNotifyId = state.NextNotifyId
+	s.nextPushId = state.NextPushId
+	if s.AuthUserId != 0 {
+		s.state = userIdLoaded
+	}
+
+	for _, sessState := range state.Sessions {
+		sess := newSession(sessState.SessionId, s)
+		sess.fromState(sessState)
+		s.sessions[sess.sessionId] = sess
+	}
+}
+
+func (c *session) toState() *model.SessionState {
+	state := &model.SessionState{
+		SessionId:     c.sessionId,
+		SessionState:  c.sessionState,
+		GatewayIdList: make([]string, 0, len(c.gatewayIdList)),
+		NextSeqNo:     c.nextSeqNo,
+		FirstMsgId:    c.firstMsgId,
+		ConnState:     c.connState,
+		CloseDate:     c.closeDate,
+		IsAndroidPush: c.isAndroidPush,
+		IsGeneric:     c.isGeneric,
+		InMinMsgId:    c.inQueue.minMsgId,
+		InMaxMsgId:    c.inQueue.maxMsgId,
+		InMsgs:        make([]*model.InboxMsgState, 0, c.inQueue.msgIds.Len()),
+		OutMsgs:       make([]*model.OutboxMsgState, 0, c.outQueue.oMsgs.Len()),
+		AckedIdList:   c.outQueue.ackedIdList,
+		PendingRpcs:   make([]*model.PendingRpcState, 0, c.pendingQueue.q.Len()),
+	}
+
+	for _, id := range c.gatewayIdList {
+		state.GatewayIdList = append(state.GatewayIdList, id.gatewayId)
+	}
+	for e := c.inQueue.msgIds.Front(); e != nil; e = e.Next() {
+		iMsg := e.Value.(*inboxMsg)
+		state.InMsgs = append(state.InMsgs, &model.InboxMsgState{
+			MsgId: iMsg.msgId,
+			SeqNo: iMsg.seqNo,
+			State: iMsg.state,
+		})
+	}
+	for e := c.outQueue.oMsgs.Front(); e != nil; e = e.Next() {
+		oMsg := e.Value.(*outboxMsg)
+		state.OutMsgs = append(state.OutMsgs, &model.OutboxMsgState{
+			MsgId: oMsg.msgId,
+			Sent:  oMsg.sent,
+			State: oMsg.state,
+			Msg:   oMsg.msg,
+		})
+	}
+	for e := c.pendingQueue.q.Front(); e != nil; e = e.Next() {
+		w := e.Value.(*rpcResultWaiting)
+		state.PendingRpcs = append(state.PendingRpcs, &model.PendingRpcState{
+			MsgId: w.msgId,
+			Date:  w.date,
+		})
+	}
+
+	return state
+}
+
+func (c *session) fromState(state *model.SessionState) {
+	now := time.Now().Unix()
+
+	c.sessionState = state.SessionState
+	c.nextSeqNo = state.NextSeqNo
+	c.firstMsgId = state.FirstMsgId
+	c.connState = state.ConnState
+	c.closeDate = state.CloseDate
+	c.isAndroidPush = state.IsAndroidPush
+	c.isGeneric = state.IsGeneric
+
+	for _, id := range state.GatewayIdList {
+		c.gatewayIdList = append(c.gatewayIdList, serverIdCtx{gatewayId: id, lastReceiveTime: now})
+	}
+
+	c.inQueue.minMsgId = state.InMinMsgId
+	c.inQueue.maxMsgId = state.InMaxMsgId
+	for _, iMsg := range state.InMsgs {
+		c.inQueue.msgIds.PushBack(&inboxMsg{
+			msgId: iMsg.MsgId,
+			seqNo: iMsg.SeqNo,
+			state: iMsg.State,
+		})
+	}
+
+	for _, oMsg := range state.OutMsgs {
+		c.outQueue.oMsgs.PushBack(&outboxMsg{
+			msgId: oMsg.MsgId,
+			sent:  oMsg.Sent,
+			state: oMsg.State,
+			msg:   oMsg.Msg,
+		})
+	}
+	if len(state.AckedIdList) > 0 {
+		c.outQueue.ackedIdList = state.AckedIdList
+	}
+
+	for _, w := range state.PendingRpcs {
+		c.pendingQueue.q.PushBack(&rpcResultWaiting{
+			msgId: w.MsgId,
+			date:  w.Date,
+		})
+	}
+}
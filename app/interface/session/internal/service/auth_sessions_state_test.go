@@ -0,0 +1,163 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/session/internal/model"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// newTestAuthSessions is an authSessions that is not started.
+func newTestAuthSessions(authKeyId int64, s2 *Service) *authSessions {
+	return &authSessions{
+		authKeyId:       authKeyId,
+		sessions:        make(map[int64]*session),
+		closeChan:       make(chan struct{}),
+		sessionDataChan: make(chan interface{}),
+		rpcDataChan:     make(chan interface{}, 1024),
+		clientType:      clientUnknown,
+		nextNotifyId:    math.MaxInt32,
+		Service:         s2,
+	}
+}
+
+func sortedState(state *model.AuthSessionsState) *model.AuthSessionsState {
+	sort.Slice(state.Sessions, func(i, j int) bool {
+		return state.Sessions[i].SessionId < state.Sessions[j].SessionId
+	})
+	return state
+}
+
+func TestAuthSessionsStateRoundTrip(t *testing.T) {
+	s := newTestAuthSessions(1001, nil)
+	s.permAuthKeyId = 1000
+	s.Layer = 158
+	s.Client = "android"
+	s.Langpack = "en"
+	s.AuthUserId = 136817688
+	s.cacheSalt = mtproto.MakeTLFutureSalt(&mtproto.FutureSalt{ValidSince: 1700000000, ValidUntil: 1700001800, Salt: 0x1122334455667788})
+	s.cacheLastSalt = mtproto.MakeTLFutureSalt(&mtproto.FutureSalt{ValidSince: 1699998200, ValidUntil: 1700000000, Salt: 0x0102030405060708})
+	s.pushSessionId = 2002
+	s.nextNotifyId = math.MaxInt32 - 3
+	s.nextPushId = 7
+
+	for _, sessionId := range []int64{2001, 2002} {
+		sess := newSession(sessionId, s)
+		sess.sessionState = kSessionStateCreated
+		sess.nextSeqNo = 9
+		sess.firstMsgId = 0x6000000000000000 + sessionId
+		sess.connState = kStateOnline
+		sess.isGeneric = true
+		sess.isAndroidPush = sessionId == s.pushSessionId
+		sess.addGatewayId("gateway-1")
+		sess.addGatewayId("gateway-2")
+
+		// inQueue: two requests received, one acked
+		sess.inQueue.AddMsgId(sess.firstMsgId + 4)
+		sess.inQueue.AddMsgId(sess.firstMsgId + 8)
+		sess.inQueue.ChangeAckReceived(sess.firstMsgId + 4)
+
+		// outQueue: a rpc result and a push not acked yet
+		sess.outQueue.AddRpcResultMsg(sess.firstMsgId+4, &mtproto.TLMessageRawData{MsgId: sess.firstMsgId + 5, Seqno: 3, Bytes: 4, ClassId: 0x3072cfa1, Body: []byte{1, 2, 3, 4}})
+		sess.outQueue.AddPushUpdates(1, &mtproto.TLMessageRawData{MsgId: sess.firstMsgId + 9, Seqno: 5, Bytes: 2, Body: []byte{5, 6}})
+		sess.outQueue.ackedIdList = append(sess.outQueue.ackedIdList, sess.firstMsgId+1)
+
+		// the rpc result of the second request is still pending
+		sess.pendingQueue.Add(sess.firstMsgId + 8)
+
+		s.sessions[sessionId] = sess
+	}
+
+	state := sortedState(s.toState())
+
+	// through the cache
+	v, err := jsonx.MarshalToString(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cached *model.AuthSessionsState
+	if err = jsonx.UnmarshalFromString(v, &cached); err != nil {
+		t.Fatal(err)
+	}
+
+	s2 := newTestAuthSessions(1001, nil)
+	s2.fromState(cached)
+
+	if s2.state != userIdLoaded {
+		t.Errorf("state = %d, want userIdLoaded", s2.state)
+	}
+	if len(s2.sessions) != len(s.sessions) {
+		t.Fatalf("restored %d sessions, want %d", len(s2.sessions), len(s.sessions))
+	}
+	if got := sortedState(s2.toState()); !reflect.DeepEqual(got, state) {
+		got, _ := jsonx.MarshalToString(got)
+		t.Fatalf("restored state\n%s\nwant\n%s", got, v)
+	}
+
+	// the restored queues work like the handed over ones
+	for sessionId, sess := range s.sessions {
+		sess2 := s2.sessions[sessionId]
+		if sess2.authSessions != s2 || sess2.cb != s2 {
+			t.Errorf("session %d does not belong to the restored key", sessionId)
+		}
+		if iMsg := sess2.inQueue.Lookup(sess.firstMsgId + 8); iMsg == nil {
+			t.Errorf("session %d lost in msg %d", sessionId, sess.firstMsgId+8)
+		}
+		if oMsg := sess2.outQueue.Lookup(sess.firstMsgId + 4); oMsg == nil || oMsg.msg.MsgId != sess.firstMsgId+5 {
+			t.Errorf("session %d lost the rpc result of %d", sessionId, sess.firstMsgId+4)
+		}
+		if sess2.pendingQueue.q.Len() != 1 {
+			t.Errorf("session %d has %d pending rpcs, want 1", sessionId, sess2.pendingQueue.q.Len())
+		}
+	}
+	if s2.cacheSalt.GetSalt() != s.cacheSalt.GetSalt() || s2.cacheLastSalt.GetValidUntil() != s.cacheLastSalt.GetValidUntil() {
+		t.Errorf("salts %v %v, want %v %v", s2.cacheSalt, s2.cacheLastSalt, s.cacheSalt, s.cacheLastSalt)
+	}
+}
+
+func TestHandoffCanceled(t *testing.T) {
+	// nobody reads sessionDataChan, the loop of the key is busy
+	s := newTestAuthSessions(1001, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if done := s.handoff(ctx); done != nil {
+		t.Fatal("handoff of a busy key - got done, want nil")
+	}
+
+	go func() {
+		h := (<-s.sessionDataChan).(*handoffData)
+		close(h.done)
+	}()
+	done := s.handoff(context.Background())
+	if done == nil {
+		t.Fatal("handoff - got nil")
+	}
+	<-done
+}
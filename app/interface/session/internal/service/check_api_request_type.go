@@ -62,8 +62,8 @@ import (
 func checkRpcWithoutLogin(tl mtproto.TLObject) bool {
 	switch tl.(type) {
 	// account
-	// case *mtproto.TLAccountGetPassword:
-	//	return true
+	case *mtproto.TLAccountGetPassword:
+		return true
 
 	// auth
 	case *mtproto.TLAuthSendCode,
@@ -74,9 +74,12 @@ func checkRpcWithoutLogin(tl mtproto.TLObject) bool {
 		*mtproto.TLAuthExportedAuthorization,
 		*mtproto.TLAuthExportAuthorization,
 		*mtproto.TLAuthImportAuthorization,
+		*mtproto.TLAuthImportBotAuthorization,
 		*mtproto.TLAuthCancelCode,
-		// *mtproto.TLAuthRequestPasswordRecovery,	// TODO: before process, try fetch usrId
-		// *mtproto.TLAuthRecoverPassword,			// TODO: before process, try fetch usrId
+		*mtproto.TLAuthCheckPassword,
+		*mtproto.TLAuthRequestPasswordRecovery,
+		*mtproto.TLAuthCheckRecoveryPassword,
+		*mtproto.TLAuthRecoverPassword,
 		*mtproto.TLAuthExportLoginToken,
 		*mtproto.TLAuthAcceptLoginToken,
 		*mtproto.TLAuthLogOut, // TODO: before process, try fetch usrId
@@ -115,3 +118,83 @@ func checkRpcWithoutLogin(tl mtproto.TLObject) bool {
 		return false
 	}
 }
+
+// checkRpcForbiddenForBot returns true if tl is a user only method, bots calling it get BOT_METHOD_INVALID.
+func checkRpcForbiddenForBot(tl mtproto.TLObject) bool {
+	switch tl.(type) {
+	// account
+	case *mtproto.TLAccountRegisterDevice,
+		*mtproto.TLAccountUnregisterDevice,
+		*mtproto.TLAccountGetNotifySettings,
+		*mtproto.TLAccountUpdateNotifySettings,
+		*mtproto.TLAccountResetNotifySettings,
+		*mtproto.TLAccountUpdateUsername,
+		*mtproto.TLAccountCheckUsername,
+		*mtproto.TLAccountGetPrivacy,
+		*mtproto.TLAccountSetPrivacy,
+		*mtproto.TLAccountDeleteAccount,
+		*mtproto.TLAccountGetAccountTTL,
+		*mtproto.TLAccountSetAccountTTL,
+		*mtproto.TLAccountSendChangePhoneCode,
+		*mtproto.TLAccountChangePhone,
+		*mtproto.TLAccountGetAuthorizations,
+		*mtproto.TLAccountResetAuthorization,
+		*mtproto.TLAccountGetPassword,
+		*mtproto.TLAccountUpdatePasswordSettings:
+		return true
+
+	// auth
+	case *mtproto.TLAuthSendCode,
+		*mtproto.TLAuthResendCode,
+		*mtproto.TLAuthSignUp,
+		*mtproto.TLAuthSignIn,
+		*mtproto.TLAuthCheckPassword,
+		*mtproto.TLAuthExportLoginToken,
+		*mtproto.TLAuthImportLoginToken,
+		*mtproto.TLAuthAcceptLoginToken,
+		*mtproto.TLAuthResetAuthorizations:
+		return true
+
+	// contacts
+	case *mtproto.TLContactsGetContacts,
+		*mtproto.TLContactsImportContacts,
+		*mtproto.TLContactsDeleteContacts,
+		*mtproto.TLContactsAddContact,
+		*mtproto.TLContactsSearch,
+		*mtproto.TLContactsGetTopPeers,
+		*mtproto.TLContactsGetStatuses,
+		*mtproto.TLContactsBlock,
+		*mtproto.TLContactsUnblock,
+		*mtproto.TLContactsGetBlocked:
+		return true
+
+	// messages
+	case *mtproto.TLMessagesGetDialogs,
+		*mtproto.TLMessagesGetPeerDialogs,
+		*mtproto.TLMessagesGetPinnedDialogs,
+		*mtproto.TLMessagesToggleDialogPin,
+		*mtproto.TLMessagesReorderPinnedDialogs,
+		*mtproto.TLMessagesGetHistory,
+		*mtproto.TLMessagesSearch,
+		*mtproto.TLMessagesSearchGlobal,
+		*mtproto.TLMessagesReadHistory,
+		*mtproto.TLMessagesDeleteHistory,
+		*mtproto.TLMessagesGetAllDrafts,
+		*mtproto.TLMessagesSaveDraft,
+		*mtproto.TLMessagesRequestEncryption,
+		*mtproto.TLMessagesAcceptEncryption,
+		*mtproto.TLMessagesCreateChat,
+		*mtproto.TLMessagesImportChatInvite,
+		*mtproto.TLMessagesCheckChatInvite:
+		return true
+
+	// channels
+	case *mtproto.TLChannelsCreateChannel,
+		*mtproto.TLChannelsJoinChannel,
+		*mtproto.TLChannelsReadHistory:
+		return true
+
+	default:
+		return false
+	}
+}
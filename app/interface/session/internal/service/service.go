@@ -24,6 +24,7 @@ import (
 	"github.com/teamgram/marmota/pkg/net/ip"
 	"sync"
 
+	"github.com/teamgram/marmota/pkg/sync2"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/interface/session/internal/config"
 	"github.com/teamgram/teamgram-server/app/interface/session/internal/dao"
@@ -53,6 +54,8 @@ type Service struct {
 	eGateServers    map[string]*Gateway
 	reqCache        *RequestManager
 	serverId        string
+	sessionRing     *sessionRing
+	draining        sync2.AtomicBool
 	*dao.Dao
 }
 
@@ -75,8 +78,10 @@ func New(c config.Config) *Service {
 	s.eGateServers = make(map[string]*Gateway)
 	s.reqCache = NewRequestManager()
 	s.serverId = ip.FigureOutListenOn(c.ListenOn)
+	s.sessionRing = newSessionRing()
 
 	s.watchGateway(s.ac.GatewayClient)
+	s.watchSessions(s.ac.RpcServerConf)
 	return s
 }
 
@@ -149,7 +154,7 @@ func (s *Service) watchGateway(c zrpc.RpcClientConf) {
 			// cli, err := zrpc.NewClient(c)
 			cli, err := NewGateway(c)
 			if err != nil {
-				logx.Error("watchComet NewClient(%+v) error(%v)", values, err)
+				logx.Errorf("watchComet NewClient(%+v) error(%v)", values, err)
 				return
 			}
 			clients[v] = cli
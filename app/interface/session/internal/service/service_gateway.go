@@ -38,6 +38,10 @@ func (s *Service) SessionCreateSession(ctx context.Context, r *sessionpb.TLSessi
 
 	logx.WithContext(ctx).Infof("createSession - request: %s", r.DebugString())
 
+	if cli := s.getOwnerClient(ctx, c.GetAuthKeyId()); cli != nil {
+		return cli.SessionCreateSession(s.forwardContext(ctx), r)
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -63,6 +67,10 @@ func (s *Service) SessionCloseSession(ctx context.Context, r *sessionpb.TLSessio
 
 	logx.WithContext(ctx).Infof("closeSession - request: %s", r.DebugString())
 
+	if cli := s.getOwnerClient(ctx, c.GetAuthKeyId()); cli != nil {
+		return cli.SessionCloseSession(s.forwardContext(ctx), r)
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -82,6 +90,10 @@ func (s *Service) SessionSendDataToSession(ctx context.Context, r *sessionpb.TLS
 		data     = r.GetData()
 	)
 
+	if cli := s.getOwnerClient(ctx, data.GetAuthKeyId()); cli != nil {
+		return cli.SessionSendDataToSession(s.forwardContext(ctx), r)
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -179,3 +191,9 @@ func (s *Service) SessionSetAuthKey(ctx context.Context, r *sessionpb.TLSessionS
 		ExpiresIn:  r.ExpiresIn,
 	})
 }
+
+func (s *Service) SessionGetAuthKeyExpiresAt(ctx context.Context, r *sessionpb.TLSessionGetAuthKeyExpiresAt) (*mtproto.Int32, error) {
+	return s.Dao.AuthsessionClient.AuthsessionGetAuthKeyExpiresAt(ctx, &authsession.TLAuthsessionGetAuthKeyExpiresAt{
+		AuthKeyId: r.AuthKeyId,
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	session_client "github.com/teamgram/teamgram-server/app/interface/session/client"
+	sessionpb "github.com/teamgram/teamgram-server/app/interface/session/session"
+
+	"github.com/zeromicro/go-zero/core/discov"
+	"github.com/zeromicro/go-zero/core/hash"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Gateways route an auth key to a session server with a consistent hash over the
+// session servers registered in etcd, every session server builds the same ring.
+// When the ring changes the keys a server no longer owns are handed over to their
+// next owner through the cache, requests for them are forwarded to it meanwhile.
+
+const (
+	// forwardedKey marks a request forwarded by another session server,
+	// it is handled where it arrives and never forwarded twice.
+	forwardedKey = "session-forwarded"
+
+	drainTimeout = handoffTimeout * 3
+)
+
+type sessionRing struct {
+	mu        sync.RWMutex
+	nodes     []string
+	ring      *hash.ConsistentHash
+	prevRing  *hash.ConsistentHash
+	changedAt int64
+	peers     map[string]session_client.SessionClient
+}
+
+func newSessionRing() *sessionRing {
+	return &sessionRing{
+		ring:     hash.NewConsistentHash(),
+		prevRing: hash.NewConsistentHash(),
+		peers:    make(map[string]session_client.SessionClient),
+	}
+}
+
+func (s *Service) watchSessions(c zrpc.RpcServerConf) {
+	if !c.HasEtcd() {
+		return
+	}
+
+	sub, _ := discov.NewSubscriber(c.Etcd.Hosts, c.Etcd.Key)
+	update := func() {
+		values := sub.Values()
+		if len(values) == 0 {
+			return
+		}
+
+		peers := map[string]session_client.SessionClient{}
+		for _, v := range values {
+			if v == s.serverId {
+				continue
+			}
+			if old, ok := s.sessionRing.peers[v]; ok {
+				peers[v] = old
+				continue
+			}
+			cli, err := zrpc.NewClient(zrpc.RpcClientConf{
+				Endpoints: []string{v},
+				NonBlock:  true,
+				Timeout:   c.Timeout,
+			})
+			if err != nil {
+				logx.Errorf("watchSessions NewClient(%s) error(%v)", v, err)
+				return
+			}
+			peers[v] = session_client.NewSessionClient(cli)
+		}
+
+		r := s.sessionRing
+		r.mu.Lock()
+		r.nodes = values
+		r.peers = peers
+		s.rebuildRingLocked()
+		r.mu.Unlock()
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), drainTimeout*time.Second)
+			defer cancel()
+			s.rebalance(ctx)
+		}()
+	}
+
+	sub.AddListener(update)
+	update()
+}
+
+func (s *Service) rebuildRingLocked() {
+	r := s.sessionRing
+	ring := hash.NewConsistentHash()
+	for _, v := range r.nodes {
+		// a draining server takes no keys
+		if v == s.serverId && s.draining.Get() {
+			continue
+		}
+		ring.Add(v)
+	}
+
+	r.prevRing = r.ring
+	r.ring = ring
+	r.changedAt = time.Now().Unix()
+}
+
+// getOwner returns the session server authKeyId is routed to, "" if there is none.
+func (s *Service) getOwner(authKeyId int64) string {
+	r := s.sessionRing
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.ring.Get(strconv.FormatInt(authKeyId, 10))
+	if !ok {
+		return ""
+	}
+
+	return v.(string)
+}
+
+func (s *Service) isOwner(authKeyId int64) bool {
+	if s.draining.Get() {
+		return false
+	}
+
+	owner := s.getOwner(authKeyId)
+	return owner == "" || owner == s.serverId
+}
+
+// isMovedRecently is true if authKeyId was owned by another session server
+// before the ring changed a moment ago.
+func (s *Service) isMovedRecently(authKeyId int64) bool {
+	r := s.sessionRing
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if time.Now().Unix() > r.changedAt+handoffTimeout {
+		return false
+	}
+
+	v, ok := r.prevRing.Get(strconv.FormatInt(authKeyId, 10))
+	return ok && v.(string) != s.serverId
+}
+
+// getOwnerClient returns the client of the session server owning authKeyId,
+// nil if the request is to be handled here.
+func (s *Service) getOwnerClient(ctx context.Context, authKeyId int64) session_client.SessionClient {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(forwardedKey)) > 0 {
+		return nil
+	}
+
+	owner := s.getOwner(authKeyId)
+	if owner == "" || owner == s.serverId {
+		return nil
+	}
+
+	s.sessionRing.mu.RLock()
+	defer s.sessionRing.mu.RUnlock()
+
+	return s.sessionRing.peers[owner]
+}
+
+func (s *Service) forwardContext(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, forwardedKey, s.serverId)
+}
+
+// rebalance hands the auth keys this server no longer owns over to their owners.
+func (s *Service) rebalance(ctx context.Context) {
+	var handoffs []*authSessions
+
+	s.mu.Lock()
+	for authKeyId, sessList := range s.sessionsManager {
+		if !s.isOwner(authKeyId) {
+			handoffs = append(handoffs, sessList)
+			delete(s.sessionsManager, authKeyId)
+		}
+	}
+	s.mu.Unlock()
+
+	if len(handoffs) == 0 {
+		return
+	}
+
+	logx.Infof("rebalance - handoff %d auth keys", len(handoffs))
+	doneList := make([]chan struct{}, 0, len(handoffs))
+	for i, sessList := range handoffs {
+		done := sessList.handoff(ctx)
+		if done == nil {
+			logx.Errorf("rebalance - handoff timeout, %d of %d auth keys not handed over", len(handoffs)-i, len(handoffs))
+			return
+		}
+		doneList = append(doneList, done)
+	}
+
+	for i, done := range doneList {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			logx.Errorf("rebalance - handoff timeout, %d of %d auth keys left", len(doneList)-i, len(doneList))
+			return
+		}
+	}
+}
+
+// Drain hands all auth keys over to the other session servers, requests
+// arriving afterwards are forwarded to their owners.
+func (s *Service) Drain() {
+	logx.Infof("drain - serverId: %s", s.serverId)
+
+	r := s.sessionRing
+	r.mu.Lock()
+	if !s.draining.Get() {
+		s.draining.Set(true)
+		s.rebuildRingLocked()
+	}
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout*time.Second)
+	defer cancel()
+
+	s.rebalance(ctx)
+}
+
+// SessionDrainSessions
+// session.drainSessions = Bool;
+func (s *Service) SessionDrainSessions(ctx context.Context, r *sessionpb.TLSessionDrainSessions) (*mtproto.Bool, error) {
+	logx.WithContext(ctx).Infof("drainSessions - request: %s", r.DebugString())
+
+	s.Drain()
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+const testAuthKeys = 1000
+
+// newTestService is a session server seeing nodes in etcd.
+func newTestService(serverId string, nodes ...string) *Service {
+	s := &Service{
+		serverId:        serverId,
+		sessionsManager: make(map[int64]*authSessions),
+		sessionRing:     newSessionRing(),
+	}
+	s.setTestNodes(nodes...)
+	return s
+}
+
+func (s *Service) setTestNodes(nodes ...string) {
+	r := s.sessionRing
+	r.mu.Lock()
+	r.nodes = nodes
+	s.rebuildRingLocked()
+	r.mu.Unlock()
+}
+
+func TestIsOwner(t *testing.T) {
+	// no ring yet, every key is handled where it arrives
+	s := newTestService("session-1")
+	for authKeyId := int64(1); authKeyId <= testAuthKeys; authKeyId++ {
+		if !s.isOwner(authKeyId) {
+			t.Fatalf("isOwner(%d) without a ring = false", authKeyId)
+		}
+	}
+
+	nodes := []string{"session-1", "session-2", "session-3"}
+	servers := make([]*Service, 0, len(nodes))
+	for _, v := range nodes {
+		servers = append(servers, newTestService(v, nodes...))
+	}
+
+	// every key has exactly one owner, and all servers agree on it
+	owned := map[string]int{}
+	for authKeyId := int64(1); authKeyId <= testAuthKeys; authKeyId++ {
+		owner := servers[0].getOwner(authKeyId)
+		n := 0
+		for _, s := range servers {
+			if s.getOwner(authKeyId) != owner {
+				t.Fatalf("auth key %d: %s sees owner %s, want %s", authKeyId, s.serverId, s.getOwner(authKeyId), owner)
+			}
+			if s.isOwner(authKeyId) {
+				n++
+			}
+		}
+		if n != 1 {
+			t.Fatalf("auth key %d has %d owners", authKeyId, n)
+		}
+		owned[owner]++
+	}
+	for _, v := range nodes {
+		if owned[v] == 0 {
+			t.Errorf("%s owns no keys", v)
+		}
+	}
+}
+
+func TestIsMovedRecently(t *testing.T) {
+	var (
+		s      = newTestService("session-1", "session-1", "session-2", "session-3")
+		before = map[int64]string{}
+	)
+	for authKeyId := int64(1); authKeyId <= testAuthKeys; authKeyId++ {
+		before[authKeyId] = s.getOwner(authKeyId)
+	}
+
+	// session-3 leaves, its keys move to session-1 and session-2
+	s.setTestNodes("session-1", "session-2")
+
+	moved := 0
+	for authKeyId := int64(1); authKeyId <= testAuthKeys; authKeyId++ {
+		var (
+			owner = s.getOwner(authKeyId)
+			want  = before[authKeyId] != "session-1"
+		)
+		if before[authKeyId] != "session-3" && owner != before[authKeyId] {
+			t.Fatalf("auth key %d moved from %s to %s, only the keys of session-3 move", authKeyId, before[authKeyId], owner)
+		}
+		if got := s.isMovedRecently(authKeyId); got != want {
+			t.Fatalf("isMovedRecently(%d) = %v, want %v, owner %s -> %s", authKeyId, got, want, before[authKeyId], owner)
+		}
+		if owner == "session-1" && before[authKeyId] == "session-3" {
+			moved++
+		}
+	}
+	if moved == 0 {
+		t.Fatal("no key of session-3 moved to session-1")
+	}
+
+	// long after the change nothing moved recently
+	s.sessionRing.changedAt -= handoffTimeout + 1
+	for authKeyId := int64(1); authKeyId <= testAuthKeys; authKeyId++ {
+		if s.isMovedRecently(authKeyId) {
+			t.Fatalf("isMovedRecently(%d) = true %d seconds after the change", authKeyId, handoffTimeout+1)
+		}
+	}
+}
+
+func TestDrain(t *testing.T) {
+	var (
+		nodes = []string{"session-1", "session-2", "session-3"}
+		s     = newTestService("session-1", nodes...)
+		owned []int64
+	)
+	for authKeyId := int64(1); authKeyId <= testAuthKeys; authKeyId++ {
+		if s.isOwner(authKeyId) {
+			owned = append(owned, authKeyId)
+		}
+	}
+
+	s.Drain()
+
+	for authKeyId := int64(1); authKeyId <= testAuthKeys; authKeyId++ {
+		if s.isOwner(authKeyId) {
+			t.Fatalf("isOwner(%d) on a draining server = true", authKeyId)
+		}
+		if owner := s.getOwner(authKeyId); owner == "session-1" || owner == "" {
+			t.Fatalf("auth key %d routed to %q on a draining server", authKeyId, owner)
+		}
+	}
+	// its own keys did not move to it
+	for _, authKeyId := range owned {
+		if s.isMovedRecently(authKeyId) {
+			t.Fatalf("isMovedRecently(%d) = true for a key the draining server owned", authKeyId)
+		}
+	}
+
+	// a ring change while draining keeps it out of the ring
+	s.setTestNodes(nodes[:2]...)
+	for authKeyId := int64(1); authKeyId <= testAuthKeys; authKeyId++ {
+		if owner := s.getOwner(authKeyId); owner != "session-2" {
+			t.Fatalf("auth key %d routed to %q, want session-2", authKeyId, owner)
+		}
+	}
+}
+
+func TestRebalanceBusyKey(t *testing.T) {
+	var (
+		s         = newTestService("session-1", "session-1", "session-2")
+		authKeyId int64
+	)
+	for authKeyId = 1; s.isOwner(authKeyId); authKeyId++ {
+	}
+
+	// the key moved away but its loop never takes the handoff
+	s.sessionsManager[authKeyId] = newTestAuthSessions(authKeyId, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	finished := make(chan struct{})
+	go func() {
+		s.rebalance(ctx)
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("rebalance blocked on a busy key after its ctx was done")
+	}
+	if _, ok := s.sessionsManager[authKeyId]; ok {
+		t.Errorf("auth key %d still managed after rebalance", authKeyId)
+	}
+}
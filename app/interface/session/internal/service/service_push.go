@@ -30,6 +30,10 @@ import (
 // SessionPushUpdatesData
 // RPCPushClient is the client API for RPCPush service.
 func (s *Service) SessionPushUpdatesData(ctx context.Context, r *sessionpb.TLSessionPushUpdatesData) (*mtproto.Bool, error) {
+	if cli := s.getOwnerClient(ctx, r.AuthKeyId); cli != nil {
+		return cli.SessionPushUpdatesData(s.forwardContext(ctx), r)
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -49,6 +53,10 @@ func (s *Service) SessionPushUpdatesData(ctx context.Context, r *sessionpb.TLSes
 // SessionPushSessionUpdatesData
 // RPCPushClient is the client API for RPCPush service.
 func (s *Service) SessionPushSessionUpdatesData(ctx context.Context, r *sessionpb.TLSessionPushSessionUpdatesData) (*mtproto.Bool, error) {
+	if cli := s.getOwnerClient(ctx, r.AuthKeyId); cli != nil {
+		return cli.SessionPushSessionUpdatesData(s.forwardContext(ctx), r)
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -66,6 +74,10 @@ func (s *Service) SessionPushSessionUpdatesData(ctx context.Context, r *sessionp
 }
 
 func (s *Service) SessionPushRpcResultData(ctx context.Context, r *sessionpb.TLSessionPushRpcResultData) (*mtproto.Bool, error) {
+	if cli := s.getOwnerClient(ctx, r.AuthKeyId); cli != nil {
+		return cli.SessionPushRpcResultData(s.forwardContext(ctx), r)
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -131,10 +131,9 @@ func (c *session) onDestroyAuthKey(gatewayId string, msgId *inboxMsg, destroyAut
 }
 
 func (c *session) onPing(gatewayId string, msgId *inboxMsg, ping *mtproto.TLPing) {
-	logx.Infof("onPing - request data: {sess: %s, gatewayId: %s, msg_id: %s, seq_no: %d, request: {%s}}",
+	logx.Infof("onPing - request data: {sess: %s, gatewayId: %s, msg: %s, request: {%s}}",
 		c,
 		gatewayId,
-		msgId,
 		msgId.DebugString(),
 		ping.DebugString())
 
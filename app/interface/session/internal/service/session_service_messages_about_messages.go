@@ -430,7 +430,7 @@ func (c *session) checkBadMsgNotification(gatewayId string, excludeMsgIdToo bool
 	} return HandleResult::Success;
 */
 func (c *session) onMsgsStateReq(gatewayId string, msgId *inboxMsg, request *mtproto.TLMsgsStateReq) {
-	logx.Infof("onMsgsStateReq - request data: {sess: %s, gatewayId: %s, md: %s, msg_id: %d, seq_no: %d, request: {%s}}",
+	logx.Infof("onMsgsStateReq - request data: {sess: %s, gatewayId: %s, msg_id: %d, seq_no: %d, request: {%s}}",
 		c,
 		gatewayId,
 		msgId.msgId,
@@ -553,7 +553,7 @@ mtpRequestId Session::resend(quint64 msgId, qint64 msCanWait, bool forceContaine
 }
 */
 func (c *session) onMsgsStateInfo(gatewayId string, msgId *inboxMsg, request *mtproto.TLMsgsStateInfo) {
-	logx.Infof("onMsgsStateInfo - request data: {sess: %s, gatewayId: %s, md: %s, msg_id: %d, seq_no: %d, request: {%s}}",
+	logx.Infof("onMsgsStateInfo - request data: {sess: %s, gatewayId: %s, msg_id: %d, seq_no: %d, request: {%s}}",
 		c,
 		gatewayId,
 		msgId.msgId,
@@ -624,7 +624,7 @@ func (c *session) onMsgsStateInfo(gatewayId string, msgId *inboxMsg, request *mt
 }
 
 func (c *session) onMsgsAllInfo(gatewayId string, msgId *inboxMsg, request *mtproto.TLMsgsAllInfo) {
-	logx.Infof("onMsgsAllInfo - request data: {sess: %s, conn_id: %s, md: %s, msg_id: %d, seq_no: %d, request: {%s}}",
+	logx.Infof("onMsgsAllInfo - request data: {sess: %s, conn_id: %s, msg_id: %d, seq_no: %d, request: {%s}}",
 		c,
 		gatewayId,
 		msgId.msgId,
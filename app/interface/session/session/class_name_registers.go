@@ -23,6 +23,8 @@ const (
 	Predicate_session_pushUpdatesData        = "session_pushUpdatesData"
 	Predicate_session_pushSessionUpdatesData = "session_pushSessionUpdatesData"
 	Predicate_session_pushRpcResultData      = "session_pushRpcResultData"
+	Predicate_session_getAuthKeyExpiresAt    = "session_getAuthKeyExpiresAt"
+	Predicate_session_drainSessions          = "session_drainSessions"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -74,6 +76,14 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: 556344000, // 0x212922c0
 
 	},
+	Predicate_session_getAuthKeyExpiresAt: {
+		0: -2119252004, // 0x81aec7dc
+
+	},
+	Predicate_session_drainSessions: {
+		0: -41012823, // 0xfd8e31a9
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
@@ -89,6 +99,8 @@ var clazzIdNameRegisters2 = map[int32]string{
 	1075152191:  Predicate_session_pushUpdatesData,        // 0x4015853f
 	106898165:   Predicate_session_pushSessionUpdatesData, // 0x65f22f5
 	556344000:   Predicate_session_pushRpcResultData,      // 0x212922c0
+	-2119252004: Predicate_session_getAuthKeyExpiresAt,    // 0x81aec7dc
+	-41012823:   Predicate_session_drainSessions,          // 0xfd8e31a9
 
 }
 
@@ -92,6 +92,16 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: 556344000,
 		}
 	},
+	-2119252004: func() mtproto.TLObject { // 0x81aec7dc
+		return &TLSessionGetAuthKeyExpiresAt{
+			Constructor: -2119252004,
+		}
+	},
+	-41012823: func() mtproto.TLObject { // 0xfd8e31a9
+		return &TLSessionDrainSessions{
+			Constructor: -41012823,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -226,6 +236,7 @@ func (m *TLSessionClientEvent) Encode(layer int32) []byte {
 			x.Long(m.GetAuthKeyId())
 			x.Long(m.GetSessionId())
 			x.String(m.GetClientIp())
+
 			return x.GetBuf()
 		},
 	}
@@ -331,7 +342,7 @@ func (m *SessionClientData) DebugString() string {
 }
 
 // To_SessionClientData
-// sessionClientData  server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
+// sessionClientData server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
 func (m *SessionClientData) To_SessionClientData() *TLSessionClientData {
 	m.PredicateName = Predicate_sessionClientData
 	return &TLSessionClientData{
@@ -340,7 +351,7 @@ func (m *SessionClientData) To_SessionClientData() *TLSessionClientData {
 }
 
 // MakeTLSessionClientData
-// sessionClientData  server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
+// sessionClientData server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
 func MakeTLSessionClientData(data2 *SessionClientData) *TLSessionClientData {
 	if data2 == nil {
 		return &TLSessionClientData{Data2: &SessionClientData{
@@ -390,7 +401,7 @@ func (m *TLSessionClientData) Encode(layer int32) []byte {
 
 	var encodeF = map[uint32]func() []byte{
 		0x3138d08e: func() []byte {
-			// sessionClientData  server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
+			// sessionClientData server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
 			x.UInt(0x3138d08e)
 
 			x.String(m.GetServerId())
@@ -401,6 +412,7 @@ func (m *TLSessionClientData) Encode(layer int32) []byte {
 			x.Int(m.GetQuickAck())
 			x.Long(m.GetSalt())
 			x.StringBytes(m.GetPayload())
+
 			return x.GetBuf()
 		},
 	}
@@ -424,7 +436,7 @@ func (m *TLSessionClientData) CalcByteSize(layer int32) int {
 func (m *TLSessionClientData) Decode(dBuf *mtproto.DecodeBuf) error {
 	var decodeF = map[uint32]func() error{
 		0x3138d08e: func() error {
-			// sessionClientData  server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
+			// sessionClientData server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
 			m.SetServerId(dBuf.String())
 			m.SetConnType(dBuf.Int())
 			m.SetAuthKeyId(dBuf.Long())
@@ -551,6 +563,7 @@ func (m *TLHttpSessionData) Encode(layer int32) []byte {
 			x.UInt(0xdbd8534f)
 
 			x.StringBytes(m.GetPayload())
+
 			return x.GetBuf()
 		},
 	}
@@ -593,6 +606,52 @@ func (m *TLHttpSessionData) DebugString() string {
 	return dbgString
 }
 
+// TLSessionDrainSessions
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSessionDrainSessions) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_session_drainSessions))
+
+	switch uint32(m.Constructor) {
+	case 0xfd8e31a9:
+		// session.drainSessions = Bool;
+		x.UInt(0xfd8e31a9)
+
+		// no flags
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSessionDrainSessions) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSessionDrainSessions) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xfd8e31a9:
+		// session.drainSessions = Bool;
+
+		// not has flags
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSessionDrainSessions) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 //----------------------------------------------------------------------------------------------------------------
 // TLSessionQueryAuthKey
 ///////////////////////////////////////////////////////////////////////////////
@@ -1090,4 +1149,51 @@ func (m *TLSessionPushRpcResultData) DebugString() string {
 	return dbgString
 }
 
-//----------------------------------------------------------------------------------------------------------------
+// TLSessionGetAuthKeyExpiresAt
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSessionGetAuthKeyExpiresAt) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_session_getAuthKeyExpiresAt))
+
+	switch uint32(m.Constructor) {
+	case 0x81aec7dc:
+		// session.getAuthKeyExpiresAt auth_key_id:long = Int32;
+		x.UInt(0x81aec7dc)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSessionGetAuthKeyExpiresAt) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSessionGetAuthKeyExpiresAt) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x81aec7dc:
+		// session.getAuthKeyExpiresAt auth_key_id:long = Int32;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSessionGetAuthKeyExpiresAt) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
@@ -35,6 +35,8 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLSessionPushUpdatesData":        RPCContextTuple{"/mtproto.RPCSession/session_pushUpdatesData", func() interface{} { return new(mtproto.Bool) }},
 	"TLSessionPushSessionUpdatesData": RPCContextTuple{"/mtproto.RPCSession/session_pushSessionUpdatesData", func() interface{} { return new(mtproto.Bool) }},
 	"TLSessionPushRpcResultData":      RPCContextTuple{"/mtproto.RPCSession/session_pushRpcResultData", func() interface{} { return new(mtproto.Bool) }},
+	"TLSessionGetAuthKeyExpiresAt":    RPCContextTuple{"/mtproto.RPCSession/session_getAuthKeyExpiresAt", func() interface{} { return new(mtproto.Int32) }},
+	"TLSessionDrainSessions":          RPCContextTuple{"/mtproto.RPCSession/session_drainSessions", func() interface{} { return new(mtproto.Bool) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
@@ -47,6 +47,8 @@ const (
 	CRC32_session_pushUpdatesData        TLConstructor = 1075152191
 	CRC32_session_pushSessionUpdatesData TLConstructor = 106898165
 	CRC32_session_pushRpcResultData      TLConstructor = 556344000
+	CRC32_session_getAuthKeyExpiresAt    TLConstructor = -2119252004
+	CRC32_session_drainSessions          TLConstructor = -41012823
 )
 
 var TLConstructor_name = map[int32]string{
@@ -63,6 +65,8 @@ var TLConstructor_name = map[int32]string{
 	1075152191:  "CRC32_session_pushUpdatesData",
 	106898165:   "CRC32_session_pushSessionUpdatesData",
 	556344000:   "CRC32_session_pushRpcResultData",
+	-2119252004: "CRC32_session_getAuthKeyExpiresAt",
+	-41012823:   "CRC32_session_drainSessions",
 }
 
 var TLConstructor_value = map[string]int32{
@@ -79,6 +83,8 @@ var TLConstructor_value = map[string]int32{
 	"CRC32_session_pushUpdatesData":        1075152191,
 	"CRC32_session_pushSessionUpdatesData": 106898165,
 	"CRC32_session_pushRpcResultData":      556344000,
+	"CRC32_session_getAuthKeyExpiresAt":    -2119252004,
+	"CRC32_session_drainSessions":          -41012823,
 }
 
 func (x TLConstructor) String() string {
@@ -89,37 +95,32 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_3b8e1e0b46c4ab6f, []int{0}
 }
 
-//--------------------------------------------------------------------------------------------
-// sessionClientEvent server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string = SessionClientEvent;
-//
-// SessionClientEvent <--
-//  + TL_sessionClientEvent
+// --------------------------------------------------------------------------------------------
+// httpSessionData payload:bytes = HttpSessionData;
 //
-type SessionClientEvent struct {
+// HttpSessionData <--
+//   - TL_httpSessionData
+type HttpSessionData struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
-	ServerId             string        `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ConnType             int32         `protobuf:"varint,4,opt,name=conn_type,json=connType,proto3" json:"conn_type,omitempty"`
-	AuthKeyId            int64         `protobuf:"varint,5,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
-	SessionId            int64         `protobuf:"varint,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	ClientIp             string        `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Payload              []byte        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SessionClientEvent) Reset()         { *m = SessionClientEvent{} }
-func (m *SessionClientEvent) String() string { return proto.CompactTextString(m) }
-func (*SessionClientEvent) ProtoMessage()    {}
-func (*SessionClientEvent) Descriptor() ([]byte, []int) {
+func (m *HttpSessionData) Reset()         { *m = HttpSessionData{} }
+func (m *HttpSessionData) String() string { return proto.CompactTextString(m) }
+func (*HttpSessionData) ProtoMessage()    {}
+func (*HttpSessionData) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3b8e1e0b46c4ab6f, []int{0}
 }
-func (m *SessionClientEvent) XXX_Unmarshal(b []byte) error {
+func (m *HttpSessionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SessionClientEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HttpSessionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SessionClientEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HttpSessionData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -129,87 +130,59 @@ func (m *SessionClientEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SessionClientEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SessionClientEvent.Merge(m, src)
+func (m *HttpSessionData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpSessionData.Merge(m, src)
 }
-func (m *SessionClientEvent) XXX_Size() int {
+func (m *HttpSessionData) XXX_Size() int {
 	return m.Size()
 }
-func (m *SessionClientEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_SessionClientEvent.DiscardUnknown(m)
+func (m *HttpSessionData) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpSessionData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SessionClientEvent proto.InternalMessageInfo
+var xxx_messageInfo_HttpSessionData proto.InternalMessageInfo
 
-func (m *SessionClientEvent) GetPredicateName() string {
+func (m *HttpSessionData) GetPredicateName() string {
 	if m != nil {
 		return m.PredicateName
 	}
 	return ""
 }
 
-func (m *SessionClientEvent) GetConstructor() TLConstructor {
+func (m *HttpSessionData) GetConstructor() TLConstructor {
 	if m != nil {
 		return m.Constructor
 	}
 	return CRC32_UNKNOWN
 }
 
-func (m *SessionClientEvent) GetServerId() string {
-	if m != nil {
-		return m.ServerId
-	}
-	return ""
-}
-
-func (m *SessionClientEvent) GetConnType() int32 {
-	if m != nil {
-		return m.ConnType
-	}
-	return 0
-}
-
-func (m *SessionClientEvent) GetAuthKeyId() int64 {
-	if m != nil {
-		return m.AuthKeyId
-	}
-	return 0
-}
-
-func (m *SessionClientEvent) GetSessionId() int64 {
-	if m != nil {
-		return m.SessionId
-	}
-	return 0
-}
-
-func (m *SessionClientEvent) GetClientIp() string {
+func (m *HttpSessionData) GetPayload() []byte {
 	if m != nil {
-		return m.ClientIp
+		return m.Payload
 	}
-	return ""
+	return nil
 }
 
-// sessionClientEvent server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string = SessionClientEvent;
-type TLSessionClientEvent struct {
-	Data2                *SessionClientEvent `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+// httpSessionData payload:bytes = HttpSessionData;
+type TLHttpSessionData struct {
+	Data2                *HttpSessionData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *TLSessionClientEvent) Reset()         { *m = TLSessionClientEvent{} }
-func (m *TLSessionClientEvent) String() string { return proto.CompactTextString(m) }
-func (*TLSessionClientEvent) ProtoMessage()    {}
-func (*TLSessionClientEvent) Descriptor() ([]byte, []int) {
+func (m *TLHttpSessionData) Reset()         { *m = TLHttpSessionData{} }
+func (m *TLHttpSessionData) String() string { return proto.CompactTextString(m) }
+func (*TLHttpSessionData) ProtoMessage()    {}
+func (*TLHttpSessionData) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3b8e1e0b46c4ab6f, []int{1}
 }
-func (m *TLSessionClientEvent) XXX_Unmarshal(b []byte) error {
+func (m *TLHttpSessionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TLSessionClientEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TLHttpSessionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TLSessionClientEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TLHttpSessionData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -219,31 +192,30 @@ func (m *TLSessionClientEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TLSessionClientEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TLSessionClientEvent.Merge(m, src)
+func (m *TLHttpSessionData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLHttpSessionData.Merge(m, src)
 }
-func (m *TLSessionClientEvent) XXX_Size() int {
+func (m *TLHttpSessionData) XXX_Size() int {
 	return m.Size()
 }
-func (m *TLSessionClientEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_TLSessionClientEvent.DiscardUnknown(m)
+func (m *TLHttpSessionData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLHttpSessionData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TLSessionClientEvent proto.InternalMessageInfo
+var xxx_messageInfo_TLHttpSessionData proto.InternalMessageInfo
 
-func (m *TLSessionClientEvent) GetData2() *SessionClientEvent {
+func (m *TLHttpSessionData) GetData2() *HttpSessionData {
 	if m != nil {
 		return m.Data2
 	}
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
-// sessionClientData  server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
+// --------------------------------------------------------------------------------------------
+// sessionClientData server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
 //
 // SessionClientData <--
-//  + TL_sessionClientData
-//
+//   - TL_sessionClientData
 type SessionClientData struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -363,7 +335,7 @@ func (m *SessionClientData) GetPayload() []byte {
 	return nil
 }
 
-// sessionClientData  server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
+// sessionClientData server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string quick_ack:int salt:long payload:bytes = SessionClientData;
 type TLSessionClientData struct {
 	Data2                *SessionClientData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -411,33 +383,36 @@ func (m *TLSessionClientData) GetData2() *SessionClientData {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
-// httpSessionData payload:bytes = HttpSessionData;
-//
-// HttpSessionData <--
-//  + TL_httpSessionData
+// --------------------------------------------------------------------------------------------
+// sessionClientEvent server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string = SessionClientEvent;
 //
-type HttpSessionData struct {
+// SessionClientEvent <--
+//   - TL_sessionClientEvent
+type SessionClientEvent struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
-	Payload              []byte        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	ServerId             string        `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ConnType             int32         `protobuf:"varint,4,opt,name=conn_type,json=connType,proto3" json:"conn_type,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,5,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	SessionId            int64         `protobuf:"varint,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ClientIp             string        `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *HttpSessionData) Reset()         { *m = HttpSessionData{} }
-func (m *HttpSessionData) String() string { return proto.CompactTextString(m) }
-func (*HttpSessionData) ProtoMessage()    {}
-func (*HttpSessionData) Descriptor() ([]byte, []int) {
+func (m *SessionClientEvent) Reset()         { *m = SessionClientEvent{} }
+func (m *SessionClientEvent) String() string { return proto.CompactTextString(m) }
+func (*SessionClientEvent) ProtoMessage()    {}
+func (*SessionClientEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3b8e1e0b46c4ab6f, []int{4}
 }
-func (m *HttpSessionData) XXX_Unmarshal(b []byte) error {
+func (m *SessionClientEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HttpSessionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SessionClientEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HttpSessionData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SessionClientEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -447,59 +422,87 @@ func (m *HttpSessionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *HttpSessionData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HttpSessionData.Merge(m, src)
+func (m *SessionClientEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionClientEvent.Merge(m, src)
 }
-func (m *HttpSessionData) XXX_Size() int {
+func (m *SessionClientEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *HttpSessionData) XXX_DiscardUnknown() {
-	xxx_messageInfo_HttpSessionData.DiscardUnknown(m)
+func (m *SessionClientEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionClientEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HttpSessionData proto.InternalMessageInfo
+var xxx_messageInfo_SessionClientEvent proto.InternalMessageInfo
 
-func (m *HttpSessionData) GetPredicateName() string {
+func (m *SessionClientEvent) GetPredicateName() string {
 	if m != nil {
 		return m.PredicateName
 	}
 	return ""
 }
 
-func (m *HttpSessionData) GetConstructor() TLConstructor {
+func (m *SessionClientEvent) GetConstructor() TLConstructor {
 	if m != nil {
 		return m.Constructor
 	}
 	return CRC32_UNKNOWN
 }
 
-func (m *HttpSessionData) GetPayload() []byte {
+func (m *SessionClientEvent) GetServerId() string {
 	if m != nil {
-		return m.Payload
+		return m.ServerId
 	}
-	return nil
+	return ""
 }
 
-// httpSessionData payload:bytes = HttpSessionData;
-type TLHttpSessionData struct {
-	Data2                *HttpSessionData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *SessionClientEvent) GetConnType() int32 {
+	if m != nil {
+		return m.ConnType
+	}
+	return 0
 }
 
-func (m *TLHttpSessionData) Reset()         { *m = TLHttpSessionData{} }
-func (m *TLHttpSessionData) String() string { return proto.CompactTextString(m) }
-func (*TLHttpSessionData) ProtoMessage()    {}
-func (*TLHttpSessionData) Descriptor() ([]byte, []int) {
+func (m *SessionClientEvent) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *SessionClientEvent) GetSessionId() int64 {
+	if m != nil {
+		return m.SessionId
+	}
+	return 0
+}
+
+func (m *SessionClientEvent) GetClientIp() string {
+	if m != nil {
+		return m.ClientIp
+	}
+	return ""
+}
+
+// sessionClientEvent server_id:string conn_type:int auth_key_id:long session_id:long client_ip:string = SessionClientEvent;
+type TLSessionClientEvent struct {
+	Data2                *SessionClientEvent `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *TLSessionClientEvent) Reset()         { *m = TLSessionClientEvent{} }
+func (m *TLSessionClientEvent) String() string { return proto.CompactTextString(m) }
+func (*TLSessionClientEvent) ProtoMessage()    {}
+func (*TLSessionClientEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3b8e1e0b46c4ab6f, []int{5}
 }
-func (m *TLHttpSessionData) XXX_Unmarshal(b []byte) error {
+func (m *TLSessionClientEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TLHttpSessionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TLSessionClientEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TLHttpSessionData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TLSessionClientEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,26 +512,26 @@ func (m *TLHttpSessionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *TLHttpSessionData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TLHttpSessionData.Merge(m, src)
+func (m *TLSessionClientEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSessionClientEvent.Merge(m, src)
 }
-func (m *TLHttpSessionData) XXX_Size() int {
+func (m *TLSessionClientEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *TLHttpSessionData) XXX_DiscardUnknown() {
-	xxx_messageInfo_TLHttpSessionData.DiscardUnknown(m)
+func (m *TLSessionClientEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSessionClientEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TLHttpSessionData proto.InternalMessageInfo
+var xxx_messageInfo_TLSessionClientEvent proto.InternalMessageInfo
 
-func (m *TLHttpSessionData) GetData2() *HttpSessionData {
+func (m *TLSessionClientEvent) GetData2() *SessionClientEvent {
 	if m != nil {
 		return m.Data2
 	}
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.queryAuthKey auth_key_id:long = AuthKeyInfo;
 type TLSessionQueryAuthKey struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -585,7 +588,7 @@ func (m *TLSessionQueryAuthKey) GetAuthKeyId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
 type TLSessionSetAuthKey struct {
 	Constructor          TLConstructor        `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -658,7 +661,7 @@ func (m *TLSessionSetAuthKey) GetExpiresIn() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.createSession client:SessionClientEvent = Bool;
 type TLSessionCreateSession struct {
 	Constructor          TLConstructor       `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -715,7 +718,7 @@ func (m *TLSessionCreateSession) GetClient() *SessionClientEvent {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.sendDataToSession data:SessionClientData = Bool;
 type TLSessionSendDataToSession struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -772,7 +775,7 @@ func (m *TLSessionSendDataToSession) GetData() *SessionClientData {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.sendHttpDataToSession client:SessionClientData = HttpSessionData;
 type TLSessionSendHttpDataToSession struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -829,7 +832,7 @@ func (m *TLSessionSendHttpDataToSession) GetClient() *SessionClientData {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.closeSession client:SessionClientEvent = Bool;
 type TLSessionCloseSession struct {
 	Constructor          TLConstructor       `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -886,7 +889,7 @@ func (m *TLSessionCloseSession) GetClient() *SessionClientEvent {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.pushUpdatesData flags:# auth_key_id:long notification:flags.0?true updates:Updates = Bool;
 type TLSessionPushUpdatesData struct {
 	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -959,7 +962,7 @@ func (m *TLSessionPushUpdatesData) GetUpdates() *mtproto.Updates {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.pushSessionUpdatesData auth_key_id:long session_id:long updates:Updates = Bool;
 type TLSessionPushSessionUpdatesData struct {
 	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -1032,7 +1035,7 @@ func (m *TLSessionPushSessionUpdatesData) GetUpdates() *mtproto.Updates {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // session.pushRpcResultData auth_key_id:long session_id:long client_req_msg_id:long rpc_result_data:bytes = Bool;
 type TLSessionPushRpcResultData struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
@@ -1113,14 +1116,120 @@ func (m *TLSessionPushRpcResultData) GetRpcResultData() []byte {
 	return nil
 }
 
+// --------------------------------------------------------------------------------------------
+// session.getAuthKeyExpiresAt auth_key_id:long = Int32;
+type TLSessionGetAuthKeyExpiresAt struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSessionGetAuthKeyExpiresAt) Reset()         { *m = TLSessionGetAuthKeyExpiresAt{} }
+func (m *TLSessionGetAuthKeyExpiresAt) String() string { return proto.CompactTextString(m) }
+func (*TLSessionGetAuthKeyExpiresAt) ProtoMessage()    {}
+func (*TLSessionGetAuthKeyExpiresAt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b8e1e0b46c4ab6f, []int{15}
+}
+func (m *TLSessionGetAuthKeyExpiresAt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSessionGetAuthKeyExpiresAt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSessionGetAuthKeyExpiresAt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSessionGetAuthKeyExpiresAt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSessionGetAuthKeyExpiresAt.Merge(m, src)
+}
+func (m *TLSessionGetAuthKeyExpiresAt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSessionGetAuthKeyExpiresAt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSessionGetAuthKeyExpiresAt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSessionGetAuthKeyExpiresAt proto.InternalMessageInfo
+
+func (m *TLSessionGetAuthKeyExpiresAt) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSessionGetAuthKeyExpiresAt) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+// session.drainSessions = Bool;
+type TLSessionDrainSessions struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSessionDrainSessions) Reset()         { *m = TLSessionDrainSessions{} }
+func (m *TLSessionDrainSessions) String() string { return proto.CompactTextString(m) }
+func (*TLSessionDrainSessions) ProtoMessage()    {}
+func (*TLSessionDrainSessions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b8e1e0b46c4ab6f, []int{16}
+}
+func (m *TLSessionDrainSessions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSessionDrainSessions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSessionDrainSessions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSessionDrainSessions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSessionDrainSessions.Merge(m, src)
+}
+func (m *TLSessionDrainSessions) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSessionDrainSessions) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSessionDrainSessions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSessionDrainSessions proto.InternalMessageInfo
+
+func (m *TLSessionDrainSessions) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
 func init() {
 	proto.RegisterEnum("session.TLConstructor", TLConstructor_name, TLConstructor_value)
-	proto.RegisterType((*SessionClientEvent)(nil), "session.SessionClientEvent")
-	proto.RegisterType((*TLSessionClientEvent)(nil), "session.TL_sessionClientEvent")
-	proto.RegisterType((*SessionClientData)(nil), "session.SessionClientData")
-	proto.RegisterType((*TLSessionClientData)(nil), "session.TL_sessionClientData")
 	proto.RegisterType((*HttpSessionData)(nil), "session.HttpSessionData")
 	proto.RegisterType((*TLHttpSessionData)(nil), "session.TL_httpSessionData")
+	proto.RegisterType((*SessionClientData)(nil), "session.SessionClientData")
+	proto.RegisterType((*TLSessionClientData)(nil), "session.TL_sessionClientData")
+	proto.RegisterType((*SessionClientEvent)(nil), "session.SessionClientEvent")
+	proto.RegisterType((*TLSessionClientEvent)(nil), "session.TL_sessionClientEvent")
 	proto.RegisterType((*TLSessionQueryAuthKey)(nil), "session.TL_session_queryAuthKey")
 	proto.RegisterType((*TLSessionSetAuthKey)(nil), "session.TL_session_setAuthKey")
 	proto.RegisterType((*TLSessionCreateSession)(nil), "session.TL_session_createSession")
@@ -1130,115 +1239,116 @@ func init() {
 	proto.RegisterType((*TLSessionPushUpdatesData)(nil), "session.TL_session_pushUpdatesData")
 	proto.RegisterType((*TLSessionPushSessionUpdatesData)(nil), "session.TL_session_pushSessionUpdatesData")
 	proto.RegisterType((*TLSessionPushRpcResultData)(nil), "session.TL_session_pushRpcResultData")
+	proto.RegisterType((*TLSessionGetAuthKeyExpiresAt)(nil), "session.TL_session_getAuthKeyExpiresAt")
+	proto.RegisterType((*TLSessionDrainSessions)(nil), "session.TL_session_drainSessions")
 }
 
 func init() { proto.RegisterFile("session.tl.proto", fileDescriptor_3b8e1e0b46c4ab6f) }
 
 var fileDescriptor_3b8e1e0b46c4ab6f = []byte{
-	// 1204 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcd, 0x6f, 0xdb, 0x64,
-	0x18, 0xaf, 0xdb, 0xf4, 0xeb, 0xc9, 0xda, 0xb9, 0x2f, 0x2d, 0xf5, 0x52, 0x9a, 0x65, 0x2e, 0x85,
-	0xae, 0xb0, 0x64, 0xa4, 0x1c, 0xe0, 0xb8, 0x75, 0x43, 0x0b, 0x2d, 0xdd, 0xe4, 0x76, 0x42, 0x42,
-	0x42, 0xd6, 0x5b, 0xe7, 0x4d, 0x6a, 0x35, 0xf1, 0xeb, 0xda, 0xaf, 0x07, 0xb9, 0xed, 0xc0, 0xa1,
-	0xdd, 0x00, 0x21, 0xfe, 0x81, 0x81, 0x40, 0x9c, 0x10, 0x42, 0x5c, 0x38, 0xec, 0x30, 0x2e, 0x85,
-	0xdd, 0x00, 0x21, 0x40, 0x08, 0x09, 0x58, 0xe1, 0x80, 0x10, 0x87, 0x5d, 0x90, 0x90, 0x00, 0x15,
-	0xf9, 0xb5, 0x93, 0xf8, 0x2b, 0x01, 0x54, 0xd8, 0x0e, 0x9c, 0xea, 0x3c, 0xcf, 0xcf, 0xcf, 0xf3,
-	0x7b, 0xbe, 0x5d, 0x10, 0x6d, 0x62, 0xdb, 0x3a, 0x35, 0xf2, 0xac, 0x96, 0x37, 0x2d, 0xca, 0x28,
-	0x1a, 0xf4, 0x25, 0x99, 0x13, 0x55, 0x9d, 0x6d, 0x38, 0xeb, 0x79, 0x8d, 0xd6, 0x0b, 0x55, 0x5a,
-	0xa5, 0x05, 0xae, 0x5f, 0x77, 0x2a, 0xfc, 0x17, 0xff, 0xc1, 0x9f, 0xbc, 0xf7, 0x32, 0xd9, 0x2a,
-	0xa5, 0xd5, 0x1a, 0x69, 0xa3, 0x9e, 0xb3, 0xb0, 0x69, 0x12, 0xcb, 0xf6, 0xf5, 0x19, 0x5b, 0xdb,
-	0x20, 0x75, 0xec, 0x3a, 0xd2, 0xa8, 0x45, 0x54, 0xd6, 0x30, 0x49, 0x53, 0x77, 0xa4, 0xad, 0x63,
-	0x16, 0x36, 0x6c, 0x93, 0x5a, 0xcc, 0x57, 0x8d, 0xb7, 0x55, 0x76, 0xc3, 0xd0, 0x3c, 0xa9, 0x7c,
-	0xb5, 0x17, 0xd0, 0xaa, 0xc7, 0x73, 0xb1, 0xa6, 0x13, 0x83, 0x9d, 0xbd, 0x44, 0x0c, 0x86, 0x66,
-	0x61, 0xd4, 0xb4, 0x48, 0x59, 0xd7, 0x30, 0x23, 0xaa, 0x81, 0xeb, 0x44, 0x12, 0x72, 0xc2, 0xdc,
-	0xb0, 0x32, 0xd2, 0x92, 0xae, 0xe0, 0x3a, 0x41, 0x8f, 0x41, 0x5a, 0xa3, 0x86, 0xcd, 0x2c, 0x47,
-	0x63, 0xd4, 0x92, 0x7a, 0x73, 0xc2, 0xdc, 0x68, 0xf1, 0xde, 0x7c, 0x33, 0x15, 0x6b, 0xcb, 0x8b,
-	0x6d, 0xad, 0x12, 0x84, 0xa2, 0x29, 0x18, 0xb6, 0x89, 0x75, 0x89, 0x58, 0xaa, 0x5e, 0x96, 0xfa,
-	0xb8, 0xed, 0x21, 0x4f, 0x50, 0x2a, 0xbb, 0x4a, 0x8d, 0x1a, 0x06, 0x8f, 0x4c, 0x4a, 0xe5, 0x84,
-	0xb9, 0x7e, 0x65, 0xc8, 0x15, 0xac, 0x35, 0x4c, 0x82, 0xb2, 0x90, 0xc6, 0x0e, 0xdb, 0x50, 0x37,
-	0x49, 0xc3, 0x7d, 0xb7, 0x3f, 0x27, 0xcc, 0xf5, 0x29, 0xc3, 0xae, 0x68, 0x89, 0x34, 0x4a, 0x65,
-	0x34, 0x0d, 0xe0, 0xfb, 0x77, 0xd5, 0x03, 0x9e, 0xda, 0x97, 0xf8, 0xb6, 0x79, 0xa0, 0xaa, 0x6e,
-	0x4a, 0x83, 0x9e, 0x63, 0x4f, 0x50, 0x32, 0xe5, 0x27, 0x61, 0x62, 0x6d, 0x59, 0xb5, 0xe3, 0xf9,
-	0x78, 0x04, 0xfa, 0xcb, 0x98, 0xe1, 0x22, 0x4f, 0x43, 0xba, 0x38, 0xd5, 0x0a, 0x31, 0x9e, 0x3b,
-	0xc5, 0x43, 0xca, 0x9f, 0xf7, 0xc2, 0x58, 0x48, 0x7b, 0x06, 0x33, 0xfc, 0x3f, 0x4d, 0xac, 0xab,
-	0xdc, 0x72, 0x74, 0x6d, 0x53, 0xc5, 0xda, 0xa6, 0x34, 0xe4, 0x39, 0xe6, 0x82, 0x53, 0xda, 0x26,
-	0x42, 0x90, 0xb2, 0x71, 0x8d, 0x49, 0xc3, 0xdc, 0x24, 0x7f, 0x46, 0x12, 0x0c, 0x9a, 0xb8, 0x51,
-	0xa3, 0xb8, 0x2c, 0x41, 0x4e, 0x98, 0x3b, 0xa4, 0x34, 0x7f, 0xca, 0xe7, 0x60, 0x3c, 0x5a, 0x23,
-	0x9e, 0xd9, 0x93, 0xe1, 0x12, 0x65, 0x92, 0x4b, 0xe4, 0x42, 0x9b, 0x15, 0x7a, 0x51, 0x80, 0xc3,
-	0xe7, 0x18, 0x33, 0x7d, 0xc0, 0x9d, 0xa9, 0x4f, 0x20, 0xb0, 0xbe, 0x70, 0x60, 0x67, 0x00, 0xad,
-	0x2d, 0xab, 0x1b, 0x11, 0x42, 0xf9, 0x70, 0x58, 0x52, 0xcb, 0x47, 0x84, 0x79, 0x33, 0x28, 0x1b,
-	0x26, 0xdb, 0xe9, 0x51, 0xb7, 0x1c, 0x62, 0x35, 0x4e, 0x79, 0x25, 0x8c, 0x92, 0x16, 0xfe, 0x3e,
-	0xe9, 0x48, 0x6b, 0xf4, 0x45, 0x5a, 0x43, 0xfe, 0x52, 0x08, 0x0e, 0x8e, 0x6a, 0x13, 0x76, 0x70,
-	0x9f, 0x05, 0x18, 0x6a, 0xfa, 0xe4, 0x0e, 0xd3, 0xc5, 0xf1, 0x7c, 0x9d, 0xf1, 0xad, 0x95, 0xf7,
-	0xad, 0x97, 0x8c, 0x0a, 0x55, 0x06, 0x7d, 0x1a, 0xe8, 0x51, 0x48, 0x57, 0x1c, 0xe6, 0x58, 0x44,
-	0xe5, 0xdd, 0x94, 0xe2, 0xef, 0xdc, 0xd3, 0x7a, 0xe7, 0x09, 0xae, 0x5b, 0xc5, 0x35, 0xa6, 0x40,
-	0xa5, 0xf5, 0xec, 0x76, 0x35, 0x79, 0xde, 0xd4, 0x2d, 0x62, 0xab, 0xba, 0xc1, 0x9b, 0xbe, 0x5f,
-	0x19, 0xf6, 0x25, 0x25, 0x43, 0xde, 0x11, 0x40, 0x0a, 0x44, 0xa6, 0x59, 0x04, 0x33, 0xe2, 0xa7,
-	0xfd, 0x00, 0xc1, 0x2d, 0xc0, 0x80, 0x37, 0x1b, 0x7e, 0x68, 0x5d, 0x17, 0x8a, 0x0f, 0x95, 0xb7,
-	0x05, 0xb8, 0x2f, 0x94, 0x65, 0xa3, 0xec, 0x56, 0x7e, 0x8d, 0x1e, 0x9c, 0x4f, 0x1e, 0x52, 0x6e,
-	0xfb, 0xf8, 0x6c, 0xba, 0xcd, 0x0e, 0xc7, 0xc9, 0xaf, 0x08, 0x90, 0x8b, 0x50, 0x71, 0xfb, 0xf1,
-	0xdf, 0xa2, 0x53, 0x8c, 0xa4, 0xa7, 0x1b, 0xa1, 0x40, 0x76, 0x82, 0x9d, 0xaf, 0xd5, 0xa8, 0x7d,
-	0xb7, 0x0a, 0xf5, 0xa1, 0x00, 0x99, 0x00, 0x15, 0xd3, 0xb1, 0x37, 0x2e, 0x9a, 0x65, 0xcc, 0x88,
-	0xcd, 0x47, 0xfa, 0x3f, 0x9b, 0x43, 0x24, 0xc3, 0x21, 0x83, 0x32, 0xbd, 0xe2, 0x2e, 0x2a, 0x9d,
-	0x1a, 0x7c, 0x06, 0x86, 0x94, 0x90, 0x0c, 0xcd, 0xc3, 0xa0, 0xe3, 0x91, 0xe1, 0xdd, 0x9e, 0x2e,
-	0x8a, 0xad, 0x11, 0xf1, 0x49, 0x2a, 0x4d, 0x80, 0x7c, 0x53, 0x80, 0x63, 0x91, 0x40, 0xfc, 0xb0,
-	0xef, 0x4c, 0x3c, 0xe1, 0x93, 0x93, 0x8a, 0x9e, 0x9c, 0x7f, 0x12, 0xca, 0x4f, 0xe1, 0xe1, 0x71,
-	0x43, 0x51, 0x4c, 0x4d, 0x21, 0xb6, 0x53, 0x63, 0x77, 0x37, 0x8a, 0xe3, 0x30, 0xe6, 0x1f, 0x4e,
-	0x8b, 0x6c, 0xa9, 0x75, 0xbb, 0xda, 0xbe, 0xbe, 0xa3, 0x9e, 0x42, 0x21, 0x5b, 0x4f, 0xd9, 0xd5,
-	0x52, 0x19, 0x3d, 0x00, 0x87, 0x2d, 0x53, 0x53, 0x2d, 0xce, 0x5a, 0xe5, 0x13, 0x3b, 0xc0, 0x8f,
-	0xc8, 0x88, 0x15, 0x8c, 0x65, 0xfe, 0x72, 0x0a, 0x46, 0x42, 0x84, 0xd1, 0x18, 0x8c, 0x2c, 0x2a,
-	0x8b, 0x0b, 0x45, 0xf5, 0xe2, 0xca, 0xd2, 0xca, 0xf9, 0xa7, 0x57, 0xc4, 0x1e, 0x34, 0x0b, 0x92,
-	0x27, 0x8a, 0x7f, 0xef, 0x88, 0xd7, 0xae, 0xbc, 0x76, 0xed, 0x8f, 0xfd, 0xfd, 0xfd, 0x7d, 0x01,
-	0x1d, 0x85, 0xc9, 0x04, 0x98, 0xeb, 0x46, 0x7c, 0xf9, 0xf5, 0xef, 0x77, 0xfa, 0x90, 0x0c, 0x13,
-	0x1e, 0x20, 0x72, 0xba, 0xc4, 0x8f, 0xdf, 0xba, 0xf5, 0x8d, 0x6f, 0x44, 0x86, 0x4c, 0xc8, 0x48,
-	0xe8, 0x30, 0x89, 0x9f, 0xde, 0x7e, 0xff, 0xab, 0x01, 0x94, 0x8b, 0xf0, 0x09, 0x9c, 0x11, 0xf1,
-	0xea, 0xab, 0xbb, 0x3f, 0x0a, 0x68, 0x06, 0xa6, 0xc2, 0x88, 0xd0, 0x3a, 0x16, 0x5f, 0xfa, 0xe1,
-	0xbd, 0xed, 0x14, 0x7a, 0x18, 0x8e, 0x46, 0xcd, 0x44, 0xf6, 0xa4, 0xf8, 0xf3, 0xd7, 0x6f, 0x5f,
-	0xff, 0xd5, 0x23, 0x76, 0x12, 0x66, 0xe2, 0xe8, 0xd8, 0x2a, 0x13, 0xdf, 0xf9, 0xe8, 0xdd, 0x6f,
-	0x7f, 0xeb, 0x10, 0x4a, 0x70, 0xd3, 0x88, 0x9f, 0xbd, 0x70, 0xe3, 0xba, 0x80, 0x66, 0x61, 0x3a,
-	0x8c, 0x89, 0xac, 0x00, 0xf1, 0xc6, 0x95, 0x2f, 0x2e, 0xa7, 0xd0, 0x43, 0x70, 0x7f, 0x1c, 0x16,
-	0x1f, 0x30, 0xf1, 0x97, 0xdd, 0xdf, 0x8b, 0xe8, 0xc1, 0x68, 0x5c, 0xb1, 0x16, 0x16, 0x3f, 0xd8,
-	0x7d, 0x73, 0xa7, 0x37, 0x93, 0xda, 0x7e, 0x23, 0xdb, 0x53, 0xdc, 0xef, 0x07, 0x50, 0x2e, 0x2c,
-	0x36, 0x37, 0xe0, 0x05, 0x18, 0x4f, 0xfc, 0x26, 0xc8, 0x05, 0x1a, 0x3c, 0xb1, 0x38, 0x99, 0xc4,
-	0xab, 0x2b, 0xf7, 0xa0, 0xb3, 0x80, 0x12, 0xee, 0x7d, 0x36, 0xc9, 0x5e, 0x5b, 0x9f, 0x19, 0x69,
-	0x59, 0x3b, 0x4d, 0x69, 0x4d, 0xee, 0x41, 0x4b, 0x30, 0x91, 0x7c, 0x5c, 0x8f, 0x25, 0x59, 0x0a,
-	0x41, 0xe2, 0xc6, 0x56, 0xe1, 0x48, 0xe7, 0xeb, 0x38, 0x9b, 0x4c, 0x2d, 0x02, 0x8b, 0x1b, 0xad,
-	0xc0, 0x74, 0xf7, 0x3b, 0x77, 0xbc, 0x93, 0xe1, 0x18, 0x34, 0xd3, 0xf1, 0xf3, 0x4d, 0xee, 0x41,
-	0xa5, 0x76, 0x89, 0x42, 0xc7, 0x2b, 0xb1, 0x44, 0x41, 0x44, 0x9c, 0xf2, 0x79, 0x98, 0xec, 0x74,
-	0x7c, 0x66, 0x92, 0xac, 0x45, 0x40, 0x71, 0x83, 0xcf, 0x42, 0xf6, 0x2f, 0x8e, 0xc0, 0x7c, 0x27,
-	0xbb, 0x71, 0x6c, 0xd7, 0xba, 0xc5, 0x17, 0xf3, 0x6c, 0x27, 0xcb, 0x21, 0x58, 0xcc, 0xe8, 0xe9,
-	0xa5, 0xdb, 0xb7, 0xb2, 0xc2, 0xcd, 0xbd, 0xac, 0xf0, 0xc9, 0x5e, 0x56, 0xf8, 0x6e, 0x2f, 0x2b,
-	0x3c, 0xf3, 0x38, 0x23, 0xb8, 0x5e, 0xb5, 0x70, 0x3d, 0xaf, 0xd3, 0x42, 0xf3, 0xf9, 0x84, 0xf7,
-	0xdf, 0x51, 0x01, 0x9b, 0x66, 0x41, 0x37, 0x18, 0xb1, 0x2a, 0x58, 0x23, 0x05, 0xdf, 0x4f, 0xf3,
-	0xef, 0xfa, 0x00, 0x37, 0xbd, 0xf0, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xba, 0x92, 0x9d,
-	0xe7, 0x0f, 0x00, 0x00,
+	// 1256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x8e, 0x13, 0x3f, 0x37, 0xe9, 0x66, 0x48, 0xc8, 0xd6, 0x21, 0x8e, 0xb3, 0x21,
+	0x34, 0x0d, 0xd4, 0x2e, 0x0e, 0x07, 0xae, 0x69, 0x1a, 0x54, 0x93, 0x90, 0x56, 0x9b, 0x54, 0x20,
+	0x24, 0xb4, 0xda, 0xac, 0xc7, 0xce, 0x2a, 0xf6, 0xee, 0x66, 0x77, 0xb6, 0x60, 0x4e, 0x1c, 0x38,
+	0x24, 0x2d, 0x05, 0xc4, 0x3f, 0x50, 0x10, 0x88, 0x13, 0x42, 0x15, 0x17, 0x0e, 0x39, 0x94, 0x4b,
+	0xa0, 0x37, 0x40, 0x08, 0x50, 0x05, 0x02, 0x1a, 0x38, 0x20, 0xc4, 0xa1, 0x17, 0x24, 0x24, 0x40,
+	0x41, 0x3b, 0xbb, 0xb6, 0xf7, 0x97, 0x13, 0x50, 0x68, 0x7b, 0xe0, 0x94, 0xdd, 0xf7, 0xbe, 0xfd,
+	0xe6, 0x7d, 0xef, 0xcd, 0xbc, 0x37, 0x0e, 0xb0, 0x26, 0x36, 0x4d, 0x45, 0x53, 0xf3, 0xa4, 0x96,
+	0xd7, 0x0d, 0x8d, 0x68, 0xa8, 0xc7, 0xb5, 0x64, 0x4e, 0x56, 0x15, 0xb2, 0x66, 0xad, 0xe6, 0x65,
+	0xad, 0x5e, 0xa8, 0x6a, 0x55, 0xad, 0x40, 0xfd, 0xab, 0x56, 0x85, 0xbe, 0xd1, 0x17, 0xfa, 0xe4,
+	0x7c, 0x97, 0xc9, 0x56, 0x35, 0xad, 0x5a, 0xc3, 0x6d, 0xd4, 0xf3, 0x86, 0xa4, 0xeb, 0xd8, 0x30,
+	0x5d, 0x7f, 0xc6, 0x94, 0xd7, 0x70, 0x5d, 0xb2, 0x17, 0x92, 0x35, 0x03, 0x8b, 0xa4, 0xa1, 0xe3,
+	0xa6, 0xef, 0x58, 0xdb, 0x47, 0x0c, 0x49, 0x35, 0x75, 0xcd, 0x20, 0xae, 0x6b, 0xb0, 0xed, 0x32,
+	0x1b, 0xaa, 0xec, 0x58, 0xf9, 0x57, 0x18, 0x38, 0x7a, 0x96, 0x10, 0x7d, 0xd9, 0x89, 0xf5, 0x8c,
+	0x44, 0x24, 0x34, 0x09, 0xfd, 0xba, 0x81, 0xcb, 0x8a, 0x2c, 0x11, 0x2c, 0xaa, 0x52, 0x1d, 0x73,
+	0x4c, 0x8e, 0x99, 0x4a, 0x09, 0x7d, 0x2d, 0xeb, 0x92, 0x54, 0xc7, 0xe8, 0x71, 0x48, 0xcb, 0x9a,
+	0x6a, 0x12, 0xc3, 0x92, 0x89, 0x66, 0x70, 0x5d, 0x39, 0x66, 0xaa, 0xbf, 0x78, 0x7f, 0xbe, 0x99,
+	0x87, 0x95, 0xc5, 0xb9, 0xb6, 0x57, 0xf0, 0x42, 0x11, 0x07, 0x3d, 0xba, 0xd4, 0xa8, 0x69, 0x52,
+	0x99, 0x8b, 0xe7, 0x98, 0xa9, 0x23, 0x42, 0xf3, 0x95, 0x3f, 0x03, 0x68, 0x65, 0x51, 0x5c, 0x0b,
+	0x04, 0x94, 0x87, 0xee, 0xb2, 0x44, 0xa4, 0x22, 0x8d, 0x23, 0x5d, 0xe4, 0x5a, 0x6b, 0x04, 0x22,
+	0x17, 0x1c, 0x18, 0xff, 0x65, 0x17, 0x0c, 0xb8, 0xe6, 0xb9, 0x9a, 0x82, 0x55, 0x72, 0x77, 0x64,
+	0x8d, 0x40, 0xca, 0xc4, 0xc6, 0x45, 0x6c, 0x88, 0x8a, 0x23, 0x2c, 0x25, 0xf4, 0x3a, 0x86, 0x52,
+	0xd9, 0x76, 0xca, 0x9a, 0xaa, 0xd2, 0x6a, 0x71, 0x89, 0x1c, 0x33, 0xd5, 0x2d, 0xf4, 0xda, 0x86,
+	0x95, 0x86, 0x8e, 0x51, 0x16, 0xd2, 0x92, 0x45, 0xd6, 0xc4, 0x75, 0xdc, 0xb0, 0xbf, 0xed, 0xce,
+	0x31, 0x53, 0x71, 0x21, 0x65, 0x9b, 0x16, 0x70, 0xa3, 0x54, 0x46, 0xa3, 0x00, 0xee, 0xfa, 0xb6,
+	0x3b, 0xe9, 0xb8, 0x5d, 0x8b, 0xcb, 0x4d, 0x75, 0x8a, 0x8a, 0xce, 0xf5, 0x38, 0x0b, 0x3b, 0x86,
+	0x92, 0x6e, 0x3b, 0x37, 0x2c, 0x45, 0x5e, 0x17, 0x25, 0x79, 0x9d, 0xeb, 0x75, 0x16, 0xa6, 0x86,
+	0x59, 0x79, 0x1d, 0x21, 0x48, 0x98, 0x52, 0x8d, 0x70, 0x29, 0x4a, 0x49, 0x9f, 0xbd, 0xd5, 0x01,
+	0x7f, 0x75, 0xce, 0xc2, 0xe0, 0xca, 0xa2, 0x68, 0x86, 0x32, 0x7b, 0xca, 0x5f, 0x9f, 0x4c, 0x2b,
+	0x59, 0xa1, 0x22, 0x34, 0x2b, 0x74, 0xb9, 0x0b, 0x90, 0xcf, 0x39, 0x7f, 0x11, 0xab, 0xe4, 0x7f,
+	0x5a, 0x22, 0xfe, 0x49, 0x18, 0x0a, 0xe6, 0xd5, 0xc9, 0xc7, 0xa3, 0xfe, 0xc4, 0x8e, 0x44, 0x27,
+	0x96, 0x62, 0x9b, 0x99, 0x35, 0x61, 0xb8, 0xcd, 0x25, 0x6e, 0x58, 0xd8, 0x68, 0xcc, 0x3a, 0x41,
+	0x06, 0xd3, 0xc6, 0xfc, 0xf3, 0xb4, 0x05, 0xc4, 0xc7, 0x03, 0xe2, 0xf9, 0xaf, 0x19, 0xaf, 0x02,
+	0xd1, 0xc4, 0xe4, 0xf0, 0x6b, 0x16, 0xa0, 0xb7, 0xb9, 0x26, 0x5d, 0x30, 0x5d, 0x1c, 0xcc, 0xd7,
+	0x09, 0xed, 0x5a, 0x79, 0x97, 0xbd, 0xa4, 0x56, 0x34, 0xa1, 0xc7, 0x0d, 0x03, 0x3d, 0x06, 0xe9,
+	0x8a, 0x45, 0x2c, 0x03, 0x8b, 0x74, 0x4b, 0x27, 0xe8, 0x37, 0xf7, 0xb5, 0xbe, 0x79, 0x82, 0xfa,
+	0x96, 0xa5, 0x1a, 0x11, 0xa0, 0xd2, 0x7a, 0xb6, 0xeb, 0x86, 0x5f, 0xd0, 0x15, 0x03, 0x9b, 0xa2,
+	0xa2, 0xd2, 0xb2, 0x76, 0x0b, 0x29, 0xd7, 0x52, 0x52, 0xf9, 0x2d, 0x06, 0x38, 0x8f, 0x32, 0xd9,
+	0xc0, 0x12, 0xc1, 0x6e, 0xf6, 0x0f, 0x21, 0x6e, 0x06, 0x92, 0x4e, 0xf5, 0xb9, 0xf8, 0xc1, 0x95,
+	0x75, 0xa1, 0xfc, 0x26, 0x03, 0x0f, 0xf8, 0xb2, 0xac, 0x96, 0xed, 0x33, 0xb5, 0xa2, 0x1d, 0x3e,
+	0x9e, 0x3c, 0x24, 0xec, 0xed, 0xc3, 0xc5, 0x0f, 0x3c, 0xc0, 0x14, 0xc7, 0xbf, 0xce, 0x40, 0x2e,
+	0x10, 0x8a, 0xdd, 0x8b, 0xff, 0xab, 0x70, 0x8a, 0x81, 0xf4, 0xec, 0x17, 0x90, 0x27, 0x3b, 0xde,
+	0x9d, 0x2f, 0xd7, 0x34, 0xf3, 0x5e, 0x15, 0xea, 0x63, 0x06, 0x32, 0x9e, 0x50, 0x74, 0xcb, 0x5c,
+	0xbb, 0xa0, 0x97, 0x25, 0x82, 0x4d, 0xda, 0x2e, 0xef, 0xd8, 0x39, 0x44, 0x3c, 0x1c, 0x51, 0x35,
+	0xa2, 0x54, 0xec, 0x56, 0xa9, 0x68, 0x2a, 0x3d, 0x03, 0xbd, 0x82, 0xcf, 0x86, 0xa6, 0xa1, 0xc7,
+	0x72, 0x82, 0xa1, 0xbb, 0x3d, 0x5d, 0x64, 0x5b, 0x47, 0xc4, 0x0d, 0x52, 0x68, 0x02, 0xf8, 0x1b,
+	0x0c, 0x8c, 0x07, 0x84, 0xb8, 0xb2, 0xef, 0x8e, 0x1e, 0x7f, 0x53, 0x4d, 0x04, 0x9b, 0xea, 0xbf,
+	0x91, 0xf2, 0x8b, 0xff, 0xf0, 0xd8, 0x52, 0x04, 0x5d, 0x16, 0xb0, 0x69, 0xd5, 0xc8, 0xbd, 0x55,
+	0x71, 0x02, 0x06, 0xdc, 0xd1, 0x60, 0xe0, 0x0d, 0xb1, 0x6e, 0x56, 0xdb, 0xf3, 0xa5, 0xdf, 0x71,
+	0x08, 0x78, 0xe3, 0x29, 0xb3, 0x5a, 0x2a, 0xa3, 0x87, 0xe0, 0xa8, 0xa1, 0xcb, 0xa2, 0x41, 0xa3,
+	0x16, 0xe9, 0x89, 0x4d, 0xd2, 0x11, 0xdd, 0x67, 0x78, 0xb5, 0xf0, 0x2f, 0x42, 0xd6, 0xa3, 0xb5,
+	0xda, 0x6a, 0xc7, 0xf3, 0x4e, 0x5f, 0x9b, 0x25, 0x77, 0x4e, 0xed, 0xf4, 0xb5, 0x04, 0xf4, 0xf9,
+	0x3e, 0x47, 0x03, 0xd0, 0x37, 0x27, 0xcc, 0xcd, 0x14, 0xc5, 0x0b, 0x4b, 0x0b, 0x4b, 0xe7, 0x9e,
+	0x5e, 0x62, 0x63, 0x68, 0x12, 0x38, 0xc7, 0x14, 0x1e, 0x7a, 0xec, 0xd5, 0x4b, 0x6f, 0x5e, 0xfd,
+	0x6b, 0x6f, 0x6f, 0x6f, 0x8f, 0x41, 0x63, 0x30, 0x1c, 0x01, 0xb3, 0x25, 0xb2, 0xaf, 0xbe, 0xf5,
+	0xe3, 0x56, 0x1c, 0xf1, 0x30, 0xe4, 0x00, 0x02, 0x57, 0x46, 0xf6, 0xd3, 0x77, 0x6f, 0x7d, 0xe7,
+	0x92, 0xf0, 0x90, 0xf1, 0x91, 0xf8, 0x86, 0x22, 0xfb, 0xf9, 0xed, 0x0f, 0x6f, 0x26, 0x51, 0x2e,
+	0x10, 0x8f, 0x67, 0x84, 0xb1, 0x97, 0xdf, 0xd8, 0xf9, 0x99, 0x41, 0x13, 0x30, 0xe2, 0x47, 0xf8,
+	0x46, 0x01, 0x7b, 0xe5, 0xa7, 0x0f, 0x36, 0x13, 0xe8, 0x11, 0x18, 0x0b, 0xd2, 0x04, 0x7a, 0x34,
+	0xfb, 0xeb, 0x37, 0xef, 0x6d, 0xff, 0xee, 0x04, 0x76, 0x0a, 0x26, 0xc2, 0xe8, 0x50, 0x1b, 0x65,
+	0xdf, 0xff, 0xe4, 0xda, 0xf7, 0x7f, 0x74, 0x90, 0xe2, 0xed, 0x72, 0xec, 0x17, 0x2f, 0x5f, 0xdf,
+	0x66, 0xd0, 0x24, 0x8c, 0xfa, 0x31, 0x81, 0xf6, 0xc3, 0x5e, 0xbf, 0xf4, 0xd5, 0x4b, 0x09, 0xf4,
+	0x30, 0x3c, 0x18, 0x86, 0x85, 0x0f, 0x37, 0xfb, 0xdb, 0xce, 0x9f, 0x45, 0x74, 0x1c, 0xc6, 0xc2,
+	0x60, 0xdf, 0xf1, 0x61, 0x3f, 0xda, 0x79, 0x67, 0xab, 0x0b, 0xe5, 0x61, 0xdc, 0x0f, 0x8c, 0xd8,
+	0x7b, 0xec, 0xb7, 0xaf, 0x6d, 0x5f, 0x71, 0x52, 0x90, 0x49, 0x6c, 0xbe, 0x9d, 0x8d, 0x15, 0x6f,
+	0x26, 0x01, 0x84, 0xf3, 0x73, 0xcd, 0x6e, 0x7d, 0x1e, 0x06, 0x23, 0xef, 0x2f, 0x39, 0xcf, 0xf6,
+	0x8c, 0x2c, 0x66, 0x26, 0xf2, 0x86, 0xc0, 0xc7, 0xd0, 0x3c, 0xa0, 0x88, 0xbb, 0x49, 0x36, 0x8a,
+	0xaf, 0xed, 0xcf, 0xf4, 0xb5, 0xd8, 0x4e, 0x6b, 0x5a, 0x8d, 0x8f, 0xa1, 0x05, 0x18, 0x8a, 0xbe,
+	0x08, 0x8c, 0x47, 0x31, 0xf9, 0x20, 0x61, 0xb2, 0x65, 0x38, 0xd6, 0x79, 0x92, 0x4f, 0x46, 0x87,
+	0x16, 0x80, 0x85, 0x49, 0x2b, 0x30, 0xba, 0xff, 0x4c, 0x3e, 0xd1, 0x89, 0x38, 0x04, 0xcd, 0x74,
+	0xfc, 0x99, 0xc5, 0xc7, 0x50, 0x09, 0x06, 0xa3, 0xb6, 0x60, 0x74, 0x89, 0xbc, 0x88, 0x70, 0xc8,
+	0xe7, 0x60, 0xb8, 0xd3, 0xa0, 0x9c, 0x88, 0x62, 0x0b, 0x80, 0xc2, 0x84, 0xcf, 0x41, 0xf6, 0x80,
+	0x81, 0x35, 0xdd, 0x89, 0x37, 0x8c, 0xdd, 0xb7, 0x6e, 0xe1, 0x21, 0x32, 0xd9, 0x89, 0xd9, 0x07,
+	0x0b, 0x93, 0x3e, 0x03, 0x23, 0xfb, 0x75, 0xeb, 0xe3, 0x51, 0xb4, 0x11, 0xc0, 0x4c, 0x7f, 0x8b,
+	0xb8, 0xa4, 0x92, 0x99, 0x22, 0x1f, 0x3b, 0xbd, 0x7c, 0xfb, 0x56, 0x96, 0xb9, 0xb1, 0x9b, 0x65,
+	0x3e, 0xdb, 0xcd, 0x32, 0x3f, 0xec, 0x66, 0x99, 0x67, 0x67, 0x3d, 0xff, 0x8e, 0x20, 0x58, 0xaa,
+	0x57, 0x0d, 0xa9, 0xfd, 0x70, 0xd2, 0xf9, 0x35, 0x54, 0x90, 0x74, 0xbd, 0xa0, 0xa8, 0x04, 0x1b,
+	0x15, 0x49, 0xc6, 0x05, 0x77, 0xc9, 0xe6, 0xdf, 0xd5, 0x24, 0x5d, 0x63, 0xe6, 0xef, 0x01, 0x00,
+	0x9e, 0xe7, 0x05, 0x93, 0xf5, 0x10, 0x00, 0x00,
 }
 
-func (this *SessionClientEvent) GoString() string {
+func (this *HttpSessionData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&session.SessionClientEvent{")
+	s := make([]string, 0, 7)
+	s = append(s, "&session.HttpSessionData{")
 	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "ServerId: "+fmt.Sprintf("%#v", this.ServerId)+",\n")
-	s = append(s, "ConnType: "+fmt.Sprintf("%#v", this.ConnType)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "SessionId: "+fmt.Sprintf("%#v", this.SessionId)+",\n")
-	s = append(s, "ClientIp: "+fmt.Sprintf("%#v", this.ClientIp)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLSessionClientEvent) GoString() string {
+func (this *TLHttpSessionData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 5)
-	s = append(s, "&session.TLSessionClientEvent{")
+	s = append(s, "&session.TLHttpSessionData{")
 	if this.Data2 != nil {
 		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
 	}
@@ -1285,27 +1395,31 @@ func (this *TLSessionClientData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *HttpSessionData) GoString() string {
+func (this *SessionClientEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&session.HttpSessionData{")
+	s := make([]string, 0, 11)
+	s = append(s, "&session.SessionClientEvent{")
 	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "ServerId: "+fmt.Sprintf("%#v", this.ServerId)+",\n")
+	s = append(s, "ConnType: "+fmt.Sprintf("%#v", this.ConnType)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "SessionId: "+fmt.Sprintf("%#v", this.SessionId)+",\n")
+	s = append(s, "ClientIp: "+fmt.Sprintf("%#v", this.ClientIp)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLHttpSessionData) GoString() string {
+func (this *TLSessionClientEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 5)
-	s = append(s, "&session.TLHttpSessionData{")
+	s = append(s, "&session.TLSessionClientEvent{")
 	if this.Data2 != nil {
 		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
 	}
@@ -1466,6 +1580,33 @@ func (this *TLSessionPushRpcResultData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TLSessionGetAuthKeyExpiresAt) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&session.TLSessionGetAuthKeyExpiresAt{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSessionDrainSessions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&session.TLSessionDrainSessions{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringSessionTl(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1505,6 +1646,9 @@ type RPCSessionClient interface {
 	SessionPushSessionUpdatesData(ctx context.Context, in *TLSessionPushSessionUpdatesData, opts ...grpc.CallOption) (*mtproto.Bool, error)
 	// session.pushRpcResultData auth_key_id:long session_id:long client_req_msg_id:long rpc_result_data:bytes = Bool;
 	SessionPushRpcResultData(ctx context.Context, in *TLSessionPushRpcResultData, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// session.getAuthKeyExpiresAt auth_key_id:long = Int32;
+	SessionGetAuthKeyExpiresAt(ctx context.Context, in *TLSessionGetAuthKeyExpiresAt, opts ...grpc.CallOption) (*mtproto.Int32, error)
+	SessionDrainSessions(ctx context.Context, in *TLSessionDrainSessions, opts ...grpc.CallOption) (*mtproto.Bool, error)
 }
 
 type rPCSessionClient struct {
@@ -1596,6 +1740,24 @@ func (c *rPCSessionClient) SessionPushRpcResultData(ctx context.Context, in *TLS
 	return out, nil
 }
 
+func (c *rPCSessionClient) SessionGetAuthKeyExpiresAt(ctx context.Context, in *TLSessionGetAuthKeyExpiresAt, opts ...grpc.CallOption) (*mtproto.Int32, error) {
+	out := new(mtproto.Int32)
+	err := c.cc.Invoke(ctx, "/session.RPCSession/session_getAuthKeyExpiresAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSessionClient) SessionDrainSessions(ctx context.Context, in *TLSessionDrainSessions, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/session.RPCSession/session_drainSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RPCSessionServer is the server API for RPCSession service.
 type RPCSessionServer interface {
 	// session.queryAuthKey auth_key_id:long = AuthKeyInfo;
@@ -1616,6 +1778,9 @@ type RPCSessionServer interface {
 	SessionPushSessionUpdatesData(context.Context, *TLSessionPushSessionUpdatesData) (*mtproto.Bool, error)
 	// session.pushRpcResultData auth_key_id:long session_id:long client_req_msg_id:long rpc_result_data:bytes = Bool;
 	SessionPushRpcResultData(context.Context, *TLSessionPushRpcResultData) (*mtproto.Bool, error)
+	// session.getAuthKeyExpiresAt auth_key_id:long = Int32;
+	SessionGetAuthKeyExpiresAt(context.Context, *TLSessionGetAuthKeyExpiresAt) (*mtproto.Int32, error)
+	SessionDrainSessions(context.Context, *TLSessionDrainSessions) (*mtproto.Bool, error)
 }
 
 // UnimplementedRPCSessionServer can be embedded to have forward compatible implementations.
@@ -1649,6 +1814,13 @@ func (*UnimplementedRPCSessionServer) SessionPushSessionUpdatesData(ctx context.
 func (*UnimplementedRPCSessionServer) SessionPushRpcResultData(ctx context.Context, req *TLSessionPushRpcResultData) (*mtproto.Bool, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SessionPushRpcResultData not implemented")
 }
+func (*UnimplementedRPCSessionServer) SessionGetAuthKeyExpiresAt(ctx context.Context, req *TLSessionGetAuthKeyExpiresAt) (*mtproto.Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionGetAuthKeyExpiresAt not implemented")
+}
+
+func (*UnimplementedRPCSessionServer) SessionDrainSessions(ctx context.Context, req *TLSessionDrainSessions) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionDrainSessions not implemented")
+}
 
 func RegisterRPCSessionServer(s *grpc.Server, srv RPCSessionServer) {
 	s.RegisterService(&_RPCSession_serviceDesc, srv)
@@ -1816,6 +1988,42 @@ func _RPCSession_SessionPushRpcResultData_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCSession_SessionGetAuthKeyExpiresAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSessionGetAuthKeyExpiresAt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSessionServer).SessionGetAuthKeyExpiresAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.RPCSession/SessionGetAuthKeyExpiresAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSessionServer).SessionGetAuthKeyExpiresAt(ctx, req.(*TLSessionGetAuthKeyExpiresAt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSession_SessionDrainSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSessionDrainSessions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSessionServer).SessionDrainSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.RPCSession/SessionDrainSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSessionServer).SessionDrainSessions(ctx, req.(*TLSessionDrainSessions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RPCSession_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "session.RPCSession",
 	HandlerType: (*RPCSessionServer)(nil),
@@ -1856,12 +2064,20 @@ var _RPCSession_serviceDesc = grpc.ServiceDesc{
 			MethodName: "session_pushRpcResultData",
 			Handler:    _RPCSession_SessionPushRpcResultData_Handler,
 		},
+		{
+			MethodName: "session_getAuthKeyExpiresAt",
+			Handler:    _RPCSession_SessionGetAuthKeyExpiresAt_Handler,
+		},
+		{
+			MethodName: "session_drainSessions",
+			Handler:    _RPCSession_SessionDrainSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "session.tl.proto",
 }
 
-func (m *SessionClientEvent) Marshal() (dAtA []byte, err error) {
+func (m *HttpSessionData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1871,12 +2087,12 @@ func (m *SessionClientEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SessionClientEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *HttpSessionData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionClientEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HttpSessionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1885,32 +2101,10 @@ func (m *SessionClientEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ClientIp) > 0 {
-		i -= len(m.ClientIp)
-		copy(dAtA[i:], m.ClientIp)
-		i = encodeVarintSessionTl(dAtA, i, uint64(len(m.ClientIp)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.SessionId != 0 {
-		i = encodeVarintSessionTl(dAtA, i, uint64(m.SessionId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintSessionTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ConnType != 0 {
-		i = encodeVarintSessionTl(dAtA, i, uint64(m.ConnType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ServerId) > 0 {
-		i -= len(m.ServerId)
-		copy(dAtA[i:], m.ServerId)
-		i = encodeVarintSessionTl(dAtA, i, uint64(len(m.ServerId)))
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintSessionTl(dAtA, i, uint64(len(m.Payload)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1929,7 +2123,7 @@ func (m *SessionClientEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TLSessionClientEvent) Marshal() (dAtA []byte, err error) {
+func (m *TLHttpSessionData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1939,12 +2133,12 @@ func (m *TLSessionClientEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLSessionClientEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLHttpSessionData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLSessionClientEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLHttpSessionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2092,7 +2286,7 @@ func (m *TLSessionClientData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HttpSessionData) Marshal() (dAtA []byte, err error) {
+func (m *SessionClientEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2102,12 +2296,12 @@ func (m *HttpSessionData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HttpSessionData) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionClientEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HttpSessionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionClientEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2116,10 +2310,32 @@ func (m *HttpSessionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintSessionTl(dAtA, i, uint64(len(m.Payload)))
+	if len(m.ClientIp) > 0 {
+		i -= len(m.ClientIp)
+		copy(dAtA[i:], m.ClientIp)
+		i = encodeVarintSessionTl(dAtA, i, uint64(len(m.ClientIp)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SessionId != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.SessionId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ConnType != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.ConnType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ServerId) > 0 {
+		i -= len(m.ServerId)
+		copy(dAtA[i:], m.ServerId)
+		i = encodeVarintSessionTl(dAtA, i, uint64(len(m.ServerId)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2138,7 +2354,7 @@ func (m *HttpSessionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TLHttpSessionData) Marshal() (dAtA []byte, err error) {
+func (m *TLSessionClientEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2148,12 +2364,12 @@ func (m *TLHttpSessionData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLHttpSessionData) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLSessionClientEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLHttpSessionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLSessionClientEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2588,28 +2804,97 @@ func (m *TLSessionPushRpcResultData) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RpcResultData) > 0 {
-		i -= len(m.RpcResultData)
-		copy(dAtA[i:], m.RpcResultData)
-		i = encodeVarintSessionTl(dAtA, i, uint64(len(m.RpcResultData)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.ClientReqMsgId != 0 {
-		i = encodeVarintSessionTl(dAtA, i, uint64(m.ClientReqMsgId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.SessionId != 0 {
-		i = encodeVarintSessionTl(dAtA, i, uint64(m.SessionId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintSessionTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x18
-	}
+	if len(m.RpcResultData) > 0 {
+		i -= len(m.RpcResultData)
+		copy(dAtA[i:], m.RpcResultData)
+		i = encodeVarintSessionTl(dAtA, i, uint64(len(m.RpcResultData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ClientReqMsgId != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.ClientReqMsgId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SessionId != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.SessionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSessionGetAuthKeyExpiresAt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSessionGetAuthKeyExpiresAt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSessionGetAuthKeyExpiresAt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSessionDrainSessions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSessionDrainSessions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSessionDrainSessions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if m.Constructor != 0 {
 		i = encodeVarintSessionTl(dAtA, i, uint64(m.Constructor))
 		i--
@@ -2629,7 +2914,7 @@ func encodeVarintSessionTl(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *SessionClientEvent) Size() (n int) {
+func (m *HttpSessionData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2642,20 +2927,7 @@ func (m *SessionClientEvent) Size() (n int) {
 	if m.Constructor != 0 {
 		n += 1 + sovSessionTl(uint64(m.Constructor))
 	}
-	l = len(m.ServerId)
-	if l > 0 {
-		n += 1 + l + sovSessionTl(uint64(l))
-	}
-	if m.ConnType != 0 {
-		n += 1 + sovSessionTl(uint64(m.ConnType))
-	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovSessionTl(uint64(m.AuthKeyId))
-	}
-	if m.SessionId != 0 {
-		n += 1 + sovSessionTl(uint64(m.SessionId))
-	}
-	l = len(m.ClientIp)
+	l = len(m.Payload)
 	if l > 0 {
 		n += 1 + l + sovSessionTl(uint64(l))
 	}
@@ -2665,7 +2937,7 @@ func (m *SessionClientEvent) Size() (n int) {
 	return n
 }
 
-func (m *TLSessionClientEvent) Size() (n int) {
+func (m *TLHttpSessionData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2743,7 +3015,7 @@ func (m *TLSessionClientData) Size() (n int) {
 	return n
 }
 
-func (m *HttpSessionData) Size() (n int) {
+func (m *SessionClientEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2756,7 +3028,20 @@ func (m *HttpSessionData) Size() (n int) {
 	if m.Constructor != 0 {
 		n += 1 + sovSessionTl(uint64(m.Constructor))
 	}
-	l = len(m.Payload)
+	l = len(m.ServerId)
+	if l > 0 {
+		n += 1 + l + sovSessionTl(uint64(l))
+	}
+	if m.ConnType != 0 {
+		n += 1 + sovSessionTl(uint64(m.ConnType))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSessionTl(uint64(m.AuthKeyId))
+	}
+	if m.SessionId != 0 {
+		n += 1 + sovSessionTl(uint64(m.SessionId))
+	}
+	l = len(m.ClientIp)
 	if l > 0 {
 		n += 1 + l + sovSessionTl(uint64(l))
 	}
@@ -2766,7 +3051,7 @@ func (m *HttpSessionData) Size() (n int) {
 	return n
 }
 
-func (m *TLHttpSessionData) Size() (n int) {
+func (m *TLSessionClientEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2980,13 +3265,46 @@ func (m *TLSessionPushRpcResultData) Size() (n int) {
 	return n
 }
 
+func (m *TLSessionGetAuthKeyExpiresAt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSessionTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSessionDrainSessions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSessionTl(uint64(m.Constructor))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSessionTl(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozSessionTl(x uint64) (n int) {
 	return sovSessionTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *SessionClientEvent) Unmarshal(dAtA []byte) error {
+func (m *HttpSessionData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3009,10 +3327,10 @@ func (m *SessionClientEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionClientEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: HttpSessionData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionClientEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HttpSessionData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3068,9 +3386,9 @@ func (m *SessionClientEvent) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSessionTl
@@ -3080,112 +3398,25 @@ func (m *SessionClientEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSessionTl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSessionTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnType", wireType)
-			}
-			m.ConnType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConnType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
-			}
-			m.AuthKeyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
-			}
-			m.SessionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SessionId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientIp", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSessionTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
-			m.ClientIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3209,7 +3440,7 @@ func (m *SessionClientEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLSessionClientEvent) Unmarshal(dAtA []byte) error {
+func (m *TLHttpSessionData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3232,10 +3463,10 @@ func (m *TLSessionClientEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_sessionClientEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_httpSessionData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_sessionClientEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_httpSessionData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3268,7 +3499,7 @@ func (m *TLSessionClientEvent) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Data2 == nil {
-				m.Data2 = &SessionClientEvent{}
+				m.Data2 = &HttpSessionData{}
 			}
 			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3678,7 +3909,7 @@ func (m *TLSessionClientData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HttpSessionData) Unmarshal(dAtA []byte) error {
+func (m *SessionClientEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3697,19 +3928,70 @@ func (m *HttpSessionData) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HttpSessionData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HttpSessionData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionClientEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionClientEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3737,13 +4019,13 @@ func (m *HttpSessionData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			m.ServerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnType", wireType)
 			}
-			m.Constructor = 0
+			m.ConnType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSessionTl
@@ -3753,16 +4035,54 @@ func (m *HttpSessionData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.ConnType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			m.SessionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIp", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSessionTl
@@ -3772,25 +4092,23 @@ func (m *HttpSessionData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSessionTl
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSessionTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.ClientIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3814,7 +4132,7 @@ func (m *HttpSessionData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLHttpSessionData) Unmarshal(dAtA []byte) error {
+func (m *TLSessionClientEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3837,10 +4155,10 @@ func (m *TLHttpSessionData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_httpSessionData: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_sessionClientEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_httpSessionData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_sessionClientEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3873,7 +4191,7 @@ func (m *TLHttpSessionData) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Data2 == nil {
-				m.Data2 = &HttpSessionData{}
+				m.Data2 = &SessionClientEvent{}
 			}
 			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5025,6 +5343,165 @@ func (m *TLSessionPushRpcResultData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TLSessionGetAuthKeyExpiresAt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_session_getAuthKeyExpiresAt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_session_getAuthKeyExpiresAt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSessionDrainSessions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_session_drainSessions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_session_drainSessions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSessionTl(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,19 +22,19 @@ import (
 	"context"
 	"fmt"
 
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeromicro/go-zero/core/jsonx"
 )
 
 type defaultInboxMqClient struct {
-	cli *kafka.Producer
+	cli mqx.Producer
 }
 
-func NewInboxMqClient(cli *kafka.Producer) InboxClient {
+func NewInboxMqClient(cli mqx.Producer) InboxClient {
 	return &defaultInboxMqClient{
 		cli: cli,
 	}
@@ -77,6 +77,15 @@ func (m *defaultInboxMqClient) InboxSendChatMessageToInbox(ctx context.Context,
 		in)
 }
 
+// InboxSendChannelMessageToInbox
+// inbox.sendChannelMessageToInbox from_id:long peer_channel_id:long message:MessageBox = Void;
+func (m *defaultInboxMqClient) InboxSendChannelMessageToInbox(ctx context.Context, in *inbox.TLInboxSendChannelMessageToInbox) (*mtproto.Void, error) {
+	return m.sendMessage(
+		ctx,
+		fmt.Sprintf("%s#%d", proto.MessageName(in), in.GetPeerChannelId()),
+		in)
+}
+
 // InboxSendUserMultiMessageToInbox
 // inbox.sendUserMultiMessageToInbox from_id:long peer_user_id:long message:Vector<InboxMessageData> = Void;
 func (m *defaultInboxMqClient) InboxSendUserMultiMessageToInbox(ctx context.Context, in *inbox.TLInboxSendUserMultiMessageToInbox) (*mtproto.Void, error) {
@@ -95,6 +104,15 @@ func (m *defaultInboxMqClient) InboxSendChatMultiMessageToInbox(ctx context.Cont
 		in)
 }
 
+// InboxSendChannelMultiMessageToInbox
+// inbox.sendChannelMultiMessageToInbox from_id:long peer_channel_id:long message:Vector<MessageBox> = Void;
+func (m *defaultInboxMqClient) InboxSendChannelMultiMessageToInbox(ctx context.Context, in *inbox.TLInboxSendChannelMultiMessageToInbox) (*mtproto.Void, error) {
+	return m.sendMessage(
+		ctx,
+		fmt.Sprintf("%s#%d", proto.MessageName(in), in.GetPeerChannelId()),
+		in)
+}
+
 // InboxEditUserMessageToInbox
 // inbox.editUserMessageToInbox from_id:long peer_user_id:long message:Message = Void;
 func (m *defaultInboxMqClient) InboxEditUserMessageToInbox(ctx context.Context, in *inbox.TLInboxEditUserMessageToInbox) (*mtproto.Void, error) {
@@ -113,6 +131,15 @@ func (m *defaultInboxMqClient) InboxEditChatMessageToInbox(ctx context.Context,
 		in)
 }
 
+// InboxEditChannelMessageToInbox
+// inbox.editChannelMessageToInbox from_id:long peer_channel_id:long pts:int pts_count:int message:Message = Void;
+func (m *defaultInboxMqClient) InboxEditChannelMessageToInbox(ctx context.Context, in *inbox.TLInboxEditChannelMessageToInbox) (*mtproto.Void, error) {
+	return m.sendMessage(
+		ctx,
+		fmt.Sprintf("%s#%d", proto.MessageName(in), in.GetPeerChannelId()),
+		in)
+}
+
 // InboxDeleteMessagesToInbox
 // inbox.deleteMessagesToInbox from_id:long id:Vector<int> = Void;
 func (m *defaultInboxMqClient) InboxDeleteMessagesToInbox(ctx context.Context, in *inbox.TLInboxDeleteMessagesToInbox) (*mtproto.Void, error) {
@@ -140,6 +167,15 @@ func (m *defaultInboxMqClient) InboxDeleteChatHistoryToInbox(ctx context.Context
 		in)
 }
 
+// InboxDeleteChannelMessagesToInbox
+// inbox.deleteChannelMessagesToInbox from_id:long peer_channel_id:long pts:int pts_count:int id:Vector<int> = Void;
+func (m *defaultInboxMqClient) InboxDeleteChannelMessagesToInbox(ctx context.Context, in *inbox.TLInboxDeleteChannelMessagesToInbox) (*mtproto.Void, error) {
+	return m.sendMessage(
+		ctx,
+		fmt.Sprintf("%s#%d", proto.MessageName(in), in.GetPeerChannelId()),
+		in)
+}
+
 // InboxReadUserMediaUnreadToInbox
 // inbox.readUserMediaUnreadToInbox from_id:long id:Vector<int> = Void;
 func (m *defaultInboxMqClient) InboxReadUserMediaUnreadToInbox(ctx context.Context, in *inbox.TLInboxReadUserMediaUnreadToInbox) (*mtproto.Void, error) {
@@ -158,6 +194,15 @@ func (m *defaultInboxMqClient) InboxReadChatMediaUnreadToInbox(ctx context.Conte
 		in)
 }
 
+// InboxReadChannelMediaUnreadToInbox
+// inbox.readChannelMediaUnreadToInbox from_id:long peer_channel_id:long id:Vector<int> = Void;
+func (m *defaultInboxMqClient) InboxReadChannelMediaUnreadToInbox(ctx context.Context, in *inbox.TLInboxReadChannelMediaUnreadToInbox) (*mtproto.Void, error) {
+	return m.sendMessage(
+		ctx,
+		fmt.Sprintf("%s#%d", proto.MessageName(in), in.GetPeerChannelId()),
+		in)
+}
+
 // InboxUpdateHistoryReaded
 // inbox.updateHistoryReaded from_id:long peer_type:int peer_id:long max_id:int = Void;
 func (m *defaultInboxMqClient) InboxUpdateHistoryReaded(ctx context.Context, in *inbox.TLInboxUpdateHistoryReaded) (*mtproto.Void, error) {
@@ -11,16 +11,16 @@
 package inbox_helper
 
 import (
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/internal/config"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/internal/server/mq"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/internal/svc"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 )
 
 type (
 	Config = config.Config
 )
 
-func New(c Config) *kafka.ConsumerGroup {
-	return mq.New(svc.NewServiceContext(c), c.InboxConsumer)
+func New(c Config) mqx.Consumer {
+	return mq.New(svc.NewServiceContext(c), c.InboxConsumer, c.InboxRetry)
 }
@@ -12,6 +12,8 @@ package config
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -19,13 +21,16 @@ import (
 type Config struct {
 	zrpc.RpcServerConf
 	InboxConsumer   kafka.KafkaConsumerConf
+	InboxRetry      mqx.RetryConf
 	Mysql           sqlx.Config
 	KV              kv.KvConf
 	IdgenClient     zrpc.RpcClientConf
 	UserClient      zrpc.RpcClientConf
 	ChatClient      zrpc.RpcClientConf
+	ChannelClient   zrpc.RpcClientConf
 	DialogClient    zrpc.RpcClientConf
 	SyncClient      *kafka.KafkaProducerConf
 	BotSyncClient   *kafka.KafkaProducerConf `json:",optional"`
 	MessageSharding int                      `json:",default=1"`
+	SearchIndex     message_helper.SearchIndexConfig
 }
@@ -24,6 +24,9 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/mtproto/rpc/metadata"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/internal/svc"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 
@@ -97,8 +100,98 @@ func (c *InboxCore) makeUpdateNewMessageListUpdates(selfUserId int64, boxList ..
 			rUpdates.PushChat(chats.GetChatListByIdList(selfUserId, chatIdList...)...)
 		},
 		func(channelIdList []int64) {
-			// TODO
+			channels, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx,
+				&channelpb.TLChannelGetChannelListByIdList{
+					SelfId: selfUserId,
+					Id:     channelIdList,
+				})
+			rUpdates.PushChat(channels.GetChatListByIdList(selfUserId, channelIdList...)...)
 		})
 
 	return rUpdates
 }
+
+// sendChannelMessageListToInbox bumps the channel dialog of every participant and
+// pushes updateNewChannelMessage to everyone except the sender. A participant whose
+// dialog already tops at the last box got the list on an earlier try.
+func (c *InboxCore) sendChannelMessageListToInbox(fromId, channelId int64, boxList ...*mtproto.MessageBox) error {
+	if len(boxList) == 0 {
+		return nil
+	}
+
+	idList, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelParticipantIdList(c.ctx, &channelpb.TLChannelGetChannelParticipantIdList{
+		ChannelId: channelId,
+	})
+	if err != nil {
+		c.Logger.Errorf("inbox.sendChannelMessageToInbox - error: %v", err)
+		return err
+	}
+
+	var (
+		topBox   = boxList[len(boxList)-1]
+		date     = int64(topBox.GetMessage().GetDate())
+		toIdList = make([]int64, 0, len(idList.GetDatas()))
+	)
+
+	c.svcCtx.Dao.DialogsDAO.UpdateOutboxDialog(c.ctx, topBox.MessageId, date, fromId, mtproto.PEER_CHANNEL, channelId)
+
+	for _, userId := range idList.GetDatas() {
+		if userId != fromId {
+			toIdList = append(toIdList, userId)
+		}
+	}
+
+	return c.forEachMember(
+		toIdList,
+		func(userId int64) (bool, error) {
+			dialogDO, err := c.svcCtx.Dao.DialogsDAO.SelectDialog(c.ctx, userId, mtproto.PEER_CHANNEL, channelId)
+			if err != nil {
+				return false, err
+			}
+			return dialogDO != nil && dialogDO.TopMessage >= topBox.MessageId, nil
+		},
+		func(userId int64) error {
+			_, _, err := c.svcCtx.Dao.DialogsDAO.InsertOrUpdate(c.ctx, &dataobject.DialogsDO{
+				UserId:           userId,
+				PeerType:         mtproto.PEER_CHANNEL,
+				PeerId:           channelId,
+				PeerDialogId:     mtproto.MakePeerDialogId(mtproto.PEER_CHANNEL, channelId),
+				TopMessage:       topBox.MessageId,
+				UnreadCount:      int32(len(boxList)),
+				DraftMessageData: "null",
+				Date2:            date,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+				UserId:  userId,
+				Updates: c.makeUpdateNewMessageListUpdates(userId, boxList...),
+			})
+			return err
+		})
+}
+
+// pushChannelUpdatesToParticipants pushes one update to every participant of
+// the channel except fromId.
+func (c *InboxCore) pushChannelUpdatesToParticipants(fromId, channelId int64, cb func(userId int64) *mtproto.Update) {
+	idList, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelParticipantIdList(c.ctx, &channelpb.TLChannelGetChannelParticipantIdList{
+		ChannelId: channelId,
+	})
+	if err != nil {
+		c.Logger.Errorf("inbox.pushChannelUpdatesToParticipants - error: %v", err)
+		return
+	}
+
+	for _, userId := range idList.GetDatas() {
+		if userId == fromId {
+			continue
+		}
+
+		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+			UserId:  userId,
+			Updates: mtproto.MakeUpdatesByUpdates(cb(userId)),
+		})
+	}
+}
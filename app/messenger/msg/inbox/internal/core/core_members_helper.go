@@ -0,0 +1,42 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+// forEachMember calls send for every user in idList and returns the first error,
+// so the consumer retries the request. A failing member does not stop the others.
+// done reports the members an earlier try already got to, they are skipped and
+// get no new pts or push on a retry.
+func (c *InboxCore) forEachMember(idList []int64, done func(userId int64) (bool, error), send func(userId int64) error) error {
+	var rErr error
+
+	for _, userId := range idList {
+		ok, err := done(userId)
+		if err == nil && !ok {
+			err = send(userId)
+		}
+		if err != nil {
+			c.Logger.Errorf("inbox.forEachMember - user %d, error: %v", userId, err)
+			if rErr == nil {
+				rErr = err
+			}
+		}
+	}
+
+	return rErr
+}
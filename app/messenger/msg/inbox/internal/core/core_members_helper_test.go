@@ -0,0 +1,115 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// testInbox stands in for the message boxes, failUserId fails once.
+type testInbox struct {
+	boxes      map[int64]bool
+	pts        map[int64]int
+	pushed     []int64
+	failUserId int64
+}
+
+func (b *testInbox) done(userId int64) (bool, error) {
+	return b.boxes[userId], nil
+}
+
+func (b *testInbox) send(userId int64) error {
+	if userId == b.failUserId {
+		b.failUserId = 0
+		return errors.New("db down")
+	}
+	b.boxes[userId] = true
+	b.pts[userId]++
+	b.pushed = append(b.pushed, userId)
+	return nil
+}
+
+func TestForEachMemberRetry(t *testing.T) {
+	var (
+		c      = New(context.Background(), nil)
+		idList = []int64{1, 2, 3, 4}
+		inbox  = &testInbox{
+			// 1 got the message before the consumer crashed
+			boxes:      map[int64]bool{1: true},
+			pts:        map[int64]int{},
+			failUserId: 3,
+		}
+	)
+
+	if err := c.forEachMember(idList, inbox.done, inbox.send); err == nil {
+		t.Fatal("member 3 failed, want an error")
+	}
+	if want := []int64{2, 4}; !reflect.DeepEqual(inbox.pushed, want) {
+		t.Fatalf("pushed %v, want %v", inbox.pushed, want)
+	}
+
+	// the retry reaches 3 only
+	inbox.pushed = nil
+	if err := c.forEachMember(idList, inbox.done, inbox.send); err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if want := []int64{3}; !reflect.DeepEqual(inbox.pushed, want) {
+		t.Fatalf("retry pushed %v, want %v", inbox.pushed, want)
+	}
+
+	for _, userId := range idList[1:] {
+		if inbox.pts[userId] != 1 {
+			t.Errorf("user %d got pts %d times, want once", userId, inbox.pts[userId])
+		}
+	}
+	if inbox.pts[1] != 0 {
+		t.Errorf("user 1 got pts again on a retry")
+	}
+}
+
+func TestForEachMemberDoneError(t *testing.T) {
+	var (
+		c      = New(context.Background(), nil)
+		errDb  = errors.New("db down")
+		pushed []int64
+	)
+
+	err := c.forEachMember(
+		[]int64{1, 2},
+		func(userId int64) (bool, error) {
+			if userId == 1 {
+				return false, errDb
+			}
+			return false, nil
+		},
+		func(userId int64) error {
+			pushed = append(pushed, userId)
+			return nil
+		})
+	if err != errDb {
+		t.Fatalf("got error %v, want %v", err, errDb)
+	}
+	// 1 is left for the retry, it may not have its box yet
+	if want := []int64{2}; !reflect.DeepEqual(pushed, want) {
+		t.Fatalf("pushed %v, want %v", pushed, want)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
+)
+
+// InboxDeleteChannelMessagesToInbox
+// inbox.deleteChannelMessagesToInbox from_id:long peer_channel_id:long pts:int pts_count:int id:Vector<int> = Void;
+func (c *InboxCore) InboxDeleteChannelMessagesToInbox(in *inbox.TLInboxDeleteChannelMessagesToInbox) (*mtproto.Void, error) {
+	updateDeleteChannelMessages := mtproto.MakeTLUpdateDeleteChannelMessages(&mtproto.Update{
+		ChannelId: in.PeerChannelId,
+		Messages:  in.Id,
+		Pts_INT32: in.Pts,
+		PtsCount:  in.PtsCount,
+	}).To_Update()
+
+	c.pushChannelUpdatesToParticipants(
+		in.FromId,
+		in.PeerChannelId,
+		func(userId int64) *mtproto.Update {
+			return updateDeleteChannelMessages
+		})
+
+	return mtproto.EmptyVoid, nil
+}
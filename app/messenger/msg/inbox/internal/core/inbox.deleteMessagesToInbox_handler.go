@@ -28,13 +28,13 @@ import (
 // InboxDeleteMessagesToInbox
 // inbox.deleteMessagesToInbox from_id:long id:Vector<int> = Void;
 func (c *InboxCore) InboxDeleteMessagesToInbox(in *inbox.TLInboxDeleteMessagesToInbox) (*mtproto.Void, error) {
-	c.svcCtx.Dao.DeleteInboxMessages(
+	err := c.svcCtx.Dao.DeleteInboxMessages(
 		c.ctx,
 		in.FromId,
 		mtproto.MakePeerUtil(in.PeerType, in.PeerId),
 		in.Id,
-		func(ctx context.Context, userId int64, idList []int32) {
-			c.svcCtx.Dao.SyncClient.SyncPushUpdates(ctx, &sync.TLSyncPushUpdates{
+		func(ctx context.Context, userId int64, idList []int32) error {
+			_, err := c.svcCtx.Dao.SyncClient.SyncPushUpdates(ctx, &sync.TLSyncPushUpdates{
 				UserId: userId,
 				Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateDeleteMessages(&mtproto.Update{
 					Messages:  idList,
@@ -42,6 +42,12 @@ func (c *InboxCore) InboxDeleteMessagesToInbox(in *inbox.TLInboxDeleteMessagesTo
 					PtsCount:  int32(len(idList)),
 				}).To_Update()),
 			})
+			return err
 		})
+	if err != nil {
+		c.Logger.Errorf("inbox.deleteMessagesToInbox - error: %v", err)
+		return nil, err
+	}
+
 	return mtproto.EmptyVoid, nil
 }
@@ -32,51 +32,63 @@ func (c *InboxCore) InboxEditChatMessageToInbox(in *inbox.TLInboxEditChatMessage
 		return mtproto.EmptyVoid, nil
 	}
 
+	toIdList := make([]int64, 0, len(chatUserIdList.Datas))
 	for _, toId := range chatUserIdList.Datas {
-		message := proto.Clone(in.Message).(*mtproto.Message)
-		if toId == in.FromId {
-			continue
+		if toId != in.FromId {
+			toIdList = append(toIdList, toId)
 		}
+	}
 
-		inBox, err := c.svcCtx.Dao.EditUserInboxMessage(c.ctx, in.FromId, toId, message)
-		if err != nil {
-			c.Logger.Errorf("inbox.editChatMessageToInbox - error: %v", err)
-			// return err
-			continue
-		} else if inBox == nil {
-			c.Logger.Errorf("inbox.editChatMessageToInbox - error: {inBox is nil}")
-			continue
-		}
+	err = c.forEachMember(
+		toIdList,
+		func(toId int64) (bool, error) {
+			return c.svcCtx.Dao.HasEditedChatInboxMessage(c.ctx, in.FromId, toId, in.Message)
+		},
+		func(toId int64) error {
+			message := proto.Clone(in.Message).(*mtproto.Message)
 
-		pushUpdates := mtproto.MakePushUpdates(
-			func(idList []int64) []*mtproto.User {
-				users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
-					&userpb.TLUserGetMutableUsers{
-						Id: idList,
-					})
-				return users.GetUserListByIdList(toId, idList...)
-			},
-			func(idList []int64) []*mtproto.Chat {
-				chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
-					&chatpb.TLChatGetChatListByIdList{
-						IdList: idList,
-					})
-				return chats.GetChatListByIdList(toId, idList...)
-			},
-			func(idList []int64) []*mtproto.Chat {
-				// TODO
+			inBox, err := c.svcCtx.Dao.EditChatInboxMessage(c.ctx, in.FromId, in.PeerChatId, toId, message)
+			if err != nil {
+				return err
+			} else if inBox == nil {
+				c.Logger.Errorf("inbox.editChatMessageToInbox - error: {inBox is nil}")
 				return nil
-			},
-			mtproto.MakeTLUpdateEditMessage(&mtproto.Update{
-				Pts_INT32:       inBox.Pts,
-				PtsCount:        inBox.PtsCount,
-				Message_MESSAGE: inBox.Message,
-			}).To_Update())
+			}
+
+			pushUpdates := mtproto.MakePushUpdates(
+				func(idList []int64) []*mtproto.User {
+					users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+						&userpb.TLUserGetMutableUsers{
+							Id: idList,
+						})
+					return users.GetUserListByIdList(toId, idList...)
+				},
+				func(idList []int64) []*mtproto.Chat {
+					chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
+						&chatpb.TLChatGetChatListByIdList{
+							IdList: idList,
+						})
+					return chats.GetChatListByIdList(toId, idList...)
+				},
+				func(idList []int64) []*mtproto.Chat {
+					// TODO
+					return nil
+				},
+				mtproto.MakeTLUpdateEditMessage(&mtproto.Update{
+					Pts_INT32:       inBox.Pts,
+					PtsCount:        inBox.PtsCount,
+					Message_MESSAGE: inBox.Message,
+				}).To_Update())
 
-		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
-			UserId:  toId,
-			Updates: pushUpdates,
+			_, err = c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+				UserId:  toId,
+				Updates: pushUpdates,
+			})
+			return err
 		})
+	if err != nil {
+		c.Logger.Errorf("inbox.editChatMessageToInbox - error: %v", err)
+		return nil, err
 	}
 
 	return mtproto.EmptyVoid, nil
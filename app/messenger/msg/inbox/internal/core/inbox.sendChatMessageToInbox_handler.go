@@ -30,6 +30,8 @@ import (
 // InboxSendChatMessageToInbox
 // inbox.sendChatMessageToInbox from_id:long peer_chat_id:long message:InboxMessageData = Void;
 func (c *InboxCore) InboxSendChatMessageToInbox(in *inbox.TLInboxSendChatMessageToInbox) (*mtproto.Void, error) {
+	var toIdList []int64
+
 	_, err := c.svcCtx.Dao.ChatParticipantsDAO.SelectListWithCB(
 		c.ctx,
 		in.PeerChatId,
@@ -59,17 +61,29 @@ func (c *InboxCore) InboxSendChatMessageToInbox(in *inbox.TLInboxSendChatMessage
 				return
 			}
 
+			toIdList = append(toIdList, v.UserId)
+		})
+	if err != nil {
+		c.Logger.Errorf("inbox.sendChatMessageToInbox - error: %v", err)
+		return nil, err
+	}
+
+	err = c.forEachMember(
+		toIdList,
+		func(toId int64) (bool, error) {
+			return c.svcCtx.Dao.HasInboxMessage(c.ctx, toId, in.Message.DialogMessageId)
+		},
+		func(toId int64) error {
 			inBox, err := c.svcCtx.Dao.SendChatMessageToInbox(
 				c.ctx,
 				in.FromId,
 				in.PeerChatId,
-				v.UserId,
+				toId,
 				in.Message.DialogMessageId,
 				in.Message.RandomId,
 				in.Message.GetMessage())
 			if err != nil {
-				c.Logger.Errorf(err.Error())
-				return
+				return err
 			}
 
 			var (
@@ -84,7 +98,7 @@ func (c *InboxCore) InboxSendChatMessageToInbox(in *inbox.TLInboxSendChatMessage
 				c.svcCtx.Dao.DialogsDAO.UpdateReadInboxMaxId(
 					c.ctx,
 					inBox.MessageId,
-					v.UserId,
+					toId,
 					mtproto.MakePeerDialogId(mtproto.PEER_CHAT, in.PeerChatId))
 
 				updates = append(updates, mtproto.MakeTLUpdateReadHistoryInbox(&mtproto.Update{
@@ -92,14 +106,14 @@ func (c *InboxCore) InboxSendChatMessageToInbox(in *inbox.TLInboxSendChatMessage
 					Peer_PEER:        mtproto.MakePeerChat(in.PeerChatId),
 					MaxId:            inBox.MessageId,
 					StillUnreadCount: 0,
-					Pts_INT32:        c.svcCtx.Dao.NextPtsId(c.ctx, v.UserId),
+					Pts_INT32:        c.svcCtx.Dao.NextPtsId(c.ctx, toId),
 					PtsCount:         1,
 				}).To_Update())
 			}
 
 			//users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
 			//	&userpb.TLUserGetMutableUsers{
-			//		Id: []int64{in.FromId, v.UserId},
+			//		Id: []int64{in.FromId, toId},
 			//	})
 
 			var (
@@ -110,21 +124,21 @@ func (c *InboxCore) InboxSendChatMessageToInbox(in *inbox.TLInboxSendChatMessage
 				func(idList []int64) []*mtproto.User {
 					users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
 						&userpb.TLUserGetMutableUsers{
-							Id: append(idList, v.UserId),
+							Id: append(idList, toId),
 						})
 					for _, bot := range users.GetDatas() {
-						if bot.Id() == v.UserId && bot.IsBot() {
+						if bot.Id() == toId && bot.IsBot() {
 							isBot = true
 						}
 					}
-					return users.GetUserListByIdList(v.UserId, append(idList, v.UserId)...)
+					return users.GetUserListByIdList(toId, append(idList, toId)...)
 				},
 				func(idList []int64) []*mtproto.Chat {
 					chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
 						&chatpb.TLChatGetChatListByIdList{
 							IdList: idList,
 						})
-					return chats.GetChatListByIdList(v.UserId, idList...)
+					return chats.GetChatListByIdList(toId, idList...)
 				},
 				func(idList []int64) []*mtproto.Chat {
 					// TODO
@@ -135,21 +149,23 @@ func (c *InboxCore) InboxSendChatMessageToInbox(in *inbox.TLInboxSendChatMessage
 			if isBot {
 				if c.svcCtx.Dao.BotSyncClient != nil {
 					_, err = c.svcCtx.Dao.BotSyncClient.SyncPushBotUpdates(c.ctx, &sync.TLSyncPushBotUpdates{
-						UserId:  v.UserId,
+						UserId:  toId,
 						Updates: pushUpdates,
 					})
 				} else {
 					// TODO: log
 				}
 			} else {
-				c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
-					UserId:  v.UserId,
+				_, err = c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+					UserId:  toId,
 					Updates: pushUpdates,
 				})
 			}
+			return err
 		})
 	if err != nil {
-		c.Logger.Errorf("inbox.sendUserMessageToInbox - error: %v", err)
+		c.Logger.Errorf("inbox.sendChatMessageToInbox - error: %v", err)
+		return nil, err
 	}
 
 	return mtproto.EmptyVoid, nil
@@ -49,6 +49,21 @@ func (s *Service) InboxSendChatMessageToInbox(ctx context.Context, request *inbo
 	return r, err
 }
 
+// InboxSendChannelMessageToInbox
+// inbox.sendChannelMessageToInbox from_id:long peer_channel_id:long message:MessageBox = Void;
+func (s *Service) InboxSendChannelMessageToInbox(ctx context.Context, request *inbox.TLInboxSendChannelMessageToInbox) (*mtproto.Void, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("inbox.sendChannelMessageToInbox - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.InboxSendChannelMessageToInbox(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("inbox.sendChannelMessageToInbox - reply: %s", r.DebugString())
+	return r, err
+}
+
 // InboxSendUserMultiMessageToInbox
 // inbox.sendUserMultiMessageToInbox from_id:long peer_user_id:long message:Vector<InboxMessageData> = Void;
 func (s *Service) InboxSendUserMultiMessageToInbox(ctx context.Context, request *inbox.TLInboxSendUserMultiMessageToInbox) (*mtproto.Void, error) {
@@ -79,6 +94,21 @@ func (s *Service) InboxSendChatMultiMessageToInbox(ctx context.Context, request
 	return r, err
 }
 
+// InboxSendChannelMultiMessageToInbox
+// inbox.sendChannelMultiMessageToInbox from_id:long peer_channel_id:long message:Vector<MessageBox> = Void;
+func (s *Service) InboxSendChannelMultiMessageToInbox(ctx context.Context, request *inbox.TLInboxSendChannelMultiMessageToInbox) (*mtproto.Void, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("inbox.sendChannelMultiMessageToInbox - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.InboxSendChannelMultiMessageToInbox(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("inbox.sendChannelMultiMessageToInbox - reply: %s", r.DebugString())
+	return r, err
+}
+
 // InboxEditUserMessageToInbox
 // inbox.editUserMessageToInbox from_id:long peer_user_id:long message:Message = Void;
 func (s *Service) InboxEditUserMessageToInbox(ctx context.Context, request *inbox.TLInboxEditUserMessageToInbox) (*mtproto.Void, error) {
@@ -109,6 +139,21 @@ func (s *Service) InboxEditChatMessageToInbox(ctx context.Context, request *inbo
 	return r, err
 }
 
+// InboxEditChannelMessageToInbox
+// inbox.editChannelMessageToInbox from_id:long peer_channel_id:long pts:int pts_count:int message:Message = Void;
+func (s *Service) InboxEditChannelMessageToInbox(ctx context.Context, request *inbox.TLInboxEditChannelMessageToInbox) (*mtproto.Void, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("inbox.editChannelMessageToInbox - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.InboxEditChannelMessageToInbox(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("inbox.editChannelMessageToInbox - reply: %s", r.DebugString())
+	return r, err
+}
+
 // InboxDeleteMessagesToInbox
 // inbox.deleteMessagesToInbox from_id:long id:Vector<long> = Void;
 func (s *Service) InboxDeleteMessagesToInbox(ctx context.Context, request *inbox.TLInboxDeleteMessagesToInbox) (*mtproto.Void, error) {
@@ -154,6 +199,21 @@ func (s *Service) InboxDeleteChatHistoryToInbox(ctx context.Context, request *in
 	return r, err
 }
 
+// InboxDeleteChannelMessagesToInbox
+// inbox.deleteChannelMessagesToInbox from_id:long peer_channel_id:long pts:int pts_count:int id:Vector<int> = Void;
+func (s *Service) InboxDeleteChannelMessagesToInbox(ctx context.Context, request *inbox.TLInboxDeleteChannelMessagesToInbox) (*mtproto.Void, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("inbox.deleteChannelMessagesToInbox - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.InboxDeleteChannelMessagesToInbox(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("inbox.deleteChannelMessagesToInbox - reply: %s", r.DebugString())
+	return r, err
+}
+
 // InboxReadUserMediaUnreadToInbox
 // inbox.readUserMediaUnreadToInbox from_id:long peer_user_id:long id:Vector<InboxMessageId> = Void;
 func (s *Service) InboxReadUserMediaUnreadToInbox(ctx context.Context, request *inbox.TLInboxReadUserMediaUnreadToInbox) (*mtproto.Void, error) {
@@ -184,6 +244,21 @@ func (s *Service) InboxReadChatMediaUnreadToInbox(ctx context.Context, request *
 	return r, err
 }
 
+// InboxReadChannelMediaUnreadToInbox
+// inbox.readChannelMediaUnreadToInbox from_id:long peer_channel_id:long id:Vector<int> = Void;
+func (s *Service) InboxReadChannelMediaUnreadToInbox(ctx context.Context, request *inbox.TLInboxReadChannelMediaUnreadToInbox) (*mtproto.Void, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("inbox.readChannelMediaUnreadToInbox - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.InboxReadChannelMediaUnreadToInbox(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("inbox.readChannelMediaUnreadToInbox - reply: %s", r.DebugString())
+	return r, err
+}
+
 // InboxUpdateHistoryReaded
 // inbox.updateHistoryReaded from_id:long peer_type:int peer_id:long max_id:int sender:long = Void;
 func (s *Service) InboxUpdateHistoryReaded(ctx context.Context, request *inbox.TLInboxUpdateHistoryReaded) (*mtproto.Void, error) {
@@ -228,4 +303,3 @@ func (s *Service) InboxUnpinAllMessages(ctx context.Context, request *inbox.TLIn
 	c.Logger.Debugf("inbox.unpinAllMessages - reply: %s", r.DebugString())
 	return r, err
 }
-
@@ -28,17 +28,18 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/internal/core"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/internal/svc"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 // New new a grpc server.
-func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf) *kafka.ConsumerGroup {
-	s := kafka.MustKafkaConsumer(&conf)
+func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf, retry mqx.RetryConf) mqx.Consumer {
+	s := mqx.MustConsumer(&conf)
 	s.RegisterHandlers(
 		conf.Topics[0],
-		func(ctx context.Context, key string, value []byte) {
+		mqx.WithRetry(retry, conf.Topics[0], conf.Group, mqx.MustDeadLetterProducer(retry), func(ctx context.Context, key string, value []byte) error {
 			logx.WithContext(ctx).Debugf("key: %s, value: %s", key, value)
 
 			switch strings.Split(key, "#")[0] {
@@ -48,158 +49,233 @@ func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf) *kafka.Consum
 				r := new(inbox.TLInboxSendUserMessageToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.sendUserMessageToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.sendUserMessageToInbox - request: %s", r.DebugString())
 
-				c.InboxSendUserMessageToInbox(r)
+				_, err := c.InboxSendUserMessageToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxSendChatMessageToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxSendChatMessageToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.sendChatMessageToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.sendChatMessageToInbox - request: %s", r.DebugString())
 
-				c.InboxSendChatMessageToInbox(r)
+				_, err := c.InboxSendChatMessageToInbox(r)
+				return err
+			case proto.MessageName((*inbox.TLInboxSendChannelMessageToInbox)(nil)):
+				c := core.New(ctx, svcCtx)
+
+				r := new(inbox.TLInboxSendChannelMessageToInbox)
+				if err := json.Unmarshal(value, r); err != nil {
+					c.Logger.Errorf("inbox.sendChannelMessageToInbox - error: %v", err)
+					return mqx.Permanent(err)
+				}
+				c.Logger.Debugf("inbox.sendChannelMessageToInbox - request: %s", r.DebugString())
+
+				_, err := c.InboxSendChannelMessageToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxSendUserMultiMessageToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxSendUserMultiMessageToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.sendUserMultiMessageToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.sendUserMultiMessageToInbox - request: %s", r.DebugString())
 
-				c.InboxSendUserMultiMessageToInbox(r)
+				_, err := c.InboxSendUserMultiMessageToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxSendChatMultiMessageToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxSendChatMultiMessageToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.sendChatMultiMessageToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.sendChatMultiMessageToInbox - request: %s", r.DebugString())
 
-				c.InboxSendChatMultiMessageToInbox(r)
+				_, err := c.InboxSendChatMultiMessageToInbox(r)
+				return err
+			case proto.MessageName((*inbox.TLInboxSendChannelMultiMessageToInbox)(nil)):
+				c := core.New(ctx, svcCtx)
+
+				r := new(inbox.TLInboxSendChannelMultiMessageToInbox)
+				if err := json.Unmarshal(value, r); err != nil {
+					c.Logger.Errorf("inbox.sendChannelMultiMessageToInbox - error: %v", err)
+					return mqx.Permanent(err)
+				}
+				c.Logger.Debugf("inbox.sendChannelMultiMessageToInbox - request: %s", r.DebugString())
+
+				_, err := c.InboxSendChannelMultiMessageToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxEditUserMessageToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxEditUserMessageToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.editUserMessageToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.editUserMessageToInbox - request: %s", r.DebugString())
 
-				c.InboxEditUserMessageToInbox(r)
+				_, err := c.InboxEditUserMessageToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxEditChatMessageToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxEditChatMessageToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.editChatMessageToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.editChatMessageToInbox - request: %s", r.DebugString())
 
-				c.InboxEditChatMessageToInbox(r)
+				_, err := c.InboxEditChatMessageToInbox(r)
+				return err
+			case proto.MessageName((*inbox.TLInboxEditChannelMessageToInbox)(nil)):
+				c := core.New(ctx, svcCtx)
+
+				r := new(inbox.TLInboxEditChannelMessageToInbox)
+				if err := json.Unmarshal(value, r); err != nil {
+					c.Logger.Errorf("inbox.editChannelMessageToInbox - error: %v", err)
+					return mqx.Permanent(err)
+				}
+				c.Logger.Debugf("inbox.editChannelMessageToInbox - request: %s", r.DebugString())
+
+				_, err := c.InboxEditChannelMessageToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxDeleteMessagesToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxDeleteMessagesToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.deleteMessagesToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.deleteMessagesToInbox - request: %s", r.DebugString())
 
-				c.InboxDeleteMessagesToInbox(r)
+				_, err := c.InboxDeleteMessagesToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxDeleteUserHistoryToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxDeleteUserHistoryToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.deleteUserHistoryToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.deleteUserHistoryToInbox - request: %s", r.DebugString())
 
-				c.InboxDeleteUserHistoryToInbox(r)
+				_, err := c.InboxDeleteUserHistoryToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxDeleteChatHistoryToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxDeleteChatHistoryToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.deleteChatHistoryToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.deleteChatHistoryToInbox - request: %s", r.DebugString())
 
-				c.InboxDeleteChatHistoryToInbox(r)
+				_, err := c.InboxDeleteChatHistoryToInbox(r)
+				return err
+			case proto.MessageName((*inbox.TLInboxDeleteChannelMessagesToInbox)(nil)):
+				c := core.New(ctx, svcCtx)
+
+				r := new(inbox.TLInboxDeleteChannelMessagesToInbox)
+				if err := json.Unmarshal(value, r); err != nil {
+					c.Logger.Errorf("inbox.deleteChannelMessagesToInbox - error: %v", err)
+					return mqx.Permanent(err)
+				}
+				c.Logger.Debugf("inbox.deleteChannelMessagesToInbox - request: %s", r.DebugString())
+
+				_, err := c.InboxDeleteChannelMessagesToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxReadUserMediaUnreadToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxReadUserMediaUnreadToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.readUserMediaUnreadToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.readUserMediaUnreadToInbox - request: %s", r.DebugString())
 
-				c.InboxReadUserMediaUnreadToInbox(r)
+				_, err := c.InboxReadUserMediaUnreadToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxReadChatMediaUnreadToInbox)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxReadChatMediaUnreadToInbox)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.readChatMediaUnreadToInbox - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.readChatMediaUnreadToInbox - request: %s", r.DebugString())
 
-				c.InboxReadChatMediaUnreadToInbox(r)
+				_, err := c.InboxReadChatMediaUnreadToInbox(r)
+				return err
+			case proto.MessageName((*inbox.TLInboxReadChannelMediaUnreadToInbox)(nil)):
+				c := core.New(ctx, svcCtx)
+
+				r := new(inbox.TLInboxReadChannelMediaUnreadToInbox)
+				if err := json.Unmarshal(value, r); err != nil {
+					c.Logger.Errorf("inbox.readChannelMediaUnreadToInbox - error: %v", err)
+					return mqx.Permanent(err)
+				}
+				c.Logger.Debugf("inbox.readChannelMediaUnreadToInbox - request: %s", r.DebugString())
+
+				_, err := c.InboxReadChannelMediaUnreadToInbox(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxUpdateHistoryReaded)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxUpdateHistoryReaded)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.updateHistoryReaded - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.updateHistoryReaded - request: %s", r.DebugString())
 
-				c.InboxUpdateHistoryReaded(r)
+				_, err := c.InboxUpdateHistoryReaded(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxUpdatePinnedMessage)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxUpdatePinnedMessage)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.updatePinnedMessage - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.updatePinnedMessage - request: %s", r.DebugString())
 
-				c.InboxUpdatePinnedMessage(r)
+				_, err := c.InboxUpdatePinnedMessage(r)
+				return err
 			case proto.MessageName((*inbox.TLInboxUnpinAllMessages)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(inbox.TLInboxUnpinAllMessages)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Errorf("inbox.unpinAllMessages - error: %v", err)
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("inbox.unpinAllMessages - request: %s", r.DebugString())
 
-				c.InboxUnpinAllMessages(r)
+				_, err := c.InboxUnpinAllMessages(r)
+				return err
 			default:
 				err := fmt.Errorf("invalid key: %s", key)
 				logx.Error(err.Error())
+				return mqx.Permanent(err)
 			}
-		})
+		}))
 	return s
 }
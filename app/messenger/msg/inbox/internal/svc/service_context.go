@@ -10,17 +10,18 @@
 package svc
 
 import (
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/internal/config"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dao"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
-	// channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/zeromicro/go-zero/core/stores/kv"
 )
@@ -34,16 +35,18 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	db := sqlx.NewMySQL(&c.Mysql)
 
 	dao := &dao.Dao{
-		Mysql:        dao.NewMysqlDao(db, c.MessageSharding),
-		KV:           kv.NewStore(c.KV),
-		IDGenClient2: idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
-		UserClient:   user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
-		ChatClient:   chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
-		SyncClient:   sync_client.NewSyncMqClient(kafka.GetCachedMQClient(c.SyncClient)),
-		DialogClient: dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
+		Mysql:         dao.NewMysqlDao(db, c.MessageSharding),
+		SearchIndex:   message_helper.MustNewSearchIndex(c.SearchIndex, db),
+		KV:            kv.NewStore(c.KV),
+		IDGenClient2:  idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
+		UserClient:    user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		ChatClient:    chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
+		ChannelClient: channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
+		SyncClient:    sync_client.NewSyncMqClient(mqx.GetCachedProducer(c.SyncClient)),
+		DialogClient:  dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
 	}
 	if c.BotSyncClient != nil {
-		dao.BotSyncClient = sync_client.NewSyncMqClient(kafka.GetCachedMQClient(c.BotSyncClient))
+		dao.BotSyncClient = sync_client.NewSyncMqClient(mqx.GetCachedProducer(c.BotSyncClient))
 	}
 
 	return &ServiceContext{
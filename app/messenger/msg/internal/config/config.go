@@ -21,19 +21,26 @@ package config
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
+	MessageBus       mqx.BusConf
 	InboxConsumer    kafka.KafkaConsumerConf
+	InboxRetry       mqx.RetryConf
 	Mysql            sqlx.Config
 	KV               kv.KvConf
 	IdgenClient      zrpc.RpcClientConf
 	BizServiceClient zrpc.RpcClientConf
+	StatusClient     zrpc.RpcClientConf
 	InboxClient      *kafka.KafkaProducerConf
 	SyncClient       *kafka.KafkaProducerConf
 	BotSyncClient    *kafka.KafkaProducerConf `json:",optional"`
 	MessageSharding  int                      `json:",default=1"`
+	EditTimeLimit    int32                    `json:",default=172800"`
+	SearchIndex      message_helper.SearchIndexConfig
 }
@@ -1,2 +1,2 @@
 ./dalgen.sh hash_tags
-
+./dalgen.sh scheduled_messages
@@ -0,0 +1,367 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ScheduledMessagesDAO struct {
+	db *sqlx.DB
+}
+
+func NewScheduledMessagesDAO(db *sqlx.DB) *ScheduledMessagesDAO {
+	return &ScheduledMessagesDAO{db}
+}
+
+// Insert
+// insert into scheduled_messages(user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2) values (:user_id, :user_message_box_id, :peer_type, :peer_id, :random_id, :no_webpage, :background, :message_data, :schedule_date, 0, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) Insert(ctx context.Context, do *dataobject.ScheduledMessagesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into scheduled_messages(user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2) values (:user_id, :user_message_box_id, :peer_type, :peer_id, :random_id, :no_webpage, :background, :message_data, :schedule_date, 0, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into scheduled_messages(user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2) values (:user_id, :user_message_box_id, :peer_type, :peer_id, :random_id, :no_webpage, :background, :message_data, :schedule_date, 0, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) InsertTx(tx *sqlx.Tx, do *dataobject.ScheduledMessagesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into scheduled_messages(user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2) values (:user_id, :user_message_box_id, :peer_type, :peer_id, :random_id, :no_webpage, :background, :message_data, :schedule_date, 0, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByIdList
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and user_message_box_id in (:idList) and state = 0 and deleted = 0 order by schedule_date asc, user_message_box_id asc
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByIdList(ctx context.Context, user_id int64, peer_type int32, peer_id int64, idList []int32) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and user_message_box_id in (?) and state = 0 and deleted = 0 order by schedule_date asc, user_message_box_id asc"
+		a      []interface{}
+		values []dataobject.ScheduledMessagesDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ScheduledMessagesDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByIdListWithCB
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and user_message_box_id in (:idList) and state = 0 and deleted = 0 order by schedule_date asc, user_message_box_id asc
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByIdListWithCB(ctx context.Context, user_id int64, peer_type int32, peer_id int64, idList []int32, cb func(i int, v *dataobject.ScheduledMessagesDO)) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and user_message_box_id in (?) and state = 0 and deleted = 0 order by schedule_date asc, user_message_box_id asc"
+		a      []interface{}
+		values []dataobject.ScheduledMessagesDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ScheduledMessagesDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectDueList
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where state = 0 and schedule_date <= :schedule_date and deleted = 0 order by schedule_date asc, id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectDueList(ctx context.Context, schedule_date int64, limit int32) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where state = 0 and schedule_date <= ? and deleted = 0 order by schedule_date asc, id asc limit ?"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, schedule_date, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectDueList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectDueListWithCB
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where state = 0 and schedule_date <= :schedule_date and deleted = 0 order by schedule_date asc, id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectDueListWithCB(ctx context.Context, schedule_date int64, limit int32, cb func(i int, v *dataobject.ScheduledMessagesDO)) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where state = 0 and schedule_date <= ? and deleted = 0 order by schedule_date asc, id asc limit ?"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, schedule_date, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectDueList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectListByScheduleDate
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where state = 0 and schedule_date = :schedule_date and id > :id and deleted = 0 order by id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectListByScheduleDate(ctx context.Context, schedule_date int64, id int64, limit int32) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where state = 0 and schedule_date = ? and id > ? and deleted = 0 order by id asc limit ?"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, schedule_date, id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByScheduleDate(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByScheduleDateWithCB
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where state = 0 and schedule_date = :schedule_date and id > :id and deleted = 0 order by id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectListByScheduleDateWithCB(ctx context.Context, schedule_date int64, id int64, limit int32, cb func(i int, v *dataobject.ScheduledMessagesDO)) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where state = 0 and schedule_date = ? and id > ? and deleted = 0 order by id asc limit ?"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, schedule_date, id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByScheduleDate(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateSent
+// update scheduled_messages set state = 1 where id = :id and state = 0 and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) UpdateSent(ctx context.Context, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set state = 1 where id = ? and state = 0 and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateSent(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateSent(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateSentTx
+// update scheduled_messages set state = 1 where id = :id and state = 0 and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) UpdateSentTx(tx *sqlx.Tx, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set state = 1 where id = ? and state = 0 and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateSent(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateSent(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByIdList
+// update scheduled_messages set deleted = 1 where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and user_message_box_id in (:idList) and state = 0 and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) DeleteByIdList(ctx context.Context, user_id int64, peer_type int32, peer_id int64, idList []int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set deleted = 1 where user_id = ? and peer_type = ? and peer_id = ? and user_message_box_id in (?) and state = 0 and deleted = 0"
+		a       []interface{}
+		rResult sql.Result
+	)
+
+	if len(idList) == 0 {
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in DeleteByIdList(_), error: %v", err)
+		return
+	}
+	rResult, err = dao.db.Exec(ctx, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteByIdList(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteByIdList(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByIdListTx
+// update scheduled_messages set deleted = 1 where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and user_message_box_id in (:idList) and state = 0 and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) DeleteByIdListTx(tx *sqlx.Tx, user_id int64, peer_type int32, peer_id int64, idList []int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set deleted = 1 where user_id = ? and peer_type = ? and peer_id = ? and user_message_box_id in (?) and state = 0 and deleted = 0"
+		a       []interface{}
+		rResult sql.Result
+	)
+
+	if len(idList) == 0 {
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(tx.Context()).Errorf("sqlx.In in DeleteByIdList(_), error: %v", err)
+		return
+	}
+	rResult, err = tx.Exec(query, a...)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteByIdList(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteByIdList(_), error: %v", err)
+	}
+
+	return
+}
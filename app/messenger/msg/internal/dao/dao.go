@@ -13,11 +13,13 @@ import (
 	inbox_client "github.com/teamgram/teamgram-server/app/messenger/msg/inbox/client"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/plugin"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
-	// channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
+	status_client "github.com/teamgram/teamgram-server/app/service/status/client"
 
 	"github.com/zeromicro/go-zero/core/stores/kv"
 )
@@ -28,9 +30,12 @@ type Dao struct {
 	idgen_client.IDGenClient2
 	user_client.UserClient
 	chat_client.ChatClient
+	channel_client.ChannelClient
 	inbox_client.InboxClient
 	SyncClient    sync_client.SyncClient
 	BotSyncClient sync_client.SyncClient
 	dialog_client.DialogClient
+	StatusClient status_client.StatusClient
 	plugin.MsgPlugin
+	SearchIndex message_helper.SearchIndex
 }
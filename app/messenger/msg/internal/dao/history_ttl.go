@@ -0,0 +1,92 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// getHistoryTTLPeriod returns the auto-delete timer of the dialog the message goes to.
+// A private chat without a dialog yet uses the sender's default timer.
+func (d *Dao) getHistoryTTLPeriod(ctx context.Context, fromId int64, peer *mtproto.PeerUtil) int32 {
+	switch peer.PeerType {
+	case mtproto.PEER_USER:
+		if fromId == peer.PeerId {
+			return 0
+		}
+
+		dialogDO, err := d.DialogsDAO.SelectDialog(ctx, fromId, mtproto.PEER_USER, peer.PeerId)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("getHistoryTTLPeriod - error: %v", err)
+			return 0
+		} else if dialogDO != nil {
+			return dialogDO.TtlPeriod
+		}
+
+		ttl, err := d.UserClient.UserGetDefaultHistoryTTL(ctx, &userpb.TLUserGetDefaultHistoryTTL{
+			UserId: fromId,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("getHistoryTTLPeriod - error: %v", err)
+			return 0
+		}
+		return ttl.GetPeriod()
+	case mtproto.PEER_CHAT:
+		chat, err := d.ChatClient.ChatGetMutableChat(ctx, &chatpb.TLChatGetMutableChat{
+			ChatId: peer.PeerId,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("getHistoryTTLPeriod - error: %v", err)
+			return 0
+		}
+		return chat.TTLPeriod()
+	}
+
+	return 0
+}
+
+// setMessageTTLPeriod stamps the dialog's auto-delete timer on an outgoing message.
+func (d *Dao) setMessageTTLPeriod(ctx context.Context, fromId int64, peer *mtproto.PeerUtil, message *mtproto.Message) {
+	if message.GetTtlPeriod() != nil {
+		return
+	}
+	if message.GetAction().GetPredicateName() == mtproto.Predicate_messageActionSetMessagesTTL {
+		return
+	}
+
+	if ttl := d.getHistoryTTLPeriod(ctx, fromId, peer); ttl > 0 {
+		message.TtlPeriod = &types.Int32Value{Value: ttl}
+	}
+}
+
+// getDialogTTLPeriod returns the timer a dialog created by message starts with.
+func getDialogTTLPeriod(message *mtproto.Message) int32 {
+	if action := message.GetAction(); action.GetPredicateName() == mtproto.Predicate_messageActionSetMessagesTTL {
+		return action.GetPeriod()
+	}
+
+	return message.GetTtlPeriod().GetValue()
+}
@@ -125,12 +125,13 @@ func (d *Dao) sendMessageToInbox(ctx context.Context, fromId int64, peer *mtprot
 		MediaUnread:       message.MediaUnread,
 	}
 
+	var inBoxDO *dataobject.MessagesDO
 	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
 		// TODO(@benqi): do ignore
 
 		// Pts:              pts,
 		// PtsCount:         ptsCount,
-		inBoxDO := &dataobject.MessagesDO{
+		inBoxDO = &dataobject.MessagesDO{
 			UserId:            inBox.UserId,
 			UserMessageBoxId:  inBox.MessageId,
 			DialogId1:         inBox.DialogId1,
@@ -146,6 +147,7 @@ func (d *Dao) sendMessageToInbox(ctx context.Context, fromId int64, peer *mtprot
 			Mentioned:         inBox.Mentioned,
 			MediaUnread:       inBox.MediaUnread,
 			Date2:             date,
+			TtlPeriod:         message.GetTtlPeriod().GetValue(),
 			Deleted:           false,
 		}
 
@@ -169,6 +171,7 @@ func (d *Dao) sendMessageToInbox(ctx context.Context, fromId int64, peer *mtprot
 				TopMessage:       inBoxMsgId,
 				UnreadCount:      1,
 				DraftMessageData: "null",
+				TtlPeriod:        getDialogTTLPeriod(message),
 				Date2:            date,
 			}
 
@@ -188,6 +191,7 @@ func (d *Dao) sendMessageToInbox(ctx context.Context, fromId int64, peer *mtprot
 				TopMessage:       inBoxMsgId,
 				UnreadCount:      1,
 				DraftMessageData: "null",
+				TtlPeriod:        getDialogTTLPeriod(message),
 				Date2:            date,
 			}
 
@@ -220,6 +224,7 @@ func (d *Dao) sendMessageToInbox(ctx context.Context, fromId int64, peer *mtprot
 	if tR.Err != nil {
 		return nil, tR.Err
 	}
+	d.indexMessage(ctx, inBoxDO)
 
 	inBox.Pts = d.IDGenClient2.NextPtsId(ctx, toUserId)
 	inBox.PtsCount = 1
@@ -227,6 +232,17 @@ func (d *Dao) sendMessageToInbox(ctx context.Context, fromId int64, peer *mtprot
 	return inBox, nil
 }
 
+// HasInboxMessage reports whether the inbox of userId already holds dialogMessageId,
+// a retried inbox request skips these users.
+func (d *Dao) HasInboxMessage(ctx context.Context, userId, dialogMessageId int64) (bool, error) {
+	do, err := d.MessagesDAO.SelectByMessageDataId(ctx, userId, dialogMessageId)
+	if err != nil {
+		return false, err
+	}
+
+	return do != nil, nil
+}
+
 func (d *Dao) SendUserMessageToInbox(ctx context.Context, fromId, toId int64, dialogMessageId, clientRandomId int64, message *mtproto.Message) (*mtproto.MessageBox, error) {
 	peer := &mtproto.PeerUtil{
 		PeerType: mtproto.PEER_USER,
@@ -280,7 +296,9 @@ func (d *Dao) SendChatMultiMessageToInbox(ctx context.Context, fromId, chatId, t
 	return boxList, nil
 }
 
-func (d *Dao) DeleteInboxMessages(ctx context.Context, deleteUserId int64, peer *mtproto.PeerUtil, deleteMsgDataIds []int64, cb func(ctx context.Context, userId int64, idList []int32)) error {
+// DeleteInboxMessages deletes the copies other users have of deleteMsgDataIds and calls cb for each of them.
+// Every user is tried and the first error is returned, copies already deleted by an earlier try are not selected again.
+func (d *Dao) DeleteInboxMessages(ctx context.Context, deleteUserId int64, peer *mtproto.PeerUtil, deleteMsgDataIds []int64, cb func(ctx context.Context, userId int64, idList []int32) error) error {
 	var (
 		deletedDialogsMap = map[int64][]*dataobject.MessagesDO{}
 	)
@@ -314,25 +332,33 @@ func (d *Dao) DeleteInboxMessages(ctx context.Context, deleteUserId int64, peer
 			tables = sets.NewWithLength(1)
 		)
 
-		pUserIdList, _ := d.ChatClient.ChatGetChatParticipantIdList(ctx, &chatpb.TLChatGetChatParticipantIdList{
+		pUserIdList, err := d.ChatClient.ChatGetChatParticipantIdList(ctx, &chatpb.TLChatGetChatParticipantIdList{
 			ChatId: peer.PeerId,
 		})
+		if err != nil {
+			return err
+		}
 
 		for _, uId := range pUserIdList.GetDatas() {
 			tables.Insert(d.MessagesDAO.CalcTableName(uId))
 		}
 
 		for tableName, _ := range tables {
-			d.MessagesDAO.SelectByMessageDataIdListWithCB(
+			_, err = d.MessagesDAO.SelectByMessageDataIdListWithCB(
 				ctx,
 				tableName,
 				deleteMsgDataIds,
 				func(i int, v *dataobject.MessagesDO) {
 					doDeleteMessageF(v)
 				})
+			if err != nil {
+				return err
+			}
 		}
 	}
 
+	var rErr error
+
 	// TODO(@benqi): sort
 
 	for userId, msgDOList := range deletedDialogsMap {
@@ -408,14 +434,21 @@ func (d *Dao) DeleteInboxMessages(ctx context.Context, deleteUserId int64, peer
 			}
 		})
 		if tR.Err != nil {
-			return tR.Err
+			logx.WithContext(ctx).Errorf("deleteInboxMessages(%d) - error: %v", userId, tR.Err)
+			if rErr == nil {
+				rErr = tR.Err
+			}
+			continue
 		}
+		d.unindexMessages(ctx, userId, msgIds)
 
 		if cb != nil {
-			cb(ctx, userId, msgIds)
+			if err := cb(ctx, userId, msgIds); err != nil && rErr == nil {
+				rErr = err
+			}
 		}
 	}
-	return nil
+	return rErr
 }
 
 func (d *Dao) EditUserInboxMessage(ctx context.Context, fromId, peerId int64, message *mtproto.Message) (box *mtproto.MessageBox, err error) {
@@ -443,6 +476,9 @@ func (d *Dao) EditUserInboxMessage(ctx context.Context, fromId, peerId int64, me
 	if _, err = d.MessagesDAO.UpdateEditMessage(ctx, string(mData), message.Message, peerId, message.Id); err != nil {
 		return
 	}
+	peerMsgDO.Message = message.Message
+	peerMsgDO.MessageFilterType = mtproto.GetMediaType(message)
+	d.indexMessage(ctx, peerMsgDO)
 
 	box = &mtproto.MessageBox{
 		UserId:            peerId,
@@ -462,6 +498,26 @@ func (d *Dao) EditUserInboxMessage(ctx context.Context, fromId, peerId int64, me
 	return
 }
 
+// HasEditedChatInboxMessage reports whether the copy toId has of message already carries its edit,
+// a copy that is gone has nothing left to edit either.
+func (d *Dao) HasEditedChatInboxMessage(ctx context.Context, fromId, toId int64, message *mtproto.Message) (bool, error) {
+	peerMsgDO, err := d.MessagesDAO.SelectPeerUserMessage(ctx, toId, fromId, message.Id)
+	if err != nil {
+		return false, err
+	} else if peerMsgDO == nil {
+		return true, nil
+	}
+
+	var peerMessage *mtproto.Message
+	if err = jsonx.UnmarshalFromString(peerMsgDO.MessageData, &peerMessage); err != nil {
+		return false, err
+	}
+
+	return message.GetEditDate() != nil &&
+		peerMessage.GetEditDate().GetValue() == message.GetEditDate().GetValue() &&
+		peerMsgDO.Message == message.Message, nil
+}
+
 func (d *Dao) EditChatInboxMessage(ctx context.Context, fromId int64, peerChatId, toId int64, message *mtproto.Message) (box *mtproto.MessageBox, err error) {
 	var peerMsgDO *dataobject.MessagesDO
 
@@ -488,6 +544,9 @@ func (d *Dao) EditChatInboxMessage(ctx context.Context, fromId int64, peerChatId
 	if _, err = d.MessagesDAO.UpdateEditMessage(ctx, string(mData), message.Message, toId, message.Id); err != nil {
 		return
 	}
+	peerMsgDO.Message = message.Message
+	peerMsgDO.MessageFilterType = mtproto.GetMediaType(message)
+	d.indexMessage(ctx, peerMsgDO)
 
 	box = &mtproto.MessageBox{
 		UserId:            toId,
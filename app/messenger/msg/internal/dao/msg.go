@@ -32,7 +32,11 @@ func (d *Dao) DeleteByMessageIdList(ctx context.Context, userId int64, idList []
 	if len(idList) == 0 {
 		return 0, nil
 	}
-	return d.MessagesDAO.DeleteMessagesByMessageIdList(ctx, userId, idList)
+	rowsAffected, err = d.MessagesDAO.DeleteMessagesByMessageIdList(ctx, userId, idList)
+	if err == nil {
+		d.unindexMessages(ctx, userId, idList)
+	}
+	return
 }
 
 func (d *Dao) GetLastMessageAndIdListByDialog(ctx context.Context, userId int64, peer *mtproto.PeerUtil) (lastMessage *mtproto.Message, idList []int32) {
@@ -28,17 +28,23 @@ type Mysql struct {
 	*mysql_dao.MessagesDAO
 	*mysql_dao.ChatParticipantsDAO
 	*mysql_dao.HashTagsDAO
+	*mysql_dao.MessageEditHistoryDAO
+	*mysql_dao.MessageReactionsDAO
 	*mysql_dao.DialogsDAO
+	*mysql_dao.ScheduledMessagesDAO
 	*sqlx.CommonDAO
 }
 
 func NewMysqlDao(db *sqlx.DB, shardingSize int) *Mysql {
 	return &Mysql{
-		DB:                  db,
-		MessagesDAO:         mysql_dao.NewMessagesDAO(db, shardingSize),
-		ChatParticipantsDAO: mysql_dao.NewChatParticipantsDAO(db),
-		HashTagsDAO:         mysql_dao.NewHashTagsDAO(db),
-		DialogsDAO:          mysql_dao.NewDialogsDAO(db),
-		CommonDAO:           sqlx.NewCommonDAO(db),
+		DB:                    db,
+		MessagesDAO:           mysql_dao.NewMessagesDAO(db, shardingSize),
+		ChatParticipantsDAO:   mysql_dao.NewChatParticipantsDAO(db),
+		HashTagsDAO:           mysql_dao.NewHashTagsDAO(db),
+		MessageEditHistoryDAO: mysql_dao.NewMessageEditHistoryDAO(db),
+		MessageReactionsDAO:   mysql_dao.NewMessageReactionsDAO(db),
+		DialogsDAO:            mysql_dao.NewDialogsDAO(db),
+		ScheduledMessagesDAO:  mysql_dao.NewScheduledMessagesDAO(db),
+		CommonDAO:             sqlx.NewCommonDAO(db),
 	}
 }
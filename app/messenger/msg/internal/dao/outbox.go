@@ -66,6 +66,8 @@ func (d *Dao) sendMessageToOutbox(ctx context.Context, fromId int64, peer *mtpro
 		message  = outboxMessage.Message
 	)
 
+	d.setMessageTTLPeriod(ctx, fromId, peer, message)
+
 	idList := d.IDGenClient2.GetNextIdList(
 		ctx,
 		idgen_client.MakeIDTypeNextId(),
@@ -86,6 +88,7 @@ func (d *Dao) sendMessageToOutbox(ctx context.Context, fromId int64, peer *mtpro
 	}
 
 	// A, B
+	var insertedDO *dataobject.MessagesDO
 	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
 		message.Out = true
 		message.Id = outBoxMsgId
@@ -132,9 +135,11 @@ func (d *Dao) sendMessageToOutbox(ctx context.Context, fromId int64, peer *mtpro
 			Mentioned:         false,
 			MediaUnread:       message.MediaUnread,
 			Date2:             int64(outMsgBox.Message.Date),
+			TtlPeriod:         message.GetTtlPeriod().GetValue(),
 			Deleted:           false,
 		}
 
+		insertedDO = outBoxDO
 		lastInsertId, rowsAffected, err := d.MessagesDAO.InsertOrReturnIdTx(tx, outBoxDO)
 		if err != nil {
 			result.Err = err
@@ -166,6 +171,7 @@ func (d *Dao) sendMessageToOutbox(ctx context.Context, fromId int64, peer *mtpro
 				TopMessage:       outBoxMsgId,
 				UnreadCount:      0,
 				DraftMessageData: "null",
+				TtlPeriod:        getDialogTTLPeriod(message),
 				Date2:            int64(outMsgBox.Message.Date),
 			}
 			if dialogMessageId > 1 {
@@ -213,6 +219,7 @@ func (d *Dao) sendMessageToOutbox(ctx context.Context, fromId int64, peer *mtpro
 				TopMessage:       outBoxMsgId,
 				UnreadCount:      0,
 				DraftMessageData: "null",
+				TtlPeriod:        getDialogTTLPeriod(message),
 				Date2:            int64(outMsgBox.Message.Date),
 			}
 
@@ -292,6 +299,7 @@ func (d *Dao) sendMessageToOutbox(ctx context.Context, fromId int64, peer *mtpro
 		outBox = tR.Data.(*mtproto.MessageBox)
 		outBox.Pts = pts // d.IDGenClient2.NextPtsId(ctx, fromId)
 		outBox.PtsCount = 1
+		d.indexMessage(ctx, insertedDO)
 
 	case int64:
 		if tR.Data.(int64) <= 0 {
@@ -358,11 +366,14 @@ func (d *Dao) SendChatMultiMessage(ctx context.Context, fromId, chatId int64, ou
 	return boxList, nil
 }
 
-func (d *Dao) DeleteMessages(ctx context.Context, userId int64, msgIds []int32) (*mtproto.PeerUtil, []int64, error) {
+// DeleteMessages also returns the box ids this call deleted, ids already
+// deleted by a concurrent call are left out.
+func (d *Dao) DeleteMessages(ctx context.Context, userId int64, msgIds []int32) (*mtproto.PeerUtil, []int32, []int64, error) {
 
 	var (
 		topMessageIndex      int32
 		dialogId             *mtproto.DialogID
+		deletedIdList        []int32
 		deletedMsgDataIdList = make([]int64, 0, len(msgIds))
 	)
 
@@ -385,15 +396,15 @@ func (d *Dao) DeleteMessages(ctx context.Context, userId int64, msgIds []int32)
 		})
 	if err != nil {
 		// mtproto.ErrMsgIdInvalid
-		return nil, nil, err
+		return nil, nil, nil, err
 	} else if dialogId.IsZero() {
-		return mtproto.MakePeerUtil(mtproto.PEER_EMPTY, 0), []int64{}, nil
+		return mtproto.MakePeerUtil(mtproto.PEER_EMPTY, 0), []int32{}, []int64{}, nil
 	}
 
 	// 会话里最后n条消息，检查是否需要修改会话信息
 	topMessageDOList, err := d.MessagesDAO.SelectDialogLastMessageList(ctx, userId, dialogId.A, dialogId.B, int32(len(msgIds)+1))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	} else if len(topMessageDOList) == 0 {
 		// return []int64{}, nil
 	} else {
@@ -425,7 +436,11 @@ func (d *Dao) DeleteMessages(ctx context.Context, userId int64, msgIds []int32)
 	peer := dialogId.ToPeerUtil(userId)
 
 	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
-		_, result.Err = d.MessagesDAO.DeleteMessagesByMessageIdListTx(tx, userId, msgIds)
+		deletedIdList, result.Err = d.MessagesDAO.SelectUndeletedIdListForUpdateTx(tx, userId, msgIds)
+		if result.Err != nil || len(deletedIdList) == 0 {
+			return
+		}
+		_, result.Err = d.MessagesDAO.DeleteMessagesByMessageIdListTx(tx, userId, deletedIdList)
 		if result.Err != nil {
 			return
 		}
@@ -439,13 +454,22 @@ func (d *Dao) DeleteMessages(ctx context.Context, userId int64, msgIds []int32)
 			peer.PeerId)
 	})
 	if tR.Err != nil {
-		return nil, nil, tR.Err
+		return nil, nil, nil, tR.Err
+	}
+	if len(deletedIdList) == 0 {
+		return peer, []int32{}, []int64{}, nil
 	}
+	d.unindexMessages(ctx, userId, deletedIdList)
 
 	for i := 0; i < len(msgDOList); i++ {
-		deletedMsgDataIdList = append(deletedMsgDataIdList, msgDOList[i].DialogMessageId)
+		for _, id := range deletedIdList {
+			if msgDOList[i].UserMessageBoxId == id {
+				deletedMsgDataIdList = append(deletedMsgDataIdList, msgDOList[i].DialogMessageId)
+				break
+			}
+		}
 	}
-	return peer, deletedMsgDataIdList, nil
+	return peer, deletedIdList, deletedMsgDataIdList, nil
 }
 
 //func (d *Dao) editOutboxMessage(ctx context.Context, fromId int32, peer *model.PeerUtil, toId int32, message *mtproto.Message) (box *model.MessageBox, err error) {
@@ -486,8 +510,34 @@ func (d *Dao) editOutboxMessage(ctx context.Context, fromId int64, peerType int3
 		did      = mtproto.MakeDialogId(fromId, peerType, peerId)
 	)
 
-	if _, err := d.MessagesDAO.UpdateEditMessage(ctx, string(mData), message.Message, fromId, message.Id); err != nil {
+	prevDO, err := d.MessagesDAO.SelectByMessageId(ctx, fromId, message.Id)
+	if err != nil {
 		return nil, err
+	} else if prevDO == nil {
+		return nil, mtproto.ErrMessageIdInvalid
+	}
+
+	if _, err = d.MessagesDAO.UpdateEditMessage(ctx, string(mData), message.Message, fromId, message.Id); err != nil {
+		return nil, err
+	}
+	editDO := *prevDO
+	editDO.Message = message.Message
+	editDO.MessageFilterType = mtproto.GetMediaType(message)
+	d.indexMessage(ctx, &editDO)
+
+	// the history is kept on the sender side only, the inbox copies are the same message
+	_, _, err = d.MessageEditHistoryDAO.Insert(ctx, &dataobject.MessageEditHistoryDO{
+		UserId:          fromId,
+		PeerType:        peerType,
+		PeerId:          peerId,
+		MessageId:       message.Id,
+		EditorUserId:    fromId,
+		PrevMessageData: prevDO.MessageData,
+		MessageData:     string(mData),
+		EditDate:        int64(message.GetEditDate().GetValue()),
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("editOutboxMessage - insert message_edit_history error: %v", err)
 	}
 
 	d.HashTagsDAO.DeleteHashTagMessageId(ctx, fromId, message.Id)
@@ -563,6 +613,7 @@ func (d *Dao) DeletePhoneCallHistory(ctx context.Context, userId int64) ([]int32
 		if err != nil {
 			continue
 		}
+		d.unindexMessages(ctx, userId, msgIds)
 		lastMessageId, _ := d.MessagesDAO.SelectDialogLastMessageId(ctx, userId, dialogId.A, dialogId.B)
 		d.DialogsDAO.UpdateCustomMap(ctx, map[string]interface{}{
 			"top_message": lastMessageId,
@@ -0,0 +1,73 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	reaperLock = "reaper_lock"
+
+	// only delete the key if it still holds our token, the lock may have expired and been taken by another replica
+	unlockScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+else
+	return 0
+end`
+)
+
+func makeReaperLockKey(tableName string) string {
+	return reaperLock + "_" + tableName
+}
+
+// TryLockReaper takes the lock of one messages table for a reaper pass, every msg replica runs a reaper.
+// It returns the token to unlock with, or "" if another replica holds the lock.
+func (d *Dao) TryLockReaper(ctx context.Context, tableName string, expireSeconds int) (string, error) {
+	var (
+		k     = makeReaperLockKey(tableName)
+		token = strconv.FormatInt(rand.Int63(), 10)
+	)
+
+	ok, err := d.KV.SetnxExCtx(ctx, k, token, expireSeconds)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("tryLockReaper - SETNX {%s, %d}, error: %v", k, expireSeconds, err)
+		return "", err
+	} else if !ok {
+		return "", nil
+	}
+
+	return token, nil
+}
+
+// UnlockReaper releases a lock taken by TryLockReaper.
+func (d *Dao) UnlockReaper(ctx context.Context, tableName, token string) error {
+	k := makeReaperLockKey(tableName)
+
+	if _, err := d.KV.EvalCtx(ctx, unlockScript, k, token); err != nil {
+		logx.WithContext(ctx).Errorf("unlockReaper - DEL {%s}, error: %v", k, err)
+		return err
+	}
+
+	return nil
+}
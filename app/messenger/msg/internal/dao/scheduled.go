@@ -0,0 +1,104 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+const (
+	// ScheduleWhenOnline is the schedule_date clients use for "send when online".
+	ScheduleWhenOnline = 0x7FFFFFFE
+)
+
+// SaveScheduledMessage stores outBox until its schedule_date, the message id is
+// taken from the per-user scheduled sequence, not from the message box.
+func (d *Dao) SaveScheduledMessage(ctx context.Context, fromId int64, peer *mtproto.PeerUtil, outBox *msg.OutboxMessage) (*mtproto.MessageBox, error) {
+	var (
+		message      = outBox.Message
+		scheduleDate = outBox.GetScheduleDate().GetValue()
+	)
+
+	id := d.IDGenClient2.NextScheduledMessageBoxId(ctx, fromId)
+	if id == 0 {
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	message.Out = true
+	message.Id = id
+	message.FromScheduled = true
+	message.Date = scheduleDate
+	message.MediaUnread = mtproto.CheckHasMediaUnread(message)
+
+	mData, _ := jsonx.Marshal(message)
+	_, _, err := d.ScheduledMessagesDAO.Insert(ctx, &dataobject.ScheduledMessagesDO{
+		UserId:           fromId,
+		UserMessageBoxId: id,
+		PeerType:         peer.PeerType,
+		PeerId:           peer.PeerId,
+		RandomId:         outBox.RandomId,
+		NoWebpage:        outBox.NoWebpage,
+		Background:       outBox.Background,
+		MessageData:      hack.String(mData),
+		ScheduleDate:     int64(scheduleDate),
+		Date2:            time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &mtproto.MessageBox{
+		UserId:       fromId,
+		SenderUserId: fromId,
+		PeerType:     peer.PeerType,
+		PeerId:       peer.PeerId,
+		MessageId:    id,
+		RandomId:     outBox.RandomId,
+		Message:      message,
+	}, nil
+}
+
+// MakeScheduledOutboxMessage rebuilds the outbox message of do to be sent at date.
+func MakeScheduledOutboxMessage(do *dataobject.ScheduledMessagesDO, date int32) *msg.OutboxMessage {
+	var (
+		message *mtproto.Message
+	)
+
+	_ = jsonx.UnmarshalFromString(do.MessageData, &message)
+	message = message.FixData()
+	message.Id = 0
+	message.Date = date
+	message.FromScheduled = true
+
+	return msg.MakeTLOutboxMessage(&msg.OutboxMessage{
+		NoWebpage:    do.NoWebpage,
+		Background:   do.Background,
+		RandomId:     do.RandomId,
+		Message:      message,
+		ScheduleDate: nil,
+	}).To_OutboxMessage()
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// indexMessage feeds a stored box to the search index, the box is already
+// committed so a failure is only logged.
+func (d *Dao) indexMessage(ctx context.Context, do *dataobject.MessagesDO) {
+	if d.SearchIndex == nil {
+		return
+	}
+
+	err := d.SearchIndex.Index(ctx, &message_helper.SearchDocument{
+		UserId:    do.UserId,
+		PeerType:  do.PeerType,
+		PeerId:    do.PeerId,
+		MessageId: do.UserMessageBoxId,
+		FromId:    do.SenderUserId,
+		MediaType: do.MessageFilterType,
+		Message:   do.Message,
+		Date:      do.Date2,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("indexMessage(%d, %d) - error: %v", do.UserId, do.UserMessageBoxId, err)
+	}
+}
+
+func (d *Dao) unindexMessages(ctx context.Context, userId int64, msgIds []int32) {
+	if d.SearchIndex == nil {
+		return
+	}
+
+	err := d.SearchIndex.Delete(ctx, userId, 0, 0, msgIds)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("unindexMessages(%d, %v) - error: %v", userId, msgIds, err)
+	}
+}
@@ -21,11 +21,11 @@ package server
 import (
 	"flag"
 
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	inbox_helper "github.com/teamgram/teamgram-server/app/messenger/msg/inbox"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/config"
 	msg_helper "github.com/teamgram/teamgram-server/app/messenger/msg/msg"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -36,8 +36,10 @@ import (
 var configFile = flag.String("f", "etc/msg.yaml", "the config file")
 
 type Server struct {
-	grpcSrv *zrpc.RpcServer
-	mq      *kafka.ConsumerGroup
+	grpcSrv   *zrpc.RpcServer
+	mq        mqx.Consumer
+	scheduler *msg_helper.Scheduler
+	reaper    *msg_helper.Reaper
 }
 
 func New() *Server {
@@ -49,6 +51,7 @@ func (s *Server) Initialize() error {
 	conf.MustLoad(*configFile, &c)
 
 	logx.Infov(c)
+	mqx.MustSetUp(c.MessageBus)
 	// ctx := svc.NewServiceContext(c)
 	// s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
 
@@ -64,10 +67,14 @@ func (s *Server) Initialize() error {
 					IdgenClient:     c.IdgenClient,
 					UserClient:      c.BizServiceClient,
 					ChatClient:      c.BizServiceClient,
+					ChannelClient:   c.BizServiceClient,
 					SyncClient:      c.SyncClient,
 					InboxClient:     c.InboxClient,
 					DialogClient:    c.BizServiceClient,
+					StatusClient:    c.StatusClient,
 					MessageSharding: c.MessageSharding,
+					EditTimeLimit:   c.EditTimeLimit,
+					SearchIndex:     c.SearchIndex,
 				}, nil))
 	})
 
@@ -78,21 +85,68 @@ func (s *Server) Initialize() error {
 	s.mq = inbox_helper.New(inbox_helper.Config{
 		RpcServerConf:   c.RpcServerConf,
 		InboxConsumer:   c.InboxConsumer,
+		InboxRetry:      c.InboxRetry,
 		Mysql:           c.Mysql,
 		KV:              c.KV,
 		IdgenClient:     c.IdgenClient,
 		UserClient:      c.BizServiceClient,
 		ChatClient:      c.BizServiceClient,
+		ChannelClient:   c.BizServiceClient,
 		SyncClient:      c.SyncClient,
 		BotSyncClient:   c.BotSyncClient,
 		DialogClient:    c.BizServiceClient,
 		MessageSharding: c.MessageSharding,
+		SearchIndex:     c.SearchIndex,
 	})
 
 	go func() {
 		s.mq.Start()
 	}()
 
+	s.scheduler = msg_helper.NewScheduler(
+		msg_helper.Config{
+			RpcServerConf:   c.RpcServerConf,
+			Mysql:           c.Mysql,
+			KV:              c.KV,
+			IdgenClient:     c.IdgenClient,
+			UserClient:      c.BizServiceClient,
+			ChatClient:      c.BizServiceClient,
+			ChannelClient:   c.BizServiceClient,
+			SyncClient:      c.SyncClient,
+			InboxClient:     c.InboxClient,
+			DialogClient:    c.BizServiceClient,
+			StatusClient:    c.StatusClient,
+			MessageSharding: c.MessageSharding,
+			EditTimeLimit:   c.EditTimeLimit,
+			SearchIndex:     c.SearchIndex,
+		}, nil)
+
+	go func() {
+		s.scheduler.Start()
+	}()
+
+	s.reaper = msg_helper.NewReaper(
+		msg_helper.Config{
+			RpcServerConf:   c.RpcServerConf,
+			Mysql:           c.Mysql,
+			KV:              c.KV,
+			IdgenClient:     c.IdgenClient,
+			UserClient:      c.BizServiceClient,
+			ChatClient:      c.BizServiceClient,
+			ChannelClient:   c.BizServiceClient,
+			SyncClient:      c.SyncClient,
+			InboxClient:     c.InboxClient,
+			DialogClient:    c.BizServiceClient,
+			StatusClient:    c.StatusClient,
+			MessageSharding: c.MessageSharding,
+			EditTimeLimit:   c.EditTimeLimit,
+			SearchIndex:     c.SearchIndex,
+		}, nil)
+
+	go func() {
+		s.reaper.Start()
+	}()
+
 	return nil
 }
 
@@ -100,5 +154,7 @@ func (s *Server) RunLoop() {
 }
 
 func (s *Server) Destroy() {
+	s.scheduler.Stop()
+	s.reaper.Stop()
 	s.grpcSrv.Stop()
 }
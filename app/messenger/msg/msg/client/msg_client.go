@@ -35,6 +35,9 @@ type MsgClient interface {
 	MsgReadHistory(ctx context.Context, in *msg.TLMsgReadHistory) (*mtproto.Messages_AffectedMessages, error)
 	MsgUpdatePinnedMessage(ctx context.Context, in *msg.TLMsgUpdatePinnedMessage) (*mtproto.Updates, error)
 	MsgUnpinAllMessages(ctx context.Context, in *msg.TLMsgUnpinAllMessages) (*mtproto.Messages_AffectedHistory, error)
+	MsgSendReaction(ctx context.Context, in *msg.TLMsgSendReaction) (*mtproto.Updates, error)
+	MsgSendScheduledMessages(ctx context.Context, in *msg.TLMsgSendScheduledMessages) (*mtproto.Updates, error)
+	MsgDeleteScheduledMessages(ctx context.Context, in *msg.TLMsgDeleteScheduledMessages) (*mtproto.Updates, error)
 }
 
 type defaultMsgClient struct {
@@ -137,3 +140,24 @@ func (m *defaultMsgClient) MsgUnpinAllMessages(ctx context.Context, in *msg.TLMs
 	client := msg.NewRPCMsgClient(m.cli.Conn())
 	return client.MsgUnpinAllMessages(ctx, in)
 }
+
+// MsgSendReaction
+// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.0?true add_to_recent:flags.1?true peer_type:int peer_id:long msg_id:int reaction:Vector<Reaction> = Updates;
+func (m *defaultMsgClient) MsgSendReaction(ctx context.Context, in *msg.TLMsgSendReaction) (*mtproto.Updates, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgSendReaction(ctx, in)
+}
+
+// MsgSendScheduledMessages
+// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (m *defaultMsgClient) MsgSendScheduledMessages(ctx context.Context, in *msg.TLMsgSendScheduledMessages) (*mtproto.Updates, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgSendScheduledMessages(ctx, in)
+}
+
+// MsgDeleteScheduledMessages
+// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (m *defaultMsgClient) MsgDeleteScheduledMessages(ctx context.Context, in *msg.TLMsgDeleteScheduledMessages) (*mtproto.Updates, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgDeleteScheduledMessages(ctx, in)
+}
@@ -13,14 +13,26 @@ package msg_helper
 import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/config"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/server/grpc/service"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/server/reaper"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/server/scheduler"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/svc"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/plugin"
 )
 
 type (
-	Config = config.Config
+	Config    = config.Config
+	Scheduler = scheduler.Scheduler
+	Reaper    = reaper.Reaper
 )
 
 func New(c Config, plugin plugin.MsgPlugin) *service.Service {
 	return service.New(svc.NewServiceContext(c, plugin))
 }
+
+func NewScheduler(c Config, plugin plugin.MsgPlugin) *Scheduler {
+	return scheduler.New(svc.NewServiceContext(c, plugin))
+}
+
+func NewReaper(c Config, plugin plugin.MsgPlugin) *Reaper {
+	return reaper.New(svc.NewServiceContext(c, plugin))
+}
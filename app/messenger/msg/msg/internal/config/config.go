@@ -21,6 +21,7 @@ package config
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -36,5 +37,8 @@ type Config struct {
 	SyncClient      *kafka.KafkaProducerConf
 	ChannelClient   zrpc.RpcClientConf
 	DialogClient    zrpc.RpcClientConf
-	MessageSharding int `json:",default=1"`
+	StatusClient    zrpc.RpcClientConf
+	MessageSharding int   `json:",default=1"`
+	EditTimeLimit   int32 `json:",default=172800"`
+	SearchIndex     message_helper.SearchIndexConfig
 }
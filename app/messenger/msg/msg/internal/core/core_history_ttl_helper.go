@@ -0,0 +1,54 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+)
+
+// DeleteExpiredMessages removes the messages of one dialog whose auto-delete timer ran out.
+// Every copy of a message expires on its own, so only userId's box is touched.
+func (c *MsgCore) DeleteExpiredMessages(userId int64, idList []int32) error {
+	// only the ids this call deleted get pts, another replica or the user may have deleted the rest
+	_, deletedIdList, _, err := c.svcCtx.Dao.DeleteMessages(c.ctx, userId, idList)
+	if err != nil {
+		c.Logger.Errorf("deleteExpiredMessages(%d, %v) - error: %v", userId, idList, err)
+		return err
+	} else if len(deletedIdList) == 0 {
+		return nil
+	}
+
+	pts := c.svcCtx.Dao.IDGenClient2.NextNPtsId(c.ctx, userId, len(deletedIdList))
+	ptsCount := int32(len(deletedIdList))
+
+	_, err = c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+		UserId: userId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateDeleteMessages(&mtproto.Update{
+			Messages:  deletedIdList,
+			Pts_INT32: pts,
+			PtsCount:  ptsCount,
+		}).To_Update()),
+	})
+	if err != nil {
+		c.Logger.Errorf("deleteExpiredMessages(%d, %v) - error: %v", userId, idList, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dao"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+const (
+	maxScheduleDelay = 366 * 24 * 60 * 60 // 366 days
+)
+
+// checkScheduleDate reports whether outBox has to wait for its schedule_date,
+// a schedule_date that has already passed is dropped and the message is sent right away.
+func checkScheduleDate(outBox *msg.OutboxMessage) (bool, error) {
+	scheduleDate := outBox.GetScheduleDate().GetValue()
+	if scheduleDate == 0 {
+		return false, nil
+	}
+
+	if scheduleDate == dao.ScheduleWhenOnline {
+		return true, nil
+	}
+
+	now := time.Now().Unix()
+	if int64(scheduleDate) <= now {
+		outBox.ScheduleDate = nil
+		return false, nil
+	} else if int64(scheduleDate) > now+maxScheduleDelay {
+		return false, mtproto.ErrScheduleDateTooLate
+	}
+
+	return true, nil
+}
+
+// sendScheduledOutgoingMessages keeps outBoxList in the sender's scheduled list,
+// the scheduler sends them through msg.sendMessage once they are due.
+func (c *MsgCore) sendScheduledOutgoingMessages(userId, authKeyId int64, peer *mtproto.PeerUtil, outBoxList ...*msg.OutboxMessage) (*mtproto.Updates, error) {
+	if !peer.IsChannel() && !peer.IsChatOrUser() {
+		return nil, mtproto.ErrPeerIdInvalid
+	}
+
+	updateList := make([]*mtproto.Update, 0, len(outBoxList))
+	for _, outBox := range outBoxList {
+		box, err := c.svcCtx.Dao.SaveScheduledMessage(c.ctx, userId, peer, outBox)
+		if err != nil {
+			c.Logger.Errorf("msg.sendScheduledOutgoingMessages - error: %v", err)
+			return nil, err
+		}
+
+		updateList = append(updateList, mtproto.MakeTLUpdateNewScheduledMessage(&mtproto.Update{
+			RandomId:        box.RandomId,
+			Message_MESSAGE: box.Message,
+		}).To_Update())
+	}
+
+	return c.makeReplyUpdates(userId, authKeyId, updateList...), nil
+}
+
+// makeDeleteScheduledUpdates notifies all sessions of userId but authKeyId that idList left the scheduled list.
+func (c *MsgCore) makeDeleteScheduledUpdates(userId, authKeyId int64, peer *mtproto.PeerUtil, idList []int32) *mtproto.Updates {
+	return c.makeReplyUpdates(
+		userId,
+		authKeyId,
+		mtproto.MakeTLUpdateDeleteScheduledMessages(&mtproto.Update{
+			Peer_PEER: peer.ToPeer(),
+			Messages:  idList,
+		}).To_Update())
+}
+
+// sendScheduledMessage sends do through msg.sendMessage, it returns nil updates
+// if do has already been sent or deleted.
+func (c *MsgCore) sendScheduledMessage(authKeyId int64, do *dataobject.ScheduledMessagesDO) (*mtproto.Updates, error) {
+	// claim it first, so that a message is never sent twice
+	rowsAffected, err := c.svcCtx.Dao.ScheduledMessagesDAO.UpdateSent(c.ctx, do.Id)
+	if err != nil {
+		return nil, err
+	} else if rowsAffected == 0 {
+		return nil, nil
+	}
+
+	return c.MsgSendMessage(&msg.TLMsgSendMessage{
+		UserId:    do.UserId,
+		AuthKeyId: authKeyId,
+		PeerType:  do.PeerType,
+		PeerId:    do.PeerId,
+		Message:   dao.MakeScheduledOutboxMessage(do, int32(time.Now().Unix())),
+	})
+}
+
+// SendDueScheduledMessage delivers a scheduled message whose time has come to the
+// peer and removes it from the scheduled list of all sender's sessions.
+func (c *MsgCore) SendDueScheduledMessage(do *dataobject.ScheduledMessagesDO) error {
+	updates, err := c.sendScheduledMessage(0, do)
+	if err != nil {
+		c.Logger.Errorf("sendDueScheduledMessage(%d, %d) - error: %v", do.UserId, do.UserMessageBoxId, err)
+		return err
+	} else if updates == nil {
+		return nil
+	}
+
+	c.makeDeleteScheduledUpdates(
+		do.UserId,
+		0,
+		mtproto.MakePeerUtil(do.PeerType, do.PeerId),
+		[]int32{do.UserMessageBoxId})
+
+	return nil
+}
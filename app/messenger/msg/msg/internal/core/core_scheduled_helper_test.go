@@ -0,0 +1,59 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dao"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+
+	"github.com/gogo/protobuf/types"
+)
+
+func TestCheckScheduleDate(t *testing.T) {
+	now := int32(time.Now().Unix())
+
+	tests := []struct {
+		name         string
+		scheduleDate *types.Int32Value
+		scheduled    bool
+		err          error
+		cleared      bool
+	}{
+		{"not scheduled", nil, false, nil, false},
+		{"past", mtproto.MakeFlagsInt32(now - 10), false, nil, true},
+		{"future", mtproto.MakeFlagsInt32(now + 3600), true, nil, false},
+		{"when online", mtproto.MakeFlagsInt32(dao.ScheduleWhenOnline), true, nil, false},
+		{"too late", mtproto.MakeFlagsInt32(now + maxScheduleDelay + 3600), false, mtproto.ErrScheduleDateTooLate, false},
+	}
+
+	for _, tt := range tests {
+		outBox := &msg.OutboxMessage{ScheduleDate: tt.scheduleDate}
+		scheduled, err := checkScheduleDate(outBox)
+		if scheduled != tt.scheduled || err != tt.err {
+			t.Errorf("%s: checkScheduleDate() = (%v, %v), want (%v, %v)", tt.name, scheduled, err, tt.scheduled, tt.err)
+		}
+		if tt.cleared && outBox.ScheduleDate != nil {
+			t.Errorf("%s: ScheduleDate = %v, want nil", tt.name, outBox.ScheduleDate)
+		}
+	}
+}
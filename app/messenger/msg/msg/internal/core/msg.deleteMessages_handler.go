@@ -14,6 +14,7 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 )
 
 // MsgDeleteMessages
@@ -45,6 +46,11 @@ func (c *MsgCore) MsgDeleteMessages(in *msg.TLMsgDeleteMessages) (*mtproto.Messa
 			}
 		}
 	case mtproto.PEER_CHANNEL:
+		rValue, err = c.deleteChannelMessages(in)
+		if err != nil {
+			c.Logger.Errorf("msg.deleteMessages - error: %v", err)
+			return nil, err
+		}
 	default:
 		err = mtproto.ErrPeerIdInvalid
 		c.Logger.Errorf("DeleteMessages - error: %v", err)
@@ -57,10 +63,11 @@ func (c *MsgCore) MsgDeleteMessages(in *msg.TLMsgDeleteMessages) (*mtproto.Messa
 func (c *MsgCore) deleteUserMessages(in *msg.TLMsgDeleteMessages) (*mtproto.Messages_AffectedMessages, error) {
 	var (
 		pts, ptsCount int32
+		deletedIdList []int32
 		msgDataIdList []int64
 	)
 
-	peer, msgDataIdList, err := c.svcCtx.Dao.DeleteMessages(c.ctx, in.UserId, in.Id)
+	peer, deletedIdList, msgDataIdList, err := c.svcCtx.Dao.DeleteMessages(c.ctx, in.UserId, in.Id)
 	if err != nil {
 		c.Logger.Errorf("DeleteMessages - %v", err)
 		return nil, err
@@ -71,15 +78,15 @@ func (c *MsgCore) deleteUserMessages(in *msg.TLMsgDeleteMessages) (*mtproto.Mess
 		}).To_Messages_AffectedMessages(), nil
 	}
 
-	pts = c.svcCtx.Dao.IDGenClient2.NextNPtsId(c.ctx, in.UserId, len(in.Id))
-	ptsCount = int32(len(in.Id))
+	pts = c.svcCtx.Dao.IDGenClient2.NextNPtsId(c.ctx, in.UserId, len(deletedIdList))
+	ptsCount = int32(len(deletedIdList))
 
 	// me
 	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
 		UserId:    in.UserId,
 		AuthKeyId: in.AuthKeyId,
 		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateDeleteMessages(&mtproto.Update{
-			Messages:  in.Id,
+			Messages:  deletedIdList,
 			Pts_INT32: pts,
 			PtsCount:  ptsCount,
 		}).To_Update()),
@@ -101,3 +108,40 @@ func (c *MsgCore) deleteUserMessages(in *msg.TLMsgDeleteMessages) (*mtproto.Mess
 		PtsCount: ptsCount,
 	}).To_Messages_AffectedMessages(), nil
 }
+
+func (c *MsgCore) deleteChannelMessages(in *msg.TLMsgDeleteMessages) (*mtproto.Messages_AffectedMessages, error) {
+	affected, err := c.svcCtx.Dao.ChannelClient.ChannelDeleteChannelMessages(c.ctx, &channelpb.TLChannelDeleteChannelMessages{
+		FromId:    in.UserId,
+		ChannelId: in.PeerId,
+		Id:        in.Id,
+	})
+	if err != nil {
+		return nil, err
+	} else if affected.PtsCount == 0 {
+		return affected, nil
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    in.UserId,
+		AuthKeyId: in.AuthKeyId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateDeleteChannelMessages(&mtproto.Update{
+			ChannelId: in.PeerId,
+			Messages:  in.Id,
+			Pts_INT32: affected.Pts,
+			PtsCount:  affected.PtsCount,
+		}).To_Update()),
+	})
+
+	_, err = c.svcCtx.Dao.InboxClient.InboxDeleteChannelMessagesToInbox(c.ctx, &inbox.TLInboxDeleteChannelMessagesToInbox{
+		FromId:        in.UserId,
+		PeerChannelId: in.PeerId,
+		Pts:           affected.Pts,
+		PtsCount:      affected.PtsCount,
+		Id:            in.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,35 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// MsgDeleteScheduledMessages
+// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (c *MsgCore) MsgDeleteScheduledMessages(in *msg.TLMsgDeleteScheduledMessages) (*mtproto.Updates, error) {
+	var (
+		peer = mtproto.MakePeerUtil(in.PeerType, in.PeerId)
+	)
+
+	if len(in.Id) == 0 {
+		return mtproto.MakeEmptyUpdates(), nil
+	}
+
+	_, err := c.svcCtx.Dao.ScheduledMessagesDAO.DeleteByIdList(c.ctx, in.UserId, in.PeerType, in.PeerId, in.Id)
+	if err != nil {
+		c.Logger.Errorf("msg.deleteScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeDeleteScheduledUpdates(in.UserId, in.AuthKeyId, peer, in.Id), nil
+}
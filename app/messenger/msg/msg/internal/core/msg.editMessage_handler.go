@@ -15,9 +15,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
-	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
-	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
-	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 
 	"github.com/gogo/protobuf/types"
 )
@@ -34,13 +32,19 @@ func (c *MsgCore) MsgEditMessage(in *msg.TLMsgEditMessage) (*mtproto.Updates, er
 		in.Message.Message.EditDate = &types.Int32Value{Value: int32(time.Now().Unix())}
 	}
 
+	if !c.checkEditTimeLimit(in) {
+		err = mtproto.ErrMessageEditTimeExpired
+		c.Logger.Errorf("msg.editMessage - error: %v", err)
+		return nil, err
+	}
+
 	switch in.PeerType {
 	case mtproto.PEER_USER:
 		rUpdates, err = c.editUserOutgoingMessage(in)
 	case mtproto.PEER_CHAT:
 		rUpdates, err = c.editChatOutgoingMessage(in)
 	case mtproto.PEER_CHANNEL:
-		// rUpdates, err = c.editChannelOutgoingMessage(in)
+		rUpdates, err = c.editChannelOutgoingMessage(in)
 	default:
 		err = mtproto.ErrPeerIdInvalid
 		c.Logger.Errorf("msg.editMessage - error: %v", err)
@@ -55,6 +59,24 @@ func (c *MsgCore) MsgEditMessage(in *msg.TLMsgEditMessage) (*mtproto.Updates, er
 	return rUpdates, nil
 }
 
+// checkEditTimeLimit applies the edit_time_limit of help.getConfig, Saved Messages
+// and channel posts can be edited at any time.
+func (c *MsgCore) checkEditTimeLimit(in *msg.TLMsgEditMessage) bool {
+	var (
+		limit   = c.svcCtx.Config.EditTimeLimit
+		message = in.Message.Message
+	)
+
+	if limit <= 0 || message.Post {
+		return true
+	}
+	if in.PeerType == mtproto.PEER_USER && in.PeerId == in.UserId {
+		return true
+	}
+
+	return message.EditDate.GetValue()-message.Date <= limit
+}
+
 func (c *MsgCore) editUserOutgoingMessage(in *msg.TLMsgEditMessage) (*mtproto.Updates, error) {
 	outBox, err := c.svcCtx.Dao.EditUserOutboxMessage(c.ctx, in.UserId, in.PeerId, in.Message.Message)
 	if err != nil {
@@ -76,48 +98,7 @@ func (c *MsgCore) editUserOutgoingMessage(in *msg.TLMsgEditMessage) (*mtproto.Up
 		Message_MESSAGE: outBox.Message,
 	}).To_Update()
 
-	rUpdates := mtproto.MakeReplyUpdates(
-		func(idList []int64) []*mtproto.User {
-			users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
-				&userpb.TLUserGetMutableUsers{
-					Id: idList,
-				})
-			return users.GetUserListByIdList(in.UserId, idList...)
-		},
-		func(idList []int64) []*mtproto.Chat {
-			chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
-				&chatpb.TLChatGetChatListByIdList{
-					IdList: idList,
-				})
-			return chats.GetChatListByIdList(in.UserId, idList...)
-		},
-		func(idList []int64) []*mtproto.Chat {
-			// TODO
-			return nil
-		},
-		updateEditMessage)
-
-	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
-		c.ctx,
-		&sync.TLSyncUpdatesNotMe{
-			UserId:    in.UserId,
-			AuthKeyId: in.AuthKeyId,
-			Updates: mtproto.MakeSyncNotMeUpdates(
-				func(idList []int64) []*mtproto.User {
-					return rUpdates.Users
-				},
-				func(idList []int64) []*mtproto.Chat {
-					// TODO
-					return rUpdates.Chats
-				},
-				func(idList []int64) []*mtproto.Chat {
-					// TODO
-					return rUpdates.Chats
-				},
-				updateEditMessage),
-		})
-
-	return rUpdates, nil
+	return c.makeReplyUpdates(in.UserId, in.AuthKeyId, updateEditMessage), nil
 }
 
 func (c *MsgCore) editChatOutgoingMessage(in *msg.TLMsgEditMessage) (*mtproto.Updates, error) {
@@ -139,46 +120,37 @@ func (c *MsgCore) editChatOutgoingMessage(in *msg.TLMsgEditMessage) (*mtproto.Up
 		Message_MESSAGE: outBox.Message,
 	}).To_Update()
 
-	rUpdates := mtproto.MakeReplyUpdates(
-		func(idList []int64) []*mtproto.User {
-			users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
-				&userpb.TLUserGetMutableUsers{
-					Id: idList,
-				})
-			return users.GetUserListByIdList(in.UserId, idList...)
-		},
-		func(idList []int64) []*mtproto.Chat {
-			chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
-				&chatpb.TLChatGetChatListByIdList{
-					IdList: idList,
-				})
-			return chats.GetChatListByIdList(in.UserId, idList...)
-		},
-		func(idList []int64) []*mtproto.Chat {
-			// TODO
-			return nil
-		},
-		updateEditMessage)
-
-	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
-		c.ctx,
-		&sync.TLSyncUpdatesNotMe{
-			UserId:    in.UserId,
-			AuthKeyId: in.AuthKeyId,
-			Updates: mtproto.MakeSyncNotMeUpdates(
-				func(idList []int64) []*mtproto.User {
-					return rUpdates.Users
-				},
-				func(idList []int64) []*mtproto.Chat {
-					// TODO
-					return rUpdates.Chats
-				},
-				func(idList []int64) []*mtproto.Chat {
-					// TODO
-					return rUpdates.Chats
-				},
-				updateEditMessage),
-		})
+	return c.makeReplyUpdates(in.UserId, in.AuthKeyId, updateEditMessage), nil
+}
 
-	return rUpdates, nil
+func (c *MsgCore) editChannelOutgoingMessage(in *msg.TLMsgEditMessage) (*mtproto.Updates, error) {
+	box, err := c.svcCtx.Dao.ChannelClient.ChannelEditChannelMessage(c.ctx, &channelpb.TLChannelEditChannelMessage{
+		FromId:    in.UserId,
+		ChannelId: in.PeerId,
+		Message:   in.Message.Message,
+	})
+	if err != nil {
+		c.Logger.Errorf("msg.editMessage - error: %v", err)
+		return nil, err
+	}
+
+	_, err = c.svcCtx.Dao.InboxClient.InboxEditChannelMessageToInbox(c.ctx, &inbox.TLInboxEditChannelMessageToInbox{
+		FromId:        in.UserId,
+		PeerChannelId: in.PeerId,
+		Pts:           box.Pts,
+		PtsCount:      box.PtsCount,
+		Message:       box.Message,
+	})
+	if err != nil {
+		c.Logger.Errorf("msg.editMessage - error: %v", err)
+		return nil, err
+	}
+
+	updateEditChannelMessage := mtproto.MakeTLUpdateEditChannelMessage(&mtproto.Update{
+		Pts_INT32:       box.Pts,
+		PtsCount:        box.PtsCount,
+		Message_MESSAGE: box.ToMessage(in.UserId),
+	}).To_Update()
+
+	return c.makeReplyUpdates(in.UserId, in.AuthKeyId, updateEditChannelMessage), nil
 }
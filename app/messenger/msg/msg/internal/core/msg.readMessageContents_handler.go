@@ -112,6 +112,7 @@ func (c *MsgCore) readMediaUnreadMessageContents(in *msg.TLMsgReadMessageContent
 func (c *MsgCore) readReactionUnreadMessageContents(in *msg.TLMsgReadMessageContents) (int32, error) {
 	for _, m := range in.Id {
 		if m.Reaction {
+			c.svcCtx.Dao.MessagesDAO.UpdateReactionUnread(c.ctx, false, in.UserId, m.Id)
 			if c.svcCtx.Dao.MsgPlugin != nil {
 				c.svcCtx.Dao.MsgPlugin.ReadReactionUnreadMessage(c.ctx, in.UserId, m.Id)
 			}
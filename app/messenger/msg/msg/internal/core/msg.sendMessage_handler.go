@@ -16,6 +16,7 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
@@ -30,14 +31,27 @@ func (c *MsgCore) MsgSendMessage(in *msg.TLMsgSendMessage) (*mtproto.Updates, er
 		peer     = mtproto.MakePeerUtil(in.PeerType, in.PeerId)
 	)
 
-	if peer.IsChannel() {
-		// c.Logger.Errorf("msg.sendMultiMessage blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		return nil, mtproto.ErrEnterpriseIsBlocked
+	if scheduled, err := checkScheduleDate(outBox); err != nil {
+		c.Logger.Errorf("msg.sendMessage - error: %v", err)
+		return nil, err
+	} else if scheduled {
+		rUpdates, err = c.sendScheduledOutgoingMessages(in.UserId, in.AuthKeyId, peer, outBox)
+		if err != nil {
+			c.Logger.Errorf("msg.sendMessage - error: %v", err)
+			return nil, err
+		}
+
+		return rUpdates, nil
 	}
 
-	if outBox.GetScheduleDate().GetValue() != 0 {
-		// c.Logger.Errorf("msg.sendMessage blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		return nil, mtproto.ErrEnterpriseIsBlocked
+	if peer.IsChannel() {
+		rUpdates, err = c.sendChannelOutgoingMessage(in.UserId, in.AuthKeyId, in.PeerId, outBox)
+		if err != nil {
+			c.Logger.Errorf("msg.sendMessage - error: %v", err)
+			return nil, err
+		}
+
+		return rUpdates, nil
 	}
 
 	if !peer.IsChatOrUser() {
@@ -358,3 +372,102 @@ func (c *MsgCore) sendChatMessage(
 
 	return rUpdates, nil
 }
+
+func (c *MsgCore) sendChannelOutgoingMessage(userId, authKeyId, peerChannelId int64, outBox *msg.OutboxMessage) (*mtproto.Updates, error) {
+	hasDuplicateMessage, err := c.svcCtx.Dao.HasDuplicateMessage(c.ctx, userId, outBox.RandomId)
+	if err != nil {
+		c.Logger.Errorf("checkDuplicateMessage error - %v", err)
+		return nil, err
+	} else if hasDuplicateMessage {
+		upd, err := c.svcCtx.Dao.GetDuplicateMessage(c.ctx, userId, outBox.RandomId)
+		if err != nil {
+			c.Logger.Errorf("checkDuplicateMessage error - %v", err)
+			return nil, err
+		} else if upd != nil {
+			return upd, nil
+		}
+	}
+
+	box, err := c.svcCtx.Dao.ChannelClient.ChannelSendChannelMessage(c.ctx, &channelpb.TLChannelSendChannelMessage{
+		FromId:    userId,
+		ChannelId: peerChannelId,
+		RandomId:  outBox.RandomId,
+		Message:   outBox.Message,
+	})
+	if err != nil {
+		c.Logger.Errorf("msg.sendChannelOutgoingMessage - error: %v", err)
+		return nil, err
+	}
+
+	if !hasDuplicateMessage {
+		_, err = c.svcCtx.Dao.InboxClient.InboxSendChannelMessageToInbox(c.ctx, &inbox.TLInboxSendChannelMessageToInbox{
+			FromId:        userId,
+			PeerChannelId: peerChannelId,
+			Message:       box,
+		})
+		if err != nil {
+			c.Logger.Errorf("msg.sendChannelOutgoingMessage - error: %v", err)
+			return nil, err
+		}
+	}
+
+	updateNewMessage := mtproto.MakeTLUpdateNewChannelMessage(&mtproto.Update{
+		Pts_INT32:       box.Pts,
+		PtsCount:        box.PtsCount,
+		RandomId:        box.RandomId,
+		Message_MESSAGE: box.ToMessage(userId),
+	}).To_Update()
+
+	rUpdates := c.makeReplyUpdates(userId, authKeyId, updateNewMessage)
+	c.svcCtx.Dao.PutDuplicateMessage(c.ctx, userId, outBox.RandomId, rUpdates)
+
+	return rUpdates, nil
+}
+
+// makeReplyUpdates resolves users, chats and channels through their biz clients
+// and syncs the same updates to the other sessions of fromUserId.
+func (c *MsgCore) makeReplyUpdates(fromUserId, fromAuthKeyId int64, updates ...*mtproto.Update) *mtproto.Updates {
+	rUpdates := mtproto.MakeReplyUpdates(
+		func(idList []int64) []*mtproto.User {
+			users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: idList,
+				})
+			return users.GetUserListByIdList(fromUserId, idList...)
+		},
+		func(idList []int64) []*mtproto.Chat {
+			chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
+				&chatpb.TLChatGetChatListByIdList{
+					IdList: idList,
+				})
+			return chats.GetChatListByIdList(fromUserId, idList...)
+		},
+		func(idList []int64) []*mtproto.Chat {
+			channels, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx,
+				&channelpb.TLChannelGetChannelListByIdList{
+					SelfId: fromUserId,
+					Id:     idList,
+				})
+			return channels.GetChatListByIdList(fromUserId, idList...)
+		},
+		updates...)
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    fromUserId,
+		AuthKeyId: fromAuthKeyId,
+		Updates: mtproto.MakeSyncNotMeUpdates(
+			func(idList []int64) []*mtproto.User {
+				return rUpdates.Users
+			},
+			func(idList []int64) []*mtproto.Chat {
+				return rUpdates.Chats
+			},
+			func(idList []int64) []*mtproto.Chat {
+				// rUpdates.Chats include channels
+				return nil
+			},
+			updates...),
+	})
+
+	return rUpdates
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
@@ -27,19 +28,38 @@ func (c *MsgCore) MsgSendMultiMessage(in *msg.TLMsgSendMultiMessage) (*mtproto.U
 		peer     = mtproto.MakePeerUtil(in.PeerType, in.PeerId)
 	)
 
-	if peer.IsChannel() {
-		// c.Logger.Errorf("msg.sendMultiMessage blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		return nil, mtproto.ErrEnterpriseIsBlocked
-	}
-
 	if len(in.Message) == 0 {
 		err = mtproto.ErrGroupedMediaInvalid
 		c.Logger.Errorf("msg.sendMultiMessage - error: %v", err)
 		return nil, err
 	}
-	if in.Message[0].GetScheduleDate().GetValue() != 0 {
-		// c.Logger.Errorf("msg.sendMultiMessage blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		return nil, mtproto.ErrEnterpriseIsBlocked
+	scheduled, err := checkScheduleDate(in.Message[0])
+	if err != nil {
+		c.Logger.Errorf("msg.sendMultiMessage - error: %v", err)
+		return nil, err
+	}
+	// an album is scheduled as a whole
+	for _, outBox := range in.Message[1:] {
+		outBox.ScheduleDate = in.Message[0].ScheduleDate
+	}
+	if scheduled {
+		rUpdates, err = c.sendScheduledOutgoingMessages(in.UserId, in.AuthKeyId, peer, in.Message...)
+		if err != nil {
+			c.Logger.Errorf("msg.sendMultiMessage - error: %v", err)
+			return nil, err
+		}
+
+		return rUpdates, nil
+	}
+
+	if peer.IsChannel() {
+		rUpdates, err = c.sendChannelOutgoingMultiMessage(in)
+		if err != nil {
+			c.Logger.Errorf("msg.sendMultiMessage - error: %v", err)
+			return nil, err
+		}
+
+		return rUpdates, nil
 	}
 
 	if !peer.IsChatOrUser() {
@@ -349,3 +369,42 @@ func (c *MsgCore) sendChatMultiMessage(
 
 	return rUpdates, nil
 }
+
+func (c *MsgCore) sendChannelOutgoingMultiMessage(in *msg.TLMsgSendMultiMessage) (*mtproto.Updates, error) {
+	var (
+		boxList              = make([]*mtproto.MessageBox, 0, len(in.Message))
+		updateNewMessageList = make([]*mtproto.Update, 0, len(in.Message))
+	)
+
+	for _, outBox := range in.Message {
+		box, err := c.svcCtx.Dao.ChannelClient.ChannelSendChannelMessage(c.ctx, &channelpb.TLChannelSendChannelMessage{
+			FromId:    in.UserId,
+			ChannelId: in.PeerId,
+			RandomId:  outBox.RandomId,
+			Message:   outBox.Message,
+		})
+		if err != nil {
+			c.Logger.Errorf("msg.sendMultiMessage - error: %v", err)
+			return nil, err
+		}
+		boxList = append(boxList, box)
+		updateNewMessageList = append(updateNewMessageList, mtproto.MakeTLUpdateNewChannelMessage(&mtproto.Update{
+			Pts_INT32:       box.Pts,
+			PtsCount:        box.PtsCount,
+			RandomId:        box.RandomId,
+			Message_MESSAGE: box.ToMessage(in.UserId),
+		}).To_Update())
+	}
+
+	_, err := c.svcCtx.Dao.InboxClient.InboxSendChannelMultiMessageToInbox(c.ctx, &inbox.TLInboxSendChannelMultiMessageToInbox{
+		FromId:        in.UserId,
+		PeerChannelId: in.PeerId,
+		Message:       boxList,
+	})
+	if err != nil {
+		c.Logger.Errorf("msg.sendMultiMessage - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeReplyUpdates(in.UserId, in.AuthKeyId, updateNewMessageList...), nil
+}
@@ -0,0 +1,206 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+)
+
+// MsgSendReaction
+// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.0?true add_to_recent:flags.1?true peer_type:int peer_id:long msg_id:int reaction:Vector<Reaction> = Updates;
+func (c *MsgCore) MsgSendReaction(in *msg.TLMsgSendReaction) (*mtproto.Updates, error) {
+	var (
+		peer   = mtproto.MakePeerUtil(in.PeerType, in.PeerId)
+		idList []int64
+	)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER:
+		idList = []int64{in.UserId}
+		if peer.PeerId != in.UserId {
+			idList = append(idList, peer.PeerId)
+		}
+	case mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		c.Logger.Errorf("msg.sendReaction blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		return nil, mtproto.ErrEnterpriseIsBlocked
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("msg.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	reactions := make([]string, 0, len(in.Reaction))
+	for _, r := range in.Reaction {
+		if s := r.ToString(); s != "" && !containsReaction(reactions, s) {
+			reactions = append(reactions, s)
+		}
+	}
+
+	boxDO, err := c.svcCtx.Dao.MessagesDAO.SelectByMessageId(c.ctx, in.UserId, in.MsgId)
+	if err != nil {
+		c.Logger.Errorf("msg.sendReaction - error: %v", err)
+		return nil, err
+	} else if boxDO == nil {
+		err = mtproto.ErrMsgIdInvalid
+		c.Logger.Errorf("msg.sendReaction - error: not found msg_id (%d, %d)", in.UserId, in.MsgId)
+		return nil, err
+	}
+
+	if peer.PeerType == mtproto.PEER_CHAT {
+		chat, err := c.svcCtx.Dao.ChatClient.ChatGetMutableChat(c.ctx, &chatpb.TLChatGetMutableChat{
+			ChatId: peer.PeerId,
+		})
+		if err != nil {
+			c.Logger.Errorf("msg.sendReaction - error: %v", err)
+			return nil, err
+		}
+		if me, _ := chat.GetImmutableChatParticipant(in.UserId); me == nil || !me.IsChatMemberStateNormal() {
+			err = mtproto.ErrChatWriteForbidden
+			c.Logger.Errorf("msg.sendReaction - error: %v", err)
+			return nil, err
+		}
+		if !checkChatReactions(chat.GetChat().GetAvailableReactionsType(), chat.GetChat().GetAvailableReactions(), reactions) {
+			err = msg.ErrReactionInvalid
+			c.Logger.Errorf("msg.sendReaction - error: %v", err)
+			return nil, err
+		}
+		idList = chat.ParticipantIdList()
+	}
+
+	// the reactions of in.UserId replace the previous ones, chosen_order keeps the order they were sent in
+	date := time.Now().Unix()
+	if len(reactions) == 0 {
+		c.svcCtx.Dao.MessageReactionsDAO.DeleteByUserId(c.ctx, boxDO.DialogMessageId, in.UserId)
+	} else {
+		c.svcCtx.Dao.MessageReactionsDAO.DeleteByUserIdNotInReactions(c.ctx, boxDO.DialogMessageId, in.UserId, reactions)
+		for i, r := range reactions {
+			c.svcCtx.Dao.MessageReactionsDAO.InsertOrUpdate(c.ctx, &dataobject.MessageReactionsDO{
+				DialogMessageId: boxDO.DialogMessageId,
+				UserId:          in.UserId,
+				Reaction:        r,
+				Big:             in.Big,
+				ChosenOrder:     int32(i),
+				Date2:           date,
+			})
+		}
+	}
+
+	reactionList, err := c.svcCtx.Dao.MessageReactionsDAO.SelectList(c.ctx, boxDO.DialogMessageId)
+	if err != nil {
+		c.Logger.Errorf("msg.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		rUpdates *mtproto.Updates
+	)
+
+	c.walkMessageBoxList(boxDO.DialogMessageId, idList, func(box *dataobject.MessagesDO) {
+		c.svcCtx.Dao.MessagesDAO.UpdateHasReaction(c.ctx, len(reactionList) > 0, box.UserId, box.UserMessageBoxId)
+		if box.UserId == in.UserId {
+			reaction := ""
+			if len(reactions) > 0 {
+				reaction = reactions[0]
+			}
+			c.svcCtx.Dao.MessagesDAO.UpdateReaction(c.ctx, reaction, date, box.UserId, box.UserMessageBoxId)
+		} else if box.UserId == box.SenderUserId && len(reactions) > 0 && !box.ReactionUnread {
+			// the sender has a new reaction to read
+			box.ReactionUnread = true
+			c.svcCtx.Dao.MessagesDAO.UpdateReactionUnread(c.ctx, true, box.UserId, box.UserMessageBoxId)
+		}
+
+		updates := mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateMessageReactions(&mtproto.Update{
+			Peer_PEER:   mtproto.MakePeerUtil(box.PeerType, box.PeerId).ToPeer(),
+			MsgId_INT32: box.UserMessageBoxId,
+			Reactions:   message_helper.MakeMessageReactions(box.UserId, box.PeerType, box.ReactionUnread, reactionList),
+		}).To_Update())
+
+		if box.UserId == in.UserId {
+			rUpdates = updates
+			c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+				UserId:    in.UserId,
+				AuthKeyId: in.AuthKeyId,
+				Updates:   updates,
+			})
+		} else {
+			c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+				UserId:  box.UserId,
+				Updates: updates,
+			})
+		}
+	})
+
+	if rUpdates == nil {
+		rUpdates = mtproto.MakeEmptyUpdates()
+	}
+
+	return rUpdates, nil
+}
+
+// walkMessageBoxList visits the boxes of dialogMessageId owned by idList, grouped by sharding table.
+func (c *MsgCore) walkMessageBoxList(dialogMessageId int64, idList []int64, cb func(box *dataobject.MessagesDO)) {
+	tables := make(map[string][]int64)
+	for _, id := range idList {
+		table := c.svcCtx.Dao.MessagesDAO.CalcTableName(id)
+		tables[table] = append(tables[table], id)
+	}
+
+	for table, v := range tables {
+		c.svcCtx.Dao.MessagesDAO.SelectByMessageDataIdUserIdListWithCB(
+			c.ctx,
+			table,
+			dialogMessageId,
+			v,
+			func(i int, v *dataobject.MessagesDO) {
+				cb(v)
+			})
+	}
+}
+
+// checkChatReactions applies the available_reactions of a chat, chats that never set them allow
+// all emoji reactions.
+func checkChatReactions(reactionsType int32, available []string, reactions []string) bool {
+	for _, r := range reactions {
+		custom := mtproto.FromReaction(r).GetDocumentId() != 0
+		switch reactionsType {
+		case mtproto.ChatReactionsTypeNone:
+			return false
+		case mtproto.ChatReactionsTypeNotDefined, mtproto.ChatReactionsTypeAllNoAllowCustom:
+			if custom {
+				return false
+			}
+		case mtproto.ChatReactionsTypeSome:
+			if !containsReaction(available, r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func containsReaction(reactions []string, r string) bool {
+	for _, v := range reactions {
+		if v == r {
+			return true
+		}
+	}
+
+	return false
+}
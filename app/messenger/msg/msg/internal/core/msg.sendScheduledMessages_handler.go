@@ -0,0 +1,60 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// MsgSendScheduledMessages
+// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (c *MsgCore) MsgSendScheduledMessages(in *msg.TLMsgSendScheduledMessages) (*mtproto.Updates, error) {
+	var (
+		peer     = mtproto.MakePeerUtil(in.PeerType, in.PeerId)
+		rUpdates *mtproto.Updates
+		idList   []int32
+	)
+
+	doList, err := c.svcCtx.Dao.ScheduledMessagesDAO.SelectByIdList(c.ctx, in.UserId, in.PeerType, in.PeerId, in.Id)
+	if err != nil {
+		c.Logger.Errorf("msg.sendScheduledMessages - error: %v", err)
+		return nil, err
+	} else if len(doList) == 0 {
+		err = mtproto.ErrMessageIdInvalid
+		c.Logger.Errorf("msg.sendScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	for i := 0; i < len(doList); i++ {
+		updates, err := c.sendScheduledMessage(in.AuthKeyId, &doList[i])
+		if err != nil {
+			c.Logger.Errorf("msg.sendScheduledMessages - error: %v", err)
+			continue
+		} else if updates == nil {
+			continue
+		}
+
+		idList = append(idList, doList[i].UserMessageBoxId)
+		if rUpdates == nil {
+			rUpdates = updates
+		} else {
+			rUpdates.PushBackUpdate(updates.Updates...)
+		}
+	}
+
+	if len(idList) == 0 {
+		return mtproto.MakeEmptyUpdates(), nil
+	}
+
+	rUpdates.PushBackUpdate(c.makeDeleteScheduledUpdates(in.UserId, in.AuthKeyId, peer, idList).Updates...)
+
+	return rUpdates, nil
+}
@@ -212,3 +212,48 @@ func (s *Service) MsgUnpinAllMessages(ctx context.Context, request *msg.TLMsgUnp
 	c.Logger.Debugf("msg.unpinAllMessages - reply: %s", r.DebugString())
 	return r, err
 }
+
+// MsgSendReaction
+// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.0?true add_to_recent:flags.1?true peer_type:int peer_id:long msg_id:int reaction:Vector<Reaction> = Updates;
+func (s *Service) MsgSendReaction(ctx context.Context, request *msg.TLMsgSendReaction) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("msg.sendReaction - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgSendReaction(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("msg.sendReaction - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MsgSendScheduledMessages
+// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (s *Service) MsgSendScheduledMessages(ctx context.Context, request *msg.TLMsgSendScheduledMessages) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("msg.sendScheduledMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgSendScheduledMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("msg.sendScheduledMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MsgDeleteScheduledMessages
+// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (s *Service) MsgDeleteScheduledMessages(ctx context.Context, request *msg.TLMsgDeleteScheduledMessages) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("msg.deleteScheduledMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgDeleteScheduledMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("msg.deleteScheduledMessages - reply: %s", r.DebugString())
+	return r, err
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/core"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	tickInterval = 5 * time.Second
+	batchSize    = 500
+
+	// a pass never takes this long, the lock outlives a replica dying mid-pass only this much
+	lockExpire = 60
+)
+
+// Reaper deletes the messages whose auto-delete timer (ttl_period) ran out.
+type Reaper struct {
+	svcCtx *svc.ServiceContext
+	quit   chan struct{}
+}
+
+func New(svcCtx *svc.ServiceContext) *Reaper {
+	return &Reaper{
+		svcCtx: svcCtx,
+		quit:   make(chan struct{}),
+	}
+}
+
+// Start runs the reaper until Stop is called.
+func (r *Reaper) Start() {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.quit:
+			return
+		case <-ticker.C:
+			ctx := context.Background()
+			for _, tableName := range r.svcCtx.Dao.MessagesDAO.CalcTableNameList() {
+				r.reapTable(ctx, tableName)
+			}
+		}
+	}
+}
+
+func (r *Reaper) Stop() {
+	close(r.quit)
+}
+
+type dialogKey struct {
+	userId    int64
+	dialogId1 int64
+	dialogId2 int64
+}
+
+// reapTable runs one pass over tableName unless the reaper of another replica is on it.
+func (r *Reaper) reapTable(ctx context.Context, tableName string) {
+	token, err := r.svcCtx.Dao.TryLockReaper(ctx, tableName, lockExpire)
+	if err != nil || token == "" {
+		return
+	}
+	defer r.svcCtx.Dao.UnlockReaper(ctx, tableName, token)
+
+	r.deleteExpiredMessages(ctx, tableName)
+}
+
+func (r *Reaper) deleteExpiredMessages(ctx context.Context, tableName string) {
+	doList, err := r.svcCtx.Dao.MessagesDAO.SelectExpiredList(ctx, tableName, time.Now().Unix(), batchSize)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("reaper.deleteExpiredMessages - error: %v", err)
+		return
+	}
+
+	// msg.deleteMessages works on one dialog at a time
+	var (
+		keyList []dialogKey
+		idList  = make(map[dialogKey][]int32)
+	)
+	for i := 0; i < len(doList); i++ {
+		k := dialogKey{
+			userId:    doList[i].UserId,
+			dialogId1: doList[i].DialogId1,
+			dialogId2: doList[i].DialogId2,
+		}
+		if _, ok := idList[k]; !ok {
+			keyList = append(keyList, k)
+		}
+		idList[k] = append(idList[k], doList[i].UserMessageBoxId)
+	}
+
+	for _, k := range keyList {
+		core.New(ctx, r.svcCtx).DeleteExpiredMessages(k.userId, idList[k])
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dao"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/core"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/svc"
+	"github.com/teamgram/teamgram-server/app/service/status/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	tickInterval = time.Second
+	batchSize    = 100
+)
+
+// Scheduler delivers scheduled messages once they are due. Every msg instance
+// may run one, a message is claimed before it is sent so it goes out only once.
+type Scheduler struct {
+	svcCtx *svc.ServiceContext
+	quit   chan struct{}
+
+	// cursor over the "send when online" messages
+	whenOnlineId int64
+}
+
+func New(svcCtx *svc.ServiceContext) *Scheduler {
+	return &Scheduler{
+		svcCtx: svcCtx,
+		quit:   make(chan struct{}),
+	}
+}
+
+// Start runs the scheduler until Stop is called.
+func (s *Scheduler) Start() {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-ticker.C:
+			ctx := context.Background()
+			s.sendDueMessages(ctx)
+			s.sendWhenOnlineMessages(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) Stop() {
+	close(s.quit)
+}
+
+func (s *Scheduler) sendDueMessages(ctx context.Context) {
+	doList, err := s.svcCtx.Dao.ScheduledMessagesDAO.SelectDueList(ctx, time.Now().Unix(), batchSize)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("scheduler.sendDueMessages - error: %v", err)
+		return
+	}
+
+	for i := 0; i < len(doList); i++ {
+		core.New(ctx, s.svcCtx).SendDueScheduledMessage(&doList[i])
+	}
+}
+
+func (s *Scheduler) sendWhenOnlineMessages(ctx context.Context) {
+	doList, err := s.svcCtx.Dao.ScheduledMessagesDAO.SelectListByScheduleDate(ctx, dao.ScheduleWhenOnline, s.whenOnlineId, batchSize)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("scheduler.sendWhenOnlineMessages - error: %v", err)
+		return
+	} else if len(doList) == 0 {
+		s.whenOnlineId = 0
+		return
+	}
+	s.whenOnlineId = doList[len(doList)-1].Id
+
+	var (
+		dueList = make([]*dataobject.ScheduledMessagesDO, 0, len(doList))
+		idList  = make([]int64, 0, len(doList))
+	)
+	for i := 0; i < len(doList); i++ {
+		if waitsForPeer(&doList[i]) {
+			idList = append(idList, doList[i].PeerId)
+		} else {
+			dueList = append(dueList, &doList[i])
+		}
+	}
+
+	if len(idList) > 0 {
+		sessionsList, err := s.svcCtx.Dao.StatusClient.StatusGetUsersOnlineSessionsList(ctx, &status.TLStatusGetUsersOnlineSessionsList{
+			Users: idList,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("scheduler.sendWhenOnlineMessages - error: %v", err)
+		} else {
+			online := make(map[int64]bool, len(sessionsList.GetDatas()))
+			for _, v := range sessionsList.GetDatas() {
+				online[v.GetUserId()] = len(v.GetUserSessions()) > 0
+			}
+			for i := 0; i < len(doList); i++ {
+				if waitsForPeer(&doList[i]) && online[doList[i].PeerId] {
+					dueList = append(dueList, &doList[i])
+				}
+			}
+		}
+	}
+
+	for _, do := range dueList {
+		core.New(ctx, s.svcCtx).SendDueScheduledMessage(do)
+	}
+}
+
+// waitsForPeer reports whether do waits for its peer to come online,
+// only private chats do, the others go out right away.
+func waitsForPeer(do *dataobject.ScheduledMessagesDO) bool {
+	return do.PeerType == mtproto.PEER_USER && do.PeerId != do.UserId
+}
@@ -19,7 +19,6 @@
 package svc
 
 import (
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	inbox_client "github.com/teamgram/teamgram-server/app/messenger/msg/inbox/client"
@@ -27,10 +26,14 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/config"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/plugin"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
+	status_client "github.com/teamgram/teamgram-server/app/service/status/client"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 )
 
@@ -44,15 +47,18 @@ func NewServiceContext(c config.Config, plugin plugin.MsgPlugin) *ServiceContext
 	return &ServiceContext{
 		Config: c,
 		Dao: &dao.Dao{
-			Mysql:        dao.NewMysqlDao(db, c.MessageSharding),
-			KV:           kv.NewStore(c.KV),
-			IDGenClient2: idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
-			UserClient:   user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
-			InboxClient:  inbox_client.NewInboxMqClient(kafka.MustKafkaProducer(c.InboxClient)),
-			ChatClient:   chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
-			SyncClient:   sync_client.NewSyncMqClient(kafka.GetCachedMQClient(c.SyncClient)),
-			DialogClient: dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
-			MsgPlugin:    plugin,
+			Mysql:         dao.NewMysqlDao(db, c.MessageSharding),
+			SearchIndex:   message_helper.MustNewSearchIndex(c.SearchIndex, db),
+			KV:            kv.NewStore(c.KV),
+			IDGenClient2:  idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
+			UserClient:    user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+			InboxClient:   inbox_client.NewInboxMqClient(mqx.MustProducer(c.InboxClient)),
+			ChatClient:    chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
+			ChannelClient: channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
+			SyncClient:    sync_client.NewSyncMqClient(mqx.GetCachedProducer(c.SyncClient)),
+			DialogClient:  dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
+			StatusClient:  status_client.NewStatusClient(rpcx.GetCachedRpcClient(c.StatusClient)),
+			MsgPlugin:     plugin,
 		},
 	}
 }
@@ -112,6 +112,21 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: -1199153371,
 		}
 	},
+	2008924552: func() mtproto.TLObject { // 0x77bdc188
+		return &TLMsgSendReaction{
+			Constructor: 2008924552,
+		}
+	},
+	-899241015: func() mtproto.TLObject { // 0xca66abc9
+		return &TLMsgSendScheduledMessages{
+			Constructor: -899241015,
+		}
+	},
+	1571885886: func() mtproto.TLObject { // 0x5db1133e
+		return &TLMsgDeleteScheduledMessages{
+			Constructor: 1571885886,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -212,7 +227,7 @@ func (m *TLContentMessage) To_ContentMessage() *ContentMessage {
 	return m.Data2
 }
 
-//// flags
+// // flags
 func (m *TLContentMessage) SetId(v int32) { m.Data2.Id = v }
 func (m *TLContentMessage) GetId() int32  { return m.Data2.Id }
 
@@ -397,7 +412,7 @@ func (m *TLOutboxMessage) To_OutboxMessage() *OutboxMessage {
 	return m.Data2
 }
 
-//// flags
+// // flags
 func (m *TLOutboxMessage) SetNoWebpage(v bool) { m.Data2.NoWebpage = v }
 func (m *TLOutboxMessage) GetNoWebpage() bool  { return m.Data2.NoWebpage }
 
@@ -1500,4 +1515,219 @@ func (m *TLMsgUnpinAllMessages) DebugString() string {
 	return dbgString
 }
 
+// TLMsgSendReaction
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgSendReaction) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_sendReaction))
+
+	switch uint32(m.Constructor) {
+	case 0x77bdc188:
+		// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.0?true add_to_recent:flags.1?true peer_type:int peer_id:long msg_id:int reaction:Vector<Reaction> = Updates;
+		x.UInt(0x77bdc188)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetBig() == true {
+			flags |= 1 << 0
+		}
+		if m.GetAddToRecent() == true {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+		x.Int(m.GetMsgId())
+
+		x.Int(int32(mtproto.CRC32_vector))
+		x.Int(int32(len(m.GetReaction())))
+		for _, v := range m.GetReaction() {
+			x.Bytes((*v).Encode(layer))
+		}
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgSendReaction) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgSendReaction) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x77bdc188:
+		// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.0?true add_to_recent:flags.1?true peer_type:int peer_id:long msg_id:int reaction:Vector<Reaction> = Updates;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.Big = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.AddToRecent = true
+		}
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+		m.MsgId = dBuf.Int()
+		c9 := dBuf.Int()
+		if c9 != int32(mtproto.CRC32_vector) {
+			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 9, c9)
+			return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 9, c9)
+		}
+		l9 := dBuf.Int()
+		v9 := make([]*mtproto.Reaction, l9)
+		for i := int32(0); i < l9; i++ {
+			v9[i] = &mtproto.Reaction{}
+			v9[i].Decode(dBuf)
+		}
+		m.Reaction = v9
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgSendReaction) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMsgSendScheduledMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgSendScheduledMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_sendScheduledMessages))
+
+	switch uint32(m.Constructor) {
+	case 0xca66abc9:
+		// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+		x.UInt(0xca66abc9)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgSendScheduledMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgSendScheduledMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xca66abc9:
+		// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgSendScheduledMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMsgDeleteScheduledMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgDeleteScheduledMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_deleteScheduledMessages))
+
+	switch uint32(m.Constructor) {
+	case 0x5db1133e:
+		// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+		x.UInt(0x5db1133e)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgDeleteScheduledMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgDeleteScheduledMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5db1133e:
+		// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgDeleteScheduledMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 //----------------------------------------------------------------------------------------------------------------
@@ -34,23 +34,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type TLConstructor int32
 
 const (
-	CRC32_UNKNOWN                    TLConstructor = 0
-	CRC32_sender                     TLConstructor = 1513645242
-	CRC32_outboxMessage              TLConstructor = 1402283185
-	CRC32_contentMessage             TLConstructor = -1301595468
-	CRC32_msg_sendMessage            TLConstructor = 1218652155
-	CRC32_msg_sendMultiMessage       TLConstructor = -1727589428
-	CRC32_msg_pushUserMessage        TLConstructor = 902887962
-	CRC32_msg_readMessageContents    TLConstructor = 673481940
-	CRC32_msg_sendMessageV2          TLConstructor = 770211174
-	CRC32_msg_editMessage            TLConstructor = -2129725231
-	CRC32_msg_deleteMessages         TLConstructor = 568855069
-	CRC32_msg_deleteHistory          TLConstructor = 1975576778
-	CRC32_msg_deletePhoneCallHistory TLConstructor = 649568574
-	CRC32_msg_deleteChatHistory      TLConstructor = -283155749
-	CRC32_msg_readHistory            TLConstructor = 1510960658
-	CRC32_msg_updatePinnedMessage    TLConstructor = -441560663
-	CRC32_msg_unpinAllMessages       TLConstructor = -1199153371
+	CRC32_UNKNOWN                     TLConstructor = 0
+	CRC32_sender                      TLConstructor = 1513645242
+	CRC32_outboxMessage               TLConstructor = 1402283185
+	CRC32_contentMessage              TLConstructor = -1301595468
+	CRC32_msg_sendMessage             TLConstructor = 1218652155
+	CRC32_msg_sendMultiMessage        TLConstructor = -1727589428
+	CRC32_msg_pushUserMessage         TLConstructor = 902887962
+	CRC32_msg_readMessageContents     TLConstructor = 673481940
+	CRC32_msg_sendMessageV2           TLConstructor = 770211174
+	CRC32_msg_editMessage             TLConstructor = -2129725231
+	CRC32_msg_deleteMessages          TLConstructor = 568855069
+	CRC32_msg_deleteHistory           TLConstructor = 1975576778
+	CRC32_msg_deletePhoneCallHistory  TLConstructor = 649568574
+	CRC32_msg_deleteChatHistory       TLConstructor = -283155749
+	CRC32_msg_readHistory             TLConstructor = 1510960658
+	CRC32_msg_updatePinnedMessage     TLConstructor = -441560663
+	CRC32_msg_unpinAllMessages        TLConstructor = -1199153371
+	CRC32_msg_sendReaction            TLConstructor = 2008924552
+	CRC32_msg_sendScheduledMessages   TLConstructor = -899241015
+	CRC32_msg_deleteScheduledMessages TLConstructor = 1571885886
 )
 
 var TLConstructor_name = map[int32]string{
@@ -71,26 +74,32 @@ var TLConstructor_name = map[int32]string{
 	1510960658:  "CRC32_msg_readHistory",
 	-441560663:  "CRC32_msg_updatePinnedMessage",
 	-1199153371: "CRC32_msg_unpinAllMessages",
+	2008924552:  "CRC32_msg_sendReaction",
+	-899241015:  "CRC32_msg_sendScheduledMessages",
+	1571885886:  "CRC32_msg_deleteScheduledMessages",
 }
 
 var TLConstructor_value = map[string]int32{
-	"CRC32_UNKNOWN":                    0,
-	"CRC32_sender":                     1513645242,
-	"CRC32_outboxMessage":              1402283185,
-	"CRC32_contentMessage":             -1301595468,
-	"CRC32_msg_sendMessage":            1218652155,
-	"CRC32_msg_sendMultiMessage":       -1727589428,
-	"CRC32_msg_pushUserMessage":        902887962,
-	"CRC32_msg_readMessageContents":    673481940,
-	"CRC32_msg_sendMessageV2":          770211174,
-	"CRC32_msg_editMessage":            -2129725231,
-	"CRC32_msg_deleteMessages":         568855069,
-	"CRC32_msg_deleteHistory":          1975576778,
-	"CRC32_msg_deletePhoneCallHistory": 649568574,
-	"CRC32_msg_deleteChatHistory":      -283155749,
-	"CRC32_msg_readHistory":            1510960658,
-	"CRC32_msg_updatePinnedMessage":    -441560663,
-	"CRC32_msg_unpinAllMessages":       -1199153371,
+	"CRC32_UNKNOWN":                     0,
+	"CRC32_sender":                      1513645242,
+	"CRC32_outboxMessage":               1402283185,
+	"CRC32_contentMessage":              -1301595468,
+	"CRC32_msg_sendMessage":             1218652155,
+	"CRC32_msg_sendMultiMessage":        -1727589428,
+	"CRC32_msg_pushUserMessage":         902887962,
+	"CRC32_msg_readMessageContents":     673481940,
+	"CRC32_msg_sendMessageV2":           770211174,
+	"CRC32_msg_editMessage":             -2129725231,
+	"CRC32_msg_deleteMessages":          568855069,
+	"CRC32_msg_deleteHistory":           1975576778,
+	"CRC32_msg_deletePhoneCallHistory":  649568574,
+	"CRC32_msg_deleteChatHistory":       -283155749,
+	"CRC32_msg_readHistory":             1510960658,
+	"CRC32_msg_updatePinnedMessage":     -441560663,
+	"CRC32_msg_unpinAllMessages":        -1199153371,
+	"CRC32_msg_sendReaction":            2008924552,
+	"CRC32_msg_sendScheduledMessages":   -899241015,
+	"CRC32_msg_deleteScheduledMessages": 1571885886,
 }
 
 func (x TLConstructor) String() string {
@@ -102,8 +111,7 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 }
 
 // ContentMessage <--
-//  + TL_contentMessage
-//
+//   - TL_contentMessage
 type ContentMessage struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
@@ -247,8 +255,7 @@ func (m *TLContentMessage) GetData2() *ContentMessage {
 }
 
 // OutboxMessage <--
-//  + TL_outboxMessage
-//
+//   - TL_outboxMessage
 type OutboxMessage struct {
 	PredicateName        string            `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor     `protobuf:"varint,2,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
@@ -392,8 +399,7 @@ func (m *TLOutboxMessage) GetData2() *OutboxMessage {
 }
 
 // Sender <--
-//  + TL_sender
-//
+//   - TL_sender
 type Sender struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
@@ -520,7 +526,7 @@ func (m *TLSender) GetData2() *Sender {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgSendMessage struct {
 	Constructor          TLConstructor  `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -608,7 +614,7 @@ func (m *TLMsgSendMessage) GetMessage() *OutboxMessage {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgSendMultiMessage struct {
 	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64            `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -696,7 +702,7 @@ func (m *TLMsgSendMultiMessage) GetMessage() []*OutboxMessage {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgPushUserMessage struct {
 	Constructor          TLConstructor  `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -792,7 +798,7 @@ func (m *TLMsgPushUserMessage) GetMessage() *OutboxMessage {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgReadMessageContents struct {
 	Constructor          TLConstructor     `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64             `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -880,7 +886,7 @@ func (m *TLMsgReadMessageContents) GetId() []*ContentMessage {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgSendMessageV2 struct {
 	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64            `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -968,7 +974,7 @@ func (m *TLMsgSendMessageV2) GetMessage() []*OutboxMessage {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgEditMessage struct {
 	Constructor          TLConstructor  `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1064,7 +1070,7 @@ func (m *TLMsgEditMessage) GetMessage() *OutboxMessage {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgDeleteMessages struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1160,7 +1166,7 @@ func (m *TLMsgDeleteMessages) GetId() []int32 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgDeleteHistory struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1264,7 +1270,7 @@ func (m *TLMsgDeleteHistory) GetMaxId() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgDeletePhoneCallHistory struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1336,7 +1342,7 @@ func (m *TLMsgDeletePhoneCallHistory) GetRevoke() bool {
 	return false
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgDeleteChatHistory struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	ChatId               int64         `protobuf:"varint,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
@@ -1400,7 +1406,7 @@ func (m *TLMsgDeleteChatHistory) GetDeleteUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgReadHistory struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1488,7 +1494,7 @@ func (m *TLMsgReadHistory) GetMaxId() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgUpdatePinnedMessage struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1600,7 +1606,7 @@ func (m *TLMsgUpdatePinnedMessage) GetId() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMsgUnpinAllMessages struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1680,6 +1686,297 @@ func (m *TLMsgUnpinAllMessages) GetPeerId() int64 {
 	return 0
 }
 
+// --------------------------------------------------------------------------------------------
+// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.0?true add_to_recent:flags.1?true peer_type:int peer_id:long msg_id:int reaction:Vector<Reaction> = Updates;
+type TLMsgSendReaction struct {
+	Constructor          TLConstructor       `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64               `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64               `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Big                  bool                `protobuf:"varint,5,opt,name=big,proto3" json:"big,omitempty"`
+	AddToRecent          bool                `protobuf:"varint,6,opt,name=add_to_recent,json=addToRecent,proto3" json:"add_to_recent,omitempty"`
+	PeerType             int32               `protobuf:"varint,7,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64               `protobuf:"varint,8,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	MsgId                int32               `protobuf:"varint,9,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Reaction             []*mtproto.Reaction `protobuf:"bytes,10,rep,name=reaction,proto3" json:"reaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *TLMsgSendReaction) Reset()         { *m = TLMsgSendReaction{} }
+func (m *TLMsgSendReaction) String() string { return proto.CompactTextString(m) }
+func (*TLMsgSendReaction) ProtoMessage()    {}
+func (*TLMsgSendReaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{19}
+}
+func (m *TLMsgSendReaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgSendReaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgSendReaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLMsgSendReaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgSendReaction.Merge(m, src)
+}
+func (m *TLMsgSendReaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgSendReaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgSendReaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMsgSendReaction proto.InternalMessageInfo
+
+func (m *TLMsgSendReaction) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMsgSendReaction) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLMsgSendReaction) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLMsgSendReaction) GetBig() bool {
+	if m != nil {
+		return m.Big
+	}
+	return false
+}
+
+func (m *TLMsgSendReaction) GetAddToRecent() bool {
+	if m != nil {
+		return m.AddToRecent
+	}
+	return false
+}
+
+func (m *TLMsgSendReaction) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
+	}
+	return 0
+}
+
+func (m *TLMsgSendReaction) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
+	}
+	return 0
+}
+
+func (m *TLMsgSendReaction) GetMsgId() int32 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *TLMsgSendReaction) GetReaction() []*mtproto.Reaction {
+	if m != nil {
+		return m.Reaction
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------
+// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+type TLMsgSendScheduledMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,6,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,7,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMsgSendScheduledMessages) Reset()         { *m = TLMsgSendScheduledMessages{} }
+func (m *TLMsgSendScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*TLMsgSendScheduledMessages) ProtoMessage()    {}
+func (*TLMsgSendScheduledMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{20}
+}
+func (m *TLMsgSendScheduledMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgSendScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgSendScheduledMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLMsgSendScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgSendScheduledMessages.Merge(m, src)
+}
+func (m *TLMsgSendScheduledMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgSendScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgSendScheduledMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMsgSendScheduledMessages proto.InternalMessageInfo
+
+func (m *TLMsgSendScheduledMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMsgSendScheduledMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLMsgSendScheduledMessages) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLMsgSendScheduledMessages) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
+	}
+	return 0
+}
+
+func (m *TLMsgSendScheduledMessages) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
+	}
+	return 0
+}
+
+func (m *TLMsgSendScheduledMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------
+// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+type TLMsgDeleteScheduledMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,6,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,7,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMsgDeleteScheduledMessages) Reset()         { *m = TLMsgDeleteScheduledMessages{} }
+func (m *TLMsgDeleteScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*TLMsgDeleteScheduledMessages) ProtoMessage()    {}
+func (*TLMsgDeleteScheduledMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{21}
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgDeleteScheduledMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgDeleteScheduledMessages.Merge(m, src)
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgDeleteScheduledMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMsgDeleteScheduledMessages proto.InternalMessageInfo
+
+func (m *TLMsgDeleteScheduledMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMsgDeleteScheduledMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLMsgDeleteScheduledMessages) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLMsgDeleteScheduledMessages) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
+	}
+	return 0
+}
+
+func (m *TLMsgDeleteScheduledMessages) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
+	}
+	return 0
+}
+
+func (m *TLMsgDeleteScheduledMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("msg.TLConstructor", TLConstructor_name, TLConstructor_value)
 	proto.RegisterType((*ContentMessage)(nil), "msg.ContentMessage")
@@ -1701,110 +1998,120 @@ func init() {
 	proto.RegisterType((*TLMsgReadHistory)(nil), "msg.TL_msg_readHistory")
 	proto.RegisterType((*TLMsgUpdatePinnedMessage)(nil), "msg.TL_msg_updatePinnedMessage")
 	proto.RegisterType((*TLMsgUnpinAllMessages)(nil), "msg.TL_msg_unpinAllMessages")
+	proto.RegisterType((*TLMsgSendReaction)(nil), "msg.TL_msg_sendReaction")
+	proto.RegisterType((*TLMsgSendScheduledMessages)(nil), "msg.TL_msg_sendScheduledMessages")
+	proto.RegisterType((*TLMsgDeleteScheduledMessages)(nil), "msg.TL_msg_deleteScheduledMessages")
 }
 
 func init() { proto.RegisterFile("msg.tl.proto", fileDescriptor_cb6f24e718b1b713) }
 
 var fileDescriptor_cb6f24e718b1b713 = []byte{
-	// 1565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x6d, 0x68, 0x1c, 0x55,
-	0x17, 0xce, 0xec, 0x66, 0xbf, 0x4e, 0x3e, 0x3a, 0xb9, 0x49, 0x9a, 0xcd, 0xa6, 0xd9, 0x6e, 0xe6,
-	0x7d, 0x4b, 0xf7, 0x2d, 0x6f, 0x37, 0xb0, 0x7d, 0x7f, 0xbe, 0x16, 0xdb, 0xf5, 0xa3, 0x4b, 0xd3,
-	0x24, 0x6c, 0x93, 0x14, 0xfd, 0x33, 0x4e, 0x76, 0x6e, 0x67, 0xc7, 0xec, 0xcc, 0x2c, 0x33, 0x77,
-	0xda, 0xe6, 0xb7, 0x22, 0x2d, 0x08, 0x85, 0xea, 0x1f, 0xa5, 0x4a, 0xa5, 0xa8, 0x08, 0x5a, 0x50,
-	0x54, 0x50, 0xb1, 0xe0, 0x47, 0xa1, 0x15, 0xc1, 0x16, 0xfa, 0x4f, 0x91, 0xb6, 0x48, 0x45, 0x10,
-	0x54, 0x50, 0xa8, 0x16, 0x8d, 0xcc, 0xdc, 0x99, 0x9d, 0x8f, 0x9d, 0xd8, 0x62, 0xd1, 0xb2, 0xfe,
-	0x08, 0xec, 0x3d, 0xe7, 0xdc, 0x73, 0xcf, 0xf3, 0xdc, 0x67, 0xee, 0x3d, 0x37, 0xd0, 0xaf, 0x18,
-	0x52, 0x89, 0x34, 0x4b, 0x2d, 0x5d, 0x23, 0x1a, 0x8a, 0x2b, 0x86, 0x94, 0xdb, 0x2e, 0xc9, 0xa4,
-	0x61, 0x2e, 0x97, 0xea, 0x9a, 0x32, 0x2d, 0x69, 0x92, 0x36, 0x6d, 0xfb, 0x96, 0xcd, 0x83, 0xf6,
-	0xc8, 0x1e, 0xd8, 0xbf, 0xe8, 0x9c, 0x5c, 0x5e, 0xd2, 0x34, 0xa9, 0x89, 0xbd, 0xa8, 0xc3, 0xba,
-	0xd0, 0x6a, 0x61, 0xdd, 0x70, 0xfc, 0x39, 0xa3, 0xde, 0xc0, 0x8a, 0x60, 0x2d, 0x52, 0xd7, 0x74,
-	0xcc, 0x93, 0xd5, 0x16, 0x76, 0x7d, 0xe3, 0x9e, 0x8f, 0xe8, 0x82, 0x6a, 0xb4, 0x34, 0x9d, 0x38,
-	0xae, 0x11, 0xcf, 0x65, 0xac, 0xaa, 0x75, 0x6a, 0xe5, 0x9e, 0x88, 0xc1, 0x60, 0x45, 0x53, 0x09,
-	0x56, 0xc9, 0x3e, 0x6c, 0x18, 0x82, 0x84, 0xd1, 0x16, 0x18, 0x6c, 0xe9, 0x58, 0x94, 0xeb, 0x02,
-	0xc1, 0xbc, 0x2a, 0x28, 0x38, 0xcb, 0x14, 0x98, 0x62, 0xa6, 0x36, 0xd0, 0xb6, 0xce, 0x0a, 0x0a,
-	0x46, 0xff, 0x83, 0xbe, 0xba, 0xa6, 0x1a, 0x44, 0x37, 0xeb, 0x44, 0xd3, 0xb3, 0xb1, 0x02, 0x53,
-	0x1c, 0x2c, 0xa3, 0x92, 0x05, 0x7f, 0x61, 0xa6, 0xe2, 0x79, 0x6a, 0xfe, 0x30, 0x34, 0x08, 0x31,
-	0x59, 0xcc, 0xc6, 0x0b, 0x4c, 0x31, 0x51, 0x8b, 0xc9, 0x22, 0xda, 0x06, 0x43, 0xa2, 0x2c, 0x34,
-	0x35, 0x89, 0x57, 0xe8, 0xf2, 0xbc, 0x2c, 0x66, 0x7b, 0x0b, 0x4c, 0x31, 0x5e, 0xdb, 0x40, 0x1d,
-	0x4e, 0x59, 0x55, 0x11, 0x6d, 0x82, 0x8c, 0x82, 0x55, 0x22, 0x6b, 0x2a, 0x16, 0xb3, 0x89, 0x02,
-	0x53, 0x4c, 0xd7, 0x3c, 0x03, 0x9a, 0x82, 0x7e, 0x05, 0x8b, 0xb2, 0xc0, 0x9b, 0xaa, 0x8e, 0x05,
-	0x31, 0x9b, 0xb4, 0x03, 0xfa, 0x6c, 0xdb, 0xa2, 0x6d, 0x42, 0x39, 0x48, 0xeb, 0x58, 0xa8, 0x5b,
-	0x13, 0xb2, 0x29, 0xdb, 0xdd, 0x1e, 0x73, 0x3b, 0x61, 0x68, 0x61, 0x86, 0xaf, 0x07, 0xa9, 0xf8,
-	0x0f, 0x24, 0x44, 0x81, 0x08, 0x65, 0x9b, 0x81, 0xbe, 0xf2, 0xb0, 0x8d, 0x2e, 0x48, 0x57, 0x8d,
-	0x46, 0x70, 0x6f, 0xc7, 0x60, 0x60, 0xce, 0x24, 0xcb, 0xda, 0x91, 0xbf, 0x85, 0xc7, 0x49, 0x00,
-	0x55, 0xe3, 0x0f, 0xe3, 0xe5, 0x96, 0x20, 0x61, 0x9b, 0xcf, 0x74, 0x2d, 0xa3, 0x6a, 0x07, 0xa8,
-	0x01, 0xe5, 0x01, 0x96, 0x85, 0xfa, 0x8a, 0xa4, 0x6b, 0xa6, 0x4a, 0xf9, 0x4c, 0xd7, 0x7c, 0x16,
-	0x34, 0x01, 0x19, 0x5d, 0x50, 0x45, 0x4d, 0xb1, 0xe8, 0x4e, 0xd8, 0x74, 0xa7, 0xa9, 0xa1, 0x6a,
-	0xed, 0x49, 0xca, 0xd9, 0x0c, 0x9b, 0xc4, 0xbe, 0x32, 0x5b, 0x52, 0x88, 0x2d, 0x97, 0x92, 0x0b,
-	0xda, 0x0d, 0x40, 0xf7, 0xc2, 0x80, 0xa5, 0x2b, 0xd1, 0x6c, 0x62, 0x5e, 0x14, 0x08, 0xb6, 0x79,
-	0xed, 0x2b, 0x4f, 0x94, 0xa8, 0x88, 0x4b, 0xae, 0x88, 0x4b, 0x55, 0x95, 0xec, 0x28, 0x2f, 0x09,
-	0x4d, 0x13, 0xd7, 0xfa, 0xdd, 0x19, 0xf7, 0x09, 0x04, 0x73, 0xff, 0x07, 0x76, 0x61, 0x86, 0xd7,
-	0x02, 0xd4, 0x15, 0x83, 0xbc, 0x53, 0x36, 0x02, 0xec, 0xba, 0xb4, 0xbf, 0xc1, 0x40, 0x72, 0x3f,
-	0x56, 0x45, 0xac, 0xff, 0xb5, 0x7c, 0x8f, 0x41, 0xca, 0x34, 0xb0, 0xce, 0x3b, 0xe2, 0x8d, 0xd7,
-	0x92, 0xd6, 0xb0, 0x2a, 0x22, 0x04, 0xbd, 0xd6, 0x07, 0x68, 0x73, 0x9c, 0xa8, 0xd9, 0xbf, 0x51,
-	0x1e, 0xfa, 0x04, 0x93, 0x34, 0xf8, 0x15, 0xbc, 0xea, 0xf1, 0x9b, 0xb1, 0x4c, 0x7b, 0xf1, 0x6a,
-	0x55, 0xe4, 0x4a, 0x90, 0x59, 0x98, 0xe1, 0x0d, 0x5a, 0xf6, 0x54, 0x10, 0x6b, 0x9f, 0x5d, 0x09,
-	0x85, 0xe4, 0x82, 0xfc, 0x9a, 0x01, 0xb4, 0x30, 0xc3, 0x2b, 0x86, 0x64, 0x4f, 0x72, 0x59, 0x0a,
-	0x21, 0x61, 0xee, 0x10, 0x49, 0xa8, 0xea, 0xde, 0x50, 0xd5, 0x96, 0x66, 0x5a, 0x18, 0xeb, 0xf6,
-	0x79, 0x63, 0x63, 0x4a, 0xd4, 0xd2, 0x96, 0x61, 0xc1, 0x82, 0x3c, 0x06, 0x29, 0xdb, 0x29, 0xd3,
-	0x0f, 0x2f, 0x5e, 0x4b, 0x5a, 0xc3, 0xaa, 0x88, 0xfe, 0xeb, 0x89, 0x29, 0xb5, 0xee, 0x66, 0xba,
-	0x21, 0xdc, 0xb7, 0x0c, 0x8c, 0xf9, 0x91, 0x9a, 0x4d, 0x22, 0x77, 0x2f, 0xdc, 0xf8, 0xad, 0xe0,
-	0x1e, 0x8d, 0xc1, 0x46, 0x07, 0x6e, 0xcb, 0x34, 0x1a, 0x8b, 0x06, 0xd6, 0xbb, 0x0a, 0xad, 0x35,
-	0xcb, 0x34, 0x1a, 0x74, 0x56, 0xca, 0x99, 0x65, 0x1a, 0x0d, 0x7b, 0x96, 0x8f, 0x8a, 0xf4, 0xad,
-	0x77, 0xfe, 0x3a, 0x03, 0x39, 0x87, 0x0a, 0xeb, 0xac, 0x76, 0xfc, 0xce, 0x59, 0x6b, 0x74, 0x07,
-	0x1d, 0xff, 0xb2, 0x2f, 0x37, 0xba, 0xef, 0x91, 0x77, 0x45, 0x4c, 0x16, 0xb9, 0x6f, 0x18, 0x18,
-	0xe9, 0xfc, 0x98, 0x97, 0xca, 0xff, 0x44, 0x7d, 0x3f, 0x1e, 0x6b, 0x1f, 0x5c, 0x58, 0x94, 0x49,
-	0xb7, 0x69, 0xdb, 0xaa, 0x39, 0xa0, 0x6d, 0xcb, 0xf0, 0x27, 0xb4, 0xfd, 0x15, 0x03, 0xa3, 0x0e,
-	0x0d, 0x22, 0x6e, 0x62, 0x82, 0x9d, 0x88, 0x2e, 0x91, 0xf5, 0x46, 0x48, 0xea, 0xf8, 0x90, 0xb6,
-	0x82, 0x9d, 0xa6, 0xc9, 0x19, 0x39, 0xbd, 0x5c, 0xba, 0x10, 0xa7, 0xbd, 0x1c, 0x77, 0x2c, 0xd6,
-	0x56, 0x36, 0x85, 0xb9, 0x47, 0x36, 0x88, 0xa6, 0xaf, 0x76, 0x07, 0xca, 0x49, 0x80, 0x47, 0x4d,
-	0x83, 0xf0, 0xf5, 0x26, 0x16, 0x74, 0x07, 0x69, 0xc6, 0xb2, 0x54, 0x2c, 0x83, 0x8f, 0x84, 0x74,
-	0x80, 0x84, 0x51, 0x48, 0x2a, 0xc2, 0x11, 0x2b, 0x5d, 0xc6, 0x5e, 0x29, 0xa1, 0x08, 0x47, 0xaa,
-	0x22, 0xf7, 0x12, 0x03, 0x93, 0x01, 0x2e, 0xe6, 0x1b, 0x9a, 0x8a, 0x2b, 0x42, 0xb3, 0x79, 0x97,
-	0x48, 0xf1, 0xea, 0x4f, 0xf8, 0xeb, 0xe7, 0x8e, 0x33, 0x90, 0x0d, 0x14, 0x5a, 0x69, 0x08, 0xe4,
-	0x8e, 0x6b, 0xac, 0x37, 0x04, 0xe2, 0xab, 0xd1, 0x1a, 0x56, 0x45, 0xf4, 0x6f, 0x18, 0xa4, 0x6b,
-	0xf0, 0x2e, 0x06, 0x5a, 0x66, 0x3f, 0xb5, 0x2e, 0xda, 0x48, 0xb8, 0x8b, 0x5e, 0xb7, 0x63, 0xdd,
-	0x04, 0x5d, 0x25, 0x22, 0x4f, 0x0d, 0x29, 0xbf, 0x1a, 0x9e, 0x8f, 0xb5, 0x2f, 0x37, 0xb3, 0x65,
-	0xb5, 0xc9, 0xf3, 0xb2, 0xaa, 0xe2, 0xbb, 0xd5, 0xc8, 0x6d, 0x84, 0xa4, 0x21, 0x37, 0xb1, 0x4a,
-	0x5c, 0x29, 0xd0, 0x11, 0x1a, 0x81, 0x84, 0xa9, 0xb6, 0x64, 0xd5, 0x79, 0x3a, 0xd1, 0x81, 0xf5,
-	0x5d, 0xb4, 0x14, 0x5e, 0x53, 0xb1, 0x21, 0x8b, 0xee, 0x09, 0x90, 0x69, 0x29, 0x73, 0xd4, 0x10,
-	0xe4, 0x29, 0xbd, 0x3e, 0x4f, 0x99, 0x00, 0x4f, 0xf4, 0xe8, 0x00, 0xf7, 0x19, 0xc8, 0x9d, 0xf5,
-	0xfa, 0x3e, 0x7b, 0xd5, 0x5d, 0xcd, 0x66, 0x57, 0x9d, 0x91, 0xdb, 0xae, 0xf4, 0xc2, 0x40, 0xa0,
-	0x1a, 0x34, 0x04, 0x03, 0x95, 0x5a, 0x65, 0x47, 0x99, 0x5f, 0x9c, 0xdd, 0x3b, 0x3b, 0x77, 0x60,
-	0x96, 0xed, 0x41, 0x23, 0xd0, 0x4f, 0x4d, 0xb4, 0xf5, 0x67, 0xdf, 0xbd, 0x70, 0xf5, 0x52, 0x02,
-	0x4d, 0xc0, 0x30, 0xb5, 0x06, 0xde, 0x40, 0xec, 0xeb, 0x17, 0x2e, 0x9f, 0x4c, 0xa0, 0x29, 0x18,
-	0xa1, 0xce, 0xe0, 0xcb, 0x94, 0x7d, 0xf3, 0xa7, 0x57, 0x9f, 0xba, 0xb9, 0xb6, 0xb6, 0xb6, 0xc6,
-	0xa0, 0x49, 0x18, 0xa5, 0x21, 0xa1, 0x96, 0x82, 0xbd, 0xf9, 0xd9, 0x93, 0xe7, 0x7a, 0xd1, 0x56,
-	0xc8, 0x85, 0xdc, 0xbe, 0xa6, 0x9a, 0xfd, 0xf4, 0xe5, 0x93, 0xe7, 0x7f, 0xa6, 0x79, 0xa6, 0x60,
-	0xdc, 0x0b, 0x0c, 0xb5, 0xa3, 0xec, 0xb3, 0x3f, 0x7e, 0x74, 0x26, 0x8e, 0xb6, 0xc0, 0xa4, 0x17,
-	0x12, 0xd1, 0xa6, 0xb1, 0x97, 0x8f, 0x9d, 0xf8, 0x20, 0x86, 0x36, 0xc3, 0x58, 0x64, 0x45, 0x4b,
-	0x65, 0xf6, 0xfa, 0x2f, 0x2f, 0x7c, 0x17, 0x43, 0x9c, 0xbf, 0x64, 0x5f, 0x67, 0xc0, 0x5e, 0xfa,
-	0xe1, 0xbd, 0xa3, 0x37, 0x68, 0x39, 0x05, 0xc8, 0x7a, 0x31, 0xc1, 0x6b, 0x93, 0x7d, 0xee, 0xe9,
-	0x53, 0xc7, 0x43, 0xcb, 0x04, 0x6e, 0x1c, 0xf6, 0x93, 0x4b, 0x8f, 0x9d, 0x49, 0xa1, 0x22, 0x14,
-	0xc2, 0x01, 0xe1, 0x63, 0x98, 0x3d, 0xfb, 0xe1, 0x97, 0x6f, 0xc5, 0x50, 0x11, 0x26, 0xc2, 0x91,
-	0xbe, 0x73, 0x90, 0xfd, 0xfc, 0xdc, 0xaf, 0x37, 0x7e, 0x8b, 0x60, 0xdb, 0x77, 0x3e, 0xb1, 0x27,
-	0xbe, 0x78, 0xff, 0x62, 0x02, 0x6d, 0xf3, 0x33, 0x14, 0xf1, 0xad, 0xb3, 0xaf, 0x9c, 0x7e, 0xe7,
-	0x35, 0x27, 0x55, 0x60, 0x67, 0xc2, 0xb2, 0x67, 0x5f, 0x3c, 0xfb, 0xcc, 0xc7, 0x74, 0x87, 0x73,
-	0xbd, 0x47, 0x4f, 0xe7, 0x7b, 0xca, 0xa7, 0xd2, 0x90, 0xac, 0xcd, 0x57, 0xf6, 0x19, 0x12, 0xda,
-	0x09, 0x1b, 0xc2, 0x8f, 0xc1, 0x31, 0xe7, 0x83, 0x08, 0x73, 0x9e, 0xf3, 0x1e, 0xef, 0x8b, 0x76,
-	0x39, 0x06, 0xd7, 0x83, 0xf6, 0xc0, 0x48, 0xe4, 0x13, 0x6b, 0x53, 0x47, 0x12, 0x9f, 0x37, 0x32,
-	0x53, 0x05, 0x86, 0xa3, 0x5e, 0x2f, 0x13, 0xfe, 0x44, 0x21, 0x67, 0x6e, 0xa0, 0x9d, 0x67, 0xb7,
-	0xa6, 0x35, 0xb9, 0x1e, 0xf4, 0x08, 0x8c, 0xad, 0xd7, 0xf7, 0x6f, 0xf6, 0x27, 0x8a, 0x08, 0xc8,
-	0x71, 0xed, 0x64, 0x4e, 0xb7, 0x65, 0xf0, 0xbb, 0x0e, 0x1e, 0xc4, 0x75, 0xd2, 0xa6, 0xdc, 0x2a,
-	0xf3, 0x7e, 0x18, 0xea, 0x6c, 0xb8, 0xc7, 0xd7, 0xa1, 0x6c, 0xa9, 0x9c, 0x1b, 0x0e, 0x41, 0x9d,
-	0x91, 0x0d, 0xc2, 0xf5, 0xb8, 0xbc, 0xfb, 0x7b, 0xd9, 0x00, 0xef, 0x3e, 0x47, 0x24, 0x5b, 0x4b,
-	0x80, 0x22, 0x9a, 0xc0, 0x9c, 0x3f, 0x45, 0xd0, 0x77, 0x9b, 0xf0, 0xf6, 0x53, 0x78, 0xc1, 0xae,
-	0x6b, 0xbc, 0x33, 0xad, 0xe3, 0xca, 0x4d, 0xad, 0x9f, 0xd5, 0x09, 0xe1, 0x7a, 0xd0, 0x0a, 0xe4,
-	0xfe, 0xa0, 0x7d, 0xe1, 0x3a, 0xb3, 0x87, 0x63, 0x72, 0x5b, 0xd7, 0x5f, 0xe6, 0x01, 0xcd, 0x54,
-	0xfd, 0x08, 0x1e, 0x84, 0xd1, 0xe8, 0x16, 0x64, 0xb2, 0x73, 0x1d, 0x9f, 0xbb, 0x53, 0x4b, 0xf3,
-	0x74, 0x8b, 0xfc, 0x9d, 0xc3, 0x58, 0x58, 0x43, 0xee, 0xe4, 0xdb, 0x23, 0x77, 0x96, 0xaa, 0x33,
-	0xea, 0xe2, 0x0e, 0xa8, 0x33, 0x22, 0x20, 0x52, 0x04, 0x0f, 0xd1, 0x8f, 0xaf, 0xe3, 0x9e, 0x0b,
-	0x7c, 0x7c, 0x61, 0xef, 0x6d, 0x6d, 0xd9, 0xee, 0xb9, 0xef, 0xaf, 0xe6, 0x99, 0xf3, 0xd7, 0xf2,
-	0xcc, 0xc5, 0x6b, 0x79, 0xe6, 0xca, 0xb5, 0x3c, 0xf3, 0xf0, 0x3d, 0xbe, 0xff, 0x3d, 0x13, 0x2c,
-	0x28, 0x92, 0x2e, 0x78, 0x3f, 0xb6, 0x1b, 0x58, 0x3f, 0x84, 0xf5, 0x69, 0xa1, 0xd5, 0x9a, 0xb6,
-	0xb2, 0x62, 0x55, 0xc2, 0xfa, 0xb4, 0x62, 0x48, 0xee, 0xdf, 0x72, 0xd2, 0x5e, 0x72, 0xc7, 0xef,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x47, 0xbf, 0x5d, 0xb2, 0xd6, 0x16, 0x00, 0x00,
+	// 1668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x6f, 0x68, 0x1c, 0xd5,
+	0x16, 0xcf, 0xec, 0x66, 0xff, 0x9d, 0xfc, 0xe9, 0xe4, 0x66, 0x93, 0xdd, 0x6c, 0x9a, 0xed, 0x66,
+	0xde, 0x2b, 0xdd, 0x57, 0x5e, 0x37, 0xb0, 0x7d, 0x1f, 0xdf, 0x2b, 0xaf, 0xdd, 0xf7, 0xa7, 0x4b,
+	0xd3, 0x24, 0x4c, 0x93, 0x94, 0xf7, 0xbe, 0x8c, 0x93, 0x9d, 0xdb, 0xd9, 0x31, 0x3b, 0x33, 0xcb,
+	0xfc, 0x69, 0x9b, 0x6f, 0x42, 0x45, 0x52, 0x10, 0x0a, 0xd5, 0x2f, 0x4a, 0x15, 0xa5, 0xa8, 0x08,
+	0x5a, 0x50, 0x54, 0x50, 0x69, 0xc1, 0x3f, 0x85, 0x56, 0x04, 0x5b, 0xe8, 0x37, 0x45, 0xda, 0x22,
+	0x15, 0x41, 0x50, 0x41, 0xb1, 0x5a, 0x34, 0x32, 0x73, 0x67, 0x76, 0xfe, 0xec, 0xae, 0x2d, 0x16,
+	0x5b, 0xd6, 0x0f, 0x81, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0xf9, 0xfd, 0xee, 0xb9, 0xf7, 0x9e, 0xb3,
+	0x81, 0x41, 0x59, 0x17, 0x4b, 0x46, 0xa3, 0xd4, 0xd4, 0x54, 0x43, 0x45, 0x51, 0x59, 0x17, 0x73,
+	0x3b, 0x44, 0xc9, 0xa8, 0x9b, 0x2b, 0xa5, 0x9a, 0x2a, 0xcf, 0x88, 0xaa, 0xa8, 0xce, 0xd8, 0xba,
+	0x15, 0xf3, 0x90, 0x3d, 0xb2, 0x07, 0xf6, 0x17, 0x99, 0x93, 0xcb, 0x8b, 0xaa, 0x2a, 0x36, 0xb0,
+	0x67, 0x75, 0x44, 0xe3, 0x9b, 0x4d, 0xac, 0xe9, 0x8e, 0x3e, 0xa7, 0xd7, 0xea, 0x58, 0xe6, 0xad,
+	0x45, 0x6a, 0xaa, 0x86, 0x39, 0x63, 0xad, 0x89, 0x5d, 0xdd, 0x84, 0xa7, 0x33, 0x34, 0x5e, 0xd1,
+	0x9b, 0xaa, 0x66, 0x38, 0xaa, 0xb4, 0xa7, 0xd2, 0xd7, 0x94, 0x1a, 0x91, 0x32, 0x8f, 0x44, 0x60,
+	0xb8, 0xa2, 0x2a, 0x06, 0x56, 0x8c, 0xfd, 0x58, 0xd7, 0x79, 0x11, 0xa3, 0xad, 0x30, 0xdc, 0xd4,
+	0xb0, 0x20, 0xd5, 0x78, 0x03, 0x73, 0x0a, 0x2f, 0xe3, 0x2c, 0x55, 0xa0, 0x8a, 0x29, 0x76, 0xa8,
+	0x25, 0x9d, 0xe3, 0x65, 0x8c, 0xfe, 0x06, 0x03, 0x35, 0x55, 0xd1, 0x0d, 0xcd, 0xac, 0x19, 0xaa,
+	0x96, 0x8d, 0x14, 0xa8, 0xe2, 0x70, 0x19, 0x95, 0x2c, 0xf8, 0x8b, 0xb3, 0x15, 0x4f, 0xc3, 0xfa,
+	0xcd, 0xd0, 0x30, 0x44, 0x24, 0x21, 0x1b, 0x2d, 0x50, 0xc5, 0x18, 0x1b, 0x91, 0x04, 0xb4, 0x1d,
+	0x46, 0x04, 0x89, 0x6f, 0xa8, 0x22, 0x27, 0x93, 0xe5, 0x39, 0x49, 0xc8, 0xf6, 0x17, 0xa8, 0x62,
+	0x94, 0xdd, 0x44, 0x14, 0x4e, 0x58, 0x55, 0x01, 0x6d, 0x86, 0x94, 0x8c, 0x15, 0x43, 0x52, 0x15,
+	0x2c, 0x64, 0x63, 0x05, 0xaa, 0x98, 0x64, 0x3d, 0x01, 0x9a, 0x86, 0x41, 0x19, 0x0b, 0x12, 0xcf,
+	0x99, 0x8a, 0x86, 0x79, 0x21, 0x1b, 0xb7, 0x0d, 0x06, 0x6c, 0xd9, 0x92, 0x2d, 0x42, 0x39, 0x48,
+	0x6a, 0x98, 0xaf, 0x59, 0x13, 0xb2, 0x09, 0x5b, 0xdd, 0x1a, 0x33, 0xbb, 0x60, 0x64, 0x71, 0x96,
+	0xab, 0x05, 0xa9, 0xf8, 0x0b, 0xc4, 0x04, 0xde, 0xe0, 0xcb, 0x36, 0x03, 0x03, 0xe5, 0x51, 0x1b,
+	0x5d, 0x90, 0x2e, 0x96, 0x58, 0x30, 0x6f, 0x44, 0x60, 0x68, 0xde, 0x34, 0x56, 0xd4, 0xa3, 0xf7,
+	0x84, 0xc7, 0x29, 0x00, 0x45, 0xe5, 0x8e, 0xe0, 0x95, 0x26, 0x2f, 0x62, 0x9b, 0xcf, 0x24, 0x9b,
+	0x52, 0xd4, 0x83, 0x44, 0x80, 0xf2, 0x00, 0x2b, 0x7c, 0x6d, 0x55, 0xd4, 0x54, 0x53, 0x21, 0x7c,
+	0x26, 0x59, 0x9f, 0x04, 0x4d, 0x42, 0x4a, 0xe3, 0x15, 0x41, 0x95, 0x39, 0x89, 0x50, 0x19, 0x65,
+	0x93, 0x44, 0x50, 0xb5, 0xf6, 0x24, 0xe1, 0x6c, 0x86, 0x4d, 0xe2, 0x40, 0x99, 0x2e, 0xc9, 0x86,
+	0x9d, 0x2e, 0x25, 0x17, 0xb4, 0x6b, 0x80, 0xfe, 0x09, 0x43, 0x56, 0x5e, 0x09, 0x66, 0x03, 0x73,
+	0x02, 0x6f, 0x60, 0x9b, 0xd7, 0x81, 0xf2, 0x64, 0x89, 0x24, 0x71, 0xc9, 0x4d, 0xe2, 0x52, 0x55,
+	0x31, 0x76, 0x96, 0x97, 0xf9, 0x86, 0x89, 0xd9, 0x41, 0x77, 0xc6, 0xbf, 0x78, 0x03, 0x33, 0x7f,
+	0x07, 0x7a, 0x71, 0x96, 0x53, 0x03, 0xd4, 0x15, 0x83, 0xbc, 0x13, 0x36, 0x02, 0xec, 0xba, 0xb4,
+	0xbf, 0x4a, 0x41, 0xfc, 0x00, 0x56, 0x04, 0xac, 0xfd, 0xbe, 0x7c, 0x67, 0x20, 0x61, 0xea, 0x58,
+	0xe3, 0x9c, 0xe4, 0x8d, 0xb2, 0x71, 0x6b, 0x58, 0x15, 0x10, 0x82, 0x7e, 0xeb, 0x00, 0xda, 0x1c,
+	0xc7, 0x58, 0xfb, 0x1b, 0xe5, 0x61, 0x80, 0x37, 0x8d, 0x3a, 0xb7, 0x8a, 0xd7, 0x3c, 0x7e, 0x53,
+	0x96, 0x68, 0x1f, 0x5e, 0xab, 0x0a, 0x4c, 0x09, 0x52, 0x8b, 0xb3, 0x9c, 0x4e, 0xc2, 0x9e, 0x0e,
+	0x62, 0x1d, 0xb0, 0x23, 0x21, 0x90, 0x5c, 0x90, 0x9f, 0x53, 0x80, 0x16, 0x67, 0x39, 0x59, 0x17,
+	0xed, 0x49, 0x2e, 0x4b, 0x21, 0x24, 0xd4, 0x5d, 0x22, 0x09, 0x45, 0xdd, 0x1f, 0x8a, 0xda, 0xca,
+	0x99, 0x26, 0xc6, 0x9a, 0x7d, 0xdf, 0xd8, 0x98, 0x62, 0x6c, 0xd2, 0x12, 0x2c, 0x5a, 0x90, 0x33,
+	0x90, 0xb0, 0x95, 0x12, 0x39, 0x78, 0x51, 0x36, 0x6e, 0x0d, 0xab, 0x02, 0xfa, 0xab, 0x97, 0x4c,
+	0x89, 0xae, 0x9b, 0xe9, 0x9a, 0x30, 0x5f, 0x52, 0x90, 0xf1, 0x23, 0x35, 0x1b, 0x86, 0xd4, 0xbb,
+	0x70, 0xa3, 0xb7, 0x83, 0xbb, 0x1e, 0x81, 0x71, 0x07, 0x6e, 0xd3, 0xd4, 0xeb, 0x4b, 0x3a, 0xd6,
+	0x7a, 0x0a, 0xad, 0x35, 0xcb, 0xd4, 0xeb, 0x64, 0x56, 0xc2, 0x99, 0x65, 0xea, 0x75, 0x7b, 0x96,
+	0x8f, 0x8a, 0xe4, 0xed, 0x77, 0xfe, 0x06, 0x05, 0x39, 0x87, 0x0a, 0xeb, 0xae, 0x76, 0xf4, 0xce,
+	0x5d, 0xab, 0xf7, 0x06, 0x1d, 0x7f, 0xb2, 0x1f, 0x37, 0xb2, 0xef, 0x1d, 0xdf, 0x8a, 0x88, 0x24,
+	0x30, 0x5f, 0x50, 0x90, 0x6e, 0x3f, 0xcc, 0xcb, 0xe5, 0x3f, 0x62, 0x7e, 0x3f, 0x1c, 0x69, 0x5d,
+	0x5c, 0x58, 0x90, 0x8c, 0x5e, 0xcb, 0x6d, 0x2b, 0xe6, 0x40, 0x6e, 0x5b, 0x82, 0xdf, 0x90, 0xdb,
+	0x9f, 0x51, 0x30, 0xe6, 0xd0, 0x20, 0xe0, 0x06, 0x36, 0xb0, 0x63, 0xd1, 0x23, 0x69, 0x3d, 0x0e,
+	0x71, 0x0d, 0x1f, 0x56, 0x57, 0xb1, 0x53, 0x34, 0x39, 0x23, 0xa7, 0x96, 0x4b, 0x16, 0xa2, 0xa4,
+	0x96, 0x63, 0x8e, 0x47, 0x20, 0x1d, 0x80, 0xb9, 0x57, 0xd2, 0x0d, 0x55, 0x5b, 0xeb, 0x0d, 0x94,
+	0x53, 0x00, 0x0f, 0x9a, 0xba, 0xc1, 0xd5, 0x1a, 0x98, 0xd7, 0x1c, 0xa4, 0x29, 0x4b, 0x52, 0xb1,
+	0x04, 0x3e, 0x12, 0x92, 0x01, 0x12, 0xc6, 0x20, 0x2e, 0xf3, 0x47, 0x2d, 0x77, 0x29, 0x7b, 0xa5,
+	0x98, 0xcc, 0x1f, 0xad, 0x0a, 0xcc, 0xf3, 0x14, 0x4c, 0x05, 0xb8, 0x58, 0xa8, 0xab, 0x0a, 0xae,
+	0xf0, 0x8d, 0xc6, 0x7d, 0x22, 0xc5, 0x8b, 0x3f, 0xe6, 0x8f, 0x9f, 0x39, 0x41, 0x41, 0x36, 0x10,
+	0x68, 0xa5, 0xce, 0x1b, 0x77, 0x1d, 0x63, 0xad, 0xce, 0x1b, 0xbe, 0x18, 0xad, 0x61, 0x55, 0x40,
+	0x7f, 0x86, 0x61, 0xb2, 0x06, 0xe7, 0x62, 0x20, 0x61, 0x0e, 0x12, 0xe9, 0x92, 0x8d, 0x84, 0xb9,
+	0xe4, 0x55, 0x3b, 0xd6, 0x4b, 0xd0, 0x53, 0x49, 0xe4, 0x65, 0x43, 0xc2, 0x9f, 0x0d, 0x4f, 0x47,
+	0x5a, 0x8f, 0x9b, 0xd9, 0xb4, 0xca, 0xe4, 0x05, 0x49, 0x51, 0xf0, 0xfd, 0x2a, 0xe4, 0xc6, 0x21,
+	0xae, 0x4b, 0x0d, 0xac, 0x18, 0x6e, 0x2a, 0x90, 0x11, 0x4a, 0x43, 0xcc, 0x54, 0x9a, 0x92, 0xe2,
+	0xb4, 0x4e, 0x64, 0x60, 0x9d, 0x8b, 0xa6, 0xcc, 0xa9, 0x0a, 0xd6, 0x25, 0xc1, 0xbd, 0x01, 0x52,
+	0x4d, 0x79, 0x9e, 0x08, 0x82, 0x3c, 0x25, 0xbb, 0xf3, 0x94, 0x0a, 0xf0, 0x44, 0xae, 0x0e, 0x70,
+	0xdb, 0x40, 0xe6, 0x9c, 0x57, 0xf7, 0xd9, 0xab, 0xee, 0x6e, 0x34, 0x7a, 0xea, 0x8e, 0xb4, 0xda,
+	0xbf, 0x51, 0xdf, 0xab, 0xce, 0x3a, 0x6d, 0xe5, 0xbd, 0x0e, 0x9e, 0x86, 0xe8, 0x8a, 0x24, 0x3a,
+	0xfb, 0x6a, 0x7d, 0x22, 0x06, 0x86, 0x78, 0x41, 0xe0, 0x0c, 0x95, 0xd3, 0x70, 0xcd, 0xda, 0x73,
+	0xa7, 0x2f, 0xe6, 0x05, 0x61, 0x51, 0x65, 0x6d, 0x51, 0x10, 0x72, 0xa2, 0x3b, 0xe4, 0x64, 0x5b,
+	0xae, 0xeb, 0xa2, 0xff, 0xe6, 0xd3, 0xc5, 0xaa, 0x80, 0x76, 0xf8, 0x9a, 0x6c, 0xb0, 0x4b, 0x84,
+	0x91, 0x56, 0xfb, 0xe8, 0xd2, 0xe2, 0xf5, 0xdd, 0xdb, 0x1f, 0x8a, 0xc1, 0x50, 0x80, 0x09, 0x34,
+	0x02, 0x43, 0x15, 0xb6, 0xb2, 0xb3, 0xcc, 0x2d, 0xcd, 0xed, 0x9b, 0x9b, 0x3f, 0x38, 0x47, 0xf7,
+	0xa1, 0x34, 0x0c, 0x12, 0x11, 0xe9, 0x99, 0xe8, 0xb7, 0x2e, 0x5e, 0xbb, 0x1c, 0x43, 0x93, 0x30,
+	0x4a, 0xa4, 0x81, 0xe6, 0x91, 0x7e, 0xe5, 0xe2, 0x95, 0x53, 0x31, 0x34, 0x0d, 0x69, 0xa2, 0x0c,
+	0xb6, 0xf4, 0xf4, 0x6b, 0xdf, 0xbd, 0xf4, 0xd8, 0xad, 0x8d, 0x8d, 0x8d, 0x0d, 0x0a, 0x4d, 0xc1,
+	0x18, 0x31, 0x09, 0xd5, 0x62, 0xf4, 0xad, 0x8f, 0x1e, 0x3d, 0xdf, 0x8f, 0xb6, 0x41, 0x2e, 0xa4,
+	0xf6, 0x75, 0x23, 0xf4, 0x87, 0x2f, 0x9c, 0xba, 0xf0, 0x03, 0xf1, 0x33, 0x0d, 0x13, 0x9e, 0x61,
+	0xa8, 0x8e, 0xa7, 0x9f, 0xfc, 0xf6, 0xbd, 0x33, 0x51, 0xb4, 0x15, 0xa6, 0x3c, 0x93, 0x0e, 0xf5,
+	0x2d, 0x7d, 0xe5, 0xf8, 0xc9, 0x77, 0x22, 0x68, 0x0b, 0x64, 0x3a, 0x46, 0xb4, 0x5c, 0xa6, 0x6f,
+	0xfc, 0xf8, 0xec, 0x57, 0x11, 0xc4, 0xf8, 0x43, 0xf6, 0x95, 0x54, 0xf4, 0xe5, 0x6f, 0xde, 0x5e,
+	0xbf, 0x49, 0xc2, 0x29, 0x40, 0xd6, 0xb3, 0x09, 0xd6, 0x1b, 0xf4, 0x53, 0x8f, 0x3f, 0x73, 0x22,
+	0xb4, 0x4c, 0xe0, 0xa9, 0xa6, 0x3f, 0xb8, 0x7c, 0xec, 0x4c, 0x02, 0x15, 0xa1, 0x10, 0x36, 0x08,
+	0xbf, 0x5f, 0xf4, 0xb9, 0x77, 0x3f, 0x7d, 0x3d, 0x82, 0x8a, 0x30, 0x19, 0xb6, 0xf4, 0x3d, 0x20,
+	0xf4, 0xc7, 0xe7, 0x7f, 0xba, 0xf9, 0x73, 0x07, 0xb6, 0x7d, 0x17, 0x3b, 0x7d, 0xf2, 0x93, 0xb3,
+	0x97, 0x62, 0x68, 0xbb, 0x9f, 0xa1, 0x0e, 0x97, 0x24, 0xfd, 0xe2, 0xe9, 0x37, 0x5f, 0x76, 0x5c,
+	0x05, 0x76, 0x26, 0x7c, 0x5f, 0xd0, 0xcf, 0x9d, 0x7b, 0xe2, 0x7d, 0x67, 0x87, 0xf3, 0x30, 0x1e,
+	0xe4, 0xd3, 0x4d, 0x40, 0x7a, 0xfd, 0xd8, 0xf7, 0x67, 0x13, 0xb9, 0xfe, 0xf5, 0xd3, 0xf9, 0xbe,
+	0xf2, 0xd5, 0x24, 0xc4, 0xd9, 0x85, 0xca, 0x7e, 0x5d, 0x44, 0xbb, 0x60, 0x53, 0xb8, 0xcb, 0xce,
+	0x38, 0x87, 0x35, 0xbc, 0x27, 0x39, 0xef, 0x57, 0x91, 0x25, 0x3b, 0x5c, 0x9d, 0xe9, 0x43, 0x7b,
+	0x21, 0xdd, 0xb1, 0x77, 0xdd, 0xdc, 0xe6, 0xc4, 0xa7, 0xed, 0xe8, 0xa9, 0x02, 0xa3, 0x9d, 0xda,
+	0xc2, 0x49, 0xbf, 0xa3, 0x90, 0x32, 0x37, 0xd4, 0xf2, 0xb3, 0x47, 0x55, 0x1b, 0x4c, 0x1f, 0x7a,
+	0x00, 0x32, 0xdd, 0x1a, 0xaa, 0x2d, 0x7e, 0x47, 0x1d, 0x0c, 0x72, 0x4c, 0xcb, 0x99, 0x53, 0xc6,
+	0xea, 0xdc, 0xee, 0x43, 0x87, 0x70, 0xcd, 0x68, 0x6d, 0x89, 0x15, 0xe6, 0xbf, 0x61, 0xa4, 0xbd,
+	0x93, 0x99, 0xe8, 0x42, 0xd9, 0x72, 0x39, 0x37, 0x1a, 0x82, 0x3a, 0x2b, 0xe9, 0x06, 0xd3, 0xe7,
+	0xf2, 0xee, 0x6f, 0x12, 0x02, 0xbc, 0xfb, 0x14, 0x1d, 0xd9, 0x5a, 0x06, 0xd4, 0xa1, 0xba, 0xce,
+	0xf9, 0x5d, 0x04, 0x75, 0x77, 0x08, 0xef, 0x00, 0x8c, 0x78, 0x73, 0xdd, 0x4a, 0x64, 0xa2, 0xdd,
+	0xad, 0xa3, 0xca, 0x4d, 0x77, 0xf7, 0xea, 0x98, 0x30, 0x7d, 0x68, 0x15, 0x72, 0xbf, 0x52, 0x17,
+	0x32, 0xed, 0xde, 0xc3, 0x36, 0xb9, 0x6d, 0xdd, 0x97, 0xf9, 0x8f, 0xf5, 0xfb, 0x9e, 0x0f, 0xc1,
+	0x7f, 0x61, 0xac, 0x73, 0x6d, 0x37, 0xd5, 0xbe, 0x8e, 0x4f, 0xdd, 0x9e, 0x4b, 0x0b, 0xb0, 0xc9,
+	0x4d, 0x15, 0xd7, 0x45, 0x26, 0x9c, 0x43, 0xee, 0xe4, 0x3b, 0x23, 0x77, 0x0e, 0x32, 0x5d, 0x0e,
+	0x7b, 0x30, 0x3b, 0x3b, 0x18, 0x74, 0x4c, 0x82, 0xff, 0x41, 0xba, 0xd3, 0x85, 0x10, 0x3c, 0x7c,
+	0x61, 0xed, 0x9d, 0x6d, 0xd9, 0x2e, 0xa0, 0xdb, 0x9e, 0xf6, 0x6c, 0x38, 0xcb, 0x5d, 0x4d, 0x7b,
+	0x70, 0x7b, 0xe6, 0xbf, 0xbe, 0x96, 0xa7, 0x2e, 0x5c, 0xcf, 0x53, 0x97, 0xae, 0xe7, 0xa9, 0xab,
+	0xd7, 0xf3, 0xd4, 0xff, 0xff, 0xe1, 0xfb, 0xa7, 0x80, 0x81, 0x79, 0x59, 0xd4, 0x78, 0xef, 0x63,
+	0x87, 0x8e, 0xb5, 0xc3, 0x58, 0x9b, 0xe1, 0x9b, 0xcd, 0x19, 0x2b, 0x2a, 0xac, 0x88, 0x58, 0x9b,
+	0x91, 0x75, 0xd1, 0xfd, 0x5b, 0x89, 0xdb, 0xfe, 0x77, 0xfe, 0x32, 0x00, 0xea, 0x0e, 0x3c, 0x3a,
+	0x6f, 0x18, 0x00, 0x00,
 }
 
 func (this *ContentMessage) GoString() string {
@@ -2159,6 +2466,65 @@ func (this *TLMsgUnpinAllMessages) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TLMsgSendReaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&msg.TLMsgSendReaction{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Big: "+fmt.Sprintf("%#v", this.Big)+",\n")
+	s = append(s, "AddToRecent: "+fmt.Sprintf("%#v", this.AddToRecent)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
+	if this.Reaction != nil {
+		s = append(s, "Reaction: "+fmt.Sprintf("%#v", this.Reaction)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgSendScheduledMessages) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgSendScheduledMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgDeleteScheduledMessages) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgDeleteScheduledMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMsgTl(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2193,6 +2559,10 @@ type RPCMsgClient interface {
 	MsgReadHistory(ctx context.Context, in *TLMsgReadHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error)
 	MsgUpdatePinnedMessage(ctx context.Context, in *TLMsgUpdatePinnedMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
 	MsgUnpinAllMessages(ctx context.Context, in *TLMsgUnpinAllMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error)
+	// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.0?true add_to_recent:flags.1?true peer_type:int peer_id:long msg_id:int reaction:Vector<Reaction> = Updates;
+	MsgSendReaction(ctx context.Context, in *TLMsgSendReaction, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	MsgSendScheduledMessages(ctx context.Context, in *TLMsgSendScheduledMessages, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	MsgDeleteScheduledMessages(ctx context.Context, in *TLMsgDeleteScheduledMessages, opts ...grpc.CallOption) (*mtproto.Updates, error)
 }
 
 type rPCMsgClient struct {
@@ -2320,12 +2690,39 @@ func (c *rPCMsgClient) MsgUnpinAllMessages(ctx context.Context, in *TLMsgUnpinAl
 	return out, nil
 }
 
-// RPCMsgServer is the server API for RPCMsg service.
-type RPCMsgServer interface {
-	MsgSendMessage(context.Context, *TLMsgSendMessage) (*mtproto.Updates, error)
-	MsgSendMultiMessage(context.Context, *TLMsgSendMultiMessage) (*mtproto.Updates, error)
-	MsgPushUserMessage(context.Context, *TLMsgPushUserMessage) (*mtproto.Bool, error)
-	MsgReadMessageContents(context.Context, *TLMsgReadMessageContents) (*mtproto.Messages_AffectedMessages, error)
+func (c *rPCMsgClient) MsgSendReaction(ctx context.Context, in *TLMsgSendReaction, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCMsgClient) MsgSendScheduledMessages(ctx context.Context, in *TLMsgSendScheduledMessages, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCMsgClient) MsgDeleteScheduledMessages(ctx context.Context, in *TLMsgDeleteScheduledMessages, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deleteScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCMsgServer is the server API for RPCMsg service.
+type RPCMsgServer interface {
+	MsgSendMessage(context.Context, *TLMsgSendMessage) (*mtproto.Updates, error)
+	MsgSendMultiMessage(context.Context, *TLMsgSendMultiMessage) (*mtproto.Updates, error)
+	MsgPushUserMessage(context.Context, *TLMsgPushUserMessage) (*mtproto.Bool, error)
+	MsgReadMessageContents(context.Context, *TLMsgReadMessageContents) (*mtproto.Messages_AffectedMessages, error)
 	MsgSendMessageV2(context.Context, *TLMsgSendMessageV2) (*mtproto.UpdateList, error)
 	MsgEditMessage(context.Context, *TLMsgEditMessage) (*mtproto.Updates, error)
 	MsgDeleteMessages(context.Context, *TLMsgDeleteMessages) (*mtproto.Messages_AffectedMessages, error)
@@ -2335,6 +2732,10 @@ type RPCMsgServer interface {
 	MsgReadHistory(context.Context, *TLMsgReadHistory) (*mtproto.Messages_AffectedMessages, error)
 	MsgUpdatePinnedMessage(context.Context, *TLMsgUpdatePinnedMessage) (*mtproto.Updates, error)
 	MsgUnpinAllMessages(context.Context, *TLMsgUnpinAllMessages) (*mtproto.Messages_AffectedHistory, error)
+	// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.0?true add_to_recent:flags.1?true peer_type:int peer_id:long msg_id:int reaction:Vector<Reaction> = Updates;
+	MsgSendReaction(context.Context, *TLMsgSendReaction) (*mtproto.Updates, error)
+	MsgSendScheduledMessages(context.Context, *TLMsgSendScheduledMessages) (*mtproto.Updates, error)
+	MsgDeleteScheduledMessages(context.Context, *TLMsgDeleteScheduledMessages) (*mtproto.Updates, error)
 }
 
 // UnimplementedRPCMsgServer can be embedded to have forward compatible implementations.
@@ -2380,6 +2781,17 @@ func (*UnimplementedRPCMsgServer) MsgUpdatePinnedMessage(ctx context.Context, re
 func (*UnimplementedRPCMsgServer) MsgUnpinAllMessages(ctx context.Context, req *TLMsgUnpinAllMessages) (*mtproto.Messages_AffectedHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MsgUnpinAllMessages not implemented")
 }
+func (*UnimplementedRPCMsgServer) MsgSendReaction(ctx context.Context, req *TLMsgSendReaction) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSendReaction not implemented")
+}
+
+func (*UnimplementedRPCMsgServer) MsgSendScheduledMessages(ctx context.Context, req *TLMsgSendScheduledMessages) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSendScheduledMessages not implemented")
+}
+
+func (*UnimplementedRPCMsgServer) MsgDeleteScheduledMessages(ctx context.Context, req *TLMsgDeleteScheduledMessages) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleteScheduledMessages not implemented")
+}
 
 func RegisterRPCMsgServer(s *grpc.Server, srv RPCMsgServer) {
 	s.RegisterService(&_RPCMsg_serviceDesc, srv)
@@ -2619,6 +3031,60 @@ func _RPCMsg_MsgUnpinAllMessages_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCMsg_MsgSendReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgSendReaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgSendReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgSendReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgSendReaction(ctx, req.(*TLMsgSendReaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgSendScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgSendScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgSendScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgSendScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgSendScheduledMessages(ctx, req.(*TLMsgSendScheduledMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgDeleteScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgDeleteScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgDeleteScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgDeleteScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgDeleteScheduledMessages(ctx, req.(*TLMsgDeleteScheduledMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RPCMsg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "msg.RPCMsg",
 	HandlerType: (*RPCMsgServer)(nil),
@@ -2675,6 +3141,18 @@ var _RPCMsg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "msg_unpinAllMessages",
 			Handler:    _RPCMsg_MsgUnpinAllMessages_Handler,
 		},
+		{
+			MethodName: "msg_sendReaction",
+			Handler:    _RPCMsg_MsgSendReaction_Handler,
+		},
+		{
+			MethodName: "msg_sendScheduledMessages",
+			Handler:    _RPCMsg_MsgSendScheduledMessages_Handler,
+		},
+		{
+			MethodName: "msg_deleteScheduledMessages",
+			Handler:    _RPCMsg_MsgDeleteScheduledMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "msg.tl.proto",
@@ -3866,104 +4344,337 @@ func (m *TLMsgUnpinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgTl(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgTl(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TLMsgSendReaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ContentMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TLMsgSendReaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgSendReaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovMsgTl(uint64(l))
-	}
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Id != 0 {
-		n += 1 + sovMsgTl(uint64(m.Id))
+	if len(m.Reaction) > 0 {
+		for iNdEx := len(m.Reaction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reaction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.DialogMessageId != 0 {
-		n += 1 + sovMsgTl(uint64(m.DialogMessageId))
+	if m.MsgId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.MsgId))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Mentioned {
-		n += 2
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.MediaUnread {
-		n += 2
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Reaction {
-		n += 2
+	if m.AddToRecent {
+		i--
+		if m.AddToRecent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Big {
+		i--
+		if m.Big {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *TLContentMessage) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.Data2 != nil {
-		l = m.Data2.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OutboxMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgSendScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgSendScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgSendScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovMsgTl(uint64(l))
-	}
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NoWebpage {
-		n += 2
+	if len(m.Id) > 0 {
+		dAtA7 := make([]byte, len(m.Id)*10)
+		var j7 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA7[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA7[:j7])
+		i = encodeVarintMsgTl(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Background {
-		n += 2
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.RandomId != 0 {
-		n += 1 + sovMsgTl(uint64(m.RandomId))
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ScheduleDate != nil {
-		l = m.ScheduleDate.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLOutboxMessage) Size() (n int) {
+func (m *TLMsgDeleteScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgDeleteScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgDeleteScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA7 := make([]byte, len(m.Id)*10)
+		var j7 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA7[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA7[:j7])
+		i = encodeVarintMsgTl(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContentMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMsgTl(uint64(m.Id))
+	}
+	if m.DialogMessageId != 0 {
+		n += 1 + sovMsgTl(uint64(m.DialogMessageId))
+	}
+	if m.Mentioned {
+		n += 2
+	}
+	if m.MediaUnread {
+		n += 2
+	}
+	if m.Reaction {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLContentMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OutboxMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.NoWebpage {
+		n += 2
+	}
+	if m.Background {
+		n += 2
+	}
+	if m.RandomId != 0 {
+		n += 1 + sovMsgTl(uint64(m.RandomId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.ScheduleDate != nil {
+		l = m.ScheduleDate.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLOutboxMessage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4435,49 +5146,159 @@ func (m *TLMsgUnpinAllMessages) Size() (n int) {
 	return n
 }
 
-func sovMsgTl(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgTl(x uint64) (n int) {
-	return sovMsgTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ContentMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgTl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContentMessage: wiretype end group for non-group")
+func (m *TLMsgSendReaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.Big {
+		n += 2
+	}
+	if m.AddToRecent {
+		n += 2
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.MsgId != 0 {
+		n += 1 + sovMsgTl(uint64(m.MsgId))
+	}
+	if len(m.Reaction) > 0 {
+		for _, e := range m.Reaction {
+			l = e.Size()
+			n += 1 + l + sovMsgTl(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContentMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgSendScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovMsgTl(uint64(e))
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
+		n += 1 + sovMsgTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgDeleteScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovMsgTl(uint64(e))
+		}
+		n += 1 + sovMsgTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMsgTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgTl(x uint64) (n int) {
+	return sovMsgTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContentMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -7637,6 +8458,689 @@ func (m *TLMsgUnpinAllMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TLMsgSendReaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_msg_sendReaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_msg_sendReaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Big", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Big = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddToRecent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AddToRecent = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			m.MsgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reaction = append(m.Reaction, &mtproto.Reaction{})
+			if err := m.Reaction[len(m.Reaction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMsgSendScheduledMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_msg_sendScheduledMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_msg_sendScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMsgDeleteScheduledMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_msg_deleteScheduledMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_msg_deleteScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgTl(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,8 +6,20 @@
 
 package msg
 
+import (
+	"github.com/teamgram/proto/mtproto"
+
+	"google.golang.org/grpc/status"
+)
+
 const (
 	EditTypeNormal   = 0
 	EditTypePoll     = 1
 	EditTypeReaction = 2
 )
+
+var (
+	// ErrReactionInvalid
+	// | 400 | REACTION_INVALID | The specified reaction is invalid. |
+	ErrReactionInvalid = status.Error(mtproto.ErrBadRequest, "REACTION_INVALID")
+)
@@ -9,19 +9,19 @@ package sync_client
 import (
 	"context"
 	"fmt"
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeromicro/go-zero/core/jsonx"
 )
 
 type defaultSyncMqClient struct {
-	cli *kafka.Producer
+	cli mqx.Producer
 }
 
-func NewSyncMqClient(cli *kafka.Producer) SyncClient {
+func NewSyncMqClient(cli mqx.Producer) SyncClient {
 	return &defaultSyncMqClient{
 		cli: cli,
 	}
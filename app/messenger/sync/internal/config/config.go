@@ -20,6 +20,7 @@ package config
 
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -31,6 +32,9 @@ import (
 type Routine struct {
 	Size uint64
 	Chan uint64
+	// PushTimeout is how long (ms) a push of updates waits for a full queue before it is dropped,
+	// rpc results wait as long as their request does
+	PushTimeout int64 `json:",default=100"`
 }
 
 type Config struct {
@@ -39,10 +43,13 @@ type Config struct {
 	Cache         cache.CacheConf
 	KV            kv.KvConf
 	Routine       Routine
+	MessageBus    mqx.BusConf
 	SyncConsumer  kafka.KafkaConsumerConf
+	SyncRetry     mqx.RetryConf
 	SessionClient zrpc.RpcClientConf
 	IdgenClient   zrpc.RpcClientConf
 	StatusClient  zrpc.RpcClientConf
 	ChatClient    zrpc.RpcClientConf
 	PushClient    *kafka.KafkaProducerConf `json:",optional"`
+	BotApiClient  *zrpc.RpcClientConf      `json:",optional"`
 }
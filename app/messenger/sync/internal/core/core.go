@@ -166,11 +166,15 @@ func (c *SyncCore) processUpdates(syncType SyncType, userId int64, isBot bool, u
 	return needPush, nil
 }
 
+// pushUpdatesToSession pushes to every session of userId the syncType picks.
+// The updates are already in the pts queue, so a failed push is logged and dropped:
+// retrying the caller would queue them twice, the session catches up by getDifference.
 func (c *SyncCore) pushUpdatesToSession(syncType SyncType, userId, authKeyId, clientMsgId int64, pushData *mtproto.Updates, hasServerId string, notification bool) {
 	if syncType == syncTypeUserMe && hasServerId != "" {
-		logx.Infof("pushUpdatesToSession - pushData: {server_id: %d, auth_key_id: %d}", hasServerId, authKeyId)
+		logx.Infof("pushUpdatesToSession - pushData: {server_id: %s, auth_key_id: %d}", hasServerId, authKeyId)
+		var err error
 		if clientMsgId != 0 {
-			c.svcCtx.Dao.PushSessionUpdatesToSession(
+			err = c.svcCtx.Dao.PushSessionUpdatesToSession(
 				c.ctx,
 				hasServerId,
 				&session.TLSessionPushSessionUpdatesData{
@@ -179,7 +183,7 @@ func (c *SyncCore) pushUpdatesToSession(syncType SyncType, userId, authKeyId, cl
 					Updates:   pushData,
 				})
 		} else {
-			c.svcCtx.Dao.PushUpdatesToSession(
+			err = c.svcCtx.Dao.PushUpdatesToSession(
 				c.ctx,
 				hasServerId,
 				&session.TLSessionPushUpdatesData{
@@ -188,20 +192,31 @@ func (c *SyncCore) pushUpdatesToSession(syncType SyncType, userId, authKeyId, cl
 					Updates:      pushData,
 				})
 		}
+		if err != nil {
+			c.Logger.Errorf("pushUpdatesToSession - error: %v", err)
+		}
 	} else {
 		var (
 			pushExcludeList   = make([]int64, 0)
 			serverIdKeyIdList = make(map[string][]int64)
 		)
 
-		statusList, _ := c.svcCtx.Dao.StatusClient.StatusGetUserOnlineSessions(c.ctx, &status.TLStatusGetUserOnlineSessions{
+		statusList, err := c.svcCtx.Dao.StatusClient.StatusGetUserOnlineSessions(c.ctx, &status.TLStatusGetUserOnlineSessions{
 			UserId: userId,
 		})
+		if err != nil {
+			c.Logger.Errorf("pushUpdatesToSession - error: %v", err)
+			return
+		}
 		logx.Infof("statusList - #%v", statusList)
 		for _, sess := range statusList.GetUserSessions() {
 			if syncType == syncTypeUserNotMe && sess.AuthKeyId == authKeyId {
 				continue
 			}
+			// authKeyId may be the perm key of a device bound to a temp key
+			if syncType == syncTypeUserMe && sess.AuthKeyId != authKeyId && sess.PermAuthKeyId != authKeyId {
+				continue
+			}
 			pushExcludeList = append(pushExcludeList, sess.PermAuthKeyId)
 			if keyIdList, ok := serverIdKeyIdList[sess.Gateway]; ok {
 				keyIdList = append(keyIdList, sess.AuthKeyId)
@@ -215,7 +230,7 @@ func (c *SyncCore) pushUpdatesToSession(syncType SyncType, userId, authKeyId, cl
 		for serverId, keyIdList := range serverIdKeyIdList {
 			for _, keyId := range keyIdList {
 				// log.Debugf("serverIdKeyIdList - #%v", serverIdKeyIdList)
-				c.svcCtx.Dao.PushUpdatesToSession(
+				err = c.svcCtx.Dao.PushUpdatesToSession(
 					c.ctx,
 					serverId,
 					&session.TLSessionPushUpdatesData{
@@ -223,6 +238,9 @@ func (c *SyncCore) pushUpdatesToSession(syncType SyncType, userId, authKeyId, cl
 						Notification: notification,
 						Updates:      pushData,
 					})
+				if err != nil {
+					c.Logger.Errorf("pushUpdatesToSession - error: %v", err)
+				}
 			}
 		}
 
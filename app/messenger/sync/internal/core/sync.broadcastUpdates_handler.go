@@ -30,13 +30,20 @@ func (c *SyncCore) SyncBroadcastUpdates(in *sync.TLSyncBroadcastUpdates) (*mtpro
 		return mtproto.EmptyVoid, nil
 	}
 
-	idList, _ := c.svcCtx.Dao.ChatClient.ChatGetChatParticipantIdList(c.ctx, &chatpb.TLChatGetChatParticipantIdList{
+	idList, err := c.svcCtx.Dao.ChatClient.ChatGetChatParticipantIdList(c.ctx, &chatpb.TLChatGetChatParticipantIdList{
 		ChatId: in.ChatId,
 	})
+	if err != nil {
+		c.Logger.Errorf("sync.broadcastUpdates - error: %v", err)
+		return nil, err
+	}
 
+	// a retry would queue the updates again for the members already reached
 	for _, id := range idList.GetDatas() {
 		pushUpdates.UserId = id
-		c.SyncPushUpdates(pushUpdates)
+		if _, err = c.SyncPushUpdates(pushUpdates); err != nil {
+			c.Logger.Errorf("sync.broadcastUpdates - error: %v", err)
+		}
 	}
 
 	return mtproto.EmptyVoid, nil
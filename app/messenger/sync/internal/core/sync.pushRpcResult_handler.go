@@ -18,12 +18,16 @@ import (
 // SyncPushRpcResult
 // sync.pushRpcResult server_id:long auth_key_id:long req_msg_id:long result:bytes = PushUpdates;
 func (c *SyncCore) SyncPushRpcResult(in *sync.TLSyncPushRpcResult) (*mtproto.Void, error) {
-	c.svcCtx.Dao.PushRpcResultToSession(c.ctx, in.ServerId, &session.TLSessionPushRpcResultData{
+	err := c.svcCtx.Dao.PushRpcResultToSession(c.ctx, in.ServerId, &session.TLSessionPushRpcResultData{
 		AuthKeyId:      in.AuthKeyId,
 		SessionId:      in.SessionId,
 		ClientReqMsgId: in.ClientReqMsgId,
 		RpcResultData:  in.RpcResult,
 	})
+	if err != nil {
+		c.Logger.Errorf("sync.pushRpcResult - error: %v", err)
+		return nil, err
+	}
 
 	return mtproto.EmptyVoid, nil
 }
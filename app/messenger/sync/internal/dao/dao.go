@@ -10,7 +10,8 @@
 package dao
 
 import (
-	kafka "github.com/teamgram/marmota/pkg/mq"
+	"sync"
+
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
@@ -18,6 +19,7 @@ import (
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 	status_client "github.com/teamgram/teamgram-server/app/service/status/client"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -27,6 +29,8 @@ type Dao struct {
 	kv             kv.Store
 	conf           *config.Config
 	sessionServers map[string]*Session
+	// guards sessionServers, rebuilt by the watchers and read by the pushes
+	sessionServersMu sync.RWMutex
 	idgen_client.IDGenClient2
 	status_client.StatusClient
 	chat_client.ChatClient
@@ -45,9 +49,12 @@ func New(c config.Config) *Dao {
 		ChatClient:     chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
 	}
 	if c.PushClient != nil {
-		d.PushClient = sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.PushClient))
+		d.PushClient = sync_client.NewSyncMqClient(mqx.MustProducer(c.PushClient))
 	}
 
 	go d.watch(c.SessionClient)
+	if c.BotApiClient != nil {
+		go d.watch(*c.BotApiClient)
+	}
 	return d
 }
@@ -20,8 +20,8 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sync/atomic"
 	"time"
 
 	session_client "github.com/teamgram/teamgram-server/app/interface/session/client"
@@ -29,24 +29,47 @@ import (
 
 	"github.com/zeromicro/go-zero/core/discov"
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
+var (
+	ErrSessionPushTimeout = errors.New("push to session timeout")
+	ErrSessionClosed      = errors.New("session client closed")
+)
+
+var (
+	metricSessionPushFull = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "sync",
+		Subsystem: "session_push",
+		Name:      "full_total",
+		Help:      "pushes that found the queue of their auth key full.",
+		Labels:    []string{"server"},
+	})
+	metricSessionPushDropped = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "sync",
+		Subsystem: "session_push",
+		Name:      "dropped_total",
+		Help:      "pushes dropped after waiting PushTimeout for the queue of their auth key.",
+		Labels:    []string{"server"},
+	})
+)
+
 // SessionOptions comet options.
 type SessionOptions struct {
 	RoutineSize uint64
 	RoutineChan uint64
+	PushTimeout time.Duration
 }
 
 // Session is a gateway.
 type Session struct {
-	serverId       string
-	client         session_client.SessionClient
-	sessionChan    []chan interface{}
-	sessionChanNum uint64
-	options        SessionOptions
-	ctx            context.Context
-	cancel         context.CancelFunc
+	serverId    string
+	client      session_client.SessionClient
+	sessionChan []chan interface{}
+	options     SessionOptions
+	ctx         context.Context
+	cancel      context.CancelFunc
 }
 
 // process
@@ -64,17 +87,17 @@ func (c *Session) process(sessionChan chan interface{}) {
 			case *session.TLSessionPushSessionUpdatesData:
 				_, err = c.client.SessionPushSessionUpdatesData(context.Background(), r)
 				if err != nil {
-					logx.Errorf("c.client.PushSessionUpdates(%s, %v, reply) serverId:%d error(%v)", r, c.serverId, err)
+					logx.Errorf("c.client.PushSessionUpdates(%s, reply) serverId:%s error(%v)", r, c.serverId, err)
 				}
 			case *session.TLSessionPushUpdatesData:
 				_, err = c.client.SessionPushUpdatesData(context.Background(), r)
 				if err != nil {
-					logx.Errorf("c.client.PushUpdates(%s, %v, reply) serverId:%d error(%v)", r, c.serverId, err)
+					logx.Errorf("c.client.PushUpdates(%s, reply) serverId:%s error(%v)", r, c.serverId, err)
 				}
 			case *session.TLSessionPushRpcResultData:
 				_, err = c.client.SessionPushRpcResultData(context.Background(), r)
 				if err != nil {
-					logx.Errorf("c.client.PushRpcResult(%s, %v, reply) serverId:%d error(%v)", r, c.serverId, err)
+					logx.Errorf("c.client.PushRpcResult(%s, reply) serverId:%s error(%v)", r, c.serverId, err)
 				}
 			default:
 				logx.Errorf("invalid type: %#v", r)
@@ -111,48 +134,104 @@ func (c *Session) Close() (err error) {
 }
 
 func (c *Session) PushUpdates(ctx context.Context, msg *session.TLSessionPushUpdatesData) (err error) {
-	idx := atomic.AddUint64(&c.sessionChanNum, 1) % c.options.RoutineSize
-	c.sessionChan[idx] <- msg
-	return
+	return c.push(ctx, msg.AuthKeyId, msg)
 }
 
 func (c *Session) PushSessionUpdates(ctx context.Context, msg *session.TLSessionPushSessionUpdatesData) (err error) {
-	idx := atomic.AddUint64(&c.sessionChanNum, 1) % c.options.RoutineSize
-	c.sessionChan[idx] <- msg
-	return
+	return c.push(ctx, msg.AuthKeyId, msg)
 }
 
 func (c *Session) PushRpcResult(ctx context.Context, msg *session.TLSessionPushRpcResultData) (err error) {
-	idx := atomic.AddUint64(&c.sessionChanNum, 1) % c.options.RoutineSize
-	c.sessionChan[idx] <- msg
-	return
+	return c.pushWait(ctx, msg.AuthKeyId, msg)
 }
 
-// NewSession new a comet.
-func NewSession(c zrpc.RpcClientConf, options SessionOptions) (*Session, error) {
-	sess := &Session{
-		serverId:    c.Endpoints[0],
-		sessionChan: make([]chan interface{}, options.RoutineSize),
-		options:     options,
+// push queues msg on the routine of authKeyId, so the pushes of an auth key reach
+// the session in order. A full queue is waited for at most PushTimeout, then msg
+// is dropped instead of blocking the consumer, the client catches up with getDifference.
+func (c *Session) push(ctx context.Context, authKeyId int64, msg interface{}) error {
+	ch := c.sessionChan[uint64(authKeyId)%c.options.RoutineSize]
+
+	select {
+	case ch <- msg:
+		return nil
+	default:
+	}
+
+	metricSessionPushFull.Inc(c.serverId)
+
+	timer := time.NewTimer(c.options.PushTimeout)
+	defer timer.Stop()
+
+	select {
+	case ch <- msg:
+		return nil
+	case <-timer.C:
+	case <-ctx.Done():
+	case <-c.ctx.Done():
+	}
+
+	metricSessionPushDropped.Inc(c.serverId)
+	logx.WithContext(ctx).Errorf("push to session(%s) dropped, authKeyId: %d, queue: %d", c.serverId, authKeyId, len(ch))
+
+	return ErrSessionPushTimeout
+}
+
+// pushWait queues msg like push but never drops it, there is no getDifference for
+// a rpc result. It waits for a full queue until ctx or the session is done.
+func (c *Session) pushWait(ctx context.Context, authKeyId int64, msg interface{}) error {
+	ch := c.sessionChan[uint64(authKeyId)%c.options.RoutineSize]
+
+	select {
+	case ch <- msg:
+		return nil
+	default:
+	}
+
+	metricSessionPushFull.Inc(c.serverId)
+
+	select {
+	case ch <- msg:
+		return nil
+	case <-ctx.Done():
+		logx.WithContext(ctx).Errorf("push to session(%s) canceled, authKeyId: %d, queue: %d", c.serverId, authKeyId, len(ch))
+		return ctx.Err()
+	case <-c.ctx.Done():
+		return ErrSessionClosed
 	}
+}
 
+// NewSession new a comet.
+func NewSession(c zrpc.RpcClientConf, options SessionOptions) (*Session, error) {
 	cli, err := zrpc.NewClient(c)
 	if err != nil {
 		logx.Errorf("watchComet NewClient(%+v) error(%v)", c, err)
 		return nil, err
 	}
-	sess.client = session_client.NewSessionClient(cli)
+
+	return newSession(c.Endpoints[0], session_client.NewSessionClient(cli), options), nil
+}
+
+func newSession(serverId string, client session_client.SessionClient, options SessionOptions) *Session {
+	sess := &Session{
+		serverId:    serverId,
+		client:      client,
+		sessionChan: make([]chan interface{}, options.RoutineSize),
+		options:     options,
+	}
 	sess.ctx, sess.cancel = context.WithCancel(context.Background())
 
 	for i := uint64(0); i < options.RoutineSize; i++ {
 		sess.sessionChan[i] = make(chan interface{}, options.RoutineChan)
 		go sess.process(sess.sessionChan[i])
 	}
-	return sess, nil
+	return sess
 }
 
+// watch keeps the servers registered under c.Etcd.Key in d.sessionServers, several
+// keys (session, botapi) can be watched, each one only adds and removes its own servers.
 func (d *Dao) watch(c zrpc.RpcClientConf) {
 	sub, _ := discov.NewSubscriber(c.Etcd.Hosts, c.Etcd.Key)
+	owned := map[string]*Session{}
 	update := func() {
 		values := sub.Values()
 		if len(values) == 0 {
@@ -161,7 +240,7 @@ func (d *Dao) watch(c zrpc.RpcClientConf) {
 
 		sessions := map[string]*Session{}
 		for _, v := range values {
-			if old, ok := d.sessionServers[v]; ok {
+			if old, ok := owned[v]; ok {
 				sessions[v] = old
 				continue
 			}
@@ -170,57 +249,75 @@ func (d *Dao) watch(c zrpc.RpcClientConf) {
 			cli, err := NewSession(c, SessionOptions{
 				RoutineSize: d.conf.Routine.Size,
 				RoutineChan: d.conf.Routine.Chan,
+				PushTimeout: time.Duration(d.conf.Routine.PushTimeout) * time.Millisecond,
 			})
 			if err != nil {
-				logx.Error("watchComet NewClient(%+v) error(%v)", values, err)
+				logx.Errorf("watchComet NewClient(%+v) error(%v)", values, err)
 				return
 			}
 			sessions[v] = cli
 		}
 
-		for key, old := range d.sessionServers {
+		d.sessionServersMu.Lock()
+		servers := make(map[string]*Session, len(d.sessionServers))
+		for key, s := range d.sessionServers {
+			if _, ok := owned[key]; !ok {
+				servers[key] = s
+			}
+		}
+		for key, s := range sessions {
+			servers[key] = s
+		}
+		d.sessionServers = servers
+		d.sessionServersMu.Unlock()
+
+		for key, old := range owned {
 			if _, ok := sessions[key]; !ok {
 				old.cancel()
 				logx.Infof("watchComet DelComet:%s", key)
 			}
 		}
-
-		d.sessionServers = sessions
+		owned = sessions
 	}
 
 	sub.AddListener(update)
 	update()
 }
 
+func (d *Dao) getSessionServer(serverId string) (*Session, bool) {
+	d.sessionServersMu.RLock()
+	defer d.sessionServersMu.RUnlock()
+
+	c, ok := d.sessionServers[serverId]
+	if !ok {
+		logx.Errorf("not found k: %s, %v", serverId, d.sessionServers)
+	}
+	return c, ok
+}
+
 func (d *Dao) PushUpdatesToSession(ctx context.Context, serverId string, msg *session.TLSessionPushUpdatesData) (err error) {
-	if c, ok := d.sessionServers[serverId]; ok {
+	if c, ok := d.getSessionServer(serverId); ok {
 		// log.Info("push updates to serverId: (%s, %s)", serverId, msg.DebugString())
 		return c.PushUpdates(ctx, msg)
 	} else {
-		logx.Errorf("not found k: %s, %v", serverId, d.sessionServers)
 		return fmt.Errorf("not found k: %s", serverId)
 	}
-	return
 }
 
 func (d *Dao) PushSessionUpdatesToSession(ctx context.Context, serverId string, msg *session.TLSessionPushSessionUpdatesData) (err error) {
-	if c, ok := d.sessionServers[serverId]; ok {
+	if c, ok := d.getSessionServer(serverId); ok {
 		// logx.Info("push session updates to serverId: (%s, %s)", serverId, msg.DebugString())
 		return c.PushSessionUpdates(ctx, msg)
 	} else {
-		logx.Errorf("not found k: %s, %v", serverId, d.sessionServers)
 		return fmt.Errorf("not found k: %s", serverId)
 	}
-	return
 }
 
 func (d *Dao) PushRpcResultToSession(ctx context.Context, serverId string, msg *session.TLSessionPushRpcResultData) (err error) {
-	if c, ok := d.sessionServers[serverId]; ok {
+	if c, ok := d.getSessionServer(serverId); ok {
 		// log.Debugf("push rpc result to serverId: (%s, %s)", serverId, msg.DebugString())
 		return c.PushRpcResult(ctx, msg)
 	} else {
-		logx.Errorf("not found k: %s, %v", serverId, d.sessionServers)
 		return fmt.Errorf("not found k: %s", serverId)
 	}
-	return
 }
@@ -0,0 +1,194 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"math/rand"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	session_client "github.com/teamgram/teamgram-server/app/interface/session/client"
+	"github.com/teamgram/teamgram-server/app/interface/session/session"
+)
+
+// testSessionClient records the rpc results it gets per auth key,
+// gate (if set) holds every push until it is closed.
+type testSessionClient struct {
+	session_client.SessionClient
+
+	gate chan struct{}
+
+	mu      gosync.Mutex
+	results map[int64][]int64
+}
+
+func (c *testSessionClient) SessionPushRpcResultData(ctx context.Context, in *session.TLSessionPushRpcResultData) (*mtproto.Bool, error) {
+	if c.gate != nil {
+		<-c.gate
+	} else {
+		time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+	}
+
+	c.mu.Lock()
+	c.results[in.AuthKeyId] = append(c.results[in.AuthKeyId], in.ClientReqMsgId)
+	c.mu.Unlock()
+
+	return mtproto.BoolTrue, nil
+}
+
+func (c *testSessionClient) SessionPushUpdatesData(ctx context.Context, in *session.TLSessionPushUpdatesData) (*mtproto.Bool, error) {
+	if c.gate != nil {
+		<-c.gate
+	}
+	return mtproto.BoolTrue, nil
+}
+
+func (c *testSessionClient) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := 0
+	for _, v := range c.results {
+		n += len(v)
+	}
+	return n
+}
+
+func makeTestRpcResult(authKeyId, reqMsgId int64) *session.TLSessionPushRpcResultData {
+	return &session.TLSessionPushRpcResultData{
+		AuthKeyId:      authKeyId,
+		ClientReqMsgId: reqMsgId,
+	}
+}
+
+func TestSessionPushOrder(t *testing.T) {
+	const (
+		authKeys = 16
+		results  = 200
+	)
+
+	var (
+		cli  = &testSessionClient{results: map[int64][]int64{}}
+		sess = newSession("test", cli, SessionOptions{
+			RoutineSize: 4,
+			RoutineChan: 2,
+			PushTimeout: time.Millisecond,
+		})
+		wg gosync.WaitGroup
+	)
+	defer sess.cancel()
+
+	// the queues are tiny, every auth key keeps finding its queue full
+	for i := int64(1); i <= authKeys; i++ {
+		wg.Add(1)
+		go func(authKeyId int64) {
+			defer wg.Done()
+			for reqMsgId := int64(1); reqMsgId <= results; reqMsgId++ {
+				if err := sess.PushRpcResult(context.Background(), makeTestRpcResult(authKeyId, reqMsgId)); err != nil {
+					t.Errorf("PushRpcResult(%d, %d) - error: %v", authKeyId, reqMsgId, err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for deadline := time.Now().Add(5 * time.Second); cli.count() < authKeys*results; {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d rpc results, want %d", cli.count(), authKeys*results)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	for authKeyId, reqMsgIdList := range cli.results {
+		for i, reqMsgId := range reqMsgIdList {
+			if reqMsgId != int64(i+1) {
+				t.Fatalf("auth key %d: rpc result #%d is %d, out of order", authKeyId, i+1, reqMsgId)
+			}
+		}
+	}
+}
+
+func TestSessionPushQueueFull(t *testing.T) {
+	var (
+		cli = &testSessionClient{
+			gate:    make(chan struct{}),
+			results: map[int64][]int64{},
+		}
+		sess = newSession("test", cli, SessionOptions{
+			RoutineSize: 1,
+			RoutineChan: 1,
+			PushTimeout: 10 * time.Millisecond,
+		})
+		ctx = context.Background()
+	)
+	defer sess.cancel()
+
+	// the first one is held in the client, the second one fills the queue
+	for reqMsgId := int64(1); reqMsgId <= 2; reqMsgId++ {
+		if err := sess.PushRpcResult(ctx, makeTestRpcResult(1, reqMsgId)); err != nil {
+			t.Fatalf("PushRpcResult(%d) - error: %v", reqMsgId, err)
+		}
+	}
+	for len(sess.sessionChan[0]) != 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// updates are dropped after PushTimeout, getDifference catches up on them
+	if err := sess.PushUpdates(ctx, &session.TLSessionPushUpdatesData{AuthKeyId: 1}); err != ErrSessionPushTimeout {
+		t.Fatalf("PushUpdates on a full queue - got error %v, want %v", err, ErrSessionPushTimeout)
+	}
+
+	// a canceled request gives up its rpc result
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := sess.PushRpcResult(canceled, makeTestRpcResult(1, 100)); err != context.Canceled {
+		t.Fatalf("PushRpcResult canceled - got error %v, want %v", err, context.Canceled)
+	}
+
+	// rpc results wait for the queue as long as it takes
+	done := make(chan error, 1)
+	go func() {
+		done <- sess.PushRpcResult(ctx, makeTestRpcResult(1, 3))
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("PushRpcResult on a full queue returned %v, want it to wait", err)
+	case <-time.After(10 * sess.options.PushTimeout):
+	}
+
+	close(cli.gate)
+	if err := <-done; err != nil {
+		t.Fatalf("PushRpcResult - error: %v", err)
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); cli.count() < 3; {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d rpc results, want 3", cli.count())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if got := cli.results[1]; len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("got rpc results %v, want [1 2 3]", got)
+	}
+}
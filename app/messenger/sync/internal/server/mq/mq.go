@@ -16,17 +16,18 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/sync/internal/core"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/internal/svc"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 // New new a grpc server.
-func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf) *kafka.ConsumerGroup {
-	s := kafka.MustKafkaConsumer(&conf)
+func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf, retry mqx.RetryConf) mqx.Consumer {
+	s := mqx.MustConsumer(&conf)
 	s.RegisterHandlers(
 		conf.Topics[0],
-		func(ctx context.Context, key string, value []byte) {
+		mqx.WithRetry(retry, conf.Topics[0], conf.Group, mqx.MustDeadLetterProducer(retry), func(ctx context.Context, key string, value []byte) error {
 			logx.WithContext(ctx).Debugf("key: %s, value: %s", key, value)
 
 			switch strings.Split(key, "#")[0] {
@@ -36,59 +37,65 @@ func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf) *kafka.Consum
 				r := new(sync.TLSyncUpdatesMe)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Error(err.Error())
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("sync.updatesMe - request: %s", r.DebugString())
 
-				c.SyncUpdatesMe(r)
+				_, err := c.SyncUpdatesMe(r)
+				return err
 			case proto.MessageName((*sync.TLSyncUpdatesNotMe)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(sync.TLSyncUpdatesNotMe)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Error(err.Error())
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("sync.updatesNotMe - request: %s", r.DebugString())
 
-				c.SyncUpdatesNotMe(r)
+				_, err := c.SyncUpdatesNotMe(r)
+				return err
 			case proto.MessageName((*sync.TLSyncPushUpdates)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(sync.TLSyncPushUpdates)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Error(err.Error())
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("sync.pushUpdates - request: %s", r.DebugString())
 
-				c.SyncPushUpdates(r)
+				_, err := c.SyncPushUpdates(r)
+				return err
 			case proto.MessageName((*sync.TLSyncPushRpcResult)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(sync.TLSyncPushRpcResult)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Error(err.Error())
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("sync.pushRpcResult - request: %s", r.DebugString())
 
-				c.SyncPushRpcResult(r)
+				_, err := c.SyncPushRpcResult(r)
+				return err
 			case proto.MessageName((*sync.TLSyncBroadcastUpdates)(nil)):
 				c := core.New(ctx, svcCtx)
 
 				r := new(sync.TLSyncBroadcastUpdates)
 				if err := json.Unmarshal(value, r); err != nil {
 					c.Logger.Error(err.Error())
-					return
+					return mqx.Permanent(err)
 				}
 				c.Logger.Debugf("sync.broadcastUpdates - request: %s", r.DebugString())
 
-				c.SyncBroadcastUpdates(r)
+				_, err := c.SyncBroadcastUpdates(r)
+				return err
 			default:
 				err := fmt.Errorf("invalid key: %s", key)
 				logx.Error(err.Error())
+				return mqx.Permanent(err)
 			}
-		})
+		}))
 	return s
 }
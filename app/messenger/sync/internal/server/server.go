@@ -12,10 +12,10 @@ package server
 import (
 	"flag"
 
-	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/internal/config"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/internal/server/mq"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/internal/svc"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -25,7 +25,7 @@ var configFile = flag.String("f", "etc/sync.yaml", "the config file")
 
 type Server struct {
 	// grpcSrv *zrpc.RpcServer
-	mq *kafka.ConsumerGroup
+	mq mqx.Consumer
 }
 
 func New() *Server {
@@ -40,10 +40,11 @@ func (s *Server) Initialize() error {
 	if err := logx.SetUp(c.Log); err != nil {
 		return err
 	}
+	mqx.MustSetUp(c.MessageBus)
 
 	ctx := svc.NewServiceContext(c)
 	// s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
-	s.mq = mq.New(ctx, c.SyncConsumer)
+	s.mq = mq.New(ctx, c.SyncConsumer, c.SyncRetry)
 
 	// go s.grpcSrv.Start()
 	go s.mq.Start()
@@ -35,8 +35,8 @@ type TLConstructor int32
 
 const (
 	CRC32_UNKNOWN                          TLConstructor = 0
-	CRC32_clientSession                    TLConstructor = -1701940816
 	CRC32_authKeyStateData                 TLConstructor = -646863312
+	CRC32_clientSession                    TLConstructor = -1701940816
 	CRC32_authsession_getAuthorizations    TLConstructor = 820122180
 	CRC32_authsession_resetAuthorization   TLConstructor = -1923126106
 	CRC32_authsession_getLayer             TLConstructor = -1473309015
@@ -51,16 +51,23 @@ const (
 	CRC32_authsession_bindAuthKeyUser      TLConstructor = 198050851
 	CRC32_authsession_unbindAuthKeyUser    TLConstructor = 123258440
 	CRC32_authsession_getPermAuthKeyId     TLConstructor = -1871420202
-	CRC32_authsession_bindTempAuthKey      TLConstructor = 1620004742
+	CRC32_authsession_bindTempAuthKey      TLConstructor = 2130778196
 	CRC32_authsession_setClientSessionInfo TLConstructor = 47841172
 	CRC32_authsession_getAuthorization     TLConstructor = 1851660579
 	CRC32_authsession_getAuthStateData     TLConstructor = 1331573041
+	CRC32_authsession_dropTempAuthKeys     TLConstructor = 82990883
+	CRC32_authsession_getAuthKeyExpiresAt  TLConstructor = -1471638396
+	CRC32_pushDevice                       TLConstructor = -742057663
+	CRC32_authsession_registerDevice       TLConstructor = -954003608
+	CRC32_authsession_unregisterDevice     TLConstructor = 468246175
+	CRC32_authsession_updateDeviceLocked   TLConstructor = -172649891
+	CRC32_authsession_getUserPushDevices   TLConstructor = 1125895727
 )
 
 var TLConstructor_name = map[int32]string{
 	0:           "CRC32_UNKNOWN",
-	-1701940816: "CRC32_clientSession",
 	-646863312:  "CRC32_authKeyStateData",
+	-1701940816: "CRC32_clientSession",
 	820122180:   "CRC32_authsession_getAuthorizations",
 	-1923126106: "CRC32_authsession_resetAuthorization",
 	-1473309015: "CRC32_authsession_getLayer",
@@ -75,16 +82,23 @@ var TLConstructor_name = map[int32]string{
 	198050851:   "CRC32_authsession_bindAuthKeyUser",
 	123258440:   "CRC32_authsession_unbindAuthKeyUser",
 	-1871420202: "CRC32_authsession_getPermAuthKeyId",
-	1620004742:  "CRC32_authsession_bindTempAuthKey",
+	2130778196:  "CRC32_authsession_bindTempAuthKey",
 	47841172:    "CRC32_authsession_setClientSessionInfo",
 	1851660579:  "CRC32_authsession_getAuthorization",
 	1331573041:  "CRC32_authsession_getAuthStateData",
+	82990883:    "CRC32_authsession_dropTempAuthKeys",
+	-1471638396: "CRC32_authsession_getAuthKeyExpiresAt",
+	-742057663:  "CRC32_pushDevice",
+	-954003608:  "CRC32_authsession_registerDevice",
+	468246175:   "CRC32_authsession_unregisterDevice",
+	-172649891:  "CRC32_authsession_updateDeviceLocked",
+	1125895727:  "CRC32_authsession_getUserPushDevices",
 }
 
 var TLConstructor_value = map[string]int32{
 	"CRC32_UNKNOWN":                          0,
-	"CRC32_clientSession":                    -1701940816,
 	"CRC32_authKeyStateData":                 -646863312,
+	"CRC32_clientSession":                    -1701940816,
 	"CRC32_authsession_getAuthorizations":    820122180,
 	"CRC32_authsession_resetAuthorization":   -1923126106,
 	"CRC32_authsession_getLayer":             -1473309015,
@@ -99,10 +113,17 @@ var TLConstructor_value = map[string]int32{
 	"CRC32_authsession_bindAuthKeyUser":      198050851,
 	"CRC32_authsession_unbindAuthKeyUser":    123258440,
 	"CRC32_authsession_getPermAuthKeyId":     -1871420202,
-	"CRC32_authsession_bindTempAuthKey":      1620004742,
+	"CRC32_authsession_bindTempAuthKey":      2130778196,
 	"CRC32_authsession_setClientSessionInfo": 47841172,
 	"CRC32_authsession_getAuthorization":     1851660579,
 	"CRC32_authsession_getAuthStateData":     1331573041,
+	"CRC32_authsession_dropTempAuthKeys":     82990883,
+	"CRC32_authsession_getAuthKeyExpiresAt":  -1471638396,
+	"CRC32_pushDevice":                       -742057663,
+	"CRC32_authsession_registerDevice":       -954003608,
+	"CRC32_authsession_unregisterDevice":     468246175,
+	"CRC32_authsession_updateDeviceLocked":   -172649891,
+	"CRC32_authsession_getUserPushDevices":   1125895727,
 }
 
 func (x TLConstructor) String() string {
@@ -113,6 +134,163 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7cbc1347c4a76ecf, []int{0}
 }
 
+//--------------------------------------------------------------------------------------------
+// authKeyStateData auth_key_id:long user_id:long key_state:int layer:int client_type:int android_push_session_id:long = AuthKeyStateData;
+//
+// AuthKeyStateData <--
+//  + TL_authKeyStateData
+//
+type AuthKeyStateData struct {
+	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KeyState             int32         `protobuf:"varint,5,opt,name=key_state,json=keyState,proto3" json:"key_state,omitempty"`
+	Layer                int32         `protobuf:"varint,6,opt,name=layer,proto3" json:"layer,omitempty"`
+	ClientType           int32         `protobuf:"varint,7,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	AndroidPushSessionId int64         `protobuf:"varint,8,opt,name=android_push_session_id,json=androidPushSessionId,proto3" json:"android_push_session_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuthKeyStateData) Reset()         { *m = AuthKeyStateData{} }
+func (m *AuthKeyStateData) String() string { return proto.CompactTextString(m) }
+func (*AuthKeyStateData) ProtoMessage()    {}
+func (*AuthKeyStateData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{0}
+}
+func (m *AuthKeyStateData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthKeyStateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthKeyStateData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthKeyStateData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthKeyStateData.Merge(m, src)
+}
+func (m *AuthKeyStateData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthKeyStateData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthKeyStateData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthKeyStateData proto.InternalMessageInfo
+
+func (m *AuthKeyStateData) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *AuthKeyStateData) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *AuthKeyStateData) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *AuthKeyStateData) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *AuthKeyStateData) GetKeyState() int32 {
+	if m != nil {
+		return m.KeyState
+	}
+	return 0
+}
+
+func (m *AuthKeyStateData) GetLayer() int32 {
+	if m != nil {
+		return m.Layer
+	}
+	return 0
+}
+
+func (m *AuthKeyStateData) GetClientType() int32 {
+	if m != nil {
+		return m.ClientType
+	}
+	return 0
+}
+
+func (m *AuthKeyStateData) GetAndroidPushSessionId() int64 {
+	if m != nil {
+		return m.AndroidPushSessionId
+	}
+	return 0
+}
+
+// authKeyStateData auth_key_id:long user_id:long key_state:int layer:int client_type:int android_push_session_id:long = AuthKeyStateData;
+type TLAuthKeyStateData struct {
+	Data2                *AuthKeyStateData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TLAuthKeyStateData) Reset()         { *m = TLAuthKeyStateData{} }
+func (m *TLAuthKeyStateData) String() string { return proto.CompactTextString(m) }
+func (*TLAuthKeyStateData) ProtoMessage()    {}
+func (*TLAuthKeyStateData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{1}
+}
+func (m *TLAuthKeyStateData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLAuthKeyStateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLAuthKeyStateData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLAuthKeyStateData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLAuthKeyStateData.Merge(m, src)
+}
+func (m *TLAuthKeyStateData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLAuthKeyStateData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLAuthKeyStateData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLAuthKeyStateData proto.InternalMessageInfo
+
+func (m *TLAuthKeyStateData) GetData2() *AuthKeyStateData {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
 //--------------------------------------------------------------------------------------------
 // clientSession auth_key_id:long ip:string layer:int api_id:int device_model:string system_version:string app_version:string system_lang_code:string lang_pack:string lang_code:string proxy:string params:string = ClientSession;
 //
@@ -143,7 +321,7 @@ func (m *ClientSession) Reset()         { *m = ClientSession{} }
 func (m *ClientSession) String() string { return proto.CompactTextString(m) }
 func (*ClientSession) ProtoMessage()    {}
 func (*ClientSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{0}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{2}
 }
 func (m *ClientSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +460,7 @@ func (m *TLClientSession) Reset()         { *m = TLClientSession{} }
 func (m *TLClientSession) String() string { return proto.CompactTextString(m) }
 func (*TLClientSession) ProtoMessage()    {}
 func (*TLClientSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{1}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{3}
 }
 func (m *TLClientSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,37 +497,40 @@ func (m *TLClientSession) GetData2() *ClientSession {
 }
 
 //--------------------------------------------------------------------------------------------
-// authKeyStateData auth_key_id:long user_id:long key_state:int layer:int client_type:int android_push_session_id:long = AuthKeyStateData;
+// pushDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes locked_period:int other_uids:Vector<long> = PushDevice;
 //
-// AuthKeyStateData <--
-//  + TL_authKeyStateData
+// PushDevice <--
+//  + TL_pushDevice
 //
-type AuthKeyStateData struct {
+type PushDevice struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
-	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
-	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	KeyState             int32         `protobuf:"varint,5,opt,name=key_state,json=keyState,proto3" json:"key_state,omitempty"`
-	Layer                int32         `protobuf:"varint,6,opt,name=layer,proto3" json:"layer,omitempty"`
-	ClientType           int32         `protobuf:"varint,7,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
-	AndroidPushSessionId int64         `protobuf:"varint,8,opt,name=android_push_session_id,json=androidPushSessionId,proto3" json:"android_push_session_id,omitempty"`
+	NoMuted              bool          `protobuf:"varint,3,opt,name=no_muted,json=noMuted,proto3" json:"no_muted,omitempty"`
+	AppSandbox           bool          `protobuf:"varint,4,opt,name=app_sandbox,json=appSandbox,proto3" json:"app_sandbox,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,5,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	UserId               int64         `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenType            int32         `protobuf:"varint,7,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	Token                string        `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+	Secret               []byte        `protobuf:"bytes,9,opt,name=secret,proto3" json:"secret,omitempty"`
+	LockedPeriod         int32         `protobuf:"varint,10,opt,name=locked_period,json=lockedPeriod,proto3" json:"locked_period,omitempty"`
+	OtherUids            []int64       `protobuf:"varint,11,rep,packed,name=other_uids,json=otherUids,proto3" json:"other_uids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *AuthKeyStateData) Reset()         { *m = AuthKeyStateData{} }
-func (m *AuthKeyStateData) String() string { return proto.CompactTextString(m) }
-func (*AuthKeyStateData) ProtoMessage()    {}
-func (*AuthKeyStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{2}
+func (m *PushDevice) Reset()         { *m = PushDevice{} }
+func (m *PushDevice) String() string { return proto.CompactTextString(m) }
+func (*PushDevice) ProtoMessage()    {}
+func (*PushDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{4}
 }
-func (m *AuthKeyStateData) XXX_Unmarshal(b []byte) error {
+func (m *PushDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthKeyStateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PushDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthKeyStateData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PushDevice.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -359,94 +540,115 @@ func (m *AuthKeyStateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *AuthKeyStateData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthKeyStateData.Merge(m, src)
+func (m *PushDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushDevice.Merge(m, src)
 }
-func (m *AuthKeyStateData) XXX_Size() int {
+func (m *PushDevice) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthKeyStateData) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthKeyStateData.DiscardUnknown(m)
+func (m *PushDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushDevice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthKeyStateData proto.InternalMessageInfo
+var xxx_messageInfo_PushDevice proto.InternalMessageInfo
 
-func (m *AuthKeyStateData) GetPredicateName() string {
+func (m *PushDevice) GetPredicateName() string {
 	if m != nil {
 		return m.PredicateName
 	}
 	return ""
 }
 
-func (m *AuthKeyStateData) GetConstructor() TLConstructor {
+func (m *PushDevice) GetConstructor() TLConstructor {
 	if m != nil {
 		return m.Constructor
 	}
 	return CRC32_UNKNOWN
 }
 
-func (m *AuthKeyStateData) GetAuthKeyId() int64 {
+func (m *PushDevice) GetNoMuted() bool {
+	if m != nil {
+		return m.NoMuted
+	}
+	return false
+}
+
+func (m *PushDevice) GetAppSandbox() bool {
+	if m != nil {
+		return m.AppSandbox
+	}
+	return false
+}
+
+func (m *PushDevice) GetAuthKeyId() int64 {
 	if m != nil {
 		return m.AuthKeyId
 	}
 	return 0
 }
 
-func (m *AuthKeyStateData) GetUserId() int64 {
+func (m *PushDevice) GetUserId() int64 {
 	if m != nil {
 		return m.UserId
 	}
 	return 0
 }
 
-func (m *AuthKeyStateData) GetKeyState() int32 {
+func (m *PushDevice) GetTokenType() int32 {
 	if m != nil {
-		return m.KeyState
+		return m.TokenType
 	}
 	return 0
 }
 
-func (m *AuthKeyStateData) GetLayer() int32 {
+func (m *PushDevice) GetToken() string {
 	if m != nil {
-		return m.Layer
+		return m.Token
 	}
-	return 0
+	return ""
 }
 
-func (m *AuthKeyStateData) GetClientType() int32 {
+func (m *PushDevice) GetSecret() []byte {
 	if m != nil {
-		return m.ClientType
+		return m.Secret
 	}
-	return 0
+	return nil
 }
 
-func (m *AuthKeyStateData) GetAndroidPushSessionId() int64 {
+func (m *PushDevice) GetLockedPeriod() int32 {
 	if m != nil {
-		return m.AndroidPushSessionId
+		return m.LockedPeriod
 	}
 	return 0
 }
 
-// authKeyStateData auth_key_id:long user_id:long key_state:int layer:int client_type:int android_push_session_id:long = AuthKeyStateData;
-type TLAuthKeyStateData struct {
-	Data2                *AuthKeyStateData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *PushDevice) GetOtherUids() []int64 {
+	if m != nil {
+		return m.OtherUids
+	}
+	return nil
 }
 
-func (m *TLAuthKeyStateData) Reset()         { *m = TLAuthKeyStateData{} }
-func (m *TLAuthKeyStateData) String() string { return proto.CompactTextString(m) }
-func (*TLAuthKeyStateData) ProtoMessage()    {}
-func (*TLAuthKeyStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{3}
+// pushDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes locked_period:int other_uids:Vector<long> = PushDevice;
+type TLPushDevice struct {
+	Data2                *PushDevice `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *TLAuthKeyStateData) XXX_Unmarshal(b []byte) error {
+
+func (m *TLPushDevice) Reset()         { *m = TLPushDevice{} }
+func (m *TLPushDevice) String() string { return proto.CompactTextString(m) }
+func (*TLPushDevice) ProtoMessage()    {}
+func (*TLPushDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{5}
+}
+func (m *TLPushDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TLAuthKeyStateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TLPushDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TLAuthKeyStateData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TLPushDevice.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -456,19 +658,19 @@ func (m *TLAuthKeyStateData) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *TLAuthKeyStateData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TLAuthKeyStateData.Merge(m, src)
+func (m *TLPushDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPushDevice.Merge(m, src)
 }
-func (m *TLAuthKeyStateData) XXX_Size() int {
+func (m *TLPushDevice) XXX_Size() int {
 	return m.Size()
 }
-func (m *TLAuthKeyStateData) XXX_DiscardUnknown() {
-	xxx_messageInfo_TLAuthKeyStateData.DiscardUnknown(m)
+func (m *TLPushDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPushDevice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TLAuthKeyStateData proto.InternalMessageInfo
+var xxx_messageInfo_TLPushDevice proto.InternalMessageInfo
 
-func (m *TLAuthKeyStateData) GetData2() *AuthKeyStateData {
+func (m *TLPushDevice) GetData2() *PushDevice {
 	if m != nil {
 		return m.Data2
 	}
@@ -490,7 +692,7 @@ func (m *TLAuthsessionGetAuthorizations) Reset()         { *m = TLAuthsessionGet
 func (m *TLAuthsessionGetAuthorizations) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetAuthorizations) ProtoMessage()    {}
 func (*TLAuthsessionGetAuthorizations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{4}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{6}
 }
 func (m *TLAuthsessionGetAuthorizations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +758,7 @@ func (m *TLAuthsessionResetAuthorization) Reset()         { *m = TLAuthsessionRe
 func (m *TLAuthsessionResetAuthorization) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionResetAuthorization) ProtoMessage()    {}
 func (*TLAuthsessionResetAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{5}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{7}
 }
 func (m *TLAuthsessionResetAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +829,7 @@ func (m *TLAuthsessionGetLayer) Reset()         { *m = TLAuthsessionGetLayer{} }
 func (m *TLAuthsessionGetLayer) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetLayer) ProtoMessage()    {}
 func (*TLAuthsessionGetLayer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{6}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{8}
 }
 func (m *TLAuthsessionGetLayer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +886,7 @@ func (m *TLAuthsessionGetLangPack) Reset()         { *m = TLAuthsessionGetLangPa
 func (m *TLAuthsessionGetLangPack) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetLangPack) ProtoMessage()    {}
 func (*TLAuthsessionGetLangPack) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{7}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{9}
 }
 func (m *TLAuthsessionGetLangPack) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +943,7 @@ func (m *TLAuthsessionGetClient) Reset()         { *m = TLAuthsessionGetClient{}
 func (m *TLAuthsessionGetClient) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetClient) ProtoMessage()    {}
 func (*TLAuthsessionGetClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{8}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{10}
 }
 func (m *TLAuthsessionGetClient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +1000,7 @@ func (m *TLAuthsessionGetLangCode) Reset()         { *m = TLAuthsessionGetLangCo
 func (m *TLAuthsessionGetLangCode) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetLangCode) ProtoMessage()    {}
 func (*TLAuthsessionGetLangCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{9}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{11}
 }
 func (m *TLAuthsessionGetLangCode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +1057,7 @@ func (m *TLAuthsessionGetUserId) Reset()         { *m = TLAuthsessionGetUserId{}
 func (m *TLAuthsessionGetUserId) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetUserId) ProtoMessage()    {}
 func (*TLAuthsessionGetUserId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{10}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{12}
 }
 func (m *TLAuthsessionGetUserId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,7 +1116,7 @@ func (m *TLAuthsessionGetPushSessionId) Reset()         { *m = TLAuthsessionGetP
 func (m *TLAuthsessionGetPushSessionId) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetPushSessionId) ProtoMessage()    {}
 func (*TLAuthsessionGetPushSessionId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{11}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{13}
 }
 func (m *TLAuthsessionGetPushSessionId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -986,7 +1188,7 @@ func (m *TLAuthsessionGetFutureSalts) Reset()         { *m = TLAuthsessionGetFut
 func (m *TLAuthsessionGetFutureSalts) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetFutureSalts) ProtoMessage()    {}
 func (*TLAuthsessionGetFutureSalts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{12}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{14}
 }
 func (m *TLAuthsessionGetFutureSalts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,7 +1252,7 @@ func (m *TLAuthsessionQueryAuthKey) Reset()         { *m = TLAuthsessionQueryAut
 func (m *TLAuthsessionQueryAuthKey) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionQueryAuthKey) ProtoMessage()    {}
 func (*TLAuthsessionQueryAuthKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{13}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{15}
 }
 func (m *TLAuthsessionQueryAuthKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1109,7 +1311,7 @@ func (m *TLAuthsessionSetAuthKey) Reset()         { *m = TLAuthsessionSetAuthKey
 func (m *TLAuthsessionSetAuthKey) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionSetAuthKey) ProtoMessage()    {}
 func (*TLAuthsessionSetAuthKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{14}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{16}
 }
 func (m *TLAuthsessionSetAuthKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1383,7 @@ func (m *TLAuthsessionBindAuthKeyUser) Reset()         { *m = TLAuthsessionBindA
 func (m *TLAuthsessionBindAuthKeyUser) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionBindAuthKeyUser) ProtoMessage()    {}
 func (*TLAuthsessionBindAuthKeyUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{15}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{17}
 }
 func (m *TLAuthsessionBindAuthKeyUser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,7 +1448,7 @@ func (m *TLAuthsessionUnbindAuthKeyUser) Reset()         { *m = TLAuthsessionUnb
 func (m *TLAuthsessionUnbindAuthKeyUser) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionUnbindAuthKeyUser) ProtoMessage()    {}
 func (*TLAuthsessionUnbindAuthKeyUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{16}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{18}
 }
 func (m *TLAuthsessionUnbindAuthKeyUser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1297,7 +1499,7 @@ func (m *TLAuthsessionUnbindAuthKeyUser) GetUserId() int64 {
 }
 
 //--------------------------------------------------------------------------------------------
-// authsession.getPermAuthKeyId auth_key_id:long= Int64;
+// authsession.getPermAuthKeyId auth_key_id:long = Int64;
 type TLAuthsessionGetPermAuthKeyId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
 	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
@@ -1310,7 +1512,7 @@ func (m *TLAuthsessionGetPermAuthKeyId) Reset()         { *m = TLAuthsessionGetP
 func (m *TLAuthsessionGetPermAuthKeyId) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetPermAuthKeyId) ProtoMessage()    {}
 func (*TLAuthsessionGetPermAuthKeyId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{17}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{19}
 }
 func (m *TLAuthsessionGetPermAuthKeyId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1354,13 +1556,16 @@ func (m *TLAuthsessionGetPermAuthKeyId) GetAuthKeyId() int64 {
 }
 
 //--------------------------------------------------------------------------------------------
-// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
+// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes temp_auth_key_id:long temp_session_id:long msg_id:long = Bool;
 type TLAuthsessionBindTempAuthKey struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
 	PermAuthKeyId        int64         `protobuf:"varint,3,opt,name=perm_auth_key_id,json=permAuthKeyId,proto3" json:"perm_auth_key_id,omitempty"`
 	Nonce                int64         `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	ExpiresAt            int32         `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	EncryptedMessage     []byte        `protobuf:"bytes,6,opt,name=encrypted_message,json=encryptedMessage,proto3" json:"encrypted_message,omitempty"`
+	TempAuthKeyId        int64         `protobuf:"varint,7,opt,name=temp_auth_key_id,json=tempAuthKeyId,proto3" json:"temp_auth_key_id,omitempty"`
+	TempSessionId        int64         `protobuf:"varint,8,opt,name=temp_session_id,json=tempSessionId,proto3" json:"temp_session_id,omitempty"`
+	MsgId                int64         `protobuf:"varint,9,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -1370,7 +1575,7 @@ func (m *TLAuthsessionBindTempAuthKey) Reset()         { *m = TLAuthsessionBindT
 func (m *TLAuthsessionBindTempAuthKey) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionBindTempAuthKey) ProtoMessage()    {}
 func (*TLAuthsessionBindTempAuthKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{18}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{20}
 }
 func (m *TLAuthsessionBindTempAuthKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,6 +1639,27 @@ func (m *TLAuthsessionBindTempAuthKey) GetEncryptedMessage() []byte {
 	return nil
 }
 
+func (m *TLAuthsessionBindTempAuthKey) GetTempAuthKeyId() int64 {
+	if m != nil {
+		return m.TempAuthKeyId
+	}
+	return 0
+}
+
+func (m *TLAuthsessionBindTempAuthKey) GetTempSessionId() int64 {
+	if m != nil {
+		return m.TempSessionId
+	}
+	return 0
+}
+
+func (m *TLAuthsessionBindTempAuthKey) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
 //--------------------------------------------------------------------------------------------
 // authsession.setClientSessionInfo data:ClientSession = Bool;
 type TLAuthsessionSetClientSessionInfo struct {
@@ -1448,7 +1674,7 @@ func (m *TLAuthsessionSetClientSessionInfo) Reset()         { *m = TLAuthsession
 func (m *TLAuthsessionSetClientSessionInfo) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionSetClientSessionInfo) ProtoMessage()    {}
 func (*TLAuthsessionSetClientSessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{19}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{21}
 }
 func (m *TLAuthsessionSetClientSessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,7 +1731,7 @@ func (m *TLAuthsessionGetAuthorization) Reset()         { *m = TLAuthsessionGetA
 func (m *TLAuthsessionGetAuthorization) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetAuthorization) ProtoMessage()    {}
 func (*TLAuthsessionGetAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{20}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{22}
 }
 func (m *TLAuthsessionGetAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,7 +1788,7 @@ func (m *TLAuthsessionGetAuthStateData) Reset()         { *m = TLAuthsessionGetA
 func (m *TLAuthsessionGetAuthStateData) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetAuthStateData) ProtoMessage()    {}
 func (*TLAuthsessionGetAuthStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{21}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{23}
 }
 func (m *TLAuthsessionGetAuthStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1606,26 +1832,28 @@ func (m *TLAuthsessionGetAuthStateData) GetAuthKeyId() int64 {
 }
 
 //--------------------------------------------------------------------------------------------
-// Vector api result type
-type Vector_Long struct {
-	Datas                []int64  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Bool;
+type TLAuthsessionDropTempAuthKeys struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
+	PermAuthKeyId        int64         `protobuf:"varint,3,opt,name=perm_auth_key_id,json=permAuthKeyId,proto3" json:"perm_auth_key_id,omitempty"`
+	ExceptAuthKeys       []int64       `protobuf:"varint,4,rep,packed,name=except_auth_keys,json=exceptAuthKeys,proto3" json:"except_auth_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *Vector_Long) Reset()         { *m = Vector_Long{} }
-func (m *Vector_Long) String() string { return proto.CompactTextString(m) }
-func (*Vector_Long) ProtoMessage()    {}
-func (*Vector_Long) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{22}
+func (m *TLAuthsessionDropTempAuthKeys) Reset()         { *m = TLAuthsessionDropTempAuthKeys{} }
+func (m *TLAuthsessionDropTempAuthKeys) String() string { return proto.CompactTextString(m) }
+func (*TLAuthsessionDropTempAuthKeys) ProtoMessage()    {}
+func (*TLAuthsessionDropTempAuthKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{24}
 }
-func (m *Vector_Long) XXX_Unmarshal(b []byte) error {
+func (m *TLAuthsessionDropTempAuthKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vector_Long) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TLAuthsessionDropTempAuthKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vector_Long.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TLAuthsessionDropTempAuthKeys.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1635,492 +1863,886 @@ func (m *Vector_Long) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Vector_Long) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vector_Long.Merge(m, src)
+func (m *TLAuthsessionDropTempAuthKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLAuthsessionDropTempAuthKeys.Merge(m, src)
 }
-func (m *Vector_Long) XXX_Size() int {
+func (m *TLAuthsessionDropTempAuthKeys) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vector_Long) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vector_Long.DiscardUnknown(m)
+func (m *TLAuthsessionDropTempAuthKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLAuthsessionDropTempAuthKeys.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Vector_Long proto.InternalMessageInfo
+var xxx_messageInfo_TLAuthsessionDropTempAuthKeys proto.InternalMessageInfo
 
-func (m *Vector_Long) GetDatas() []int64 {
+func (m *TLAuthsessionDropTempAuthKeys) GetConstructor() TLConstructor {
 	if m != nil {
-		return m.Datas
+		return m.Constructor
 	}
-	return nil
+	return CRC32_UNKNOWN
 }
 
-func init() {
-	proto.RegisterEnum("authsession.TLConstructor", TLConstructor_name, TLConstructor_value)
-	proto.RegisterType((*ClientSession)(nil), "authsession.ClientSession")
-	proto.RegisterType((*TLClientSession)(nil), "authsession.TL_clientSession")
-	proto.RegisterType((*AuthKeyStateData)(nil), "authsession.AuthKeyStateData")
-	proto.RegisterType((*TLAuthKeyStateData)(nil), "authsession.TL_authKeyStateData")
-	proto.RegisterType((*TLAuthsessionGetAuthorizations)(nil), "authsession.TL_authsession_getAuthorizations")
-	proto.RegisterType((*TLAuthsessionResetAuthorization)(nil), "authsession.TL_authsession_resetAuthorization")
-	proto.RegisterType((*TLAuthsessionGetLayer)(nil), "authsession.TL_authsession_getLayer")
-	proto.RegisterType((*TLAuthsessionGetLangPack)(nil), "authsession.TL_authsession_getLangPack")
-	proto.RegisterType((*TLAuthsessionGetClient)(nil), "authsession.TL_authsession_getClient")
-	proto.RegisterType((*TLAuthsessionGetLangCode)(nil), "authsession.TL_authsession_getLangCode")
-	proto.RegisterType((*TLAuthsessionGetUserId)(nil), "authsession.TL_authsession_getUserId")
-	proto.RegisterType((*TLAuthsessionGetPushSessionId)(nil), "authsession.TL_authsession_getPushSessionId")
-	proto.RegisterType((*TLAuthsessionGetFutureSalts)(nil), "authsession.TL_authsession_getFutureSalts")
-	proto.RegisterType((*TLAuthsessionQueryAuthKey)(nil), "authsession.TL_authsession_queryAuthKey")
-	proto.RegisterType((*TLAuthsessionSetAuthKey)(nil), "authsession.TL_authsession_setAuthKey")
-	proto.RegisterType((*TLAuthsessionBindAuthKeyUser)(nil), "authsession.TL_authsession_bindAuthKeyUser")
-	proto.RegisterType((*TLAuthsessionUnbindAuthKeyUser)(nil), "authsession.TL_authsession_unbindAuthKeyUser")
-	proto.RegisterType((*TLAuthsessionGetPermAuthKeyId)(nil), "authsession.TL_authsession_getPermAuthKeyId")
-	proto.RegisterType((*TLAuthsessionBindTempAuthKey)(nil), "authsession.TL_authsession_bindTempAuthKey")
-	proto.RegisterType((*TLAuthsessionSetClientSessionInfo)(nil), "authsession.TL_authsession_setClientSessionInfo")
-	proto.RegisterType((*TLAuthsessionGetAuthorization)(nil), "authsession.TL_authsession_getAuthorization")
-	proto.RegisterType((*TLAuthsessionGetAuthStateData)(nil), "authsession.TL_authsession_getAuthStateData")
-	proto.RegisterType((*Vector_Long)(nil), "authsession.Vector_Long")
+func (m *TLAuthsessionDropTempAuthKeys) GetPermAuthKeyId() int64 {
+	if m != nil {
+		return m.PermAuthKeyId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("authsession.tl.proto", fileDescriptor_7cbc1347c4a76ecf) }
+func (m *TLAuthsessionDropTempAuthKeys) GetExceptAuthKeys() []int64 {
+	if m != nil {
+		return m.ExceptAuthKeys
+	}
+	return nil
+}
 
-var fileDescriptor_7cbc1347c4a76ecf = []byte{
-	// 1734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5d, 0x6c, 0x1b, 0x59,
-	0x15, 0xf6, 0xc4, 0xb1, 0x9b, 0x1c, 0xd7, 0x61, 0xf6, 0xd6, 0x9b, 0x78, 0x27, 0x1b, 0xc7, 0x9d,
-	0xf4, 0x27, 0xa4, 0x8d, 0xbd, 0x24, 0x0b, 0x0f, 0x08, 0x09, 0x65, 0xb3, 0x42, 0x32, 0x9b, 0x0d,
-	0x59, 0x27, 0x5d, 0x24, 0x40, 0x3b, 0xdc, 0xcc, 0xdc, 0x38, 0xa3, 0x78, 0x7e, 0x76, 0x66, 0xdc,
-	0x8d, 0x97, 0x07, 0xa4, 0x05, 0x41, 0x1e, 0x90, 0x10, 0x02, 0x84, 0xf6, 0x05, 0x1e, 0xba, 0xfc,
-	0x89, 0x1f, 0x15, 0x5e, 0x60, 0x81, 0x22, 0x55, 0xaa, 0xaa, 0x22, 0x7e, 0x54, 0x24, 0x84, 0x54,
-	0x09, 0x09, 0x9a, 0x4a, 0x45, 0xf4, 0x85, 0x0a, 0x21, 0x51, 0x8a, 0xaa, 0xa0, 0xb9, 0x77, 0x6c,
-	0xcf, 0x9f, 0xed, 0x56, 0xad, 0xd5, 0xbe, 0xcd, 0x3d, 0xf7, 0xbb, 0xe7, 0x7c, 0xf7, 0x9c, 0x73,
-	0xcf, 0x3d, 0x77, 0x20, 0x87, 0x1b, 0xce, 0xb6, 0x4d, 0x6c, 0x5b, 0x35, 0xf4, 0x92, 0x53, 0x2f,
-	0x99, 0x96, 0xe1, 0x18, 0x28, 0xe3, 0x93, 0x0a, 0xf3, 0x35, 0xd5, 0xd9, 0x6e, 0x6c, 0x96, 0x64,
-	0x43, 0x2b, 0xd7, 0x8c, 0x9a, 0x51, 0xa6, 0x98, 0xcd, 0xc6, 0x16, 0x1d, 0xd1, 0x01, 0xfd, 0x62,
-	0x6b, 0x85, 0x42, 0xcd, 0x30, 0x6a, 0x75, 0xd2, 0x41, 0xbd, 0x61, 0x61, 0xd3, 0x24, 0x96, 0xed,
-	0xcd, 0x0b, 0xb6, 0xbc, 0x4d, 0x34, 0xec, 0x1a, 0x93, 0x0d, 0x8b, 0x48, 0x4e, 0xd3, 0x24, 0xad,
-	0xb9, 0x67, 0x3a, 0x73, 0x8e, 0x85, 0x75, 0xdb, 0x34, 0x2c, 0xc7, 0x9b, 0xca, 0x75, 0xa6, 0xec,
-	0xa6, 0x2e, 0x33, 0xa9, 0xf8, 0x87, 0x24, 0x64, 0x97, 0xeb, 0x2a, 0xd1, 0x9d, 0x75, 0xc6, 0x16,
-	0x1d, 0x87, 0x31, 0xd3, 0x22, 0x8a, 0x2a, 0x63, 0x87, 0x48, 0x3a, 0xd6, 0x48, 0x9e, 0x2b, 0x72,
-	0xb3, 0xa3, 0xd5, 0x6c, 0x5b, 0xba, 0x8a, 0x35, 0x82, 0x3e, 0x04, 0x19, 0xd9, 0xd0, 0x6d, 0xc7,
-	0x6a, 0xc8, 0x8e, 0x61, 0xe5, 0x87, 0x8a, 0xdc, 0xec, 0xd8, 0x82, 0x50, 0xf2, 0x7b, 0x63, 0x63,
-	0x65, 0xb9, 0x83, 0xa8, 0xfa, 0xe1, 0xa8, 0x00, 0xd4, 0x43, 0xd2, 0x0e, 0x69, 0x4a, 0xaa, 0x92,
-	0x4f, 0x16, 0xb9, 0xd9, 0x64, 0x75, 0xd4, 0x15, 0xbd, 0x44, 0x9a, 0x15, 0x05, 0x8d, 0xc1, 0x90,
-	0x6a, 0xe6, 0x87, 0xa9, 0xe1, 0x21, 0xd5, 0x44, 0x39, 0x48, 0xd5, 0x71, 0x93, 0x58, 0xf9, 0x54,
-	0x91, 0x9b, 0x4d, 0x55, 0xd9, 0x00, 0x3d, 0x0d, 0x69, 0x6c, 0xaa, 0xae, 0x82, 0x34, 0x13, 0x63,
-	0x53, 0xad, 0x28, 0xe8, 0x28, 0x1c, 0x56, 0xc8, 0x59, 0x55, 0x26, 0x92, 0x66, 0x28, 0xa4, 0x9e,
-	0x3f, 0x44, 0xd5, 0x64, 0x98, 0xec, 0x65, 0x57, 0xe4, 0x6e, 0xd2, 0x6e, 0xda, 0x0e, 0xd1, 0xa4,
-	0xb3, 0xc4, 0x72, 0xc9, 0xe6, 0x47, 0xd8, 0x26, 0x99, 0xf4, 0x55, 0x26, 0x44, 0xd3, 0x90, 0xc1,
-	0xa6, 0xd9, 0xc6, 0x8c, 0x52, 0x0c, 0x60, 0xd3, 0x6c, 0x01, 0x66, 0x81, 0xf7, 0xf4, 0xd4, 0xb1,
-	0x5e, 0x93, 0x64, 0x43, 0x21, 0x79, 0xa0, 0x28, 0x4f, 0xff, 0x0a, 0xd6, 0x6b, 0xcb, 0x86, 0x42,
-	0xd0, 0x24, 0x8c, 0x52, 0x88, 0x89, 0xe5, 0x9d, 0x7c, 0x86, 0x42, 0x46, 0x5c, 0xc1, 0x1a, 0x96,
-	0x77, 0xda, 0x93, 0x74, 0xfd, 0xe1, 0xce, 0x24, 0x5d, 0x99, 0x83, 0x94, 0x69, 0x19, 0xbb, 0xcd,
-	0x7c, 0x96, 0x4e, 0xb0, 0x01, 0x1a, 0x87, 0xb4, 0x89, 0x2d, 0xac, 0xd9, 0xf9, 0x31, 0x2a, 0xf6,
-	0x46, 0xe2, 0x8b, 0xc0, 0x6f, 0xac, 0x48, 0x72, 0x20, 0xa4, 0xcf, 0x41, 0x4a, 0xc1, 0x0e, 0x5e,
-	0xa0, 0x91, 0xcc, 0x84, 0xa2, 0x14, 0x88, 0x7e, 0x95, 0x01, 0xc5, 0x5f, 0x0e, 0x01, 0xbf, 0xc4,
-	0xa2, 0xb1, 0xee, 0x60, 0x87, 0xbc, 0x88, 0x1d, 0xfc, 0x64, 0x64, 0xc6, 0x04, 0x1c, 0x6a, 0xd8,
-	0xc4, 0x72, 0xe7, 0x86, 0xe9, 0x5c, 0xda, 0x1d, 0x56, 0x14, 0xd7, 0x87, 0xee, 0x1a, 0xdb, 0xa5,
-	0xeb, 0xa5, 0xc9, 0xc8, 0x8e, 0x47, 0xbf, 0x93, 0x3f, 0x69, 0x7f, 0xfe, 0x4c, 0x43, 0x86, 0x39,
-	0x8a, 0x9e, 0x21, 0x9a, 0x27, 0xa9, 0x2a, 0x30, 0xd1, 0x46, 0xd3, 0x24, 0xe8, 0xfd, 0x30, 0x81,
-	0x75, 0xc5, 0x32, 0x54, 0x45, 0x32, 0x1b, 0xf6, 0xb6, 0xe4, 0xf1, 0x77, 0x8d, 0x8f, 0x50, 0xe3,
-	0x39, 0x6f, 0x7a, 0xad, 0x61, 0x6f, 0x7b, 0x2e, 0xac, 0x28, 0xe2, 0x47, 0xe1, 0xc8, 0xc6, 0x8a,
-	0x84, 0xc3, 0xfe, 0x5b, 0x0c, 0x86, 0x61, 0x2a, 0xe0, 0x92, 0xb0, 0xb7, 0x5b, 0x91, 0xf8, 0x2e,
-	0x07, 0x45, 0x4f, 0x59, 0xcb, 0x7a, 0x8d, 0x38, 0x2e, 0xda, 0xb0, 0xd4, 0x37, 0xb1, 0xa3, 0x1a,
-	0xba, 0x1d, 0x76, 0x39, 0xf7, 0x60, 0x2e, 0xf7, 0xb9, 0x34, 0x19, 0x70, 0xe9, 0x69, 0x40, 0x64,
-	0x57, 0xae, 0x37, 0x14, 0x22, 0xb5, 0x62, 0x52, 0x69, 0xb9, 0x9d, 0xf7, 0x66, 0x96, 0x5a, 0x91,
-	0x11, 0x7f, 0xcc, 0xc1, 0xd1, 0x10, 0x53, 0x8b, 0xd8, 0x21, 0xae, 0x83, 0xa2, 0x1a, 0x4a, 0x9b,
-	0xe1, 0x70, 0xda, 0x20, 0x18, 0xde, 0xc6, 0xf6, 0x36, 0x4d, 0x8c, 0x64, 0x95, 0x7e, 0x8b, 0x6f,
-	0xc0, 0x44, 0xd4, 0xb3, 0x2b, 0x34, 0x33, 0x1e, 0x8e, 0x65, 0x9f, 0x1c, 0x16, 0xdf, 0x04, 0x21,
-	0xce, 0xb0, 0x57, 0x0c, 0x06, 0x6b, 0x7b, 0x17, 0xf2, 0x51, 0xdb, 0xac, 0x06, 0x3c, 0xae, 0x5d,
-	0xd3, 0x2a, 0xf7, 0x18, 0x76, 0x7d, 0x86, 0xa5, 0xce, 0x60, 0x2d, 0xff, 0x8c, 0x83, 0xe9, 0xa8,
-	0xe9, 0x40, 0xbd, 0x78, 0x5c, 0x67, 0x62, 0x0a, 0xc0, 0x31, 0x76, 0x88, 0xce, 0xaa, 0x1f, 0x2b,
-	0x99, 0xa3, 0x54, 0xe2, 0x16, 0x3f, 0xf1, 0xcb, 0x1c, 0x4c, 0x45, 0x99, 0x7f, 0xa4, 0xe1, 0x34,
-	0x2c, 0xb2, 0x8e, 0xeb, 0x8e, 0x3d, 0x58, 0xcf, 0x21, 0x1e, 0x92, 0x7a, 0x43, 0xa3, 0xb4, 0x53,
-	0x55, 0xf7, 0x53, 0xfc, 0x0c, 0x4c, 0x86, 0x08, 0xbd, 0xde, 0x20, 0x56, 0xd3, 0x2b, 0x41, 0x03,
-	0x0e, 0xe4, 0x5f, 0x39, 0x78, 0x26, 0x64, 0xdd, 0x2b, 0x6e, 0x0f, 0x6f, 0xbb, 0x0c, 0x23, 0x2d,
-	0xdb, 0xd4, 0x70, 0x66, 0x21, 0x57, 0xd2, 0x1c, 0xda, 0xa1, 0xb5, 0x2e, 0x86, 0x8a, 0xbe, 0x65,
-	0x54, 0x0f, 0x79, 0x74, 0xd0, 0xf3, 0x90, 0xd9, 0xa2, 0x81, 0x90, 0x6c, 0x5c, 0x77, 0xa8, 0x8f,
-	0x32, 0x0b, 0x47, 0xda, 0x6b, 0x3a, 0x41, 0xaa, 0xc2, 0x56, 0xfb, 0xdb, 0x0d, 0x38, 0xd9, 0x35,
-	0x55, 0x8b, 0xd8, 0x92, 0xaa, 0xb7, 0x02, 0xee, 0x49, 0x2a, 0xba, 0xf8, 0x0d, 0x0e, 0x0a, 0xa1,
-	0x1d, 0x6e, 0xaa, 0xba, 0xe2, 0x11, 0x70, 0x4f, 0xcb, 0x80, 0x23, 0xde, 0xed, 0x6e, 0x17, 0xdf,
-	0x8e, 0x5e, 0x82, 0x0d, 0xfd, 0x09, 0xe1, 0xf6, 0xd9, 0xd8, 0xf3, 0x4d, 0x2c, 0xad, 0x7d, 0x33,
-	0x0e, 0x38, 0x31, 0xff, 0x11, 0x1f, 0xb6, 0x0d, 0xa2, 0x99, 0x8f, 0x26, 0x3b, 0x4f, 0x02, 0x6f,
-	0x12, 0x4b, 0x93, 0xa2, 0x2c, 0xb2, 0x66, 0x60, 0x9f, 0x39, 0x48, 0xe9, 0x86, 0x2e, 0x13, 0xcf,
-	0x43, 0x6c, 0xe0, 0xcf, 0x3a, 0xec, 0x84, 0xb2, 0x6e, 0xc9, 0x41, 0xa7, 0xe0, 0x29, 0xa2, 0xcb,
-	0x56, 0xd3, 0x74, 0x88, 0x22, 0x69, 0xc4, 0xb6, 0x71, 0x8d, 0xd0, 0x36, 0xed, 0x70, 0x95, 0x6f,
-	0x4f, 0xbc, 0xcc, 0xe4, 0xe2, 0x57, 0x39, 0x98, 0x89, 0x1e, 0xc2, 0x40, 0x0b, 0xeb, 0x1e, 0x94,
-	0x87, 0xdc, 0x70, 0x09, 0x86, 0xdd, 0xe6, 0xcb, 0x3b, 0x8a, 0xbd, 0xda, 0x65, 0x8a, 0x8b, 0x4f,
-	0x81, 0x47, 0xd9, 0xf6, 0xf4, 0x4b, 0x81, 0xae, 0x04, 0x3a, 0xcd, 0xe7, 0x60, 0x09, 0xcc, 0x40,
-	0xe6, 0x55, 0xe2, 0x22, 0xa5, 0x15, 0x43, 0xaf, 0xb9, 0x89, 0xe0, 0x3a, 0xc6, 0xce, 0x73, 0xc5,
-	0xa4, 0x9b, 0x08, 0x74, 0x30, 0xf7, 0x9f, 0x34, 0x64, 0x03, 0x36, 0xd0, 0x53, 0x90, 0x5d, 0xae,
-	0x2e, 0x2f, 0x2e, 0x48, 0x67, 0x56, 0x5f, 0x5a, 0xfd, 0xd8, 0xc7, 0x57, 0xf9, 0x04, 0x2a, 0xc2,
-	0x11, 0x26, 0x0a, 0x3c, 0x61, 0xf8, 0xf3, 0x37, 0xde, 0xfd, 0xd3, 0x7f, 0x0f, 0x0e, 0x0e, 0x0e,
-	0x38, 0x34, 0x03, 0xe3, 0x0c, 0x11, 0x6e, 0xb0, 0xf9, 0xf3, 0xbf, 0xbb, 0xfc, 0xdb, 0x7b, 0x0c,
-	0x74, 0x0a, 0x66, 0x3a, 0xa0, 0xae, 0x8d, 0x33, 0x7f, 0xe9, 0x9d, 0xbd, 0x2f, 0x26, 0xd1, 0xfb,
-	0xe0, 0x58, 0x14, 0x1c, 0xed, 0x5d, 0xf9, 0xef, 0x5c, 0xbb, 0x77, 0xeb, 0x0e, 0xd3, 0x7f, 0x12,
-	0x84, 0x58, 0xfd, 0xb4, 0x7d, 0xe4, 0xbf, 0xff, 0xa3, 0x5f, 0x5d, 0xbc, 0xcb, 0x80, 0xc7, 0x61,
-	0xaa, 0x0b, 0x90, 0xb5, 0x7b, 0xfc, 0xcd, 0xb7, 0xfe, 0xf9, 0xfb, 0x21, 0x34, 0x03, 0x93, 0xb1,
-	0x30, 0x96, 0x6e, 0xfc, 0xaf, 0x7f, 0x70, 0xfd, 0xad, 0x74, 0x4f, 0x5d, 0x6e, 0x13, 0xc5, 0x5f,
-	0xbb, 0x75, 0xf3, 0x52, 0xaa, 0xab, 0x2e, 0xd6, 0xef, 0xf0, 0x3f, 0x7c, 0xf7, 0x9d, 0x5f, 0xa4,
-	0x50, 0x19, 0xc4, 0x58, 0x50, 0xa0, 0x33, 0xe1, 0x2f, 0xee, 0xef, 0x7d, 0xf3, 0x7f, 0x6c, 0x23,
-	0xf3, 0x50, 0x8c, 0x5d, 0xe0, 0x6b, 0x08, 0xf8, 0xaf, 0x9f, 0xbf, 0x70, 0xd9, 0x83, 0x9f, 0x80,
-	0x42, 0x14, 0xee, 0xbf, 0xae, 0xf9, 0xef, 0xdd, 0xfe, 0xcb, 0xb7, 0x53, 0xe8, 0x18, 0x3c, 0x1b,
-	0xc5, 0x75, 0x2e, 0x56, 0xfe, 0x2b, 0x6f, 0x5f, 0xfd, 0x77, 0x12, 0xcd, 0xc2, 0xd1, 0x28, 0x2a,
-	0x74, 0x01, 0xf0, 0xe7, 0xf6, 0x7e, 0xfe, 0x1a, 0x9a, 0x8b, 0x0b, 0x7c, 0xe4, 0xb2, 0xe0, 0xaf,
-	0x7c, 0xe9, 0xc6, 0x07, 0xbb, 0xfb, 0xc0, 0x5f, 0xd5, 0xf8, 0x3f, 0xff, 0xe6, 0x8f, 0x9f, 0xf3,
-	0x52, 0xef, 0xbd, 0xdd, 0x68, 0xf8, 0x8a, 0x2d, 0xff, 0x85, 0x6f, 0x5d, 0xf8, 0x7c, 0x1a, 0xcd,
-	0xc3, 0x89, 0xd8, 0x7d, 0x45, 0x6a, 0x15, 0xff, 0xb5, 0x83, 0xbf, 0x8f, 0xa3, 0xb9, 0x2e, 0x54,
-	0x82, 0x09, 0x78, 0xee, 0xa7, 0x77, 0xfe, 0x95, 0xee, 0x89, 0xed, 0x1c, 0x86, 0x9f, 0xec, 0xdf,
-	0xb9, 0x3b, 0x2c, 0x0c, 0xef, 0x9d, 0x2b, 0x24, 0x16, 0x2e, 0x66, 0x61, 0xac, 0xba, 0xb6, 0xbc,
-	0xd4, 0xc1, 0xa3, 0xd7, 0x61, 0xaa, 0xf7, 0x9b, 0x72, 0x3e, 0x54, 0x1b, 0x7a, 0x9f, 0x24, 0x61,
-	0xba, 0xdd, 0x7c, 0x60, 0x59, 0x36, 0x1a, 0xba, 0x23, 0x05, 0x01, 0x62, 0x02, 0xd5, 0xa1, 0xd0,
-	0xe7, 0x71, 0x58, 0xea, 0x65, 0x33, 0x8a, 0x17, 0xf2, 0x01, 0xbc, 0xaf, 0x02, 0x89, 0x09, 0xb4,
-	0x1a, 0xf8, 0x35, 0xd7, 0x79, 0xda, 0x1d, 0xeb, 0xb3, 0x2f, 0x8a, 0x12, 0xc6, 0xda, 0xdb, 0xa9,
-	0xe8, 0xce, 0xe2, 0x82, 0x98, 0x40, 0x67, 0x60, 0xa2, 0xdb, 0x8b, 0xed, 0x64, 0x5f, 0x95, 0x0c,
-	0x28, 0xbc, 0xa7, 0xad, 0x75, 0xdd, 0xb1, 0x54, 0x4a, 0xf3, 0x15, 0x78, 0x3a, 0xfe, 0x31, 0x76,
-	0xbc, 0x8f, 0x52, 0x06, 0x8b, 0x53, 0x19, 0xcf, 0x94, 0xbe, 0xb2, 0xee, 0x87, 0xa9, 0x0b, 0x8c,
-	0x53, 0xbb, 0x16, 0x61, 0xea, 0x3d, 0xa0, 0xfa, 0x31, 0x65, 0xb0, 0xa0, 0x4b, 0x3f, 0xf0, 0xbc,
-	0x98, 0x40, 0xaf, 0xc1, 0xb3, 0x3d, 0xdf, 0x45, 0xa7, 0xfb, 0x28, 0x0e, 0xa0, 0x63, 0xf4, 0x7f,
-	0x1a, 0x84, 0x1e, 0xaf, 0x97, 0xb9, 0x3e, 0xda, 0x7d, 0x58, 0x21, 0x17, 0xd3, 0x5a, 0xbb, 0x29,
-	0xfd, 0x29, 0xc8, 0x77, 0x7d, 0x8e, 0xcc, 0xf6, 0xd2, 0xef, 0x47, 0x0a, 0xb1, 0xcd, 0x3e, 0xcd,
-	0x8d, 0xf1, 0x2e, 0xcf, 0x8d, 0x13, 0xbd, 0x74, 0x77, 0x70, 0x42, 0xb6, 0xad, 0xf9, 0x05, 0xc3,
-	0xa8, 0x53, 0xc2, 0x93, 0xbd, 0xfa, 0xfb, 0x53, 0xbd, 0xf4, 0x86, 0xc0, 0x31, 0x0e, 0x97, 0x82,
-	0x45, 0x25, 0xda, 0xa3, 0xf7, 0x2c, 0x2a, 0x11, 0x78, 0x94, 0x7e, 0x4c, 0xc6, 0x04, 0x3a, 0xd0,
-	0xbe, 0x19, 0xe3, 0x47, 0xc7, 0x6c, 0xe0, 0x93, 0x51, 0xf7, 0xf8, 0xfb, 0xe8, 0xbe, 0xee, 0xf1,
-	0x81, 0xa3, 0xe4, 0x09, 0x14, 0xfb, 0x36, 0xae, 0xcf, 0xf5, 0x09, 0x6c, 0x64, 0x45, 0xd4, 0xcc,
-	0x56, 0xc4, 0x47, 0xc1, 0x22, 0x7b, 0xfa, 0x41, 0x0a, 0xbb, 0x30, 0x1e, 0xc8, 0xcd, 0xb6, 0x5c,
-	0x4c, 0x20, 0x2d, 0xd6, 0x4e, 0xa7, 0xe3, 0xbc, 0x1f, 0x3b, 0x6d, 0xb4, 0xd0, 0xfb, 0x6f, 0xa8,
-	0x98, 0x78, 0xe1, 0x95, 0xdb, 0xd7, 0x0b, 0xdc, 0x95, 0xfd, 0x02, 0x77, 0x75, 0xbf, 0xc0, 0xfd,
-	0x6d, 0xbf, 0xc0, 0x7d, 0xe2, 0xc3, 0x0e, 0xc1, 0x5a, 0xcd, 0xc2, 0x5a, 0x49, 0x35, 0xca, 0xad,
-	0xef, 0x79, 0x9b, 0x58, 0x67, 0x89, 0x55, 0xc6, 0xa6, 0x59, 0x76, 0x3f, 0x55, 0x99, 0x94, 0x7d,
-	0x9a, 0xfd, 0xdf, 0x9b, 0x69, 0xba, 0xb1, 0xc5, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x85, 0xa5,
-	0xf1, 0x7d, 0xc4, 0x19, 0x00, 0x00,
+//--------------------------------------------------------------------------------------------
+// authsession.getAuthKeyExpiresAt auth_key_id:long = Int32;
+type TLAuthsessionGetAuthKeyExpiresAt struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (this *ClientSession) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *TLAuthsessionGetAuthKeyExpiresAt) Reset()         { *m = TLAuthsessionGetAuthKeyExpiresAt{} }
+func (m *TLAuthsessionGetAuthKeyExpiresAt) String() string { return proto.CompactTextString(m) }
+func (*TLAuthsessionGetAuthKeyExpiresAt) ProtoMessage()    {}
+func (*TLAuthsessionGetAuthKeyExpiresAt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{25}
+}
+func (m *TLAuthsessionGetAuthKeyExpiresAt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLAuthsessionGetAuthKeyExpiresAt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLAuthsessionGetAuthKeyExpiresAt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&authsession.ClientSession{")
-	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "Ip: "+fmt.Sprintf("%#v", this.Ip)+",\n")
-	s = append(s, "Layer: "+fmt.Sprintf("%#v", this.Layer)+",\n")
-	s = append(s, "ApiId: "+fmt.Sprintf("%#v", this.ApiId)+",\n")
-	s = append(s, "DeviceModel: "+fmt.Sprintf("%#v", this.DeviceModel)+",\n")
-	s = append(s, "SystemVersion: "+fmt.Sprintf("%#v", this.SystemVersion)+",\n")
-	s = append(s, "AppVersion: "+fmt.Sprintf("%#v", this.AppVersion)+",\n")
-	s = append(s, "SystemLangCode: "+fmt.Sprintf("%#v", this.SystemLangCode)+",\n")
-	s = append(s, "LangPack: "+fmt.Sprintf("%#v", this.LangPack)+",\n")
-	s = append(s, "LangCode: "+fmt.Sprintf("%#v", this.LangCode)+",\n")
-	s = append(s, "Proxy: "+fmt.Sprintf("%#v", this.Proxy)+",\n")
-	s = append(s, "Params: "+fmt.Sprintf("%#v", this.Params)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLAuthsessionGetAuthKeyExpiresAt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLAuthsessionGetAuthKeyExpiresAt.Merge(m, src)
+}
+func (m *TLAuthsessionGetAuthKeyExpiresAt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLAuthsessionGetAuthKeyExpiresAt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLAuthsessionGetAuthKeyExpiresAt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLAuthsessionGetAuthKeyExpiresAt proto.InternalMessageInfo
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLClientSession) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&authsession.TLClientSession{")
-	if this.Data2 != nil {
-		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// authsession.registerDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes other_uids:Vector<long> = Bool;
+type TLAuthsessionRegisterDevice struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
+	NoMuted              bool          `protobuf:"varint,3,opt,name=no_muted,json=noMuted,proto3" json:"no_muted,omitempty"`
+	AppSandbox           bool          `protobuf:"varint,4,opt,name=app_sandbox,json=appSandbox,proto3" json:"app_sandbox,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,5,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	UserId               int64         `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenType            int32         `protobuf:"varint,7,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	Token                string        `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+	Secret               []byte        `protobuf:"bytes,9,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtherUids            []int64       `protobuf:"varint,10,rep,packed,name=other_uids,json=otherUids,proto3" json:"other_uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLAuthsessionRegisterDevice) Reset()         { *m = TLAuthsessionRegisterDevice{} }
+func (m *TLAuthsessionRegisterDevice) String() string { return proto.CompactTextString(m) }
+func (*TLAuthsessionRegisterDevice) ProtoMessage()    {}
+func (*TLAuthsessionRegisterDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{26}
+}
+func (m *TLAuthsessionRegisterDevice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLAuthsessionRegisterDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLAuthsessionRegisterDevice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLAuthsessionRegisterDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLAuthsessionRegisterDevice.Merge(m, src)
+}
+func (m *TLAuthsessionRegisterDevice) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLAuthsessionRegisterDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLAuthsessionRegisterDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLAuthsessionRegisterDevice proto.InternalMessageInfo
+
+func (m *TLAuthsessionRegisterDevice) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *AuthKeyStateData) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLAuthsessionRegisterDevice) GetNoMuted() bool {
+	if m != nil {
+		return m.NoMuted
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&authsession.AuthKeyStateData{")
-	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "KeyState: "+fmt.Sprintf("%#v", this.KeyState)+",\n")
-	s = append(s, "Layer: "+fmt.Sprintf("%#v", this.Layer)+",\n")
-	s = append(s, "ClientType: "+fmt.Sprintf("%#v", this.ClientType)+",\n")
-	s = append(s, "AndroidPushSessionId: "+fmt.Sprintf("%#v", this.AndroidPushSessionId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return false
+}
+
+func (m *TLAuthsessionRegisterDevice) GetAppSandbox() bool {
+	if m != nil {
+		return m.AppSandbox
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return false
 }
-func (this *TLAuthKeyStateData) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLAuthsessionRegisterDevice) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&authsession.TLAuthKeyStateData{")
-	if this.Data2 != nil {
-		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	return 0
+}
+
+func (m *TLAuthsessionRegisterDevice) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLAuthsessionRegisterDevice) GetTokenType() int32 {
+	if m != nil {
+		return m.TokenType
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLAuthsessionGetAuthorizations) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLAuthsessionRegisterDevice) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&authsession.TLAuthsessionGetAuthorizations{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "ExcludeAuthKeyId: "+fmt.Sprintf("%#v", this.ExcludeAuthKeyId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *TLAuthsessionResetAuthorization) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&authsession.TLAuthsessionResetAuthorization{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+func (m *TLAuthsessionRegisterDevice) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *TLAuthsessionGetLayer) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionGetLayer{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+func (m *TLAuthsessionRegisterDevice) GetOtherUids() []int64 {
+	if m != nil {
+		return m.OtherUids
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *TLAuthsessionGetLangPack) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionGetLangPack{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+//--------------------------------------------------------------------------------------------
+// authsession.unregisterDevice auth_key_id:long user_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+type TLAuthsessionUnregisterDevice struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenType            int32         `protobuf:"varint,5,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	Token                string        `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	OtherUids            []int64       `protobuf:"varint,7,rep,packed,name=other_uids,json=otherUids,proto3" json:"other_uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLAuthsessionUnregisterDevice) Reset()         { *m = TLAuthsessionUnregisterDevice{} }
+func (m *TLAuthsessionUnregisterDevice) String() string { return proto.CompactTextString(m) }
+func (*TLAuthsessionUnregisterDevice) ProtoMessage()    {}
+func (*TLAuthsessionUnregisterDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{27}
+}
+func (m *TLAuthsessionUnregisterDevice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLAuthsessionUnregisterDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLAuthsessionUnregisterDevice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *TLAuthsessionGetClient) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *TLAuthsessionUnregisterDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLAuthsessionUnregisterDevice.Merge(m, src)
+}
+func (m *TLAuthsessionUnregisterDevice) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLAuthsessionUnregisterDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLAuthsessionUnregisterDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLAuthsessionUnregisterDevice proto.InternalMessageInfo
+
+func (m *TLAuthsessionUnregisterDevice) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionGetClient{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return CRC32_UNKNOWN
+}
+
+func (m *TLAuthsessionUnregisterDevice) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLAuthsessionGetLangCode) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLAuthsessionUnregisterDevice) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionGetLangCode{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLAuthsessionUnregisterDevice) GetTokenType() int32 {
+	if m != nil {
+		return m.TokenType
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLAuthsessionGetUserId) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLAuthsessionUnregisterDevice) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionGetUserId{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return ""
+}
+
+func (m *TLAuthsessionUnregisterDevice) GetOtherUids() []int64 {
+	if m != nil {
+		return m.OtherUids
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *TLAuthsessionGetPushSessionId) GoString() string {
-	if this == nil {
-		return "nil"
+
+//--------------------------------------------------------------------------------------------
+// authsession.updateDeviceLocked auth_key_id:long user_id:long period:int = Bool;
+type TLAuthsessionUpdateDeviceLocked struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period               int32         `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLAuthsessionUpdateDeviceLocked) Reset()         { *m = TLAuthsessionUpdateDeviceLocked{} }
+func (m *TLAuthsessionUpdateDeviceLocked) String() string { return proto.CompactTextString(m) }
+func (*TLAuthsessionUpdateDeviceLocked) ProtoMessage()    {}
+func (*TLAuthsessionUpdateDeviceLocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{28}
+}
+func (m *TLAuthsessionUpdateDeviceLocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLAuthsessionUpdateDeviceLocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLAuthsessionUpdateDeviceLocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&authsession.TLAuthsessionGetPushSessionId{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLAuthsessionUpdateDeviceLocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLAuthsessionUpdateDeviceLocked.Merge(m, src)
+}
+func (m *TLAuthsessionUpdateDeviceLocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLAuthsessionUpdateDeviceLocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLAuthsessionUpdateDeviceLocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLAuthsessionUpdateDeviceLocked proto.InternalMessageInfo
+
+func (m *TLAuthsessionUpdateDeviceLocked) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLAuthsessionGetFutureSalts) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLAuthsessionUpdateDeviceLocked) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&authsession.TLAuthsessionGetFutureSalts{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "Num: "+fmt.Sprintf("%#v", this.Num)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLAuthsessionUpdateDeviceLocked) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLAuthsessionQueryAuthKey) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLAuthsessionUpdateDeviceLocked) GetPeriod() int32 {
+	if m != nil {
+		return m.Period
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionQueryAuthKey{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// authsession.getUserPushDevices user_id:long = Vector<PushDevice>;
+type TLAuthsessionGetUserPushDevices struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLAuthsessionGetUserPushDevices) Reset()         { *m = TLAuthsessionGetUserPushDevices{} }
+func (m *TLAuthsessionGetUserPushDevices) String() string { return proto.CompactTextString(m) }
+func (*TLAuthsessionGetUserPushDevices) ProtoMessage()    {}
+func (*TLAuthsessionGetUserPushDevices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{29}
+}
+func (m *TLAuthsessionGetUserPushDevices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLAuthsessionGetUserPushDevices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLAuthsessionGetUserPushDevices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *TLAuthsessionSetAuthKey) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *TLAuthsessionGetUserPushDevices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLAuthsessionGetUserPushDevices.Merge(m, src)
+}
+func (m *TLAuthsessionGetUserPushDevices) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLAuthsessionGetUserPushDevices) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLAuthsessionGetUserPushDevices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLAuthsessionGetUserPushDevices proto.InternalMessageInfo
+
+func (m *TLAuthsessionGetUserPushDevices) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&authsession.TLAuthsessionSetAuthKey{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	if this.AuthKey != nil {
-		s = append(s, "AuthKey: "+fmt.Sprintf("%#v", this.AuthKey)+",\n")
+	return CRC32_UNKNOWN
+}
+
+func (m *TLAuthsessionGetUserPushDevices) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	if this.FutureSalt != nil {
-		s = append(s, "FutureSalt: "+fmt.Sprintf("%#v", this.FutureSalt)+",\n")
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_Long struct {
+	Datas                []int64  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vector_Long) Reset()         { *m = Vector_Long{} }
+func (m *Vector_Long) String() string { return proto.CompactTextString(m) }
+func (*Vector_Long) ProtoMessage()    {}
+func (*Vector_Long) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{30}
+}
+func (m *Vector_Long) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_Long) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_Long.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "ExpiresIn: "+fmt.Sprintf("%#v", this.ExpiresIn)+",\n")
+}
+func (m *Vector_Long) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_Long.Merge(m, src)
+}
+func (m *Vector_Long) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_Long) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_Long.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_Long proto.InternalMessageInfo
+
+func (m *Vector_Long) GetDatas() []int64 {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type Vector_PushDevice struct {
+	Datas                []*PushDevice `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Vector_PushDevice) Reset()         { *m = Vector_PushDevice{} }
+func (m *Vector_PushDevice) String() string { return proto.CompactTextString(m) }
+func (*Vector_PushDevice) ProtoMessage()    {}
+func (*Vector_PushDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{31}
+}
+func (m *Vector_PushDevice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_PushDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_PushDevice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_PushDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_PushDevice.Merge(m, src)
+}
+func (m *Vector_PushDevice) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_PushDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_PushDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_PushDevice proto.InternalMessageInfo
+
+func (m *Vector_PushDevice) GetDatas() []*PushDevice {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("authsession.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*AuthKeyStateData)(nil), "authsession.AuthKeyStateData")
+	proto.RegisterType((*TLAuthKeyStateData)(nil), "authsession.TL_authKeyStateData")
+	proto.RegisterType((*ClientSession)(nil), "authsession.ClientSession")
+	proto.RegisterType((*TLClientSession)(nil), "authsession.TL_clientSession")
+	proto.RegisterType((*PushDevice)(nil), "authsession.PushDevice")
+	proto.RegisterType((*TLPushDevice)(nil), "authsession.TL_pushDevice")
+	proto.RegisterType((*TLAuthsessionGetAuthorizations)(nil), "authsession.TL_authsession_getAuthorizations")
+	proto.RegisterType((*TLAuthsessionResetAuthorization)(nil), "authsession.TL_authsession_resetAuthorization")
+	proto.RegisterType((*TLAuthsessionGetLayer)(nil), "authsession.TL_authsession_getLayer")
+	proto.RegisterType((*TLAuthsessionGetLangPack)(nil), "authsession.TL_authsession_getLangPack")
+	proto.RegisterType((*TLAuthsessionGetClient)(nil), "authsession.TL_authsession_getClient")
+	proto.RegisterType((*TLAuthsessionGetLangCode)(nil), "authsession.TL_authsession_getLangCode")
+	proto.RegisterType((*TLAuthsessionGetUserId)(nil), "authsession.TL_authsession_getUserId")
+	proto.RegisterType((*TLAuthsessionGetPushSessionId)(nil), "authsession.TL_authsession_getPushSessionId")
+	proto.RegisterType((*TLAuthsessionGetFutureSalts)(nil), "authsession.TL_authsession_getFutureSalts")
+	proto.RegisterType((*TLAuthsessionQueryAuthKey)(nil), "authsession.TL_authsession_queryAuthKey")
+	proto.RegisterType((*TLAuthsessionSetAuthKey)(nil), "authsession.TL_authsession_setAuthKey")
+	proto.RegisterType((*TLAuthsessionBindAuthKeyUser)(nil), "authsession.TL_authsession_bindAuthKeyUser")
+	proto.RegisterType((*TLAuthsessionUnbindAuthKeyUser)(nil), "authsession.TL_authsession_unbindAuthKeyUser")
+	proto.RegisterType((*TLAuthsessionGetPermAuthKeyId)(nil), "authsession.TL_authsession_getPermAuthKeyId")
+	proto.RegisterType((*TLAuthsessionBindTempAuthKey)(nil), "authsession.TL_authsession_bindTempAuthKey")
+	proto.RegisterType((*TLAuthsessionSetClientSessionInfo)(nil), "authsession.TL_authsession_setClientSessionInfo")
+	proto.RegisterType((*TLAuthsessionGetAuthorization)(nil), "authsession.TL_authsession_getAuthorization")
+	proto.RegisterType((*TLAuthsessionGetAuthStateData)(nil), "authsession.TL_authsession_getAuthStateData")
+	proto.RegisterType((*TLAuthsessionDropTempAuthKeys)(nil), "authsession.TL_authsession_dropTempAuthKeys")
+	proto.RegisterType((*TLAuthsessionGetAuthKeyExpiresAt)(nil), "authsession.TL_authsession_getAuthKeyExpiresAt")
+	proto.RegisterType((*TLAuthsessionRegisterDevice)(nil), "authsession.TL_authsession_registerDevice")
+	proto.RegisterType((*TLAuthsessionUnregisterDevice)(nil), "authsession.TL_authsession_unregisterDevice")
+	proto.RegisterType((*TLAuthsessionUpdateDeviceLocked)(nil), "authsession.TL_authsession_updateDeviceLocked")
+	proto.RegisterType((*TLAuthsessionGetUserPushDevices)(nil), "authsession.TL_authsession_getUserPushDevices")
+	proto.RegisterType((*Vector_Long)(nil), "authsession.Vector_Long")
+	proto.RegisterType((*Vector_PushDevice)(nil), "authsession.Vector_PushDevice")
+}
+
+func init() { proto.RegisterFile("authsession.tl.proto", fileDescriptor_7cbc1347c4a76ecf) }
+
+var fileDescriptor_7cbc1347c4a76ecf = []byte{
+	// 2249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x6b, 0x6c, 0x1c, 0x57,
+	0x15, 0xf6, 0xd8, 0xde, 0xb5, 0xf7, 0xac, 0xd7, 0x9d, 0xdc, 0x6c, 0xec, 0xcd, 0xc6, 0xde, 0x38,
+	0xe3, 0x3c, 0x5c, 0x27, 0xb6, 0x8b, 0x53, 0xf8, 0x81, 0x10, 0x52, 0xe2, 0x00, 0x5a, 0xe2, 0x18,
+	0xb3, 0x76, 0x5a, 0x41, 0xa1, 0xc3, 0x78, 0xe6, 0x7a, 0x3d, 0xf2, 0xce, 0xa3, 0xf3, 0x48, 0xbd,
+	0xe1, 0x07, 0x52, 0xa8, 0x44, 0x7e, 0x54, 0xaa, 0x10, 0x45, 0x50, 0x09, 0x05, 0x09, 0x87, 0x47,
+	0x05, 0x2d, 0x69, 0xfe, 0x40, 0x80, 0x56, 0x0a, 0xaa, 0xaa, 0x56, 0x3c, 0xd4, 0x4a, 0x55, 0xa5,
+	0x4a, 0xa0, 0x52, 0x57, 0x2a, 0xa8, 0x42, 0x6a, 0x85, 0x90, 0x08, 0x85, 0xc8, 0x68, 0xee, 0x9d,
+	0xdd, 0x79, 0xee, 0x6c, 0x4a, 0xb2, 0x4d, 0xfb, 0x6f, 0xef, 0xbd, 0xdf, 0x9c, 0xf7, 0x3d, 0xf7,
+	0x9c, 0x63, 0x43, 0x5e, 0xb0, 0xad, 0x35, 0x13, 0x9b, 0xa6, 0xac, 0xa9, 0xd3, 0x56, 0x6d, 0x5a,
+	0x37, 0x34, 0x4b, 0x43, 0x59, 0xdf, 0x6e, 0x71, 0xaa, 0x2a, 0x5b, 0x6b, 0xf6, 0xca, 0xb4, 0xa8,
+	0x29, 0x33, 0x55, 0xad, 0xaa, 0xcd, 0x10, 0xcc, 0x8a, 0xbd, 0x4a, 0x56, 0x64, 0x41, 0x7e, 0xd1,
+	0x6f, 0x8b, 0xa5, 0xaa, 0xa6, 0x55, 0x6b, 0xd8, 0x43, 0x3d, 0x68, 0x08, 0xba, 0x8e, 0x0d, 0xd3,
+	0x3d, 0x2f, 0x9a, 0xe2, 0x1a, 0x56, 0x04, 0x87, 0x99, 0xa8, 0x19, 0x98, 0xb7, 0xea, 0x3a, 0x6e,
+	0x9c, 0xed, 0xf6, 0xce, 0x2c, 0x43, 0x50, 0x4d, 0x5d, 0x33, 0x2c, 0xf7, 0x28, 0xef, 0x1d, 0x99,
+	0x75, 0x55, 0xa4, 0xbb, 0xdc, 0xaf, 0xbb, 0x81, 0x3d, 0x66, 0x5b, 0x6b, 0x27, 0x71, 0x7d, 0xc9,
+	0x12, 0x2c, 0x7c, 0x42, 0xb0, 0x04, 0x74, 0x00, 0x06, 0x75, 0x03, 0x4b, 0xb2, 0x28, 0x58, 0x98,
+	0x57, 0x05, 0x05, 0x17, 0x98, 0x31, 0x66, 0x22, 0x53, 0xc9, 0x35, 0x77, 0x17, 0x04, 0x05, 0xa3,
+	0x4f, 0x40, 0x56, 0xd4, 0x54, 0xd3, 0x32, 0x6c, 0xd1, 0xd2, 0x8c, 0x42, 0xf7, 0x18, 0x33, 0x31,
+	0x38, 0x5b, 0x9c, 0xf6, 0x1b, 0x64, 0x79, 0x7e, 0xce, 0x43, 0x54, 0xfc, 0x70, 0x54, 0x02, 0x62,
+	0x24, 0x7e, 0x1d, 0xd7, 0x79, 0x59, 0x2a, 0xf4, 0x8c, 0x31, 0x13, 0x3d, 0x95, 0x8c, 0x40, 0x65,
+	0x29, 0x4b, 0x68, 0x18, 0xfa, 0x6c, 0x13, 0x1b, 0xce, 0x59, 0x2f, 0x39, 0x4b, 0x3b, 0xcb, 0xb2,
+	0x84, 0xf6, 0x40, 0xc6, 0xf9, 0xc6, 0x74, 0xc4, 0x2d, 0xa4, 0xc6, 0x98, 0x89, 0x54, 0xa5, 0x7f,
+	0xdd, 0x15, 0x1f, 0xe5, 0x21, 0x55, 0x13, 0xea, 0xd8, 0x28, 0xa4, 0xc9, 0x01, 0x5d, 0xa0, 0xbd,
+	0x90, 0x15, 0x6b, 0x32, 0x56, 0x2d, 0x62, 0xac, 0x42, 0x1f, 0x39, 0x03, 0xba, 0xb5, 0x5c, 0xd7,
+	0x31, 0xfa, 0x28, 0x0c, 0x0b, 0xaa, 0x64, 0x68, 0xb2, 0xc4, 0xeb, 0xb6, 0xb9, 0xc6, 0xbb, 0xf2,
+	0x3b, 0xcc, 0xfb, 0x09, 0xf3, 0xbc, 0x7b, 0xbc, 0x68, 0x9b, 0x6b, 0x4b, 0xf4, 0xb0, 0x2c, 0x71,
+	0x9f, 0x85, 0x9d, 0xcb, 0xf3, 0xbc, 0x10, 0xb6, 0xdf, 0x51, 0x48, 0x49, 0x82, 0x25, 0xcc, 0x12,
+	0xb3, 0x65, 0x67, 0x47, 0x03, 0x26, 0x09, 0x5b, 0xbb, 0x42, 0xb1, 0xdc, 0x1f, 0x7b, 0x20, 0x37,
+	0x47, 0x24, 0x72, 0xe9, 0x7f, 0x30, 0xdc, 0x30, 0x08, 0xdd, 0xb2, 0x4e, 0x3c, 0x90, 0xa9, 0x74,
+	0xcb, 0xba, 0x67, 0xe0, 0x94, 0xdf, 0xc0, 0xbb, 0x20, 0x2d, 0xe8, 0xb2, 0x43, 0xc0, 0xb5, 0xbb,
+	0xa0, 0xcb, 0x65, 0x09, 0xed, 0x83, 0x01, 0x09, 0x9f, 0x91, 0x45, 0xcc, 0x2b, 0x9a, 0x84, 0x6b,
+	0xc4, 0xf0, 0x99, 0x4a, 0x96, 0xee, 0x9d, 0x72, 0xb6, 0x1c, 0x25, 0xcd, 0xba, 0x69, 0x61, 0x85,
+	0x3f, 0x83, 0x0d, 0x47, 0x58, 0x62, 0xf0, 0x4c, 0x25, 0x47, 0x77, 0xef, 0xa1, 0x9b, 0x8e, 0x07,
+	0x05, 0x5d, 0x6f, 0x62, 0x32, 0x04, 0x03, 0x82, 0xae, 0x37, 0x00, 0x13, 0xc0, 0xba, 0x74, 0x6a,
+	0x82, 0x5a, 0xe5, 0x45, 0x4d, 0xc2, 0x05, 0x20, 0x28, 0x97, 0xfe, 0xbc, 0xa0, 0x56, 0xe7, 0x34,
+	0x09, 0x3b, 0xf1, 0x43, 0x20, 0xba, 0x20, 0xae, 0x17, 0xb2, 0x04, 0xd2, 0xef, 0x6c, 0x2c, 0x0a,
+	0xe2, 0x7a, 0xf3, 0x90, 0x7c, 0x3f, 0xe0, 0x1d, 0x92, 0x2f, 0xf3, 0x90, 0xd2, 0x0d, 0x6d, 0xa3,
+	0x5e, 0xc8, 0x91, 0x03, 0xba, 0x40, 0x43, 0x90, 0xd6, 0x05, 0x43, 0x50, 0xcc, 0xc2, 0x20, 0xd9,
+	0x76, 0x57, 0xdc, 0x09, 0x60, 0x97, 0xe7, 0x79, 0x31, 0xe0, 0xd2, 0xbb, 0x82, 0x91, 0x11, 0xf4,
+	0x52, 0xc0, 0xfb, 0x8d, 0xb0, 0xf8, 0x57, 0x37, 0x80, 0x13, 0x74, 0x27, 0x88, 0xcd, 0xde, 0x9f,
+	0x98, 0xd8, 0x0d, 0xfd, 0xaa, 0xc6, 0x2b, 0xb6, 0x85, 0x69, 0x40, 0xf4, 0x57, 0xfa, 0x54, 0xed,
+	0x94, 0xb3, 0x6c, 0xf8, 0xc1, 0x14, 0x54, 0x69, 0x45, 0xdb, 0x20, 0x71, 0xd1, 0x4f, 0xfc, 0xb0,
+	0x44, 0x77, 0xc2, 0xf1, 0x94, 0x4a, 0xb8, 0xd6, 0xe9, 0xc0, 0xb5, 0x1e, 0x05, 0xb0, 0xb4, 0x75,
+	0xac, 0xfa, 0xaf, 0x68, 0x86, 0xec, 0x90, 0x1b, 0x9a, 0x87, 0x14, 0x59, 0xb8, 0xe1, 0x41, 0x17,
+	0x8e, 0xed, 0x4d, 0x2c, 0x1a, 0xd8, 0x22, 0x11, 0x31, 0x50, 0x71, 0x57, 0x68, 0x1c, 0x72, 0x35,
+	0x4d, 0x5c, 0xc7, 0x12, 0xaf, 0x63, 0x43, 0xd6, 0x24, 0x12, 0x0a, 0xa9, 0xca, 0x00, 0xdd, 0x5c,
+	0x24, 0x7b, 0x0e, 0x47, 0xcd, 0x5a, 0xc3, 0x06, 0x6f, 0xcb, 0x92, 0x59, 0xc8, 0x8e, 0xf5, 0x38,
+	0x92, 0x92, 0x9d, 0xd3, 0xb2, 0x64, 0x72, 0x9f, 0x84, 0xdc, 0xf2, 0x3c, 0xaf, 0x7b, 0xb6, 0x9f,
+	0x0a, 0x3a, 0x6f, 0x38, 0x60, 0x4e, 0xcf, 0x47, 0x0d, 0xcf, 0xfd, 0x98, 0x81, 0x31, 0x37, 0x3b,
+	0xb8, 0x20, 0xbe, 0x8a, 0x2d, 0xe7, 0xfa, 0x6b, 0x86, 0x7c, 0x56, 0xb0, 0x64, 0x4d, 0x35, 0xc3,
+	0x8e, 0x62, 0xde, 0x9b, 0xa3, 0x7c, 0xc6, 0xec, 0x09, 0x18, 0xf3, 0x08, 0x20, 0xbc, 0x21, 0xd6,
+	0x6c, 0x09, 0xf3, 0x0d, 0x6f, 0x94, 0x1b, 0x79, 0x94, 0x75, 0x4f, 0x8e, 0x35, 0x7c, 0xc2, 0x3d,
+	0xc9, 0xc0, 0xbe, 0x90, 0xa4, 0x06, 0x36, 0x43, 0xb2, 0x76, 0x4a, 0xd4, 0x50, 0xc0, 0xf4, 0x86,
+	0x03, 0x06, 0x41, 0xef, 0x9a, 0x60, 0xae, 0xb9, 0x91, 0x44, 0x7e, 0x73, 0x0f, 0xc2, 0x70, 0xd4,
+	0xb2, 0xf3, 0x24, 0x13, 0xdd, 0x9c, 0x94, 0x6d, 0xb2, 0x21, 0x77, 0x16, 0x8a, 0x71, 0x8c, 0xdd,
+	0xe4, 0xd1, 0x59, 0xde, 0x1b, 0x50, 0x88, 0xf2, 0xa6, 0x39, 0xe3, 0x76, 0x69, 0x4d, 0xb2, 0xe2,
+	0x6d, 0xd0, 0xfa, 0x34, 0x0d, 0x9d, 0xce, 0x72, 0xfe, 0x05, 0x03, 0x7b, 0xa3, 0xac, 0x03, 0x05,
+	0xc0, 0xed, 0xba, 0x13, 0xc1, 0x5c, 0x99, 0x0a, 0xe5, 0x4a, 0xee, 0x11, 0x06, 0x46, 0xa3, 0x92,
+	0x7f, 0xda, 0xb6, 0x6c, 0x03, 0x2f, 0x09, 0x35, 0xcb, 0xec, 0xac, 0xe5, 0x10, 0x0b, 0x3d, 0xaa,
+	0xad, 0x10, 0xb1, 0x53, 0x15, 0xe7, 0x27, 0xf7, 0x55, 0xd8, 0x13, 0x12, 0xe8, 0x01, 0x1b, 0x1b,
+	0x75, 0x37, 0x05, 0x75, 0xd8, 0x91, 0xaf, 0x31, 0xb0, 0x3b, 0xc4, 0xdd, 0x4d, 0x6e, 0x37, 0xcf,
+	0x7b, 0x06, 0xfa, 0x1b, 0xbc, 0x09, 0xe3, 0xec, 0x6c, 0x7e, 0x5a, 0xb1, 0x48, 0x6d, 0xdd, 0xa8,
+	0xf4, 0xca, 0xea, 0xaa, 0x56, 0xe9, 0x73, 0xc5, 0x41, 0x77, 0x43, 0x76, 0x95, 0x38, 0x82, 0x37,
+	0x85, 0x9a, 0x45, 0x6c, 0x94, 0x9d, 0xdd, 0xd9, 0xfc, 0xc6, 0x73, 0x52, 0x05, 0x56, 0x9b, 0xbf,
+	0x1d, 0x87, 0xe3, 0x0d, 0x5d, 0x36, 0xb0, 0xc9, 0xcb, 0x6a, 0xc3, 0xe1, 0xee, 0x4e, 0x59, 0xe5,
+	0xbe, 0xc3, 0x40, 0x29, 0xa4, 0xe1, 0x8a, 0xac, 0x4a, 0xae, 0x00, 0xce, 0x6d, 0xe9, 0xb0, 0xc7,
+	0x5b, 0x15, 0xeb, 0xdc, 0x63, 0xd1, 0x47, 0xd0, 0x56, 0x3f, 0x20, 0xb2, 0x7d, 0x2d, 0xf6, 0x7e,
+	0x63, 0x43, 0x69, 0xbe, 0x8c, 0x1d, 0x0e, 0xcc, 0x97, 0xba, 0x63, 0xdd, 0xb6, 0x8c, 0x15, 0xfd,
+	0xd6, 0x44, 0xe7, 0x21, 0x60, 0x75, 0x6c, 0x28, 0x7c, 0x54, 0x8a, 0x9c, 0x1e, 0xd0, 0x33, 0x0f,
+	0x29, 0x55, 0x53, 0x45, 0xec, 0x5a, 0x88, 0x2e, 0xfc, 0x51, 0x27, 0x58, 0xa1, 0xa8, 0x3b, 0x66,
+	0xa1, 0xc3, 0xb0, 0x03, 0xab, 0xa2, 0x51, 0xd7, 0x2d, 0x2c, 0xf1, 0x0a, 0x36, 0x4d, 0xa1, 0x8a,
+	0x49, 0x51, 0x37, 0x50, 0x61, 0x9b, 0x07, 0xa7, 0xe8, 0xbe, 0x23, 0x8a, 0x85, 0x15, 0x3d, 0x20,
+	0x4a, 0x1f, 0x15, 0xc5, 0xf2, 0xf4, 0x2d, 0x4b, 0xe8, 0x20, 0xdc, 0x41, 0x80, 0x91, 0x16, 0x8c,
+	0xe0, 0xbc, 0xd4, 0xbb, 0x0b, 0xd2, 0x8a, 0x59, 0x75, 0x8e, 0x33, 0x54, 0x66, 0xc5, 0xac, 0x96,
+	0x25, 0xee, 0x5b, 0x0c, 0x8c, 0x47, 0x2f, 0x7b, 0xa0, 0xb4, 0x76, 0x2e, 0xe4, 0x4d, 0x1a, 0x76,
+	0x1a, 0x7a, 0x9d, 0x22, 0xcf, 0xbd, 0xf2, 0x49, 0x65, 0x3c, 0xc1, 0xc5, 0x87, 0xda, 0xad, 0x2c,
+	0xaf, 0xda, 0x85, 0x5a, 0x4b, 0x01, 0xbc, 0xae, 0xb5, 0xb3, 0x02, 0x5c, 0x8e, 0xbe, 0xa6, 0x92,
+	0xa1, 0xe9, 0xbe, 0x58, 0x37, 0xdf, 0xaf, 0x60, 0x9f, 0x00, 0xa7, 0x04, 0xc6, 0xba, 0xd5, 0x84,
+	0x9a, 0x85, 0x5e, 0x52, 0xfd, 0x0f, 0xd2, 0xfd, 0x86, 0x40, 0xdc, 0x39, 0x06, 0xb8, 0x78, 0xb3,
+	0x9d, 0xc4, 0xf5, 0x4f, 0x35, 0x2f, 0x42, 0x67, 0x2d, 0xf7, 0x4c, 0x77, 0xe4, 0x35, 0x37, 0x70,
+	0x55, 0x36, 0x2d, 0x6c, 0xb8, 0x8d, 0xc9, 0xcd, 0xf1, 0xff, 0xf0, 0x77, 0x7b, 0xc1, 0x46, 0x0e,
+	0xc2, 0x8d, 0xdc, 0xdf, 0xa3, 0xa1, 0x67, 0xab, 0xb7, 0xd4, 0x84, 0xff, 0xf7, 0x2c, 0x2b, 0xb9,
+	0x90, 0xf3, 0xcc, 0x90, 0xf6, 0x9b, 0x21, 0xa8, 0x6e, 0x5f, 0x58, 0xdd, 0xcb, 0xd1, 0x6e, 0xce,
+	0xd6, 0x25, 0xe7, 0x96, 0x13, 0x55, 0xe7, 0x49, 0x0b, 0x7c, 0xbb, 0x14, 0x76, 0x86, 0x25, 0xb4,
+	0x23, 0xa7, 0xca, 0xba, 0x2b, 0xee, 0x2c, 0xec, 0x8b, 0x2f, 0xf3, 0xbd, 0xbe, 0xba, 0x53, 0xcd,
+	0x32, 0x37, 0x0e, 0xd9, 0x7b, 0xb0, 0x03, 0xe1, 0xe7, 0x35, 0xb5, 0xea, 0x18, 0xdd, 0xc9, 0xd9,
+	0x66, 0x81, 0x21, 0x96, 0xa5, 0x0b, 0xee, 0x38, 0xec, 0x70, 0x41, 0x8b, 0x91, 0x89, 0x00, 0x85,
+	0xb6, 0x9b, 0x08, 0x98, 0x93, 0x4f, 0x65, 0x20, 0x17, 0x10, 0x10, 0xed, 0x80, 0xdc, 0x5c, 0x65,
+	0xee, 0xe8, 0x2c, 0x7f, 0x7a, 0xe1, 0xe4, 0xc2, 0xe7, 0xee, 0x5d, 0x60, 0xbb, 0xd0, 0x38, 0x0c,
+	0xd1, 0xad, 0xf0, 0x58, 0x91, 0xbd, 0xf4, 0xfb, 0xe7, 0x7e, 0x77, 0x7d, 0x7b, 0x7b, 0x7b, 0x9b,
+	0x41, 0x63, 0xb0, 0x93, 0x82, 0x02, 0xe3, 0x25, 0xf6, 0xd2, 0x1b, 0x57, 0x5e, 0xfe, 0x37, 0x45,
+	0x1c, 0x86, 0x71, 0x8f, 0x4c, 0xcb, 0xf9, 0x03, 0xfb, 0xec, 0xc5, 0xf3, 0xdf, 0xe8, 0x41, 0x1f,
+	0x81, 0xfd, 0x51, 0x70, 0x74, 0x04, 0xc0, 0xfe, 0xe8, 0xd5, 0xeb, 0x6f, 0x5d, 0xa3, 0xf4, 0x0f,
+	0x41, 0x31, 0x96, 0x3e, 0xe9, 0xc2, 0xd9, 0xc7, 0x9f, 0xf8, 0xcd, 0xd5, 0x77, 0x29, 0xf0, 0x00,
+	0x8c, 0xb6, 0x00, 0xd2, 0xae, 0x99, 0x7d, 0xf3, 0xdc, 0xdb, 0x7f, 0xe8, 0x46, 0xe3, 0xb0, 0x27,
+	0x16, 0x46, 0x5f, 0x53, 0xf6, 0x99, 0x9f, 0xbe, 0x7e, 0x2e, 0x9d, 0x48, 0xcb, 0xe9, 0x45, 0xd9,
+	0x57, 0xdf, 0x7a, 0xf3, 0xd9, 0x54, 0x4b, 0x5a, 0xb4, 0x6d, 0x64, 0x7f, 0x76, 0xe5, 0xe2, 0xaf,
+	0x52, 0x68, 0x06, 0xb8, 0x58, 0x50, 0xa0, 0xc1, 0x63, 0xaf, 0x6e, 0x9d, 0xbf, 0xf0, 0x1f, 0xaa,
+	0xc8, 0x14, 0x8c, 0xc5, 0x7e, 0xe0, 0xeb, 0xab, 0xd8, 0x6f, 0x5f, 0x7a, 0xfa, 0x39, 0x17, 0x7e,
+	0x10, 0x4a, 0x51, 0xb8, 0xbf, 0xeb, 0x61, 0x7f, 0xf2, 0xce, 0x9f, 0x7e, 0x98, 0x42, 0xfb, 0x61,
+	0x24, 0x8a, 0xf3, 0xfa, 0x13, 0xf6, 0x9b, 0x8f, 0xbd, 0xf8, 0xcf, 0x1e, 0x34, 0x01, 0xfb, 0xa2,
+	0xa8, 0x50, 0x1d, 0xcd, 0x6e, 0x9e, 0xff, 0xe5, 0xfd, 0x68, 0x32, 0xce, 0xf1, 0x91, 0x9a, 0x9b,
+	0x7d, 0xfe, 0xe1, 0x37, 0x3e, 0xde, 0xda, 0x06, 0xfe, 0xf7, 0x92, 0x7d, 0xe5, 0x85, 0x97, 0xbe,
+	0xee, 0x46, 0xd5, 0x9d, 0xad, 0xc4, 0xf0, 0xbd, 0xe3, 0xec, 0xcb, 0x97, 0x1e, 0xba, 0xd6, 0x87,
+	0xa6, 0xe0, 0x60, 0xac, 0x5e, 0x91, 0x52, 0x8c, 0x7d, 0x74, 0xfb, 0xaf, 0x43, 0x68, 0xb2, 0x85,
+	0x28, 0xc1, 0x00, 0xdc, 0xbc, 0x7c, 0xed, 0x1f, 0xe9, 0x44, 0xac, 0x77, 0x5d, 0x9e, 0xda, 0xba,
+	0xf6, 0x6e, 0x2f, 0xba, 0x33, 0x0e, 0x1b, 0xae, 0x3c, 0xd8, 0xcd, 0x27, 0x5f, 0x38, 0x84, 0x66,
+	0xe1, 0x40, 0x4b, 0xb2, 0xfe, 0xf7, 0x9e, 0x7d, 0xe8, 0xf1, 0x1f, 0xfc, 0xd6, 0x8d, 0xee, 0x51,
+	0x60, 0xe9, 0x37, 0xde, 0x9c, 0x90, 0xbd, 0x7a, 0xf9, 0xd1, 0x0b, 0xd7, 0x13, 0x62, 0x26, 0xf8,
+	0xf4, 0xb0, 0x7f, 0xbb, 0xf0, 0xf0, 0x95, 0xff, 0x52, 0x78, 0xac, 0x62, 0xe1, 0xb7, 0x8a, 0xfd,
+	0xfe, 0xd3, 0x9b, 0xaf, 0x31, 0xf1, 0x77, 0x36, 0x9a, 0xe8, 0xd9, 0x3f, 0x5f, 0x7c, 0xe5, 0x89,
+	0x6d, 0x4a, 0xfe, 0x08, 0xec, 0x6f, 0x79, 0x2f, 0x7c, 0x79, 0x96, 0xfd, 0xf9, 0xf7, 0xde, 0xfe,
+	0x6e, 0x6f, 0xb1, 0xf7, 0xfc, 0x66, 0xa9, 0x6b, 0xf6, 0x11, 0x04, 0x83, 0x95, 0xc5, 0xb9, 0x63,
+	0xde, 0x17, 0xe8, 0x01, 0x18, 0x4d, 0x1e, 0x6a, 0x4e, 0x85, 0x52, 0x72, 0x72, 0x0e, 0x2a, 0xee,
+	0x6d, 0x76, 0xbf, 0x82, 0x28, 0x6a, 0xb6, 0x6a, 0xf1, 0x41, 0x00, 0xd7, 0x85, 0x6a, 0x50, 0x6a,
+	0x33, 0x9d, 0x9c, 0x4e, 0xe2, 0x19, 0xc5, 0x17, 0x0b, 0x01, 0xbc, 0x2f, 0xff, 0x73, 0x5d, 0x68,
+	0x01, 0xf2, 0x21, 0x89, 0xe9, 0x6c, 0x71, 0x7f, 0x1b, 0xbd, 0x08, 0xaa, 0x38, 0xd8, 0x54, 0xa7,
+	0xac, 0x5a, 0x47, 0x67, 0xb9, 0x2e, 0x74, 0x1a, 0x86, 0x5b, 0x8d, 0x0c, 0x0f, 0xb5, 0x25, 0x49,
+	0x81, 0xc5, 0x3b, 0x9a, 0x54, 0x97, 0x2c, 0x43, 0x26, 0x62, 0x7e, 0x1e, 0x76, 0xc5, 0x4f, 0x03,
+	0x0f, 0xb4, 0x21, 0x4a, 0x61, 0x71, 0x24, 0xe3, 0x25, 0x25, 0x63, 0xbe, 0x1b, 0x91, 0xd4, 0x01,
+	0xc6, 0x91, 0x5d, 0x84, 0x5d, 0x31, 0x21, 0x57, 0x96, 0xda, 0x4a, 0x4a, 0x61, 0x41, 0x93, 0x7e,
+	0xec, 0x6e, 0xae, 0x0b, 0xdd, 0x0f, 0x23, 0x89, 0x83, 0xb9, 0x23, 0x6d, 0x08, 0x07, 0xd0, 0x31,
+	0xf4, 0xbf, 0x02, 0xc5, 0x84, 0xf1, 0xd9, 0x64, 0x1b, 0xea, 0x3e, 0x6c, 0x31, 0x1f, 0x33, 0xdb,
+	0x71, 0x42, 0xfa, 0x4b, 0x50, 0x68, 0x39, 0x0f, 0x9b, 0x48, 0xa2, 0xef, 0x47, 0x16, 0x63, 0xa7,
+	0x4d, 0x24, 0x36, 0x86, 0x5a, 0xcc, 0xbb, 0x0e, 0x26, 0xd1, 0xf6, 0x70, 0xc5, 0x5c, 0x93, 0xf2,
+	0x71, 0x4d, 0xab, 0x11, 0x81, 0xf7, 0x24, 0x0d, 0x98, 0x0e, 0x27, 0xd1, 0x0d, 0x81, 0x63, 0x0c,
+	0xce, 0xc3, 0x68, 0xe2, 0x83, 0x95, 0x9c, 0x54, 0x22, 0xf0, 0xa8, 0xf8, 0x31, 0x11, 0x13, 0xe8,
+	0x0a, 0xdb, 0x46, 0x8c, 0x1f, 0x1d, 0xa3, 0xc0, 0x7d, 0x51, 0xf3, 0xf8, 0x07, 0x39, 0x6d, 0xcd,
+	0xe3, 0x03, 0x47, 0x85, 0xc7, 0x30, 0xd6, 0x76, 0xa2, 0x71, 0x57, 0x1b, 0xc7, 0x46, 0xbe, 0x88,
+	0xb2, 0x59, 0x8d, 0xd8, 0x28, 0x98, 0x64, 0x8f, 0xbc, 0x97, 0xc4, 0x5e, 0x1c, 0x0a, 0xc4, 0x66,
+	0x73, 0x9f, 0xeb, 0x42, 0x0a, 0x8c, 0x24, 0x3d, 0xdd, 0x37, 0xc4, 0xa7, 0x89, 0x2e, 0x26, 0xff,
+	0x7d, 0x9d, 0xeb, 0x42, 0x5f, 0x86, 0x91, 0xa4, 0xd7, 0x3f, 0x99, 0x5d, 0x18, 0x1d, 0xb5, 0x9a,
+	0x04, 0x7b, 0xdb, 0x4d, 0x08, 0x66, 0x6e, 0x40, 0x21, 0xff, 0x07, 0x31, 0x8f, 0xc8, 0xbd, 0xc1,
+	0x8c, 0x14, 0xea, 0x5f, 0x27, 0x93, 0x9f, 0x3f, 0x3f, 0x36, 0xa4, 0x00, 0xba, 0x0f, 0x46, 0x92,
+	0xca, 0x8d, 0x64, 0xeb, 0xd8, 0x6a, 0x32, 0x71, 0x1e, 0x4a, 0xc9, 0xf5, 0x49, 0xf2, 0xc3, 0x1d,
+	0xc5, 0x87, 0x19, 0xe8, 0x50, 0x8a, 0x79, 0x33, 0xfc, 0x5d, 0xe3, 0xf4, 0x0d, 0xbc, 0x31, 0x3e,
+	0x7c, 0xb1, 0x14, 0x57, 0x19, 0x78, 0x80, 0xe3, 0x5f, 0x78, 0xe7, 0xf5, 0x12, 0xf3, 0xfc, 0x56,
+	0x89, 0x79, 0x71, 0xab, 0xc4, 0xfc, 0x65, 0xab, 0xc4, 0x7c, 0xf1, 0x33, 0xbe, 0x7f, 0xf0, 0xb1,
+	0xb0, 0xa0, 0x54, 0x0d, 0xc1, 0xfb, 0x31, 0x65, 0x62, 0xe3, 0x0c, 0x36, 0x66, 0x04, 0x5d, 0x9f,
+	0x71, 0x7e, 0xca, 0x22, 0x9e, 0xf1, 0x31, 0xf0, 0xff, 0x5e, 0x49, 0x13, 0xc5, 0x8e, 0xfe, 0x6f,
+	0x00, 0x7d, 0x10, 0x18, 0x09, 0x55, 0x24, 0x00, 0x00,
+}
+
+func (this *AuthKeyStateData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&authsession.AuthKeyStateData{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "KeyState: "+fmt.Sprintf("%#v", this.KeyState)+",\n")
+	s = append(s, "Layer: "+fmt.Sprintf("%#v", this.Layer)+",\n")
+	s = append(s, "ClientType: "+fmt.Sprintf("%#v", this.ClientType)+",\n")
+	s = append(s, "AndroidPushSessionId: "+fmt.Sprintf("%#v", this.AndroidPushSessionId)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLAuthsessionBindAuthKeyUser) GoString() string {
+func (this *TLAuthKeyStateData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&authsession.TLAuthsessionBindAuthKeyUser{")
+	s := make([]string, 0, 5)
+	s = append(s, "&authsession.TLAuthKeyStateData{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClientSession) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&authsession.ClientSession{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Ip: "+fmt.Sprintf("%#v", this.Ip)+",\n")
+	s = append(s, "Layer: "+fmt.Sprintf("%#v", this.Layer)+",\n")
+	s = append(s, "ApiId: "+fmt.Sprintf("%#v", this.ApiId)+",\n")
+	s = append(s, "DeviceModel: "+fmt.Sprintf("%#v", this.DeviceModel)+",\n")
+	s = append(s, "SystemVersion: "+fmt.Sprintf("%#v", this.SystemVersion)+",\n")
+	s = append(s, "AppVersion: "+fmt.Sprintf("%#v", this.AppVersion)+",\n")
+	s = append(s, "SystemLangCode: "+fmt.Sprintf("%#v", this.SystemLangCode)+",\n")
+	s = append(s, "LangPack: "+fmt.Sprintf("%#v", this.LangPack)+",\n")
+	s = append(s, "LangCode: "+fmt.Sprintf("%#v", this.LangCode)+",\n")
+	s = append(s, "Proxy: "+fmt.Sprintf("%#v", this.Proxy)+",\n")
+	s = append(s, "Params: "+fmt.Sprintf("%#v", this.Params)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLClientSession) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&authsession.TLClientSession{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PushDevice) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&authsession.PushDevice{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "NoMuted: "+fmt.Sprintf("%#v", this.NoMuted)+",\n")
+	s = append(s, "AppSandbox: "+fmt.Sprintf("%#v", this.AppSandbox)+",\n")
 	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
 	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	s = append(s, "LockedPeriod: "+fmt.Sprintf("%#v", this.LockedPeriod)+",\n")
+	s = append(s, "OtherUids: "+fmt.Sprintf("%#v", this.OtherUids)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLAuthsessionUnbindAuthKeyUser) GoString() string {
+func (this *TLPushDevice) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&authsession.TLPushDevice{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLAuthsessionGetAuthorizations) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 7)
-	s = append(s, "&authsession.TLAuthsessionUnbindAuthKeyUser{")
+	s = append(s, "&authsession.TLAuthsessionGetAuthorizations{")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
 	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ExcludeAuthKeyId: "+fmt.Sprintf("%#v", this.ExcludeAuthKeyId)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLAuthsessionGetPermAuthKeyId) GoString() string {
+func (this *TLAuthsessionResetAuthorization) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionGetPermAuthKeyId{")
+	s := make([]string, 0, 8)
+	s = append(s, "&authsession.TLAuthsessionResetAuthorization{")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
 	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLAuthsessionBindTempAuthKey) GoString() string {
+func (this *TLAuthsessionGetLayer) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&authsession.TLAuthsessionBindTempAuthKey{")
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionGetLayer{")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "PermAuthKeyId: "+fmt.Sprintf("%#v", this.PermAuthKeyId)+",\n")
-	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
-	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
-	s = append(s, "EncryptedMessage: "+fmt.Sprintf("%#v", this.EncryptedMessage)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLAuthsessionSetClientSessionInfo) GoString() string {
+func (this *TLAuthsessionGetLangPack) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionSetClientSessionInfo{")
+	s = append(s, "&authsession.TLAuthsessionGetLangPack{")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	if this.Data != nil {
-		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	}
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLAuthsessionGetAuthorization) GoString() string {
+func (this *TLAuthsessionGetClient) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionGetAuthorization{")
+	s = append(s, "&authsession.TLAuthsessionGetClient{")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
 	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
 	if this.XXX_unrecognized != nil {
@@ -2129,12 +2751,12 @@ func (this *TLAuthsessionGetAuthorization) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TLAuthsessionGetAuthStateData) GoString() string {
+func (this *TLAuthsessionGetLangCode) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&authsession.TLAuthsessionGetAuthStateData{")
+	s = append(s, "&authsession.TLAuthsessionGetLangCode{")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
 	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
 	if this.XXX_unrecognized != nil {
@@ -2143,303 +2765,681 @@ func (this *TLAuthsessionGetAuthStateData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *Vector_Long) GoString() string {
+func (this *TLAuthsessionGetUserId) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&authsession.Vector_Long{")
-	s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionGetUserId{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringAuthsessionTl(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *TLAuthsessionGetPushSessionId) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// RPCAuthsessionClient is the client API for RPCAuthsession service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RPCAuthsessionClient interface {
-	// authsession.getAuthorizations user_id:long exclude_auth_keyId:long = account.Authorizations;
-	AuthsessionGetAuthorizations(ctx context.Context, in *TLAuthsessionGetAuthorizations, opts ...grpc.CallOption) (*mtproto.Account_Authorizations, error)
-	// authsession.resetAuthorization user_id:long auth_key_id:long hash:long = Vector<long>;
-	AuthsessionResetAuthorization(ctx context.Context, in *TLAuthsessionResetAuthorization, opts ...grpc.CallOption) (*Vector_Long, error)
-	// authsession.getLayer auth_key_id:long = Int32;
-	AuthsessionGetLayer(ctx context.Context, in *TLAuthsessionGetLayer, opts ...grpc.CallOption) (*mtproto.Int32, error)
-	// authsession.getLangPack auth_key_id:long = String;
-	AuthsessionGetLangPack(ctx context.Context, in *TLAuthsessionGetLangPack, opts ...grpc.CallOption) (*mtproto.String, error)
-	// authsession.getClient auth_key_id:long = String;
-	AuthsessionGetClient(ctx context.Context, in *TLAuthsessionGetClient, opts ...grpc.CallOption) (*mtproto.String, error)
-	// authsession.getLangCode auth_key_id:long = String;
-	AuthsessionGetLangCode(ctx context.Context, in *TLAuthsessionGetLangCode, opts ...grpc.CallOption) (*mtproto.String, error)
-	// authsession.getUserId auth_key_id:long = Int64;
-	AuthsessionGetUserId(ctx context.Context, in *TLAuthsessionGetUserId, opts ...grpc.CallOption) (*mtproto.Int64, error)
-	// authsession.getPushSessionId user_id:long auth_key_id:long token_type:int = Int64;
-	AuthsessionGetPushSessionId(ctx context.Context, in *TLAuthsessionGetPushSessionId, opts ...grpc.CallOption) (*mtproto.Int64, error)
-	// authsession.getFutureSalts auth_key_id:long num:int = FutureSalts;
-	AuthsessionGetFutureSalts(ctx context.Context, in *TLAuthsessionGetFutureSalts, opts ...grpc.CallOption) (*mtproto.FutureSalts, error)
-	// authsession.queryAuthKey auth_key_id:long = AuthKeyInfo;
-	AuthsessionQueryAuthKey(ctx context.Context, in *TLAuthsessionQueryAuthKey, opts ...grpc.CallOption) (*mtproto.AuthKeyInfo, error)
-	// authsession.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
-	AuthsessionSetAuthKey(ctx context.Context, in *TLAuthsessionSetAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
-	// authsession.bindAuthKeyUser auth_key_id:long user_id:long = Int64;
-	AuthsessionBindAuthKeyUser(ctx context.Context, in *TLAuthsessionBindAuthKeyUser, opts ...grpc.CallOption) (*mtproto.Int64, error)
-	// authsession.unbindAuthKeyUser auth_key_id:long user_id:long = Bool;
-	AuthsessionUnbindAuthKeyUser(ctx context.Context, in *TLAuthsessionUnbindAuthKeyUser, opts ...grpc.CallOption) (*mtproto.Bool, error)
-	// authsession.getPermAuthKeyId auth_key_id:long= Int64;
-	AuthsessionGetPermAuthKeyId(ctx context.Context, in *TLAuthsessionGetPermAuthKeyId, opts ...grpc.CallOption) (*mtproto.Int64, error)
-	// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
-	AuthsessionBindTempAuthKey(ctx context.Context, in *TLAuthsessionBindTempAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
-	// authsession.setClientSessionInfo data:ClientSession = Bool;
-	AuthsessionSetClientSessionInfo(ctx context.Context, in *TLAuthsessionSetClientSessionInfo, opts ...grpc.CallOption) (*mtproto.Bool, error)
-	// authsession.getAuthorization auth_key_id:long = Authorization;
-	AuthsessionGetAuthorization(ctx context.Context, in *TLAuthsessionGetAuthorization, opts ...grpc.CallOption) (*mtproto.Authorization, error)
-	// authsession.getAuthStateData auth_key_id:long = AuthKeyStateData;
-	AuthsessionGetAuthStateData(ctx context.Context, in *TLAuthsessionGetAuthStateData, opts ...grpc.CallOption) (*AuthKeyStateData, error)
-}
-
-type rPCAuthsessionClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewRPCAuthsessionClient(cc *grpc.ClientConn) RPCAuthsessionClient {
-	return &rPCAuthsessionClient{cc}
-}
-
-func (c *rPCAuthsessionClient) AuthsessionGetAuthorizations(ctx context.Context, in *TLAuthsessionGetAuthorizations, opts ...grpc.CallOption) (*mtproto.Account_Authorizations, error) {
-	out := new(mtproto.Account_Authorizations)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getAuthorizations", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 8)
+	s = append(s, "&authsession.TLAuthsessionGetPushSessionId{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return out, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionResetAuthorization(ctx context.Context, in *TLAuthsessionResetAuthorization, opts ...grpc.CallOption) (*Vector_Long, error) {
-	out := new(Vector_Long)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_resetAuthorization", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionGetFutureSalts) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
-}
-
-func (c *rPCAuthsessionClient) AuthsessionGetLayer(ctx context.Context, in *TLAuthsessionGetLayer, opts ...grpc.CallOption) (*mtproto.Int32, error) {
-	out := new(mtproto.Int32)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getLayer", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 7)
+	s = append(s, "&authsession.TLAuthsessionGetFutureSalts{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Num: "+fmt.Sprintf("%#v", this.Num)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return out, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionGetLangPack(ctx context.Context, in *TLAuthsessionGetLangPack, opts ...grpc.CallOption) (*mtproto.String, error) {
-	out := new(mtproto.String)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getLangPack", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionQueryAuthKey) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
-}
-
-func (c *rPCAuthsessionClient) AuthsessionGetClient(ctx context.Context, in *TLAuthsessionGetClient, opts ...grpc.CallOption) (*mtproto.String, error) {
-	out := new(mtproto.String)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionQueryAuthKey{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return out, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionGetLangCode(ctx context.Context, in *TLAuthsessionGetLangCode, opts ...grpc.CallOption) (*mtproto.String, error) {
-	out := new(mtproto.String)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getLangCode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionSetAuthKey) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
-}
-
-func (c *rPCAuthsessionClient) AuthsessionGetUserId(ctx context.Context, in *TLAuthsessionGetUserId, opts ...grpc.CallOption) (*mtproto.Int64, error) {
-	out := new(mtproto.Int64)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getUserId", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 8)
+	s = append(s, "&authsession.TLAuthsessionSetAuthKey{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.AuthKey != nil {
+		s = append(s, "AuthKey: "+fmt.Sprintf("%#v", this.AuthKey)+",\n")
 	}
-	return out, nil
-}
-
-func (c *rPCAuthsessionClient) AuthsessionGetPushSessionId(ctx context.Context, in *TLAuthsessionGetPushSessionId, opts ...grpc.CallOption) (*mtproto.Int64, error) {
-	out := new(mtproto.Int64)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getPushSessionId", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.FutureSalt != nil {
+		s = append(s, "FutureSalt: "+fmt.Sprintf("%#v", this.FutureSalt)+",\n")
 	}
-	return out, nil
-}
-
-func (c *rPCAuthsessionClient) AuthsessionGetFutureSalts(ctx context.Context, in *TLAuthsessionGetFutureSalts, opts ...grpc.CallOption) (*mtproto.FutureSalts, error) {
-	out := new(mtproto.FutureSalts)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getFutureSalts", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s = append(s, "ExpiresIn: "+fmt.Sprintf("%#v", this.ExpiresIn)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return out, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionQueryAuthKey(ctx context.Context, in *TLAuthsessionQueryAuthKey, opts ...grpc.CallOption) (*mtproto.AuthKeyInfo, error) {
-	out := new(mtproto.AuthKeyInfo)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_queryAuthKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionBindAuthKeyUser) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
-}
-
-func (c *rPCAuthsessionClient) AuthsessionSetAuthKey(ctx context.Context, in *TLAuthsessionSetAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error) {
-	out := new(mtproto.Bool)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_setAuthKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 7)
+	s = append(s, "&authsession.TLAuthsessionBindAuthKeyUser{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return out, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionBindAuthKeyUser(ctx context.Context, in *TLAuthsessionBindAuthKeyUser, opts ...grpc.CallOption) (*mtproto.Int64, error) {
-	out := new(mtproto.Int64)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_bindAuthKeyUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionUnbindAuthKeyUser) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
-}
-
-func (c *rPCAuthsessionClient) AuthsessionUnbindAuthKeyUser(ctx context.Context, in *TLAuthsessionUnbindAuthKeyUser, opts ...grpc.CallOption) (*mtproto.Bool, error) {
-	out := new(mtproto.Bool)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_unbindAuthKeyUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 7)
+	s = append(s, "&authsession.TLAuthsessionUnbindAuthKeyUser{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return out, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionGetPermAuthKeyId(ctx context.Context, in *TLAuthsessionGetPermAuthKeyId, opts ...grpc.CallOption) (*mtproto.Int64, error) {
-	out := new(mtproto.Int64)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getPermAuthKeyId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionGetPermAuthKeyId) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
-}
-
-func (c *rPCAuthsessionClient) AuthsessionBindTempAuthKey(ctx context.Context, in *TLAuthsessionBindTempAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error) {
-	out := new(mtproto.Bool)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_bindTempAuthKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionGetPermAuthKeyId{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return out, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionSetClientSessionInfo(ctx context.Context, in *TLAuthsessionSetClientSessionInfo, opts ...grpc.CallOption) (*mtproto.Bool, error) {
-	out := new(mtproto.Bool)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_setClientSessionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionBindTempAuthKey) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
+	s := make([]string, 0, 12)
+	s = append(s, "&authsession.TLAuthsessionBindTempAuthKey{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "PermAuthKeyId: "+fmt.Sprintf("%#v", this.PermAuthKeyId)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "EncryptedMessage: "+fmt.Sprintf("%#v", this.EncryptedMessage)+",\n")
+	s = append(s, "TempAuthKeyId: "+fmt.Sprintf("%#v", this.TempAuthKeyId)+",\n")
+	s = append(s, "TempSessionId: "+fmt.Sprintf("%#v", this.TempSessionId)+",\n")
+	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionGetAuthorization(ctx context.Context, in *TLAuthsessionGetAuthorization, opts ...grpc.CallOption) (*mtproto.Authorization, error) {
-	out := new(mtproto.Authorization)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getAuthorization", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionSetClientSessionInfo) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionSetClientSessionInfo{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.Data != nil {
+		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *rPCAuthsessionClient) AuthsessionGetAuthStateData(ctx context.Context, in *TLAuthsessionGetAuthStateData, opts ...grpc.CallOption) (*AuthKeyStateData, error) {
-	out := new(AuthKeyStateData)
-	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getAuthStateData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *TLAuthsessionGetAuthorization) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionGetAuthorization{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLAuthsessionGetAuthStateData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionGetAuthStateData{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLAuthsessionDropTempAuthKeys) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&authsession.TLAuthsessionDropTempAuthKeys{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "PermAuthKeyId: "+fmt.Sprintf("%#v", this.PermAuthKeyId)+",\n")
+	s = append(s, "ExceptAuthKeys: "+fmt.Sprintf("%#v", this.ExceptAuthKeys)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLAuthsessionGetAuthKeyExpiresAt) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionGetAuthKeyExpiresAt{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLAuthsessionRegisterDevice) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&authsession.TLAuthsessionRegisterDevice{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "NoMuted: "+fmt.Sprintf("%#v", this.NoMuted)+",\n")
+	s = append(s, "AppSandbox: "+fmt.Sprintf("%#v", this.AppSandbox)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	s = append(s, "OtherUids: "+fmt.Sprintf("%#v", this.OtherUids)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLAuthsessionUnregisterDevice) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&authsession.TLAuthsessionUnregisterDevice{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "OtherUids: "+fmt.Sprintf("%#v", this.OtherUids)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLAuthsessionUpdateDeviceLocked) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&authsession.TLAuthsessionUpdateDeviceLocked{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Period: "+fmt.Sprintf("%#v", this.Period)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLAuthsessionGetUserPushDevices) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&authsession.TLAuthsessionGetUserPushDevices{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_Long) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&authsession.Vector_Long{")
+	s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_PushDevice) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&authsession.Vector_PushDevice{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAuthsessionTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 
-// RPCAuthsessionServer is the server API for RPCAuthsession service.
-type RPCAuthsessionServer interface {
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCAuthsessionClient is the client API for RPCAuthsession service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCAuthsessionClient interface {
 	// authsession.getAuthorizations user_id:long exclude_auth_keyId:long = account.Authorizations;
-	AuthsessionGetAuthorizations(context.Context, *TLAuthsessionGetAuthorizations) (*mtproto.Account_Authorizations, error)
+	AuthsessionGetAuthorizations(ctx context.Context, in *TLAuthsessionGetAuthorizations, opts ...grpc.CallOption) (*mtproto.Account_Authorizations, error)
 	// authsession.resetAuthorization user_id:long auth_key_id:long hash:long = Vector<long>;
-	AuthsessionResetAuthorization(context.Context, *TLAuthsessionResetAuthorization) (*Vector_Long, error)
+	AuthsessionResetAuthorization(ctx context.Context, in *TLAuthsessionResetAuthorization, opts ...grpc.CallOption) (*Vector_Long, error)
 	// authsession.getLayer auth_key_id:long = Int32;
-	AuthsessionGetLayer(context.Context, *TLAuthsessionGetLayer) (*mtproto.Int32, error)
+	AuthsessionGetLayer(ctx context.Context, in *TLAuthsessionGetLayer, opts ...grpc.CallOption) (*mtproto.Int32, error)
 	// authsession.getLangPack auth_key_id:long = String;
-	AuthsessionGetLangPack(context.Context, *TLAuthsessionGetLangPack) (*mtproto.String, error)
+	AuthsessionGetLangPack(ctx context.Context, in *TLAuthsessionGetLangPack, opts ...grpc.CallOption) (*mtproto.String, error)
 	// authsession.getClient auth_key_id:long = String;
-	AuthsessionGetClient(context.Context, *TLAuthsessionGetClient) (*mtproto.String, error)
+	AuthsessionGetClient(ctx context.Context, in *TLAuthsessionGetClient, opts ...grpc.CallOption) (*mtproto.String, error)
 	// authsession.getLangCode auth_key_id:long = String;
-	AuthsessionGetLangCode(context.Context, *TLAuthsessionGetLangCode) (*mtproto.String, error)
+	AuthsessionGetLangCode(ctx context.Context, in *TLAuthsessionGetLangCode, opts ...grpc.CallOption) (*mtproto.String, error)
 	// authsession.getUserId auth_key_id:long = Int64;
-	AuthsessionGetUserId(context.Context, *TLAuthsessionGetUserId) (*mtproto.Int64, error)
+	AuthsessionGetUserId(ctx context.Context, in *TLAuthsessionGetUserId, opts ...grpc.CallOption) (*mtproto.Int64, error)
 	// authsession.getPushSessionId user_id:long auth_key_id:long token_type:int = Int64;
-	AuthsessionGetPushSessionId(context.Context, *TLAuthsessionGetPushSessionId) (*mtproto.Int64, error)
+	AuthsessionGetPushSessionId(ctx context.Context, in *TLAuthsessionGetPushSessionId, opts ...grpc.CallOption) (*mtproto.Int64, error)
 	// authsession.getFutureSalts auth_key_id:long num:int = FutureSalts;
-	AuthsessionGetFutureSalts(context.Context, *TLAuthsessionGetFutureSalts) (*mtproto.FutureSalts, error)
+	AuthsessionGetFutureSalts(ctx context.Context, in *TLAuthsessionGetFutureSalts, opts ...grpc.CallOption) (*mtproto.FutureSalts, error)
 	// authsession.queryAuthKey auth_key_id:long = AuthKeyInfo;
-	AuthsessionQueryAuthKey(context.Context, *TLAuthsessionQueryAuthKey) (*mtproto.AuthKeyInfo, error)
+	AuthsessionQueryAuthKey(ctx context.Context, in *TLAuthsessionQueryAuthKey, opts ...grpc.CallOption) (*mtproto.AuthKeyInfo, error)
 	// authsession.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
-	AuthsessionSetAuthKey(context.Context, *TLAuthsessionSetAuthKey) (*mtproto.Bool, error)
+	AuthsessionSetAuthKey(ctx context.Context, in *TLAuthsessionSetAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
 	// authsession.bindAuthKeyUser auth_key_id:long user_id:long = Int64;
-	AuthsessionBindAuthKeyUser(context.Context, *TLAuthsessionBindAuthKeyUser) (*mtproto.Int64, error)
+	AuthsessionBindAuthKeyUser(ctx context.Context, in *TLAuthsessionBindAuthKeyUser, opts ...grpc.CallOption) (*mtproto.Int64, error)
 	// authsession.unbindAuthKeyUser auth_key_id:long user_id:long = Bool;
-	AuthsessionUnbindAuthKeyUser(context.Context, *TLAuthsessionUnbindAuthKeyUser) (*mtproto.Bool, error)
-	// authsession.getPermAuthKeyId auth_key_id:long= Int64;
-	AuthsessionGetPermAuthKeyId(context.Context, *TLAuthsessionGetPermAuthKeyId) (*mtproto.Int64, error)
-	// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
-	AuthsessionBindTempAuthKey(context.Context, *TLAuthsessionBindTempAuthKey) (*mtproto.Bool, error)
+	AuthsessionUnbindAuthKeyUser(ctx context.Context, in *TLAuthsessionUnbindAuthKeyUser, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// authsession.getPermAuthKeyId auth_key_id:long = Int64;
+	AuthsessionGetPermAuthKeyId(ctx context.Context, in *TLAuthsessionGetPermAuthKeyId, opts ...grpc.CallOption) (*mtproto.Int64, error)
+	// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes temp_auth_key_id:long temp_session_id:long msg_id:long = Bool;
+	AuthsessionBindTempAuthKey(ctx context.Context, in *TLAuthsessionBindTempAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
 	// authsession.setClientSessionInfo data:ClientSession = Bool;
-	AuthsessionSetClientSessionInfo(context.Context, *TLAuthsessionSetClientSessionInfo) (*mtproto.Bool, error)
+	AuthsessionSetClientSessionInfo(ctx context.Context, in *TLAuthsessionSetClientSessionInfo, opts ...grpc.CallOption) (*mtproto.Bool, error)
 	// authsession.getAuthorization auth_key_id:long = Authorization;
-	AuthsessionGetAuthorization(context.Context, *TLAuthsessionGetAuthorization) (*mtproto.Authorization, error)
+	AuthsessionGetAuthorization(ctx context.Context, in *TLAuthsessionGetAuthorization, opts ...grpc.CallOption) (*mtproto.Authorization, error)
 	// authsession.getAuthStateData auth_key_id:long = AuthKeyStateData;
-	AuthsessionGetAuthStateData(context.Context, *TLAuthsessionGetAuthStateData) (*AuthKeyStateData, error)
+	AuthsessionGetAuthStateData(ctx context.Context, in *TLAuthsessionGetAuthStateData, opts ...grpc.CallOption) (*AuthKeyStateData, error)
+	// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Bool;
+	AuthsessionDropTempAuthKeys(ctx context.Context, in *TLAuthsessionDropTempAuthKeys, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// authsession.getAuthKeyExpiresAt auth_key_id:long = Int32;
+	AuthsessionGetAuthKeyExpiresAt(ctx context.Context, in *TLAuthsessionGetAuthKeyExpiresAt, opts ...grpc.CallOption) (*mtproto.Int32, error)
+	// authsession.registerDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes other_uids:Vector<long> = Bool;
+	AuthsessionRegisterDevice(ctx context.Context, in *TLAuthsessionRegisterDevice, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// authsession.unregisterDevice auth_key_id:long user_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+	AuthsessionUnregisterDevice(ctx context.Context, in *TLAuthsessionUnregisterDevice, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// authsession.updateDeviceLocked auth_key_id:long user_id:long period:int = Bool;
+	AuthsessionUpdateDeviceLocked(ctx context.Context, in *TLAuthsessionUpdateDeviceLocked, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// authsession.getUserPushDevices user_id:long = Vector<PushDevice>;
+	AuthsessionGetUserPushDevices(ctx context.Context, in *TLAuthsessionGetUserPushDevices, opts ...grpc.CallOption) (*Vector_PushDevice, error)
 }
 
-// UnimplementedRPCAuthsessionServer can be embedded to have forward compatible implementations.
-type UnimplementedRPCAuthsessionServer struct {
+type rPCAuthsessionClient struct {
+	cc *grpc.ClientConn
 }
 
-func (*UnimplementedRPCAuthsessionServer) AuthsessionGetAuthorizations(ctx context.Context, req *TLAuthsessionGetAuthorizations) (*mtproto.Account_Authorizations, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetAuthorizations not implemented")
-}
-func (*UnimplementedRPCAuthsessionServer) AuthsessionResetAuthorization(ctx context.Context, req *TLAuthsessionResetAuthorization) (*Vector_Long, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionResetAuthorization not implemented")
-}
-func (*UnimplementedRPCAuthsessionServer) AuthsessionGetLayer(ctx context.Context, req *TLAuthsessionGetLayer) (*mtproto.Int32, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetLayer not implemented")
+func NewRPCAuthsessionClient(cc *grpc.ClientConn) RPCAuthsessionClient {
+	return &rPCAuthsessionClient{cc}
 }
-func (*UnimplementedRPCAuthsessionServer) AuthsessionGetLangPack(ctx context.Context, req *TLAuthsessionGetLangPack) (*mtproto.String, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetLangPack not implemented")
+
+func (c *rPCAuthsessionClient) AuthsessionGetAuthorizations(ctx context.Context, in *TLAuthsessionGetAuthorizations, opts ...grpc.CallOption) (*mtproto.Account_Authorizations, error) {
+	out := new(mtproto.Account_Authorizations)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getAuthorizations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionResetAuthorization(ctx context.Context, in *TLAuthsessionResetAuthorization, opts ...grpc.CallOption) (*Vector_Long, error) {
+	out := new(Vector_Long)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_resetAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetLayer(ctx context.Context, in *TLAuthsessionGetLayer, opts ...grpc.CallOption) (*mtproto.Int32, error) {
+	out := new(mtproto.Int32)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getLayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetLangPack(ctx context.Context, in *TLAuthsessionGetLangPack, opts ...grpc.CallOption) (*mtproto.String, error) {
+	out := new(mtproto.String)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getLangPack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetClient(ctx context.Context, in *TLAuthsessionGetClient, opts ...grpc.CallOption) (*mtproto.String, error) {
+	out := new(mtproto.String)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetLangCode(ctx context.Context, in *TLAuthsessionGetLangCode, opts ...grpc.CallOption) (*mtproto.String, error) {
+	out := new(mtproto.String)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getLangCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetUserId(ctx context.Context, in *TLAuthsessionGetUserId, opts ...grpc.CallOption) (*mtproto.Int64, error) {
+	out := new(mtproto.Int64)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getUserId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetPushSessionId(ctx context.Context, in *TLAuthsessionGetPushSessionId, opts ...grpc.CallOption) (*mtproto.Int64, error) {
+	out := new(mtproto.Int64)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getPushSessionId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetFutureSalts(ctx context.Context, in *TLAuthsessionGetFutureSalts, opts ...grpc.CallOption) (*mtproto.FutureSalts, error) {
+	out := new(mtproto.FutureSalts)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getFutureSalts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionQueryAuthKey(ctx context.Context, in *TLAuthsessionQueryAuthKey, opts ...grpc.CallOption) (*mtproto.AuthKeyInfo, error) {
+	out := new(mtproto.AuthKeyInfo)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_queryAuthKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionSetAuthKey(ctx context.Context, in *TLAuthsessionSetAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_setAuthKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionBindAuthKeyUser(ctx context.Context, in *TLAuthsessionBindAuthKeyUser, opts ...grpc.CallOption) (*mtproto.Int64, error) {
+	out := new(mtproto.Int64)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_bindAuthKeyUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionUnbindAuthKeyUser(ctx context.Context, in *TLAuthsessionUnbindAuthKeyUser, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_unbindAuthKeyUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetPermAuthKeyId(ctx context.Context, in *TLAuthsessionGetPermAuthKeyId, opts ...grpc.CallOption) (*mtproto.Int64, error) {
+	out := new(mtproto.Int64)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getPermAuthKeyId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionBindTempAuthKey(ctx context.Context, in *TLAuthsessionBindTempAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_bindTempAuthKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionSetClientSessionInfo(ctx context.Context, in *TLAuthsessionSetClientSessionInfo, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_setClientSessionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetAuthorization(ctx context.Context, in *TLAuthsessionGetAuthorization, opts ...grpc.CallOption) (*mtproto.Authorization, error) {
+	out := new(mtproto.Authorization)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetAuthStateData(ctx context.Context, in *TLAuthsessionGetAuthStateData, opts ...grpc.CallOption) (*AuthKeyStateData, error) {
+	out := new(AuthKeyStateData)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getAuthStateData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionDropTempAuthKeys(ctx context.Context, in *TLAuthsessionDropTempAuthKeys, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_dropTempAuthKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetAuthKeyExpiresAt(ctx context.Context, in *TLAuthsessionGetAuthKeyExpiresAt, opts ...grpc.CallOption) (*mtproto.Int32, error) {
+	out := new(mtproto.Int32)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getAuthKeyExpiresAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionRegisterDevice(ctx context.Context, in *TLAuthsessionRegisterDevice, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_registerDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionUnregisterDevice(ctx context.Context, in *TLAuthsessionUnregisterDevice, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_unregisterDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionUpdateDeviceLocked(ctx context.Context, in *TLAuthsessionUpdateDeviceLocked, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_updateDeviceLocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCAuthsessionClient) AuthsessionGetUserPushDevices(ctx context.Context, in *TLAuthsessionGetUserPushDevices, opts ...grpc.CallOption) (*Vector_PushDevice, error) {
+	out := new(Vector_PushDevice)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_getUserPushDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCAuthsessionServer is the server API for RPCAuthsession service.
+type RPCAuthsessionServer interface {
+	// authsession.getAuthorizations user_id:long exclude_auth_keyId:long = account.Authorizations;
+	AuthsessionGetAuthorizations(context.Context, *TLAuthsessionGetAuthorizations) (*mtproto.Account_Authorizations, error)
+	// authsession.resetAuthorization user_id:long auth_key_id:long hash:long = Vector<long>;
+	AuthsessionResetAuthorization(context.Context, *TLAuthsessionResetAuthorization) (*Vector_Long, error)
+	// authsession.getLayer auth_key_id:long = Int32;
+	AuthsessionGetLayer(context.Context, *TLAuthsessionGetLayer) (*mtproto.Int32, error)
+	// authsession.getLangPack auth_key_id:long = String;
+	AuthsessionGetLangPack(context.Context, *TLAuthsessionGetLangPack) (*mtproto.String, error)
+	// authsession.getClient auth_key_id:long = String;
+	AuthsessionGetClient(context.Context, *TLAuthsessionGetClient) (*mtproto.String, error)
+	// authsession.getLangCode auth_key_id:long = String;
+	AuthsessionGetLangCode(context.Context, *TLAuthsessionGetLangCode) (*mtproto.String, error)
+	// authsession.getUserId auth_key_id:long = Int64;
+	AuthsessionGetUserId(context.Context, *TLAuthsessionGetUserId) (*mtproto.Int64, error)
+	// authsession.getPushSessionId user_id:long auth_key_id:long token_type:int = Int64;
+	AuthsessionGetPushSessionId(context.Context, *TLAuthsessionGetPushSessionId) (*mtproto.Int64, error)
+	// authsession.getFutureSalts auth_key_id:long num:int = FutureSalts;
+	AuthsessionGetFutureSalts(context.Context, *TLAuthsessionGetFutureSalts) (*mtproto.FutureSalts, error)
+	// authsession.queryAuthKey auth_key_id:long = AuthKeyInfo;
+	AuthsessionQueryAuthKey(context.Context, *TLAuthsessionQueryAuthKey) (*mtproto.AuthKeyInfo, error)
+	// authsession.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
+	AuthsessionSetAuthKey(context.Context, *TLAuthsessionSetAuthKey) (*mtproto.Bool, error)
+	// authsession.bindAuthKeyUser auth_key_id:long user_id:long = Int64;
+	AuthsessionBindAuthKeyUser(context.Context, *TLAuthsessionBindAuthKeyUser) (*mtproto.Int64, error)
+	// authsession.unbindAuthKeyUser auth_key_id:long user_id:long = Bool;
+	AuthsessionUnbindAuthKeyUser(context.Context, *TLAuthsessionUnbindAuthKeyUser) (*mtproto.Bool, error)
+	// authsession.getPermAuthKeyId auth_key_id:long = Int64;
+	AuthsessionGetPermAuthKeyId(context.Context, *TLAuthsessionGetPermAuthKeyId) (*mtproto.Int64, error)
+	// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes temp_auth_key_id:long temp_session_id:long msg_id:long = Bool;
+	AuthsessionBindTempAuthKey(context.Context, *TLAuthsessionBindTempAuthKey) (*mtproto.Bool, error)
+	// authsession.setClientSessionInfo data:ClientSession = Bool;
+	AuthsessionSetClientSessionInfo(context.Context, *TLAuthsessionSetClientSessionInfo) (*mtproto.Bool, error)
+	// authsession.getAuthorization auth_key_id:long = Authorization;
+	AuthsessionGetAuthorization(context.Context, *TLAuthsessionGetAuthorization) (*mtproto.Authorization, error)
+	// authsession.getAuthStateData auth_key_id:long = AuthKeyStateData;
+	AuthsessionGetAuthStateData(context.Context, *TLAuthsessionGetAuthStateData) (*AuthKeyStateData, error)
+	// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Bool;
+	AuthsessionDropTempAuthKeys(context.Context, *TLAuthsessionDropTempAuthKeys) (*mtproto.Bool, error)
+	// authsession.getAuthKeyExpiresAt auth_key_id:long = Int32;
+	AuthsessionGetAuthKeyExpiresAt(context.Context, *TLAuthsessionGetAuthKeyExpiresAt) (*mtproto.Int32, error)
+	// authsession.registerDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes other_uids:Vector<long> = Bool;
+	AuthsessionRegisterDevice(context.Context, *TLAuthsessionRegisterDevice) (*mtproto.Bool, error)
+	// authsession.unregisterDevice auth_key_id:long user_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+	AuthsessionUnregisterDevice(context.Context, *TLAuthsessionUnregisterDevice) (*mtproto.Bool, error)
+	// authsession.updateDeviceLocked auth_key_id:long user_id:long period:int = Bool;
+	AuthsessionUpdateDeviceLocked(context.Context, *TLAuthsessionUpdateDeviceLocked) (*mtproto.Bool, error)
+	// authsession.getUserPushDevices user_id:long = Vector<PushDevice>;
+	AuthsessionGetUserPushDevices(context.Context, *TLAuthsessionGetUserPushDevices) (*Vector_PushDevice, error)
+}
+
+// UnimplementedRPCAuthsessionServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCAuthsessionServer struct {
+}
+
+func (*UnimplementedRPCAuthsessionServer) AuthsessionGetAuthorizations(ctx context.Context, req *TLAuthsessionGetAuthorizations) (*mtproto.Account_Authorizations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetAuthorizations not implemented")
+}
+func (*UnimplementedRPCAuthsessionServer) AuthsessionResetAuthorization(ctx context.Context, req *TLAuthsessionResetAuthorization) (*Vector_Long, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionResetAuthorization not implemented")
+}
+func (*UnimplementedRPCAuthsessionServer) AuthsessionGetLayer(ctx context.Context, req *TLAuthsessionGetLayer) (*mtproto.Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetLayer not implemented")
+}
+func (*UnimplementedRPCAuthsessionServer) AuthsessionGetLangPack(ctx context.Context, req *TLAuthsessionGetLangPack) (*mtproto.String, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetLangPack not implemented")
 }
 func (*UnimplementedRPCAuthsessionServer) AuthsessionGetClient(ctx context.Context, req *TLAuthsessionGetClient) (*mtproto.String, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetClient not implemented")
@@ -2483,6 +3483,24 @@ func (*UnimplementedRPCAuthsessionServer) AuthsessionGetAuthorization(ctx contex
 func (*UnimplementedRPCAuthsessionServer) AuthsessionGetAuthStateData(ctx context.Context, req *TLAuthsessionGetAuthStateData) (*AuthKeyStateData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetAuthStateData not implemented")
 }
+func (*UnimplementedRPCAuthsessionServer) AuthsessionDropTempAuthKeys(ctx context.Context, req *TLAuthsessionDropTempAuthKeys) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionDropTempAuthKeys not implemented")
+}
+func (*UnimplementedRPCAuthsessionServer) AuthsessionGetAuthKeyExpiresAt(ctx context.Context, req *TLAuthsessionGetAuthKeyExpiresAt) (*mtproto.Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetAuthKeyExpiresAt not implemented")
+}
+func (*UnimplementedRPCAuthsessionServer) AuthsessionRegisterDevice(ctx context.Context, req *TLAuthsessionRegisterDevice) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionRegisterDevice not implemented")
+}
+func (*UnimplementedRPCAuthsessionServer) AuthsessionUnregisterDevice(ctx context.Context, req *TLAuthsessionUnregisterDevice) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionUnregisterDevice not implemented")
+}
+func (*UnimplementedRPCAuthsessionServer) AuthsessionUpdateDeviceLocked(ctx context.Context, req *TLAuthsessionUpdateDeviceLocked) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionUpdateDeviceLocked not implemented")
+}
+func (*UnimplementedRPCAuthsessionServer) AuthsessionGetUserPushDevices(ctx context.Context, req *TLAuthsessionGetUserPushDevices) (*Vector_PushDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionGetUserPushDevices not implemented")
+}
 
 func RegisterRPCAuthsessionServer(s *grpc.Server, srv RPCAuthsessionServer) {
 	s.RegisterService(&_RPCAuthsession_serviceDesc, srv)
@@ -2812,7 +3830,115 @@ func _RPCAuthsession_AuthsessionGetAuthStateData_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
-var _RPCAuthsession_serviceDesc = grpc.ServiceDesc{
+func _RPCAuthsession_AuthsessionDropTempAuthKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLAuthsessionDropTempAuthKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCAuthsessionServer).AuthsessionDropTempAuthKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authsession.RPCAuthsession/AuthsessionDropTempAuthKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCAuthsessionServer).AuthsessionDropTempAuthKeys(ctx, req.(*TLAuthsessionDropTempAuthKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCAuthsession_AuthsessionGetAuthKeyExpiresAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLAuthsessionGetAuthKeyExpiresAt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCAuthsessionServer).AuthsessionGetAuthKeyExpiresAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authsession.RPCAuthsession/AuthsessionGetAuthKeyExpiresAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCAuthsessionServer).AuthsessionGetAuthKeyExpiresAt(ctx, req.(*TLAuthsessionGetAuthKeyExpiresAt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCAuthsession_AuthsessionRegisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLAuthsessionRegisterDevice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCAuthsessionServer).AuthsessionRegisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authsession.RPCAuthsession/AuthsessionRegisterDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCAuthsessionServer).AuthsessionRegisterDevice(ctx, req.(*TLAuthsessionRegisterDevice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCAuthsession_AuthsessionUnregisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLAuthsessionUnregisterDevice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCAuthsessionServer).AuthsessionUnregisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authsession.RPCAuthsession/AuthsessionUnregisterDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCAuthsessionServer).AuthsessionUnregisterDevice(ctx, req.(*TLAuthsessionUnregisterDevice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCAuthsession_AuthsessionUpdateDeviceLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLAuthsessionUpdateDeviceLocked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCAuthsessionServer).AuthsessionUpdateDeviceLocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authsession.RPCAuthsession/AuthsessionUpdateDeviceLocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCAuthsessionServer).AuthsessionUpdateDeviceLocked(ctx, req.(*TLAuthsessionUpdateDeviceLocked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCAuthsession_AuthsessionGetUserPushDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLAuthsessionGetUserPushDevices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCAuthsessionServer).AuthsessionGetUserPushDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authsession.RPCAuthsession/AuthsessionGetUserPushDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCAuthsessionServer).AuthsessionGetUserPushDevices(ctx, req.(*TLAuthsessionGetUserPushDevices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCAuthsession_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "authsession.RPCAuthsession",
 	HandlerType: (*RPCAuthsessionServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -2888,11 +4014,143 @@ var _RPCAuthsession_serviceDesc = grpc.ServiceDesc{
 			MethodName: "authsession_getAuthStateData",
 			Handler:    _RPCAuthsession_AuthsessionGetAuthStateData_Handler,
 		},
+		{
+			MethodName: "authsession_dropTempAuthKeys",
+			Handler:    _RPCAuthsession_AuthsessionDropTempAuthKeys_Handler,
+		},
+		{
+			MethodName: "authsession_getAuthKeyExpiresAt",
+			Handler:    _RPCAuthsession_AuthsessionGetAuthKeyExpiresAt_Handler,
+		},
+		{
+			MethodName: "authsession_registerDevice",
+			Handler:    _RPCAuthsession_AuthsessionRegisterDevice_Handler,
+		},
+		{
+			MethodName: "authsession_unregisterDevice",
+			Handler:    _RPCAuthsession_AuthsessionUnregisterDevice_Handler,
+		},
+		{
+			MethodName: "authsession_updateDeviceLocked",
+			Handler:    _RPCAuthsession_AuthsessionUpdateDeviceLocked_Handler,
+		},
+		{
+			MethodName: "authsession_getUserPushDevices",
+			Handler:    _RPCAuthsession_AuthsessionGetUserPushDevices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authsession.tl.proto",
 }
 
+func (m *AuthKeyStateData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthKeyStateData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthKeyStateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AndroidPushSessionId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.AndroidPushSessionId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ClientType != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.ClientType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Layer != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Layer))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeyState != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.KeyState))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UserId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLAuthKeyStateData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLAuthKeyStateData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLAuthKeyStateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthsessionTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClientSession) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3049,7 +4307,7 @@ func (m *TLClientSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AuthKeyStateData) Marshal() (dAtA []byte, err error) {
+func (m *PushDevice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3059,12 +4317,12 @@ func (m *AuthKeyStateData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuthKeyStateData) MarshalTo(dAtA []byte) (int, error) {
+func (m *PushDevice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthKeyStateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PushDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3073,34 +4331,77 @@ func (m *AuthKeyStateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AndroidPushSessionId != 0 {
-		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.AndroidPushSessionId))
+	if len(m.OtherUids) > 0 {
+		dAtA4 := make([]byte, len(m.OtherUids)*10)
+		var j3 int
+		for _, num1 := range m.OtherUids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j3))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x5a
 	}
-	if m.ClientType != 0 {
-		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.ClientType))
+	if m.LockedPeriod != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.LockedPeriod))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x50
 	}
-	if m.Layer != 0 {
-		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Layer))
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(len(m.Secret)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x4a
 	}
-	if m.KeyState != 0 {
-		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.KeyState))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(len(m.Token)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x42
+	}
+	if m.TokenType != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.UserId != 0 {
 		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.UserId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x30
 	}
 	if m.AuthKeyId != 0 {
 		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.AuthKeyId))
 		i--
+		dAtA[i] = 0x28
+	}
+	if m.AppSandbox {
+		i--
+		if m.AppSandbox {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NoMuted {
+		i--
+		if m.NoMuted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
 		dAtA[i] = 0x18
 	}
 	if m.Constructor != 0 {
@@ -3118,7 +4419,7 @@ func (m *AuthKeyStateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TLAuthKeyStateData) Marshal() (dAtA []byte, err error) {
+func (m *TLPushDevice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3128,12 +4429,12 @@ func (m *TLAuthKeyStateData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLAuthKeyStateData) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLPushDevice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLAuthKeyStateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLPushDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3763,6 +5064,21 @@ func (m *TLAuthsessionBindTempAuthKey) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MsgId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.MsgId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TempSessionId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.TempSessionId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TempAuthKeyId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.TempAuthKeyId))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.EncryptedMessage) > 0 {
 		i -= len(m.EncryptedMessage)
 		copy(dAtA[i:], m.EncryptedMessage)
@@ -3911,7 +5227,7 @@ func (m *TLAuthsessionGetAuthStateData) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *Vector_Long) Marshal() (dAtA []byte, err error) {
+func (m *TLAuthsessionDropTempAuthKeys) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3921,12 +5237,12 @@ func (m *Vector_Long) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vector_Long) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLAuthsessionDropTempAuthKeys) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vector_Long) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLAuthsessionDropTempAuthKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3935,282 +5251,476 @@ func (m *Vector_Long) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Datas) > 0 {
-		dAtA7 := make([]byte, len(m.Datas)*10)
-		var j6 int
-		for _, num1 := range m.Datas {
+	if len(m.ExceptAuthKeys) > 0 {
+		dAtA10 := make([]byte, len(m.ExceptAuthKeys)*10)
+		var j9 int
+		for _, num1 := range m.ExceptAuthKeys {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j6++
+				j9++
 			}
-			dAtA7[j6] = uint8(num)
-			j6++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j6))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j9))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if m.PermAuthKeyId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.PermAuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuthsessionTl(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthsessionTl(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TLAuthsessionGetAuthKeyExpiresAt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClientSession) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	if m.Constructor != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.AuthKeyId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
-	}
-	l = len(m.Ip)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	if m.Layer != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Layer))
-	}
-	if m.ApiId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.ApiId))
-	}
-	l = len(m.DeviceModel)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	l = len(m.SystemVersion)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	l = len(m.AppVersion)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	l = len(m.SystemLangCode)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	l = len(m.LangPack)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	l = len(m.LangCode)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	l = len(m.Proxy)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
-	l = len(m.Params)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLClientSession) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLAuthsessionRegisterDevice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLAuthsessionRegisterDevice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLAuthsessionRegisterDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data2 != nil {
-		l = m.Data2.Size()
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *AuthKeyStateData) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.OtherUids) > 0 {
+		dAtA12 := make([]byte, len(m.OtherUids)*10)
+		var j11 int
+		for _, num1 := range m.OtherUids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x52
 	}
-	var l int
-	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovAuthsessionTl(uint64(l))
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Constructor != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	if m.TokenType != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.UserId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.UserId))
-	}
-	if m.KeyState != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.KeyState))
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Layer != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Layer))
+	if m.AuthKeyId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ClientType != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.ClientType))
+	if m.AppSandbox {
+		i--
+		if m.AppSandbox {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.AndroidPushSessionId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.AndroidPushSessionId))
+	if m.NoMuted {
+		i--
+		if m.NoMuted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLAuthKeyStateData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLAuthsessionUnregisterDevice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLAuthsessionUnregisterDevice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLAuthsessionUnregisterDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data2 != nil {
-		l = m.Data2.Size()
-		n += 1 + l + sovAuthsessionTl(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *TLAuthsessionGetAuthorizations) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.OtherUids) > 0 {
+		dAtA14 := make([]byte, len(m.OtherUids)*10)
+		var j13 int
+		for _, num1 := range m.OtherUids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TokenType != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.UserId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ExcludeAuthKeyId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.ExcludeAuthKeyId))
+	if m.AuthKeyId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLAuthsessionResetAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLAuthsessionUpdateDeviceLocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLAuthsessionUpdateDeviceLocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLAuthsessionUpdateDeviceLocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Period != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.UserId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.AuthKeyId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
-	}
-	if m.Hash != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Hash))
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLAuthsessionGetLayer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLAuthsessionGetUserPushDevices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLAuthsessionGetUserPushDevices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLAuthsessionGetUserPushDevices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	if m.UserId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLAuthsessionGetLangPack) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Vector_Long) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_Long) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Long) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	if len(m.Datas) > 0 {
+		dAtA16 := make([]byte, len(m.Datas)*10)
+		var j15 int
+		for _, num1 := range m.Datas {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_PushDevice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_PushDevice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_PushDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthsessionTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TLAuthsessionGetClient) Size() (n int) {
+func encodeVarintAuthsessionTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthsessionTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuthKeyStateData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
 	if m.Constructor != 0 {
 		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
 	}
 	if m.AuthKeyId != 0 {
 		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
 	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.KeyState != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.KeyState))
+	}
+	if m.Layer != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Layer))
+	}
+	if m.ClientType != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.ClientType))
+	}
+	if m.AndroidPushSessionId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AndroidPushSessionId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TLAuthsessionGetLangCode) Size() (n int) {
+func (m *TLAuthKeyStateData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
-	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovAuthsessionTl(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -4218,41 +5728,79 @@ func (m *TLAuthsessionGetLangCode) Size() (n int) {
 	return n
 }
 
-func (m *TLAuthsessionGetUserId) Size() (n int) {
+func (m *ClientSession) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
 	if m.Constructor != 0 {
 		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
 	}
 	if m.AuthKeyId != 0 {
 		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
 	}
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	if m.Layer != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Layer))
+	}
+	if m.ApiId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.ApiId))
+	}
+	l = len(m.DeviceModel)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.SystemVersion)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.SystemLangCode)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.LangPack)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.LangCode)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.Proxy)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TLAuthsessionGetPushSessionId) Size() (n int) {
+func (m *TLClientSession) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.UserId))
-	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
-	}
-	if m.TokenType != 0 {
-		n += 1 + sovAuthsessionTl(uint64(m.TokenType))
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovAuthsessionTl(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -4260,7 +5808,234 @@ func (m *TLAuthsessionGetPushSessionId) Size() (n int) {
 	return n
 }
 
-func (m *TLAuthsessionGetFutureSalts) Size() (n int) {
+func (m *PushDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.NoMuted {
+		n += 2
+	}
+	if m.AppSandbox {
+		n += 2
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.TokenType))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	if m.LockedPeriod != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.LockedPeriod))
+	}
+	if len(m.OtherUids) > 0 {
+		l = 0
+		for _, e := range m.OtherUids {
+			l += sovAuthsessionTl(uint64(e))
+		}
+		n += 1 + sovAuthsessionTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPushDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetAuthorizations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.ExcludeAuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.ExcludeAuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionResetAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetLayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetLangPack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetLangCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetUserId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetPushSessionId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.TokenType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetFutureSalts) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4407,6 +6182,15 @@ func (m *TLAuthsessionBindTempAuthKey) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthsessionTl(uint64(l))
 	}
+	if m.TempAuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.TempAuthKeyId))
+	}
+	if m.TempSessionId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.TempSessionId))
+	}
+	if m.MsgId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.MsgId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4465,35 +6249,1055 @@ func (m *TLAuthsessionGetAuthStateData) Size() (n int) {
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.PermAuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.PermAuthKeyId))
+	}
+	if len(m.ExceptAuthKeys) > 0 {
+		l = 0
+		for _, e := range m.ExceptAuthKeys {
+			l += sovAuthsessionTl(uint64(e))
+		}
+		n += 1 + sovAuthsessionTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionRegisterDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.NoMuted {
+		n += 2
+	}
+	if m.AppSandbox {
+		n += 2
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.TokenType))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	if len(m.OtherUids) > 0 {
+		l = 0
+		for _, e := range m.OtherUids {
+			l += sovAuthsessionTl(uint64(e))
+		}
+		n += 1 + sovAuthsessionTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionUnregisterDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.TokenType))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAuthsessionTl(uint64(l))
+	}
+	if len(m.OtherUids) > 0 {
+		l = 0
+		for _, e := range m.OtherUids {
+			l += sovAuthsessionTl(uint64(e))
+		}
+		n += 1 + sovAuthsessionTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionUpdateDeviceLocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.AuthKeyId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.Period != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Period))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLAuthsessionGetUserPushDevices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Long) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		l = 0
+		for _, e := range m.Datas {
+			l += sovAuthsessionTl(uint64(e))
+		}
+		n += 1 + sovAuthsessionTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_PushDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovAuthsessionTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAuthsessionTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthsessionTl(x uint64) (n int) {
+	return sovAuthsessionTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuthKeyStateData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthKeyStateData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthKeyStateData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyState", wireType)
+			}
+			m.KeyState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyState |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+			}
+			m.Layer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layer |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			m.ClientType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AndroidPushSessionId", wireType)
+			}
+			m.AndroidPushSessionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AndroidPushSessionId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLAuthKeyStateData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_authKeyStateData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_authKeyStateData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &AuthKeyStateData{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+			}
+			m.Layer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layer |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
+			}
+			m.ApiId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SystemVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemLangCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SystemLangCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangPack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LangPack = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LangCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *Vector_Long) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Datas) > 0 {
-		l = 0
-		for _, e := range m.Datas {
-			l += sovAuthsessionTl(uint64(e))
+	return nil
+}
+func (m *TLClientSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_clientSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_clientSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ClientSession{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
-		n += 1 + sovAuthsessionTl(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
 
-func sovAuthsessionTl(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuthsessionTl(x uint64) (n int) {
-	return sovAuthsessionTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ClientSession) Unmarshal(dAtA []byte) error {
+func (m *PushDevice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4512,19 +7316,167 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClientSession: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientSession: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoMuted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoMuted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSandbox", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AppSandbox = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4552,13 +7504,13 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			m.Constructor = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4568,16 +7520,31 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = append(m.Secret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Secret == nil {
+				m.Secret = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedPeriod", wireType)
 			}
-			m.AuthKeyId = 0
+			m.LockedPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4587,16 +7554,143 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				m.LockedPeriod |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 11:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OtherUids = append(m.OtherUids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OtherUids) == 0 {
+					m.OtherUids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthsessionTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OtherUids = append(m.OtherUids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherUids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPushDevice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_pushDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_pushDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4606,48 +7700,84 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuthsessionTl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthsessionTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ip = string(dAtA[iNdEx:postIndex])
+			if m.Data2 == nil {
+				m.Data2 = &PushDevice{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Layer = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Layer |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLAuthsessionGetAuthorizations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_authsession_getAuthorizations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_authsession_getAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			m.ApiId = 0
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4657,16 +7787,16 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiId |= int32(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModel", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var stringLen uint64
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4676,29 +7806,16 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeviceModel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemVersion", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeAuthKeyId", wireType)
 			}
-			var stringLen uint64
+			m.ExcludeAuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4708,29 +7825,67 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExcludeAuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuthsessionTl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SystemVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLAuthsessionResetAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_authsession_resetAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_authsession_resetAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4740,29 +7895,16 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AppVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemLangCode", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var stringLen uint64
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4772,29 +7914,16 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SystemLangCode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangPack", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			var stringLen uint64
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4804,29 +7933,16 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LangPack = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangCode", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			m.Hash = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4836,29 +7952,67 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Hash |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuthsessionTl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangCode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLAuthsessionGetLayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_authsession_getLayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_authsession_getLayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4868,29 +8022,16 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proxy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			var stringLen uint64
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4900,24 +8041,11 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Params = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -4940,7 +8068,7 @@ func (m *ClientSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLClientSession) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetLangPack) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4963,17 +8091,17 @@ func (m *TLClientSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_clientSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getLangPack: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_clientSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getLangPack: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			var msglen int
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -4983,28 +8111,30 @@ func (m *TLClientSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data2 == nil {
-				m.Data2 = &ClientSession{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -5027,7 +8157,7 @@ func (m *TLClientSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthKeyStateData) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5050,45 +8180,13 @@ func (m *AuthKeyStateData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthKeyStateData: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthKeyStateData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PredicateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
@@ -5126,68 +8224,62 @@ func (m *AuthKeyStateData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UserId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyState", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.KeyState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.KeyState |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLAuthsessionGetLangCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Layer = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Layer |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_authsession_getLangCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_authsession_getLangCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			m.ClientType = 0
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -5197,16 +8289,16 @@ func (m *AuthKeyStateData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientType |= int32(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AndroidPushSessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			m.AndroidPushSessionId = 0
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -5216,7 +8308,7 @@ func (m *AuthKeyStateData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AndroidPushSessionId |= int64(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5243,7 +8335,7 @@ func (m *AuthKeyStateData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthKeyStateData) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetUserId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5266,17 +8358,17 @@ func (m *TLAuthKeyStateData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authKeyStateData: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getUserId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authKeyStateData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getUserId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			var msglen int
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -5286,28 +8378,30 @@ func (m *TLAuthKeyStateData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data2 == nil {
-				m.Data2 = &AuthKeyStateData{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -5330,7 +8424,7 @@ func (m *TLAuthKeyStateData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetAuthorizations) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetPushSessionId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5353,10 +8447,10 @@ func (m *TLAuthsessionGetAuthorizations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getAuthorizations: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getPushSessionId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getPushSessionId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5399,9 +8493,9 @@ func (m *TLAuthsessionGetAuthorizations) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeAuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			m.ExcludeAuthKeyId = 0
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -5411,7 +8505,26 @@ func (m *TLAuthsessionGetAuthorizations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExcludeAuthKeyId |= int64(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5438,7 +8551,7 @@ func (m *TLAuthsessionGetAuthorizations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionResetAuthorization) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetFutureSalts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5461,10 +8574,10 @@ func (m *TLAuthsessionResetAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_resetAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getFutureSalts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_resetAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getFutureSalts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5487,25 +8600,6 @@ func (m *TLAuthsessionResetAuthorization) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
-			}
-			m.UserId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
@@ -5524,11 +8618,11 @@ func (m *TLAuthsessionResetAuthorization) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Num", wireType)
 			}
-			m.Hash = 0
+			m.Num = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -5538,7 +8632,7 @@ func (m *TLAuthsessionResetAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Hash |= int64(b&0x7F) << shift
+				m.Num |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5565,7 +8659,7 @@ func (m *TLAuthsessionResetAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetLayer) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionQueryAuthKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5588,10 +8682,10 @@ func (m *TLAuthsessionGetLayer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getLayer: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_queryAuthKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getLayer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_queryAuthKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5654,7 +8748,7 @@ func (m *TLAuthsessionGetLayer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetLangPack) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionSetAuthKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5677,10 +8771,10 @@ func (m *TLAuthsessionGetLangPack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getLangPack: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_setAuthKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getLangPack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_setAuthKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5703,10 +8797,82 @@ func (m *TLAuthsessionGetLangPack) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthKey == nil {
+				m.AuthKey = &mtproto.AuthKeyInfo{}
+			}
+			if err := m.AuthKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureSalt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FutureSalt == nil {
+				m.FutureSalt = &mtproto.FutureSalt{}
+			}
+			if err := m.FutureSalt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
 			}
-			m.AuthKeyId = 0
+			m.ExpiresIn = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -5716,7 +8882,7 @@ func (m *TLAuthsessionGetLangPack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				m.ExpiresIn |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5743,7 +8909,7 @@ func (m *TLAuthsessionGetLangPack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetClient) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionBindAuthKeyUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5766,10 +8932,10 @@ func (m *TLAuthsessionGetClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_bindAuthKeyUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_bindAuthKeyUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5810,6 +8976,25 @@ func (m *TLAuthsessionGetClient) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -5832,7 +9017,7 @@ func (m *TLAuthsessionGetClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetLangCode) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionUnbindAuthKeyUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5855,10 +9040,10 @@ func (m *TLAuthsessionGetLangCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getLangCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_unbindAuthKeyUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getLangCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_unbindAuthKeyUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5899,6 +9084,25 @@ func (m *TLAuthsessionGetLangCode) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -5921,7 +9125,7 @@ func (m *TLAuthsessionGetLangCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetUserId) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetPermAuthKeyId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5944,10 +9148,10 @@ func (m *TLAuthsessionGetUserId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getUserId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getPermAuthKeyId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getUserId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getPermAuthKeyId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6010,7 +9214,7 @@ func (m *TLAuthsessionGetUserId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetPushSessionId) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6033,10 +9237,10 @@ func (m *TLAuthsessionGetPushSessionId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getPushSessionId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_bindTempAuthKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getPushSessionId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_bindTempAuthKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6060,9 +9264,9 @@ func (m *TLAuthsessionGetPushSessionId) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermAuthKeyId", wireType)
 			}
-			m.UserId = 0
+			m.PermAuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6072,16 +9276,16 @@ func (m *TLAuthsessionGetPushSessionId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
+				m.PermAuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.AuthKeyId = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6091,16 +9295,16 @@ func (m *TLAuthsessionGetPushSessionId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				m.Nonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.TokenType = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6110,67 +9314,50 @@ func (m *TLAuthsessionGetPushSessionId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TokenType |= int32(b&0x7F) << shift
+				m.ExpiresAt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuthsessionTl
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TLAuthsessionGetFutureSalts) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuthsessionTl
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EncryptedMessage = append(m.EncryptedMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedMessage == nil {
+				m.EncryptedMessage = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getFutureSalts: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getFutureSalts: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TempAuthKeyId", wireType)
 			}
-			m.Constructor = 0
+			m.TempAuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6180,16 +9367,16 @@ func (m *TLAuthsessionGetFutureSalts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.TempAuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TempSessionId", wireType)
 			}
-			m.AuthKeyId = 0
+			m.TempSessionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6199,16 +9386,16 @@ func (m *TLAuthsessionGetFutureSalts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				m.TempSessionId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Num", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
 			}
-			m.Num = 0
+			m.MsgId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6218,7 +9405,7 @@ func (m *TLAuthsessionGetFutureSalts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Num |= int32(b&0x7F) << shift
+				m.MsgId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6245,7 +9432,7 @@ func (m *TLAuthsessionGetFutureSalts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionQueryAuthKey) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionSetClientSessionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6268,10 +9455,10 @@ func (m *TLAuthsessionQueryAuthKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_queryAuthKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_setClientSessionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_queryAuthKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_setClientSessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6294,10 +9481,10 @@ func (m *TLAuthsessionQueryAuthKey) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.AuthKeyId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6307,11 +9494,28 @@ func (m *TLAuthsessionQueryAuthKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &ClientSession{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -6334,7 +9538,7 @@ func (m *TLAuthsessionQueryAuthKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionSetAuthKey) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6357,10 +9561,10 @@ func (m *TLAuthsessionSetAuthKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_setAuthKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_setAuthKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6383,82 +9587,10 @@ func (m *TLAuthsessionSetAuthKey) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AuthKey == nil {
-				m.AuthKey = &mtproto.AuthKeyInfo{}
-			}
-			if err := m.AuthKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureSalt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthsessionTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FutureSalt == nil {
-				m.FutureSalt = &mtproto.FutureSalt{}
-			}
-			if err := m.FutureSalt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			m.ExpiresIn = 0
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6468,7 +9600,7 @@ func (m *TLAuthsessionSetAuthKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresIn |= int32(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6495,7 +9627,7 @@ func (m *TLAuthsessionSetAuthKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionBindAuthKeyUser) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetAuthStateData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6518,10 +9650,10 @@ func (m *TLAuthsessionBindAuthKeyUser) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_bindAuthKeyUser: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getAuthStateData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_bindAuthKeyUser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getAuthStateData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6562,25 +9694,6 @@ func (m *TLAuthsessionBindAuthKeyUser) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
-			}
-			m.UserId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -6603,7 +9716,7 @@ func (m *TLAuthsessionBindAuthKeyUser) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionUnbindAuthKeyUser) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionDropTempAuthKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6626,10 +9739,10 @@ func (m *TLAuthsessionUnbindAuthKeyUser) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_unbindAuthKeyUser: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_dropTempAuthKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_unbindAuthKeyUser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_dropTempAuthKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6653,9 +9766,9 @@ func (m *TLAuthsessionUnbindAuthKeyUser) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermAuthKeyId", wireType)
 			}
-			m.AuthKeyId = 0
+			m.PermAuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6665,29 +9778,86 @@ func (m *TLAuthsessionUnbindAuthKeyUser) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				m.PermAuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
-			}
-			m.UserId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthsessionTl
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExceptAuthKeys = append(m.ExceptAuthKeys, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExceptAuthKeys) == 0 {
+					m.ExceptAuthKeys = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthsessionTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExceptAuthKeys = append(m.ExceptAuthKeys, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExceptAuthKeys", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -6711,7 +9881,7 @@ func (m *TLAuthsessionUnbindAuthKeyUser) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetPermAuthKeyId) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetAuthKeyExpiresAt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6734,10 +9904,10 @@ func (m *TLAuthsessionGetPermAuthKeyId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getPermAuthKeyId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getAuthKeyExpiresAt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getPermAuthKeyId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getAuthKeyExpiresAt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6800,7 +9970,7 @@ func (m *TLAuthsessionGetPermAuthKeyId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionRegisterDevice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6823,10 +9993,10 @@ func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_bindTempAuthKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_registerDevice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_bindTempAuthKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_registerDevice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6850,9 +10020,9 @@ func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermAuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoMuted", wireType)
 			}
-			m.PermAuthKeyId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6862,16 +10032,17 @@ func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PermAuthKeyId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.NoMuted = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSandbox", wireType)
 			}
-			m.Nonce = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6881,16 +10052,17 @@ func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AppSandbox = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			m.ExpiresAt = 0
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -6900,14 +10072,84 @@ func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= int32(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6934,11 +10176,87 @@ func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedMessage = append(m.EncryptedMessage[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedMessage == nil {
-				m.EncryptedMessage = []byte{}
+			m.Secret = append(m.Secret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Secret == nil {
+				m.Secret = []byte{}
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OtherUids = append(m.OtherUids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OtherUids) == 0 {
+					m.OtherUids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthsessionTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OtherUids = append(m.OtherUids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherUids", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -6961,7 +10279,7 @@ func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionSetClientSessionInfo) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionUnregisterDevice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6974,27 +10292,84 @@ func (m *TLAuthsessionSetClientSessionInfo) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_authsession_unregisterDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_authsession_unregisterDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_setClientSessionInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_setClientSessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
-			m.Constructor = 0
+			m.TokenType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -7004,16 +10379,16 @@ func (m *TLAuthsessionSetClientSessionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.TokenType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -7023,28 +10398,100 @@ func (m *TLAuthsessionSetClientSessionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuthsessionTl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthsessionTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &ClientSession{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OtherUids = append(m.OtherUids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OtherUids) == 0 {
+					m.OtherUids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthsessionTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OtherUids = append(m.OtherUids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherUids", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -7067,7 +10514,7 @@ func (m *TLAuthsessionSetClientSessionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetAuthorization) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionUpdateDeviceLocked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7090,10 +10537,10 @@ func (m *TLAuthsessionGetAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_updateDeviceLocked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_updateDeviceLocked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7134,6 +10581,44 @@ func (m *TLAuthsessionGetAuthorization) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
@@ -7156,7 +10641,7 @@ func (m *TLAuthsessionGetAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLAuthsessionGetAuthStateData) Unmarshal(dAtA []byte) error {
+func (m *TLAuthsessionGetUserPushDevices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7179,10 +10664,10 @@ func (m *TLAuthsessionGetAuthStateData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_authsession_getAuthStateData: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_authsession_getUserPushDevices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_authsession_getAuthStateData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_authsession_getUserPushDevices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7206,9 +10691,9 @@ func (m *TLAuthsessionGetAuthStateData) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.AuthKeyId = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthsessionTl
@@ -7218,7 +10703,7 @@ func (m *TLAuthsessionGetAuthStateData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7372,6 +10857,91 @@ func (m *Vector_Long) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Vector_PushDevice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_PushDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_PushDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &PushDevice{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthsessionTl(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,8 +11,8 @@
 package authsession
 
 const (
-	Predicate_clientSession                    = "clientSession"
 	Predicate_authKeyStateData                 = "authKeyStateData"
+	Predicate_clientSession                    = "clientSession"
 	Predicate_authsession_getAuthorizations    = "authsession_getAuthorizations"
 	Predicate_authsession_resetAuthorization   = "authsession_resetAuthorization"
 	Predicate_authsession_getLayer             = "authsession_getLayer"
@@ -31,16 +31,23 @@ const (
 	Predicate_authsession_setClientSessionInfo = "authsession_setClientSessionInfo"
 	Predicate_authsession_getAuthorization     = "authsession_getAuthorization"
 	Predicate_authsession_getAuthStateData     = "authsession_getAuthStateData"
+	Predicate_authsession_dropTempAuthKeys     = "authsession_dropTempAuthKeys"
+	Predicate_authsession_getAuthKeyExpiresAt  = "authsession_getAuthKeyExpiresAt"
+	Predicate_pushDevice                       = "pushDevice"
+	Predicate_authsession_registerDevice       = "authsession_registerDevice"
+	Predicate_authsession_unregisterDevice     = "authsession_unregisterDevice"
+	Predicate_authsession_updateDeviceLocked   = "authsession_updateDeviceLocked"
+	Predicate_authsession_getUserPushDevices   = "authsession_getUserPushDevices"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
-	Predicate_clientSession: {
-		0: -1701940816, // 0x9a8e71b0
-
-	},
 	Predicate_authKeyStateData: {
 		0: -646863312, // 0xd971a630
 
+	},
+	Predicate_clientSession: {
+		0: -1701940816, // 0x9a8e71b0
+
 	},
 	Predicate_authsession_getAuthorizations: {
 		0: 820122180, // 0x30e21244
@@ -99,7 +106,7 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 
 	},
 	Predicate_authsession_bindTempAuthKey: {
-		0: 1620004742, // 0x608f4f86
+		0: 2130778196, // 0x7f011854
 
 	},
 	Predicate_authsession_setClientSessionInfo: {
@@ -114,11 +121,39 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: 1331573041, // 0x4f5e3131
 
 	},
+	Predicate_authsession_dropTempAuthKeys: {
+		0: 82990883, // 0x4f25723
+
+	},
+	Predicate_authsession_getAuthKeyExpiresAt: {
+		0: -1471638396, // 0xa8489484
+
+	},
+	Predicate_pushDevice: {
+		0: -742057663, // 0xd3c51941
+
+	},
+	Predicate_authsession_registerDevice: {
+		0: -954003608, // 0xc7230f68
+
+	},
+	Predicate_authsession_unregisterDevice: {
+		0: 468246175, // 0x1be8de9f
+
+	},
+	Predicate_authsession_updateDeviceLocked: {
+		0: -172649891, // 0xf5b5925d
+
+	},
+	Predicate_authsession_getUserPushDevices: {
+		0: 1125895727, // 0x431bce2f
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
-	-1701940816: Predicate_clientSession,                    // 0x9a8e71b0
 	-646863312:  Predicate_authKeyStateData,                 // 0xd971a630
+	-1701940816: Predicate_clientSession,                    // 0x9a8e71b0
 	820122180:   Predicate_authsession_getAuthorizations,    // 0x30e21244
 	-1923126106: Predicate_authsession_resetAuthorization,   // 0x8d5f6ca6
 	-1473309015: Predicate_authsession_getLayer,             // 0xa82f16a9
@@ -133,10 +168,17 @@ var clazzIdNameRegisters2 = map[int32]string{
 	198050851:   Predicate_authsession_bindAuthKeyUser,      // 0xbce0423
 	123258440:   Predicate_authsession_unbindAuthKeyUser,    // 0x758c648
 	-1871420202: Predicate_authsession_getPermAuthKeyId,     // 0x907464d6
-	1620004742:  Predicate_authsession_bindTempAuthKey,      // 0x608f4f86
+	2130778196:  Predicate_authsession_bindTempAuthKey,      // 0x7f011854
 	47841172:    Predicate_authsession_setClientSessionInfo, // 0x2d9ff94
 	1851660579:  Predicate_authsession_getAuthorization,     // 0x6e5e1923
 	1331573041:  Predicate_authsession_getAuthStateData,     // 0x4f5e3131
+	82990883:    Predicate_authsession_dropTempAuthKeys,     // 0x4f25723
+	-1471638396: Predicate_authsession_getAuthKeyExpiresAt,  // 0xa8489484
+	-742057663:  Predicate_pushDevice,                       // 0xd3c51941
+	-954003608:  Predicate_authsession_registerDevice,       // 0xc7230f68
+	468246175:   Predicate_authsession_unregisterDevice,     // 0x1be8de9f
+	-172649891:  Predicate_authsession_updateDeviceLocked,   // 0xf5b5925d
+	1125895727:  Predicate_authsession_getUserPushDevices,   // 0x431bce2f
 
 }
 
@@ -40,6 +40,11 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 		o.Data2.Constructor = -1701940816
 		return o
 	},
+	-742057663: func() mtproto.TLObject { // 0xd3c51941
+		o := MakeTLPushDevice(nil)
+		o.Data2.Constructor = -742057663
+		return o
+	},
 
 	// Method
 	820122180: func() mtproto.TLObject { // 0x30e21244
@@ -112,9 +117,9 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: -1871420202,
 		}
 	},
-	1620004742: func() mtproto.TLObject { // 0x608f4f86
+	2130778196: func() mtproto.TLObject { // 0x7f011854
 		return &TLAuthsessionBindTempAuthKey{
-			Constructor: 1620004742,
+			Constructor: 2130778196,
 		}
 	},
 	47841172: func() mtproto.TLObject { // 0x2d9ff94
@@ -132,6 +137,36 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: 1331573041,
 		}
 	},
+	82990883: func() mtproto.TLObject { // 0x4f25723
+		return &TLAuthsessionDropTempAuthKeys{
+			Constructor: 82990883,
+		}
+	},
+	-1471638396: func() mtproto.TLObject { // 0xa8489484
+		return &TLAuthsessionGetAuthKeyExpiresAt{
+			Constructor: -1471638396,
+		}
+	},
+	-954003608: func() mtproto.TLObject { // 0xc7230f68
+		return &TLAuthsessionRegisterDevice{
+			Constructor: -954003608,
+		}
+	},
+	468246175: func() mtproto.TLObject { // 0x1be8de9f
+		return &TLAuthsessionUnregisterDevice{
+			Constructor: 468246175,
+		}
+	},
+	-172649891: func() mtproto.TLObject { // 0xf5b5925d
+		return &TLAuthsessionUpdateDeviceLocked{
+			Constructor: -172649891,
+		}
+	},
+	1125895727: func() mtproto.TLObject { // 0x431bce2f
+		return &TLAuthsessionGetUserPushDevices{
+			Constructor: 1125895727,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -270,6 +305,7 @@ func (m *TLAuthKeyStateData) Encode(layer int32) []byte {
 			x.Int(m.GetLayer())
 			x.Int(m.GetClientType())
 			x.Long(m.GetAndroidPushSessionId())
+
 			return x.GetBuf()
 		},
 	}
@@ -462,6 +498,7 @@ func (m *TLClientSession) Encode(layer int32) []byte {
 			x.String(m.GetLangCode())
 			x.String(m.GetProxy())
 			x.String(m.GetParams())
+
 			return x.GetBuf()
 		},
 	}
@@ -515,6 +552,210 @@ func (m *TLClientSession) DebugString() string {
 	return dbgString
 }
 
+// PushDevice <--
+//  + TL_PushDevice
+//
+
+func (m *PushDevice) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_pushDevice:
+		t := m.To_PushDevice()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *PushDevice) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *PushDevice) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0xd3c51941:
+		m2 := MakeTLPushDevice(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *PushDevice) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_pushDevice:
+		t := m.To_PushDevice()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_PushDevice
+// pushDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes locked_period:int other_uids:Vector<long> = PushDevice;
+func (m *PushDevice) To_PushDevice() *TLPushDevice {
+	m.PredicateName = Predicate_pushDevice
+	return &TLPushDevice{
+		Data2: m,
+	}
+}
+
+// MakeTLPushDevice
+// pushDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes locked_period:int other_uids:Vector<long> = PushDevice;
+func MakeTLPushDevice(data2 *PushDevice) *TLPushDevice {
+	if data2 == nil {
+		return &TLPushDevice{Data2: &PushDevice{
+			PredicateName: Predicate_pushDevice,
+		}}
+	} else {
+		data2.PredicateName = Predicate_pushDevice
+		return &TLPushDevice{Data2: data2}
+	}
+}
+
+func (m *TLPushDevice) To_PushDevice() *PushDevice {
+	m.Data2.PredicateName = Predicate_pushDevice
+	return m.Data2
+}
+
+func (m *TLPushDevice) SetNoMuted(v bool) { m.Data2.NoMuted = v }
+func (m *TLPushDevice) GetNoMuted() bool  { return m.Data2.NoMuted }
+
+func (m *TLPushDevice) SetAppSandbox(v bool) { m.Data2.AppSandbox = v }
+func (m *TLPushDevice) GetAppSandbox() bool  { return m.Data2.AppSandbox }
+
+func (m *TLPushDevice) SetAuthKeyId(v int64) { m.Data2.AuthKeyId = v }
+func (m *TLPushDevice) GetAuthKeyId() int64  { return m.Data2.AuthKeyId }
+
+func (m *TLPushDevice) SetUserId(v int64) { m.Data2.UserId = v }
+func (m *TLPushDevice) GetUserId() int64  { return m.Data2.UserId }
+
+func (m *TLPushDevice) SetTokenType(v int32) { m.Data2.TokenType = v }
+func (m *TLPushDevice) GetTokenType() int32  { return m.Data2.TokenType }
+
+func (m *TLPushDevice) SetToken(v string) { m.Data2.Token = v }
+func (m *TLPushDevice) GetToken() string  { return m.Data2.Token }
+
+func (m *TLPushDevice) SetSecret(v []byte) { m.Data2.Secret = v }
+func (m *TLPushDevice) GetSecret() []byte  { return m.Data2.Secret }
+
+func (m *TLPushDevice) SetLockedPeriod(v int32) { m.Data2.LockedPeriod = v }
+func (m *TLPushDevice) GetLockedPeriod() int32  { return m.Data2.LockedPeriod }
+
+func (m *TLPushDevice) SetOtherUids(v []int64) { m.Data2.OtherUids = v }
+func (m *TLPushDevice) GetOtherUids() []int64  { return m.Data2.OtherUids }
+
+func (m *TLPushDevice) GetPredicateName() string {
+	return Predicate_pushDevice
+}
+
+func (m *TLPushDevice) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0xd3c51941: func() []byte {
+			// pushDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes locked_period:int other_uids:Vector<long> = PushDevice;
+			x.UInt(0xd3c51941)
+
+			// set flags
+			var flags uint32 = 0
+
+			if m.GetNoMuted() == true {
+				flags |= 1 << 0
+			}
+			if m.GetAppSandbox() == true {
+				flags |= 1 << 1
+			}
+
+			x.UInt(flags)
+
+			// flags Debug by @benqi
+			x.Long(m.GetAuthKeyId())
+			x.Long(m.GetUserId())
+			x.Int(m.GetTokenType())
+			x.String(m.GetToken())
+			x.StringBytes(m.GetSecret())
+			x.Int(m.GetLockedPeriod())
+
+			x.VectorLong(m.GetOtherUids())
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_pushDevice, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_pushDevice, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPushDevice) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPushDevice) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0xd3c51941: func() error {
+			// pushDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes locked_period:int other_uids:Vector<long> = PushDevice;
+			var flags = dBuf.UInt()
+			_ = flags
+
+			if (flags & (1 << 0)) != 0 {
+				m.SetNoMuted(true)
+			}
+			if (flags & (1 << 1)) != 0 {
+				m.SetAppSandbox(true)
+			}
+			m.SetAuthKeyId(dBuf.Long())
+			m.SetUserId(dBuf.Long())
+			m.SetTokenType(dBuf.Int())
+			m.SetToken(dBuf.String())
+			m.SetSecret(dBuf.StringBytes())
+			m.SetLockedPeriod(dBuf.Int())
+
+			m.SetOtherUids(dBuf.VectorLong())
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLPushDevice) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 //----------------------------------------------------------------------------------------------------------------
 // TLAuthsessionGetAuthorizations
 ///////////////////////////////////////////////////////////////////////////////
@@ -1188,7 +1429,7 @@ func (m *TLAuthsessionGetPermAuthKeyId) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x907464d6:
-		// authsession.getPermAuthKeyId auth_key_id:long= Int64;
+		// authsession.getPermAuthKeyId auth_key_id:long = Int64;
 		x.UInt(0x907464d6)
 
 		// no flags
@@ -1209,7 +1450,7 @@ func (m *TLAuthsessionGetPermAuthKeyId) CalcByteSize(layer int32) int {
 func (m *TLAuthsessionGetPermAuthKeyId) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x907464d6:
-		// authsession.getPermAuthKeyId auth_key_id:long= Int64;
+		// authsession.getPermAuthKeyId auth_key_id:long = Int64;
 
 		// not has flags
 
@@ -1236,9 +1477,9 @@ func (m *TLAuthsessionBindTempAuthKey) Encode(layer int32) []byte {
 	// x.Int(int32(CRC32_authsession_bindTempAuthKey))
 
 	switch uint32(m.Constructor) {
-	case 0x608f4f86:
-		// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
-		x.UInt(0x608f4f86)
+	case 0x7f011854:
+		// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes temp_auth_key_id:long temp_session_id:long msg_id:long = Bool;
+		x.UInt(0x7f011854)
 
 		// no flags
 
@@ -1246,6 +1487,9 @@ func (m *TLAuthsessionBindTempAuthKey) Encode(layer int32) []byte {
 		x.Long(m.GetNonce())
 		x.Int(m.GetExpiresAt())
 		x.StringBytes(m.GetEncryptedMessage())
+		x.Long(m.GetTempAuthKeyId())
+		x.Long(m.GetTempSessionId())
+		x.Long(m.GetMsgId())
 
 	default:
 		// log.Errorf("")
@@ -1260,8 +1504,8 @@ func (m *TLAuthsessionBindTempAuthKey) CalcByteSize(layer int32) int {
 
 func (m *TLAuthsessionBindTempAuthKey) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
-	case 0x608f4f86:
-		// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
+	case 0x7f011854:
+		// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes temp_auth_key_id:long temp_session_id:long msg_id:long = Bool;
 
 		// not has flags
 
@@ -1269,6 +1513,9 @@ func (m *TLAuthsessionBindTempAuthKey) Decode(dBuf *mtproto.DecodeBuf) error {
 		m.Nonce = dBuf.Long()
 		m.ExpiresAt = dBuf.Int()
 		m.EncryptedMessage = dBuf.StringBytes()
+		m.TempAuthKeyId = dBuf.Long()
+		m.TempSessionId = dBuf.Long()
+		m.MsgId = dBuf.Long()
 		return dBuf.GetError()
 
 	default:
@@ -1433,6 +1680,352 @@ func (m *TLAuthsessionGetAuthStateData) DebugString() string {
 	return dbgString
 }
 
+// TLAuthsessionDropTempAuthKeys
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLAuthsessionDropTempAuthKeys) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_authsession_dropTempAuthKeys))
+
+	switch uint32(m.Constructor) {
+	case 0x4f25723:
+		// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Bool;
+		x.UInt(0x4f25723)
+
+		// no flags
+
+		x.Long(m.GetPermAuthKeyId())
+
+		x.VectorLong(m.GetExceptAuthKeys())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x4f25723:
+		// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Bool;
+
+		// not has flags
+
+		m.PermAuthKeyId = dBuf.Long()
+
+		m.ExceptAuthKeys = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLAuthsessionGetAuthKeyExpiresAt
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_authsession_getAuthKeyExpiresAt))
+
+	switch uint32(m.Constructor) {
+	case 0xa8489484:
+		// authsession.getAuthKeyExpiresAt auth_key_id:long = Int32;
+		x.UInt(0xa8489484)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xa8489484:
+		// authsession.getAuthKeyExpiresAt auth_key_id:long = Int32;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLAuthsessionGetAuthKeyExpiresAt) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLAuthsessionRegisterDevice
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLAuthsessionRegisterDevice) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_authsession_registerDevice))
+
+	switch uint32(m.Constructor) {
+	case 0xc7230f68:
+		// authsession.registerDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes other_uids:Vector<long> = Bool;
+		x.UInt(0xc7230f68)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetNoMuted() == true {
+			flags |= 1 << 0
+		}
+		if m.GetAppSandbox() == true {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetAuthKeyId())
+		x.Long(m.GetUserId())
+		x.Int(m.GetTokenType())
+		x.String(m.GetToken())
+		x.StringBytes(m.GetSecret())
+
+		x.VectorLong(m.GetOtherUids())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLAuthsessionRegisterDevice) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLAuthsessionRegisterDevice) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc7230f68:
+		// authsession.registerDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes other_uids:Vector<long> = Bool;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.NoMuted = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.AppSandbox = true
+		}
+		m.AuthKeyId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		m.TokenType = dBuf.Int()
+		m.Token = dBuf.String()
+		m.Secret = dBuf.StringBytes()
+
+		m.OtherUids = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLAuthsessionRegisterDevice) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLAuthsessionUnregisterDevice
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLAuthsessionUnregisterDevice) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_authsession_unregisterDevice))
+
+	switch uint32(m.Constructor) {
+	case 0x1be8de9f:
+		// authsession.unregisterDevice auth_key_id:long user_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+		x.UInt(0x1be8de9f)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+		x.Long(m.GetUserId())
+		x.Int(m.GetTokenType())
+		x.String(m.GetToken())
+
+		x.VectorLong(m.GetOtherUids())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLAuthsessionUnregisterDevice) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLAuthsessionUnregisterDevice) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x1be8de9f:
+		// authsession.unregisterDevice auth_key_id:long user_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		m.TokenType = dBuf.Int()
+		m.Token = dBuf.String()
+
+		m.OtherUids = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLAuthsessionUnregisterDevice) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLAuthsessionUpdateDeviceLocked
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLAuthsessionUpdateDeviceLocked) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_authsession_updateDeviceLocked))
+
+	switch uint32(m.Constructor) {
+	case 0xf5b5925d:
+		// authsession.updateDeviceLocked auth_key_id:long user_id:long period:int = Bool;
+		x.UInt(0xf5b5925d)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+		x.Long(m.GetUserId())
+		x.Int(m.GetPeriod())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLAuthsessionUpdateDeviceLocked) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLAuthsessionUpdateDeviceLocked) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xf5b5925d:
+		// authsession.updateDeviceLocked auth_key_id:long user_id:long period:int = Bool;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		m.Period = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLAuthsessionUpdateDeviceLocked) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLAuthsessionGetUserPushDevices
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLAuthsessionGetUserPushDevices) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_authsession_getUserPushDevices))
+
+	switch uint32(m.Constructor) {
+	case 0x431bce2f:
+		// authsession.getUserPushDevices user_id:long = Vector<PushDevice>;
+		x.UInt(0x431bce2f)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLAuthsessionGetUserPushDevices) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLAuthsessionGetUserPushDevices) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x431bce2f:
+		// authsession.getUserPushDevices user_id:long = Vector<PushDevice>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLAuthsessionGetUserPushDevices) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 //----------------------------------------------------------------------------------------------------------------
 // Vector_Long
 ///////////////////////////////////////////////////////////////////////////////
@@ -1458,3 +2051,38 @@ func (m *Vector_Long) DebugString() string {
 	dbgString, _ := jsonm.MarshalToString(m)
 	return dbgString
 }
+
+// Vector_PushDevice
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_PushDevice) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_PushDevice) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*PushDevice, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(PushDevice)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_PushDevice) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_PushDevice) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
@@ -44,6 +44,12 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLAuthsessionSetClientSessionInfo": RPCContextTuple{"/mtproto.RPCAuthsession/authsession_setClientSessionInfo", func() interface{} { return new(mtproto.Bool) }},
 	"TLAuthsessionGetAuthorization":     RPCContextTuple{"/mtproto.RPCAuthsession/authsession_getAuthorization", func() interface{} { return new(mtproto.Authorization) }},
 	"TLAuthsessionGetAuthStateData":     RPCContextTuple{"/mtproto.RPCAuthsession/authsession_getAuthStateData", func() interface{} { return new(AuthKeyStateData) }},
+	"TLAuthsessionDropTempAuthKeys":     RPCContextTuple{"/mtproto.RPCAuthsession/authsession_dropTempAuthKeys", func() interface{} { return new(mtproto.Bool) }},
+	"TLAuthsessionGetAuthKeyExpiresAt":  RPCContextTuple{"/mtproto.RPCAuthsession/authsession_getAuthKeyExpiresAt", func() interface{} { return new(mtproto.Int32) }},
+	"TLAuthsessionRegisterDevice":       RPCContextTuple{"/mtproto.RPCAuthsession/authsession_registerDevice", func() interface{} { return new(mtproto.Bool) }},
+	"TLAuthsessionUnregisterDevice":     RPCContextTuple{"/mtproto.RPCAuthsession/authsession_unregisterDevice", func() interface{} { return new(mtproto.Bool) }},
+	"TLAuthsessionUpdateDeviceLocked":   RPCContextTuple{"/mtproto.RPCAuthsession/authsession_updateDeviceLocked", func() interface{} { return new(mtproto.Bool) }},
+	"TLAuthsessionGetUserPushDevices":   RPCContextTuple{"/mtproto.RPCAuthsession/authsession_getUserPushDevices", func() interface{} { return new(Vector_PushDevice) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
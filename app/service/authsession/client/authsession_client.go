@@ -40,6 +40,12 @@ type AuthsessionClient interface {
 	AuthsessionSetClientSessionInfo(ctx context.Context, in *authsession.TLAuthsessionSetClientSessionInfo) (*mtproto.Bool, error)
 	AuthsessionGetAuthorization(ctx context.Context, in *authsession.TLAuthsessionGetAuthorization) (*mtproto.Authorization, error)
 	AuthsessionGetAuthStateData(ctx context.Context, in *authsession.TLAuthsessionGetAuthStateData) (*authsession.AuthKeyStateData, error)
+	AuthsessionDropTempAuthKeys(ctx context.Context, in *authsession.TLAuthsessionDropTempAuthKeys) (*mtproto.Bool, error)
+	AuthsessionGetAuthKeyExpiresAt(ctx context.Context, in *authsession.TLAuthsessionGetAuthKeyExpiresAt) (*mtproto.Int32, error)
+	AuthsessionRegisterDevice(ctx context.Context, in *authsession.TLAuthsessionRegisterDevice) (*mtproto.Bool, error)
+	AuthsessionUnregisterDevice(ctx context.Context, in *authsession.TLAuthsessionUnregisterDevice) (*mtproto.Bool, error)
+	AuthsessionUpdateDeviceLocked(ctx context.Context, in *authsession.TLAuthsessionUpdateDeviceLocked) (*mtproto.Bool, error)
+	AuthsessionGetUserPushDevices(ctx context.Context, in *authsession.TLAuthsessionGetUserPushDevices) (*authsession.Vector_PushDevice, error)
 }
 
 type defaultAuthsessionClient struct {
@@ -123,7 +129,7 @@ func (m *defaultAuthsessionClient) AuthsessionQueryAuthKey(ctx context.Context,
 }
 
 // AuthsessionSetAuthKey
-// authsession.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt = Bool;
+// authsession.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
 func (m *defaultAuthsessionClient) AuthsessionSetAuthKey(ctx context.Context, in *authsession.TLAuthsessionSetAuthKey) (*mtproto.Bool, error) {
 	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
 	return client.AuthsessionSetAuthKey(ctx, in)
@@ -144,14 +150,14 @@ func (m *defaultAuthsessionClient) AuthsessionUnbindAuthKeyUser(ctx context.Cont
 }
 
 // AuthsessionGetPermAuthKeyId
-// authsession.getPermAuthKeyId auth_key_id:long= Int64;
+// authsession.getPermAuthKeyId auth_key_id:long = Int64;
 func (m *defaultAuthsessionClient) AuthsessionGetPermAuthKeyId(ctx context.Context, in *authsession.TLAuthsessionGetPermAuthKeyId) (*mtproto.Int64, error) {
 	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
 	return client.AuthsessionGetPermAuthKeyId(ctx, in)
 }
 
 // AuthsessionBindTempAuthKey
-// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
+// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes temp_auth_key_id:long temp_session_id:long msg_id:long = Bool;
 func (m *defaultAuthsessionClient) AuthsessionBindTempAuthKey(ctx context.Context, in *authsession.TLAuthsessionBindTempAuthKey) (*mtproto.Bool, error) {
 	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
 	return client.AuthsessionBindTempAuthKey(ctx, in)
@@ -177,3 +183,45 @@ func (m *defaultAuthsessionClient) AuthsessionGetAuthStateData(ctx context.Conte
 	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
 	return client.AuthsessionGetAuthStateData(ctx, in)
 }
+
+// AuthsessionDropTempAuthKeys
+// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Bool;
+func (m *defaultAuthsessionClient) AuthsessionDropTempAuthKeys(ctx context.Context, in *authsession.TLAuthsessionDropTempAuthKeys) (*mtproto.Bool, error) {
+	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
+	return client.AuthsessionDropTempAuthKeys(ctx, in)
+}
+
+// AuthsessionGetAuthKeyExpiresAt
+// authsession.getAuthKeyExpiresAt auth_key_id:long = Int32;
+func (m *defaultAuthsessionClient) AuthsessionGetAuthKeyExpiresAt(ctx context.Context, in *authsession.TLAuthsessionGetAuthKeyExpiresAt) (*mtproto.Int32, error) {
+	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
+	return client.AuthsessionGetAuthKeyExpiresAt(ctx, in)
+}
+
+// AuthsessionRegisterDevice
+// authsession.registerDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes other_uids:Vector<long> = Bool;
+func (m *defaultAuthsessionClient) AuthsessionRegisterDevice(ctx context.Context, in *authsession.TLAuthsessionRegisterDevice) (*mtproto.Bool, error) {
+	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
+	return client.AuthsessionRegisterDevice(ctx, in)
+}
+
+// AuthsessionUnregisterDevice
+// authsession.unregisterDevice auth_key_id:long user_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+func (m *defaultAuthsessionClient) AuthsessionUnregisterDevice(ctx context.Context, in *authsession.TLAuthsessionUnregisterDevice) (*mtproto.Bool, error) {
+	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
+	return client.AuthsessionUnregisterDevice(ctx, in)
+}
+
+// AuthsessionUpdateDeviceLocked
+// authsession.updateDeviceLocked auth_key_id:long user_id:long period:int = Bool;
+func (m *defaultAuthsessionClient) AuthsessionUpdateDeviceLocked(ctx context.Context, in *authsession.TLAuthsessionUpdateDeviceLocked) (*mtproto.Bool, error) {
+	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
+	return client.AuthsessionUpdateDeviceLocked(ctx, in)
+}
+
+// AuthsessionGetUserPushDevices
+// authsession.getUserPushDevices user_id:long = Vector<PushDevice>;
+func (m *defaultAuthsessionClient) AuthsessionGetUserPushDevices(ctx context.Context, in *authsession.TLAuthsessionGetUserPushDevices) (*authsession.Vector_PushDevice, error) {
+	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
+	return client.AuthsessionGetUserPushDevices(ctx, in)
+}
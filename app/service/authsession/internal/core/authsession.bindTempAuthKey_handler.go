@@ -10,8 +10,9 @@
 package core
 
 import (
+	"time"
+
 	"github.com/teamgram/proto/mtproto"
-	"github.com/teamgram/proto/mtproto/crypto"
 	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 )
 
@@ -92,7 +93,7 @@ AuthsessionBindTempAuthKey
    }
 ```
 */
-// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
+// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes temp_auth_key_id:long temp_session_id:long msg_id:long = Bool;
 func (c *AuthsessionCore) AuthsessionBindTempAuthKey(in *authsession.TLAuthsessionBindTempAuthKey) (*mtproto.Bool, error) {
 	// 400	ENCRYPTED_MESSAGE_INVALID	Encrypted message is incorrect
 	// 400	INPUT_REQUEST_TOO_LONG	The request is too big
@@ -101,53 +102,45 @@ func (c *AuthsessionCore) AuthsessionBindTempAuthKey(in *authsession.TLAuthsessi
 	// -503	Timeout	Timeout while fetching data
 	//
 
-	keyData, err := c.svcCtx.Dao.QueryAuthKeyV2(c.ctx, in.GetPermAuthKeyId())
+	if err := c.checkBindEncryptedMessage(in); err != nil {
+		return nil, err
+	}
+
+	tempKeyData, err := c.svcCtx.Dao.QueryAuthKeyV2(c.ctx, in.GetTempAuthKeyId())
 	if err != nil {
 		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
 		return nil, err
+	} else if tempKeyData.AuthKeyType != mtproto.AuthKeyTypeTemp && tempKeyData.AuthKeyType != mtproto.AuthKeyTypeMediaTemp {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: auth_key_id %d is not a temp key", in.GetTempAuthKeyId())
+		return nil, mtproto.ErrTempAuthKeyEmpty
+	} else if tempKeyData.PermAuthKeyId != 0 && tempKeyData.PermAuthKeyId != in.GetPermAuthKeyId() {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: temp_auth_key_id %d already bound to %d", in.GetTempAuthKeyId(), tempKeyData.PermAuthKeyId)
+		return nil, mtproto.ErrTempAuthKeyAlreadyBound
 	}
 
-	permAuthKey := crypto.NewAuthKey(in.PermAuthKeyId, keyData.AuthKey)
-	innerData, err := permAuthKey.AesIgeDecryptV1(in.EncryptedMessage[8:8+16], in.EncryptedMessage[8+16:])
+	permKeyData, err := c.svcCtx.Dao.QueryAuthKeyV2(c.ctx, in.GetPermAuthKeyId())
 	if err != nil {
 		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
 		return nil, err
+	} else if permKeyData.AuthKeyType != mtproto.AuthKeyTypePerm {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: auth_key_id %d is not a perm key", in.GetPermAuthKeyId())
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
+
+	if err = c.checkBindAuthKeyInner(in, permKeyData.AuthKey); err != nil {
+		return nil, err
 	}
 
-	// 8+8+8+8
-
-	//// 2. 反序列化出pqInnerData
-	dbuf := mtproto.NewDecodeBuf(innerData[32:])
-	o := dbuf.Object()
-	if dbuf.GetError() != nil {
-		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", dbuf.GetError())
-		return nil, dbuf.GetError()
-	} else if bindAuthKeyInner, ok := o.(*mtproto.TLBindAuthKeyInner); !ok {
-		c.Logger.Errorf("auth.bindTempAuthKey - invalid innerData")
-		return nil, mtproto.ErrInternelServerError
-	} else {
-		// bind_auth_key_inner#75a3f765 nonce:long temp_auth_key_id:long perm_auth_key_id:long temp_session_id:long expires_at:int = BindAuthKeyInner;
-		// bind
-		c.Logger.Infof("auth.bindTempAuthKey - bind_auth_key_inner: %s", bindAuthKeyInner.DebugString())
-		tempKeyData, err2 := c.svcCtx.Dao.QueryAuthKeyV2(c.ctx, bindAuthKeyInner.GetTempAuthKeyId())
-		if err2 != nil {
-			c.Logger.Errorf("auth.bindTempAuthKey - invalid innerData")
-			return nil, mtproto.ErrInternelServerError
-		}
-
-		// TODO: tx wrapper
-		// bindTemp
-		c.svcCtx.Dao.UnsafeBindKeyIdV2(c.ctx,
-			bindAuthKeyInner.GetPermAuthKeyId(),
-			tempKeyData.AuthKeyType,
-			bindAuthKeyInner.GetTempAuthKeyId())
-
-		// TODO: expiredIn int32
-		// bindPerm
-		c.svcCtx.Dao.UnsafeBindKeyIdV2(c.ctx,
-			bindAuthKeyInner.GetTempAuthKeyId(),
-			mtproto.AuthKeyTypePerm,
-			bindAuthKeyInner.GetPermAuthKeyId())
+	keyExpiresAt, _ := c.svcCtx.Dao.GetAuthKeyExpiresAt(c.ctx, in.GetTempAuthKeyId())
+	expiresAt, err := c.bindExpiresAt(in, keyExpiresAt, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.svcCtx.Dao.BindTempAuthKeyV2(c.ctx, permKeyData, tempKeyData, expiresAt)
+	if err != nil {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
+		return nil, err
 	}
 
 	return mtproto.BoolTrue, nil
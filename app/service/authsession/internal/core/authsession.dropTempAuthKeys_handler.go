@@ -0,0 +1,38 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// AuthsessionDropTempAuthKeys
+// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Bool;
+func (c *AuthsessionCore) AuthsessionDropTempAuthKeys(in *authsession.TLAuthsessionDropTempAuthKeys) (*mtproto.Bool, error) {
+	permKeyData, err := c.svcCtx.Dao.QueryAuthKeyV2(c.ctx, in.GetPermAuthKeyId())
+	if err != nil {
+		c.Logger.Errorf("authsession.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	} else if permKeyData.AuthKeyType != mtproto.AuthKeyTypePerm {
+		err = mtproto.ErrAuthKeyPermEmpty
+		c.Logger.Errorf("authsession.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	}
+
+	dropList, err := c.svcCtx.Dao.DropTempAuthKeys(c.ctx, permKeyData, in.GetExceptAuthKeys())
+	if err != nil {
+		c.Logger.Errorf("authsession.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	}
+	c.Logger.Infof("authsession.dropTempAuthKeys - dropped: %v", dropList)
+
+	return mtproto.BoolTrue, nil
+}
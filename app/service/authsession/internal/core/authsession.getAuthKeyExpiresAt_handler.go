@@ -0,0 +1,29 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// AuthsessionGetAuthKeyExpiresAt
+// authsession.getAuthKeyExpiresAt auth_key_id:long = Int32;
+func (c *AuthsessionCore) AuthsessionGetAuthKeyExpiresAt(in *authsession.TLAuthsessionGetAuthKeyExpiresAt) (*mtproto.Int32, error) {
+	expiresAt, err := c.svcCtx.Dao.GetAuthKeyExpiresAt(c.ctx, in.GetAuthKeyId())
+	if err != nil {
+		c.Logger.Errorf("authsession.getAuthKeyExpiresAt - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLInt32(&mtproto.Int32{
+		V: int32(expiresAt),
+	}).To_Int32(), nil
+}
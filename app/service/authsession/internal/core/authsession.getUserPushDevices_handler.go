@@ -0,0 +1,28 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// AuthsessionGetUserPushDevices
+// authsession.getUserPushDevices user_id:long = Vector<PushDevice>;
+func (c *AuthsessionCore) AuthsessionGetUserPushDevices(in *authsession.TLAuthsessionGetUserPushDevices) (*authsession.Vector_PushDevice, error) {
+	devices, err := c.svcCtx.Dao.GetUserPushDevices(c.ctx, in.GetUserId())
+	if err != nil {
+		c.Logger.Errorf("authsession.getUserPushDevices - error: %v", err)
+		return nil, err
+	}
+
+	return &authsession.Vector_PushDevice{
+		Datas: devices,
+	}, nil
+}
@@ -0,0 +1,36 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// AuthsessionRegisterDevice
+// authsession.registerDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes other_uids:Vector<long> = Bool;
+func (c *AuthsessionCore) AuthsessionRegisterDevice(in *authsession.TLAuthsessionRegisterDevice) (*mtproto.Bool, error) {
+	err := c.svcCtx.Dao.RegisterDevice(c.ctx, authsession.MakeTLPushDevice(&authsession.PushDevice{
+		NoMuted:    in.GetNoMuted(),
+		AppSandbox: in.GetAppSandbox(),
+		AuthKeyId:  in.GetAuthKeyId(),
+		UserId:     in.GetUserId(),
+		TokenType:  in.GetTokenType(),
+		Token:      in.GetToken(),
+		Secret:     in.GetSecret(),
+		OtherUids:  in.GetOtherUids(),
+	}).To_PushDevice())
+	if err != nil {
+		c.Logger.Errorf("authsession.registerDevice - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
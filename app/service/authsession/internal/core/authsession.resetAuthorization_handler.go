@@ -38,19 +38,22 @@ func (c *AuthsessionCore) AuthsessionResetAuthorization(in *authsession.TLAuthse
 	keyIdList := c.svcCtx.Dao.ResetAuthorization(c.ctx, in.UserId, excludeKeyId, in.Hash)
 	// log.Debugf("keyIdList: %v", keyIdList)
 
+	// sessions of pfs clients live on the temp keys bound to the perm key
 	keyIdL2ist := make([]int64, 0, len(keyIdList))
 	for _, keyId := range keyIdList {
+		keyIdL2ist = append(keyIdL2ist, keyId)
 		keyData, _ := c.svcCtx.Dao.QueryAuthKeyV2(c.ctx, keyId)
 		if keyData != nil {
 			if keyData.TempAuthKeyId != 0 {
 				keyIdL2ist = append(keyIdL2ist, keyData.TempAuthKeyId)
-			} else {
-				keyIdL2ist = append(keyIdL2ist, keyId)
+			}
+			if keyData.MediaTempAuthKeyId != 0 {
+				keyIdL2ist = append(keyIdL2ist, keyData.MediaTempAuthKeyId)
 			}
 		}
 	}
 
 	return &authsession.Vector_Long{
-		Datas: keyIdList,
+		Datas: keyIdL2ist,
 	}, nil
 }
@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// AuthsessionUnregisterDevice
+// authsession.unregisterDevice auth_key_id:long user_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+func (c *AuthsessionCore) AuthsessionUnregisterDevice(in *authsession.TLAuthsessionUnregisterDevice) (*mtproto.Bool, error) {
+	err := c.svcCtx.Dao.UnregisterDevice(c.ctx, in.GetUserId(), in.GetTokenType(), in.GetToken(), in.GetOtherUids())
+	if err != nil {
+		c.Logger.Errorf("authsession.unregisterDevice - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// AuthsessionUpdateDeviceLocked
+// authsession.updateDeviceLocked auth_key_id:long user_id:long period:int = Bool;
+func (c *AuthsessionCore) AuthsessionUpdateDeviceLocked(in *authsession.TLAuthsessionUpdateDeviceLocked) (*mtproto.Bool, error) {
+	err := c.svcCtx.Dao.UpdateDeviceLocked(c.ctx, in.GetAuthKeyId(), in.GetUserId(), in.GetPeriod())
+	if err != nil {
+		c.Logger.Errorf("authsession.updateDeviceLocked - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
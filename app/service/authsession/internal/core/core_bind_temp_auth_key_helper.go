@@ -0,0 +1,101 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"encoding/binary"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/crypto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+// checkBindEncryptedMessage
+// encrypted_message is an MTProto v1 message encrypted with the perm key:
+// perm_auth_key_id(8) msg_key(16) aes_ige(salt(8) session_id(8) msg_id(8) seq_no(4) msg_len(4) bind_auth_key_inner(40) padding)
+func (c *AuthsessionCore) checkBindEncryptedMessage(in *authsession.TLAuthsessionBindTempAuthKey) error {
+	encryptedMessage := in.GetEncryptedMessage()
+	if len(encryptedMessage) < 8+16+32+40 || (len(encryptedMessage)-8-16)%16 != 0 {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: invalid encrypted_message len %d", len(encryptedMessage))
+		return mtproto.ErrEncryptedMessageInvalid
+	} else if int64(binary.LittleEndian.Uint64(encryptedMessage)) != in.GetPermAuthKeyId() {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: encrypted_message not encrypted by perm_auth_key_id %d", in.GetPermAuthKeyId())
+		return mtproto.ErrEncryptedMessageInvalid
+	}
+
+	return nil
+}
+
+// checkBindAuthKeyInner
+// decrypts encrypted_message with the perm key and checks the wrapped bind_auth_key_inner against the request.
+func (c *AuthsessionCore) checkBindAuthKeyInner(in *authsession.TLAuthsessionBindTempAuthKey, permAuthKey []byte) error {
+	encryptedMessage := in.GetEncryptedMessage()
+
+	innerData, err := crypto.NewAuthKey(in.GetPermAuthKeyId(), permAuthKey).AesIgeDecryptV1(encryptedMessage[8:8+16], encryptedMessage[8+16:])
+	if err != nil {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
+		return mtproto.ErrEncryptedMessageInvalid
+	}
+
+	// the wrapped message must carry the msg_id of the auth.bindTempAuthKey request itself
+	if int64(binary.LittleEndian.Uint64(innerData[16:])) != in.GetMsgId() {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: msg_id mismatch")
+		return mtproto.ErrEncryptedMessageInvalid
+	}
+
+	dbuf := mtproto.NewDecodeBuf(innerData[32:])
+	o := dbuf.Object()
+	if dbuf.GetError() != nil {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", dbuf.GetError())
+		return mtproto.ErrEncryptedMessageInvalid
+	}
+	bindAuthKeyInner, ok := o.(*mtproto.TLBindAuthKeyInner)
+	if !ok {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: invalid innerData")
+		return mtproto.ErrEncryptedMessageInvalid
+	}
+
+	// bind_auth_key_inner#75a3f765 nonce:long temp_auth_key_id:long perm_auth_key_id:long temp_session_id:long expires_at:int = BindAuthKeyInner;
+	c.Logger.Infof("auth.bindTempAuthKey - bind_auth_key_inner: %s", bindAuthKeyInner.DebugString())
+	if bindAuthKeyInner.GetNonce() != in.GetNonce() ||
+		bindAuthKeyInner.GetTempAuthKeyId() != in.GetTempAuthKeyId() ||
+		bindAuthKeyInner.GetPermAuthKeyId() != in.GetPermAuthKeyId() ||
+		bindAuthKeyInner.GetTempSessionId() != in.GetTempSessionId() ||
+		bindAuthKeyInner.GetExpiresAt() != in.GetExpiresAt() {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: bind_auth_key_inner mismatch")
+		return mtproto.ErrEncryptedMessageInvalid
+	}
+
+	return nil
+}
+
+// bindExpiresAt
+// the binding never outlives the temp key itself, keyExpiresAt is 0 if unknown.
+func (c *AuthsessionCore) bindExpiresAt(in *authsession.TLAuthsessionBindTempAuthKey, keyExpiresAt, now int64) (int64, error) {
+	expiresAt := int64(in.GetExpiresAt())
+	if keyExpiresAt != 0 && keyExpiresAt < expiresAt {
+		expiresAt = keyExpiresAt
+	}
+	if expiresAt <= now {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: expires_at %d already passed", expiresAt)
+		return 0, mtproto.ErrEncryptedMessageInvalid
+	}
+
+	return expiresAt, nil
+}
@@ -0,0 +1,272 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/crypto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+)
+
+const (
+	testPermAuthKeyId = 0x0102030405060708
+	testTempAuthKeyId = 0x1112131415161718
+	testTempSessionId = 0x2122232425262728
+	testNonce         = 0x3132333435363738
+	testMsgId         = 0x6000000000000004
+	testExpiresAt     = 1700086400
+)
+
+func testPermAuthKey(seed byte) []byte {
+	k := make([]byte, 256)
+	for i := range k {
+		k[i] = byte(i) ^ seed
+	}
+	return k
+}
+
+// makeBindEncryptedMessage mirrors the android client, it wraps inner in an
+// MTProto v1 message carrying msgId and encrypts it with permAuthKey.
+func makeBindEncryptedMessage(t *testing.T, permAuthKey []byte, msgId int64, inner []byte) []byte {
+	raw := make([]byte, 32, 32+len(inner))
+	binary.LittleEndian.PutUint64(raw[16:], uint64(msgId))
+	binary.LittleEndian.PutUint32(raw[28:], uint32(len(inner)))
+	raw = append(raw, inner...)
+
+	msgKey, data, err := crypto.NewClientAuthKey(testPermAuthKeyId, permAuthKey).AesIgeEncryptV1(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := make([]byte, 8, 8+len(msgKey)+len(data))
+	binary.LittleEndian.PutUint64(b, uint64(testPermAuthKeyId))
+	b = append(b, msgKey...)
+	return append(b, data...)
+}
+
+func makeBindAuthKeyInner(f func(inner *mtproto.BindAuthKeyInner)) []byte {
+	inner := &mtproto.BindAuthKeyInner{
+		Nonce:         testNonce,
+		TempAuthKeyId: testTempAuthKeyId,
+		PermAuthKeyId: testPermAuthKeyId,
+		TempSessionId: testTempSessionId,
+		ExpiresAt:     testExpiresAt,
+	}
+	if f != nil {
+		f(inner)
+	}
+
+	x := mtproto.NewEncodeBuf(64)
+	x.UInt(0x75a3f765)
+	x.Long(inner.Nonce)
+	x.Long(inner.TempAuthKeyId)
+	x.Long(inner.PermAuthKeyId)
+	x.Long(inner.TempSessionId)
+	x.Int(inner.ExpiresAt)
+	return x.GetBuf()
+}
+
+func makeBindTempAuthKey(encryptedMessage []byte) *authsession.TLAuthsessionBindTempAuthKey {
+	return &authsession.TLAuthsessionBindTempAuthKey{
+		PermAuthKeyId:    testPermAuthKeyId,
+		Nonce:            testNonce,
+		ExpiresAt:        testExpiresAt,
+		EncryptedMessage: encryptedMessage,
+		TempAuthKeyId:    testTempAuthKeyId,
+		TempSessionId:    testTempSessionId,
+		MsgId:            testMsgId,
+	}
+}
+
+func TestCheckBindTempAuthKey(t *testing.T) {
+	var (
+		c       = New(context.Background(), nil)
+		permKey = testPermAuthKey(0)
+	)
+
+	tests := []struct {
+		name string
+		in   func() *authsession.TLAuthsessionBindTempAuthKey
+		err  error
+	}{
+		{
+			name: "valid",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, makeBindAuthKeyInner(nil)))
+			},
+		},
+		{
+			name: "encrypted_message too short",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, makeBindAuthKeyInner(nil))[:64])
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "wrong perm_auth_key_id",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				in := makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, makeBindAuthKeyInner(nil)))
+				in.PermAuthKeyId++
+				return in
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "encrypted by another perm key",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, testPermAuthKey(0x5a), testMsgId, makeBindAuthKeyInner(nil)))
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "msg_id mismatch",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId+4, makeBindAuthKeyInner(nil)))
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "not a bind_auth_key_inner",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				inner := makeBindAuthKeyInner(nil)
+				binary.LittleEndian.PutUint32(inner, 0x997275b5) // boolTrue
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, inner))
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "inner nonce mismatch",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, makeBindAuthKeyInner(func(inner *mtproto.BindAuthKeyInner) {
+					inner.Nonce++
+				})))
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "inner temp_auth_key_id mismatch",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, makeBindAuthKeyInner(func(inner *mtproto.BindAuthKeyInner) {
+					inner.TempAuthKeyId++
+				})))
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "inner perm_auth_key_id mismatch",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, makeBindAuthKeyInner(func(inner *mtproto.BindAuthKeyInner) {
+					inner.PermAuthKeyId++
+				})))
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "inner temp_session_id mismatch",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, makeBindAuthKeyInner(func(inner *mtproto.BindAuthKeyInner) {
+					inner.TempSessionId++
+				})))
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name: "inner expires_at mismatch",
+			in: func() *authsession.TLAuthsessionBindTempAuthKey {
+				return makeBindTempAuthKey(makeBindEncryptedMessage(t, permKey, testMsgId, makeBindAuthKeyInner(func(inner *mtproto.BindAuthKeyInner) {
+					inner.ExpiresAt += 3600
+				})))
+			},
+			err: mtproto.ErrEncryptedMessageInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := tt.in()
+			err := c.checkBindEncryptedMessage(in)
+			if err == nil {
+				err = c.checkBindAuthKeyInner(in, permKey)
+			}
+			if err != tt.err {
+				t.Errorf("got error %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestBindExpiresAt(t *testing.T) {
+	var (
+		c   = New(context.Background(), nil)
+		now = int64(testExpiresAt - 3600)
+	)
+
+	tests := []struct {
+		name         string
+		keyExpiresAt int64
+		now          int64
+		expiresAt    int64
+		err          error
+	}{
+		{
+			name:      "expires_in unknown",
+			now:       now,
+			expiresAt: testExpiresAt,
+		},
+		{
+			name:         "capped by the temp key",
+			keyExpiresAt: testExpiresAt - 60,
+			now:          now,
+			expiresAt:    testExpiresAt - 60,
+		},
+		{
+			name:         "temp key outlives the binding",
+			keyExpiresAt: testExpiresAt + 60,
+			now:          now,
+			expiresAt:    testExpiresAt,
+		},
+		{
+			name: "expires_at already passed",
+			now:  testExpiresAt,
+			err:  mtproto.ErrEncryptedMessageInvalid,
+		},
+		{
+			name:         "temp key already expired",
+			keyExpiresAt: now - 1,
+			now:          now,
+			err:          mtproto.ErrEncryptedMessageInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiresAt, err := c.bindExpiresAt(makeBindTempAuthKey(nil), tt.keyExpiresAt, tt.now)
+			if err != tt.err {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+			if expiresAt != tt.expiresAt {
+				t.Errorf("got expires_at %d, want %d", expiresAt, tt.expiresAt)
+			}
+		})
+	}
+}
@@ -31,11 +31,11 @@ func NewDevicesDAO(db *sqlx.DB) *DevicesDAO {
 }
 
 // InsertOrUpdate
-// insert into devices(auth_key_id, user_id, token_type, token, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), secret = values(secret), other_uids = values(other_uids), state = 0
+// insert into devices(auth_key_id, user_id, token_type, token, no_muted, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :no_muted, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), no_muted = values(no_muted), app_sandbox = values(app_sandbox), secret = values(secret), other_uids = values(other_uids), state = 0
 // TODO(@benqi): sqlmap
 func (dao *DevicesDAO) InsertOrUpdate(ctx context.Context, do *dataobject.DevicesDO) (lastInsertId, rowsAffected int64, err error) {
 	var (
-		query = "insert into devices(auth_key_id, user_id, token_type, token, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), secret = values(secret), other_uids = values(other_uids), state = 0"
+		query = "insert into devices(auth_key_id, user_id, token_type, token, no_muted, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :no_muted, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), no_muted = values(no_muted), app_sandbox = values(app_sandbox), secret = values(secret), other_uids = values(other_uids), state = 0"
 		r     sql.Result
 	)
 
@@ -59,11 +59,11 @@ func (dao *DevicesDAO) InsertOrUpdate(ctx context.Context, do *dataobject.Device
 }
 
 // InsertOrUpdateTx
-// insert into devices(auth_key_id, user_id, token_type, token, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), secret = values(secret), other_uids = values(other_uids), state = 0
+// insert into devices(auth_key_id, user_id, token_type, token, no_muted, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :no_muted, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), no_muted = values(no_muted), app_sandbox = values(app_sandbox), secret = values(secret), other_uids = values(other_uids), state = 0
 // TODO(@benqi): sqlmap
 func (dao *DevicesDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.DevicesDO) (lastInsertId, rowsAffected int64, err error) {
 	var (
-		query = "insert into devices(auth_key_id, user_id, token_type, token, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), secret = values(secret), other_uids = values(other_uids), state = 0"
+		query = "insert into devices(auth_key_id, user_id, token_type, token, no_muted, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :no_muted, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), no_muted = values(no_muted), app_sandbox = values(app_sandbox), secret = values(secret), other_uids = values(other_uids), state = 0"
 		r     sql.Result
 	)
 
@@ -110,6 +110,52 @@ func (dao *DevicesDAO) Select(ctx context.Context, auth_key_id int64, user_id in
 	return
 }
 
+// SelectListByUserId
+// select id, auth_key_id, user_id, token_type, token, no_muted, locked_period, app_sandbox, secret, other_uids from devices where user_id = :user_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) SelectListByUserId(ctx context.Context, user_id int64) (rList []dataobject.DevicesDO, err error) {
+	var (
+		query  = "select id, auth_key_id, user_id, token_type, token, no_muted, locked_period, app_sandbox, secret, other_uids from devices where user_id = ? and state = 0"
+		values []dataobject.DevicesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByUserId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByUserIdWithCB
+// select id, auth_key_id, user_id, token_type, token, no_muted, locked_period, app_sandbox, secret, other_uids from devices where user_id = :user_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) SelectListByUserIdWithCB(ctx context.Context, user_id int64, cb func(i int, v *dataobject.DevicesDO)) (rList []dataobject.DevicesDO, err error) {
+	var (
+		query  = "select id, auth_key_id, user_id, token_type, token, no_muted, locked_period, app_sandbox, secret, other_uids from devices where user_id = ? and state = 0"
+		values []dataobject.DevicesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByUserId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
 // SelectListById
 // select id, auth_key_id, user_id, token_type, token from devices where token_type = :token_type and token = :token and state = 1
 // TODO(@benqi): sqlmap
@@ -157,14 +203,14 @@ func (dao *DevicesDAO) SelectListByIdWithCB(ctx context.Context, token_type int3
 }
 
 // UpdateState
-// update devices set state = :state where auth_key_id = :auth_key_id and user_id = :user_id and token_type
+// update devices set state = :state where auth_key_id = :auth_key_id and user_id = :user_id and token_type = :token_type
 // TODO(@benqi): sqlmap
-func (dao *DevicesDAO) UpdateState(ctx context.Context, state bool, auth_key_id int64, user_id int64) (rowsAffected int64, err error) {
+func (dao *DevicesDAO) UpdateState(ctx context.Context, state bool, auth_key_id int64, user_id int64, token_type int32) (rowsAffected int64, err error) {
 	var (
-		query   = "update devices set state = ? where auth_key_id = ? and user_id = ? and token_type"
+		query   = "update devices set state = ? where auth_key_id = ? and user_id = ? and token_type = ?"
 		rResult sql.Result
 	)
-	rResult, err = dao.db.Exec(ctx, query, state, auth_key_id, user_id)
+	rResult, err = dao.db.Exec(ctx, query, state, auth_key_id, user_id, token_type)
 
 	if err != nil {
 		logx.WithContext(ctx).Errorf("exec in UpdateState(_), error: %v", err)
@@ -179,15 +225,15 @@ func (dao *DevicesDAO) UpdateState(ctx context.Context, state bool, auth_key_id
 	return
 }
 
-// update devices set state = :state where auth_key_id = :auth_key_id and user_id = :user_id and token_type
+// update devices set state = :state where auth_key_id = :auth_key_id and user_id = :user_id and token_type = :token_type
 // UpdateStateTx
 // TODO(@benqi): sqlmap
-func (dao *DevicesDAO) UpdateStateTx(tx *sqlx.Tx, state bool, auth_key_id int64, user_id int64) (rowsAffected int64, err error) {
+func (dao *DevicesDAO) UpdateStateTx(tx *sqlx.Tx, state bool, auth_key_id int64, user_id int64, token_type int32) (rowsAffected int64, err error) {
 	var (
-		query   = "update devices set state = ? where auth_key_id = ? and user_id = ? and token_type"
+		query   = "update devices set state = ? where auth_key_id = ? and user_id = ? and token_type = ?"
 		rResult sql.Result
 	)
-	rResult, err = tx.Exec(query, state, auth_key_id, user_id)
+	rResult, err = tx.Exec(query, state, auth_key_id, user_id, token_type)
 
 	if err != nil {
 		logx.WithContext(tx.Context()).Errorf("exec in UpdateState(_), error: %v", err)
@@ -202,6 +248,53 @@ func (dao *DevicesDAO) UpdateStateTx(tx *sqlx.Tx, state bool, auth_key_id int64,
 	return
 }
 
+// UpdateLockedPeriod
+// update devices set locked_period = :locked_period where auth_key_id = :auth_key_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateLockedPeriod(ctx context.Context, locked_period int32, auth_key_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set locked_period = ? where auth_key_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+
+	rResult, err = dao.db.Exec(ctx, query, locked_period, auth_key_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateLockedPeriod(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateLockedPeriod(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateLockedPeriodTx
+// update devices set locked_period = :locked_period where auth_key_id = :auth_key_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateLockedPeriodTx(tx *sqlx.Tx, locked_period int32, auth_key_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set locked_period = ? where auth_key_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, locked_period, auth_key_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateLockedPeriod(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateLockedPeriod(_), error: %v", err)
+	}
+
+	return
+}
+
 // UpdateStateById
 // update devices set state = :state where id = :id
 // TODO(@benqi): sqlmap
@@ -293,3 +386,50 @@ func (dao *DevicesDAO) UpdateStateByTokenTx(tx *sqlx.Tx, state bool, token_type
 
 	return
 }
+
+// UpdateStateByUserToken
+// update devices set state = :state where user_id = :user_id and token_type = :token_type and token = :token
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateStateByUserToken(ctx context.Context, state bool, user_id int64, token_type int32, token string) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set state = ? where user_id = ? and token_type = ? and token = ?"
+		rResult sql.Result
+	)
+
+	rResult, err = dao.db.Exec(ctx, query, state, user_id, token_type, token)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateStateByUserToken(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateStateByUserToken(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateStateByUserTokenTx
+// update devices set state = :state where user_id = :user_id and token_type = :token_type and token = :token
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateStateByUserTokenTx(tx *sqlx.Tx, state bool, user_id int64, token_type int32, token string) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set state = ? where user_id = ? and token_type = ? and token = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, state, user_id, token_type, token)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateStateByUserToken(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateStateByUserToken(_), error: %v", err)
+	}
+
+	return
+}
@@ -18,4 +18,5 @@ type AuthKeyInfosDO struct {
 	TempAuthKeyId      int64 `db:"temp_auth_key_id"`
 	MediaTempAuthKeyId int64 `db:"media_temp_auth_key_id"`
 	Deleted            bool  `db:"deleted"`
+	ExpiresAt          int64 `db:"expires_at"`
 }
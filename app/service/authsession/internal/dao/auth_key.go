@@ -23,7 +23,9 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/teamgram/marmota/pkg/container2"
 	"github.com/teamgram/marmota/pkg/stores/sqlc"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	"github.com/teamgram/proto/mtproto"
@@ -142,24 +144,38 @@ func (d *Dao) getAuthKey(ctx context.Context, keyId int64) (keyData *mtproto.Aut
 	return
 }
 
-func (d *Dao) QueryAuthKeyV2(ctx context.Context, authKeyId int64) (*mtproto.AuthKeyInfo, error) {
+// authKeyData is the cached auth_key_infos row with its key body.
+// ExpiresAt is 0 for keys that never expire.
+type authKeyData struct {
+	*mtproto.AuthKeyInfo
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+}
+
+func (k *authKeyData) isExpired() bool {
+	return k.ExpiresAt != 0 && k.ExpiresAt <= time.Now().Unix()
+}
+
+func (d *Dao) queryAuthKeyData(ctx context.Context, authKeyId int64) (*authKeyData, error) {
 	var (
-		keyInfo = mtproto.MakeTLAuthKeyInfo(&mtproto.AuthKeyInfo{
-			AuthKeyId:          authKeyId,
-			AuthKey:            nil,
-			AuthKeyType:        0,
-			PermAuthKeyId:      0,
-			TempAuthKeyId:      0,
-			MediaTempAuthKeyId: 0,
-		}).To_AuthKeyInfo()
+		keyData = &authKeyData{
+			AuthKeyInfo: mtproto.MakeTLAuthKeyInfo(&mtproto.AuthKeyInfo{
+				AuthKeyId:          authKeyId,
+				AuthKey:            nil,
+				AuthKeyType:        0,
+				PermAuthKeyId:      0,
+				TempAuthKeyId:      0,
+				MediaTempAuthKeyId: 0,
+			}).To_AuthKeyInfo(),
+		}
 	)
 
 	err := d.CachedConn.QueryRow(
 		ctx,
-		keyInfo,
+		keyData,
 		genCacheAuthKeyV2Key(authKeyId),
 		func(ctx context.Context, conn *sqlx.DB, v interface{}) error {
-			kInfo := v.(*mtproto.AuthKeyInfo)
+			kData := v.(*authKeyData)
+			kInfo := kData.AuthKeyInfo
 			err := mr.Finish(
 				func() error {
 					do1, err2 := d.AuthKeyInfosDAO.SelectByAuthKeyId(ctx, authKeyId)
@@ -172,6 +188,7 @@ func (d *Dao) QueryAuthKeyV2(ctx context.Context, authKeyId int64) (*mtproto.Aut
 					kInfo.PermAuthKeyId = do1.PermAuthKeyId
 					kInfo.TempAuthKeyId = do1.TempAuthKeyId
 					kInfo.MediaTempAuthKeyId = do1.MediaTempAuthKeyId
+					kData.ExpiresAt = do1.ExpiresAt
 
 					return nil
 				},
@@ -202,11 +219,11 @@ func (d *Dao) QueryAuthKeyV2(ctx context.Context, authKeyId int64) (*mtproto.Aut
 						d.AuthKeyInfosDAO.Insert(
 							contextx.ValueOnlyFrom(ctx),
 							&dataobject.AuthKeyInfosDO{
-								AuthKeyId:          keyInfo.AuthKeyId,
-								AuthKeyType:        keyInfo.AuthKeyType,
-								PermAuthKeyId:      keyInfo.PermAuthKeyId,
-								TempAuthKeyId:      keyInfo.TempAuthKeyId,
-								MediaTempAuthKeyId: keyInfo.MediaTempAuthKeyId,
+								AuthKeyId:          kInfo.AuthKeyId,
+								AuthKeyType:        kInfo.AuthKeyType,
+								PermAuthKeyId:      kInfo.PermAuthKeyId,
+								TempAuthKeyId:      kInfo.TempAuthKeyId,
+								MediaTempAuthKeyId: kInfo.MediaTempAuthKeyId,
 								Deleted:            false,
 							})
 					})
@@ -224,12 +241,42 @@ func (d *Dao) QueryAuthKeyV2(ctx context.Context, authKeyId int64) (*mtproto.Aut
 		return nil, err
 	}
 
-	return keyInfo, nil
+	return keyData, nil
+}
+
+// QueryAuthKeyV2 returns ErrAuthKeyInvalid for temp keys past their expires_at.
+func (d *Dao) QueryAuthKeyV2(ctx context.Context, authKeyId int64) (*mtproto.AuthKeyInfo, error) {
+	keyData, err := d.queryAuthKeyData(ctx, authKeyId)
+	if err != nil {
+		return nil, err
+	} else if keyData.isExpired() {
+		return nil, mtproto.ErrAuthKeyInvalid
+	}
+
+	return keyData.AuthKeyInfo, nil
+}
+
+// GetAuthKeyExpiresAt returns 0 if the key never expires.
+func (d *Dao) GetAuthKeyExpiresAt(ctx context.Context, authKeyId int64) (int64, error) {
+	keyData, err := d.queryAuthKeyData(ctx, authKeyId)
+	if err != nil {
+		return 0, err
+	} else if keyData.isExpired() {
+		return 0, mtproto.ErrAuthKeyInvalid
+	}
+
+	return keyData.ExpiresAt, nil
 }
 
 func (d *Dao) SetAuthKeyV2(ctx context.Context, authKey *mtproto.AuthKeyInfo, expiredIn int32) (err error) {
-	// TODO(@benqi): expiredIn
-	_ = expiredIn
+	var (
+		expiresAt int64
+	)
+
+	// p_q_inner_data_temp: the key is dropped after expires_in seconds
+	if expiredIn > 0 {
+		expiresAt = time.Now().Unix() + int64(expiredIn)
+	}
 
 	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
 		_, _, err = d.AuthKeysDAO.InsertTx(tx, &dataobject.AuthKeysDO{
@@ -247,6 +294,7 @@ func (d *Dao) SetAuthKeyV2(ctx context.Context, authKey *mtproto.AuthKeyInfo, ex
 			TempAuthKeyId:      authKey.TempAuthKeyId,
 			MediaTempAuthKeyId: authKey.MediaTempAuthKeyId,
 			Deleted:            false,
+			ExpiresAt:          expiresAt,
 		})
 		if err != nil {
 			result.Err = err
@@ -256,48 +304,140 @@ func (d *Dao) SetAuthKeyV2(ctx context.Context, authKey *mtproto.AuthKeyInfo, ex
 	return tR.Err
 }
 
-func (d *Dao) UnsafeBindKeyIdV2(ctx context.Context, keyId int64, bindType int32, bindKeyId int64) (err error) {
+func tempAuthKeyIdField(keyType int32) string {
+	switch keyType {
+	case mtproto.AuthKeyTypeTemp:
+		return "temp_auth_key_id"
+	case mtproto.AuthKeyTypeMediaTemp:
+		return "media_temp_auth_key_id"
+	}
+	return ""
+}
+
+// BindTempAuthKeyV2 binds tempKey to permKey, each perm key holds one temp key
+// per key type, so a previously bound temp key of the same type is unbound.
+func (d *Dao) BindTempAuthKeyV2(ctx context.Context, permKey, tempKey *mtproto.AuthKeyInfo, expiresAt int64) error {
 	var (
-		key = genCacheAuthKeyV2Key(keyId)
+		field        = tempAuthKeyIdField(tempKey.AuthKeyType)
+		oldTempKeyId int64
+		keys         = []string{genCacheAuthKeyV2Key(permKey.AuthKeyId), genCacheAuthKeyV2Key(tempKey.AuthKeyId)}
 	)
 
-	_, _, err = d.CachedConn.Exec(
+	if field == "" {
+		return mtproto.ErrTempAuthKeyEmpty
+	}
+	if tempKey.AuthKeyType == mtproto.AuthKeyTypeTemp {
+		oldTempKeyId = permKey.TempAuthKeyId
+	} else {
+		oldTempKeyId = permKey.MediaTempAuthKeyId
+	}
+	if oldTempKeyId == tempKey.AuthKeyId {
+		oldTempKeyId = 0
+	}
+	if oldTempKeyId != 0 {
+		keys = append(keys, genCacheAuthKeyV2Key(oldTempKeyId))
+	}
+
+	_, _, err := d.CachedConn.Exec(
 		ctx,
 		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
-			//
-			var (
-				err2 error
-			)
-
-			switch bindType {
-			case mtproto.AuthKeyTypePerm:
-				_, err2 = d.AuthKeyInfosDAO.UpdateCustomMap(
-					ctx,
-					map[string]interface{}{
-						"perm_auth_key_id": bindKeyId,
-					},
-					keyId)
-			case mtproto.AuthKeyTypeTemp:
-				_, err2 = d.AuthKeyInfosDAO.UpdateCustomMap(
-					ctx,
+			tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				if oldTempKeyId != 0 {
+					_, result.Err = d.AuthKeyInfosDAO.UpdateCustomMapTx(
+						tx,
+						map[string]interface{}{
+							"perm_auth_key_id": 0,
+						},
+						oldTempKeyId)
+					if result.Err != nil {
+						return
+					}
+				}
+				_, result.Err = d.AuthKeyInfosDAO.UpdateCustomMapTx(
+					tx,
 					map[string]interface{}{
-						"temp_auth_key_id": bindKeyId,
+						field: tempKey.AuthKeyId,
 					},
-					keyId)
-			case mtproto.AuthKeyTypeMediaTemp:
-				_, err2 = d.AuthKeyInfosDAO.UpdateCustomMap(
-					ctx,
+					permKey.AuthKeyId)
+				if result.Err != nil {
+					return
+				}
+				_, result.Err = d.AuthKeyInfosDAO.UpdateCustomMapTx(
+					tx,
 					map[string]interface{}{
-						"media_temp_auth_key_id": bindKeyId,
+						"perm_auth_key_id": permKey.AuthKeyId,
+						"expires_at":       expiresAt,
 					},
-					keyId)
-			}
+					tempKey.AuthKeyId)
+			})
+			return 0, 0, tR.Err
+		},
+		keys...)
+
+	return err
+}
 
-			return 0, 0, err2
+// DropTempAuthKeys unbinds and expires the temp keys of permKey not listed in exceptKeyIdList,
+// it returns the dropped key ids.
+func (d *Dao) DropTempAuthKeys(ctx context.Context, permKey *mtproto.AuthKeyInfo, exceptKeyIdList []int64) ([]int64, error) {
+	var (
+		permCMap  = make(map[string]interface{})
+		dropList  []int64
+		keys      = []string{genCacheAuthKeyV2Key(permKey.AuthKeyId)}
+		expiresAt = time.Now().Unix()
+	)
+
+	for _, v := range []struct {
+		field string
+		keyId int64
+	}{
+		{"temp_auth_key_id", permKey.TempAuthKeyId},
+		{"media_temp_auth_key_id", permKey.MediaTempAuthKeyId},
+	} {
+		if v.keyId == 0 {
+			continue
+		}
+		if ok, _ := container2.Contains(v.keyId, exceptKeyIdList); ok {
+			continue
+		}
+		permCMap[v.field] = 0
+		dropList = append(dropList, v.keyId)
+		keys = append(keys, genCacheAuthKeyV2Key(v.keyId))
+	}
+
+	if len(dropList) == 0 {
+		return nil, nil
+	}
+
+	_, _, err := d.CachedConn.Exec(
+		ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				_, result.Err = d.AuthKeyInfosDAO.UpdateCustomMapTx(tx, permCMap, permKey.AuthKeyId)
+				if result.Err != nil {
+					return
+				}
+				for _, id := range dropList {
+					_, result.Err = d.AuthKeyInfosDAO.UpdateCustomMapTx(
+						tx,
+						map[string]interface{}{
+							"perm_auth_key_id": 0,
+							"expires_at":       expiresAt,
+						},
+						id)
+					if result.Err != nil {
+						return
+					}
+				}
+			})
+			return 0, 0, tR.Err
 		},
-		key)
+		keys...)
+	if err != nil {
+		return nil, err
+	}
 
-	return
+	return dropList, nil
 }
 
 func (d *Dao) GetPermAuthKeyId(ctx context.Context, authKeyId int64) int64 {
@@ -0,0 +1,110 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/teamgram/marmota/pkg/strings2"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	"github.com/teamgram/teamgram-server/app/service/authsession/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// RegisterDevice
+// A token can only be bound to one auth key per user, so re-registering it from a
+// new auth key (i.e. after a reinstall) disables the stale rows first.
+func (d *Dao) RegisterDevice(ctx context.Context, device *authsession.PushDevice) error {
+	if _, err := d.DevicesDAO.UpdateStateByUserToken(
+		ctx,
+		true,
+		device.UserId,
+		device.TokenType,
+		device.Token); err != nil {
+		return err
+	}
+
+	_, _, err := d.DevicesDAO.InsertOrUpdate(ctx, &dataobject.DevicesDO{
+		AuthKeyId:  device.AuthKeyId,
+		UserId:     device.UserId,
+		TokenType:  device.TokenType,
+		Token:      device.Token,
+		NoMuted:    device.NoMuted,
+		AppSandbox: device.AppSandbox,
+		Secret:     hex.EncodeToString(device.Secret),
+		OtherUids:  strings2.JoinInt64List(device.OtherUids, ","),
+	})
+
+	return err
+}
+
+// UnregisterDevice
+func (d *Dao) UnregisterDevice(ctx context.Context, userId int64, tokenType int32, token string, otherUids []int64) error {
+	for _, id := range append([]int64{userId}, otherUids...) {
+		if _, err := d.DevicesDAO.UpdateStateByUserToken(ctx, true, id, tokenType, token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateDeviceLocked
+func (d *Dao) UpdateDeviceLocked(ctx context.Context, authKeyId, userId int64, period int32) error {
+	_, err := d.DevicesDAO.UpdateLockedPeriod(ctx, period, authKeyId, userId)
+
+	return err
+}
+
+// GetUserPushDevices
+func (d *Dao) GetUserPushDevices(ctx context.Context, userId int64) ([]*authsession.PushDevice, error) {
+	devices := make([]*authsession.PushDevice, 0)
+	_, err := d.DevicesDAO.SelectListByUserIdWithCB(
+		ctx,
+		userId,
+		func(i int, v *dataobject.DevicesDO) {
+			secret, err := hex.DecodeString(v.Secret)
+			if err != nil {
+				logx.WithContext(ctx).Errorf("invalid secret - device: %d, error: %v", v.Id, err)
+			}
+			otherUids, err := strings2.SplitInt64s(v.OtherUids, ",")
+			if err != nil {
+				logx.WithContext(ctx).Errorf("invalid other_uids - device: %d, error: %v", v.Id, err)
+			}
+
+			devices = append(devices, authsession.MakeTLPushDevice(&authsession.PushDevice{
+				NoMuted:      v.NoMuted,
+				AppSandbox:   v.AppSandbox,
+				AuthKeyId:    v.AuthKeyId,
+				UserId:       v.UserId,
+				TokenType:    v.TokenType,
+				Token:        v.Token,
+				Secret:       secret,
+				LockedPeriod: v.LockedPeriod,
+				OtherUids:    otherUids,
+			}).To_PushDevice())
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return devices, nil
+}
@@ -169,7 +169,7 @@ func (s *Service) AuthsessionQueryAuthKey(ctx context.Context, request *authsess
 }
 
 // AuthsessionSetAuthKey
-// authsession.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt = Bool;
+// authsession.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
 func (s *Service) AuthsessionSetAuthKey(ctx context.Context, request *authsession.TLAuthsessionSetAuthKey) (*mtproto.Bool, error) {
 	c := core.New(ctx, s.svcCtx)
 	c.Logger.Debugf("authsession.setAuthKey - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
@@ -214,7 +214,7 @@ func (s *Service) AuthsessionUnbindAuthKeyUser(ctx context.Context, request *aut
 }
 
 // AuthsessionGetPermAuthKeyId
-// authsession.getPermAuthKeyId auth_key_id:long= Int64;
+// authsession.getPermAuthKeyId auth_key_id:long = Int64;
 func (s *Service) AuthsessionGetPermAuthKeyId(ctx context.Context, request *authsession.TLAuthsessionGetPermAuthKeyId) (*mtproto.Int64, error) {
 	c := core.New(ctx, s.svcCtx)
 	c.Logger.Debugf("authsession.getPermAuthKeyId - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
@@ -229,7 +229,7 @@ func (s *Service) AuthsessionGetPermAuthKeyId(ctx context.Context, request *auth
 }
 
 // AuthsessionBindTempAuthKey
-// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
+// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes temp_auth_key_id:long temp_session_id:long msg_id:long = Bool;
 func (s *Service) AuthsessionBindTempAuthKey(ctx context.Context, request *authsession.TLAuthsessionBindTempAuthKey) (*mtproto.Bool, error) {
 	c := core.New(ctx, s.svcCtx)
 	c.Logger.Debugf("authsession.bindTempAuthKey - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
@@ -287,3 +287,93 @@ func (s *Service) AuthsessionGetAuthStateData(ctx context.Context, request *auth
 	c.Logger.Debugf("authsession.getAuthStateData - reply: %s", r.DebugString())
 	return r, err
 }
+
+// AuthsessionDropTempAuthKeys
+// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Bool;
+func (s *Service) AuthsessionDropTempAuthKeys(ctx context.Context, request *authsession.TLAuthsessionDropTempAuthKeys) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("authsession.dropTempAuthKeys - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.AuthsessionDropTempAuthKeys(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("authsession.dropTempAuthKeys - reply: %s", r.DebugString())
+	return r, err
+}
+
+// AuthsessionGetAuthKeyExpiresAt
+// authsession.getAuthKeyExpiresAt auth_key_id:long = Int32;
+func (s *Service) AuthsessionGetAuthKeyExpiresAt(ctx context.Context, request *authsession.TLAuthsessionGetAuthKeyExpiresAt) (*mtproto.Int32, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("authsession.getAuthKeyExpiresAt - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.AuthsessionGetAuthKeyExpiresAt(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("authsession.getAuthKeyExpiresAt - reply: %s", r.DebugString())
+	return r, err
+}
+
+// AuthsessionRegisterDevice
+// authsession.registerDevice flags:# no_muted:flags.0?true app_sandbox:flags.1?true auth_key_id:long user_id:long token_type:int token:string secret:bytes other_uids:Vector<long> = Bool;
+func (s *Service) AuthsessionRegisterDevice(ctx context.Context, request *authsession.TLAuthsessionRegisterDevice) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("authsession.registerDevice - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.AuthsessionRegisterDevice(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("authsession.registerDevice - reply: %s", r.DebugString())
+	return r, err
+}
+
+// AuthsessionUnregisterDevice
+// authsession.unregisterDevice auth_key_id:long user_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+func (s *Service) AuthsessionUnregisterDevice(ctx context.Context, request *authsession.TLAuthsessionUnregisterDevice) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("authsession.unregisterDevice - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.AuthsessionUnregisterDevice(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("authsession.unregisterDevice - reply: %s", r.DebugString())
+	return r, err
+}
+
+// AuthsessionUpdateDeviceLocked
+// authsession.updateDeviceLocked auth_key_id:long user_id:long period:int = Bool;
+func (s *Service) AuthsessionUpdateDeviceLocked(ctx context.Context, request *authsession.TLAuthsessionUpdateDeviceLocked) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("authsession.updateDeviceLocked - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.AuthsessionUpdateDeviceLocked(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("authsession.updateDeviceLocked - reply: %s", r.DebugString())
+	return r, err
+}
+
+// AuthsessionGetUserPushDevices
+// authsession.getUserPushDevices user_id:long = Vector<PushDevice>;
+func (s *Service) AuthsessionGetUserPushDevices(ctx context.Context, request *authsession.TLAuthsessionGetUserPushDevices) (*authsession.Vector_PushDevice, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("authsession.getUserPushDevices - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.AuthsessionGetUserPushDevices(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("authsession.getUserPushDevices - reply: %s", r.DebugString())
+	return r, err
+}
@@ -20,6 +20,9 @@ package config
 
 import (
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -32,5 +35,7 @@ type Config struct {
 	KV              kv.KvConf
 	MediaClient     zrpc.RpcClientConf
 	IdgenClient     zrpc.RpcClientConf
-	MessageSharding int `json:",default=1"`
+	MessageSharding int                   `json:",default=1"`
+	EmailCode       *conf.EmailCodeConfig `json:",optional"`
+	SearchIndex     message_helper.SearchIndexConfig
 }
@@ -22,6 +22,8 @@ import (
 	"flag"
 
 	"github.com/teamgram/teamgram-server/app/service/biz/biz/internal/config"
+	channel_helper "github.com/teamgram/teamgram-server/app/service/biz/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chat_helper "github.com/teamgram/teamgram-server/app/service/biz/chat"
 	"github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	code_helper "github.com/teamgram/teamgram-server/app/service/biz/code"
@@ -30,6 +32,10 @@ import (
 	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
 	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	secretchat_helper "github.com/teamgram/teamgram-server/app/service/biz/secretchat"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+	twofa_helper "github.com/teamgram/teamgram-server/app/service/biz/twofa"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
 	updates_helper "github.com/teamgram/teamgram-server/app/service/biz/updates"
 	"github.com/teamgram/teamgram-server/app/service/biz/updates/updates"
 	user_helper "github.com/teamgram/teamgram-server/app/service/biz/user"
@@ -62,6 +68,18 @@ func (s *Server) Initialize() error {
 	// s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
 
 	s.grpcSrv = zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		// channel_helper
+		channel.RegisterRPCChannelServer(
+			grpcServer,
+			channel_helper.New(channel_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				Mysql:         c.Mysql,
+				Cache:         c.Cache,
+				MediaClient:   c.MediaClient,
+				IdgenClient:   c.IdgenClient,
+				SearchIndex:   c.SearchIndex,
+			}))
+
 		// chat_helper
 		chat.RegisterRPCChatServer(
 			grpcServer,
@@ -102,9 +120,30 @@ func (s *Server) Initialize() error {
 					Mysql:           c.Mysql,
 					Cache:           c.Cache,
 					MessageSharding: c.MessageSharding,
+					SearchIndex:     c.SearchIndex,
 				},
 				nil))
 
+		// secretchat_helper
+		secretchat.RegisterRPCSecretchatServer(
+			grpcServer,
+			secretchat_helper.New(secretchat_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				Mysql:         c.Mysql,
+				Cache:         c.Cache,
+				IdgenClient:   c.IdgenClient,
+			}))
+
+		// twofa_helper
+		twofa.RegisterRPCTwofaServer(
+			grpcServer,
+			twofa_helper.New(twofa_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				Mysql:         c.Mysql,
+				KV:            c.KV,
+				EmailCode:     c.EmailCode,
+			}))
+
 		// updates_helper
 		updates.RegisterRPCUpdatesServer(
 			grpcServer,
@@ -0,0 +1,9707 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: channel.tl.proto
+
+package channel
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN                             TLConstructor = 0
+	CRC32_channelData                         TLConstructor = 487226122
+	CRC32_channelParticipantData              TLConstructor = -636213148
+	CRC32_mutableChannel                      TLConstructor = 910424644
+	CRC32_channel_createChannel               TLConstructor = 1339626802
+	CRC32_channel_getMutableChannel           TLConstructor = 1998741470
+	CRC32_channel_getChannelListByIdList      TLConstructor = -126104251
+	CRC32_channel_joinChannel                 TLConstructor = -1585495833
+	CRC32_channel_leaveChannel                TLConstructor = 246600306
+	CRC32_channel_getChannelParticipants      TLConstructor = 179442531
+	CRC32_channel_getChannelParticipantIdList TLConstructor = 421986753
+	CRC32_channel_getMyChannelList            TLConstructor = 1770937184
+	CRC32_channel_sendChannelMessage          TLConstructor = -1097744163
+	CRC32_channel_getChannelMessageList       TLConstructor = -1899410279
+	CRC32_channel_getChannelHistory           TLConstructor = 157290284
+	CRC32_channel_readChannelHistory          TLConstructor = -799502370
+	CRC32_channel_editChannelMessage          TLConstructor = -648313281
+	CRC32_channel_getChannelAdminLog          TLConstructor = -399709117
+	CRC32_channel_searchChannelMessages       TLConstructor = -2006490984
+	CRC32_channel_deleteChannelMessages       TLConstructor = -1171086675
+)
+
+var TLConstructor_name = map[int32]string{
+	0:           "CRC32_UNKNOWN",
+	487226122:   "CRC32_channelData",
+	-636213148:  "CRC32_channelParticipantData",
+	910424644:   "CRC32_mutableChannel",
+	1339626802:  "CRC32_channel_createChannel",
+	1998741470:  "CRC32_channel_getMutableChannel",
+	-126104251:  "CRC32_channel_getChannelListByIdList",
+	-1585495833: "CRC32_channel_joinChannel",
+	246600306:   "CRC32_channel_leaveChannel",
+	179442531:   "CRC32_channel_getChannelParticipants",
+	421986753:   "CRC32_channel_getChannelParticipantIdList",
+	1770937184:  "CRC32_channel_getMyChannelList",
+	-1097744163: "CRC32_channel_sendChannelMessage",
+	-1899410279: "CRC32_channel_getChannelMessageList",
+	157290284:   "CRC32_channel_getChannelHistory",
+	-799502370:  "CRC32_channel_readChannelHistory",
+	-648313281:  "CRC32_channel_editChannelMessage",
+	-399709117:  "CRC32_channel_getChannelAdminLog",
+	-2006490984: "CRC32_channel_searchChannelMessages",
+	-1171086675: "CRC32_channel_deleteChannelMessages",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":                             0,
+	"CRC32_channelData":                         487226122,
+	"CRC32_channelParticipantData":              -636213148,
+	"CRC32_mutableChannel":                      910424644,
+	"CRC32_channel_createChannel":               1339626802,
+	"CRC32_channel_getMutableChannel":           1998741470,
+	"CRC32_channel_getChannelListByIdList":      -126104251,
+	"CRC32_channel_joinChannel":                 -1585495833,
+	"CRC32_channel_leaveChannel":                246600306,
+	"CRC32_channel_getChannelParticipants":      179442531,
+	"CRC32_channel_getChannelParticipantIdList": 421986753,
+	"CRC32_channel_getMyChannelList":            1770937184,
+	"CRC32_channel_sendChannelMessage":          -1097744163,
+	"CRC32_channel_getChannelMessageList":       -1899410279,
+	"CRC32_channel_getChannelHistory":           157290284,
+	"CRC32_channel_readChannelHistory":          -799502370,
+	"CRC32_channel_editChannelMessage":          -648313281,
+	"CRC32_channel_getChannelAdminLog":          -399709117,
+	"CRC32_channel_searchChannelMessages":       -2006490984,
+	"CRC32_channel_deleteChannelMessages":       -1171086675,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// channelData flags:# id:long creator_id:long access_hash:long broadcast:flags.0?true megagroup:flags.1?true deactivated:flags.2?true noforwards:flags.3?true signatures:flags.4?true title:string about:string photo:flags.5?Photo participants_count:int top_message:int pts:int date:int version:int = ChannelData;
+//
+// ChannelData <--
+//  + TL_channelData
+//
+type ChannelData struct {
+	PredicateName        string         `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor  `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	Id                   int64          `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId            int64          `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	AccessHash           int64          `protobuf:"varint,5,opt,name=access_hash,json=accessHash,proto3" json:"access_hash,omitempty"`
+	Broadcast            bool           `protobuf:"varint,6,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Megagroup            bool           `protobuf:"varint,7,opt,name=megagroup,proto3" json:"megagroup,omitempty"`
+	Deactivated          bool           `protobuf:"varint,8,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	Noforwards           bool           `protobuf:"varint,9,opt,name=noforwards,proto3" json:"noforwards,omitempty"`
+	Signatures           bool           `protobuf:"varint,10,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	Title                string         `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
+	About                string         `protobuf:"bytes,12,opt,name=about,proto3" json:"about,omitempty"`
+	Photo                *mtproto.Photo `protobuf:"bytes,13,opt,name=photo,proto3" json:"photo,omitempty"`
+	ParticipantsCount    int32          `protobuf:"varint,14,opt,name=participants_count,json=participantsCount,proto3" json:"participants_count,omitempty"`
+	TopMessage           int32          `protobuf:"varint,15,opt,name=top_message,json=topMessage,proto3" json:"top_message,omitempty"`
+	Pts                  int32          `protobuf:"varint,16,opt,name=pts,proto3" json:"pts,omitempty"`
+	Date                 int32          `protobuf:"varint,17,opt,name=date,proto3" json:"date,omitempty"`
+	Version              int32          `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelData) Reset()         { *m = ChannelData{} }
+func (m *ChannelData) String() string { return proto.CompactTextString(m) }
+func (*ChannelData) ProtoMessage()    {}
+func (*ChannelData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{0}
+}
+func (m *ChannelData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelData.Merge(m, src)
+}
+func (m *ChannelData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelData proto.InternalMessageInfo
+
+func (m *ChannelData) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *ChannelData) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *ChannelData) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ChannelData) GetCreatorId() int64 {
+	if m != nil {
+		return m.CreatorId
+	}
+	return 0
+}
+
+func (m *ChannelData) GetAccessHash() int64 {
+	if m != nil {
+		return m.AccessHash
+	}
+	return 0
+}
+
+func (m *ChannelData) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *ChannelData) GetMegagroup() bool {
+	if m != nil {
+		return m.Megagroup
+	}
+	return false
+}
+
+func (m *ChannelData) GetDeactivated() bool {
+	if m != nil {
+		return m.Deactivated
+	}
+	return false
+}
+
+func (m *ChannelData) GetNoforwards() bool {
+	if m != nil {
+		return m.Noforwards
+	}
+	return false
+}
+
+func (m *ChannelData) GetSignatures() bool {
+	if m != nil {
+		return m.Signatures
+	}
+	return false
+}
+
+func (m *ChannelData) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ChannelData) GetAbout() string {
+	if m != nil {
+		return m.About
+	}
+	return ""
+}
+
+func (m *ChannelData) GetPhoto() *mtproto.Photo {
+	if m != nil {
+		return m.Photo
+	}
+	return nil
+}
+
+func (m *ChannelData) GetParticipantsCount() int32 {
+	if m != nil {
+		return m.ParticipantsCount
+	}
+	return 0
+}
+
+func (m *ChannelData) GetTopMessage() int32 {
+	if m != nil {
+		return m.TopMessage
+	}
+	return 0
+}
+
+func (m *ChannelData) GetPts() int32 {
+	if m != nil {
+		return m.Pts
+	}
+	return 0
+}
+
+func (m *ChannelData) GetDate() int32 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+func (m *ChannelData) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// channelData flags:# id:long creator_id:long access_hash:long broadcast:flags.0?true megagroup:flags.1?true deactivated:flags.2?true noforwards:flags.3?true signatures:flags.4?true title:string about:string photo:flags.5?Photo participants_count:int top_message:int pts:int date:int version:int = ChannelData;
+type TLChannelData struct {
+	Data2                *ChannelData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TLChannelData) Reset()         { *m = TLChannelData{} }
+func (m *TLChannelData) String() string { return proto.CompactTextString(m) }
+func (*TLChannelData) ProtoMessage()    {}
+func (*TLChannelData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{1}
+}
+func (m *TLChannelData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelData.Merge(m, src)
+}
+func (m *TLChannelData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelData proto.InternalMessageInfo
+
+func (m *TLChannelData) GetData2() *ChannelData {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channelParticipantData channel_id:long user_id:long participant_type:int inviter_user_id:long invited_at:int kicked_at:int left_at:int state:int read_inbox_max_id:int date:int = ChannelParticipantData;
+//
+// ChannelParticipantData <--
+//  + TL_channelParticipantData
+//
+type ChannelParticipantData struct {
+	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ParticipantType      int32         `protobuf:"varint,5,opt,name=participant_type,json=participantType,proto3" json:"participant_type,omitempty"`
+	InviterUserId        int64         `protobuf:"varint,6,opt,name=inviter_user_id,json=inviterUserId,proto3" json:"inviter_user_id,omitempty"`
+	InvitedAt            int32         `protobuf:"varint,7,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
+	KickedAt             int32         `protobuf:"varint,8,opt,name=kicked_at,json=kickedAt,proto3" json:"kicked_at,omitempty"`
+	LeftAt               int32         `protobuf:"varint,9,opt,name=left_at,json=leftAt,proto3" json:"left_at,omitempty"`
+	State                int32         `protobuf:"varint,10,opt,name=state,proto3" json:"state,omitempty"`
+	ReadInboxMaxId       int32         `protobuf:"varint,11,opt,name=read_inbox_max_id,json=readInboxMaxId,proto3" json:"read_inbox_max_id,omitempty"`
+	Date                 int32         `protobuf:"varint,12,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ChannelParticipantData) Reset()         { *m = ChannelParticipantData{} }
+func (m *ChannelParticipantData) String() string { return proto.CompactTextString(m) }
+func (*ChannelParticipantData) ProtoMessage()    {}
+func (*ChannelParticipantData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{2}
+}
+func (m *ChannelParticipantData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelParticipantData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelParticipantData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelParticipantData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelParticipantData.Merge(m, src)
+}
+func (m *ChannelParticipantData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelParticipantData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelParticipantData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelParticipantData proto.InternalMessageInfo
+
+func (m *ChannelParticipantData) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *ChannelParticipantData) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *ChannelParticipantData) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetParticipantType() int32 {
+	if m != nil {
+		return m.ParticipantType
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetInviterUserId() int64 {
+	if m != nil {
+		return m.InviterUserId
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetInvitedAt() int32 {
+	if m != nil {
+		return m.InvitedAt
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetKickedAt() int32 {
+	if m != nil {
+		return m.KickedAt
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetLeftAt() int32 {
+	if m != nil {
+		return m.LeftAt
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetReadInboxMaxId() int32 {
+	if m != nil {
+		return m.ReadInboxMaxId
+	}
+	return 0
+}
+
+func (m *ChannelParticipantData) GetDate() int32 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+// channelParticipantData channel_id:long user_id:long participant_type:int inviter_user_id:long invited_at:int kicked_at:int left_at:int state:int read_inbox_max_id:int date:int = ChannelParticipantData;
+type TLChannelParticipantData struct {
+	Data2                *ChannelParticipantData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *TLChannelParticipantData) Reset()         { *m = TLChannelParticipantData{} }
+func (m *TLChannelParticipantData) String() string { return proto.CompactTextString(m) }
+func (*TLChannelParticipantData) ProtoMessage()    {}
+func (*TLChannelParticipantData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{3}
+}
+func (m *TLChannelParticipantData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelParticipantData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelParticipantData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelParticipantData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelParticipantData.Merge(m, src)
+}
+func (m *TLChannelParticipantData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelParticipantData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelParticipantData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelParticipantData proto.InternalMessageInfo
+
+func (m *TLChannelParticipantData) GetData2() *ChannelParticipantData {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// mutableChannel channel:ChannelData participants:Vector<ChannelParticipantData> = MutableChannel;
+//
+// MutableChannel <--
+//  + TL_mutableChannel
+//
+type MutableChannel struct {
+	PredicateName        string                    `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor             `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	Channel              *ChannelData              `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Participants         []*ChannelParticipantData `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *MutableChannel) Reset()         { *m = MutableChannel{} }
+func (m *MutableChannel) String() string { return proto.CompactTextString(m) }
+func (*MutableChannel) ProtoMessage()    {}
+func (*MutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{4}
+}
+func (m *MutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MutableChannel.Merge(m, src)
+}
+func (m *MutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MutableChannel proto.InternalMessageInfo
+
+func (m *MutableChannel) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *MutableChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *MutableChannel) GetChannel() *ChannelData {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *MutableChannel) GetParticipants() []*ChannelParticipantData {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+// mutableChannel channel:ChannelData participants:Vector<ChannelParticipantData> = MutableChannel;
+type TLMutableChannel struct {
+	Data2                *MutableChannel `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TLMutableChannel) Reset()         { *m = TLMutableChannel{} }
+func (m *TLMutableChannel) String() string { return proto.CompactTextString(m) }
+func (*TLMutableChannel) ProtoMessage()    {}
+func (*TLMutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{5}
+}
+func (m *TLMutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLMutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMutableChannel.Merge(m, src)
+}
+func (m *TLMutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMutableChannel proto.InternalMessageInfo
+
+func (m *TLMutableChannel) GetData2() *MutableChannel {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string date:int = MutableChannel;
+type TLChannelCreateChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	CreatorId            int64         `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Broadcast            bool          `protobuf:"varint,4,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Megagroup            bool          `protobuf:"varint,5,opt,name=megagroup,proto3" json:"megagroup,omitempty"`
+	Title                string        `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	About                string        `protobuf:"bytes,7,opt,name=about,proto3" json:"about,omitempty"`
+	Date                 int32         `protobuf:"varint,8,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelCreateChannel) Reset()         { *m = TLChannelCreateChannel{} }
+func (m *TLChannelCreateChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelCreateChannel) ProtoMessage()    {}
+func (*TLChannelCreateChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{6}
+}
+func (m *TLChannelCreateChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelCreateChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelCreateChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelCreateChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelCreateChannel.Merge(m, src)
+}
+func (m *TLChannelCreateChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelCreateChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelCreateChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelCreateChannel proto.InternalMessageInfo
+
+func (m *TLChannelCreateChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelCreateChannel) GetCreatorId() int64 {
+	if m != nil {
+		return m.CreatorId
+	}
+	return 0
+}
+
+func (m *TLChannelCreateChannel) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *TLChannelCreateChannel) GetMegagroup() bool {
+	if m != nil {
+		return m.Megagroup
+	}
+	return false
+}
+
+func (m *TLChannelCreateChannel) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *TLChannelCreateChannel) GetAbout() string {
+	if m != nil {
+		return m.About
+	}
+	return ""
+}
+
+func (m *TLChannelCreateChannel) GetDate() int32 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+type TLChannelGetMutableChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Id                   []int64       `protobuf:"varint,4,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetMutableChannel) Reset()         { *m = TLChannelGetMutableChannel{} }
+func (m *TLChannelGetMutableChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetMutableChannel) ProtoMessage()    {}
+func (*TLChannelGetMutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{7}
+}
+func (m *TLChannelGetMutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetMutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetMutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetMutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetMutableChannel.Merge(m, src)
+}
+func (m *TLChannelGetMutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetMutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetMutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetMutableChannel proto.InternalMessageInfo
+
+func (m *TLChannelGetMutableChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetMutableChannel) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetMutableChannel) GetId() []int64 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelListByIdList self_id:long id:Vector<long> = Vector<MutableChannel>;
+type TLChannelGetChannelListByIdList struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	SelfId               int64         `protobuf:"varint,3,opt,name=self_id,json=selfId,proto3" json:"self_id,omitempty"`
+	Id                   []int64       `protobuf:"varint,4,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelListByIdList) Reset()         { *m = TLChannelGetChannelListByIdList{} }
+func (m *TLChannelGetChannelListByIdList) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelListByIdList) ProtoMessage()    {}
+func (*TLChannelGetChannelListByIdList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{8}
+}
+func (m *TLChannelGetChannelListByIdList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelListByIdList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelListByIdList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelListByIdList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelListByIdList.Merge(m, src)
+}
+func (m *TLChannelGetChannelListByIdList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelListByIdList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelListByIdList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelListByIdList proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelListByIdList) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelListByIdList) GetSelfId() int64 {
+	if m != nil {
+		return m.SelfId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelListByIdList) GetId() []int64 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.joinChannel channel_id:long user_id:long inviter_id:long = MutableChannel;
+type TLChannelJoinChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InviterId            int64         `protobuf:"varint,5,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelJoinChannel) Reset()         { *m = TLChannelJoinChannel{} }
+func (m *TLChannelJoinChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelJoinChannel) ProtoMessage()    {}
+func (*TLChannelJoinChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{9}
+}
+func (m *TLChannelJoinChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelJoinChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelJoinChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelJoinChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelJoinChannel.Merge(m, src)
+}
+func (m *TLChannelJoinChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelJoinChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelJoinChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelJoinChannel proto.InternalMessageInfo
+
+func (m *TLChannelJoinChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelJoinChannel) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelJoinChannel) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelJoinChannel) GetInviterId() int64 {
+	if m != nil {
+		return m.InviterId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+type TLChannelLeaveChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelLeaveChannel) Reset()         { *m = TLChannelLeaveChannel{} }
+func (m *TLChannelLeaveChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelLeaveChannel) ProtoMessage()    {}
+func (*TLChannelLeaveChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{10}
+}
+func (m *TLChannelLeaveChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelLeaveChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelLeaveChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelLeaveChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelLeaveChannel.Merge(m, src)
+}
+func (m *TLChannelLeaveChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelLeaveChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelLeaveChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelLeaveChannel proto.InternalMessageInfo
+
+func (m *TLChannelLeaveChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelLeaveChannel) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelLeaveChannel) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelParticipants channel_id:long offset:int limit:int = Vector<ChannelParticipantData>;
+type TLChannelGetChannelParticipants struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Offset               int32         `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32         `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelParticipants) Reset()         { *m = TLChannelGetChannelParticipants{} }
+func (m *TLChannelGetChannelParticipants) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelParticipants) ProtoMessage()    {}
+func (*TLChannelGetChannelParticipants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{11}
+}
+func (m *TLChannelGetChannelParticipants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelParticipants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelParticipants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelParticipants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelParticipants.Merge(m, src)
+}
+func (m *TLChannelGetChannelParticipants) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelParticipants) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelParticipants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelParticipants proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelParticipants) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelParticipants) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelParticipants) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelParticipants) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+type TLChannelGetChannelParticipantIdList struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelParticipantIdList) Reset()         { *m = TLChannelGetChannelParticipantIdList{} }
+func (m *TLChannelGetChannelParticipantIdList) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelParticipantIdList) ProtoMessage()    {}
+func (*TLChannelGetChannelParticipantIdList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{12}
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelParticipantIdList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelParticipantIdList.Merge(m, src)
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelParticipantIdList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelParticipantIdList proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelParticipantIdList) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelParticipantIdList) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getMyChannelList user_id:long = Vector<long>;
+type TLChannelGetMyChannelList struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetMyChannelList) Reset()         { *m = TLChannelGetMyChannelList{} }
+func (m *TLChannelGetMyChannelList) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetMyChannelList) ProtoMessage()    {}
+func (*TLChannelGetMyChannelList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{13}
+}
+func (m *TLChannelGetMyChannelList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetMyChannelList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetMyChannelList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetMyChannelList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetMyChannelList.Merge(m, src)
+}
+func (m *TLChannelGetMyChannelList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetMyChannelList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetMyChannelList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetMyChannelList proto.InternalMessageInfo
+
+func (m *TLChannelGetMyChannelList) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetMyChannelList) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.sendChannelMessage from_id:long channel_id:long random_id:long message:Message = MessageBox;
+type TLChannelSendChannelMessage struct {
+	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	FromId               int64            `protobuf:"varint,3,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ChannelId            int64            `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	RandomId             int64            `protobuf:"varint,5,opt,name=random_id,json=randomId,proto3" json:"random_id,omitempty"`
+	Message              *mtproto.Message `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TLChannelSendChannelMessage) Reset()         { *m = TLChannelSendChannelMessage{} }
+func (m *TLChannelSendChannelMessage) String() string { return proto.CompactTextString(m) }
+func (*TLChannelSendChannelMessage) ProtoMessage()    {}
+func (*TLChannelSendChannelMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{14}
+}
+func (m *TLChannelSendChannelMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelSendChannelMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelSendChannelMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelSendChannelMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelSendChannelMessage.Merge(m, src)
+}
+func (m *TLChannelSendChannelMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelSendChannelMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelSendChannelMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelSendChannelMessage proto.InternalMessageInfo
+
+func (m *TLChannelSendChannelMessage) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelSendChannelMessage) GetFromId() int64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *TLChannelSendChannelMessage) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelSendChannelMessage) GetRandomId() int64 {
+	if m != nil {
+		return m.RandomId
+	}
+	return 0
+}
+
+func (m *TLChannelSendChannelMessage) GetMessage() *mtproto.Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelMessageList channel_id:long id:Vector<int> = Vector<MessageBox>;
+type TLChannelGetChannelMessageList struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,4,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelMessageList) Reset()         { *m = TLChannelGetChannelMessageList{} }
+func (m *TLChannelGetChannelMessageList) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelMessageList) ProtoMessage()    {}
+func (*TLChannelGetChannelMessageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{15}
+}
+func (m *TLChannelGetChannelMessageList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelMessageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelMessageList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelMessageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelMessageList.Merge(m, src)
+}
+func (m *TLChannelGetChannelMessageList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelMessageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelMessageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelMessageList proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelMessageList) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelMessageList) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelMessageList) GetId() []int32 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelHistory channel_id:long offset_id:int limit:int = Vector<MessageBox>;
+type TLChannelGetChannelHistory struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OffsetId             int32         `protobuf:"varint,4,opt,name=offset_id,json=offsetId,proto3" json:"offset_id,omitempty"`
+	Limit                int32         `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelHistory) Reset()         { *m = TLChannelGetChannelHistory{} }
+func (m *TLChannelGetChannelHistory) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelHistory) ProtoMessage()    {}
+func (*TLChannelGetChannelHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{16}
+}
+func (m *TLChannelGetChannelHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelHistory.Merge(m, src)
+}
+func (m *TLChannelGetChannelHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelHistory proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelHistory) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelHistory) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelHistory) GetOffsetId() int32 {
+	if m != nil {
+		return m.OffsetId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelHistory) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.readChannelHistory channel_id:long user_id:long max_id:int = Bool;
+type TLChannelReadChannelHistory struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MaxId                int32         `protobuf:"varint,5,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelReadChannelHistory) Reset()         { *m = TLChannelReadChannelHistory{} }
+func (m *TLChannelReadChannelHistory) String() string { return proto.CompactTextString(m) }
+func (*TLChannelReadChannelHistory) ProtoMessage()    {}
+func (*TLChannelReadChannelHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{17}
+}
+func (m *TLChannelReadChannelHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelReadChannelHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelReadChannelHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelReadChannelHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelReadChannelHistory.Merge(m, src)
+}
+func (m *TLChannelReadChannelHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelReadChannelHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelReadChannelHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelReadChannelHistory proto.InternalMessageInfo
+
+func (m *TLChannelReadChannelHistory) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelReadChannelHistory) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelReadChannelHistory) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelReadChannelHistory) GetMaxId() int32 {
+	if m != nil {
+		return m.MaxId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.editChannelMessage from_id:long channel_id:long message:Message = MessageBox;
+type TLChannelEditChannelMessage struct {
+	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	FromId               int64            `protobuf:"varint,3,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ChannelId            int64            `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Message              *mtproto.Message `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TLChannelEditChannelMessage) Reset()         { *m = TLChannelEditChannelMessage{} }
+func (m *TLChannelEditChannelMessage) String() string { return proto.CompactTextString(m) }
+func (*TLChannelEditChannelMessage) ProtoMessage()    {}
+func (*TLChannelEditChannelMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{18}
+}
+func (m *TLChannelEditChannelMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelEditChannelMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelEditChannelMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelEditChannelMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelEditChannelMessage.Merge(m, src)
+}
+func (m *TLChannelEditChannelMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelEditChannelMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelEditChannelMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelEditChannelMessage proto.InternalMessageInfo
+
+func (m *TLChannelEditChannelMessage) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelEditChannelMessage) GetFromId() int64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *TLChannelEditChannelMessage) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelEditChannelMessage) GetMessage() *mtproto.Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelAdminLog channel_id:long user_id:long max_id:long min_id:long limit:int = Vector<ChannelAdminLogEvent>;
+type TLChannelGetChannelAdminLog struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MaxId                int64         `protobuf:"varint,5,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	MinId                int64         `protobuf:"varint,6,opt,name=min_id,json=minId,proto3" json:"min_id,omitempty"`
+	Limit                int32         `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelAdminLog) Reset()         { *m = TLChannelGetChannelAdminLog{} }
+func (m *TLChannelGetChannelAdminLog) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelAdminLog) ProtoMessage()    {}
+func (*TLChannelGetChannelAdminLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{19}
+}
+func (m *TLChannelGetChannelAdminLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelAdminLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelAdminLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelAdminLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelAdminLog.Merge(m, src)
+}
+func (m *TLChannelGetChannelAdminLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelAdminLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelAdminLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelAdminLog proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelAdminLog) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelAdminLog) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelAdminLog) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelAdminLog) GetMaxId() int64 {
+	if m != nil {
+		return m.MaxId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelAdminLog) GetMinId() int64 {
+	if m != nil {
+		return m.MinId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelAdminLog) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.searchChannelMessages channel_id:long user_id:long q:string from_id:long offset_id:int min_date:int max_date:int limit:int = Vector<MessageBox>;
+type TLChannelSearchChannelMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Q                    string        `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"`
+	FromId               int64         `protobuf:"varint,6,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	OffsetId             int32         `protobuf:"varint,7,opt,name=offset_id,json=offsetId,proto3" json:"offset_id,omitempty"`
+	MinDate              int32         `protobuf:"varint,8,opt,name=min_date,json=minDate,proto3" json:"min_date,omitempty"`
+	MaxDate              int32         `protobuf:"varint,9,opt,name=max_date,json=maxDate,proto3" json:"max_date,omitempty"`
+	Limit                int32         `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelSearchChannelMessages) Reset()         { *m = TLChannelSearchChannelMessages{} }
+func (m *TLChannelSearchChannelMessages) String() string { return proto.CompactTextString(m) }
+func (*TLChannelSearchChannelMessages) ProtoMessage()    {}
+func (*TLChannelSearchChannelMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{20}
+}
+func (m *TLChannelSearchChannelMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelSearchChannelMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelSearchChannelMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelSearchChannelMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelSearchChannelMessages.Merge(m, src)
+}
+func (m *TLChannelSearchChannelMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelSearchChannelMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelSearchChannelMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelSearchChannelMessages proto.InternalMessageInfo
+
+func (m *TLChannelSearchChannelMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelSearchChannelMessages) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelSearchChannelMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelSearchChannelMessages) GetQ() string {
+	if m != nil {
+		return m.Q
+	}
+	return ""
+}
+
+func (m *TLChannelSearchChannelMessages) GetFromId() int64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *TLChannelSearchChannelMessages) GetOffsetId() int32 {
+	if m != nil {
+		return m.OffsetId
+	}
+	return 0
+}
+
+func (m *TLChannelSearchChannelMessages) GetMinDate() int32 {
+	if m != nil {
+		return m.MinDate
+	}
+	return 0
+}
+
+func (m *TLChannelSearchChannelMessages) GetMaxDate() int32 {
+	if m != nil {
+		return m.MaxDate
+	}
+	return 0
+}
+
+func (m *TLChannelSearchChannelMessages) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.deleteChannelMessages from_id:long channel_id:long id:Vector<int> = messages.AffectedMessages;
+type TLChannelDeleteChannelMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	FromId               int64         `protobuf:"varint,3,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ChannelId            int64         `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelDeleteChannelMessages) Reset()         { *m = TLChannelDeleteChannelMessages{} }
+func (m *TLChannelDeleteChannelMessages) String() string { return proto.CompactTextString(m) }
+func (*TLChannelDeleteChannelMessages) ProtoMessage()    {}
+func (*TLChannelDeleteChannelMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{21}
+}
+func (m *TLChannelDeleteChannelMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelDeleteChannelMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelDeleteChannelMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelDeleteChannelMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelDeleteChannelMessages.Merge(m, src)
+}
+func (m *TLChannelDeleteChannelMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelDeleteChannelMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelDeleteChannelMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelDeleteChannelMessages proto.InternalMessageInfo
+
+func (m *TLChannelDeleteChannelMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelDeleteChannelMessages) GetFromId() int64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteChannelMessages) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteChannelMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_MutableChannel struct {
+	Datas                []*MutableChannel `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Vector_MutableChannel) Reset()         { *m = Vector_MutableChannel{} }
+func (m *Vector_MutableChannel) String() string { return proto.CompactTextString(m) }
+func (*Vector_MutableChannel) ProtoMessage()    {}
+func (*Vector_MutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{22}
+}
+func (m *Vector_MutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_MutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_MutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_MutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_MutableChannel.Merge(m, src)
+}
+func (m *Vector_MutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_MutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_MutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_MutableChannel proto.InternalMessageInfo
+
+func (m *Vector_MutableChannel) GetDatas() []*MutableChannel {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type Vector_ChannelParticipantData struct {
+	Datas                []*ChannelParticipantData `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *Vector_ChannelParticipantData) Reset()         { *m = Vector_ChannelParticipantData{} }
+func (m *Vector_ChannelParticipantData) String() string { return proto.CompactTextString(m) }
+func (*Vector_ChannelParticipantData) ProtoMessage()    {}
+func (*Vector_ChannelParticipantData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{23}
+}
+func (m *Vector_ChannelParticipantData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_ChannelParticipantData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_ChannelParticipantData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_ChannelParticipantData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_ChannelParticipantData.Merge(m, src)
+}
+func (m *Vector_ChannelParticipantData) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_ChannelParticipantData) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_ChannelParticipantData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_ChannelParticipantData proto.InternalMessageInfo
+
+func (m *Vector_ChannelParticipantData) GetDatas() []*ChannelParticipantData {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type Vector_Long struct {
+	Datas                []int64  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vector_Long) Reset()         { *m = Vector_Long{} }
+func (m *Vector_Long) String() string { return proto.CompactTextString(m) }
+func (*Vector_Long) ProtoMessage()    {}
+func (*Vector_Long) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{24}
+}
+func (m *Vector_Long) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_Long) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_Long.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_Long) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_Long.Merge(m, src)
+}
+func (m *Vector_Long) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_Long) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_Long.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_Long proto.InternalMessageInfo
+
+func (m *Vector_Long) GetDatas() []int64 {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type Vector_MessageBox struct {
+	Datas                []*mtproto.MessageBox `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Vector_MessageBox) Reset()         { *m = Vector_MessageBox{} }
+func (m *Vector_MessageBox) String() string { return proto.CompactTextString(m) }
+func (*Vector_MessageBox) ProtoMessage()    {}
+func (*Vector_MessageBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{25}
+}
+func (m *Vector_MessageBox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_MessageBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_MessageBox.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_MessageBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_MessageBox.Merge(m, src)
+}
+func (m *Vector_MessageBox) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_MessageBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_MessageBox.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_MessageBox proto.InternalMessageInfo
+
+func (m *Vector_MessageBox) GetDatas() []*mtproto.MessageBox {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type Vector_ChannelAdminLogEvent struct {
+	Datas                []*mtproto.ChannelAdminLogEvent `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *Vector_ChannelAdminLogEvent) Reset()         { *m = Vector_ChannelAdminLogEvent{} }
+func (m *Vector_ChannelAdminLogEvent) String() string { return proto.CompactTextString(m) }
+func (*Vector_ChannelAdminLogEvent) ProtoMessage()    {}
+func (*Vector_ChannelAdminLogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{26}
+}
+func (m *Vector_ChannelAdminLogEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_ChannelAdminLogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_ChannelAdminLogEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_ChannelAdminLogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_ChannelAdminLogEvent.Merge(m, src)
+}
+func (m *Vector_ChannelAdminLogEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_ChannelAdminLogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_ChannelAdminLogEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_ChannelAdminLogEvent proto.InternalMessageInfo
+
+func (m *Vector_ChannelAdminLogEvent) GetDatas() []*mtproto.ChannelAdminLogEvent {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("channel.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*ChannelData)(nil), "channel.ChannelData")
+	proto.RegisterType((*TLChannelData)(nil), "channel.TL_channelData")
+	proto.RegisterType((*ChannelParticipantData)(nil), "channel.ChannelParticipantData")
+	proto.RegisterType((*TLChannelParticipantData)(nil), "channel.TL_channelParticipantData")
+	proto.RegisterType((*MutableChannel)(nil), "channel.MutableChannel")
+	proto.RegisterType((*TLMutableChannel)(nil), "channel.TL_mutableChannel")
+	proto.RegisterType((*TLChannelCreateChannel)(nil), "channel.TL_channel_createChannel")
+	proto.RegisterType((*TLChannelGetMutableChannel)(nil), "channel.TL_channel_getMutableChannel")
+	proto.RegisterType((*TLChannelGetChannelListByIdList)(nil), "channel.TL_channel_getChannelListByIdList")
+	proto.RegisterType((*TLChannelJoinChannel)(nil), "channel.TL_channel_joinChannel")
+	proto.RegisterType((*TLChannelLeaveChannel)(nil), "channel.TL_channel_leaveChannel")
+	proto.RegisterType((*TLChannelGetChannelParticipants)(nil), "channel.TL_channel_getChannelParticipants")
+	proto.RegisterType((*TLChannelGetChannelParticipantIdList)(nil), "channel.TL_channel_getChannelParticipantIdList")
+	proto.RegisterType((*TLChannelGetMyChannelList)(nil), "channel.TL_channel_getMyChannelList")
+	proto.RegisterType((*TLChannelSendChannelMessage)(nil), "channel.TL_channel_sendChannelMessage")
+	proto.RegisterType((*TLChannelGetChannelMessageList)(nil), "channel.TL_channel_getChannelMessageList")
+	proto.RegisterType((*TLChannelGetChannelHistory)(nil), "channel.TL_channel_getChannelHistory")
+	proto.RegisterType((*TLChannelReadChannelHistory)(nil), "channel.TL_channel_readChannelHistory")
+	proto.RegisterType((*TLChannelEditChannelMessage)(nil), "channel.TL_channel_editChannelMessage")
+	proto.RegisterType((*TLChannelGetChannelAdminLog)(nil), "channel.TL_channel_getChannelAdminLog")
+	proto.RegisterType((*TLChannelSearchChannelMessages)(nil), "channel.TL_channel_searchChannelMessages")
+	proto.RegisterType((*TLChannelDeleteChannelMessages)(nil), "channel.TL_channel_deleteChannelMessages")
+	proto.RegisterType((*Vector_MutableChannel)(nil), "channel.Vector_MutableChannel")
+	proto.RegisterType((*Vector_ChannelParticipantData)(nil), "channel.Vector_ChannelParticipantData")
+	proto.RegisterType((*Vector_Long)(nil), "channel.Vector_Long")
+	proto.RegisterType((*Vector_MessageBox)(nil), "channel.Vector_MessageBox")
+	proto.RegisterType((*Vector_ChannelAdminLogEvent)(nil), "channel.Vector_ChannelAdminLogEvent")
+}
+
+func init() { proto.RegisterFile("channel.tl.proto", fileDescriptor_51d76c1638b87f93) }
+
+var fileDescriptor_51d76c1638b87f93 = []byte{
+	// 1985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6c, 0x23, 0x57,
+	0x15, 0xce, 0xac, 0x63, 0x3b, 0x3e, 0x4e, 0xb2, 0x93, 0xdb, 0xec, 0xee, 0xc4, 0xd9, 0x38, 0xde,
+	0xd9, 0xdd, 0x90, 0x2c, 0x4a, 0x42, 0xb3, 0x42, 0xe2, 0x01, 0x21, 0x25, 0x2e, 0xa8, 0x56, 0xb3,
+	0xcb, 0x62, 0x36, 0x05, 0x81, 0x90, 0xb9, 0x99, 0xb9, 0xb6, 0x87, 0xda, 0x33, 0xd3, 0xb9, 0xd7,
+	0x69, 0xc2, 0x13, 0x0b, 0x12, 0x7f, 0x45, 0xe2, 0x95, 0x22, 0x7e, 0x1e, 0x28, 0x88, 0xae, 0x80,
+	0x87, 0x22, 0x21, 0xf5, 0x01, 0xaa, 0xd5, 0x52, 0x51, 0x0a, 0x0f, 0xcb, 0x03, 0x12, 0xaa, 0x44,
+	0x55, 0x96, 0x0a, 0x78, 0xa2, 0x08, 0x55, 0x55, 0x05, 0x6a, 0x83, 0xe6, 0xde, 0x19, 0xfb, 0x8e,
+	0x3d, 0xe3, 0x04, 0x42, 0x76, 0x9f, 0xe2, 0x7b, 0xce, 0x37, 0xe7, 0x9e, 0x7b, 0xce, 0x77, 0xcf,
+	0x3d, 0x47, 0x01, 0xd5, 0x68, 0x62, 0xdb, 0x26, 0xad, 0x15, 0xd6, 0x5a, 0x71, 0x3d, 0x87, 0x39,
+	0x28, 0x1b, 0x48, 0x0a, 0xcb, 0x0d, 0x8b, 0x35, 0x3b, 0xdb, 0x2b, 0x86, 0xd3, 0x5e, 0x6d, 0x38,
+	0x0d, 0x67, 0x95, 0xeb, 0xb7, 0x3b, 0x75, 0xbe, 0xe2, 0x0b, 0xfe, 0x4b, 0x7c, 0x57, 0x28, 0x36,
+	0x1c, 0xa7, 0xd1, 0x22, 0x3d, 0xd4, 0x13, 0x1e, 0x76, 0x5d, 0xe2, 0xd1, 0x40, 0x5f, 0xa0, 0x46,
+	0x93, 0xb4, 0xb1, 0xbf, 0x91, 0xe1, 0x78, 0xa4, 0xc6, 0xf6, 0x5c, 0x12, 0xea, 0x66, 0x7a, 0x3a,
+	0xe6, 0x61, 0x9b, 0xba, 0x8e, 0xc7, 0x02, 0xd5, 0x74, 0x4f, 0x45, 0xf7, 0x6c, 0x43, 0x48, 0xf5,
+	0x5f, 0x8c, 0x42, 0xbe, 0x2c, 0xfc, 0x7c, 0x08, 0x33, 0x8c, 0x2e, 0xc2, 0xa4, 0xeb, 0x11, 0xd3,
+	0x32, 0x30, 0x23, 0x35, 0x1b, 0xb7, 0x89, 0xa6, 0x94, 0x94, 0xc5, 0x5c, 0x75, 0xa2, 0x2b, 0xbd,
+	0x8a, 0xdb, 0x04, 0xbd, 0x0f, 0xf2, 0x86, 0x63, 0x53, 0xe6, 0x75, 0x0c, 0xe6, 0x78, 0xda, 0x89,
+	0x92, 0xb2, 0x38, 0xb9, 0x76, 0x7a, 0x25, 0x8c, 0xc1, 0xf5, 0xcd, 0x72, 0x4f, 0x5b, 0x95, 0xa1,
+	0x68, 0x12, 0x4e, 0x58, 0xa6, 0x96, 0x2a, 0x29, 0x8b, 0xa9, 0xea, 0x09, 0xcb, 0x44, 0x73, 0x00,
+	0x86, 0x47, 0x30, 0x73, 0xbc, 0x9a, 0x65, 0x6a, 0xa3, 0x5c, 0x9e, 0x0b, 0x24, 0x15, 0x13, 0xcd,
+	0x43, 0x1e, 0x1b, 0x06, 0xa1, 0xb4, 0xd6, 0xc4, 0xb4, 0xa9, 0xa5, 0xb9, 0x1e, 0x84, 0xe8, 0x61,
+	0x4c, 0x9b, 0xe8, 0x2c, 0xe4, 0xb6, 0x3d, 0x07, 0x9b, 0x06, 0xa6, 0x4c, 0xcb, 0x94, 0x94, 0xc5,
+	0xb1, 0x6a, 0x4f, 0xe0, 0x6b, 0xdb, 0xa4, 0x81, 0x1b, 0x9e, 0xd3, 0x71, 0xb5, 0xac, 0xd0, 0x76,
+	0x05, 0xa8, 0x04, 0x79, 0x93, 0x60, 0x83, 0x59, 0x3b, 0x98, 0x11, 0x53, 0x1b, 0xe3, 0x7a, 0x59,
+	0x84, 0x8a, 0x00, 0xb6, 0x53, 0x77, 0xbc, 0x27, 0xb0, 0x67, 0x52, 0x2d, 0xc7, 0x01, 0x92, 0xc4,
+	0xd7, 0x53, 0xab, 0x61, 0x63, 0xd6, 0xf1, 0x08, 0xd5, 0x40, 0xe8, 0x7b, 0x12, 0x34, 0x0d, 0x69,
+	0x66, 0xb1, 0x16, 0xd1, 0xf2, 0x3c, 0x8a, 0x62, 0xe1, 0x4b, 0xf1, 0xb6, 0xd3, 0x61, 0xda, 0xb8,
+	0x90, 0xf2, 0x05, 0xba, 0x00, 0x69, 0xb7, 0xe9, 0x30, 0x47, 0x9b, 0x28, 0x29, 0x8b, 0xf9, 0xb5,
+	0xc9, 0x95, 0x36, 0xe3, 0x39, 0x5a, 0xb9, 0xe6, 0x4b, 0xab, 0x42, 0x89, 0x96, 0x01, 0xb9, 0xd8,
+	0x63, 0x96, 0x61, 0xb9, 0xd8, 0x66, 0xb4, 0x66, 0x38, 0x1d, 0x9b, 0x69, 0x93, 0x25, 0x65, 0x31,
+	0x5d, 0x9d, 0x92, 0x35, 0x65, 0x5f, 0xe1, 0xc7, 0x8f, 0x39, 0x6e, 0xad, 0x4d, 0x28, 0xc5, 0x0d,
+	0xa2, 0x9d, 0xe4, 0x38, 0x60, 0x8e, 0x7b, 0x45, 0x48, 0x90, 0x0a, 0x29, 0x97, 0x51, 0x4d, 0xe5,
+	0x0a, 0xff, 0x27, 0x42, 0x30, 0x6a, 0x62, 0x46, 0xb4, 0x29, 0x2e, 0xe2, 0xbf, 0x91, 0x06, 0xd9,
+	0x1d, 0xe2, 0x51, 0xcb, 0xb1, 0x35, 0xc4, 0xc5, 0xe1, 0x52, 0x7f, 0x3f, 0x4c, 0x5e, 0xdf, 0xac,
+	0x19, 0x12, 0x85, 0x2e, 0x41, 0xda, 0xc4, 0x0c, 0xaf, 0x71, 0xe6, 0xe4, 0xd7, 0xa6, 0xbb, 0xac,
+	0x90, 0x78, 0x56, 0x15, 0x10, 0xfd, 0x27, 0x29, 0x38, 0x1d, 0x88, 0xaf, 0xf5, 0x7c, 0xbf, 0x37,
+	0x4c, 0xf4, 0x99, 0x27, 0x50, 0xb5, 0x2e, 0x23, 0x73, 0x81, 0xa4, 0x62, 0xa2, 0x33, 0x90, 0xed,
+	0x50, 0x22, 0xb1, 0x32, 0xe3, 0x2f, 0x2b, 0x26, 0x5a, 0x02, 0x55, 0x8a, 0x33, 0xbf, 0x7e, 0x9c,
+	0x97, 0xe9, 0xea, 0x49, 0x49, 0x7e, 0x7d, 0xcf, 0x25, 0x68, 0x01, 0x4e, 0x5a, 0xf6, 0x8e, 0xc5,
+	0x88, 0x57, 0x0b, 0x6d, 0x65, 0xb8, 0xad, 0x89, 0x40, 0xbc, 0x25, 0x4c, 0xce, 0x01, 0x08, 0x81,
+	0x59, 0xc3, 0x8c, 0xf3, 0x34, 0x5d, 0xcd, 0x05, 0x92, 0x75, 0x86, 0x66, 0x21, 0xf7, 0x98, 0x65,
+	0x3c, 0x26, 0xb4, 0x63, 0x5c, 0x3b, 0x26, 0x04, 0xeb, 0xcc, 0xf7, 0xb3, 0x45, 0xea, 0xcc, 0x57,
+	0xe5, 0xb8, 0x2a, 0xe3, 0x2f, 0xd7, 0x99, 0xcf, 0x32, 0xca, 0xfc, 0x44, 0x02, 0x17, 0x8b, 0x05,
+	0x5a, 0x82, 0x29, 0x8f, 0x60, 0xb3, 0x66, 0xd9, 0xdb, 0xce, 0x6e, 0xad, 0x8d, 0x77, 0x7d, 0xa7,
+	0xf2, 0x1c, 0x31, 0xe9, 0x2b, 0x2a, 0xbe, 0xfc, 0x0a, 0xde, 0xad, 0x98, 0x5d, 0x22, 0x8c, 0xf7,
+	0x88, 0xa0, 0x57, 0x61, 0xa6, 0x97, 0xee, 0xfe, 0x94, 0xbd, 0x37, 0x9a, 0xf9, 0xf9, 0xfe, 0xcc,
+	0xf7, 0xe1, 0x43, 0x12, 0xfc, 0x45, 0x81, 0xc9, 0x2b, 0x1d, 0x86, 0xb7, 0x5b, 0x24, 0x00, 0x1e,
+	0x7f, 0xf2, 0x57, 0x20, 0x2c, 0xcf, 0x5a, 0x6a, 0x08, 0x4d, 0x43, 0x10, 0x2a, 0xc3, 0xb8, 0x7c,
+	0xb9, 0xb4, 0xd1, 0x52, 0xea, 0x30, 0x27, 0x8c, 0x7c, 0xa4, 0x6f, 0xc0, 0xd4, 0xf5, 0xcd, 0x5a,
+	0x3b, 0x7a, 0xd4, 0xe5, 0x68, 0xd0, 0xce, 0x74, 0x4d, 0x46, 0x43, 0x12, 0x06, 0xeb, 0x75, 0x05,
+	0xb4, 0x5e, 0x06, 0x6a, 0xbc, 0x52, 0x76, 0x6d, 0xf5, 0xc5, 0x43, 0xf9, 0xef, 0x2e, 0x43, 0xaf,
+	0x0c, 0xa7, 0xfa, 0xcb, 0x70, 0xa4, 0xca, 0x8e, 0x0e, 0xad, 0xb2, 0xe9, 0xfe, 0x2a, 0xdb, 0xad,
+	0x81, 0x99, 0xd8, 0x1a, 0x98, 0x95, 0x6b, 0x60, 0x48, 0xb9, 0x31, 0x89, 0x72, 0x5f, 0x52, 0xe0,
+	0xac, 0x74, 0xe2, 0x06, 0x61, 0x7d, 0x64, 0x39, 0xda, 0xa9, 0x87, 0x94, 0x00, 0xf1, 0x56, 0xf9,
+	0xa9, 0xe6, 0x6f, 0x95, 0xfe, 0x45, 0x05, 0xce, 0x45, 0x3d, 0x09, 0x5c, 0xd8, 0xb4, 0x28, 0xdb,
+	0xd8, 0xab, 0x98, 0xfe, 0xdf, 0x23, 0xb8, 0x73, 0x06, 0xb2, 0x94, 0xb4, 0xea, 0x3d, 0x5f, 0x32,
+	0xfe, 0x32, 0xc6, 0x91, 0x67, 0x14, 0x38, 0x2d, 0x39, 0xf2, 0x19, 0xc7, 0xb2, 0x8f, 0x3d, 0x18,
+	0x89, 0xf5, 0xb0, 0x5b, 0xbc, 0xb8, 0x4e, 0xbc, 0xd0, 0x41, 0xf1, 0xf2, 0x2a, 0xa6, 0xfe, 0xa4,
+	0x02, 0x67, 0x24, 0x5f, 0x5b, 0x04, 0xef, 0x90, 0xfb, 0xe6, 0xac, 0x7e, 0x33, 0x29, 0x85, 0xd2,
+	0xcd, 0xa5, 0xc7, 0xe7, 0xd7, 0x69, 0xc8, 0x38, 0xf5, 0x3a, 0x25, 0xe2, 0x12, 0xa5, 0xab, 0xc1,
+	0xca, 0xbf, 0x0d, 0x2d, 0xab, 0x6d, 0xb1, 0xe0, 0x21, 0x11, 0x0b, 0xfd, 0x86, 0x02, 0x0b, 0x07,
+	0x39, 0x7b, 0x64, 0xd2, 0x0d, 0xf7, 0x58, 0x77, 0x61, 0xb6, 0xef, 0xf2, 0xed, 0x49, 0xa4, 0x3f,
+	0x1a, 0xd9, 0xc3, 0x14, 0xa5, 0x22, 0x29, 0x7a, 0x59, 0x81, 0x39, 0x69, 0x4b, 0x4a, 0x6c, 0x33,
+	0xd8, 0x31, 0xec, 0x59, 0x8e, 0xb4, 0x69, 0xdd, 0x73, 0xda, 0xd2, 0xa6, 0xfe, 0x52, 0x90, 0x58,
+	0x8a, 0xc2, 0x68, 0x7f, 0xde, 0x66, 0x21, 0xe7, 0x61, 0xdb, 0x14, 0x5f, 0x0a, 0x8a, 0x8f, 0x09,
+	0x41, 0xc5, 0x44, 0x97, 0x20, 0x1b, 0xf6, 0x57, 0x19, 0x5e, 0xc3, 0xd5, 0x6e, 0xeb, 0x16, 0x78,
+	0x5c, 0x0d, 0x01, 0xfe, 0x6d, 0x28, 0xc5, 0xa6, 0x34, 0x40, 0x1e, 0x6b, 0x32, 0xa5, 0x3a, 0x92,
+	0xe6, 0x75, 0xe4, 0xe6, 0x40, 0x69, 0x0d, 0xbc, 0x79, 0xd8, 0xa2, 0xcc, 0xf1, 0xf6, 0x8e, 0xcf,
+	0x93, 0x59, 0xc8, 0x09, 0xea, 0x87, 0xe1, 0x4e, 0x57, 0xc7, 0x84, 0xa0, 0x62, 0x26, 0xdc, 0x86,
+	0x1f, 0x46, 0x79, 0xe1, 0x37, 0x2b, 0xf7, 0xca, 0xdb, 0xc4, 0xda, 0x77, 0x0a, 0x32, 0x41, 0x0b,
+	0x15, 0xb8, 0xda, 0xf6, 0x3b, 0x27, 0xfd, 0xe7, 0x51, 0x57, 0x89, 0x69, 0xb1, 0xfb, 0x4e, 0x61,
+	0x89, 0xa5, 0xe9, 0x83, 0x58, 0xfa, 0xfb, 0xa8, 0xff, 0x3d, 0x5e, 0xac, 0x9b, 0x6d, 0xcb, 0xde,
+	0x74, 0x1a, 0xf7, 0x3d, 0xd4, 0xa9, 0x20, 0xd4, 0x5c, 0x6c, 0xd9, 0xbd, 0xce, 0x3a, 0xdd, 0xb6,
+	0x6c, 0x99, 0x42, 0x59, 0x99, 0x42, 0x4f, 0x9f, 0x88, 0xdc, 0x3e, 0x4a, 0xb0, 0x67, 0x34, 0xa3,
+	0x99, 0xa1, 0xf7, 0xe1, 0x68, 0xe3, 0xa0, 0x3c, 0xce, 0x4f, 0x95, 0xab, 0x2a, 0x8f, 0xcb, 0x09,
+	0xce, 0x44, 0x12, 0x1c, 0xb9, 0x33, 0xd9, 0xbe, 0x3b, 0x33, 0x03, 0x63, 0x7e, 0x1c, 0xa4, 0xee,
+	0x29, 0xdb, 0xb6, 0xec, 0x87, 0x30, 0x23, 0x5c, 0x85, 0x77, 0x85, 0x2a, 0x17, 0xa8, 0xf0, 0x2e,
+	0x57, 0x75, 0xc3, 0x04, 0x91, 0x30, 0x45, 0x8b, 0x94, 0x49, 0x5a, 0x84, 0x91, 0xff, 0x5f, 0x98,
+	0xfe, 0x57, 0x06, 0x8b, 0xea, 0x95, 0xee, 0x56, 0xaf, 0x0f, 0xc1, 0xa9, 0x47, 0x89, 0x6f, 0xb1,
+	0x76, 0x25, 0xb6, 0xa5, 0xa6, 0x9a, 0x52, 0x4a, 0x1d, 0xd8, 0x52, 0x53, 0xfd, 0x51, 0x98, 0x0b,
+	0xec, 0x94, 0x87, 0xce, 0x35, 0xa1, 0xbd, 0xc3, 0xcd, 0x35, 0x54, 0x3f, 0x0f, 0xf9, 0xc0, 0xee,
+	0xa6, 0x63, 0x37, 0xfc, 0x58, 0xf7, 0xac, 0xa4, 0x42, 0xd0, 0x07, 0x60, 0x2a, 0x3c, 0x84, 0x88,
+	0xec, 0x86, 0xb3, 0x8b, 0x96, 0xa2, 0x1b, 0x3e, 0xd0, 0x7f, 0x53, 0x37, 0x9c, 0xdd, 0xf0, 0xfb,
+	0x2a, 0xcc, 0x46, 0x9d, 0x0f, 0xaf, 0xe8, 0x07, 0x77, 0x88, 0xcd, 0xd0, 0xe5, 0xa8, 0xa5, 0xb9,
+	0xae, 0xa5, 0x38, 0x74, 0x60, 0xf3, 0xd2, 0xcb, 0x19, 0x98, 0x88, 0xe4, 0x0f, 0x4d, 0xc1, 0x44,
+	0xb9, 0x5a, 0xbe, 0xbc, 0x56, 0xdb, 0xba, 0xfa, 0xc8, 0xd5, 0x0f, 0x7f, 0xec, 0xaa, 0x3a, 0x82,
+	0x66, 0x60, 0x4a, 0x88, 0xa4, 0xd9, 0x5f, 0xfd, 0xea, 0x9b, 0xcf, 0xfc, 0x4d, 0x41, 0x4b, 0x70,
+	0x36, 0xa2, 0xea, 0x8b, 0x8f, 0xfa, 0xda, 0x9d, 0x3b, 0x77, 0xde, 0xde, 0xdf, 0xdf, 0xdf, 0x57,
+	0xd0, 0x59, 0x98, 0x16, 0xd0, 0xe8, 0x54, 0xa4, 0xfe, 0xf2, 0x8d, 0xaf, 0x3f, 0x9b, 0x42, 0xe7,
+	0x61, 0x36, 0x62, 0x28, 0x3a, 0xee, 0xa8, 0xcf, 0xde, 0x7c, 0xed, 0x9d, 0x51, 0xf4, 0x2e, 0x98,
+	0x8f, 0x82, 0x06, 0x26, 0x04, 0xf5, 0x95, 0xe7, 0xbf, 0xf2, 0x5c, 0x16, 0x3d, 0x08, 0x17, 0x06,
+	0x80, 0x31, 0x0d, 0xbc, 0xfa, 0xc2, 0x37, 0x5f, 0xbf, 0xbd, 0x2f, 0xdc, 0x5b, 0x80, 0x99, 0xe8,
+	0x27, 0x52, 0xab, 0xad, 0xfe, 0xf5, 0xc6, 0xdb, 0x4f, 0xfe, 0x4b, 0xe0, 0xce, 0x41, 0x21, 0x8a,
+	0x93, 0xdb, 0x5c, 0xf5, 0x9f, 0x4f, 0xff, 0xa6, 0x83, 0xde, 0x9d, 0xbc, 0xbb, 0xdc, 0x7b, 0xaa,
+	0x7f, 0xfe, 0xc1, 0x8b, 0x5b, 0xe8, 0x41, 0x58, 0x3a, 0x04, 0x38, 0xf0, 0xf7, 0xd6, 0x17, 0xbe,
+	0xf5, 0x6b, 0xdf, 0xd5, 0xe2, 0x60, 0x18, 0xe4, 0x5e, 0x4d, 0x7d, 0xf5, 0x67, 0xcf, 0xfd, 0x36,
+	0x83, 0x96, 0xa1, 0x14, 0xc5, 0x0d, 0x36, 0x58, 0xea, 0x1f, 0x6f, 0xfc, 0xea, 0x8d, 0x7f, 0x8b,
+	0x93, 0xbd, 0x07, 0xce, 0x27, 0x79, 0x22, 0xb5, 0x2c, 0xea, 0x53, 0x4f, 0x7d, 0xff, 0xcd, 0xb7,
+	0xc2, 0x98, 0xcd, 0x27, 0x7d, 0x11, 0x3c, 0xd4, 0xea, 0x8f, 0xbe, 0xf3, 0xb9, 0x47, 0x06, 0x1d,
+	0x19, 0x7c, 0xd1, 0xd5, 0x57, 0xbe, 0x7b, 0xf7, 0xf3, 0x01, 0x53, 0x06, 0xe0, 0x83, 0xaf, 0xaa,
+	0xfa, 0xfc, 0xd7, 0xfe, 0xfe, 0x52, 0x12, 0x7c, 0xf0, 0x11, 0x53, 0x6f, 0xff, 0xe1, 0xa7, 0xb7,
+	0xde, 0x49, 0x38, 0x66, 0xec, 0xdb, 0xa0, 0x7e, 0xe3, 0xd6, 0xb7, 0x6f, 0xbf, 0x95, 0xf0, 0x45,
+	0x6c, 0x99, 0x54, 0x7f, 0xfc, 0xc2, 0x4b, 0xbf, 0x13, 0xa1, 0x2c, 0x8c, 0x7e, 0xf9, 0x7b, 0xc5,
+	0x91, 0xb5, 0xdb, 0xe3, 0x00, 0xd5, 0x6b, 0xe5, 0x00, 0x87, 0xb6, 0xe0, 0x54, 0xfc, 0x2c, 0x7f,
+	0x4e, 0x2a, 0xa5, 0xf1, 0xfc, 0x2f, 0x24, 0x15, 0x36, 0x7d, 0x04, 0x7d, 0x0a, 0x66, 0x92, 0x07,
+	0xe6, 0x8b, 0x71, 0xa6, 0x07, 0x60, 0xc3, 0xcc, 0xdb, 0x50, 0x3c, 0x60, 0x0a, 0xbe, 0x94, 0xb0,
+	0x47, 0x0c, 0xb6, 0x50, 0xec, 0x62, 0x63, 0xeb, 0xb9, 0x3e, 0x82, 0x3e, 0x02, 0x0f, 0xc4, 0x0d,
+	0xbb, 0xf3, 0x71, 0x9b, 0x48, 0x80, 0x61, 0x47, 0xf8, 0x28, 0x4c, 0xc7, 0xce, 0xa4, 0xa5, 0x38,
+	0x9b, 0x32, 0x62, 0x98, 0xd1, 0x1d, 0x28, 0x0e, 0xbd, 0xb1, 0xf4, 0xa0, 0xb8, 0xc8, 0xd8, 0xc2,
+	0x42, 0x7f, 0x5c, 0xe2, 0xdf, 0x1b, 0x9e, 0x8f, 0xf3, 0x87, 0x99, 0x12, 0x57, 0x0f, 0xbd, 0x79,
+	0x90, 0x99, 0xe9, 0x7e, 0x0f, 0xfc, 0x97, 0x4c, 0x1f, 0x41, 0x1f, 0x07, 0x2d, 0x71, 0x24, 0xbc,
+	0x90, 0xc4, 0x2e, 0x19, 0x95, 0x68, 0xf9, 0x93, 0x50, 0x18, 0x32, 0xf9, 0x2d, 0xc4, 0xd9, 0x1e,
+	0xc4, 0x15, 0xe2, 0x5e, 0x4c, 0x7d, 0x04, 0x35, 0x61, 0x6e, 0xf8, 0xe4, 0xb5, 0x34, 0x3c, 0x40,
+	0x12, 0xb4, 0x50, 0x18, 0x20, 0xad, 0xbc, 0xd3, 0xa7, 0x61, 0x66, 0xf0, 0xf3, 0x70, 0x4e, 0xb9,
+	0x38, 0x7c, 0x97, 0x00, 0x76, 0xc0, 0x0e, 0x5b, 0x50, 0x48, 0x2e, 0x9c, 0xf1, 0x81, 0x1a, 0xc4,
+	0x15, 0x26, 0xba, 0x81, 0xda, 0x70, 0x9c, 0x56, 0x34, 0xfe, 0x31, 0x63, 0x4b, 0xac, 0xd9, 0x41,
+	0x5c, 0x52, 0xfc, 0x5b, 0x50, 0x18, 0x3c, 0x6e, 0x77, 0xa6, 0x58, 0x18, 0x1e, 0x96, 0x10, 0x57,
+	0xb8, 0x90, 0x70, 0x2d, 0x22, 0xbd, 0x4c, 0x34, 0xdb, 0xf1, 0x9d, 0xfe, 0x52, 0x3c, 0x9b, 0x62,
+	0xa0, 0x07, 0xe4, 0xc2, 0x85, 0xb9, 0xa1, 0xaf, 0x40, 0xfc, 0x4e, 0xb1, 0xd0, 0x82, 0xde, 0x1f,
+	0x3a, 0x5a, 0x5b, 0xaf, 0xd7, 0x89, 0xc1, 0x88, 0x19, 0x0a, 0xf4, 0x91, 0x8d, 0xad, 0x7f, 0xfc,
+	0xa9, 0xa8, 0xbc, 0x78, 0xb7, 0xa8, 0xdc, 0xb9, 0x5b, 0x54, 0x5e, 0xbd, 0x5b, 0x54, 0x3e, 0x51,
+	0x96, 0xfe, 0xcb, 0xc8, 0x08, 0x6e, 0x37, 0x3c, 0xdc, 0xfb, 0xb1, 0x4c, 0x89, 0xb7, 0x43, 0xbc,
+	0x55, 0xec, 0xba, 0xab, 0xfe, 0x4f, 0xcb, 0x20, 0xab, 0xdb, 0xd6, 0x67, 0x57, 0x03, 0x57, 0xc2,
+	0xbf, 0xdb, 0x19, 0xbe, 0xf3, 0xe5, 0xff, 0x0c, 0x00, 0x2d, 0x31, 0x45, 0xa5, 0xce, 0x1c, 0x00,
+	0x00,
+}
+
+func (this *ChannelData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 22)
+	s = append(s, "&channel.ChannelData{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "CreatorId: "+fmt.Sprintf("%#v", this.CreatorId)+",\n")
+	s = append(s, "AccessHash: "+fmt.Sprintf("%#v", this.AccessHash)+",\n")
+	s = append(s, "Broadcast: "+fmt.Sprintf("%#v", this.Broadcast)+",\n")
+	s = append(s, "Megagroup: "+fmt.Sprintf("%#v", this.Megagroup)+",\n")
+	s = append(s, "Deactivated: "+fmt.Sprintf("%#v", this.Deactivated)+",\n")
+	s = append(s, "Noforwards: "+fmt.Sprintf("%#v", this.Noforwards)+",\n")
+	s = append(s, "Signatures: "+fmt.Sprintf("%#v", this.Signatures)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "About: "+fmt.Sprintf("%#v", this.About)+",\n")
+	if this.Photo != nil {
+		s = append(s, "Photo: "+fmt.Sprintf("%#v", this.Photo)+",\n")
+	}
+	s = append(s, "ParticipantsCount: "+fmt.Sprintf("%#v", this.ParticipantsCount)+",\n")
+	s = append(s, "TopMessage: "+fmt.Sprintf("%#v", this.TopMessage)+",\n")
+	s = append(s, "Pts: "+fmt.Sprintf("%#v", this.Pts)+",\n")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLChannelData{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ChannelParticipantData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&channel.ChannelParticipantData{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ParticipantType: "+fmt.Sprintf("%#v", this.ParticipantType)+",\n")
+	s = append(s, "InviterUserId: "+fmt.Sprintf("%#v", this.InviterUserId)+",\n")
+	s = append(s, "InvitedAt: "+fmt.Sprintf("%#v", this.InvitedAt)+",\n")
+	s = append(s, "KickedAt: "+fmt.Sprintf("%#v", this.KickedAt)+",\n")
+	s = append(s, "LeftAt: "+fmt.Sprintf("%#v", this.LeftAt)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "ReadInboxMaxId: "+fmt.Sprintf("%#v", this.ReadInboxMaxId)+",\n")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelParticipantData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLChannelParticipantData{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.MutableChannel{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.Channel != nil {
+		s = append(s, "Channel: "+fmt.Sprintf("%#v", this.Channel)+",\n")
+	}
+	if this.Participants != nil {
+		s = append(s, "Participants: "+fmt.Sprintf("%#v", this.Participants)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLMutableChannel{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelCreateChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&channel.TLChannelCreateChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "CreatorId: "+fmt.Sprintf("%#v", this.CreatorId)+",\n")
+	s = append(s, "Broadcast: "+fmt.Sprintf("%#v", this.Broadcast)+",\n")
+	s = append(s, "Megagroup: "+fmt.Sprintf("%#v", this.Megagroup)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "About: "+fmt.Sprintf("%#v", this.About)+",\n")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetMutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelGetMutableChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelListByIdList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelGetChannelListByIdList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "SelfId: "+fmt.Sprintf("%#v", this.SelfId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelJoinChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelJoinChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "InviterId: "+fmt.Sprintf("%#v", this.InviterId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelLeaveChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelLeaveChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelParticipants) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelGetChannelParticipants{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelParticipantIdList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&channel.TLChannelGetChannelParticipantIdList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetMyChannelList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&channel.TLChannelGetMyChannelList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelSendChannelMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.TLChannelSendChannelMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "FromId: "+fmt.Sprintf("%#v", this.FromId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "RandomId: "+fmt.Sprintf("%#v", this.RandomId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelMessageList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelGetChannelMessageList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelGetChannelHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelReadChannelHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelReadChannelHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelEditChannelMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelEditChannelMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "FromId: "+fmt.Sprintf("%#v", this.FromId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelAdminLog) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&channel.TLChannelGetChannelAdminLog{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	s = append(s, "MinId: "+fmt.Sprintf("%#v", this.MinId)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelSearchChannelMessages) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&channel.TLChannelSearchChannelMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
+	s = append(s, "FromId: "+fmt.Sprintf("%#v", this.FromId)+",\n")
+	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
+	s = append(s, "MinDate: "+fmt.Sprintf("%#v", this.MinDate)+",\n")
+	s = append(s, "MaxDate: "+fmt.Sprintf("%#v", this.MaxDate)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelDeleteChannelMessages) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelDeleteChannelMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "FromId: "+fmt.Sprintf("%#v", this.FromId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_MutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.Vector_MutableChannel{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_ChannelParticipantData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.Vector_ChannelParticipantData{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_Long) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.Vector_Long{")
+	s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_MessageBox) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.Vector_MessageBox{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_ChannelAdminLogEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.Vector_ChannelAdminLogEvent{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringChannelTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCChannelClient is the client API for RPCChannel service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCChannelClient interface {
+	// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string date:int = MutableChannel;
+	ChannelCreateChannel(ctx context.Context, in *TLChannelCreateChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+	ChannelGetMutableChannel(ctx context.Context, in *TLChannelGetMutableChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.getChannelListByIdList self_id:long id:Vector<long> = Vector<MutableChannel>;
+	ChannelGetChannelListByIdList(ctx context.Context, in *TLChannelGetChannelListByIdList, opts ...grpc.CallOption) (*Vector_MutableChannel, error)
+	// channel.joinChannel channel_id:long user_id:long inviter_id:long = MutableChannel;
+	ChannelJoinChannel(ctx context.Context, in *TLChannelJoinChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+	ChannelLeaveChannel(ctx context.Context, in *TLChannelLeaveChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.getChannelParticipants channel_id:long offset:int limit:int = Vector<ChannelParticipantData>;
+	ChannelGetChannelParticipants(ctx context.Context, in *TLChannelGetChannelParticipants, opts ...grpc.CallOption) (*Vector_ChannelParticipantData, error)
+	// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+	ChannelGetChannelParticipantIdList(ctx context.Context, in *TLChannelGetChannelParticipantIdList, opts ...grpc.CallOption) (*Vector_Long, error)
+	// channel.getMyChannelList user_id:long = Vector<long>;
+	ChannelGetMyChannelList(ctx context.Context, in *TLChannelGetMyChannelList, opts ...grpc.CallOption) (*Vector_Long, error)
+	// channel.sendChannelMessage from_id:long channel_id:long random_id:long message:Message = MessageBox;
+	ChannelSendChannelMessage(ctx context.Context, in *TLChannelSendChannelMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error)
+	// channel.getChannelMessageList channel_id:long id:Vector<int> = Vector<MessageBox>;
+	ChannelGetChannelMessageList(ctx context.Context, in *TLChannelGetChannelMessageList, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	// channel.getChannelHistory channel_id:long offset_id:int limit:int = Vector<MessageBox>;
+	ChannelGetChannelHistory(ctx context.Context, in *TLChannelGetChannelHistory, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	// channel.readChannelHistory channel_id:long user_id:long max_id:int = Bool;
+	ChannelReadChannelHistory(ctx context.Context, in *TLChannelReadChannelHistory, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// channel.editChannelMessage from_id:long channel_id:long message:Message = MessageBox;
+	ChannelEditChannelMessage(ctx context.Context, in *TLChannelEditChannelMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error)
+	// channel.getChannelAdminLog channel_id:long user_id:long max_id:long min_id:long limit:int = Vector<ChannelAdminLogEvent>;
+	ChannelGetChannelAdminLog(ctx context.Context, in *TLChannelGetChannelAdminLog, opts ...grpc.CallOption) (*Vector_ChannelAdminLogEvent, error)
+	// channel.searchChannelMessages channel_id:long user_id:long q:string from_id:long offset_id:int min_date:int max_date:int limit:int = Vector<MessageBox>;
+	ChannelSearchChannelMessages(ctx context.Context, in *TLChannelSearchChannelMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	// channel.deleteChannelMessages from_id:long channel_id:long id:Vector<int> = messages.AffectedMessages;
+	ChannelDeleteChannelMessages(ctx context.Context, in *TLChannelDeleteChannelMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error)
+}
+
+type rPCChannelClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCChannelClient(cc *grpc.ClientConn) RPCChannelClient {
+	return &rPCChannelClient{cc}
+}
+
+func (c *rPCChannelClient) ChannelCreateChannel(ctx context.Context, in *TLChannelCreateChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_createChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetMutableChannel(ctx context.Context, in *TLChannelGetMutableChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getMutableChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelListByIdList(ctx context.Context, in *TLChannelGetChannelListByIdList, opts ...grpc.CallOption) (*Vector_MutableChannel, error) {
+	out := new(Vector_MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelListByIdList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelJoinChannel(ctx context.Context, in *TLChannelJoinChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_joinChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelLeaveChannel(ctx context.Context, in *TLChannelLeaveChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_leaveChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelParticipants(ctx context.Context, in *TLChannelGetChannelParticipants, opts ...grpc.CallOption) (*Vector_ChannelParticipantData, error) {
+	out := new(Vector_ChannelParticipantData)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelParticipantIdList(ctx context.Context, in *TLChannelGetChannelParticipantIdList, opts ...grpc.CallOption) (*Vector_Long, error) {
+	out := new(Vector_Long)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelParticipantIdList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetMyChannelList(ctx context.Context, in *TLChannelGetMyChannelList, opts ...grpc.CallOption) (*Vector_Long, error) {
+	out := new(Vector_Long)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getMyChannelList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelSendChannelMessage(ctx context.Context, in *TLChannelSendChannelMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error) {
+	out := new(mtproto.MessageBox)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_sendChannelMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelMessageList(ctx context.Context, in *TLChannelGetChannelMessageList, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelMessageList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelHistory(ctx context.Context, in *TLChannelGetChannelHistory, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelReadChannelHistory(ctx context.Context, in *TLChannelReadChannelHistory, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_readChannelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelEditChannelMessage(ctx context.Context, in *TLChannelEditChannelMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error) {
+	out := new(mtproto.MessageBox)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_editChannelMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelAdminLog(ctx context.Context, in *TLChannelGetChannelAdminLog, opts ...grpc.CallOption) (*Vector_ChannelAdminLogEvent, error) {
+	out := new(Vector_ChannelAdminLogEvent)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelAdminLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelSearchChannelMessages(ctx context.Context, in *TLChannelSearchChannelMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_searchChannelMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelDeleteChannelMessages(ctx context.Context, in *TLChannelDeleteChannelMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error) {
+	out := new(mtproto.Messages_AffectedMessages)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_deleteChannelMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCChannelServer is the server API for RPCChannel service.
+type RPCChannelServer interface {
+	// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string date:int = MutableChannel;
+	ChannelCreateChannel(context.Context, *TLChannelCreateChannel) (*MutableChannel, error)
+	// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+	ChannelGetMutableChannel(context.Context, *TLChannelGetMutableChannel) (*MutableChannel, error)
+	// channel.getChannelListByIdList self_id:long id:Vector<long> = Vector<MutableChannel>;
+	ChannelGetChannelListByIdList(context.Context, *TLChannelGetChannelListByIdList) (*Vector_MutableChannel, error)
+	// channel.joinChannel channel_id:long user_id:long inviter_id:long = MutableChannel;
+	ChannelJoinChannel(context.Context, *TLChannelJoinChannel) (*MutableChannel, error)
+	// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+	ChannelLeaveChannel(context.Context, *TLChannelLeaveChannel) (*MutableChannel, error)
+	// channel.getChannelParticipants channel_id:long offset:int limit:int = Vector<ChannelParticipantData>;
+	ChannelGetChannelParticipants(context.Context, *TLChannelGetChannelParticipants) (*Vector_ChannelParticipantData, error)
+	// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+	ChannelGetChannelParticipantIdList(context.Context, *TLChannelGetChannelParticipantIdList) (*Vector_Long, error)
+	// channel.getMyChannelList user_id:long = Vector<long>;
+	ChannelGetMyChannelList(context.Context, *TLChannelGetMyChannelList) (*Vector_Long, error)
+	// channel.sendChannelMessage from_id:long channel_id:long random_id:long message:Message = MessageBox;
+	ChannelSendChannelMessage(context.Context, *TLChannelSendChannelMessage) (*mtproto.MessageBox, error)
+	// channel.getChannelMessageList channel_id:long id:Vector<int> = Vector<MessageBox>;
+	ChannelGetChannelMessageList(context.Context, *TLChannelGetChannelMessageList) (*Vector_MessageBox, error)
+	// channel.getChannelHistory channel_id:long offset_id:int limit:int = Vector<MessageBox>;
+	ChannelGetChannelHistory(context.Context, *TLChannelGetChannelHistory) (*Vector_MessageBox, error)
+	// channel.readChannelHistory channel_id:long user_id:long max_id:int = Bool;
+	ChannelReadChannelHistory(context.Context, *TLChannelReadChannelHistory) (*mtproto.Bool, error)
+	// channel.editChannelMessage from_id:long channel_id:long message:Message = MessageBox;
+	ChannelEditChannelMessage(context.Context, *TLChannelEditChannelMessage) (*mtproto.MessageBox, error)
+	// channel.getChannelAdminLog channel_id:long user_id:long max_id:long min_id:long limit:int = Vector<ChannelAdminLogEvent>;
+	ChannelGetChannelAdminLog(context.Context, *TLChannelGetChannelAdminLog) (*Vector_ChannelAdminLogEvent, error)
+	// channel.searchChannelMessages channel_id:long user_id:long q:string from_id:long offset_id:int min_date:int max_date:int limit:int = Vector<MessageBox>;
+	ChannelSearchChannelMessages(context.Context, *TLChannelSearchChannelMessages) (*Vector_MessageBox, error)
+	// channel.deleteChannelMessages from_id:long channel_id:long id:Vector<int> = messages.AffectedMessages;
+	ChannelDeleteChannelMessages(context.Context, *TLChannelDeleteChannelMessages) (*mtproto.Messages_AffectedMessages, error)
+}
+
+// UnimplementedRPCChannelServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCChannelServer struct {
+}
+
+func (*UnimplementedRPCChannelServer) ChannelCreateChannel(ctx context.Context, req *TLChannelCreateChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelCreateChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetMutableChannel(ctx context.Context, req *TLChannelGetMutableChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetMutableChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelListByIdList(ctx context.Context, req *TLChannelGetChannelListByIdList) (*Vector_MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelListByIdList not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelJoinChannel(ctx context.Context, req *TLChannelJoinChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelJoinChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelLeaveChannel(ctx context.Context, req *TLChannelLeaveChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelLeaveChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelParticipants(ctx context.Context, req *TLChannelGetChannelParticipants) (*Vector_ChannelParticipantData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelParticipants not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelParticipantIdList(ctx context.Context, req *TLChannelGetChannelParticipantIdList) (*Vector_Long, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelParticipantIdList not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetMyChannelList(ctx context.Context, req *TLChannelGetMyChannelList) (*Vector_Long, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetMyChannelList not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelSendChannelMessage(ctx context.Context, req *TLChannelSendChannelMessage) (*mtproto.MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelSendChannelMessage not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelMessageList(ctx context.Context, req *TLChannelGetChannelMessageList) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelMessageList not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelHistory(ctx context.Context, req *TLChannelGetChannelHistory) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelHistory not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelReadChannelHistory(ctx context.Context, req *TLChannelReadChannelHistory) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelReadChannelHistory not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelEditChannelMessage(ctx context.Context, req *TLChannelEditChannelMessage) (*mtproto.MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelEditChannelMessage not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelAdminLog(ctx context.Context, req *TLChannelGetChannelAdminLog) (*Vector_ChannelAdminLogEvent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelAdminLog not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelSearchChannelMessages(ctx context.Context, req *TLChannelSearchChannelMessages) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelSearchChannelMessages not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelDeleteChannelMessages(ctx context.Context, req *TLChannelDeleteChannelMessages) (*mtproto.Messages_AffectedMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDeleteChannelMessages not implemented")
+}
+
+func RegisterRPCChannelServer(s *grpc.Server, srv RPCChannelServer) {
+	s.RegisterService(&_RPCChannel_serviceDesc, srv)
+}
+
+func _RPCChannel_ChannelCreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelCreateChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelCreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelCreateChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelCreateChannel(ctx, req.(*TLChannelCreateChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetMutableChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetMutableChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetMutableChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetMutableChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetMutableChannel(ctx, req.(*TLChannelGetMutableChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelListByIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelListByIdList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelListByIdList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelListByIdList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelListByIdList(ctx, req.(*TLChannelGetChannelListByIdList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelJoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelJoinChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelJoinChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelJoinChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelJoinChannel(ctx, req.(*TLChannelJoinChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelLeaveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelLeaveChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelLeaveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelLeaveChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelLeaveChannel(ctx, req.(*TLChannelLeaveChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelParticipants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelParticipants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelParticipants(ctx, req.(*TLChannelGetChannelParticipants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelParticipantIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelParticipantIdList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelParticipantIdList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelParticipantIdList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelParticipantIdList(ctx, req.(*TLChannelGetChannelParticipantIdList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetMyChannelList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetMyChannelList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetMyChannelList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetMyChannelList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetMyChannelList(ctx, req.(*TLChannelGetMyChannelList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelSendChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelSendChannelMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelSendChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelSendChannelMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelSendChannelMessage(ctx, req.(*TLChannelSendChannelMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelMessageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelMessageList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelMessageList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelMessageList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelMessageList(ctx, req.(*TLChannelGetChannelMessageList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelHistory(ctx, req.(*TLChannelGetChannelHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelReadChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelReadChannelHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelReadChannelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelReadChannelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelReadChannelHistory(ctx, req.(*TLChannelReadChannelHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelEditChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelEditChannelMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelEditChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelEditChannelMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelEditChannelMessage(ctx, req.(*TLChannelEditChannelMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelAdminLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelAdminLog)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelAdminLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelAdminLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelAdminLog(ctx, req.(*TLChannelGetChannelAdminLog))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelSearchChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelSearchChannelMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelSearchChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelSearchChannelMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelSearchChannelMessages(ctx, req.(*TLChannelSearchChannelMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelDeleteChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelDeleteChannelMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelDeleteChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelDeleteChannelMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelDeleteChannelMessages(ctx, req.(*TLChannelDeleteChannelMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCChannel_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "channel.RPCChannel",
+	HandlerType: (*RPCChannelServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "channel_createChannel",
+			Handler:    _RPCChannel_ChannelCreateChannel_Handler,
+		},
+		{
+			MethodName: "channel_getMutableChannel",
+			Handler:    _RPCChannel_ChannelGetMutableChannel_Handler,
+		},
+		{
+			MethodName: "channel_getChannelListByIdList",
+			Handler:    _RPCChannel_ChannelGetChannelListByIdList_Handler,
+		},
+		{
+			MethodName: "channel_joinChannel",
+			Handler:    _RPCChannel_ChannelJoinChannel_Handler,
+		},
+		{
+			MethodName: "channel_leaveChannel",
+			Handler:    _RPCChannel_ChannelLeaveChannel_Handler,
+		},
+		{
+			MethodName: "channel_getChannelParticipants",
+			Handler:    _RPCChannel_ChannelGetChannelParticipants_Handler,
+		},
+		{
+			MethodName: "channel_getChannelParticipantIdList",
+			Handler:    _RPCChannel_ChannelGetChannelParticipantIdList_Handler,
+		},
+		{
+			MethodName: "channel_getMyChannelList",
+			Handler:    _RPCChannel_ChannelGetMyChannelList_Handler,
+		},
+		{
+			MethodName: "channel_sendChannelMessage",
+			Handler:    _RPCChannel_ChannelSendChannelMessage_Handler,
+		},
+		{
+			MethodName: "channel_getChannelMessageList",
+			Handler:    _RPCChannel_ChannelGetChannelMessageList_Handler,
+		},
+		{
+			MethodName: "channel_getChannelHistory",
+			Handler:    _RPCChannel_ChannelGetChannelHistory_Handler,
+		},
+		{
+			MethodName: "channel_readChannelHistory",
+			Handler:    _RPCChannel_ChannelReadChannelHistory_Handler,
+		},
+		{
+			MethodName: "channel_editChannelMessage",
+			Handler:    _RPCChannel_ChannelEditChannelMessage_Handler,
+		},
+		{
+			MethodName: "channel_getChannelAdminLog",
+			Handler:    _RPCChannel_ChannelGetChannelAdminLog_Handler,
+		},
+		{
+			MethodName: "channel_searchChannelMessages",
+			Handler:    _RPCChannel_ChannelSearchChannelMessages_Handler,
+		},
+		{
+			MethodName: "channel_deleteChannelMessages",
+			Handler:    _RPCChannel_ChannelDeleteChannelMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "channel.tl.proto",
+}
+
+func (m *ChannelData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Version != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Date != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Pts != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Pts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.TopMessage != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.TopMessage))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ParticipantsCount != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ParticipantsCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Photo != nil {
+		{
+			size, err := m.Photo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.About) > 0 {
+		i -= len(m.About)
+		copy(dAtA[i:], m.About)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.About)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Signatures {
+		i--
+		if m.Signatures {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Noforwards {
+		i--
+		if m.Noforwards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Deactivated {
+		i--
+		if m.Deactivated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Megagroup {
+		i--
+		if m.Megagroup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Broadcast {
+		i--
+		if m.Broadcast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AccessHash != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.AccessHash))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.CreatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelParticipantData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelParticipantData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelParticipantData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Date != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ReadInboxMaxId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ReadInboxMaxId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.State != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LeftAt != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.LeftAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.KickedAt != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.KickedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InvitedAt != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.InvitedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InviterUserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.InviterUserId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ParticipantType != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ParticipantType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelParticipantData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelParticipantData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelParticipantData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Channel != nil {
+		{
+			size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelCreateChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelCreateChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelCreateChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Date != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.About) > 0 {
+		i -= len(m.About)
+		copy(dAtA[i:], m.About)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.About)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Megagroup {
+		i--
+		if m.Megagroup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Broadcast {
+		i--
+		if m.Broadcast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.CreatorId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetMutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetMutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetMutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA7 := make([]byte, len(m.Id)*10)
+		var j6 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelListByIdList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelListByIdList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelListByIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA9 := make([]byte, len(m.Id)*10)
+		var j8 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SelfId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.SelfId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelJoinChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelJoinChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelJoinChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InviterId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.InviterId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelLeaveChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelLeaveChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelLeaveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelParticipants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelParticipants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelParticipants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Offset != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelParticipantIdList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelParticipantIdList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelParticipantIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetMyChannelList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetMyChannelList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetMyChannelList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelSendChannelMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelSendChannelMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelSendChannelMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RandomId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.RandomId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelMessageList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelMessageList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelMessageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA12 := make([]byte, len(m.Id)*10)
+		var j11 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OffsetId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OffsetId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelReadChannelHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelReadChannelHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelReadChannelHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelEditChannelMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelEditChannelMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelEditChannelMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelAdminLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelAdminLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelAdminLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MinId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelSearchChannelMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelSearchChannelMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelSearchChannelMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxDate != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MaxDate))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinDate != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MinDate))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OffsetId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OffsetId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FromId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Q) > 0 {
+		i -= len(m.Q)
+		copy(dAtA[i:], m.Q)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Q)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelDeleteChannelMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelDeleteChannelMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelDeleteChannelMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA17 := make([]byte, len(m.Id)*10)
+		var j16 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_MutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_MutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_MutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_ChannelParticipantData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_ChannelParticipantData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_ChannelParticipantData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_Long) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_Long) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Long) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		dAtA15 := make([]byte, len(m.Datas)*10)
+		var j14 int
+		for _, num1 := range m.Datas {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_MessageBox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_MessageBox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_MessageBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_ChannelAdminLogEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_ChannelAdminLogEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_ChannelAdminLogEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannelTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannelTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovChannelTl(uint64(m.Id))
+	}
+	if m.CreatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.CreatorId))
+	}
+	if m.AccessHash != 0 {
+		n += 1 + sovChannelTl(uint64(m.AccessHash))
+	}
+	if m.Broadcast {
+		n += 2
+	}
+	if m.Megagroup {
+		n += 2
+	}
+	if m.Deactivated {
+		n += 2
+	}
+	if m.Noforwards {
+		n += 2
+	}
+	if m.Signatures {
+		n += 2
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	l = len(m.About)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Photo != nil {
+		l = m.Photo.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.ParticipantsCount != 0 {
+		n += 1 + sovChannelTl(uint64(m.ParticipantsCount))
+	}
+	if m.TopMessage != 0 {
+		n += 1 + sovChannelTl(uint64(m.TopMessage))
+	}
+	if m.Pts != 0 {
+		n += 2 + sovChannelTl(uint64(m.Pts))
+	}
+	if m.Date != 0 {
+		n += 2 + sovChannelTl(uint64(m.Date))
+	}
+	if m.Version != 0 {
+		n += 2 + sovChannelTl(uint64(m.Version))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChannelParticipantData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ParticipantType != 0 {
+		n += 1 + sovChannelTl(uint64(m.ParticipantType))
+	}
+	if m.InviterUserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.InviterUserId))
+	}
+	if m.InvitedAt != 0 {
+		n += 1 + sovChannelTl(uint64(m.InvitedAt))
+	}
+	if m.KickedAt != 0 {
+		n += 1 + sovChannelTl(uint64(m.KickedAt))
+	}
+	if m.LeftAt != 0 {
+		n += 1 + sovChannelTl(uint64(m.LeftAt))
+	}
+	if m.State != 0 {
+		n += 1 + sovChannelTl(uint64(m.State))
+	}
+	if m.ReadInboxMaxId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ReadInboxMaxId))
+	}
+	if m.Date != 0 {
+		n += 1 + sovChannelTl(uint64(m.Date))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelParticipantData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelCreateChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.CreatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.CreatorId))
+	}
+	if m.Broadcast {
+		n += 2
+	}
+	if m.Megagroup {
+		n += 2
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	l = len(m.About)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Date != 0 {
+		n += 1 + sovChannelTl(uint64(m.Date))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetMutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelListByIdList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.SelfId != 0 {
+		n += 1 + sovChannelTl(uint64(m.SelfId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelJoinChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.InviterId != 0 {
+		n += 1 + sovChannelTl(uint64(m.InviterId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelLeaveChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelParticipants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovChannelTl(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannelTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelParticipantIdList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetMyChannelList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelSendChannelMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.FromId != 0 {
+		n += 1 + sovChannelTl(uint64(m.FromId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.RandomId != 0 {
+		n += 1 + sovChannelTl(uint64(m.RandomId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelMessageList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OffsetId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OffsetId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannelTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelReadChannelHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovChannelTl(uint64(m.MaxId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelEditChannelMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.FromId != 0 {
+		n += 1 + sovChannelTl(uint64(m.FromId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelAdminLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovChannelTl(uint64(m.MaxId))
+	}
+	if m.MinId != 0 {
+		n += 1 + sovChannelTl(uint64(m.MinId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannelTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelSearchChannelMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	l = len(m.Q)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.FromId != 0 {
+		n += 1 + sovChannelTl(uint64(m.FromId))
+	}
+	if m.OffsetId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OffsetId))
+	}
+	if m.MinDate != 0 {
+		n += 1 + sovChannelTl(uint64(m.MinDate))
+	}
+	if m.MaxDate != 0 {
+		n += 1 + sovChannelTl(uint64(m.MaxDate))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannelTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelDeleteChannelMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.FromId != 0 {
+		n += 1 + sovChannelTl(uint64(m.FromId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_MutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_ChannelParticipantData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Long) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		l = 0
+		for _, e := range m.Datas {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_MessageBox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_ChannelAdminLogEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovChannelTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChannelTl(x uint64) (n int) {
+	return sovChannelTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorId", wireType)
+			}
+			m.CreatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessHash", wireType)
+			}
+			m.AccessHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessHash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broadcast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broadcast = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Megagroup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Megagroup = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deactivated = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Noforwards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Noforwards = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Signatures = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field About", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.About = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Photo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Photo == nil {
+				m.Photo = &mtproto.Photo{}
+			}
+			if err := m.Photo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsCount", wireType)
+			}
+			m.ParticipantsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantsCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopMessage", wireType)
+			}
+			m.TopMessage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopMessage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pts", wireType)
+			}
+			m.Pts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channelData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channelData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ChannelData{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelParticipantData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelParticipantData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelParticipantData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantType", wireType)
+			}
+			m.ParticipantType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviterUserId", wireType)
+			}
+			m.InviterUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InviterUserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitedAt", wireType)
+			}
+			m.InvitedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvitedAt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KickedAt", wireType)
+			}
+			m.KickedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KickedAt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftAt", wireType)
+			}
+			m.LeftAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeftAt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadInboxMaxId", wireType)
+			}
+			m.ReadInboxMaxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadInboxMaxId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelParticipantData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channelParticipantData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channelParticipantData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ChannelParticipantData{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Channel == nil {
+				m.Channel = &ChannelData{}
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &ChannelParticipantData{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_mutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_mutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &MutableChannel{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelCreateChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_createChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_createChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorId", wireType)
+			}
+			m.CreatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broadcast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broadcast = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Megagroup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Megagroup = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field About", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.About = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetMutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getMutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getMutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelListByIdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelListByIdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelListByIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfId", wireType)
+			}
+			m.SelfId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelJoinChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_joinChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_joinChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviterId", wireType)
+			}
+			m.InviterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InviterId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelLeaveChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_leaveChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_leaveChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelParticipants) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelParticipants: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelParticipantIdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelParticipantIdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelParticipantIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetMyChannelList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getMyChannelList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getMyChannelList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelSendChannelMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_sendChannelMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_sendChannelMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+			}
+			m.FromId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomId", wireType)
+			}
+			m.RandomId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandomId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &mtproto.Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelMessageList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelMessageList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelMessageList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
+			}
+			m.OffsetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelReadChannelHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_readChannelHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_readChannelHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
+			}
+			m.MaxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelEditChannelMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_editChannelMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_editChannelMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+			}
+			m.FromId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &mtproto.Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelAdminLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelAdminLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelAdminLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
+			}
+			m.MaxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinId", wireType)
+			}
+			m.MinId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelSearchChannelMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_searchChannelMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_searchChannelMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Q = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+			}
+			m.FromId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
+			}
+			m.OffsetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDate", wireType)
+			}
+			m.MinDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDate", wireType)
+			}
+			m.MaxDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelDeleteChannelMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_deleteChannelMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_deleteChannelMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+			}
+			m.FromId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_MutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_MutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_MutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &MutableChannel{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_ChannelParticipantData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_ChannelParticipantData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_ChannelParticipantData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &ChannelParticipantData{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_Long) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_Long: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_Long: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Datas = append(m.Datas, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Datas) == 0 {
+					m.Datas = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Datas = append(m.Datas, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_MessageBox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_MessageBox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_MessageBox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &mtproto.MessageBox{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_ChannelAdminLogEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_ChannelAdminLogEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_ChannelAdminLogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &mtproto.ChannelAdminLogEvent{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChannelTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChannelTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChannelTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChannelTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChannelTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChannelTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChannelTl = fmt.Errorf("proto: unexpected end of group")
+)
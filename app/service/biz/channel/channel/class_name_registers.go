@@ -0,0 +1,149 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package channel
+
+const (
+	Predicate_channelData                         = "channelData"
+	Predicate_channelParticipantData              = "channelParticipantData"
+	Predicate_mutableChannel                      = "mutableChannel"
+	Predicate_channel_createChannel               = "channel_createChannel"
+	Predicate_channel_getMutableChannel           = "channel_getMutableChannel"
+	Predicate_channel_getChannelListByIdList      = "channel_getChannelListByIdList"
+	Predicate_channel_joinChannel                 = "channel_joinChannel"
+	Predicate_channel_leaveChannel                = "channel_leaveChannel"
+	Predicate_channel_getChannelParticipants      = "channel_getChannelParticipants"
+	Predicate_channel_getChannelParticipantIdList = "channel_getChannelParticipantIdList"
+	Predicate_channel_getMyChannelList            = "channel_getMyChannelList"
+	Predicate_channel_sendChannelMessage          = "channel_sendChannelMessage"
+	Predicate_channel_getChannelMessageList       = "channel_getChannelMessageList"
+	Predicate_channel_getChannelHistory           = "channel_getChannelHistory"
+	Predicate_channel_readChannelHistory          = "channel_readChannelHistory"
+	Predicate_channel_editChannelMessage          = "channel_editChannelMessage"
+	Predicate_channel_getChannelAdminLog          = "channel_getChannelAdminLog"
+	Predicate_channel_searchChannelMessages       = "channel_searchChannelMessages"
+	Predicate_channel_deleteChannelMessages       = "channel_deleteChannelMessages"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_channelData: {
+		0: 487226122, // 0x1d0a7b0a
+
+	},
+	Predicate_channelParticipantData: {
+		0: -636213148, // 0xda142864
+
+	},
+	Predicate_mutableChannel: {
+		0: 910424644, // 0x3643fa44
+
+	},
+	Predicate_channel_createChannel: {
+		0: 1339626802, // 0x4fd91532
+
+	},
+	Predicate_channel_getMutableChannel: {
+		0: 1998741470, // 0x77225fde
+
+	},
+	Predicate_channel_getChannelListByIdList: {
+		0: -126104251, // 0xf87bcd45
+
+	},
+	Predicate_channel_joinChannel: {
+		0: -1585495833, // 0xa17f40e7
+
+	},
+	Predicate_channel_leaveChannel: {
+		0: 246600306, // 0xeb2d272
+
+	},
+	Predicate_channel_getChannelParticipants: {
+		0: 179442531, // 0xab21363
+
+	},
+	Predicate_channel_getChannelParticipantIdList: {
+		0: 421986753, // 0x192701c1
+
+	},
+	Predicate_channel_getMyChannelList: {
+		0: 1770937184, // 0x698e5b60
+
+	},
+	Predicate_channel_sendChannelMessage: {
+		0: -1097744163, // 0xbe91c0dd
+
+	},
+	Predicate_channel_getChannelMessageList: {
+		0: -1899410279, // 0x8ec94c99
+
+	},
+	Predicate_channel_getChannelHistory: {
+		0: 157290284, // 0x9600f2c
+
+	},
+	Predicate_channel_readChannelHistory: {
+		0: -799502370, // 0xd0588fde
+
+	},
+	Predicate_channel_editChannelMessage: {
+		0: -648313281, // 0xd95b863f
+
+	},
+	Predicate_channel_getChannelAdminLog: {
+		0: -399709117, // 0xe82cec43
+
+	},
+	Predicate_channel_searchChannelMessages: {
+		0: -2006490984, // 0x88676098
+
+	},
+	Predicate_channel_deleteChannelMessages: {
+		0: -1171086675, // 0xba32a2ad
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	487226122:   Predicate_channelData,                         // 0x1d0a7b0a
+	-636213148:  Predicate_channelParticipantData,              // 0xda142864
+	910424644:   Predicate_mutableChannel,                      // 0x3643fa44
+	1339626802:  Predicate_channel_createChannel,               // 0x4fd91532
+	1998741470:  Predicate_channel_getMutableChannel,           // 0x77225fde
+	-126104251:  Predicate_channel_getChannelListByIdList,      // 0xf87bcd45
+	-1585495833: Predicate_channel_joinChannel,                 // 0xa17f40e7
+	246600306:   Predicate_channel_leaveChannel,                // 0xeb2d272
+	179442531:   Predicate_channel_getChannelParticipants,      // 0xab21363
+	421986753:   Predicate_channel_getChannelParticipantIdList, // 0x192701c1
+	1770937184:  Predicate_channel_getMyChannelList,            // 0x698e5b60
+	-1097744163: Predicate_channel_sendChannelMessage,          // 0xbe91c0dd
+	-1899410279: Predicate_channel_getChannelMessageList,       // 0x8ec94c99
+	157290284:   Predicate_channel_getChannelHistory,           // 0x9600f2c
+	-799502370:  Predicate_channel_readChannelHistory,          // 0xd0588fde
+	-648313281:  Predicate_channel_editChannelMessage,          // 0xd95b863f
+	-399709117:  Predicate_channel_getChannelAdminLog,          // 0xe82cec43
+	-2006490984: Predicate_channel_searchChannelMessages,       // 0x88676098
+	-1171086675: Predicate_channel_deleteChannelMessages,       // 0xba32a2ad
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
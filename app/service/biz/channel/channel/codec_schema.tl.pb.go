@@ -0,0 +1,1825 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package channel
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+	487226122: func() mtproto.TLObject { // 0x1d0a7b0a
+		o := MakeTLChannelData(nil)
+		o.Data2.Constructor = 487226122
+		return o
+	},
+	-636213148: func() mtproto.TLObject { // 0xda142864
+		o := MakeTLChannelParticipantData(nil)
+		o.Data2.Constructor = -636213148
+		return o
+	},
+	910424644: func() mtproto.TLObject { // 0x3643fa44
+		o := MakeTLMutableChannel(nil)
+		o.Data2.Constructor = 910424644
+		return o
+	},
+
+	// Method
+	1339626802: func() mtproto.TLObject { // 0x4fd91532
+		return &TLChannelCreateChannel{
+			Constructor: 1339626802,
+		}
+	},
+	1998741470: func() mtproto.TLObject { // 0x77225fde
+		return &TLChannelGetMutableChannel{
+			Constructor: 1998741470,
+		}
+	},
+	-126104251: func() mtproto.TLObject { // 0xf87bcd45
+		return &TLChannelGetChannelListByIdList{
+			Constructor: -126104251,
+		}
+	},
+	-1585495833: func() mtproto.TLObject { // 0xa17f40e7
+		return &TLChannelJoinChannel{
+			Constructor: -1585495833,
+		}
+	},
+	246600306: func() mtproto.TLObject { // 0xeb2d272
+		return &TLChannelLeaveChannel{
+			Constructor: 246600306,
+		}
+	},
+	179442531: func() mtproto.TLObject { // 0xab21363
+		return &TLChannelGetChannelParticipants{
+			Constructor: 179442531,
+		}
+	},
+	421986753: func() mtproto.TLObject { // 0x192701c1
+		return &TLChannelGetChannelParticipantIdList{
+			Constructor: 421986753,
+		}
+	},
+	1770937184: func() mtproto.TLObject { // 0x698e5b60
+		return &TLChannelGetMyChannelList{
+			Constructor: 1770937184,
+		}
+	},
+	-1097744163: func() mtproto.TLObject { // 0xbe91c0dd
+		return &TLChannelSendChannelMessage{
+			Constructor: -1097744163,
+		}
+	},
+	-1899410279: func() mtproto.TLObject { // 0x8ec94c99
+		return &TLChannelGetChannelMessageList{
+			Constructor: -1899410279,
+		}
+	},
+	157290284: func() mtproto.TLObject { // 0x9600f2c
+		return &TLChannelGetChannelHistory{
+			Constructor: 157290284,
+		}
+	},
+	-799502370: func() mtproto.TLObject { // 0xd0588fde
+		return &TLChannelReadChannelHistory{
+			Constructor: -799502370,
+		}
+	},
+	-648313281: func() mtproto.TLObject { // 0xd95b863f
+		return &TLChannelEditChannelMessage{
+			Constructor: -648313281,
+		}
+	},
+	-399709117: func() mtproto.TLObject { // 0xe82cec43
+		return &TLChannelGetChannelAdminLog{
+			Constructor: -399709117,
+		}
+	},
+	-2006490984: func() mtproto.TLObject { // 0x88676098
+		return &TLChannelSearchChannelMessages{
+			Constructor: -2006490984,
+		}
+	},
+	-1171086675: func() mtproto.TLObject { // 0xba32a2ad
+		return &TLChannelDeleteChannelMessages{
+			Constructor: -1171086675,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+///////////////////////////////////////////////////////////////////////////////
+// ChannelData <--
+//  + TL_ChannelData
+//
+
+func (m *ChannelData) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_channelData:
+		t := m.To_ChannelData()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *ChannelData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *ChannelData) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x1d0a7b0a:
+		m2 := MakeTLChannelData(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *ChannelData) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_channelData:
+		t := m.To_ChannelData()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_ChannelData
+// channelData flags:# id:long creator_id:long access_hash:long broadcast:flags.0?true megagroup:flags.1?true deactivated:flags.2?true noforwards:flags.3?true signatures:flags.4?true title:string about:string photo:flags.5?Photo participants_count:int top_message:int pts:int date:int version:int = ChannelData;
+func (m *ChannelData) To_ChannelData() *TLChannelData {
+	m.PredicateName = Predicate_channelData
+	return &TLChannelData{
+		Data2: m,
+	}
+}
+
+// MakeTLChannelData
+// channelData flags:# id:long creator_id:long access_hash:long broadcast:flags.0?true megagroup:flags.1?true deactivated:flags.2?true noforwards:flags.3?true signatures:flags.4?true title:string about:string photo:flags.5?Photo participants_count:int top_message:int pts:int date:int version:int = ChannelData;
+func MakeTLChannelData(data2 *ChannelData) *TLChannelData {
+	if data2 == nil {
+		return &TLChannelData{Data2: &ChannelData{
+			PredicateName: Predicate_channelData,
+		}}
+	} else {
+		data2.PredicateName = Predicate_channelData
+		return &TLChannelData{Data2: data2}
+	}
+}
+
+func (m *TLChannelData) To_ChannelData() *ChannelData {
+	m.Data2.PredicateName = Predicate_channelData
+	return m.Data2
+}
+
+//// flags
+func (m *TLChannelData) SetId(v int64) { m.Data2.Id = v }
+func (m *TLChannelData) GetId() int64  { return m.Data2.Id }
+
+func (m *TLChannelData) SetCreatorId(v int64) { m.Data2.CreatorId = v }
+func (m *TLChannelData) GetCreatorId() int64  { return m.Data2.CreatorId }
+
+func (m *TLChannelData) SetAccessHash(v int64) { m.Data2.AccessHash = v }
+func (m *TLChannelData) GetAccessHash() int64  { return m.Data2.AccessHash }
+
+func (m *TLChannelData) SetBroadcast(v bool) { m.Data2.Broadcast = v }
+func (m *TLChannelData) GetBroadcast() bool  { return m.Data2.Broadcast }
+
+func (m *TLChannelData) SetMegagroup(v bool) { m.Data2.Megagroup = v }
+func (m *TLChannelData) GetMegagroup() bool  { return m.Data2.Megagroup }
+
+func (m *TLChannelData) SetDeactivated(v bool) { m.Data2.Deactivated = v }
+func (m *TLChannelData) GetDeactivated() bool  { return m.Data2.Deactivated }
+
+func (m *TLChannelData) SetNoforwards(v bool) { m.Data2.Noforwards = v }
+func (m *TLChannelData) GetNoforwards() bool  { return m.Data2.Noforwards }
+
+func (m *TLChannelData) SetSignatures(v bool) { m.Data2.Signatures = v }
+func (m *TLChannelData) GetSignatures() bool  { return m.Data2.Signatures }
+
+func (m *TLChannelData) SetTitle(v string) { m.Data2.Title = v }
+func (m *TLChannelData) GetTitle() string  { return m.Data2.Title }
+
+func (m *TLChannelData) SetAbout(v string) { m.Data2.About = v }
+func (m *TLChannelData) GetAbout() string  { return m.Data2.About }
+
+func (m *TLChannelData) SetPhoto(v *mtproto.Photo) { m.Data2.Photo = v }
+func (m *TLChannelData) GetPhoto() *mtproto.Photo  { return m.Data2.Photo }
+
+func (m *TLChannelData) SetParticipantsCount(v int32) { m.Data2.ParticipantsCount = v }
+func (m *TLChannelData) GetParticipantsCount() int32  { return m.Data2.ParticipantsCount }
+
+func (m *TLChannelData) SetTopMessage(v int32) { m.Data2.TopMessage = v }
+func (m *TLChannelData) GetTopMessage() int32  { return m.Data2.TopMessage }
+
+func (m *TLChannelData) SetPts(v int32) { m.Data2.Pts = v }
+func (m *TLChannelData) GetPts() int32  { return m.Data2.Pts }
+
+func (m *TLChannelData) SetDate(v int32) { m.Data2.Date = v }
+func (m *TLChannelData) GetDate() int32  { return m.Data2.Date }
+
+func (m *TLChannelData) SetVersion(v int32) { m.Data2.Version = v }
+func (m *TLChannelData) GetVersion() int32  { return m.Data2.Version }
+
+func (m *TLChannelData) GetPredicateName() string {
+	return Predicate_channelData
+}
+
+func (m *TLChannelData) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x1d0a7b0a: func() []byte {
+			// channelData flags:# id:long creator_id:long access_hash:long broadcast:flags.0?true megagroup:flags.1?true deactivated:flags.2?true noforwards:flags.3?true signatures:flags.4?true title:string about:string photo:flags.5?Photo participants_count:int top_message:int pts:int date:int version:int = ChannelData;
+			x.UInt(0x1d0a7b0a)
+
+			// set flags
+			var getFlags = func() uint32 {
+				var flags uint32 = 0
+
+				if m.GetBroadcast() == true {
+					flags |= 1 << 0
+				}
+
+				if m.GetMegagroup() == true {
+					flags |= 1 << 1
+				}
+
+				if m.GetDeactivated() == true {
+					flags |= 1 << 2
+				}
+
+				if m.GetNoforwards() == true {
+					flags |= 1 << 3
+				}
+
+				if m.GetSignatures() == true {
+					flags |= 1 << 4
+				}
+
+				if m.GetPhoto() != nil {
+					flags |= 1 << 5
+				}
+
+				return flags
+			}
+
+			// set flags
+			var flags = getFlags()
+			x.UInt(flags)
+			x.Long(m.GetId())
+			x.Long(m.GetCreatorId())
+			x.Long(m.GetAccessHash())
+			x.String(m.GetTitle())
+			x.String(m.GetAbout())
+			if m.GetPhoto() != nil {
+				x.Bytes(m.GetPhoto().Encode(layer))
+			}
+			x.Int(m.GetParticipantsCount())
+			x.Int(m.GetTopMessage())
+			x.Int(m.GetPts())
+			x.Int(m.GetDate())
+			x.Int(m.GetVersion())
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_channelData, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_channelData, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelData) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x1d0a7b0a: func() error {
+			// channelData flags:# id:long creator_id:long access_hash:long broadcast:flags.0?true megagroup:flags.1?true deactivated:flags.2?true noforwards:flags.3?true signatures:flags.4?true title:string about:string photo:flags.5?Photo participants_count:int top_message:int pts:int date:int version:int = ChannelData;
+			var flags = dBuf.UInt()
+			_ = flags
+			m.SetId(dBuf.Long())
+			m.SetCreatorId(dBuf.Long())
+			m.SetAccessHash(dBuf.Long())
+			if (flags & (1 << 0)) != 0 {
+				m.SetBroadcast(true)
+			}
+			if (flags & (1 << 1)) != 0 {
+				m.SetMegagroup(true)
+			}
+			if (flags & (1 << 2)) != 0 {
+				m.SetDeactivated(true)
+			}
+			if (flags & (1 << 3)) != 0 {
+				m.SetNoforwards(true)
+			}
+			if (flags & (1 << 4)) != 0 {
+				m.SetSignatures(true)
+			}
+			m.SetTitle(dBuf.String())
+			m.SetAbout(dBuf.String())
+			if (flags & (1 << 5)) != 0 {
+				m11 := &mtproto.Photo{}
+				m11.Decode(dBuf)
+				m.SetPhoto(m11)
+			}
+			m.SetParticipantsCount(dBuf.Int())
+			m.SetTopMessage(dBuf.Int())
+			m.SetPts(dBuf.Int())
+			m.SetDate(dBuf.Int())
+			m.SetVersion(dBuf.Int())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLChannelData) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ChannelParticipantData <--
+//  + TL_ChannelParticipantData
+//
+
+func (m *ChannelParticipantData) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_channelParticipantData:
+		t := m.To_ChannelParticipantData()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *ChannelParticipantData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *ChannelParticipantData) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0xda142864:
+		m2 := MakeTLChannelParticipantData(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *ChannelParticipantData) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_channelParticipantData:
+		t := m.To_ChannelParticipantData()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_ChannelParticipantData
+// channelParticipantData channel_id:long user_id:long participant_type:int inviter_user_id:long invited_at:int kicked_at:int left_at:int state:int read_inbox_max_id:int date:int = ChannelParticipantData;
+func (m *ChannelParticipantData) To_ChannelParticipantData() *TLChannelParticipantData {
+	m.PredicateName = Predicate_channelParticipantData
+	return &TLChannelParticipantData{
+		Data2: m,
+	}
+}
+
+// MakeTLChannelParticipantData
+// channelParticipantData channel_id:long user_id:long participant_type:int inviter_user_id:long invited_at:int kicked_at:int left_at:int state:int read_inbox_max_id:int date:int = ChannelParticipantData;
+func MakeTLChannelParticipantData(data2 *ChannelParticipantData) *TLChannelParticipantData {
+	if data2 == nil {
+		return &TLChannelParticipantData{Data2: &ChannelParticipantData{
+			PredicateName: Predicate_channelParticipantData,
+		}}
+	} else {
+		data2.PredicateName = Predicate_channelParticipantData
+		return &TLChannelParticipantData{Data2: data2}
+	}
+}
+
+func (m *TLChannelParticipantData) To_ChannelParticipantData() *ChannelParticipantData {
+	m.Data2.PredicateName = Predicate_channelParticipantData
+	return m.Data2
+}
+
+func (m *TLChannelParticipantData) SetChannelId(v int64) { m.Data2.ChannelId = v }
+func (m *TLChannelParticipantData) GetChannelId() int64  { return m.Data2.ChannelId }
+
+func (m *TLChannelParticipantData) SetUserId(v int64) { m.Data2.UserId = v }
+func (m *TLChannelParticipantData) GetUserId() int64  { return m.Data2.UserId }
+
+func (m *TLChannelParticipantData) SetParticipantType(v int32) { m.Data2.ParticipantType = v }
+func (m *TLChannelParticipantData) GetParticipantType() int32  { return m.Data2.ParticipantType }
+
+func (m *TLChannelParticipantData) SetInviterUserId(v int64) { m.Data2.InviterUserId = v }
+func (m *TLChannelParticipantData) GetInviterUserId() int64  { return m.Data2.InviterUserId }
+
+func (m *TLChannelParticipantData) SetInvitedAt(v int32) { m.Data2.InvitedAt = v }
+func (m *TLChannelParticipantData) GetInvitedAt() int32  { return m.Data2.InvitedAt }
+
+func (m *TLChannelParticipantData) SetKickedAt(v int32) { m.Data2.KickedAt = v }
+func (m *TLChannelParticipantData) GetKickedAt() int32  { return m.Data2.KickedAt }
+
+func (m *TLChannelParticipantData) SetLeftAt(v int32) { m.Data2.LeftAt = v }
+func (m *TLChannelParticipantData) GetLeftAt() int32  { return m.Data2.LeftAt }
+
+func (m *TLChannelParticipantData) SetState(v int32) { m.Data2.State = v }
+func (m *TLChannelParticipantData) GetState() int32  { return m.Data2.State }
+
+func (m *TLChannelParticipantData) SetReadInboxMaxId(v int32) { m.Data2.ReadInboxMaxId = v }
+func (m *TLChannelParticipantData) GetReadInboxMaxId() int32  { return m.Data2.ReadInboxMaxId }
+
+func (m *TLChannelParticipantData) SetDate(v int32) { m.Data2.Date = v }
+func (m *TLChannelParticipantData) GetDate() int32  { return m.Data2.Date }
+
+func (m *TLChannelParticipantData) GetPredicateName() string {
+	return Predicate_channelParticipantData
+}
+
+func (m *TLChannelParticipantData) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0xda142864: func() []byte {
+			// channelParticipantData channel_id:long user_id:long participant_type:int inviter_user_id:long invited_at:int kicked_at:int left_at:int state:int read_inbox_max_id:int date:int = ChannelParticipantData;
+			x.UInt(0xda142864)
+
+			x.Long(m.GetChannelId())
+			x.Long(m.GetUserId())
+			x.Int(m.GetParticipantType())
+			x.Long(m.GetInviterUserId())
+			x.Int(m.GetInvitedAt())
+			x.Int(m.GetKickedAt())
+			x.Int(m.GetLeftAt())
+			x.Int(m.GetState())
+			x.Int(m.GetReadInboxMaxId())
+			x.Int(m.GetDate())
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_channelParticipantData, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_channelParticipantData, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelParticipantData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelParticipantData) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0xda142864: func() error {
+			// channelParticipantData channel_id:long user_id:long participant_type:int inviter_user_id:long invited_at:int kicked_at:int left_at:int state:int read_inbox_max_id:int date:int = ChannelParticipantData;
+			m.SetChannelId(dBuf.Long())
+			m.SetUserId(dBuf.Long())
+			m.SetParticipantType(dBuf.Int())
+			m.SetInviterUserId(dBuf.Long())
+			m.SetInvitedAt(dBuf.Int())
+			m.SetKickedAt(dBuf.Int())
+			m.SetLeftAt(dBuf.Int())
+			m.SetState(dBuf.Int())
+			m.SetReadInboxMaxId(dBuf.Int())
+			m.SetDate(dBuf.Int())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLChannelParticipantData) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// MutableChannel <--
+//  + TL_MutableChannel
+//
+
+func (m *MutableChannel) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_mutableChannel:
+		t := m.To_MutableChannel()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *MutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *MutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x3643fa44:
+		m2 := MakeTLMutableChannel(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *MutableChannel) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_mutableChannel:
+		t := m.To_MutableChannel()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_MutableChannel
+// mutableChannel channel:ChannelData participants:Vector<ChannelParticipantData> = MutableChannel;
+func (m *MutableChannel) To_MutableChannel() *TLMutableChannel {
+	m.PredicateName = Predicate_mutableChannel
+	return &TLMutableChannel{
+		Data2: m,
+	}
+}
+
+// MakeTLMutableChannel
+// mutableChannel channel:ChannelData participants:Vector<ChannelParticipantData> = MutableChannel;
+func MakeTLMutableChannel(data2 *MutableChannel) *TLMutableChannel {
+	if data2 == nil {
+		return &TLMutableChannel{Data2: &MutableChannel{
+			PredicateName: Predicate_mutableChannel,
+		}}
+	} else {
+		data2.PredicateName = Predicate_mutableChannel
+		return &TLMutableChannel{Data2: data2}
+	}
+}
+
+func (m *TLMutableChannel) To_MutableChannel() *MutableChannel {
+	m.Data2.PredicateName = Predicate_mutableChannel
+	return m.Data2
+}
+
+func (m *TLMutableChannel) SetChannel(v *ChannelData) { m.Data2.Channel = v }
+func (m *TLMutableChannel) GetChannel() *ChannelData  { return m.Data2.Channel }
+
+func (m *TLMutableChannel) SetParticipants(v []*ChannelParticipantData) { m.Data2.Participants = v }
+func (m *TLMutableChannel) GetParticipants() []*ChannelParticipantData  { return m.Data2.Participants }
+
+func (m *TLMutableChannel) GetPredicateName() string {
+	return Predicate_mutableChannel
+}
+
+func (m *TLMutableChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x3643fa44: func() []byte {
+			// mutableChannel channel:ChannelData participants:Vector<ChannelParticipantData> = MutableChannel;
+			x.UInt(0x3643fa44)
+
+			x.Bytes(m.GetChannel().Encode(layer))
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetParticipants())))
+			for _, v := range m.GetParticipants() {
+				x.Bytes((*v).Encode(layer))
+			}
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_mutableChannel, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_mutableChannel, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x3643fa44: func() error {
+			// mutableChannel channel:ChannelData participants:Vector<ChannelParticipantData> = MutableChannel;
+			m0 := &ChannelData{}
+			m0.Decode(dBuf)
+			m.SetChannel(m0)
+
+			c1 := dBuf.Int()
+			if c1 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+			}
+			l1 := dBuf.Int()
+			v1 := make([]*ChannelParticipantData, l1)
+			for i := int32(0); i < l1; i++ {
+				v1[i] = &ChannelParticipantData{}
+				v1[i].Decode(dBuf)
+			}
+			m.SetParticipants(v1)
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLMutableChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// TLChannelCreateChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelCreateChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_createChannel))
+
+	switch uint32(m.Constructor) {
+	case 0x4fd91532:
+		// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string date:int = MutableChannel;
+		x.UInt(0x4fd91532)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetBroadcast() == true {
+			flags |= 1 << 0
+		}
+		if m.GetMegagroup() == true {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetCreatorId())
+		x.String(m.GetTitle())
+		x.String(m.GetAbout())
+		x.Int(m.GetDate())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelCreateChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelCreateChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x4fd91532:
+		// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string date:int = MutableChannel;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.CreatorId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.Broadcast = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.Megagroup = true
+		}
+		m.Title = dBuf.String()
+		m.About = dBuf.String()
+		m.Date = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelCreateChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetMutableChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetMutableChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getMutableChannel))
+
+	switch uint32(m.Constructor) {
+	case 0x77225fde:
+		// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+		x.UInt(0x77225fde)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+
+		x.VectorLong(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetMutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetMutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x77225fde:
+		// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+
+		m.Id = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetMutableChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelListByIdList
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelListByIdList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelListByIdList))
+
+	switch uint32(m.Constructor) {
+	case 0xf87bcd45:
+		// channel.getChannelListByIdList self_id:long id:Vector<long> = Vector<MutableChannel>;
+		x.UInt(0xf87bcd45)
+
+		// no flags
+
+		x.Long(m.GetSelfId())
+
+		x.VectorLong(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelListByIdList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelListByIdList) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xf87bcd45:
+		// channel.getChannelListByIdList self_id:long id:Vector<long> = Vector<MutableChannel>;
+
+		// not has flags
+
+		m.SelfId = dBuf.Long()
+
+		m.Id = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelListByIdList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelJoinChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelJoinChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_joinChannel))
+
+	switch uint32(m.Constructor) {
+	case 0xa17f40e7:
+		// channel.joinChannel channel_id:long user_id:long inviter_id:long = MutableChannel;
+		x.UInt(0xa17f40e7)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetUserId())
+		x.Long(m.GetInviterId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelJoinChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelJoinChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xa17f40e7:
+		// channel.joinChannel channel_id:long user_id:long inviter_id:long = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		m.InviterId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelJoinChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelLeaveChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelLeaveChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_leaveChannel))
+
+	switch uint32(m.Constructor) {
+	case 0xeb2d272:
+		// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+		x.UInt(0xeb2d272)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelLeaveChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelLeaveChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xeb2d272:
+		// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelLeaveChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelParticipants
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelParticipants) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelParticipants))
+
+	switch uint32(m.Constructor) {
+	case 0xab21363:
+		// channel.getChannelParticipants channel_id:long offset:int limit:int = Vector<ChannelParticipantData>;
+		x.UInt(0xab21363)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Int(m.GetOffset())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelParticipants) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelParticipants) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xab21363:
+		// channel.getChannelParticipants channel_id:long offset:int limit:int = Vector<ChannelParticipantData>;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.Offset = dBuf.Int()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelParticipants) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelParticipantIdList
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelParticipantIdList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelParticipantIdList))
+
+	switch uint32(m.Constructor) {
+	case 0x192701c1:
+		// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+		x.UInt(0x192701c1)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelParticipantIdList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelParticipantIdList) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x192701c1:
+		// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelParticipantIdList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetMyChannelList
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetMyChannelList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getMyChannelList))
+
+	switch uint32(m.Constructor) {
+	case 0x698e5b60:
+		// channel.getMyChannelList user_id:long = Vector<long>;
+		x.UInt(0x698e5b60)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetMyChannelList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetMyChannelList) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x698e5b60:
+		// channel.getMyChannelList user_id:long = Vector<long>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetMyChannelList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelSendChannelMessage
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelSendChannelMessage) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_sendChannelMessage))
+
+	switch uint32(m.Constructor) {
+	case 0xbe91c0dd:
+		// channel.sendChannelMessage from_id:long channel_id:long random_id:long message:Message = MessageBox;
+		x.UInt(0xbe91c0dd)
+
+		// no flags
+
+		x.Long(m.GetFromId())
+		x.Long(m.GetChannelId())
+		x.Long(m.GetRandomId())
+		x.Bytes(m.GetMessage().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelSendChannelMessage) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelSendChannelMessage) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xbe91c0dd:
+		// channel.sendChannelMessage from_id:long channel_id:long random_id:long message:Message = MessageBox;
+
+		// not has flags
+
+		m.FromId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+		m.RandomId = dBuf.Long()
+
+		m4 := &mtproto.Message{}
+		m4.Decode(dBuf)
+		m.Message = m4
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelSendChannelMessage) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelMessageList
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelMessageList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelMessageList))
+
+	switch uint32(m.Constructor) {
+	case 0x8ec94c99:
+		// channel.getChannelMessageList channel_id:long id:Vector<int> = Vector<MessageBox>;
+		x.UInt(0x8ec94c99)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelMessageList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelMessageList) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8ec94c99:
+		// channel.getChannelMessageList channel_id:long id:Vector<int> = Vector<MessageBox>;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelMessageList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelHistory
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelHistory) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelHistory))
+
+	switch uint32(m.Constructor) {
+	case 0x9600f2c:
+		// channel.getChannelHistory channel_id:long offset_id:int limit:int = Vector<MessageBox>;
+		x.UInt(0x9600f2c)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Int(m.GetOffsetId())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelHistory) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelHistory) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x9600f2c:
+		// channel.getChannelHistory channel_id:long offset_id:int limit:int = Vector<MessageBox>;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OffsetId = dBuf.Int()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelHistory) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelReadChannelHistory
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelReadChannelHistory) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_readChannelHistory))
+
+	switch uint32(m.Constructor) {
+	case 0xd0588fde:
+		// channel.readChannelHistory channel_id:long user_id:long max_id:int = Bool;
+		x.UInt(0xd0588fde)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetUserId())
+		x.Int(m.GetMaxId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelReadChannelHistory) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelReadChannelHistory) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd0588fde:
+		// channel.readChannelHistory channel_id:long user_id:long max_id:int = Bool;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		m.MaxId = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelReadChannelHistory) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelEditChannelMessage
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelEditChannelMessage) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_editChannelMessage))
+
+	switch uint32(m.Constructor) {
+	case 0xd95b863f:
+		// channel.editChannelMessage from_id:long channel_id:long message:Message = MessageBox;
+		x.UInt(0xd95b863f)
+
+		// no flags
+
+		x.Long(m.GetFromId())
+		x.Long(m.GetChannelId())
+		x.Bytes(m.GetMessage().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelEditChannelMessage) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelEditChannelMessage) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd95b863f:
+		// channel.editChannelMessage from_id:long channel_id:long message:Message = MessageBox;
+
+		// not has flags
+
+		m.FromId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+
+		m3 := &mtproto.Message{}
+		m3.Decode(dBuf)
+		m.Message = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelEditChannelMessage) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelAdminLog
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelAdminLog) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelAdminLog))
+
+	switch uint32(m.Constructor) {
+	case 0xe82cec43:
+		// channel.getChannelAdminLog channel_id:long user_id:long max_id:long min_id:long limit:int = Vector<ChannelAdminLogEvent>;
+		x.UInt(0xe82cec43)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetUserId())
+		x.Long(m.GetMaxId())
+		x.Long(m.GetMinId())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelAdminLog) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelAdminLog) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe82cec43:
+		// channel.getChannelAdminLog channel_id:long user_id:long max_id:long min_id:long limit:int = Vector<ChannelAdminLogEvent>;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		m.MaxId = dBuf.Long()
+		m.MinId = dBuf.Long()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelAdminLog) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelSearchChannelMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelSearchChannelMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_searchChannelMessages))
+
+	switch uint32(m.Constructor) {
+	case 0x88676098:
+		// channel.searchChannelMessages channel_id:long user_id:long q:string from_id:long offset_id:int min_date:int max_date:int limit:int = Vector<MessageBox>;
+		x.UInt(0x88676098)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetUserId())
+		x.String(m.GetQ())
+		x.Long(m.GetFromId())
+		x.Int(m.GetOffsetId())
+		x.Int(m.GetMinDate())
+		x.Int(m.GetMaxDate())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelSearchChannelMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelSearchChannelMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x88676098:
+		// channel.searchChannelMessages channel_id:long user_id:long q:string from_id:long offset_id:int min_date:int max_date:int limit:int = Vector<MessageBox>;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		m.Q = dBuf.String()
+		m.FromId = dBuf.Long()
+		m.OffsetId = dBuf.Int()
+		m.MinDate = dBuf.Int()
+		m.MaxDate = dBuf.Int()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelSearchChannelMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelDeleteChannelMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelDeleteChannelMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_deleteChannelMessages))
+
+	switch uint32(m.Constructor) {
+	case 0xba32a2ad:
+		// channel.deleteChannelMessages from_id:long channel_id:long id:Vector<int> = messages.AffectedMessages;
+		x.UInt(0xba32a2ad)
+
+		// no flags
+
+		x.Long(m.GetFromId())
+		x.Long(m.GetChannelId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelDeleteChannelMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelDeleteChannelMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xba32a2ad:
+		// channel.deleteChannelMessages from_id:long channel_id:long id:Vector<int> = messages.AffectedMessages;
+
+		// not has flags
+
+		m.FromId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelDeleteChannelMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// Vector_MutableChannel
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_MutableChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_MutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*MutableChannel, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(MutableChannel)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_MutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_MutableChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// Vector_ChannelParticipantData
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_ChannelParticipantData) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_ChannelParticipantData) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*ChannelParticipantData, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(ChannelParticipantData)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_ChannelParticipantData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_ChannelParticipantData) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// Vector_Long
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_Long) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.VectorLong(m.Datas)
+
+	return x.GetBuf()
+}
+
+func (m *Vector_Long) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Datas = dBuf.VectorLong()
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_Long) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_Long) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// Vector_MessageBox
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_MessageBox) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_MessageBox) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*mtproto.MessageBox, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(mtproto.MessageBox)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_MessageBox) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_MessageBox) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// Vector_ChannelAdminLogEvent
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_ChannelAdminLogEvent) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_ChannelAdminLogEvent) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*mtproto.ChannelAdminLogEvent, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(mtproto.ChannelAdminLogEvent)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_ChannelAdminLogEvent) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_ChannelAdminLogEvent) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package channel
+
+import (
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	ChannelMemberNormal  = 0
+	ChannelMemberAdmin   = 1
+	ChannelMemberCreator = 2
+)
+
+const (
+	ChannelMemberStateNormal = 0
+	ChannelMemberStateLeft   = 1
+	ChannelMemberStateKicked = 2
+)
+
+func (m *ChannelParticipantData) IsCreator() bool {
+	return m.ParticipantType == ChannelMemberCreator
+}
+
+func (m *ChannelParticipantData) IsAdmin() bool {
+	return m.ParticipantType == ChannelMemberAdmin || m.ParticipantType == ChannelMemberCreator
+}
+
+func (m *ChannelParticipantData) IsStateNormal() bool {
+	return m.State == ChannelMemberStateNormal
+}
+
+func (m *ChannelParticipantData) IsStateLeft() bool {
+	return m.State == ChannelMemberStateLeft
+}
+
+func (m *ChannelParticipantData) IsStateKicked() bool {
+	return m.State == ChannelMemberStateKicked
+}
+
+// ToChannelParticipant converts the participant record to the mtproto
+// channelParticipant* object as seen by selfId.
+func (m *ChannelParticipantData) ToChannelParticipant(selfId int64) *mtproto.ChannelParticipant {
+	participant := &mtproto.ChannelParticipant{
+		UserId:          m.UserId,
+		Date:            m.Date,
+		InviterId_INT64: m.InviterUserId,
+		Peer:            mtproto.MakePeerUser(m.UserId),
+	}
+
+	switch {
+	case m.IsStateLeft():
+		return mtproto.MakeTLChannelParticipantLeft(participant).To_ChannelParticipant()
+	case m.IsStateKicked():
+		participant.KickedBy = m.InviterUserId
+		participant.Date = m.KickedAt
+		participant.BannedRights = mtproto.MakeDefaultBannedRights()
+		return mtproto.MakeTLChannelParticipantBanned(participant).To_ChannelParticipant()
+	case m.IsCreator():
+		participant.AdminRights = mtproto.MakeDefaultChatAdminRights()
+		return mtproto.MakeTLChannelParticipantCreator(participant).To_ChannelParticipant()
+	case m.IsAdmin():
+		participant.Self = m.UserId == selfId
+		participant.AdminRights = mtproto.MakeDefaultChatAdminRights()
+		participant.PromotedBy = m.InviterUserId
+		if m.InviterUserId != 0 {
+			participant.InviterId_FLAGINT64 = &types.Int64Value{Value: m.InviterUserId}
+		}
+		return mtproto.MakeTLChannelParticipantAdmin(participant).To_ChannelParticipant()
+	case m.UserId == selfId:
+		return mtproto.MakeTLChannelParticipantSelf(participant).To_ChannelParticipant()
+	default:
+		return mtproto.MakeTLChannelParticipant(participant).To_ChannelParticipant()
+	}
+}
+
+func (m *MutableChannel) Id() int64 {
+	return m.GetChannel().GetId()
+}
+
+func (m *MutableChannel) Creator() int64 {
+	return m.GetChannel().GetCreatorId()
+}
+
+func (m *MutableChannel) IsBroadcast() bool {
+	return m.GetChannel().GetBroadcast()
+}
+
+func (m *MutableChannel) IsMegagroup() bool {
+	return m.GetChannel().GetMegagroup()
+}
+
+func (m *MutableChannel) GetChannelParticipant(userId int64) (*ChannelParticipantData, bool) {
+	for _, p := range m.GetParticipants() {
+		if p.UserId == userId {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// IsChannelParticipant reports whether userId is currently a member of the channel.
+func (m *MutableChannel) IsChannelParticipant(userId int64) bool {
+	p, ok := m.GetChannelParticipant(userId)
+	return ok && p.IsStateNormal()
+}
+
+// ToUnsafeChat returns the channel as seen by selfId; the participant record
+// of selfId must be loaded into m, or the result will look like a non-member view.
+func (m *MutableChannel) ToUnsafeChat(selfId int64) *mtproto.Chat {
+	c := m.GetChannel()
+	if c.GetDeactivated() {
+		return m.toChannelForbidden()
+	}
+
+	chat := &mtproto.Chat{
+		Id:                          c.Id,
+		AccessHash_FLAGINT64:        &types.Int64Value{Value: c.AccessHash},
+		Title:                       c.Title,
+		Photo:                       mtproto.MakeChatPhotoByPhoto(c.Photo),
+		Date:                        c.Date,
+		Version:                     c.Version,
+		Broadcast:                   c.Broadcast,
+		Megagroup:                   c.Megagroup,
+		Signatures:                  c.Signatures,
+		Noforwards:                  c.Noforwards,
+		ParticipantsCount_FLAGINT32: &types.Int32Value{Value: c.ParticipantsCount},
+		Left:                        true,
+	}
+
+	if me, ok := m.GetChannelParticipant(selfId); ok {
+		if me.IsStateKicked() {
+			return m.toChannelForbidden()
+		}
+		chat.Left = !me.IsStateNormal()
+		chat.Creator = me.IsCreator() && me.IsStateNormal()
+		if me.IsAdmin() && me.IsStateNormal() {
+			chat.AdminRights = mtproto.MakeDefaultChatAdminRights()
+		}
+	}
+
+	if c.Megagroup {
+		chat.DefaultBannedRights = mtproto.MakeDefaultBannedRights()
+	}
+
+	return mtproto.MakeTLChannel(chat).To_Chat()
+}
+
+func (m *MutableChannel) toChannelForbidden() *mtproto.Chat {
+	c := m.GetChannel()
+	return mtproto.MakeTLChannelForbidden(&mtproto.Chat{
+		Id:               c.Id,
+		AccessHash_INT64: c.AccessHash,
+		Title:            c.Title,
+		Broadcast:        c.Broadcast,
+		Megagroup:        c.Megagroup,
+	}).To_Chat()
+}
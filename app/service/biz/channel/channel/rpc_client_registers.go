@@ -0,0 +1,63 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package channel
+
+import (
+	"reflect"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+var _ *mtproto.Bool
+
+type newRPCReplyFunc func() interface{}
+
+type RPCContextTuple struct {
+	Method       string
+	NewReplyFunc newRPCReplyFunc
+}
+
+var rpcContextRegisters = map[string]RPCContextTuple{
+	"TLChannelCreateChannel":               RPCContextTuple{"/mtproto.RPCChannel/channel_createChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelGetMutableChannel":           RPCContextTuple{"/mtproto.RPCChannel/channel_getMutableChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelGetChannelListByIdList":      RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelListByIdList", func() interface{} { return new(Vector_MutableChannel) }},
+	"TLChannelJoinChannel":                 RPCContextTuple{"/mtproto.RPCChannel/channel_joinChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelLeaveChannel":                RPCContextTuple{"/mtproto.RPCChannel/channel_leaveChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelGetChannelParticipants":      RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelParticipants", func() interface{} { return new(Vector_ChannelParticipantData) }},
+	"TLChannelGetChannelParticipantIdList": RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelParticipantIdList", func() interface{} { return new(Vector_Long) }},
+	"TLChannelGetMyChannelList":            RPCContextTuple{"/mtproto.RPCChannel/channel_getMyChannelList", func() interface{} { return new(Vector_Long) }},
+	"TLChannelSendChannelMessage":          RPCContextTuple{"/mtproto.RPCChannel/channel_sendChannelMessage", func() interface{} { return new(mtproto.MessageBox) }},
+	"TLChannelGetChannelMessageList":       RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelMessageList", func() interface{} { return new(Vector_MessageBox) }},
+	"TLChannelGetChannelHistory":           RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelHistory", func() interface{} { return new(Vector_MessageBox) }},
+	"TLChannelReadChannelHistory":          RPCContextTuple{"/mtproto.RPCChannel/channel_readChannelHistory", func() interface{} { return new(mtproto.Bool) }},
+	"TLChannelEditChannelMessage":          RPCContextTuple{"/mtproto.RPCChannel/channel_editChannelMessage", func() interface{} { return new(mtproto.MessageBox) }},
+	"TLChannelGetChannelAdminLog":          RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelAdminLog", func() interface{} { return new(Vector_ChannelAdminLogEvent) }},
+	"TLChannelSearchChannelMessages":       RPCContextTuple{"/mtproto.RPCChannel/channel_searchChannelMessages", func() interface{} { return new(Vector_MessageBox) }},
+	"TLChannelDeleteChannelMessages":       RPCContextTuple{"/mtproto.RPCChannel/channel_deleteChannelMessages", func() interface{} { return new(mtproto.Messages_AffectedMessages) }},
+}
+
+func FindRPCContextTuple(t interface{}) *RPCContextTuple {
+	rt := reflect.TypeOf(t)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	m, ok := rpcContextRegisters[rt.Name()]
+	if !ok {
+		// log.Errorf("Can't find name: %s", rt.Name())
+		return nil
+	}
+	return &m
+}
+
+func GetRPCContextRegisters() map[string]RPCContextTuple {
+	return rpcContextRegisters
+}
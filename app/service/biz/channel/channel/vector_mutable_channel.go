@@ -0,0 +1,42 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package channel
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+func (m *Vector_MutableChannel) Length() int32 {
+	return int32(len(m.GetDatas()))
+}
+
+func (m *Vector_MutableChannel) GetChatListByIdList(selfId int64, id ...int64) []*mtproto.Chat {
+	if m == nil {
+		return []*mtproto.Chat{}
+	}
+
+	chatList := make([]*mtproto.Chat, 0, len(m.Datas))
+	for _, channel2 := range m.Datas {
+		chatList = append(chatList, channel2.ToUnsafeChat(selfId))
+	}
+
+	return chatList
+}
+
+func (m *Vector_MutableChannel) Walk(cb func(idx int, v *MutableChannel)) {
+	if m == nil {
+		return
+	}
+
+	if cb == nil {
+		return
+	}
+
+	for i, v := range m.Datas {
+		cb(i, v)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package channel_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type ChannelClient interface {
+	ChannelCreateChannel(ctx context.Context, in *channel.TLChannelCreateChannel) (*channel.MutableChannel, error)
+	ChannelGetMutableChannel(ctx context.Context, in *channel.TLChannelGetMutableChannel) (*channel.MutableChannel, error)
+	ChannelGetChannelListByIdList(ctx context.Context, in *channel.TLChannelGetChannelListByIdList) (*channel.Vector_MutableChannel, error)
+	ChannelJoinChannel(ctx context.Context, in *channel.TLChannelJoinChannel) (*channel.MutableChannel, error)
+	ChannelLeaveChannel(ctx context.Context, in *channel.TLChannelLeaveChannel) (*channel.MutableChannel, error)
+	ChannelGetChannelParticipants(ctx context.Context, in *channel.TLChannelGetChannelParticipants) (*channel.Vector_ChannelParticipantData, error)
+	ChannelGetChannelParticipantIdList(ctx context.Context, in *channel.TLChannelGetChannelParticipantIdList) (*channel.Vector_Long, error)
+	ChannelGetMyChannelList(ctx context.Context, in *channel.TLChannelGetMyChannelList) (*channel.Vector_Long, error)
+	ChannelSendChannelMessage(ctx context.Context, in *channel.TLChannelSendChannelMessage) (*mtproto.MessageBox, error)
+	ChannelGetChannelMessageList(ctx context.Context, in *channel.TLChannelGetChannelMessageList) (*channel.Vector_MessageBox, error)
+	ChannelGetChannelHistory(ctx context.Context, in *channel.TLChannelGetChannelHistory) (*channel.Vector_MessageBox, error)
+	ChannelReadChannelHistory(ctx context.Context, in *channel.TLChannelReadChannelHistory) (*mtproto.Bool, error)
+	ChannelEditChannelMessage(ctx context.Context, in *channel.TLChannelEditChannelMessage) (*mtproto.MessageBox, error)
+	ChannelGetChannelAdminLog(ctx context.Context, in *channel.TLChannelGetChannelAdminLog) (*channel.Vector_ChannelAdminLogEvent, error)
+	ChannelSearchChannelMessages(ctx context.Context, in *channel.TLChannelSearchChannelMessages) (*channel.Vector_MessageBox, error)
+	ChannelDeleteChannelMessages(ctx context.Context, in *channel.TLChannelDeleteChannelMessages) (*mtproto.Messages_AffectedMessages, error)
+}
+
+type defaultChannelClient struct {
+	cli zrpc.Client
+}
+
+func NewChannelClient(cli zrpc.Client) ChannelClient {
+	return &defaultChannelClient{
+		cli: cli,
+	}
+}
+
+// ChannelCreateChannel
+// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string date:int = MutableChannel;
+func (m *defaultChannelClient) ChannelCreateChannel(ctx context.Context, in *channel.TLChannelCreateChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelCreateChannel(ctx, in)
+}
+
+// ChannelGetMutableChannel
+// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+func (m *defaultChannelClient) ChannelGetMutableChannel(ctx context.Context, in *channel.TLChannelGetMutableChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetMutableChannel(ctx, in)
+}
+
+// ChannelGetChannelListByIdList
+// channel.getChannelListByIdList self_id:long id:Vector<long> = Vector<MutableChannel>;
+func (m *defaultChannelClient) ChannelGetChannelListByIdList(ctx context.Context, in *channel.TLChannelGetChannelListByIdList) (*channel.Vector_MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelListByIdList(ctx, in)
+}
+
+// ChannelJoinChannel
+// channel.joinChannel channel_id:long user_id:long inviter_id:long = MutableChannel;
+func (m *defaultChannelClient) ChannelJoinChannel(ctx context.Context, in *channel.TLChannelJoinChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelJoinChannel(ctx, in)
+}
+
+// ChannelLeaveChannel
+// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+func (m *defaultChannelClient) ChannelLeaveChannel(ctx context.Context, in *channel.TLChannelLeaveChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelLeaveChannel(ctx, in)
+}
+
+// ChannelGetChannelParticipants
+// channel.getChannelParticipants channel_id:long offset:int limit:int = Vector<ChannelParticipantData>;
+func (m *defaultChannelClient) ChannelGetChannelParticipants(ctx context.Context, in *channel.TLChannelGetChannelParticipants) (*channel.Vector_ChannelParticipantData, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelParticipants(ctx, in)
+}
+
+// ChannelGetChannelParticipantIdList
+// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+func (m *defaultChannelClient) ChannelGetChannelParticipantIdList(ctx context.Context, in *channel.TLChannelGetChannelParticipantIdList) (*channel.Vector_Long, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelParticipantIdList(ctx, in)
+}
+
+// ChannelGetMyChannelList
+// channel.getMyChannelList user_id:long = Vector<long>;
+func (m *defaultChannelClient) ChannelGetMyChannelList(ctx context.Context, in *channel.TLChannelGetMyChannelList) (*channel.Vector_Long, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetMyChannelList(ctx, in)
+}
+
+// ChannelSendChannelMessage
+// channel.sendChannelMessage from_id:long channel_id:long random_id:long message:Message = MessageBox;
+func (m *defaultChannelClient) ChannelSendChannelMessage(ctx context.Context, in *channel.TLChannelSendChannelMessage) (*mtproto.MessageBox, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelSendChannelMessage(ctx, in)
+}
+
+// ChannelGetChannelMessageList
+// channel.getChannelMessageList channel_id:long id:Vector<int> = Vector<MessageBox>;
+func (m *defaultChannelClient) ChannelGetChannelMessageList(ctx context.Context, in *channel.TLChannelGetChannelMessageList) (*channel.Vector_MessageBox, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelMessageList(ctx, in)
+}
+
+// ChannelGetChannelHistory
+// channel.getChannelHistory channel_id:long offset_id:int limit:int = Vector<MessageBox>;
+func (m *defaultChannelClient) ChannelGetChannelHistory(ctx context.Context, in *channel.TLChannelGetChannelHistory) (*channel.Vector_MessageBox, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelHistory(ctx, in)
+}
+
+// ChannelReadChannelHistory
+// channel.readChannelHistory channel_id:long user_id:long max_id:int = Bool;
+func (m *defaultChannelClient) ChannelReadChannelHistory(ctx context.Context, in *channel.TLChannelReadChannelHistory) (*mtproto.Bool, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelReadChannelHistory(ctx, in)
+}
+
+// ChannelEditChannelMessage
+// channel.editChannelMessage from_id:long channel_id:long message:Message = MessageBox;
+func (m *defaultChannelClient) ChannelEditChannelMessage(ctx context.Context, in *channel.TLChannelEditChannelMessage) (*mtproto.MessageBox, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelEditChannelMessage(ctx, in)
+}
+
+// ChannelGetChannelAdminLog
+// channel.getChannelAdminLog channel_id:long user_id:long max_id:long min_id:long limit:int = Vector<ChannelAdminLogEvent>;
+func (m *defaultChannelClient) ChannelGetChannelAdminLog(ctx context.Context, in *channel.TLChannelGetChannelAdminLog) (*channel.Vector_ChannelAdminLogEvent, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelAdminLog(ctx, in)
+}
+
+// ChannelSearchChannelMessages
+// channel.searchChannelMessages channel_id:long user_id:long q:string from_id:long offset_id:int min_date:int max_date:int limit:int = Vector<MessageBox>;
+func (m *defaultChannelClient) ChannelSearchChannelMessages(ctx context.Context, in *channel.TLChannelSearchChannelMessages) (*channel.Vector_MessageBox, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelSearchChannelMessages(ctx, in)
+}
+
+// ChannelDeleteChannelMessages
+// channel.deleteChannelMessages from_id:long channel_id:long id:Vector<int> = messages.AffectedMessages;
+func (m *defaultChannelClient) ChannelDeleteChannelMessages(ctx context.Context, in *channel.TLChannelDeleteChannelMessages) (*mtproto.Messages_AffectedMessages, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelDeleteChannelMessages(ctx, in)
+}
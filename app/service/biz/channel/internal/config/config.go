@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package config
+
+import (
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+	Mysql       sqlx.Config
+	Cache       cache.CacheConf
+	MediaClient zrpc.RpcClientConf
+	IdgenClient zrpc.RpcClientConf
+	SearchIndex message_helper.SearchIndexConfig
+}
@@ -0,0 +1,89 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelCreateChannel
+// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string date:int = MutableChannel;
+func (c *ChannelCore) ChannelCreateChannel(in *channel.TLChannelCreateChannel) (*channel.MutableChannel, error) {
+	var (
+		channelsDO *dataobject.ChannelsDO
+		err        error
+		date       = int64(in.Date)
+	)
+
+	if in.Title == "" {
+		err = mtproto.ErrChatTitleEmpty
+		c.Logger.Errorf("channel.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	if channelsDO, err = c.svcCtx.Dao.ChannelsDAO.SelectLastCreator(c.ctx, in.CreatorId); err != nil {
+		c.Logger.Errorf("channel.createChannel - error: %v", err)
+		return nil, err
+	} else if channelsDO != nil {
+		if date-channelsDO.Date < createChannelFlood {
+			err = mtproto.NewErrFloodWaitX(int32(createChannelFlood - (date - channelsDO.Date)))
+			c.Logger.Errorf("channel.createChannel - error: %v", err)
+			return nil, err
+		}
+	}
+
+	channelsDO = &dataobject.ChannelsDO{
+		CreatorUserId:     in.CreatorId,
+		AccessHash:        rand.Int63(),
+		Broadcast:         in.Broadcast,
+		Megagroup:         in.Megagroup || !in.Broadcast,
+		Title:             in.Title,
+		About:             in.About,
+		ParticipantsCount: 1,
+		Version:           1,
+		Date:              date,
+	}
+
+	participantDO := &dataobject.ChannelParticipantsDO{
+		UserId:          in.CreatorId,
+		ParticipantType: channel.ChannelMemberCreator,
+		InviterUserId:   0,
+		InvitedAt:       date,
+		Date2:           date,
+	}
+
+	tR := sqlx.TxWrapper(c.ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		channelsDO.Id, _, result.Err = c.svcCtx.Dao.ChannelsDAO.InsertTx(tx, channelsDO)
+		if result.Err != nil {
+			return
+		}
+
+		participantDO.ChannelId = channelsDO.Id
+		_, _, result.Err = c.svcCtx.Dao.ChannelParticipantsDAO.InsertTx(tx, participantDO)
+	})
+	if tR.Err != nil {
+		c.Logger.Errorf("channel.createChannel - error: %v", tR.Err)
+		return nil, tR.Err
+	}
+
+	// the channel may have been looked up by id before it existed
+	c.svcCtx.Dao.DelCache(context.Background(), c.svcCtx.Dao.GetChannelCacheKey(channelsDO.Id))
+
+	return channel.MakeTLMutableChannel(&channel.MutableChannel{
+		Channel:      c.svcCtx.Dao.MakeChannelDataByDO(channelsDO),
+		Participants: []*channel.ChannelParticipantData{c.svcCtx.Dao.MakeChannelParticipantDataByDO(participantDO)},
+	}).To_MutableChannel(), nil
+}
@@ -0,0 +1,109 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelDeleteChannelMessages
+// channel.deleteChannelMessages from_id:long channel_id:long id:Vector<int> = messages.AffectedMessages;
+func (c *ChannelCore) ChannelDeleteChannelMessages(in *channel.TLChannelDeleteChannelMessages) (*mtproto.Messages_AffectedMessages, error) {
+	var (
+		fromId    = in.FromId
+		channelId = in.ChannelId
+	)
+
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, channelId, fromId)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteChannelMessages - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetChannelParticipant(fromId)
+	if !ok || !me.IsStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.deleteChannelMessages - error: %v", err)
+		return nil, err
+	}
+
+	boxDOList, err := c.svcCtx.Dao.ChannelMessagesDAO.SelectByMessageIdList(c.ctx, channelId, in.Id)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteChannelMessages - error: %v", err)
+		return nil, err
+	}
+
+	// admins may delete every message, the others only their own
+	idList := make([]int32, 0, len(boxDOList))
+	for i := range boxDOList {
+		if boxDOList[i].SenderUserId != fromId && !me.IsAdmin() {
+			err = mtproto.ErrMessageDeleteForbidden
+			c.Logger.Errorf("channel.deleteChannelMessages - error: %v", err)
+			return nil, err
+		}
+		idList = append(idList, boxDOList[i].ChannelMessageId)
+	}
+
+	if len(idList) == 0 {
+		return mtproto.MakeTLMessagesAffectedMessages(&mtproto.Messages_AffectedMessages{
+			Pts:      mChannel.GetChannel().GetPts(),
+			PtsCount: 0,
+		}).To_Messages_AffectedMessages(), nil
+	}
+
+	pts := c.svcCtx.Dao.IDGenClient2.NextChannelNPtsId(c.ctx, channelId, len(idList))
+	if pts == 0 {
+		err = mtproto.ErrInternelServerError
+		c.Logger.Errorf("channel.deleteChannelMessages - error: %v", err)
+		return nil, err
+	}
+
+	ptsCount := int32(len(idList))
+	update := mtproto.MakeTLUpdateDeleteChannelMessages(&mtproto.Update{
+		ChannelId: channelId,
+		Messages:  idList,
+		Pts_INT32: pts,
+		PtsCount:  ptsCount,
+	}).To_Update()
+	ptsDO := c.svcCtx.Dao.MakeChannelPtsUpdatesDO(channelId, pts, ptsCount, update, time.Now().Unix())
+
+	_, _, err = c.svcCtx.Dao.CachedConn.Exec(
+		c.ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			tR := sqlx.TxWrapper(ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				_, result.Err = c.svcCtx.Dao.ChannelMessagesDAO.DeleteMessagesTx(tx, channelId, idList)
+				if result.Err != nil {
+					return
+				}
+				_, _, result.Err = c.svcCtx.Dao.ChannelPtsUpdatesDAO.InsertTx(tx, ptsDO)
+				if result.Err != nil {
+					return
+				}
+				_, result.Err = c.svcCtx.Dao.ChannelsDAO.UpdatePtsTx(tx, pts, channelId)
+			})
+			return 0, 0, tR.Err
+		},
+		c.svcCtx.Dao.GetChannelCacheKey(channelId))
+	if err != nil {
+		c.Logger.Errorf("channel.deleteChannelMessages - error: %v", err)
+		return nil, err
+	}
+	c.svcCtx.Dao.UnindexChannelMessages(c.ctx, channelId, idList)
+
+	return mtproto.MakeTLMessagesAffectedMessages(&mtproto.Messages_AffectedMessages{
+		Pts:      pts,
+		PtsCount: ptsCount,
+	}).To_Messages_AffectedMessages(), nil
+}
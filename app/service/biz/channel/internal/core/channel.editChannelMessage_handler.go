@@ -0,0 +1,152 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// ChannelEditChannelMessage
+// channel.editChannelMessage from_id:long channel_id:long message:Message = MessageBox;
+func (c *ChannelCore) ChannelEditChannelMessage(in *channel.TLChannelEditChannelMessage) (*mtproto.MessageBox, error) {
+	var (
+		fromId    = in.FromId
+		channelId = in.ChannelId
+		message   = in.Message
+	)
+
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, channelId, fromId)
+	if err != nil {
+		c.Logger.Errorf("channel.editChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetChannelParticipant(fromId)
+	if !ok || !me.IsStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.editChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	prevDO, err := c.svcCtx.Dao.ChannelMessagesDAO.SelectByMessageId(c.ctx, channelId, message.GetId())
+	if err != nil {
+		c.Logger.Errorf("channel.editChannelMessage - error: %v", err)
+		return nil, err
+	} else if prevDO == nil {
+		err = mtproto.ErrMessageIdInvalid
+		c.Logger.Errorf("channel.editChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	// admins of a broadcast channel may edit every post
+	if prevDO.SenderUserId != fromId && !(mChannel.IsBroadcast() && me.IsAdmin()) {
+		err = mtproto.ErrMessageAuthorRequired
+		c.Logger.Errorf("channel.editChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	pts := c.svcCtx.Dao.IDGenClient2.NextChannelPtsId(c.ctx, channelId)
+	if pts == 0 {
+		err = mtproto.ErrInternelServerError
+		c.Logger.Errorf("channel.editChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	prevBox := c.svcCtx.Dao.MakeMessageBoxByDO(mChannel.IsBroadcast(), prevDO)
+
+	// the stored message owns everything but the editable parts
+	message.Id = prevDO.ChannelMessageId
+	message.Out = true
+	message.PeerId = prevBox.Message.PeerId
+	message.FromId = prevBox.Message.FromId
+	message.Post = prevBox.Message.Post
+	message.Date = prevBox.Message.Date
+	message.Views = prevBox.Message.Views
+	if message.EditDate == nil {
+		message.EditDate = &types.Int32Value{Value: int32(time.Now().Unix())}
+	}
+
+	mData, _ := jsonx.Marshal(message)
+
+	inboxMessage := proto.Clone(message).(*mtproto.Message)
+	inboxMessage.Out = false
+	update := mtproto.MakeTLUpdateEditChannelMessage(&mtproto.Update{
+		Message_MESSAGE: inboxMessage,
+		Pts_INT32:       pts,
+		PtsCount:        1,
+	}).To_Update()
+	ptsDO := c.svcCtx.Dao.MakeChannelPtsUpdatesDO(channelId, pts, 1, update, int64(message.EditDate.Value))
+
+	historyDO := &message_helper.MessageEditHistoryDO{
+		UserId:          0,
+		PeerType:        mtproto.PEER_CHANNEL,
+		PeerId:          channelId,
+		MessageId:       prevDO.ChannelMessageId,
+		EditorUserId:    fromId,
+		PrevMessageData: prevDO.MessageData,
+		MessageData:     hack.String(mData),
+		EditDate:        int64(message.EditDate.Value),
+	}
+
+	_, _, err = c.svcCtx.Dao.CachedConn.Exec(
+		c.ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			tR := sqlx.TxWrapper(ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				_, result.Err = c.svcCtx.Dao.ChannelMessagesDAO.UpdateEditMessageTx(
+					tx,
+					mtproto.GetMediaType(message),
+					hack.String(mData),
+					message.Message,
+					channelId,
+					prevDO.ChannelMessageId)
+				if result.Err != nil {
+					return
+				}
+				_, _, result.Err = c.svcCtx.Dao.ChannelPtsUpdatesDAO.InsertTx(tx, ptsDO)
+				if result.Err != nil {
+					return
+				}
+				_, _, result.Err = c.svcCtx.Dao.MessageEditHistoryDAO.InsertTx(tx, historyDO)
+				if result.Err != nil {
+					return
+				}
+				_, result.Err = c.svcCtx.Dao.ChannelsDAO.UpdatePtsTx(tx, pts, channelId)
+			})
+			return 0, 0, tR.Err
+		},
+		c.svcCtx.Dao.GetChannelCacheKey(channelId))
+	if err != nil {
+		c.Logger.Errorf("channel.editChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	editDO := *prevDO
+	editDO.Message = message.Message
+	editDO.MessageFilterType = mtproto.GetMediaType(message)
+	c.svcCtx.Dao.IndexChannelMessage(c.ctx, &editDO)
+
+	prevBox.Message = message
+	prevBox.MessageFilterType = editDO.MessageFilterType
+	prevBox.Pts = pts
+	prevBox.PtsCount = 1
+
+	return prevBox, nil
+}
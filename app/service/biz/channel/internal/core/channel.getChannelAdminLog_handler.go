@@ -0,0 +1,88 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// ChannelGetChannelAdminLog
+// channel.getChannelAdminLog channel_id:long user_id:long max_id:long min_id:long limit:int = Vector<ChannelAdminLogEvent>;
+func (c *ChannelCore) ChannelGetChannelAdminLog(in *channel.TLChannelGetChannelAdminLog) (*channel.Vector_ChannelAdminLogEvent, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelAdminLog - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetChannelParticipant(in.UserId)
+	if !ok || !me.IsStateNormal() || !me.IsAdmin() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.getChannelAdminLog - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		maxId = in.MaxId
+		limit = in.Limit
+		rList = &channel.Vector_ChannelAdminLogEvent{
+			Datas: []*mtproto.ChannelAdminLogEvent{},
+		}
+	)
+	if maxId == 0 {
+		maxId = math.MaxInt64
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	// only message edits are logged for now
+	c.svcCtx.Dao.MessageEditHistoryDAO.SelectByPeerOffsetLimitWithCB(
+		c.ctx,
+		mtproto.PEER_CHANNEL,
+		in.ChannelId,
+		maxId,
+		in.MinId,
+		limit,
+		func(i int, v *message_helper.MessageEditHistoryDO) {
+			var (
+				prevMessage *mtproto.Message
+				newMessage  *mtproto.Message
+			)
+			if err := jsonx.UnmarshalFromString(v.PrevMessageData, &prevMessage); err != nil {
+				c.Logger.Errorf("channel.getChannelAdminLog - unmarshal message_edit_history(%d) error: %v", v.Id, err)
+				return
+			}
+			if err := jsonx.UnmarshalFromString(v.MessageData, &newMessage); err != nil {
+				c.Logger.Errorf("channel.getChannelAdminLog - unmarshal message_edit_history(%d) error: %v", v.Id, err)
+				return
+			}
+			prevMessage.Out = false
+			newMessage.Out = false
+
+			rList.Datas = append(rList.Datas, mtproto.MakeTLChannelAdminLogEvent(&mtproto.ChannelAdminLogEvent{
+				Id:     v.Id,
+				Date:   int32(v.EditDate),
+				UserId: v.EditorUserId,
+				Action: mtproto.MakeTLChannelAdminLogEventActionEditMessage(&mtproto.ChannelAdminLogEventAction{
+					PrevMessage: prevMessage.FixData(),
+					NewMessage:  newMessage.FixData(),
+				}).To_ChannelAdminLogEventAction(),
+			}).To_ChannelAdminLogEvent())
+		})
+
+	return rList, nil
+}
@@ -0,0 +1,59 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelGetChannelHistory
+// channel.getChannelHistory channel_id:long offset_id:int limit:int = Vector<MessageBox>;
+func (c *ChannelCore) ChannelGetChannelHistory(in *channel.TLChannelGetChannelHistory) (*channel.Vector_MessageBox, error) {
+	var (
+		offsetId = in.OffsetId
+		limit    = in.Limit
+	)
+
+	if offsetId <= 0 {
+		offsetId = math.MaxInt32
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	channelData, err := c.svcCtx.Dao.GetChannelData(c.ctx, in.ChannelId)
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelHistory - error: %v", err)
+		return nil, err
+	}
+
+	rValues := &channel.Vector_MessageBox{
+		Datas: make([]*mtproto.MessageBox, 0, limit),
+	}
+
+	_, err = c.svcCtx.Dao.ChannelMessagesDAO.SelectBackwardByOffsetIdLimitWithCB(
+		c.ctx,
+		in.ChannelId,
+		offsetId,
+		limit,
+		func(i int, v *dataobject.ChannelMessagesDO) {
+			rValues.Datas = append(rValues.Datas, c.svcCtx.Dao.MakeMessageBoxByDO(channelData.Broadcast, v))
+		})
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelHistory - error: %v", err)
+		return nil, err
+	}
+
+	return rValues, nil
+}
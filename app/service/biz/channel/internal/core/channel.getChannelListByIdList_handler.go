@@ -0,0 +1,33 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelGetChannelListByIdList
+// channel.getChannelListByIdList self_id:long id:Vector<long> = Vector<MutableChannel>;
+func (c *ChannelCore) ChannelGetChannelListByIdList(in *channel.TLChannelGetChannelListByIdList) (*channel.Vector_MutableChannel, error) {
+	rValues := &channel.Vector_MutableChannel{
+		Datas: make([]*channel.MutableChannel, 0, len(in.Id)),
+	}
+
+	for _, id := range in.Id {
+		mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, id, in.SelfId)
+		if err != nil {
+			c.Logger.Errorf("channel.getChannelListByIdList - error: %v", err)
+			continue
+		}
+		rValues.Datas = append(rValues.Datas, mChannel)
+	}
+
+	return rValues, nil
+}
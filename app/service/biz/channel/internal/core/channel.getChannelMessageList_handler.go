@@ -0,0 +1,48 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelGetChannelMessageList
+// channel.getChannelMessageList channel_id:long id:Vector<int> = Vector<MessageBox>;
+func (c *ChannelCore) ChannelGetChannelMessageList(in *channel.TLChannelGetChannelMessageList) (*channel.Vector_MessageBox, error) {
+	rValues := &channel.Vector_MessageBox{
+		Datas: make([]*mtproto.MessageBox, 0, len(in.Id)),
+	}
+
+	if len(in.Id) == 0 {
+		return rValues, nil
+	}
+
+	channelData, err := c.svcCtx.Dao.GetChannelData(c.ctx, in.ChannelId)
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelMessageList - error: %v", err)
+		return nil, err
+	}
+
+	_, err = c.svcCtx.Dao.ChannelMessagesDAO.SelectByMessageIdListWithCB(
+		c.ctx,
+		in.ChannelId,
+		in.Id,
+		func(i int, v *dataobject.ChannelMessagesDO) {
+			rValues.Datas = append(rValues.Datas, c.svcCtx.Dao.MakeMessageBoxByDO(channelData.Broadcast, v))
+		})
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelMessageList - error: %v", err)
+		return nil, err
+	}
+
+	return rValues, nil
+}
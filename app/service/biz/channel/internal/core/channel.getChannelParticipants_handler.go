@@ -0,0 +1,46 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelGetChannelParticipants
+// channel.getChannelParticipants channel_id:long offset:int limit:int = Vector<ChannelParticipantData>;
+func (c *ChannelCore) ChannelGetChannelParticipants(in *channel.TLChannelGetChannelParticipants) (*channel.Vector_ChannelParticipantData, error) {
+	var (
+		limit = in.Limit
+	)
+
+	if limit <= 0 || limit > 200 {
+		limit = 200
+	}
+
+	rValues := &channel.Vector_ChannelParticipantData{
+		Datas: make([]*channel.ChannelParticipantData, 0, limit),
+	}
+
+	_, err := c.svcCtx.Dao.ChannelParticipantsDAO.SelectListByOffsetLimitWithCB(
+		c.ctx,
+		in.ChannelId,
+		in.Offset,
+		limit,
+		func(i int, v *dataobject.ChannelParticipantsDO) {
+			rValues.Datas = append(rValues.Datas, c.svcCtx.Dao.MakeChannelParticipantDataByDO(v))
+		})
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelParticipants - error: %v", err)
+		return nil, err
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,32 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelGetMyChannelList
+// channel.getMyChannelList user_id:long = Vector<long>;
+func (c *ChannelCore) ChannelGetMyChannelList(in *channel.TLChannelGetMyChannelList) (*channel.Vector_Long, error) {
+	idList, err := c.svcCtx.Dao.ChannelParticipantsDAO.SelectMyChannelIdList(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.getMyChannelList - error: %v", err)
+		return nil, err
+	}
+
+	if idList == nil {
+		idList = []int64{}
+	}
+
+	return &channel.Vector_Long{
+		Datas: idList,
+	}, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelJoinChannel
+// channel.joinChannel channel_id:long user_id:long inviter_id:long = MutableChannel;
+func (c *ChannelCore) ChannelJoinChannel(in *channel.TLChannelJoinChannel) (*channel.MutableChannel, error) {
+	var (
+		now = time.Now().Unix()
+	)
+
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	if mChannel.GetChannel().GetDeactivated() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	if me, ok := mChannel.GetChannelParticipant(in.UserId); ok {
+		if me.IsStateNormal() {
+			err = mtproto.ErrUserAlreadyParticipant
+			c.Logger.Errorf("channel.joinChannel - error: %v", err)
+			return nil, err
+		} else if me.IsStateKicked() {
+			err = mtproto.ErrUserBannedInChannel
+			c.Logger.Errorf("channel.joinChannel - error: %v", err)
+			return nil, err
+		}
+	}
+
+	participantDO := &dataobject.ChannelParticipantsDO{
+		ChannelId:       in.ChannelId,
+		UserId:          in.UserId,
+		ParticipantType: channel.ChannelMemberNormal,
+		InviterUserId:   in.InviterId,
+		InvitedAt:       now,
+		Date2:           now,
+	}
+
+	_, _, err = c.svcCtx.Dao.CachedConn.Exec(
+		c.ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			tR := sqlx.TxWrapper(ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				_, _, result.Err = c.svcCtx.Dao.ChannelParticipantsDAO.InsertOrUpdateTx(tx, participantDO)
+				if result.Err != nil {
+					return
+				}
+				_, result.Err = c.svcCtx.Dao.ChannelsDAO.UpdateParticipantsCountTx(tx, 1, in.ChannelId)
+			})
+			return 0, 0, tR.Err
+		},
+		c.svcCtx.Dao.GetChannelCacheKey(in.ChannelId))
+	if err != nil {
+		c.Logger.Errorf("channel.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	mChannel.Channel.ParticipantsCount += 1
+	mChannel.Channel.Version += 1
+	mChannel.Participants = []*channel.ChannelParticipantData{c.svcCtx.Dao.MakeChannelParticipantDataByDO(participantDO)}
+
+	return mChannel, nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelLeaveChannel
+// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+func (c *ChannelCore) ChannelLeaveChannel(in *channel.TLChannelLeaveChannel) (*channel.MutableChannel, error) {
+	var (
+		now = time.Now().Unix()
+	)
+
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetChannelParticipant(in.UserId)
+	if !ok || !me.IsStateNormal() {
+		err = mtproto.ErrUserNotParticipant
+		c.Logger.Errorf("channel.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	if me.IsCreator() {
+		// TODO: transfer ownership before the creator leaves
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	_, _, err = c.svcCtx.Dao.CachedConn.Exec(
+		c.ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			tR := sqlx.TxWrapper(ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				_, result.Err = c.svcCtx.Dao.ChannelParticipantsDAO.UpdateLeftTx(tx, now, in.ChannelId, in.UserId)
+				if result.Err != nil {
+					return
+				}
+				_, result.Err = c.svcCtx.Dao.ChannelsDAO.UpdateParticipantsCountTx(tx, -1, in.ChannelId)
+			})
+			return 0, 0, tR.Err
+		},
+		c.svcCtx.Dao.GetChannelCacheKey(in.ChannelId))
+	if err != nil {
+		c.Logger.Errorf("channel.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	mChannel.Channel.ParticipantsCount -= 1
+	mChannel.Channel.Version += 1
+	me.State = channel.ChannelMemberStateLeft
+	me.LeftAt = int32(now)
+
+	return mChannel, nil
+}
@@ -0,0 +1,47 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelReadChannelHistory
+// channel.readChannelHistory channel_id:long user_id:long max_id:int = Bool;
+func (c *ChannelCore) ChannelReadChannelHistory(in *channel.TLChannelReadChannelHistory) (*mtproto.Bool, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.readChannelHistory - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetChannelParticipant(in.UserId)
+	if !ok || !me.IsStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.readChannelHistory - error: %v", err)
+		return nil, err
+	}
+
+	maxId := in.MaxId
+	if maxId > mChannel.GetChannel().GetTopMessage() || maxId <= 0 {
+		maxId = mChannel.GetChannel().GetTopMessage()
+	}
+	if maxId <= me.ReadInboxMaxId {
+		return mtproto.BoolFalse, nil
+	}
+
+	if _, err = c.svcCtx.Dao.ChannelParticipantsDAO.UpdateReadInboxMaxId(c.ctx, maxId, in.ChannelId, in.UserId); err != nil {
+		c.Logger.Errorf("channel.readChannelHistory - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math"
+	"sort"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+)
+
+// ChannelSearchChannelMessages
+// channel.searchChannelMessages channel_id:long user_id:long q:string from_id:long offset_id:int min_date:int max_date:int limit:int = Vector<MessageBox>;
+func (c *ChannelCore) ChannelSearchChannelMessages(in *channel.TLChannelSearchChannelMessages) (*channel.Vector_MessageBox, error) {
+	var (
+		offsetId = in.OffsetId
+		limit    = in.Limit
+		rValues  = &channel.Vector_MessageBox{
+			Datas: []*mtproto.MessageBox{},
+		}
+	)
+
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.searchChannelMessages - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetChannelParticipant(in.UserId)
+	if !ok || !me.IsStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.searchChannelMessages - error: %v", err)
+		return nil, err
+	}
+
+	if offsetId == 0 {
+		offsetId = math.MaxInt32
+	}
+	if limit <= 0 || limit > 50 {
+		limit = 50
+	}
+
+	idList, err := c.svcCtx.Dao.SearchIndex.Search(c.ctx, &message_helper.SearchQuery{
+		UserId:   0,
+		PeerType: mtproto.PEER_CHANNEL,
+		PeerId:   in.ChannelId,
+		FromId:   in.FromId,
+		Q:        in.Q,
+		OffsetId: offsetId,
+		MinDate:  int64(in.MinDate),
+		MaxDate:  int64(in.MaxDate),
+		Limit:    limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("channel.searchChannelMessages - error: %v", err)
+		return nil, err
+	} else if len(idList) == 0 {
+		return rValues, nil
+	}
+
+	_, err = c.svcCtx.Dao.ChannelMessagesDAO.SelectByMessageIdListWithCB(
+		c.ctx,
+		in.ChannelId,
+		idList,
+		func(i int, v *dataobject.ChannelMessagesDO) {
+			rValues.Datas = append(rValues.Datas, c.svcCtx.Dao.MakeMessageBoxByDO(mChannel.IsBroadcast(), v))
+		})
+	if err != nil {
+		c.Logger.Errorf("channel.searchChannelMessages - error: %v", err)
+		return nil, err
+	}
+
+	sort.Slice(rValues.Datas, func(i, j int) bool {
+		return rValues.Datas[i].MessageId > rValues.Datas[j].MessageId
+	})
+
+	return rValues, nil
+}
@@ -0,0 +1,148 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"context"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// ChannelSendChannelMessage
+// channel.sendChannelMessage from_id:long channel_id:long random_id:long message:Message = MessageBox;
+func (c *ChannelCore) ChannelSendChannelMessage(in *channel.TLChannelSendChannelMessage) (*mtproto.MessageBox, error) {
+	var (
+		fromId    = in.FromId
+		channelId = in.ChannelId
+		message   = in.Message
+	)
+
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, channelId, fromId)
+	if err != nil {
+		c.Logger.Errorf("channel.sendChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetChannelParticipant(fromId)
+	if !ok || !me.IsStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.sendChannelMessage - error: %v", err)
+		return nil, err
+	}
+	if mChannel.IsBroadcast() && !me.IsAdmin() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.sendChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	// duplicate random_id: the client resent a message we already stored
+	if in.RandomId != 0 {
+		if do, _ := c.svcCtx.Dao.ChannelMessagesDAO.SelectByRandomId(c.ctx, channelId, fromId, in.RandomId); do != nil {
+			return c.svcCtx.Dao.MakeMessageBoxByDO(mChannel.IsBroadcast(), do), nil
+		}
+	}
+
+	idList := c.svcCtx.Dao.IDGenClient2.GetNextIdList(
+		c.ctx,
+		idgen_client.MakeIDTypeNgen(idgen_client.IDTypeChannelMessageBox, channelId),
+		idgen_client.MakeIDTypeNgen(idgen_client.IDTypeChannelPts, channelId))
+	if len(idList) != 2 || idList[0].Id == 0 || idList[1].Id == 0 {
+		err = mtproto.ErrInternelServerError
+		c.Logger.Errorf("channel.sendChannelMessage - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		messageId = int32(idList[0].Id)
+		pts       = int32(idList[1].Id)
+	)
+
+	message.Id = messageId
+	message.Out = true
+	message.PeerId = mtproto.MakePeerChannel(channelId)
+	message.MediaUnread = mtproto.CheckHasMediaUnread(message)
+	if mChannel.IsBroadcast() {
+		message.Post = true
+		message.FromId = nil
+		message.Views = &types.Int32Value{Value: 0}
+	} else {
+		message.FromId = mtproto.MakePeerUser(fromId)
+	}
+
+	mData, _ := jsonx.Marshal(message)
+	boxDO := &dataobject.ChannelMessagesDO{
+		ChannelId:         channelId,
+		ChannelMessageId:  messageId,
+		SenderUserId:      fromId,
+		RandomId:          in.RandomId,
+		Pts:               pts,
+		MessageFilterType: mtproto.GetMediaType(message),
+		MessageData:       hack.String(mData),
+		Message:           message.Message,
+		Date2:             int64(message.Date),
+	}
+
+	// channel_pts_updates keeps the update as seen by the other participants
+	inboxMessage := proto.Clone(message).(*mtproto.Message)
+	inboxMessage.Out = false
+	update := mtproto.MakeTLUpdateNewChannelMessage(&mtproto.Update{
+		Message_MESSAGE: inboxMessage,
+		Pts_INT32:       pts,
+		PtsCount:        1,
+	}).To_Update()
+	ptsDO := c.svcCtx.Dao.MakeChannelPtsUpdatesDO(channelId, pts, 1, update, int64(message.Date))
+
+	_, _, err = c.svcCtx.Dao.CachedConn.Exec(
+		c.ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			tR := sqlx.TxWrapper(ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				_, _, result.Err = c.svcCtx.Dao.ChannelMessagesDAO.InsertTx(tx, boxDO)
+				if result.Err != nil {
+					return
+				}
+				_, _, result.Err = c.svcCtx.Dao.ChannelPtsUpdatesDAO.InsertTx(tx, ptsDO)
+				if result.Err != nil {
+					return
+				}
+				_, result.Err = c.svcCtx.Dao.ChannelsDAO.UpdateTopMessageTx(tx, messageId, pts, channelId)
+			})
+			return 0, 0, tR.Err
+		},
+		c.svcCtx.Dao.GetChannelCacheKey(channelId))
+	if err != nil {
+		c.Logger.Errorf("channel.sendChannelMessage - error: %v", err)
+		return nil, err
+	}
+	c.svcCtx.Dao.IndexChannelMessage(c.ctx, boxDO)
+
+	return &mtproto.MessageBox{
+		UserId:            fromId,
+		MessageId:         messageId,
+		SenderUserId:      fromId,
+		PeerType:          mtproto.PEER_CHANNEL,
+		PeerId:            channelId,
+		RandomId:          in.RandomId,
+		DialogMessageId:   int64(messageId),
+		MessageFilterType: boxDO.MessageFilterType,
+		Message:           message,
+		MediaUnread:       message.MediaUnread,
+		Pts:               pts,
+		PtsCount:          1,
+	}, nil
+}
@@ -0,0 +1,4 @@
+./dalgen.sh channel_messages
+./dalgen.sh channel_participants
+./dalgen.sh channels
+./dalgen.sh channel_pts_updates
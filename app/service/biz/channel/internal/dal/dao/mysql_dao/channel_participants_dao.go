@@ -0,0 +1,445 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ChannelParticipantsDAO struct {
+	db *sqlx.DB
+}
+
+func NewChannelParticipantsDAO(db *sqlx.DB) *ChannelParticipantsDAO {
+	return &ChannelParticipantsDAO{db}
+}
+
+// Insert
+// insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, invited_at, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :invited_at, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) Insert(ctx context.Context, do *dataobject.ChannelParticipantsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, invited_at, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :invited_at, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, invited_at, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :invited_at, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.ChannelParticipantsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, invited_at, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :invited_at, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdate
+// insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, invited_at, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :invited_at, :date2) on duplicate key update participant_type = values(participant_type), inviter_user_id = values(inviter_user_id), invited_at = values(invited_at), state = 0, kicked_at = 0, left_at = 0, date2 = values(date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.ChannelParticipantsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, invited_at, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :invited_at, :date2) on duplicate key update participant_type = values(participant_type), inviter_user_id = values(inviter_user_id), invited_at = values(invited_at), state = 0, kicked_at = 0, left_at = 0, date2 = values(date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, invited_at, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :invited_at, :date2) on duplicate key update participant_type = values(participant_type), inviter_user_id = values(inviter_user_id), invited_at = values(invited_at), state = 0, kicked_at = 0, left_at = 0, date2 = values(date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.ChannelParticipantsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, invited_at, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :invited_at, :date2) on duplicate key update participant_type = values(participant_type), inviter_user_id = values(inviter_user_id), invited_at = values(invited_at), state = 0, kicked_at = 0, left_at = 0, date2 = values(date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByParticipantId
+// select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = :channel_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectByParticipantId(ctx context.Context, channel_id int64, user_id int64) (rValue *dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query = "select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = ? and user_id = ?"
+		do    = &dataobject.ChannelParticipantsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, channel_id, user_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByParticipantId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectListByParticipantIdList
+// select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = :channel_id and user_id in (:idList)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectListByParticipantIdList(ctx context.Context, channel_id int64, idList []int64) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = ? and user_id in (?)"
+		a      []interface{}
+		values []dataobject.ChannelParticipantsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelParticipantsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, channel_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListByParticipantIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByParticipantIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByParticipantIdListWithCB
+// select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = :channel_id and user_id in (:idList)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectListByParticipantIdListWithCB(ctx context.Context, channel_id int64, idList []int64, cb func(i int, v *dataobject.ChannelParticipantsDO)) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = ? and user_id in (?)"
+		a      []interface{}
+		values []dataobject.ChannelParticipantsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelParticipantsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, channel_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListByParticipantIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByParticipantIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectListByOffsetLimit
+// select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = :channel_id and state = 0 order by id asc limit :offset, :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectListByOffsetLimit(ctx context.Context, channel_id int64, offset int32, limit int32) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = ? and state = 0 order by id asc limit ?, ?"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByOffsetLimit(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByOffsetLimitWithCB
+// select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = :channel_id and state = 0 order by id asc limit :offset, :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectListByOffsetLimitWithCB(ctx context.Context, channel_id int64, offset int32, limit int32, cb func(i int, v *dataobject.ChannelParticipantsDO)) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, inviter_user_id, invited_at, kicked_at, left_at, read_inbox_max_id, state, date2 from channel_participants where channel_id = ? and state = 0 order by id asc limit ?, ?"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByOffsetLimit(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectParticipantIdList
+// select user_id from channel_participants where channel_id = :channel_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectParticipantIdList(ctx context.Context, channel_id int64) (rList []int64, err error) {
+	var query = "select user_id from channel_participants where channel_id = ? and state = 0"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectParticipantIdList(_), error: %v", err)
+	}
+
+	return
+}
+
+// SelectParticipantIdListWithCB
+// select user_id from channel_participants where channel_id = :channel_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectParticipantIdListWithCB(ctx context.Context, channel_id int64, cb func(i int, v int64)) (rList []int64, err error) {
+	var query = "select user_id from channel_participants where channel_id = ? and state = 0"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectParticipantIdList(_), error: %v", err)
+	}
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectMyChannelIdList
+// select channel_id from channel_participants where user_id = :user_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectMyChannelIdList(ctx context.Context, user_id int64) (rList []int64, err error) {
+	var query = "select channel_id from channel_participants where user_id = ? and state = 0"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectMyChannelIdList(_), error: %v", err)
+	}
+
+	return
+}
+
+// SelectMyChannelIdListWithCB
+// select channel_id from channel_participants where user_id = :user_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectMyChannelIdListWithCB(ctx context.Context, user_id int64, cb func(i int, v int64)) (rList []int64, err error) {
+	var query = "select channel_id from channel_participants where user_id = ? and state = 0"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectMyChannelIdList(_), error: %v", err)
+	}
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateLeft
+// update channel_participants set kicked_at = 0, left_at = :left_at, state = 1 where channel_id = :channel_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateLeft(ctx context.Context, left_at int64, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set kicked_at = 0, left_at = ?, state = 1 where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, left_at, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateLeft(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateLeft(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_participants set kicked_at = 0, left_at = :left_at, state = 1 where channel_id = :channel_id and user_id = :user_id
+// UpdateLeftTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateLeftTx(tx *sqlx.Tx, left_at int64, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set kicked_at = 0, left_at = ?, state = 1 where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, left_at, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateLeft(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateLeft(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReadInboxMaxId
+// update channel_participants set read_inbox_max_id = :read_inbox_max_id where channel_id = :channel_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateReadInboxMaxId(ctx context.Context, read_inbox_max_id int32, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set read_inbox_max_id = ? where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, read_inbox_max_id, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateReadInboxMaxId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateReadInboxMaxId(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_participants set read_inbox_max_id = :read_inbox_max_id where channel_id = :channel_id and user_id = :user_id
+// UpdateReadInboxMaxIdTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateReadInboxMaxIdTx(tx *sqlx.Tx, read_inbox_max_id int32, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set read_inbox_max_id = ? where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, read_inbox_max_id, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateReadInboxMaxId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateReadInboxMaxId(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,345 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ChannelsDAO struct {
+	db *sqlx.DB
+}
+
+func NewChannelsDAO(db *sqlx.DB) *ChannelsDAO {
+	return &ChannelsDAO{db}
+}
+
+// Insert
+// insert into channels(creator_user_id, access_hash, broadcast, megagroup, title, about, participants_count, version, `date`) values (:creator_user_id, :access_hash, :broadcast, :megagroup, :title, :about, :participants_count, :version, :date)
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) Insert(ctx context.Context, do *dataobject.ChannelsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channels(creator_user_id, access_hash, broadcast, megagroup, title, about, participants_count, version, `date`) values (:creator_user_id, :access_hash, :broadcast, :megagroup, :title, :about, :participants_count, :version, :date)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into channels(creator_user_id, access_hash, broadcast, megagroup, title, about, participants_count, version, `date`) values (:creator_user_id, :access_hash, :broadcast, :megagroup, :title, :about, :participants_count, :version, :date)
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.ChannelsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channels(creator_user_id, access_hash, broadcast, megagroup, title, about, participants_count, version, `date`) values (:creator_user_id, :access_hash, :broadcast, :megagroup, :title, :about, :participants_count, :version, :date)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// Select
+// select id, creator_user_id, access_hash, broadcast, megagroup, title, about, photo_id, participants_count, top_message, pts, signatures, noforwards, deactivated, version, `date` from channels where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) Select(ctx context.Context, id int64) (rValue *dataobject.ChannelsDO, err error) {
+	var (
+		query = "select id, creator_user_id, access_hash, broadcast, megagroup, title, about, photo_id, participants_count, top_message, pts, signatures, noforwards, deactivated, version, `date` from channels where id = ?"
+		do    = &dataobject.ChannelsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in Select(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectByIdList
+// select id, creator_user_id, access_hash, broadcast, megagroup, title, about, photo_id, participants_count, top_message, pts, signatures, noforwards, deactivated, version, `date` from channels where id in (:idList)
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) SelectByIdList(ctx context.Context, idList []int64) (rList []dataobject.ChannelsDO, err error) {
+	var (
+		query  = "select id, creator_user_id, access_hash, broadcast, megagroup, title, about, photo_id, participants_count, top_message, pts, signatures, noforwards, deactivated, version, `date` from channels where id in (?)"
+		a      []interface{}
+		values []dataobject.ChannelsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByIdListWithCB
+// select id, creator_user_id, access_hash, broadcast, megagroup, title, about, photo_id, participants_count, top_message, pts, signatures, noforwards, deactivated, version, `date` from channels where id in (:idList)
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) SelectByIdListWithCB(ctx context.Context, idList []int64, cb func(i int, v *dataobject.ChannelsDO)) (rList []dataobject.ChannelsDO, err error) {
+	var (
+		query  = "select id, creator_user_id, access_hash, broadcast, megagroup, title, about, photo_id, participants_count, top_message, pts, signatures, noforwards, deactivated, version, `date` from channels where id in (?)"
+		a      []interface{}
+		values []dataobject.ChannelsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectLastCreator
+// select id, creator_user_id, access_hash, broadcast, megagroup, title, about, photo_id, participants_count, top_message, pts, signatures, noforwards, deactivated, version, `date` from channels where creator_user_id = :creator_user_id order by `date` desc limit 1
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) SelectLastCreator(ctx context.Context, creator_user_id int64) (rValue *dataobject.ChannelsDO, err error) {
+	var (
+		query = "select id, creator_user_id, access_hash, broadcast, megagroup, title, about, photo_id, participants_count, top_message, pts, signatures, noforwards, deactivated, version, `date` from channels where creator_user_id = ? order by `date` desc limit 1"
+		do    = &dataobject.ChannelsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, creator_user_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectLastCreator(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// UpdateParticipantsCount
+// update channels set participants_count = participants_count + :delta, version = version + 1 where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateParticipantsCount(ctx context.Context, delta int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set participants_count = participants_count + ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, delta, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateParticipantsCount(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateParticipantsCount(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set participants_count = participants_count + :delta, version = version + 1 where id = :id
+// UpdateParticipantsCountTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateParticipantsCountTx(tx *sqlx.Tx, delta int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set participants_count = participants_count + ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, delta, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateParticipantsCount(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateParticipantsCount(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateTopMessage
+// update channels set top_message = GREATEST(top_message, :top_message), pts = GREATEST(pts, :pts) where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateTopMessage(ctx context.Context, top_message int32, pts int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set top_message = GREATEST(top_message, ?), pts = GREATEST(pts, ?) where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, top_message, pts, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateTopMessage(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateTopMessage(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set top_message = GREATEST(top_message, :top_message), pts = GREATEST(pts, :pts) where id = :id
+// UpdateTopMessageTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateTopMessageTx(tx *sqlx.Tx, top_message int32, pts int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set top_message = GREATEST(top_message, ?), pts = GREATEST(pts, ?) where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, top_message, pts, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateTopMessage(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateTopMessage(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdatePts
+// update channels set pts = GREATEST(pts, :pts) where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdatePts(ctx context.Context, pts int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set pts = GREATEST(pts, ?) where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, pts, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdatePts(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdatePts(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set pts = GREATEST(pts, :pts) where id = :id
+// UpdatePtsTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdatePtsTx(tx *sqlx.Tx, pts int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set pts = GREATEST(pts, ?) where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, pts, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdatePts(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdatePts(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,26 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type ChannelMessagesDO struct {
+	Id                int64  `db:"id"`
+	ChannelId         int64  `db:"channel_id"`
+	ChannelMessageId  int32  `db:"channel_message_id"`
+	SenderUserId      int64  `db:"sender_user_id"`
+	RandomId          int64  `db:"random_id"`
+	Pts               int32  `db:"pts"`
+	MessageFilterType int32  `db:"message_filter_type"`
+	MessageData       string `db:"message_data"`
+	Message           string `db:"message"`
+	Views             int32  `db:"views"`
+	Deleted           bool   `db:"deleted"`
+	Date2             int64  `db:"date2"`
+}
@@ -0,0 +1,25 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type ChannelParticipantsDO struct {
+	Id              int64 `db:"id"`
+	ChannelId       int64 `db:"channel_id"`
+	UserId          int64 `db:"user_id"`
+	ParticipantType int32 `db:"participant_type"`
+	InviterUserId   int64 `db:"inviter_user_id"`
+	InvitedAt       int64 `db:"invited_at"`
+	KickedAt        int64 `db:"kicked_at"`
+	LeftAt          int64 `db:"left_at"`
+	ReadInboxMaxId  int32 `db:"read_inbox_max_id"`
+	State           int32 `db:"state"`
+	Date2           int64 `db:"date2"`
+}
@@ -0,0 +1,30 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type ChannelsDO struct {
+	Id                int64  `db:"id"`
+	CreatorUserId     int64  `db:"creator_user_id"`
+	AccessHash        int64  `db:"access_hash"`
+	Broadcast         bool   `db:"broadcast"`
+	Megagroup         bool   `db:"megagroup"`
+	Title             string `db:"title"`
+	About             string `db:"about"`
+	PhotoId           int64  `db:"photo_id"`
+	ParticipantsCount int32  `db:"participants_count"`
+	TopMessage        int32  `db:"top_message"`
+	Pts               int32  `db:"pts"`
+	Signatures        bool   `db:"signatures"`
+	Noforwards        bool   `db:"noforwards"`
+	Deactivated       bool   `db:"deactivated"`
+	Version           int32  `db:"version"`
+	Date              int64  `db:"date"`
+}
@@ -0,0 +1 @@
+gofmt -w *.go
\ No newline at end of file
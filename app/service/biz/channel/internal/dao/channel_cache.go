@@ -0,0 +1,111 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlc"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+const (
+	channelKeyPrefix = "channel"
+)
+
+func genChannelCacheKey(channelId int64) string {
+	return fmt.Sprintf("%s_%d", channelKeyPrefix, channelId)
+}
+
+func (d *Dao) GetChannelCacheKey(channelId int64) string {
+	return genChannelCacheKey(channelId)
+}
+
+func (d *Dao) GetChannelData(ctx context.Context, channelId int64) (*channel.ChannelData, error) {
+	var (
+		channelData *channel.ChannelData
+	)
+
+	err := d.CachedConn.QueryRow(
+		ctx,
+		&channelData,
+		genChannelCacheKey(channelId),
+		func(ctx context.Context, conn *sqlx.DB, v interface{}) error {
+			do, err := d.ChannelsDAO.Select(ctx, channelId)
+			if err != nil {
+				return err
+			} else if do == nil {
+				return sqlc.ErrNotFound
+			}
+
+			data := d.MakeChannelDataByDO(do)
+			if do.PhotoId != 0 {
+				data.Photo, _ = d.MediaClient.MediaGetPhoto(ctx, &media.TLMediaGetPhoto{
+					PhotoId: do.PhotoId,
+				})
+			}
+			*v.(**channel.ChannelData) = data
+
+			return nil
+		})
+
+	if err != nil {
+		if err == sqlc.ErrNotFound {
+			err = mtproto.ErrChannelInvalid
+		}
+		return nil, err
+	}
+
+	return channelData, nil
+}
+
+// GetMutableChannel returns the channel together with the participant records of idList.
+func (d *Dao) GetMutableChannel(ctx context.Context, channelId int64, idList ...int64) (*channel.MutableChannel, error) {
+	channelData, err := d.GetChannelData(ctx, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	mChannel := channel.MakeTLMutableChannel(&channel.MutableChannel{
+		Channel:      channelData,
+		Participants: []*channel.ChannelParticipantData{},
+	}).To_MutableChannel()
+
+	if len(idList) == 0 {
+		return mChannel, nil
+	}
+
+	_, err = d.ChannelParticipantsDAO.SelectListByParticipantIdListWithCB(
+		ctx,
+		channelId,
+		idList,
+		func(i int, v *dataobject.ChannelParticipantsDO) {
+			mChannel.Participants = append(mChannel.Participants, d.MakeChannelParticipantDataByDO(v))
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return mChannel, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func (d *Dao) MakeChannelDataByDO(channelsDO *dataobject.ChannelsDO) *channel.ChannelData {
+	return channel.MakeTLChannelData(&channel.ChannelData{
+		Id:                channelsDO.Id,
+		CreatorId:         channelsDO.CreatorUserId,
+		AccessHash:        channelsDO.AccessHash,
+		Broadcast:         channelsDO.Broadcast,
+		Megagroup:         channelsDO.Megagroup,
+		Deactivated:       channelsDO.Deactivated,
+		Noforwards:        channelsDO.Noforwards,
+		Signatures:        channelsDO.Signatures,
+		Title:             channelsDO.Title,
+		About:             channelsDO.About,
+		Photo:             mtproto.MakeTLPhotoEmpty(nil).To_Photo(),
+		ParticipantsCount: channelsDO.ParticipantsCount,
+		TopMessage:        channelsDO.TopMessage,
+		Pts:               channelsDO.Pts,
+		Date:              int32(channelsDO.Date),
+		Version:           channelsDO.Version,
+	}).To_ChannelData()
+}
+
+func (d *Dao) MakeChannelParticipantDataByDO(participantsDO *dataobject.ChannelParticipantsDO) *channel.ChannelParticipantData {
+	return channel.MakeTLChannelParticipantData(&channel.ChannelParticipantData{
+		ChannelId:       participantsDO.ChannelId,
+		UserId:          participantsDO.UserId,
+		ParticipantType: participantsDO.ParticipantType,
+		InviterUserId:   participantsDO.InviterUserId,
+		InvitedAt:       int32(participantsDO.InvitedAt),
+		KickedAt:        int32(participantsDO.KickedAt),
+		LeftAt:          int32(participantsDO.LeftAt),
+		State:           participantsDO.State,
+		ReadInboxMaxId:  participantsDO.ReadInboxMaxId,
+		Date:            int32(participantsDO.Date2),
+	}).To_ChannelParticipantData()
+}
+
+func (d *Dao) MakeMessageBoxByDO(broadcast bool, boxDO *dataobject.ChannelMessagesDO) *mtproto.MessageBox {
+	box := &mtproto.MessageBox{
+		UserId:            boxDO.SenderUserId,
+		MessageId:         boxDO.ChannelMessageId,
+		SenderUserId:      boxDO.SenderUserId,
+		PeerType:          mtproto.PEER_CHANNEL,
+		PeerId:            boxDO.ChannelId,
+		RandomId:          boxDO.RandomId,
+		DialogMessageId:   int64(boxDO.ChannelMessageId),
+		MessageFilterType: boxDO.MessageFilterType,
+		Message:           nil,
+		Pts:               boxDO.Pts,
+		PtsCount:          1,
+		Views:             boxDO.Views,
+	}
+	jsonx.UnmarshalFromString(boxDO.MessageData, &box.Message)
+	box.Message = box.Message.FixData()
+	if broadcast {
+		box.Message.Views = &types.Int32Value{Value: boxDO.Views}
+	}
+
+	return box
+}
+
+// MakeChannelPtsUpdatesDO makes the channel_pts_updates row of update, every
+// write that moves channels.pts inserts one in the same transaction.
+func (d *Dao) MakeChannelPtsUpdatesDO(channelId int64, pts, ptsCount int32, update *mtproto.Update, date int64) *dataobject.ChannelPtsUpdatesDO {
+	uData, _ := jsonx.Marshal(update)
+	return &dataobject.ChannelPtsUpdatesDO{
+		ChannelId:  channelId,
+		Pts:        pts,
+		PtsCount:   ptsCount,
+		UpdateType: mtproto.GetUpdateType(update),
+		UpdateData: hack.String(uData),
+		Date2:      date,
+	}
+}
+
+// IndexChannelMessage feeds a stored channel message to the search index,
+// channel messages are shared by all participants and indexed under user 0.
+func (d *Dao) IndexChannelMessage(ctx context.Context, do *dataobject.ChannelMessagesDO) {
+	err := d.SearchIndex.Index(ctx, &message_helper.SearchDocument{
+		UserId:    0,
+		PeerType:  mtproto.PEER_CHANNEL,
+		PeerId:    do.ChannelId,
+		MessageId: do.ChannelMessageId,
+		FromId:    do.SenderUserId,
+		MediaType: do.MessageFilterType,
+		Message:   do.Message,
+		Date:      do.Date2,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("indexChannelMessage(%d, %d) - error: %v", do.ChannelId, do.ChannelMessageId, err)
+	}
+}
+
+func (d *Dao) UnindexChannelMessages(ctx context.Context, channelId int64, idList []int32) {
+	err := d.SearchIndex.Delete(ctx, 0, mtproto.PEER_CHANNEL, channelId, idList)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("unindexChannelMessages(%d, %v) - error: %v", channelId, idList, err)
+	}
+}
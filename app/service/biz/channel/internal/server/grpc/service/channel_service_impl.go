@@ -0,0 +1,259 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/core"
+)
+
+// ChannelCreateChannel
+// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string date:int = MutableChannel;
+func (s *Service) ChannelCreateChannel(ctx context.Context, request *channel.TLChannelCreateChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.createChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelCreateChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.createChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetMutableChannel
+// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+func (s *Service) ChannelGetMutableChannel(ctx context.Context, request *channel.TLChannelGetMutableChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.getMutableChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetMutableChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.getMutableChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelListByIdList
+// channel.getChannelListByIdList self_id:long id:Vector<long> = Vector<MutableChannel>;
+func (s *Service) ChannelGetChannelListByIdList(ctx context.Context, request *channel.TLChannelGetChannelListByIdList) (*channel.Vector_MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.getChannelListByIdList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelListByIdList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.getChannelListByIdList - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelJoinChannel
+// channel.joinChannel channel_id:long user_id:long inviter_id:long = MutableChannel;
+func (s *Service) ChannelJoinChannel(ctx context.Context, request *channel.TLChannelJoinChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.joinChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelJoinChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.joinChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelLeaveChannel
+// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+func (s *Service) ChannelLeaveChannel(ctx context.Context, request *channel.TLChannelLeaveChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.leaveChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelLeaveChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.leaveChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelParticipants
+// channel.getChannelParticipants channel_id:long offset:int limit:int = Vector<ChannelParticipantData>;
+func (s *Service) ChannelGetChannelParticipants(ctx context.Context, request *channel.TLChannelGetChannelParticipants) (*channel.Vector_ChannelParticipantData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.getChannelParticipants - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelParticipants(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.getChannelParticipants - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelParticipantIdList
+// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+func (s *Service) ChannelGetChannelParticipantIdList(ctx context.Context, request *channel.TLChannelGetChannelParticipantIdList) (*channel.Vector_Long, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.getChannelParticipantIdList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelParticipantIdList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.getChannelParticipantIdList - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetMyChannelList
+// channel.getMyChannelList user_id:long = Vector<long>;
+func (s *Service) ChannelGetMyChannelList(ctx context.Context, request *channel.TLChannelGetMyChannelList) (*channel.Vector_Long, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.getMyChannelList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetMyChannelList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.getMyChannelList - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelSendChannelMessage
+// channel.sendChannelMessage from_id:long channel_id:long random_id:long message:Message = MessageBox;
+func (s *Service) ChannelSendChannelMessage(ctx context.Context, request *channel.TLChannelSendChannelMessage) (*mtproto.MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.sendChannelMessage - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelSendChannelMessage(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.sendChannelMessage - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelMessageList
+// channel.getChannelMessageList channel_id:long id:Vector<int> = Vector<MessageBox>;
+func (s *Service) ChannelGetChannelMessageList(ctx context.Context, request *channel.TLChannelGetChannelMessageList) (*channel.Vector_MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.getChannelMessageList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelMessageList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.getChannelMessageList - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelHistory
+// channel.getChannelHistory channel_id:long offset_id:int limit:int = Vector<MessageBox>;
+func (s *Service) ChannelGetChannelHistory(ctx context.Context, request *channel.TLChannelGetChannelHistory) (*channel.Vector_MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.getChannelHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.getChannelHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelReadChannelHistory
+// channel.readChannelHistory channel_id:long user_id:long max_id:int = Bool;
+func (s *Service) ChannelReadChannelHistory(ctx context.Context, request *channel.TLChannelReadChannelHistory) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.readChannelHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelReadChannelHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.readChannelHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelEditChannelMessage
+// channel.editChannelMessage from_id:long channel_id:long message:Message = MessageBox;
+func (s *Service) ChannelEditChannelMessage(ctx context.Context, request *channel.TLChannelEditChannelMessage) (*mtproto.MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.editChannelMessage - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelEditChannelMessage(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.editChannelMessage - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelAdminLog
+// channel.getChannelAdminLog channel_id:long user_id:long max_id:long min_id:long limit:int = Vector<ChannelAdminLogEvent>;
+func (s *Service) ChannelGetChannelAdminLog(ctx context.Context, request *channel.TLChannelGetChannelAdminLog) (*channel.Vector_ChannelAdminLogEvent, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.getChannelAdminLog - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelAdminLog(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.getChannelAdminLog - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelSearchChannelMessages
+// channel.searchChannelMessages channel_id:long user_id:long q:string from_id:long offset_id:int min_date:int max_date:int limit:int = Vector<MessageBox>;
+func (s *Service) ChannelSearchChannelMessages(ctx context.Context, request *channel.TLChannelSearchChannelMessages) (*channel.Vector_MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.searchChannelMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelSearchChannelMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.searchChannelMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelDeleteChannelMessages
+// channel.deleteChannelMessages from_id:long channel_id:long id:Vector<int> = messages.AffectedMessages;
+func (s *Service) ChannelDeleteChannelMessages(ctx context.Context, request *channel.TLChannelDeleteChannelMessages) (*mtproto.Messages_AffectedMessages, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("channel.deleteChannelMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelDeleteChannelMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("channel.deleteChannelMessages - reply: %s", r.DebugString())
+	return r, err
+}
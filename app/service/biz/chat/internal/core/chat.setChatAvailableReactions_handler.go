@@ -77,6 +77,7 @@ func (c *ChatCore) ChatSetChatAvailableReactions(in *chat.TLChatSetChatAvailable
 		return nil, err
 	}
 
+	chat2.Chat.AvailableReactionsType = in.AvailableReactionsType
 	chat2.Chat.AvailableReactions = in.AvailableReactions
 	return chat2, nil
 }
@@ -0,0 +1,18 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dialog
+
+const (
+	HistoryTTLMinPeriod = 24 * 60 * 60
+	HistoryTTLMaxPeriod = 365 * 24 * 60 * 60
+)
+
+// CheckHistoryTTLPeriod reports whether period may be used as the auto-delete
+// period of a dialog, 0 turns auto-delete off.
+func CheckHistoryTTLPeriod(period int32) bool {
+	return period == 0 || period >= HistoryTTLMinPeriod && period <= HistoryTTLMaxPeriod
+}
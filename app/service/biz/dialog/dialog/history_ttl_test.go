@@ -0,0 +1,32 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dialog
+
+import (
+	"testing"
+)
+
+func TestCheckHistoryTTLPeriod(t *testing.T) {
+	tests := []struct {
+		period int32
+		ok     bool
+	}{
+		{0, true},
+		{-1, false},
+		{60, false},
+		{HistoryTTLMinPeriod, true},
+		{7 * 24 * 60 * 60, true},
+		{HistoryTTLMaxPeriod, true},
+		{HistoryTTLMaxPeriod + 1, false},
+	}
+
+	for _, tt := range tests {
+		if ok := CheckHistoryTTLPeriod(tt.period); ok != tt.ok {
+			t.Errorf("CheckHistoryTTLPeriod(%d) = %v, want %v", tt.period, ok, tt.ok)
+		}
+	}
+}
@@ -42,6 +42,14 @@ type MessageClient interface {
 	MessageUnPinAllMessages(ctx context.Context, in *message.TLMessageUnPinAllMessages) (*message.Vector_Int, error)
 	MessageGetUnreadMentions(ctx context.Context, in *message.TLMessageGetUnreadMentions) (*message.Vector_MessageBox, error)
 	MessageGetUnreadMentionsCount(ctx context.Context, in *message.TLMessageGetUnreadMentionsCount) (*mtproto.Int32, error)
+	MessageGetMessageReactionsList(ctx context.Context, in *message.TLMessageGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error)
+	MessageCreatePoll(ctx context.Context, in *message.TLMessageCreatePoll) (*mtproto.MessageMedia, error)
+	MessageGetMediaPoll(ctx context.Context, in *message.TLMessageGetMediaPoll) (*mtproto.MessageMedia, error)
+	MessageSendVote(ctx context.Context, in *message.TLMessageSendVote) (*mtproto.MessageMedia, error)
+	MessageClosePoll(ctx context.Context, in *message.TLMessageClosePoll) (*mtproto.MessageMedia, error)
+	MessageGetPollVotes(ctx context.Context, in *message.TLMessageGetPollVotes) (*mtproto.Messages_VotesList, error)
+	MessageGetScheduledMessageHistory(ctx context.Context, in *message.TLMessageGetScheduledMessageHistory) (*message.Vector_MessageBox, error)
+	MessageGetScheduledMessages(ctx context.Context, in *message.TLMessageGetScheduledMessages) (*message.Vector_MessageBox, error)
 }
 
 type defaultMessageClient struct {
@@ -193,3 +201,59 @@ func (m *defaultMessageClient) MessageGetUnreadMentionsCount(ctx context.Context
 	client := message.NewRPCMessageClient(m.cli.Conn())
 	return client.MessageGetUnreadMentionsCount(ctx, in)
 }
+
+// MessageGetMessageReactionsList
+// message.getMessageReactionsList user_id:long msg_id:int reaction:string offset:string limit:int = messages.MessageReactionsList;
+func (m *defaultMessageClient) MessageGetMessageReactionsList(ctx context.Context, in *message.TLMessageGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	client := message.NewRPCMessageClient(m.cli.Conn())
+	return client.MessageGetMessageReactionsList(ctx, in)
+}
+
+// MessageCreatePoll
+// message.createPoll user_id:long poll:Poll correct_answers:Vector<string> solution:string solution_entities:Vector<MessageEntity> = MessageMedia;
+func (m *defaultMessageClient) MessageCreatePoll(ctx context.Context, in *message.TLMessageCreatePoll) (*mtproto.MessageMedia, error) {
+	client := message.NewRPCMessageClient(m.cli.Conn())
+	return client.MessageCreatePoll(ctx, in)
+}
+
+// MessageGetMediaPoll
+// message.getMediaPoll user_id:long poll_id:long = MessageMedia;
+func (m *defaultMessageClient) MessageGetMediaPoll(ctx context.Context, in *message.TLMessageGetMediaPoll) (*mtproto.MessageMedia, error) {
+	client := message.NewRPCMessageClient(m.cli.Conn())
+	return client.MessageGetMediaPoll(ctx, in)
+}
+
+// MessageSendVote
+// message.sendVote user_id:long poll_id:long options:Vector<string> = MessageMedia;
+func (m *defaultMessageClient) MessageSendVote(ctx context.Context, in *message.TLMessageSendVote) (*mtproto.MessageMedia, error) {
+	client := message.NewRPCMessageClient(m.cli.Conn())
+	return client.MessageSendVote(ctx, in)
+}
+
+// MessageClosePoll
+// message.closePoll user_id:long poll_id:long = MessageMedia;
+func (m *defaultMessageClient) MessageClosePoll(ctx context.Context, in *message.TLMessageClosePoll) (*mtproto.MessageMedia, error) {
+	client := message.NewRPCMessageClient(m.cli.Conn())
+	return client.MessageClosePoll(ctx, in)
+}
+
+// MessageGetPollVotes
+// message.getPollVotes user_id:long poll_id:long option:string offset:string limit:int = messages.VotesList;
+func (m *defaultMessageClient) MessageGetPollVotes(ctx context.Context, in *message.TLMessageGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	client := message.NewRPCMessageClient(m.cli.Conn())
+	return client.MessageGetPollVotes(ctx, in)
+}
+
+// MessageGetScheduledMessageHistory
+// message.getScheduledMessageHistory user_id:long peer_type:int peer_id:long = Vector<MessageBox>;
+func (m *defaultMessageClient) MessageGetScheduledMessageHistory(ctx context.Context, in *message.TLMessageGetScheduledMessageHistory) (*message.Vector_MessageBox, error) {
+	client := message.NewRPCMessageClient(m.cli.Conn())
+	return client.MessageGetScheduledMessageHistory(ctx, in)
+}
+
+// MessageGetScheduledMessages
+// message.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<MessageBox>;
+func (m *defaultMessageClient) MessageGetScheduledMessages(ctx context.Context, in *message.TLMessageGetScheduledMessages) (*message.Vector_MessageBox, error) {
+	client := message.NewRPCMessageClient(m.cli.Conn())
+	return client.MessageGetScheduledMessages(ctx, in)
+}
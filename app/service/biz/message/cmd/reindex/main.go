@@ -0,0 +1,97 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// reindex backfills message_search_index from the messages and
+// channel_messages tables, run it once after upgrading to the search index:
+//
+//	reindex -f ../etc/biz.yaml
+//
+// An interrupted run is resumed with -table and -from.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dao/mysql_dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	configFile = flag.String("f", "etc/message.yaml", "the config file")
+	table      = flag.String("table", "", "reindex this table only")
+	fromId     = flag.Int64("from", 0, "start after this row id")
+	batchSize  = flag.Int("batch", 500, "rows per batch")
+)
+
+type Config struct {
+	Mysql           sqlx.Config
+	MessageSharding int `json:",default=1"`
+	SearchIndex     searchindex.Config
+}
+
+func main() {
+	flag.Parse()
+
+	var c Config
+	conf.MustLoad(*configFile, &c)
+
+	var (
+		ctx     = context.Background()
+		db      = sqlx.NewMySQL(&c.Mysql)
+		idx     = searchindex.MustNew(c.SearchIndex, db)
+		sources []searchindex.Source
+	)
+
+	for _, name := range mysql_dao.NewMessagesDAO(db, c.MessageSharding).CalcTableNameList() {
+		sources = append(sources, searchindex.MessagesSource(name))
+	}
+	sources = append(sources, searchindex.ChannelMessagesSource())
+
+	for _, src := range sources {
+		if *table != "" && src.Table != *table {
+			continue
+		}
+
+		var (
+			from  int64
+			total int
+		)
+		if *table != "" {
+			from = *fromId
+		}
+
+		lastId, err := searchindex.Reindex(ctx, idx, db, src, from, *batchSize, func(lastId int64, n int) {
+			total += n
+			logx.Infof("reindex %s - %d rows, last id %d", src.Table, total, lastId)
+		})
+		if err != nil {
+			logx.Errorf("reindex %s - error: %v, resume with -table %s -from %d", src.Table, err, src.Table, lastId)
+			logx.Close()
+			os.Exit(1)
+		}
+		logx.Infof("reindex %s - done, %d rows", src.Table, total)
+	}
+
+	logx.Close()
+}
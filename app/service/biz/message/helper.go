@@ -14,7 +14,9 @@ import (
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/config"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dao/mysql_dao"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dao"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/plugin"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/server/grpc/service"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/svc"
 )
@@ -28,8 +30,16 @@ func New(c Config, plugin plugin.MessagePlugin) *service.Service {
 }
 
 type (
-	MessagesDAO = mysql_dao.MessagesDAO
-	MessagesDO  = dataobject.MessagesDO
+	MessagesDAO           = mysql_dao.MessagesDAO
+	MessagesDO            = dataobject.MessagesDO
+	MessageEditHistoryDAO = mysql_dao.MessageEditHistoryDAO
+	MessageEditHistoryDO  = dataobject.MessageEditHistoryDO
+	MessageReactionsDAO   = mysql_dao.MessageReactionsDAO
+	MessageReactionsDO    = dataobject.MessageReactionsDO
+	SearchIndex           = searchindex.Index
+	SearchIndexConfig     = searchindex.Config
+	SearchDocument        = searchindex.Document
+	SearchQuery           = searchindex.Query
 	// ChannelMessagesDAO   = mysql_dao.ChannelMessagesDAO
 	// ChannelMessagesDO    = dataobject.ChannelMessagesDO
 	// ScheduledMessagesDAO = mysql_dao.ScheduledMessagesDAO
@@ -37,7 +47,11 @@ type (
 )
 
 var (
-	NewMessagesDAO = mysql_dao.NewMessagesDAO
+	NewMessagesDAO           = mysql_dao.NewMessagesDAO
+	NewMessageEditHistoryDAO = mysql_dao.NewMessageEditHistoryDAO
+	NewMessageReactionsDAO   = mysql_dao.NewMessageReactionsDAO
+	MakeMessageReactions     = dao.MakeMessageReactions
+	MustNewSearchIndex       = searchindex.MustNew
 	// NewChannelMessagesDAO   = mysql_dao.NewChannelMessagesDAO
 	// NewScheduledMessagesDAO = mysql_dao.NewScheduledMessagesDAO
 )
@@ -20,6 +20,7 @@ package config
 
 import (
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -29,4 +30,5 @@ type Config struct {
 	Mysql           sqlx.Config
 	Cache           cache.CacheConf
 	MessageSharding int `json:",default=1"`
+	SearchIndex     searchindex.Config
 }
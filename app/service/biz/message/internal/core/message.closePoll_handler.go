@@ -0,0 +1,46 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessageClosePoll
+// message.closePoll user_id:long poll_id:long = MessageMedia;
+func (c *MessageCore) MessageClosePoll(in *message.TLMessageClosePoll) (*mtproto.MessageMedia, error) {
+	pollDO, err := c.svcCtx.Dao.PollsDAO.Select(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("message.closePoll - error: %v", err)
+		return nil, err
+	} else if pollDO == nil {
+		err = mtproto.ErrMediaInvalid
+		c.Logger.Errorf("message.closePoll - error: %v", err)
+		return nil, err
+	}
+
+	if pollDO.CreatorUserId != in.UserId {
+		err = mtproto.ErrMessageAuthorRequired
+		c.Logger.Errorf("message.closePoll - error: %v", err)
+		return nil, err
+	}
+
+	if !pollDO.Closed {
+		if _, err = c.svcCtx.Dao.PollsDAO.UpdateClosed(c.ctx, true, in.PollId); err != nil {
+			c.Logger.Errorf("message.closePoll - error: %v", err)
+			return nil, err
+		}
+	}
+
+	return c.svcCtx.Dao.GetMediaPoll(c.ctx, in.UserId, in.PollId, time.Now().Unix())
+}
@@ -0,0 +1,138 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+const (
+	pollQuestionMaxLen = 255
+	pollAnswersMin     = 2
+	pollAnswersMax     = 10
+	pollAnswerMaxLen   = 100
+	pollOptionMaxLen   = 100
+	pollSolutionMaxLen = 200
+	pollClosePeriodMax = 600
+)
+
+// MessageCreatePoll
+// message.createPoll user_id:long poll:Poll correct_answers:Vector<string> solution:string solution_entities:Vector<MessageEntity> = MessageMedia;
+func (c *MessageCore) MessageCreatePoll(in *message.TLMessageCreatePoll) (*mtproto.MessageMedia, error) {
+	var (
+		poll = in.GetPoll()
+		now  = time.Now().Unix()
+	)
+
+	// only quizzes have correct answers and a solution
+	if !poll.GetQuiz() {
+		in.CorrectAnswers = nil
+		in.Solution = ""
+		in.SolutionEntities = nil
+	}
+
+	if err := checkPoll(poll, in.CorrectAnswers, in.Solution); err != nil {
+		c.Logger.Errorf("message.createPoll - error: %v", err)
+		return nil, err
+	}
+
+	pollDO := &dataobject.PollsDO{
+		CreatorUserId:    in.UserId,
+		Question:         poll.Question,
+		Answers:          "[]",
+		Closed:           poll.Closed,
+		PublicVoters:     poll.PublicVoters,
+		MultipleChoice:   poll.MultipleChoice,
+		Quiz:             poll.Quiz,
+		ClosePeriod:      poll.GetClosePeriod().GetValue(),
+		CloseDate:        int64(poll.GetCloseDate().GetValue()),
+		CorrectAnswers:   "[]",
+		Solution:         in.Solution,
+		SolutionEntities: "[]",
+		Date2:            now,
+	}
+	if pollDO.ClosePeriod > 0 {
+		pollDO.CloseDate = now + int64(pollDO.ClosePeriod)
+	}
+	pollDO.Answers, _ = jsonx.MarshalToString(poll.Answers)
+	if len(in.CorrectAnswers) > 0 {
+		pollDO.CorrectAnswers, _ = jsonx.MarshalToString(in.CorrectAnswers)
+	}
+	if len(in.SolutionEntities) > 0 {
+		pollDO.SolutionEntities, _ = jsonx.MarshalToString(in.SolutionEntities)
+	}
+
+	pollId, _, err := c.svcCtx.Dao.PollsDAO.Insert(c.ctx, pollDO)
+	if err != nil {
+		c.Logger.Errorf("message.createPoll - error: %v", err)
+		return nil, err
+	}
+	pollDO.Id = pollId
+
+	return dao.MakeMediaPoll(in.UserId, pollDO, nil, now), nil
+}
+
+func checkPoll(poll *mtproto.Poll, correctAnswers []string, solution string) error {
+	if poll == nil {
+		return mtproto.ErrMediaInvalid
+	}
+	if poll.Question == "" || len([]rune(poll.Question)) > pollQuestionMaxLen {
+		return mtproto.ErrPollQuestionInvalid
+	}
+	if len(poll.Answers) < pollAnswersMin || len(poll.Answers) > pollAnswersMax {
+		return mtproto.ErrPollAnswersInvalid
+	}
+
+	options := make(map[string]bool, len(poll.Answers))
+	for _, answer := range poll.Answers {
+		if answer.Text == "" || len([]rune(answer.Text)) > pollAnswerMaxLen {
+			return mtproto.ErrPollAnswerInvalid
+		}
+		if len(answer.Option) == 0 || len(answer.Option) > pollOptionMaxLen {
+			return mtproto.ErrPollOptionInvalid
+		}
+		if options[string(answer.Option)] {
+			return mtproto.ErrPollOptionDuplicate
+		}
+		options[string(answer.Option)] = true
+	}
+
+	if poll.GetClosePeriod().GetValue() < 0 || poll.GetClosePeriod().GetValue() > pollClosePeriodMax {
+		return mtproto.ErrPollUnsupported
+	}
+
+	if poll.Quiz {
+		if poll.MultipleChoice {
+			return mtproto.ErrQuizMultipleInvalid
+		}
+		switch len(correctAnswers) {
+		case 0:
+			return mtproto.ErrQuizCorrectAnswersEmpty
+		case 1:
+			if !options[correctAnswers[0]] {
+				return mtproto.ErrQuizCorrectAnswerInvalid
+			}
+		default:
+			return mtproto.ErrQuizCorrectAnswersTooMuch
+		}
+		if len([]rune(solution)) > pollSolutionMaxLen {
+			return mtproto.ErrPollUnsupported
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessageGetMediaPoll
+// message.getMediaPoll user_id:long poll_id:long = MessageMedia;
+func (c *MessageCore) MessageGetMediaPoll(in *message.TLMessageGetMediaPoll) (*mtproto.MessageMedia, error) {
+	media, err := c.svcCtx.Dao.GetMediaPoll(c.ctx, in.UserId, in.PollId, time.Now().Unix())
+	if err != nil {
+		c.Logger.Errorf("message.getMediaPoll - error: %v", err)
+		return nil, err
+	}
+
+	return media, nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// MessageGetMessageReactionsList
+// message.getMessageReactionsList user_id:long msg_id:int reaction:string offset:string limit:int = messages.MessageReactionsList;
+func (c *MessageCore) MessageGetMessageReactionsList(in *message.TLMessageGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	boxDO, err := c.svcCtx.Dao.MessagesDAO.SelectByMessageId(c.ctx, in.UserId, in.MsgId)
+	if err != nil {
+		c.Logger.Errorf("message.getMessageReactionsList - error: %v", err)
+		return nil, err
+	} else if boxDO == nil {
+		err = mtproto.ErrMsgIdInvalid
+		c.Logger.Errorf("message.getMessageReactionsList - error: %v", err)
+		return nil, err
+	}
+
+	rValues := mtproto.MakeTLMessagesMessageReactionsList(&mtproto.Messages_MessageReactionsList{
+		Count:      0,
+		Reactions:  []*mtproto.MessagePeerReaction{},
+		Chats:      []*mtproto.Chat{},
+		Users:      []*mtproto.User{},
+		NextOffset: nil,
+	}).To_Messages_MessageReactionsList()
+
+	if !boxDO.HasReaction {
+		return rValues, nil
+	}
+
+	doList, err := c.svcCtx.Dao.MessageReactionsDAO.SelectList(c.ctx, boxDO.DialogMessageId)
+	if err != nil {
+		c.Logger.Errorf("message.getMessageReactionsList - error: %v", err)
+		return nil, err
+	}
+
+	// offset is the index of the next reaction, as returned in next_offset
+	offset, _ := strconv.Atoi(in.Offset)
+	limit := int(in.Limit)
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	for _, do := range doList {
+		if in.Reaction != "" && do.Reaction != in.Reaction {
+			continue
+		}
+		rValues.Count++
+		if int(rValues.Count) <= offset || len(rValues.Reactions) >= limit {
+			continue
+		}
+		rValues.Reactions = append(rValues.Reactions, mtproto.MakeTLMessagePeerReaction(&mtproto.MessagePeerReaction{
+			Big:               do.Big,
+			Unread:            boxDO.ReactionUnread && do.UserId != in.UserId,
+			PeerId:            mtproto.MakePeerUser(do.UserId),
+			Reaction_REACTION: mtproto.FromReaction(do.Reaction),
+		}).To_MessagePeerReaction())
+	}
+
+	if next := offset + len(rValues.Reactions); next < int(rValues.Count) {
+		rValues.NextOffset = &types.StringValue{Value: strconv.Itoa(next)}
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,136 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// MessageGetPollVotes
+// message.getPollVotes user_id:long poll_id:long option:string offset:string limit:int = messages.VotesList;
+func (c *MessageCore) MessageGetPollVotes(in *message.TLMessageGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	pollDO, err := c.svcCtx.Dao.PollsDAO.Select(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("message.getPollVotes - error: %v", err)
+		return nil, err
+	} else if pollDO == nil {
+		err = mtproto.ErrMediaInvalid
+		c.Logger.Errorf("message.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	// voters of anonymous polls are never listed
+	if !pollDO.PublicVoters {
+		err = mtproto.ErrBroadcastForbidden
+		c.Logger.Errorf("message.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	voteList, err := c.svcCtx.Dao.PollVotesDAO.SelectList(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("message.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	// the creator may always look, everyone else once they voted or the poll is closed
+	if pollDO.CreatorUserId != in.UserId && !dao.IsPollClosed(pollDO, time.Now().Unix()) {
+		voted := false
+		for _, v := range voteList {
+			if v.VoteUserId == in.UserId {
+				voted = true
+				break
+			}
+		}
+		if !voted {
+			err = mtproto.ErrPollVoteRequired
+			c.Logger.Errorf("message.getPollVotes - error: %v", err)
+			return nil, err
+		}
+	}
+
+	var (
+		votes []*mtproto.MessageUserVote
+	)
+	if in.Option != "" {
+		for _, v := range voteList {
+			if v.AnswerOption != in.Option {
+				continue
+			}
+			votes = append(votes, mtproto.MakeTLMessageUserVote(&mtproto.MessageUserVote{
+				UserId: v.VoteUserId,
+				Option: []byte(v.AnswerOption),
+				Date:   int32(v.Date2),
+			}).To_MessageUserVote())
+		}
+	} else {
+		// one entry per voter, in the order of their latest vote
+		var (
+			voterIdList []int64
+			userOptions = make(map[int64][][]byte)
+			userDates   = make(map[int64]int32)
+		)
+		for _, v := range voteList {
+			if _, ok := userOptions[v.VoteUserId]; !ok {
+				voterIdList = append(voterIdList, v.VoteUserId)
+				userDates[v.VoteUserId] = int32(v.Date2)
+			}
+			userOptions[v.VoteUserId] = append(userOptions[v.VoteUserId], []byte(v.AnswerOption))
+		}
+		for _, id := range voterIdList {
+			if options := userOptions[id]; len(options) == 1 {
+				votes = append(votes, mtproto.MakeTLMessageUserVote(&mtproto.MessageUserVote{
+					UserId: id,
+					Option: options[0],
+					Date:   userDates[id],
+				}).To_MessageUserVote())
+			} else {
+				votes = append(votes, mtproto.MakeTLMessageUserVoteMultiple(&mtproto.MessageUserVote{
+					UserId:  id,
+					Options: options,
+					Date:    userDates[id],
+				}).To_MessageUserVote())
+			}
+		}
+	}
+
+	// offset is the index of the next vote, as returned in next_offset
+	offset, _ := strconv.Atoi(in.Offset)
+	limit := int(in.Limit)
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	rValues := mtproto.MakeTLMessagesVotesList(&mtproto.Messages_VotesList{
+		Count:      int32(len(votes)),
+		Votes:      []*mtproto.MessageUserVote{},
+		Users:      []*mtproto.User{},
+		NextOffset: nil,
+	}).To_Messages_VotesList()
+
+	if offset < len(votes) {
+		end := offset + limit
+		if end > len(votes) {
+			end = len(votes)
+		}
+		rValues.Votes = votes[offset:end]
+		if end < len(votes) {
+			rValues.NextOffset = &types.StringValue{Value: strconv.Itoa(end)}
+		}
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,24 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessageGetScheduledMessageHistory
+// message.getScheduledMessageHistory user_id:long peer_type:int peer_id:long = Vector<MessageBox>;
+func (c *MessageCore) MessageGetScheduledMessageHistory(in *message.TLMessageGetScheduledMessageHistory) (*message.Vector_MessageBox, error) {
+	rValueList := &message.Vector_MessageBox{
+		Datas: c.svcCtx.Dao.GetScheduledMessageBoxList(c.ctx, in.UserId, in.PeerType, in.PeerId),
+	}
+
+	return rValueList, nil
+}
@@ -0,0 +1,37 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessageGetScheduledMessages
+// message.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<MessageBox>;
+func (c *MessageCore) MessageGetScheduledMessages(in *message.TLMessageGetScheduledMessages) (*message.Vector_MessageBox, error) {
+	rValueList := &message.Vector_MessageBox{
+		Datas: make([]*mtproto.MessageBox, 0, len(in.Id)),
+	}
+
+	c.svcCtx.Dao.ScheduledMessagesDAO.SelectByIdListWithCB(
+		c.ctx,
+		in.UserId,
+		in.PeerType,
+		in.PeerId,
+		in.Id,
+		func(i int, v *dataobject.ScheduledMessagesDO) {
+			rValueList.Datas = append(rValueList.GetDatas(), dao.MakeScheduledMessageBox(v))
+		})
+
+	return rValueList, nil
+}
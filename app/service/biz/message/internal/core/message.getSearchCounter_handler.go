@@ -11,6 +11,7 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 )
 
@@ -18,21 +19,26 @@ import (
 // message.getSearchCounter user_id:long peer_type:int peer_id:long media_type:int = Int32;
 func (c *MessageCore) MessageGetSearchCounter(in *message.TLMessageGetSearchCounter) (*mtproto.Int32, error) {
 	var (
-		dialogId = mtproto.MakeDialogId(in.UserId, in.PeerType, in.PeerId)
+		peerType = in.PeerType
+		peerId   = in.PeerId
 	)
 
-	sz := c.svcCtx.Dao.CommonDAO.CalcSize(
-		c.ctx,
-		c.svcCtx.Dao.MessagesDAO.CalcTableName(in.UserId),
-		map[string]interface{}{
-			"user_id":             in.UserId,
-			"dialog_id1":          dialogId.A,
-			"dialog_id2":          dialogId.B,
-			"message_filter_type": in.MediaType,
-			"deleted":             0,
-		})
+	if peerType == mtproto.PEER_SELF {
+		peerType, peerId = mtproto.PEER_USER, in.UserId
+	}
+
+	sz, err := c.svcCtx.Dao.SearchIndex.Count(c.ctx, &searchindex.Query{
+		UserId:    in.UserId,
+		PeerType:  peerType,
+		PeerId:    peerId,
+		MediaType: in.MediaType,
+	})
+	if err != nil {
+		c.Logger.Errorf("message.getSearchCounter - error: %v", err)
+		return nil, err
+	}
 
 	return &mtproto.Int32{
-		V: int32(sz),
+		V: sz,
 	}, nil
 }
@@ -13,7 +13,7 @@ import (
 	"math"
 
 	"github.com/teamgram/proto/mtproto"
-	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 )
 
@@ -29,15 +29,12 @@ func (c *MessageCore) MessageSearchGlobal(in *message.TLMessageSearchGlobal) (*m
 		offset = math.MaxInt32
 	}
 
-	rList, _ := c.svcCtx.Dao.MessagesDAO.SearchGlobalWithCB(
-		c.ctx,
-		in.UserId,
-		offset, "%"+in.Q+"%",
-		in.Limit,
-		func(i int, v *dataobject.MessagesDO) {
-			rValues = append(rValues, c.svcCtx.Dao.MakeMessageBox(c.ctx, in.UserId, v))
-		})
-	_ = rList
+	rValues = c.svcCtx.Dao.SearchMessageBoxList(c.ctx, &searchindex.Query{
+		UserId:   in.UserId,
+		Q:        in.Q,
+		OffsetId: offset,
+		Limit:    in.Limit,
+	})
 
 	if rValues == nil {
 		rValues = []*mtproto.MessageBox{}
@@ -21,6 +21,7 @@ package core
 import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 	"math"
 )
@@ -28,7 +29,7 @@ import (
 // MessageSearchV2
 // message.searchV2 user_id:long peer_type:int peer_id:long q:string from_id:long min_date:int max_date:int offset_id:int add_offset:int limit:int max_id:int min_id:int hash:long = Vector<MessageBox>;
 func (c *MessageCore) MessageSearchV2(in *message.TLMessageSearchV2) (*message.Vector_MessageBox, error) {
-	if in.FromId == 0 {
+	if in.FromId == 0 && (in.Q == "" || in.Q[0] == '#') {
 		return c.MessageSearch(&message.TLMessageSearch{
 			UserId:   in.UserId,
 			PeerType: in.PeerType,
@@ -39,6 +40,36 @@ func (c *MessageCore) MessageSearchV2(in *message.TLMessageSearchV2) (*message.V
 		})
 	}
 
+	if in.Q != "" {
+		var (
+			limit    = in.Limit
+			peerType = in.PeerType
+			peerId   = in.PeerId
+		)
+
+		if limit > 50 {
+			limit = 50
+		}
+		if peerType == mtproto.PEER_SELF {
+			peerType, peerId = mtproto.PEER_USER, in.UserId
+		}
+
+		return &message.Vector_MessageBox{
+			Datas: c.svcCtx.Dao.SearchMessageBoxList(c.ctx, &searchindex.Query{
+				UserId:   in.UserId,
+				PeerType: peerType,
+				PeerId:   peerId,
+				FromId:   in.FromId,
+				Q:        in.Q,
+				OffsetId: in.OffsetId,
+				MinId:    in.MinId,
+				MinDate:  int64(in.MinDate),
+				MaxDate:  int64(in.MaxDate),
+				Limit:    limit,
+			}),
+		}, nil
+	}
+
 	var (
 		//offset  = in.Offset
 		//q       = in.Q
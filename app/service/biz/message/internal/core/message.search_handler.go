@@ -14,6 +14,7 @@ import (
 
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 )
 
@@ -34,6 +35,11 @@ func (c *MessageCore) MessageSearch(in *message.TLMessageSearch) (*message.Vecto
 	if limit > 50 {
 		limit = 50
 	}
+	if q == "" {
+		return &message.Vector_MessageBox{
+			Datas: []*mtproto.MessageBox{},
+		}, nil
+	}
 
 	switch in.PeerType {
 	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
@@ -55,18 +61,18 @@ func (c *MessageCore) MessageSearch(in *message.TLMessageSearch) (*message.Vecto
 					})
 			}
 		} else {
-			dialogId := mtproto.MakeDialogId(in.UserId, in.PeerType, in.PeerId)
-			c.svcCtx.Dao.MessagesDAO.SearchWithCB(
-				c.ctx,
-				in.UserId,
-				dialogId.A,
-				dialogId.B,
-				in.Offset,
-				"%"+q+"%",
-				in.Limit,
-				func(i int, v *dataobject.MessagesDO) {
-					boxList = append(boxList, c.svcCtx.Dao.MakeMessageBox(c.ctx, in.UserId, v))
-				})
+			peerType, peerId := in.PeerType, in.PeerId
+			if peerType == mtproto.PEER_SELF {
+				peerType, peerId = mtproto.PEER_USER, in.UserId
+			}
+			boxList = c.svcCtx.Dao.SearchMessageBoxList(c.ctx, &searchindex.Query{
+				UserId:   in.UserId,
+				PeerType: peerType,
+				PeerId:   peerId,
+				Q:        q,
+				OffsetId: offset,
+				Limit:    limit,
+			})
 		}
 	case mtproto.PEER_CHANNEL:
 		c.Logger.Errorf("message.search blocked, License key from https://teamgram.net required to unlock enterprise features.")
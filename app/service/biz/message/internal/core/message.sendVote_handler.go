@@ -0,0 +1,102 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessageSendVote
+// message.sendVote user_id:long poll_id:long options:Vector<string> = MessageMedia;
+func (c *MessageCore) MessageSendVote(in *message.TLMessageSendVote) (*mtproto.MessageMedia, error) {
+	now := time.Now().Unix()
+
+	pollDO, err := c.svcCtx.Dao.PollsDAO.Select(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("message.sendVote - error: %v", err)
+		return nil, err
+	} else if pollDO == nil {
+		err = mtproto.ErrMediaInvalid
+		c.Logger.Errorf("message.sendVote - error: %v", err)
+		return nil, err
+	}
+
+	if dao.IsPollClosed(pollDO, now) {
+		err = mtproto.ErrMessagePollClosed
+		c.Logger.Errorf("message.sendVote - error: %v", err)
+		return nil, err
+	}
+
+	if len(in.Options) > 1 && !pollDO.MultipleChoice {
+		err = mtproto.ErrOptionsTooMuch
+		c.Logger.Errorf("message.sendVote - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		poll    = dao.MakePoll(pollDO, now)
+		options = make([]string, 0, len(in.Options))
+		seen    = make(map[string]bool, len(in.Options))
+	)
+	for _, option := range in.Options {
+		if !isPollOption(poll, option) {
+			err = mtproto.ErrOptionInvalid
+			c.Logger.Errorf("message.sendVote - error: %v", err)
+			return nil, err
+		}
+		if !seen[option] {
+			seen[option] = true
+			options = append(options, option)
+		}
+	}
+
+	if pollDO.Quiz {
+		// a quiz answer is final
+		voteList, err := c.svcCtx.Dao.PollVotesDAO.SelectList(c.ctx, in.PollId)
+		if err != nil {
+			c.Logger.Errorf("message.sendVote - error: %v", err)
+			return nil, err
+		}
+		for _, v := range voteList {
+			if v.VoteUserId == in.UserId {
+				err = mtproto.ErrRevoteNotAllowed
+				c.Logger.Errorf("message.sendVote - error: %v", err)
+				return nil, err
+			}
+		}
+		if len(options) == 0 {
+			err = mtproto.ErrRevoteNotAllowed
+			c.Logger.Errorf("message.sendVote - error: %v", err)
+			return nil, err
+		}
+	}
+
+	err = c.svcCtx.Dao.SetPollVotes(c.ctx, in.PollId, in.UserId, options, now)
+	if err != nil {
+		c.Logger.Errorf("message.sendVote - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.GetMediaPoll(c.ctx, in.UserId, in.PollId, now)
+}
+
+func isPollOption(poll *mtproto.Poll, option string) bool {
+	for _, answer := range poll.Answers {
+		if string(answer.Option) == option {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,3 +2,8 @@
 ./dalgen.sh chats
 ./dalgen.sh hash_tags
 ./dalgen.sh messages
+./dalgen.sh message_edit_history
+./dalgen.sh message_reactions
+./dalgen.sh polls
+./dalgen.sh poll_votes
+./dalgen.sh scheduled_messages
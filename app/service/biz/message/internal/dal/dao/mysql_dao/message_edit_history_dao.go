@@ -0,0 +1,179 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type MessageEditHistoryDAO struct {
+	db *sqlx.DB
+}
+
+func NewMessageEditHistoryDAO(db *sqlx.DB) *MessageEditHistoryDAO {
+	return &MessageEditHistoryDAO{db}
+}
+
+// Insert
+// insert into message_edit_history(user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date) values (:user_id, :peer_type, :peer_id, :message_id, :editor_user_id, :prev_message_data, :message_data, :edit_date)
+// TODO(@benqi): sqlmap
+func (dao *MessageEditHistoryDAO) Insert(ctx context.Context, do *dataobject.MessageEditHistoryDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into message_edit_history(user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date) values (:user_id, :peer_type, :peer_id, :message_id, :editor_user_id, :prev_message_data, :message_data, :edit_date)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into message_edit_history(user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date) values (:user_id, :peer_type, :peer_id, :message_id, :editor_user_id, :prev_message_data, :message_data, :edit_date)
+// TODO(@benqi): sqlmap
+func (dao *MessageEditHistoryDAO) InsertTx(tx *sqlx.Tx, do *dataobject.MessageEditHistoryDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into message_edit_history(user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date) values (:user_id, :peer_type, :peer_id, :message_id, :editor_user_id, :prev_message_data, :message_data, :edit_date)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByMessageId
+// select id, user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date from message_edit_history where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and message_id = :message_id order by id asc
+// TODO(@benqi): sqlmap
+func (dao *MessageEditHistoryDAO) SelectByMessageId(ctx context.Context, user_id int64, peer_type int32, peer_id int64, message_id int32) (rList []dataobject.MessageEditHistoryDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date from message_edit_history where user_id = ? and peer_type = ? and peer_id = ? and message_id = ? order by id asc"
+		values []dataobject.MessageEditHistoryDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, peer_type, peer_id, message_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByMessageId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByMessageIdWithCB
+// select id, user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date from message_edit_history where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and message_id = :message_id order by id asc
+// TODO(@benqi): sqlmap
+func (dao *MessageEditHistoryDAO) SelectByMessageIdWithCB(ctx context.Context, user_id int64, peer_type int32, peer_id int64, message_id int32, cb func(i int, v *dataobject.MessageEditHistoryDO)) (rList []dataobject.MessageEditHistoryDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date from message_edit_history where user_id = ? and peer_type = ? and peer_id = ? and message_id = ? order by id asc"
+		values []dataobject.MessageEditHistoryDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, peer_type, peer_id, message_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByMessageId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectByPeerOffsetLimit
+// select id, user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date from message_edit_history where peer_type = :peer_type and peer_id = :peer_id and id < :max_id and id > :min_id order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessageEditHistoryDAO) SelectByPeerOffsetLimit(ctx context.Context, peer_type int32, peer_id int64, max_id int64, min_id int64, limit int32) (rList []dataobject.MessageEditHistoryDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date from message_edit_history where peer_type = ? and peer_id = ? and id < ? and id > ? order by id desc limit ?"
+		values []dataobject.MessageEditHistoryDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, peer_type, peer_id, max_id, min_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByPeerOffsetLimit(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByPeerOffsetLimitWithCB
+// select id, user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date from message_edit_history where peer_type = :peer_type and peer_id = :peer_id and id < :max_id and id > :min_id order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessageEditHistoryDAO) SelectByPeerOffsetLimitWithCB(ctx context.Context, peer_type int32, peer_id int64, max_id int64, min_id int64, limit int32, cb func(i int, v *dataobject.MessageEditHistoryDO)) (rList []dataobject.MessageEditHistoryDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, message_id, editor_user_id, prev_message_data, message_data, edit_date from message_edit_history where peer_type = ? and peer_id = ? and id < ? and id > ? order by id desc limit ?"
+		values []dataobject.MessageEditHistoryDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, peer_type, peer_id, max_id, min_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByPeerOffsetLimit(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,249 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type MessageReactionsDAO struct {
+	db *sqlx.DB
+}
+
+func NewMessageReactionsDAO(db *sqlx.DB) *MessageReactionsDAO {
+	return &MessageReactionsDAO{db}
+}
+
+// InsertOrUpdate
+// insert into message_reactions(dialog_message_id, user_id, reaction, big, chosen_order, date2, deleted) values (:dialog_message_id, :user_id, :reaction, :big, :chosen_order, :date2, 0) on duplicate key update big = values(big), chosen_order = values(chosen_order), date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.MessageReactionsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into message_reactions(dialog_message_id, user_id, reaction, big, chosen_order, date2, deleted) values (:dialog_message_id, :user_id, :reaction, :big, :chosen_order, :date2, 0) on duplicate key update big = values(big), chosen_order = values(chosen_order), date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into message_reactions(dialog_message_id, user_id, reaction, big, chosen_order, date2, deleted) values (:dialog_message_id, :user_id, :reaction, :big, :chosen_order, :date2, 0) on duplicate key update big = values(big), chosen_order = values(chosen_order), date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.MessageReactionsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into message_reactions(dialog_message_id, user_id, reaction, big, chosen_order, date2, deleted) values (:dialog_message_id, :user_id, :reaction, :big, :chosen_order, :date2, 0) on duplicate key update big = values(big), chosen_order = values(chosen_order), date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// DeleteByUserId
+// update message_reactions set deleted = 1 where dialog_message_id = :dialog_message_id and user_id = :user_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) DeleteByUserId(ctx context.Context, dialog_message_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update message_reactions set deleted = 1 where dialog_message_id = ? and user_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, dialog_message_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteByUserId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteByUserId(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByUserIdTx
+// update message_reactions set deleted = 1 where dialog_message_id = :dialog_message_id and user_id = :user_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) DeleteByUserIdTx(tx *sqlx.Tx, dialog_message_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update message_reactions set deleted = 1 where dialog_message_id = ? and user_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, dialog_message_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteByUserId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteByUserId(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByUserIdNotInReactions
+// update message_reactions set deleted = 1 where dialog_message_id = :dialog_message_id and user_id = :user_id and reaction not in (:reactionList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) DeleteByUserIdNotInReactions(ctx context.Context, dialog_message_id int64, user_id int64, reactionList []string) (rowsAffected int64, err error) {
+	var (
+		query   = "update message_reactions set deleted = 1 where dialog_message_id = ? and user_id = ? and reaction not in (?) and deleted = 0"
+		a       []interface{}
+		rResult sql.Result
+	)
+
+	if len(reactionList) == 0 {
+		return
+	}
+
+	query, a, err = sqlx.In(query, dialog_message_id, user_id, reactionList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in DeleteByUserIdNotInReactions(_), error: %v", err)
+		return
+	}
+	rResult, err = dao.db.Exec(ctx, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteByUserIdNotInReactions(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteByUserIdNotInReactions(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByUserIdNotInReactionsTx
+// update message_reactions set deleted = 1 where dialog_message_id = :dialog_message_id and user_id = :user_id and reaction not in (:reactionList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) DeleteByUserIdNotInReactionsTx(tx *sqlx.Tx, dialog_message_id int64, user_id int64, reactionList []string) (rowsAffected int64, err error) {
+	var (
+		query   = "update message_reactions set deleted = 1 where dialog_message_id = ? and user_id = ? and reaction not in (?) and deleted = 0"
+		a       []interface{}
+		rResult sql.Result
+	)
+
+	if len(reactionList) == 0 {
+		return
+	}
+
+	query, a, err = sqlx.In(query, dialog_message_id, user_id, reactionList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(tx.Context()).Errorf("sqlx.In in DeleteByUserIdNotInReactions(_), error: %v", err)
+		return
+	}
+	rResult, err = tx.Exec(query, a...)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteByUserIdNotInReactions(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteByUserIdNotInReactions(_), error: %v", err)
+	}
+
+	return
+}
+
+// SelectList
+// select id, dialog_message_id, user_id, reaction, big, chosen_order, date2 from message_reactions where dialog_message_id = :dialog_message_id and deleted = 0 order by date2 desc, id desc
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) SelectList(ctx context.Context, dialog_message_id int64) (rList []dataobject.MessageReactionsDO, err error) {
+	var (
+		query  = "select id, dialog_message_id, user_id, reaction, big, chosen_order, date2 from message_reactions where dialog_message_id = ? and deleted = 0 order by date2 desc, id desc"
+		values []dataobject.MessageReactionsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, dialog_message_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListWithCB
+// select id, dialog_message_id, user_id, reaction, big, chosen_order, date2 from message_reactions where dialog_message_id = :dialog_message_id and deleted = 0 order by date2 desc, id desc
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) SelectListWithCB(ctx context.Context, dialog_message_id int64, cb func(i int, v *dataobject.MessageReactionsDO)) (rList []dataobject.MessageReactionsDO, err error) {
+	var (
+		query  = "select id, dialog_message_id, user_id, reaction, big, chosen_order, date2 from message_reactions where dialog_message_id = ? and deleted = 0 order by date2 desc, id desc"
+		values []dataobject.MessageReactionsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, dialog_message_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
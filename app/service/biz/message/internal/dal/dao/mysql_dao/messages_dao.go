@@ -48,6 +48,18 @@ func (dao *MessagesDAO) CalcTableName(id int64) string {
 	}
 }
 
+func (dao *MessagesDAO) CalcTableNameList() []string {
+	if dao.ShardingSize == 0 {
+		return []string{"messages"}
+	}
+
+	nameList := make([]string, 0, dao.ShardingSize)
+	for i := 0; i < dao.ShardingSize; i++ {
+		nameList = append(nameList, "messages_"+strconv.Itoa(i))
+	}
+	return nameList
+}
+
 // InsertOrReturnId
 // insert into messages(user_id, user_message_box_id, dialog_id1, dialog_id2, dialog_message_id, sender_user_id, peer_type, peer_id, random_id, message_filter_type, message_data, message, mentioned, media_unread, pinned, date2) values (:user_id, :user_message_box_id, :dialog_id1, :dialog_id2, :dialog_message_id, :sender_user_id, :peer_type, :peer_id, :random_id, :message_filter_type, :message_data, :message, :mentioned, :media_unread, :pinned, :date2) on duplicate key update id = last_insert_id(id)
 // TODO(@benqi): sqlmap
@@ -863,6 +875,35 @@ func (dao *MessagesDAO) DeleteMessagesByMessageIdListTx(tx *sqlx.Tx, user_id int
 	return
 }
 
+// select user_message_box_id from messages where user_id = :user_id and user_message_box_id in (:idList) and deleted = 0 for update
+// SelectUndeletedIdListForUpdateTx
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) SelectUndeletedIdListForUpdateTx(tx *sqlx.Tx, user_id int64, idList []int32) (rList []int32, err error) {
+	var (
+		query = "select user_message_box_id from " + dao.CalcTableName(user_id) + " where user_id = ? and user_message_box_id in (?) and deleted = 0 for update"
+		a     []interface{}
+	)
+
+	if len(idList) == 0 {
+		rList = []int32{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(tx.Context()).Errorf("sqlx.In in SelectUndeletedIdListForUpdate(_), error: %v", err)
+		return
+	}
+	err = tx.QueryRowsPartial(&rList, query, a...)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("select in SelectUndeletedIdListForUpdate(_), error: %v", err)
+	}
+
+	return
+}
+
 // SelectDialogMessageIdList
 // select user_id, user_message_box_id, dialog_id1, dialog_id2, dialog_message_id, sender_user_id, peer_type, peer_id, random_id, message_filter_type, message_data, message, mentioned, media_unread, pinned, has_reaction, reaction, reaction_date, reaction_unread, date2 from messages where user_id = :user_id and (dialog_id1 = :dialog_id1 and dialog_id2 = :dialog_id2) and deleted = 0 order by user_message_box_id desc
 // TODO(@benqi): sqlmap
@@ -1621,6 +1662,144 @@ func (dao *MessagesDAO) UpdateCustomMapTx(tx *sqlx.Tx, cMap map[string]interface
 	return
 }
 
+// UpdateHasReaction
+// update messages set has_reaction = :has_reaction where user_id = :user_id and user_message_box_id = :user_message_box_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateHasReaction(ctx context.Context, has_reaction bool, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update " + dao.CalcTableName(user_id) + " set has_reaction = ? where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, has_reaction, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateHasReaction(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateHasReaction(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateHasReactionTx
+// update messages set has_reaction = :has_reaction where user_id = :user_id and user_message_box_id = :user_message_box_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateHasReactionTx(tx *sqlx.Tx, has_reaction bool, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update " + dao.CalcTableName(user_id) + " set has_reaction = ? where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, has_reaction, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateHasReaction(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateHasReaction(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReaction
+// update messages set reaction = :reaction, reaction_date = :reaction_date where user_id = :user_id and user_message_box_id = :user_message_box_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReaction(ctx context.Context, reaction string, reaction_date int64, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update " + dao.CalcTableName(user_id) + " set reaction = ?, reaction_date = ? where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, reaction, reaction_date, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateReaction(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateReaction(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReactionTx
+// update messages set reaction = :reaction, reaction_date = :reaction_date where user_id = :user_id and user_message_box_id = :user_message_box_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReactionTx(tx *sqlx.Tx, reaction string, reaction_date int64, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update " + dao.CalcTableName(user_id) + " set reaction = ?, reaction_date = ? where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, reaction, reaction_date, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateReaction(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateReaction(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReactionUnread
+// update messages set reaction_unread = :reaction_unread where user_id = :user_id and user_message_box_id = :user_message_box_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReactionUnread(ctx context.Context, reaction_unread bool, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update " + dao.CalcTableName(user_id) + " set reaction_unread = ? where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, reaction_unread, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateReactionUnread(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateReactionUnread(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReactionUnreadTx
+// update messages set reaction_unread = :reaction_unread where user_id = :user_id and user_message_box_id = :user_message_box_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReactionUnreadTx(tx *sqlx.Tx, reaction_unread bool, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update " + dao.CalcTableName(user_id) + " set reaction_unread = ? where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, reaction_unread, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateReactionUnread(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateReactionUnread(_), error: %v", err)
+	}
+
+	return
+}
+
 // SelectBackwardBySendUserIdOffsetIdLimit
 // select user_id, user_message_box_id, dialog_id1, dialog_id2, dialog_message_id, sender_user_id, peer_type, peer_id, random_id, message_filter_type, message_data, message, mentioned, media_unread, pinned, has_reaction, reaction, reaction_date, reaction_unread, date2 from messages where user_id = :user_id and (dialog_id1 = :dialog_id1 and dialog_id2 = :dialog_id2) and sender_user_id = :sender_user_id and user_message_box_id < :user_message_box_id and deleted = 0 order by user_message_box_id desc limit :limit
 // TODO(@benqi): sqlmap
@@ -1666,3 +1845,23 @@ func (dao *MessagesDAO) SelectBackwardBySendUserIdOffsetIdLimitWithCB(ctx contex
 
 	return
 }
+
+// SelectExpiredList
+// select user_id, user_message_box_id, dialog_id1, dialog_id2, dialog_message_id, sender_user_id, peer_type, peer_id, random_id, message_filter_type, message_data, message, mentioned, media_unread, pinned, has_reaction, reaction, reaction_date, reaction_unread, date2, ttl_period from messages where deleted = 0 and ttl_expire_date > 0 and ttl_expire_date <= :ttl_expire_date order by ttl_expire_date asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) SelectExpiredList(ctx context.Context, tableName string, ttl_expire_date int64, limit int32) (rList []dataobject.MessagesDO, err error) {
+	var (
+		query  = "select user_id, user_message_box_id, dialog_id1, dialog_id2, dialog_message_id, sender_user_id, peer_type, peer_id, random_id, message_filter_type, message_data, message, mentioned, media_unread, pinned, has_reaction, reaction, reaction_date, reaction_unread, date2, ttl_period from " + tableName + " where deleted = 0 and ttl_expire_date > 0 and ttl_expire_date <= ? order by ttl_expire_date asc limit ?"
+		values []dataobject.MessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, ttl_expire_date, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectExpiredList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
@@ -0,0 +1,179 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type PollVotesDAO struct {
+	db *sqlx.DB
+}
+
+func NewPollVotesDAO(db *sqlx.DB) *PollVotesDAO {
+	return &PollVotesDAO{db}
+}
+
+// InsertOrUpdate
+// insert into poll_votes(poll_id, vote_user_id, answer_option, date2, deleted) values (:poll_id, :vote_user_id, :answer_option, :date2, 0) on duplicate key update date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *PollVotesDAO) InsertOrUpdate(ctx context.Context, do *dataobject.PollVotesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into poll_votes(poll_id, vote_user_id, answer_option, date2, deleted) values (:poll_id, :vote_user_id, :answer_option, :date2, 0) on duplicate key update date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into poll_votes(poll_id, vote_user_id, answer_option, date2, deleted) values (:poll_id, :vote_user_id, :answer_option, :date2, 0) on duplicate key update date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *PollVotesDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.PollVotesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into poll_votes(poll_id, vote_user_id, answer_option, date2, deleted) values (:poll_id, :vote_user_id, :answer_option, :date2, 0) on duplicate key update date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// DeleteByUserId
+// update poll_votes set deleted = 1 where poll_id = :poll_id and vote_user_id = :vote_user_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *PollVotesDAO) DeleteByUserId(ctx context.Context, poll_id int64, vote_user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update poll_votes set deleted = 1 where poll_id = ? and vote_user_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, poll_id, vote_user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteByUserId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteByUserId(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByUserIdTx
+// update poll_votes set deleted = 1 where poll_id = :poll_id and vote_user_id = :vote_user_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *PollVotesDAO) DeleteByUserIdTx(tx *sqlx.Tx, poll_id int64, vote_user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update poll_votes set deleted = 1 where poll_id = ? and vote_user_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, poll_id, vote_user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteByUserId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteByUserId(_), error: %v", err)
+	}
+
+	return
+}
+
+// SelectList
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_votes where poll_id = :poll_id and deleted = 0 order by date2 desc, id desc
+// TODO(@benqi): sqlmap
+func (dao *PollVotesDAO) SelectList(ctx context.Context, poll_id int64) (rList []dataobject.PollVotesDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_votes where poll_id = ? and deleted = 0 order by date2 desc, id desc"
+		values []dataobject.PollVotesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListWithCB
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_votes where poll_id = :poll_id and deleted = 0 order by date2 desc, id desc
+// TODO(@benqi): sqlmap
+func (dao *PollVotesDAO) SelectListWithCB(ctx context.Context, poll_id int64, cb func(i int, v *dataobject.PollVotesDO)) (rList []dataobject.PollVotesDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_votes where poll_id = ? and deleted = 0 order by date2 desc, id desc"
+		values []dataobject.PollVotesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,157 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type PollsDAO struct {
+	db *sqlx.DB
+}
+
+func NewPollsDAO(db *sqlx.DB) *PollsDAO {
+	return &PollsDAO{db}
+}
+
+// Insert
+// insert into polls(creator_user_id, question, answers, closed, public_voters, multiple_choice, quiz, close_period, close_date, correct_answers, solution, solution_entities, date2) values (:creator_user_id, :question, :answers, :closed, :public_voters, :multiple_choice, :quiz, :close_period, :close_date, :correct_answers, :solution, :solution_entities, :date2)
+// TODO(@benqi): sqlmap
+func (dao *PollsDAO) Insert(ctx context.Context, do *dataobject.PollsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into polls(creator_user_id, question, answers, closed, public_voters, multiple_choice, quiz, close_period, close_date, correct_answers, solution, solution_entities, date2) values (:creator_user_id, :question, :answers, :closed, :public_voters, :multiple_choice, :quiz, :close_period, :close_date, :correct_answers, :solution, :solution_entities, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into polls(creator_user_id, question, answers, closed, public_voters, multiple_choice, quiz, close_period, close_date, correct_answers, solution, solution_entities, date2) values (:creator_user_id, :question, :answers, :closed, :public_voters, :multiple_choice, :quiz, :close_period, :close_date, :correct_answers, :solution, :solution_entities, :date2)
+// TODO(@benqi): sqlmap
+func (dao *PollsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.PollsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into polls(creator_user_id, question, answers, closed, public_voters, multiple_choice, quiz, close_period, close_date, correct_answers, solution, solution_entities, date2) values (:creator_user_id, :question, :answers, :closed, :public_voters, :multiple_choice, :quiz, :close_period, :close_date, :correct_answers, :solution, :solution_entities, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// Select
+// select id, creator_user_id, question, answers, closed, public_voters, multiple_choice, quiz, close_period, close_date, correct_answers, solution, solution_entities, date2 from polls where id = :id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *PollsDAO) Select(ctx context.Context, id int64) (rValue *dataobject.PollsDO, err error) {
+	var (
+		query = "select id, creator_user_id, question, answers, closed, public_voters, multiple_choice, quiz, close_period, close_date, correct_answers, solution, solution_entities, date2 from polls where id = ? and deleted = 0"
+		do    = &dataobject.PollsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in Select(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// UpdateClosed
+// update polls set closed = :closed where id = :id
+// TODO(@benqi): sqlmap
+func (dao *PollsDAO) UpdateClosed(ctx context.Context, closed bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update polls set closed = ? where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, closed, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateClosed(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateClosed(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateClosedTx
+// update polls set closed = :closed where id = :id
+// TODO(@benqi): sqlmap
+func (dao *PollsDAO) UpdateClosedTx(tx *sqlx.Tx, closed bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update polls set closed = ? where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, closed, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateClosed(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateClosed(_), error: %v", err)
+	}
+
+	return
+}
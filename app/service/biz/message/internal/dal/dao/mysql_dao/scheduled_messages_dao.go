@@ -0,0 +1,149 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ScheduledMessagesDAO struct {
+	db *sqlx.DB
+}
+
+func NewScheduledMessagesDAO(db *sqlx.DB) *ScheduledMessagesDAO {
+	return &ScheduledMessagesDAO{db}
+}
+
+// SelectPeerList
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and state = 0 and deleted = 0 order by schedule_date desc, user_message_box_id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectPeerList(ctx context.Context, user_id int64, peer_type int32, peer_id int64, limit int32) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and state = 0 and deleted = 0 order by schedule_date desc, user_message_box_id desc limit ?"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, peer_type, peer_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectPeerList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectPeerListWithCB
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and state = 0 and deleted = 0 order by schedule_date desc, user_message_box_id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectPeerListWithCB(ctx context.Context, user_id int64, peer_type int32, peer_id int64, limit int32, cb func(i int, v *dataobject.ScheduledMessagesDO)) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and state = 0 and deleted = 0 order by schedule_date desc, user_message_box_id desc limit ?"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, peer_type, peer_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectPeerList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectByIdList
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and user_message_box_id in (:idList) and state = 0 and deleted = 0 order by schedule_date desc, user_message_box_id desc
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByIdList(ctx context.Context, user_id int64, peer_type int32, peer_id int64, idList []int32) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and user_message_box_id in (?) and state = 0 and deleted = 0 order by schedule_date desc, user_message_box_id desc"
+		a      []interface{}
+		values []dataobject.ScheduledMessagesDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ScheduledMessagesDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByIdListWithCB
+// select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and user_message_box_id in (:idList) and state = 0 and deleted = 0 order by schedule_date desc, user_message_box_id desc
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByIdListWithCB(ctx context.Context, user_id int64, peer_type int32, peer_id int64, idList []int32, cb func(i int, v *dataobject.ScheduledMessagesDO)) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, peer_type, peer_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and user_message_box_id in (?) and state = 0 and deleted = 0 order by schedule_date desc, user_message_box_id desc"
+		a      []interface{}
+		values []dataobject.ScheduledMessagesDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ScheduledMessagesDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
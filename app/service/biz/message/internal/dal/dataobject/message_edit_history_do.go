@@ -0,0 +1,23 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type MessageEditHistoryDO struct {
+	Id              int64  `db:"id"`
+	UserId          int64  `db:"user_id"`
+	PeerType        int32  `db:"peer_type"`
+	PeerId          int64  `db:"peer_id"`
+	MessageId       int32  `db:"message_id"`
+	EditorUserId    int64  `db:"editor_user_id"`
+	PrevMessageData string `db:"prev_message_data"`
+	MessageData     string `db:"message_data"`
+	EditDate        int64  `db:"edit_date"`
+}
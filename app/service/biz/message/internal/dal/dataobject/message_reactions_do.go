@@ -0,0 +1,22 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type MessageReactionsDO struct {
+	Id              int64  `db:"id"`
+	DialogMessageId int64  `db:"dialog_message_id"`
+	UserId          int64  `db:"user_id"`
+	Reaction        string `db:"reaction"`
+	Big             bool   `db:"big"`
+	ChosenOrder     int32  `db:"chosen_order"`
+	Date2           int64  `db:"date2"`
+	Deleted         bool   `db:"deleted"`
+}
@@ -0,0 +1,29 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type PollsDO struct {
+	Id               int64  `db:"id"`
+	CreatorUserId    int64  `db:"creator_user_id"`
+	Question         string `db:"question"`
+	Answers          string `db:"answers"`
+	Closed           bool   `db:"closed"`
+	PublicVoters     bool   `db:"public_voters"`
+	MultipleChoice   bool   `db:"multiple_choice"`
+	Quiz             bool   `db:"quiz"`
+	ClosePeriod      int32  `db:"close_period"`
+	CloseDate        int64  `db:"close_date"`
+	CorrectAnswers   string `db:"correct_answers"`
+	Solution         string `db:"solution"`
+	SolutionEntities string `db:"solution_entities"`
+	Date2            int64  `db:"date2"`
+	Deleted          bool   `db:"deleted"`
+}
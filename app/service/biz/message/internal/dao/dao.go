@@ -14,21 +14,24 @@ import (
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/config"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/plugin"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
 )
 
 // Dao dao.
 type Dao struct {
 	*Mysql
 	sqlc.CachedConn
-	Plugin plugin.MessagePlugin
+	Plugin      plugin.MessagePlugin
+	SearchIndex searchindex.Index
 }
 
 // New new a dao and return.
 func New(c config.Config, plugin plugin.MessagePlugin) *Dao {
 	db := sqlx.NewMySQL(&c.Mysql)
 	return &Dao{
-		Mysql:      newMysqlDao(db, c.MessageSharding),
-		CachedConn: sqlc.NewConn(db, c.Cache),
-		Plugin:     plugin,
+		Mysql:       newMysqlDao(db, c.MessageSharding),
+		CachedConn:  sqlc.NewConn(db, c.Cache),
+		Plugin:      plugin,
+		SearchIndex: searchindex.MustNew(c.SearchIndex, db),
 	}
 }
@@ -8,9 +8,11 @@ package dao
 
 import (
 	"context"
+	"time"
 
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/searchindex"
 	"github.com/zeromicro/go-zero/core/jsonx"
 )
 
@@ -37,12 +39,37 @@ func (d *Dao) MakeMessageBox(ctx context.Context, selfUserId int64, do *dataobje
 	box.Message = box.Message.FixData()
 
 	// poll
-	if d.Plugin != nil {
-		pollId, _ := mtproto.GetPollIdByMessage(box.Message.Media)
-		if pollId != 0 {
+	if pollId, _ := mtproto.GetPollIdByMessage(box.Message.Media); pollId != 0 {
+		if d.Plugin != nil {
 			box.Message.Media, _ = d.Plugin.GetMessageMediaPoll(ctx, selfUserId, pollId)
+		} else if media, err := d.GetMediaPoll(ctx, do.UserId, pollId, time.Now().Unix()); err == nil {
+			box.Message.Media = media
 		}
 	}
 
+	// reactions
+	if do.HasReaction {
+		box.Message.Reactions = d.GetMessageReactions(ctx, do)
+	}
+
 	return
 }
+
+// SearchMessageBoxList looks up q in the search index and loads the matched boxes of q.UserId.
+func (d *Dao) SearchMessageBoxList(ctx context.Context, q *searchindex.Query) []*mtproto.MessageBox {
+	idList, err := d.SearchIndex.Search(ctx, q)
+	if err != nil || len(idList) == 0 {
+		return []*mtproto.MessageBox{}
+	}
+
+	boxList := make([]*mtproto.MessageBox, 0, len(idList))
+	d.MessagesDAO.SelectByMessageIdListWithCB(
+		ctx,
+		q.UserId,
+		idList,
+		func(i int, v *dataobject.MessagesDO) {
+			boxList = append(boxList, d.MakeMessageBox(ctx, q.UserId, v))
+		})
+
+	return boxList
+}
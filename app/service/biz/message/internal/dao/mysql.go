@@ -27,14 +27,22 @@ type Mysql struct {
 	*sqlx.DB
 	*mysql_dao.MessagesDAO
 	*mysql_dao.HashTagsDAO
+	*mysql_dao.MessageReactionsDAO
+	*mysql_dao.PollsDAO
+	*mysql_dao.PollVotesDAO
+	*mysql_dao.ScheduledMessagesDAO
 	*sqlx.CommonDAO
 }
 
 func newMysqlDao(db *sqlx.DB, shardingSize int) *Mysql {
 	return &Mysql{
-		DB:          db,
-		MessagesDAO: mysql_dao.NewMessagesDAO(db, shardingSize),
-		HashTagsDAO: mysql_dao.NewHashTagsDAO(db),
-		CommonDAO:   sqlx.NewCommonDAO(db),
+		DB:                   db,
+		MessagesDAO:          mysql_dao.NewMessagesDAO(db, shardingSize),
+		HashTagsDAO:          mysql_dao.NewHashTagsDAO(db),
+		MessageReactionsDAO:  mysql_dao.NewMessageReactionsDAO(db),
+		PollsDAO:             mysql_dao.NewPollsDAO(db),
+		PollVotesDAO:         mysql_dao.NewPollVotesDAO(db),
+		ScheduledMessagesDAO: mysql_dao.NewScheduledMessagesDAO(db),
+		CommonDAO:            sqlx.NewCommonDAO(db),
 	}
 }
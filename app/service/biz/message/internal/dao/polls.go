@@ -0,0 +1,164 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+const (
+	recentVotersLimit = 3
+)
+
+// IsPollClosed reports whether the poll was closed by its creator or its close_date has passed.
+func IsPollClosed(pollDO *dataobject.PollsDO, now int64) bool {
+	return pollDO.Closed || (pollDO.CloseDate != 0 && pollDO.CloseDate <= now)
+}
+
+// MakePoll decodes the poll stored in pollDO.
+func MakePoll(pollDO *dataobject.PollsDO, now int64) *mtproto.Poll {
+	var (
+		answers []*mtproto.PollAnswer
+	)
+
+	_ = jsonx.UnmarshalFromString(pollDO.Answers, &answers)
+	poll := mtproto.MakeTLPoll(&mtproto.Poll{
+		Id:             pollDO.Id,
+		Closed:         IsPollClosed(pollDO, now),
+		PublicVoters:   pollDO.PublicVoters,
+		MultipleChoice: pollDO.MultipleChoice,
+		Quiz:           pollDO.Quiz,
+		Question:       pollDO.Question,
+		Answers:        answers,
+		ClosePeriod:    nil,
+		CloseDate:      nil,
+	}).To_Poll()
+	if pollDO.ClosePeriod != 0 {
+		poll.ClosePeriod = &types.Int32Value{Value: pollDO.ClosePeriod}
+	}
+	if pollDO.CloseDate != 0 {
+		poll.CloseDate = &types.Int32Value{Value: int32(pollDO.CloseDate)}
+	}
+
+	return poll
+}
+
+// MakeMediaPoll builds the messageMediaPoll of pollDO as seen by selfUserId. The correct
+// answers and the solution of a quiz are only revealed once selfUserId has voted or the
+// poll is closed. voteList must be sorted by date2 desc.
+func MakeMediaPoll(selfUserId int64, pollDO *dataobject.PollsDO, voteList []dataobject.PollVotesDO, now int64) *mtproto.MessageMedia {
+	var (
+		poll         = MakePoll(pollDO, now)
+		voters       = make(map[string]int32)
+		chosen       = make(map[string]bool)
+		voterIdList  = make(map[int64]bool)
+		recentVoters []int64
+		correct      []string
+	)
+
+	for _, v := range voteList {
+		voters[v.AnswerOption]++
+		if v.VoteUserId == selfUserId {
+			chosen[v.AnswerOption] = true
+		}
+		if !voterIdList[v.VoteUserId] {
+			voterIdList[v.VoteUserId] = true
+			if pollDO.PublicVoters && len(recentVoters) < recentVotersLimit {
+				recentVoters = append(recentVoters, v.VoteUserId)
+			}
+		}
+	}
+
+	reveal := pollDO.Quiz && (len(chosen) > 0 || poll.Closed)
+	if reveal {
+		_ = jsonx.UnmarshalFromString(pollDO.CorrectAnswers, &correct)
+	}
+
+	results := mtproto.MakeTLPollResults(&mtproto.PollResults{
+		Min:              false,
+		Results:          make([]*mtproto.PollAnswerVoters, 0, len(poll.Answers)),
+		TotalVoters:      &types.Int32Value{Value: int32(len(voterIdList))},
+		RecentVoters:     recentVoters,
+		Solution:         nil,
+		SolutionEntities: nil,
+	}).To_PollResults()
+
+	for _, answer := range poll.Answers {
+		option := string(answer.Option)
+		isCorrect := false
+		for _, c := range correct {
+			if c == option {
+				isCorrect = true
+				break
+			}
+		}
+		results.Results = append(results.Results, mtproto.MakeTLPollAnswerVoters(&mtproto.PollAnswerVoters{
+			Chosen:  chosen[option],
+			Correct: isCorrect,
+			Option:  answer.Option,
+			Voters:  voters[option],
+		}).To_PollAnswerVoters())
+	}
+
+	if reveal && pollDO.Solution != "" {
+		results.Solution = &types.StringValue{Value: pollDO.Solution}
+		_ = jsonx.UnmarshalFromString(pollDO.SolutionEntities, &results.SolutionEntities)
+	}
+
+	return mtproto.MakeTLMessageMediaPoll(&mtproto.MessageMedia{
+		Poll:    poll,
+		Results: results,
+	}).To_MessageMedia()
+}
+
+// GetMediaPoll loads the poll pollId with its results as seen by selfUserId.
+func (d *Dao) GetMediaPoll(ctx context.Context, selfUserId int64, pollId int64, now int64) (*mtproto.MessageMedia, error) {
+	pollDO, err := d.PollsDAO.Select(ctx, pollId)
+	if err != nil {
+		return nil, err
+	} else if pollDO == nil {
+		return nil, mtproto.ErrMediaInvalid
+	}
+
+	voteList, err := d.PollVotesDAO.SelectList(ctx, pollId)
+	if err != nil {
+		return nil, err
+	}
+
+	return MakeMediaPoll(selfUserId, pollDO, voteList, now), nil
+}
+
+// SetPollVotes replaces the votes of userId on the poll pollId with options,
+// an empty options retracts the vote.
+func (d *Dao) SetPollVotes(ctx context.Context, pollId, userId int64, options []string, date int64) error {
+	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		_, result.Err = d.PollVotesDAO.DeleteByUserIdTx(tx, pollId, userId)
+		if result.Err != nil {
+			return
+		}
+		for _, option := range options {
+			_, _, result.Err = d.PollVotesDAO.InsertOrUpdateTx(tx, &dataobject.PollVotesDO{
+				PollId:       pollId,
+				VoteUserId:   userId,
+				AnswerOption: option,
+				Date2:        date,
+			})
+			if result.Err != nil {
+				return
+			}
+		}
+	})
+
+	return tR.Err
+}
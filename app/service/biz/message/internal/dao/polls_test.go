@@ -0,0 +1,103 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+)
+
+func TestMakeMediaPoll(t *testing.T) {
+	pollDO := &dataobject.PollsDO{
+		Id:             1,
+		Question:       "q",
+		Answers:        `[{"predicate_name":"pollAnswer","text":"a","option":"MA=="},{"predicate_name":"pollAnswer","text":"b","option":"MQ=="}]`,
+		PublicVoters:   true,
+		Quiz:           true,
+		CloseDate:      100,
+		CorrectAnswers: `["1"]`,
+		Solution:       "because",
+	}
+	// sorted by date2 desc
+	voteList := []dataobject.PollVotesDO{
+		{VoteUserId: 3, AnswerOption: "1", Date2: 3},
+		{VoteUserId: 2, AnswerOption: "0", Date2: 2},
+		{VoteUserId: 1, AnswerOption: "1", Date2: 1},
+	}
+
+	media := MakeMediaPoll(1, pollDO, voteList, 50)
+	if media.Poll.Closed {
+		t.Errorf("Closed = true, want false")
+	}
+	if len(media.Poll.Answers) != 2 {
+		t.Fatalf("len(Answers) = %d, want 2", len(media.Poll.Answers))
+	}
+
+	results := media.Results
+	if v := results.TotalVoters.GetValue(); v != 3 {
+		t.Errorf("TotalVoters = %d, want 3", v)
+	}
+	if len(results.RecentVoters) != 3 || results.RecentVoters[0] != 3 {
+		t.Errorf("RecentVoters = %v, want [3 2 1]", results.RecentVoters)
+	}
+	want := []struct {
+		option  string
+		voters  int32
+		chosen  bool
+		correct bool
+	}{
+		{"0", 1, false, false},
+		{"1", 2, true, true},
+	}
+	for i, w := range want {
+		r := results.Results[i]
+		if string(r.Option) != w.option || r.Voters != w.voters || r.Chosen != w.chosen || r.Correct != w.correct {
+			t.Errorf("Results[%d] = {%s %d %v %v}, want %v", i, r.Option, r.Voters, r.Chosen, r.Correct, w)
+		}
+	}
+	if results.Solution.GetValue() != "because" {
+		t.Errorf("Solution = %v, want because", results.Solution)
+	}
+
+	// a quiz stays sealed for users who have not voted until it closes
+	media = MakeMediaPoll(4, pollDO, voteList, 50)
+	for _, r := range media.Results.Results {
+		if r.Chosen || r.Correct {
+			t.Errorf("option %s revealed before voting", r.Option)
+		}
+	}
+	if media.Results.Solution != nil {
+		t.Errorf("Solution = %v, want nil", media.Results.Solution)
+	}
+
+	media = MakeMediaPoll(4, pollDO, voteList, 100)
+	if !media.Poll.Closed {
+		t.Errorf("Closed = false after close_date, want true")
+	}
+	if !media.Results.Results[1].Correct {
+		t.Errorf("correct answer not revealed after close_date")
+	}
+
+	// anonymous polls hide the voters
+	pollDO.PublicVoters = false
+	if media = MakeMediaPoll(1, pollDO, voteList, 50); len(media.Results.RecentVoters) != 0 {
+		t.Errorf("RecentVoters = %v, want none", media.Results.RecentVoters)
+	}
+}
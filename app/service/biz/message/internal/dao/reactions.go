@@ -0,0 +1,86 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"sort"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	recentReactionsLimit = 5
+)
+
+// MakeMessageReactions aggregates the reactions of a message as seen by the owner of
+// the box selfUserId: chosen_order marks selfUserId's own reactions, and unread marks
+// the reactions of others on a box whose reaction_unread is set. doList must be sorted
+// by date2 desc.
+func MakeMessageReactions(selfUserId int64, peerType int32, reactionUnread bool, doList []dataobject.MessageReactionsDO) *mtproto.MessageReactions {
+	if len(doList) == 0 {
+		return nil
+	}
+
+	var (
+		results = make([]*mtproto.ReactionCount, 0)
+		counts  = make(map[string]*mtproto.ReactionCount)
+		recent  []*mtproto.MessagePeerReaction
+	)
+
+	// oldest first, so that ties keep the order reactions were first chosen
+	for i := len(doList) - 1; i >= 0; i-- {
+		do := &doList[i]
+		rc, ok := counts[do.Reaction]
+		if !ok {
+			rc = mtproto.MakeTLReactionCount(&mtproto.ReactionCount{
+				Reaction_REACTION: mtproto.FromReaction(do.Reaction),
+			}).To_ReactionCount()
+			counts[do.Reaction] = rc
+			results = append(results, rc)
+		}
+		rc.Count++
+		if do.UserId == selfUserId {
+			rc.ChosenOrder = &types.Int32Value{Value: do.ChosenOrder}
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Count > results[j].Count
+	})
+
+	if peerType == mtproto.PEER_USER || peerType == mtproto.PEER_CHAT {
+		for i := 0; i < len(doList) && len(recent) < recentReactionsLimit; i++ {
+			do := &doList[i]
+			recent = append(recent, mtproto.MakeTLMessagePeerReaction(&mtproto.MessagePeerReaction{
+				Big:               do.Big,
+				Unread:            reactionUnread && do.UserId != selfUserId,
+				PeerId:            mtproto.MakePeerUser(do.UserId),
+				Reaction_REACTION: mtproto.FromReaction(do.Reaction),
+			}).To_MessagePeerReaction())
+		}
+	}
+
+	return mtproto.MakeTLMessageReactions(&mtproto.MessageReactions{
+		Min:             false,
+		CanSeeList:      peerType == mtproto.PEER_CHAT,
+		Results:         results,
+		RecentReactions: recent,
+	}).To_MessageReactions()
+}
+
+// GetMessageReactions loads the reactions of the message in box do.
+func (d *Dao) GetMessageReactions(ctx context.Context, do *dataobject.MessagesDO) *mtproto.MessageReactions {
+	doList, err := d.MessageReactionsDAO.SelectList(ctx, do.DialogMessageId)
+	if err != nil {
+		return nil
+	}
+
+	return MakeMessageReactions(do.UserId, do.PeerType, do.ReactionUnread, doList)
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+)
+
+func TestMakeMessageReactions(t *testing.T) {
+	// sorted by date2 desc
+	doList := []dataobject.MessageReactionsDO{
+		{UserId: 3, Reaction: "👍", Date2: 4},
+		{UserId: 1, Reaction: "❤", ChosenOrder: 1, Date2: 3},
+		{UserId: 2, Reaction: "❤", Date2: 2},
+		{UserId: 1, Reaction: "👍", ChosenOrder: 0, Date2: 1},
+		{UserId: 4, Reaction: "🔥", Date2: 0},
+	}
+
+	if r := MakeMessageReactions(1, mtproto.PEER_CHAT, false, nil); r != nil {
+		t.Fatalf("MakeMessageReactions(nil) = %v, want nil", r)
+	}
+
+	r := MakeMessageReactions(1, mtproto.PEER_CHAT, true, doList)
+	if !r.CanSeeList {
+		t.Errorf("CanSeeList = false, want true")
+	}
+
+	want := []struct {
+		reaction string
+		count    int32
+		chosen   int32
+	}{
+		{"👍", 2, 0},
+		{"❤", 2, 1},
+		{"🔥", 1, -1},
+	}
+	if len(r.Results) != len(want) {
+		t.Fatalf("len(Results) = %d, want %d", len(r.Results), len(want))
+	}
+	for i, w := range want {
+		rc := r.Results[i]
+		if rc.Reaction_REACTION.ToString() != w.reaction || rc.Count != w.count {
+			t.Errorf("Results[%d] = (%s, %d), want (%s, %d)", i, rc.Reaction_REACTION.ToString(), rc.Count, w.reaction, w.count)
+		}
+		if w.chosen < 0 && rc.ChosenOrder != nil {
+			t.Errorf("Results[%d].ChosenOrder = %v, want nil", i, rc.ChosenOrder)
+		} else if w.chosen >= 0 && rc.ChosenOrder.GetValue() != w.chosen {
+			t.Errorf("Results[%d].ChosenOrder = %v, want %d", i, rc.ChosenOrder, w.chosen)
+		}
+	}
+
+	if len(r.RecentReactions) != recentReactionsLimit {
+		t.Fatalf("len(RecentReactions) = %d, want %d", len(r.RecentReactions), recentReactionsLimit)
+	}
+	for _, p := range r.RecentReactions {
+		if p.Unread == (p.PeerId.UserId == 1) {
+			t.Errorf("RecentReactions(%d).Unread = %v", p.PeerId.UserId, p.Unread)
+		}
+	}
+
+	// a private chat has no reactors list
+	if r = MakeMessageReactions(2, mtproto.PEER_USER, false, doList); r.CanSeeList || r.RecentReactions[0].Unread {
+		t.Errorf("MakeMessageReactions(PEER_USER) = %v", r)
+	}
+}
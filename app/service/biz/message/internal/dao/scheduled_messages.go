@@ -0,0 +1,53 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+const (
+	scheduledMessagesLimit = 100
+)
+
+// MakeScheduledMessageBox decodes a pending scheduled message of its owner.
+func MakeScheduledMessageBox(do *dataobject.ScheduledMessagesDO) (box *mtproto.MessageBox) {
+	box = mtproto.MakeTLMessageBox(&mtproto.MessageBox{
+		UserId:       do.UserId,
+		MessageId:    do.UserMessageBoxId,
+		SenderUserId: do.UserId,
+		PeerType:     do.PeerType,
+		PeerId:       do.PeerId,
+		RandomId:     do.RandomId,
+		Message:      nil,
+	}).To_MessageBox()
+	_ = jsonx.UnmarshalFromString(do.MessageData, &box.Message)
+	box.Message = box.Message.FixData()
+
+	return
+}
+
+// GetScheduledMessageBoxList returns up to scheduledMessagesLimit pending scheduled messages of userId to the peer.
+func (d *Dao) GetScheduledMessageBoxList(ctx context.Context, userId int64, peerType int32, peerId int64) []*mtproto.MessageBox {
+	boxList := make([]*mtproto.MessageBox, 0)
+	d.ScheduledMessagesDAO.SelectPeerListWithCB(
+		ctx,
+		userId,
+		peerType,
+		peerId,
+		scheduledMessagesLimit,
+		func(i int, v *dataobject.ScheduledMessagesDO) {
+			boxList = append(boxList, MakeScheduledMessageBox(v))
+		})
+
+	return boxList
+}
@@ -0,0 +1,246 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package searchindex
+
+import (
+	"context"
+	"strings"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	maxTokensPerMessage = 512
+	insertBatchSize     = 128
+)
+
+func init() {
+	Register("inverted", newInvertedIndex)
+}
+
+// invertedIndex keeps one posting row per (message, token) in the
+// message_search_index table, so it needs nothing besides the main database.
+type invertedIndex struct {
+	db *sqlx.DB
+}
+
+func newInvertedIndex(c Config, db *sqlx.DB) (Index, error) {
+	return &invertedIndex{db: db}, nil
+}
+
+func (idx *invertedIndex) Index(ctx context.Context, docs ...*Document) error {
+	tR := sqlx.TxWrapper(ctx, idx.db, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		for _, doc := range docs {
+			_, result.Err = tx.Exec(
+				"delete from message_search_index where user_id = ? and peer_type = ? and peer_id = ? and message_id = ?",
+				doc.UserId,
+				doc.PeerType,
+				doc.PeerId,
+				doc.MessageId)
+			if result.Err != nil {
+				return
+			}
+
+			tokens := Tokenize(doc.Message)
+			if len(tokens) > maxTokensPerMessage {
+				tokens = tokens[:maxTokensPerMessage]
+			}
+			if doc.MediaType > 0 {
+				tokens = append(tokens, mediaToken(doc.MediaType))
+			}
+
+			for len(tokens) > 0 {
+				n := len(tokens)
+				if n > insertBatchSize {
+					n = insertBatchSize
+				}
+
+				var (
+					values = make([]string, 0, n)
+					args   = make([]interface{}, 0, n*7)
+				)
+				for _, token := range tokens[:n] {
+					values = append(values, "(?, ?, ?, ?, ?, ?, ?)")
+					args = append(args, doc.UserId, doc.PeerType, doc.PeerId, doc.MessageId, doc.FromId, token, doc.Date)
+				}
+				_, result.Err = tx.Exec(
+					"insert ignore into message_search_index(user_id, peer_type, peer_id, message_id, from_id, token, date2) values "+strings.Join(values, ", "),
+					args...)
+				if result.Err != nil {
+					return
+				}
+				tokens = tokens[n:]
+			}
+		}
+	})
+	if tR.Err != nil {
+		logx.WithContext(ctx).Errorf("searchindex.Index - error: %v", tR.Err)
+	}
+
+	return tR.Err
+}
+
+func (idx *invertedIndex) Delete(ctx context.Context, userId int64, peerType int32, peerId int64, idList []int32) error {
+	if len(idList) == 0 {
+		return nil
+	}
+
+	var (
+		query = "delete from message_search_index where user_id = ? and message_id in (?)"
+		args  = []interface{}{userId, idList}
+	)
+	if peerType != 0 {
+		query += " and peer_type = ? and peer_id = ?"
+		args = append(args, peerType, peerId)
+	}
+
+	query, args, err := sqlx.In(query, args...)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("searchindex.Delete - error: %v", err)
+		return err
+	}
+	if _, err = idx.db.Exec(ctx, query, args...); err != nil {
+		logx.WithContext(ctx).Errorf("searchindex.Delete - error: %v", err)
+	}
+
+	return err
+}
+
+// where builds the filter shared by Search and Count, every term has to
+// match at least one posting of the message.
+func (idx *invertedIndex) where(q *Query) (string, []interface{}, bool) {
+	terms := parseQuery(q.Q)
+	if q.MediaType > 0 {
+		terms = append(terms, term{token: mediaToken(q.MediaType)})
+	}
+	if len(terms) == 0 {
+		return "", nil, false
+	}
+
+	var (
+		sb   strings.Builder
+		args []interface{}
+	)
+
+	sb.WriteString("from message_search_index where user_id = ?")
+	args = append(args, q.UserId)
+	if q.PeerType != 0 {
+		sb.WriteString(" and peer_type = ? and peer_id = ?")
+		args = append(args, q.PeerType, q.PeerId)
+	}
+	if q.FromId != 0 {
+		sb.WriteString(" and from_id = ?")
+		args = append(args, q.FromId)
+	}
+	if q.OffsetId > 0 {
+		sb.WriteString(" and message_id < ?")
+		args = append(args, q.OffsetId)
+	}
+	if q.MinId > 0 {
+		sb.WriteString(" and message_id > ?")
+		args = append(args, q.MinId)
+	}
+	if q.MinDate > 0 {
+		sb.WriteString(" and date2 >= ?")
+		args = append(args, q.MinDate)
+	}
+	if q.MaxDate > 0 {
+		sb.WriteString(" and date2 <= ?")
+		args = append(args, q.MaxDate)
+	}
+
+	match := func(t term) string {
+		if t.prefix {
+			args = append(args, t.token+"%")
+			return "token like ?"
+		}
+		args = append(args, t.token)
+		return "token = ?"
+	}
+
+	sb.WriteString(" and (")
+	for i, t := range terms {
+		if i > 0 {
+			sb.WriteString(" or ")
+		}
+		sb.WriteString(match(t))
+	}
+	sb.WriteString(") group by message_id")
+
+	if len(terms) > 1 {
+		sb.WriteString(" having ")
+		for i, t := range terms {
+			if i > 0 {
+				sb.WriteString(" and ")
+			}
+			sb.WriteString("sum(" + match(t) + ") > 0")
+		}
+	}
+
+	return sb.String(), args, true
+}
+
+func (idx *invertedIndex) Search(ctx context.Context, q *Query) ([]int32, error) {
+	where, args, ok := idx.where(q)
+	if !ok {
+		return []int32{}, nil
+	}
+
+	var (
+		values []struct {
+			MessageId int32 `db:"message_id"`
+		}
+		query = "select message_id " + where + " order by message_id desc limit ?"
+	)
+
+	err := idx.db.QueryRowsPartial(ctx, &values, query, append(args, q.Limit)...)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("searchindex.Search - error: %v", err)
+		return nil, err
+	}
+
+	idList := make([]int32, 0, len(values))
+	for _, v := range values {
+		idList = append(idList, v.MessageId)
+	}
+
+	return idList, nil
+}
+
+func (idx *invertedIndex) Count(ctx context.Context, q *Query) (int32, error) {
+	where, args, ok := idx.where(q)
+	if !ok {
+		return 0, nil
+	}
+
+	var (
+		count int32
+		query = "select count(*) from (select message_id " + where + ") t"
+	)
+
+	err := idx.db.QueryRow(ctx, &count, query, args...)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("searchindex.Count - error: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
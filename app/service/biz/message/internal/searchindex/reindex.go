@@ -0,0 +1,116 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package searchindex
+
+import (
+	"context"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Source is a message table fed to the index by Reindex.
+type Source struct {
+	Table string
+	query string
+	args  []interface{}
+}
+
+// MessagesSource reads the boxes of a messages (or messages_N) table.
+func MessagesSource(table string) Source {
+	return Source{
+		Table: table,
+		query: "select id, user_id, peer_type, peer_id, user_message_box_id as message_id, sender_user_id as from_id, message_filter_type as media_type, message, date2 from " +
+			table + " where id > ? and deleted = 0 order by id asc limit ?",
+	}
+}
+
+// ChannelMessagesSource reads channel_messages, indexed under user 0 like IndexChannelMessage does.
+func ChannelMessagesSource() Source {
+	return Source{
+		Table: "channel_messages",
+		query: "select id, 0 as user_id, ? as peer_type, channel_id as peer_id, channel_message_id as message_id, sender_user_id as from_id, message_filter_type as media_type, message, date2 from " +
+			"channel_messages where id > ? and deleted = 0 order by id asc limit ?",
+		args: []interface{}{mtproto.PEER_CHANNEL},
+	}
+}
+
+type reindexRow struct {
+	Id        int64  `db:"id"`
+	UserId    int64  `db:"user_id"`
+	PeerType  int32  `db:"peer_type"`
+	PeerId    int64  `db:"peer_id"`
+	MessageId int32  `db:"message_id"`
+	FromId    int64  `db:"from_id"`
+	MediaType int32  `db:"media_type"`
+	Message   string `db:"message"`
+	Date2     int64  `db:"date2"`
+}
+
+// Reindex feeds the rows of src stored after row fromId to idx, batchSize rows at a time.
+// Only messages written after the index was deployed are indexed on write, so this
+// backfills the older ones. Index replaces postings, running it twice is harmless.
+// It returns the last row id done so an interrupted run can be resumed from there.
+func Reindex(ctx context.Context, idx Index, db *sqlx.DB, src Source, fromId int64, batchSize int, progress func(lastId int64, n int)) (int64, error) {
+	lastId := fromId
+	for {
+		var rows []reindexRow
+
+		args := append(append([]interface{}{}, src.args...), lastId, batchSize)
+		if err := db.QueryRowsPartial(ctx, &rows, src.query, args...); err != nil {
+			logx.WithContext(ctx).Errorf("searchindex.Reindex(%s) - error: %v", src.Table, err)
+			return lastId, err
+		}
+		if len(rows) == 0 {
+			return lastId, nil
+		}
+
+		docs := make([]*Document, 0, len(rows))
+		for i := range rows {
+			if rows[i].Message == "" && rows[i].MediaType == 0 {
+				continue
+			}
+			docs = append(docs, &Document{
+				UserId:    rows[i].UserId,
+				PeerType:  rows[i].PeerType,
+				PeerId:    rows[i].PeerId,
+				MessageId: rows[i].MessageId,
+				FromId:    rows[i].FromId,
+				MediaType: rows[i].MediaType,
+				Message:   rows[i].Message,
+				Date:      rows[i].Date2,
+			})
+		}
+		if len(docs) > 0 {
+			if err := idx.Index(ctx, docs...); err != nil {
+				return lastId, err
+			}
+		}
+
+		lastId = rows[len(rows)-1].Id
+		if progress != nil {
+			progress(lastId, len(rows))
+		}
+		if len(rows) < batchSize {
+			return lastId, nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package searchindex keeps a full-text index of message text for
+// message.search, message.searchGlobal and the search counters.
+package searchindex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+)
+
+// Document is the indexed part of a message box.
+type Document struct {
+	UserId    int64 // owner of the box, 0 for channel messages
+	PeerType  int32
+	PeerId    int64
+	MessageId int32
+	FromId    int64
+	MediaType int32 // message_filter_type
+	Message   string
+	Date      int64
+}
+
+// Query selects message ids newest first. PeerType 0 searches every
+// dialog of UserId, FromId and MediaType 0 don't filter.
+type Query struct {
+	UserId    int64
+	PeerType  int32
+	PeerId    int64
+	FromId    int64
+	MediaType int32
+	Q         string
+	OffsetId  int32
+	MinId     int32
+	MinDate   int64
+	MaxDate   int64
+	Limit     int32
+}
+
+// Index is implemented by the search backends.
+type Index interface {
+	// Index replaces the postings of the documents.
+	Index(ctx context.Context, docs ...*Document) error
+	// Delete drops the postings of idList, peerType 0 matches any peer.
+	Delete(ctx context.Context, userId int64, peerType int32, peerId int64, idList []int32) error
+	Search(ctx context.Context, q *Query) ([]int32, error)
+	Count(ctx context.Context, q *Query) (int32, error)
+}
+
+type Config struct {
+	Type string `json:",default=inverted"`
+}
+
+type Factory func(c Config, db *sqlx.DB) (Index, error)
+
+var factories = map[string]Factory{}
+
+// Register makes a backend available by Config.Type.
+func Register(name string, f Factory) {
+	factories[name] = f
+}
+
+func MustNew(c Config, db *sqlx.DB) Index {
+	f, ok := factories[c.Type]
+	if !ok {
+		panic(fmt.Errorf("searchindex: unknown type %q", c.Type))
+	}
+
+	idx, err := f(c, db)
+	if err != nil {
+		panic(err)
+	}
+
+	return idx
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package searchindex
+
+import (
+	"strconv"
+	"unicode"
+)
+
+const (
+	maxTokenLen = 32
+)
+
+type term struct {
+	token  string
+	prefix bool
+}
+
+// isCJK scripts without word separators are indexed as unigrams and bigrams.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// split walks text and calls word for every latin-like word and cjk for
+// every run of CJK characters, both lower cased.
+func split(text string, word func(w []rune), cjk func(run []rune)) {
+	var (
+		w   []rune
+		run []rune
+	)
+
+	flush := func() {
+		if len(w) > 0 {
+			if len(w) > maxTokenLen {
+				w = w[:maxTokenLen]
+			}
+			word(w)
+			w = nil
+		}
+		if len(run) > 0 {
+			cjk(run)
+			run = nil
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			if len(w) > 0 {
+				flush()
+			}
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r):
+			if len(run) > 0 {
+				flush()
+			}
+			w = append(w, unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+}
+
+// Tokenize returns the distinct tokens of text.
+func Tokenize(text string) []string {
+	var (
+		tokens []string
+		seen   = make(map[string]bool)
+	)
+
+	add := func(t string) {
+		if !seen[t] {
+			seen[t] = true
+			tokens = append(tokens, t)
+		}
+	}
+
+	split(text,
+		func(w []rune) {
+			add(string(w))
+		},
+		func(run []rune) {
+			for i := range run {
+				add(string(run[i]))
+				if i+1 < len(run) {
+					add(string(run[i : i+2]))
+				}
+			}
+		})
+
+	return tokens
+}
+
+// parseQuery all terms must match, the last word is matched as a prefix
+// unless the query ends with a separator.
+func parseQuery(q string) []term {
+	var (
+		terms []term
+		last  = -1
+	)
+
+	split(q,
+		func(w []rune) {
+			terms = append(terms, term{token: string(w)})
+			last = len(terms) - 1
+		},
+		func(run []rune) {
+			if len(run) == 1 {
+				terms = append(terms, term{token: string(run)})
+			}
+			for i := 0; i+1 < len(run); i++ {
+				terms = append(terms, term{token: string(run[i : i+2])})
+			}
+			last = -1
+		})
+
+	if last >= 0 && last == len(terms)-1 {
+		rs := []rune(q)
+		if r := rs[len(rs)-1]; unicode.IsLetter(r) || unicode.IsDigit(r) {
+			terms[last].prefix = true
+		}
+	}
+
+	return terms
+}
+
+// mediaToken the message_filter_type facet, never produced by Tokenize.
+func mediaToken(mediaType int32) string {
+	return "#media:" + strconv.Itoa(int(mediaType))
+}
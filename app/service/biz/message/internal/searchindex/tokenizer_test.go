@@ -0,0 +1,62 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package searchindex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		text string
+		want []string
+	}{
+		{"Hello, World! hello", []string{"hello", "world"}},
+		{"teamgram-server 2023", []string{"teamgram", "server", "2023"}},
+		{"你好世界", []string{"你", "你好", "好", "好世", "世", "世界", "界"}},
+		{"go语言", []string{"go", "语", "语言", "言"}},
+	}
+
+	for _, c := range cases {
+		if got := Tokenize(c.text); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Tokenize(%q) = %v, want %v", c.text, got, c.want)
+		}
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	cases := []struct {
+		q    string
+		want []term
+	}{
+		{"hel", []term{{"hel", true}}},
+		{"hello wor", []term{{"hello", false}, {"wor", true}}},
+		{"hello ", []term{{"hello", false}}},
+		{"世界", []term{{"世界", false}}},
+		{"世", []term{{"世", false}}},
+		{"", nil},
+	}
+
+	for _, c := range cases {
+		if got := parseQuery(c.q); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("parseQuery(%q) = %v, want %v", c.q, got, c.want)
+		}
+	}
+}
@@ -317,3 +317,123 @@ func (s *Service) MessageGetUnreadMentionsCount(ctx context.Context, request *me
 	c.Logger.Debugf("message.getUnreadMentionsCount - reply: %s", r.DebugString())
 	return r, err
 }
+
+// MessageGetMessageReactionsList
+// message.getMessageReactionsList user_id:long msg_id:int reaction:string offset:string limit:int = messages.MessageReactionsList;
+func (s *Service) MessageGetMessageReactionsList(ctx context.Context, request *message.TLMessageGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("message.getMessageReactionsList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessageGetMessageReactionsList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("message.getMessageReactionsList - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessageCreatePoll
+// message.createPoll user_id:long poll:Poll correct_answers:Vector<string> solution:string solution_entities:Vector<MessageEntity> = MessageMedia;
+func (s *Service) MessageCreatePoll(ctx context.Context, request *message.TLMessageCreatePoll) (*mtproto.MessageMedia, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("message.createPoll - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessageCreatePoll(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("message.createPoll - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessageGetMediaPoll
+// message.getMediaPoll user_id:long poll_id:long = MessageMedia;
+func (s *Service) MessageGetMediaPoll(ctx context.Context, request *message.TLMessageGetMediaPoll) (*mtproto.MessageMedia, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("message.getMediaPoll - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessageGetMediaPoll(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("message.getMediaPoll - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessageSendVote
+// message.sendVote user_id:long poll_id:long options:Vector<string> = MessageMedia;
+func (s *Service) MessageSendVote(ctx context.Context, request *message.TLMessageSendVote) (*mtproto.MessageMedia, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("message.sendVote - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessageSendVote(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("message.sendVote - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessageClosePoll
+// message.closePoll user_id:long poll_id:long = MessageMedia;
+func (s *Service) MessageClosePoll(ctx context.Context, request *message.TLMessageClosePoll) (*mtproto.MessageMedia, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("message.closePoll - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessageClosePoll(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("message.closePoll - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessageGetPollVotes
+// message.getPollVotes user_id:long poll_id:long option:string offset:string limit:int = messages.VotesList;
+func (s *Service) MessageGetPollVotes(ctx context.Context, request *message.TLMessageGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("message.getPollVotes - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessageGetPollVotes(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("message.getPollVotes - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessageGetScheduledMessageHistory
+// message.getScheduledMessageHistory user_id:long peer_type:int peer_id:long = Vector<MessageBox>;
+func (s *Service) MessageGetScheduledMessageHistory(ctx context.Context, request *message.TLMessageGetScheduledMessageHistory) (*message.Vector_MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("message.getScheduledMessageHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessageGetScheduledMessageHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("message.getScheduledMessageHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessageGetScheduledMessages
+// message.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<MessageBox>;
+func (s *Service) MessageGetScheduledMessages(ctx context.Context, request *message.TLMessageGetScheduledMessages) (*message.Vector_MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("message.getScheduledMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessageGetScheduledMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("message.getScheduledMessages - reply: %s", r.DebugString())
+	return r, err
+}
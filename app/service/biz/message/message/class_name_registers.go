@@ -31,6 +31,14 @@ const (
 	Predicate_message_unPinAllMessages                     = "message_unPinAllMessages"
 	Predicate_message_getUnreadMentions                    = "message_getUnreadMentions"
 	Predicate_message_getUnreadMentionsCount               = "message_getUnreadMentionsCount"
+	Predicate_message_getMessageReactionsList              = "message_getMessageReactionsList"
+	Predicate_message_createPoll                           = "message_createPoll"
+	Predicate_message_getMediaPoll                         = "message_getMediaPoll"
+	Predicate_message_sendVote                             = "message_sendVote"
+	Predicate_message_closePoll                            = "message_closePoll"
+	Predicate_message_getPollVotes                         = "message_getPollVotes"
+	Predicate_message_getScheduledMessageHistory           = "message_getScheduledMessageHistory"
+	Predicate_message_getScheduledMessages                 = "message_getScheduledMessages"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -114,6 +122,38 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: -1254023095, // 0xb5412049
 
 	},
+	Predicate_message_getMessageReactionsList: {
+		0: 1655415226, // 0x62aba1ba
+
+	},
+	Predicate_message_createPoll: {
+		0: -733869235, // 0xd4420b4d
+
+	},
+	Predicate_message_getMediaPoll: {
+		0: 1758477985, // 0x68d03ea1
+
+	},
+	Predicate_message_sendVote: {
+		0: 2013334744, // 0x78010cd8
+
+	},
+	Predicate_message_closePoll: {
+		0: 2059693693, // 0x7ac46e7d
+
+	},
+	Predicate_message_getPollVotes: {
+		0: 1868078041, // 0x6f589bd9
+
+	},
+	Predicate_message_getScheduledMessageHistory: {
+		0: 1511885933, // 0x5a1d8c6d
+
+	},
+	Predicate_message_getScheduledMessages: {
+		0: -129566692, // 0xf846f81c
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
@@ -137,6 +177,14 @@ var clazzIdNameRegisters2 = map[int32]string{
 	-368432525:  Predicate_message_unPinAllMessages,                     // 0xea0a2a73
 	1877050548:  Predicate_message_getUnreadMentions,                    // 0x6fe184b4
 	-1254023095: Predicate_message_getUnreadMentionsCount,               // 0xb5412049
+	1655415226:  Predicate_message_getMessageReactionsList,              // 0x62aba1ba
+	-733869235:  Predicate_message_createPoll,                           // 0xd4420b4d
+	1758477985:  Predicate_message_getMediaPoll,                         // 0x68d03ea1
+	2013334744:  Predicate_message_sendVote,                             // 0x78010cd8
+	2059693693:  Predicate_message_closePoll,                            // 0x7ac46e7d
+	1868078041:  Predicate_message_getPollVotes,                         // 0x6f589bd9
+	1511885933:  Predicate_message_getScheduledMessageHistory,           // 0x5a1d8c6d
+	-129566692:  Predicate_message_getScheduledMessages,                 // 0xf846f81c
 
 }
 
@@ -132,6 +132,46 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: -1254023095,
 		}
 	},
+	1655415226: func() mtproto.TLObject { // 0x62aba1ba
+		return &TLMessageGetMessageReactionsList{
+			Constructor: 1655415226,
+		}
+	},
+	-733869235: func() mtproto.TLObject { // 0xd4420b4d
+		return &TLMessageCreatePoll{
+			Constructor: -733869235,
+		}
+	},
+	1758477985: func() mtproto.TLObject { // 0x68d03ea1
+		return &TLMessageGetMediaPoll{
+			Constructor: 1758477985,
+		}
+	},
+	2013334744: func() mtproto.TLObject { // 0x78010cd8
+		return &TLMessageSendVote{
+			Constructor: 2013334744,
+		}
+	},
+	2059693693: func() mtproto.TLObject { // 0x7ac46e7d
+		return &TLMessageClosePoll{
+			Constructor: 2059693693,
+		}
+	},
+	1868078041: func() mtproto.TLObject { // 0x6f589bd9
+		return &TLMessageGetPollVotes{
+			Constructor: 1868078041,
+		}
+	},
+	1511885933: func() mtproto.TLObject { // 0x5a1d8c6d
+		return &TLMessageGetScheduledMessageHistory{
+			Constructor: 1511885933,
+		}
+	},
+	-129566692: func() mtproto.TLObject { // 0xf846f81c
+		return &TLMessageGetScheduledMessages{
+			Constructor: -129566692,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -1239,9 +1279,473 @@ func (m *TLMessageGetUnreadMentionsCount) DebugString() string {
 	return dbgString
 }
 
-//----------------------------------------------------------------------------------------------------------------
-// Vector_MessageBox
+// TLMessageGetMessageReactionsList
 ///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMessageGetMessageReactionsList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_message_getMessageReactionsList))
+
+	switch uint32(m.Constructor) {
+	case 0x62aba1ba:
+		// message.getMessageReactionsList user_id:long msg_id:int reaction:string offset:string limit:int = messages.MessageReactionsList;
+		x.UInt(0x62aba1ba)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Int(m.GetMsgId())
+		x.String(m.GetReaction())
+		x.String(m.GetOffset())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMessageGetMessageReactionsList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMessageGetMessageReactionsList) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x62aba1ba:
+		// message.getMessageReactionsList user_id:long msg_id:int reaction:string offset:string limit:int = messages.MessageReactionsList;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.MsgId = dBuf.Int()
+		m.Reaction = dBuf.String()
+		m.Offset = dBuf.String()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMessageGetMessageReactionsList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMessageCreatePoll
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMessageCreatePoll) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_message_createPoll))
+
+	switch uint32(m.Constructor) {
+	case 0xd4420b4d:
+		// message.createPoll user_id:long poll:Poll correct_answers:Vector<string> solution:string solution_entities:Vector<MessageEntity> = MessageMedia;
+		x.UInt(0xd4420b4d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetPoll().Encode(layer))
+
+		x.VectorString(m.GetCorrectAnswers())
+
+		x.String(m.GetSolution())
+
+		x.Int(int32(mtproto.CRC32_vector))
+		x.Int(int32(len(m.GetSolutionEntities())))
+		for _, v := range m.GetSolutionEntities() {
+			x.Bytes((*v).Encode(layer))
+		}
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMessageCreatePoll) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMessageCreatePoll) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd4420b4d:
+		// message.createPoll user_id:long poll:Poll correct_answers:Vector<string> solution:string solution_entities:Vector<MessageEntity> = MessageMedia;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.Poll{}
+		m2.Decode(dBuf)
+		m.Poll = m2
+
+		m.CorrectAnswers = dBuf.VectorString()
+
+		m.Solution = dBuf.String()
+		c5 := dBuf.Int()
+		if c5 != int32(mtproto.CRC32_vector) {
+			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 5, c5)
+			return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 5, c5)
+		}
+		l5 := dBuf.Int()
+		v5 := make([]*mtproto.MessageEntity, l5)
+		for i := int32(0); i < l5; i++ {
+			v5[i] = &mtproto.MessageEntity{}
+			v5[i].Decode(dBuf)
+		}
+		m.SolutionEntities = v5
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMessageCreatePoll) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMessageGetMediaPoll
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMessageGetMediaPoll) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_message_getMediaPoll))
+
+	switch uint32(m.Constructor) {
+	case 0x68d03ea1:
+		// message.getMediaPoll user_id:long poll_id:long = MessageMedia;
+		x.UInt(0x68d03ea1)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetPollId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMessageGetMediaPoll) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMessageGetMediaPoll) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x68d03ea1:
+		// message.getMediaPoll user_id:long poll_id:long = MessageMedia;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PollId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMessageGetMediaPoll) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMessageSendVote
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMessageSendVote) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_message_sendVote))
+
+	switch uint32(m.Constructor) {
+	case 0x78010cd8:
+		// message.sendVote user_id:long poll_id:long options:Vector<string> = MessageMedia;
+		x.UInt(0x78010cd8)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetPollId())
+
+		x.VectorString(m.GetOptions())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMessageSendVote) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMessageSendVote) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x78010cd8:
+		// message.sendVote user_id:long poll_id:long options:Vector<string> = MessageMedia;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PollId = dBuf.Long()
+
+		m.Options = dBuf.VectorString()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMessageSendVote) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMessageClosePoll
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMessageClosePoll) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_message_closePoll))
+
+	switch uint32(m.Constructor) {
+	case 0x7ac46e7d:
+		// message.closePoll user_id:long poll_id:long = MessageMedia;
+		x.UInt(0x7ac46e7d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetPollId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMessageClosePoll) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMessageClosePoll) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x7ac46e7d:
+		// message.closePoll user_id:long poll_id:long = MessageMedia;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PollId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMessageClosePoll) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMessageGetPollVotes
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMessageGetPollVotes) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_message_getPollVotes))
+
+	switch uint32(m.Constructor) {
+	case 0x6f589bd9:
+		// message.getPollVotes user_id:long poll_id:long option:string offset:string limit:int = messages.VotesList;
+		x.UInt(0x6f589bd9)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetPollId())
+		x.String(m.GetOption())
+		x.String(m.GetOffset())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMessageGetPollVotes) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMessageGetPollVotes) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6f589bd9:
+		// message.getPollVotes user_id:long poll_id:long option:string offset:string limit:int = messages.VotesList;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PollId = dBuf.Long()
+		m.Option = dBuf.String()
+		m.Offset = dBuf.String()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMessageGetPollVotes) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMessageGetScheduledMessageHistory
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMessageGetScheduledMessageHistory) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_message_getScheduledMessageHistory))
+
+	switch uint32(m.Constructor) {
+	case 0x5a1d8c6d:
+		// message.getScheduledMessageHistory user_id:long peer_type:int peer_id:long = Vector<MessageBox>;
+		x.UInt(0x5a1d8c6d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMessageGetScheduledMessageHistory) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMessageGetScheduledMessageHistory) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5a1d8c6d:
+		// message.getScheduledMessageHistory user_id:long peer_type:int peer_id:long = Vector<MessageBox>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMessageGetScheduledMessageHistory) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMessageGetScheduledMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMessageGetScheduledMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_message_getScheduledMessages))
+
+	switch uint32(m.Constructor) {
+	case 0xf846f81c:
+		// message.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<MessageBox>;
+		x.UInt(0xf846f81c)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMessageGetScheduledMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMessageGetScheduledMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xf846f81c:
+		// message.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<MessageBox>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMessageGetScheduledMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// ----------------------------------------------------------------------------------------------------------------
+// Vector_MessageBox
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_MessageBox) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -1276,7 +1780,7 @@ func (m *Vector_MessageBox) DebugString() string {
 }
 
 // Vector_Int
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_Int) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.VectorInt(m.Datas)
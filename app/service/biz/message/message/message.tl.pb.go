@@ -55,6 +55,14 @@ const (
 	CRC32_message_unPinAllMessages                     TLConstructor = -368432525
 	CRC32_message_getUnreadMentions                    TLConstructor = 1877050548
 	CRC32_message_getUnreadMentionsCount               TLConstructor = -1254023095
+	CRC32_message_getMessageReactionsList              TLConstructor = 1655415226
+	CRC32_message_createPoll                           TLConstructor = -733869235
+	CRC32_message_getMediaPoll                         TLConstructor = 1758477985
+	CRC32_message_sendVote                             TLConstructor = 2013334744
+	CRC32_message_closePoll                            TLConstructor = 2059693693
+	CRC32_message_getPollVotes                         TLConstructor = 1868078041
+	CRC32_message_getScheduledMessageHistory           TLConstructor = 1511885933
+	CRC32_message_getScheduledMessages                 TLConstructor = -129566692
 )
 
 var TLConstructor_name = map[int32]string{
@@ -79,6 +87,14 @@ var TLConstructor_name = map[int32]string{
 	-368432525:  "CRC32_message_unPinAllMessages",
 	1877050548:  "CRC32_message_getUnreadMentions",
 	-1254023095: "CRC32_message_getUnreadMentionsCount",
+	1655415226:  "CRC32_message_getMessageReactionsList",
+	-733869235:  "CRC32_message_createPoll",
+	1758477985:  "CRC32_message_getMediaPoll",
+	2013334744:  "CRC32_message_sendVote",
+	2059693693:  "CRC32_message_closePoll",
+	1868078041:  "CRC32_message_getPollVotes",
+	1511885933:  "CRC32_message_getScheduledMessageHistory",
+	-129566692:  "CRC32_message_getScheduledMessages",
 }
 
 var TLConstructor_value = map[string]int32{
@@ -103,6 +119,14 @@ var TLConstructor_value = map[string]int32{
 	"CRC32_message_unPinAllMessages":                     -368432525,
 	"CRC32_message_getUnreadMentions":                    1877050548,
 	"CRC32_message_getUnreadMentionsCount":               -1254023095,
+	"CRC32_message_getMessageReactionsList":              1655415226,
+	"CRC32_message_createPoll":                           -733869235,
+	"CRC32_message_getMediaPoll":                         1758477985,
+	"CRC32_message_sendVote":                             2013334744,
+	"CRC32_message_closePoll":                            2059693693,
+	"CRC32_message_getPollVotes":                         1868078041,
+	"CRC32_message_getScheduledMessageHistory":           1511885933,
+	"CRC32_message_getScheduledMessages":                 -129566692,
 }
 
 func (x TLConstructor) String() string {
@@ -113,7 +137,7 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_854009303dbd8a76, []int{0}
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetUserMessage struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -177,7 +201,7 @@ func (m *TLMessageGetUserMessage) GetId() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetUserMessageList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -241,7 +265,7 @@ func (m *TLMessageGetUserMessageList) GetIdList() []int32 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetUserMessageListByDataIdList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -307,7 +331,7 @@ func (m *TLMessageGetUserMessageListByDataIdList) GetIdList() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetUserMessageListByDataIdUserIdList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -375,7 +399,7 @@ func (m *TLMessageGetUserMessageListByDataIdUserIdList) GetUserIdList() []int64
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetHistoryMessages struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -503,7 +527,7 @@ func (m *TLMessageGetHistoryMessages) GetHash() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetHistoryMessagesCount struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -575,7 +599,7 @@ func (m *TLMessageGetHistoryMessagesCount) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetPeerUserMessageId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -647,7 +671,7 @@ func (m *TLMessageGetPeerUserMessageId) GetMsgId() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetPeerUserMessage struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -719,7 +743,7 @@ func (m *TLMessageGetPeerUserMessage) GetMsgId() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageSearchByMediaType struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -815,7 +839,7 @@ func (m *TLMessageSearchByMediaType) GetLimit() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageSearch struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -911,7 +935,7 @@ func (m *TLMessageSearch) GetLimit() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageSearchGlobal struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -991,7 +1015,7 @@ func (m *TLMessageSearchGlobal) GetLimit() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageSearchByPinned struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1063,7 +1087,7 @@ func (m *TLMessageSearchByPinned) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetSearchCounter struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1143,7 +1167,7 @@ func (m *TLMessageGetSearchCounter) GetMediaType() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageSearchV2 struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1295,7 +1319,7 @@ func (m *TLMessageSearchV2) GetHash() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetLastTwoPinnedMessageId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1367,7 +1391,7 @@ func (m *TLMessageGetLastTwoPinnedMessageId) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageUpdatePinnedMessageId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1455,7 +1479,7 @@ func (m *TLMessageUpdatePinnedMessageId) GetPinned() *mtproto.Bool {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetPinnedMessageIdList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1527,7 +1551,7 @@ func (m *TLMessageGetPinnedMessageIdList) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageUnPinAllMessages struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1599,7 +1623,7 @@ func (m *TLMessageUnPinAllMessages) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetUnreadMentions struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1711,7 +1735,7 @@ func (m *TLMessageGetUnreadMentions) GetMaxInt() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMessageGetUnreadMentionsCount struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1783,27 +1807,32 @@ func (m *TLMessageGetUnreadMentionsCount) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
-// Vector api result type
-type Vector_MessageBox struct {
-	Datas                []*mtproto.MessageBox `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+// --------------------------------------------------------------------------------------------
+// message.getMessageReactionsList user_id:long msg_id:int reaction:string offset:string limit:int = messages.MessageReactionsList;
+type TLMessageGetMessageReactionsList struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MsgId                int32         `protobuf:"varint,4,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Reaction             string        `protobuf:"bytes,5,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Offset               string        `protobuf:"bytes,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32         `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *Vector_MessageBox) Reset()         { *m = Vector_MessageBox{} }
-func (m *Vector_MessageBox) String() string { return proto.CompactTextString(m) }
-func (*Vector_MessageBox) ProtoMessage()    {}
-func (*Vector_MessageBox) Descriptor() ([]byte, []int) {
+func (m *TLMessageGetMessageReactionsList) Reset()         { *m = TLMessageGetMessageReactionsList{} }
+func (m *TLMessageGetMessageReactionsList) String() string { return proto.CompactTextString(m) }
+func (*TLMessageGetMessageReactionsList) ProtoMessage()    {}
+func (*TLMessageGetMessageReactionsList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_854009303dbd8a76, []int{20}
 }
-func (m *Vector_MessageBox) XXX_Unmarshal(b []byte) error {
+func (m *TLMessageGetMessageReactionsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vector_MessageBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TLMessageGetMessageReactionsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vector_MessageBox.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TLMessageGetMessageReactionsList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1813,44 +1842,86 @@ func (m *Vector_MessageBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *Vector_MessageBox) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vector_MessageBox.Merge(m, src)
+func (m *TLMessageGetMessageReactionsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMessageGetMessageReactionsList.Merge(m, src)
 }
-func (m *Vector_MessageBox) XXX_Size() int {
+func (m *TLMessageGetMessageReactionsList) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vector_MessageBox) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vector_MessageBox.DiscardUnknown(m)
+func (m *TLMessageGetMessageReactionsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMessageGetMessageReactionsList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Vector_MessageBox proto.InternalMessageInfo
+var xxx_messageInfo_TLMessageGetMessageReactionsList proto.InternalMessageInfo
 
-func (m *Vector_MessageBox) GetDatas() []*mtproto.MessageBox {
+func (m *TLMessageGetMessageReactionsList) GetConstructor() TLConstructor {
 	if m != nil {
-		return m.Datas
+		return m.Constructor
 	}
-	return nil
+	return CRC32_UNKNOWN
 }
 
-type Vector_Int struct {
-	Datas                []int32  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TLMessageGetMessageReactionsList) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
 }
 
-func (m *Vector_Int) Reset()         { *m = Vector_Int{} }
-func (m *Vector_Int) String() string { return proto.CompactTextString(m) }
-func (*Vector_Int) ProtoMessage()    {}
-func (*Vector_Int) Descriptor() ([]byte, []int) {
+func (m *TLMessageGetMessageReactionsList) GetMsgId() int32 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *TLMessageGetMessageReactionsList) GetReaction() string {
+	if m != nil {
+		return m.Reaction
+	}
+	return ""
+}
+
+func (m *TLMessageGetMessageReactionsList) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *TLMessageGetMessageReactionsList) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+// message.createPoll user_id:long poll:Poll correct_answers:Vector<string> solution:string solution_entities:Vector<MessageEntity> = MessageMedia;
+type TLMessageCreatePoll struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Poll                 *mtproto.Poll            `protobuf:"bytes,4,opt,name=poll,proto3" json:"poll,omitempty"`
+	CorrectAnswers       []string                 `protobuf:"bytes,5,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	Solution             string                   `protobuf:"bytes,6,opt,name=solution,proto3" json:"solution,omitempty"`
+	SolutionEntities     []*mtproto.MessageEntity `protobuf:"bytes,7,rep,name=solution_entities,json=solutionEntities,proto3" json:"solution_entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLMessageCreatePoll) Reset()         { *m = TLMessageCreatePoll{} }
+func (m *TLMessageCreatePoll) String() string { return proto.CompactTextString(m) }
+func (*TLMessageCreatePoll) ProtoMessage()    {}
+func (*TLMessageCreatePoll) Descriptor() ([]byte, []int) {
 	return fileDescriptor_854009303dbd8a76, []int{21}
 }
-func (m *Vector_Int) XXX_Unmarshal(b []byte) error {
+func (m *TLMessageCreatePoll) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vector_Int) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TLMessageCreatePoll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vector_Int.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TLMessageCreatePoll.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1860,2234 +1931,2361 @@ func (m *Vector_Int) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Vector_Int) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vector_Int.Merge(m, src)
+func (m *TLMessageCreatePoll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMessageCreatePoll.Merge(m, src)
 }
-func (m *Vector_Int) XXX_Size() int {
+func (m *TLMessageCreatePoll) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vector_Int) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vector_Int.DiscardUnknown(m)
+func (m *TLMessageCreatePoll) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMessageCreatePoll.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Vector_Int proto.InternalMessageInfo
+var xxx_messageInfo_TLMessageCreatePoll proto.InternalMessageInfo
 
-func (m *Vector_Int) GetDatas() []int32 {
+func (m *TLMessageCreatePoll) GetConstructor() TLConstructor {
 	if m != nil {
-		return m.Datas
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMessageCreatePoll) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLMessageCreatePoll) GetPoll() *mtproto.Poll {
+	if m != nil {
+		return m.Poll
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("message.TLConstructor", TLConstructor_name, TLConstructor_value)
-	proto.RegisterType((*TLMessageGetUserMessage)(nil), "message.TL_message_getUserMessage")
-	proto.RegisterType((*TLMessageGetUserMessageList)(nil), "message.TL_message_getUserMessageList")
-	proto.RegisterType((*TLMessageGetUserMessageListByDataIdList)(nil), "message.TL_message_getUserMessageListByDataIdList")
-	proto.RegisterType((*TLMessageGetUserMessageListByDataIdUserIdList)(nil), "message.TL_message_getUserMessageListByDataIdUserIdList")
-	proto.RegisterType((*TLMessageGetHistoryMessages)(nil), "message.TL_message_getHistoryMessages")
-	proto.RegisterType((*TLMessageGetHistoryMessagesCount)(nil), "message.TL_message_getHistoryMessagesCount")
-	proto.RegisterType((*TLMessageGetPeerUserMessageId)(nil), "message.TL_message_getPeerUserMessageId")
-	proto.RegisterType((*TLMessageGetPeerUserMessage)(nil), "message.TL_message_getPeerUserMessage")
-	proto.RegisterType((*TLMessageSearchByMediaType)(nil), "message.TL_message_searchByMediaType")
-	proto.RegisterType((*TLMessageSearch)(nil), "message.TL_message_search")
-	proto.RegisterType((*TLMessageSearchGlobal)(nil), "message.TL_message_searchGlobal")
-	proto.RegisterType((*TLMessageSearchByPinned)(nil), "message.TL_message_searchByPinned")
-	proto.RegisterType((*TLMessageGetSearchCounter)(nil), "message.TL_message_getSearchCounter")
-	proto.RegisterType((*TLMessageSearchV2)(nil), "message.TL_message_searchV2")
-	proto.RegisterType((*TLMessageGetLastTwoPinnedMessageId)(nil), "message.TL_message_getLastTwoPinnedMessageId")
-	proto.RegisterType((*TLMessageUpdatePinnedMessageId)(nil), "message.TL_message_updatePinnedMessageId")
-	proto.RegisterType((*TLMessageGetPinnedMessageIdList)(nil), "message.TL_message_getPinnedMessageIdList")
-	proto.RegisterType((*TLMessageUnPinAllMessages)(nil), "message.TL_message_unPinAllMessages")
-	proto.RegisterType((*TLMessageGetUnreadMentions)(nil), "message.TL_message_getUnreadMentions")
-	proto.RegisterType((*TLMessageGetUnreadMentionsCount)(nil), "message.TL_message_getUnreadMentionsCount")
-	proto.RegisterType((*Vector_MessageBox)(nil), "message.Vector_MessageBox")
-	proto.RegisterType((*Vector_Int)(nil), "message.Vector_Int")
+func (m *TLMessageCreatePoll) GetCorrectAnswers() []string {
+	if m != nil {
+		return m.CorrectAnswers
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("message.tl.proto", fileDescriptor_854009303dbd8a76) }
+func (m *TLMessageCreatePoll) GetSolution() string {
+	if m != nil {
+		return m.Solution
+	}
+	return ""
+}
 
-var fileDescriptor_854009303dbd8a76 = []byte{
-	// 1644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5d, 0x6c, 0x14, 0xd5,
-	0x17, 0xef, 0xf4, 0x63, 0xb7, 0x3d, 0xfd, 0x60, 0xb8, 0xf4, 0xdf, 0x6e, 0x97, 0x76, 0xbb, 0x0c,
-	0x2d, 0x94, 0x42, 0xdb, 0xff, 0x7f, 0xf9, 0x3f, 0x18, 0x1f, 0x4c, 0x6c, 0x49, 0x74, 0xb5, 0x94,
-	0x66, 0x69, 0x4b, 0x82, 0x89, 0xcb, 0x74, 0xe7, 0x76, 0x3b, 0xc9, 0xee, 0xcc, 0x32, 0x33, 0x2b,
-	0x2d, 0x0f, 0xbe, 0x68, 0x10, 0x35, 0x51, 0xa3, 0x89, 0x10, 0x23, 0x31, 0x08, 0x21, 0x20, 0x7e,
-	0x44, 0x63, 0x8c, 0xd1, 0x17, 0xd0, 0x40, 0xc0, 0xc4, 0xa4, 0x31, 0xd8, 0x98, 0x18, 0x12, 0xa8,
-	0x31, 0xf1, 0xc9, 0x90, 0xe0, 0x93, 0x20, 0x35, 0x73, 0xef, 0xec, 0xee, 0x7c, 0xee, 0x2c, 0x98,
-	0x9a, 0xe5, 0x69, 0xe7, 0xde, 0x73, 0xee, 0x39, 0xbf, 0x7b, 0xee, 0xf9, 0xb8, 0xe7, 0x2e, 0xb0,
-	0x59, 0xac, 0xaa, 0x7c, 0x1a, 0x0f, 0x6b, 0x99, 0xe1, 0x9c, 0x22, 0x6b, 0x32, 0x0a, 0x1a, 0x33,
-	0xe1, 0xa1, 0xb4, 0xa8, 0xcd, 0xe7, 0x67, 0x87, 0x53, 0x72, 0x76, 0x24, 0x2d, 0xa7, 0xe5, 0x11,
-	0x42, 0x9f, 0xcd, 0xcf, 0x91, 0x11, 0x19, 0x90, 0x2f, 0xba, 0x2e, 0x1c, 0x49, 0xcb, 0x72, 0x3a,
-	0x83, 0x4b, 0x5c, 0x87, 0x14, 0x3e, 0x97, 0xc3, 0x8a, 0x6a, 0xd0, 0xc3, 0x6a, 0x6a, 0x1e, 0x67,
-	0x79, 0x5d, 0x51, 0x4a, 0x56, 0x70, 0x52, 0x5b, 0xcc, 0xe1, 0x02, 0xad, 0xab, 0x44, 0xd3, 0x14,
-	0x5e, 0x52, 0x73, 0xb2, 0xa2, 0x19, 0xa4, 0xf6, 0x12, 0x49, 0x5d, 0x94, 0x52, 0x74, 0x96, 0x7b,
-	0x1e, 0xba, 0xa6, 0xc6, 0x93, 0x06, 0xd2, 0x64, 0x1a, 0x6b, 0xd3, 0x2a, 0x56, 0x76, 0xd3, 0x21,
-	0x7a, 0x04, 0x9a, 0x53, 0xb2, 0xa4, 0x6a, 0x4a, 0x3e, 0xa5, 0xc9, 0x4a, 0x88, 0x89, 0x32, 0x03,
-	0x6d, 0xb1, 0x8e, 0xe1, 0xc2, 0x4e, 0xa7, 0xc6, 0xc7, 0x4a, 0xd4, 0x84, 0x99, 0x15, 0x75, 0x42,
-	0x30, 0xaf, 0x62, 0x25, 0x29, 0x0a, 0xa1, 0xba, 0x28, 0x33, 0x50, 0x97, 0x08, 0xe8, 0xc3, 0xb8,
-	0x80, 0xda, 0xa0, 0x56, 0x14, 0x42, 0xf5, 0x51, 0x66, 0xa0, 0x21, 0x51, 0x2b, 0x0a, 0xdc, 0xab,
-	0x0c, 0xf4, 0x78, 0x02, 0x18, 0x17, 0x55, 0x6d, 0x2d, 0x40, 0x74, 0x42, 0x50, 0x14, 0x92, 0x19,
-	0x51, 0xd5, 0x42, 0xf5, 0xd1, 0xba, 0x81, 0x86, 0x44, 0x40, 0x14, 0x74, 0x5d, 0xdc, 0x31, 0x06,
-	0xb6, 0x95, 0x45, 0x33, 0xba, 0xb8, 0x8b, 0xd7, 0xf8, 0xb8, 0xf0, 0x2f, 0x21, 0xab, 0x2b, 0x22,
-	0x3b, 0xc1, 0xc0, 0x48, 0x45, 0xc8, 0xa6, 0x89, 0xa0, 0x7f, 0x88, 0x8f, 0x9e, 0x12, 0x85, 0x56,
-	0x2b, 0x0a, 0x28, 0x0a, 0x2d, 0x06, 0x5e, 0x33, 0x36, 0xc8, 0x17, 0x75, 0x71, 0xd7, 0x6b, 0xed,
-	0xe7, 0xf8, 0xa4, 0xa8, 0x6a, 0xb2, 0xb2, 0x68, 0x40, 0x54, 0xd7, 0xc2, 0x5a, 0x1b, 0xa1, 0x29,
-	0x87, 0xb1, 0x42, 0x22, 0xc0, 0xf0, 0xa9, 0x46, 0x7d, 0x62, 0x6a, 0x31, 0x87, 0xf5, 0x55, 0x84,
-	0x28, 0x0a, 0xa1, 0x06, 0xba, 0x4a, 0x1f, 0xd2, 0x55, 0xf2, 0xdc, 0x9c, 0x8a, 0x35, 0x9d, 0x14,
-	0xa0, 0xab, 0xe8, 0x44, 0x5c, 0x40, 0xbd, 0xd0, 0x6c, 0x10, 0x05, 0x5e, 0xc3, 0xa1, 0x20, 0x21,
-	0x03, 0x9d, 0xda, 0xc5, 0x6b, 0x18, 0xf5, 0x00, 0xf0, 0x82, 0x90, 0xa4, 0x33, 0xa1, 0x46, 0x42,
-	0x6f, 0xe2, 0x05, 0x61, 0x0f, 0x99, 0x40, 0xed, 0xd0, 0x90, 0x11, 0xb3, 0xa2, 0x16, 0x6a, 0x22,
-	0x14, 0x3a, 0x40, 0xff, 0x81, 0x40, 0x96, 0x5f, 0xd0, 0xf5, 0x01, 0x9d, 0xce, 0xf2, 0x0b, 0x71,
-	0x81, 0x4c, 0x8b, 0x92, 0x3e, 0xdd, 0x6c, 0x4c, 0x8b, 0x52, 0x5c, 0x40, 0x08, 0xea, 0xe7, 0x79,
-	0x75, 0x3e, 0xd4, 0x42, 0x60, 0x93, 0x6f, 0xee, 0x23, 0x06, 0xb8, 0xb2, 0xf6, 0x1d, 0x93, 0xf3,
-	0x92, 0x56, 0x35, 0x46, 0xd6, 0xf1, 0xf6, 0x5a, 0xf1, 0x4e, 0x62, 0xac, 0x98, 0x7c, 0x36, 0x2e,
-	0xac, 0x05, 0xd8, 0x28, 0xb4, 0x10, 0x3c, 0x05, 0x6a, 0x3d, 0xa1, 0x42, 0xce, 0xd0, 0x6d, 0xd8,
-	0x5c, 0x4d, 0x17, 0x00, 0xeb, 0x36, 0x57, 0xd3, 0x71, 0x81, 0x3b, 0xef, 0xc8, 0x43, 0x36, 0xbc,
-	0x55, 0x85, 0xf6, 0x0f, 0x06, 0xba, 0x4d, 0x68, 0x55, 0xcc, 0x2b, 0xa9, 0xf9, 0xd1, 0xc5, 0xdd,
-	0x58, 0x10, 0x79, 0x72, 0x2e, 0x55, 0x13, 0x6c, 0x3d, 0x00, 0x59, 0x1d, 0x15, 0x5d, 0x46, 0xa3,
-	0xad, 0x29, 0x5b, 0xc4, 0xd9, 0x01, 0x01, 0x23, 0x92, 0x68, 0xa4, 0x19, 0xa3, 0x52, 0x18, 0x35,
-	0x9a, 0xc2, 0x88, 0xfb, 0x89, 0x81, 0xf5, 0x8e, 0x6d, 0x57, 0xcf, 0x5e, 0x5b, 0x80, 0x39, 0x48,
-	0xb6, 0xd8, 0x94, 0x60, 0x0e, 0xde, 0xe7, 0xd6, 0xce, 0x32, 0xd0, 0xe9, 0xd8, 0xda, 0x13, 0x19,
-	0x79, 0x96, 0xcf, 0xac, 0xc5, 0x06, 0x09, 0xd4, 0x7a, 0x27, 0xd4, 0x06, 0x77, 0xa8, 0x01, 0x33,
-	0xd4, 0xd3, 0x8c, 0xe5, 0xce, 0x50, 0x70, 0xbe, 0x49, 0x51, 0x92, 0xb0, 0x50, 0x3d, 0x19, 0xe8,
-	0x12, 0x03, 0x1b, 0xad, 0x11, 0xbd, 0x97, 0x20, 0x25, 0x89, 0x12, 0x2b, 0x0f, 0x4b, 0x88, 0x70,
-	0xaf, 0xd7, 0xc1, 0x06, 0x87, 0xb9, 0x67, 0x62, 0xd5, 0xea, 0xf6, 0x9d, 0x10, 0x9c, 0x53, 0xe4,
-	0xac, 0xce, 0x16, 0xa4, 0x6c, 0xfa, 0x30, 0x2e, 0xa0, 0x2e, 0x68, 0xd4, 0x8b, 0x1d, 0x29, 0xab,
-	0xd4, 0xf5, 0x83, 0x59, 0x51, 0x22, 0x35, 0x55, 0x27, 0xf1, 0x0b, 0x94, 0xd4, 0x64, 0x90, 0xf8,
-	0x05, 0x42, 0xb2, 0x14, 0x6b, 0xb0, 0x15, 0x6b, 0x6b, 0x2d, 0x6e, 0xf6, 0xac, 0xc5, 0x2d, 0xee,
-	0xb5, 0xb8, 0xd5, 0xbd, 0x16, 0xb7, 0xb9, 0xd5, 0xe2, 0x75, 0xa6, 0x5a, 0xfc, 0x09, 0x03, 0x7d,
-	0x56, 0xcf, 0x1a, 0xe7, 0x55, 0x6d, 0xea, 0x90, 0x4c, 0x43, 0x60, 0x4d, 0x0b, 0xdc, 0x83, 0xc5,
-	0xc2, 0x0a, 0x03, 0x51, 0x13, 0xe2, 0x7c, 0x4e, 0x37, 0x75, 0xb5, 0xa2, 0x35, 0x6e, 0x9f, 0x81,
-	0x42, 0x8f, 0x80, 0xfa, 0x21, 0x90, 0x23, 0x58, 0x89, 0x47, 0x35, 0xc7, 0x5a, 0x87, 0xb3, 0x1a,
-	0xe9, 0x5e, 0x86, 0x47, 0x65, 0x39, 0x93, 0x30, 0x88, 0xdc, 0x87, 0x0c, 0x6c, 0xb2, 0x95, 0x70,
-	0xeb, 0x0e, 0xd7, 0xea, 0xd2, 0xfe, 0x60, 0x67, 0x72, 0xc6, 0x9a, 0x9f, 0xf2, 0xd2, 0xa4, 0x28,
-	0x3d, 0x9e, 0xc9, 0x54, 0xdd, 0x7d, 0x99, 0x3b, 0x57, 0x6b, 0xb9, 0x6c, 0xe8, 0xad, 0x87, 0xa4,
-	0x60, 0x5e, 0xd8, 0x8d, 0x25, 0x4d, 0x94, 0xa5, 0x87, 0xe5, 0x66, 0x6f, 0x4d, 0x16, 0x41, 0xcf,
-	0x64, 0xd1, 0x68, 0x4f, 0x16, 0x34, 0x2b, 0x34, 0x99, 0xb3, 0x42, 0x27, 0x04, 0x49, 0x0e, 0x91,
-	0x34, 0x23, 0x27, 0xe9, 0x29, 0x25, 0x2e, 0x69, 0x2e, 0x3e, 0x68, 0xb5, 0x55, 0x95, 0xdd, 0xd2,
-	0x1f, 0x83, 0xf5, 0x33, 0x58, 0x17, 0x9c, 0x34, 0xdc, 0x6e, 0x54, 0x5e, 0x40, 0xdb, 0xa0, 0x41,
-	0xe0, 0x35, 0x5e, 0x0d, 0x31, 0xd1, 0xba, 0x81, 0xe6, 0xd8, 0x86, 0x62, 0xb4, 0x95, 0x78, 0x12,
-	0x94, 0x83, 0xe3, 0x00, 0x8c, 0xf5, 0x71, 0x89, 0x98, 0xb0, 0xb4, 0xb0, 0xc1, 0xe0, 0x19, 0x3c,
-	0x1b, 0x84, 0x56, 0xcb, 0x8e, 0xd0, 0x7a, 0x68, 0x1d, 0x4b, 0x8c, 0xed, 0x8c, 0x25, 0xa7, 0x27,
-	0x9e, 0x9e, 0xd8, 0xb3, 0x6f, 0x82, 0xad, 0x41, 0x7d, 0xd0, 0x4d, 0xa7, 0xdc, 0x1b, 0x5c, 0xf6,
-	0xca, 0x2f, 0x9f, 0x2d, 0x07, 0xd1, 0x10, 0x44, 0xcb, 0x71, 0xe9, 0xf1, 0xcd, 0x9e, 0xf9, 0xf2,
-	0xc6, 0x3b, 0x7f, 0xad, 0xae, 0xae, 0xae, 0x32, 0xe8, 0xff, 0xb0, 0xc3, 0x8f, 0xdd, 0xdc, 0xcf,
-	0xb3, 0x77, 0xbe, 0x59, 0x7e, 0x85, 0x41, 0x8f, 0x42, 0xac, 0xd2, 0x55, 0xa5, 0x5e, 0x9b, 0xfd,
-	0xf4, 0xda, 0xd5, 0x5f, 0x6b, 0xd1, 0x56, 0x17, 0x80, 0xb6, 0x3e, 0x8d, 0xbd, 0x70, 0xf9, 0xfd,
-	0x10, 0xda, 0x01, 0xfd, 0x7e, 0x8c, 0xc4, 0x55, 0xd8, 0xb7, 0xee, 0x5e, 0x3c, 0x8c, 0x06, 0x81,
-	0x73, 0x70, 0x3b, 0xda, 0x29, 0xf6, 0xbd, 0xdf, 0xbf, 0x3a, 0x11, 0x44, 0x03, 0x2e, 0x10, 0x6c,
-	0xbc, 0xec, 0x9b, 0xa7, 0xbf, 0x3b, 0x1e, 0x40, 0x5b, 0xa1, 0xd7, 0xca, 0xe9, 0x68, 0x23, 0xd8,
-	0x6f, 0x7f, 0xb8, 0x75, 0x94, 0x41, 0xdd, 0xd0, 0xee, 0xc6, 0xc8, 0x9e, 0xba, 0xb1, 0x7c, 0x51,
-	0x17, 0x13, 0x76, 0xa3, 0xd2, 0xbb, 0x2b, 0x7b, 0xe9, 0xeb, 0x9b, 0xef, 0xde, 0xa1, 0xc7, 0xe1,
-	0x38, 0x63, 0xeb, 0xcd, 0x91, 0xfd, 0xf1, 0x8b, 0xef, 0x6f, 0x07, 0xd0, 0x76, 0x88, 0x38, 0xf0,
-	0x5b, 0x2e, 0x6e, 0xec, 0xe5, 0x7b, 0x1f, 0xbf, 0x7d, 0x8f, 0x8a, 0xdc, 0x0c, 0x1d, 0x6e, 0x22,
-	0x67, 0x62, 0xec, 0xc9, 0xe5, 0xe3, 0xaf, 0xfd, 0x59, 0x70, 0x83, 0xad, 0x0e, 0x89, 0xee, 0x05,
-	0x9b, 0x3d, 0xff, 0xc6, 0x6f, 0x77, 0x8d, 0x55, 0xff, 0x85, 0xcd, 0xd6, 0x55, 0xae, 0x45, 0x93,
-	0x5d, 0xfa, 0xf9, 0x85, 0x73, 0xab, 0x74, 0xc5, 0xff, 0xa0, 0xcf, 0x69, 0x79, 0x67, 0x05, 0x62,
-	0xaf, 0x9f, 0x7c, 0x69, 0xc9, 0xf0, 0x50, 0xc7, 0x66, 0xed, 0x55, 0x80, 0xbd, 0x7d, 0xed, 0xec,
-	0x92, 0xb1, 0x59, 0xc7, 0x79, 0x39, 0xb2, 0x0b, 0xfb, 0xf9, 0xcb, 0x47, 0x56, 0x03, 0xae, 0x40,
-	0x5c, 0xd2, 0x10, 0x7b, 0xf5, 0xc2, 0x8b, 0x1f, 0xd0, 0xb3, 0x09, 0xd7, 0x1f, 0x3d, 0x15, 0xa9,
-	0x89, 0x1d, 0x5b, 0x07, 0x90, 0x98, 0x1c, 0x2b, 0x74, 0xbc, 0x7b, 0xa1, 0xc3, 0xe3, 0x61, 0x90,
-	0x33, 0xe5, 0x2a, 0x8f, 0x38, 0x09, 0xbb, 0xe5, 0x0d, 0xae, 0x06, 0xcd, 0x42, 0xb8, 0xcc, 0x63,
-	0xdf, 0x16, 0x7f, 0xc1, 0x3a, 0x5f, 0x38, 0x5c, 0xe4, 0x73, 0xe4, 0x2f, 0xae, 0x06, 0x1d, 0x86,
-	0x2d, 0x15, 0x3e, 0xe1, 0xc5, 0x2a, 0xd3, 0x67, 0x5e, 0xe3, 0xa3, 0xfb, 0x08, 0x03, 0x3b, 0xee,
-	0xef, 0x95, 0xee, 0xfe, 0x20, 0x94, 0x56, 0xfa, 0x00, 0xb1, 0x1a, 0xda, 0xfe, 0x1a, 0xe7, 0x65,
-	0x68, 0x1b, 0x9f, 0x8f, 0x8e, 0x03, 0xd0, 0xeb, 0xf7, 0x22, 0xb5, 0xbd, 0x32, 0x45, 0x84, 0x39,
-	0xdc, 0x56, 0xf4, 0x99, 0xb8, 0xa4, 0xed, 0x8c, 0x71, 0x35, 0x68, 0x3f, 0x74, 0x97, 0x7d, 0x43,
-	0x1a, 0xf0, 0x10, 0xef, 0xe0, 0x74, 0x91, 0xfd, 0x8c, 0xc5, 0x42, 0xf6, 0xf7, 0x9e, 0x2d, 0x95,
-	0x49, 0xf6, 0xf2, 0xf3, 0x03, 0xd0, 0xe5, 0xfd, 0x3c, 0xd3, 0xef, 0x26, 0xdb, 0xc1, 0xe6, 0x63,
-	0xfc, 0xa7, 0xa0, 0xcd, 0xf6, 0x12, 0x12, 0xf6, 0x16, 0xeb, 0x23, 0x6b, 0x06, 0xda, 0x5d, 0x9f,
-	0x1e, 0xa2, 0xde, 0x12, 0x29, 0x87, 0x8f, 0xdc, 0xfd, 0xa5, 0x14, 0x62, 0x7b, 0x27, 0xe0, 0xca,
-	0x99, 0x80, 0xf2, 0xf8, 0xc8, 0x4e, 0x40, 0xc8, 0xb3, 0xb9, 0xef, 0xf3, 0x38, 0x3c, 0x0b, 0x97,
-	0x8b, 0x4b, 0x4c, 0x14, 0xff, 0xc7, 0x29, 0x35, 0xda, 0xdd, 0xde, 0x48, 0x67, 0x62, 0x3e, 0x18,
-	0x45, 0xd8, 0xe4, 0xdf, 0x26, 0x0e, 0x79, 0x80, 0x75, 0x67, 0xd7, 0x1d, 0xce, 0xaa, 0x51, 0xbf,
-	0x78, 0xea, 0xde, 0xdc, 0x53, 0xbe, 0xbf, 0xdb, 0xe6, 0xa6, 0xc6, 0x95, 0x35, 0x6c, 0xed, 0xb0,
-	0xb8, 0x1a, 0x94, 0x82, 0x88, 0x4f, 0x5f, 0x35, 0xe8, 0x15, 0x2e, 0x4e, 0x5e, 0xaf, 0x1d, 0xec,
-	0x2b, 0x1d, 0xa8, 0xa3, 0x1b, 0x72, 0x3d, 0x50, 0x3b, 0x97, 0x97, 0x60, 0x53, 0x2c, 0x3a, 0xbb,
-	0x97, 0x7e, 0xaf, 0xfc, 0x6b, 0x61, 0xf3, 0x39, 0xe7, 0x67, 0x2d, 0xf6, 0x71, 0xbb, 0xf3, 0x0f,
-	0x56, 0xa4, 0xc6, 0x23, 0x0d, 0x8e, 0x4e, 0xdf, 0xba, 0x19, 0x61, 0xae, 0xac, 0x44, 0x98, 0xa5,
-	0x95, 0x08, 0x73, 0x63, 0x25, 0xc2, 0xec, 0x1f, 0x33, 0xfd, 0xa9, 0xa8, 0x61, 0x3e, 0x9b, 0x56,
-	0xf8, 0xd2, 0xc7, 0x90, 0x8a, 0x95, 0xe7, 0xb0, 0x32, 0xc2, 0xe7, 0x72, 0x23, 0xfa, 0xa7, 0x98,
-	0xc2, 0x23, 0xb3, 0xe2, 0xe1, 0x11, 0x43, 0x6f, 0xe1, 0x77, 0x36, 0x40, 0xb4, 0xec, 0xfc, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0x1c, 0x16, 0xe6, 0xf8, 0xbd, 0x1c, 0x00, 0x00,
+func (m *TLMessageCreatePoll) GetSolutionEntities() []*mtproto.MessageEntity {
+	if m != nil {
+		return m.SolutionEntities
+	}
+	return nil
 }
 
-func (this *TLMessageGetUserMessage) GoString() string {
-	if this == nil {
-		return "nil"
+// --------------------------------------------------------------------------------------------
+// message.getMediaPoll user_id:long poll_id:long = MessageMedia;
+type TLMessageGetMediaPoll struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PollId               int64         `protobuf:"varint,4,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMessageGetMediaPoll) Reset()         { *m = TLMessageGetMediaPoll{} }
+func (m *TLMessageGetMediaPoll) String() string { return proto.CompactTextString(m) }
+func (*TLMessageGetMediaPoll) ProtoMessage()    {}
+func (*TLMessageGetMediaPoll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_854009303dbd8a76, []int{22}
+}
+func (m *TLMessageGetMediaPoll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMessageGetMediaPoll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMessageGetMediaPoll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&message.TLMessageGetUserMessage{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLMessageGetMediaPoll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMessageGetMediaPoll.Merge(m, src)
+}
+func (m *TLMessageGetMediaPoll) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMessageGetMediaPoll) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMessageGetMediaPoll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMessageGetMediaPoll proto.InternalMessageInfo
+
+func (m *TLMessageGetMediaPoll) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLMessageGetUserMessageList) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMessageGetMediaPoll) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&message.TLMessageGetUserMessageList{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "IdList: "+fmt.Sprintf("%#v", this.IdList)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMessageGetMediaPoll) GetPollId() int64 {
+	if m != nil {
+		return m.PollId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMessageGetUserMessageListByDataIdList) GoString() string {
-	if this == nil {
-		return "nil"
+
+// --------------------------------------------------------------------------------------------
+// message.sendVote user_id:long poll_id:long options:Vector<string> = MessageMedia;
+type TLMessageSendVote struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PollId               int64         `protobuf:"varint,4,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	Options              []string      `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMessageSendVote) Reset()         { *m = TLMessageSendVote{} }
+func (m *TLMessageSendVote) String() string { return proto.CompactTextString(m) }
+func (*TLMessageSendVote) ProtoMessage()    {}
+func (*TLMessageSendVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_854009303dbd8a76, []int{23}
+}
+func (m *TLMessageSendVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMessageSendVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMessageSendVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&message.TLMessageGetUserMessageListByDataIdList{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "IdList: "+fmt.Sprintf("%#v", this.IdList)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLMessageSendVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMessageSendVote.Merge(m, src)
+}
+func (m *TLMessageSendVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMessageSendVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMessageSendVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMessageSendVote proto.InternalMessageInfo
+
+func (m *TLMessageSendVote) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLMessageGetUserMessageListByDataIdUserIdList) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMessageSendVote) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&message.TLMessageGetUserMessageListByDataIdUserIdList{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "UserIdList: "+fmt.Sprintf("%#v", this.UserIdList)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMessageSendVote) GetPollId() int64 {
+	if m != nil {
+		return m.PollId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMessageGetHistoryMessages) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMessageSendVote) GetOptions() []string {
+	if m != nil {
+		return m.Options
 	}
-	s := make([]string, 0, 15)
-	s = append(s, "&message.TLMessageGetHistoryMessages{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
-	s = append(s, "OffsetDate: "+fmt.Sprintf("%#v", this.OffsetDate)+",\n")
-	s = append(s, "AddOffset: "+fmt.Sprintf("%#v", this.AddOffset)+",\n")
-	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
-	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
-	s = append(s, "MinId: "+fmt.Sprintf("%#v", this.MinId)+",\n")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *TLMessageGetHistoryMessagesCount) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&message.TLMessageGetHistoryMessagesCount{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+// --------------------------------------------------------------------------------------------
+// message.closePoll user_id:long poll_id:long = MessageMedia;
+type TLMessageClosePoll struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PollId               int64         `protobuf:"varint,4,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (this *TLMessageGetPeerUserMessageId) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&message.TLMessageGetPeerUserMessageId{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerUserId: "+fmt.Sprintf("%#v", this.PeerUserId)+",\n")
-	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *TLMessageClosePoll) Reset()         { *m = TLMessageClosePoll{} }
+func (m *TLMessageClosePoll) String() string { return proto.CompactTextString(m) }
+func (*TLMessageClosePoll) ProtoMessage()    {}
+func (*TLMessageClosePoll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_854009303dbd8a76, []int{24}
 }
-func (this *TLMessageGetPeerUserMessage) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&message.TLMessageGetPeerUserMessage{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerUserId: "+fmt.Sprintf("%#v", this.PeerUserId)+",\n")
-	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *TLMessageClosePoll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *TLMessageSearchByMediaType) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 11)
-	s = append(s, "&message.TLMessageSearchByMediaType{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "MediaType: "+fmt.Sprintf("%#v", this.MediaType)+",\n")
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+func (m *TLMessageClosePoll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMessageClosePoll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *TLMessageSearch) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 11)
-	s = append(s, "&message.TLMessageSearch{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *TLMessageClosePoll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMessageClosePoll.Merge(m, src)
 }
-func (this *TLMessageSearchGlobal) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&message.TLMessageSearchGlobal{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+func (m *TLMessageClosePoll) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMessageClosePoll) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMessageClosePoll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMessageClosePoll proto.InternalMessageInfo
+
+func (m *TLMessageClosePoll) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLMessageSearchByPinned) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMessageClosePoll) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&message.TLMessageSearchByPinned{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMessageClosePoll) GetPollId() int64 {
+	if m != nil {
+		return m.PollId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMessageGetSearchCounter) GoString() string {
-	if this == nil {
-		return "nil"
+
+// --------------------------------------------------------------------------------------------
+// message.getPollVotes user_id:long poll_id:long option:string offset:string limit:int = messages.VotesList;
+type TLMessageGetPollVotes struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PollId               int64         `protobuf:"varint,4,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	Option               string        `protobuf:"bytes,5,opt,name=option,proto3" json:"option,omitempty"`
+	Offset               string        `protobuf:"bytes,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32         `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMessageGetPollVotes) Reset()         { *m = TLMessageGetPollVotes{} }
+func (m *TLMessageGetPollVotes) String() string { return proto.CompactTextString(m) }
+func (*TLMessageGetPollVotes) ProtoMessage()    {}
+func (*TLMessageGetPollVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_854009303dbd8a76, []int{25}
+}
+func (m *TLMessageGetPollVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMessageGetPollVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMessageGetPollVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&message.TLMessageGetSearchCounter{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "MediaType: "+fmt.Sprintf("%#v", this.MediaType)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLMessageGetPollVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMessageGetPollVotes.Merge(m, src)
+}
+func (m *TLMessageGetPollVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMessageGetPollVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMessageGetPollVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMessageGetPollVotes proto.InternalMessageInfo
+
+func (m *TLMessageGetPollVotes) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLMessageSearchV2) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMessageGetPollVotes) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&message.TLMessageSearchV2{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
-	s = append(s, "FromId: "+fmt.Sprintf("%#v", this.FromId)+",\n")
-	s = append(s, "MinDate: "+fmt.Sprintf("%#v", this.MinDate)+",\n")
-	s = append(s, "MaxDate: "+fmt.Sprintf("%#v", this.MaxDate)+",\n")
-	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
-	s = append(s, "AddOffset: "+fmt.Sprintf("%#v", this.AddOffset)+",\n")
-	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
-	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
-	s = append(s, "MinId: "+fmt.Sprintf("%#v", this.MinId)+",\n")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMessageGetPollVotes) GetPollId() int64 {
+	if m != nil {
+		return m.PollId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMessageGetLastTwoPinnedMessageId) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMessageGetPollVotes) GetOption() string {
+	if m != nil {
+		return m.Option
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&message.TLMessageGetLastTwoPinnedMessageId{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return ""
+}
+
+func (m *TLMessageGetPollVotes) GetOffset() string {
+	if m != nil {
+		return m.Offset
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *TLMessageUpdatePinnedMessageId) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMessageGetPollVotes) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&message.TLMessageUpdatePinnedMessageId{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	if this.Pinned != nil {
-		s = append(s, "Pinned: "+fmt.Sprintf("%#v", this.Pinned)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMessageGetPinnedMessageIdList) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&message.TLMessageGetPinnedMessageIdList{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+// --------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_MessageBox struct {
+	Datas                []*mtproto.MessageBox `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (this *TLMessageUnPinAllMessages) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&message.TLMessageUnPinAllMessages{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *Vector_MessageBox) Reset()         { *m = Vector_MessageBox{} }
+func (m *Vector_MessageBox) String() string { return proto.CompactTextString(m) }
+func (*Vector_MessageBox) ProtoMessage()    {}
+func (*Vector_MessageBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_854009303dbd8a76, []int{26}
 }
-func (this *TLMessageGetUnreadMentions) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 13)
-	s = append(s, "&message.TLMessageGetUnreadMentions{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
-	s = append(s, "AddOffset: "+fmt.Sprintf("%#v", this.AddOffset)+",\n")
-	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
-	s = append(s, "MinId: "+fmt.Sprintf("%#v", this.MinId)+",\n")
-	s = append(s, "MaxInt: "+fmt.Sprintf("%#v", this.MaxInt)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *Vector_MessageBox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *TLMessageGetUnreadMentionsCount) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&message.TLMessageGetUnreadMentionsCount{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+func (m *Vector_MessageBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_MessageBox.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *Vector_MessageBox) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&message.Vector_MessageBox{")
-	if this.Datas != nil {
-		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *Vector_MessageBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_MessageBox.Merge(m, src)
 }
-func (this *Vector_Int) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&message.Vector_Int{")
-	s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *Vector_MessageBox) XXX_Size() int {
+	return m.Size()
 }
-func valueToGoStringMessageTl(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
-	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+func (m *Vector_MessageBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_MessageBox.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_Vector_MessageBox proto.InternalMessageInfo
 
-// RPCMessageClient is the client API for RPCMessage service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RPCMessageClient interface {
-	MessageGetUserMessage(ctx context.Context, in *TLMessageGetUserMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error)
-	MessageGetUserMessageList(ctx context.Context, in *TLMessageGetUserMessageList, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageGetUserMessageListByDataIdList(ctx context.Context, in *TLMessageGetUserMessageListByDataIdList, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageGetUserMessageListByDataIdUserIdList(ctx context.Context, in *TLMessageGetUserMessageListByDataIdUserIdList, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageGetHistoryMessages(ctx context.Context, in *TLMessageGetHistoryMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageGetHistoryMessagesCount(ctx context.Context, in *TLMessageGetHistoryMessagesCount, opts ...grpc.CallOption) (*mtproto.Int32, error)
-	MessageGetPeerUserMessageId(ctx context.Context, in *TLMessageGetPeerUserMessageId, opts ...grpc.CallOption) (*mtproto.Int32, error)
-	MessageGetPeerUserMessage(ctx context.Context, in *TLMessageGetPeerUserMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error)
-	MessageSearchByMediaType(ctx context.Context, in *TLMessageSearchByMediaType, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageSearch(ctx context.Context, in *TLMessageSearch, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageSearchGlobal(ctx context.Context, in *TLMessageSearchGlobal, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageSearchByPinned(ctx context.Context, in *TLMessageSearchByPinned, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageGetSearchCounter(ctx context.Context, in *TLMessageGetSearchCounter, opts ...grpc.CallOption) (*mtproto.Int32, error)
-	MessageSearchV2(ctx context.Context, in *TLMessageSearchV2, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageGetLastTwoPinnedMessageId(ctx context.Context, in *TLMessageGetLastTwoPinnedMessageId, opts ...grpc.CallOption) (*Vector_Int, error)
-	MessageUpdatePinnedMessageId(ctx context.Context, in *TLMessageUpdatePinnedMessageId, opts ...grpc.CallOption) (*mtproto.Bool, error)
-	MessageGetPinnedMessageIdList(ctx context.Context, in *TLMessageGetPinnedMessageIdList, opts ...grpc.CallOption) (*Vector_Int, error)
-	MessageUnPinAllMessages(ctx context.Context, in *TLMessageUnPinAllMessages, opts ...grpc.CallOption) (*Vector_Int, error)
-	MessageGetUnreadMentions(ctx context.Context, in *TLMessageGetUnreadMentions, opts ...grpc.CallOption) (*Vector_MessageBox, error)
-	MessageGetUnreadMentionsCount(ctx context.Context, in *TLMessageGetUnreadMentionsCount, opts ...grpc.CallOption) (*mtproto.Int32, error)
+func (m *Vector_MessageBox) GetDatas() []*mtproto.MessageBox {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
 }
 
-type rPCMessageClient struct {
-	cc *grpc.ClientConn
+type Vector_Int struct {
+	Datas                []int32  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewRPCMessageClient(cc *grpc.ClientConn) RPCMessageClient {
-	return &rPCMessageClient{cc}
+func (m *Vector_Int) Reset()         { *m = Vector_Int{} }
+func (m *Vector_Int) String() string { return proto.CompactTextString(m) }
+func (*Vector_Int) ProtoMessage()    {}
+func (*Vector_Int) Descriptor() ([]byte, []int) {
+	return fileDescriptor_854009303dbd8a76, []int{27}
 }
-
-func (c *rPCMessageClient) MessageGetUserMessage(ctx context.Context, in *TLMessageGetUserMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error) {
-	out := new(mtproto.MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUserMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Vector_Int) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *rPCMessageClient) MessageGetUserMessageList(ctx context.Context, in *TLMessageGetUserMessageList, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUserMessageList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Vector_Int) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_Int.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *rPCMessageClient) MessageGetUserMessageListByDataIdList(ctx context.Context, in *TLMessageGetUserMessageListByDataIdList, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUserMessageListByDataIdList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Vector_Int) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_Int.Merge(m, src)
 }
-
-func (c *rPCMessageClient) MessageGetUserMessageListByDataIdUserIdList(ctx context.Context, in *TLMessageGetUserMessageListByDataIdUserIdList, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUserMessageListByDataIdUserIdList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Vector_Int) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_Int) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_Int.DiscardUnknown(m)
 }
 
-func (c *rPCMessageClient) MessageGetHistoryMessages(ctx context.Context, in *TLMessageGetHistoryMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getHistoryMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Vector_Int proto.InternalMessageInfo
+
+func (m *Vector_Int) GetDatas() []int32 {
+	if m != nil {
+		return m.Datas
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rPCMessageClient) MessageGetHistoryMessagesCount(ctx context.Context, in *TLMessageGetHistoryMessagesCount, opts ...grpc.CallOption) (*mtproto.Int32, error) {
-	out := new(mtproto.Int32)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getHistoryMessagesCount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// --------------------------------------------------------------------------------------------
+// message.getScheduledMessageHistory user_id:long peer_type:int peer_id:long = Vector<MessageBox>;
+type TLMessageGetScheduledMessageHistory struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,4,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *rPCMessageClient) MessageGetPeerUserMessageId(ctx context.Context, in *TLMessageGetPeerUserMessageId, opts ...grpc.CallOption) (*mtproto.Int32, error) {
-	out := new(mtproto.Int32)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getPeerUserMessageId", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TLMessageGetScheduledMessageHistory) Reset()         { *m = TLMessageGetScheduledMessageHistory{} }
+func (m *TLMessageGetScheduledMessageHistory) String() string { return proto.CompactTextString(m) }
+func (*TLMessageGetScheduledMessageHistory) ProtoMessage()    {}
+func (*TLMessageGetScheduledMessageHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_854009303dbd8a76, []int{28}
 }
-
-func (c *rPCMessageClient) MessageGetPeerUserMessage(ctx context.Context, in *TLMessageGetPeerUserMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error) {
-	out := new(mtproto.MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getPeerUserMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TLMessageGetScheduledMessageHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *rPCMessageClient) MessageSearchByMediaType(ctx context.Context, in *TLMessageSearchByMediaType, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_searchByMediaType", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessageHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMessageGetScheduledMessageHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TLMessageGetScheduledMessageHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMessageGetScheduledMessageHistory.Merge(m, src)
+}
+func (m *TLMessageGetScheduledMessageHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMessageGetScheduledMessageHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMessageGetScheduledMessageHistory.DiscardUnknown(m)
 }
 
-func (c *rPCMessageClient) MessageSearch(ctx context.Context, in *TLMessageSearch, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_search", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TLMessageGetScheduledMessageHistory proto.InternalMessageInfo
+
+func (m *TLMessageGetScheduledMessageHistory) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return out, nil
+	return CRC32_UNKNOWN
 }
 
-func (c *rPCMessageClient) MessageSearchGlobal(ctx context.Context, in *TLMessageSearchGlobal, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_searchGlobal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessageHistory) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMessageClient) MessageSearchByPinned(ctx context.Context, in *TLMessageSearchByPinned, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_searchByPinned", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessageHistory) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMessageClient) MessageGetSearchCounter(ctx context.Context, in *TLMessageGetSearchCounter, opts ...grpc.CallOption) (*mtproto.Int32, error) {
-	out := new(mtproto.Int32)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getSearchCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessageHistory) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMessageClient) MessageSearchV2(ctx context.Context, in *TLMessageSearchV2, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_searchV2", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// --------------------------------------------------------------------------------------------
+// message.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<MessageBox>;
+type TLMessageGetScheduledMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=message.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,4,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,6,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *rPCMessageClient) MessageGetLastTwoPinnedMessageId(ctx context.Context, in *TLMessageGetLastTwoPinnedMessageId, opts ...grpc.CallOption) (*Vector_Int, error) {
-	out := new(Vector_Int)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getLastTwoPinnedMessageId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessages) Reset()         { *m = TLMessageGetScheduledMessages{} }
+func (m *TLMessageGetScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*TLMessageGetScheduledMessages) ProtoMessage()    {}
+func (*TLMessageGetScheduledMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_854009303dbd8a76, []int{29}
+}
+func (m *TLMessageGetScheduledMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMessageGetScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMessageGetScheduledMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TLMessageGetScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMessageGetScheduledMessages.Merge(m, src)
+}
+func (m *TLMessageGetScheduledMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMessageGetScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMessageGetScheduledMessages.DiscardUnknown(m)
 }
 
-func (c *rPCMessageClient) MessageUpdatePinnedMessageId(ctx context.Context, in *TLMessageUpdatePinnedMessageId, opts ...grpc.CallOption) (*mtproto.Bool, error) {
-	out := new(mtproto.Bool)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_updatePinnedMessageId", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TLMessageGetScheduledMessages proto.InternalMessageInfo
+
+func (m *TLMessageGetScheduledMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return out, nil
+	return CRC32_UNKNOWN
 }
 
-func (c *rPCMessageClient) MessageGetPinnedMessageIdList(ctx context.Context, in *TLMessageGetPinnedMessageIdList, opts ...grpc.CallOption) (*Vector_Int, error) {
-	out := new(Vector_Int)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getPinnedMessageIdList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMessageClient) MessageUnPinAllMessages(ctx context.Context, in *TLMessageUnPinAllMessages, opts ...grpc.CallOption) (*Vector_Int, error) {
-	out := new(Vector_Int)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_unPinAllMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessages) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMessageClient) MessageGetUnreadMentions(ctx context.Context, in *TLMessageGetUnreadMentions, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
-	out := new(Vector_MessageBox)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUnreadMentions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessages) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMessageClient) MessageGetUnreadMentionsCount(ctx context.Context, in *TLMessageGetUnreadMentionsCount, opts ...grpc.CallOption) (*mtproto.Int32, error) {
-	out := new(mtproto.Int32)
-	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUnreadMentionsCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMessageGetScheduledMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return nil
 }
 
-// RPCMessageServer is the server API for RPCMessage service.
-type RPCMessageServer interface {
-	MessageGetUserMessage(context.Context, *TLMessageGetUserMessage) (*mtproto.MessageBox, error)
-	MessageGetUserMessageList(context.Context, *TLMessageGetUserMessageList) (*Vector_MessageBox, error)
-	MessageGetUserMessageListByDataIdList(context.Context, *TLMessageGetUserMessageListByDataIdList) (*Vector_MessageBox, error)
-	MessageGetUserMessageListByDataIdUserIdList(context.Context, *TLMessageGetUserMessageListByDataIdUserIdList) (*Vector_MessageBox, error)
-	MessageGetHistoryMessages(context.Context, *TLMessageGetHistoryMessages) (*Vector_MessageBox, error)
-	MessageGetHistoryMessagesCount(context.Context, *TLMessageGetHistoryMessagesCount) (*mtproto.Int32, error)
-	MessageGetPeerUserMessageId(context.Context, *TLMessageGetPeerUserMessageId) (*mtproto.Int32, error)
-	MessageGetPeerUserMessage(context.Context, *TLMessageGetPeerUserMessage) (*mtproto.MessageBox, error)
-	MessageSearchByMediaType(context.Context, *TLMessageSearchByMediaType) (*Vector_MessageBox, error)
-	MessageSearch(context.Context, *TLMessageSearch) (*Vector_MessageBox, error)
-	MessageSearchGlobal(context.Context, *TLMessageSearchGlobal) (*Vector_MessageBox, error)
-	MessageSearchByPinned(context.Context, *TLMessageSearchByPinned) (*Vector_MessageBox, error)
-	MessageGetSearchCounter(context.Context, *TLMessageGetSearchCounter) (*mtproto.Int32, error)
-	MessageSearchV2(context.Context, *TLMessageSearchV2) (*Vector_MessageBox, error)
-	MessageGetLastTwoPinnedMessageId(context.Context, *TLMessageGetLastTwoPinnedMessageId) (*Vector_Int, error)
-	MessageUpdatePinnedMessageId(context.Context, *TLMessageUpdatePinnedMessageId) (*mtproto.Bool, error)
-	MessageGetPinnedMessageIdList(context.Context, *TLMessageGetPinnedMessageIdList) (*Vector_Int, error)
-	MessageUnPinAllMessages(context.Context, *TLMessageUnPinAllMessages) (*Vector_Int, error)
-	MessageGetUnreadMentions(context.Context, *TLMessageGetUnreadMentions) (*Vector_MessageBox, error)
-	MessageGetUnreadMentionsCount(context.Context, *TLMessageGetUnreadMentionsCount) (*mtproto.Int32, error)
+func init() {
+	proto.RegisterEnum("message.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*TLMessageGetUserMessage)(nil), "message.TL_message_getUserMessage")
+	proto.RegisterType((*TLMessageGetUserMessageList)(nil), "message.TL_message_getUserMessageList")
+	proto.RegisterType((*TLMessageGetUserMessageListByDataIdList)(nil), "message.TL_message_getUserMessageListByDataIdList")
+	proto.RegisterType((*TLMessageGetUserMessageListByDataIdUserIdList)(nil), "message.TL_message_getUserMessageListByDataIdUserIdList")
+	proto.RegisterType((*TLMessageGetHistoryMessages)(nil), "message.TL_message_getHistoryMessages")
+	proto.RegisterType((*TLMessageGetHistoryMessagesCount)(nil), "message.TL_message_getHistoryMessagesCount")
+	proto.RegisterType((*TLMessageGetPeerUserMessageId)(nil), "message.TL_message_getPeerUserMessageId")
+	proto.RegisterType((*TLMessageGetPeerUserMessage)(nil), "message.TL_message_getPeerUserMessage")
+	proto.RegisterType((*TLMessageSearchByMediaType)(nil), "message.TL_message_searchByMediaType")
+	proto.RegisterType((*TLMessageSearch)(nil), "message.TL_message_search")
+	proto.RegisterType((*TLMessageSearchGlobal)(nil), "message.TL_message_searchGlobal")
+	proto.RegisterType((*TLMessageSearchByPinned)(nil), "message.TL_message_searchByPinned")
+	proto.RegisterType((*TLMessageGetSearchCounter)(nil), "message.TL_message_getSearchCounter")
+	proto.RegisterType((*TLMessageSearchV2)(nil), "message.TL_message_searchV2")
+	proto.RegisterType((*TLMessageGetLastTwoPinnedMessageId)(nil), "message.TL_message_getLastTwoPinnedMessageId")
+	proto.RegisterType((*TLMessageUpdatePinnedMessageId)(nil), "message.TL_message_updatePinnedMessageId")
+	proto.RegisterType((*TLMessageGetPinnedMessageIdList)(nil), "message.TL_message_getPinnedMessageIdList")
+	proto.RegisterType((*TLMessageUnPinAllMessages)(nil), "message.TL_message_unPinAllMessages")
+	proto.RegisterType((*TLMessageGetUnreadMentions)(nil), "message.TL_message_getUnreadMentions")
+	proto.RegisterType((*TLMessageGetUnreadMentionsCount)(nil), "message.TL_message_getUnreadMentionsCount")
+	proto.RegisterType((*TLMessageGetMessageReactionsList)(nil), "message.TL_message_getMessageReactionsList")
+	proto.RegisterType((*TLMessageCreatePoll)(nil), "message.TL_message_createPoll")
+	proto.RegisterType((*TLMessageGetMediaPoll)(nil), "message.TL_message_getMediaPoll")
+	proto.RegisterType((*TLMessageSendVote)(nil), "message.TL_message_sendVote")
+	proto.RegisterType((*TLMessageClosePoll)(nil), "message.TL_message_closePoll")
+	proto.RegisterType((*TLMessageGetPollVotes)(nil), "message.TL_message_getPollVotes")
+	proto.RegisterType((*TLMessageGetScheduledMessageHistory)(nil), "message.TL_message_getScheduledMessageHistory")
+	proto.RegisterType((*TLMessageGetScheduledMessages)(nil), "message.TL_message_getScheduledMessages")
+	proto.RegisterType((*Vector_MessageBox)(nil), "message.Vector_MessageBox")
+	proto.RegisterType((*Vector_Int)(nil), "message.Vector_Int")
 }
 
-// UnimplementedRPCMessageServer can be embedded to have forward compatible implementations.
-type UnimplementedRPCMessageServer struct {
-}
+func init() { proto.RegisterFile("message.tl.proto", fileDescriptor_854009303dbd8a76) }
 
-func (*UnimplementedRPCMessageServer) MessageGetUserMessage(ctx context.Context, req *TLMessageGetUserMessage) (*mtproto.MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUserMessage not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetUserMessageList(ctx context.Context, req *TLMessageGetUserMessageList) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUserMessageList not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetUserMessageListByDataIdList(ctx context.Context, req *TLMessageGetUserMessageListByDataIdList) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUserMessageListByDataIdList not implemented")
+var fileDescriptor_854009303dbd8a76 = []byte{
+	// 2047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xf6, 0xd8, 0xeb, 0x59, 0xef, 0xb1, 0xe3, 0x8e, 0x6f, 0x1c, 0x7b, 0x3c, 0xb1, 0xd7, 0x9b,
+	0x69, 0x9c, 0x38, 0x69, 0x6c, 0xc3, 0x86, 0x07, 0xc4, 0x03, 0x52, 0xed, 0x56, 0x65, 0x69, 0xfe,
+	0xb4, 0x8d, 0x5d, 0x29, 0x48, 0x6c, 0xc7, 0x3b, 0x37, 0xeb, 0x91, 0x76, 0x67, 0xb6, 0x33, 0xb3,
+	0x8d, 0x37, 0x0f, 0x3c, 0x40, 0x69, 0x03, 0x48, 0xfc, 0x8a, 0x36, 0xfc, 0x54, 0x28, 0x6d, 0x55,
+	0xb5, 0x14, 0x8a, 0x40, 0x08, 0x50, 0x79, 0x49, 0xa9, 0x1a, 0xb5, 0xa0, 0x4a, 0x11, 0x2a, 0x56,
+	0x10, 0x54, 0x4a, 0x8d, 0x90, 0x78, 0x42, 0x95, 0xca, 0x53, 0xdb, 0xd4, 0xe8, 0xfe, 0xec, 0xee,
+	0xfc, 0x7a, 0x9d, 0x56, 0x1b, 0x6d, 0x9e, 0xbc, 0xf7, 0x9e, 0x73, 0xcf, 0xf9, 0xee, 0xb9, 0xe7,
+	0x9e, 0x73, 0xee, 0x19, 0x83, 0x54, 0xc1, 0x8e, 0xa3, 0x95, 0xf0, 0xbc, 0x5b, 0x9e, 0xaf, 0xda,
+	0x96, 0x6b, 0xa1, 0x24, 0x9f, 0x51, 0xe6, 0x4a, 0x86, 0xbb, 0x56, 0x5b, 0x9d, 0x2f, 0x5a, 0x95,
+	0x85, 0x92, 0x55, 0xb2, 0x16, 0x28, 0x7d, 0xb5, 0x76, 0x96, 0x8e, 0xe8, 0x80, 0xfe, 0x62, 0xeb,
+	0x94, 0x74, 0xc9, 0xb2, 0x4a, 0x65, 0xdc, 0xe2, 0x3a, 0x67, 0x6b, 0xd5, 0x2a, 0xb6, 0x1d, 0x4e,
+	0x57, 0x9c, 0xe2, 0x1a, 0xae, 0x68, 0x44, 0x51, 0xd1, 0xb2, 0x71, 0xc1, 0xad, 0x57, 0x71, 0x83,
+	0x36, 0xd1, 0xa2, 0xb9, 0xb6, 0x66, 0x3a, 0x55, 0xcb, 0x76, 0x39, 0x69, 0xb4, 0x45, 0x72, 0xea,
+	0x66, 0x91, 0xcd, 0xaa, 0x5f, 0x81, 0x89, 0xd3, 0xc7, 0x0a, 0x1c, 0x69, 0xa1, 0x84, 0xdd, 0x65,
+	0x07, 0xdb, 0xc7, 0xd9, 0x10, 0x7d, 0x16, 0x06, 0x8b, 0x96, 0xe9, 0xb8, 0x76, 0xad, 0xe8, 0x5a,
+	0xb6, 0x2c, 0x64, 0x84, 0xd9, 0xe1, 0xec, 0xd8, 0x7c, 0x63, 0xa7, 0xa7, 0x8f, 0x2d, 0xb5, 0xa8,
+	0x79, 0x2f, 0x2b, 0x1a, 0x87, 0x64, 0xcd, 0xc1, 0x76, 0xc1, 0xd0, 0xe5, 0xbe, 0x8c, 0x30, 0xdb,
+	0x97, 0x17, 0xc9, 0x30, 0xa7, 0xa3, 0x61, 0xe8, 0x35, 0x74, 0x39, 0x91, 0x11, 0x66, 0xfb, 0xf3,
+	0xbd, 0x86, 0xae, 0x7e, 0x4b, 0x80, 0xa9, 0x58, 0x00, 0xc7, 0x0c, 0xc7, 0xed, 0x04, 0x88, 0x71,
+	0x48, 0x1a, 0x7a, 0xa1, 0x6c, 0x38, 0xae, 0x9c, 0xc8, 0xf4, 0xcd, 0xf6, 0xe7, 0x45, 0x43, 0x27,
+	0xba, 0xd4, 0x27, 0x05, 0x38, 0xb4, 0x2d, 0x9a, 0xc5, 0xfa, 0x3d, 0x9a, 0xab, 0xe5, 0xf4, 0x5b,
+	0x84, 0xac, 0xaf, 0x89, 0xec, 0x29, 0x01, 0x16, 0x76, 0x84, 0x6c, 0x99, 0x0a, 0xfa, 0x84, 0xf8,
+	0xd8, 0x29, 0x31, 0x68, 0xbd, 0x86, 0x8e, 0x32, 0x30, 0xc4, 0xf1, 0x7a, 0xb1, 0x41, 0xad, 0xa9,
+	0x4b, 0x7d, 0xbb, 0x37, 0x78, 0x8e, 0x5f, 0x30, 0x1c, 0xd7, 0xb2, 0xeb, 0x1c, 0xa2, 0xd3, 0x09,
+	0x6b, 0xed, 0x85, 0x54, 0x15, 0x63, 0x9b, 0xde, 0x00, 0xee, 0x53, 0x03, 0x64, 0xe2, 0x74, 0xbd,
+	0x8a, 0xc9, 0x2a, 0x4a, 0x34, 0x74, 0xb9, 0x9f, 0xad, 0xaa, 0xe2, 0xc6, 0x2a, 0xeb, 0xec, 0x59,
+	0x07, 0xbb, 0x84, 0x24, 0xb2, 0x55, 0x6c, 0x22, 0xa7, 0xa3, 0x69, 0x18, 0xe4, 0x44, 0x5d, 0x73,
+	0xb1, 0x9c, 0xa4, 0x64, 0x60, 0x53, 0xf7, 0x68, 0x2e, 0x46, 0x53, 0x00, 0x9a, 0xae, 0x17, 0xd8,
+	0x8c, 0x3c, 0x40, 0xe9, 0x29, 0x4d, 0xd7, 0x4f, 0xd2, 0x09, 0x34, 0x0a, 0xfd, 0x65, 0xa3, 0x62,
+	0xb8, 0x72, 0x8a, 0x52, 0xd8, 0x00, 0xed, 0x01, 0xb1, 0xa2, 0xad, 0x13, 0x7d, 0xc0, 0xa6, 0x2b,
+	0xda, 0x7a, 0x4e, 0xa7, 0xd3, 0x86, 0x49, 0xa6, 0x07, 0xf9, 0xb4, 0x61, 0xe6, 0x74, 0x84, 0x20,
+	0xb1, 0xa6, 0x39, 0x6b, 0xf2, 0x10, 0x85, 0x4d, 0x7f, 0xab, 0xbf, 0x14, 0x40, 0xdd, 0xd6, 0xbe,
+	0x4b, 0x56, 0xcd, 0x74, 0xbb, 0xc6, 0xc8, 0x04, 0xef, 0xb4, 0x1f, 0xef, 0x29, 0x8c, 0x6d, 0x8f,
+	0xcf, 0xe6, 0xf4, 0x4e, 0x80, 0xcd, 0xc0, 0x10, 0xc5, 0xd3, 0xa0, 0x26, 0x28, 0x15, 0xaa, 0x5c,
+	0x37, 0xb7, 0xb9, 0x53, 0x6a, 0x00, 0x26, 0x36, 0x77, 0x4a, 0x39, 0x5d, 0x7d, 0x31, 0x14, 0x87,
+	0x02, 0x78, 0xbb, 0x0a, 0xed, 0xff, 0x04, 0x98, 0xf4, 0xa0, 0x75, 0xb0, 0x66, 0x17, 0xd7, 0x16,
+	0xeb, 0xc7, 0xb1, 0x6e, 0x68, 0xf4, 0x5c, 0xba, 0xe6, 0xb2, 0x4d, 0x01, 0x54, 0x08, 0x2a, 0xb6,
+	0x8c, 0xdd, 0xb6, 0x54, 0xa5, 0x89, 0x73, 0x0c, 0x44, 0x7e, 0x93, 0xd8, 0x4d, 0x13, 0xad, 0xc0,
+	0x35, 0x1a, 0xf0, 0x5c, 0x23, 0xf5, 0x9a, 0x00, 0x23, 0xa1, 0x6d, 0x77, 0xcf, 0x5e, 0x87, 0x40,
+	0x78, 0x98, 0x6e, 0x31, 0x95, 0x17, 0x1e, 0xbe, 0xc9, 0xad, 0x3d, 0x2f, 0xc0, 0x78, 0x68, 0x6b,
+	0xf7, 0x95, 0xad, 0x55, 0xad, 0xdc, 0x89, 0x0d, 0x52, 0xa8, 0x89, 0x30, 0xd4, 0xfe, 0x68, 0xa8,
+	0xa2, 0x17, 0xea, 0xb3, 0x02, 0x4c, 0x84, 0xa0, 0x2e, 0xd6, 0x4f, 0x19, 0xa6, 0x89, 0xf5, 0xee,
+	0x89, 0x40, 0xaf, 0x09, 0xb0, 0xd7, 0x7f, 0xa3, 0x1f, 0xa0, 0x48, 0x69, 0xa0, 0xc4, 0xf6, 0xed,
+	0x72, 0x45, 0xd4, 0xef, 0xf4, 0xc1, 0xee, 0x90, 0xb9, 0x57, 0xb2, 0xdd, 0xea, 0xf6, 0xe3, 0x90,
+	0x3c, 0x6b, 0x5b, 0x15, 0xc2, 0x96, 0x64, 0x6c, 0x64, 0x98, 0xd3, 0xd1, 0x04, 0x0c, 0x90, 0x64,
+	0x47, 0xd3, 0x2a, 0x73, 0xfd, 0x64, 0xc5, 0x30, 0x69, 0x4e, 0x25, 0x24, 0x6d, 0x9d, 0x91, 0x52,
+	0x9c, 0xa4, 0xad, 0x53, 0x92, 0x2f, 0x59, 0x43, 0x20, 0x59, 0xfb, 0x73, 0xf1, 0x60, 0x6c, 0x2e,
+	0x1e, 0x8a, 0xce, 0xc5, 0xbb, 0xa2, 0x73, 0xf1, 0x70, 0x54, 0x2e, 0xbe, 0xc3, 0x93, 0x8b, 0x7f,
+	0x25, 0xc0, 0x7e, 0xbf, 0x67, 0x1d, 0xd3, 0x1c, 0xf7, 0xf4, 0x39, 0x8b, 0x5d, 0x81, 0x8e, 0x26,
+	0xb8, 0x8f, 0x77, 0x17, 0x36, 0x05, 0xc8, 0x78, 0x10, 0xd7, 0xaa, 0xc4, 0xd4, 0xdd, 0x8a, 0x96,
+	0x57, 0x9f, 0x62, 0xe3, 0x8d, 0x80, 0x66, 0x40, 0xac, 0x52, 0xac, 0xd4, 0xa3, 0x06, 0xb3, 0xbb,
+	0xe6, 0x2b, 0x2e, 0x7d, 0xbd, 0xcc, 0x2f, 0x5a, 0x56, 0x39, 0xcf, 0x89, 0xea, 0x2f, 0x04, 0xd8,
+	0x17, 0x48, 0xe1, 0xfe, 0x1d, 0x76, 0xaa, 0x68, 0xff, 0x78, 0x67, 0xf2, 0x9c, 0x3f, 0x3e, 0xd5,
+	0xcc, 0x53, 0x86, 0x79, 0x77, 0xb9, 0xdc, 0x75, 0xf5, 0xb2, 0xfa, 0x42, 0x2f, 0x4c, 0x06, 0x9e,
+	0x1e, 0xa6, 0x8d, 0x35, 0xfd, 0x38, 0x36, 0x5d, 0xc3, 0x32, 0x6f, 0x97, 0xca, 0xde, 0x1f, 0x2c,
+	0x92, 0xb1, 0xc1, 0x62, 0x20, 0x18, 0x2c, 0x58, 0x54, 0x48, 0x79, 0xa3, 0xc2, 0x38, 0x24, 0x69,
+	0x0c, 0x31, 0x5d, 0x1e, 0x93, 0x48, 0x48, 0xc9, 0x99, 0x6e, 0x84, 0x0f, 0xfa, 0x6d, 0xd5, 0x65,
+	0x55, 0xfa, 0xb5, 0xd0, 0xab, 0x82, 0xbb, 0x5f, 0x1e, 0x6b, 0x45, 0x0a, 0xb8, 0x53, 0x77, 0xa6,
+	0x55, 0xd8, 0x26, 0x3c, 0x85, 0x2d, 0x52, 0x60, 0xc0, 0xe6, 0xaa, 0x29, 0xd4, 0x54, 0xbe, 0x39,
+	0xf6, 0x54, 0x29, 0x2c, 0xd9, 0x84, 0xaa, 0x94, 0xa4, 0xb7, 0x4a, 0x79, 0xb2, 0x17, 0xf6, 0x78,
+	0xb6, 0x56, 0xb4, 0x31, 0x09, 0x79, 0x56, 0xb9, 0x23, 0xe5, 0xd4, 0x3e, 0x48, 0x54, 0xad, 0x72,
+	0x59, 0x4e, 0x04, 0xe2, 0x13, 0xd1, 0x97, 0xa7, 0x24, 0x74, 0x10, 0xee, 0x28, 0x5a, 0xb6, 0x8d,
+	0x8b, 0x6e, 0x41, 0x33, 0x9d, 0x73, 0xd8, 0x76, 0xe4, 0xfe, 0x4c, 0xdf, 0x6c, 0x2a, 0x3f, 0xcc,
+	0xa7, 0xef, 0x66, 0xb3, 0xc4, 0x04, 0x8e, 0x55, 0xae, 0x51, 0x13, 0xb0, 0x8d, 0x36, 0xc7, 0x68,
+	0x09, 0x46, 0x1a, 0xbf, 0x0b, 0xc4, 0xa3, 0x5c, 0x03, 0x3b, 0x72, 0x32, 0xd3, 0x37, 0x3b, 0x98,
+	0x1d, 0x6b, 0x2a, 0xe5, 0x47, 0x78, 0x2f, 0xa1, 0xd7, 0xf3, 0x52, 0x63, 0xc1, 0xbd, 0x9c, 0x5f,
+	0xfd, 0xba, 0xbf, 0xd4, 0xa4, 0x87, 0xae, 0x1b, 0x5a, 0xa7, 0x6c, 0x43, 0x9c, 0xcf, 0x2a, 0x97,
+	0x5b, 0xef, 0x1b, 0x91, 0x0c, 0x73, 0xba, 0xfa, 0x13, 0x21, 0x50, 0xd8, 0x98, 0xfa, 0x8a, 0xe5,
+	0xe2, 0x5b, 0x89, 0x01, 0xc9, 0x90, 0xb4, 0xaa, 0xd4, 0xd1, 0xf9, 0x69, 0x34, 0x86, 0xea, 0x57,
+	0x05, 0x18, 0xf5, 0xfa, 0x4f, 0xd9, 0x72, 0xf0, 0x2d, 0x37, 0xd1, 0x5f, 0x42, 0x47, 0x45, 0x20,
+	0x10, 0x2b, 0x39, 0xb7, 0xd4, 0x4c, 0xe4, 0xea, 0x55, 0x3d, 0x97, 0x92, 0x8f, 0x6e, 0xf2, 0x4a,
+	0x7e, 0x1e, 0x46, 0x56, 0x30, 0x41, 0x50, 0xe0, 0x2e, 0xba, 0x68, 0xad, 0xa3, 0x43, 0xd0, 0xaf,
+	0x6b, 0xae, 0xe6, 0xc8, 0x02, 0x75, 0xe3, 0xdd, 0x41, 0x37, 0x5e, 0xb4, 0xd6, 0xf3, 0x8c, 0x43,
+	0x55, 0x01, 0xf8, 0xfa, 0x9c, 0x49, 0x75, 0xb4, 0x16, 0xf6, 0x73, 0x9e, 0xc3, 0x8f, 0xa7, 0x60,
+	0x97, 0x6f, 0xeb, 0x68, 0x04, 0x76, 0x2d, 0xe5, 0x97, 0x8e, 0x66, 0x0b, 0xcb, 0x27, 0xee, 0x3f,
+	0x71, 0xf2, 0xc1, 0x13, 0x52, 0x0f, 0xda, 0x0f, 0x93, 0x6c, 0x2a, 0xba, 0x9d, 0x26, 0xbd, 0xfe,
+	0xaf, 0xdf, 0x6c, 0x24, 0xd1, 0x1c, 0x64, 0xb6, 0xe3, 0x22, 0x91, 0x51, 0x7a, 0xee, 0x0f, 0xd7,
+	0x7f, 0x7c, 0x63, 0x6b, 0x6b, 0x6b, 0x4b, 0x40, 0x9f, 0x81, 0x23, 0xed, 0xd8, 0xbd, 0xdd, 0x43,
+	0xe9, 0x83, 0x3f, 0x6d, 0x7c, 0x53, 0x40, 0x9f, 0x83, 0xec, 0x4e, 0x57, 0xb5, 0x3a, 0x7b, 0xd2,
+	0xaf, 0xdf, 0x7a, 0xe3, 0xdf, 0xbd, 0xe8, 0x60, 0x04, 0xc0, 0x40, 0x57, 0x48, 0xba, 0x7c, 0xe5,
+	0x69, 0x19, 0x1d, 0x81, 0x99, 0x76, 0x8c, 0x34, 0x31, 0x49, 0x3f, 0xf8, 0xf0, 0x95, 0xf3, 0xe8,
+	0x30, 0xa8, 0x21, 0xee, 0x50, 0xf3, 0x46, 0xfa, 0xd9, 0x7f, 0x5f, 0x7e, 0x2a, 0x89, 0x66, 0x21,
+	0xd3, 0x8e, 0x57, 0xfa, 0xfe, 0xb3, 0x7f, 0xbe, 0x28, 0xa2, 0x83, 0x30, 0xed, 0xe7, 0x0c, 0x35,
+	0x2d, 0xa4, 0x57, 0xff, 0xfa, 0xee, 0x05, 0x01, 0x4d, 0xc2, 0x68, 0x14, 0xa3, 0xf4, 0xcc, 0xf5,
+	0x8d, 0x57, 0x88, 0x18, 0x25, 0x8a, 0xca, 0x5e, 0xca, 0xd2, 0x6b, 0x7f, 0x7c, 0xe7, 0xa7, 0x1f,
+	0xb0, 0xe3, 0x08, 0x9d, 0xb1, 0xff, 0x9d, 0x2a, 0xfd, 0xed, 0x77, 0x6f, 0xbe, 0x27, 0xa2, 0xbb,
+	0x20, 0x1d, 0xc2, 0xef, 0x7b, 0x26, 0x4a, 0x57, 0x3e, 0x7a, 0xe9, 0x89, 0x8f, 0x98, 0xc8, 0x3b,
+	0x61, 0x2c, 0x4a, 0xe4, 0x4a, 0x56, 0xba, 0xb4, 0x71, 0xf1, 0xdb, 0xef, 0x37, 0xdc, 0xe0, 0x60,
+	0x48, 0x62, 0xf4, 0xf3, 0x40, 0x7a, 0xf1, 0xbb, 0xff, 0xf9, 0x90, 0xaf, 0xfa, 0x14, 0xdc, 0xe9,
+	0x5f, 0x15, 0x59, 0xa2, 0x4b, 0x57, 0xff, 0xf1, 0xb5, 0x17, 0xb6, 0xd8, 0x8a, 0x4f, 0xc3, 0xfe,
+	0xb0, 0xe5, 0xc3, 0xf5, 0xae, 0xf4, 0xf6, 0xa5, 0xc7, 0xaf, 0x72, 0x0f, 0x0d, 0x6d, 0x36, 0x58,
+	0x73, 0x4a, 0xef, 0xbd, 0xf5, 0xfc, 0x55, 0xbe, 0xd9, 0xd0, 0x79, 0x85, 0x6a, 0x19, 0xe9, 0xb7,
+	0xdf, 0x78, 0x6c, 0x4b, 0x8c, 0x04, 0x12, 0x51, 0xf4, 0x48, 0x6f, 0x5c, 0x7e, 0xf4, 0xe7, 0xfc,
+	0x6c, 0xe6, 0x22, 0xfc, 0x31, 0xaa, 0xf0, 0x90, 0x5e, 0x7e, 0xf5, 0xa5, 0x1f, 0x8a, 0x68, 0x06,
+	0x64, 0x3f, 0x7b, 0x2b, 0x99, 0x4b, 0x6f, 0x3e, 0x71, 0xe1, 0x69, 0xbe, 0x3d, 0x15, 0x94, 0x08,
+	0xa9, 0x3c, 0xb3, 0x49, 0x97, 0x6e, 0x5c, 0xbe, 0x22, 0xa2, 0x74, 0xf8, 0x08, 0x59, 0xd6, 0x91,
+	0xae, 0xfd, 0xe8, 0xd1, 0xcb, 0x49, 0x34, 0x0d, 0xe3, 0x01, 0x55, 0x8d, 0xb8, 0x2f, 0xdd, 0xf8,
+	0xe7, 0xf7, 0x36, 0x92, 0x91, 0x4a, 0x9a, 0x31, 0x59, 0xfa, 0xfb, 0xef, 0x37, 0xdf, 0x17, 0x95,
+	0xc4, 0x85, 0x67, 0xd2, 0x3d, 0xd9, 0x8b, 0xbb, 0x01, 0xf2, 0xa7, 0x96, 0xf8, 0xbe, 0xd0, 0x03,
+	0x30, 0x16, 0xf3, 0x95, 0x45, 0xf5, 0xc4, 0xec, 0x98, 0x30, 0xa0, 0x44, 0x85, 0x45, 0xb5, 0x07,
+	0xad, 0x82, 0xb2, 0xcd, 0x97, 0x93, 0x03, 0xed, 0x05, 0x13, 0x3e, 0x45, 0x69, 0xf2, 0x85, 0xc2,
+	0xb3, 0xda, 0x83, 0xce, 0xc3, 0x81, 0x1d, 0x7e, 0x0f, 0xc9, 0xee, 0x4c, 0x9f, 0x77, 0x4d, 0x1b,
+	0xdd, 0x8f, 0x09, 0x70, 0xe4, 0xe6, 0x3e, 0x79, 0xdc, 0x1c, 0x84, 0xd6, 0xca, 0x36, 0x40, 0xfc,
+	0x86, 0x0e, 0x7e, 0xda, 0x88, 0x33, 0x74, 0x80, 0xaf, 0x8d, 0x8e, 0x87, 0x60, 0xba, 0x5d, 0x7b,
+	0xff, 0xae, 0x9d, 0x29, 0xa2, 0xcc, 0xca, 0x70, 0xd3, 0x67, 0x72, 0xa6, 0x7b, 0x34, 0xab, 0xf6,
+	0xa0, 0x33, 0x30, 0xb9, 0x6d, 0x43, 0x7e, 0x36, 0x46, 0x7c, 0x88, 0x33, 0x42, 0xf6, 0x97, 0x40,
+	0x89, 0x5f, 0x11, 0x6b, 0xa1, 0x00, 0x5f, 0x9c, 0x9f, 0x3f, 0x04, 0x13, 0xf1, 0xbd, 0xee, 0x99,
+	0x28, 0xd9, 0x21, 0xb6, 0x36, 0xc6, 0xff, 0x22, 0x0c, 0xfb, 0x97, 0x22, 0x25, 0x5e, 0x6c, 0x1b,
+	0x59, 0x2b, 0x30, 0x1a, 0xd9, 0xc7, 0xcd, 0xc4, 0x4b, 0x64, 0x1c, 0x6d, 0xe4, 0x9e, 0x81, 0x31,
+	0xff, 0xaa, 0x66, 0xd3, 0x55, 0xdd, 0xce, 0x04, 0x8c, 0xa7, 0x8d, 0xec, 0x3c, 0xc8, 0xb1, 0x9d,
+	0xd2, 0xfd, 0x31, 0x87, 0xe7, 0xe3, 0x8a, 0x70, 0x89, 0x13, 0x20, 0xf9, 0xb1, 0xac, 0x64, 0xd1,
+	0x64, 0x3c, 0xd2, 0x95, 0x6c, 0x1b, 0x8c, 0x06, 0xec, 0x6b, 0xdf, 0x73, 0x9b, 0x8b, 0x01, 0x1b,
+	0xcd, 0x4e, 0x1c, 0xce, 0xaf, 0x91, 0xbc, 0xe2, 0x89, 0x37, 0x4f, 0x6d, 0xdf, 0x2c, 0x3b, 0x14,
+	0xa5, 0x26, 0x92, 0x55, 0xf1, 0xb7, 0xab, 0xd4, 0x1e, 0x54, 0x84, 0x74, 0x9b, 0x26, 0xd5, 0xe1,
+	0xb8, 0xeb, 0x12, 0xe6, 0x8d, 0xdb, 0xc1, 0x83, 0x20, 0xc7, 0xa5, 0xf9, 0xe8, 0x03, 0x0d, 0x72,
+	0xc5, 0x09, 0xf6, 0xdc, 0xc5, 0x70, 0x2b, 0x68, 0x26, 0x2e, 0xfe, 0xfa, 0xd8, 0xda, 0x9c, 0xf3,
+	0x97, 0x21, 0x1d, 0xbb, 0x94, 0xc5, 0xc1, 0xc3, 0x3b, 0x52, 0x13, 0x17, 0x06, 0x1f, 0xf1, 0x05,
+	0xda, 0xc8, 0x8e, 0x47, 0x5c, 0xa0, 0x8d, 0x62, 0x56, 0x0e, 0x34, 0x35, 0x70, 0x4e, 0xa7, 0x10,
+	0x29, 0xf4, 0x7e, 0x40, 0x11, 0xed, 0x88, 0x74, 0x94, 0xaa, 0x16, 0x5d, 0xd9, 0x13, 0x0c, 0x89,
+	0x34, 0xa4, 0xa1, 0x93, 0x30, 0xea, 0xc3, 0xd5, 0x78, 0xc1, 0x67, 0x62, 0x91, 0x73, 0x8e, 0x38,
+	0x81, 0xf7, 0x81, 0x14, 0x2c, 0x8a, 0xe2, 0x6e, 0x2b, 0xa3, 0xc6, 0x09, 0xca, 0xc1, 0x48, 0xf8,
+	0xd5, 0x3c, 0x15, 0xb9, 0xcb, 0x06, 0x39, 0x4e, 0xd4, 0xb2, 0x6f, 0x93, 0xad, 0xb7, 0x6f, 0xdc,
+	0x26, 0x9b, 0x1c, 0xca, 0xde, 0xf0, 0x99, 0x50, 0x02, 0xcd, 0xfe, 0xcb, 0xef, 0xbe, 0x93, 0x16,
+	0x5e, 0xdf, 0x4c, 0x0b, 0x57, 0x37, 0xd3, 0xc2, 0xf5, 0xcd, 0xb4, 0x70, 0x66, 0xc9, 0xf3, 0x2f,
+	0x3a, 0x2e, 0xd6, 0x2a, 0x25, 0x5b, 0x6b, 0xfd, 0x98, 0x73, 0xb0, 0xfd, 0x08, 0xb6, 0x17, 0xb4,
+	0x6a, 0x75, 0x81, 0xfc, 0x34, 0x8a, 0x78, 0x61, 0xd5, 0x38, 0xbf, 0xc0, 0x25, 0x37, 0xfe, 0xae,
+	0x8a, 0x54, 0xe1, 0xd1, 0xff, 0x0f, 0x00, 0xde, 0x43, 0x65, 0x30, 0x0b, 0x24, 0x00, 0x00,
 }
-func (*UnimplementedRPCMessageServer) MessageGetUserMessageListByDataIdUserIdList(ctx context.Context, req *TLMessageGetUserMessageListByDataIdUserIdList) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUserMessageListByDataIdUserIdList not implemented")
+
+func (this *TLMessageGetUserMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&message.TLMessageGetUserMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (*UnimplementedRPCMessageServer) MessageGetHistoryMessages(ctx context.Context, req *TLMessageGetHistoryMessages) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetHistoryMessages not implemented")
+func (this *TLMessageGetUserMessageList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&message.TLMessageGetUserMessageList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "IdList: "+fmt.Sprintf("%#v", this.IdList)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (*UnimplementedRPCMessageServer) MessageGetHistoryMessagesCount(ctx context.Context, req *TLMessageGetHistoryMessagesCount) (*mtproto.Int32, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetHistoryMessagesCount not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetPeerUserMessageId(ctx context.Context, req *TLMessageGetPeerUserMessageId) (*mtproto.Int32, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetPeerUserMessageId not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetPeerUserMessage(ctx context.Context, req *TLMessageGetPeerUserMessage) (*mtproto.MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetPeerUserMessage not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageSearchByMediaType(ctx context.Context, req *TLMessageSearchByMediaType) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageSearchByMediaType not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageSearch(ctx context.Context, req *TLMessageSearch) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageSearch not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageSearchGlobal(ctx context.Context, req *TLMessageSearchGlobal) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageSearchGlobal not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageSearchByPinned(ctx context.Context, req *TLMessageSearchByPinned) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageSearchByPinned not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetSearchCounter(ctx context.Context, req *TLMessageGetSearchCounter) (*mtproto.Int32, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetSearchCounter not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageSearchV2(ctx context.Context, req *TLMessageSearchV2) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageSearchV2 not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetLastTwoPinnedMessageId(ctx context.Context, req *TLMessageGetLastTwoPinnedMessageId) (*Vector_Int, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetLastTwoPinnedMessageId not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageUpdatePinnedMessageId(ctx context.Context, req *TLMessageUpdatePinnedMessageId) (*mtproto.Bool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageUpdatePinnedMessageId not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetPinnedMessageIdList(ctx context.Context, req *TLMessageGetPinnedMessageIdList) (*Vector_Int, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetPinnedMessageIdList not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageUnPinAllMessages(ctx context.Context, req *TLMessageUnPinAllMessages) (*Vector_Int, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageUnPinAllMessages not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetUnreadMentions(ctx context.Context, req *TLMessageGetUnreadMentions) (*Vector_MessageBox, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUnreadMentions not implemented")
-}
-func (*UnimplementedRPCMessageServer) MessageGetUnreadMentionsCount(ctx context.Context, req *TLMessageGetUnreadMentionsCount) (*mtproto.Int32, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUnreadMentionsCount not implemented")
-}
-
-func RegisterRPCMessageServer(s *grpc.Server, srv RPCMessageServer) {
-	s.RegisterService(&_RPCMessage_serviceDesc, srv)
-}
-
-func _RPCMessage_MessageGetUserMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetUserMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetUserMessage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetUserMessage",
+func (this *TLMessageGetUserMessageListByDataIdList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetUserMessage(ctx, req.(*TLMessageGetUserMessage))
+	s := make([]string, 0, 7)
+	s = append(s, "&message.TLMessageGetUserMessageListByDataIdList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "IdList: "+fmt.Sprintf("%#v", this.IdList)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageGetUserMessageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetUserMessageList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetUserMessageList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetUserMessageList",
+func (this *TLMessageGetUserMessageListByDataIdUserIdList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetUserMessageList(ctx, req.(*TLMessageGetUserMessageList))
+	s := make([]string, 0, 7)
+	s = append(s, "&message.TLMessageGetUserMessageListByDataIdUserIdList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "UserIdList: "+fmt.Sprintf("%#v", this.UserIdList)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageGetUserMessageListByDataIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetUserMessageListByDataIdList)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageGetHistoryMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetUserMessageListByDataIdList(ctx, in)
+	s := make([]string, 0, 15)
+	s = append(s, "&message.TLMessageGetHistoryMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
+	s = append(s, "OffsetDate: "+fmt.Sprintf("%#v", this.OffsetDate)+",\n")
+	s = append(s, "AddOffset: "+fmt.Sprintf("%#v", this.AddOffset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	s = append(s, "MinId: "+fmt.Sprintf("%#v", this.MinId)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetUserMessageListByDataIdList",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageGetHistoryMessagesCount) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetUserMessageListByDataIdList(ctx, req.(*TLMessageGetUserMessageListByDataIdList))
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageGetHistoryMessagesCount{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageGetUserMessageListByDataIdUserIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetUserMessageListByDataIdUserIdList)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageGetPeerUserMessageId) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetUserMessageListByDataIdUserIdList(ctx, in)
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageGetPeerUserMessageId{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerUserId: "+fmt.Sprintf("%#v", this.PeerUserId)+",\n")
+	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetUserMessageListByDataIdUserIdList",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageGetPeerUserMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetUserMessageListByDataIdUserIdList(ctx, req.(*TLMessageGetUserMessageListByDataIdUserIdList))
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageGetPeerUserMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerUserId: "+fmt.Sprintf("%#v", this.PeerUserId)+",\n")
+	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageGetHistoryMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetHistoryMessages)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageSearchByMediaType) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetHistoryMessages(ctx, in)
+	s := make([]string, 0, 11)
+	s = append(s, "&message.TLMessageSearchByMediaType{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "MediaType: "+fmt.Sprintf("%#v", this.MediaType)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetHistoryMessages",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageSearch) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetHistoryMessages(ctx, req.(*TLMessageGetHistoryMessages))
+	s := make([]string, 0, 11)
+	s = append(s, "&message.TLMessageSearch{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageGetHistoryMessagesCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetHistoryMessagesCount)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageSearchGlobal) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetHistoryMessagesCount(ctx, in)
+	s := make([]string, 0, 9)
+	s = append(s, "&message.TLMessageSearchGlobal{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetHistoryMessagesCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetHistoryMessagesCount(ctx, req.(*TLMessageGetHistoryMessagesCount))
-	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageGetPeerUserMessageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetPeerUserMessageId)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageSearchByPinned) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetPeerUserMessageId(ctx, in)
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageSearchByPinned{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetPeerUserMessageId",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageGetSearchCounter) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetPeerUserMessageId(ctx, req.(*TLMessageGetPeerUserMessageId))
+	s := make([]string, 0, 9)
+	s = append(s, "&message.TLMessageGetSearchCounter{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "MediaType: "+fmt.Sprintf("%#v", this.MediaType)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageGetPeerUserMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetPeerUserMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageSearchV2) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetPeerUserMessage(ctx, in)
+	s := make([]string, 0, 18)
+	s = append(s, "&message.TLMessageSearchV2{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
+	s = append(s, "FromId: "+fmt.Sprintf("%#v", this.FromId)+",\n")
+	s = append(s, "MinDate: "+fmt.Sprintf("%#v", this.MinDate)+",\n")
+	s = append(s, "MaxDate: "+fmt.Sprintf("%#v", this.MaxDate)+",\n")
+	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
+	s = append(s, "AddOffset: "+fmt.Sprintf("%#v", this.AddOffset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	s = append(s, "MinId: "+fmt.Sprintf("%#v", this.MinId)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetPeerUserMessage",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageGetLastTwoPinnedMessageId) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetPeerUserMessage(ctx, req.(*TLMessageGetPeerUserMessage))
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageGetLastTwoPinnedMessageId{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageSearchByMediaType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageSearchByMediaType)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageSearchByMediaType(ctx, in)
+func (this *TLMessageUpdatePinnedMessageId) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageSearchByMediaType",
+	s := make([]string, 0, 10)
+	s = append(s, "&message.TLMessageUpdatePinnedMessageId{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.Pinned != nil {
+		s = append(s, "Pinned: "+fmt.Sprintf("%#v", this.Pinned)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageSearchByMediaType(ctx, req.(*TLMessageSearchByMediaType))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageSearch)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageGetPinnedMessageIdList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageSearch(ctx, in)
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageGetPinnedMessageIdList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageSearch",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageUnPinAllMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageSearch(ctx, req.(*TLMessageSearch))
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageUnPinAllMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageSearchGlobal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageSearchGlobal)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageGetUnreadMentions) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageSearchGlobal(ctx, in)
+	s := make([]string, 0, 13)
+	s = append(s, "&message.TLMessageGetUnreadMentions{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
+	s = append(s, "AddOffset: "+fmt.Sprintf("%#v", this.AddOffset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "MinId: "+fmt.Sprintf("%#v", this.MinId)+",\n")
+	s = append(s, "MaxInt: "+fmt.Sprintf("%#v", this.MaxInt)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageSearchGlobal",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageGetUnreadMentionsCount) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageSearchGlobal(ctx, req.(*TLMessageSearchGlobal))
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageGetUnreadMentionsCount{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageSearchByPinned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageSearchByPinned)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageGetMessageReactionsList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageSearchByPinned(ctx, in)
+	s := make([]string, 0, 10)
+	s = append(s, "&message.TLMessageGetMessageReactionsList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
+	s = append(s, "Reaction: "+fmt.Sprintf("%#v", this.Reaction)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageSearchByPinned",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageCreatePoll) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageSearchByPinned(ctx, req.(*TLMessageSearchByPinned))
+	s := make([]string, 0, 10)
+	s = append(s, "&message.TLMessageCreatePoll{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Poll != nil {
+		s = append(s, "Poll: "+fmt.Sprintf("%#v", this.Poll)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPCMessage_MessageGetSearchCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetSearchCounter)
-	if err := dec(in); err != nil {
-		return nil, err
+	s = append(s, "CorrectAnswers: "+fmt.Sprintf("%#v", this.CorrectAnswers)+",\n")
+	s = append(s, "Solution: "+fmt.Sprintf("%#v", this.Solution)+",\n")
+	if this.SolutionEntities != nil {
+		s = append(s, "SolutionEntities: "+fmt.Sprintf("%#v", this.SolutionEntities)+",\n")
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetSearchCounter(ctx, in)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetSearchCounter",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageGetMediaPoll) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetSearchCounter(ctx, req.(*TLMessageGetSearchCounter))
+	s := make([]string, 0, 7)
+	s = append(s, "&message.TLMessageGetMediaPoll{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PollId: "+fmt.Sprintf("%#v", this.PollId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageSearchV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageSearchV2)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageSendVote) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageSearchV2(ctx, in)
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageSendVote{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PollId: "+fmt.Sprintf("%#v", this.PollId)+",\n")
+	s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageSearchV2",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageClosePoll) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageSearchV2(ctx, req.(*TLMessageSearchV2))
+	s := make([]string, 0, 7)
+	s = append(s, "&message.TLMessageClosePoll{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PollId: "+fmt.Sprintf("%#v", this.PollId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageGetLastTwoPinnedMessageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetLastTwoPinnedMessageId)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageGetPollVotes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetLastTwoPinnedMessageId(ctx, in)
+	s := make([]string, 0, 10)
+	s = append(s, "&message.TLMessageGetPollVotes{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PollId: "+fmt.Sprintf("%#v", this.PollId)+",\n")
+	s = append(s, "Option: "+fmt.Sprintf("%#v", this.Option)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetLastTwoPinnedMessageId",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMessageGetScheduledMessageHistory) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetLastTwoPinnedMessageId(ctx, req.(*TLMessageGetLastTwoPinnedMessageId))
+	s := make([]string, 0, 8)
+	s = append(s, "&message.TLMessageGetScheduledMessageHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMessage_MessageUpdatePinnedMessageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageUpdatePinnedMessageId)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMessageGetScheduledMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageUpdatePinnedMessageId(ctx, in)
+	s := make([]string, 0, 9)
+	s = append(s, "&message.TLMessageGetScheduledMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageUpdatePinnedMessageId",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_MessageBox) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageUpdatePinnedMessageId(ctx, req.(*TLMessageUpdatePinnedMessageId))
+	s := make([]string, 0, 5)
+	s = append(s, "&message.Vector_MessageBox{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPCMessage_MessageGetPinnedMessageIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetPinnedMessageIdList)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetPinnedMessageIdList(ctx, in)
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_Int) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetPinnedMessageIdList",
+	s := make([]string, 0, 5)
+	s = append(s, "&message.Vector_Int{")
+	s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetPinnedMessageIdList(ctx, req.(*TLMessageGetPinnedMessageIdList))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessageTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	return interceptor(ctx, in, info, handler)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 
-func _RPCMessage_MessageUnPinAllMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageUnPinAllMessages)
-	if err := dec(in); err != nil {
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCMessageClient is the client API for RPCMessage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCMessageClient interface {
+	MessageGetUserMessage(ctx context.Context, in *TLMessageGetUserMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error)
+	MessageGetUserMessageList(ctx context.Context, in *TLMessageGetUserMessageList, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageGetUserMessageListByDataIdList(ctx context.Context, in *TLMessageGetUserMessageListByDataIdList, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageGetUserMessageListByDataIdUserIdList(ctx context.Context, in *TLMessageGetUserMessageListByDataIdUserIdList, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageGetHistoryMessages(ctx context.Context, in *TLMessageGetHistoryMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageGetHistoryMessagesCount(ctx context.Context, in *TLMessageGetHistoryMessagesCount, opts ...grpc.CallOption) (*mtproto.Int32, error)
+	MessageGetPeerUserMessageId(ctx context.Context, in *TLMessageGetPeerUserMessageId, opts ...grpc.CallOption) (*mtproto.Int32, error)
+	MessageGetPeerUserMessage(ctx context.Context, in *TLMessageGetPeerUserMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error)
+	MessageSearchByMediaType(ctx context.Context, in *TLMessageSearchByMediaType, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageSearch(ctx context.Context, in *TLMessageSearch, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageSearchGlobal(ctx context.Context, in *TLMessageSearchGlobal, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageSearchByPinned(ctx context.Context, in *TLMessageSearchByPinned, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageGetSearchCounter(ctx context.Context, in *TLMessageGetSearchCounter, opts ...grpc.CallOption) (*mtproto.Int32, error)
+	MessageSearchV2(ctx context.Context, in *TLMessageSearchV2, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageGetLastTwoPinnedMessageId(ctx context.Context, in *TLMessageGetLastTwoPinnedMessageId, opts ...grpc.CallOption) (*Vector_Int, error)
+	MessageUpdatePinnedMessageId(ctx context.Context, in *TLMessageUpdatePinnedMessageId, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	MessageGetPinnedMessageIdList(ctx context.Context, in *TLMessageGetPinnedMessageIdList, opts ...grpc.CallOption) (*Vector_Int, error)
+	MessageUnPinAllMessages(ctx context.Context, in *TLMessageUnPinAllMessages, opts ...grpc.CallOption) (*Vector_Int, error)
+	MessageGetUnreadMentions(ctx context.Context, in *TLMessageGetUnreadMentions, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageGetUnreadMentionsCount(ctx context.Context, in *TLMessageGetUnreadMentionsCount, opts ...grpc.CallOption) (*mtproto.Int32, error)
+	// message.getMessageReactionsList user_id:long msg_id:int reaction:string offset:string limit:int = messages.MessageReactionsList;
+	MessageGetMessageReactionsList(ctx context.Context, in *TLMessageGetMessageReactionsList, opts ...grpc.CallOption) (*mtproto.Messages_MessageReactionsList, error)
+	// message.createPoll user_id:long poll:Poll correct_answers:Vector<string> solution:string solution_entities:Vector<MessageEntity> = MessageMedia;
+	MessageCreatePoll(ctx context.Context, in *TLMessageCreatePoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	// message.getMediaPoll user_id:long poll_id:long = MessageMedia;
+	MessageGetMediaPoll(ctx context.Context, in *TLMessageGetMediaPoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	// message.sendVote user_id:long poll_id:long options:Vector<string> = MessageMedia;
+	MessageSendVote(ctx context.Context, in *TLMessageSendVote, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	// message.closePoll user_id:long poll_id:long = MessageMedia;
+	MessageClosePoll(ctx context.Context, in *TLMessageClosePoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	// message.getPollVotes user_id:long poll_id:long option:string offset:string limit:int = messages.VotesList;
+	MessageGetPollVotes(ctx context.Context, in *TLMessageGetPollVotes, opts ...grpc.CallOption) (*mtproto.Messages_VotesList, error)
+	MessageGetScheduledMessageHistory(ctx context.Context, in *TLMessageGetScheduledMessageHistory, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	MessageGetScheduledMessages(ctx context.Context, in *TLMessageGetScheduledMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+}
+
+type rPCMessageClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCMessageClient(cc *grpc.ClientConn) RPCMessageClient {
+	return &rPCMessageClient{cc}
+}
+
+func (c *rPCMessageClient) MessageGetUserMessage(ctx context.Context, in *TLMessageGetUserMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error) {
+	out := new(mtproto.MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUserMessage", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageUnPinAllMessages(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageUnPinAllMessages",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageUnPinAllMessages(ctx, req.(*TLMessageUnPinAllMessages))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _RPCMessage_MessageGetUnreadMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetUnreadMentions)
-	if err := dec(in); err != nil {
+func (c *rPCMessageClient) MessageGetUserMessageList(ctx context.Context, in *TLMessageGetUserMessageList, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUserMessageList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetUnreadMentions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetUnreadMentions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetUnreadMentions(ctx, req.(*TLMessageGetUnreadMentions))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _RPCMessage_MessageGetUnreadMentionsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMessageGetUnreadMentionsCount)
-	if err := dec(in); err != nil {
+func (c *rPCMessageClient) MessageGetUserMessageListByDataIdList(ctx context.Context, in *TLMessageGetUserMessageListByDataIdList, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUserMessageListByDataIdList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RPCMessageServer).MessageGetUnreadMentionsCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/message.RPCMessage/MessageGetUnreadMentionsCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMessageServer).MessageGetUnreadMentionsCount(ctx, req.(*TLMessageGetUnreadMentionsCount))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _RPCMessage_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "message.RPCMessage",
-	HandlerType: (*RPCMessageServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "message_getUserMessage",
-			Handler:    _RPCMessage_MessageGetUserMessage_Handler,
-		},
-		{
-			MethodName: "message_getUserMessageList",
-			Handler:    _RPCMessage_MessageGetUserMessageList_Handler,
-		},
-		{
-			MethodName: "message_getUserMessageListByDataIdList",
-			Handler:    _RPCMessage_MessageGetUserMessageListByDataIdList_Handler,
-		},
-		{
-			MethodName: "message_getUserMessageListByDataIdUserIdList",
-			Handler:    _RPCMessage_MessageGetUserMessageListByDataIdUserIdList_Handler,
-		},
-		{
-			MethodName: "message_getHistoryMessages",
-			Handler:    _RPCMessage_MessageGetHistoryMessages_Handler,
-		},
-		{
-			MethodName: "message_getHistoryMessagesCount",
-			Handler:    _RPCMessage_MessageGetHistoryMessagesCount_Handler,
-		},
-		{
-			MethodName: "message_getPeerUserMessageId",
-			Handler:    _RPCMessage_MessageGetPeerUserMessageId_Handler,
-		},
-		{
-			MethodName: "message_getPeerUserMessage",
-			Handler:    _RPCMessage_MessageGetPeerUserMessage_Handler,
-		},
-		{
-			MethodName: "message_searchByMediaType",
-			Handler:    _RPCMessage_MessageSearchByMediaType_Handler,
-		},
-		{
-			MethodName: "message_search",
-			Handler:    _RPCMessage_MessageSearch_Handler,
-		},
-		{
-			MethodName: "message_searchGlobal",
-			Handler:    _RPCMessage_MessageSearchGlobal_Handler,
-		},
-		{
-			MethodName: "message_searchByPinned",
-			Handler:    _RPCMessage_MessageSearchByPinned_Handler,
-		},
-		{
-			MethodName: "message_getSearchCounter",
-			Handler:    _RPCMessage_MessageGetSearchCounter_Handler,
-		},
-		{
-			MethodName: "message_searchV2",
-			Handler:    _RPCMessage_MessageSearchV2_Handler,
-		},
-		{
-			MethodName: "message_getLastTwoPinnedMessageId",
-			Handler:    _RPCMessage_MessageGetLastTwoPinnedMessageId_Handler,
-		},
-		{
-			MethodName: "message_updatePinnedMessageId",
-			Handler:    _RPCMessage_MessageUpdatePinnedMessageId_Handler,
-		},
-		{
-			MethodName: "message_getPinnedMessageIdList",
-			Handler:    _RPCMessage_MessageGetPinnedMessageIdList_Handler,
-		},
-		{
-			MethodName: "message_unPinAllMessages",
-			Handler:    _RPCMessage_MessageUnPinAllMessages_Handler,
-		},
-		{
-			MethodName: "message_getUnreadMentions",
-			Handler:    _RPCMessage_MessageGetUnreadMentions_Handler,
-		},
-		{
-			MethodName: "message_getUnreadMentionsCount",
-			Handler:    _RPCMessage_MessageGetUnreadMentionsCount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "message.tl.proto",
+func (c *rPCMessageClient) MessageGetUserMessageListByDataIdUserIdList(ctx context.Context, in *TLMessageGetUserMessageListByDataIdUserIdList, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUserMessageListByDataIdUserIdList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageGetHistoryMessages(ctx context.Context, in *TLMessageGetHistoryMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getHistoryMessages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageGetHistoryMessagesCount(ctx context.Context, in *TLMessageGetHistoryMessagesCount, opts ...grpc.CallOption) (*mtproto.Int32, error) {
+	out := new(mtproto.Int32)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getHistoryMessagesCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Id != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+func (c *rPCMessageClient) MessageGetPeerUserMessageId(ctx context.Context, in *TLMessageGetPeerUserMessageId, opts ...grpc.CallOption) (*mtproto.Int32, error) {
+	out := new(mtproto.Int32)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getPeerUserMessageId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *rPCMessageClient) MessageGetPeerUserMessage(ctx context.Context, in *TLMessageGetPeerUserMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error) {
+	out := new(mtproto.MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getPeerUserMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageSearchByMediaType(ctx context.Context, in *TLMessageSearchByMediaType, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_searchByMediaType", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageSearch(ctx context.Context, in *TLMessageSearch, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *rPCMessageClient) MessageSearchGlobal(ctx context.Context, in *TLMessageSearchGlobal, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_searchGlobal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IdList) > 0 {
-		dAtA2 := make([]byte, len(m.IdList)*10)
-		var j1 int
-		for _, num1 := range m.IdList {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintMessageTl(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *rPCMessageClient) MessageSearchByPinned(ctx context.Context, in *TLMessageSearchByPinned, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_searchByPinned", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	return out, nil
+}
+
+func (c *rPCMessageClient) MessageGetSearchCounter(ctx context.Context, in *TLMessageGetSearchCounter, opts ...grpc.CallOption) (*mtproto.Int32, error) {
+	out := new(mtproto.Int32)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getSearchCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *rPCMessageClient) MessageSearchV2(ctx context.Context, in *TLMessageSearchV2, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_searchV2", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageListByDataIdList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageGetLastTwoPinnedMessageId(ctx context.Context, in *TLMessageGetLastTwoPinnedMessageId, opts ...grpc.CallOption) (*Vector_Int, error) {
+	out := new(Vector_Int)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getLastTwoPinnedMessageId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageListByDataIdList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageUpdatePinnedMessageId(ctx context.Context, in *TLMessageUpdatePinnedMessageId, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_updatePinnedMessageId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageListByDataIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.IdList) > 0 {
-		dAtA4 := make([]byte, len(m.IdList)*10)
-		var j3 int
-		for _, num1 := range m.IdList {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintMessageTl(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+func (c *rPCMessageClient) MessageGetPinnedMessageIdList(ctx context.Context, in *TLMessageGetPinnedMessageIdList, opts ...grpc.CallOption) (*Vector_Int, error) {
+	out := new(Vector_Int)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getPinnedMessageIdList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageListByDataIdUserIdList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageUnPinAllMessages(ctx context.Context, in *TLMessageUnPinAllMessages, opts ...grpc.CallOption) (*Vector_Int, error) {
+	out := new(Vector_Int)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_unPinAllMessages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageListByDataIdUserIdList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageGetUnreadMentions(ctx context.Context, in *TLMessageGetUnreadMentions, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUnreadMentions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMessageGetUserMessageListByDataIdUserIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.UserIdList) > 0 {
-		dAtA6 := make([]byte, len(m.UserIdList)*10)
-		var j5 int
-		for _, num1 := range m.UserIdList {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintMessageTl(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Id != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+func (c *rPCMessageClient) MessageGetUnreadMentionsCount(ctx context.Context, in *TLMessageGetUnreadMentionsCount, opts ...grpc.CallOption) (*mtproto.Int32, error) {
+	out := new(mtproto.Int32)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getUnreadMentionsCount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMessageGetHistoryMessages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageGetMessageReactionsList(ctx context.Context, in *TLMessageGetMessageReactionsList, opts ...grpc.CallOption) (*mtproto.Messages_MessageReactionsList, error) {
+	out := new(mtproto.Messages_MessageReactionsList)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getMessageReactionsList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMessageGetHistoryMessages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageCreatePoll(ctx context.Context, in *TLMessageCreatePoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_createPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMessageGetHistoryMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Hash != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Hash))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.MinId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MinId))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.MaxId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MaxId))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Limit != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.AddOffset != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.AddOffset))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.OffsetDate != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.OffsetDate))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.OffsetId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.OffsetId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+func (c *rPCMessageClient) MessageGetMediaPoll(ctx context.Context, in *TLMessageGetMediaPoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getMediaPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMessageGetHistoryMessagesCount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageSendVote(ctx context.Context, in *TLMessageSendVote, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_sendVote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMessageGetHistoryMessagesCount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageClosePoll(ctx context.Context, in *TLMessageClosePoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_closePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMessageGetHistoryMessagesCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+func (c *rPCMessageClient) MessageGetPollVotes(ctx context.Context, in *TLMessageGetPollVotes, opts ...grpc.CallOption) (*mtproto.Messages_VotesList, error) {
+	out := new(mtproto.Messages_VotesList)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getPollVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMessageGetPeerUserMessageId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageGetScheduledMessageHistory(ctx context.Context, in *TLMessageGetScheduledMessageHistory, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getScheduledMessageHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMessageGetPeerUserMessageId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMessageClient) MessageGetScheduledMessages(ctx context.Context, in *TLMessageGetScheduledMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/message.RPCMessage/message_getScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMessageGetPeerUserMessageId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.MsgId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MsgId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PeerUserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerUserId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// RPCMessageServer is the server API for RPCMessage service.
+type RPCMessageServer interface {
+	MessageGetUserMessage(context.Context, *TLMessageGetUserMessage) (*mtproto.MessageBox, error)
+	MessageGetUserMessageList(context.Context, *TLMessageGetUserMessageList) (*Vector_MessageBox, error)
+	MessageGetUserMessageListByDataIdList(context.Context, *TLMessageGetUserMessageListByDataIdList) (*Vector_MessageBox, error)
+	MessageGetUserMessageListByDataIdUserIdList(context.Context, *TLMessageGetUserMessageListByDataIdUserIdList) (*Vector_MessageBox, error)
+	MessageGetHistoryMessages(context.Context, *TLMessageGetHistoryMessages) (*Vector_MessageBox, error)
+	MessageGetHistoryMessagesCount(context.Context, *TLMessageGetHistoryMessagesCount) (*mtproto.Int32, error)
+	MessageGetPeerUserMessageId(context.Context, *TLMessageGetPeerUserMessageId) (*mtproto.Int32, error)
+	MessageGetPeerUserMessage(context.Context, *TLMessageGetPeerUserMessage) (*mtproto.MessageBox, error)
+	MessageSearchByMediaType(context.Context, *TLMessageSearchByMediaType) (*Vector_MessageBox, error)
+	MessageSearch(context.Context, *TLMessageSearch) (*Vector_MessageBox, error)
+	MessageSearchGlobal(context.Context, *TLMessageSearchGlobal) (*Vector_MessageBox, error)
+	MessageSearchByPinned(context.Context, *TLMessageSearchByPinned) (*Vector_MessageBox, error)
+	MessageGetSearchCounter(context.Context, *TLMessageGetSearchCounter) (*mtproto.Int32, error)
+	MessageSearchV2(context.Context, *TLMessageSearchV2) (*Vector_MessageBox, error)
+	MessageGetLastTwoPinnedMessageId(context.Context, *TLMessageGetLastTwoPinnedMessageId) (*Vector_Int, error)
+	MessageUpdatePinnedMessageId(context.Context, *TLMessageUpdatePinnedMessageId) (*mtproto.Bool, error)
+	MessageGetPinnedMessageIdList(context.Context, *TLMessageGetPinnedMessageIdList) (*Vector_Int, error)
+	MessageUnPinAllMessages(context.Context, *TLMessageUnPinAllMessages) (*Vector_Int, error)
+	MessageGetUnreadMentions(context.Context, *TLMessageGetUnreadMentions) (*Vector_MessageBox, error)
+	MessageGetUnreadMentionsCount(context.Context, *TLMessageGetUnreadMentionsCount) (*mtproto.Int32, error)
+	// message.getMessageReactionsList user_id:long msg_id:int reaction:string offset:string limit:int = messages.MessageReactionsList;
+	MessageGetMessageReactionsList(context.Context, *TLMessageGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error)
+	// message.createPoll user_id:long poll:Poll correct_answers:Vector<string> solution:string solution_entities:Vector<MessageEntity> = MessageMedia;
+	MessageCreatePoll(context.Context, *TLMessageCreatePoll) (*mtproto.MessageMedia, error)
+	// message.getMediaPoll user_id:long poll_id:long = MessageMedia;
+	MessageGetMediaPoll(context.Context, *TLMessageGetMediaPoll) (*mtproto.MessageMedia, error)
+	// message.sendVote user_id:long poll_id:long options:Vector<string> = MessageMedia;
+	MessageSendVote(context.Context, *TLMessageSendVote) (*mtproto.MessageMedia, error)
+	// message.closePoll user_id:long poll_id:long = MessageMedia;
+	MessageClosePoll(context.Context, *TLMessageClosePoll) (*mtproto.MessageMedia, error)
+	// message.getPollVotes user_id:long poll_id:long option:string offset:string limit:int = messages.VotesList;
+	MessageGetPollVotes(context.Context, *TLMessageGetPollVotes) (*mtproto.Messages_VotesList, error)
+	MessageGetScheduledMessageHistory(context.Context, *TLMessageGetScheduledMessageHistory) (*Vector_MessageBox, error)
+	MessageGetScheduledMessages(context.Context, *TLMessageGetScheduledMessages) (*Vector_MessageBox, error)
 }
 
-func (m *TLMessageGetPeerUserMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedRPCMessageServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCMessageServer struct {
 }
 
-func (m *TLMessageGetPeerUserMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedRPCMessageServer) MessageGetUserMessage(ctx context.Context, req *TLMessageGetUserMessage) (*mtproto.MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUserMessage not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetUserMessageList(ctx context.Context, req *TLMessageGetUserMessageList) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUserMessageList not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetUserMessageListByDataIdList(ctx context.Context, req *TLMessageGetUserMessageListByDataIdList) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUserMessageListByDataIdList not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetUserMessageListByDataIdUserIdList(ctx context.Context, req *TLMessageGetUserMessageListByDataIdUserIdList) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUserMessageListByDataIdUserIdList not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetHistoryMessages(ctx context.Context, req *TLMessageGetHistoryMessages) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetHistoryMessages not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetHistoryMessagesCount(ctx context.Context, req *TLMessageGetHistoryMessagesCount) (*mtproto.Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetHistoryMessagesCount not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetPeerUserMessageId(ctx context.Context, req *TLMessageGetPeerUserMessageId) (*mtproto.Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetPeerUserMessageId not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetPeerUserMessage(ctx context.Context, req *TLMessageGetPeerUserMessage) (*mtproto.MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetPeerUserMessage not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageSearchByMediaType(ctx context.Context, req *TLMessageSearchByMediaType) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageSearchByMediaType not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageSearch(ctx context.Context, req *TLMessageSearch) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageSearch not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageSearchGlobal(ctx context.Context, req *TLMessageSearchGlobal) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageSearchGlobal not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageSearchByPinned(ctx context.Context, req *TLMessageSearchByPinned) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageSearchByPinned not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetSearchCounter(ctx context.Context, req *TLMessageGetSearchCounter) (*mtproto.Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetSearchCounter not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageSearchV2(ctx context.Context, req *TLMessageSearchV2) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageSearchV2 not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetLastTwoPinnedMessageId(ctx context.Context, req *TLMessageGetLastTwoPinnedMessageId) (*Vector_Int, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetLastTwoPinnedMessageId not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageUpdatePinnedMessageId(ctx context.Context, req *TLMessageUpdatePinnedMessageId) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageUpdatePinnedMessageId not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetPinnedMessageIdList(ctx context.Context, req *TLMessageGetPinnedMessageIdList) (*Vector_Int, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetPinnedMessageIdList not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageUnPinAllMessages(ctx context.Context, req *TLMessageUnPinAllMessages) (*Vector_Int, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageUnPinAllMessages not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetUnreadMentions(ctx context.Context, req *TLMessageGetUnreadMentions) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUnreadMentions not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetUnreadMentionsCount(ctx context.Context, req *TLMessageGetUnreadMentionsCount) (*mtproto.Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetUnreadMentionsCount not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetMessageReactionsList(ctx context.Context, req *TLMessageGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetMessageReactionsList not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageCreatePoll(ctx context.Context, req *TLMessageCreatePoll) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageCreatePoll not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetMediaPoll(ctx context.Context, req *TLMessageGetMediaPoll) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetMediaPoll not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageSendVote(ctx context.Context, req *TLMessageSendVote) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageSendVote not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageClosePoll(ctx context.Context, req *TLMessageClosePoll) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageClosePoll not implemented")
+}
+func (*UnimplementedRPCMessageServer) MessageGetPollVotes(ctx context.Context, req *TLMessageGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetPollVotes not implemented")
 }
 
-func (m *TLMessageGetPeerUserMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.MsgId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MsgId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PeerUserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerUserId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedRPCMessageServer) MessageGetScheduledMessageHistory(ctx context.Context, req *TLMessageGetScheduledMessageHistory) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetScheduledMessageHistory not implemented")
 }
 
-func (m *TLMessageSearchByMediaType) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedRPCMessageServer) MessageGetScheduledMessages(ctx context.Context, req *TLMessageGetScheduledMessages) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageGetScheduledMessages not implemented")
 }
 
-func (m *TLMessageSearchByMediaType) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterRPCMessageServer(s *grpc.Server, srv RPCMessageServer) {
+	s.RegisterService(&_RPCMessage_serviceDesc, srv)
 }
 
-func (m *TLMessageSearchByMediaType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _RPCMessage_MessageGetUserMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetUserMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Limit != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x40
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetUserMessage(ctx, in)
 	}
-	if m.Offset != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x38
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetUserMessage",
 	}
-	if m.MediaType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MediaType))
-		i--
-		dAtA[i] = 0x30
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetUserMessage(ctx, req.(*TLMessageGetUserMessage))
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageGetUserMessageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetUserMessageList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetUserMessageList(ctx, in)
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetUserMessageList",
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetUserMessageList(ctx, req.(*TLMessageGetUserMessageList))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageSearch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMessage_MessageGetUserMessageListByDataIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetUserMessageListByDataIdList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMessageSearch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMessageSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetUserMessageListByDataIdList(ctx, in)
 	}
-	if m.Limit != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x40
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetUserMessageListByDataIdList",
 	}
-	if m.Offset != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Q) > 0 {
-		i -= len(m.Q)
-		copy(dAtA[i:], m.Q)
-		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Q)))
-		i--
-		dAtA[i] = 0x32
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetUserMessageListByDataIdList(ctx, req.(*TLMessageGetUserMessageListByDataIdList))
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageGetUserMessageListByDataIdUserIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetUserMessageListByDataIdUserIdList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetUserMessageListByDataIdUserIdList(ctx, in)
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetUserMessageListByDataIdUserIdList",
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetUserMessageListByDataIdUserIdList(ctx, req.(*TLMessageGetUserMessageListByDataIdUserIdList))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageSearchGlobal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMessage_MessageGetHistoryMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetHistoryMessages)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMessageSearchGlobal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMessageSearchGlobal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetHistoryMessages(ctx, in)
 	}
-	if m.Limit != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetHistoryMessages",
 	}
-	if m.Offset != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetHistoryMessages(ctx, req.(*TLMessageGetHistoryMessages))
 	}
-	if len(m.Q) > 0 {
-		i -= len(m.Q)
-		copy(dAtA[i:], m.Q)
-		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Q)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageGetHistoryMessagesCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetHistoryMessagesCount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetHistoryMessagesCount(ctx, in)
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetHistoryMessagesCount",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetHistoryMessagesCount(ctx, req.(*TLMessageGetHistoryMessagesCount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageSearchByPinned) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMessage_MessageGetPeerUserMessageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetPeerUserMessageId)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMessageSearchByPinned) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetPeerUserMessageId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetPeerUserMessageId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetPeerUserMessageId(ctx, req.(*TLMessageGetPeerUserMessageId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageSearchByPinned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
+func _RPCMessage_MessageGetPeerUserMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetPeerUserMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetPeerUserMessage(ctx, in)
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetPeerUserMessage",
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetPeerUserMessage(ctx, req.(*TLMessageGetPeerUserMessage))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageGetSearchCounter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMessage_MessageSearchByMediaType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageSearchByMediaType)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMessageGetSearchCounter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMessageGetSearchCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageSearchByMediaType(ctx, in)
 	}
-	if m.MediaType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MediaType))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageSearchByMediaType",
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageSearchByMediaType(ctx, req.(*TLMessageSearchByMediaType))
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageSearch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageSearch(ctx, in)
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageSearch",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageSearch(ctx, req.(*TLMessageSearch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageSearchV2) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMessage_MessageSearchGlobal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageSearchGlobal)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMessageSearchV2) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageSearchGlobal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageSearchGlobal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageSearchGlobal(ctx, req.(*TLMessageSearchGlobal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageSearchV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _RPCMessage_MessageSearchByPinned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageSearchByPinned)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Hash != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Hash))
-		i--
-		dAtA[i] = 0x78
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageSearchByPinned(ctx, in)
 	}
-	if m.MinId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MinId))
-		i--
-		dAtA[i] = 0x70
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageSearchByPinned",
 	}
-	if m.MaxId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MaxId))
-		i--
-		dAtA[i] = 0x68
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageSearchByPinned(ctx, req.(*TLMessageSearchByPinned))
 	}
-	if m.Limit != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x60
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageGetSearchCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetSearchCounter)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AddOffset != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.AddOffset))
-		i--
-		dAtA[i] = 0x58
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetSearchCounter(ctx, in)
 	}
-	if m.OffsetId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.OffsetId))
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetSearchCounter",
 	}
-	if m.MaxDate != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MaxDate))
-		i--
-		dAtA[i] = 0x48
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetSearchCounter(ctx, req.(*TLMessageGetSearchCounter))
 	}
-	if m.MinDate != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MinDate))
-		i--
-		dAtA[i] = 0x40
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageSearchV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageSearchV2)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FromId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.FromId))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageSearchV2(ctx, in)
 	}
-	if len(m.Q) > 0 {
-		i -= len(m.Q)
-		copy(dAtA[i:], m.Q)
-		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Q)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageSearchV2",
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageSearchV2(ctx, req.(*TLMessageSearchV2))
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageGetLastTwoPinnedMessageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetLastTwoPinnedMessageId)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetLastTwoPinnedMessageId(ctx, in)
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetLastTwoPinnedMessageId",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetLastTwoPinnedMessageId(ctx, req.(*TLMessageGetLastTwoPinnedMessageId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageGetLastTwoPinnedMessageId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMessage_MessageUpdatePinnedMessageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageUpdatePinnedMessageId)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageUpdatePinnedMessageId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageUpdatePinnedMessageId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageUpdatePinnedMessageId(ctx, req.(*TLMessageUpdatePinnedMessageId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageGetLastTwoPinnedMessageId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _RPCMessage_MessageGetPinnedMessageIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetPinnedMessageIdList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetPinnedMessageIdList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetPinnedMessageIdList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetPinnedMessageIdList(ctx, req.(*TLMessageGetPinnedMessageIdList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageGetLastTwoPinnedMessageId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
+func _RPCMessage_MessageUnPinAllMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageUnPinAllMessages)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageUnPinAllMessages(ctx, in)
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageUnPinAllMessages",
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageUnPinAllMessages(ctx, req.(*TLMessageUnPinAllMessages))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageUpdatePinnedMessageId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMessage_MessageGetUnreadMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetUnreadMentions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetUnreadMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetUnreadMentions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetUnreadMentions(ctx, req.(*TLMessageGetUnreadMentions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageUpdatePinnedMessageId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _RPCMessage_MessageGetUnreadMentionsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetUnreadMentionsCount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetUnreadMentionsCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetUnreadMentionsCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetUnreadMentionsCount(ctx, req.(*TLMessageGetUnreadMentionsCount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageUpdatePinnedMessageId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _RPCMessage_MessageGetMessageReactionsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetMessageReactionsList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pinned != nil {
-		{
-			size, err := m.Pinned.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessageTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetMessageReactionsList(ctx, in)
 	}
-	if m.Id != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetMessageReactionsList",
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetMessageReactionsList(ctx, req.(*TLMessageGetMessageReactionsList))
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageCreatePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageCreatePoll)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageCreatePoll(ctx, in)
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageCreatePoll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageCreatePoll(ctx, req.(*TLMessageCreatePoll))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMessageGetPinnedMessageIdList) Marshal() (dAtA []byte, err error) {
+func _RPCMessage_MessageGetMediaPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetMediaPoll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetMediaPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetMediaPoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetMediaPoll(ctx, req.(*TLMessageGetMediaPoll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageSendVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageSendVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageSendVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageSendVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageSendVote(ctx, req.(*TLMessageSendVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageClosePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageClosePoll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageClosePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageClosePoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageClosePoll(ctx, req.(*TLMessageClosePoll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageGetPollVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetPollVotes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetPollVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetPollVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetPollVotes(ctx, req.(*TLMessageGetPollVotes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageGetScheduledMessageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetScheduledMessageHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetScheduledMessageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetScheduledMessageHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetScheduledMessageHistory(ctx, req.(*TLMessageGetScheduledMessageHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMessage_MessageGetScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMessageGetScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMessageServer).MessageGetScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.RPCMessage/MessageGetScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMessageServer).MessageGetScheduledMessages(ctx, req.(*TLMessageGetScheduledMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCMessage_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "message.RPCMessage",
+	HandlerType: (*RPCMessageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "message_getUserMessage",
+			Handler:    _RPCMessage_MessageGetUserMessage_Handler,
+		},
+		{
+			MethodName: "message_getUserMessageList",
+			Handler:    _RPCMessage_MessageGetUserMessageList_Handler,
+		},
+		{
+			MethodName: "message_getUserMessageListByDataIdList",
+			Handler:    _RPCMessage_MessageGetUserMessageListByDataIdList_Handler,
+		},
+		{
+			MethodName: "message_getUserMessageListByDataIdUserIdList",
+			Handler:    _RPCMessage_MessageGetUserMessageListByDataIdUserIdList_Handler,
+		},
+		{
+			MethodName: "message_getHistoryMessages",
+			Handler:    _RPCMessage_MessageGetHistoryMessages_Handler,
+		},
+		{
+			MethodName: "message_getHistoryMessagesCount",
+			Handler:    _RPCMessage_MessageGetHistoryMessagesCount_Handler,
+		},
+		{
+			MethodName: "message_getPeerUserMessageId",
+			Handler:    _RPCMessage_MessageGetPeerUserMessageId_Handler,
+		},
+		{
+			MethodName: "message_getPeerUserMessage",
+			Handler:    _RPCMessage_MessageGetPeerUserMessage_Handler,
+		},
+		{
+			MethodName: "message_searchByMediaType",
+			Handler:    _RPCMessage_MessageSearchByMediaType_Handler,
+		},
+		{
+			MethodName: "message_search",
+			Handler:    _RPCMessage_MessageSearch_Handler,
+		},
+		{
+			MethodName: "message_searchGlobal",
+			Handler:    _RPCMessage_MessageSearchGlobal_Handler,
+		},
+		{
+			MethodName: "message_searchByPinned",
+			Handler:    _RPCMessage_MessageSearchByPinned_Handler,
+		},
+		{
+			MethodName: "message_getSearchCounter",
+			Handler:    _RPCMessage_MessageGetSearchCounter_Handler,
+		},
+		{
+			MethodName: "message_searchV2",
+			Handler:    _RPCMessage_MessageSearchV2_Handler,
+		},
+		{
+			MethodName: "message_getLastTwoPinnedMessageId",
+			Handler:    _RPCMessage_MessageGetLastTwoPinnedMessageId_Handler,
+		},
+		{
+			MethodName: "message_updatePinnedMessageId",
+			Handler:    _RPCMessage_MessageUpdatePinnedMessageId_Handler,
+		},
+		{
+			MethodName: "message_getPinnedMessageIdList",
+			Handler:    _RPCMessage_MessageGetPinnedMessageIdList_Handler,
+		},
+		{
+			MethodName: "message_unPinAllMessages",
+			Handler:    _RPCMessage_MessageUnPinAllMessages_Handler,
+		},
+		{
+			MethodName: "message_getUnreadMentions",
+			Handler:    _RPCMessage_MessageGetUnreadMentions_Handler,
+		},
+		{
+			MethodName: "message_getUnreadMentionsCount",
+			Handler:    _RPCMessage_MessageGetUnreadMentionsCount_Handler,
+		},
+		{
+			MethodName: "message_getMessageReactionsList",
+			Handler:    _RPCMessage_MessageGetMessageReactionsList_Handler,
+		},
+		{
+			MethodName: "message_createPoll",
+			Handler:    _RPCMessage_MessageCreatePoll_Handler,
+		},
+		{
+			MethodName: "message_getMediaPoll",
+			Handler:    _RPCMessage_MessageGetMediaPoll_Handler,
+		},
+		{
+			MethodName: "message_sendVote",
+			Handler:    _RPCMessage_MessageSendVote_Handler,
+		},
+		{
+			MethodName: "message_closePoll",
+			Handler:    _RPCMessage_MessageClosePoll_Handler,
+		},
+		{
+			MethodName: "message_getPollVotes",
+			Handler:    _RPCMessage_MessageGetPollVotes_Handler,
+		},
+		{
+			MethodName: "message_getScheduledMessageHistory",
+			Handler:    _RPCMessage_MessageGetScheduledMessageHistory_Handler,
+		},
+		{
+			MethodName: "message_getScheduledMessages",
+			Handler:    _RPCMessage_MessageGetScheduledMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "message.tl.proto",
+}
+
+func (m *TLMessageGetUserMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4097,12 +4295,12 @@ func (m *TLMessageGetPinnedMessageIdList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMessageGetPinnedMessageIdList) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLMessageGetUserMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMessageGetPinnedMessageIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLMessageGetUserMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4111,13 +4309,8 @@ func (m *TLMessageGetPinnedMessageIdList) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+	if m.Id != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x20
 	}
@@ -4134,7 +4327,7 @@ func (m *TLMessageGetPinnedMessageIdList) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageUnPinAllMessages) Marshal() (dAtA []byte, err error) {
+func (m *TLMessageGetUserMessageList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4144,12 +4337,12 @@ func (m *TLMessageUnPinAllMessages) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMessageUnPinAllMessages) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLMessageGetUserMessageList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMessageUnPinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLMessageGetUserMessageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4158,15 +4351,80 @@ func (m *TLMessageUnPinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+	if len(m.IdList) > 0 {
+		dAtA2 := make([]byte, len(m.IdList)*10)
+		var j1 int
+		for _, num1 := range m.IdList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintMessageTl(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x22
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMessageGetUserMessageListByDataIdList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetUserMessageListByDataIdList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetUserMessageListByDataIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IdList) > 0 {
+		dAtA4 := make([]byte, len(m.IdList)*10)
+		var j3 int
+		for _, num1 := range m.IdList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintMessageTl(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.UserId != 0 {
 		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
@@ -4181,7 +4439,7 @@ func (m *TLMessageUnPinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetUnreadMentions) Marshal() (dAtA []byte, err error) {
+func (m *TLMessageGetUserMessageListByDataIdUserIdList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4191,12 +4449,12 @@ func (m *TLMessageGetUnreadMentions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMessageGetUnreadMentions) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLMessageGetUserMessageListByDataIdUserIdList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMessageGetUnreadMentions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLMessageGetUserMessageListByDataIdUserIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4205,24 +4463,90 @@ func (m *TLMessageGetUnreadMentions) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxInt != 0 {
-		i = encodeVarintMessageTl(dAtA, i, uint64(m.MaxInt))
+	if len(m.UserIdList) > 0 {
+		dAtA6 := make([]byte, len(m.UserIdList)*10)
+		var j5 int
+		for _, num1 := range m.UserIdList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintMessageTl(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMessageGetHistoryMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetHistoryMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetHistoryMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hash != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x60
 	}
 	if m.MinId != 0 {
 		i = encodeVarintMessageTl(dAtA, i, uint64(m.MinId))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x58
+	}
+	if m.MaxId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x50
 	}
 	if m.Limit != 0 {
 		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x48
 	}
 	if m.AddOffset != 0 {
 		i = encodeVarintMessageTl(dAtA, i, uint64(m.AddOffset))
 		i--
+		dAtA[i] = 0x40
+	}
+	if m.OffsetDate != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.OffsetDate))
+		i--
 		dAtA[i] = 0x38
 	}
 	if m.OffsetId != 0 {
@@ -4253,7 +4577,7 @@ func (m *TLMessageGetUnreadMentions) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetUnreadMentionsCount) Marshal() (dAtA []byte, err error) {
+func (m *TLMessageGetHistoryMessagesCount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4263,12 +4587,12 @@ func (m *TLMessageGetUnreadMentionsCount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMessageGetUnreadMentionsCount) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLMessageGetHistoryMessagesCount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMessageGetUnreadMentionsCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLMessageGetHistoryMessagesCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4300,7 +4624,7 @@ func (m *TLMessageGetUnreadMentionsCount) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *Vector_MessageBox) Marshal() (dAtA []byte, err error) {
+func (m *TLMessageGetPeerUserMessageId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4310,12 +4634,12 @@ func (m *Vector_MessageBox) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vector_MessageBox) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLMessageGetPeerUserMessageId) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vector_MessageBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLMessageGetPeerUserMessageId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4324,24 +4648,30 @@ func (m *Vector_MessageBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Datas) > 0 {
-		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessageTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.MsgId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MsgId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeerUserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerUserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Vector_Int) Marshal() (dAtA []byte, err error) {
+func (m *TLMessageGetPeerUserMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4351,12 +4681,12 @@ func (m *Vector_Int) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vector_Int) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLMessageGetPeerUserMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vector_Int) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLMessageGetPeerUserMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4365,663 +4695,3245 @@ func (m *Vector_Int) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Datas) > 0 {
-		dAtA9 := make([]byte, len(m.Datas)*10)
-		var j8 int
-		for _, num1 := range m.Datas {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintMessageTl(dAtA, i, uint64(j8))
+	if m.MsgId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MsgId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x28
+	}
+	if m.PeerUserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerUserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessageTl(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessageTl(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TLMessageSearchByMediaType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TLMessageGetUserMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TLMessageSearchByMediaType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageSearchByMediaType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.Limit != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Id != 0 {
-		n += 1 + sovMessageTl(uint64(m.Id))
+	if m.Offset != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MediaType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MediaType))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *TLMessageGetUserMessageList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
-	}
-	if len(m.IdList) > 0 {
-		l = 0
-		for _, e := range m.IdList {
-			l += sovMessageTl(uint64(e))
-		}
-		n += 1 + sovMessageTl(uint64(l)) + l
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetUserMessageListByDataIdList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageSearch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageSearch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.IdList) > 0 {
-		l = 0
-		for _, e := range m.IdList {
-			l += sovMessageTl(uint64(e))
-		}
-		n += 1 + sovMessageTl(uint64(l)) + l
+	if m.Limit != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Offset != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *TLMessageGetUserMessageListByDataIdUserIdList) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Q) > 0 {
+		i -= len(m.Q)
+		copy(dAtA[i:], m.Q)
+		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Q)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Id != 0 {
-		n += 1 + sovMessageTl(uint64(m.Id))
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.UserIdList) > 0 {
-		l = 0
-		for _, e := range m.UserIdList {
-			l += sovMessageTl(uint64(e))
-		}
-		n += 1 + sovMessageTl(uint64(l)) + l
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetHistoryMessages) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageSearchGlobal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageSearchGlobal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageSearchGlobal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
-	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
-	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
-	}
-	if m.OffsetId != 0 {
-		n += 1 + sovMessageTl(uint64(m.OffsetId))
-	}
-	if m.OffsetDate != 0 {
-		n += 1 + sovMessageTl(uint64(m.OffsetDate))
-	}
-	if m.AddOffset != 0 {
-		n += 1 + sovMessageTl(uint64(m.AddOffset))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Limit != 0 {
-		n += 1 + sovMessageTl(uint64(m.Limit))
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.MaxId != 0 {
-		n += 1 + sovMessageTl(uint64(m.MaxId))
+	if m.Offset != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MinId != 0 {
-		n += 1 + sovMessageTl(uint64(m.MinId))
+	if len(m.Q) > 0 {
+		i -= len(m.Q)
+		copy(dAtA[i:], m.Q)
+		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Q)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Hash != 0 {
-		n += 1 + sovMessageTl(uint64(m.Hash))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetHistoryMessagesCount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageSearchByPinned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageSearchByPinned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageSearchByPinned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetPeerUserMessageId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetSearchCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetSearchCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetSearchCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.MediaType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MediaType))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.PeerUserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerUserId))
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MsgId != 0 {
-		n += 1 + sovMessageTl(uint64(m.MsgId))
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetPeerUserMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageSearchV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageSearchV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageSearchV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.Hash != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.PeerUserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerUserId))
+	if m.MinId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MinId))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.MsgId != 0 {
-		n += 1 + sovMessageTl(uint64(m.MsgId))
+	if m.MaxId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Limit != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
-}
-
-func (m *TLMessageSearchByMediaType) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AddOffset != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.AddOffset))
+		i--
+		dAtA[i] = 0x58
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.OffsetId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.OffsetId))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.MaxDate != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MaxDate))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+	if m.MinDate != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MinDate))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if m.FromId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.MediaType != 0 {
-		n += 1 + sovMessageTl(uint64(m.MediaType))
+	if len(m.Q) > 0 {
+		i -= len(m.Q)
+		copy(dAtA[i:], m.Q)
+		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Q)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Offset != 0 {
-		n += 1 + sovMessageTl(uint64(m.Offset))
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Limit != 0 {
-		n += 1 + sovMessageTl(uint64(m.Limit))
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageSearch) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetLastTwoPinnedMessageId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetLastTwoPinnedMessageId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetLastTwoPinnedMessageId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
-	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Q)
-	if l > 0 {
-		n += 1 + l + sovMessageTl(uint64(l))
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Offset != 0 {
-		n += 1 + sovMessageTl(uint64(m.Offset))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Limit != 0 {
-		n += 1 + sovMessageTl(uint64(m.Limit))
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageSearchGlobal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageUpdatePinnedMessageId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageUpdatePinnedMessageId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageUpdatePinnedMessageId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.Pinned != nil {
+		{
+			size, err := m.Pinned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessageTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Q)
-	if l > 0 {
-		n += 1 + l + sovMessageTl(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Offset != 0 {
-		n += 1 + sovMessageTl(uint64(m.Offset))
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Limit != 0 {
-		n += 1 + sovMessageTl(uint64(m.Limit))
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageSearchByPinned) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetPinnedMessageIdList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetPinnedMessageIdList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetPinnedMessageIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetSearchCounter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageUnPinAllMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageUnPinAllMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageUnPinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
-	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MediaType != 0 {
-		n += 1 + sovMessageTl(uint64(m.MediaType))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageSearchV2) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetUnreadMentions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetUnreadMentions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetUnreadMentions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
-	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
-	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Q)
-	if l > 0 {
-		n += 1 + l + sovMessageTl(uint64(l))
+	if m.MaxInt != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MaxInt))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.FromId != 0 {
-		n += 1 + sovMessageTl(uint64(m.FromId))
+	if m.MinId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MinId))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.MinDate != 0 {
-		n += 1 + sovMessageTl(uint64(m.MinDate))
+	if m.Limit != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.MaxDate != 0 {
-		n += 1 + sovMessageTl(uint64(m.MaxDate))
+	if m.AddOffset != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.AddOffset))
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.OffsetId != 0 {
-		n += 1 + sovMessageTl(uint64(m.OffsetId))
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.OffsetId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.AddOffset != 0 {
-		n += 1 + sovMessageTl(uint64(m.AddOffset))
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Limit != 0 {
-		n += 1 + sovMessageTl(uint64(m.Limit))
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MaxId != 0 {
-		n += 1 + sovMessageTl(uint64(m.MaxId))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MinId != 0 {
-		n += 1 + sovMessageTl(uint64(m.MinId))
-	}
-	if m.Hash != 0 {
-		n += 1 + sovMessageTl(uint64(m.Hash))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetLastTwoPinnedMessageId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetUnreadMentionsCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetUnreadMentionsCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetUnreadMentionsCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageUpdatePinnedMessageId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetMessageReactionsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetMessageReactionsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetMessageReactionsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.Limit != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+	if len(m.Offset) > 0 {
+		i -= len(m.Offset)
+		copy(dAtA[i:], m.Offset)
+		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Offset)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if len(m.Reaction) > 0 {
+		i -= len(m.Reaction)
+		copy(dAtA[i:], m.Reaction)
+		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Reaction)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Id != 0 {
-		n += 1 + sovMessageTl(uint64(m.Id))
+	if m.MsgId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.MsgId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Pinned != nil {
-		l = m.Pinned.Size()
-		n += 1 + l + sovMessageTl(uint64(l))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetPinnedMessageIdList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageCreatePoll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageCreatePoll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageCreatePoll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if len(m.SolutionEntities) > 0 {
+		for iNdEx := len(m.SolutionEntities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SolutionEntities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessageTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+	if len(m.Solution) > 0 {
+		i -= len(m.Solution)
+		copy(dAtA[i:], m.Solution)
+		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Solution)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if len(m.CorrectAnswers) > 0 {
+		for iNdEx := len(m.CorrectAnswers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CorrectAnswers[iNdEx])
+			copy(dAtA[i:], m.CorrectAnswers[iNdEx])
+			i = encodeVarintMessageTl(dAtA, i, uint64(len(m.CorrectAnswers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Poll != nil {
+		{
+			size, err := m.Poll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessageTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageUnPinAllMessages) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetMediaPoll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetMediaPoll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetMediaPoll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+	if m.PollId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PollId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetUnreadMentions) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageSendVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageSendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageSendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+	if m.PollId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PollId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.OffsetId != 0 {
-		n += 1 + sovMessageTl(uint64(m.OffsetId))
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.AddOffset != 0 {
-		n += 1 + sovMessageTl(uint64(m.AddOffset))
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMessageClosePoll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Limit != 0 {
-		n += 1 + sovMessageTl(uint64(m.Limit))
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageClosePoll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageClosePoll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MinId != 0 {
-		n += 1 + sovMessageTl(uint64(m.MinId))
+	if m.PollId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PollId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MaxInt != 0 {
-		n += 1 + sovMessageTl(uint64(m.MaxInt))
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMessageGetUnreadMentionsCount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetPollVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetPollVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetPollVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMessageTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Offset) > 0 {
+		i -= len(m.Offset)
+		copy(dAtA[i:], m.Offset)
+		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Offset)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Option) > 0 {
+		i -= len(m.Option)
+		copy(dAtA[i:], m.Option)
+		i = encodeVarintMessageTl(dAtA, i, uint64(len(m.Option)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PollId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PollId))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.UserId != 0 {
-		n += 1 + sovMessageTl(uint64(m.UserId))
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerType))
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMessageTl(uint64(m.PeerId))
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMessageGetScheduledMessageHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetScheduledMessageHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetScheduledMessageHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Vector_MessageBox) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMessageGetScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMessageGetScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMessageGetScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Datas) > 0 {
-		for _, e := range m.Datas {
-			l = e.Size()
-			n += 1 + l + sovMessageTl(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA6 := make([]byte, len(m.Id)*10)
+		var j6 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA6[j6] = uint8(num)
+			j6++
 		}
+		i -= j6
+		copy(dAtA[i:], dAtA6[:j6])
+		i = encodeVarintMessageTl(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PeerId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if m.PeerType != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMessageTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Vector_Int) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Vector_MessageBox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_MessageBox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_MessageBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Datas) > 0 {
-		l = 0
-		for _, e := range m.Datas {
-			l += sovMessageTl(uint64(e))
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessageTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		n += 1 + sovMessageTl(uint64(l)) + l
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovMessageTl(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Vector_Int) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozMessageTl(x uint64) (n int) {
+
+func (m *Vector_Int) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Int) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		dAtA10 := make([]byte, len(m.Datas)*10)
+		var j9 int
+		for _, num1 := range m.Datas {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintMessageTl(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessageTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessageTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TLMessageGetUserMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMessageTl(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetUserMessageList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if len(m.IdList) > 0 {
+		l = 0
+		for _, e := range m.IdList {
+			l += sovMessageTl(uint64(e))
+		}
+		n += 1 + sovMessageTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetUserMessageListByDataIdList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if len(m.IdList) > 0 {
+		l = 0
+		for _, e := range m.IdList {
+			l += sovMessageTl(uint64(e))
+		}
+		n += 1 + sovMessageTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetUserMessageListByDataIdUserIdList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMessageTl(uint64(m.Id))
+	}
+	if len(m.UserIdList) > 0 {
+		l = 0
+		for _, e := range m.UserIdList {
+			l += sovMessageTl(uint64(e))
+		}
+		n += 1 + sovMessageTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetHistoryMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.OffsetId != 0 {
+		n += 1 + sovMessageTl(uint64(m.OffsetId))
+	}
+	if m.OffsetDate != 0 {
+		n += 1 + sovMessageTl(uint64(m.OffsetDate))
+	}
+	if m.AddOffset != 0 {
+		n += 1 + sovMessageTl(uint64(m.AddOffset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMessageTl(uint64(m.Limit))
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovMessageTl(uint64(m.MaxId))
+	}
+	if m.MinId != 0 {
+		n += 1 + sovMessageTl(uint64(m.MinId))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovMessageTl(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetHistoryMessagesCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetPeerUserMessageId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerUserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerUserId))
+	}
+	if m.MsgId != 0 {
+		n += 1 + sovMessageTl(uint64(m.MsgId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetPeerUserMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerUserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerUserId))
+	}
+	if m.MsgId != 0 {
+		n += 1 + sovMessageTl(uint64(m.MsgId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageSearchByMediaType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.MediaType != 0 {
+		n += 1 + sovMessageTl(uint64(m.MediaType))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovMessageTl(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMessageTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageSearch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	l = len(m.Q)
+	if l > 0 {
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovMessageTl(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMessageTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageSearchGlobal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	l = len(m.Q)
+	if l > 0 {
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovMessageTl(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMessageTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageSearchByPinned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetSearchCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.MediaType != 0 {
+		n += 1 + sovMessageTl(uint64(m.MediaType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageSearchV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	l = len(m.Q)
+	if l > 0 {
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	if m.FromId != 0 {
+		n += 1 + sovMessageTl(uint64(m.FromId))
+	}
+	if m.MinDate != 0 {
+		n += 1 + sovMessageTl(uint64(m.MinDate))
+	}
+	if m.MaxDate != 0 {
+		n += 1 + sovMessageTl(uint64(m.MaxDate))
+	}
+	if m.OffsetId != 0 {
+		n += 1 + sovMessageTl(uint64(m.OffsetId))
+	}
+	if m.AddOffset != 0 {
+		n += 1 + sovMessageTl(uint64(m.AddOffset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMessageTl(uint64(m.Limit))
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovMessageTl(uint64(m.MaxId))
+	}
+	if m.MinId != 0 {
+		n += 1 + sovMessageTl(uint64(m.MinId))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovMessageTl(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetLastTwoPinnedMessageId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageUpdatePinnedMessageId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMessageTl(uint64(m.Id))
+	}
+	if m.Pinned != nil {
+		l = m.Pinned.Size()
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetPinnedMessageIdList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageUnPinAllMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetUnreadMentions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.OffsetId != 0 {
+		n += 1 + sovMessageTl(uint64(m.OffsetId))
+	}
+	if m.AddOffset != 0 {
+		n += 1 + sovMessageTl(uint64(m.AddOffset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMessageTl(uint64(m.Limit))
+	}
+	if m.MinId != 0 {
+		n += 1 + sovMessageTl(uint64(m.MinId))
+	}
+	if m.MaxInt != 0 {
+		n += 1 + sovMessageTl(uint64(m.MaxInt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetUnreadMentionsCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetMessageReactionsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.MsgId != 0 {
+		n += 1 + sovMessageTl(uint64(m.MsgId))
+	}
+	l = len(m.Reaction)
+	if l > 0 {
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	l = len(m.Offset)
+	if l > 0 {
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMessageTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageCreatePoll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.Poll != nil {
+		l = m.Poll.Size()
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	if len(m.CorrectAnswers) > 0 {
+		for _, s := range m.CorrectAnswers {
+			l = len(s)
+			n += 1 + l + sovMessageTl(uint64(l))
+		}
+	}
+	l = len(m.Solution)
+	if l > 0 {
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	if len(m.SolutionEntities) > 0 {
+		for _, e := range m.SolutionEntities {
+			l = e.Size()
+			n += 1 + l + sovMessageTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetMediaPoll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PollId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PollId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageSendVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PollId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PollId))
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovMessageTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageClosePoll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PollId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PollId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetPollVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PollId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PollId))
+	}
+	l = len(m.Option)
+	if l > 0 {
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	l = len(m.Offset)
+	if l > 0 {
+		n += 1 + l + sovMessageTl(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMessageTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetScheduledMessageHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMessageGetScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMessageTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMessageTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMessageTl(uint64(m.PeerId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovMessageTl(uint64(e))
+		}
+		n += 1 + sovMessageTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_MessageBox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovMessageTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Int) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		l = 0
+		for _, e := range m.Datas {
+			l += sovMessageTl(uint64(e))
+		}
+		n += 1 + sovMessageTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMessageTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessageTl(x uint64) (n int) {
 	return sovMessageTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *TLMessageGetUserMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMessageGetUserMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getUserMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getUserMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetUserMessageList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getUserMessageList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getUserMessageList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessageTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IdList = append(m.IdList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessageTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessageTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMessageTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IdList) == 0 {
+					m.IdList = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessageTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IdList = append(m.IdList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdList", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetUserMessageListByDataIdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getUserMessageListByDataIdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getUserMessageListByDataIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessageTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IdList = append(m.IdList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessageTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessageTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMessageTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IdList) == 0 {
+					m.IdList = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessageTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IdList = append(m.IdList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdList", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetUserMessageListByDataIdUserIdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getUserMessageListByDataIdUserIdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getUserMessageListByDataIdUserIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessageTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UserIdList = append(m.UserIdList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessageTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessageTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMessageTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UserIdList) == 0 {
+					m.UserIdList = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessageTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UserIdList = append(m.UserIdList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdList", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getHistoryMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getHistoryMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
+			}
+			m.OffsetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetDate", wireType)
+			}
+			m.OffsetDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetDate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOffset", wireType)
+			}
+			m.AddOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddOffset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
+			}
+			m.MaxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinId", wireType)
+			}
+			m.MinId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetHistoryMessagesCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getHistoryMessagesCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getHistoryMessagesCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetPeerUserMessageId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getPeerUserMessageId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getPeerUserMessageId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerUserId", wireType)
+			}
+			m.PeerUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerUserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			m.MsgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetPeerUserMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5044,10 +7956,10 @@ func (m *TLMessageGetUserMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getUserMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_getPeerUserMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getUserMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_getPeerUserMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5090,9 +8002,9 @@ func (m *TLMessageGetUserMessage) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerUserId", wireType)
 			}
-			m.Id = 0
+			m.PeerUserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5102,7 +8014,26 @@ func (m *TLMessageGetUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
+				m.PeerUserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			m.MsgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5129,7 +8060,7 @@ func (m *TLMessageGetUserMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetUserMessageList) Unmarshal(dAtA []byte) error {
+func (m *TLMessageSearchByMediaType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5152,10 +8083,10 @@ func (m *TLMessageGetUserMessageList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getUserMessageList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_searchByMediaType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getUserMessageList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_searchByMediaType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5197,80 +8128,99 @@ func (m *TLMessageGetUserMessageList) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMessageTl
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
 				}
-				m.IdList = append(m.IdList, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMessageTl
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMessageTl
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMessageTl
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.IdList) == 0 {
-					m.IdList = make([]int32, 0, elementCount)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			m.MediaType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMessageTl
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IdList = append(m.IdList, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MediaType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdList", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5294,7 +8244,7 @@ func (m *TLMessageGetUserMessageList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetUserMessageListByDataIdList) Unmarshal(dAtA []byte) error {
+func (m *TLMessageSearch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5317,17 +8267,74 @@ func (m *TLMessageGetUserMessageListByDataIdList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getUserMessageListByDataIdList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_search: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getUserMessageListByDataIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_search: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			m.Constructor = 0
+			m.PeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5337,16 +8344,16 @@ func (m *TLMessageGetUserMessageListByDataIdList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
 			}
-			m.UserId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5356,86 +8363,61 @@ func (m *TLMessageGetUserMessageListByDataIdList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMessageTl
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Q = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
 				}
-				m.IdList = append(m.IdList, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMessageTl
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMessageTl
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMessageTl
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.IdList) == 0 {
-					m.IdList = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMessageTl
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IdList = append(m.IdList, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdList", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5459,7 +8441,7 @@ func (m *TLMessageGetUserMessageListByDataIdList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetUserMessageListByDataIdUserIdList) Unmarshal(dAtA []byte) error {
+func (m *TLMessageSearchGlobal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5482,10 +8464,10 @@ func (m *TLMessageGetUserMessageListByDataIdUserIdList) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getUserMessageListByDataIdUserIdList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_searchGlobal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getUserMessageListByDataIdUserIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_searchGlobal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5509,9 +8491,9 @@ func (m *TLMessageGetUserMessageListByDataIdUserIdList) Unmarshal(dAtA []byte) e
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.Id = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5521,86 +8503,80 @@ func (m *TLMessageGetUserMessageListByDataIdUserIdList) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMessageTl
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
 				}
-				m.UserIdList = append(m.UserIdList, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMessageTl
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMessageTl
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMessageTl
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Q = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.UserIdList) == 0 {
-					m.UserIdList = make([]int64, 0, elementCount)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMessageTl
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UserIdList = append(m.UserIdList, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdList", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5624,7 +8600,7 @@ func (m *TLMessageGetUserMessageListByDataIdUserIdList) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
+func (m *TLMessageSearchByPinned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5647,10 +8623,10 @@ func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getHistoryMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_searchByPinned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getHistoryMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_searchByPinned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5729,49 +8705,62 @@ func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.OffsetId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OffsetId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetDate", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.OffsetDate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OffsetDate |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetSearchCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
 			}
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getSearchCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getSearchCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			m.AddOffset = 0
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5781,16 +8770,16 @@ func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AddOffset |= int32(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.Limit = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5800,16 +8789,16 @@ func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			m.MaxId = 0
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5819,16 +8808,16 @@ func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxId |= int32(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			m.MinId = 0
+			m.PeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5838,16 +8827,16 @@ func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinId |= int32(b&0x7F) << shift
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
 			}
-			m.Hash = 0
+			m.MediaType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -5857,7 +8846,7 @@ func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Hash |= int64(b&0x7F) << shift
+				m.MediaType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5884,7 +8873,7 @@ func (m *TLMessageGetHistoryMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetHistoryMessagesCount) Unmarshal(dAtA []byte) error {
+func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5907,10 +8896,10 @@ func (m *TLMessageGetHistoryMessagesCount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getHistoryMessagesCount: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_searchV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getHistoryMessagesCount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_searchV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5989,62 +8978,62 @@ func (m *TLMessageGetHistoryMessagesCount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessageTl(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMessageTl
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TLMessageGetPeerUserMessageId) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessageTl
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Q = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getPeerUserMessageId: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getPeerUserMessageId: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.FromId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDate", wireType)
 			}
-			m.Constructor = 0
+			m.MinDate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6054,16 +9043,16 @@ func (m *TLMessageGetPeerUserMessageId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.MinDate |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDate", wireType)
 			}
-			m.UserId = 0
+			m.MaxDate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6073,16 +9062,16 @@ func (m *TLMessageGetPeerUserMessageId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
+				m.MaxDate |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerUserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
 			}
-			m.PeerUserId = 0
+			m.OffsetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6092,16 +9081,16 @@ func (m *TLMessageGetPeerUserMessageId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerUserId |= int64(b&0x7F) << shift
+				m.OffsetId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOffset", wireType)
 			}
-			m.MsgId = 0
+			m.AddOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6111,67 +9100,16 @@ func (m *TLMessageGetPeerUserMessageId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgId |= int32(b&0x7F) << shift
+				m.AddOffset |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessageTl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMessageTl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TLMessageGetPeerUserMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessageTl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getPeerUserMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getPeerUserMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Constructor = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6181,16 +9119,16 @@ func (m *TLMessageGetPeerUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
 			}
-			m.UserId = 0
+			m.MaxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6200,16 +9138,16 @@ func (m *TLMessageGetPeerUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
+				m.MaxId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerUserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinId", wireType)
 			}
-			m.PeerUserId = 0
+			m.MinId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6219,16 +9157,16 @@ func (m *TLMessageGetPeerUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerUserId |= int64(b&0x7F) << shift
+				m.MinId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.MsgId = 0
+			m.Hash = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6238,7 +9176,7 @@ func (m *TLMessageGetPeerUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgId |= int32(b&0x7F) << shift
+				m.Hash |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6265,7 +9203,7 @@ func (m *TLMessageGetPeerUserMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageSearchByMediaType) Unmarshal(dAtA []byte) error {
+func (m *TLMessageGetLastTwoPinnedMessageId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6288,10 +9226,10 @@ func (m *TLMessageSearchByMediaType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_searchByMediaType: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_getLastTwoPinnedMessageId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_searchByMediaType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_getLastTwoPinnedMessageId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6370,63 +9308,6 @@ func (m *TLMessageSearchByMediaType) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
-			}
-			m.MediaType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MediaType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessageTl(dAtA[iNdEx:])
@@ -6449,7 +9330,7 @@ func (m *TLMessageSearchByMediaType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageSearch) Unmarshal(dAtA []byte) error {
+func (m *TLMessageUpdatePinnedMessageId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6472,10 +9353,10 @@ func (m *TLMessageSearch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_search: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_updatePinnedMessageId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_search: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_updatePinnedMessageId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6555,10 +9436,10 @@ func (m *TLMessageSearch) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6568,29 +9449,16 @@ func (m *TLMessageSearch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessageTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessageTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Q = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
 			}
-			m.Offset = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6600,30 +9468,28 @@ func (m *TLMessageSearch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMessageTl
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pinned == nil {
+				m.Pinned = &mtproto.Bool{}
+			}
+			if err := m.Pinned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessageTl(dAtA[iNdEx:])
@@ -6646,7 +9512,7 @@ func (m *TLMessageSearch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageSearchGlobal) Unmarshal(dAtA []byte) error {
+func (m *TLMessageGetPinnedMessageIdList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6669,10 +9535,10 @@ func (m *TLMessageSearchGlobal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_searchGlobal: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_getPinnedMessageIdList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_searchGlobal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_getPinnedMessageIdList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6709,47 +9575,15 @@ func (m *TLMessageSearchGlobal) Unmarshal(dAtA []byte) error {
 				b := dAtA[iNdEx]
 				iNdEx++
 				m.UserId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessageTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessageTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Q = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			m.Offset = 0
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6759,16 +9593,16 @@ func (m *TLMessageSearchGlobal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			m.Limit = 0
+			m.PeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -6778,7 +9612,7 @@ func (m *TLMessageSearchGlobal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6805,7 +9639,7 @@ func (m *TLMessageSearchGlobal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageSearchByPinned) Unmarshal(dAtA []byte) error {
+func (m *TLMessageUnPinAllMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6828,10 +9662,10 @@ func (m *TLMessageSearchByPinned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_searchByPinned: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_unPinAllMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_searchByPinned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_unPinAllMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6932,7 +9766,7 @@ func (m *TLMessageSearchByPinned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetSearchCounter) Unmarshal(dAtA []byte) error {
+func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6955,10 +9789,10 @@ func (m *TLMessageGetSearchCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getSearchCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_getUnreadMentions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getSearchCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_getUnreadMentions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7039,9 +9873,9 @@ func (m *TLMessageGetSearchCounter) Unmarshal(dAtA []byte) error {
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
 			}
-			m.MediaType = 0
+			m.OffsetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7051,7 +9885,83 @@ func (m *TLMessageGetSearchCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MediaType |= int32(b&0x7F) << shift
+				m.OffsetId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOffset", wireType)
+			}
+			m.AddOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddOffset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinId", wireType)
+			}
+			m.MinId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInt", wireType)
+			}
+			m.MaxInt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7078,7 +9988,7 @@ func (m *TLMessageGetSearchCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
+func (m *TLMessageGetUnreadMentionsCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7101,10 +10011,10 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_searchV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_getUnreadMentionsCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_searchV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_getUnreadMentionsCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7183,100 +10093,62 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessageTl
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMessageTl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Q = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
-			}
-			m.FromId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDate", wireType)
-			}
-			m.MinDate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinDate |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetMessageReactionsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDate", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxDate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxDate |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getMessageReactionsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getMessageReactionsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			m.OffsetId = 0
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7286,16 +10158,16 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OffsetId |= int32(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.AddOffset = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7305,16 +10177,16 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AddOffset |= int32(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
 			}
-			m.Limit = 0
+			m.MsgId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7324,16 +10196,16 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				m.MsgId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
 			}
-			m.MaxId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7343,16 +10215,29 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
 			}
-			m.MinId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reaction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7362,16 +10247,29 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Hash = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7381,7 +10279,7 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Hash |= int64(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7408,7 +10306,7 @@ func (m *TLMessageSearchV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetLastTwoPinnedMessageId) Unmarshal(dAtA []byte) error {
+func (m *TLMessageCreatePoll) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7431,10 +10329,10 @@ func (m *TLMessageGetLastTwoPinnedMessageId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getLastTwoPinnedMessageId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_createPoll: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getLastTwoPinnedMessageId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_createPoll: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7476,10 +10374,10 @@ func (m *TLMessageGetLastTwoPinnedMessageId) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Poll", wireType)
 			}
-			m.PeerType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7489,16 +10387,33 @@ func (m *TLMessageGetLastTwoPinnedMessageId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Poll == nil {
+				m.Poll = &mtproto.Poll{}
+			}
+			if err := m.Poll.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectAnswers", wireType)
 			}
-			m.PeerId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7508,11 +10423,90 @@ func (m *TLMessageGetLastTwoPinnedMessageId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrectAnswers = append(m.CorrectAnswers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Solution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SolutionEntities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SolutionEntities = append(m.SolutionEntities, &mtproto.MessageEntity{})
+			if err := m.SolutionEntities[len(m.SolutionEntities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessageTl(dAtA[iNdEx:])
@@ -7535,7 +10529,7 @@ func (m *TLMessageGetLastTwoPinnedMessageId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageUpdatePinnedMessageId) Unmarshal(dAtA []byte) error {
+func (m *TLMessageGetMediaPoll) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7558,10 +10552,10 @@ func (m *TLMessageUpdatePinnedMessageId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_updatePinnedMessageId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_getMediaPoll: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_updatePinnedMessageId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_getMediaPoll: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7604,66 +10598,9 @@ func (m *TLMessageUpdatePinnedMessageId) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
-			}
-			m.PeerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
-			}
-			m.PeerId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollId", wireType)
 			}
-			var msglen int
+			m.PollId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7673,28 +10610,11 @@ func (m *TLMessageUpdatePinnedMessageId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PollId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessageTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessageTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pinned == nil {
-				m.Pinned = &mtproto.Bool{}
-			}
-			if err := m.Pinned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessageTl(dAtA[iNdEx:])
@@ -7717,7 +10637,7 @@ func (m *TLMessageUpdatePinnedMessageId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetPinnedMessageIdList) Unmarshal(dAtA []byte) error {
+func (m *TLMessageSendVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7740,10 +10660,10 @@ func (m *TLMessageGetPinnedMessageIdList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getPinnedMessageIdList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_sendVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getPinnedMessageIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_sendVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7786,9 +10706,9 @@ func (m *TLMessageGetPinnedMessageIdList) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollId", wireType)
 			}
-			m.PeerType = 0
+			m.PollId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7798,16 +10718,16 @@ func (m *TLMessageGetPinnedMessageIdList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
+				m.PollId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			m.PeerId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7817,11 +10737,24 @@ func (m *TLMessageGetPinnedMessageIdList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessageTl(dAtA[iNdEx:])
@@ -7844,7 +10777,7 @@ func (m *TLMessageGetPinnedMessageIdList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageUnPinAllMessages) Unmarshal(dAtA []byte) error {
+func (m *TLMessageClosePoll) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7867,10 +10800,10 @@ func (m *TLMessageUnPinAllMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_unPinAllMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_closePoll: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_unPinAllMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_closePoll: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7913,28 +10846,9 @@ func (m *TLMessageUnPinAllMessages) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
-			}
-			m.PeerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessageTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollId", wireType)
 			}
-			m.PeerId = 0
+			m.PollId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -7944,7 +10858,7 @@ func (m *TLMessageUnPinAllMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
+				m.PollId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7971,7 +10885,7 @@ func (m *TLMessageUnPinAllMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
+func (m *TLMessageGetPollVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7994,10 +10908,10 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getUnreadMentions: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_getPollVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getUnreadMentions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_getPollVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8040,9 +10954,9 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollId", wireType)
 			}
-			m.PeerType = 0
+			m.PollId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -8052,16 +10966,16 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
+				m.PollId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			m.PeerId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -8071,16 +10985,29 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Option = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.OffsetId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -8090,16 +11017,29 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OffsetId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.AddOffset = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -8109,16 +11049,67 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AddOffset |= int32(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessageTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessageTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMessageGetScheduledMessageHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessageTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_message_getScheduledMessageHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_message_getScheduledMessageHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			m.Limit = 0
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -8128,16 +11119,16 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.MinId = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -8147,16 +11138,16 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinId |= int32(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxInt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			m.MaxInt = 0
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessageTl
@@ -8166,7 +11157,26 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxInt |= int32(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessageTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8193,7 +11203,7 @@ func (m *TLMessageGetUnreadMentions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMessageGetUnreadMentionsCount) Unmarshal(dAtA []byte) error {
+func (m *TLMessageGetScheduledMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8216,10 +11226,10 @@ func (m *TLMessageGetUnreadMentionsCount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_message_getUnreadMentionsCount: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_message_getScheduledMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_message_getUnreadMentionsCount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_message_getScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8298,6 +11308,82 @@ func (m *TLMessageGetUnreadMentionsCount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessageTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessageTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessageTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMessageTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessageTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessageTl(dAtA[iNdEx:])
@@ -0,0 +1,36 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package message
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// MakeMinPollResults strips the voter specific fields (chosen, correct and the quiz
+// solution) from results, so they can be pushed to every participant. Clients keep
+// their own chosen answers when merging min results.
+func MakeMinPollResults(results *mtproto.PollResults) *mtproto.PollResults {
+	minResults := mtproto.MakeTLPollResults(&mtproto.PollResults{
+		Min:              true,
+		Results:          make([]*mtproto.PollAnswerVoters, 0, len(results.GetResults())),
+		TotalVoters:      results.GetTotalVoters(),
+		RecentVoters:     results.GetRecentVoters(),
+		Solution:         nil,
+		SolutionEntities: nil,
+	}).To_PollResults()
+
+	for _, r := range results.GetResults() {
+		minResults.Results = append(minResults.Results, mtproto.MakeTLPollAnswerVoters(&mtproto.PollAnswerVoters{
+			Chosen:  false,
+			Correct: false,
+			Option:  r.Option,
+			Voters:  r.Voters,
+		}).To_PollAnswerVoters())
+	}
+
+	return minResults
+}
@@ -46,6 +46,14 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLMessageUnPinAllMessages":                     RPCContextTuple{"/mtproto.RPCMessage/message_unPinAllMessages", func() interface{} { return new(Vector_Int) }},
 	"TLMessageGetUnreadMentions":                    RPCContextTuple{"/mtproto.RPCMessage/message_getUnreadMentions", func() interface{} { return new(Vector_MessageBox) }},
 	"TLMessageGetUnreadMentionsCount":               RPCContextTuple{"/mtproto.RPCMessage/message_getUnreadMentionsCount", func() interface{} { return new(mtproto.Int32) }},
+	"TLMessageGetMessageReactionsList":              RPCContextTuple{"/mtproto.RPCMessage/message_getMessageReactionsList", func() interface{} { return new(mtproto.Messages_MessageReactionsList) }},
+	"TLMessageCreatePoll":                           RPCContextTuple{"/mtproto.RPCMessage/message_createPoll", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLMessageGetMediaPoll":                         RPCContextTuple{"/mtproto.RPCMessage/message_getMediaPoll", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLMessageSendVote":                             RPCContextTuple{"/mtproto.RPCMessage/message_sendVote", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLMessageClosePoll":                            RPCContextTuple{"/mtproto.RPCMessage/message_closePoll", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLMessageGetPollVotes":                         RPCContextTuple{"/mtproto.RPCMessage/message_getPollVotes", func() interface{} { return new(mtproto.Messages_VotesList) }},
+	"TLMessageGetScheduledMessageHistory":           RPCContextTuple{"/mtproto.RPCMessage/message_getScheduledMessageHistory", func() interface{} { return new(Vector_MessageBox) }},
+	"TLMessageGetScheduledMessages":                 RPCContextTuple{"/mtproto.RPCMessage/message_getScheduledMessages", func() interface{} { return new(Vector_MessageBox) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
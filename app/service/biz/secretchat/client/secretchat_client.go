@@ -0,0 +1,99 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package secretchat_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type SecretchatClient interface {
+	SecretchatRequestEncryption(ctx context.Context, in *secretchat.TLSecretchatRequestEncryption) (*secretchat.SecretChatData, error)
+	SecretchatAcceptEncryption(ctx context.Context, in *secretchat.TLSecretchatAcceptEncryption) (*secretchat.SecretChatData, error)
+	SecretchatDiscardEncryption(ctx context.Context, in *secretchat.TLSecretchatDiscardEncryption) (*secretchat.SecretChatData, error)
+	SecretchatGetSecretChat(ctx context.Context, in *secretchat.TLSecretchatGetSecretChat) (*secretchat.SecretChatData, error)
+	SecretchatSendEncryptedMessage(ctx context.Context, in *secretchat.TLSecretchatSendEncryptedMessage) (*secretchat.EncryptedMessageData, error)
+	SecretchatGetDifference(ctx context.Context, in *secretchat.TLSecretchatGetDifference) (*secretchat.SecretChatDifference, error)
+	SecretchatGetCurrentQts(ctx context.Context, in *secretchat.TLSecretchatGetCurrentQts) (*mtproto.Int32, error)
+	SecretchatReceivedQueue(ctx context.Context, in *secretchat.TLSecretchatReceivedQueue) (*secretchat.Vector_Long, error)
+}
+
+type defaultSecretchatClient struct {
+	cli zrpc.Client
+}
+
+func NewSecretchatClient(cli zrpc.Client) SecretchatClient {
+	return &defaultSecretchatClient{
+		cli: cli,
+	}
+}
+
+// SecretchatRequestEncryption
+// secretchat.requestEncryption admin_id:long admin_auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChatData;
+func (m *defaultSecretchatClient) SecretchatRequestEncryption(ctx context.Context, in *secretchat.TLSecretchatRequestEncryption) (*secretchat.SecretChatData, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatRequestEncryption(ctx, in)
+}
+
+// SecretchatAcceptEncryption
+// secretchat.acceptEncryption chat_id:int participant_id:long participant_auth_key_id:long g_b:bytes key_fingerprint:long = SecretChatData;
+func (m *defaultSecretchatClient) SecretchatAcceptEncryption(ctx context.Context, in *secretchat.TLSecretchatAcceptEncryption) (*secretchat.SecretChatData, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatAcceptEncryption(ctx, in)
+}
+
+// SecretchatDiscardEncryption
+// secretchat.discardEncryption chat_id:int user_id:long delete_history:Bool = SecretChatData;
+func (m *defaultSecretchatClient) SecretchatDiscardEncryption(ctx context.Context, in *secretchat.TLSecretchatDiscardEncryption) (*secretchat.SecretChatData, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatDiscardEncryption(ctx, in)
+}
+
+// SecretchatGetSecretChat
+// secretchat.getSecretChat chat_id:int = SecretChatData;
+func (m *defaultSecretchatClient) SecretchatGetSecretChat(ctx context.Context, in *secretchat.TLSecretchatGetSecretChat) (*secretchat.SecretChatData, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatGetSecretChat(ctx, in)
+}
+
+// SecretchatSendEncryptedMessage
+// secretchat.sendEncryptedMessage user_id:long chat_id:int message:EncryptedMessage = EncryptedMessageData;
+func (m *defaultSecretchatClient) SecretchatSendEncryptedMessage(ctx context.Context, in *secretchat.TLSecretchatSendEncryptedMessage) (*secretchat.EncryptedMessageData, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatSendEncryptedMessage(ctx, in)
+}
+
+// SecretchatGetDifference
+// secretchat.getDifference auth_key_id:long qts:int limit:int = SecretChatDifference;
+func (m *defaultSecretchatClient) SecretchatGetDifference(ctx context.Context, in *secretchat.TLSecretchatGetDifference) (*secretchat.SecretChatDifference, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatGetDifference(ctx, in)
+}
+
+// SecretchatGetCurrentQts
+// secretchat.getCurrentQts auth_key_id:long = Int32;
+func (m *defaultSecretchatClient) SecretchatGetCurrentQts(ctx context.Context, in *secretchat.TLSecretchatGetCurrentQts) (*mtproto.Int32, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatGetCurrentQts(ctx, in)
+}
+
+// SecretchatReceivedQueue
+// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+func (m *defaultSecretchatClient) SecretchatReceivedQueue(ctx context.Context, in *secretchat.TLSecretchatReceivedQueue) (*secretchat.Vector_Long, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatReceivedQueue(ctx, in)
+}
@@ -0,0 +1,25 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package secretchat_helper
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/config"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/server/grpc/service"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/svc"
+)
+
+type (
+	Config = config.Config
+)
+
+func New(c Config) *service.Service {
+	return service.New(svc.NewServiceContext(c))
+}
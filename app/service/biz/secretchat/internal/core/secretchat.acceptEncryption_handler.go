@@ -0,0 +1,67 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// SecretchatAcceptEncryption
+// secretchat.acceptEncryption chat_id:int participant_id:long participant_auth_key_id:long g_b:bytes key_fingerprint:long = SecretChatData;
+func (c *SecretchatCore) SecretchatAcceptEncryption(in *secretchat.TLSecretchatAcceptEncryption) (*secretchat.SecretChatData, error) {
+	chat, err := c.svcCtx.Dao.GetSecretChat(c.ctx, in.ChatId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if chat.ParticipantId != in.ParticipantId {
+		err = mtproto.ErrEncryptionIdInvalid
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	switch chat.State {
+	case secretchat.SecretChatStateAccepted:
+		err = mtproto.ErrEncryptionAlreadyAccepted
+	case secretchat.SecretChatStateDiscarded:
+		err = mtproto.ErrEncryptionAlreadyDeclined
+	}
+	if err != nil {
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	// only the first device of the participant wins
+	rowsAffected, err := c.svcCtx.Dao.SecretChatsDAO.UpdateAccepted(
+		c.ctx,
+		in.ParticipantAuthKeyId,
+		dao.EncodeBytes(in.GB),
+		in.KeyFingerprint,
+		secretchat.SecretChatStateAccepted,
+		in.ChatId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	} else if rowsAffected == 0 {
+		err = mtproto.ErrEncryptionAlreadyAccepted
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	chat.ParticipantAuthKeyId = in.ParticipantAuthKeyId
+	chat.GB = in.GB
+	chat.KeyFingerprint = in.KeyFingerprint
+	chat.State = secretchat.SecretChatStateAccepted
+
+	return chat, nil
+}
@@ -0,0 +1,49 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// SecretchatDiscardEncryption
+// secretchat.discardEncryption chat_id:int user_id:long delete_history:Bool = SecretChatData;
+func (c *SecretchatCore) SecretchatDiscardEncryption(in *secretchat.TLSecretchatDiscardEncryption) (*secretchat.SecretChatData, error) {
+	chat, err := c.svcCtx.Dao.GetSecretChat(c.ctx, in.ChatId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.discardEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if !chat.IsParticipant(in.UserId) {
+		err = mtproto.ErrEncryptionIdInvalid
+		c.Logger.Errorf("secretchat.discardEncryption - error: %v", err)
+		return nil, err
+	} else if chat.State == secretchat.SecretChatStateDiscarded {
+		err = mtproto.ErrEncryptionAlreadyDeclined
+		c.Logger.Errorf("secretchat.discardEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.SecretChatsDAO.UpdateState(c.ctx, secretchat.SecretChatStateDiscarded, in.ChatId); err != nil {
+		c.Logger.Errorf("secretchat.discardEncryption - error: %v", err)
+		return nil, err
+	}
+	chat.State = secretchat.SecretChatStateDiscarded
+
+	if mtproto.FromBool(in.DeleteHistory) {
+		if _, err = c.svcCtx.Dao.SecretChatQueuesDAO.DeleteByChatId(c.ctx, in.ChatId); err != nil {
+			c.Logger.Errorf("secretchat.discardEncryption - error: %v", err)
+		}
+	}
+
+	return chat, nil
+}
@@ -0,0 +1,25 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// SecretchatGetCurrentQts
+// secretchat.getCurrentQts auth_key_id:long = Int32;
+func (c *SecretchatCore) SecretchatGetCurrentQts(in *secretchat.TLSecretchatGetCurrentQts) (*mtproto.Int32, error) {
+	qts := c.svcCtx.Dao.IDGenClient2.CurrentQtsId(c.ctx, in.GetAuthKeyId())
+
+	return mtproto.MakeTLInt32(&mtproto.Int32{
+		V: qts,
+	}).To_Int32(), nil
+}
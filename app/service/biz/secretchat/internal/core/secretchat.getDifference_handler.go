@@ -0,0 +1,57 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// SecretchatGetDifference
+// secretchat.getDifference auth_key_id:long qts:int limit:int = SecretChatDifference;
+func (c *SecretchatCore) SecretchatGetDifference(in *secretchat.TLSecretchatGetDifference) (*secretchat.SecretChatDifference, error) {
+	limit := in.GetLimit()
+	if limit <= 0 || limit > 1000 {
+		limit = 1000
+	}
+
+	var (
+		qts         = in.GetQts()
+		messageList = make([]*mtproto.EncryptedMessage, 0)
+	)
+
+	_, err := c.svcCtx.Dao.SecretChatQueuesDAO.SelectByGtQtsWithCB(
+		c.ctx,
+		in.GetAuthKeyId(),
+		in.GetQts(),
+		limit,
+		func(i int, v *dataobject.SecretChatQueuesDO) {
+			message, err2 := c.svcCtx.Dao.MakeEncryptedMessage(v)
+			if err2 != nil {
+				return
+			}
+			messageList = append(messageList, message)
+			qts = v.Qts
+		})
+	if err != nil {
+		c.Logger.Errorf("secretchat.getDifference - error: %v", err)
+		return nil, err
+	}
+
+	if len(messageList) == 0 {
+		qts = c.svcCtx.Dao.IDGenClient2.CurrentQtsId(c.ctx, in.GetAuthKeyId())
+	}
+
+	return secretchat.MakeTLSecretChatDifference(&secretchat.SecretChatDifference{
+		Qts:                  qts,
+		NewEncryptedMessages: messageList,
+	}).To_SecretChatDifference(), nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// SecretchatReceivedQueue
+// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+func (c *SecretchatCore) SecretchatReceivedQueue(in *secretchat.TLSecretchatReceivedQueue) (*secretchat.Vector_Long, error) {
+	var (
+		randomIdList = make([]int64, 0)
+	)
+
+	_, err := c.svcCtx.Dao.SecretChatQueuesDAO.SelectByLeQtsWithCB(
+		c.ctx,
+		in.GetAuthKeyId(),
+		in.GetMaxQts(),
+		func(i int, v *dataobject.SecretChatQueuesDO) {
+			randomIdList = append(randomIdList, v.RandomId)
+		})
+	if err != nil {
+		c.Logger.Errorf("secretchat.receivedQueue - error: %v", err)
+		return nil, err
+	}
+
+	if len(randomIdList) > 0 {
+		if _, err = c.svcCtx.Dao.SecretChatQueuesDAO.DeleteByLeQts(c.ctx, in.GetAuthKeyId(), in.GetMaxQts()); err != nil {
+			c.Logger.Errorf("secretchat.receivedQueue - error: %v", err)
+			return nil, err
+		}
+	}
+
+	return &secretchat.Vector_Long{
+		Datas: randomIdList,
+	}, nil
+}
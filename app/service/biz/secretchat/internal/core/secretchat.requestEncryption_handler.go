@@ -0,0 +1,56 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// SecretchatRequestEncryption
+// secretchat.requestEncryption admin_id:long admin_auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChatData;
+func (c *SecretchatCore) SecretchatRequestEncryption(in *secretchat.TLSecretchatRequestEncryption) (*secretchat.SecretChatData, error) {
+	// the client resends requestEncryption with the same random_id
+	do, err := c.svcCtx.Dao.SecretChatsDAO.SelectByRandomId(c.ctx, in.AdminId, in.RandomId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if do == nil {
+		do = &dataobject.SecretChatsDO{
+			AccessHash:     rand.Int63(),
+			AdminId:        in.AdminId,
+			AdminAuthKeyId: in.AdminAuthKeyId,
+			ParticipantId:  in.ParticipantId,
+			RandomId:       in.RandomId,
+			GA:             dao.EncodeBytes(in.GA),
+			State:          secretchat.SecretChatStateRequested,
+			Date2:          time.Now().Unix(),
+		}
+		lastInsertId, _, err := c.svcCtx.Dao.SecretChatsDAO.Insert(c.ctx, do)
+		if err != nil {
+			c.Logger.Errorf("secretchat.requestEncryption - error: %v", err)
+			return nil, err
+		}
+		do.Id = int32(lastInsertId)
+	} else if do.ParticipantId != in.ParticipantId {
+		err = mtproto.ErrInputRequestInvalid
+		c.Logger.Errorf("secretchat.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeSecretChatData(do), nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// SecretchatSendEncryptedMessage
+// secretchat.sendEncryptedMessage user_id:long chat_id:int message:EncryptedMessage = EncryptedMessageData;
+func (c *SecretchatCore) SecretchatSendEncryptedMessage(in *secretchat.TLSecretchatSendEncryptedMessage) (*secretchat.EncryptedMessageData, error) {
+	chat, err := c.svcCtx.Dao.GetSecretChat(c.ctx, in.ChatId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.sendEncryptedMessage - error: %v", err)
+		return nil, err
+	}
+
+	if !chat.IsParticipant(in.UserId) {
+		err = mtproto.ErrEncryptionIdInvalid
+	} else if chat.State == secretchat.SecretChatStateDiscarded {
+		err = mtproto.ErrEncryptionDeclined
+	} else if chat.State != secretchat.SecretChatStateAccepted {
+		err = mtproto.ErrEncryptionIdInvalid
+	}
+	if err != nil {
+		c.Logger.Errorf("secretchat.sendEncryptedMessage - error: %v", err)
+		return nil, err
+	}
+
+	// the message is queued for the single device the peer accepted the chat on
+	peerId, peerAuthKeyId := chat.GetPeer(in.UserId)
+	message := in.GetMessage()
+
+	// a resend with the same random_id gets the queued message back instead of a new qts
+	if queued, err := c.svcCtx.Dao.GetQueuedEncryptedMessageData(c.ctx, peerAuthKeyId, message.GetRandomId()); err != nil {
+		c.Logger.Errorf("secretchat.sendEncryptedMessage - error: %v", err)
+		return nil, err
+	} else if queued != nil {
+		return queued, nil
+	}
+
+	qts := c.svcCtx.Dao.IDGenClient2.NextQtsId(c.ctx, peerAuthKeyId)
+	if qts == 0 {
+		err = mtproto.ErrInternelServerError
+		c.Logger.Errorf("secretchat.sendEncryptedMessage - error: %v", err)
+		return nil, err
+	}
+
+	mData, _ := jsonx.Marshal(message)
+	_, _, err = c.svcCtx.Dao.SecretChatQueuesDAO.Insert(c.ctx, &dataobject.SecretChatQueuesDO{
+		AuthKeyId:   peerAuthKeyId,
+		UserId:      peerId,
+		ChatId:      in.ChatId,
+		Qts:         qts,
+		RandomId:    message.GetRandomId(),
+		MessageData: hack.String(mData),
+		Date2:       int64(message.GetDate()),
+	})
+	if err != nil {
+		if sqlx.IsDuplicate(err) {
+			// lost the race against a concurrent resend
+			queued, err2 := c.svcCtx.Dao.GetQueuedEncryptedMessageData(c.ctx, peerAuthKeyId, message.GetRandomId())
+			if err2 == nil && queued != nil {
+				return queued, nil
+			}
+		}
+		c.Logger.Errorf("secretchat.sendEncryptedMessage - error: %v", err)
+		return nil, err
+	}
+
+	return secretchat.MakeTLEncryptedMessageData(&secretchat.EncryptedMessageData{
+		UserId:    peerId,
+		AuthKeyId: peerAuthKeyId,
+		Qts:       qts,
+		Message:   message,
+	}).To_EncryptedMessageData(), nil
+}
@@ -0,0 +1,2 @@
+./dalgen.sh secret_chats
+./dalgen.sh secret_chat_queues
@@ -0,0 +1,295 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type SecretChatQueuesDAO struct {
+	db *sqlx.DB
+}
+
+func NewSecretChatQueuesDAO(db *sqlx.DB) *SecretChatQueuesDAO {
+	return &SecretChatQueuesDAO{db}
+}
+
+// Insert
+// insert into secret_chat_queues(auth_key_id, user_id, chat_id, qts, random_id, message_data, date2) values (:auth_key_id, :user_id, :chat_id, :qts, :random_id, :message_data, :date2)
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) Insert(ctx context.Context, do *dataobject.SecretChatQueuesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into secret_chat_queues(auth_key_id, user_id, chat_id, qts, random_id, message_data, date2) values (:auth_key_id, :user_id, :chat_id, :qts, :random_id, :message_data, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into secret_chat_queues(auth_key_id, user_id, chat_id, qts, random_id, message_data, date2) values (:auth_key_id, :user_id, :chat_id, :qts, :random_id, :message_data, :date2)
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) InsertTx(tx *sqlx.Tx, do *dataobject.SecretChatQueuesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into secret_chat_queues(auth_key_id, user_id, chat_id, qts, random_id, message_data, date2) values (:auth_key_id, :user_id, :chat_id, :qts, :random_id, :message_data, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByRandomId
+// select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = :auth_key_id and random_id = :random_id
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) SelectByRandomId(ctx context.Context, auth_key_id int64, random_id int64) (rValue *dataobject.SecretChatQueuesDO, err error) {
+	var (
+		query = "select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = ? and random_id = ?"
+		do    = &dataobject.SecretChatQueuesDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, auth_key_id, random_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByRandomId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectByGtQts
+// select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = :auth_key_id and qts > :qts order by qts asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) SelectByGtQts(ctx context.Context, auth_key_id int64, qts int32, limit int32) (rList []dataobject.SecretChatQueuesDO, err error) {
+	var (
+		query  = "select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = ? and qts > ? order by qts asc limit ?"
+		values []dataobject.SecretChatQueuesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_key_id, qts, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByGtQts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByGtQtsWithCB
+// select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = :auth_key_id and qts > :qts order by qts asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) SelectByGtQtsWithCB(ctx context.Context, auth_key_id int64, qts int32, limit int32, cb func(i int, v *dataobject.SecretChatQueuesDO)) (rList []dataobject.SecretChatQueuesDO, err error) {
+	var (
+		query  = "select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = ? and qts > ? order by qts asc limit ?"
+		values []dataobject.SecretChatQueuesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_key_id, qts, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByGtQts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectByLeQts
+// select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = :auth_key_id and qts <= :qts
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) SelectByLeQts(ctx context.Context, auth_key_id int64, qts int32) (rList []dataobject.SecretChatQueuesDO, err error) {
+	var (
+		query  = "select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = ? and qts <= ?"
+		values []dataobject.SecretChatQueuesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_key_id, qts)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByLeQts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByLeQtsWithCB
+// select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = :auth_key_id and qts <= :qts
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) SelectByLeQtsWithCB(ctx context.Context, auth_key_id int64, qts int32, cb func(i int, v *dataobject.SecretChatQueuesDO)) (rList []dataobject.SecretChatQueuesDO, err error) {
+	var (
+		query  = "select id, auth_key_id, user_id, chat_id, qts, random_id, message_data, date2 from secret_chat_queues where auth_key_id = ? and qts <= ?"
+		values []dataobject.SecretChatQueuesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_key_id, qts)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByLeQts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// DeleteByLeQts
+// delete from secret_chat_queues where auth_key_id = :auth_key_id and qts <= :qts
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) DeleteByLeQts(ctx context.Context, auth_key_id int64, qts int32) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from secret_chat_queues where auth_key_id = ? and qts <= ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, auth_key_id, qts)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteByLeQts(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteByLeQts(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByLeQtsTx
+// delete from secret_chat_queues where auth_key_id = :auth_key_id and qts <= :qts
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) DeleteByLeQtsTx(tx *sqlx.Tx, auth_key_id int64, qts int32) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from secret_chat_queues where auth_key_id = ? and qts <= ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, auth_key_id, qts)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteByLeQts(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteByLeQts(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByChatId
+// delete from secret_chat_queues where chat_id = :chat_id
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) DeleteByChatId(ctx context.Context, chat_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from secret_chat_queues where chat_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, chat_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteByChatId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteByChatId(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByChatIdTx
+// delete from secret_chat_queues where chat_id = :chat_id
+// TODO(@benqi): sqlmap
+func (dao *SecretChatQueuesDAO) DeleteByChatIdTx(tx *sqlx.Tx, chat_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from secret_chat_queues where chat_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, chat_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteByChatId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteByChatId(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,227 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type SecretChatsDAO struct {
+	db *sqlx.DB
+}
+
+func NewSecretChatsDAO(db *sqlx.DB) *SecretChatsDAO {
+	return &SecretChatsDAO{db}
+}
+
+// Insert
+// insert into secret_chats(access_hash, admin_id, admin_auth_key_id, participant_id, random_id, g_a, state, date2) values (:access_hash, :admin_id, :admin_auth_key_id, :participant_id, :random_id, :g_a, :state, :date2)
+// TODO(@benqi): sqlmap
+func (dao *SecretChatsDAO) Insert(ctx context.Context, do *dataobject.SecretChatsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into secret_chats(access_hash, admin_id, admin_auth_key_id, participant_id, random_id, g_a, state, date2) values (:access_hash, :admin_id, :admin_auth_key_id, :participant_id, :random_id, :g_a, :state, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into secret_chats(access_hash, admin_id, admin_auth_key_id, participant_id, random_id, g_a, state, date2) values (:access_hash, :admin_id, :admin_auth_key_id, :participant_id, :random_id, :g_a, :state, :date2)
+// TODO(@benqi): sqlmap
+func (dao *SecretChatsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.SecretChatsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into secret_chats(access_hash, admin_id, admin_auth_key_id, participant_id, random_id, g_a, state, date2) values (:access_hash, :admin_id, :admin_auth_key_id, :participant_id, :random_id, :g_a, :state, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// Select
+// select id, access_hash, admin_id, admin_auth_key_id, participant_id, participant_auth_key_id, random_id, g_a, g_b, key_fingerprint, state, date2 from secret_chats where id = :id
+// TODO(@benqi): sqlmap
+func (dao *SecretChatsDAO) Select(ctx context.Context, id int32) (rValue *dataobject.SecretChatsDO, err error) {
+	var (
+		query = "select id, access_hash, admin_id, admin_auth_key_id, participant_id, participant_auth_key_id, random_id, g_a, g_b, key_fingerprint, state, date2 from secret_chats where id = ?"
+		do    = &dataobject.SecretChatsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in Select(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectByRandomId
+// select id, access_hash, admin_id, admin_auth_key_id, participant_id, participant_auth_key_id, random_id, g_a, g_b, key_fingerprint, state, date2 from secret_chats where admin_id = :admin_id and random_id = :random_id
+// TODO(@benqi): sqlmap
+func (dao *SecretChatsDAO) SelectByRandomId(ctx context.Context, admin_id int64, random_id int32) (rValue *dataobject.SecretChatsDO, err error) {
+	var (
+		query = "select id, access_hash, admin_id, admin_auth_key_id, participant_id, participant_auth_key_id, random_id, g_a, g_b, key_fingerprint, state, date2 from secret_chats where admin_id = ? and random_id = ?"
+		do    = &dataobject.SecretChatsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, admin_id, random_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByRandomId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// UpdateAccepted
+// update secret_chats set participant_auth_key_id = :participant_auth_key_id, g_b = :g_b, key_fingerprint = :key_fingerprint, state = :state where id = :id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *SecretChatsDAO) UpdateAccepted(ctx context.Context, participant_auth_key_id int64, g_b string, key_fingerprint int64, state int32, id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update secret_chats set participant_auth_key_id = ?, g_b = ?, key_fingerprint = ?, state = ? where id = ? and state = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, participant_auth_key_id, g_b, key_fingerprint, state, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateAccepted(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateAccepted(_), error: %v", err)
+	}
+
+	return
+}
+
+// update secret_chats set participant_auth_key_id = :participant_auth_key_id, g_b = :g_b, key_fingerprint = :key_fingerprint, state = :state where id = :id and state = 0
+// UpdateAcceptedTx
+// TODO(@benqi): sqlmap
+func (dao *SecretChatsDAO) UpdateAcceptedTx(tx *sqlx.Tx, participant_auth_key_id int64, g_b string, key_fingerprint int64, state int32, id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update secret_chats set participant_auth_key_id = ?, g_b = ?, key_fingerprint = ?, state = ? where id = ? and state = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, participant_auth_key_id, g_b, key_fingerprint, state, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateAccepted(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateAccepted(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateState
+// update secret_chats set state = :state where id = :id
+// TODO(@benqi): sqlmap
+func (dao *SecretChatsDAO) UpdateState(ctx context.Context, state int32, id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update secret_chats set state = ? where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, state, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateState(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateState(_), error: %v", err)
+	}
+
+	return
+}
+
+// update secret_chats set state = :state where id = :id
+// UpdateStateTx
+// TODO(@benqi): sqlmap
+func (dao *SecretChatsDAO) UpdateStateTx(tx *sqlx.Tx, state int32, id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update secret_chats set state = ? where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, state, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateState(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateState(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,22 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type SecretChatQueuesDO struct {
+	Id          int64  `db:"id"`
+	AuthKeyId   int64  `db:"auth_key_id"`
+	UserId      int64  `db:"user_id"`
+	ChatId      int32  `db:"chat_id"`
+	Qts         int32  `db:"qts"`
+	RandomId    int64  `db:"random_id"`
+	MessageData string `db:"message_data"`
+	Date2       int64  `db:"date2"`
+}
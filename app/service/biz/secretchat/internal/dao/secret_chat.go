@@ -0,0 +1,101 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func EncodeBytes(b []byte) string {
+	return base64.RawStdEncoding.EncodeToString(b)
+}
+
+func decodeBytes(s string) []byte {
+	b, _ := base64.RawStdEncoding.DecodeString(s)
+	return b
+}
+
+func (d *Dao) MakeSecretChatData(do *dataobject.SecretChatsDO) *secretchat.SecretChatData {
+	return secretchat.MakeTLSecretChatData(&secretchat.SecretChatData{
+		Id:                   do.Id,
+		AccessHash:           do.AccessHash,
+		AdminId:              do.AdminId,
+		AdminAuthKeyId:       do.AdminAuthKeyId,
+		ParticipantId:        do.ParticipantId,
+		ParticipantAuthKeyId: do.ParticipantAuthKeyId,
+		RandomId:             do.RandomId,
+		GA:                   decodeBytes(do.GA),
+		GB:                   decodeBytes(do.GB),
+		KeyFingerprint:       do.KeyFingerprint,
+		State:                do.State,
+		Date:                 int32(do.Date2),
+	}).To_SecretChatData()
+}
+
+func (d *Dao) GetSecretChat(ctx context.Context, id int32) (*secretchat.SecretChatData, error) {
+	do, err := d.SecretChatsDAO.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if do == nil {
+		return nil, mtproto.ErrEncryptionIdInvalid
+	}
+
+	return d.MakeSecretChatData(do), nil
+}
+
+func (d *Dao) MakeEncryptedMessage(do *dataobject.SecretChatQueuesDO) (*mtproto.EncryptedMessage, error) {
+	message := new(mtproto.EncryptedMessage)
+	if err := jsonx.UnmarshalFromString(do.MessageData, message); err != nil {
+		logx.Errorf("unmarshal secret_chat_queues(%d) error: %v", do.Id, err)
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// GetQueuedEncryptedMessageData
+// returns the message still queued for authKeyId under randomId, nil if there is none.
+func (d *Dao) GetQueuedEncryptedMessageData(ctx context.Context, authKeyId, randomId int64) (*secretchat.EncryptedMessageData, error) {
+	do, err := d.SecretChatQueuesDAO.SelectByRandomId(ctx, authKeyId, randomId)
+	if err != nil {
+		return nil, err
+	} else if do == nil {
+		return nil, nil
+	}
+
+	message, err := d.MakeEncryptedMessage(do)
+	if err != nil {
+		return nil, err
+	}
+
+	return secretchat.MakeTLEncryptedMessageData(&secretchat.EncryptedMessageData{
+		UserId:    do.UserId,
+		AuthKeyId: do.AuthKeyId,
+		Qts:       do.Qts,
+		Message:   message,
+	}).To_EncryptedMessageData(), nil
+}
@@ -0,0 +1,139 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/internal/core"
+	"github.com/teamgram/teamgram-server/app/service/biz/secretchat/secretchat"
+)
+
+// SecretchatRequestEncryption
+// secretchat.requestEncryption admin_id:long admin_auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChatData;
+func (s *Service) SecretchatRequestEncryption(ctx context.Context, request *secretchat.TLSecretchatRequestEncryption) (*secretchat.SecretChatData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("secretchat.requestEncryption - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatRequestEncryption(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("secretchat.requestEncryption - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatAcceptEncryption
+// secretchat.acceptEncryption chat_id:int participant_id:long participant_auth_key_id:long g_b:bytes key_fingerprint:long = SecretChatData;
+func (s *Service) SecretchatAcceptEncryption(ctx context.Context, request *secretchat.TLSecretchatAcceptEncryption) (*secretchat.SecretChatData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("secretchat.acceptEncryption - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatAcceptEncryption(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("secretchat.acceptEncryption - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatDiscardEncryption
+// secretchat.discardEncryption chat_id:int user_id:long delete_history:Bool = SecretChatData;
+func (s *Service) SecretchatDiscardEncryption(ctx context.Context, request *secretchat.TLSecretchatDiscardEncryption) (*secretchat.SecretChatData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("secretchat.discardEncryption - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatDiscardEncryption(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("secretchat.discardEncryption - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatGetSecretChat
+// secretchat.getSecretChat chat_id:int = SecretChatData;
+func (s *Service) SecretchatGetSecretChat(ctx context.Context, request *secretchat.TLSecretchatGetSecretChat) (*secretchat.SecretChatData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("secretchat.getSecretChat - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatGetSecretChat(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("secretchat.getSecretChat - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatSendEncryptedMessage
+// secretchat.sendEncryptedMessage user_id:long chat_id:int message:EncryptedMessage = EncryptedMessageData;
+func (s *Service) SecretchatSendEncryptedMessage(ctx context.Context, request *secretchat.TLSecretchatSendEncryptedMessage) (*secretchat.EncryptedMessageData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("secretchat.sendEncryptedMessage - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatSendEncryptedMessage(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("secretchat.sendEncryptedMessage - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatGetDifference
+// secretchat.getDifference auth_key_id:long qts:int limit:int = SecretChatDifference;
+func (s *Service) SecretchatGetDifference(ctx context.Context, request *secretchat.TLSecretchatGetDifference) (*secretchat.SecretChatDifference, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("secretchat.getDifference - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatGetDifference(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("secretchat.getDifference - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatGetCurrentQts
+// secretchat.getCurrentQts auth_key_id:long = Int32;
+func (s *Service) SecretchatGetCurrentQts(ctx context.Context, request *secretchat.TLSecretchatGetCurrentQts) (*mtproto.Int32, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("secretchat.getCurrentQts - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatGetCurrentQts(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("secretchat.getCurrentQts - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatReceivedQueue
+// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+func (s *Service) SecretchatReceivedQueue(ctx context.Context, request *secretchat.TLSecretchatReceivedQueue) (*secretchat.Vector_Long, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("secretchat.receivedQueue - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatReceivedQueue(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("secretchat.receivedQueue - reply: %s", r.DebugString())
+	return r, err
+}
@@ -0,0 +1,101 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package secretchat
+
+const (
+	Predicate_secretChatData                  = "secretChatData"
+	Predicate_encryptedMessageData            = "encryptedMessageData"
+	Predicate_secretChatDifference            = "secretChatDifference"
+	Predicate_secretchat_requestEncryption    = "secretchat_requestEncryption"
+	Predicate_secretchat_acceptEncryption     = "secretchat_acceptEncryption"
+	Predicate_secretchat_discardEncryption    = "secretchat_discardEncryption"
+	Predicate_secretchat_getSecretChat        = "secretchat_getSecretChat"
+	Predicate_secretchat_sendEncryptedMessage = "secretchat_sendEncryptedMessage"
+	Predicate_secretchat_getDifference        = "secretchat_getDifference"
+	Predicate_secretchat_getCurrentQts        = "secretchat_getCurrentQts"
+	Predicate_secretchat_receivedQueue        = "secretchat_receivedQueue"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_secretChatData: {
+		0: 2021766153, // 0x7881b409
+
+	},
+	Predicate_encryptedMessageData: {
+		0: 376919572, // 0x16775614
+
+	},
+	Predicate_secretChatDifference: {
+		0: 523901090, // 0x1f3a18a2
+
+	},
+	Predicate_secretchat_requestEncryption: {
+		0: -492340831, // 0xe2a779a1
+
+	},
+	Predicate_secretchat_acceptEncryption: {
+		0: 802850071, // 0x2fda8517
+
+	},
+	Predicate_secretchat_discardEncryption: {
+		0: -675525901, // 0xd7bc4af3
+
+	},
+	Predicate_secretchat_getSecretChat: {
+		0: 1936540531, // 0x736d4373
+
+	},
+	Predicate_secretchat_sendEncryptedMessage: {
+		0: 241288781, // 0xe61c64d
+
+	},
+	Predicate_secretchat_getDifference: {
+		0: -869344375, // 0xcc2edb89
+
+	},
+	Predicate_secretchat_getCurrentQts: {
+		0: -1250924352, // 0xb57068c0
+
+	},
+	Predicate_secretchat_receivedQueue: {
+		0: -1758015209, // 0x9736d117
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	2021766153:  Predicate_secretChatData,                  // 0x7881b409
+	376919572:   Predicate_encryptedMessageData,            // 0x16775614
+	523901090:   Predicate_secretChatDifference,            // 0x1f3a18a2
+	-492340831:  Predicate_secretchat_requestEncryption,    // 0xe2a779a1
+	802850071:   Predicate_secretchat_acceptEncryption,     // 0x2fda8517
+	-675525901:  Predicate_secretchat_discardEncryption,    // 0xd7bc4af3
+	1936540531:  Predicate_secretchat_getSecretChat,        // 0x736d4373
+	241288781:   Predicate_secretchat_sendEncryptedMessage, // 0xe61c64d
+	-869344375:  Predicate_secretchat_getDifference,        // 0xcc2edb89
+	-1250924352: Predicate_secretchat_getCurrentQts,        // 0xb57068c0
+	-1758015209: Predicate_secretchat_receivedQueue,        // 0x9736d117
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
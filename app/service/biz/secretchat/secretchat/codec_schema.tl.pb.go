@@ -0,0 +1,1092 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package secretchat
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+	2021766153: func() mtproto.TLObject { // 0x7881b409
+		o := MakeTLSecretChatData(nil)
+		o.Data2.Constructor = 2021766153
+		return o
+	},
+	376919572: func() mtproto.TLObject { // 0x16775614
+		o := MakeTLEncryptedMessageData(nil)
+		o.Data2.Constructor = 376919572
+		return o
+	},
+	523901090: func() mtproto.TLObject { // 0x1f3a18a2
+		o := MakeTLSecretChatDifference(nil)
+		o.Data2.Constructor = 523901090
+		return o
+	},
+
+	// Method
+	-492340831: func() mtproto.TLObject { // 0xe2a779a1
+		return &TLSecretchatRequestEncryption{
+			Constructor: -492340831,
+		}
+	},
+	802850071: func() mtproto.TLObject { // 0x2fda8517
+		return &TLSecretchatAcceptEncryption{
+			Constructor: 802850071,
+		}
+	},
+	-675525901: func() mtproto.TLObject { // 0xd7bc4af3
+		return &TLSecretchatDiscardEncryption{
+			Constructor: -675525901,
+		}
+	},
+	1936540531: func() mtproto.TLObject { // 0x736d4373
+		return &TLSecretchatGetSecretChat{
+			Constructor: 1936540531,
+		}
+	},
+	241288781: func() mtproto.TLObject { // 0xe61c64d
+		return &TLSecretchatSendEncryptedMessage{
+			Constructor: 241288781,
+		}
+	},
+	-869344375: func() mtproto.TLObject { // 0xcc2edb89
+		return &TLSecretchatGetDifference{
+			Constructor: -869344375,
+		}
+	},
+	-1250924352: func() mtproto.TLObject { // 0xb57068c0
+		return &TLSecretchatGetCurrentQts{
+			Constructor: -1250924352,
+		}
+	},
+	-1758015209: func() mtproto.TLObject { // 0x9736d117
+		return &TLSecretchatReceivedQueue{
+			Constructor: -1758015209,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+///////////////////////////////////////////////////////////////////////////////
+// SecretChatData <--
+//  + TL_SecretChatData
+//
+
+func (m *SecretChatData) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_secretChatData:
+		t := m.To_SecretChatData()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *SecretChatData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *SecretChatData) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x7881b409:
+		m2 := MakeTLSecretChatData(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *SecretChatData) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_secretChatData:
+		t := m.To_SecretChatData()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_SecretChatData
+// secretChatData id:int access_hash:long admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long random_id:int g_a:bytes g_b:bytes key_fingerprint:long state:int date:int = SecretChatData;
+func (m *SecretChatData) To_SecretChatData() *TLSecretChatData {
+	m.PredicateName = Predicate_secretChatData
+	return &TLSecretChatData{
+		Data2: m,
+	}
+}
+
+// MakeTLSecretChatData
+// secretChatData id:int access_hash:long admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long random_id:int g_a:bytes g_b:bytes key_fingerprint:long state:int date:int = SecretChatData;
+func MakeTLSecretChatData(data2 *SecretChatData) *TLSecretChatData {
+	if data2 == nil {
+		return &TLSecretChatData{Data2: &SecretChatData{
+			PredicateName: Predicate_secretChatData,
+		}}
+	} else {
+		data2.PredicateName = Predicate_secretChatData
+		return &TLSecretChatData{Data2: data2}
+	}
+}
+
+func (m *TLSecretChatData) To_SecretChatData() *SecretChatData {
+	m.Data2.PredicateName = Predicate_secretChatData
+	return m.Data2
+}
+
+func (m *TLSecretChatData) SetId(v int32) { m.Data2.Id = v }
+func (m *TLSecretChatData) GetId() int32  { return m.Data2.Id }
+
+func (m *TLSecretChatData) SetAccessHash(v int64) { m.Data2.AccessHash = v }
+func (m *TLSecretChatData) GetAccessHash() int64  { return m.Data2.AccessHash }
+
+func (m *TLSecretChatData) SetAdminId(v int64) { m.Data2.AdminId = v }
+func (m *TLSecretChatData) GetAdminId() int64  { return m.Data2.AdminId }
+
+func (m *TLSecretChatData) SetAdminAuthKeyId(v int64) { m.Data2.AdminAuthKeyId = v }
+func (m *TLSecretChatData) GetAdminAuthKeyId() int64  { return m.Data2.AdminAuthKeyId }
+
+func (m *TLSecretChatData) SetParticipantId(v int64) { m.Data2.ParticipantId = v }
+func (m *TLSecretChatData) GetParticipantId() int64  { return m.Data2.ParticipantId }
+
+func (m *TLSecretChatData) SetParticipantAuthKeyId(v int64) { m.Data2.ParticipantAuthKeyId = v }
+func (m *TLSecretChatData) GetParticipantAuthKeyId() int64  { return m.Data2.ParticipantAuthKeyId }
+
+func (m *TLSecretChatData) SetRandomId(v int32) { m.Data2.RandomId = v }
+func (m *TLSecretChatData) GetRandomId() int32  { return m.Data2.RandomId }
+
+func (m *TLSecretChatData) SetGA(v []byte) { m.Data2.GA = v }
+func (m *TLSecretChatData) GetGA() []byte  { return m.Data2.GA }
+
+func (m *TLSecretChatData) SetGB(v []byte) { m.Data2.GB = v }
+func (m *TLSecretChatData) GetGB() []byte  { return m.Data2.GB }
+
+func (m *TLSecretChatData) SetKeyFingerprint(v int64) { m.Data2.KeyFingerprint = v }
+func (m *TLSecretChatData) GetKeyFingerprint() int64  { return m.Data2.KeyFingerprint }
+
+func (m *TLSecretChatData) SetState(v int32) { m.Data2.State = v }
+func (m *TLSecretChatData) GetState() int32  { return m.Data2.State }
+
+func (m *TLSecretChatData) SetDate(v int32) { m.Data2.Date = v }
+func (m *TLSecretChatData) GetDate() int32  { return m.Data2.Date }
+
+func (m *TLSecretChatData) GetPredicateName() string {
+	return Predicate_secretChatData
+}
+
+func (m *TLSecretChatData) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x7881b409: func() []byte {
+			// secretChatData id:int access_hash:long admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long random_id:int g_a:bytes g_b:bytes key_fingerprint:long state:int date:int = SecretChatData;
+			x.UInt(0x7881b409)
+
+			x.Int(m.GetId())
+			x.Long(m.GetAccessHash())
+			x.Long(m.GetAdminId())
+			x.Long(m.GetAdminAuthKeyId())
+			x.Long(m.GetParticipantId())
+			x.Long(m.GetParticipantAuthKeyId())
+			x.Int(m.GetRandomId())
+			x.StringBytes(m.GetGA())
+			x.StringBytes(m.GetGB())
+			x.Long(m.GetKeyFingerprint())
+			x.Int(m.GetState())
+			x.Int(m.GetDate())
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_secretChatData, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_secretChatData, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretChatData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretChatData) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x7881b409: func() error {
+			// secretChatData id:int access_hash:long admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long random_id:int g_a:bytes g_b:bytes key_fingerprint:long state:int date:int = SecretChatData;
+			m.SetId(dBuf.Int())
+			m.SetAccessHash(dBuf.Long())
+			m.SetAdminId(dBuf.Long())
+			m.SetAdminAuthKeyId(dBuf.Long())
+			m.SetParticipantId(dBuf.Long())
+			m.SetParticipantAuthKeyId(dBuf.Long())
+			m.SetRandomId(dBuf.Int())
+			m.SetGA(dBuf.StringBytes())
+			m.SetGB(dBuf.StringBytes())
+			m.SetKeyFingerprint(dBuf.Long())
+			m.SetState(dBuf.Int())
+			m.SetDate(dBuf.Int())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLSecretChatData) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// EncryptedMessageData <--
+//  + TL_EncryptedMessageData
+//
+
+func (m *EncryptedMessageData) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_encryptedMessageData:
+		t := m.To_EncryptedMessageData()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *EncryptedMessageData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *EncryptedMessageData) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x16775614:
+		m2 := MakeTLEncryptedMessageData(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *EncryptedMessageData) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_encryptedMessageData:
+		t := m.To_EncryptedMessageData()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_EncryptedMessageData
+// encryptedMessageData user_id:long auth_key_id:long qts:int message:EncryptedMessage = EncryptedMessageData;
+func (m *EncryptedMessageData) To_EncryptedMessageData() *TLEncryptedMessageData {
+	m.PredicateName = Predicate_encryptedMessageData
+	return &TLEncryptedMessageData{
+		Data2: m,
+	}
+}
+
+// MakeTLEncryptedMessageData
+// encryptedMessageData user_id:long auth_key_id:long qts:int message:EncryptedMessage = EncryptedMessageData;
+func MakeTLEncryptedMessageData(data2 *EncryptedMessageData) *TLEncryptedMessageData {
+	if data2 == nil {
+		return &TLEncryptedMessageData{Data2: &EncryptedMessageData{
+			PredicateName: Predicate_encryptedMessageData,
+		}}
+	} else {
+		data2.PredicateName = Predicate_encryptedMessageData
+		return &TLEncryptedMessageData{Data2: data2}
+	}
+}
+
+func (m *TLEncryptedMessageData) To_EncryptedMessageData() *EncryptedMessageData {
+	m.Data2.PredicateName = Predicate_encryptedMessageData
+	return m.Data2
+}
+
+func (m *TLEncryptedMessageData) SetUserId(v int64) { m.Data2.UserId = v }
+func (m *TLEncryptedMessageData) GetUserId() int64  { return m.Data2.UserId }
+
+func (m *TLEncryptedMessageData) SetAuthKeyId(v int64) { m.Data2.AuthKeyId = v }
+func (m *TLEncryptedMessageData) GetAuthKeyId() int64  { return m.Data2.AuthKeyId }
+
+func (m *TLEncryptedMessageData) SetQts(v int32) { m.Data2.Qts = v }
+func (m *TLEncryptedMessageData) GetQts() int32  { return m.Data2.Qts }
+
+func (m *TLEncryptedMessageData) SetMessage(v *mtproto.EncryptedMessage) { m.Data2.Message = v }
+func (m *TLEncryptedMessageData) GetMessage() *mtproto.EncryptedMessage  { return m.Data2.Message }
+
+func (m *TLEncryptedMessageData) GetPredicateName() string {
+	return Predicate_encryptedMessageData
+}
+
+func (m *TLEncryptedMessageData) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x16775614: func() []byte {
+			// encryptedMessageData user_id:long auth_key_id:long qts:int message:EncryptedMessage = EncryptedMessageData;
+			x.UInt(0x16775614)
+
+			x.Long(m.GetUserId())
+			x.Long(m.GetAuthKeyId())
+			x.Int(m.GetQts())
+			x.Bytes(m.GetMessage().Encode(layer))
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_encryptedMessageData, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_encryptedMessageData, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLEncryptedMessageData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLEncryptedMessageData) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x16775614: func() error {
+			// encryptedMessageData user_id:long auth_key_id:long qts:int message:EncryptedMessage = EncryptedMessageData;
+			m.SetUserId(dBuf.Long())
+			m.SetAuthKeyId(dBuf.Long())
+			m.SetQts(dBuf.Int())
+			m3 := &mtproto.EncryptedMessage{}
+			m3.Decode(dBuf)
+			m.SetMessage(m3)
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLEncryptedMessageData) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SecretChatDifference <--
+//  + TL_SecretChatDifference
+//
+
+func (m *SecretChatDifference) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_secretChatDifference:
+		t := m.To_SecretChatDifference()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *SecretChatDifference) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *SecretChatDifference) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x1f3a18a2:
+		m2 := MakeTLSecretChatDifference(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *SecretChatDifference) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_secretChatDifference:
+		t := m.To_SecretChatDifference()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_SecretChatDifference
+// secretChatDifference qts:int new_encrypted_messages:Vector<EncryptedMessage> = SecretChatDifference;
+func (m *SecretChatDifference) To_SecretChatDifference() *TLSecretChatDifference {
+	m.PredicateName = Predicate_secretChatDifference
+	return &TLSecretChatDifference{
+		Data2: m,
+	}
+}
+
+// MakeTLSecretChatDifference
+// secretChatDifference qts:int new_encrypted_messages:Vector<EncryptedMessage> = SecretChatDifference;
+func MakeTLSecretChatDifference(data2 *SecretChatDifference) *TLSecretChatDifference {
+	if data2 == nil {
+		return &TLSecretChatDifference{Data2: &SecretChatDifference{
+			PredicateName: Predicate_secretChatDifference,
+		}}
+	} else {
+		data2.PredicateName = Predicate_secretChatDifference
+		return &TLSecretChatDifference{Data2: data2}
+	}
+}
+
+func (m *TLSecretChatDifference) To_SecretChatDifference() *SecretChatDifference {
+	m.Data2.PredicateName = Predicate_secretChatDifference
+	return m.Data2
+}
+
+func (m *TLSecretChatDifference) SetQts(v int32) { m.Data2.Qts = v }
+func (m *TLSecretChatDifference) GetQts() int32  { return m.Data2.Qts }
+
+func (m *TLSecretChatDifference) SetNewEncryptedMessages(v []*mtproto.EncryptedMessage) {
+	m.Data2.NewEncryptedMessages = v
+}
+func (m *TLSecretChatDifference) GetNewEncryptedMessages() []*mtproto.EncryptedMessage {
+	return m.Data2.NewEncryptedMessages
+}
+
+func (m *TLSecretChatDifference) GetPredicateName() string {
+	return Predicate_secretChatDifference
+}
+
+func (m *TLSecretChatDifference) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x1f3a18a2: func() []byte {
+			// secretChatDifference qts:int new_encrypted_messages:Vector<EncryptedMessage> = SecretChatDifference;
+			x.UInt(0x1f3a18a2)
+
+			x.Int(m.GetQts())
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetNewEncryptedMessages())))
+			for _, v := range m.GetNewEncryptedMessages() {
+				x.Bytes((*v).Encode(layer))
+			}
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_secretChatDifference, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_secretChatDifference, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretChatDifference) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretChatDifference) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x1f3a18a2: func() error {
+			// secretChatDifference qts:int new_encrypted_messages:Vector<EncryptedMessage> = SecretChatDifference;
+			m.SetQts(dBuf.Int())
+			c1 := dBuf.Int()
+			if c1 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+			}
+			l1 := dBuf.Int()
+			v1 := make([]*mtproto.EncryptedMessage, l1)
+			for i := int32(0); i < l1; i++ {
+				v1[i] = &mtproto.EncryptedMessage{}
+				v1[i].Decode(dBuf)
+			}
+			m.SetNewEncryptedMessages(v1)
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLSecretChatDifference) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// TLSecretchatRequestEncryption
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatRequestEncryption) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_requestEncryption))
+
+	switch uint32(m.Constructor) {
+	case 0xe2a779a1:
+		// secretchat.requestEncryption admin_id:long admin_auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChatData;
+		x.UInt(0xe2a779a1)
+
+		// no flags
+
+		x.Long(m.GetAdminId())
+		x.Long(m.GetAdminAuthKeyId())
+		x.Long(m.GetParticipantId())
+		x.Int(m.GetRandomId())
+		x.StringBytes(m.GetGA())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatRequestEncryption) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe2a779a1:
+		// secretchat.requestEncryption admin_id:long admin_auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChatData;
+
+		// not has flags
+
+		m.AdminId = dBuf.Long()
+		m.AdminAuthKeyId = dBuf.Long()
+		m.ParticipantId = dBuf.Long()
+		m.RandomId = dBuf.Int()
+		m.GA = dBuf.StringBytes()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatRequestEncryption) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatAcceptEncryption
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatAcceptEncryption) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_acceptEncryption))
+
+	switch uint32(m.Constructor) {
+	case 0x2fda8517:
+		// secretchat.acceptEncryption chat_id:int participant_id:long participant_auth_key_id:long g_b:bytes key_fingerprint:long = SecretChatData;
+		x.UInt(0x2fda8517)
+
+		// no flags
+
+		x.Int(m.GetChatId())
+		x.Long(m.GetParticipantId())
+		x.Long(m.GetParticipantAuthKeyId())
+		x.StringBytes(m.GetGB())
+		x.Long(m.GetKeyFingerprint())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatAcceptEncryption) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatAcceptEncryption) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x2fda8517:
+		// secretchat.acceptEncryption chat_id:int participant_id:long participant_auth_key_id:long g_b:bytes key_fingerprint:long = SecretChatData;
+
+		// not has flags
+
+		m.ChatId = dBuf.Int()
+		m.ParticipantId = dBuf.Long()
+		m.ParticipantAuthKeyId = dBuf.Long()
+		m.GB = dBuf.StringBytes()
+		m.KeyFingerprint = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatAcceptEncryption) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatDiscardEncryption
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatDiscardEncryption) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_discardEncryption))
+
+	switch uint32(m.Constructor) {
+	case 0xd7bc4af3:
+		// secretchat.discardEncryption chat_id:int user_id:long delete_history:Bool = SecretChatData;
+		x.UInt(0xd7bc4af3)
+
+		// no flags
+
+		x.Int(m.GetChatId())
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetDeleteHistory().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatDiscardEncryption) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatDiscardEncryption) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd7bc4af3:
+		// secretchat.discardEncryption chat_id:int user_id:long delete_history:Bool = SecretChatData;
+
+		// not has flags
+
+		m.ChatId = dBuf.Int()
+		m.UserId = dBuf.Long()
+
+		m3 := &mtproto.Bool{}
+		m3.Decode(dBuf)
+		m.DeleteHistory = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatDiscardEncryption) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatGetSecretChat
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatGetSecretChat) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_getSecretChat))
+
+	switch uint32(m.Constructor) {
+	case 0x736d4373:
+		// secretchat.getSecretChat chat_id:int = SecretChatData;
+		x.UInt(0x736d4373)
+
+		// no flags
+
+		x.Int(m.GetChatId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatGetSecretChat) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatGetSecretChat) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x736d4373:
+		// secretchat.getSecretChat chat_id:int = SecretChatData;
+
+		// not has flags
+
+		m.ChatId = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatGetSecretChat) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatSendEncryptedMessage
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatSendEncryptedMessage) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_sendEncryptedMessage))
+
+	switch uint32(m.Constructor) {
+	case 0xe61c64d:
+		// secretchat.sendEncryptedMessage user_id:long chat_id:int message:EncryptedMessage = EncryptedMessageData;
+		x.UInt(0xe61c64d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Int(m.GetChatId())
+		x.Bytes(m.GetMessage().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatSendEncryptedMessage) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatSendEncryptedMessage) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe61c64d:
+		// secretchat.sendEncryptedMessage user_id:long chat_id:int message:EncryptedMessage = EncryptedMessageData;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.ChatId = dBuf.Int()
+
+		m3 := &mtproto.EncryptedMessage{}
+		m3.Decode(dBuf)
+		m.Message = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatSendEncryptedMessage) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatGetDifference
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatGetDifference) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_getDifference))
+
+	switch uint32(m.Constructor) {
+	case 0xcc2edb89:
+		// secretchat.getDifference auth_key_id:long qts:int limit:int = SecretChatDifference;
+		x.UInt(0xcc2edb89)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetQts())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatGetDifference) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatGetDifference) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xcc2edb89:
+		// secretchat.getDifference auth_key_id:long qts:int limit:int = SecretChatDifference;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		m.Qts = dBuf.Int()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatGetDifference) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatGetCurrentQts
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatGetCurrentQts) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_getCurrentQts))
+
+	switch uint32(m.Constructor) {
+	case 0xb57068c0:
+		// secretchat.getCurrentQts auth_key_id:long = Int32;
+		x.UInt(0xb57068c0)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatGetCurrentQts) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatGetCurrentQts) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xb57068c0:
+		// secretchat.getCurrentQts auth_key_id:long = Int32;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatGetCurrentQts) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatReceivedQueue
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatReceivedQueue) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_receivedQueue))
+
+	switch uint32(m.Constructor) {
+	case 0x9736d117:
+		// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+		x.UInt(0x9736d117)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetMaxQts())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatReceivedQueue) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatReceivedQueue) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x9736d117:
+		// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		m.MaxQts = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatReceivedQueue) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// Vector_Long
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_Long) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.VectorLong(m.Datas)
+
+	return x.GetBuf()
+}
+
+func (m *Vector_Long) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Datas = dBuf.VectorLong()
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_Long) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_Long) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
@@ -0,0 +1,55 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package secretchat
+
+import (
+	"reflect"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+var _ *mtproto.Bool
+
+type newRPCReplyFunc func() interface{}
+
+type RPCContextTuple struct {
+	Method       string
+	NewReplyFunc newRPCReplyFunc
+}
+
+var rpcContextRegisters = map[string]RPCContextTuple{
+	"TLSecretchatRequestEncryption":    RPCContextTuple{"/mtproto.RPCSecretchat/secretchat_requestEncryption", func() interface{} { return new(SecretChatData) }},
+	"TLSecretchatAcceptEncryption":     RPCContextTuple{"/mtproto.RPCSecretchat/secretchat_acceptEncryption", func() interface{} { return new(SecretChatData) }},
+	"TLSecretchatDiscardEncryption":    RPCContextTuple{"/mtproto.RPCSecretchat/secretchat_discardEncryption", func() interface{} { return new(SecretChatData) }},
+	"TLSecretchatGetSecretChat":        RPCContextTuple{"/mtproto.RPCSecretchat/secretchat_getSecretChat", func() interface{} { return new(SecretChatData) }},
+	"TLSecretchatSendEncryptedMessage": RPCContextTuple{"/mtproto.RPCSecretchat/secretchat_sendEncryptedMessage", func() interface{} { return new(EncryptedMessageData) }},
+	"TLSecretchatGetDifference":        RPCContextTuple{"/mtproto.RPCSecretchat/secretchat_getDifference", func() interface{} { return new(SecretChatDifference) }},
+	"TLSecretchatGetCurrentQts":        RPCContextTuple{"/mtproto.RPCSecretchat/secretchat_getCurrentQts", func() interface{} { return new(mtproto.Int32) }},
+	"TLSecretchatReceivedQueue":        RPCContextTuple{"/mtproto.RPCSecretchat/secretchat_receivedQueue", func() interface{} { return new(Vector_Long) }},
+}
+
+func FindRPCContextTuple(t interface{}) *RPCContextTuple {
+	rt := reflect.TypeOf(t)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	m, ok := rpcContextRegisters[rt.Name()]
+	if !ok {
+		// log.Errorf("Can't find name: %s", rt.Name())
+		return nil
+	}
+	return &m
+}
+
+func GetRPCContextRegisters() map[string]RPCContextTuple {
+	return rpcContextRegisters
+}
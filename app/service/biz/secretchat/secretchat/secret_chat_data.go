@@ -0,0 +1,75 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package secretchat
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+const (
+	SecretChatStateRequested = 0
+	SecretChatStateAccepted  = 1
+	SecretChatStateDiscarded = 2
+)
+
+func (m *SecretChatData) IsAdmin(userId int64) bool {
+	return m.AdminId == userId
+}
+
+func (m *SecretChatData) IsParticipant(userId int64) bool {
+	return m.AdminId == userId || m.ParticipantId == userId
+}
+
+// GetPeer returns the other side of the chat, and the auth key of its device
+// (0 while the chat was not accepted yet).
+func (m *SecretChatData) GetPeer(userId int64) (peerId int64, peerAuthKeyId int64) {
+	if m.AdminId == userId {
+		return m.ParticipantId, m.ParticipantAuthKeyId
+	}
+	return m.AdminId, m.AdminAuthKeyId
+}
+
+func (m *SecretChatData) ToEncryptedChat(selfId int64) *mtproto.EncryptedChat {
+	switch m.State {
+	case SecretChatStateRequested:
+		if m.IsAdmin(selfId) {
+			return mtproto.MakeTLEncryptedChatWaiting(&mtproto.EncryptedChat{
+				Id:            m.Id,
+				AccessHash:    m.AccessHash,
+				Date:          m.Date,
+				AdminId:       m.AdminId,
+				ParticipantId: m.ParticipantId,
+			}).To_EncryptedChat()
+		}
+		return mtproto.MakeTLEncryptedChatRequested(&mtproto.EncryptedChat{
+			Id:            m.Id,
+			AccessHash:    m.AccessHash,
+			Date:          m.Date,
+			AdminId:       m.AdminId,
+			ParticipantId: m.ParticipantId,
+			GA:            m.GA,
+		}).To_EncryptedChat()
+	case SecretChatStateAccepted:
+		gAOrB := m.GA
+		if m.IsAdmin(selfId) {
+			gAOrB = m.GB
+		}
+		return mtproto.MakeTLEncryptedChat(&mtproto.EncryptedChat{
+			Id:             m.Id,
+			AccessHash:     m.AccessHash,
+			Date:           m.Date,
+			AdminId:        m.AdminId,
+			ParticipantId:  m.ParticipantId,
+			GAOrB:          gAOrB,
+			KeyFingerprint: m.KeyFingerprint,
+		}).To_EncryptedChat()
+	default:
+		return mtproto.MakeTLEncryptedChatDiscarded(&mtproto.EncryptedChat{
+			Id: m.Id,
+		}).To_EncryptedChat()
+	}
+}
@@ -0,0 +1,5316 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secretchat.tl.proto
+
+package secretchat
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN                         TLConstructor = 0
+	CRC32_secretChatData                  TLConstructor = 2021766153
+	CRC32_encryptedMessageData            TLConstructor = 376919572
+	CRC32_secretChatDifference            TLConstructor = 523901090
+	CRC32_secretchat_requestEncryption    TLConstructor = -492340831
+	CRC32_secretchat_acceptEncryption     TLConstructor = 802850071
+	CRC32_secretchat_discardEncryption    TLConstructor = -675525901
+	CRC32_secretchat_getSecretChat        TLConstructor = 1936540531
+	CRC32_secretchat_sendEncryptedMessage TLConstructor = 241288781
+	CRC32_secretchat_getDifference        TLConstructor = -869344375
+	CRC32_secretchat_getCurrentQts        TLConstructor = -1250924352
+	CRC32_secretchat_receivedQueue        TLConstructor = -1758015209
+)
+
+var TLConstructor_name = map[int32]string{
+	0:           "CRC32_UNKNOWN",
+	2021766153:  "CRC32_secretChatData",
+	376919572:   "CRC32_encryptedMessageData",
+	523901090:   "CRC32_secretChatDifference",
+	-492340831:  "CRC32_secretchat_requestEncryption",
+	802850071:   "CRC32_secretchat_acceptEncryption",
+	-675525901:  "CRC32_secretchat_discardEncryption",
+	1936540531:  "CRC32_secretchat_getSecretChat",
+	241288781:   "CRC32_secretchat_sendEncryptedMessage",
+	-869344375:  "CRC32_secretchat_getDifference",
+	-1250924352: "CRC32_secretchat_getCurrentQts",
+	-1758015209: "CRC32_secretchat_receivedQueue",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":                         0,
+	"CRC32_secretChatData":                  2021766153,
+	"CRC32_encryptedMessageData":            376919572,
+	"CRC32_secretChatDifference":            523901090,
+	"CRC32_secretchat_requestEncryption":    -492340831,
+	"CRC32_secretchat_acceptEncryption":     802850071,
+	"CRC32_secretchat_discardEncryption":    -675525901,
+	"CRC32_secretchat_getSecretChat":        1936540531,
+	"CRC32_secretchat_sendEncryptedMessage": 241288781,
+	"CRC32_secretchat_getDifference":        -869344375,
+	"CRC32_secretchat_getCurrentQts":        -1250924352,
+	"CRC32_secretchat_receivedQueue":        -1758015209,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// encryptedMessageData user_id:long auth_key_id:long qts:int message:EncryptedMessage = EncryptedMessageData;
+//
+// EncryptedMessageData <--
+//  + TL_encryptedMessageData
+//
+type EncryptedMessageData struct {
+	PredicateName        string                    `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor             `protobuf:"varint,2,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                     `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64                     `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Qts                  int32                     `protobuf:"varint,5,opt,name=qts,proto3" json:"qts,omitempty"`
+	Message              *mtproto.EncryptedMessage `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *EncryptedMessageData) Reset()         { *m = EncryptedMessageData{} }
+func (m *EncryptedMessageData) String() string { return proto.CompactTextString(m) }
+func (*EncryptedMessageData) ProtoMessage()    {}
+func (*EncryptedMessageData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{0}
+}
+func (m *EncryptedMessageData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedMessageData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedMessageData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptedMessageData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedMessageData.Merge(m, src)
+}
+func (m *EncryptedMessageData) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedMessageData) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedMessageData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedMessageData proto.InternalMessageInfo
+
+func (m *EncryptedMessageData) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *EncryptedMessageData) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *EncryptedMessageData) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *EncryptedMessageData) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *EncryptedMessageData) GetQts() int32 {
+	if m != nil {
+		return m.Qts
+	}
+	return 0
+}
+
+func (m *EncryptedMessageData) GetMessage() *mtproto.EncryptedMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+// encryptedMessageData user_id:long auth_key_id:long qts:int message:EncryptedMessage = EncryptedMessageData;
+type TLEncryptedMessageData struct {
+	Data2                *EncryptedMessageData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TLEncryptedMessageData) Reset()         { *m = TLEncryptedMessageData{} }
+func (m *TLEncryptedMessageData) String() string { return proto.CompactTextString(m) }
+func (*TLEncryptedMessageData) ProtoMessage()    {}
+func (*TLEncryptedMessageData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{1}
+}
+func (m *TLEncryptedMessageData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLEncryptedMessageData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLEncryptedMessageData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLEncryptedMessageData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLEncryptedMessageData.Merge(m, src)
+}
+func (m *TLEncryptedMessageData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLEncryptedMessageData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLEncryptedMessageData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLEncryptedMessageData proto.InternalMessageInfo
+
+func (m *TLEncryptedMessageData) GetData2() *EncryptedMessageData {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretChatData id:int access_hash:long admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long random_id:int g_a:bytes g_b:bytes key_fingerprint:long state:int date:int = SecretChatData;
+//
+// SecretChatData <--
+//  + TL_secretChatData
+//
+type SecretChatData struct {
+	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	Id                   int32         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	AccessHash           int64         `protobuf:"varint,4,opt,name=access_hash,json=accessHash,proto3" json:"access_hash,omitempty"`
+	AdminId              int64         `protobuf:"varint,5,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AdminAuthKeyId       int64         `protobuf:"varint,6,opt,name=admin_auth_key_id,json=adminAuthKeyId,proto3" json:"admin_auth_key_id,omitempty"`
+	ParticipantId        int64         `protobuf:"varint,7,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ParticipantAuthKeyId int64         `protobuf:"varint,8,opt,name=participant_auth_key_id,json=participantAuthKeyId,proto3" json:"participant_auth_key_id,omitempty"`
+	RandomId             int32         `protobuf:"varint,9,opt,name=random_id,json=randomId,proto3" json:"random_id,omitempty"`
+	GA                   []byte        `protobuf:"bytes,10,opt,name=g_a,json=gA,proto3" json:"g_a,omitempty"`
+	GB                   []byte        `protobuf:"bytes,11,opt,name=g_b,json=gB,proto3" json:"g_b,omitempty"`
+	KeyFingerprint       int64         `protobuf:"varint,12,opt,name=key_fingerprint,json=keyFingerprint,proto3" json:"key_fingerprint,omitempty"`
+	State                int32         `protobuf:"varint,13,opt,name=state,proto3" json:"state,omitempty"`
+	Date                 int32         `protobuf:"varint,14,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SecretChatData) Reset()         { *m = SecretChatData{} }
+func (m *SecretChatData) String() string { return proto.CompactTextString(m) }
+func (*SecretChatData) ProtoMessage()    {}
+func (*SecretChatData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{2}
+}
+func (m *SecretChatData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretChatData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretChatData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretChatData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretChatData.Merge(m, src)
+}
+func (m *SecretChatData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretChatData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretChatData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretChatData proto.InternalMessageInfo
+
+func (m *SecretChatData) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *SecretChatData) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *SecretChatData) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetAccessHash() int64 {
+	if m != nil {
+		return m.AccessHash
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetAdminId() int64 {
+	if m != nil {
+		return m.AdminId
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetAdminAuthKeyId() int64 {
+	if m != nil {
+		return m.AdminAuthKeyId
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetParticipantId() int64 {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetParticipantAuthKeyId() int64 {
+	if m != nil {
+		return m.ParticipantAuthKeyId
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetRandomId() int32 {
+	if m != nil {
+		return m.RandomId
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetGA() []byte {
+	if m != nil {
+		return m.GA
+	}
+	return nil
+}
+
+func (m *SecretChatData) GetGB() []byte {
+	if m != nil {
+		return m.GB
+	}
+	return nil
+}
+
+func (m *SecretChatData) GetKeyFingerprint() int64 {
+	if m != nil {
+		return m.KeyFingerprint
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *SecretChatData) GetDate() int32 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+// secretChatData id:int access_hash:long admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long random_id:int g_a:bytes g_b:bytes key_fingerprint:long state:int date:int = SecretChatData;
+type TLSecretChatData struct {
+	Data2                *SecretChatData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TLSecretChatData) Reset()         { *m = TLSecretChatData{} }
+func (m *TLSecretChatData) String() string { return proto.CompactTextString(m) }
+func (*TLSecretChatData) ProtoMessage()    {}
+func (*TLSecretChatData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{3}
+}
+func (m *TLSecretChatData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretChatData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretChatData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretChatData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretChatData.Merge(m, src)
+}
+func (m *TLSecretChatData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretChatData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretChatData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretChatData proto.InternalMessageInfo
+
+func (m *TLSecretChatData) GetData2() *SecretChatData {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretChatDifference qts:int new_encrypted_messages:Vector<EncryptedMessage> = SecretChatDifference;
+//
+// SecretChatDifference <--
+//  + TL_secretChatDifference
+//
+type SecretChatDifference struct {
+	PredicateName        string                      `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor               `protobuf:"varint,2,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	Qts                  int32                       `protobuf:"varint,3,opt,name=qts,proto3" json:"qts,omitempty"`
+	NewEncryptedMessages []*mtproto.EncryptedMessage `protobuf:"bytes,4,rep,name=new_encrypted_messages,json=newEncryptedMessages,proto3" json:"new_encrypted_messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *SecretChatDifference) Reset()         { *m = SecretChatDifference{} }
+func (m *SecretChatDifference) String() string { return proto.CompactTextString(m) }
+func (*SecretChatDifference) ProtoMessage()    {}
+func (*SecretChatDifference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{4}
+}
+func (m *SecretChatDifference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretChatDifference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretChatDifference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretChatDifference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretChatDifference.Merge(m, src)
+}
+func (m *SecretChatDifference) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretChatDifference) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretChatDifference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretChatDifference proto.InternalMessageInfo
+
+func (m *SecretChatDifference) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *SecretChatDifference) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *SecretChatDifference) GetQts() int32 {
+	if m != nil {
+		return m.Qts
+	}
+	return 0
+}
+
+func (m *SecretChatDifference) GetNewEncryptedMessages() []*mtproto.EncryptedMessage {
+	if m != nil {
+		return m.NewEncryptedMessages
+	}
+	return nil
+}
+
+// secretChatDifference qts:int new_encrypted_messages:Vector<EncryptedMessage> = SecretChatDifference;
+type TLSecretChatDifference struct {
+	Data2                *SecretChatDifference `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TLSecretChatDifference) Reset()         { *m = TLSecretChatDifference{} }
+func (m *TLSecretChatDifference) String() string { return proto.CompactTextString(m) }
+func (*TLSecretChatDifference) ProtoMessage()    {}
+func (*TLSecretChatDifference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{5}
+}
+func (m *TLSecretChatDifference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretChatDifference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretChatDifference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretChatDifference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretChatDifference.Merge(m, src)
+}
+func (m *TLSecretChatDifference) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretChatDifference) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretChatDifference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretChatDifference proto.InternalMessageInfo
+
+func (m *TLSecretChatDifference) GetData2() *SecretChatDifference {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.requestEncryption admin_id:long admin_auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChatData;
+type TLSecretchatRequestEncryption struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	AdminId              int64         `protobuf:"varint,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AdminAuthKeyId       int64         `protobuf:"varint,4,opt,name=admin_auth_key_id,json=adminAuthKeyId,proto3" json:"admin_auth_key_id,omitempty"`
+	ParticipantId        int64         `protobuf:"varint,5,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	RandomId             int32         `protobuf:"varint,6,opt,name=random_id,json=randomId,proto3" json:"random_id,omitempty"`
+	GA                   []byte        `protobuf:"bytes,7,opt,name=g_a,json=gA,proto3" json:"g_a,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatRequestEncryption) Reset()         { *m = TLSecretchatRequestEncryption{} }
+func (m *TLSecretchatRequestEncryption) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatRequestEncryption) ProtoMessage()    {}
+func (*TLSecretchatRequestEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{6}
+}
+func (m *TLSecretchatRequestEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatRequestEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatRequestEncryption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatRequestEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatRequestEncryption.Merge(m, src)
+}
+func (m *TLSecretchatRequestEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatRequestEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatRequestEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatRequestEncryption proto.InternalMessageInfo
+
+func (m *TLSecretchatRequestEncryption) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatRequestEncryption) GetAdminId() int64 {
+	if m != nil {
+		return m.AdminId
+	}
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) GetAdminAuthKeyId() int64 {
+	if m != nil {
+		return m.AdminAuthKeyId
+	}
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) GetParticipantId() int64 {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) GetRandomId() int32 {
+	if m != nil {
+		return m.RandomId
+	}
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) GetGA() []byte {
+	if m != nil {
+		return m.GA
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.acceptEncryption chat_id:int participant_id:long participant_auth_key_id:long g_b:bytes key_fingerprint:long = SecretChatData;
+type TLSecretchatAcceptEncryption struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	ChatId               int32         `protobuf:"varint,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	ParticipantId        int64         `protobuf:"varint,4,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ParticipantAuthKeyId int64         `protobuf:"varint,5,opt,name=participant_auth_key_id,json=participantAuthKeyId,proto3" json:"participant_auth_key_id,omitempty"`
+	GB                   []byte        `protobuf:"bytes,6,opt,name=g_b,json=gB,proto3" json:"g_b,omitempty"`
+	KeyFingerprint       int64         `protobuf:"varint,7,opt,name=key_fingerprint,json=keyFingerprint,proto3" json:"key_fingerprint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatAcceptEncryption) Reset()         { *m = TLSecretchatAcceptEncryption{} }
+func (m *TLSecretchatAcceptEncryption) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatAcceptEncryption) ProtoMessage()    {}
+func (*TLSecretchatAcceptEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{7}
+}
+func (m *TLSecretchatAcceptEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatAcceptEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatAcceptEncryption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatAcceptEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatAcceptEncryption.Merge(m, src)
+}
+func (m *TLSecretchatAcceptEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatAcceptEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatAcceptEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatAcceptEncryption proto.InternalMessageInfo
+
+func (m *TLSecretchatAcceptEncryption) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatAcceptEncryption) GetChatId() int32 {
+	if m != nil {
+		return m.ChatId
+	}
+	return 0
+}
+
+func (m *TLSecretchatAcceptEncryption) GetParticipantId() int64 {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return 0
+}
+
+func (m *TLSecretchatAcceptEncryption) GetParticipantAuthKeyId() int64 {
+	if m != nil {
+		return m.ParticipantAuthKeyId
+	}
+	return 0
+}
+
+func (m *TLSecretchatAcceptEncryption) GetGB() []byte {
+	if m != nil {
+		return m.GB
+	}
+	return nil
+}
+
+func (m *TLSecretchatAcceptEncryption) GetKeyFingerprint() int64 {
+	if m != nil {
+		return m.KeyFingerprint
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.discardEncryption chat_id:int user_id:long delete_history:Bool = SecretChatData;
+type TLSecretchatDiscardEncryption struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	ChatId               int32         `protobuf:"varint,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeleteHistory        *mtproto.Bool `protobuf:"bytes,5,opt,name=delete_history,json=deleteHistory,proto3" json:"delete_history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatDiscardEncryption) Reset()         { *m = TLSecretchatDiscardEncryption{} }
+func (m *TLSecretchatDiscardEncryption) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatDiscardEncryption) ProtoMessage()    {}
+func (*TLSecretchatDiscardEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{8}
+}
+func (m *TLSecretchatDiscardEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatDiscardEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatDiscardEncryption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatDiscardEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatDiscardEncryption.Merge(m, src)
+}
+func (m *TLSecretchatDiscardEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatDiscardEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatDiscardEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatDiscardEncryption proto.InternalMessageInfo
+
+func (m *TLSecretchatDiscardEncryption) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatDiscardEncryption) GetChatId() int32 {
+	if m != nil {
+		return m.ChatId
+	}
+	return 0
+}
+
+func (m *TLSecretchatDiscardEncryption) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLSecretchatDiscardEncryption) GetDeleteHistory() *mtproto.Bool {
+	if m != nil {
+		return m.DeleteHistory
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.getSecretChat chat_id:int = SecretChatData;
+type TLSecretchatGetSecretChat struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	ChatId               int32         `protobuf:"varint,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatGetSecretChat) Reset()         { *m = TLSecretchatGetSecretChat{} }
+func (m *TLSecretchatGetSecretChat) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatGetSecretChat) ProtoMessage()    {}
+func (*TLSecretchatGetSecretChat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{9}
+}
+func (m *TLSecretchatGetSecretChat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatGetSecretChat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatGetSecretChat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatGetSecretChat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatGetSecretChat.Merge(m, src)
+}
+func (m *TLSecretchatGetSecretChat) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatGetSecretChat) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatGetSecretChat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatGetSecretChat proto.InternalMessageInfo
+
+func (m *TLSecretchatGetSecretChat) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatGetSecretChat) GetChatId() int32 {
+	if m != nil {
+		return m.ChatId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.sendEncryptedMessage user_id:long chat_id:int message:EncryptedMessage = EncryptedMessageData;
+type TLSecretchatSendEncryptedMessage struct {
+	Constructor          TLConstructor             `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                     `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId               int32                     `protobuf:"varint,4,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Message              *mtproto.EncryptedMessage `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *TLSecretchatSendEncryptedMessage) Reset()         { *m = TLSecretchatSendEncryptedMessage{} }
+func (m *TLSecretchatSendEncryptedMessage) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatSendEncryptedMessage) ProtoMessage()    {}
+func (*TLSecretchatSendEncryptedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{10}
+}
+func (m *TLSecretchatSendEncryptedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatSendEncryptedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatSendEncryptedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatSendEncryptedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatSendEncryptedMessage.Merge(m, src)
+}
+func (m *TLSecretchatSendEncryptedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatSendEncryptedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatSendEncryptedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatSendEncryptedMessage proto.InternalMessageInfo
+
+func (m *TLSecretchatSendEncryptedMessage) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatSendEncryptedMessage) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLSecretchatSendEncryptedMessage) GetChatId() int32 {
+	if m != nil {
+		return m.ChatId
+	}
+	return 0
+}
+
+func (m *TLSecretchatSendEncryptedMessage) GetMessage() *mtproto.EncryptedMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.getDifference auth_key_id:long qts:int limit:int = SecretChatDifference;
+type TLSecretchatGetDifference struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Qts                  int32         `protobuf:"varint,4,opt,name=qts,proto3" json:"qts,omitempty"`
+	Limit                int32         `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatGetDifference) Reset()         { *m = TLSecretchatGetDifference{} }
+func (m *TLSecretchatGetDifference) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatGetDifference) ProtoMessage()    {}
+func (*TLSecretchatGetDifference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{11}
+}
+func (m *TLSecretchatGetDifference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatGetDifference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatGetDifference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatGetDifference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatGetDifference.Merge(m, src)
+}
+func (m *TLSecretchatGetDifference) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatGetDifference) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatGetDifference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatGetDifference proto.InternalMessageInfo
+
+func (m *TLSecretchatGetDifference) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatGetDifference) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLSecretchatGetDifference) GetQts() int32 {
+	if m != nil {
+		return m.Qts
+	}
+	return 0
+}
+
+func (m *TLSecretchatGetDifference) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.getCurrentQts auth_key_id:long = Int32;
+type TLSecretchatGetCurrentQts struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatGetCurrentQts) Reset()         { *m = TLSecretchatGetCurrentQts{} }
+func (m *TLSecretchatGetCurrentQts) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatGetCurrentQts) ProtoMessage()    {}
+func (*TLSecretchatGetCurrentQts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{12}
+}
+func (m *TLSecretchatGetCurrentQts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatGetCurrentQts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatGetCurrentQts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatGetCurrentQts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatGetCurrentQts.Merge(m, src)
+}
+func (m *TLSecretchatGetCurrentQts) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatGetCurrentQts) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatGetCurrentQts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatGetCurrentQts proto.InternalMessageInfo
+
+func (m *TLSecretchatGetCurrentQts) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatGetCurrentQts) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+type TLSecretchatReceivedQueue struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	MaxQts               int32         `protobuf:"varint,4,opt,name=max_qts,json=maxQts,proto3" json:"max_qts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatReceivedQueue) Reset()         { *m = TLSecretchatReceivedQueue{} }
+func (m *TLSecretchatReceivedQueue) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatReceivedQueue) ProtoMessage()    {}
+func (*TLSecretchatReceivedQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{13}
+}
+func (m *TLSecretchatReceivedQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatReceivedQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatReceivedQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatReceivedQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatReceivedQueue.Merge(m, src)
+}
+func (m *TLSecretchatReceivedQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatReceivedQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatReceivedQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatReceivedQueue proto.InternalMessageInfo
+
+func (m *TLSecretchatReceivedQueue) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatReceivedQueue) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLSecretchatReceivedQueue) GetMaxQts() int32 {
+	if m != nil {
+		return m.MaxQts
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_Long struct {
+	Datas                []int64  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vector_Long) Reset()         { *m = Vector_Long{} }
+func (m *Vector_Long) String() string { return proto.CompactTextString(m) }
+func (*Vector_Long) ProtoMessage()    {}
+func (*Vector_Long) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{14}
+}
+func (m *Vector_Long) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_Long) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_Long.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_Long) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_Long.Merge(m, src)
+}
+func (m *Vector_Long) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_Long) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_Long.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_Long proto.InternalMessageInfo
+
+func (m *Vector_Long) GetDatas() []int64 {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("secretchat.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*EncryptedMessageData)(nil), "secretchat.EncryptedMessageData")
+	proto.RegisterType((*TLEncryptedMessageData)(nil), "secretchat.TL_encryptedMessageData")
+	proto.RegisterType((*SecretChatData)(nil), "secretchat.SecretChatData")
+	proto.RegisterType((*TLSecretChatData)(nil), "secretchat.TL_secretChatData")
+	proto.RegisterType((*SecretChatDifference)(nil), "secretchat.SecretChatDifference")
+	proto.RegisterType((*TLSecretChatDifference)(nil), "secretchat.TL_secretChatDifference")
+	proto.RegisterType((*TLSecretchatRequestEncryption)(nil), "secretchat.TL_secretchat_requestEncryption")
+	proto.RegisterType((*TLSecretchatAcceptEncryption)(nil), "secretchat.TL_secretchat_acceptEncryption")
+	proto.RegisterType((*TLSecretchatDiscardEncryption)(nil), "secretchat.TL_secretchat_discardEncryption")
+	proto.RegisterType((*TLSecretchatGetSecretChat)(nil), "secretchat.TL_secretchat_getSecretChat")
+	proto.RegisterType((*TLSecretchatSendEncryptedMessage)(nil), "secretchat.TL_secretchat_sendEncryptedMessage")
+	proto.RegisterType((*TLSecretchatGetDifference)(nil), "secretchat.TL_secretchat_getDifference")
+	proto.RegisterType((*TLSecretchatGetCurrentQts)(nil), "secretchat.TL_secretchat_getCurrentQts")
+	proto.RegisterType((*TLSecretchatReceivedQueue)(nil), "secretchat.TL_secretchat_receivedQueue")
+	proto.RegisterType((*Vector_Long)(nil), "secretchat.Vector_Long")
+}
+
+func init() { proto.RegisterFile("secretchat.tl.proto", fileDescriptor_7a7ba6dea2cc539a) }
+
+var fileDescriptor_7a7ba6dea2cc539a = []byte{
+	// 1289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x8f, 0x13, 0x55,
+	0x14, 0xdf, 0xd9, 0xe9, 0x07, 0x7b, 0x4a, 0xcb, 0x70, 0x6d, 0xdc, 0xd9, 0x42, 0x4a, 0x1d, 0x83,
+	0x14, 0x90, 0xd6, 0x74, 0xd5, 0x17, 0x9f, 0x60, 0xc5, 0xd0, 0xb0, 0x2e, 0x6e, 0x01, 0x4d, 0x34,
+	0x71, 0x72, 0x77, 0xe6, 0xee, 0x74, 0xc2, 0xce, 0x07, 0xf7, 0xde, 0x02, 0xe5, 0x0f, 0x40, 0x31,
+	0xc6, 0x17, 0x25, 0x3c, 0x18, 0x1f, 0xc4, 0x47, 0x7d, 0x31, 0xc6, 0x18, 0x63, 0x4c, 0x34, 0x31,
+	0x86, 0x17, 0x13, 0x7d, 0x26, 0x24, 0xc2, 0x13, 0xf1, 0x49, 0xc3, 0x8b, 0xf8, 0xc1, 0x9a, 0x99,
+	0xe9, 0x76, 0x66, 0xda, 0x69, 0xbb, 0x04, 0x97, 0xb7, 0x7b, 0xcf, 0xf9, 0xcd, 0x3d, 0xf7, 0x77,
+	0xee, 0xf9, 0xdd, 0x73, 0x5b, 0x78, 0x8c, 0x11, 0x8d, 0x12, 0xae, 0xb5, 0x31, 0xaf, 0xf1, 0xb5,
+	0x9a, 0x4b, 0x1d, 0xee, 0x20, 0x08, 0x8d, 0xa5, 0x43, 0x86, 0xc9, 0xdb, 0x9d, 0x95, 0x9a, 0xe6,
+	0x58, 0x75, 0xc3, 0x31, 0x9c, 0xba, 0x0f, 0x59, 0xe9, 0xac, 0xfa, 0x33, 0x7f, 0xe2, 0x8f, 0x82,
+	0x4f, 0x4b, 0x65, 0xc3, 0x71, 0x8c, 0x35, 0x12, 0xa2, 0xce, 0x53, 0xec, 0xba, 0x84, 0xb2, 0x9e,
+	0xbf, 0xc4, 0xb4, 0x36, 0xb1, 0xb0, 0x17, 0x4b, 0x73, 0x28, 0x51, 0x79, 0xd7, 0x25, 0x1b, 0xbe,
+	0xb9, 0xd0, 0xc7, 0x29, 0xb6, 0x99, 0xeb, 0x50, 0xde, 0x73, 0x15, 0x43, 0x17, 0xeb, 0xda, 0x5a,
+	0x60, 0x55, 0xfe, 0x12, 0xa0, 0x78, 0xd4, 0xd6, 0x68, 0xd7, 0xe5, 0x44, 0x7f, 0x99, 0x30, 0x86,
+	0x0d, 0xf2, 0x22, 0xe6, 0x18, 0xed, 0x85, 0x82, 0x4b, 0x89, 0x6e, 0x6a, 0x98, 0x13, 0xd5, 0xc6,
+	0x16, 0x91, 0x85, 0x8a, 0x50, 0x9d, 0x69, 0xe5, 0xfb, 0xd6, 0x25, 0x6c, 0x11, 0xf4, 0x02, 0xe4,
+	0x34, 0xc7, 0x66, 0x9c, 0x76, 0x34, 0xee, 0x50, 0x79, 0xba, 0x22, 0x54, 0x0b, 0x8d, 0xb9, 0x5a,
+	0x24, 0x25, 0xa7, 0x16, 0x17, 0x42, 0x40, 0x2b, 0x8a, 0x46, 0xb3, 0x90, 0xed, 0x30, 0x42, 0x55,
+	0x53, 0x97, 0xc5, 0x8a, 0x50, 0x15, 0x5b, 0x19, 0x6f, 0xda, 0xd4, 0x51, 0x19, 0x72, 0xb8, 0xc3,
+	0xdb, 0xea, 0x19, 0xd2, 0xf5, 0x9c, 0x29, 0xdf, 0x39, 0xe3, 0x99, 0x8e, 0x93, 0x6e, 0x53, 0x47,
+	0x12, 0x88, 0x67, 0x39, 0x93, 0xd3, 0x15, 0xa1, 0x9a, 0x6e, 0x79, 0x43, 0x34, 0x0f, 0x59, 0x2b,
+	0xd8, 0xbd, 0x9c, 0xa9, 0x08, 0xd5, 0x5c, 0x63, 0xae, 0x66, 0x71, 0x9f, 0x62, 0x6d, 0x90, 0x5e,
+	0x6b, 0x03, 0xa9, 0x2c, 0xc3, 0xec, 0xa9, 0x45, 0x95, 0x24, 0xd1, 0x7f, 0x1e, 0xd2, 0x3a, 0xe6,
+	0xb8, 0xe1, 0xb3, 0xce, 0x35, 0x2a, 0x51, 0x46, 0x49, 0xf9, 0x6a, 0x05, 0x70, 0xe5, 0x86, 0x08,
+	0x85, 0x93, 0x3e, 0x74, 0xa1, 0x8d, 0xf9, 0x23, 0xcb, 0x64, 0x01, 0xa6, 0x7b, 0x49, 0x4c, 0xb7,
+	0xa6, 0x4d, 0x1d, 0xed, 0x81, 0x1c, 0xd6, 0x34, 0xc2, 0x98, 0xda, 0xc6, 0xac, 0xdd, 0x4b, 0x20,
+	0x04, 0xa6, 0x63, 0x98, 0xb5, 0xd1, 0x1c, 0x6c, 0xc3, 0xba, 0x65, 0xda, 0x5e, 0x7a, 0xd3, 0xbe,
+	0x37, 0xeb, 0xcf, 0x9b, 0x3a, 0xda, 0x0f, 0x3b, 0x03, 0x57, 0xf4, 0x08, 0x32, 0x3e, 0xa6, 0xe0,
+	0x3b, 0x0e, 0xf7, 0xcf, 0xc1, 0xa3, 0x86, 0x29, 0x37, 0x35, 0xd3, 0xc5, 0x36, 0xf7, 0x70, 0x59,
+	0x1f, 0x97, 0x8f, 0x58, 0x9b, 0x3a, 0x7a, 0x0e, 0x66, 0xa3, 0xb0, 0xe8, 0xba, 0xdb, 0x7c, 0x7c,
+	0x31, 0xe2, 0x0e, 0x57, 0xdf, 0x05, 0x33, 0x14, 0xdb, 0xba, 0x63, 0x79, 0xc0, 0x19, 0x9f, 0xdb,
+	0xb6, 0xc0, 0xd0, 0xd4, 0xd1, 0x0e, 0x10, 0x0d, 0x15, 0xcb, 0x50, 0x11, 0xaa, 0xdb, 0x5b, 0xd3,
+	0xc6, 0xe1, 0xc0, 0xb0, 0x22, 0xe7, 0x7a, 0x86, 0x23, 0x68, 0x1f, 0xec, 0xf0, 0x82, 0xac, 0x9a,
+	0xb6, 0x41, 0xa8, 0x4b, 0x4d, 0x9b, 0xcb, 0xdb, 0x03, 0x16, 0x67, 0x48, 0xf7, 0xa5, 0xd0, 0x8a,
+	0x8a, 0x90, 0x66, 0x1c, 0x73, 0x22, 0xe7, 0xfd, 0x18, 0xc1, 0x04, 0x21, 0x48, 0xe9, 0x9e, 0xb1,
+	0xe0, 0x1b, 0xfd, 0xb1, 0x72, 0x14, 0x76, 0x9e, 0x5a, 0x54, 0x59, 0xfc, 0x7c, 0x9f, 0x89, 0x97,
+	0x4a, 0x29, 0x7a, 0x64, 0xf1, 0x52, 0xd8, 0x28, 0x92, 0x9b, 0x02, 0x14, 0x23, 0x1e, 0x73, 0x75,
+	0x95, 0x50, 0x62, 0x6b, 0xe4, 0x91, 0x94, 0x4a, 0x4f, 0x3b, 0x62, 0xa8, 0x9d, 0x13, 0xf0, 0xb8,
+	0x4d, 0xce, 0x87, 0x3a, 0x50, 0x7b, 0xfa, 0x60, 0x72, 0xaa, 0x22, 0x8e, 0x97, 0x52, 0xd1, 0x26,
+	0xe7, 0x07, 0x8d, 0xac, 0xa7, 0x2b, 0x96, 0xc4, 0x70, 0x9c, 0xae, 0x92, 0x52, 0xb2, 0x91, 0xb2,
+	0x7b, 0x02, 0xec, 0xe9, 0xaf, 0xe9, 0xa1, 0x55, 0x4a, 0xce, 0x76, 0x08, 0xe3, 0xbd, 0xe8, 0xa6,
+	0x63, 0x0f, 0xa6, 0x45, 0x78, 0xa0, 0xb4, 0x44, 0x05, 0x21, 0x6e, 0x42, 0x10, 0xa9, 0x4d, 0x0a,
+	0x22, 0x9d, 0x24, 0x88, 0x58, 0x65, 0x67, 0x92, 0x2b, 0x3b, 0xbb, 0x51, 0xd9, 0xca, 0x7b, 0xd3,
+	0x50, 0x8e, 0x73, 0xf7, 0x84, 0xec, 0xfe, 0x6f, 0xd4, 0x67, 0x21, 0xeb, 0xaf, 0xda, 0xbf, 0x41,
+	0x32, 0xde, 0x34, 0x91, 0x4d, 0xea, 0x01, 0xe5, 0x9d, 0x1e, 0x23, 0xef, 0x9e, 0x60, 0x33, 0xe3,
+	0x04, 0x9b, 0x4d, 0x12, 0xac, 0xf2, 0xe3, 0x50, 0x31, 0xe8, 0x26, 0xd3, 0x30, 0xd5, 0xb7, 0x3c,
+	0x23, 0x91, 0x8e, 0x95, 0x8a, 0x75, 0xac, 0x67, 0xa1, 0xa0, 0x93, 0x35, 0xc2, 0x89, 0xda, 0x36,
+	0x19, 0x77, 0x68, 0xd7, 0xa7, 0x9e, 0x6b, 0xe4, 0xfb, 0xda, 0x39, 0xe2, 0x38, 0x6b, 0xad, 0x7c,
+	0x00, 0x3a, 0x16, 0x60, 0x14, 0x06, 0xbb, 0xe2, 0x3c, 0x0c, 0xc2, 0x43, 0x15, 0x6c, 0x0d, 0x07,
+	0xe5, 0xba, 0x00, 0x4a, 0x3c, 0x2a, 0x23, 0xb6, 0x3e, 0xa8, 0xe2, 0x87, 0x0e, 0x9e, 0xdc, 0xd9,
+	0x23, 0xbb, 0x4a, 0xc5, 0x32, 0x1b, 0x69, 0xe0, 0xe9, 0x4d, 0x37, 0xf0, 0x6b, 0x42, 0x42, 0x02,
+	0x23, 0xb7, 0xcd, 0x43, 0x71, 0x18, 0x78, 0x84, 0x88, 0x23, 0x1e, 0x21, 0xa9, 0xf0, 0x22, 0x2d,
+	0x42, 0x7a, 0xcd, 0xb4, 0x4c, 0xde, 0x7b, 0x98, 0x04, 0x13, 0xe5, 0x62, 0xc2, 0x1e, 0x17, 0x3a,
+	0x94, 0x12, 0x9b, 0x2f, 0x73, 0xb6, 0xa5, 0x7b, 0x54, 0xde, 0x1f, 0x4a, 0x10, 0x25, 0x1a, 0x31,
+	0xcf, 0x11, 0x7d, 0xb9, 0x43, 0x3a, 0x5b, 0x9c, 0xa0, 0x59, 0xc8, 0x5a, 0xf8, 0x82, 0x1a, 0x26,
+	0x29, 0x63, 0xe1, 0x0b, 0xcb, 0x9c, 0x29, 0x4f, 0x42, 0xee, 0x55, 0xe2, 0x2d, 0xa1, 0x2e, 0x3a,
+	0xb6, 0xe1, 0xa5, 0x4d, 0xc7, 0x1c, 0x33, 0x59, 0xa8, 0x88, 0x55, 0x31, 0xb8, 0xf1, 0xd9, 0x81,
+	0x3f, 0x45, 0xc8, 0xc7, 0x82, 0xa3, 0x9d, 0x90, 0x5f, 0x68, 0x2d, 0xcc, 0x37, 0xd4, 0xd3, 0x4b,
+	0xc7, 0x97, 0x4e, 0xbc, 0xb6, 0x24, 0x4d, 0xa1, 0xdd, 0x50, 0x0c, 0x4c, 0xf1, 0x9e, 0x2c, 0x5d,
+	0xbe, 0x73, 0xe9, 0x87, 0x2c, 0x52, 0xa0, 0x14, 0x78, 0x93, 0x9e, 0x78, 0xd2, 0x07, 0x9f, 0xdd,
+	0xfc, 0x42, 0x08, 0x31, 0x49, 0xed, 0x4a, 0xba, 0xf6, 0xf9, 0x9d, 0x7b, 0x02, 0xaa, 0x83, 0x12,
+	0xc5, 0x24, 0xb7, 0x1f, 0xe9, 0xe3, 0xbb, 0x1f, 0x5d, 0xb9, 0xbf, 0xbe, 0xbe, 0xbe, 0x2e, 0xa0,
+	0xfd, 0xf0, 0xc4, 0xd0, 0x07, 0x83, 0x77, 0xb6, 0x74, 0xf5, 0x9d, 0xdf, 0xee, 0x4f, 0x27, 0xae,
+	0x3d, 0x74, 0x9b, 0x49, 0x77, 0xaf, 0xfc, 0xf1, 0xed, 0xbf, 0xc1, 0xda, 0x4f, 0x41, 0x79, 0xe8,
+	0x83, 0xd8, 0xb5, 0x21, 0xdd, 0xbd, 0xf4, 0xe5, 0x87, 0x59, 0xf4, 0x34, 0xec, 0x1d, 0xc2, 0x25,
+	0x09, 0x5d, 0xfa, 0xe9, 0xdd, 0xb7, 0x18, 0x3a, 0x98, 0xbc, 0x6a, 0x24, 0x15, 0x97, 0xbf, 0xfa,
+	0xe6, 0xd6, 0x3f, 0xc1, 0x16, 0x46, 0x80, 0xc3, 0xa2, 0x96, 0xbe, 0xfb, 0xe5, 0xfb, 0x4f, 0xff,
+	0x1e, 0x0d, 0x8e, 0x15, 0xa1, 0x74, 0xf5, 0xda, 0x8d, 0xaf, 0xef, 0xf9, 0xe0, 0x52, 0xea, 0xed,
+	0x4f, 0xca, 0x53, 0x8d, 0xeb, 0x19, 0xc8, 0xb7, 0x5e, 0x59, 0x38, 0xd9, 0xff, 0x00, 0x99, 0xb0,
+	0x7b, 0x6c, 0xeb, 0x3f, 0x18, 0x2f, 0xd9, 0xb1, 0x07, 0x55, 0x1a, 0xf3, 0x42, 0x53, 0xa6, 0x90,
+	0x01, 0xbb, 0xc6, 0x75, 0xda, 0x03, 0xa3, 0x23, 0x0d, 0x62, 0x27, 0x04, 0x8a, 0x73, 0x1a, 0xee,
+	0x60, 0x63, 0x38, 0x0d, 0x81, 0x27, 0x84, 0xc2, 0x20, 0x8f, 0x6c, 0x32, 0xfb, 0x46, 0x87, 0x89,
+	0x01, 0x27, 0x84, 0xe8, 0xc0, 0x9e, 0x49, 0x1d, 0xa5, 0x36, 0x3a, 0x52, 0x12, 0xbe, 0x34, 0xf1,
+	0x47, 0x97, 0x7f, 0x5a, 0xf2, 0xc8, 0xdb, 0x7f, 0x3c, 0xb3, 0x10, 0x58, 0x9a, 0xf8, 0x0a, 0x55,
+	0xa6, 0xd0, 0x69, 0x90, 0x47, 0x55, 0xfb, 0x84, 0x40, 0x21, 0xb0, 0x54, 0xe8, 0xf7, 0xb4, 0xa6,
+	0xcd, 0xe7, 0x1b, 0xca, 0x14, 0x7a, 0x13, 0xe4, 0x51, 0xba, 0x18, 0xb7, 0x6c, 0x0c, 0x58, 0x9a,
+	0x8d, 0x02, 0x23, 0x37, 0xab, 0x32, 0x75, 0xe4, 0x8d, 0xdf, 0x6f, 0x95, 0x85, 0xeb, 0xb7, 0xcb,
+	0xc2, 0xcf, 0xb7, 0xcb, 0xc2, 0xaf, 0xb7, 0xcb, 0xc2, 0xeb, 0xcd, 0xc8, 0xbf, 0x11, 0x9c, 0x60,
+	0xcb, 0xa0, 0x38, 0x1c, 0x1c, 0x62, 0x84, 0x9e, 0x23, 0xb4, 0x8e, 0x5d, 0xb7, 0xee, 0x0d, 0x4d,
+	0x8d, 0xd4, 0x57, 0xcc, 0x8b, 0xf5, 0x70, 0xfd, 0xc8, 0x70, 0x25, 0xe3, 0x73, 0x99, 0xff, 0x6f,
+	0x00, 0x65, 0xf2, 0x34, 0x68, 0x02, 0x11, 0x00, 0x00,
+}
+
+func (this *EncryptedMessageData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&secretchat.EncryptedMessageData{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Qts: "+fmt.Sprintf("%#v", this.Qts)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLEncryptedMessageData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&secretchat.TLEncryptedMessageData{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretChatData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&secretchat.SecretChatData{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "AccessHash: "+fmt.Sprintf("%#v", this.AccessHash)+",\n")
+	s = append(s, "AdminId: "+fmt.Sprintf("%#v", this.AdminId)+",\n")
+	s = append(s, "AdminAuthKeyId: "+fmt.Sprintf("%#v", this.AdminAuthKeyId)+",\n")
+	s = append(s, "ParticipantId: "+fmt.Sprintf("%#v", this.ParticipantId)+",\n")
+	s = append(s, "ParticipantAuthKeyId: "+fmt.Sprintf("%#v", this.ParticipantAuthKeyId)+",\n")
+	s = append(s, "RandomId: "+fmt.Sprintf("%#v", this.RandomId)+",\n")
+	s = append(s, "GA: "+fmt.Sprintf("%#v", this.GA)+",\n")
+	s = append(s, "GB: "+fmt.Sprintf("%#v", this.GB)+",\n")
+	s = append(s, "KeyFingerprint: "+fmt.Sprintf("%#v", this.KeyFingerprint)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretChatData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&secretchat.TLSecretChatData{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretChatDifference) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&secretchat.SecretChatDifference{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Qts: "+fmt.Sprintf("%#v", this.Qts)+",\n")
+	if this.NewEncryptedMessages != nil {
+		s = append(s, "NewEncryptedMessages: "+fmt.Sprintf("%#v", this.NewEncryptedMessages)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretChatDifference) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&secretchat.TLSecretChatDifference{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatRequestEncryption) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&secretchat.TLSecretchatRequestEncryption{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AdminId: "+fmt.Sprintf("%#v", this.AdminId)+",\n")
+	s = append(s, "AdminAuthKeyId: "+fmt.Sprintf("%#v", this.AdminAuthKeyId)+",\n")
+	s = append(s, "ParticipantId: "+fmt.Sprintf("%#v", this.ParticipantId)+",\n")
+	s = append(s, "RandomId: "+fmt.Sprintf("%#v", this.RandomId)+",\n")
+	s = append(s, "GA: "+fmt.Sprintf("%#v", this.GA)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatAcceptEncryption) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&secretchat.TLSecretchatAcceptEncryption{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
+	s = append(s, "ParticipantId: "+fmt.Sprintf("%#v", this.ParticipantId)+",\n")
+	s = append(s, "ParticipantAuthKeyId: "+fmt.Sprintf("%#v", this.ParticipantAuthKeyId)+",\n")
+	s = append(s, "GB: "+fmt.Sprintf("%#v", this.GB)+",\n")
+	s = append(s, "KeyFingerprint: "+fmt.Sprintf("%#v", this.KeyFingerprint)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatDiscardEncryption) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&secretchat.TLSecretchatDiscardEncryption{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.DeleteHistory != nil {
+		s = append(s, "DeleteHistory: "+fmt.Sprintf("%#v", this.DeleteHistory)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatGetSecretChat) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&secretchat.TLSecretchatGetSecretChat{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatSendEncryptedMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&secretchat.TLSecretchatSendEncryptedMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatGetDifference) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&secretchat.TLSecretchatGetDifference{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Qts: "+fmt.Sprintf("%#v", this.Qts)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatGetCurrentQts) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&secretchat.TLSecretchatGetCurrentQts{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatReceivedQueue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&secretchat.TLSecretchatReceivedQueue{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "MaxQts: "+fmt.Sprintf("%#v", this.MaxQts)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_Long) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&secretchat.Vector_Long{")
+	s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSecretchatTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCSecretchatClient is the client API for RPCSecretchat service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCSecretchatClient interface {
+	// secretchat.requestEncryption admin_id:long admin_auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChatData;
+	SecretchatRequestEncryption(ctx context.Context, in *TLSecretchatRequestEncryption, opts ...grpc.CallOption) (*SecretChatData, error)
+	// secretchat.acceptEncryption chat_id:int participant_id:long participant_auth_key_id:long g_b:bytes key_fingerprint:long = SecretChatData;
+	SecretchatAcceptEncryption(ctx context.Context, in *TLSecretchatAcceptEncryption, opts ...grpc.CallOption) (*SecretChatData, error)
+	// secretchat.discardEncryption chat_id:int user_id:long delete_history:Bool = SecretChatData;
+	SecretchatDiscardEncryption(ctx context.Context, in *TLSecretchatDiscardEncryption, opts ...grpc.CallOption) (*SecretChatData, error)
+	// secretchat.getSecretChat chat_id:int = SecretChatData;
+	SecretchatGetSecretChat(ctx context.Context, in *TLSecretchatGetSecretChat, opts ...grpc.CallOption) (*SecretChatData, error)
+	// secretchat.sendEncryptedMessage user_id:long chat_id:int message:EncryptedMessage = EncryptedMessageData;
+	SecretchatSendEncryptedMessage(ctx context.Context, in *TLSecretchatSendEncryptedMessage, opts ...grpc.CallOption) (*EncryptedMessageData, error)
+	// secretchat.getDifference auth_key_id:long qts:int limit:int = SecretChatDifference;
+	SecretchatGetDifference(ctx context.Context, in *TLSecretchatGetDifference, opts ...grpc.CallOption) (*SecretChatDifference, error)
+	// secretchat.getCurrentQts auth_key_id:long = Int32;
+	SecretchatGetCurrentQts(ctx context.Context, in *TLSecretchatGetCurrentQts, opts ...grpc.CallOption) (*mtproto.Int32, error)
+	// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+	SecretchatReceivedQueue(ctx context.Context, in *TLSecretchatReceivedQueue, opts ...grpc.CallOption) (*Vector_Long, error)
+}
+
+type rPCSecretchatClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCSecretchatClient(cc *grpc.ClientConn) RPCSecretchatClient {
+	return &rPCSecretchatClient{cc}
+}
+
+func (c *rPCSecretchatClient) SecretchatRequestEncryption(ctx context.Context, in *TLSecretchatRequestEncryption, opts ...grpc.CallOption) (*SecretChatData, error) {
+	out := new(SecretChatData)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_requestEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatAcceptEncryption(ctx context.Context, in *TLSecretchatAcceptEncryption, opts ...grpc.CallOption) (*SecretChatData, error) {
+	out := new(SecretChatData)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_acceptEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatDiscardEncryption(ctx context.Context, in *TLSecretchatDiscardEncryption, opts ...grpc.CallOption) (*SecretChatData, error) {
+	out := new(SecretChatData)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_discardEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatGetSecretChat(ctx context.Context, in *TLSecretchatGetSecretChat, opts ...grpc.CallOption) (*SecretChatData, error) {
+	out := new(SecretChatData)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_getSecretChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatSendEncryptedMessage(ctx context.Context, in *TLSecretchatSendEncryptedMessage, opts ...grpc.CallOption) (*EncryptedMessageData, error) {
+	out := new(EncryptedMessageData)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_sendEncryptedMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatGetDifference(ctx context.Context, in *TLSecretchatGetDifference, opts ...grpc.CallOption) (*SecretChatDifference, error) {
+	out := new(SecretChatDifference)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_getDifference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatGetCurrentQts(ctx context.Context, in *TLSecretchatGetCurrentQts, opts ...grpc.CallOption) (*mtproto.Int32, error) {
+	out := new(mtproto.Int32)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_getCurrentQts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatReceivedQueue(ctx context.Context, in *TLSecretchatReceivedQueue, opts ...grpc.CallOption) (*Vector_Long, error) {
+	out := new(Vector_Long)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_receivedQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCSecretchatServer is the server API for RPCSecretchat service.
+type RPCSecretchatServer interface {
+	// secretchat.requestEncryption admin_id:long admin_auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChatData;
+	SecretchatRequestEncryption(context.Context, *TLSecretchatRequestEncryption) (*SecretChatData, error)
+	// secretchat.acceptEncryption chat_id:int participant_id:long participant_auth_key_id:long g_b:bytes key_fingerprint:long = SecretChatData;
+	SecretchatAcceptEncryption(context.Context, *TLSecretchatAcceptEncryption) (*SecretChatData, error)
+	// secretchat.discardEncryption chat_id:int user_id:long delete_history:Bool = SecretChatData;
+	SecretchatDiscardEncryption(context.Context, *TLSecretchatDiscardEncryption) (*SecretChatData, error)
+	// secretchat.getSecretChat chat_id:int = SecretChatData;
+	SecretchatGetSecretChat(context.Context, *TLSecretchatGetSecretChat) (*SecretChatData, error)
+	// secretchat.sendEncryptedMessage user_id:long chat_id:int message:EncryptedMessage = EncryptedMessageData;
+	SecretchatSendEncryptedMessage(context.Context, *TLSecretchatSendEncryptedMessage) (*EncryptedMessageData, error)
+	// secretchat.getDifference auth_key_id:long qts:int limit:int = SecretChatDifference;
+	SecretchatGetDifference(context.Context, *TLSecretchatGetDifference) (*SecretChatDifference, error)
+	// secretchat.getCurrentQts auth_key_id:long = Int32;
+	SecretchatGetCurrentQts(context.Context, *TLSecretchatGetCurrentQts) (*mtproto.Int32, error)
+	// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+	SecretchatReceivedQueue(context.Context, *TLSecretchatReceivedQueue) (*Vector_Long, error)
+}
+
+// UnimplementedRPCSecretchatServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCSecretchatServer struct {
+}
+
+func (*UnimplementedRPCSecretchatServer) SecretchatRequestEncryption(ctx context.Context, req *TLSecretchatRequestEncryption) (*SecretChatData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatRequestEncryption not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatAcceptEncryption(ctx context.Context, req *TLSecretchatAcceptEncryption) (*SecretChatData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatAcceptEncryption not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatDiscardEncryption(ctx context.Context, req *TLSecretchatDiscardEncryption) (*SecretChatData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatDiscardEncryption not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatGetSecretChat(ctx context.Context, req *TLSecretchatGetSecretChat) (*SecretChatData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatGetSecretChat not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatSendEncryptedMessage(ctx context.Context, req *TLSecretchatSendEncryptedMessage) (*EncryptedMessageData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatSendEncryptedMessage not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatGetDifference(ctx context.Context, req *TLSecretchatGetDifference) (*SecretChatDifference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatGetDifference not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatGetCurrentQts(ctx context.Context, req *TLSecretchatGetCurrentQts) (*mtproto.Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatGetCurrentQts not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatReceivedQueue(ctx context.Context, req *TLSecretchatReceivedQueue) (*Vector_Long, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatReceivedQueue not implemented")
+}
+
+func RegisterRPCSecretchatServer(s *grpc.Server, srv RPCSecretchatServer) {
+	s.RegisterService(&_RPCSecretchat_serviceDesc, srv)
+}
+
+func _RPCSecretchat_SecretchatRequestEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatRequestEncryption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatRequestEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatRequestEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatRequestEncryption(ctx, req.(*TLSecretchatRequestEncryption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatAcceptEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatAcceptEncryption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatAcceptEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatAcceptEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatAcceptEncryption(ctx, req.(*TLSecretchatAcceptEncryption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatDiscardEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatDiscardEncryption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatDiscardEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatDiscardEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatDiscardEncryption(ctx, req.(*TLSecretchatDiscardEncryption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatGetSecretChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatGetSecretChat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatGetSecretChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatGetSecretChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatGetSecretChat(ctx, req.(*TLSecretchatGetSecretChat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatSendEncryptedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatSendEncryptedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatSendEncryptedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatSendEncryptedMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatSendEncryptedMessage(ctx, req.(*TLSecretchatSendEncryptedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatGetDifference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatGetDifference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatGetDifference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatGetDifference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatGetDifference(ctx, req.(*TLSecretchatGetDifference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatGetCurrentQts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatGetCurrentQts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatGetCurrentQts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatGetCurrentQts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatGetCurrentQts(ctx, req.(*TLSecretchatGetCurrentQts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatReceivedQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatReceivedQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatReceivedQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatReceivedQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatReceivedQueue(ctx, req.(*TLSecretchatReceivedQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCSecretchat_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secretchat.RPCSecretchat",
+	HandlerType: (*RPCSecretchatServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "secretchat_requestEncryption",
+			Handler:    _RPCSecretchat_SecretchatRequestEncryption_Handler,
+		},
+		{
+			MethodName: "secretchat_acceptEncryption",
+			Handler:    _RPCSecretchat_SecretchatAcceptEncryption_Handler,
+		},
+		{
+			MethodName: "secretchat_discardEncryption",
+			Handler:    _RPCSecretchat_SecretchatDiscardEncryption_Handler,
+		},
+		{
+			MethodName: "secretchat_getSecretChat",
+			Handler:    _RPCSecretchat_SecretchatGetSecretChat_Handler,
+		},
+		{
+			MethodName: "secretchat_sendEncryptedMessage",
+			Handler:    _RPCSecretchat_SecretchatSendEncryptedMessage_Handler,
+		},
+		{
+			MethodName: "secretchat_getDifference",
+			Handler:    _RPCSecretchat_SecretchatGetDifference_Handler,
+		},
+		{
+			MethodName: "secretchat_getCurrentQts",
+			Handler:    _RPCSecretchat_SecretchatGetCurrentQts_Handler,
+		},
+		{
+			MethodName: "secretchat_receivedQueue",
+			Handler:    _RPCSecretchat_SecretchatReceivedQueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secretchat.tl.proto",
+}
+
+func (m *EncryptedMessageData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedMessageData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedMessageData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Qts != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Qts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLEncryptedMessageData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLEncryptedMessageData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLEncryptedMessageData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretChatData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretChatData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretChatData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Date != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.State != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.KeyFingerprint != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.KeyFingerprint))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.GB) > 0 {
+		i -= len(m.GB)
+		copy(dAtA[i:], m.GB)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.GB)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.GA) > 0 {
+		i -= len(m.GA)
+		copy(dAtA[i:], m.GA)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.GA)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RandomId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.RandomId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ParticipantAuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ParticipantAuthKeyId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ParticipantId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AdminAuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AdminAuthKeyId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AdminId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AdminId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccessHash != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AccessHash))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretChatData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretChatData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretChatData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretChatDifference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretChatDifference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretChatDifference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewEncryptedMessages) > 0 {
+		for iNdEx := len(m.NewEncryptedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewEncryptedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Qts != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Qts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretChatDifference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretChatDifference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretChatDifference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatRequestEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatRequestEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatRequestEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.GA) > 0 {
+		i -= len(m.GA)
+		copy(dAtA[i:], m.GA)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.GA)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RandomId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.RandomId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ParticipantId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AdminAuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AdminAuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AdminId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AdminId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatAcceptEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatAcceptEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatAcceptEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.KeyFingerprint != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.KeyFingerprint))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.GB) > 0 {
+		i -= len(m.GB)
+		copy(dAtA[i:], m.GB)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.GB)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ParticipantAuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ParticipantAuthKeyId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ParticipantId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChatId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ChatId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatDiscardEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatDiscardEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatDiscardEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeleteHistory != nil {
+		{
+			size, err := m.DeleteHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UserId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChatId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ChatId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatGetSecretChat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatGetSecretChat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatGetSecretChat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChatId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ChatId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatSendEncryptedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatSendEncryptedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatSendEncryptedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChatId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ChatId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatGetDifference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatGetDifference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatGetDifference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Qts != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Qts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatGetCurrentQts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatGetCurrentQts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatGetCurrentQts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatReceivedQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatReceivedQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatReceivedQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxQts != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.MaxQts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_Long) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_Long) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Long) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		dAtA8 := make([]byte, len(m.Datas)*10)
+		var j7 int
+		for _, num1 := range m.Datas {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSecretchatTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSecretchatTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EncryptedMessageData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AuthKeyId))
+	}
+	if m.Qts != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Qts))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLEncryptedMessageData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretChatData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Id))
+	}
+	if m.AccessHash != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AccessHash))
+	}
+	if m.AdminId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AdminId))
+	}
+	if m.AdminAuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AdminAuthKeyId))
+	}
+	if m.ParticipantId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ParticipantId))
+	}
+	if m.ParticipantAuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ParticipantAuthKeyId))
+	}
+	if m.RandomId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.RandomId))
+	}
+	l = len(m.GA)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	l = len(m.GB)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.KeyFingerprint != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.KeyFingerprint))
+	}
+	if m.State != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.State))
+	}
+	if m.Date != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Date))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretChatData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretChatDifference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.Qts != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Qts))
+	}
+	if len(m.NewEncryptedMessages) > 0 {
+		for _, e := range m.NewEncryptedMessages {
+			l = e.Size()
+			n += 1 + l + sovSecretchatTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretChatDifference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatRequestEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.AdminId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AdminId))
+	}
+	if m.AdminAuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AdminAuthKeyId))
+	}
+	if m.ParticipantId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ParticipantId))
+	}
+	if m.RandomId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.RandomId))
+	}
+	l = len(m.GA)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatAcceptEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.ChatId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ChatId))
+	}
+	if m.ParticipantId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ParticipantId))
+	}
+	if m.ParticipantAuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ParticipantAuthKeyId))
+	}
+	l = len(m.GB)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.KeyFingerprint != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.KeyFingerprint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatDiscardEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.ChatId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ChatId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.UserId))
+	}
+	if m.DeleteHistory != nil {
+		l = m.DeleteHistory.Size()
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatGetSecretChat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.ChatId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ChatId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatSendEncryptedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.UserId))
+	}
+	if m.ChatId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ChatId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatGetDifference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AuthKeyId))
+	}
+	if m.Qts != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Qts))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatGetCurrentQts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatReceivedQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AuthKeyId))
+	}
+	if m.MaxQts != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.MaxQts))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Long) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		l = 0
+		for _, e := range m.Datas {
+			l += sovSecretchatTl(uint64(e))
+		}
+		n += 1 + sovSecretchatTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSecretchatTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSecretchatTl(x uint64) (n int) {
+	return sovSecretchatTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EncryptedMessageData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedMessageData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedMessageData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qts", wireType)
+			}
+			m.Qts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Qts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &mtproto.EncryptedMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLEncryptedMessageData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_encryptedMessageData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_encryptedMessageData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &EncryptedMessageData{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretChatData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretChatData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretChatData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessHash", wireType)
+			}
+			m.AccessHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessHash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
+			}
+			m.AdminId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAuthKeyId", wireType)
+			}
+			m.AdminAuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminAuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAuthKeyId", wireType)
+			}
+			m.ParticipantAuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantAuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomId", wireType)
+			}
+			m.RandomId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandomId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GA = append(m.GA[:0], dAtA[iNdEx:postIndex]...)
+			if m.GA == nil {
+				m.GA = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GB = append(m.GB[:0], dAtA[iNdEx:postIndex]...)
+			if m.GB == nil {
+				m.GB = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFingerprint", wireType)
+			}
+			m.KeyFingerprint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyFingerprint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretChatData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretChatData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretChatData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &SecretChatData{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretChatDifference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretChatDifference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretChatDifference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qts", wireType)
+			}
+			m.Qts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Qts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEncryptedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewEncryptedMessages = append(m.NewEncryptedMessages, &mtproto.EncryptedMessage{})
+			if err := m.NewEncryptedMessages[len(m.NewEncryptedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretChatDifference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretChatDifference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretChatDifference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &SecretChatDifference{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatRequestEncryption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_requestEncryption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_requestEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
+			}
+			m.AdminId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAuthKeyId", wireType)
+			}
+			m.AdminAuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminAuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomId", wireType)
+			}
+			m.RandomId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandomId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GA = append(m.GA[:0], dAtA[iNdEx:postIndex]...)
+			if m.GA == nil {
+				m.GA = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatAcceptEncryption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_acceptEncryption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_acceptEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
+			}
+			m.ChatId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChatId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAuthKeyId", wireType)
+			}
+			m.ParticipantAuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantAuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GB = append(m.GB[:0], dAtA[iNdEx:postIndex]...)
+			if m.GB == nil {
+				m.GB = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFingerprint", wireType)
+			}
+			m.KeyFingerprint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyFingerprint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatDiscardEncryption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_discardEncryption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_discardEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
+			}
+			m.ChatId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChatId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteHistory == nil {
+				m.DeleteHistory = &mtproto.Bool{}
+			}
+			if err := m.DeleteHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatGetSecretChat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_getSecretChat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_getSecretChat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
+			}
+			m.ChatId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChatId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatSendEncryptedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_sendEncryptedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_sendEncryptedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
+			}
+			m.ChatId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChatId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &mtproto.EncryptedMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatGetDifference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_getDifference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_getDifference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qts", wireType)
+			}
+			m.Qts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Qts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatGetCurrentQts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_getCurrentQts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_getCurrentQts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatReceivedQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_receivedQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_receivedQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQts", wireType)
+			}
+			m.MaxQts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_Long) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_Long: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_Long: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSecretchatTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Datas = append(m.Datas, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSecretchatTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSecretchatTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSecretchatTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Datas) == 0 {
+					m.Datas = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSecretchatTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Datas = append(m.Datas, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSecretchatTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSecretchatTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSecretchatTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSecretchatTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSecretchatTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSecretchatTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSecretchatTl = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,147 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package twofa_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type TwofaClient interface {
+	TwofaGetPassword(ctx context.Context, in *twofa.TLTwofaGetPassword) (*mtproto.Account_Password, error)
+	TwofaGetPasswordSettings(ctx context.Context, in *twofa.TLTwofaGetPasswordSettings) (*mtproto.Account_PasswordSettings, error)
+	TwofaUpdatePasswordSettings(ctx context.Context, in *twofa.TLTwofaUpdatePasswordSettings) (*mtproto.Bool, error)
+	TwofaCheckPassword(ctx context.Context, in *twofa.TLTwofaCheckPassword) (*mtproto.Bool, error)
+	TwofaCheckSessionPasswordNeeded(ctx context.Context, in *twofa.TLTwofaCheckSessionPasswordNeeded) (*mtproto.Bool, error)
+	TwofaConfirmPasswordEmail(ctx context.Context, in *twofa.TLTwofaConfirmPasswordEmail) (*mtproto.Bool, error)
+	TwofaResendPasswordEmail(ctx context.Context, in *twofa.TLTwofaResendPasswordEmail) (*mtproto.Bool, error)
+	TwofaCancelPasswordEmail(ctx context.Context, in *twofa.TLTwofaCancelPasswordEmail) (*mtproto.Bool, error)
+	TwofaRequestPasswordRecovery(ctx context.Context, in *twofa.TLTwofaRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error)
+	TwofaCheckRecoveryPassword(ctx context.Context, in *twofa.TLTwofaCheckRecoveryPassword) (*mtproto.Bool, error)
+	TwofaRecoverPassword(ctx context.Context, in *twofa.TLTwofaRecoverPassword) (*mtproto.Bool, error)
+	TwofaSetPendingSignIn(ctx context.Context, in *twofa.TLTwofaSetPendingSignIn) (*mtproto.Bool, error)
+	TwofaGetPendingSignIn(ctx context.Context, in *twofa.TLTwofaGetPendingSignIn) (*mtproto.Int64, error)
+	TwofaDeletePendingSignIn(ctx context.Context, in *twofa.TLTwofaDeletePendingSignIn) (*mtproto.Bool, error)
+}
+
+type defaultTwofaClient struct {
+	cli zrpc.Client
+}
+
+func NewTwofaClient(cli zrpc.Client) TwofaClient {
+	return &defaultTwofaClient{
+		cli: cli,
+	}
+}
+
+// TwofaGetPassword
+// twofa.getPassword user_id:long = account.Password;
+func (m *defaultTwofaClient) TwofaGetPassword(ctx context.Context, in *twofa.TLTwofaGetPassword) (*mtproto.Account_Password, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaGetPassword(ctx, in)
+}
+
+// TwofaGetPasswordSettings
+// twofa.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+func (m *defaultTwofaClient) TwofaGetPasswordSettings(ctx context.Context, in *twofa.TLTwofaGetPasswordSettings) (*mtproto.Account_PasswordSettings, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaGetPasswordSettings(ctx, in)
+}
+
+// TwofaUpdatePasswordSettings
+// twofa.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+func (m *defaultTwofaClient) TwofaUpdatePasswordSettings(ctx context.Context, in *twofa.TLTwofaUpdatePasswordSettings) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaUpdatePasswordSettings(ctx, in)
+}
+
+// TwofaCheckPassword
+// twofa.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+func (m *defaultTwofaClient) TwofaCheckPassword(ctx context.Context, in *twofa.TLTwofaCheckPassword) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaCheckPassword(ctx, in)
+}
+
+// TwofaCheckSessionPasswordNeeded
+// twofa.checkSessionPasswordNeeded user_id:long = Bool;
+func (m *defaultTwofaClient) TwofaCheckSessionPasswordNeeded(ctx context.Context, in *twofa.TLTwofaCheckSessionPasswordNeeded) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaCheckSessionPasswordNeeded(ctx, in)
+}
+
+// TwofaConfirmPasswordEmail
+// twofa.confirmPasswordEmail user_id:long code:string = Bool;
+func (m *defaultTwofaClient) TwofaConfirmPasswordEmail(ctx context.Context, in *twofa.TLTwofaConfirmPasswordEmail) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaConfirmPasswordEmail(ctx, in)
+}
+
+// TwofaResendPasswordEmail
+// twofa.resendPasswordEmail user_id:long = Bool;
+func (m *defaultTwofaClient) TwofaResendPasswordEmail(ctx context.Context, in *twofa.TLTwofaResendPasswordEmail) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaResendPasswordEmail(ctx, in)
+}
+
+// TwofaCancelPasswordEmail
+// twofa.cancelPasswordEmail user_id:long = Bool;
+func (m *defaultTwofaClient) TwofaCancelPasswordEmail(ctx context.Context, in *twofa.TLTwofaCancelPasswordEmail) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaCancelPasswordEmail(ctx, in)
+}
+
+// TwofaRequestPasswordRecovery
+// twofa.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+func (m *defaultTwofaClient) TwofaRequestPasswordRecovery(ctx context.Context, in *twofa.TLTwofaRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaRequestPasswordRecovery(ctx, in)
+}
+
+// TwofaCheckRecoveryPassword
+// twofa.checkRecoveryPassword user_id:long code:string = Bool;
+func (m *defaultTwofaClient) TwofaCheckRecoveryPassword(ctx context.Context, in *twofa.TLTwofaCheckRecoveryPassword) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaCheckRecoveryPassword(ctx, in)
+}
+
+// TwofaRecoverPassword
+// twofa.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+func (m *defaultTwofaClient) TwofaRecoverPassword(ctx context.Context, in *twofa.TLTwofaRecoverPassword) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaRecoverPassword(ctx, in)
+}
+
+// TwofaSetPendingSignIn
+// twofa.setPendingSignIn auth_key_id:long user_id:long = Bool;
+func (m *defaultTwofaClient) TwofaSetPendingSignIn(ctx context.Context, in *twofa.TLTwofaSetPendingSignIn) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaSetPendingSignIn(ctx, in)
+}
+
+// TwofaGetPendingSignIn
+// twofa.getPendingSignIn auth_key_id:long = Int64;
+func (m *defaultTwofaClient) TwofaGetPendingSignIn(ctx context.Context, in *twofa.TLTwofaGetPendingSignIn) (*mtproto.Int64, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaGetPendingSignIn(ctx, in)
+}
+
+// TwofaDeletePendingSignIn
+// twofa.deletePendingSignIn auth_key_id:long = Bool;
+func (m *defaultTwofaClient) TwofaDeletePendingSignIn(ctx context.Context, in *twofa.TLTwofaDeletePendingSignIn) (*mtproto.Bool, error) {
+	client := twofa.NewRPCTwofaClient(m.cli.Conn())
+	return client.TwofaDeletePendingSignIn(ctx, in)
+}
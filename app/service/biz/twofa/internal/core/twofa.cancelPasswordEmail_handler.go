@@ -0,0 +1,23 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaCancelPasswordEmail
+// twofa.cancelPasswordEmail user_id:long = Bool;
+func (c *TwofaCore) TwofaCancelPasswordEmail(in *twofa.TLTwofaCancelPasswordEmail) (*mtproto.Bool, error) {
+	c.svcCtx.Dao.DeleteCacheEmailCode(c.ctx, in.UserId)
+
+	return mtproto.BoolTrue, nil
+}
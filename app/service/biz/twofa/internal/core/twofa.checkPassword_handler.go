@@ -0,0 +1,36 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaCheckPassword
+// twofa.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+func (c *TwofaCore) TwofaCheckPassword(in *twofa.TLTwofaCheckPassword) (*mtproto.Bool, error) {
+	pwd, err := c.svcCtx.Dao.GetUserPassword(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("twofa.checkPassword - error: %v", err)
+		return nil, err
+	} else if !pwd.HasPassword {
+		err = mtproto.ErrPasswordEmpty
+		c.Logger.Errorf("twofa.checkPassword - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.checkPassword(pwd, in.Password); err != nil {
+		c.Logger.Errorf("twofa.checkPassword - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
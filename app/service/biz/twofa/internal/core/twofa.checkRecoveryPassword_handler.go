@@ -0,0 +1,26 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaCheckRecoveryPassword
+// twofa.checkRecoveryPassword user_id:long code:string = Bool;
+func (c *TwofaCore) TwofaCheckRecoveryPassword(in *twofa.TLTwofaCheckRecoveryPassword) (*mtproto.Bool, error) {
+	if _, err := c.checkEmailCode(in.UserId, in.Code, true); err != nil {
+		c.Logger.Errorf("twofa.checkRecoveryPassword - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaCheckSessionPasswordNeeded
+// twofa.checkSessionPasswordNeeded user_id:long = Bool;
+func (c *TwofaCore) TwofaCheckSessionPasswordNeeded(in *twofa.TLTwofaCheckSessionPasswordNeeded) (*mtproto.Bool, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("twofa.checkSessionPasswordNeeded - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.ToBool(do != nil && do.HasPassword), nil
+}
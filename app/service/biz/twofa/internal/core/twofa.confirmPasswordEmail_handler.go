@@ -0,0 +1,33 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaConfirmPasswordEmail
+// twofa.confirmPasswordEmail user_id:long code:string = Bool;
+func (c *TwofaCore) TwofaConfirmPasswordEmail(in *twofa.TLTwofaConfirmPasswordEmail) (*mtproto.Bool, error) {
+	v, err := c.checkEmailCode(in.UserId, in.Code, false)
+	if err != nil {
+		c.Logger.Errorf("twofa.confirmPasswordEmail - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.svcCtx.Dao.UpdateUserPasswordEmail(c.ctx, in.UserId, v.Email); err != nil {
+		c.Logger.Errorf("twofa.confirmPasswordEmail - error: %v", err)
+		return nil, err
+	}
+	c.svcCtx.Dao.DeleteCacheEmailCode(c.ctx, in.UserId)
+
+	return mtproto.BoolTrue, nil
+}
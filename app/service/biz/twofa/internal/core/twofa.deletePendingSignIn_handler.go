@@ -0,0 +1,23 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaDeletePendingSignIn
+// twofa.deletePendingSignIn auth_key_id:long = Bool;
+func (c *TwofaCore) TwofaDeletePendingSignIn(in *twofa.TLTwofaDeletePendingSignIn) (*mtproto.Bool, error) {
+	c.svcCtx.Dao.DeleteCachePendingSignIn(c.ctx, in.AuthKeyId)
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,44 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaGetPasswordSettings
+// twofa.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+func (c *TwofaCore) TwofaGetPasswordSettings(in *twofa.TLTwofaGetPasswordSettings) (*mtproto.Account_PasswordSettings, error) {
+	pwd, err := c.svcCtx.Dao.GetUserPassword(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("twofa.getPasswordSettings - error: %v", err)
+		return nil, err
+	} else if !pwd.HasPassword {
+		err = mtproto.ErrPasswordEmpty
+		c.Logger.Errorf("twofa.getPasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.checkPassword(pwd, in.Password); err != nil {
+		c.Logger.Errorf("twofa.getPasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	rV := mtproto.MakeTLAccountPasswordSettings(&mtproto.Account_PasswordSettings{
+		Email:          nil,
+		SecureSettings: nil,
+	}).To_Account_PasswordSettings()
+	if pwd.Email != "" {
+		rV.Email = mtproto.MakeFlagsString(pwd.Email)
+	}
+
+	return rV, nil
+}
@@ -0,0 +1,71 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math/rand"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/crypto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+)
+
+// TwofaGetPassword
+// twofa.getPassword user_id:long = account.Password;
+func (c *TwofaCore) TwofaGetPassword(in *twofa.TLTwofaGetPassword) (*mtproto.Account_Password, error) {
+	pwd, err := c.svcCtx.Dao.GetUserPassword(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("twofa.getPassword - error: %v", err)
+		return nil, err
+	}
+
+	rV := mtproto.MakeTLAccountPassword(&mtproto.Account_Password{
+		HasRecovery:     pwd.Email != "",
+		HasSecureValues: false,
+		HasPassword:     pwd.HasPassword,
+		CurrentAlgo:     pwd.CurrentAlgo(),
+		NewAlgo:         pwd.NewAlgo(),
+		NewSecureAlgo: mtproto.MakeTLSecurePasswordKdfAlgoPBKDF2(&mtproto.SecurePasswordKdfAlgo{
+			Salt: crypto.RandomBytes(8),
+		}).To_SecurePasswordKdfAlgo(),
+		SecureRandom: crypto.RandomBytes(256),
+	}).To_Account_Password()
+
+	if pwd.HasPassword {
+		if pwd.Hint != "" {
+			rV.Hint = mtproto.MakeFlagsString(pwd.Hint)
+		}
+
+		// a fresh srp_B for every getPassword, the previous one is dropped
+		b, srpB, err := srp.NewServerEphemeral(pwd.V)
+		if err != nil {
+			c.Logger.Errorf("twofa.getPassword - error: %v", err)
+			return nil, err
+		}
+		eph := &dao.SrpEphemeral{
+			SrpId: rand.Int63(),
+			B:     b,
+		}
+		if err = c.svcCtx.Dao.PutCacheSrpEphemeral(c.ctx, in.UserId, eph); err != nil {
+			c.Logger.Errorf("twofa.getPassword - error: %v", err)
+			return nil, err
+		}
+		rV.SrpId = mtproto.MakeFlagsInt64(eph.SrpId)
+		rV.Srp_B = srpB
+	}
+
+	if emailCode, _ := c.svcCtx.Dao.GetCacheEmailCode(c.ctx, in.UserId); emailCode != nil {
+		rV.EmailUnconfirmedPattern = mtproto.MakeFlagsString(makeEmailPattern(emailCode.Email))
+	}
+
+	return rV, nil
+}
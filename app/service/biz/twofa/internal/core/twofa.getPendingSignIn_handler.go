@@ -0,0 +1,29 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaGetPendingSignIn
+// twofa.getPendingSignIn auth_key_id:long = Int64;
+func (c *TwofaCore) TwofaGetPendingSignIn(in *twofa.TLTwofaGetPendingSignIn) (*mtproto.Int64, error) {
+	userId, err := c.svcCtx.Dao.GetCachePendingSignIn(c.ctx, in.AuthKeyId)
+	if err != nil {
+		c.Logger.Errorf("twofa.getPendingSignIn - error: %v", err)
+		return nil, err
+	}
+
+	return &mtproto.Int64{
+		V: userId,
+	}, nil
+}
@@ -0,0 +1,50 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaRecoverPassword
+// twofa.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+func (c *TwofaCore) TwofaRecoverPassword(in *twofa.TLTwofaRecoverPassword) (*mtproto.Bool, error) {
+	pwd, err := c.svcCtx.Dao.GetUserPassword(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("twofa.recoverPassword - error: %v", err)
+		return nil, err
+	} else if !pwd.HasPassword {
+		err = mtproto.ErrPasswordEmpty
+		c.Logger.Errorf("twofa.recoverPassword - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.checkEmailCode(in.UserId, in.Code, true); err != nil {
+		c.Logger.Errorf("twofa.recoverPassword - error: %v", err)
+		return nil, err
+	}
+	c.svcCtx.Dao.DeleteCacheRecoveryCode(c.ctx, in.UserId)
+
+	// without new_settings the password is simply removed
+	newSettings := in.NewSettings
+	if newSettings.GetNewAlgo() == nil {
+		newSettings = mtproto.MakeTLAccountPasswordInputSettings(&mtproto.Account_PasswordInputSettings{
+			NewAlgo: mtproto.MakeTLPasswordKdfAlgoUnknown(nil).To_PasswordKdfAlgo(),
+		}).To_Account_PasswordInputSettings()
+	}
+
+	if err = c.setNewPassword(pwd, newSettings); err != nil {
+		c.Logger.Errorf("twofa.recoverPassword - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
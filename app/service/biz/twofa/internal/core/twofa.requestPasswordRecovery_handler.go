@@ -0,0 +1,47 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrPasswordRecoveryNa = status.Error(mtproto.ErrBadRequest, "PASSWORD_RECOVERY_NA")
+)
+
+// TwofaRequestPasswordRecovery
+// twofa.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+func (c *TwofaCore) TwofaRequestPasswordRecovery(in *twofa.TLTwofaRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
+	pwd, err := c.svcCtx.Dao.GetUserPassword(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("twofa.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	} else if !pwd.HasPassword {
+		err = mtproto.ErrPasswordEmpty
+		c.Logger.Errorf("twofa.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	} else if pwd.Email == "" {
+		err = ErrPasswordRecoveryNa
+		c.Logger.Errorf("twofa.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.sendEmailCode(in.UserId, pwd.Email, true); err != nil {
+		c.Logger.Errorf("twofa.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLAuthPasswordRecovery(&mtproto.Auth_PasswordRecovery{
+		EmailPattern: makeEmailPattern(pwd.Email),
+	}).To_Auth_PasswordRecovery(), nil
+}
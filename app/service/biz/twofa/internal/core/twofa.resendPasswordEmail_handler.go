@@ -0,0 +1,36 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaResendPasswordEmail
+// twofa.resendPasswordEmail user_id:long = Bool;
+func (c *TwofaCore) TwofaResendPasswordEmail(in *twofa.TLTwofaResendPasswordEmail) (*mtproto.Bool, error) {
+	v, err := c.svcCtx.Dao.GetCacheEmailCode(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("twofa.resendPasswordEmail - error: %v", err)
+		return nil, err
+	} else if v == nil {
+		err = mtproto.ErrEmailHashExpired
+		c.Logger.Errorf("twofa.resendPasswordEmail - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.sendEmailCode(in.UserId, v.Email, false); err != nil {
+		c.Logger.Errorf("twofa.resendPasswordEmail - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,26 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaSetPendingSignIn
+// twofa.setPendingSignIn auth_key_id:long user_id:long = Bool;
+func (c *TwofaCore) TwofaSetPendingSignIn(in *twofa.TLTwofaSetPendingSignIn) (*mtproto.Bool, error) {
+	if err := c.svcCtx.Dao.PutCachePendingSignIn(c.ctx, in.AuthKeyId, in.UserId); err != nil {
+		c.Logger.Errorf("twofa.setPendingSignIn - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaUpdatePasswordSettings
+// twofa.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+func (c *TwofaCore) TwofaUpdatePasswordSettings(in *twofa.TLTwofaUpdatePasswordSettings) (*mtproto.Bool, error) {
+	newSettings := in.NewSettings
+
+	pwd, err := c.svcCtx.Dao.GetUserPassword(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("twofa.updatePasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.checkPassword(pwd, in.Password); err != nil {
+		c.Logger.Errorf("twofa.updatePasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	if newSettings.GetNewAlgo() != nil {
+		if err = c.setNewPassword(pwd, newSettings); err != nil {
+			c.Logger.Errorf("twofa.updatePasswordSettings - error: %v", err)
+			return nil, err
+		}
+		if !pwd.HasPassword {
+			return mtproto.BoolTrue, nil
+		}
+	}
+
+	if newSettings.GetEmail() == nil {
+		return mtproto.BoolTrue, nil
+	}
+
+	email := newSettings.GetEmail().GetValue()
+	switch {
+	case !pwd.HasPassword:
+		err = mtproto.ErrPasswordEmpty
+	case email == "":
+		c.svcCtx.Dao.DeleteCacheEmailCode(c.ctx, in.UserId)
+		err = c.svcCtx.Dao.UpdateUserPasswordEmail(c.ctx, in.UserId, "")
+	case email == pwd.Email:
+	case !checkEmail(email):
+		err = mtproto.ErrEmailInvalid
+	default:
+		// the recovery email is only stored after account.confirmPasswordEmail
+		if err = c.sendEmailCode(in.UserId, email, false); err == nil {
+			err = mtproto.NewEmailUnconfirmedX(emailCodeLength)
+		}
+	}
+	if err != nil {
+		c.Logger.Errorf("twofa.updatePasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
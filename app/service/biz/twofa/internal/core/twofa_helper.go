@@ -0,0 +1,193 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/internal/dao"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+)
+
+const (
+	emailCodeLength      = 6
+	emailCodeMaxAttempts = 5
+)
+
+// checkPassword verifies the SRP proof against the ephemeral handed out by
+// the last twofa.getPassword. Every srp_id is good for a single attempt.
+func (c *TwofaCore) checkPassword(pwd *dao.UserPassword, password *mtproto.InputCheckPasswordSRP) error {
+	if !pwd.HasPassword {
+		return nil
+	}
+	if password.GetPredicateName() != mtproto.Predicate_inputCheckPasswordSRP {
+		return mtproto.ErrPasswordHashInvalid
+	}
+
+	eph, err := c.svcCtx.Dao.TakeCacheSrpEphemeral(c.ctx, pwd.UserId)
+	if err != nil {
+		return err
+	} else if eph == nil || eph.SrpId != password.GetSrpId() {
+		return mtproto.ErrSrpIdInvalid
+	}
+
+	if !srp.Verify(pwd.Salt1, pwd.Salt2, pwd.V, eph.B, password.GetA(), password.GetM1()) {
+		return mtproto.ErrPasswordHashInvalid
+	}
+
+	return nil
+}
+
+// setNewPassword applies new_algo and new_password_hash of newSettings,
+// new_algo:passwordKdfAlgoUnknown turns 2fa off.
+func (c *TwofaCore) setNewPassword(pwd *dao.UserPassword, newSettings *mtproto.Account_PasswordInputSettings) error {
+	newAlgo := newSettings.GetNewAlgo()
+	if newAlgo.GetPredicateName() == mtproto.Predicate_passwordKdfAlgoUnknown {
+		if err := c.svcCtx.Dao.ClearUserPassword(c.ctx, pwd.UserId); err != nil {
+			return err
+		}
+		c.svcCtx.Dao.DeleteCacheSrpEphemeral(c.ctx, pwd.UserId)
+		c.svcCtx.Dao.DeleteCacheEmailCode(c.ctx, pwd.UserId)
+		c.svcCtx.Dao.DeleteCacheRecoveryCode(c.ctx, pwd.UserId)
+		pwd.HasPassword = false
+		pwd.Email = ""
+
+		return nil
+	}
+
+	if !srp.CheckP(newAlgo.GetG(), newAlgo.GetP()) ||
+		!srp.CheckNewSalt1(pwd.NewSalt1, newAlgo.GetSalt1()) ||
+		!bytes.Equal(pwd.NewSalt2, newAlgo.GetSalt2()) {
+		return mtproto.ErrNewSaltInvalid
+	}
+	if !srp.CheckVerifier(newSettings.GetNewPasswordHash()) {
+		return mtproto.ErrNewSettingsInvalid
+	}
+
+	err := c.svcCtx.Dao.UpdateUserPassword(
+		c.ctx,
+		pwd.UserId,
+		newAlgo,
+		newSettings.GetNewPasswordHash(),
+		newSettings.GetHint().GetValue())
+	if err != nil {
+		return err
+	}
+	c.svcCtx.Dao.DeleteCacheSrpEphemeral(c.ctx, pwd.UserId)
+	pwd.HasPassword = true
+
+	return nil
+}
+
+// sendEmailCode mails a fresh code to email and keeps it for the confirmation.
+func (c *TwofaCore) sendEmailCode(userId int64, email string, recovery bool) error {
+	v := &dao.EmailCode{
+		Email: email,
+		Code:  genEmailCode(),
+	}
+
+	if err := c.svcCtx.Dao.SendEmailCode(c.ctx, email, v.Code); err != nil {
+		return err
+	}
+
+	if recovery {
+		return c.svcCtx.Dao.PutCacheRecoveryCode(c.ctx, userId, v)
+	}
+	return c.svcCtx.Dao.PutCacheEmailCode(c.ctx, userId, v)
+}
+
+// checkEmailCode matches code against the pending one, which is dropped
+// after emailCodeMaxAttempts misses.
+func (c *TwofaCore) checkEmailCode(userId int64, code string, recovery bool) (*dao.EmailCode, error) {
+	var (
+		v   *dao.EmailCode
+		err error
+	)
+
+	if recovery {
+		v, err = c.svcCtx.Dao.GetCacheRecoveryCode(c.ctx, userId)
+	} else {
+		v, err = c.svcCtx.Dao.GetCacheEmailCode(c.ctx, userId)
+	}
+	if err != nil {
+		return nil, err
+	} else if v == nil {
+		if recovery {
+			return nil, mtproto.ErrPasswordRecoveryExpired
+		}
+		return nil, mtproto.ErrEmailHashExpired
+	}
+
+	if code == "" {
+		return nil, mtproto.ErrCodeEmpty
+	} else if subtle.ConstantTimeCompare([]byte(code), []byte(v.Code)) == 1 {
+		return v, nil
+	}
+
+	v.Attempts++
+	switch {
+	case v.Attempts >= emailCodeMaxAttempts && recovery:
+		c.svcCtx.Dao.DeleteCacheRecoveryCode(c.ctx, userId)
+	case v.Attempts >= emailCodeMaxAttempts:
+		c.svcCtx.Dao.DeleteCacheEmailCode(c.ctx, userId)
+	case recovery:
+		c.svcCtx.Dao.PutCacheRecoveryCode(c.ctx, userId, v)
+	default:
+		c.svcCtx.Dao.PutCacheEmailCode(c.ctx, userId, v)
+	}
+
+	return nil, mtproto.ErrCodeInvalid
+}
+
+func genEmailCode() string {
+	n, _ := rand.Int(rand.Reader, big.NewInt(1000000))
+	return fmt.Sprintf("%0*d", emailCodeLength, n.Int64())
+}
+
+func checkEmail(email string) bool {
+	if len(email) > 128 || strings.ContainsAny(email, " \t\r\n<>") {
+		return false
+	}
+
+	i := strings.LastIndexByte(email, '@')
+	return i > 0 && i < len(email)-1 && strings.IndexByte(email[i+1:], '.') > 0
+}
+
+// makeEmailPattern hides the email: "teamgram@example.com" -> "t*******@e******.com"
+func makeEmailPattern(email string) string {
+	i := strings.LastIndexByte(email, '@')
+	if i <= 0 {
+		return email
+	}
+
+	var (
+		name   = email[:i]
+		domain = email[i+1:]
+		suffix = ""
+	)
+	if j := strings.LastIndexByte(domain, '.'); j > 0 {
+		domain, suffix = domain[:j], domain[j:]
+	}
+
+	mask := func(s string) string {
+		if len(s) <= 1 {
+			return s
+		}
+		return s[:1] + strings.Repeat("*", len(s)-1)
+	}
+
+	return mask(name) + "@" + mask(domain) + suffix
+}
@@ -0,0 +1 @@
+./dalgen.sh user_passwords
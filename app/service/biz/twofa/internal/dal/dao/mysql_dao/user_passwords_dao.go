@@ -0,0 +1,249 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type UserPasswordsDAO struct {
+	db *sqlx.DB
+}
+
+func NewUserPasswordsDAO(db *sqlx.DB) *UserPasswordsDAO {
+	return &UserPasswordsDAO{db}
+}
+
+// InsertIgnore
+// insert ignore into user_passwords (user_id, new_salt1, new_salt2) values (:user_id, :new_salt1, :new_salt2)
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) InsertIgnore(ctx context.Context, do *dataobject.UserPasswordsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert ignore into user_passwords (user_id, new_salt1, new_salt2) values (:user_id, :new_salt1, :new_salt2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertIgnore(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertIgnore(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertIgnore(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertIgnoreTx
+// insert ignore into user_passwords (user_id, new_salt1, new_salt2) values (:user_id, :new_salt1, :new_salt2)
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) InsertIgnoreTx(tx *sqlx.Tx, do *dataobject.UserPasswordsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert ignore into user_passwords (user_id, new_salt1, new_salt2) values (:user_id, :new_salt1, :new_salt2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertIgnore(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertIgnore(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertIgnore(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByUserId
+// select id, user_id, new_salt1, new_salt2, has_password, salt1, salt2, v, hint, email from user_passwords where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) SelectByUserId(ctx context.Context, user_id int64) (rValue *dataobject.UserPasswordsDO, err error) {
+	var (
+		query = "select id, user_id, new_salt1, new_salt2, has_password, salt1, salt2, v, hint, email from user_passwords where user_id = ?"
+		do    = &dataobject.UserPasswordsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, user_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByUserId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// UpdatePassword
+// update user_passwords set has_password = 1, salt1 = :salt1, salt2 = :salt2, v = :v, hint = :hint where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdatePassword(ctx context.Context, salt1 string, salt2 string, v string, hint string, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set has_password = 1, salt1 = ?, salt2 = ?, v = ?, hint = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, salt1, salt2, v, hint, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdatePassword(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdatePassword(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_passwords set has_password = 1, salt1 = :salt1, salt2 = :salt2, v = :v, hint = :hint where user_id = :user_id
+// UpdatePasswordTx
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdatePasswordTx(tx *sqlx.Tx, salt1 string, salt2 string, v string, hint string, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set has_password = 1, salt1 = ?, salt2 = ?, v = ?, hint = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, salt1, salt2, v, hint, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdatePassword(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdatePassword(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateEmail
+// update user_passwords set email = :email where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateEmail(ctx context.Context, email string, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set email = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, email, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateEmail(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateEmail(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_passwords set email = :email where user_id = :user_id
+// UpdateEmailTx
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateEmailTx(tx *sqlx.Tx, email string, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set email = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, email, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateEmail(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateEmail(_), error: %v", err)
+	}
+
+	return
+}
+
+// ClearPassword
+// update user_passwords set has_password = 0, salt1 = ”, salt2 = ”, v = ”, hint = ”, email = ” where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) ClearPassword(ctx context.Context, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set has_password = 0, salt1 = '', salt2 = '', v = '', hint = '', email = '' where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in ClearPassword(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in ClearPassword(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_passwords set has_password = 0, salt1 = ”, salt2 = ”, v = ”, hint = ”, email = ” where user_id = :user_id
+// ClearPasswordTx
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) ClearPasswordTx(tx *sqlx.Tx, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set has_password = 0, salt1 = '', salt2 = '', v = '', hint = '', email = '' where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in ClearPassword(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in ClearPassword(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,24 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type UserPasswordsDO struct {
+	Id          int64  `db:"id"`
+	UserId      int64  `db:"user_id"`
+	NewSalt1    string `db:"new_salt1"`
+	NewSalt2    string `db:"new_salt2"`
+	HasPassword bool   `db:"has_password"`
+	Salt1       string `db:"salt1"`
+	Salt2       string `db:"salt2"`
+	V           string `db:"v"`
+	Hint        string `db:"hint"`
+	Email       string `db:"email"`
+}
@@ -0,0 +1,33 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dao
+
+import (
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/internal/config"
+	"github.com/teamgram/teamgram-server/pkg/code"
+
+	"github.com/zeromicro/go-zero/core/stores/kv"
+)
+
+type Dao struct {
+	*Mysql
+	kv kv.Store
+	code.EmailCodeInterface
+}
+
+func New(c config.Config) (dao *Dao) {
+	db := sqlx.NewMySQL(&c.Mysql)
+	return &Dao{
+		Mysql:              newMysqlDao(db),
+		kv:                 kv.NewStore(c.KV),
+		EmailCodeInterface: code.NewEmailCode(c.EmailCode),
+	}
+}
@@ -0,0 +1,187 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	srpTimeout           = 3600  // srp_B lives until the next account.getPassword
+	emailCodeTimeout     = 86400 // unconfirmed recovery email
+	recoveryCodeTimeout  = 3600
+	pendingSignInTimeout = 3600
+
+	cacheSrpPrefix           = "twofa_srp"
+	cacheEmailCodePrefix     = "twofa_email_codes"
+	cacheRecoveryCodePrefix  = "twofa_recovery_codes"
+	cachePendingSignInPrefix = "twofa_pending_sign_in"
+
+	// GET and DEL in one step, two concurrent checks can't both read the key
+	takeScript = `local v = redis.call("GET", KEYS[1])
+if v then
+	redis.call("DEL", KEYS[1])
+end
+return v`
+)
+
+// SrpEphemeral is the server side of a pending SRP check.
+type SrpEphemeral struct {
+	SrpId int64  `json:"srp_id"`
+	B     []byte `json:"b"`
+}
+
+// EmailCode is a code sent to Email, waiting to be entered by the user.
+type EmailCode struct {
+	Email    string `json:"email"`
+	Code     string `json:"code"`
+	Attempts int    `json:"attempts"`
+}
+
+func genCacheSrpKey(userId int64) string {
+	return fmt.Sprintf("%s_%d", cacheSrpPrefix, userId)
+}
+
+func genCacheEmailCodeKey(userId int64) string {
+	return fmt.Sprintf("%s_%d", cacheEmailCodePrefix, userId)
+}
+
+func genCacheRecoveryCodeKey(userId int64) string {
+	return fmt.Sprintf("%s_%d", cacheRecoveryCodePrefix, userId)
+}
+
+func genCachePendingSignInKey(authKeyId int64) string {
+	return fmt.Sprintf("%s_%d", cachePendingSignInPrefix, authKeyId)
+}
+
+func (d *Dao) getCacheJson(ctx context.Context, cacheKey string, v interface{}) (bool, error) {
+	s, err := d.kv.GetCtx(ctx, cacheKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", cacheKey, err)
+		return false, err
+	} else if s == "" {
+		return false, nil
+	}
+
+	if err = jsonx.UnmarshalFromString(s, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (d *Dao) putCacheJson(ctx context.Context, cacheKey string, v interface{}, timeout int) (err error) {
+	s, _ := jsonx.MarshalToString(v)
+	if err = d.kv.SetexCtx(ctx, cacheKey, s, timeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.SETEX(%s) error(%v)", cacheKey, err)
+	}
+	return
+}
+
+func (d *Dao) deleteCache(ctx context.Context, cacheKey string) (err error) {
+	if _, err = d.kv.DelCtx(ctx, cacheKey); err != nil {
+		logx.WithContext(ctx).Errorf("conn.DEL(%s) error(%v)", cacheKey, err)
+	}
+	return
+}
+
+// TakeCacheSrpEphemeral gets and deletes the ephemeral of userId, so every
+// ephemeral is handed to a single check.
+func (d *Dao) TakeCacheSrpEphemeral(ctx context.Context, userId int64) (*SrpEphemeral, error) {
+	cacheKey := genCacheSrpKey(userId)
+	r, err := d.kv.EvalCtx(ctx, takeScript, cacheKey)
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		logx.WithContext(ctx).Errorf("conn.EVAL(%s) error(%v)", cacheKey, err)
+		return nil, err
+	}
+
+	s, _ := r.(string)
+	if s == "" {
+		return nil, nil
+	}
+
+	v := new(SrpEphemeral)
+	if err = jsonx.UnmarshalFromString(s, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (d *Dao) PutCacheSrpEphemeral(ctx context.Context, userId int64, v *SrpEphemeral) error {
+	return d.putCacheJson(ctx, genCacheSrpKey(userId), v, srpTimeout)
+}
+
+func (d *Dao) DeleteCacheSrpEphemeral(ctx context.Context, userId int64) error {
+	return d.deleteCache(ctx, genCacheSrpKey(userId))
+}
+
+func (d *Dao) GetCacheEmailCode(ctx context.Context, userId int64) (*EmailCode, error) {
+	v := new(EmailCode)
+	if ok, err := d.getCacheJson(ctx, genCacheEmailCodeKey(userId), v); err != nil || !ok {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (d *Dao) PutCacheEmailCode(ctx context.Context, userId int64, v *EmailCode) error {
+	return d.putCacheJson(ctx, genCacheEmailCodeKey(userId), v, emailCodeTimeout)
+}
+
+func (d *Dao) DeleteCacheEmailCode(ctx context.Context, userId int64) error {
+	return d.deleteCache(ctx, genCacheEmailCodeKey(userId))
+}
+
+func (d *Dao) GetCacheRecoveryCode(ctx context.Context, userId int64) (*EmailCode, error) {
+	v := new(EmailCode)
+	if ok, err := d.getCacheJson(ctx, genCacheRecoveryCodeKey(userId), v); err != nil || !ok {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (d *Dao) PutCacheRecoveryCode(ctx context.Context, userId int64, v *EmailCode) error {
+	return d.putCacheJson(ctx, genCacheRecoveryCodeKey(userId), v, recoveryCodeTimeout)
+}
+
+func (d *Dao) DeleteCacheRecoveryCode(ctx context.Context, userId int64) error {
+	return d.deleteCache(ctx, genCacheRecoveryCodeKey(userId))
+}
+
+func (d *Dao) GetCachePendingSignIn(ctx context.Context, authKeyId int64) (int64, error) {
+	cacheKey := genCachePendingSignInKey(authKeyId)
+	v, err := d.kv.GetCtx(ctx, cacheKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", cacheKey, err)
+		return 0, err
+	} else if v == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(v, 10, 64)
+}
+
+func (d *Dao) PutCachePendingSignIn(ctx context.Context, authKeyId, userId int64) (err error) {
+	cacheKey := genCachePendingSignInKey(authKeyId)
+	if err = d.kv.SetexCtx(ctx, cacheKey, strconv.FormatInt(userId, 10), pendingSignInTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.SETEX(%s) error(%v)", cacheKey, err)
+	}
+	return
+}
+
+func (d *Dao) DeleteCachePendingSignIn(ctx context.Context, authKeyId int64) error {
+	return d.deleteCache(ctx, genCachePendingSignInKey(authKeyId))
+}
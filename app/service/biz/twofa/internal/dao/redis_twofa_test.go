@@ -0,0 +1,87 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/kv"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func newTestDao(t *testing.T) *Dao {
+	mr := miniredis.RunT(t)
+	return &Dao{
+		kv: kv.NewStore(kv.KvConf{
+			cache.NodeConf{
+				RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+				Weight:    100,
+			},
+		}),
+	}
+}
+
+func TestTakeCacheSrpEphemeral(t *testing.T) {
+	var (
+		ctx = context.Background()
+		d   = newTestDao(t)
+	)
+
+	if v, err := d.TakeCacheSrpEphemeral(ctx, 1); err != nil || v != nil {
+		t.Fatalf("TakeCacheSrpEphemeral on a missing key = %v, %v", v, err)
+	}
+
+	if err := d.PutCacheSrpEphemeral(ctx, 1, &SrpEphemeral{SrpId: 7, B: []byte{1, 2}}); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		taken []*SrpEphemeral
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := d.TakeCacheSrpEphemeral(ctx, 1)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if v != nil {
+				mu.Lock()
+				taken = append(taken, v)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(taken) != 1 {
+		t.Fatalf("the ephemeral was taken %d times, want 1", len(taken))
+	}
+	if taken[0].SrpId != 7 || string(taken[0].B) != "\x01\x02" {
+		t.Errorf("took %+v", taken[0])
+	}
+}
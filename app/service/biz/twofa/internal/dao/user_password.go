@@ -0,0 +1,130 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/crypto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+)
+
+type UserPassword struct {
+	UserId      int64
+	NewSalt1    []byte
+	NewSalt2    []byte
+	HasPassword bool
+	Salt1       []byte
+	Salt2       []byte
+	V           []byte
+	Hint        string
+	Email       string
+}
+
+func decodeBytes(s string) []byte {
+	b, _ := base64.RawStdEncoding.DecodeString(s)
+	return b
+}
+
+func encodeBytes(b []byte) string {
+	return base64.RawStdEncoding.EncodeToString(b)
+}
+
+func makeUserPassword(do *dataobject.UserPasswordsDO) *UserPassword {
+	return &UserPassword{
+		UserId:      do.UserId,
+		NewSalt1:    decodeBytes(do.NewSalt1),
+		NewSalt2:    decodeBytes(do.NewSalt2),
+		HasPassword: do.HasPassword,
+		Salt1:       decodeBytes(do.Salt1),
+		Salt2:       decodeBytes(do.Salt2),
+		V:           decodeBytes(do.V),
+		Hint:        do.Hint,
+		Email:       do.Email,
+	}
+}
+
+// NewAlgo is the algo handed out for setting a new password, the client
+// extends Salt1 with its own random bytes.
+func (m *UserPassword) NewAlgo() *mtproto.PasswordKdfAlgo {
+	return mtproto.MakeTLPasswordKdfAlgoModPow(&mtproto.PasswordKdfAlgo{
+		Salt1: m.NewSalt1,
+		Salt2: m.NewSalt2,
+		G:     srp.G,
+		P:     srp.P,
+	}).To_PasswordKdfAlgo()
+}
+
+func (m *UserPassword) CurrentAlgo() *mtproto.PasswordKdfAlgo {
+	if !m.HasPassword {
+		return nil
+	}
+
+	return mtproto.MakeTLPasswordKdfAlgoModPow(&mtproto.PasswordKdfAlgo{
+		Salt1: m.Salt1,
+		Salt2: m.Salt2,
+		G:     srp.G,
+		P:     srp.P,
+	}).To_PasswordKdfAlgo()
+}
+
+// GetUserPassword returns the 2fa settings of userId, the row holding the
+// per-user new_algo salts is created on first access.
+func (d *Dao) GetUserPassword(ctx context.Context, userId int64) (*UserPassword, error) {
+	do, err := d.UserPasswordsDAO.SelectByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if do == nil {
+		do = &dataobject.UserPasswordsDO{
+			UserId:   userId,
+			NewSalt1: encodeBytes(crypto.RandomBytes(srp.NewSalt1Size)),
+			NewSalt2: encodeBytes(crypto.RandomBytes(srp.NewSalt2Size)),
+		}
+		if _, _, err = d.UserPasswordsDAO.InsertIgnore(ctx, do); err != nil {
+			return nil, err
+		}
+
+		// reload, a concurrent getPassword may have inserted the row first
+		if do, err = d.UserPasswordsDAO.SelectByUserId(ctx, userId); err != nil {
+			return nil, err
+		} else if do == nil {
+			return nil, mtproto.ErrInternelServerError
+		}
+	}
+
+	return makeUserPassword(do), nil
+}
+
+func (d *Dao) UpdateUserPassword(ctx context.Context, userId int64, algo *mtproto.PasswordKdfAlgo, v []byte, hint string) error {
+	_, err := d.UserPasswordsDAO.UpdatePassword(
+		ctx,
+		encodeBytes(algo.GetSalt1()),
+		encodeBytes(algo.GetSalt2()),
+		encodeBytes(v),
+		hint,
+		userId)
+
+	return err
+}
+
+func (d *Dao) UpdateUserPasswordEmail(ctx context.Context, userId int64, email string) error {
+	_, err := d.UserPasswordsDAO.UpdateEmail(ctx, email, userId)
+	return err
+}
+
+func (d *Dao) ClearUserPassword(ctx context.Context, userId int64) error {
+	_, err := d.UserPasswordsDAO.ClearPassword(ctx, userId)
+	return err
+}
@@ -0,0 +1,229 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/internal/core"
+	"github.com/teamgram/teamgram-server/app/service/biz/twofa/twofa"
+)
+
+// TwofaGetPassword
+// twofa.getPassword user_id:long = account.Password;
+func (s *Service) TwofaGetPassword(ctx context.Context, request *twofa.TLTwofaGetPassword) (*mtproto.Account_Password, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.getPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaGetPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.getPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaGetPasswordSettings
+// twofa.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+func (s *Service) TwofaGetPasswordSettings(ctx context.Context, request *twofa.TLTwofaGetPasswordSettings) (*mtproto.Account_PasswordSettings, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.getPasswordSettings - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaGetPasswordSettings(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.getPasswordSettings - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaUpdatePasswordSettings
+// twofa.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+func (s *Service) TwofaUpdatePasswordSettings(ctx context.Context, request *twofa.TLTwofaUpdatePasswordSettings) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.updatePasswordSettings - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaUpdatePasswordSettings(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.updatePasswordSettings - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaCheckPassword
+// twofa.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+func (s *Service) TwofaCheckPassword(ctx context.Context, request *twofa.TLTwofaCheckPassword) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.checkPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaCheckPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.checkPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaCheckSessionPasswordNeeded
+// twofa.checkSessionPasswordNeeded user_id:long = Bool;
+func (s *Service) TwofaCheckSessionPasswordNeeded(ctx context.Context, request *twofa.TLTwofaCheckSessionPasswordNeeded) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.checkSessionPasswordNeeded - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaCheckSessionPasswordNeeded(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.checkSessionPasswordNeeded - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaConfirmPasswordEmail
+// twofa.confirmPasswordEmail user_id:long code:string = Bool;
+func (s *Service) TwofaConfirmPasswordEmail(ctx context.Context, request *twofa.TLTwofaConfirmPasswordEmail) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.confirmPasswordEmail - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaConfirmPasswordEmail(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.confirmPasswordEmail - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaResendPasswordEmail
+// twofa.resendPasswordEmail user_id:long = Bool;
+func (s *Service) TwofaResendPasswordEmail(ctx context.Context, request *twofa.TLTwofaResendPasswordEmail) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.resendPasswordEmail - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaResendPasswordEmail(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.resendPasswordEmail - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaCancelPasswordEmail
+// twofa.cancelPasswordEmail user_id:long = Bool;
+func (s *Service) TwofaCancelPasswordEmail(ctx context.Context, request *twofa.TLTwofaCancelPasswordEmail) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.cancelPasswordEmail - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaCancelPasswordEmail(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.cancelPasswordEmail - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaRequestPasswordRecovery
+// twofa.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+func (s *Service) TwofaRequestPasswordRecovery(ctx context.Context, request *twofa.TLTwofaRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.requestPasswordRecovery - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaRequestPasswordRecovery(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.requestPasswordRecovery - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaCheckRecoveryPassword
+// twofa.checkRecoveryPassword user_id:long code:string = Bool;
+func (s *Service) TwofaCheckRecoveryPassword(ctx context.Context, request *twofa.TLTwofaCheckRecoveryPassword) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.checkRecoveryPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaCheckRecoveryPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.checkRecoveryPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaRecoverPassword
+// twofa.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+func (s *Service) TwofaRecoverPassword(ctx context.Context, request *twofa.TLTwofaRecoverPassword) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.recoverPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaRecoverPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.recoverPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaSetPendingSignIn
+// twofa.setPendingSignIn auth_key_id:long user_id:long = Bool;
+func (s *Service) TwofaSetPendingSignIn(ctx context.Context, request *twofa.TLTwofaSetPendingSignIn) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.setPendingSignIn - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaSetPendingSignIn(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.setPendingSignIn - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaGetPendingSignIn
+// twofa.getPendingSignIn auth_key_id:long = Int64;
+func (s *Service) TwofaGetPendingSignIn(ctx context.Context, request *twofa.TLTwofaGetPendingSignIn) (*mtproto.Int64, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.getPendingSignIn - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaGetPendingSignIn(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.getPendingSignIn - reply: %s", r.DebugString())
+	return r, err
+}
+
+// TwofaDeletePendingSignIn
+// twofa.deletePendingSignIn auth_key_id:long = Bool;
+func (s *Service) TwofaDeletePendingSignIn(ctx context.Context, request *twofa.TLTwofaDeletePendingSignIn) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("twofa.deletePendingSignIn - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.TwofaDeletePendingSignIn(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("twofa.deletePendingSignIn - reply: %s", r.DebugString())
+	return r, err
+}
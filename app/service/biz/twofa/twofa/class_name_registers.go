@@ -0,0 +1,119 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package twofa
+
+const (
+	Predicate_twofa_getPassword                = "twofa_getPassword"
+	Predicate_twofa_getPasswordSettings        = "twofa_getPasswordSettings"
+	Predicate_twofa_updatePasswordSettings     = "twofa_updatePasswordSettings"
+	Predicate_twofa_checkPassword              = "twofa_checkPassword"
+	Predicate_twofa_checkSessionPasswordNeeded = "twofa_checkSessionPasswordNeeded"
+	Predicate_twofa_confirmPasswordEmail       = "twofa_confirmPasswordEmail"
+	Predicate_twofa_resendPasswordEmail        = "twofa_resendPasswordEmail"
+	Predicate_twofa_cancelPasswordEmail        = "twofa_cancelPasswordEmail"
+	Predicate_twofa_requestPasswordRecovery    = "twofa_requestPasswordRecovery"
+	Predicate_twofa_checkRecoveryPassword      = "twofa_checkRecoveryPassword"
+	Predicate_twofa_recoverPassword            = "twofa_recoverPassword"
+	Predicate_twofa_setPendingSignIn           = "twofa_setPendingSignIn"
+	Predicate_twofa_getPendingSignIn           = "twofa_getPendingSignIn"
+	Predicate_twofa_deletePendingSignIn        = "twofa_deletePendingSignIn"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_twofa_getPassword: {
+		0: 1523577933, // 0x5acff44d
+
+	},
+	Predicate_twofa_getPasswordSettings: {
+		0: 1857923106, // 0x6ebda822
+
+	},
+	Predicate_twofa_updatePasswordSettings: {
+		0: -1855152771, // 0x916c9d7d
+
+	},
+	Predicate_twofa_checkPassword: {
+		0: 368323892, // 0x15f42d34
+
+	},
+	Predicate_twofa_checkSessionPasswordNeeded: {
+		0: -1954838614, // 0x8b7b87aa
+
+	},
+	Predicate_twofa_confirmPasswordEmail: {
+		0: -658988556, // 0xd8b8a1f4
+
+	},
+	Predicate_twofa_resendPasswordEmail: {
+		0: -1934374233, // 0x8cb3caa7
+
+	},
+	Predicate_twofa_cancelPasswordEmail: {
+		0: -1926183903, // 0x8d30c421
+
+	},
+	Predicate_twofa_requestPasswordRecovery: {
+		0: 99653626, // 0x5f097fa
+
+	},
+	Predicate_twofa_checkRecoveryPassword: {
+		0: 1654132232, // 0x62980e08
+
+	},
+	Predicate_twofa_recoverPassword: {
+		0: 765837787, // 0x2da5c1db
+
+	},
+	Predicate_twofa_setPendingSignIn: {
+		0: -166124560, // 0xf61923f0
+
+	},
+	Predicate_twofa_getPendingSignIn: {
+		0: -1065906780, // 0xc0778da4
+
+	},
+	Predicate_twofa_deletePendingSignIn: {
+		0: 1244095028, // 0x4a276234
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	1523577933:  Predicate_twofa_getPassword,                // 0x5acff44d
+	1857923106:  Predicate_twofa_getPasswordSettings,        // 0x6ebda822
+	-1855152771: Predicate_twofa_updatePasswordSettings,     // 0x916c9d7d
+	368323892:   Predicate_twofa_checkPassword,              // 0x15f42d34
+	-1954838614: Predicate_twofa_checkSessionPasswordNeeded, // 0x8b7b87aa
+	-658988556:  Predicate_twofa_confirmPasswordEmail,       // 0xd8b8a1f4
+	-1934374233: Predicate_twofa_resendPasswordEmail,        // 0x8cb3caa7
+	-1926183903: Predicate_twofa_cancelPasswordEmail,        // 0x8d30c421
+	99653626:    Predicate_twofa_requestPasswordRecovery,    // 0x5f097fa
+	1654132232:  Predicate_twofa_checkRecoveryPassword,      // 0x62980e08
+	765837787:   Predicate_twofa_recoverPassword,            // 0x2da5c1db
+	-166124560:  Predicate_twofa_setPendingSignIn,           // 0xf61923f0
+	-1065906780: Predicate_twofa_getPendingSignIn,           // 0xc0778da4
+	1244095028:  Predicate_twofa_deletePendingSignIn,        // 0x4a276234
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
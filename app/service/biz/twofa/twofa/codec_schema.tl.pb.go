@@ -0,0 +1,857 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package twofa
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+
+	// Method
+	1523577933: func() mtproto.TLObject { // 0x5acff44d
+		return &TLTwofaGetPassword{
+			Constructor: 1523577933,
+		}
+	},
+	1857923106: func() mtproto.TLObject { // 0x6ebda822
+		return &TLTwofaGetPasswordSettings{
+			Constructor: 1857923106,
+		}
+	},
+	-1855152771: func() mtproto.TLObject { // 0x916c9d7d
+		return &TLTwofaUpdatePasswordSettings{
+			Constructor: -1855152771,
+		}
+	},
+	368323892: func() mtproto.TLObject { // 0x15f42d34
+		return &TLTwofaCheckPassword{
+			Constructor: 368323892,
+		}
+	},
+	-1954838614: func() mtproto.TLObject { // 0x8b7b87aa
+		return &TLTwofaCheckSessionPasswordNeeded{
+			Constructor: -1954838614,
+		}
+	},
+	-658988556: func() mtproto.TLObject { // 0xd8b8a1f4
+		return &TLTwofaConfirmPasswordEmail{
+			Constructor: -658988556,
+		}
+	},
+	-1934374233: func() mtproto.TLObject { // 0x8cb3caa7
+		return &TLTwofaResendPasswordEmail{
+			Constructor: -1934374233,
+		}
+	},
+	-1926183903: func() mtproto.TLObject { // 0x8d30c421
+		return &TLTwofaCancelPasswordEmail{
+			Constructor: -1926183903,
+		}
+	},
+	99653626: func() mtproto.TLObject { // 0x5f097fa
+		return &TLTwofaRequestPasswordRecovery{
+			Constructor: 99653626,
+		}
+	},
+	1654132232: func() mtproto.TLObject { // 0x62980e08
+		return &TLTwofaCheckRecoveryPassword{
+			Constructor: 1654132232,
+		}
+	},
+	765837787: func() mtproto.TLObject { // 0x2da5c1db
+		return &TLTwofaRecoverPassword{
+			Constructor: 765837787,
+		}
+	},
+	-166124560: func() mtproto.TLObject { // 0xf61923f0
+		return &TLTwofaSetPendingSignIn{
+			Constructor: -166124560,
+		}
+	},
+	-1065906780: func() mtproto.TLObject { // 0xc0778da4
+		return &TLTwofaGetPendingSignIn{
+			Constructor: -1065906780,
+		}
+	},
+	1244095028: func() mtproto.TLObject { // 0x4a276234
+		return &TLTwofaDeletePendingSignIn{
+			Constructor: 1244095028,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+//----------------------------------------------------------------------------------------------------------------
+// TLTwofaGetPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaGetPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_getPassword))
+
+	switch uint32(m.Constructor) {
+	case 0x5acff44d:
+		// twofa.getPassword user_id:long = account.Password;
+		x.UInt(0x5acff44d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaGetPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaGetPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5acff44d:
+		// twofa.getPassword user_id:long = account.Password;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaGetPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaGetPasswordSettings
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaGetPasswordSettings) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_getPasswordSettings))
+
+	switch uint32(m.Constructor) {
+	case 0x6ebda822:
+		// twofa.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+		x.UInt(0x6ebda822)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetPassword().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaGetPasswordSettings) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaGetPasswordSettings) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6ebda822:
+		// twofa.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputCheckPasswordSRP{}
+		m2.Decode(dBuf)
+		m.Password = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaGetPasswordSettings) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaUpdatePasswordSettings
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaUpdatePasswordSettings) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_updatePasswordSettings))
+
+	switch uint32(m.Constructor) {
+	case 0x916c9d7d:
+		// twofa.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+		x.UInt(0x916c9d7d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetPassword().Encode(layer))
+		x.Bytes(m.GetNewSettings().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaUpdatePasswordSettings) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaUpdatePasswordSettings) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x916c9d7d:
+		// twofa.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputCheckPasswordSRP{}
+		m2.Decode(dBuf)
+		m.Password = m2
+
+		m3 := &mtproto.Account_PasswordInputSettings{}
+		m3.Decode(dBuf)
+		m.NewSettings = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaUpdatePasswordSettings) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaCheckPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaCheckPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_checkPassword))
+
+	switch uint32(m.Constructor) {
+	case 0x15f42d34:
+		// twofa.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+		x.UInt(0x15f42d34)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetPassword().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaCheckPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaCheckPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x15f42d34:
+		// twofa.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputCheckPasswordSRP{}
+		m2.Decode(dBuf)
+		m.Password = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaCheckPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaCheckSessionPasswordNeeded
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaCheckSessionPasswordNeeded) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_checkSessionPasswordNeeded))
+
+	switch uint32(m.Constructor) {
+	case 0x8b7b87aa:
+		// twofa.checkSessionPasswordNeeded user_id:long = Bool;
+		x.UInt(0x8b7b87aa)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8b7b87aa:
+		// twofa.checkSessionPasswordNeeded user_id:long = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaConfirmPasswordEmail
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaConfirmPasswordEmail) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_confirmPasswordEmail))
+
+	switch uint32(m.Constructor) {
+	case 0xd8b8a1f4:
+		// twofa.confirmPasswordEmail user_id:long code:string = Bool;
+		x.UInt(0xd8b8a1f4)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.String(m.GetCode())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaConfirmPasswordEmail) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaConfirmPasswordEmail) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd8b8a1f4:
+		// twofa.confirmPasswordEmail user_id:long code:string = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Code = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaConfirmPasswordEmail) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaResendPasswordEmail
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaResendPasswordEmail) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_resendPasswordEmail))
+
+	switch uint32(m.Constructor) {
+	case 0x8cb3caa7:
+		// twofa.resendPasswordEmail user_id:long = Bool;
+		x.UInt(0x8cb3caa7)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaResendPasswordEmail) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaResendPasswordEmail) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8cb3caa7:
+		// twofa.resendPasswordEmail user_id:long = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaResendPasswordEmail) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaCancelPasswordEmail
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaCancelPasswordEmail) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_cancelPasswordEmail))
+
+	switch uint32(m.Constructor) {
+	case 0x8d30c421:
+		// twofa.cancelPasswordEmail user_id:long = Bool;
+		x.UInt(0x8d30c421)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaCancelPasswordEmail) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaCancelPasswordEmail) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8d30c421:
+		// twofa.cancelPasswordEmail user_id:long = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaCancelPasswordEmail) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaRequestPasswordRecovery
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaRequestPasswordRecovery) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_requestPasswordRecovery))
+
+	switch uint32(m.Constructor) {
+	case 0x5f097fa:
+		// twofa.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+		x.UInt(0x5f097fa)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaRequestPasswordRecovery) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaRequestPasswordRecovery) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5f097fa:
+		// twofa.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaRequestPasswordRecovery) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaCheckRecoveryPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaCheckRecoveryPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_checkRecoveryPassword))
+
+	switch uint32(m.Constructor) {
+	case 0x62980e08:
+		// twofa.checkRecoveryPassword user_id:long code:string = Bool;
+		x.UInt(0x62980e08)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.String(m.GetCode())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaCheckRecoveryPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaCheckRecoveryPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x62980e08:
+		// twofa.checkRecoveryPassword user_id:long code:string = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Code = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaCheckRecoveryPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaRecoverPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaRecoverPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_recoverPassword))
+
+	switch uint32(m.Constructor) {
+	case 0x2da5c1db:
+		// twofa.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+		x.UInt(0x2da5c1db)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetNewSettings() != nil {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.String(m.GetCode())
+		if m.GetNewSettings() != nil {
+			x.Bytes(m.GetNewSettings().Encode(layer))
+		}
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaRecoverPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaRecoverPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x2da5c1db:
+		// twofa.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.Code = dBuf.String()
+		if (flags & (1 << 0)) != 0 {
+			m4 := &mtproto.Account_PasswordInputSettings{}
+			m4.Decode(dBuf)
+			m.NewSettings = m4
+		}
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaRecoverPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaSetPendingSignIn
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaSetPendingSignIn) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_setPendingSignIn))
+
+	switch uint32(m.Constructor) {
+	case 0xf61923f0:
+		// twofa.setPendingSignIn auth_key_id:long user_id:long = Bool;
+		x.UInt(0xf61923f0)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaSetPendingSignIn) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaSetPendingSignIn) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xf61923f0:
+		// twofa.setPendingSignIn auth_key_id:long user_id:long = Bool;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaSetPendingSignIn) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaGetPendingSignIn
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaGetPendingSignIn) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_getPendingSignIn))
+
+	switch uint32(m.Constructor) {
+	case 0xc0778da4:
+		// twofa.getPendingSignIn auth_key_id:long = Int64;
+		x.UInt(0xc0778da4)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaGetPendingSignIn) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaGetPendingSignIn) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc0778da4:
+		// twofa.getPendingSignIn auth_key_id:long = Int64;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaGetPendingSignIn) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLTwofaDeletePendingSignIn
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLTwofaDeletePendingSignIn) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_twofa_deletePendingSignIn))
+
+	switch uint32(m.Constructor) {
+	case 0x4a276234:
+		// twofa.deletePendingSignIn auth_key_id:long = Bool;
+		x.UInt(0x4a276234)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLTwofaDeletePendingSignIn) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLTwofaDeletePendingSignIn) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x4a276234:
+		// twofa.deletePendingSignIn auth_key_id:long = Bool;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLTwofaDeletePendingSignIn) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
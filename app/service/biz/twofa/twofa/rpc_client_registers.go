@@ -0,0 +1,61 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package twofa
+
+import (
+	"reflect"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+var _ *mtproto.Bool
+
+type newRPCReplyFunc func() interface{}
+
+type RPCContextTuple struct {
+	Method       string
+	NewReplyFunc newRPCReplyFunc
+}
+
+var rpcContextRegisters = map[string]RPCContextTuple{
+	"TLTwofaGetPassword":                RPCContextTuple{"/mtproto.RPCTwofa/twofa_getPassword", func() interface{} { return new(mtproto.Account_Password) }},
+	"TLTwofaGetPasswordSettings":        RPCContextTuple{"/mtproto.RPCTwofa/twofa_getPasswordSettings", func() interface{} { return new(mtproto.Account_PasswordSettings) }},
+	"TLTwofaUpdatePasswordSettings":     RPCContextTuple{"/mtproto.RPCTwofa/twofa_updatePasswordSettings", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaCheckPassword":              RPCContextTuple{"/mtproto.RPCTwofa/twofa_checkPassword", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaCheckSessionPasswordNeeded": RPCContextTuple{"/mtproto.RPCTwofa/twofa_checkSessionPasswordNeeded", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaConfirmPasswordEmail":       RPCContextTuple{"/mtproto.RPCTwofa/twofa_confirmPasswordEmail", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaResendPasswordEmail":        RPCContextTuple{"/mtproto.RPCTwofa/twofa_resendPasswordEmail", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaCancelPasswordEmail":        RPCContextTuple{"/mtproto.RPCTwofa/twofa_cancelPasswordEmail", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaRequestPasswordRecovery":    RPCContextTuple{"/mtproto.RPCTwofa/twofa_requestPasswordRecovery", func() interface{} { return new(mtproto.Auth_PasswordRecovery) }},
+	"TLTwofaCheckRecoveryPassword":      RPCContextTuple{"/mtproto.RPCTwofa/twofa_checkRecoveryPassword", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaRecoverPassword":            RPCContextTuple{"/mtproto.RPCTwofa/twofa_recoverPassword", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaSetPendingSignIn":           RPCContextTuple{"/mtproto.RPCTwofa/twofa_setPendingSignIn", func() interface{} { return new(mtproto.Bool) }},
+	"TLTwofaGetPendingSignIn":           RPCContextTuple{"/mtproto.RPCTwofa/twofa_getPendingSignIn", func() interface{} { return new(mtproto.Int64) }},
+	"TLTwofaDeletePendingSignIn":        RPCContextTuple{"/mtproto.RPCTwofa/twofa_deletePendingSignIn", func() interface{} { return new(mtproto.Bool) }},
+}
+
+func FindRPCContextTuple(t interface{}) *RPCContextTuple {
+	rt := reflect.TypeOf(t)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	m, ok := rpcContextRegisters[rt.Name()]
+	if !ok {
+		// log.Errorf("Can't find name: %s", rt.Name())
+		return nil
+	}
+	return &m
+}
+
+func GetRPCContextRegisters() map[string]RPCContextTuple {
+	return rpcContextRegisters
+}
@@ -0,0 +1,4390 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: twofa.tl.proto
+
+package twofa
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN                          TLConstructor = 0
+	CRC32_twofa_getPassword                TLConstructor = 1523577933
+	CRC32_twofa_getPasswordSettings        TLConstructor = 1857923106
+	CRC32_twofa_updatePasswordSettings     TLConstructor = -1855152771
+	CRC32_twofa_checkPassword              TLConstructor = 368323892
+	CRC32_twofa_checkSessionPasswordNeeded TLConstructor = -1954838614
+	CRC32_twofa_confirmPasswordEmail       TLConstructor = -658988556
+	CRC32_twofa_resendPasswordEmail        TLConstructor = -1934374233
+	CRC32_twofa_cancelPasswordEmail        TLConstructor = -1926183903
+	CRC32_twofa_requestPasswordRecovery    TLConstructor = 99653626
+	CRC32_twofa_checkRecoveryPassword      TLConstructor = 1654132232
+	CRC32_twofa_recoverPassword            TLConstructor = 765837787
+	CRC32_twofa_setPendingSignIn           TLConstructor = -166124560
+	CRC32_twofa_getPendingSignIn           TLConstructor = -1065906780
+	CRC32_twofa_deletePendingSignIn        TLConstructor = 1244095028
+)
+
+var TLConstructor_name = map[int32]string{
+	0:           "CRC32_UNKNOWN",
+	1523577933:  "CRC32_twofa_getPassword",
+	1857923106:  "CRC32_twofa_getPasswordSettings",
+	-1855152771: "CRC32_twofa_updatePasswordSettings",
+	368323892:   "CRC32_twofa_checkPassword",
+	-1954838614: "CRC32_twofa_checkSessionPasswordNeeded",
+	-658988556:  "CRC32_twofa_confirmPasswordEmail",
+	-1934374233: "CRC32_twofa_resendPasswordEmail",
+	-1926183903: "CRC32_twofa_cancelPasswordEmail",
+	99653626:    "CRC32_twofa_requestPasswordRecovery",
+	1654132232:  "CRC32_twofa_checkRecoveryPassword",
+	765837787:   "CRC32_twofa_recoverPassword",
+	-166124560:  "CRC32_twofa_setPendingSignIn",
+	-1065906780: "CRC32_twofa_getPendingSignIn",
+	1244095028:  "CRC32_twofa_deletePendingSignIn",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":                          0,
+	"CRC32_twofa_getPassword":                1523577933,
+	"CRC32_twofa_getPasswordSettings":        1857923106,
+	"CRC32_twofa_updatePasswordSettings":     -1855152771,
+	"CRC32_twofa_checkPassword":              368323892,
+	"CRC32_twofa_checkSessionPasswordNeeded": -1954838614,
+	"CRC32_twofa_confirmPasswordEmail":       -658988556,
+	"CRC32_twofa_resendPasswordEmail":        -1934374233,
+	"CRC32_twofa_cancelPasswordEmail":        -1926183903,
+	"CRC32_twofa_requestPasswordRecovery":    99653626,
+	"CRC32_twofa_checkRecoveryPassword":      1654132232,
+	"CRC32_twofa_recoverPassword":            765837787,
+	"CRC32_twofa_setPendingSignIn":           -166124560,
+	"CRC32_twofa_getPendingSignIn":           -1065906780,
+	"CRC32_twofa_deletePendingSignIn":        1244095028,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.getPassword user_id:long = account.Password;
+type TLTwofaGetPassword struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaGetPassword) Reset()         { *m = TLTwofaGetPassword{} }
+func (m *TLTwofaGetPassword) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaGetPassword) ProtoMessage()    {}
+func (*TLTwofaGetPassword) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{0}
+}
+func (m *TLTwofaGetPassword) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaGetPassword) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaGetPassword.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaGetPassword) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaGetPassword.Merge(m, src)
+}
+func (m *TLTwofaGetPassword) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaGetPassword) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaGetPassword.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaGetPassword proto.InternalMessageInfo
+
+func (m *TLTwofaGetPassword) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaGetPassword) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+type TLTwofaGetPasswordSettings struct {
+	Constructor          TLConstructor                  `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password             *mtproto.InputCheckPasswordSRP `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *TLTwofaGetPasswordSettings) Reset()         { *m = TLTwofaGetPasswordSettings{} }
+func (m *TLTwofaGetPasswordSettings) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaGetPasswordSettings) ProtoMessage()    {}
+func (*TLTwofaGetPasswordSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{1}
+}
+func (m *TLTwofaGetPasswordSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaGetPasswordSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaGetPasswordSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaGetPasswordSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaGetPasswordSettings.Merge(m, src)
+}
+func (m *TLTwofaGetPasswordSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaGetPasswordSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaGetPasswordSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaGetPasswordSettings proto.InternalMessageInfo
+
+func (m *TLTwofaGetPasswordSettings) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaGetPasswordSettings) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLTwofaGetPasswordSettings) GetPassword() *mtproto.InputCheckPasswordSRP {
+	if m != nil {
+		return m.Password
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+type TLTwofaUpdatePasswordSettings struct {
+	Constructor          TLConstructor                          `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password             *mtproto.InputCheckPasswordSRP         `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	NewSettings          *mtproto.Account_PasswordInputSettings `protobuf:"bytes,5,opt,name=new_settings,json=newSettings,proto3" json:"new_settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *TLTwofaUpdatePasswordSettings) Reset()         { *m = TLTwofaUpdatePasswordSettings{} }
+func (m *TLTwofaUpdatePasswordSettings) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaUpdatePasswordSettings) ProtoMessage()    {}
+func (*TLTwofaUpdatePasswordSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{2}
+}
+func (m *TLTwofaUpdatePasswordSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaUpdatePasswordSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaUpdatePasswordSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaUpdatePasswordSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaUpdatePasswordSettings.Merge(m, src)
+}
+func (m *TLTwofaUpdatePasswordSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaUpdatePasswordSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaUpdatePasswordSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaUpdatePasswordSettings proto.InternalMessageInfo
+
+func (m *TLTwofaUpdatePasswordSettings) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaUpdatePasswordSettings) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLTwofaUpdatePasswordSettings) GetPassword() *mtproto.InputCheckPasswordSRP {
+	if m != nil {
+		return m.Password
+	}
+	return nil
+}
+
+func (m *TLTwofaUpdatePasswordSettings) GetNewSettings() *mtproto.Account_PasswordInputSettings {
+	if m != nil {
+		return m.NewSettings
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+type TLTwofaCheckPassword struct {
+	Constructor          TLConstructor                  `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password             *mtproto.InputCheckPasswordSRP `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *TLTwofaCheckPassword) Reset()         { *m = TLTwofaCheckPassword{} }
+func (m *TLTwofaCheckPassword) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaCheckPassword) ProtoMessage()    {}
+func (*TLTwofaCheckPassword) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{3}
+}
+func (m *TLTwofaCheckPassword) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaCheckPassword) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaCheckPassword.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaCheckPassword) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaCheckPassword.Merge(m, src)
+}
+func (m *TLTwofaCheckPassword) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaCheckPassword) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaCheckPassword.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaCheckPassword proto.InternalMessageInfo
+
+func (m *TLTwofaCheckPassword) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaCheckPassword) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLTwofaCheckPassword) GetPassword() *mtproto.InputCheckPasswordSRP {
+	if m != nil {
+		return m.Password
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.checkSessionPasswordNeeded user_id:long = Bool;
+type TLTwofaCheckSessionPasswordNeeded struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) Reset()         { *m = TLTwofaCheckSessionPasswordNeeded{} }
+func (m *TLTwofaCheckSessionPasswordNeeded) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaCheckSessionPasswordNeeded) ProtoMessage()    {}
+func (*TLTwofaCheckSessionPasswordNeeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{4}
+}
+func (m *TLTwofaCheckSessionPasswordNeeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaCheckSessionPasswordNeeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaCheckSessionPasswordNeeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaCheckSessionPasswordNeeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaCheckSessionPasswordNeeded.Merge(m, src)
+}
+func (m *TLTwofaCheckSessionPasswordNeeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaCheckSessionPasswordNeeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaCheckSessionPasswordNeeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaCheckSessionPasswordNeeded proto.InternalMessageInfo
+
+func (m *TLTwofaCheckSessionPasswordNeeded) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.confirmPasswordEmail user_id:long code:string = Bool;
+type TLTwofaConfirmPasswordEmail struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code                 string        `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaConfirmPasswordEmail) Reset()         { *m = TLTwofaConfirmPasswordEmail{} }
+func (m *TLTwofaConfirmPasswordEmail) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaConfirmPasswordEmail) ProtoMessage()    {}
+func (*TLTwofaConfirmPasswordEmail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{5}
+}
+func (m *TLTwofaConfirmPasswordEmail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaConfirmPasswordEmail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaConfirmPasswordEmail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaConfirmPasswordEmail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaConfirmPasswordEmail.Merge(m, src)
+}
+func (m *TLTwofaConfirmPasswordEmail) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaConfirmPasswordEmail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaConfirmPasswordEmail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaConfirmPasswordEmail proto.InternalMessageInfo
+
+func (m *TLTwofaConfirmPasswordEmail) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaConfirmPasswordEmail) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLTwofaConfirmPasswordEmail) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.resendPasswordEmail user_id:long = Bool;
+type TLTwofaResendPasswordEmail struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaResendPasswordEmail) Reset()         { *m = TLTwofaResendPasswordEmail{} }
+func (m *TLTwofaResendPasswordEmail) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaResendPasswordEmail) ProtoMessage()    {}
+func (*TLTwofaResendPasswordEmail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{6}
+}
+func (m *TLTwofaResendPasswordEmail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaResendPasswordEmail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaResendPasswordEmail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaResendPasswordEmail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaResendPasswordEmail.Merge(m, src)
+}
+func (m *TLTwofaResendPasswordEmail) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaResendPasswordEmail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaResendPasswordEmail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaResendPasswordEmail proto.InternalMessageInfo
+
+func (m *TLTwofaResendPasswordEmail) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaResendPasswordEmail) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.cancelPasswordEmail user_id:long = Bool;
+type TLTwofaCancelPasswordEmail struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaCancelPasswordEmail) Reset()         { *m = TLTwofaCancelPasswordEmail{} }
+func (m *TLTwofaCancelPasswordEmail) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaCancelPasswordEmail) ProtoMessage()    {}
+func (*TLTwofaCancelPasswordEmail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{7}
+}
+func (m *TLTwofaCancelPasswordEmail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaCancelPasswordEmail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaCancelPasswordEmail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaCancelPasswordEmail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaCancelPasswordEmail.Merge(m, src)
+}
+func (m *TLTwofaCancelPasswordEmail) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaCancelPasswordEmail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaCancelPasswordEmail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaCancelPasswordEmail proto.InternalMessageInfo
+
+func (m *TLTwofaCancelPasswordEmail) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaCancelPasswordEmail) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+type TLTwofaRequestPasswordRecovery struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaRequestPasswordRecovery) Reset()         { *m = TLTwofaRequestPasswordRecovery{} }
+func (m *TLTwofaRequestPasswordRecovery) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaRequestPasswordRecovery) ProtoMessage()    {}
+func (*TLTwofaRequestPasswordRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{8}
+}
+func (m *TLTwofaRequestPasswordRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaRequestPasswordRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaRequestPasswordRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaRequestPasswordRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaRequestPasswordRecovery.Merge(m, src)
+}
+func (m *TLTwofaRequestPasswordRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaRequestPasswordRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaRequestPasswordRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaRequestPasswordRecovery proto.InternalMessageInfo
+
+func (m *TLTwofaRequestPasswordRecovery) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaRequestPasswordRecovery) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.checkRecoveryPassword user_id:long code:string = Bool;
+type TLTwofaCheckRecoveryPassword struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code                 string        `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaCheckRecoveryPassword) Reset()         { *m = TLTwofaCheckRecoveryPassword{} }
+func (m *TLTwofaCheckRecoveryPassword) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaCheckRecoveryPassword) ProtoMessage()    {}
+func (*TLTwofaCheckRecoveryPassword) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{9}
+}
+func (m *TLTwofaCheckRecoveryPassword) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaCheckRecoveryPassword) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaCheckRecoveryPassword.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaCheckRecoveryPassword) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaCheckRecoveryPassword.Merge(m, src)
+}
+func (m *TLTwofaCheckRecoveryPassword) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaCheckRecoveryPassword) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaCheckRecoveryPassword.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaCheckRecoveryPassword proto.InternalMessageInfo
+
+func (m *TLTwofaCheckRecoveryPassword) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaCheckRecoveryPassword) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLTwofaCheckRecoveryPassword) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+type TLTwofaRecoverPassword struct {
+	Constructor          TLConstructor                          `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code                 string                                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	NewSettings          *mtproto.Account_PasswordInputSettings `protobuf:"bytes,5,opt,name=new_settings,json=newSettings,proto3" json:"new_settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *TLTwofaRecoverPassword) Reset()         { *m = TLTwofaRecoverPassword{} }
+func (m *TLTwofaRecoverPassword) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaRecoverPassword) ProtoMessage()    {}
+func (*TLTwofaRecoverPassword) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{10}
+}
+func (m *TLTwofaRecoverPassword) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaRecoverPassword) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaRecoverPassword.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaRecoverPassword) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaRecoverPassword.Merge(m, src)
+}
+func (m *TLTwofaRecoverPassword) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaRecoverPassword) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaRecoverPassword.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaRecoverPassword proto.InternalMessageInfo
+
+func (m *TLTwofaRecoverPassword) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaRecoverPassword) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLTwofaRecoverPassword) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *TLTwofaRecoverPassword) GetNewSettings() *mtproto.Account_PasswordInputSettings {
+	if m != nil {
+		return m.NewSettings
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.setPendingSignIn auth_key_id:long user_id:long = Bool;
+type TLTwofaSetPendingSignIn struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaSetPendingSignIn) Reset()         { *m = TLTwofaSetPendingSignIn{} }
+func (m *TLTwofaSetPendingSignIn) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaSetPendingSignIn) ProtoMessage()    {}
+func (*TLTwofaSetPendingSignIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{11}
+}
+func (m *TLTwofaSetPendingSignIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaSetPendingSignIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaSetPendingSignIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaSetPendingSignIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaSetPendingSignIn.Merge(m, src)
+}
+func (m *TLTwofaSetPendingSignIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaSetPendingSignIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaSetPendingSignIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaSetPendingSignIn proto.InternalMessageInfo
+
+func (m *TLTwofaSetPendingSignIn) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaSetPendingSignIn) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLTwofaSetPendingSignIn) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.getPendingSignIn auth_key_id:long = Int64;
+type TLTwofaGetPendingSignIn struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaGetPendingSignIn) Reset()         { *m = TLTwofaGetPendingSignIn{} }
+func (m *TLTwofaGetPendingSignIn) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaGetPendingSignIn) ProtoMessage()    {}
+func (*TLTwofaGetPendingSignIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{12}
+}
+func (m *TLTwofaGetPendingSignIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaGetPendingSignIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaGetPendingSignIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaGetPendingSignIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaGetPendingSignIn.Merge(m, src)
+}
+func (m *TLTwofaGetPendingSignIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaGetPendingSignIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaGetPendingSignIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaGetPendingSignIn proto.InternalMessageInfo
+
+func (m *TLTwofaGetPendingSignIn) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaGetPendingSignIn) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// twofa.deletePendingSignIn auth_key_id:long = Bool;
+type TLTwofaDeletePendingSignIn struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=twofa.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLTwofaDeletePendingSignIn) Reset()         { *m = TLTwofaDeletePendingSignIn{} }
+func (m *TLTwofaDeletePendingSignIn) String() string { return proto.CompactTextString(m) }
+func (*TLTwofaDeletePendingSignIn) ProtoMessage()    {}
+func (*TLTwofaDeletePendingSignIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9a35352409b24a, []int{13}
+}
+func (m *TLTwofaDeletePendingSignIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLTwofaDeletePendingSignIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLTwofaDeletePendingSignIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLTwofaDeletePendingSignIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLTwofaDeletePendingSignIn.Merge(m, src)
+}
+func (m *TLTwofaDeletePendingSignIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLTwofaDeletePendingSignIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLTwofaDeletePendingSignIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLTwofaDeletePendingSignIn proto.InternalMessageInfo
+
+func (m *TLTwofaDeletePendingSignIn) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLTwofaDeletePendingSignIn) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("twofa.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*TLTwofaGetPassword)(nil), "twofa.TL_twofa_getPassword")
+	proto.RegisterType((*TLTwofaGetPasswordSettings)(nil), "twofa.TL_twofa_getPasswordSettings")
+	proto.RegisterType((*TLTwofaUpdatePasswordSettings)(nil), "twofa.TL_twofa_updatePasswordSettings")
+	proto.RegisterType((*TLTwofaCheckPassword)(nil), "twofa.TL_twofa_checkPassword")
+	proto.RegisterType((*TLTwofaCheckSessionPasswordNeeded)(nil), "twofa.TL_twofa_checkSessionPasswordNeeded")
+	proto.RegisterType((*TLTwofaConfirmPasswordEmail)(nil), "twofa.TL_twofa_confirmPasswordEmail")
+	proto.RegisterType((*TLTwofaResendPasswordEmail)(nil), "twofa.TL_twofa_resendPasswordEmail")
+	proto.RegisterType((*TLTwofaCancelPasswordEmail)(nil), "twofa.TL_twofa_cancelPasswordEmail")
+	proto.RegisterType((*TLTwofaRequestPasswordRecovery)(nil), "twofa.TL_twofa_requestPasswordRecovery")
+	proto.RegisterType((*TLTwofaCheckRecoveryPassword)(nil), "twofa.TL_twofa_checkRecoveryPassword")
+	proto.RegisterType((*TLTwofaRecoverPassword)(nil), "twofa.TL_twofa_recoverPassword")
+	proto.RegisterType((*TLTwofaSetPendingSignIn)(nil), "twofa.TL_twofa_setPendingSignIn")
+	proto.RegisterType((*TLTwofaGetPendingSignIn)(nil), "twofa.TL_twofa_getPendingSignIn")
+	proto.RegisterType((*TLTwofaDeletePendingSignIn)(nil), "twofa.TL_twofa_deletePendingSignIn")
+}
+
+func init() { proto.RegisterFile("twofa.tl.proto", fileDescriptor_ed9a35352409b24a) }
+
+var fileDescriptor_ed9a35352409b24a = []byte{
+	// 1055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x68, 0x24, 0xc5,
+	0x17, 0x9e, 0xfe, 0xed, 0x24, 0xbf, 0xcd, 0x8b, 0x09, 0xbd, 0x65, 0xcc, 0xce, 0x74, 0x92, 0xc9,
+	0xa4, 0xa3, 0xd9, 0x6c, 0x30, 0x33, 0x90, 0xc8, 0x1e, 0xbc, 0x08, 0x3b, 0xec, 0x61, 0x48, 0x8c,
+	0xd9, 0x49, 0x96, 0x05, 0x2f, 0x43, 0xa7, 0xbb, 0xd2, 0x69, 0x77, 0xa6, 0xab, 0xed, 0xae, 0xc9,
+	0x30, 0xde, 0x64, 0x15, 0x02, 0x0a, 0x5e, 0x14, 0x0f, 0xea, 0xc1, 0x7f, 0x08, 0x7a, 0x58, 0x91,
+	0x3d, 0x88, 0x17, 0xc1, 0x55, 0x14, 0x41, 0x8c, 0x17, 0x0f, 0x2e, 0xc8, 0x1a, 0x14, 0xf4, 0xa0,
+	0xec, 0x41, 0x65, 0x57, 0x5c, 0x46, 0xd2, 0x3d, 0xfd, 0xb7, 0x6a, 0x46, 0x90, 0x24, 0xec, 0x65,
+	0xe8, 0xea, 0xf7, 0xd5, 0xf7, 0x7d, 0x6f, 0xaa, 0xea, 0xd5, 0x6b, 0x18, 0xa6, 0x4d, 0xb2, 0xa9,
+	0x14, 0x68, 0xad, 0x60, 0xd9, 0x84, 0x12, 0xd4, 0xe7, 0x8e, 0xa5, 0x79, 0xdd, 0xa0, 0x5b, 0x8d,
+	0x8d, 0x82, 0x4a, 0xea, 0x45, 0x9d, 0xe8, 0xa4, 0xe8, 0x46, 0x37, 0x1a, 0x9b, 0xee, 0xc8, 0x1d,
+	0xb8, 0x4f, 0xde, 0x2c, 0x29, 0xa7, 0x13, 0xa2, 0xd7, 0x70, 0x88, 0x6a, 0xda, 0x8a, 0x65, 0x61,
+	0xdb, 0xe9, 0xc4, 0x25, 0x47, 0xdd, 0xc2, 0x75, 0x57, 0x46, 0x25, 0x36, 0xae, 0xd2, 0x96, 0x85,
+	0xfd, 0x58, 0x36, 0x8c, 0x51, 0x5b, 0x31, 0x1d, 0x8b, 0xd8, 0xb4, 0x13, 0x1a, 0x09, 0x43, 0x4e,
+	0xcb, 0x54, 0xbd, 0xb7, 0xb2, 0x0e, 0x23, 0xeb, 0xcb, 0x55, 0xd7, 0x67, 0x55, 0xc7, 0x74, 0x55,
+	0x71, 0x9c, 0x26, 0xb1, 0x35, 0x74, 0x06, 0x06, 0x55, 0x62, 0x3a, 0xd4, 0x6e, 0xa8, 0x94, 0xd8,
+	0x19, 0x21, 0x2f, 0xcc, 0x0e, 0x2f, 0x8c, 0x14, 0xbc, 0x04, 0xd7, 0x97, 0x4b, 0x61, 0xac, 0x12,
+	0x05, 0xa2, 0x93, 0xf0, 0xff, 0x86, 0x83, 0xed, 0xaa, 0xa1, 0x65, 0x8e, 0xe5, 0x85, 0xd9, 0x63,
+	0x95, 0xfe, 0xfd, 0x61, 0x59, 0x93, 0xdf, 0x13, 0x60, 0x9c, 0xa7, 0xb4, 0x86, 0x29, 0x35, 0x4c,
+	0xdd, 0x39, 0x70, 0x45, 0xf4, 0x30, 0x1c, 0xb7, 0x3a, 0x22, 0x99, 0x74, 0x5e, 0x98, 0x1d, 0x5c,
+	0xc8, 0x15, 0xea, 0xd4, 0x4d, 0xbb, 0x50, 0x36, 0xad, 0x06, 0x2d, 0x6d, 0x61, 0xf5, 0x52, 0xe0,
+	0xa3, 0xb2, 0x5a, 0x09, 0xf0, 0xf2, 0x6d, 0x01, 0x26, 0x03, 0xb7, 0x0d, 0x4b, 0x53, 0x28, 0xbe,
+	0xab, 0x0d, 0xa3, 0x32, 0xdc, 0x63, 0xe2, 0x66, 0xd5, 0xe9, 0x98, 0xcb, 0xf4, 0xb9, 0xf3, 0x67,
+	0x82, 0xf9, 0x8a, 0xaa, 0x92, 0x86, 0x49, 0xab, 0xfe, 0x6c, 0x97, 0xcf, 0x4f, 0xa5, 0x32, 0x68,
+	0xe2, 0xa6, 0x3f, 0x90, 0xdf, 0x16, 0x60, 0x34, 0xc8, 0x5d, 0x8d, 0x4a, 0xde, 0x5d, 0x6b, 0xb4,
+	0x0d, 0xd3, 0x71, 0x9b, 0x6b, 0xd8, 0x71, 0x0c, 0x62, 0xfa, 0xe8, 0x15, 0x8c, 0x35, 0x7c, 0x08,
+	0x3b, 0xf9, 0x19, 0x01, 0x26, 0x42, 0x61, 0x62, 0x6e, 0x1a, 0x76, 0xdd, 0xd7, 0x3c, 0x57, 0x57,
+	0x8c, 0xda, 0xc1, 0xff, 0x4d, 0x08, 0xd2, 0x2a, 0xd1, 0xb0, 0xfb, 0x17, 0x0d, 0x54, 0xdc, 0x67,
+	0x99, 0x44, 0xce, 0x93, 0x8d, 0x1d, 0x6c, 0x6a, 0x87, 0x6b, 0x22, 0x26, 0xa8, 0x2a, 0xa6, 0x8a,
+	0x6b, 0x87, 0x2c, 0xe8, 0x40, 0x3e, 0x92, 0xe1, 0x93, 0x0d, 0xec, 0x04, 0x55, 0xa3, 0x82, 0x55,
+	0xb2, 0x8d, 0xed, 0xd6, 0xc1, 0x8b, 0x3e, 0x2b, 0x40, 0x2e, 0xbe, 0xad, 0x7c, 0xad, 0xc3, 0x3b,
+	0x05, 0xbc, 0xe5, 0xfd, 0x52, 0x80, 0x4c, 0x24, 0x7b, 0xd7, 0xc2, 0x91, 0x3a, 0x38, 0xc8, 0x92,
+	0xf2, 0xbc, 0x00, 0xd9, 0x20, 0x19, 0x07, 0xd3, 0x55, 0x6c, 0x6a, 0x86, 0xa9, 0xaf, 0x19, 0xba,
+	0x59, 0x36, 0xff, 0x73, 0x36, 0x39, 0x18, 0x54, 0x1a, 0x74, 0xab, 0x7a, 0x09, 0xb7, 0xc2, 0x8c,
+	0x06, 0xf6, 0x5f, 0x2d, 0xe1, 0x56, 0x59, 0x8b, 0x66, 0x9b, 0x4e, 0x6c, 0xac, 0x6c, 0xec, 0x2a,
+	0x3a, 0x0a, 0x37, 0xf2, 0x76, 0xe4, 0xf8, 0x68, 0xb8, 0x86, 0x29, 0x3e, 0x12, 0xdd, 0xb9, 0xa7,
+	0xfb, 0x60, 0x28, 0x36, 0x1d, 0x9d, 0x80, 0xa1, 0x52, 0xa5, 0xb4, 0xb8, 0x50, 0xbd, 0xb0, 0xb2,
+	0xb4, 0xf2, 0xd8, 0xc5, 0x15, 0x31, 0x85, 0x26, 0xe1, 0xa4, 0xf7, 0x8a, 0xb9, 0x9f, 0xc5, 0xaf,
+	0x7e, 0xfe, 0xf8, 0xdb, 0x3e, 0x74, 0x0a, 0x26, 0xbb, 0x00, 0xfc, 0x45, 0x16, 0xdf, 0xd8, 0xfd,
+	0xf3, 0x8f, 0x7e, 0x54, 0x04, 0x39, 0x0a, 0xe4, 0xdf, 0x9d, 0xe2, 0x9d, 0x8f, 0x3e, 0x78, 0xee,
+	0x76, 0xbb, 0xdd, 0x6e, 0x0b, 0x68, 0x0a, 0xb2, 0xd1, 0x09, 0xb1, 0x0b, 0x47, 0xbc, 0xfa, 0xdd,
+	0xee, 0x15, 0x01, 0x2d, 0xc2, 0x0c, 0x03, 0xe1, 0x16, 0x7b, 0xf1, 0xdd, 0x17, 0x7e, 0xbb, 0x7e,
+	0xcb, 0xe3, 0x9d, 0x87, 0x7c, 0x6c, 0x12, 0xa7, 0x50, 0x8b, 0xbf, 0x5f, 0xdb, 0xfb, 0xec, 0x8e,
+	0x07, 0x7f, 0x30, 0x9e, 0x20, 0xa7, 0xa2, 0x8a, 0xef, 0xbc, 0xf4, 0xf5, 0x4f, 0xb7, 0xb8, 0x68,
+	0x4e, 0x39, 0x14, 0x5f, 0xdf, 0xb9, 0xf6, 0x4b, 0x07, 0x3d, 0x07, 0xd3, 0x71, 0x6e, 0x6e, 0x2d,
+	0x13, 0xff, 0xba, 0xf2, 0x49, 0x11, 0x9d, 0x86, 0x29, 0x26, 0xd7, 0x64, 0x05, 0x12, 0x77, 0x5e,
+	0xbd, 0xf1, 0x62, 0x3f, 0x9a, 0x86, 0xb1, 0x38, 0x6d, 0xac, 0x48, 0x88, 0xd7, 0x2f, 0xbf, 0xfc,
+	0xeb, 0xff, 0xd0, 0x69, 0x18, 0x8f, 0x82, 0x92, 0x87, 0x4f, 0xbc, 0xf9, 0xf9, 0x8f, 0xef, 0xb7,
+	0x3d, 0x9b, 0x09, 0x68, 0xf2, 0x64, 0x88, 0x6f, 0xbd, 0xf2, 0xfd, 0xe5, 0xbf, 0x3d, 0x68, 0x62,
+	0x3b, 0x70, 0xf6, 0xb3, 0x78, 0xf5, 0xd3, 0xd7, 0xbe, 0x49, 0x4b, 0xe9, 0x9d, 0x37, 0x73, 0xa9,
+	0x85, 0x0f, 0x07, 0xe0, 0x78, 0x65, 0xb5, 0xb4, 0xbe, 0x0f, 0x46, 0x8f, 0xc2, 0x09, 0xb6, 0xdf,
+	0x1c, 0x0b, 0x36, 0x3a, 0xbb, 0xc3, 0xa4, 0x6c, 0xd7, 0x9a, 0x23, 0xa7, 0x10, 0x86, 0x6c, 0xf7,
+	0xa6, 0x72, 0xba, 0x07, 0xad, 0x0f, 0x92, 0xa6, 0xba, 0xd2, 0x07, 0x05, 0x2c, 0x85, 0x2e, 0xc2,
+	0x78, 0xcf, 0x6e, 0x70, 0x26, 0xa9, 0xc4, 0xc7, 0x49, 0x43, 0x81, 0xd8, 0x59, 0x42, 0x6a, 0x72,
+	0x0a, 0x9d, 0x83, 0x7b, 0x79, 0xad, 0xd6, 0x44, 0x92, 0x2f, 0x16, 0x66, 0x69, 0xaa, 0x90, 0xff,
+	0xd7, 0x56, 0x68, 0x8e, 0xcb, 0xc9, 0xc5, 0xb2, 0x02, 0x6b, 0x20, 0xf5, 0x68, 0x79, 0xee, 0x67,
+	0xa8, 0x39, 0x28, 0x96, 0xf4, 0x3c, 0x64, 0xbb, 0x9e, 0x37, 0x76, 0xf1, 0x38, 0xa0, 0x1e, 0x94,
+	0xbc, 0x1e, 0x85, 0xa1, 0xe4, 0x80, 0x58, 0xca, 0x27, 0x60, 0xa2, 0x77, 0x17, 0x72, 0x8a, 0x75,
+	0xca, 0x05, 0x4a, 0x61, 0x77, 0xeb, 0xd6, 0xec, 0x64, 0x5c, 0x4e, 0xa1, 0x0b, 0x30, 0xd6, 0xab,
+	0xf7, 0x78, 0x80, 0xbb, 0x84, 0x49, 0x18, 0x9b, 0x42, 0x19, 0xee, 0xe3, 0xb7, 0x12, 0x93, 0xac,
+	0xf5, 0x18, 0x80, 0xa5, 0x5a, 0x82, 0xd1, 0x2e, 0x17, 0x79, 0x3e, 0xc9, 0x95, 0x44, 0xb0, 0x64,
+	0xcb, 0x30, 0x1a, 0x1e, 0xcc, 0xde, 0x64, 0x49, 0x84, 0x34, 0x1c, 0xf9, 0x54, 0xa0, 0x67, 0x1e,
+	0x8a, 0xae, 0x3d, 0xef, 0x82, 0x65, 0xd6, 0x9e, 0x03, 0x62, 0x0c, 0x9e, 0x3d, 0x7f, 0xf3, 0x87,
+	0x9c, 0xf0, 0xc5, 0x5e, 0x4e, 0xd8, 0xdd, 0xcb, 0x09, 0x37, 0xf6, 0x72, 0xc2, 0xe3, 0x8f, 0x44,
+	0x3e, 0xe7, 0x29, 0x56, 0xea, 0xba, 0xad, 0x84, 0x0f, 0xf3, 0x0e, 0xb6, 0xb7, 0xb1, 0x5d, 0x54,
+	0x2c, 0xab, 0xb8, 0xff, 0x68, 0xa8, 0xb8, 0xb8, 0x61, 0x3c, 0x55, 0x74, 0x15, 0xbd, 0xdf, 0x8d,
+	0x7e, 0x57, 0x60, 0xf1, 0x9f, 0x01, 0x00, 0x8c, 0x27, 0x96, 0x2f, 0x2f, 0x10, 0x00, 0x00,
+}
+
+func (this *TLTwofaGetPassword) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&twofa.TLTwofaGetPassword{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaGetPasswordSettings) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&twofa.TLTwofaGetPasswordSettings{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Password != nil {
+		s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaUpdatePasswordSettings) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&twofa.TLTwofaUpdatePasswordSettings{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Password != nil {
+		s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
+	}
+	if this.NewSettings != nil {
+		s = append(s, "NewSettings: "+fmt.Sprintf("%#v", this.NewSettings)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaCheckPassword) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&twofa.TLTwofaCheckPassword{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Password != nil {
+		s = append(s, "Password: "+fmt.Sprintf("%#v", this.Password)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaCheckSessionPasswordNeeded) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&twofa.TLTwofaCheckSessionPasswordNeeded{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaConfirmPasswordEmail) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&twofa.TLTwofaConfirmPasswordEmail{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaResendPasswordEmail) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&twofa.TLTwofaResendPasswordEmail{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaCancelPasswordEmail) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&twofa.TLTwofaCancelPasswordEmail{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaRequestPasswordRecovery) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&twofa.TLTwofaRequestPasswordRecovery{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaCheckRecoveryPassword) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&twofa.TLTwofaCheckRecoveryPassword{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaRecoverPassword) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&twofa.TLTwofaRecoverPassword{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	if this.NewSettings != nil {
+		s = append(s, "NewSettings: "+fmt.Sprintf("%#v", this.NewSettings)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaSetPendingSignIn) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&twofa.TLTwofaSetPendingSignIn{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaGetPendingSignIn) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&twofa.TLTwofaGetPendingSignIn{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLTwofaDeletePendingSignIn) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&twofa.TLTwofaDeletePendingSignIn{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTwofaTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCTwofaClient is the client API for RPCTwofa service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCTwofaClient interface {
+	// twofa.getPassword user_id:long = account.Password;
+	TwofaGetPassword(ctx context.Context, in *TLTwofaGetPassword, opts ...grpc.CallOption) (*mtproto.Account_Password, error)
+	// twofa.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+	TwofaGetPasswordSettings(ctx context.Context, in *TLTwofaGetPasswordSettings, opts ...grpc.CallOption) (*mtproto.Account_PasswordSettings, error)
+	// twofa.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+	TwofaUpdatePasswordSettings(ctx context.Context, in *TLTwofaUpdatePasswordSettings, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+	TwofaCheckPassword(ctx context.Context, in *TLTwofaCheckPassword, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.checkSessionPasswordNeeded user_id:long = Bool;
+	TwofaCheckSessionPasswordNeeded(ctx context.Context, in *TLTwofaCheckSessionPasswordNeeded, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.confirmPasswordEmail user_id:long code:string = Bool;
+	TwofaConfirmPasswordEmail(ctx context.Context, in *TLTwofaConfirmPasswordEmail, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.resendPasswordEmail user_id:long = Bool;
+	TwofaResendPasswordEmail(ctx context.Context, in *TLTwofaResendPasswordEmail, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.cancelPasswordEmail user_id:long = Bool;
+	TwofaCancelPasswordEmail(ctx context.Context, in *TLTwofaCancelPasswordEmail, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+	TwofaRequestPasswordRecovery(ctx context.Context, in *TLTwofaRequestPasswordRecovery, opts ...grpc.CallOption) (*mtproto.Auth_PasswordRecovery, error)
+	// twofa.checkRecoveryPassword user_id:long code:string = Bool;
+	TwofaCheckRecoveryPassword(ctx context.Context, in *TLTwofaCheckRecoveryPassword, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+	TwofaRecoverPassword(ctx context.Context, in *TLTwofaRecoverPassword, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.setPendingSignIn auth_key_id:long user_id:long = Bool;
+	TwofaSetPendingSignIn(ctx context.Context, in *TLTwofaSetPendingSignIn, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// twofa.getPendingSignIn auth_key_id:long = Int64;
+	TwofaGetPendingSignIn(ctx context.Context, in *TLTwofaGetPendingSignIn, opts ...grpc.CallOption) (*mtproto.Int64, error)
+	// twofa.deletePendingSignIn auth_key_id:long = Bool;
+	TwofaDeletePendingSignIn(ctx context.Context, in *TLTwofaDeletePendingSignIn, opts ...grpc.CallOption) (*mtproto.Bool, error)
+}
+
+type rPCTwofaClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCTwofaClient(cc *grpc.ClientConn) RPCTwofaClient {
+	return &rPCTwofaClient{cc}
+}
+
+func (c *rPCTwofaClient) TwofaGetPassword(ctx context.Context, in *TLTwofaGetPassword, opts ...grpc.CallOption) (*mtproto.Account_Password, error) {
+	out := new(mtproto.Account_Password)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_getPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaGetPasswordSettings(ctx context.Context, in *TLTwofaGetPasswordSettings, opts ...grpc.CallOption) (*mtproto.Account_PasswordSettings, error) {
+	out := new(mtproto.Account_PasswordSettings)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_getPasswordSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaUpdatePasswordSettings(ctx context.Context, in *TLTwofaUpdatePasswordSettings, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_updatePasswordSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaCheckPassword(ctx context.Context, in *TLTwofaCheckPassword, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_checkPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaCheckSessionPasswordNeeded(ctx context.Context, in *TLTwofaCheckSessionPasswordNeeded, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_checkSessionPasswordNeeded", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaConfirmPasswordEmail(ctx context.Context, in *TLTwofaConfirmPasswordEmail, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_confirmPasswordEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaResendPasswordEmail(ctx context.Context, in *TLTwofaResendPasswordEmail, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_resendPasswordEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaCancelPasswordEmail(ctx context.Context, in *TLTwofaCancelPasswordEmail, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_cancelPasswordEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaRequestPasswordRecovery(ctx context.Context, in *TLTwofaRequestPasswordRecovery, opts ...grpc.CallOption) (*mtproto.Auth_PasswordRecovery, error) {
+	out := new(mtproto.Auth_PasswordRecovery)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_requestPasswordRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaCheckRecoveryPassword(ctx context.Context, in *TLTwofaCheckRecoveryPassword, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_checkRecoveryPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaRecoverPassword(ctx context.Context, in *TLTwofaRecoverPassword, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_recoverPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaSetPendingSignIn(ctx context.Context, in *TLTwofaSetPendingSignIn, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_setPendingSignIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaGetPendingSignIn(ctx context.Context, in *TLTwofaGetPendingSignIn, opts ...grpc.CallOption) (*mtproto.Int64, error) {
+	out := new(mtproto.Int64)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_getPendingSignIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCTwofaClient) TwofaDeletePendingSignIn(ctx context.Context, in *TLTwofaDeletePendingSignIn, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/twofa.RPCTwofa/twofa_deletePendingSignIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCTwofaServer is the server API for RPCTwofa service.
+type RPCTwofaServer interface {
+	// twofa.getPassword user_id:long = account.Password;
+	TwofaGetPassword(context.Context, *TLTwofaGetPassword) (*mtproto.Account_Password, error)
+	// twofa.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+	TwofaGetPasswordSettings(context.Context, *TLTwofaGetPasswordSettings) (*mtproto.Account_PasswordSettings, error)
+	// twofa.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+	TwofaUpdatePasswordSettings(context.Context, *TLTwofaUpdatePasswordSettings) (*mtproto.Bool, error)
+	// twofa.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+	TwofaCheckPassword(context.Context, *TLTwofaCheckPassword) (*mtproto.Bool, error)
+	// twofa.checkSessionPasswordNeeded user_id:long = Bool;
+	TwofaCheckSessionPasswordNeeded(context.Context, *TLTwofaCheckSessionPasswordNeeded) (*mtproto.Bool, error)
+	// twofa.confirmPasswordEmail user_id:long code:string = Bool;
+	TwofaConfirmPasswordEmail(context.Context, *TLTwofaConfirmPasswordEmail) (*mtproto.Bool, error)
+	// twofa.resendPasswordEmail user_id:long = Bool;
+	TwofaResendPasswordEmail(context.Context, *TLTwofaResendPasswordEmail) (*mtproto.Bool, error)
+	// twofa.cancelPasswordEmail user_id:long = Bool;
+	TwofaCancelPasswordEmail(context.Context, *TLTwofaCancelPasswordEmail) (*mtproto.Bool, error)
+	// twofa.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+	TwofaRequestPasswordRecovery(context.Context, *TLTwofaRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error)
+	// twofa.checkRecoveryPassword user_id:long code:string = Bool;
+	TwofaCheckRecoveryPassword(context.Context, *TLTwofaCheckRecoveryPassword) (*mtproto.Bool, error)
+	// twofa.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+	TwofaRecoverPassword(context.Context, *TLTwofaRecoverPassword) (*mtproto.Bool, error)
+	// twofa.setPendingSignIn auth_key_id:long user_id:long = Bool;
+	TwofaSetPendingSignIn(context.Context, *TLTwofaSetPendingSignIn) (*mtproto.Bool, error)
+	// twofa.getPendingSignIn auth_key_id:long = Int64;
+	TwofaGetPendingSignIn(context.Context, *TLTwofaGetPendingSignIn) (*mtproto.Int64, error)
+	// twofa.deletePendingSignIn auth_key_id:long = Bool;
+	TwofaDeletePendingSignIn(context.Context, *TLTwofaDeletePendingSignIn) (*mtproto.Bool, error)
+}
+
+// UnimplementedRPCTwofaServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCTwofaServer struct {
+}
+
+func (*UnimplementedRPCTwofaServer) TwofaGetPassword(ctx context.Context, req *TLTwofaGetPassword) (*mtproto.Account_Password, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaGetPassword not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaGetPasswordSettings(ctx context.Context, req *TLTwofaGetPasswordSettings) (*mtproto.Account_PasswordSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaGetPasswordSettings not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaUpdatePasswordSettings(ctx context.Context, req *TLTwofaUpdatePasswordSettings) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaUpdatePasswordSettings not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaCheckPassword(ctx context.Context, req *TLTwofaCheckPassword) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaCheckPassword not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaCheckSessionPasswordNeeded(ctx context.Context, req *TLTwofaCheckSessionPasswordNeeded) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaCheckSessionPasswordNeeded not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaConfirmPasswordEmail(ctx context.Context, req *TLTwofaConfirmPasswordEmail) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaConfirmPasswordEmail not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaResendPasswordEmail(ctx context.Context, req *TLTwofaResendPasswordEmail) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaResendPasswordEmail not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaCancelPasswordEmail(ctx context.Context, req *TLTwofaCancelPasswordEmail) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaCancelPasswordEmail not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaRequestPasswordRecovery(ctx context.Context, req *TLTwofaRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaRequestPasswordRecovery not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaCheckRecoveryPassword(ctx context.Context, req *TLTwofaCheckRecoveryPassword) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaCheckRecoveryPassword not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaRecoverPassword(ctx context.Context, req *TLTwofaRecoverPassword) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaRecoverPassword not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaSetPendingSignIn(ctx context.Context, req *TLTwofaSetPendingSignIn) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaSetPendingSignIn not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaGetPendingSignIn(ctx context.Context, req *TLTwofaGetPendingSignIn) (*mtproto.Int64, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaGetPendingSignIn not implemented")
+}
+func (*UnimplementedRPCTwofaServer) TwofaDeletePendingSignIn(ctx context.Context, req *TLTwofaDeletePendingSignIn) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwofaDeletePendingSignIn not implemented")
+}
+
+func RegisterRPCTwofaServer(s *grpc.Server, srv RPCTwofaServer) {
+	s.RegisterService(&_RPCTwofa_serviceDesc, srv)
+}
+
+func _RPCTwofa_TwofaGetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaGetPassword)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaGetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaGetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaGetPassword(ctx, req.(*TLTwofaGetPassword))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaGetPasswordSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaGetPasswordSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaGetPasswordSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaGetPasswordSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaGetPasswordSettings(ctx, req.(*TLTwofaGetPasswordSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaUpdatePasswordSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaUpdatePasswordSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaUpdatePasswordSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaUpdatePasswordSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaUpdatePasswordSettings(ctx, req.(*TLTwofaUpdatePasswordSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaCheckPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaCheckPassword)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaCheckPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaCheckPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaCheckPassword(ctx, req.(*TLTwofaCheckPassword))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaCheckSessionPasswordNeeded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaCheckSessionPasswordNeeded)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaCheckSessionPasswordNeeded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaCheckSessionPasswordNeeded",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaCheckSessionPasswordNeeded(ctx, req.(*TLTwofaCheckSessionPasswordNeeded))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaConfirmPasswordEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaConfirmPasswordEmail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaConfirmPasswordEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaConfirmPasswordEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaConfirmPasswordEmail(ctx, req.(*TLTwofaConfirmPasswordEmail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaResendPasswordEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaResendPasswordEmail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaResendPasswordEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaResendPasswordEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaResendPasswordEmail(ctx, req.(*TLTwofaResendPasswordEmail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaCancelPasswordEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaCancelPasswordEmail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaCancelPasswordEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaCancelPasswordEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaCancelPasswordEmail(ctx, req.(*TLTwofaCancelPasswordEmail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaRequestPasswordRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaRequestPasswordRecovery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaRequestPasswordRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaRequestPasswordRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaRequestPasswordRecovery(ctx, req.(*TLTwofaRequestPasswordRecovery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaCheckRecoveryPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaCheckRecoveryPassword)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaCheckRecoveryPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaCheckRecoveryPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaCheckRecoveryPassword(ctx, req.(*TLTwofaCheckRecoveryPassword))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaRecoverPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaRecoverPassword)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaRecoverPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaRecoverPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaRecoverPassword(ctx, req.(*TLTwofaRecoverPassword))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaSetPendingSignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaSetPendingSignIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaSetPendingSignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaSetPendingSignIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaSetPendingSignIn(ctx, req.(*TLTwofaSetPendingSignIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaGetPendingSignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaGetPendingSignIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaGetPendingSignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaGetPendingSignIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaGetPendingSignIn(ctx, req.(*TLTwofaGetPendingSignIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCTwofa_TwofaDeletePendingSignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLTwofaDeletePendingSignIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCTwofaServer).TwofaDeletePendingSignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twofa.RPCTwofa/TwofaDeletePendingSignIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCTwofaServer).TwofaDeletePendingSignIn(ctx, req.(*TLTwofaDeletePendingSignIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCTwofa_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "twofa.RPCTwofa",
+	HandlerType: (*RPCTwofaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "twofa_getPassword",
+			Handler:    _RPCTwofa_TwofaGetPassword_Handler,
+		},
+		{
+			MethodName: "twofa_getPasswordSettings",
+			Handler:    _RPCTwofa_TwofaGetPasswordSettings_Handler,
+		},
+		{
+			MethodName: "twofa_updatePasswordSettings",
+			Handler:    _RPCTwofa_TwofaUpdatePasswordSettings_Handler,
+		},
+		{
+			MethodName: "twofa_checkPassword",
+			Handler:    _RPCTwofa_TwofaCheckPassword_Handler,
+		},
+		{
+			MethodName: "twofa_checkSessionPasswordNeeded",
+			Handler:    _RPCTwofa_TwofaCheckSessionPasswordNeeded_Handler,
+		},
+		{
+			MethodName: "twofa_confirmPasswordEmail",
+			Handler:    _RPCTwofa_TwofaConfirmPasswordEmail_Handler,
+		},
+		{
+			MethodName: "twofa_resendPasswordEmail",
+			Handler:    _RPCTwofa_TwofaResendPasswordEmail_Handler,
+		},
+		{
+			MethodName: "twofa_cancelPasswordEmail",
+			Handler:    _RPCTwofa_TwofaCancelPasswordEmail_Handler,
+		},
+		{
+			MethodName: "twofa_requestPasswordRecovery",
+			Handler:    _RPCTwofa_TwofaRequestPasswordRecovery_Handler,
+		},
+		{
+			MethodName: "twofa_checkRecoveryPassword",
+			Handler:    _RPCTwofa_TwofaCheckRecoveryPassword_Handler,
+		},
+		{
+			MethodName: "twofa_recoverPassword",
+			Handler:    _RPCTwofa_TwofaRecoverPassword_Handler,
+		},
+		{
+			MethodName: "twofa_setPendingSignIn",
+			Handler:    _RPCTwofa_TwofaSetPendingSignIn_Handler,
+		},
+		{
+			MethodName: "twofa_getPendingSignIn",
+			Handler:    _RPCTwofa_TwofaGetPendingSignIn_Handler,
+		},
+		{
+			MethodName: "twofa_deletePendingSignIn",
+			Handler:    _RPCTwofa_TwofaDeletePendingSignIn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "twofa.tl.proto",
+}
+
+func (m *TLTwofaGetPassword) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaGetPassword) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaGetPassword) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaGetPasswordSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaGetPasswordSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaGetPasswordSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Password != nil {
+		{
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwofaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaUpdatePasswordSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaUpdatePasswordSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaUpdatePasswordSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NewSettings != nil {
+		{
+			size, err := m.NewSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwofaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Password != nil {
+		{
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwofaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaCheckPassword) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaCheckPassword) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaCheckPassword) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Password != nil {
+		{
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwofaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaConfirmPasswordEmail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaConfirmPasswordEmail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaConfirmPasswordEmail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTwofaTl(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaResendPasswordEmail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaResendPasswordEmail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaResendPasswordEmail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaCancelPasswordEmail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaCancelPasswordEmail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaCancelPasswordEmail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaRequestPasswordRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaRequestPasswordRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaRequestPasswordRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaCheckRecoveryPassword) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaCheckRecoveryPassword) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaCheckRecoveryPassword) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTwofaTl(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaRecoverPassword) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaRecoverPassword) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaRecoverPassword) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NewSettings != nil {
+		{
+			size, err := m.NewSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwofaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTwofaTl(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaSetPendingSignIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaSetPendingSignIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaSetPendingSignIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaGetPendingSignIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaGetPendingSignIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaGetPendingSignIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLTwofaDeletePendingSignIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLTwofaDeletePendingSignIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLTwofaDeletePendingSignIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintTwofaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwofaTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwofaTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TLTwofaGetPassword) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaGetPasswordSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovTwofaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaUpdatePasswordSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovTwofaTl(uint64(l))
+	}
+	if m.NewSettings != nil {
+		l = m.NewSettings.Size()
+		n += 1 + l + sovTwofaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaCheckPassword) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovTwofaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaCheckSessionPasswordNeeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaConfirmPasswordEmail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTwofaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaResendPasswordEmail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaCancelPasswordEmail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaRequestPasswordRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaCheckRecoveryPassword) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTwofaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaRecoverPassword) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTwofaTl(uint64(l))
+	}
+	if m.NewSettings != nil {
+		l = m.NewSettings.Size()
+		n += 1 + l + sovTwofaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaSetPendingSignIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.AuthKeyId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaGetPendingSignIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLTwofaDeletePendingSignIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovTwofaTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovTwofaTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTwofaTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwofaTl(x uint64) (n int) {
+	return sovTwofaTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TLTwofaGetPassword) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_getPassword: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_getPassword: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaGetPasswordSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_getPasswordSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_getPasswordSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &mtproto.InputCheckPasswordSRP{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaUpdatePasswordSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_updatePasswordSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_updatePasswordSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &mtproto.InputCheckPasswordSRP{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewSettings == nil {
+				m.NewSettings = &mtproto.Account_PasswordInputSettings{}
+			}
+			if err := m.NewSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaCheckPassword) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_checkPassword: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_checkPassword: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &mtproto.InputCheckPasswordSRP{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaCheckSessionPasswordNeeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_checkSessionPasswordNeeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_checkSessionPasswordNeeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaConfirmPasswordEmail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_confirmPasswordEmail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_confirmPasswordEmail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaResendPasswordEmail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_resendPasswordEmail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_resendPasswordEmail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaCancelPasswordEmail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_cancelPasswordEmail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_cancelPasswordEmail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaRequestPasswordRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_requestPasswordRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_requestPasswordRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaCheckRecoveryPassword) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_checkRecoveryPassword: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_checkRecoveryPassword: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaRecoverPassword) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_recoverPassword: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_recoverPassword: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewSettings == nil {
+				m.NewSettings = &mtproto.Account_PasswordInputSettings{}
+			}
+			if err := m.NewSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaSetPendingSignIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_setPendingSignIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_setPendingSignIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaGetPendingSignIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_getPendingSignIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_getPendingSignIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLTwofaDeletePendingSignIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_twofa_deletePendingSignIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_twofa_deletePendingSignIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwofaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwofaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwofaTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwofaTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwofaTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwofaTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwofaTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwofaTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwofaTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwofaTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwofaTl = fmt.Errorf("proto: unexpected end of group")
+)
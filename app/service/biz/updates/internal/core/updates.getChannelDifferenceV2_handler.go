@@ -10,18 +10,70 @@
 package core
 
 import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/updates/internal/dal/dataobject"
 	"github.com/teamgram/teamgram-server/app/service/biz/updates/updates"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
 )
 
 // UpdatesGetChannelDifferenceV2
 // updates.getChannelDifferenceV2 auth_key_id:long user_id:long channel_id:long pts:int limit:int = ChannelDifference;
 func (c *UpdatesCore) UpdatesGetChannelDifferenceV2(in *updates.TLUpdatesGetChannelDifferenceV2) (*updates.ChannelDifference, error) {
-	c.Logger.Errorf("updates.getChannelDifferenceV2 blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	limit := in.Limit
+	// check limit
+	if limit <= 0 || limit > 1000 {
+		limit = 1000
+	}
+
+	var (
+		lastPts      = in.Pts
+		newMessages  = make([]*mtproto.Message, 0)
+		otherUpdates = make([]*mtproto.Update, 0)
+	)
+
+	doList, err := c.svcCtx.Dao.ChannelPtsUpdatesDAO.SelectByGtPts(c.ctx, in.ChannelId, in.Pts, limit)
+	if err != nil {
+		c.Logger.Errorf("updates.getChannelDifferenceV2 - error: %v", err)
+		return nil, err
+	}
+
+	for i := 0; i < len(doList); i++ {
+		update := c.makeChannelPtsUpdate(&doList[i])
+		if update == nil {
+			continue
+		}
+		if doList[i].Pts > lastPts {
+			lastPts = doList[i].Pts
+		}
+
+		switch update.PredicateName {
+		case mtproto.Predicate_updateNewChannelMessage:
+			newMessages = append(newMessages, update.Message_MESSAGE)
+		default:
+			otherUpdates = append(otherUpdates, update)
+		}
+	}
 
 	return updates.MakeTLChannelDifference(&updates.ChannelDifference{
-		Final:        false,
-		Pts:          in.Pts,
-		NewMessages:  nil,
-		OtherUpdates: nil,
+		Final:        len(doList) < int(limit),
+		Pts:          lastPts,
+		NewMessages:  newMessages,
+		OtherUpdates: otherUpdates,
 	}).To_ChannelDifference(), nil
 }
+
+func (c *UpdatesCore) makeChannelPtsUpdate(do *dataobject.ChannelPtsUpdatesDO) *mtproto.Update {
+	update := &mtproto.Update{}
+	err := jsonx.UnmarshalFromString(do.UpdateData, update)
+	if err != nil {
+		c.Logger.Errorf("unmarshal channel pts's update(%d - %d) error: %v", do.ChannelId, do.Pts, err)
+		return nil
+	}
+	if mtproto.GetUpdateType(update) != do.UpdateType {
+		c.Logger.Errorf("update data error.")
+		return nil
+	}
+
+	return update.FixData()
+}
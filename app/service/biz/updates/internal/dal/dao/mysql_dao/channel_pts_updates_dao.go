@@ -0,0 +1,101 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/updates/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ChannelPtsUpdatesDAO struct {
+	db *sqlx.DB
+}
+
+func NewChannelPtsUpdatesDAO(db *sqlx.DB) *ChannelPtsUpdatesDAO {
+	return &ChannelPtsUpdatesDAO{db}
+}
+
+// SelectLastPts
+// select pts from channel_pts_updates where channel_id = :channel_id order by pts desc limit 1
+// TODO(@benqi): sqlmap
+func (dao *ChannelPtsUpdatesDAO) SelectLastPts(ctx context.Context, channel_id int64) (rValue *dataobject.ChannelPtsUpdatesDO, err error) {
+	var (
+		query = "select pts from channel_pts_updates where channel_id = ? order by pts desc limit 1"
+		do    = &dataobject.ChannelPtsUpdatesDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, channel_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectLastPts(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectByGtPts
+// select channel_id, pts, pts_count, update_type, update_data, date2 from channel_pts_updates where channel_id = :channel_id and pts > :pts order by pts limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelPtsUpdatesDAO) SelectByGtPts(ctx context.Context, channel_id int64, pts int32, limit int32) (rList []dataobject.ChannelPtsUpdatesDO, err error) {
+	var (
+		query  = "select channel_id, pts, pts_count, update_type, update_data, date2 from channel_pts_updates where channel_id = ? and pts > ? order by pts limit ?"
+		values []dataobject.ChannelPtsUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, pts, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByGtPts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByGtPtsWithCB
+// select channel_id, pts, pts_count, update_type, update_data, date2 from channel_pts_updates where channel_id = :channel_id and pts > :pts order by pts limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelPtsUpdatesDAO) SelectByGtPtsWithCB(ctx context.Context, channel_id int64, pts int32, limit int32, cb func(i int, v *dataobject.ChannelPtsUpdatesDO)) (rList []dataobject.ChannelPtsUpdatesDO, err error) {
+	var (
+		query  = "select channel_id, pts, pts_count, update_type, update_data, date2 from channel_pts_updates where channel_id = ? and pts > ? order by pts limit ?"
+		values []dataobject.ChannelPtsUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, pts, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByGtPts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
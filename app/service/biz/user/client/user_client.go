@@ -94,6 +94,12 @@ type UserClient interface {
 	UserUpdateBotData(ctx context.Context, in *user.TLUserUpdateBotData) (*mtproto.Bool, error)
 	UserGetImmutableUserV2(ctx context.Context, in *user.TLUserGetImmutableUserV2) (*mtproto.ImmutableUser, error)
 	UserGetMutableUsersV2(ctx context.Context, in *user.TLUserGetMutableUsersV2) (*mtproto.MutableUsers, error)
+	UserCreateBot(ctx context.Context, in *user.TLUserCreateBot) (*mtproto.ImmutableUser, error)
+	UserGetBotIdByToken(ctx context.Context, in *user.TLUserGetBotIdByToken) (*mtproto.Int64, error)
+	UserGetBotsByCreator(ctx context.Context, in *user.TLUserGetBotsByCreator) (*user.Vector_Long, error)
+	UserResetBotToken(ctx context.Context, in *user.TLUserResetBotToken) (*mtproto.String, error)
+	UserSetDefaultHistoryTTL(ctx context.Context, in *user.TLUserSetDefaultHistoryTTL) (*mtproto.Bool, error)
+	UserGetDefaultHistoryTTL(ctx context.Context, in *user.TLUserGetDefaultHistoryTTL) (*mtproto.DefaultHistoryTTL, error)
 }
 
 type defaultUserClient struct {
@@ -609,3 +615,45 @@ func (m *defaultUserClient) UserGetMutableUsersV2(ctx context.Context, in *user.
 	client := user.NewRPCUserClient(m.cli.Conn())
 	return client.UserGetMutableUsersV2(ctx, in)
 }
+
+// UserCreateBot
+// user.createBot creator_user_id:long first_name:string username:string = ImmutableUser;
+func (m *defaultUserClient) UserCreateBot(ctx context.Context, in *user.TLUserCreateBot) (*mtproto.ImmutableUser, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserCreateBot(ctx, in)
+}
+
+// UserGetBotIdByToken
+// user.getBotIdByToken token:string = Int64;
+func (m *defaultUserClient) UserGetBotIdByToken(ctx context.Context, in *user.TLUserGetBotIdByToken) (*mtproto.Int64, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserGetBotIdByToken(ctx, in)
+}
+
+// UserGetBotsByCreator
+// user.getBotsByCreator creator_user_id:long = Vector<long>;
+func (m *defaultUserClient) UserGetBotsByCreator(ctx context.Context, in *user.TLUserGetBotsByCreator) (*user.Vector_Long, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserGetBotsByCreator(ctx, in)
+}
+
+// UserResetBotToken
+// user.resetBotToken bot_id:long = String;
+func (m *defaultUserClient) UserResetBotToken(ctx context.Context, in *user.TLUserResetBotToken) (*mtproto.String, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserResetBotToken(ctx, in)
+}
+
+// UserSetDefaultHistoryTTL
+// user.setDefaultHistoryTTL user_id:long ttl:int = Bool;
+func (m *defaultUserClient) UserSetDefaultHistoryTTL(ctx context.Context, in *user.TLUserSetDefaultHistoryTTL) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserSetDefaultHistoryTTL(ctx, in)
+}
+
+// UserGetDefaultHistoryTTL
+// user.getDefaultHistoryTTL user_id:long = DefaultHistoryTTL;
+func (m *defaultUserClient) UserGetDefaultHistoryTTL(ctx context.Context, in *user.TLUserGetDefaultHistoryTTL) (*mtproto.DefaultHistoryTTL, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserGetDefaultHistoryTTL(ctx, in)
+}
@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserCreateBot
+// user.createBot creator_user_id:long first_name:string username:string = ImmutableUser;
+func (c *UserCore) UserCreateBot(in *user.TLUserCreateBot) (*mtproto.ImmutableUser, error) {
+	bot, err := c.svcCtx.Dao.CreateBot(c.ctx, in.GetCreatorUserId(), in.GetFirstName(), in.GetUsername())
+	if err != nil {
+		c.Logger.Errorf("user.createBot - error: %v", err)
+		return nil, err
+	}
+
+	return bot, nil
+}
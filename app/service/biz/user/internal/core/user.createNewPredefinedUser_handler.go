@@ -11,14 +11,25 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
 	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // UserCreateNewPredefinedUser
 // user.createNewPredefinedUser flags:# phone:string first_name:string last_name:flags.0?string username:string code:string verified:flags.1?true = PredefinedUser;
 func (c *UserCore) UserCreateNewPredefinedUser(in *user.TLUserCreateNewPredefinedUser) (*mtproto.PredefinedUser, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.createNewPredefinedUser - error: method UserCreateNewPredefinedUser not impl")
+	predefinedUser, err := c.svcCtx.Dao.CreateNewPredefinedUser(c.ctx, &dataobject.PredefinedUsersDO{
+		Phone:     in.GetPhone(),
+		FirstName: in.GetFirstName(),
+		LastName:  in.GetLastName().GetValue(),
+		Username:  in.GetUsername(),
+		Code:      in.GetCode(),
+		Verified:  in.GetVerified(),
+	})
+	if err != nil {
+		c.Logger.Errorf("user.createNewPredefinedUser - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return predefinedUser, nil
 }
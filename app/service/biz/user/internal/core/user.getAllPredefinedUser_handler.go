@@ -10,17 +10,19 @@
 package core
 
 import (
-	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // UserGetAllPredefinedUser
 // user.getAllPredefinedUser = Vector<PredefinedUser>;
 func (c *UserCore) UserGetAllPredefinedUser(in *user.TLUserGetAllPredefinedUser) (*user.Vector_PredefinedUser, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.getAllPredefinedUser - error: method UserGetAllPredefinedUser not impl")
+	predefinedUsers, err := c.svcCtx.Dao.GetAllPredefinedUser(c.ctx)
+	if err != nil {
+		c.Logger.Errorf("user.getAllPredefinedUser - error: %v", err)
+		return nil, err
+	}
 
 	return &user.Vector_PredefinedUser{
-		Datas: []*mtproto.PredefinedUser{},
+		Datas: predefinedUsers,
 	}, nil
 }
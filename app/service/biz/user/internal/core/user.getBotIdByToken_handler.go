@@ -0,0 +1,29 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserGetBotIdByToken
+// user.getBotIdByToken token:string = Int64;
+func (c *UserCore) UserGetBotIdByToken(in *user.TLUserGetBotIdByToken) (*mtproto.Int64, error) {
+	botId, err := c.svcCtx.Dao.GetBotIdByToken(c.ctx, in.GetToken())
+	if err != nil {
+		c.Logger.Errorf("user.getBotIdByToken - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLInt64(&mtproto.Int64{
+		V: botId,
+	}).To_Int64(), nil
+}
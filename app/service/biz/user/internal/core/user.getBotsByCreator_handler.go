@@ -0,0 +1,28 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserGetBotsByCreator
+// user.getBotsByCreator creator_user_id:long = Vector<long>;
+func (c *UserCore) UserGetBotsByCreator(in *user.TLUserGetBotsByCreator) (*user.Vector_Long, error) {
+	idList, err := c.svcCtx.Dao.BotsDAO.SelectBotIdListByCreator(c.ctx, in.GetCreatorUserId())
+	if err != nil {
+		c.Logger.Errorf("user.getBotsByCreator - error: %v", err)
+		return nil, err
+	}
+
+	return &user.Vector_Long{
+		Datas: idList,
+	}, nil
+}
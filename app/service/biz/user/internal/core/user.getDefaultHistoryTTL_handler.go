@@ -0,0 +1,37 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserGetDefaultHistoryTTL
+// user.getDefaultHistoryTTL user_id:long = DefaultHistoryTTL;
+func (c *UserCore) UserGetDefaultHistoryTTL(in *user.TLUserGetDefaultHistoryTTL) (*mtproto.DefaultHistoryTTL, error) {
+	var (
+		period int32
+	)
+
+	if do, err := c.svcCtx.Dao.UserSettingsDAO.SelectByKey(c.ctx, in.UserId, "defaultHistoryTTL"); err != nil {
+		c.Logger.Errorf("user.getDefaultHistoryTTL - error: %v", err)
+		return nil, err
+	} else if do != nil {
+		v, _ := strconv.Atoi(do.Value)
+		period = int32(v)
+	}
+
+	return mtproto.MakeTLDefaultHistoryTTL(&mtproto.DefaultHistoryTTL{
+		Period: period,
+	}).To_DefaultHistoryTTL(), nil
+}
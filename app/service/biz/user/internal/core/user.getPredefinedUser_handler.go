@@ -17,8 +17,11 @@ import (
 // UserGetPredefinedUser
 // user.getPredefinedUser phone:string = PredefinedUser;
 func (c *UserCore) UserGetPredefinedUser(in *user.TLUserGetPredefinedUser) (*mtproto.PredefinedUser, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.getPredefinedUser - error: method UserGetPredefinedUser not impl")
+	predefinedUser, err := c.svcCtx.Dao.GetPredefinedUser(c.ctx, in.GetPhone())
+	if err != nil {
+		c.Logger.Errorf("user.getPredefinedUser - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return predefinedUser, nil
 }
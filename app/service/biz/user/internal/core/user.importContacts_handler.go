@@ -58,14 +58,19 @@ func (c *UserCore) UserImportContacts(in *user.TLUserImportContacts) (*user.User
 	// clear phoneList
 	// phoneList = phoneList[0:0]
 	for i := 0; i < len(registeredContacts); i++ {
-		if c2, ok := importContacts[registeredContacts[i].Phone]; ok {
-			c2.Unregistered = false
-			c2.UserId = registeredContacts[i].Id
-			phoneList = append(phoneList, registeredContacts[i].Phone)
-			contactIdList = append(contactIdList, registeredContacts[i].Id)
-		} else {
-			c2.Unregistered = true
+		c2, ok := importContacts[registeredContacts[i].Phone]
+		if !ok {
+			continue
+		}
+		if registeredContacts[i].Id == in.UserId || !c.checkAddedByPhone(registeredContacts[i].Id, in.UserId) {
+			// neither imported nor invited, so the phone number is not disclosed
+			delete(importContacts, registeredContacts[i].Phone)
+			continue
 		}
+		c2.Unregistered = false
+		c2.UserId = registeredContacts[i].Id
+		phoneList = append(phoneList, registeredContacts[i].Phone)
+		contactIdList = append(contactIdList, registeredContacts[i].Id)
 	}
 
 	if len(contactIdList) > 0 {
@@ -97,16 +102,14 @@ func (c *UserCore) UserImportContacts(in *user.TLUserImportContacts) (*user.User
 	phoneList = phoneList[0:0]
 	for _, c2 := range importContacts {
 		if c2.Unregistered {
-			go func() {
-				// 1. 未注册 - popular inviter
-				unregisteredContactsDO := &dataobject.UnregisteredContactsDO{
-					Phone:           c2.C.Phone,
-					ImporterUserId:  in.UserId,
-					ImportFirstName: c2.C.FirstName,
-					ImportLastName:  c2.C.LastName,
-				}
-				c.svcCtx.Dao.UnregisteredContactsDAO.InsertOrUpdate(c.ctx, unregisteredContactsDO)
-			}()
+			// 1. 未注册 - popular inviter, importers are notified by auth.signUp
+			unregisteredContactsDO := &dataobject.UnregisteredContactsDO{
+				Phone:           c2.C.Phone,
+				ImporterUserId:  in.UserId,
+				ImportFirstName: c2.C.FirstName,
+				ImportLastName:  c2.C.LastName,
+			}
+			c.svcCtx.Dao.UnregisteredContactsDAO.InsertOrUpdate(c.ctx, unregisteredContactsDO)
 
 			//popularContactsDO := &dataobject.PopularContactsDO{
 			//	Phone:     c2.c.Phone,
@@ -208,3 +211,26 @@ func (c *UserCore) UserImportContacts(in *user.TLUserImportContacts) (*user.User
 
 	return rImportContacts, nil
 }
+
+// checkAddedByPhone checks whether userId can be found by importerId through its phone number.
+func (c *UserCore) checkAddedByPhone(userId, importerId int64) bool {
+	rules, err := c.svcCtx.Dao.GetUserPrivacyRules(c.ctx, userId, user.ADDED_BY_PHONE)
+	if err != nil {
+		c.Logger.Errorf("checkAddedByPhone - error: %v", err)
+		return false
+	} else if len(rules.GetRules()) == 0 {
+		return true
+	}
+
+	return user.CheckPrivacyIsAllow(
+		userId,
+		rules.GetRules(),
+		importerId,
+		func(id, checkId int64) bool {
+			return c.svcCtx.Dao.GetUserContact(c.ctx, id, checkId) != nil
+		},
+		func(checkId int64, idList []int64) bool {
+			// chat participants are unknown here
+			return false
+		})
+}
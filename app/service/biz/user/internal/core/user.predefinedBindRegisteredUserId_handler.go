@@ -17,8 +17,13 @@ import (
 // UserPredefinedBindRegisteredUserId
 // user.predefinedBindRegisteredUserId phone:string registered_userId:int = Bool;
 func (c *UserCore) UserPredefinedBindRegisteredUserId(in *user.TLUserPredefinedBindRegisteredUserId) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.predefinedBindRegisteredUserId - error: method UserPredefinedBindRegisteredUserId not impl")
+	_, err := c.svcCtx.Dao.UpdatePredefinedUser(c.ctx, in.GetPhone(), map[string]interface{}{
+		"registered_user_id": in.GetRegisteredUserId(),
+	})
+	if err != nil {
+		c.Logger.Errorf("user.predefinedBindRegisteredUserId - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return mtproto.BoolTrue, nil
 }
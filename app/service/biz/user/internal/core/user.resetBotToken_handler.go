@@ -0,0 +1,29 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserResetBotToken
+// user.resetBotToken bot_id:long = String;
+func (c *UserCore) UserResetBotToken(in *user.TLUserResetBotToken) (*mtproto.String, error) {
+	token, err := c.svcCtx.Dao.ResetBotToken(c.ctx, in.GetBotId())
+	if err != nil {
+		c.Logger.Errorf("user.resetBotToken - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLString(&mtproto.String{
+		V: token,
+	}).To_String(), nil
+}
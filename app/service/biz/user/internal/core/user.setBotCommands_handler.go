@@ -17,8 +17,10 @@ import (
 // UserSetBotCommands
 // user.setBotCommands user_id:long bot_id:long commands:Vector<BotCommand> = Bool;
 func (c *UserCore) UserSetBotCommands(in *user.TLUserSetBotCommands) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.setBotCommands - error: method UserSetBotCommands not impl")
+	if err := c.svcCtx.Dao.SetBotCommands(c.ctx, in.GetBotId(), in.GetCommands()); err != nil {
+		c.Logger.Errorf("user.setBotCommands - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return mtproto.BoolTrue, nil
 }
@@ -0,0 +1,34 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserSetDefaultHistoryTTL
+// user.setDefaultHistoryTTL user_id:long ttl:int = Bool;
+func (c *UserCore) UserSetDefaultHistoryTTL(in *user.TLUserSetDefaultHistoryTTL) (*mtproto.Bool, error) {
+	_, _, err := c.svcCtx.Dao.UserSettingsDAO.InsertOrUpdate(c.ctx, &dataobject.UserSettingsDO{
+		UserId: in.UserId,
+		Key2:   "defaultHistoryTTL",
+		Value:  strconv.Itoa(int(in.Ttl)),
+	})
+	if err != nil {
+		c.Logger.Errorf("user.setDefaultHistoryTTL - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -19,15 +19,30 @@
 package core
 
 import (
-	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // UserUpdateBotData
 // user.updateBotData flags:# user_id:long bot_chat_history:flags.15?Bool bot_nochats:flags.16?Bool bot_inline_geo:flags.21?Bool bot_attach_menu:flags.27?Bool bot_inline_placeholder:flags.19?Bool = Bool;
 func (c *UserCore) UserUpdateBotData(in *user.TLUserUpdateBotData) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.updateBotData blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	// bot_attach_menu and bot_inline_placeholder have no column in bots yet
+	cMap := make(map[string]interface{})
+	if in.BotChatHistory != nil {
+		cMap["bot_chat_history"] = mtproto.FromBool(in.BotChatHistory)
+	}
+	if in.BotNochats != nil {
+		cMap["bot_nochats"] = mtproto.FromBool(in.BotNochats)
+	}
+	if in.BotInlineGeo != nil {
+		cMap["bot_inline_geo"] = mtproto.FromBool(in.BotInlineGeo)
+	}
+
+	updated, err := c.svcCtx.Dao.UpdateBotData(c.ctx, in.GetBotId(), cMap)
+	if err != nil {
+		c.Logger.Errorf("user.updateBotData - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	return mtproto.ToBool(updated), nil
 }
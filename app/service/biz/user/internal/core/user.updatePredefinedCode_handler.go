@@ -17,8 +17,13 @@ import (
 // UserUpdatePredefinedCode
 // user.updatePredefinedCode phone:string code:string = PredefinedUser;
 func (c *UserCore) UserUpdatePredefinedCode(in *user.TLUserUpdatePredefinedCode) (*mtproto.PredefinedUser, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.updatePredefinedCode - error: method UserUpdatePredefinedCode not impl")
+	predefinedUser, err := c.svcCtx.Dao.UpdatePredefinedUser(c.ctx, in.GetPhone(), map[string]interface{}{
+		"code": in.GetCode(),
+	})
+	if err != nil {
+		c.Logger.Errorf("user.updatePredefinedCode - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return predefinedUser, nil
 }
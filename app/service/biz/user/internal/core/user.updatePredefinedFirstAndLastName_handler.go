@@ -17,8 +17,14 @@ import (
 // UserUpdatePredefinedFirstAndLastName
 // user.updatePredefinedFirstAndLastName flags:# phone:string first_name:string last_name:flags.0?string = PredefinedUser;
 func (c *UserCore) UserUpdatePredefinedFirstAndLastName(in *user.TLUserUpdatePredefinedFirstAndLastName) (*mtproto.PredefinedUser, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.updatePredefinedFirstAndLastName - error: method UserUpdatePredefinedFirstAndLastName not impl")
+	predefinedUser, err := c.svcCtx.Dao.UpdatePredefinedUser(c.ctx, in.GetPhone(), map[string]interface{}{
+		"first_name": in.GetFirstName(),
+		"last_name":  in.GetLastName().GetValue(),
+	})
+	if err != nil {
+		c.Logger.Errorf("user.updatePredefinedFirstAndLastName - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return predefinedUser, nil
 }
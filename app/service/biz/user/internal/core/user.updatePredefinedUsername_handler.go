@@ -17,8 +17,13 @@ import (
 // UserUpdatePredefinedUsername
 // user.updatePredefinedUsername flags:# phone:string username:flags.1?string = PredefinedUser;
 func (c *UserCore) UserUpdatePredefinedUsername(in *user.TLUserUpdatePredefinedUsername) (*mtproto.PredefinedUser, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.updatePredefinedUsername - error: method UserUpdatePredefinedUsername not impl")
+	predefinedUser, err := c.svcCtx.Dao.UpdatePredefinedUser(c.ctx, in.GetPhone(), map[string]interface{}{
+		"username": in.GetUsername().GetValue(),
+	})
+	if err != nil {
+		c.Logger.Errorf("user.updatePredefinedUsername - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return predefinedUser, nil
 }
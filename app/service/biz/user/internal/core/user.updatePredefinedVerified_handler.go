@@ -17,8 +17,13 @@ import (
 // UserUpdatePredefinedVerified
 // user.updatePredefinedVerified flags:# phone:string verified:flags.1?true = PredefinedUser;
 func (c *UserCore) UserUpdatePredefinedVerified(in *user.TLUserUpdatePredefinedVerified) (*mtproto.PredefinedUser, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.updatePredefinedVerified - error: method UserUpdatePredefinedVerified not impl")
+	predefinedUser, err := c.svcCtx.Dao.UpdatePredefinedUser(c.ctx, in.GetPhone(), map[string]interface{}{
+		"verified": in.GetVerified(),
+	})
+	if err != nil {
+		c.Logger.Errorf("user.updatePredefinedVerified - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return predefinedUser, nil
 }
@@ -32,6 +32,62 @@ func NewBotsDAO(db *sqlx.DB) *BotsDAO {
 	return &BotsDAO{db}
 }
 
+// Insert
+// insert into bots(bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder) values (:bot_id, :bot_type, :creator_user_id, :token, :description, :bot_chat_history, :bot_nochats, :bot_inline_geo, :bot_info_version, :bot_inline_placeholder)
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) Insert(ctx context.Context, do *dataobject.BotsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into bots(bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder) values (:bot_id, :bot_type, :creator_user_id, :token, :description, :bot_chat_history, :bot_nochats, :bot_inline_geo, :bot_info_version, :bot_inline_placeholder)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into bots(bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder) values (:bot_id, :bot_type, :creator_user_id, :token, :description, :bot_chat_history, :bot_nochats, :bot_inline_geo, :bot_info_version, :bot_inline_placeholder)
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.BotsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into bots(bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder) values (:bot_id, :bot_type, :creator_user_id, :token, :description, :bot_chat_history, :bot_nochats, :bot_inline_geo, :bot_info_version, :bot_inline_placeholder)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
 // Select
 // select id, bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder from bots where bot_id = :bot_id
 // TODO(@benqi): sqlmap
@@ -75,6 +131,40 @@ func (dao *BotsDAO) SelectByToken(ctx context.Context, token string) (rValue int
 	return
 }
 
+// SelectBotIdListByCreator
+// select bot_id from bots where creator_user_id = :creator_user_id order by bot_id asc
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) SelectBotIdListByCreator(ctx context.Context, creator_user_id int64) (rList []int64, err error) {
+	var query = "select bot_id from bots where creator_user_id = ? order by bot_id asc"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, creator_user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectBotIdListByCreator(_), error: %v", err)
+	}
+
+	return
+}
+
+// SelectBotIdListByCreatorWithCB
+// select bot_id from bots where creator_user_id = :creator_user_id order by bot_id asc
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) SelectBotIdListByCreatorWithCB(ctx context.Context, creator_user_id int64, cb func(i int, v int64)) (rList []int64, err error) {
+	var query = "select bot_id from bots where creator_user_id = ? order by bot_id asc"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, creator_user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectBotIdListByCreator(_), error: %v", err)
+	}
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, rList[i])
+		}
+	}
+
+	return
+}
+
 // SelectByIdList
 // select id, bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder from bots where bot_id in (:id_list)
 // TODO(@benqi): sqlmap
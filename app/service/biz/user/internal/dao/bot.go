@@ -0,0 +1,216 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	mrand "math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	botTokenSecretLen = 26 // 35 chars base64url, same shape as telegram tokens
+)
+
+// genBotToken returns a token of the form "<bot_id>:<secret>".
+func genBotToken(botId int64) (string, error) {
+	secret := make([]byte, botTokenSecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(botId, 10) + ":" + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// ParseBotToken returns the bot id part of token, 0 if token is malformed.
+func ParseBotToken(token string) int64 {
+	idx := strings.IndexByte(token, ':')
+	if idx <= 0 || idx == len(token)-1 {
+		return 0
+	}
+	id, _ := strconv.ParseInt(token[:idx], 10, 64)
+	return id
+}
+
+func (d *Dao) CreateBot(ctx context.Context, creatorId int64, firstName, username string) (*mtproto.ImmutableUser, error) {
+	var (
+		accessHash = mrand.Int63()
+		botId      int64
+		token      string
+	)
+
+	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		// users.phone is unique and not null, bots have no phone, so a
+		// placeholder derived from access_hash is stored and hidden by makeUserDataByDO.
+		botId, _, result.Err = d.UsersDAO.InsertTx(tx, &dataobject.UsersDO{
+			UserType:       user.UserTypeBot,
+			AccessHash:     accessHash,
+			FirstName:      firstName,
+			Username:       username,
+			Phone:          fmt.Sprintf("bot%d", accessHash),
+			AccountDaysTtl: 180,
+			IsBot:          true,
+		})
+		if result.Err != nil {
+			return
+		}
+
+		token, result.Err = genBotToken(botId)
+		if result.Err != nil {
+			return
+		}
+
+		_, _, result.Err = d.BotsDAO.InsertTx(tx, &dataobject.BotsDO{
+			BotId:          botId,
+			BotType:        0,
+			CreatorUserId:  creatorId,
+			Token:          token,
+			BotInfoVersion: 1,
+		})
+	})
+	if tR.Err != nil {
+		logx.WithContext(ctx).Errorf("createBot - error: %v", tR.Err)
+		return nil, tR.Err
+	}
+
+	return d.GetImmutableUser(ctx, botId, false)
+}
+
+func (d *Dao) GetBotIdByToken(ctx context.Context, token string) (int64, error) {
+	id := ParseBotToken(token)
+	if id == 0 {
+		return 0, mtproto.ErrAccessTokenInvalid
+	}
+
+	botId, err := d.BotsDAO.SelectByToken(ctx, token)
+	if err != nil {
+		return 0, err
+	} else if botId != id {
+		return 0, mtproto.ErrAccessTokenInvalid
+	}
+
+	return botId, nil
+}
+
+// ResetBotToken revokes the current token of botId, sessions already authorized stay valid.
+func (d *Dao) ResetBotToken(ctx context.Context, botId int64) (string, error) {
+	token, err := genBotToken(botId)
+	if err != nil {
+		return "", err
+	}
+
+	_, _, err = d.CachedConn.Exec(
+		ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			rowsAffected, err := d.BotsDAO.Update(ctx, map[string]interface{}{
+				"token": token,
+			}, botId)
+			if err != nil {
+				return 0, 0, err
+			} else if rowsAffected == 0 {
+				return 0, 0, mtproto.ErrBotInvalid
+			}
+
+			return 0, rowsAffected, nil
+		},
+		genCacheUserDataCacheKey(botId))
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// SetBotCommands replaces the commands of botId and bumps bot_info_version so
+// clients refetch the bot info.
+func (d *Dao) SetBotCommands(ctx context.Context, botId int64, commands []*mtproto.BotCommand) error {
+	botDO, err := d.BotsDAO.Select(ctx, botId)
+	if err != nil {
+		return err
+	} else if botDO == nil {
+		return mtproto.ErrBotInvalid
+	}
+
+	doList := make([]*dataobject.BotCommandsDO, 0, len(commands))
+	for _, cmd := range commands {
+		doList = append(doList, &dataobject.BotCommandsDO{
+			BotId:       botId,
+			Command:     cmd.GetCommand(),
+			Description: cmd.GetDescription(),
+		})
+	}
+
+	_, _, err = d.CachedConn.Exec(
+		ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			tR := sqlx.TxWrapper(ctx, conn, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				_, result.Err = d.BotCommandsDAO.DeleteTx(tx, botId)
+				if result.Err != nil {
+					return
+				}
+				_, _, result.Err = d.BotCommandsDAO.InsertBulkTx(tx, doList)
+				if result.Err != nil {
+					return
+				}
+				_, result.Err = d.BotsDAO.UpdateTx(tx, map[string]interface{}{
+					"bot_info_version": botDO.BotInfoVersion + 1,
+				}, botId)
+			})
+
+			return 0, 0, tR.Err
+		},
+		genCacheUserDataCacheKey(botId))
+
+	return err
+}
+
+// UpdateBotData updates the bot flags in cMap (bots columns).
+func (d *Dao) UpdateBotData(ctx context.Context, botId int64, cMap map[string]interface{}) (bool, error) {
+	if len(cMap) == 0 {
+		return false, nil
+	}
+
+	_, rowsAffected, err := d.CachedConn.Exec(
+		ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			rowsAffected, err := d.BotsDAO.Update(ctx, cMap, botId)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			return 0, rowsAffected, nil
+		},
+		genCacheUserDataCacheKey(botId))
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected != 0, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"testing"
+)
+
+func TestParseBotToken(t *testing.T) {
+	cases := []struct {
+		token string
+		want  int64
+	}{
+		{"136817688:AAHbkjvBm6mZpPT8qpt0dv0SYTrrwzv5CF4", 136817688},
+		{"136817688:", 0},
+		{":AAHbkjvBm6mZpPT8qpt0dv0SYTrrwzv5CF4", 0},
+		{"bot:AAHbkjvBm6mZpPT8qpt0dv0SYTrrwzv5CF4", 0},
+		{"136817688", 0},
+		{"", 0},
+	}
+
+	for _, c := range cases {
+		if got := ParseBotToken(c.token); got != c.want {
+			t.Errorf("ParseBotToken(%q) = %d, want %d", c.token, got, c.want)
+		}
+	}
+}
+
+func TestGenBotToken(t *testing.T) {
+	token, err := genBotToken(136817688)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ParseBotToken(token); got != 136817688 {
+		t.Errorf("ParseBotToken(%q) = %d, want %d", token, got, 136817688)
+	}
+	if len(token) != len("136817688:")+35 {
+		t.Errorf("genBotToken() = %q, unexpected length %d", token, len(token))
+	}
+
+	token2, _ := genBotToken(136817688)
+	if token == token2 {
+		t.Errorf("genBotToken() returned the same token twice: %q", token)
+	}
+}
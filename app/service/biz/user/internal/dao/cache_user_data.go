@@ -158,6 +158,11 @@ func (d *Dao) makeUserDataByDO(userDO *dataobject.UsersDO) *mtproto.UserData {
 		EmojiStatus:       makeEmojiStatus(userDO.EmojiStatusDocumentId, userDO.EmojiStatusUntil),
 	}).To_UserData()
 
+	if userDO.UserType == user.UserTypeBot {
+		// placeholder of the unique users.phone, see CreateBot
+		userData.Phone = ""
+	}
+
 	return userData
 }
 
@@ -0,0 +1,81 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
+)
+
+func makePredefinedUserByDO(do *dataobject.PredefinedUsersDO) *mtproto.PredefinedUser {
+	return mtproto.MakeTLPredefinedUser(&mtproto.PredefinedUser{
+		Phone:            do.Phone,
+		FirstName:        mtproto.MakeFlagsString(do.FirstName),
+		LastName:         mtproto.MakeFlagsString(do.LastName),
+		Username:         mtproto.MakeFlagsString(do.Username),
+		Code:             do.Code,
+		Verified:         do.Verified,
+		RegisteredUserId: mtproto.MakeFlagsInt64(do.RegisteredUserId),
+	}).To_PredefinedUser()
+}
+
+// GetPredefinedUser returns ErrPhoneNumberUnoccupied if phone is not predefined.
+func (d *Dao) GetPredefinedUser(ctx context.Context, phone string) (*mtproto.PredefinedUser, error) {
+	do, err := d.PredefinedUsersDAO.SelectByPhone(ctx, phone)
+	if err != nil {
+		return nil, err
+	} else if do == nil {
+		return nil, mtproto.ErrPhoneNumberUnoccupied
+	}
+
+	return makePredefinedUserByDO(do), nil
+}
+
+func (d *Dao) GetAllPredefinedUser(ctx context.Context) ([]*mtproto.PredefinedUser, error) {
+	rValues := make([]*mtproto.PredefinedUser, 0)
+	_, err := d.PredefinedUsersDAO.SelectPredefinedUsersAllWithCB(
+		ctx,
+		func(i int, v *dataobject.PredefinedUsersDO) {
+			rValues = append(rValues, makePredefinedUserByDO(v))
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return rValues, nil
+}
+
+func (d *Dao) CreateNewPredefinedUser(ctx context.Context, do *dataobject.PredefinedUsersDO) (*mtproto.PredefinedUser, error) {
+	if _, _, err := d.PredefinedUsersDAO.Insert(ctx, do); err != nil {
+		return nil, err
+	}
+
+	return makePredefinedUserByDO(do), nil
+}
+
+// UpdatePredefinedUser updates the columns in cMap and returns the updated user.
+func (d *Dao) UpdatePredefinedUser(ctx context.Context, phone string, cMap map[string]interface{}) (*mtproto.PredefinedUser, error) {
+	if _, err := d.PredefinedUsersDAO.Update(ctx, cMap, phone); err != nil {
+		return nil, err
+	}
+
+	return d.GetPredefinedUser(ctx, phone)
+}
@@ -1097,3 +1097,93 @@ func (s *Service) UserGetMutableUsersV2(ctx context.Context, request *user.TLUse
 	c.Logger.Debugf("user.getMutableUsersV2 - reply: %s", r.DebugString())
 	return r, err
 }
+
+// UserCreateBot
+// user.createBot creator_user_id:long first_name:string username:string = ImmutableUser;
+func (s *Service) UserCreateBot(ctx context.Context, request *user.TLUserCreateBot) (*mtproto.ImmutableUser, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("user.createBot - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserCreateBot(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("user.createBot - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserGetBotIdByToken
+// user.getBotIdByToken token:string = Int64;
+func (s *Service) UserGetBotIdByToken(ctx context.Context, request *user.TLUserGetBotIdByToken) (*mtproto.Int64, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("user.getBotIdByToken - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserGetBotIdByToken(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("user.getBotIdByToken - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserGetBotsByCreator
+// user.getBotsByCreator creator_user_id:long = Vector<long>;
+func (s *Service) UserGetBotsByCreator(ctx context.Context, request *user.TLUserGetBotsByCreator) (*user.Vector_Long, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("user.getBotsByCreator - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserGetBotsByCreator(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("user.getBotsByCreator - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserResetBotToken
+// user.resetBotToken bot_id:long = String;
+func (s *Service) UserResetBotToken(ctx context.Context, request *user.TLUserResetBotToken) (*mtproto.String, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("user.resetBotToken - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserResetBotToken(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("user.resetBotToken - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserSetDefaultHistoryTTL
+// user.setDefaultHistoryTTL user_id:long ttl:int = Bool;
+func (s *Service) UserSetDefaultHistoryTTL(ctx context.Context, request *user.TLUserSetDefaultHistoryTTL) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("user.setDefaultHistoryTTL - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserSetDefaultHistoryTTL(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("user.setDefaultHistoryTTL - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserGetDefaultHistoryTTL
+// user.getDefaultHistoryTTL user_id:long = DefaultHistoryTTL;
+func (s *Service) UserGetDefaultHistoryTTL(ctx context.Context, request *user.TLUserGetDefaultHistoryTTL) (*mtproto.DefaultHistoryTTL, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("user.getDefaultHistoryTTL - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserGetDefaultHistoryTTL(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("user.getDefaultHistoryTTL - reply: %s", r.DebugString())
+	return r, err
+}
@@ -88,6 +88,12 @@ const (
 	Predicate_user_updateBotData                    = "user_updateBotData"
 	Predicate_user_getImmutableUserV2               = "user_getImmutableUserV2"
 	Predicate_user_getMutableUsersV2                = "user_getMutableUsersV2"
+	Predicate_user_createBot                        = "user_createBot"
+	Predicate_user_getBotIdByToken                  = "user_getBotIdByToken"
+	Predicate_user_getBotsByCreator                 = "user_getBotsByCreator"
+	Predicate_user_resetBotToken                    = "user_resetBotToken"
+	Predicate_user_setDefaultHistoryTTL             = "user_setDefaultHistoryTTL"
+	Predicate_user_getDefaultHistoryTTL             = "user_getDefaultHistoryTTL"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -399,6 +405,30 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: -1795585240, // 0x94f98b28
 
 	},
+	Predicate_user_createBot: {
+		0: -1717224161, // 0x99a53d1f
+
+	},
+	Predicate_user_getBotIdByToken: {
+		0: -274395290, // 0xefa50f66
+
+	},
+	Predicate_user_getBotsByCreator: {
+		0: -81646720, // 0xfb222b80
+
+	},
+	Predicate_user_resetBotToken: {
+		0: 1272704906, // 0x4bdbef8a
+
+	},
+	Predicate_user_setDefaultHistoryTTL: {
+		0: -1556323499, // 0xa33c6355
+
+	},
+	Predicate_user_getDefaultHistoryTTL: {
+		0: -1018255106, // 0xc34ea8fe
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
@@ -479,6 +509,12 @@ var clazzIdNameRegisters2 = map[int32]string{
 	-1174586898: Predicate_user_updateBotData,                    // 0xb9fd39ee
 	806009420:   Predicate_user_getImmutableUserV2,               // 0x300aba4c
 	-1795585240: Predicate_user_getMutableUsersV2,                // 0x94f98b28
+	-1717224161: Predicate_user_createBot,                        // 0x99a53d1f
+	-274395290:  Predicate_user_getBotIdByToken,                  // 0xefa50f66
+	-81646720:   Predicate_user_getBotsByCreator,                 // 0xfb222b80
+	1272704906:  Predicate_user_resetBotToken,                    // 0x4bdbef8a
+	-1556323499: Predicate_user_setDefaultHistoryTTL,             // 0xa33c6355
+	-1018255106: Predicate_user_getDefaultHistoryTTL,             // 0xc34ea8fe
 
 }
 
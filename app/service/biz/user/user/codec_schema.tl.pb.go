@@ -417,6 +417,36 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: -1795585240,
 		}
 	},
+	-1717224161: func() mtproto.TLObject { // 0x99a53d1f
+		return &TLUserCreateBot{
+			Constructor: -1717224161,
+		}
+	},
+	-274395290: func() mtproto.TLObject { // 0xefa50f66
+		return &TLUserGetBotIdByToken{
+			Constructor: -274395290,
+		}
+	},
+	-81646720: func() mtproto.TLObject { // 0xfb222b80
+		return &TLUserGetBotsByCreator{
+			Constructor: -81646720,
+		}
+	},
+	1272704906: func() mtproto.TLObject { // 0x4bdbef8a
+		return &TLUserResetBotToken{
+			Constructor: 1272704906,
+		}
+	},
+	-1556323499: func() mtproto.TLObject { // 0xa33c6355
+		return &TLUserSetDefaultHistoryTTL{
+			Constructor: -1556323499,
+		}
+	},
+	-1018255106: func() mtproto.TLObject { // 0xc34ea8fe
+		return &TLUserGetDefaultHistoryTTL{
+			Constructor: -1018255106,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -5077,9 +5107,309 @@ func (m *TLUserGetMutableUsersV2) DebugString() string {
 	return dbgString
 }
 
-//----------------------------------------------------------------------------------------------------------------
-// Vector_LastSeenData
+// TLUserCreateBot
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserCreateBot) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_createBot))
+
+	switch uint32(m.Constructor) {
+	case 0x99a53d1f:
+		// user.createBot creator_user_id:long first_name:string username:string = ImmutableUser;
+		x.UInt(0x99a53d1f)
+
+		// no flags
+
+		x.Long(m.GetCreatorUserId())
+		x.String(m.GetFirstName())
+		x.String(m.GetUsername())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserCreateBot) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserCreateBot) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x99a53d1f:
+		// user.createBot creator_user_id:long first_name:string username:string = ImmutableUser;
+
+		// not has flags
+
+		m.CreatorUserId = dBuf.Long()
+		m.FirstName = dBuf.String()
+		m.Username = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserCreateBot) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserGetBotIdByToken
 ///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserGetBotIdByToken) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_getBotIdByToken))
+
+	switch uint32(m.Constructor) {
+	case 0xefa50f66:
+		// user.getBotIdByToken token:string = Int64;
+		x.UInt(0xefa50f66)
+
+		// no flags
+
+		x.String(m.GetToken())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserGetBotIdByToken) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserGetBotIdByToken) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xefa50f66:
+		// user.getBotIdByToken token:string = Int64;
+
+		// not has flags
+
+		m.Token = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserGetBotIdByToken) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserGetBotsByCreator
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserGetBotsByCreator) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_getBotsByCreator))
+
+	switch uint32(m.Constructor) {
+	case 0xfb222b80:
+		// user.getBotsByCreator creator_user_id:long = Vector<long>;
+		x.UInt(0xfb222b80)
+
+		// no flags
+
+		x.Long(m.GetCreatorUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserGetBotsByCreator) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserGetBotsByCreator) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xfb222b80:
+		// user.getBotsByCreator creator_user_id:long = Vector<long>;
+
+		// not has flags
+
+		m.CreatorUserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserGetBotsByCreator) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserResetBotToken
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserResetBotToken) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_resetBotToken))
+
+	switch uint32(m.Constructor) {
+	case 0x4bdbef8a:
+		// user.resetBotToken bot_id:long = String;
+		x.UInt(0x4bdbef8a)
+
+		// no flags
+
+		x.Long(m.GetBotId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserResetBotToken) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserResetBotToken) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x4bdbef8a:
+		// user.resetBotToken bot_id:long = String;
+
+		// not has flags
+
+		m.BotId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserResetBotToken) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserSetDefaultHistoryTTL
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserSetDefaultHistoryTTL) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_setDefaultHistoryTTL))
+
+	switch uint32(m.Constructor) {
+	case 0xa33c6355:
+		// user.setDefaultHistoryTTL user_id:long ttl:int = Bool;
+		x.UInt(0xa33c6355)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Int(m.GetTtl())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserSetDefaultHistoryTTL) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserSetDefaultHistoryTTL) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xa33c6355:
+		// user.setDefaultHistoryTTL user_id:long ttl:int = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Ttl = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserSetDefaultHistoryTTL) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserGetDefaultHistoryTTL
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserGetDefaultHistoryTTL) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_getDefaultHistoryTTL))
+
+	switch uint32(m.Constructor) {
+	case 0xc34ea8fe:
+		// user.getDefaultHistoryTTL user_id:long = DefaultHistoryTTL;
+		x.UInt(0xc34ea8fe)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserGetDefaultHistoryTTL) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserGetDefaultHistoryTTL) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc34ea8fe:
+		// user.getDefaultHistoryTTL user_id:long = DefaultHistoryTTL;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserGetDefaultHistoryTTL) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// ----------------------------------------------------------------------------------------------------------------
+// Vector_LastSeenData
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_LastSeenData) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -5114,7 +5444,7 @@ func (m *Vector_LastSeenData) DebugString() string {
 }
 
 // Vector_ImmutableUser
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_ImmutableUser) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -5149,7 +5479,7 @@ func (m *Vector_ImmutableUser) DebugString() string {
 }
 
 // Vector_PeerPeerNotifySettings
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_PeerPeerNotifySettings) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -5184,7 +5514,7 @@ func (m *Vector_PeerPeerNotifySettings) DebugString() string {
 }
 
 // Vector_PrivacyRule
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_PrivacyRule) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -5219,7 +5549,7 @@ func (m *Vector_PrivacyRule) DebugString() string {
 }
 
 // Vector_PredefinedUser
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_PredefinedUser) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -5254,7 +5584,7 @@ func (m *Vector_PredefinedUser) DebugString() string {
 }
 
 // Vector_Long
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_Long) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.VectorLong(m.Datas)
@@ -5279,7 +5609,7 @@ func (m *Vector_Long) DebugString() string {
 }
 
 // Vector_PeerBlocked
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_PeerBlocked) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -5314,7 +5644,7 @@ func (m *Vector_PeerBlocked) DebugString() string {
 }
 
 // Vector_ContactData
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_ContactData) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -5349,7 +5679,7 @@ func (m *Vector_ContactData) DebugString() string {
 }
 
 // Vector_InputContact
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_InputContact) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -5384,7 +5714,7 @@ func (m *Vector_InputContact) DebugString() string {
 }
 
 // Vector_UserData
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_UserData) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -98,6 +98,12 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLUserUpdateBotData":                    RPCContextTuple{"/mtproto.RPCUser/user_updateBotData", func() interface{} { return new(mtproto.Bool) }},
 	"TLUserGetImmutableUserV2":               RPCContextTuple{"/mtproto.RPCUser/user_getImmutableUserV2", func() interface{} { return new(mtproto.ImmutableUser) }},
 	"TLUserGetMutableUsersV2":                RPCContextTuple{"/mtproto.RPCUser/user_getMutableUsersV2", func() interface{} { return new(mtproto.MutableUsers) }},
+	"TLUserCreateBot":                        RPCContextTuple{"/mtproto.RPCUser/user_createBot", func() interface{} { return new(mtproto.ImmutableUser) }},
+	"TLUserGetBotIdByToken":                  RPCContextTuple{"/mtproto.RPCUser/user_getBotIdByToken", func() interface{} { return new(mtproto.Int64) }},
+	"TLUserGetBotsByCreator":                 RPCContextTuple{"/mtproto.RPCUser/user_getBotsByCreator", func() interface{} { return new(Vector_Long) }},
+	"TLUserResetBotToken":                    RPCContextTuple{"/mtproto.RPCUser/user_resetBotToken", func() interface{} { return new(mtproto.String) }},
+	"TLUserSetDefaultHistoryTTL":             RPCContextTuple{"/mtproto.RPCUser/user_setDefaultHistoryTTL", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserGetDefaultHistoryTTL":             RPCContextTuple{"/mtproto.RPCUser/user_getDefaultHistoryTTL", func() interface{} { return new(mtproto.DefaultHistoryTTL) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
@@ -112,6 +112,12 @@ const (
 	CRC32_user_updateBotData                    TLConstructor = -1174586898
 	CRC32_user_getImmutableUserV2               TLConstructor = 806009420
 	CRC32_user_getMutableUsersV2                TLConstructor = -1795585240
+	CRC32_user_createBot                        TLConstructor = -1717224161
+	CRC32_user_getBotIdByToken                  TLConstructor = -274395290
+	CRC32_user_getBotsByCreator                 TLConstructor = -81646720
+	CRC32_user_resetBotToken                    TLConstructor = 1272704906
+	CRC32_user_setDefaultHistoryTTL             TLConstructor = -1556323499
+	CRC32_user_getDefaultHistoryTTL             TLConstructor = -1018255106
 )
 
 var TLConstructor_name = map[int32]string{
@@ -193,6 +199,12 @@ var TLConstructor_name = map[int32]string{
 	-1174586898: "CRC32_user_updateBotData",
 	806009420:   "CRC32_user_getImmutableUserV2",
 	-1795585240: "CRC32_user_getMutableUsersV2",
+	-1717224161: "CRC32_user_createBot",
+	-274395290:  "CRC32_user_getBotIdByToken",
+	-81646720:   "CRC32_user_getBotsByCreator",
+	1272704906:  "CRC32_user_resetBotToken",
+	-1556323499: "CRC32_user_setDefaultHistoryTTL",
+	-1018255106: "CRC32_user_getDefaultHistoryTTL",
 }
 
 var TLConstructor_value = map[string]int32{
@@ -274,6 +286,12 @@ var TLConstructor_value = map[string]int32{
 	"CRC32_user_updateBotData":                    -1174586898,
 	"CRC32_user_getImmutableUserV2":               806009420,
 	"CRC32_user_getMutableUsersV2":                -1795585240,
+	"CRC32_user_createBot":                        -1717224161,
+	"CRC32_user_getBotIdByToken":                  -274395290,
+	"CRC32_user_getBotsByCreator":                 -81646720,
+	"CRC32_user_resetBotToken":                    1272704906,
+	"CRC32_user_setDefaultHistoryTTL":             -1556323499,
+	"CRC32_user_getDefaultHistoryTTL":             -1018255106,
 }
 
 func (x TLConstructor) String() string {
@@ -285,8 +303,7 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 }
 
 // LastSeenData <--
-//  + TL_lastSeenData
-//
+//   - TL_lastSeenData
 type LastSeenData struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
@@ -414,8 +431,7 @@ func (m *TLLastSeenData) GetData2() *LastSeenData {
 }
 
 // PeerPeerNotifySettings <--
-//  + TL_peerPeerNotifySettings
-//
+//   - TL_peerPeerNotifySettings
 type PeerPeerNotifySettings struct {
 	PredicateName        string                      `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor               `protobuf:"varint,2,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
@@ -543,8 +559,7 @@ func (m *TLPeerPeerNotifySettings) GetData2() *PeerPeerNotifySettings {
 }
 
 // UserImportedContacts <--
-//  + TL_userImportedContacts
-//
+//   - TL_userImportedContacts
 type UserImportedContacts struct {
 	PredicateName        string                     `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor              `protobuf:"varint,2,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
@@ -688,9 +703,8 @@ func (m *TLUserImportedContacts) GetData2() *UserImportedContacts {
 }
 
 // UsersFound <--
-//  + TL_usersDataFound
-//  + TL_usersIdFound
-//
+//   - TL_usersDataFound
+//   - TL_usersIdFound
 type UsersFound struct {
 	PredicateName        string              `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor       `protobuf:"varint,2,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
@@ -872,7 +886,7 @@ func (m *TLUsersIdFound) GetData2() *UsersFound {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetLastSeens struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Id                   []int64       `protobuf:"varint,3,rep,packed,name=id,proto3" json:"id,omitempty"`
@@ -928,7 +942,7 @@ func (m *TLUserGetLastSeens) GetId() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdateLastSeen struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -1000,7 +1014,7 @@ func (m *TLUserUpdateLastSeen) GetExpires() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetLastSeen struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -1056,7 +1070,7 @@ func (m *TLUserGetLastSeen) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetImmutableUser struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -1128,7 +1142,7 @@ func (m *TLUserGetImmutableUser) GetContacts() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetMutableUsers struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Id                   []int64       `protobuf:"varint,3,rep,packed,name=id,proto3" json:"id,omitempty"`
@@ -1192,7 +1206,7 @@ func (m *TLUserGetMutableUsers) GetTo() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetImmutableUserByPhone struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string        `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -1248,7 +1262,7 @@ func (m *TLUserGetImmutableUserByPhone) GetPhone() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetImmutableUserByToken struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Token                string        `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
@@ -1304,7 +1318,7 @@ func (m *TLUserGetImmutableUserByToken) GetToken() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserSetAccountDaysTTL struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1368,7 +1382,7 @@ func (m *TLUserSetAccountDaysTTL) GetTtl() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetAccountDaysTTL struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1424,7 +1438,7 @@ func (m *TLUserGetAccountDaysTTL) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetNotifySettings struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1496,7 +1510,7 @@ func (m *TLUserGetNotifySettings) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetNotifySettingsList struct {
 	Constructor          TLConstructor       `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64               `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1560,7 +1574,7 @@ func (m *TLUserGetNotifySettingsList) GetPeers() []*mtproto.PeerUtil {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserSetNotifySettings struct {
 	Constructor          TLConstructor               `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64                       `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1640,7 +1654,7 @@ func (m *TLUserSetNotifySettings) GetSettings() *mtproto.PeerNotifySettings {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserResetNotifySettings struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1696,7 +1710,7 @@ func (m *TLUserResetNotifySettings) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetAllNotifySettings struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1752,7 +1766,7 @@ func (m *TLUserGetAllNotifySettings) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetGlobalPrivacySettings struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1808,7 +1822,7 @@ func (m *TLUserGetGlobalPrivacySettings) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserSetGlobalPrivacySettings struct {
 	Constructor          TLConstructor                  `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64                          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1872,7 +1886,7 @@ func (m *TLUserSetGlobalPrivacySettings) GetSettings() *mtproto.GlobalPrivacySet
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetPrivacy struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1936,7 +1950,7 @@ func (m *TLUserGetPrivacy) GetKeyType() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserSetPrivacy struct {
 	Constructor          TLConstructor          `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2008,7 +2022,7 @@ func (m *TLUserSetPrivacy) GetRules() []*mtproto.PrivacyRule {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserCheckPrivacy struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2080,7 +2094,7 @@ func (m *TLUserCheckPrivacy) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserAddPeerSettings struct {
 	Constructor          TLConstructor         `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2160,7 +2174,7 @@ func (m *TLUserAddPeerSettings) GetSettings() *mtproto.PeerSettings {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetPeerSettings struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2232,7 +2246,7 @@ func (m *TLUserGetPeerSettings) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserDeletePeerSettings struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2304,7 +2318,7 @@ func (m *TLUserDeletePeerSettings) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserChangePhone struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2368,7 +2382,7 @@ func (m *TLUserChangePhone) GetPhone() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserCreateNewPredefinedUser struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string             `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -2464,7 +2478,7 @@ func (m *TLUserCreateNewPredefinedUser) GetVerified() bool {
 	return false
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetPredefinedUser struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string        `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -2520,7 +2534,7 @@ func (m *TLUserGetPredefinedUser) GetPhone() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetAllPredefinedUser struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -2568,7 +2582,7 @@ func (m *TLUserGetAllPredefinedUser) GetConstructor() TLConstructor {
 	return CRC32_UNKNOWN
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdatePredefinedFirstAndLastName struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string             `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -2642,7 +2656,7 @@ func (m *TLUserUpdatePredefinedFirstAndLastName) GetLastName() *types.StringValu
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdatePredefinedVerified struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string        `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -2706,7 +2720,7 @@ func (m *TLUserUpdatePredefinedVerified) GetVerified() bool {
 	return false
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdatePredefinedUsername struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string             `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -2770,7 +2784,7 @@ func (m *TLUserUpdatePredefinedUsername) GetUsername() *types.StringValue {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdatePredefinedCode struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string        `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -2834,7 +2848,7 @@ func (m *TLUserUpdatePredefinedCode) GetCode() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserPredefinedBindRegisteredUserId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string        `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -2898,7 +2912,7 @@ func (m *TLUserPredefinedBindRegisteredUserId) GetRegisteredUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserCreateNewUser struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	SecretKeyId          int64         `protobuf:"varint,3,opt,name=secret_key_id,json=secretKeyId,proto3" json:"secret_key_id,omitempty"`
@@ -2986,7 +3000,7 @@ func (m *TLUserCreateNewUser) GetLastName() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserDeleteUser struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3050,7 +3064,7 @@ func (m *TLUserDeleteUser) GetReason() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserBlockPeer struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3122,7 +3136,7 @@ func (m *TLUserBlockPeer) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUnBlockPeer struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3194,7 +3208,7 @@ func (m *TLUserUnBlockPeer) GetPeerId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserBlockedByUser struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3258,7 +3272,7 @@ func (m *TLUserBlockedByUser) GetPeerUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserIsBlockedByUser struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3322,7 +3336,7 @@ func (m *TLUserIsBlockedByUser) GetPeerUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserCheckBlockUserList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3386,7 +3400,7 @@ func (m *TLUserCheckBlockUserList) GetId() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetBlockedList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3458,7 +3472,7 @@ func (m *TLUserGetBlockedList) GetLimit() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetContactSignUpNotification struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3514,7 +3528,7 @@ func (m *TLUserGetContactSignUpNotification) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserSetContactSignUpNotification struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3578,7 +3592,7 @@ func (m *TLUserSetContactSignUpNotification) GetSilent() *mtproto.Bool {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetContentSettings struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3634,7 +3648,7 @@ func (m *TLUserGetContentSettings) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserSetContentSettings struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3698,7 +3712,7 @@ func (m *TLUserSetContentSettings) GetSensitiveEnabled() bool {
 	return false
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserDeleteContact struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3762,7 +3776,7 @@ func (m *TLUserDeleteContact) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetContactList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3818,7 +3832,7 @@ func (m *TLUserGetContactList) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetContactIdList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3874,7 +3888,7 @@ func (m *TLUserGetContactIdList) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetContact struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3938,7 +3952,7 @@ func (m *TLUserGetContact) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserAddContact struct {
 	Constructor              TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId                   int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4034,7 +4048,7 @@ func (m *TLUserAddContact) GetPhone() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserCheckContact struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4098,7 +4112,7 @@ func (m *TLUserCheckContact) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetImportersByPhone struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string        `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -4154,7 +4168,7 @@ func (m *TLUserGetImportersByPhone) GetPhone() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserDeleteImportersByPhone struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Phone                string        `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -4210,7 +4224,7 @@ func (m *TLUserDeleteImportersByPhone) GetPhone() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserImportContacts struct {
 	Constructor          TLConstructor           `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64                   `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4274,7 +4288,7 @@ func (m *TLUserImportContacts) GetContacts() []*mtproto.InputContact {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetCountryCode struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4330,7 +4344,7 @@ func (m *TLUserGetCountryCode) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdateAbout struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4394,7 +4408,7 @@ func (m *TLUserUpdateAbout) GetAbout() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdateFirstAndLastName struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4466,7 +4480,7 @@ func (m *TLUserUpdateFirstAndLastName) GetLastName() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdateVerified struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4530,7 +4544,7 @@ func (m *TLUserUpdateVerified) GetVerified() *mtproto.Bool {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdateUsername struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4594,7 +4608,7 @@ func (m *TLUserUpdateUsername) GetUsername() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdateProfilePhoto struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4658,7 +4672,7 @@ func (m *TLUserUpdateProfilePhoto) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserDeleteProfilePhotos struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4722,7 +4736,7 @@ func (m *TLUserDeleteProfilePhotos) GetId() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetProfilePhotos struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4778,7 +4792,7 @@ func (m *TLUserGetProfilePhotos) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserSetBotCommands struct {
 	Constructor          TLConstructor         `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4850,7 +4864,7 @@ func (m *TLUserSetBotCommands) GetCommands() []*mtproto.BotCommand {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserIsBot struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -4906,7 +4920,7 @@ func (m *TLUserIsBot) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetBotInfo struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	BotId                int64         `protobuf:"varint,3,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
@@ -4962,7 +4976,7 @@ func (m *TLUserGetBotInfo) GetBotId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetFullUser struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	SelfUserId           int64         `protobuf:"varint,3,opt,name=self_user_id,json=selfUserId,proto3" json:"self_user_id,omitempty"`
@@ -5026,7 +5040,7 @@ func (m *TLUserGetFullUser) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdateEmojiStatus struct {
 	Constructor           TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId                int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -5098,7 +5112,7 @@ func (m *TLUserUpdateEmojiStatus) GetEmojiStatusUntil() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetUserDataById struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -5154,7 +5168,7 @@ func (m *TLUserGetUserDataById) GetUserId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetUserDataListByIdList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	UserIdList           []int64       `protobuf:"varint,3,rep,packed,name=user_id_list,json=userIdList,proto3" json:"user_id_list,omitempty"`
@@ -5210,7 +5224,7 @@ func (m *TLUserGetUserDataListByIdList) GetUserIdList() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetUserDataByToken struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Token                string        `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
@@ -5266,7 +5280,7 @@ func (m *TLUserGetUserDataByToken) GetToken() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserSearch struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Q                    string        `protobuf:"bytes,3,opt,name=q,proto3" json:"q,omitempty"`
@@ -5346,7 +5360,7 @@ func (m *TLUserSearch) GetLimit() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserUpdateBotData struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	BotId                int64         `protobuf:"varint,3,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
@@ -5442,7 +5456,7 @@ func (m *TLUserUpdateBotData) GetBotInlinePlaceholder() *mtproto.Bool {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetImmutableUserV2 struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -5522,7 +5536,7 @@ func (m *TLUserGetImmutableUserV2) GetTo() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLUserGetMutableUsersV2 struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
 	Id                   []int64       `protobuf:"varint,3,rep,packed,name=id,proto3" json:"id,omitempty"`
@@ -5602,7 +5616,251 @@ func (m *TLUserGetMutableUsersV2) GetTo() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
+// user.createBot creator_user_id:long first_name:string username:string = ImmutableUser;
+type TLUserCreateBot struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
+	CreatorUserId        int64         `protobuf:"varint,3,opt,name=creator_user_id,json=creatorUserId,proto3" json:"creator_user_id,omitempty"`
+	FirstName            string        `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	Username             string        `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLUserCreateBot) Reset()         { *m = TLUserCreateBot{} }
+func (m *TLUserCreateBot) String() string { return proto.CompactTextString(m) }
+func (*TLUserCreateBot) ProtoMessage()    {}
+func (*TLUserCreateBot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6e3d997b4637694, []int{81}
+}
+func (m *TLUserCreateBot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLUserCreateBot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLUserCreateBot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLUserCreateBot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLUserCreateBot.Merge(m, src)
+}
+func (m *TLUserCreateBot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLUserCreateBot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLUserCreateBot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLUserCreateBot proto.InternalMessageInfo
+
+func (m *TLUserCreateBot) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLUserCreateBot) GetCreatorUserId() int64 {
+	if m != nil {
+		return m.CreatorUserId
+	}
+	return 0
+}
+
+func (m *TLUserCreateBot) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *TLUserCreateBot) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+// --------------------------------------------------------------------------------------------
+// user.getBotIdByToken token:string = Int64;
+type TLUserGetBotIdByToken struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
+	Token                string        `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLUserGetBotIdByToken) Reset()         { *m = TLUserGetBotIdByToken{} }
+func (m *TLUserGetBotIdByToken) String() string { return proto.CompactTextString(m) }
+func (*TLUserGetBotIdByToken) ProtoMessage()    {}
+func (*TLUserGetBotIdByToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6e3d997b4637694, []int{82}
+}
+func (m *TLUserGetBotIdByToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLUserGetBotIdByToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLUserGetBotIdByToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLUserGetBotIdByToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLUserGetBotIdByToken.Merge(m, src)
+}
+func (m *TLUserGetBotIdByToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLUserGetBotIdByToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLUserGetBotIdByToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLUserGetBotIdByToken proto.InternalMessageInfo
+
+func (m *TLUserGetBotIdByToken) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLUserGetBotIdByToken) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// --------------------------------------------------------------------------------------------
+// user.getBotsByCreator creator_user_id:long = Vector<long>;
+type TLUserGetBotsByCreator struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
+	CreatorUserId        int64         `protobuf:"varint,3,opt,name=creator_user_id,json=creatorUserId,proto3" json:"creator_user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLUserGetBotsByCreator) Reset()         { *m = TLUserGetBotsByCreator{} }
+func (m *TLUserGetBotsByCreator) String() string { return proto.CompactTextString(m) }
+func (*TLUserGetBotsByCreator) ProtoMessage()    {}
+func (*TLUserGetBotsByCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6e3d997b4637694, []int{83}
+}
+func (m *TLUserGetBotsByCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLUserGetBotsByCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLUserGetBotsByCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLUserGetBotsByCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLUserGetBotsByCreator.Merge(m, src)
+}
+func (m *TLUserGetBotsByCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLUserGetBotsByCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLUserGetBotsByCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLUserGetBotsByCreator proto.InternalMessageInfo
+
+func (m *TLUserGetBotsByCreator) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLUserGetBotsByCreator) GetCreatorUserId() int64 {
+	if m != nil {
+		return m.CreatorUserId
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+// user.resetBotToken bot_id:long = String;
+type TLUserResetBotToken struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
+	BotId                int64         `protobuf:"varint,3,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLUserResetBotToken) Reset()         { *m = TLUserResetBotToken{} }
+func (m *TLUserResetBotToken) String() string { return proto.CompactTextString(m) }
+func (*TLUserResetBotToken) ProtoMessage()    {}
+func (*TLUserResetBotToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6e3d997b4637694, []int{84}
+}
+func (m *TLUserResetBotToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLUserResetBotToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLUserResetBotToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLUserResetBotToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLUserResetBotToken.Merge(m, src)
+}
+func (m *TLUserResetBotToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLUserResetBotToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLUserResetBotToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLUserResetBotToken proto.InternalMessageInfo
+
+func (m *TLUserResetBotToken) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLUserResetBotToken) GetBotId() int64 {
+	if m != nil {
+		return m.BotId
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
 // Vector api result type
 type Vector_LastSeenData struct {
 	Datas                []*LastSeenData `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
@@ -5615,7 +5873,7 @@ func (m *Vector_LastSeenData) Reset()         { *m = Vector_LastSeenData{} }
 func (m *Vector_LastSeenData) String() string { return proto.CompactTextString(m) }
 func (*Vector_LastSeenData) ProtoMessage()    {}
 func (*Vector_LastSeenData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{81}
+	return fileDescriptor_d6e3d997b4637694, []int{85}
 }
 func (m *Vector_LastSeenData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5662,7 +5920,7 @@ func (m *Vector_ImmutableUser) Reset()         { *m = Vector_ImmutableUser{} }
 func (m *Vector_ImmutableUser) String() string { return proto.CompactTextString(m) }
 func (*Vector_ImmutableUser) ProtoMessage()    {}
 func (*Vector_ImmutableUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{82}
+	return fileDescriptor_d6e3d997b4637694, []int{86}
 }
 func (m *Vector_ImmutableUser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5709,7 +5967,7 @@ func (m *Vector_PeerPeerNotifySettings) Reset()         { *m = Vector_PeerPeerNo
 func (m *Vector_PeerPeerNotifySettings) String() string { return proto.CompactTextString(m) }
 func (*Vector_PeerPeerNotifySettings) ProtoMessage()    {}
 func (*Vector_PeerPeerNotifySettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{83}
+	return fileDescriptor_d6e3d997b4637694, []int{87}
 }
 func (m *Vector_PeerPeerNotifySettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5756,7 +6014,7 @@ func (m *Vector_PrivacyRule) Reset()         { *m = Vector_PrivacyRule{} }
 func (m *Vector_PrivacyRule) String() string { return proto.CompactTextString(m) }
 func (*Vector_PrivacyRule) ProtoMessage()    {}
 func (*Vector_PrivacyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{84}
+	return fileDescriptor_d6e3d997b4637694, []int{88}
 }
 func (m *Vector_PrivacyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5803,7 +6061,7 @@ func (m *Vector_PredefinedUser) Reset()         { *m = Vector_PredefinedUser{} }
 func (m *Vector_PredefinedUser) String() string { return proto.CompactTextString(m) }
 func (*Vector_PredefinedUser) ProtoMessage()    {}
 func (*Vector_PredefinedUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{85}
+	return fileDescriptor_d6e3d997b4637694, []int{89}
 }
 func (m *Vector_PredefinedUser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5850,7 +6108,7 @@ func (m *Vector_Long) Reset()         { *m = Vector_Long{} }
 func (m *Vector_Long) String() string { return proto.CompactTextString(m) }
 func (*Vector_Long) ProtoMessage()    {}
 func (*Vector_Long) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{86}
+	return fileDescriptor_d6e3d997b4637694, []int{90}
 }
 func (m *Vector_Long) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5897,7 +6155,7 @@ func (m *Vector_PeerBlocked) Reset()         { *m = Vector_PeerBlocked{} }
 func (m *Vector_PeerBlocked) String() string { return proto.CompactTextString(m) }
 func (*Vector_PeerBlocked) ProtoMessage()    {}
 func (*Vector_PeerBlocked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{87}
+	return fileDescriptor_d6e3d997b4637694, []int{91}
 }
 func (m *Vector_PeerBlocked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5944,7 +6202,7 @@ func (m *Vector_ContactData) Reset()         { *m = Vector_ContactData{} }
 func (m *Vector_ContactData) String() string { return proto.CompactTextString(m) }
 func (*Vector_ContactData) ProtoMessage()    {}
 func (*Vector_ContactData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{88}
+	return fileDescriptor_d6e3d997b4637694, []int{92}
 }
 func (m *Vector_ContactData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5991,7 +6249,7 @@ func (m *Vector_InputContact) Reset()         { *m = Vector_InputContact{} }
 func (m *Vector_InputContact) String() string { return proto.CompactTextString(m) }
 func (*Vector_InputContact) ProtoMessage()    {}
 func (*Vector_InputContact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{89}
+	return fileDescriptor_d6e3d997b4637694, []int{93}
 }
 func (m *Vector_InputContact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6038,7 +6296,7 @@ func (m *Vector_UserData) Reset()         { *m = Vector_UserData{} }
 func (m *Vector_UserData) String() string { return proto.CompactTextString(m) }
 func (*Vector_UserData) ProtoMessage()    {}
 func (*Vector_UserData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6e3d997b4637694, []int{90}
+	return fileDescriptor_d6e3d997b4637694, []int{94}
 }
 func (m *Vector_UserData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6074,77 +6332,199 @@ func (m *Vector_UserData) GetDatas() []*mtproto.UserData {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("user.TLConstructor", TLConstructor_name, TLConstructor_value)
-	proto.RegisterType((*LastSeenData)(nil), "user.LastSeenData")
-	proto.RegisterType((*TLLastSeenData)(nil), "user.TL_lastSeenData")
-	proto.RegisterType((*PeerPeerNotifySettings)(nil), "user.PeerPeerNotifySettings")
-	proto.RegisterType((*TLPeerPeerNotifySettings)(nil), "user.TL_peerPeerNotifySettings")
-	proto.RegisterType((*UserImportedContacts)(nil), "user.UserImportedContacts")
-	proto.RegisterType((*TLUserImportedContacts)(nil), "user.TL_userImportedContacts")
-	proto.RegisterType((*UsersFound)(nil), "user.UsersFound")
-	proto.RegisterType((*TLUsersDataFound)(nil), "user.TL_usersDataFound")
-	proto.RegisterType((*TLUsersIdFound)(nil), "user.TL_usersIdFound")
-	proto.RegisterType((*TLUserGetLastSeens)(nil), "user.TL_user_getLastSeens")
-	proto.RegisterType((*TLUserUpdateLastSeen)(nil), "user.TL_user_updateLastSeen")
-	proto.RegisterType((*TLUserGetLastSeen)(nil), "user.TL_user_getLastSeen")
-	proto.RegisterType((*TLUserGetImmutableUser)(nil), "user.TL_user_getImmutableUser")
-	proto.RegisterType((*TLUserGetMutableUsers)(nil), "user.TL_user_getMutableUsers")
-	proto.RegisterType((*TLUserGetImmutableUserByPhone)(nil), "user.TL_user_getImmutableUserByPhone")
-	proto.RegisterType((*TLUserGetImmutableUserByToken)(nil), "user.TL_user_getImmutableUserByToken")
-	proto.RegisterType((*TLUserSetAccountDaysTTL)(nil), "user.TL_user_setAccountDaysTTL")
-	proto.RegisterType((*TLUserGetAccountDaysTTL)(nil), "user.TL_user_getAccountDaysTTL")
-	proto.RegisterType((*TLUserGetNotifySettings)(nil), "user.TL_user_getNotifySettings")
-	proto.RegisterType((*TLUserGetNotifySettingsList)(nil), "user.TL_user_getNotifySettingsList")
-	proto.RegisterType((*TLUserSetNotifySettings)(nil), "user.TL_user_setNotifySettings")
-	proto.RegisterType((*TLUserResetNotifySettings)(nil), "user.TL_user_resetNotifySettings")
-	proto.RegisterType((*TLUserGetAllNotifySettings)(nil), "user.TL_user_getAllNotifySettings")
-	proto.RegisterType((*TLUserGetGlobalPrivacySettings)(nil), "user.TL_user_getGlobalPrivacySettings")
-	proto.RegisterType((*TLUserSetGlobalPrivacySettings)(nil), "user.TL_user_setGlobalPrivacySettings")
-	proto.RegisterType((*TLUserGetPrivacy)(nil), "user.TL_user_getPrivacy")
-	proto.RegisterType((*TLUserSetPrivacy)(nil), "user.TL_user_setPrivacy")
-	proto.RegisterType((*TLUserCheckPrivacy)(nil), "user.TL_user_checkPrivacy")
-	proto.RegisterType((*TLUserAddPeerSettings)(nil), "user.TL_user_addPeerSettings")
-	proto.RegisterType((*TLUserGetPeerSettings)(nil), "user.TL_user_getPeerSettings")
-	proto.RegisterType((*TLUserDeletePeerSettings)(nil), "user.TL_user_deletePeerSettings")
-	proto.RegisterType((*TLUserChangePhone)(nil), "user.TL_user_changePhone")
-	proto.RegisterType((*TLUserCreateNewPredefinedUser)(nil), "user.TL_user_createNewPredefinedUser")
-	proto.RegisterType((*TLUserGetPredefinedUser)(nil), "user.TL_user_getPredefinedUser")
-	proto.RegisterType((*TLUserGetAllPredefinedUser)(nil), "user.TL_user_getAllPredefinedUser")
-	proto.RegisterType((*TLUserUpdatePredefinedFirstAndLastName)(nil), "user.TL_user_updatePredefinedFirstAndLastName")
-	proto.RegisterType((*TLUserUpdatePredefinedVerified)(nil), "user.TL_user_updatePredefinedVerified")
-	proto.RegisterType((*TLUserUpdatePredefinedUsername)(nil), "user.TL_user_updatePredefinedUsername")
-	proto.RegisterType((*TLUserUpdatePredefinedCode)(nil), "user.TL_user_updatePredefinedCode")
-	proto.RegisterType((*TLUserPredefinedBindRegisteredUserId)(nil), "user.TL_user_predefinedBindRegisteredUserId")
-	proto.RegisterType((*TLUserCreateNewUser)(nil), "user.TL_user_createNewUser")
-	proto.RegisterType((*TLUserDeleteUser)(nil), "user.TL_user_deleteUser")
-	proto.RegisterType((*TLUserBlockPeer)(nil), "user.TL_user_blockPeer")
-	proto.RegisterType((*TLUserUnBlockPeer)(nil), "user.TL_user_unBlockPeer")
-	proto.RegisterType((*TLUserBlockedByUser)(nil), "user.TL_user_blockedByUser")
-	proto.RegisterType((*TLUserIsBlockedByUser)(nil), "user.TL_user_isBlockedByUser")
-	proto.RegisterType((*TLUserCheckBlockUserList)(nil), "user.TL_user_checkBlockUserList")
-	proto.RegisterType((*TLUserGetBlockedList)(nil), "user.TL_user_getBlockedList")
-	proto.RegisterType((*TLUserGetContactSignUpNotification)(nil), "user.TL_user_getContactSignUpNotification")
-	proto.RegisterType((*TLUserSetContactSignUpNotification)(nil), "user.TL_user_setContactSignUpNotification")
-	proto.RegisterType((*TLUserGetContentSettings)(nil), "user.TL_user_getContentSettings")
-	proto.RegisterType((*TLUserSetContentSettings)(nil), "user.TL_user_setContentSettings")
-	proto.RegisterType((*TLUserDeleteContact)(nil), "user.TL_user_deleteContact")
-	proto.RegisterType((*TLUserGetContactList)(nil), "user.TL_user_getContactList")
-	proto.RegisterType((*TLUserGetContactIdList)(nil), "user.TL_user_getContactIdList")
-	proto.RegisterType((*TLUserGetContact)(nil), "user.TL_user_getContact")
-	proto.RegisterType((*TLUserAddContact)(nil), "user.TL_user_addContact")
-	proto.RegisterType((*TLUserCheckContact)(nil), "user.TL_user_checkContact")
-	proto.RegisterType((*TLUserGetImportersByPhone)(nil), "user.TL_user_getImportersByPhone")
-	proto.RegisterType((*TLUserDeleteImportersByPhone)(nil), "user.TL_user_deleteImportersByPhone")
-	proto.RegisterType((*TLUserImportContacts)(nil), "user.TL_user_importContacts")
-	proto.RegisterType((*TLUserGetCountryCode)(nil), "user.TL_user_getCountryCode")
-	proto.RegisterType((*TLUserUpdateAbout)(nil), "user.TL_user_updateAbout")
-	proto.RegisterType((*TLUserUpdateFirstAndLastName)(nil), "user.TL_user_updateFirstAndLastName")
-	proto.RegisterType((*TLUserUpdateVerified)(nil), "user.TL_user_updateVerified")
-	proto.RegisterType((*TLUserUpdateUsername)(nil), "user.TL_user_updateUsername")
-	proto.RegisterType((*TLUserUpdateProfilePhoto)(nil), "user.TL_user_updateProfilePhoto")
-	proto.RegisterType((*TLUserDeleteProfilePhotos)(nil), "user.TL_user_deleteProfilePhotos")
-	proto.RegisterType((*TLUserGetProfilePhotos)(nil), "user.TL_user_getProfilePhotos")
+// --------------------------------------------------------------------------------------------
+// user.setDefaultHistoryTTL user_id:long ttl:int = Bool;
+type TLUserSetDefaultHistoryTTL struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ttl                  int32         `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLUserSetDefaultHistoryTTL) Reset()         { *m = TLUserSetDefaultHistoryTTL{} }
+func (m *TLUserSetDefaultHistoryTTL) String() string { return proto.CompactTextString(m) }
+func (*TLUserSetDefaultHistoryTTL) ProtoMessage()    {}
+func (*TLUserSetDefaultHistoryTTL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6e3d997b4637694, []int{95}
+}
+func (m *TLUserSetDefaultHistoryTTL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLUserSetDefaultHistoryTTL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLUserSetDefaultHistoryTTL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLUserSetDefaultHistoryTTL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLUserSetDefaultHistoryTTL.Merge(m, src)
+}
+func (m *TLUserSetDefaultHistoryTTL) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLUserSetDefaultHistoryTTL) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLUserSetDefaultHistoryTTL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLUserSetDefaultHistoryTTL proto.InternalMessageInfo
+
+func (m *TLUserSetDefaultHistoryTTL) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLUserSetDefaultHistoryTTL) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLUserSetDefaultHistoryTTL) GetTtl() int32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+// user.getDefaultHistoryTTL user_id:long = DefaultHistoryTTL;
+type TLUserGetDefaultHistoryTTL struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=user.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLUserGetDefaultHistoryTTL) Reset()         { *m = TLUserGetDefaultHistoryTTL{} }
+func (m *TLUserGetDefaultHistoryTTL) String() string { return proto.CompactTextString(m) }
+func (*TLUserGetDefaultHistoryTTL) ProtoMessage()    {}
+func (*TLUserGetDefaultHistoryTTL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6e3d997b4637694, []int{96}
+}
+func (m *TLUserGetDefaultHistoryTTL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLUserGetDefaultHistoryTTL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLUserGetDefaultHistoryTTL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLUserGetDefaultHistoryTTL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLUserGetDefaultHistoryTTL.Merge(m, src)
+}
+func (m *TLUserGetDefaultHistoryTTL) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLUserGetDefaultHistoryTTL) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLUserGetDefaultHistoryTTL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLUserGetDefaultHistoryTTL proto.InternalMessageInfo
+
+func (m *TLUserGetDefaultHistoryTTL) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLUserGetDefaultHistoryTTL) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("user.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*LastSeenData)(nil), "user.LastSeenData")
+	proto.RegisterType((*TLLastSeenData)(nil), "user.TL_lastSeenData")
+	proto.RegisterType((*PeerPeerNotifySettings)(nil), "user.PeerPeerNotifySettings")
+	proto.RegisterType((*TLPeerPeerNotifySettings)(nil), "user.TL_peerPeerNotifySettings")
+	proto.RegisterType((*UserImportedContacts)(nil), "user.UserImportedContacts")
+	proto.RegisterType((*TLUserImportedContacts)(nil), "user.TL_userImportedContacts")
+	proto.RegisterType((*UsersFound)(nil), "user.UsersFound")
+	proto.RegisterType((*TLUsersDataFound)(nil), "user.TL_usersDataFound")
+	proto.RegisterType((*TLUsersIdFound)(nil), "user.TL_usersIdFound")
+	proto.RegisterType((*TLUserGetLastSeens)(nil), "user.TL_user_getLastSeens")
+	proto.RegisterType((*TLUserUpdateLastSeen)(nil), "user.TL_user_updateLastSeen")
+	proto.RegisterType((*TLUserGetLastSeen)(nil), "user.TL_user_getLastSeen")
+	proto.RegisterType((*TLUserGetImmutableUser)(nil), "user.TL_user_getImmutableUser")
+	proto.RegisterType((*TLUserGetMutableUsers)(nil), "user.TL_user_getMutableUsers")
+	proto.RegisterType((*TLUserGetImmutableUserByPhone)(nil), "user.TL_user_getImmutableUserByPhone")
+	proto.RegisterType((*TLUserGetImmutableUserByToken)(nil), "user.TL_user_getImmutableUserByToken")
+	proto.RegisterType((*TLUserSetAccountDaysTTL)(nil), "user.TL_user_setAccountDaysTTL")
+	proto.RegisterType((*TLUserGetAccountDaysTTL)(nil), "user.TL_user_getAccountDaysTTL")
+	proto.RegisterType((*TLUserGetNotifySettings)(nil), "user.TL_user_getNotifySettings")
+	proto.RegisterType((*TLUserGetNotifySettingsList)(nil), "user.TL_user_getNotifySettingsList")
+	proto.RegisterType((*TLUserSetNotifySettings)(nil), "user.TL_user_setNotifySettings")
+	proto.RegisterType((*TLUserResetNotifySettings)(nil), "user.TL_user_resetNotifySettings")
+	proto.RegisterType((*TLUserGetAllNotifySettings)(nil), "user.TL_user_getAllNotifySettings")
+	proto.RegisterType((*TLUserGetGlobalPrivacySettings)(nil), "user.TL_user_getGlobalPrivacySettings")
+	proto.RegisterType((*TLUserSetGlobalPrivacySettings)(nil), "user.TL_user_setGlobalPrivacySettings")
+	proto.RegisterType((*TLUserGetPrivacy)(nil), "user.TL_user_getPrivacy")
+	proto.RegisterType((*TLUserSetPrivacy)(nil), "user.TL_user_setPrivacy")
+	proto.RegisterType((*TLUserCheckPrivacy)(nil), "user.TL_user_checkPrivacy")
+	proto.RegisterType((*TLUserAddPeerSettings)(nil), "user.TL_user_addPeerSettings")
+	proto.RegisterType((*TLUserGetPeerSettings)(nil), "user.TL_user_getPeerSettings")
+	proto.RegisterType((*TLUserDeletePeerSettings)(nil), "user.TL_user_deletePeerSettings")
+	proto.RegisterType((*TLUserChangePhone)(nil), "user.TL_user_changePhone")
+	proto.RegisterType((*TLUserCreateNewPredefinedUser)(nil), "user.TL_user_createNewPredefinedUser")
+	proto.RegisterType((*TLUserGetPredefinedUser)(nil), "user.TL_user_getPredefinedUser")
+	proto.RegisterType((*TLUserGetAllPredefinedUser)(nil), "user.TL_user_getAllPredefinedUser")
+	proto.RegisterType((*TLUserUpdatePredefinedFirstAndLastName)(nil), "user.TL_user_updatePredefinedFirstAndLastName")
+	proto.RegisterType((*TLUserUpdatePredefinedVerified)(nil), "user.TL_user_updatePredefinedVerified")
+	proto.RegisterType((*TLUserUpdatePredefinedUsername)(nil), "user.TL_user_updatePredefinedUsername")
+	proto.RegisterType((*TLUserUpdatePredefinedCode)(nil), "user.TL_user_updatePredefinedCode")
+	proto.RegisterType((*TLUserPredefinedBindRegisteredUserId)(nil), "user.TL_user_predefinedBindRegisteredUserId")
+	proto.RegisterType((*TLUserCreateNewUser)(nil), "user.TL_user_createNewUser")
+	proto.RegisterType((*TLUserDeleteUser)(nil), "user.TL_user_deleteUser")
+	proto.RegisterType((*TLUserBlockPeer)(nil), "user.TL_user_blockPeer")
+	proto.RegisterType((*TLUserUnBlockPeer)(nil), "user.TL_user_unBlockPeer")
+	proto.RegisterType((*TLUserBlockedByUser)(nil), "user.TL_user_blockedByUser")
+	proto.RegisterType((*TLUserIsBlockedByUser)(nil), "user.TL_user_isBlockedByUser")
+	proto.RegisterType((*TLUserCheckBlockUserList)(nil), "user.TL_user_checkBlockUserList")
+	proto.RegisterType((*TLUserGetBlockedList)(nil), "user.TL_user_getBlockedList")
+	proto.RegisterType((*TLUserGetContactSignUpNotification)(nil), "user.TL_user_getContactSignUpNotification")
+	proto.RegisterType((*TLUserSetContactSignUpNotification)(nil), "user.TL_user_setContactSignUpNotification")
+	proto.RegisterType((*TLUserGetContentSettings)(nil), "user.TL_user_getContentSettings")
+	proto.RegisterType((*TLUserSetContentSettings)(nil), "user.TL_user_setContentSettings")
+	proto.RegisterType((*TLUserDeleteContact)(nil), "user.TL_user_deleteContact")
+	proto.RegisterType((*TLUserGetContactList)(nil), "user.TL_user_getContactList")
+	proto.RegisterType((*TLUserGetContactIdList)(nil), "user.TL_user_getContactIdList")
+	proto.RegisterType((*TLUserGetContact)(nil), "user.TL_user_getContact")
+	proto.RegisterType((*TLUserAddContact)(nil), "user.TL_user_addContact")
+	proto.RegisterType((*TLUserCheckContact)(nil), "user.TL_user_checkContact")
+	proto.RegisterType((*TLUserGetImportersByPhone)(nil), "user.TL_user_getImportersByPhone")
+	proto.RegisterType((*TLUserDeleteImportersByPhone)(nil), "user.TL_user_deleteImportersByPhone")
+	proto.RegisterType((*TLUserImportContacts)(nil), "user.TL_user_importContacts")
+	proto.RegisterType((*TLUserGetCountryCode)(nil), "user.TL_user_getCountryCode")
+	proto.RegisterType((*TLUserUpdateAbout)(nil), "user.TL_user_updateAbout")
+	proto.RegisterType((*TLUserUpdateFirstAndLastName)(nil), "user.TL_user_updateFirstAndLastName")
+	proto.RegisterType((*TLUserUpdateVerified)(nil), "user.TL_user_updateVerified")
+	proto.RegisterType((*TLUserUpdateUsername)(nil), "user.TL_user_updateUsername")
+	proto.RegisterType((*TLUserUpdateProfilePhoto)(nil), "user.TL_user_updateProfilePhoto")
+	proto.RegisterType((*TLUserDeleteProfilePhotos)(nil), "user.TL_user_deleteProfilePhotos")
+	proto.RegisterType((*TLUserGetProfilePhotos)(nil), "user.TL_user_getProfilePhotos")
 	proto.RegisterType((*TLUserSetBotCommands)(nil), "user.TL_user_setBotCommands")
 	proto.RegisterType((*TLUserIsBot)(nil), "user.TL_user_isBot")
 	proto.RegisterType((*TLUserGetBotInfo)(nil), "user.TL_user_getBotInfo")
@@ -6157,6 +6537,12 @@ func init() {
 	proto.RegisterType((*TLUserUpdateBotData)(nil), "user.TL_user_updateBotData")
 	proto.RegisterType((*TLUserGetImmutableUserV2)(nil), "user.TL_user_getImmutableUserV2")
 	proto.RegisterType((*TLUserGetMutableUsersV2)(nil), "user.TL_user_getMutableUsersV2")
+	proto.RegisterType((*TLUserCreateBot)(nil), "user.TL_user_createBot")
+	proto.RegisterType((*TLUserGetBotIdByToken)(nil), "user.TL_user_getBotIdByToken")
+	proto.RegisterType((*TLUserGetBotsByCreator)(nil), "user.TL_user_getBotsByCreator")
+	proto.RegisterType((*TLUserResetBotToken)(nil), "user.TL_user_resetBotToken")
+	proto.RegisterType((*TLUserSetDefaultHistoryTTL)(nil), "user.TL_user_setDefaultHistoryTTL")
+	proto.RegisterType((*TLUserGetDefaultHistoryTTL)(nil), "user.TL_user_getDefaultHistoryTTL")
 	proto.RegisterType((*Vector_LastSeenData)(nil), "user.Vector_LastSeenData")
 	proto.RegisterType((*Vector_ImmutableUser)(nil), "user.Vector_ImmutableUser")
 	proto.RegisterType((*Vector_PeerPeerNotifySettings)(nil), "user.Vector_PeerPeerNotifySettings")
@@ -6172,301 +6558,312 @@ func init() {
 func init() { proto.RegisterFile("user.tl.proto", fileDescriptor_d6e3d997b4637694) }
 
 var fileDescriptor_d6e3d997b4637694 = []byte{
-	// 4703 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x6d, 0x70, 0x1c, 0xc5,
-	0x95, 0x1a, 0xad, 0x56, 0x92, 0x5b, 0xb6, 0xdc, 0x1e, 0x4b, 0xf6, 0x6a, 0xf5, 0xe1, 0xf5, 0x18,
-	0x7f, 0x60, 0x63, 0x99, 0x08, 0xee, 0xb8, 0x90, 0xbb, 0x0b, 0x96, 0x8c, 0x89, 0x82, 0xb0, 0x95,
-	0xb5, 0xec, 0xbb, 0xa2, 0xee, 0x6e, 0x6f, 0xb4, 0xd3, 0x92, 0x06, 0xaf, 0x66, 0x36, 0x33, 0xb3,
-	0x06, 0xe5, 0x92, 0x0b, 0x0e, 0x9f, 0x29, 0x1f, 0x86, 0xf3, 0x71, 0x0e, 0x84, 0x80, 0x2b, 0x60,
-	0x48, 0x0c, 0x84, 0x98, 0x14, 0x57, 0x49, 0x39, 0xc9, 0xdd, 0xe5, 0xa3, 0x30, 0x39, 0x72, 0xe0,
-	0xb8, 0x28, 0x93, 0x8a, 0x0b, 0x1c, 0x6c, 0x8c, 0x2f, 0x24, 0x71, 0x72, 0x15, 0xb8, 0xd8, 0x31,
-	0x46, 0x57, 0xd3, 0xd3, 0x33, 0xd3, 0x3d, 0xdd, 0xb3, 0x32, 0xd6, 0x2e, 0x38, 0x3f, 0xa0, 0xb4,
-	0xf3, 0x5e, 0xbf, 0xf7, 0xfa, 0xf5, 0xeb, 0xd7, 0xef, 0xbd, 0x7e, 0x6d, 0x30, 0xab, 0x64, 0x23,
-	0xab, 0xdb, 0x29, 0x74, 0x17, 0x2d, 0xd3, 0x31, 0xe5, 0x3a, 0xf7, 0x67, 0x7a, 0xe5, 0xa8, 0xee,
-	0x8c, 0x95, 0x86, 0xbb, 0xf3, 0xe6, 0xf8, 0xaa, 0x51, 0x73, 0xd4, 0x5c, 0x85, 0x81, 0xc3, 0xa5,
-	0x11, 0xfc, 0x0b, 0xff, 0xc0, 0x7f, 0x79, 0x83, 0xd2, 0x5d, 0xa3, 0xa6, 0x39, 0x5a, 0x40, 0x21,
-	0xd6, 0x8d, 0x96, 0x5a, 0x2c, 0x22, 0xcb, 0x26, 0xf0, 0xb4, 0x9d, 0x1f, 0x43, 0xe3, 0xaa, 0xcb,
-	0x25, 0x6f, 0x5a, 0x28, 0xe7, 0x4c, 0x14, 0x91, 0x0f, 0x6b, 0x0b, 0x61, 0x8e, 0xa5, 0x1a, 0x76,
-	0xd1, 0xb4, 0x1c, 0x02, 0x6a, 0x09, 0x41, 0xf6, 0x84, 0x91, 0xf7, 0xbe, 0x2a, 0xdf, 0x93, 0xc0,
-	0xcc, 0x01, 0xd5, 0x76, 0x36, 0x20, 0x64, 0xac, 0x51, 0x1d, 0x55, 0x5e, 0x0c, 0x9a, 0x8b, 0x16,
-	0xd2, 0xf4, 0xbc, 0xea, 0xa0, 0x9c, 0xa1, 0x8e, 0xa3, 0x94, 0x94, 0x91, 0x96, 0xcd, 0xc8, 0xce,
-	0x0a, 0xbe, 0xae, 0x53, 0xc7, 0x91, 0xfc, 0x27, 0xa0, 0x29, 0x6f, 0x1a, 0xb6, 0x63, 0x95, 0xf2,
-	0x8e, 0x69, 0xa5, 0x6a, 0x33, 0xd2, 0xb2, 0xe6, 0x9e, 0xb9, 0xdd, 0x78, 0xfa, 0x43, 0x03, 0x7d,
-	0x21, 0x28, 0x4b, 0xe3, 0xc9, 0xf3, 0x41, 0x83, 0x8b, 0x92, 0xd3, 0xb5, 0x54, 0x22, 0x23, 0x2d,
-	0x4b, 0x64, 0xeb, 0xdd, 0x9f, 0xfd, 0x9a, 0x9c, 0x01, 0x33, 0x0b, 0xaa, 0xed, 0xe4, 0x6c, 0x84,
-	0x8c, 0x9c, 0xea, 0xa4, 0xea, 0x30, 0x14, 0x14, 0x88, 0x68, 0xab, 0x1d, 0x39, 0x05, 0x1a, 0xd0,
-	0x4d, 0x45, 0xdd, 0x42, 0x76, 0x2a, 0x99, 0x91, 0x96, 0x25, 0xb3, 0xfe, 0x4f, 0xe5, 0x23, 0x60,
-	0xf6, 0xd0, 0x40, 0xae, 0x40, 0xcf, 0x62, 0x19, 0x48, 0x6a, 0xaa, 0xa3, 0xf6, 0x60, 0xe1, 0x9b,
-	0x7a, 0x64, 0x4f, 0x30, 0x7a, 0xa2, 0x59, 0x0f, 0x41, 0x39, 0x2e, 0x81, 0x79, 0x83, 0x08, 0x59,
-	0xee, 0x7f, 0xeb, 0x4c, 0x47, 0x1f, 0x99, 0xd8, 0x80, 0x1c, 0x47, 0x37, 0x46, 0xed, 0x2a, 0xab,
-	0xa2, 0x1d, 0xcc, 0x28, 0x22, 0x64, 0xe1, 0xe5, 0xc3, 0xca, 0x48, 0x66, 0x1b, 0xdd, 0x0f, 0x43,
-	0x13, 0x45, 0xe4, 0xea, 0x09, 0x03, 0x75, 0x8d, 0x68, 0xa2, 0xde, 0xfd, 0xd9, 0xaf, 0xc9, 0x57,
-	0x80, 0x46, 0x9b, 0xc8, 0x87, 0xd5, 0xd0, 0xd4, 0xd3, 0xde, 0x3d, 0xee, 0xe0, 0xb5, 0xec, 0xe6,
-	0xa7, 0x90, 0x0d, 0x90, 0x95, 0xf5, 0xa0, 0x6d, 0x68, 0x20, 0x57, 0x14, 0xcf, 0xb4, 0x87, 0x55,
-	0x57, 0x87, 0x27, 0xbc, 0x58, 0x2d, 0xbe, 0xe2, 0x5e, 0xad, 0x05, 0x2d, 0x1b, 0xdd, 0xc5, 0x1b,
-	0x77, 0x8d, 0x0c, 0x69, 0x7d, 0xa6, 0xe1, 0xa8, 0x79, 0xa7, 0xda, 0x6a, 0xbb, 0x1c, 0x34, 0xea,
-	0x84, 0x63, 0x2a, 0x91, 0x49, 0x2c, 0x6b, 0xea, 0x49, 0x05, 0x0a, 0x88, 0x88, 0x92, 0x0d, 0x30,
-	0xe5, 0xab, 0xc0, 0xec, 0xa2, 0x59, 0x2c, 0x15, 0x54, 0x2b, 0xa7, 0x1b, 0x5b, 0x74, 0x07, 0xd9,
-	0xa9, 0x3a, 0x3c, 0x78, 0x7e, 0xa8, 0x3d, 0x0f, 0xee, 0x8f, 0x6d, 0x26, 0xf8, 0xfd, 0x1e, 0xba,
-	0x3b, 0x2b, 0x0b, 0x39, 0xd6, 0x44, 0x2e, 0x4f, 0xe6, 0x99, 0x4a, 0x66, 0x12, 0xcb, 0x12, 0xd9,
-	0x59, 0xf8, 0x6b, 0x30, 0xf9, 0x45, 0x20, 0xe9, 0xce, 0xc0, 0x4e, 0xd5, 0x63, 0xf2, 0xb3, 0x02,
-	0xf2, 0xae, 0xaa, 0xb2, 0x1e, 0x4c, 0xbe, 0x08, 0x34, 0x97, 0x8a, 0x9a, 0xab, 0x1e, 0x5d, 0xcb,
-	0x15, 0x74, 0xdb, 0x49, 0x35, 0x60, 0x5a, 0x33, 0xbd, 0xaf, 0xfd, 0xda, 0x80, 0x6e, 0x3b, 0xca,
-	0xb5, 0x60, 0xfe, 0xd0, 0x40, 0xae, 0x24, 0x52, 0xf1, 0xa5, 0xec, 0x7a, 0xa5, 0x3d, 0xad, 0x89,
-	0x56, 0xc3, 0x5f, 0xad, 0x63, 0x12, 0x00, 0x2e, 0xdc, 0x5e, 0x6b, 0x96, 0x0c, 0xad, 0xca, 0x6b,
-	0xd4, 0x02, 0x92, 0x79, 0xb3, 0x64, 0x38, 0xc4, 0xac, 0xbd, 0x1f, 0xf2, 0x52, 0x5f, 0x35, 0x9e,
-	0xe6, 0xe7, 0x30, 0xaa, 0xf1, 0xb6, 0xa4, 0xa7, 0x9e, 0x05, 0xa0, 0xc9, 0x40, 0x37, 0x39, 0x39,
-	0x73, 0x64, 0xc4, 0x46, 0x0e, 0x36, 0xf3, 0x19, 0x59, 0xe0, 0x7e, 0x5a, 0x8f, 0xbf, 0xb8, 0xbb,
-	0xc3, 0x57, 0x5c, 0x3d, 0x56, 0x5c, 0xbd, 0xee, 0xa9, 0xec, 0x23, 0x60, 0x0e, 0x51, 0x99, 0xed,
-	0x12, 0xf4, 0xe6, 0xba, 0x84, 0x55, 0x16, 0x0c, 0x95, 0xe5, 0x29, 0xc3, 0x57, 0xd1, 0x87, 0xb1,
-	0x1b, 0xc1, 0x83, 0xfb, 0xb5, 0xf7, 0x36, 0xf4, 0x6f, 0x41, 0x0b, 0x19, 0x9a, 0x1b, 0x45, 0x8e,
-	0xef, 0x66, 0xec, 0xa8, 0xfe, 0xa4, 0x73, 0xd4, 0x5f, 0x33, 0xa8, 0xd5, 0x3d, 0xeb, 0x4e, 0x64,
-	0x6b, 0x75, 0x4d, 0x79, 0x40, 0x02, 0xf3, 0x7c, 0xfa, 0x9e, 0x89, 0xf8, 0x2c, 0xa6, 0xcb, 0x41,
-	0xf2, 0x38, 0x4c, 0xcb, 0xfd, 0xfe, 0x0d, 0x98, 0x2b, 0x98, 0x7c, 0x85, 0x24, 0x53, 0xbe, 0x20,
-	0x81, 0x14, 0x45, 0xbe, 0x7f, 0x7c, 0xbc, 0xe4, 0xa8, 0xc3, 0x05, 0xe4, 0x2e, 0x42, 0xa5, 0x66,
-	0x9f, 0x02, 0x0d, 0x45, 0x4b, 0xdf, 0xa2, 0xe6, 0x27, 0xf0, 0xc4, 0x1b, 0xb3, 0xfe, 0x4f, 0x39,
-	0x0d, 0x1a, 0x23, 0xfb, 0x3d, 0xf8, 0xad, 0x14, 0x83, 0xfd, 0xe9, 0x0a, 0x76, 0x5d, 0x28, 0x56,
-	0xa5, 0xd6, 0xdd, 0xfd, 0xed, 0x98, 0x78, 0xbb, 0x24, 0xb2, 0xb5, 0x8e, 0xa9, 0x18, 0x60, 0x41,
-	0x9c, 0x2a, 0x7a, 0x27, 0x06, 0xc7, 0x4c, 0x03, 0x9d, 0x2f, 0xe7, 0x16, 0x90, 0x2c, 0xba, 0xe3,
-	0xb1, 0x52, 0x66, 0x64, 0xbd, 0x1f, 0xe5, 0xf9, 0x0d, 0x99, 0x9b, 0xcf, 0x7f, 0x95, 0x5b, 0x40,
-	0xd2, 0x71, 0xc7, 0xfb, 0xfc, 0xf0, 0x0f, 0xe5, 0x33, 0xf8, 0x8c, 0xc2, 0xfc, 0x6c, 0xe4, 0xac,
-	0xce, 0x63, 0xbf, 0xb1, 0x46, 0x9d, 0xb0, 0x87, 0x86, 0x06, 0xce, 0x97, 0x53, 0x6c, 0xc4, 0x01,
-	0x41, 0xc2, 0x71, 0x0a, 0x78, 0xc1, 0x93, 0x59, 0xf7, 0x4f, 0x65, 0x73, 0xc8, 0x7e, 0xb4, 0xda,
-	0xec, 0x95, 0x2f, 0x4b, 0x0c, 0xb7, 0xc8, 0x81, 0x5c, 0xe9, 0xc9, 0x32, 0xc1, 0x46, 0x5d, 0x7c,
-	0xb0, 0x91, 0xa4, 0x83, 0x0d, 0xe5, 0x3e, 0x09, 0x74, 0xc6, 0xca, 0xe8, 0x3a, 0xdc, 0x8a, 0xcb,
-	0xb9, 0x14, 0x24, 0x5d, 0xde, 0xfc, 0x19, 0xe1, 0xc6, 0x21, 0x1b, 0x1d, 0xbd, 0x90, 0xf5, 0xe0,
-	0xca, 0xcf, 0x24, 0xc6, 0x56, 0x2e, 0x44, 0xf5, 0x31, 0xb1, 0x5a, 0xfd, 0x7b, 0x89, 0xd5, 0xc6,
-	0x41, 0xbb, 0x3f, 0x37, 0x0b, 0x55, 0x7d, 0x76, 0x8a, 0x01, 0x3a, 0x68, 0xbb, 0x2f, 0x14, 0xaa,
-	0xcc, 0xcf, 0x02, 0x19, 0x8a, 0xdf, 0x35, 0x05, 0x73, 0x58, 0x2d, 0x0c, 0x7a, 0x0e, 0xb7, 0x6a,
-	0x3c, 0x9f, 0x92, 0x42, 0xa6, 0xf6, 0xfb, 0xc4, 0x54, 0xbe, 0x92, 0x32, 0x80, 0x3a, 0x6c, 0x00,
-	0x5d, 0x81, 0x01, 0x08, 0x25, 0xa0, 0x6c, 0xe0, 0xb3, 0x40, 0xa6, 0x94, 0x44, 0xf0, 0x2a, 0x2e,
-	0x61, 0x1b, 0x68, 0xdc, 0x8c, 0x26, 0x68, 0xbb, 0x6e, 0xd8, 0x8c, 0x26, 0x5c, 0xb3, 0x56, 0x9e,
-	0x90, 0x42, 0x09, 0xec, 0x0f, 0x42, 0x02, 0x79, 0x39, 0x48, 0x5a, 0xa5, 0x02, 0xf2, 0x4e, 0xde,
-	0xa6, 0x9e, 0x96, 0x70, 0xf3, 0x78, 0xb2, 0x64, 0x4b, 0x05, 0x94, 0xf5, 0x50, 0x94, 0x2f, 0x4a,
-	0x61, 0x08, 0x96, 0x1f, 0x43, 0xf9, 0xcd, 0x1f, 0x80, 0xbc, 0xb1, 0x7e, 0xf4, 0xa0, 0x14, 0x86,
-	0x0a, 0xaa, 0xa6, 0xb9, 0x7b, 0xff, 0x02, 0x73, 0x55, 0x1f, 0xe2, 0x5c, 0x55, 0x2b, 0xe3, 0xaa,
-	0x04, 0x06, 0xba, 0x4b, 0x62, 0xe2, 0x9f, 0x0b, 0x6f, 0x52, 0xca, 0xc3, 0x12, 0x48, 0xfb, 0x12,
-	0x6a, 0xa8, 0x80, 0x1c, 0x74, 0x01, 0x0a, 0xf9, 0x0f, 0x61, 0xf4, 0x9c, 0x1f, 0x53, 0x8d, 0x51,
-	0x34, 0xad, 0x38, 0x2e, 0x56, 0xb8, 0x20, 0xc0, 0xab, 0xa3, 0x03, 0xbc, 0x7b, 0x6b, 0xc3, 0x08,
-	0x2f, 0x6f, 0x21, 0x37, 0xed, 0x43, 0x37, 0x0e, 0x5a, 0x48, 0x43, 0x23, 0xba, 0x81, 0xb4, 0xe9,
-	0xc4, 0xd8, 0xc2, 0x88, 0x52, 0xee, 0x04, 0x60, 0x44, 0xb7, 0x6c, 0xc7, 0xcb, 0x39, 0x3d, 0x59,
-	0x66, 0xe0, 0x2f, 0x38, 0xdf, 0xfc, 0x30, 0x98, 0x81, 0xd3, 0x10, 0x0c, 0x4d, 0x92, 0x5a, 0x84,
-	0x57, 0x0e, 0xeb, 0xf6, 0xcb, 0x61, 0xdd, 0x1b, 0x1c, 0x4b, 0x37, 0x46, 0x37, 0xa9, 0x85, 0x12,
-	0xca, 0x36, 0xba, 0xe8, 0x78, 0x68, 0x1a, 0x34, 0xba, 0x22, 0xe1, 0x91, 0xf5, 0x98, 0x6e, 0xf0,
-	0x5b, 0x96, 0x41, 0x5d, 0xde, 0xd4, 0x50, 0xaa, 0x01, 0x7f, 0xc7, 0x7f, 0xbb, 0xf8, 0x5b, 0x90,
-	0xa5, 0x8f, 0xe8, 0x48, 0x4b, 0x35, 0xe2, 0xa0, 0x3f, 0xf8, 0xad, 0x8c, 0x31, 0xa1, 0x59, 0x15,
-	0xf5, 0xa1, 0x6c, 0x8c, 0x1e, 0xbd, 0x15, 0x61, 0xa6, 0xfc, 0x44, 0x02, 0xcb, 0xd8, 0x84, 0x31,
-	0xa4, 0xbb, 0xd6, 0xd5, 0xf6, 0x6a, 0x43, 0x1b, 0xf0, 0x35, 0xf7, 0xc7, 0xb1, 0xc0, 0xca, 0x36,
-	0xea, 0x04, 0x8f, 0xce, 0x69, 0x13, 0x59, 0xb9, 0xca, 0xce, 0x85, 0x36, 0x91, 0xba, 0x88, 0x89,
-	0xec, 0x29, 0x23, 0xcd, 0x46, 0xdf, 0xee, 0x2a, 0x2a, 0xcd, 0x9f, 0x51, 0x06, 0x5e, 0x77, 0x2e,
-	0x9a, 0xf3, 0xb1, 0x95, 0xcf, 0x86, 0x46, 0x16, 0x15, 0xb5, 0xcf, 0xdd, 0x0a, 0x15, 0x15, 0xd3,
-	0xdf, 0x6b, 0x75, 0xe1, 0x5e, 0x73, 0x1d, 0xf1, 0x12, 0x5f, 0x82, 0x62, 0xc0, 0xbb, 0x57, 0x37,
-	0xb4, 0x2c, 0x1a, 0xd5, 0x6d, 0x07, 0x59, 0x9e, 0xd2, 0xfa, 0x2b, 0xbc, 0x80, 0x2b, 0xc0, 0x1c,
-	0x2b, 0x60, 0xe0, 0x55, 0xd2, 0xfc, 0x7a, 0x2a, 0xb4, 0x22, 0x9c, 0x95, 0x13, 0x12, 0x68, 0xe5,
-	0x7c, 0xe1, 0x74, 0x76, 0xbc, 0x02, 0x66, 0xd9, 0x28, 0x6f, 0x21, 0x27, 0xe7, 0x06, 0x0c, 0x81,
-	0x47, 0x6e, 0xf2, 0x3e, 0x5e, 0x8b, 0x26, 0xe2, 0xdc, 0xb2, 0xbc, 0x10, 0xcc, 0xc4, 0xb9, 0x27,
-	0xae, 0x36, 0x6a, 0x88, 0x54, 0xc0, 0x9a, 0xc8, 0x37, 0xbc, 0x66, 0xec, 0x3e, 0xab, 0x8f, 0xee,
-	0xb3, 0x76, 0x7a, 0x9f, 0x79, 0x6e, 0x2f, 0xdc, 0x49, 0x9f, 0x0e, 0x03, 0x3b, 0xef, 0x58, 0x9c,
-	0xce, 0x2c, 0x63, 0x4f, 0x9c, 0x79, 0xa0, 0xde, 0x42, 0xaa, 0x6d, 0x1a, 0x64, 0x6e, 0xe4, 0x97,
-	0x72, 0xbf, 0x14, 0x14, 0xe9, 0x72, 0xc3, 0x05, 0x33, 0xbf, 0xd9, 0x3d, 0x94, 0x2f, 0x90, 0xc3,
-	0xf8, 0x01, 0x29, 0x3c, 0x8d, 0x4b, 0x46, 0xef, 0x05, 0x26, 0xdd, 0xe7, 0x29, 0x0b, 0xc5, 0x9a,
-	0x43, 0x5a, 0xef, 0x44, 0x55, 0xd6, 0x2e, 0x03, 0x66, 0x62, 0x11, 0x7c, 0x28, 0x29, 0x07, 0xba,
-	0xdf, 0xc8, 0x6e, 0xd9, 0x46, 0x45, 0x7f, 0xba, 0xdd, 0xfb, 0x01, 0x4b, 0xf3, 0xe9, 0x30, 0xd0,
-	0xc3, 0xc1, 0x3f, 0x96, 0xc7, 0x85, 0x55, 0xa5, 0x48, 0xe1, 0x95, 0xe9, 0xea, 0x82, 0xf2, 0xec,
-	0x4e, 0xaa, 0x3c, 0x3b, 0x8a, 0x1c, 0xa2, 0x8c, 0xaa, 0xb0, 0x9e, 0x07, 0xea, 0x49, 0x55, 0xdc,
-	0xb3, 0x1a, 0xf2, 0xcb, 0xf5, 0x23, 0x05, 0x7d, 0x5c, 0x77, 0x48, 0x6d, 0xd6, 0xfb, 0xa1, 0x6c,
-	0x01, 0x17, 0x51, 0x72, 0x91, 0x2b, 0x81, 0x0d, 0xfa, 0xa8, 0xb1, 0xb1, 0x88, 0x53, 0x7c, 0x3d,
-	0xaf, 0x3a, 0xba, 0x69, 0x54, 0x3c, 0xd9, 0x7e, 0x50, 0x0a, 0x19, 0xdb, 0xef, 0x23, 0x63, 0x79,
-	0x31, 0xa8, 0xb7, 0xf5, 0x02, 0x32, 0x1c, 0x72, 0x42, 0x86, 0xd7, 0x2f, 0xbd, 0xa6, 0x59, 0xc8,
-	0x12, 0xa0, 0x52, 0x08, 0xcd, 0x85, 0xe8, 0x05, 0x19, 0x4e, 0xd5, 0x4a, 0x0f, 0xf7, 0x53, 0x69,
-	0x88, 0x5d, 0x75, 0x76, 0xee, 0xa1, 0x67, 0x23, 0xc3, 0xd6, 0x1d, 0x7d, 0x0b, 0xca, 0x21, 0x43,
-	0x1d, 0x2e, 0x04, 0xe1, 0x0b, 0x0c, 0x00, 0x57, 0x7b, 0xdf, 0x95, 0x1b, 0x43, 0x8f, 0xe2, 0x1d,
-	0x05, 0x64, 0xad, 0xaa, 0xb6, 0x67, 0xfc, 0xb2, 0xfe, 0x18, 0xb3, 0x65, 0x08, 0xd7, 0x6a, 0x6c,
-	0x19, 0xe5, 0x06, 0xe6, 0xfe, 0x80, 0x70, 0xea, 0xaf, 0xca, 0xf6, 0x54, 0x1c, 0xa6, 0x68, 0xf3,
-	0x7e, 0xe9, 0xf2, 0xde, 0xda, 0x90, 0xad, 0xaa, 0x69, 0xd5, 0x62, 0x3b, 0x00, 0xda, 0x55, 0x4d,
-	0xcb, 0xe1, 0x10, 0x25, 0x47, 0x2e, 0x48, 0x72, 0xe8, 0xa6, 0x3c, 0x2a, 0xba, 0x7b, 0x59, 0xbc,
-	0xe3, 0x52, 0xaa, 0xa6, 0xe1, 0x84, 0x96, 0x94, 0x63, 0xae, 0xf6, 0xd1, 0xc9, 0x24, 0x92, 0xc1,
-	0x1d, 0xcc, 0x34, 0x02, 0x9a, 0x30, 0x8a, 0x6a, 0xa4, 0x73, 0xab, 0x2d, 0x91, 0x8a, 0xd0, 0xfb,
-	0xb5, 0x1c, 0x37, 0x84, 0xd5, 0x5b, 0x7c, 0x6b, 0x82, 0x2f, 0x64, 0x2d, 0xbb, 0x2a, 0x37, 0x34,
-	0xe3, 0xa0, 0x8b, 0xdd, 0xbf, 0xd5, 0x65, 0x47, 0x5f, 0x44, 0x7a, 0x77, 0xeb, 0xc1, 0x95, 0x74,
-	0xa5, 0xb5, 0xfa, 0x21, 0xea, 0xe6, 0xcd, 0xbb, 0x0c, 0x08, 0x2b, 0x52, 0xfd, 0x46, 0xb1, 0xe4,
-	0x04, 0x97, 0xfc, 0xc1, 0x85, 0x5c, 0xd4, 0xa7, 0x84, 0xd1, 0x72, 0xa5, 0xf7, 0x39, 0x55, 0xb4,
-	0xf1, 0x32, 0xaa, 0xd5, 0xc3, 0x66, 0xc9, 0xa9, 0x46, 0xd1, 0x46, 0x75, 0x09, 0xfb, 0xd9, 0x01,
-	0xfe, 0xa1, 0x3c, 0x29, 0x85, 0x8b, 0xee, 0x71, 0xaf, 0x54, 0x4a, 0x1f, 0x2b, 0xc8, 0x14, 0x59,
-	0x7d, 0x7b, 0x34, 0xab, 0xa7, 0xb3, 0x8d, 0x7f, 0xe1, 0x2e, 0xaf, 0xa7, 0x9b, 0xad, 0xc7, 0x8a,
-	0x79, 0x71, 0x24, 0x61, 0xe7, 0xdc, 0x51, 0x98, 0xbf, 0xdf, 0xca, 0x49, 0x35, 0xdd, 0xac, 0x3d,
-	0x56, 0xaa, 0x74, 0x24, 0x71, 0xa7, 0x2a, 0x53, 0x74, 0xe0, 0xea, 0xa7, 0xe6, 0xe6, 0x88, 0x5e,
-	0x40, 0x83, 0x63, 0xa6, 0x63, 0x56, 0xdd, 0x53, 0x7d, 0x26, 0xf4, 0x54, 0xa4, 0x3e, 0x4a, 0x71,
-	0xb7, 0xab, 0x1e, 0x37, 0xb3, 0x27, 0x73, 0x55, 0x79, 0x2b, 0x7b, 0xa9, 0xf5, 0xb6, 0x91, 0xd3,
-	0x6b, 0x3a, 0x7d, 0xe6, 0xf8, 0xb8, 0x6a, 0x68, 0x95, 0x9f, 0x66, 0x2b, 0xa8, 0x1f, 0x36, 0x9d,
-	0x30, 0x51, 0x49, 0x0e, 0x9b, 0x4e, 0xbf, 0x26, 0xaf, 0x72, 0x1d, 0x9a, 0xc7, 0x92, 0x5c, 0x68,
-	0xcc, 0xa5, 0x8c, 0xd3, 0x17, 0x27, 0x1b, 0x20, 0x29, 0x9b, 0xc0, 0x2c, 0x2a, 0xc3, 0x32, 0x9d,
-	0x4a, 0x75, 0x54, 0x0c, 0x33, 0x41, 0x4a, 0xaf, 0xe9, 0xf4, 0x1b, 0x23, 0xe7, 0x6d, 0x6b, 0xe1,
-	0x64, 0x13, 0xd4, 0x64, 0x95, 0x7f, 0x64, 0x7a, 0x42, 0xd6, 0x96, 0x0a, 0x85, 0xe9, 0x64, 0x86,
-	0x19, 0x30, 0xd3, 0x46, 0x85, 0x91, 0x1c, 0xab, 0x6f, 0xe0, 0x7e, 0xdb, 0x28, 0xb6, 0xec, 0x83,
-	0xd4, 0xf5, 0xb0, 0xb7, 0xb1, 0xae, 0x1e, 0x37, 0x6f, 0xd0, 0x37, 0x38, 0xaa, 0x53, 0xaa, 0xfc,
-	0x8a, 0x5f, 0x01, 0x52, 0xc8, 0x25, 0x9f, 0xb3, 0x31, 0xfd, 0x9c, 0x66, 0xe6, 0x4b, 0xe3, 0xc8,
-	0xa0, 0x6c, 0xa0, 0x15, 0x85, 0xec, 0xd7, 0x10, 0x68, 0xbf, 0x26, 0x5f, 0x02, 0x64, 0x66, 0x60,
-	0xc9, 0x70, 0xf4, 0x02, 0xc9, 0xe1, 0x20, 0x35, 0x64, 0xa3, 0xfb, 0x5d, 0xd1, 0x99, 0x0b, 0x17,
-	0xbf, 0x6b, 0xaa, 0x77, 0xa2, 0xbf, 0xe2, 0x9e, 0x54, 0xf9, 0x14, 0xd3, 0xf9, 0xe1, 0xb3, 0x72,
-	0x63, 0x66, 0x97, 0xdd, 0x74, 0x62, 0xe7, 0x0c, 0x98, 0x49, 0x58, 0x7a, 0x0d, 0x5c, 0x5e, 0xb7,
-	0x0b, 0xf0, 0xf8, 0xe2, 0x26, 0x2e, 0x9d, 0xc9, 0xce, 0xc2, 0x69, 0x56, 0xa1, 0xe1, 0x64, 0xaf,
-	0x04, 0x9a, 0x43, 0xaf, 0xa0, 0x5a, 0xf9, 0xb1, 0xf3, 0xa5, 0x3f, 0x13, 0x48, 0x9f, 0x24, 0xb4,
-	0xa5, 0x4f, 0xba, 0x39, 0x18, 0xba, 0x29, 0x5f, 0x28, 0x69, 0x48, 0xcb, 0x31, 0x51, 0x4c, 0x22,
-	0x0b, 0x7d, 0x40, 0x10, 0x39, 0x85, 0x39, 0x3d, 0x29, 0xf7, 0x44, 0x73, 0xfa, 0x7a, 0x3a, 0xa7,
-	0x9f, 0xac, 0x0d, 0x53, 0x36, 0xcf, 0xb2, 0x7b, 0x4d, 0x07, 0xf7, 0xbc, 0x56, 0x74, 0x07, 0xcb,
-	0x57, 0x00, 0xe8, 0x7e, 0xce, 0x8f, 0xa9, 0x4e, 0x6e, 0x4c, 0xb7, 0x1d, 0xd3, 0x9a, 0x10, 0x9f,
-	0xa9, 0xcd, 0xc3, 0xa6, 0xd3, 0x37, 0xa6, 0x3a, 0x1f, 0xf3, 0x90, 0xe4, 0x6e, 0xd0, 0xe4, 0x0e,
-	0x34, 0x4c, 0x77, 0xa8, 0xdf, 0xa4, 0x1a, 0x19, 0x03, 0x86, 0x4d, 0x67, 0x9d, 0x87, 0x20, 0x5f,
-	0x06, 0x9a, 0x31, 0x7f, 0xa3, 0xa0, 0x1b, 0x28, 0x37, 0x8a, 0x4c, 0x72, 0x01, 0x19, 0x19, 0x32,
-	0xd3, 0x15, 0x0b, 0xe3, 0x5c, 0x83, 0x5c, 0x6f, 0x35, 0xdb, 0x1d, 0xa4, 0x3a, 0x8e, 0x9a, 0x1f,
-	0xcb, 0x8d, 0x23, 0xa3, 0x84, 0x93, 0x02, 0x6e, 0xd4, 0xac, 0x61, 0xd3, 0x59, 0x8d, 0x91, 0xae,
-	0x43, 0x46, 0x49, 0xee, 0x03, 0xf3, 0x28, 0x5e, 0xc5, 0x82, 0x9a, 0x47, 0x63, 0x66, 0x41, 0x43,
-	0x16, 0xce, 0x1c, 0xb8, 0xd1, 0x2d, 0x01, 0xcf, 0xc1, 0x10, 0x55, 0xd9, 0x2d, 0x31, 0x06, 0xca,
-	0xb4, 0x45, 0x6d, 0xea, 0xa9, 0x7e, 0x4f, 0x5a, 0x2b, 0xa8, 0x1f, 0x53, 0xed, 0x9c, 0x63, 0x62,
-	0xdd, 0x36, 0x66, 0x93, 0x63, 0xaa, 0x3d, 0x64, 0x92, 0x66, 0xb1, 0xfa, 0xa0, 0x59, 0xec, 0x11,
-	0xb6, 0xc1, 0x88, 0xee, 0x4f, 0x9b, 0xbe, 0x94, 0x89, 0x4a, 0x49, 0xf9, 0x51, 0x30, 0x77, 0x13,
-	0x72, 0x49, 0xe7, 0x06, 0x04, 0xfd, 0xdb, 0x76, 0x4a, 0xc2, 0x27, 0x65, 0x6c, 0xff, 0xb6, 0xad,
-	0xac, 0x01, 0x2d, 0x84, 0x00, 0xdb, 0x1a, 0x78, 0x09, 0x4b, 0x61, 0x1e, 0xd5, 0x24, 0x4c, 0xa1,
-	0xf9, 0x54, 0x36, 0x80, 0x4e, 0x42, 0x65, 0xb0, 0x6c, 0x87, 0xb4, 0x4f, 0xee, 0x1c, 0x3a, 0xa4,
-	0x6d, 0xe5, 0x2a, 0x20, 0xfb, 0x44, 0xc3, 0x86, 0x05, 0x79, 0x39, 0x4b, 0x29, 0xa6, 0xab, 0xc1,
-	0xa3, 0xb0, 0x16, 0xb4, 0x06, 0x14, 0x98, 0x7b, 0xc1, 0x95, 0x2c, 0x11, 0xaa, 0x8b, 0x99, 0xc1,
-	0xf3, 0xe9, 0x2c, 0x02, 0x4d, 0xbe, 0x96, 0x4d, 0x63, 0xd4, 0xf5, 0x2c, 0xe1, 0xe8, 0x84, 0x40,
-	0x5c, 0x84, 0x2c, 0x52, 0xc5, 0x2c, 0x23, 0x6e, 0x88, 0xc4, 0x53, 0x20, 0xce, 0x0d, 0xaf, 0x65,
-	0x2c, 0x05, 0x0a, 0xc9, 0xa7, 0xd0, 0x1b, 0x98, 0x03, 0x9d, 0xe3, 0xc9, 0x2b, 0x58, 0x12, 0x31,
-	0x99, 0x20, 0xa1, 0x71, 0x25, 0x98, 0x4d, 0x68, 0xf8, 0x67, 0x87, 0xbc, 0x94, 0x1d, 0x2f, 0x6a,
-	0x3d, 0xc6, 0xf0, 0xe5, 0x5f, 0x4f, 0xb9, 0x41, 0x17, 0x65, 0xfe, 0xf2, 0x1c, 0x30, 0xab, 0x2f,
-	0xdb, 0x77, 0x59, 0x4f, 0x6e, 0xe3, 0xba, 0x6b, 0xd7, 0xad, 0xff, 0xab, 0x75, 0xb0, 0x46, 0x56,
-	0x40, 0xda, 0xfb, 0x24, 0xea, 0xcd, 0x86, 0xff, 0xf9, 0xfb, 0xb3, 0x87, 0xea, 0xe4, 0x0e, 0xd0,
-	0x12, 0xe2, 0x84, 0xcd, 0xc8, 0x70, 0xff, 0x37, 0xef, 0x3e, 0x9b, 0x90, 0x17, 0x00, 0x99, 0x82,
-	0x92, 0x6e, 0x63, 0xf8, 0x87, 0xc3, 0x3b, 0x6e, 0x3f, 0x35, 0x39, 0x39, 0x39, 0x29, 0xc9, 0x17,
-	0x81, 0x0e, 0x0f, 0x41, 0xdc, 0xb0, 0x0f, 0xf7, 0x4c, 0x7e, 0xf5, 0x8e, 0x86, 0x90, 0x0c, 0xfd,
-	0xf6, 0x01, 0x1e, 0xf9, 0xc1, 0xb3, 0x0f, 0x9e, 0xf1, 0xc8, 0x2c, 0x00, 0xf3, 0x43, 0x3e, 0x4c,
-	0x6b, 0x32, 0xdc, 0xfa, 0xce, 0x6d, 0xc7, 0x1b, 0xe4, 0x25, 0xa0, 0x8d, 0x42, 0x60, 0x7b, 0x8b,
-	0xe1, 0xd7, 0x9e, 0xd9, 0xfa, 0xe6, 0xa4, 0x47, 0x68, 0x11, 0x98, 0x27, 0x26, 0x04, 0x5f, 0xfd,
-	0xe7, 0x13, 0x77, 0x9e, 0xf6, 0x91, 0xda, 0x59, 0x24, 0x66, 0xab, 0xc1, 0x1f, 0xbe, 0xb0, 0xe7,
-	0xdb, 0x09, 0x79, 0x29, 0xad, 0xbc, 0xa8, 0x63, 0x82, 0x2f, 0xef, 0xff, 0xd5, 0x2f, 0x08, 0xb5,
-	0x55, 0x40, 0x29, 0x43, 0x8d, 0x94, 0x37, 0xe0, 0xeb, 0x47, 0xee, 0x7a, 0xe1, 0xdd, 0x73, 0x1b,
-	0x80, 0xe3, 0x07, 0xf8, 0xbb, 0xc7, 0x4e, 0x9d, 0x26, 0x93, 0xba, 0xd8, 0x57, 0xb2, 0xb8, 0xe3,
-	0x14, 0xfe, 0x78, 0xe7, 0x4b, 0x3b, 0xce, 0x8a, 0x50, 0xb9, 0xee, 0x50, 0xf8, 0xf3, 0xb3, 0xf7,
-	0xdc, 0x7b, 0x26, 0xb2, 0x74, 0xe2, 0xb6, 0x49, 0xf8, 0xc6, 0xc9, 0xbd, 0xb7, 0xd5, 0xc9, 0x2b,
-	0x41, 0xa6, 0x1c, 0x96, 0x1b, 0x08, 0xc1, 0x2f, 0x3d, 0x71, 0xef, 0x23, 0xef, 0xc6, 0x88, 0x1a,
-	0x21, 0x7a, 0xfc, 0xf9, 0x7f, 0x7b, 0xf1, 0x1d, 0x0f, 0x75, 0x31, 0xe8, 0xa2, 0x50, 0x05, 0xfd,
-	0x83, 0xf0, 0xad, 0x7d, 0x0f, 0x15, 0xe5, 0xa5, 0x60, 0x41, 0x64, 0x46, 0xd1, 0xbe, 0x3f, 0xf8,
-	0xf6, 0x81, 0x1f, 0x3d, 0x99, 0x94, 0x57, 0x80, 0x45, 0x2c, 0xa2, 0xb0, 0x73, 0x0d, 0xee, 0x38,
-	0x7a, 0xf8, 0x3f, 0x1a, 0xe4, 0x4b, 0x19, 0xe4, 0xb8, 0x46, 0x3b, 0xf8, 0xd4, 0xa3, 0x87, 0x8e,
-	0x13, 0x4b, 0x57, 0x40, 0x2b, 0x4b, 0x9e, 0xe0, 0xc2, 0x67, 0xf6, 0x7f, 0xee, 0xcd, 0xd3, 0x22,
-	0x9c, 0xb0, 0x19, 0x0d, 0xde, 0x7d, 0xf8, 0xd0, 0xf7, 0x83, 0x1d, 0x43, 0x9b, 0x3a, 0xdd, 0x02,
-	0x06, 0x1f, 0xff, 0xee, 0xb7, 0x9e, 0x20, 0xca, 0x61, 0xad, 0x2f, 0xd2, 0x8b, 0x05, 0x8f, 0xbd,
-	0x70, 0xe7, 0xcb, 0x04, 0x71, 0x61, 0xd4, 0x4c, 0x19, 0xc4, 0x13, 0xaf, 0x6e, 0x1b, 0x93, 0x17,
-	0x83, 0x4e, 0x0a, 0x85, 0x6f, 0x30, 0x82, 0x27, 0xbe, 0xb1, 0xfb, 0xad, 0x64, 0x64, 0xeb, 0x50,
-	0x3d, 0x3e, 0xf0, 0xf0, 0x53, 0xbf, 0x38, 0x43, 0x4c, 0x71, 0x39, 0x63, 0xbb, 0x31, 0xad, 0x38,
-	0xf0, 0x7b, 0xaf, 0xef, 0xde, 0x97, 0xe4, 0x0d, 0x2c, 0x82, 0xf5, 0xe8, 0xa1, 0x57, 0xbe, 0x9c,
-	0x90, 0x2f, 0x11, 0xac, 0x6f, 0x04, 0xf1, 0xf8, 0xe4, 0x4b, 0x9f, 0x27, 0xd3, 0xbd, 0x0c, 0xac,
-	0xe0, 0xfc, 0x40, 0x7c, 0xcb, 0x08, 0xdc, 0xf9, 0xfc, 0x9b, 0x3f, 0x49, 0x44, 0x16, 0x3b, 0xae,
-	0x27, 0x03, 0xfe, 0xe8, 0xf5, 0x43, 0x2f, 0x93, 0xbd, 0xb1, 0xa2, 0xec, 0x08, 0xbf, 0x02, 0x03,
-	0x0f, 0x9e, 0x7d, 0xe8, 0x95, 0xba, 0x88, 0x85, 0x8a, 0x3a, 0x17, 0xe0, 0x89, 0x87, 0x7e, 0x76,
-	0x47, 0xbd, 0x7c, 0x29, 0xb8, 0x98, 0x42, 0x2c, 0xdf, 0x60, 0x00, 0xf7, 0xfc, 0xe6, 0x3b, 0x19,
-	0x39, 0x03, 0x52, 0x22, 0x75, 0x63, 0xad, 0x6c, 0x7d, 0xfa, 0xe6, 0x17, 0x1b, 0xe4, 0x4e, 0xc6,
-	0xe4, 0xc2, 0x6b, 0x72, 0xf8, 0xdc, 0xd3, 0xef, 0x9e, 0x6a, 0x90, 0x17, 0xd2, 0xee, 0x3d, 0xbc,
-	0xc6, 0x86, 0x7b, 0x5f, 0xb9, 0xef, 0xce, 0x53, 0x22, 0x97, 0x49, 0xdd, 0x26, 0xc3, 0xbb, 0x9e,
-	0xfc, 0xd2, 0xef, 0xcf, 0xfa, 0x9b, 0x35, 0x15, 0xa5, 0xe3, 0x5f, 0xa3, 0xc2, 0xed, 0xdb, 0x6e,
-	0x7e, 0xe5, 0x8c, 0xc8, 0x6c, 0x23, 0xf7, 0xad, 0xf0, 0xc8, 0xb1, 0x7d, 0xfe, 0x6e, 0x5a, 0xce,
-	0xd8, 0x24, 0x7f, 0x17, 0x0a, 0x7f, 0xfb, 0xda, 0xdb, 0xbb, 0x02, 0x13, 0x6f, 0x63, 0x2d, 0x84,
-	0xba, 0xb8, 0x84, 0xcf, 0xdd, 0x3e, 0xb9, 0xab, 0x56, 0xbe, 0x1c, 0x2c, 0x65, 0x51, 0x62, 0xaf,
-	0xf2, 0xe0, 0xa1, 0xaf, 0xec, 0x7f, 0x8c, 0x38, 0x2b, 0x76, 0x54, 0xb9, 0x0b, 0x40, 0xf8, 0xda,
-	0x1b, 0xb7, 0x7d, 0x4d, 0x28, 0x3a, 0x7f, 0x2f, 0x07, 0x77, 0x1d, 0xde, 0xfe, 0xd8, 0x69, 0x11,
-	0x2e, 0x7f, 0xa9, 0x06, 0x7f, 0xf9, 0xce, 0xdd, 0xbf, 0x3c, 0x2d, 0x52, 0x31, 0x73, 0xcb, 0x05,
-	0xef, 0xbf, 0xf5, 0xf6, 0xbd, 0x44, 0x1b, 0x4b, 0xa2, 0xda, 0xa0, 0xee, 0xa4, 0xe0, 0x6b, 0x0f,
-	0x7f, 0x7d, 0x1f, 0xc1, 0x5b, 0x16, 0x3d, 0xe4, 0x98, 0x1b, 0x25, 0xf8, 0xef, 0x7b, 0xff, 0x6f,
-	0xfb, 0x64, 0x8c, 0x67, 0xf3, 0xb9, 0x1e, 0xfb, 0xc2, 0x7f, 0x1d, 0x26, 0xeb, 0xcf, 0x9a, 0x59,
-	0x78, 0x79, 0x03, 0xb7, 0xff, 0x61, 0xc7, 0x0b, 0x0d, 0x22, 0xa7, 0xe6, 0x23, 0xec, 0x7e, 0xfa,
-	0xc1, 0x7f, 0x3d, 0xe5, 0x8b, 0xde, 0x15, 0x3d, 0xf8, 0xd8, 0x4b, 0x00, 0xb8, 0xe3, 0xd6, 0xc7,
-	0xbf, 0x5b, 0x27, 0x5f, 0x0c, 0x16, 0x72, 0x9a, 0xe0, 0x50, 0x5f, 0xfa, 0xd6, 0x37, 0xf7, 0x45,
-	0xb5, 0xc1, 0x96, 0xfa, 0xe1, 0x8e, 0xdf, 0xdc, 0x72, 0xe0, 0x74, 0x8c, 0x0d, 0x51, 0x55, 0x77,
-	0xf8, 0x8d, 0x03, 0x5b, 0xef, 0xe1, 0xf6, 0x41, 0x58, 0x2e, 0x87, 0x77, 0x1c, 0xbb, 0xe5, 0xa7,
-	0x44, 0x0f, 0xdd, 0x8c, 0x68, 0xe2, 0xaa, 0x36, 0xfc, 0xd5, 0xb6, 0x2f, 0x1e, 0x16, 0xda, 0x2e,
-	0x5b, 0x56, 0x86, 0xc7, 0x0e, 0xee, 0x7e, 0xac, 0x56, 0x18, 0xda, 0x04, 0x1e, 0xe6, 0x7f, 0xbf,
-	0xf3, 0xcc, 0xe3, 0x93, 0xbe, 0x7d, 0x74, 0x0a, 0xdc, 0x4c, 0x58, 0x8b, 0x84, 0x77, 0xec, 0x3a,
-	0x70, 0x38, 0x11, 0x51, 0xb2, 0xa0, 0x5c, 0x0a, 0xb7, 0xff, 0xf0, 0xae, 0x23, 0xb5, 0xbc, 0x7d,
-	0xb0, 0x48, 0xcf, 0x7e, 0xee, 0x81, 0x63, 0x67, 0x45, 0x73, 0x60, 0x8b, 0x92, 0x70, 0xe7, 0xc1,
-	0x93, 0x7b, 0x5c, 0x2f, 0x04, 0xd9, 0x7d, 0x6f, 0x3a, 0xf0, 0xe4, 0xdd, 0xcf, 0x7f, 0xfb, 0x1d,
-	0x91, 0xf5, 0x84, 0xc5, 0x3c, 0x78, 0xcf, 0xc9, 0xfb, 0x1e, 0x49, 0xf0, 0x41, 0x9b, 0x5f, 0x87,
-	0x83, 0xfb, 0xbe, 0xff, 0x83, 0xff, 0x11, 0x06, 0x41, 0x5c, 0xad, 0x0c, 0xfe, 0xf4, 0xed, 0x87,
-	0x9f, 0x9d, 0x8c, 0x39, 0x13, 0xe9, 0x12, 0x14, 0xbc, 0xe5, 0xe8, 0xaf, 0x3b, 0xf9, 0x18, 0x4c,
-	0x54, 0x3a, 0x82, 0xa7, 0x8f, 0xec, 0xff, 0xa7, 0x53, 0x22, 0xed, 0xf3, 0xf5, 0x1e, 0xf8, 0xd0,
-	0xf3, 0x8f, 0x9e, 0x4a, 0xc8, 0x6d, 0x60, 0x0e, 0xa3, 0x2b, 0xd5, 0xca, 0x8f, 0xc1, 0xff, 0xfe,
-	0xf5, 0xa1, 0xad, 0x0d, 0x91, 0xfd, 0xcd, 0x94, 0x44, 0xe0, 0xc9, 0xdf, 0xbd, 0xf5, 0xe2, 0x99,
-	0x18, 0x46, 0x91, 0xbc, 0x1d, 0xfe, 0xf8, 0xad, 0xc7, 0x6f, 0x4e, 0xf0, 0x81, 0x1e, 0x9b, 0x37,
-	0xc3, 0xaf, 0xee, 0x7c, 0xe3, 0x2b, 0x9e, 0xed, 0xa7, 0xeb, 0xee, 0x7c, 0xa4, 0xab, 0xa6, 0xe7,
-	0xd1, 0x95, 0xa0, 0x21, 0x3b, 0xd8, 0x87, 0xb3, 0xb2, 0x01, 0x30, 0x87, 0x7f, 0x03, 0x98, 0xf6,
-	0x93, 0x6a, 0x3e, 0x08, 0x4f, 0xb7, 0x79, 0x30, 0x41, 0x06, 0xac, 0xd4, 0xc8, 0x6b, 0xc0, 0x5c,
-	0xd1, 0x8b, 0xbf, 0x0e, 0x96, 0x1e, 0x0b, 0x4d, 0xb3, 0x15, 0x0c, 0xa5, 0x46, 0xee, 0x03, 0x90,
-	0x7b, 0x9a, 0xd7, 0x16, 0x2b, 0x52, 0x5a, 0x90, 0x69, 0x2b, 0x35, 0xf2, 0x27, 0x40, 0xab, 0xf8,
-	0x01, 0x5e, 0x17, 0x47, 0x89, 0x81, 0xa7, 0x63, 0xd2, 0x6e, 0xa5, 0x46, 0xde, 0x00, 0x5a, 0x84,
-	0x4f, 0xe7, 0x3a, 0x39, 0x8a, 0x34, 0x38, 0x9d, 0x66, 0x34, 0x16, 0x25, 0x9a, 0x03, 0x1d, 0x65,
-	0x5f, 0xc7, 0x2d, 0x2e, 0x2f, 0x2e, 0x41, 0x2b, 0x23, 0x75, 0x3c, 0x03, 0xaf, 0x3a, 0x39, 0x25,
-	0x03, 0x8c, 0x56, 0x86, 0xc1, 0xc7, 0xc1, 0xbc, 0x98, 0xf7, 0x6f, 0x0b, 0x58, 0xd2, 0x1c, 0x02,
-	0xbf, 0xf4, 0x43, 0x84, 0xd6, 0xe8, 0x54, 0xb4, 0x38, 0x84, 0x74, 0x58, 0x50, 0x60, 0x01, 0x4a,
-	0x8d, 0xfc, 0xd7, 0x21, 0xd5, 0x48, 0x8d, 0x84, 0xa7, 0xca, 0x22, 0xa4, 0xcb, 0x3d, 0x7f, 0x52,
-	0x6a, 0xe4, 0x31, 0x90, 0x2e, 0xf3, 0xd4, 0x6c, 0xd1, 0x14, 0xd4, 0x5d, 0xa4, 0xf4, 0x22, 0xc6,
-	0x3c, 0xc4, 0xe5, 0x19, 0x56, 0xcb, 0xe5, 0xe7, 0xc0, 0x21, 0xf0, 0x5a, 0x5e, 0x07, 0x52, 0xb1,
-	0x2f, 0xb5, 0x16, 0xb2, 0xd4, 0x04, 0x28, 0x3c, 0xbd, 0x11, 0xd0, 0x16, 0xff, 0x14, 0x4b, 0xe1,
-	0x17, 0x2e, 0x8a, 0x73, 0xae, 0x3a, 0xd0, 0x41, 0x67, 0xf9, 0x27, 0x58, 0x4b, 0x38, 0x5e, 0x42,
-	0xbc, 0xf4, 0x14, 0x6f, 0x9a, 0xb0, 0xc9, 0x74, 0x96, 0x7f, 0x78, 0xb5, 0x84, 0xd3, 0xba, 0x98,
-	0x15, 0xa7, 0xac, 0x6b, 0xc0, 0xec, 0xe8, 0x13, 0xa9, 0x14, 0x27, 0x36, 0x81, 0xa4, 0x53, 0xac,
-	0x62, 0xc2, 0x72, 0x9b, 0x52, 0x23, 0xff, 0x39, 0x21, 0x64, 0xc7, 0x12, 0x0a, 0x21, 0xbc, 0x18,
-	0x57, 0x11, 0xc7, 0xcf, 0xbc, 0x3c, 0x8a, 0x38, 0x7e, 0x1a, 0xc6, 0x53, 0x58, 0x4b, 0xdc, 0x61,
-	0xf4, 0x79, 0x50, 0xc4, 0x1d, 0x46, 0xc0, 0x22, 0x6b, 0x6c, 0x11, 0xbe, 0xc8, 0xe1, 0xdd, 0x2a,
-	0x43, 0x47, 0xfc, 0xda, 0x47, 0xa9, 0x91, 0x07, 0xc0, 0xfc, 0xb8, 0xf7, 0x33, 0x19, 0x96, 0x24,
-	0x8f, 0xc1, 0x4b, 0xf7, 0x97, 0xe4, 0x30, 0xa2, 0x5f, 0xba, 0xb4, 0x45, 0xd5, 0x14, 0x80, 0xf8,
-	0xf1, 0x7f, 0x4f, 0xdc, 0x6f, 0xdc, 0x5b, 0x95, 0x88, 0xfb, 0x8d, 0x41, 0x4b, 0xc7, 0x95, 0x4b,
-	0x59, 0x9f, 0x19, 0xa1, 0xbd, 0x40, 0x60, 0x57, 0xe7, 0x4a, 0xf5, 0xef, 0x98, 0x3d, 0x1d, 0x21,
-	0x2c, 0xdc, 0xd3, 0x11, 0xda, 0xed, 0x11, 0xd3, 0x8d, 0xd0, 0xb7, 0xc0, 0xe2, 0x73, 0x7b, 0xeb,
-	0xd1, 0x2d, 0x0a, 0x1e, 0xe2, 0xf1, 0xcb, 0xcd, 0x69, 0x98, 0x6c, 0xea, 0xd8, 0xb7, 0x18, 0x4b,
-	0xca, 0xf3, 0xf2, 0xf1, 0xce, 0x87, 0x47, 0xd0, 0xab, 0x31, 0x05, 0x0f, 0x1f, 0xaf, 0x1c, 0x8f,
-	0xeb, 0xc9, 0xda, 0x08, 0x9f, 0x46, 0x28, 0xe5, 0xe9, 0xbb, 0x38, 0xe5, 0x68, 0x6b, 0x60, 0xd1,
-	0xb9, 0x3c, 0x7a, 0xb8, 0x84, 0xe5, 0x52, 0x1e, 0x9b, 0xdf, 0x15, 0xd7, 0x02, 0x59, 0xf0, 0x6a,
-	0xa1, 0x3d, 0x66, 0x2f, 0x4c, 0x11, 0x97, 0xf9, 0x8e, 0x90, 0x7a, 0x19, 0x90, 0x12, 0x6d, 0x74,
-	0x4c, 0x86, 0x13, 0xe5, 0x4a, 0xd0, 0x1c, 0x69, 0xec, 0x9f, 0xcf, 0x0e, 0x0e, 0x00, 0xf1, 0xce,
-	0x81, 0x6e, 0xbc, 0x8f, 0x38, 0x07, 0x0a, 0xc4, 0x8f, 0xef, 0x25, 0x6a, 0x60, 0x5b, 0xe3, 0xdb,
-	0x05, 0xfc, 0x7d, 0x60, 0xbc, 0x1b, 0x8e, 0xb6, 0xb4, 0x47, 0xdc, 0x67, 0x04, 0xcc, 0xd3, 0x19,
-	0x24, 0x6e, 0x53, 0xd0, 0x8d, 0x9e, 0x11, 0x1c, 0x0b, 0x0c, 0x46, 0x7a, 0x0e, 0x9b, 0x15, 0x98,
-	0xc6, 0xa8, 0x52, 0x23, 0xaf, 0x27, 0xd9, 0x40, 0xa4, 0xc1, 0xbc, 0x83, 0x73, 0x1e, 0x14, 0x34,
-	0x7a, 0xe2, 0x85, 0x37, 0x36, 0xd8, 0x97, 0x2e, 0x9c, 0xba, 0x33, 0x7c, 0x39, 0x47, 0x3e, 0x16,
-	0x57, 0xe4, 0xad, 0x17, 0x4e, 0xdd, 0x02, 0xbe, 0x9c, 0x3b, 0x65, 0xdf, 0x03, 0x87, 0x1c, 0x51,
-	0xb3, 0xa0, 0x8b, 0x3b, 0x23, 0x94, 0x9c, 0xc2, 0x48, 0x67, 0x02, 0x6a, 0xaa, 0x17, 0xe3, 0xe6,
-	0x22, 0x18, 0xd4, 0xf1, 0x67, 0x4f, 0xc9, 0x80, 0xc7, 0x88, 0xb7, 0x50, 0xb6, 0xd5, 0xba, 0x5d,
-	0xb4, 0xbd, 0x08, 0x90, 0xa7, 0x41, 0xd9, 0x01, 0xdd, 0x35, 0xdd, 0x11, 0xb7, 0x50, 0x02, 0x3b,
-	0xa0, 0xee, 0xdd, 0xf0, 0x14, 0x5b, 0xc5, 0xcd, 0xd1, 0x5d, 0x71, 0x24, 0x3d, 0xb8, 0xd8, 0x4c,
-	0xfb, 0xc2, 0x80, 0xcc, 0x9f, 0x5f, 0x2a, 0x8e, 0x4e, 0x5a, 0x78, 0x15, 0x48, 0xf9, 0x20, 0xaa,
-	0x99, 0x39, 0xc5, 0xc5, 0x41, 0xb1, 0x1a, 0x62, 0x82, 0x31, 0x7f, 0xbc, 0x28, 0x18, 0x8b, 0xa5,
-	0x70, 0x3d, 0x09, 0xe9, 0x45, 0xed, 0xbb, 0x0b, 0x05, 0x19, 0x1e, 0x8b, 0x12, 0xc9, 0xea, 0xe9,
-	0x2b, 0x4a, 0x1c, 0x60, 0xb4, 0x97, 0x6b, 0xd7, 0xbd, 0x48, 0x64, 0x0c, 0x1c, 0x07, 0x4e, 0xe2,
-	0x4f, 0x10, 0xab, 0x88, 0x34, 0xe5, 0x46, 0xac, 0x82, 0x85, 0xa6, 0xcb, 0xfc, 0xb3, 0x51, 0xd8,
-	0x15, 0xce, 0x15, 0xb5, 0xd2, 0x8a, 0x0c, 0x2d, 0x80, 0xa6, 0x67, 0x07, 0x82, 0x79, 0x4f, 0x11,
-	0x69, 0xb7, 0x4e, 0x35, 0xca, 0xb6, 0x89, 0x8e, 0x55, 0x0c, 0x12, 0x65, 0xb1, 0xed, 0xe5, 0x5a,
-	0x5d, 0x2f, 0x12, 0x91, 0xe2, 0xe2, 0x18, 0x8e, 0x2a, 0x5b, 0x5c, 0x09, 0x62, 0x16, 0x61, 0x71,
-	0x25, 0x88, 0x54, 0xa6, 0xa0, 0x12, 0x44, 0x25, 0x42, 0x2a, 0x41, 0x2c, 0xc2, 0x51, 0xb9, 0x8e,
-	0x38, 0x19, 0x41, 0x07, 0x68, 0x46, 0x1c, 0x7f, 0x84, 0x18, 0xe9, 0x66, 0xea, 0x2e, 0xdc, 0xf9,
-	0xd3, 0xcb, 0xb1, 0x87, 0x48, 0xc5, 0xb6, 0x74, 0x2e, 0x14, 0xc6, 0xec, 0x34, 0x8a, 0x80, 0x20,
-	0xe5, 0x21, 0x58, 0x6a, 0x5d, 0x82, 0x90, 0x98, 0x26, 0x25, 0xf4, 0x10, 0xbe, 0xce, 0x22, 0x5d,
-	0x98, 0x1d, 0x9c, 0x3b, 0xa5, 0xa0, 0xbc, 0xce, 0x7a, 0x00, 0xa0, 0x3a, 0x23, 0xe7, 0x72, 0xc7,
-	0xb3, 0x29, 0xb0, 0xa4, 0x8f, 0x86, 0xbe, 0xc9, 0xef, 0x7a, 0xe4, 0x7d, 0x13, 0x81, 0xa4, 0x21,
-	0xdd, 0x9c, 0xe9, 0x7e, 0x51, 0x6a, 0xe4, 0x8f, 0x85, 0xb5, 0xb4, 0xa0, 0xa5, 0x91, 0xaf, 0xa5,
-	0xf9, 0x20, 0x2a, 0x30, 0xc4, 0xd7, 0xfc, 0xb8, 0x1d, 0xc1, 0x05, 0x52, 0x05, 0x08, 0xbe, 0x37,
-	0x71, 0x81, 0x68, 0xc5, 0x29, 0x04, 0x7e, 0x5a, 0x1f, 0x0f, 0x53, 0x3e, 0xa6, 0x27, 0x90, 0x4f,
-	0xf9, 0x68, 0x70, 0x9a, 0x6f, 0x82, 0xc0, 0x89, 0x4a, 0x47, 0xd9, 0xa6, 0xbf, 0xc5, 0xb1, 0x34,
-	0x69, 0xb4, 0x74, 0x2b, 0xb3, 0xf0, 0x14, 0xfd, 0xc1, 0xf0, 0xc0, 0x8e, 0x36, 0xf6, 0x65, 0xca,
-	0x88, 0xeb, 0x55, 0xcd, 0x84, 0x12, 0x5f, 0x01, 0x9a, 0xe8, 0xf6, 0xbd, 0x96, 0xa8, 0x19, 0xb9,
-	0x5f, 0xd3, 0xdc, 0x3f, 0xdc, 0x46, 0x1d, 0xc6, 0x6c, 0x13, 0x5d, 0xbb, 0x48, 0xfd, 0x04, 0xc8,
-	0xab, 0x7e, 0x63, 0x38, 0x9d, 0x68, 0x1b, 0x58, 0xa6, 0x7c, 0x25, 0x70, 0x53, 0x4f, 0x99, 0x18,
-	0x3c, 0x1b, 0x26, 0xa1, 0x91, 0xb6, 0xad, 0x05, 0x65, 0xab, 0xa3, 0x9b, 0x7a, 0xa8, 0x44, 0x9e,
-	0x06, 0x28, 0x35, 0xbd, 0xeb, 0x7f, 0xfb, 0x5a, 0x97, 0xf4, 0xdc, 0xd1, 0x2e, 0xe9, 0xc0, 0xd1,
-	0x2e, 0xe9, 0xe7, 0x47, 0xbb, 0xa4, 0xeb, 0xff, 0x82, 0xfa, 0x67, 0x49, 0x1d, 0xa4, 0x8e, 0x8f,
-	0x5a, 0x6a, 0xf8, 0xc7, 0x4a, 0x1b, 0x59, 0x5b, 0x90, 0xb5, 0x4a, 0x2d, 0x16, 0x57, 0xb9, 0x7f,
-	0xea, 0x79, 0xb4, 0x6a, 0x58, 0xff, 0xd4, 0x2a, 0x97, 0x2d, 0xfe, 0xdf, 0x70, 0x3d, 0x66, 0x73,
-	0xd9, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x5c, 0xde, 0x01, 0xf3, 0x54, 0x00, 0x00,
+	// 4871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x6d, 0x74, 0x1c, 0xd5,
+	0x75, 0x5a, 0xad, 0x56, 0x92, 0xaf, 0x2c, 0xe9, 0x79, 0xac, 0x8f, 0xd5, 0xea, 0xc3, 0xab, 0x31,
+	0x36, 0xc6, 0x80, 0x9c, 0x08, 0x5a, 0x1a, 0xd2, 0x36, 0x58, 0x32, 0x26, 0x02, 0x61, 0x2b, 0x6b,
+	0xc9, 0xed, 0xe1, 0xb4, 0xdd, 0x8e, 0x76, 0x9f, 0xa4, 0xc1, 0xab, 0x99, 0xcd, 0xcc, 0xac, 0x41,
+	0x69, 0xd2, 0xe0, 0xf0, 0xe5, 0xd4, 0xc5, 0x50, 0xd7, 0x75, 0x20, 0x04, 0xfb, 0x04, 0x0c, 0x89,
+	0xf9, 0xaa, 0xc9, 0xa1, 0x27, 0x39, 0x4e, 0xd2, 0x34, 0x1f, 0x07, 0x93, 0x92, 0x80, 0xe3, 0xc3,
+	0x31, 0x69, 0x7c, 0xc0, 0xc1, 0xc6, 0xb8, 0x21, 0x89, 0x49, 0x4f, 0xa0, 0xb1, 0x31, 0x46, 0x3d,
+	0xf3, 0xfd, 0xde, 0xbc, 0x37, 0x2b, 0x61, 0xed, 0x82, 0xfb, 0x03, 0x8e, 0x76, 0xee, 0x7d, 0xf7,
+	0xde, 0x77, 0xdf, 0x7d, 0xf7, 0xdd, 0x7b, 0xdf, 0x7d, 0x86, 0xfa, 0x82, 0x8e, 0xb5, 0x1e, 0x23,
+	0xd7, 0x93, 0xd7, 0x54, 0x43, 0x15, 0xaa, 0xcc, 0x9f, 0x89, 0x4b, 0xc7, 0x65, 0x63, 0xa2, 0x30,
+	0xda, 0x93, 0x51, 0x27, 0x57, 0x8c, 0xab, 0xe3, 0xea, 0x0a, 0x0b, 0x38, 0x5a, 0x18, 0xb3, 0x7e,
+	0x59, 0x3f, 0xac, 0xbf, 0xec, 0x41, 0x89, 0xae, 0x71, 0x55, 0x1d, 0xcf, 0x61, 0x1f, 0xeb, 0x26,
+	0x4d, 0xca, 0xe7, 0xb1, 0xa6, 0x3b, 0xf0, 0x84, 0x9e, 0x99, 0xc0, 0x93, 0x92, 0xc9, 0x25, 0xa3,
+	0x6a, 0x38, 0x6d, 0x4c, 0xe5, 0xb1, 0x0b, 0x6b, 0xf3, 0x61, 0x86, 0x26, 0x29, 0x7a, 0x5e, 0xd5,
+	0x0c, 0x07, 0xd4, 0xe4, 0x83, 0xf4, 0x29, 0x25, 0x63, 0x7f, 0x15, 0xbf, 0x1f, 0x81, 0xf9, 0x83,
+	0x92, 0x6e, 0xac, 0xc3, 0x58, 0x59, 0x25, 0x19, 0x92, 0xb0, 0x04, 0x1a, 0xf2, 0x1a, 0xce, 0xca,
+	0x19, 0xc9, 0xc0, 0x69, 0x45, 0x9a, 0xc4, 0xf1, 0x48, 0x32, 0xb2, 0x6c, 0x5e, 0xaa, 0xde, 0xfb,
+	0xba, 0x46, 0x9a, 0xc4, 0xc2, 0x1f, 0x41, 0x5d, 0x46, 0x55, 0x74, 0x43, 0x2b, 0x64, 0x0c, 0x55,
+	0x8b, 0x57, 0x26, 0x23, 0xcb, 0x1a, 0x7a, 0x17, 0xf6, 0x58, 0xd3, 0x1f, 0x1e, 0xec, 0xf7, 0x41,
+	0x29, 0x12, 0x4f, 0x68, 0x85, 0x1a, 0x13, 0x25, 0x2d, 0x67, 0xe3, 0xd1, 0x64, 0x64, 0x59, 0x34,
+	0x55, 0x6d, 0xfe, 0x1c, 0xc8, 0x0a, 0x49, 0x98, 0x9f, 0x93, 0x74, 0x23, 0xad, 0x63, 0xac, 0xa4,
+	0x25, 0x23, 0x5e, 0x65, 0x41, 0x21, 0xe7, 0x88, 0xb6, 0xd2, 0x10, 0xe2, 0x50, 0x83, 0x6f, 0xce,
+	0xcb, 0x1a, 0xd6, 0xe3, 0xb1, 0x64, 0x64, 0x59, 0x2c, 0xe5, 0xfe, 0x14, 0x3f, 0x0e, 0x8d, 0xc3,
+	0x83, 0xe9, 0x1c, 0x39, 0x8b, 0x65, 0x10, 0xcb, 0x4a, 0x86, 0xd4, 0x6b, 0x09, 0x5f, 0xd7, 0x2b,
+	0xd8, 0x82, 0x91, 0x13, 0x4d, 0xd9, 0x08, 0xe2, 0xf1, 0x08, 0xb4, 0x0c, 0x61, 0xac, 0x99, 0xff,
+	0xad, 0x51, 0x0d, 0x79, 0x6c, 0x6a, 0x1d, 0x36, 0x0c, 0x59, 0x19, 0xd7, 0xcb, 0xac, 0x8a, 0x76,
+	0x98, 0x97, 0xc7, 0x58, 0xb3, 0x96, 0xcf, 0x52, 0x46, 0x2c, 0x55, 0x6b, 0x7e, 0x18, 0x9e, 0xca,
+	0x63, 0x53, 0x4f, 0x16, 0x50, 0xce, 0x3a, 0x9a, 0xa8, 0x36, 0x7f, 0x0e, 0x64, 0x85, 0x2b, 0xa0,
+	0x56, 0x77, 0xe4, 0xb3, 0xd4, 0x50, 0xd7, 0xdb, 0xde, 0x33, 0x69, 0x58, 0x6b, 0xd9, 0xc3, 0x4e,
+	0x21, 0xe5, 0x21, 0x8b, 0x6b, 0xa1, 0x6d, 0x78, 0x30, 0x9d, 0xe7, 0xcf, 0xb4, 0x97, 0x56, 0x57,
+	0x87, 0x2d, 0x3c, 0x5f, 0x2d, 0xae, 0xe2, 0x5e, 0xa9, 0x84, 0xa6, 0x11, 0x73, 0xf1, 0x26, 0x4d,
+	0x23, 0xc3, 0xd9, 0x7e, 0x55, 0x31, 0xa4, 0x8c, 0x51, 0x6e, 0xb5, 0x5d, 0x0e, 0xb5, 0xb2, 0xc3,
+	0x31, 0x1e, 0x4d, 0x46, 0x97, 0xd5, 0xf5, 0xc6, 0x3d, 0x05, 0x04, 0x44, 0x49, 0x79, 0x98, 0xc2,
+	0x55, 0xd0, 0x98, 0x57, 0xf3, 0x85, 0x9c, 0xa4, 0xa5, 0x65, 0x65, 0xa3, 0x6c, 0x60, 0x3d, 0x5e,
+	0x65, 0x0d, 0x6e, 0xf5, 0xb5, 0x67, 0xc3, 0xdd, 0xb1, 0x0d, 0x0e, 0xfe, 0x80, 0x8d, 0x6e, 0xce,
+	0x4a, 0xc3, 0x86, 0x36, 0x95, 0xce, 0x38, 0xf3, 0x8c, 0xc7, 0x92, 0xd1, 0x65, 0xd1, 0x54, 0xbd,
+	0xf5, 0xd5, 0x9b, 0xfc, 0x62, 0x88, 0x99, 0x33, 0xd0, 0xe3, 0xd5, 0x16, 0xf9, 0x7a, 0x8f, 0xbc,
+	0xa9, 0xaa, 0x94, 0x0d, 0x13, 0x2e, 0x80, 0x86, 0x42, 0x3e, 0x6b, 0xaa, 0x47, 0xce, 0xa6, 0x73,
+	0xb2, 0x6e, 0xc4, 0x6b, 0x2c, 0x5a, 0xf3, 0xed, 0xaf, 0x03, 0xd9, 0x41, 0x59, 0x37, 0xc4, 0xeb,
+	0xa0, 0x75, 0x78, 0x30, 0x5d, 0xe0, 0xa9, 0xf8, 0x23, 0xf4, 0x7a, 0x25, 0x6c, 0xad, 0xf1, 0x56,
+	0xc3, 0x5d, 0xad, 0x63, 0x11, 0x00, 0x13, 0xae, 0xaf, 0x56, 0x0b, 0x4a, 0xb6, 0xcc, 0x6b, 0xd4,
+	0x04, 0xb1, 0x8c, 0x5a, 0x50, 0x0c, 0xc7, 0xac, 0xed, 0x1f, 0xc2, 0x85, 0xae, 0x6a, 0x6c, 0xcd,
+	0x2f, 0xa0, 0x54, 0x63, 0x6f, 0x49, 0x5b, 0x3d, 0x8b, 0xa0, 0x4e, 0xc1, 0x37, 0x1b, 0x69, 0x75,
+	0x6c, 0x4c, 0xc7, 0x86, 0x65, 0xe6, 0xf3, 0x52, 0x60, 0x7e, 0x5a, 0x6b, 0x7d, 0x31, 0x77, 0x87,
+	0xab, 0xb8, 0x6a, 0x4b, 0x71, 0xd5, 0xb2, 0xad, 0xb2, 0x8f, 0xc3, 0x02, 0x47, 0x65, 0xba, 0x49,
+	0xd0, 0x9e, 0xeb, 0x52, 0x5a, 0x59, 0xc8, 0x57, 0x96, 0xad, 0x0c, 0x57, 0x45, 0x1f, 0xb3, 0xdc,
+	0x88, 0x35, 0x78, 0x20, 0xfb, 0xfe, 0x86, 0xfe, 0x35, 0x34, 0x39, 0x43, 0xd3, 0xe3, 0xd8, 0x70,
+	0xdd, 0x8c, 0x1e, 0xd4, 0x5f, 0x64, 0x96, 0xfa, 0x6b, 0x80, 0x4a, 0xd9, 0xb6, 0xee, 0x68, 0xaa,
+	0x52, 0xce, 0x8a, 0xf7, 0x47, 0xa0, 0xc5, 0xa5, 0x6f, 0x9b, 0x88, 0xcb, 0x62, 0xae, 0x1c, 0x22,
+	0x36, 0x87, 0x39, 0xb9, 0xdf, 0xbf, 0x82, 0x85, 0x9c, 0xc9, 0x97, 0x48, 0x32, 0xf1, 0x4b, 0x11,
+	0x88, 0x13, 0xe4, 0x07, 0x26, 0x27, 0x0b, 0x86, 0x34, 0x9a, 0xc3, 0xe6, 0x22, 0x94, 0x6a, 0xf6,
+	0x71, 0xa8, 0xc9, 0x6b, 0xf2, 0x46, 0x29, 0x33, 0x65, 0x4d, 0xbc, 0x36, 0xe5, 0xfe, 0x14, 0x12,
+	0x50, 0x1b, 0xd8, 0xef, 0xde, 0x6f, 0x31, 0x0f, 0xad, 0x84, 0x60, 0xd7, 0xfb, 0x62, 0x95, 0x6a,
+	0xdd, 0xcd, 0xdf, 0x86, 0x6a, 0x6d, 0x97, 0x68, 0xaa, 0xd2, 0x50, 0x45, 0x05, 0x16, 0x85, 0xa9,
+	0xa2, 0x6f, 0x6a, 0x68, 0x42, 0x55, 0xf0, 0xb9, 0x72, 0x6e, 0x82, 0x58, 0xde, 0x1c, 0x6f, 0x29,
+	0x65, 0x5e, 0xca, 0xfe, 0x51, 0x9c, 0xdf, 0xb0, 0xba, 0xe1, 0xdc, 0x57, 0xb9, 0x09, 0x62, 0x86,
+	0x39, 0xde, 0xe5, 0x67, 0xfd, 0x10, 0x3f, 0x67, 0x9d, 0x51, 0x16, 0x3f, 0x1d, 0x1b, 0x2b, 0x33,
+	0x96, 0xdf, 0x58, 0x25, 0x4d, 0xe9, 0xc3, 0xc3, 0x83, 0xe7, 0xca, 0x29, 0x34, 0xe2, 0x40, 0x10,
+	0x35, 0x8c, 0x9c, 0xb5, 0xe0, 0xb1, 0x94, 0xf9, 0xa7, 0xb8, 0xc1, 0x67, 0x3f, 0x5e, 0x6e, 0xf6,
+	0xe2, 0x57, 0x23, 0x14, 0xb7, 0xc0, 0x81, 0x5c, 0xea, 0xc9, 0x52, 0xc1, 0x46, 0x55, 0x78, 0xb0,
+	0x11, 0x23, 0x83, 0x0d, 0xf1, 0xde, 0x08, 0x74, 0x86, 0xca, 0x68, 0x3a, 0xdc, 0x92, 0xcb, 0x79,
+	0x21, 0xc4, 0xf2, 0x98, 0x77, 0x46, 0x98, 0x71, 0xc8, 0x88, 0x21, 0xe7, 0x52, 0x36, 0x5c, 0xfc,
+	0x45, 0x84, 0xb2, 0x95, 0xf3, 0x51, 0x7d, 0x54, 0xac, 0x56, 0xfd, 0x7e, 0x62, 0xb5, 0x49, 0x68,
+	0x77, 0xe7, 0xa6, 0xe1, 0xb2, 0xcf, 0x4e, 0x54, 0xa0, 0x83, 0xb4, 0xfb, 0x5c, 0xae, 0xcc, 0xfc,
+	0x34, 0x48, 0x12, 0xfc, 0xae, 0xc9, 0xa9, 0xa3, 0x52, 0x6e, 0xc8, 0x76, 0xb8, 0x65, 0xe3, 0xf9,
+	0x64, 0xc4, 0x67, 0xaa, 0x7f, 0x40, 0x4c, 0x85, 0x2b, 0x09, 0x03, 0xa8, 0xb2, 0x0c, 0xa0, 0xcb,
+	0x33, 0x00, 0xae, 0x04, 0x84, 0x0d, 0x7c, 0x1e, 0x04, 0x42, 0x49, 0x0e, 0x5e, 0xc9, 0x25, 0x6c,
+	0x83, 0xda, 0x0d, 0x78, 0x8a, 0xb4, 0xeb, 0x9a, 0x0d, 0x78, 0xca, 0x34, 0x6b, 0xf1, 0xb1, 0x88,
+	0x2f, 0x81, 0xfe, 0x61, 0x48, 0x20, 0x2c, 0x87, 0x98, 0x56, 0xc8, 0x61, 0xfb, 0xe4, 0xad, 0xeb,
+	0x6d, 0xf2, 0x37, 0x8f, 0x2d, 0x4b, 0xaa, 0x90, 0xc3, 0x29, 0x1b, 0x45, 0xfc, 0x72, 0xc4, 0x0f,
+	0xc1, 0x32, 0x13, 0x38, 0xb3, 0xe1, 0x43, 0x90, 0x37, 0xd4, 0x8f, 0x1e, 0x8c, 0xf8, 0xa1, 0x82,
+	0x94, 0xcd, 0x9a, 0x7b, 0xff, 0x3c, 0x73, 0x55, 0x1f, 0x65, 0x5c, 0x55, 0x33, 0xe5, 0xaa, 0x38,
+	0x06, 0xba, 0x2b, 0x42, 0xc5, 0x3f, 0xe7, 0xdf, 0xa4, 0xc4, 0x07, 0x23, 0x90, 0x70, 0x25, 0xcc,
+	0xe2, 0x1c, 0x36, 0xf0, 0x79, 0x28, 0xe4, 0xdf, 0xf9, 0xd1, 0x73, 0x66, 0x42, 0x52, 0xc6, 0xf1,
+	0x9c, 0xe2, 0xb8, 0x50, 0xe1, 0xbc, 0x00, 0xaf, 0x8a, 0x0c, 0xf0, 0xb6, 0x57, 0xfa, 0x11, 0x5e,
+	0x46, 0xc3, 0x66, 0xda, 0x87, 0x6f, 0x1a, 0xd2, 0x70, 0x16, 0x8f, 0xc9, 0x0a, 0xce, 0xce, 0x25,
+	0xc6, 0xe6, 0x46, 0x94, 0x42, 0x27, 0xc0, 0x98, 0xac, 0xe9, 0x86, 0x9d, 0x73, 0xda, 0xb2, 0xcc,
+	0xb3, 0xbe, 0x58, 0xf9, 0xe6, 0xc7, 0x60, 0x5e, 0x4e, 0x72, 0xa1, 0x31, 0xa7, 0x16, 0x61, 0x97,
+	0xc3, 0x7a, 0xdc, 0x72, 0x58, 0xcf, 0x3a, 0x43, 0x93, 0x95, 0xf1, 0xf5, 0x52, 0xae, 0x80, 0x53,
+	0xb5, 0x39, 0xc9, 0x19, 0x9a, 0x80, 0x5a, 0x53, 0x24, 0x6b, 0x64, 0xb5, 0x45, 0xd7, 0xfb, 0x2d,
+	0x08, 0x50, 0x95, 0x51, 0xb3, 0x38, 0x5e, 0x63, 0x7d, 0xb7, 0xfe, 0x36, 0xf1, 0x37, 0x62, 0x4d,
+	0x1e, 0x93, 0x71, 0x36, 0x5e, 0x6b, 0x05, 0xfd, 0xde, 0x6f, 0x71, 0x82, 0x0a, 0xcd, 0xca, 0xa8,
+	0x0f, 0x71, 0x24, 0x78, 0xf4, 0x96, 0x84, 0x99, 0xf8, 0xb3, 0x08, 0x2c, 0xa3, 0x13, 0x46, 0x9f,
+	0xee, 0x6a, 0x53, 0xdb, 0x2b, 0x95, 0xec, 0xa0, 0xab, 0xb9, 0xff, 0x1f, 0x0b, 0x2c, 0x6e, 0x21,
+	0x4e, 0xf0, 0xe0, 0x9c, 0xd6, 0x3b, 0x2b, 0x57, 0xda, 0xb9, 0x90, 0x26, 0x52, 0x15, 0x30, 0x91,
+	0x3d, 0x45, 0xa4, 0x19, 0x71, 0xed, 0xae, 0xa4, 0xd2, 0xfc, 0x09, 0x61, 0xe0, 0x55, 0xb3, 0xd1,
+	0x9c, 0x8b, 0x2d, 0x7e, 0x1e, 0x3a, 0xc2, 0x44, 0xed, 0x57, 0xb3, 0x25, 0x16, 0xd3, 0xdd, 0x6b,
+	0x55, 0xfe, 0x5e, 0x33, 0x1d, 0xf1, 0x52, 0x57, 0x82, 0xbc, 0xc7, 0xbb, 0x4f, 0x56, 0xb2, 0x29,
+	0x3c, 0x2e, 0xeb, 0x06, 0xd6, 0x6c, 0xa5, 0x0d, 0x94, 0x78, 0x01, 0x2f, 0x86, 0x05, 0x9a, 0xc7,
+	0xc0, 0xae, 0xa4, 0xb9, 0xf5, 0x54, 0xa4, 0x05, 0x38, 0x8b, 0x27, 0x22, 0xd0, 0xcc, 0xf8, 0xc2,
+	0xb9, 0xec, 0x78, 0x11, 0xea, 0x75, 0x9c, 0xd1, 0xb0, 0x91, 0x36, 0x03, 0x06, 0xcf, 0x23, 0xd7,
+	0xd9, 0x1f, 0xaf, 0xc3, 0x53, 0x61, 0x6e, 0x59, 0xe8, 0x86, 0xf9, 0x56, 0xee, 0x69, 0x55, 0x1b,
+	0xb3, 0xd8, 0xa9, 0x80, 0xd5, 0x39, 0xdf, 0xac, 0x35, 0xa3, 0xf7, 0x59, 0x75, 0x70, 0x9f, 0xb5,
+	0x93, 0xfb, 0xcc, 0x76, 0x7b, 0xfe, 0x4e, 0xfa, 0x2c, 0x08, 0xf4, 0xb1, 0x38, 0x97, 0x59, 0x86,
+	0x9e, 0x38, 0x2d, 0x50, 0xad, 0x61, 0x49, 0x57, 0x15, 0x67, 0x6e, 0xce, 0x2f, 0xf1, 0xbe, 0x88,
+	0x57, 0xa4, 0x4b, 0x8f, 0xe6, 0xd4, 0xcc, 0x86, 0x21, 0x5c, 0x06, 0xee, 0xe7, 0x76, 0x18, 0xdf,
+	0x1f, 0xf1, 0x4f, 0xe3, 0x82, 0xd2, 0x77, 0x9e, 0x49, 0xf7, 0x45, 0xc2, 0x42, 0x2d, 0xcd, 0xe1,
+	0x6c, 0xdf, 0x54, 0x59, 0xd6, 0x2e, 0x09, 0xf3, 0x2d, 0x11, 0x5c, 0xa8, 0x53, 0x0e, 0x34, 0xbf,
+	0x39, 0xbb, 0x65, 0x0b, 0x11, 0xfd, 0xc9, 0x7a, 0xdf, 0x87, 0x2c, 0xcd, 0x67, 0x21, 0x41, 0x05,
+	0xff, 0x96, 0x3c, 0x26, 0xac, 0x2c, 0x45, 0x0a, 0xbb, 0x4c, 0x57, 0xe5, 0x95, 0x67, 0x77, 0x10,
+	0xe5, 0xd9, 0x71, 0x6c, 0x38, 0xca, 0x28, 0x0b, 0xeb, 0x16, 0xa8, 0x76, 0xaa, 0xe2, 0xb6, 0xd5,
+	0x38, 0xbf, 0x4c, 0x3f, 0x92, 0x93, 0x27, 0x65, 0xc3, 0xa9, 0xcd, 0xda, 0x3f, 0xc4, 0x8d, 0x70,
+	0x01, 0x21, 0x97, 0x73, 0x25, 0xb0, 0x4e, 0x1e, 0x57, 0x46, 0xf2, 0x56, 0x8a, 0x6f, 0x16, 0xfa,
+	0x65, 0x55, 0x29, 0x79, 0xb2, 0xfd, 0x40, 0xc4, 0x67, 0xac, 0x7f, 0x80, 0x8c, 0x85, 0x25, 0x50,
+	0xad, 0xcb, 0x39, 0xac, 0x18, 0xce, 0x09, 0xe9, 0x5f, 0xbf, 0xf4, 0xa9, 0x6a, 0x2e, 0xe5, 0x00,
+	0xc5, 0x1c, 0x24, 0x02, 0x7a, 0xc1, 0x8a, 0x51, 0xb6, 0xd2, 0xc3, 0x7d, 0x44, 0x1a, 0xa2, 0x97,
+	0x9d, 0x9d, 0x79, 0xe8, 0xe9, 0x58, 0xd1, 0x65, 0x43, 0xde, 0x88, 0xd3, 0x58, 0x31, 0x0b, 0xb6,
+	0x6e, 0xf8, 0x82, 0x3c, 0xc0, 0xd5, 0xf6, 0x77, 0xf1, 0x26, 0x68, 0xa6, 0x8f, 0x02, 0x67, 0xad,
+	0xca, 0xb6, 0x67, 0xdc, 0xb2, 0xfe, 0x04, 0xb5, 0x65, 0x1c, 0xae, 0xe5, 0xd8, 0x32, 0xe2, 0x8d,
+	0x10, 0x67, 0x39, 0x0d, 0x94, 0x65, 0x7b, 0x8a, 0x06, 0x08, 0x2c, 0xaf, 0xb2, 0xeb, 0x72, 0x7b,
+	0xa5, 0xcf, 0x56, 0xca, 0x66, 0xcb, 0xc5, 0x76, 0x10, 0xda, 0xa5, 0x6c, 0x36, 0x6d, 0x85, 0x28,
+	0x69, 0xe7, 0x82, 0x24, 0x8d, 0x6f, 0xce, 0xe0, 0xbc, 0xb9, 0x97, 0xf9, 0x3b, 0x2e, 0x6e, 0x96,
+	0x3b, 0xcc, 0x01, 0x4e, 0x39, 0xe6, 0x6a, 0x17, 0xdd, 0x99, 0x44, 0xcc, 0x9d, 0xc4, 0x5c, 0x02,
+	0x1a, 0x3f, 0x8a, 0xaa, 0x25, 0x73, 0xab, 0x8d, 0x81, 0x8a, 0xd0, 0x07, 0xb5, 0x1c, 0x37, 0x42,
+	0x3b, 0x75, 0x6b, 0x62, 0x5d, 0xc8, 0x6a, 0x7a, 0x59, 0x6e, 0x68, 0x26, 0xa1, 0x8b, 0xde, 0xbf,
+	0xe5, 0x65, 0x47, 0x5e, 0x44, 0xda, 0x77, 0xeb, 0xde, 0x95, 0x74, 0xa9, 0xb5, 0xfa, 0x51, 0xe2,
+	0xe6, 0xcd, 0xbe, 0x0c, 0xf0, 0x2b, 0x52, 0x03, 0x4a, 0xbe, 0x60, 0x78, 0x97, 0xfc, 0x2e, 0x1a,
+	0xe3, 0x53, 0xfc, 0x68, 0xb9, 0xd4, 0xfb, 0x9c, 0x28, 0xda, 0xd8, 0x19, 0xd5, 0xca, 0x51, 0xb5,
+	0x60, 0x94, 0xa3, 0x68, 0x23, 0x99, 0x84, 0xdd, 0xec, 0xc0, 0xfa, 0x21, 0x3e, 0x1e, 0x81, 0x2e,
+	0x9a, 0x7b, 0xa9, 0x52, 0xfa, 0x50, 0x41, 0x66, 0xc8, 0xea, 0xdb, 0x83, 0x59, 0x3d, 0x99, 0x6d,
+	0xfc, 0x33, 0x73, 0x79, 0x3d, 0xd7, 0x6c, 0x3d, 0x54, 0xcc, 0x8b, 0x02, 0x09, 0x3b, 0xe3, 0x8e,
+	0x3c, 0xb0, 0x78, 0x1b, 0x23, 0xd5, 0x5c, 0xb3, 0xf6, 0x50, 0xa9, 0x12, 0x81, 0xc4, 0x9d, 0xa8,
+	0x4c, 0x91, 0x81, 0xab, 0x9b, 0x9a, 0xab, 0x63, 0x72, 0xce, 0x2c, 0x02, 0x1a, 0x6a, 0xd9, 0x3d,
+	0xd5, 0xe7, 0xa0, 0x9d, 0xf6, 0x1e, 0x24, 0x77, 0xbd, 0xec, 0x71, 0x33, 0x7d, 0x32, 0x97, 0x95,
+	0xb7, 0xb8, 0x97, 0x58, 0x6f, 0x1d, 0x1b, 0x7d, 0xaa, 0xd1, 0xaf, 0x4e, 0x4e, 0x4a, 0x4a, 0xb6,
+	0xf4, 0xd3, 0x6c, 0x86, 0xea, 0x51, 0xd5, 0xf0, 0x13, 0x95, 0xd8, 0xa8, 0x6a, 0x0c, 0x64, 0x85,
+	0x15, 0xa6, 0x43, 0xb3, 0x59, 0x3a, 0x17, 0x1a, 0x0b, 0x09, 0xe3, 0x74, 0xc5, 0x49, 0x79, 0x48,
+	0xe2, 0x7a, 0xa8, 0x27, 0x32, 0x2c, 0xd5, 0x28, 0x55, 0x47, 0xc5, 0x28, 0x15, 0xa4, 0xf4, 0xa9,
+	0xc6, 0x80, 0x32, 0x76, 0xce, 0xb6, 0xe6, 0x4f, 0x36, 0x4a, 0x4c, 0x56, 0xfc, 0x7b, 0xaa, 0x27,
+	0x64, 0x75, 0x21, 0x97, 0x9b, 0x4b, 0x66, 0x98, 0x84, 0xf9, 0x3a, 0xce, 0x8d, 0xa5, 0x69, 0x7d,
+	0x83, 0xf9, 0x6d, 0x84, 0x6f, 0xd9, 0x07, 0x89, 0xeb, 0x61, 0x7b, 0x63, 0x5d, 0x3d, 0xa9, 0xde,
+	0x28, 0xaf, 0x33, 0x24, 0xa3, 0x50, 0xfa, 0x15, 0xbf, 0x02, 0xe2, 0xd8, 0x24, 0x9f, 0xd6, 0x2d,
+	0xfa, 0xe9, 0xac, 0x9a, 0x29, 0x4c, 0x62, 0x85, 0xb0, 0x81, 0x66, 0xec, 0xb3, 0x5f, 0xe5, 0x40,
+	0x07, 0xb2, 0xc2, 0x25, 0x20, 0x50, 0x03, 0x0b, 0x8a, 0x21, 0xe7, 0x9c, 0x1c, 0x0e, 0x11, 0x43,
+	0x46, 0xcc, 0xef, 0xa2, 0x4c, 0x5d, 0xb8, 0xb8, 0x5d, 0x53, 0x7d, 0x53, 0x03, 0x25, 0xf7, 0xa4,
+	0xe2, 0x67, 0x60, 0x11, 0x87, 0x95, 0x19, 0x33, 0x9b, 0xec, 0xe6, 0x12, 0x3b, 0x27, 0x61, 0xbe,
+	0xc3, 0xd2, 0x6e, 0xe0, 0xb2, 0xbb, 0x5d, 0xc0, 0xe6, 0x6b, 0x12, 0x16, 0x65, 0x48, 0x70, 0x78,
+	0x97, 0xa5, 0xe1, 0x64, 0x6f, 0x04, 0x1a, 0x7c, 0xaf, 0x20, 0x69, 0x99, 0x89, 0x73, 0xa5, 0x3f,
+	0x1f, 0x22, 0x9f, 0x76, 0x68, 0x47, 0x3e, 0x6d, 0xe6, 0x60, 0xf8, 0xe6, 0x4c, 0xae, 0x90, 0xc5,
+	0xd9, 0x34, 0x15, 0xc5, 0x44, 0x53, 0xc8, 0x05, 0x78, 0x91, 0x93, 0x9f, 0xd3, 0x3b, 0xe5, 0x9e,
+	0x60, 0x4e, 0x5f, 0x4d, 0xe6, 0xf4, 0xd3, 0x95, 0xd0, 0x4c, 0x5b, 0x76, 0x9f, 0x6a, 0x98, 0x0a,
+	0x2a, 0xed, 0x0e, 0x16, 0xae, 0x00, 0x64, 0x7e, 0xce, 0x4c, 0x48, 0x46, 0x7a, 0x42, 0xd6, 0x0d,
+	0x55, 0x9b, 0xe2, 0x9f, 0xa9, 0x0d, 0xa3, 0xaa, 0xd1, 0x3f, 0x21, 0x19, 0x9f, 0xb4, 0x91, 0x84,
+	0x1e, 0xa8, 0x33, 0x07, 0x2a, 0xaa, 0x39, 0xd4, 0x6d, 0x52, 0x0d, 0x8c, 0x81, 0x51, 0xd5, 0x58,
+	0x63, 0x23, 0x08, 0x97, 0x41, 0x83, 0xc5, 0x5f, 0xc9, 0xc9, 0x0a, 0x4e, 0x8f, 0x63, 0x35, 0x5e,
+	0xcd, 0x1b, 0x32, 0xdf, 0x14, 0xcb, 0xc2, 0xb9, 0x06, 0x9b, 0xde, 0xaa, 0xd1, 0x1c, 0x24, 0x19,
+	0x86, 0x94, 0x99, 0x48, 0x4f, 0x62, 0xa5, 0x10, 0xaf, 0xe1, 0x8d, 0xaa, 0x1f, 0x55, 0x8d, 0x95,
+	0x16, 0xd2, 0xf5, 0x58, 0x29, 0x08, 0xfd, 0xd0, 0x42, 0xf0, 0xca, 0xe7, 0xa4, 0x0c, 0x9e, 0x50,
+	0x73, 0x59, 0xac, 0xc5, 0x6b, 0x79, 0xa3, 0x9b, 0x3c, 0x9e, 0x43, 0x3e, 0xaa, 0xb8, 0x3b, 0x02,
+	0x89, 0xb0, 0xb6, 0xa8, 0xf5, 0xbd, 0xe5, 0xef, 0x49, 0x6b, 0x86, 0xea, 0x09, 0x49, 0x4f, 0x1b,
+	0xaa, 0xa5, 0xdb, 0xda, 0x54, 0x6c, 0x42, 0xd2, 0x87, 0x55, 0xa7, 0x59, 0xac, 0xda, 0x6b, 0x16,
+	0x7b, 0x88, 0x6e, 0x30, 0x22, 0xfb, 0xd3, 0xe6, 0x2e, 0x65, 0xb4, 0x54, 0x52, 0x3e, 0x41, 0x54,
+	0x83, 0xed, 0xaa, 0xfb, 0x1c, 0x4e, 0xba, 0xa5, 0xd0, 0x68, 0xd1, 0x50, 0xb5, 0xc0, 0x51, 0x51,
+	0xef, 0x7c, 0x1e, 0x99, 0x55, 0x38, 0x4b, 0x06, 0x6c, 0xb1, 0x40, 0xc0, 0x36, 0x06, 0xad, 0x81,
+	0xc3, 0x33, 0x5b, 0x16, 0xcf, 0x34, 0x45, 0xc5, 0x46, 0x7d, 0xaa, 0xa1, 0xf7, 0x4d, 0xf5, 0xdb,
+	0x93, 0x28, 0xb3, 0x76, 0x44, 0x0c, 0xcd, 0x54, 0xf7, 0x51, 0x9f, 0x6a, 0xcc, 0x69, 0x82, 0x21,
+	0x21, 0xc2, 0x27, 0x60, 0xe1, 0x7a, 0x6c, 0x22, 0xa4, 0x07, 0x39, 0x9d, 0xfb, 0x7a, 0x3c, 0x92,
+	0x8c, 0x16, 0xeb, 0xdc, 0xd7, 0xc5, 0x55, 0xd0, 0xe4, 0x10, 0xa0, 0x9b, 0x42, 0x2f, 0xa1, 0x29,
+	0xb4, 0x10, 0xed, 0xe1, 0x04, 0x9a, 0x4b, 0x65, 0x1d, 0x74, 0x3a, 0x54, 0x86, 0x8a, 0xf6, 0xc6,
+	0xbb, 0xe4, 0x66, 0xd1, 0x1b, 0xaf, 0x8b, 0x57, 0x81, 0xe0, 0x12, 0xf5, 0x5b, 0x55, 0xcc, 0x7e,
+	0x16, 0x92, 0x52, 0x48, 0x3f, 0x8b, 0x4d, 0x61, 0x35, 0x34, 0x7b, 0x14, 0xa8, 0x1b, 0xe1, 0x4b,
+	0x69, 0x22, 0x44, 0xff, 0x3a, 0x85, 0xe7, 0xd2, 0x59, 0x0c, 0x75, 0xae, 0x96, 0x55, 0x65, 0xdc,
+	0x34, 0x36, 0x7f, 0x74, 0x94, 0x23, 0x2e, 0xc6, 0x9a, 0x53, 0xbf, 0x2e, 0x22, 0xae, 0x8f, 0xc4,
+	0x52, 0x70, 0x8e, 0x35, 0x6b, 0x2d, 0x43, 0x29, 0x10, 0x48, 0x2e, 0x85, 0x3e, 0xcf, 0x1c, 0xc8,
+	0xec, 0x5e, 0xb8, 0x98, 0x26, 0x11, 0x52, 0x03, 0x70, 0x68, 0x5c, 0x09, 0x8d, 0x0e, 0x0d, 0x37,
+	0x6a, 0x30, 0x1b, 0x0a, 0xc9, 0xf1, 0xbc, 0xa6, 0x73, 0x0b, 0xbe, 0x7c, 0x7f, 0x1b, 0xd4, 0x53,
+	0x46, 0x2c, 0x2c, 0x80, 0xfa, 0xfe, 0x54, 0xff, 0x65, 0xbd, 0xe9, 0x91, 0x35, 0xd7, 0xad, 0x59,
+	0xfb, 0x17, 0x6b, 0x50, 0x85, 0x20, 0x42, 0xc2, 0xfe, 0xc4, 0xeb, 0xca, 0x47, 0xff, 0xf1, 0x87,
+	0xb3, 0x87, 0xaa, 0x84, 0x0e, 0x68, 0xf2, 0x71, 0xfc, 0x36, 0x74, 0xb4, 0xff, 0x9b, 0x77, 0x9f,
+	0x8d, 0x0a, 0x8b, 0x40, 0x20, 0xa0, 0x4e, 0x9f, 0x39, 0x7a, 0xe7, 0xf0, 0xb6, 0x3b, 0x4e, 0x4d,
+	0x4f, 0x4f, 0x4f, 0x47, 0x84, 0x0b, 0xa0, 0xc3, 0x46, 0xe0, 0x3f, 0xd5, 0x40, 0x7b, 0xa6, 0xbf,
+	0x7e, 0x67, 0x8d, 0x4f, 0x86, 0x7c, 0xf5, 0x82, 0x8e, 0xfc, 0xf0, 0x99, 0x07, 0xce, 0xd8, 0x64,
+	0x16, 0x41, 0xab, 0xcf, 0x87, 0x6a, 0x4a, 0x47, 0x9b, 0xde, 0xbd, 0xfd, 0x78, 0x8d, 0xb0, 0x14,
+	0xda, 0x08, 0x04, 0xba, 0xab, 0x1c, 0x3d, 0xf1, 0xf4, 0xa6, 0x37, 0xa6, 0x6d, 0x42, 0x8b, 0xa1,
+	0x85, 0x4f, 0x08, 0xbd, 0xf2, 0x4f, 0x27, 0x36, 0x9f, 0x76, 0x91, 0xda, 0x69, 0x24, 0x6a, 0xab,
+	0xa1, 0x1f, 0x3d, 0xbf, 0xe7, 0xdb, 0x51, 0xe1, 0x42, 0x48, 0xd0, 0x48, 0xe4, 0x91, 0x84, 0x5e,
+	0xda, 0xff, 0x9b, 0x5f, 0x39, 0xd4, 0x56, 0x80, 0x58, 0x84, 0x9a, 0x53, 0xd8, 0x42, 0xaf, 0x1d,
+	0xb9, 0xeb, 0xf9, 0xf7, 0x66, 0x37, 0xc0, 0x72, 0x5f, 0xe8, 0xf7, 0x8f, 0x9c, 0x3a, 0xed, 0x4c,
+	0xea, 0x22, 0xe8, 0x20, 0x06, 0x30, 0xbd, 0xc6, 0xe8, 0x27, 0x3b, 0x5e, 0xdc, 0x76, 0x96, 0x87,
+	0xca, 0xf4, 0x05, 0xa3, 0x5f, 0x9e, 0xbd, 0x67, 0xfb, 0x99, 0xc0, 0xd2, 0xf1, 0x1b, 0x66, 0xd1,
+	0xeb, 0x27, 0xf7, 0xde, 0x5e, 0x25, 0x5c, 0x0a, 0xc9, 0x62, 0x58, 0x66, 0x08, 0x8c, 0xbe, 0xf2,
+	0xd8, 0xf6, 0x87, 0xde, 0x0b, 0x11, 0x35, 0x40, 0xf4, 0xf8, 0x73, 0xff, 0xf6, 0xc2, 0xbb, 0x36,
+	0xea, 0x12, 0xe8, 0x22, 0x50, 0x39, 0x9d, 0xa3, 0xe8, 0xad, 0x7d, 0x3b, 0xf3, 0xc2, 0x85, 0xb0,
+	0x28, 0x30, 0xa3, 0x60, 0xc7, 0x27, 0x7a, 0xfb, 0xc0, 0x8f, 0x1f, 0x8f, 0x09, 0x17, 0xc3, 0x62,
+	0x1a, 0x91, 0xdb, 0xb3, 0x88, 0xb6, 0x1d, 0x3d, 0xfc, 0xbd, 0x1a, 0xe1, 0x23, 0x14, 0x72, 0x58,
+	0x8b, 0x25, 0x7a, 0xf2, 0xe1, 0x43, 0xc7, 0x1d, 0x4b, 0x17, 0xa1, 0x99, 0x26, 0xef, 0xe0, 0xa2,
+	0xa7, 0xf7, 0x7f, 0xe1, 0x8d, 0xd3, 0x3c, 0x1c, 0xbf, 0x0d, 0x11, 0xdd, 0x7d, 0xf8, 0xd0, 0x0f,
+	0xbc, 0x1d, 0x43, 0x9a, 0x3a, 0xd9, 0xfc, 0x87, 0x1e, 0xfd, 0xee, 0xb7, 0x1e, 0x73, 0x94, 0x43,
+	0x5b, 0x5f, 0xa0, 0x0b, 0x0f, 0x1d, 0x7b, 0x7e, 0xf3, 0x4b, 0x0e, 0x62, 0x77, 0xd0, 0x4c, 0x29,
+	0xc4, 0x13, 0xaf, 0x6c, 0x99, 0x10, 0x96, 0x40, 0x27, 0x81, 0xc2, 0xb6, 0x96, 0xa1, 0x13, 0xdf,
+	0xd8, 0xfd, 0x56, 0x2c, 0xb0, 0x75, 0x88, 0xee, 0x2e, 0x74, 0xf8, 0xc9, 0x5f, 0x9d, 0x71, 0x4c,
+	0x71, 0x39, 0x65, 0xbb, 0x21, 0x4d, 0x58, 0xe8, 0xfb, 0xaf, 0xed, 0xde, 0x17, 0x63, 0x0d, 0x2c,
+	0x80, 0xf5, 0xf0, 0xa1, 0x97, 0xbf, 0x1a, 0x15, 0x2e, 0xe1, 0xac, 0x6f, 0x00, 0xf1, 0xf8, 0xf4,
+	0x8b, 0x5f, 0x74, 0xa6, 0x7b, 0x19, 0x5c, 0xcc, 0xf8, 0x81, 0xf0, 0x66, 0x21, 0xb4, 0xe3, 0xb9,
+	0x37, 0x7e, 0x16, 0x0d, 0x2c, 0x76, 0x58, 0x37, 0x0e, 0xfa, 0xf1, 0x6b, 0x87, 0x5e, 0x72, 0xf6,
+	0xc6, 0xc5, 0x45, 0x47, 0xb8, 0xb5, 0x37, 0x74, 0xf0, 0xec, 0xce, 0x97, 0xab, 0x02, 0x16, 0xca,
+	0xeb, 0x59, 0x41, 0x27, 0x76, 0xfe, 0xe2, 0xce, 0x6a, 0xe1, 0x23, 0x70, 0x11, 0x81, 0x58, 0xbc,
+	0xb5, 0x04, 0xed, 0x79, 0xf3, 0x3b, 0x49, 0x21, 0x09, 0x71, 0x9e, 0xba, 0x2d, 0xad, 0x6c, 0x7a,
+	0xea, 0x96, 0x17, 0x6a, 0x84, 0x4e, 0x68, 0x66, 0x16, 0xd7, 0x02, 0x3f, 0xfb, 0xd4, 0x7b, 0xa7,
+	0x6a, 0x84, 0x6e, 0xd2, 0xbd, 0xfb, 0x0d, 0x0c, 0x68, 0xef, 0xcb, 0xf7, 0x6e, 0x3e, 0xc5, 0x73,
+	0x99, 0x44, 0x1f, 0x01, 0xba, 0xeb, 0xf1, 0xaf, 0xfc, 0xe1, 0xac, 0xbb, 0x59, 0xe3, 0x41, 0x3a,
+	0xee, 0x05, 0x3a, 0xda, 0xba, 0xe5, 0x96, 0x97, 0xcf, 0xf0, 0xcc, 0x36, 0x70, 0xd3, 0x8e, 0x8e,
+	0x1c, 0xdb, 0xe7, 0xee, 0xa6, 0xe5, 0xd0, 0x19, 0xdc, 0x05, 0xd4, 0x2d, 0x38, 0xfa, 0xdd, 0xab,
+	0x6f, 0xef, 0xf2, 0x4c, 0xbc, 0x8d, 0xb6, 0x10, 0xe2, 0xca, 0x1a, 0x3d, 0x7b, 0xc7, 0xf4, 0xae,
+	0x4a, 0xe1, 0x72, 0xb8, 0x90, 0x46, 0x09, 0xbd, 0xc4, 0x45, 0x87, 0xbe, 0xb6, 0xff, 0x11, 0xc7,
+	0x59, 0xd1, 0xa3, 0x8a, 0x5d, 0xfd, 0xa2, 0x57, 0x5f, 0xbf, 0xfd, 0x09, 0xae, 0xe8, 0xec, 0x8d,
+	0x2c, 0xda, 0x75, 0x78, 0xeb, 0x23, 0xa7, 0x79, 0xb8, 0xec, 0x75, 0x2a, 0xfa, 0xf5, 0xbb, 0x77,
+	0xff, 0xfa, 0x34, 0x4f, 0xc5, 0xd4, 0xfd, 0x26, 0xba, 0xef, 0xb6, 0x3b, 0xf6, 0x3a, 0xda, 0x58,
+	0x1a, 0xd4, 0x06, 0x71, 0x1b, 0x89, 0x5e, 0x7d, 0xf0, 0x5f, 0xf7, 0x39, 0x78, 0xcb, 0xa0, 0x9d,
+	0x8b, 0x67, 0x97, 0x2d, 0xd0, 0xbf, 0xef, 0xfd, 0xdf, 0xad, 0xd3, 0x21, 0x9e, 0xcd, 0xe5, 0x7a,
+	0xec, 0x4b, 0xff, 0x79, 0xd8, 0x59, 0x7f, 0xda, 0xcc, 0xfc, 0x6b, 0x3b, 0xb4, 0xf5, 0x9d, 0x6d,
+	0xcf, 0xd7, 0xf0, 0x9c, 0x9a, 0x8b, 0xb0, 0xfb, 0xa9, 0x07, 0xfe, 0xe5, 0x94, 0x2b, 0x7a, 0x57,
+	0xf0, 0xe0, 0xa3, 0xaf, 0x7f, 0xd0, 0xb6, 0xdb, 0x1e, 0xfd, 0x6e, 0x95, 0x70, 0x11, 0x74, 0x33,
+	0x9a, 0x60, 0x50, 0x5f, 0xfc, 0xd6, 0x37, 0xf7, 0x05, 0xb5, 0x41, 0x5f, 0xf2, 0xa0, 0x6d, 0x6f,
+	0xde, 0x7a, 0xe0, 0x74, 0x88, 0x0d, 0x11, 0xf7, 0x2d, 0xe8, 0x1b, 0x07, 0x36, 0xdd, 0xc3, 0xec,
+	0x03, 0xff, 0xa2, 0x04, 0xdd, 0x79, 0xec, 0xd6, 0x9f, 0x3b, 0x7a, 0xe8, 0x81, 0x6e, 0x06, 0x89,
+	0xf1, 0x3a, 0xbf, 0xd9, 0xf2, 0xe5, 0xc3, 0x5c, 0xdb, 0xa5, 0x2f, 0x14, 0xd0, 0xb1, 0x83, 0xbb,
+	0x1f, 0xa9, 0xe4, 0x86, 0x36, 0x9e, 0x87, 0xf9, 0x9f, 0xef, 0x3c, 0xfd, 0xe8, 0xb4, 0x6b, 0x1f,
+	0x9d, 0x1c, 0x37, 0xe3, 0x57, 0xa1, 0xd1, 0x9d, 0xbb, 0x0e, 0x1c, 0x8e, 0x06, 0x94, 0xcc, 0x29,
+	0x94, 0xa3, 0xad, 0x3f, 0xba, 0xeb, 0x48, 0x25, 0x6b, 0x1f, 0x34, 0xd2, 0x33, 0x5f, 0xb8, 0xff,
+	0xd8, 0x59, 0xde, 0x1c, 0xe8, 0x72, 0x34, 0xda, 0x71, 0xf0, 0xe4, 0x1e, 0xd3, 0x0b, 0x21, 0x7a,
+	0xdf, 0xab, 0x06, 0x3a, 0x79, 0xf7, 0x73, 0xdf, 0x7e, 0x97, 0x67, 0x3d, 0x7e, 0x19, 0x17, 0xdd,
+	0x73, 0xf2, 0xde, 0x87, 0xa2, 0x6c, 0xd0, 0xe6, 0x56, 0x60, 0xd1, 0xbe, 0x1f, 0xfc, 0xf0, 0xbf,
+	0xb9, 0x41, 0x10, 0x53, 0x25, 0x45, 0x3f, 0x7f, 0xfb, 0xc1, 0x67, 0xa6, 0x43, 0xce, 0x44, 0xb2,
+	0xf8, 0x88, 0x6e, 0x3d, 0xfa, 0xdb, 0x4e, 0x36, 0x06, 0xe3, 0x15, 0x0d, 0xd1, 0xe9, 0x23, 0xfb,
+	0xff, 0xf1, 0x14, 0x4f, 0xfb, 0x6c, 0xa5, 0x0f, 0xed, 0x7c, 0xee, 0xe1, 0x53, 0x51, 0xa1, 0x0d,
+	0x16, 0x50, 0xba, 0x32, 0x8b, 0x74, 0xe8, 0xa7, 0xbf, 0x3d, 0xb4, 0xa9, 0x26, 0xb0, 0xbf, 0xa9,
+	0x62, 0x18, 0x3a, 0xf9, 0xfb, 0xb7, 0x5e, 0x38, 0x13, 0xc2, 0x28, 0x50, 0xb1, 0x41, 0x3f, 0x79,
+	0xeb, 0xd1, 0x5b, 0xa2, 0x6c, 0xa0, 0x47, 0x57, 0x4c, 0xd0, 0xd7, 0x77, 0xbc, 0xfe, 0x35, 0xcf,
+	0xf6, 0x9b, 0x98, 0x43, 0xc4, 0x5c, 0xa0, 0x5d, 0xef, 0x6c, 0xff, 0xe9, 0x69, 0x9e, 0xdf, 0x0e,
+	0x94, 0x0a, 0xd0, 0xeb, 0x3b, 0xb7, 0x9f, 0x7d, 0x2f, 0xc4, 0xab, 0x50, 0xb9, 0x3e, 0xba, 0xe5,
+	0xa5, 0xcd, 0xff, 0xe5, 0x2c, 0x02, 0x7d, 0x74, 0x51, 0xa9, 0x39, 0xfa, 0x87, 0x23, 0x6f, 0xbe,
+	0x52, 0x95, 0xa8, 0xda, 0xfc, 0x50, 0x57, 0x45, 0xef, 0xf7, 0x56, 0x40, 0x4d, 0x6a, 0xa8, 0xdf,
+	0xca, 0x16, 0x07, 0x61, 0x01, 0xfb, 0x2a, 0x35, 0xe1, 0xa6, 0xec, 0x6c, 0x72, 0x90, 0x68, 0xb3,
+	0x61, 0x9c, 0xcc, 0x5c, 0xac, 0x10, 0x56, 0xc1, 0x42, 0xde, 0x1b, 0xd4, 0x0e, 0x9a, 0x1e, 0x0d,
+	0x4d, 0xd0, 0x35, 0x35, 0xb1, 0x42, 0xe8, 0x07, 0x14, 0xe4, 0x2b, 0xb4, 0x85, 0x8a, 0x94, 0xe0,
+	0x54, 0x00, 0xc4, 0x0a, 0xe1, 0x53, 0xd0, 0xcc, 0x5d, 0x4e, 0xa1, 0x8b, 0xa1, 0x44, 0xc1, 0x13,
+	0x21, 0xe5, 0x00, 0xb1, 0x42, 0x58, 0x07, 0x4d, 0xbc, 0xa5, 0x17, 0x3a, 0x19, 0x8a, 0x24, 0x38,
+	0x91, 0xa0, 0x34, 0x16, 0x24, 0x9a, 0x86, 0x8e, 0xa2, 0xef, 0x35, 0x97, 0x14, 0x17, 0xd7, 0x41,
+	0x2b, 0x22, 0x75, 0x38, 0x03, 0xbb, 0x68, 0x33, 0x23, 0x03, 0x0b, 0xad, 0x08, 0x83, 0x6b, 0xa1,
+	0x25, 0xe4, 0x45, 0xe6, 0x22, 0x9a, 0x34, 0x83, 0xc0, 0x2e, 0xfd, 0x30, 0xb4, 0x78, 0x41, 0x69,
+	0x51, 0x5a, 0x0c, 0x42, 0xc2, 0x2f, 0x74, 0xd0, 0x00, 0xb1, 0x42, 0xf8, 0x4b, 0x9f, 0x6a, 0xa0,
+	0x76, 0xc3, 0x52, 0xa5, 0x11, 0x12, 0xc5, 0x1e, 0xe4, 0x89, 0x15, 0xc2, 0x04, 0x24, 0x8a, 0x3c,
+	0x7e, 0x5c, 0x3c, 0x03, 0x75, 0x13, 0x29, 0xb1, 0x98, 0x32, 0x0f, 0x7e, 0xd9, 0x88, 0xd6, 0x72,
+	0xf1, 0x39, 0x30, 0x08, 0xac, 0x96, 0xd7, 0x40, 0x3c, 0xf4, 0xed, 0x60, 0x37, 0x4d, 0x4d, 0xc3,
+	0xb3, 0xa0, 0x37, 0x06, 0x6d, 0xe1, 0x8f, 0x03, 0x45, 0x76, 0xe1, 0x82, 0x38, 0xb3, 0xd5, 0x81,
+	0x0c, 0x9d, 0xc5, 0x1f, 0x05, 0x2e, 0x65, 0x78, 0x71, 0xf1, 0x12, 0x33, 0xbc, 0xb2, 0xb3, 0x4c,
+	0xa6, 0xb3, 0xf8, 0x53, 0xc0, 0xa5, 0x8c, 0xd6, 0xf9, 0xac, 0x18, 0x65, 0x5d, 0x03, 0x8d, 0xc1,
+	0x47, 0x7b, 0x71, 0x46, 0x6c, 0x07, 0x92, 0x88, 0xd3, 0x8a, 0xf1, 0xcb, 0x80, 0x62, 0x85, 0xf0,
+	0xa7, 0xd0, 0x18, 0x48, 0x7a, 0x83, 0x84, 0x7c, 0x08, 0x2b, 0xc6, 0x55, 0xb0, 0xc0, 0x8f, 0x1c,
+	0xdd, 0xf1, 0x01, 0xc7, 0x4f, 0xc2, 0x58, 0x0a, 0xab, 0xa1, 0x89, 0x97, 0x2a, 0x07, 0xdd, 0x61,
+	0x00, 0xcc, 0xb3, 0xc6, 0x26, 0xee, 0x1b, 0x31, 0xd6, 0xad, 0x52, 0x74, 0xf8, 0xef, 0xcf, 0xc4,
+	0x0a, 0x61, 0x10, 0x5a, 0xc3, 0x5e, 0x74, 0x25, 0x69, 0x92, 0x2c, 0x06, 0x2b, 0xdd, 0x9f, 0x03,
+	0x0a, 0x66, 0xe7, 0xc1, 0xc3, 0x88, 0x00, 0xb1, 0xe3, 0xff, 0x16, 0x3a, 0x88, 0x20, 0x80, 0x7d,
+	0x3d, 0x15, 0x70, 0xbf, 0x21, 0x68, 0x89, 0xb0, 0x32, 0x2e, 0xed, 0x33, 0x03, 0xb4, 0x17, 0x71,
+	0xec, 0x6a, 0xb6, 0x54, 0xff, 0x86, 0xda, 0xd3, 0x01, 0xc2, 0xdc, 0x3d, 0x1d, 0xa0, 0xdd, 0x1e,
+	0x30, 0xdd, 0x00, 0x7d, 0x0d, 0x96, 0xcc, 0xee, 0xf5, 0x51, 0x0f, 0x2f, 0x78, 0x08, 0xc7, 0x2f,
+	0x36, 0xa7, 0x51, 0xe8, 0xe4, 0xd2, 0xf0, 0xfa, 0x8d, 0x96, 0x16, 0xe7, 0xe5, 0xe2, 0x9d, 0x0b,
+	0x0f, 0xaf, 0x7b, 0x68, 0x06, 0x1e, 0x2e, 0x5e, 0x31, 0x1e, 0x37, 0x40, 0x1b, 0x77, 0xac, 0xd5,
+	0xca, 0x26, 0x16, 0xa7, 0x6f, 0xe2, 0x14, 0xa3, 0x9d, 0x85, 0xc5, 0xb3, 0x79, 0x86, 0x73, 0x09,
+	0xcd, 0xa5, 0x38, 0x36, 0xbb, 0x2b, 0xae, 0x03, 0x81, 0xf3, 0x8e, 0xa6, 0x3d, 0x64, 0x2f, 0xcc,
+	0x10, 0x97, 0xb9, 0x8e, 0x90, 0x78, 0xab, 0x12, 0xe7, 0x6d, 0x74, 0x8b, 0x0c, 0x23, 0xca, 0x95,
+	0xd0, 0x10, 0x78, 0x6a, 0xd2, 0x4a, 0x0f, 0xf6, 0x00, 0xe1, 0xce, 0x81, 0x7c, 0x0a, 0x12, 0x70,
+	0x0e, 0x04, 0x88, 0x1d, 0xdf, 0x07, 0x82, 0xcf, 0xc2, 0x7b, 0x1e, 0xd1, 0xce, 0xe1, 0xef, 0x02,
+	0xc3, 0xdd, 0x70, 0xf0, 0x91, 0x45, 0xc0, 0x7d, 0x06, 0xc0, 0x2c, 0x9d, 0x21, 0x68, 0xf5, 0x9d,
+	0x3e, 0xfd, 0x3e, 0x22, 0xc9, 0x39, 0x16, 0x28, 0x8c, 0xc4, 0x02, 0x3a, 0x2b, 0x50, 0x95, 0x71,
+	0xb1, 0x42, 0x58, 0x0b, 0x0b, 0xbd, 0x9c, 0x85, 0x78, 0xf2, 0xd0, 0xc1, 0x38, 0x0f, 0x02, 0x1a,
+	0x3c, 0xf1, 0xfc, 0x9b, 0x24, 0xcb, 0x97, 0x76, 0xcf, 0xfc, 0x56, 0x61, 0x39, 0x43, 0x3e, 0x14,
+	0x97, 0xe7, 0xad, 0xbb, 0x67, 0x7e, 0x94, 0xb0, 0x9c, 0x39, 0x65, 0xdf, 0x07, 0x87, 0x34, 0xb4,
+	0x92, 0x72, 0x91, 0x8d, 0xfe, 0x49, 0xae, 0xe4, 0x04, 0x46, 0x22, 0xe9, 0x51, 0x93, 0xec, 0x18,
+	0x37, 0x1d, 0xc0, 0x20, 0x8e, 0x3f, 0x7d, 0x46, 0x06, 0x2c, 0x46, 0xb8, 0x85, 0xd2, 0xcd, 0xff,
+	0xed, 0xbc, 0xed, 0xe5, 0x00, 0x59, 0x1a, 0x84, 0x1d, 0x90, 0x7d, 0xfc, 0x1d, 0x61, 0x0b, 0xc5,
+	0xb1, 0x03, 0xe2, 0x3e, 0xd0, 0x9a, 0x62, 0x33, 0xbf, 0x5d, 0xbf, 0x2b, 0x8c, 0xe4, 0x40, 0x36,
+	0xdc, 0x4c, 0xfb, 0xa1, 0x31, 0x80, 0xcd, 0x09, 0xc8, 0xdc, 0xc9, 0x71, 0xaf, 0x28, 0x09, 0x1f,
+	0x44, 0xb4, 0xd7, 0xc7, 0x99, 0x38, 0x28, 0x54, 0x43, 0x54, 0x30, 0xe6, 0x8e, 0xe7, 0x05, 0x63,
+	0xa1, 0x14, 0x6e, 0x80, 0xb8, 0x9f, 0xbe, 0x05, 0x3a, 0xbc, 0xbb, 0x39, 0x19, 0x1e, 0x8d, 0x12,
+	0xc8, 0xea, 0xc9, 0xab, 0x53, 0x2b, 0xc0, 0x68, 0x2f, 0xd6, 0x40, 0x7e, 0x01, 0xcf, 0x18, 0x18,
+	0x0e, 0x8c, 0xc4, 0x9f, 0x82, 0x85, 0x9c, 0x0a, 0x62, 0xd0, 0x2a, 0x68, 0x68, 0xa2, 0xc8, 0x3f,
+	0x64, 0x66, 0xb9, 0xc2, 0x85, 0xbc, 0xe6, 0x6e, 0x9e, 0xa1, 0x79, 0xd0, 0x44, 0xa3, 0x27, 0x98,
+	0xfd, 0x38, 0x96, 0x74, 0xeb, 0x44, 0xeb, 0x76, 0x1b, 0xef, 0x58, 0xb5, 0x40, 0xbc, 0x2c, 0xb6,
+	0xbd, 0x58, 0xf3, 0xf5, 0x05, 0x3c, 0x52, 0x4c, 0x1c, 0xc3, 0x50, 0xa5, 0x8b, 0x2b, 0x5e, 0xcc,
+	0xc2, 0x2d, 0xae, 0x78, 0x91, 0xca, 0x0c, 0x54, 0xbc, 0xa8, 0x84, 0x4b, 0xc5, 0x8b, 0x45, 0x18,
+	0x2a, 0xd7, 0x43, 0x2b, 0x81, 0x47, 0xf5, 0x24, 0x27, 0xf9, 0xf1, 0x87, 0x8f, 0x91, 0x68, 0x20,
+	0xee, 0xe8, 0x8d, 0x3f, 0xbe, 0xdc, 0xf2, 0x10, 0xf1, 0xd0, 0x26, 0xe3, 0x6e, 0x6e, 0xcc, 0x4e,
+	0xa2, 0x70, 0x08, 0x12, 0x1e, 0x82, 0xa6, 0xd6, 0xc5, 0x09, 0x89, 0x49, 0x52, 0x5c, 0x0f, 0xe1,
+	0xea, 0x2c, 0xd0, 0x17, 0xdc, 0xc1, 0xb8, 0x53, 0x02, 0xca, 0xea, 0xac, 0x17, 0x80, 0xe8, 0xd5,
+	0x5d, 0xc8, 0x1c, 0xcf, 0x2a, 0xc7, 0x92, 0x3e, 0x01, 0x8d, 0x64, 0x7d, 0xd0, 0xec, 0xc3, 0x65,
+	0x7d, 0x93, 0x03, 0x49, 0x20, 0x62, 0xb4, 0xf5, 0x45, 0xac, 0x10, 0x3e, 0x09, 0x28, 0x58, 0xe2,
+	0xe5, 0xd4, 0xd2, 0x5c, 0x10, 0x11, 0x18, 0x9a, 0x20, 0xdd, 0x6a, 0x93, 0x30, 0x81, 0x44, 0x01,
+	0x82, 0xed, 0x96, 0x5d, 0xc4, 0x5b, 0x71, 0x02, 0x81, 0x9d, 0xd6, 0xb5, 0x7e, 0xca, 0x47, 0x75,
+	0xa9, 0xb2, 0x29, 0x1f, 0x09, 0x4e, 0xb0, 0xcd, 0x19, 0x56, 0xa2, 0xd2, 0x51, 0xb4, 0x0d, 0x75,
+	0x49, 0x28, 0x4d, 0x12, 0x2d, 0xd1, 0x4c, 0x2d, 0x3c, 0x41, 0x7f, 0xc8, 0x3f, 0xb0, 0x83, 0xad,
+	0xa6, 0xc9, 0x22, 0xe2, 0xda, 0x55, 0x33, 0xae, 0xc4, 0x57, 0x40, 0x1d, 0xd9, 0x50, 0xda, 0x14,
+	0x34, 0x23, 0xf3, 0x6b, 0x82, 0xf9, 0xa7, 0x04, 0x89, 0xc3, 0x98, 0x6e, 0xeb, 0x6c, 0xe7, 0xa9,
+	0xdf, 0x01, 0xb2, 0xaa, 0x1f, 0xf1, 0xa7, 0x13, 0x6c, 0x4c, 0x4c, 0x16, 0xaf, 0x04, 0xae, 0xef,
+	0x2d, 0x12, 0x83, 0xa7, 0xa0, 0x85, 0x57, 0xfc, 0x5c, 0xdf, 0xcb, 0x49, 0x42, 0x69, 0x04, 0x22,
+	0x91, 0x27, 0x01, 0x62, 0x85, 0xb0, 0x12, 0x1a, 0x88, 0x3c, 0xc0, 0xdc, 0x34, 0xad, 0xbc, 0x04,
+	0xc1, 0xdc, 0x38, 0x21, 0x82, 0x09, 0x57, 0xfb, 0x86, 0x46, 0xb5, 0xe2, 0x75, 0x72, 0x37, 0x91,
+	0x0b, 0x0e, 0x3a, 0x14, 0xe1, 0x5a, 0x68, 0xe6, 0x56, 0xdf, 0x39, 0xee, 0x84, 0x82, 0x73, 0xdc,
+	0x89, 0xb7, 0x88, 0x74, 0xeb, 0x5c, 0x3b, 0xa7, 0xec, 0xe6, 0x02, 0x99, 0x23, 0xaa, 0x6f, 0xed,
+	0xef, 0x5e, 0xed, 0x8a, 0x3c, 0x7b, 0xb4, 0x2b, 0x72, 0xe0, 0x68, 0x57, 0xe4, 0x97, 0x47, 0xbb,
+	0x22, 0x37, 0xfc, 0x19, 0xf1, 0x4f, 0x08, 0x1b, 0x58, 0x9a, 0x1c, 0xd7, 0x24, 0xff, 0x8f, 0x4b,
+	0x75, 0xac, 0x6d, 0xc4, 0xda, 0x0a, 0x29, 0x9f, 0x5f, 0x61, 0xfe, 0x29, 0x67, 0xf0, 0x8a, 0x51,
+	0xf9, 0x33, 0x2b, 0x4c, 0x56, 0xd6, 0xff, 0x46, 0xab, 0x2d, 0xf2, 0x97, 0xfd, 0xdf, 0x00, 0xdb,
+	0xe8, 0x86, 0x3e, 0x9f, 0x58, 0x00, 0x00,
 }
 
 func (this *LastSeenData) GoString() string {
@@ -7756,6 +8153,93 @@ func (this *TLUserGetMutableUsersV2) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TLUserCreateBot) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&user.TLUserCreateBot{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "CreatorUserId: "+fmt.Sprintf("%#v", this.CreatorUserId)+",\n")
+	s = append(s, "FirstName: "+fmt.Sprintf("%#v", this.FirstName)+",\n")
+	s = append(s, "Username: "+fmt.Sprintf("%#v", this.Username)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLUserGetBotIdByToken) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&user.TLUserGetBotIdByToken{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLUserGetBotsByCreator) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&user.TLUserGetBotsByCreator{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "CreatorUserId: "+fmt.Sprintf("%#v", this.CreatorUserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLUserResetBotToken) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&user.TLUserResetBotToken{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "BotId: "+fmt.Sprintf("%#v", this.BotId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLUserSetDefaultHistoryTTL) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&user.TLUserSetDefaultHistoryTTL{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLUserGetDefaultHistoryTTL) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&user.TLUserGetDefaultHistoryTTL{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *Vector_LastSeenData) GoString() string {
 	if this == nil {
 		return "nil"
@@ -7997,6 +8481,16 @@ type RPCUserClient interface {
 	UserUpdateBotData(ctx context.Context, in *TLUserUpdateBotData, opts ...grpc.CallOption) (*mtproto.Bool, error)
 	UserGetImmutableUserV2(ctx context.Context, in *TLUserGetImmutableUserV2, opts ...grpc.CallOption) (*mtproto.ImmutableUser, error)
 	UserGetMutableUsersV2(ctx context.Context, in *TLUserGetMutableUsersV2, opts ...grpc.CallOption) (*mtproto.MutableUsers, error)
+	// user.createBot creator_user_id:long first_name:string username:string = ImmutableUser;
+	UserCreateBot(ctx context.Context, in *TLUserCreateBot, opts ...grpc.CallOption) (*mtproto.ImmutableUser, error)
+	// user.getBotIdByToken token:string = Int64;
+	UserGetBotIdByToken(ctx context.Context, in *TLUserGetBotIdByToken, opts ...grpc.CallOption) (*mtproto.Int64, error)
+	// user.getBotsByCreator creator_user_id:long = Vector<long>;
+	UserGetBotsByCreator(ctx context.Context, in *TLUserGetBotsByCreator, opts ...grpc.CallOption) (*Vector_Long, error)
+	// user.resetBotToken bot_id:long = String;
+	UserResetBotToken(ctx context.Context, in *TLUserResetBotToken, opts ...grpc.CallOption) (*mtproto.String, error)
+	UserSetDefaultHistoryTTL(ctx context.Context, in *TLUserSetDefaultHistoryTTL, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	UserGetDefaultHistoryTTL(ctx context.Context, in *TLUserGetDefaultHistoryTTL, opts ...grpc.CallOption) (*mtproto.DefaultHistoryTTL, error)
 }
 
 type rPCUserClient struct {
@@ -8655,35 +9149,89 @@ func (c *rPCUserClient) UserGetMutableUsersV2(ctx context.Context, in *TLUserGet
 	return out, nil
 }
 
-// RPCUserServer is the server API for RPCUser service.
-type RPCUserServer interface {
-	UserGetLastSeens(context.Context, *TLUserGetLastSeens) (*Vector_LastSeenData, error)
-	UserUpdateLastSeen(context.Context, *TLUserUpdateLastSeen) (*mtproto.Bool, error)
-	UserGetLastSeen(context.Context, *TLUserGetLastSeen) (*LastSeenData, error)
-	UserGetImmutableUser(context.Context, *TLUserGetImmutableUser) (*mtproto.ImmutableUser, error)
-	UserGetMutableUsers(context.Context, *TLUserGetMutableUsers) (*Vector_ImmutableUser, error)
-	UserGetImmutableUserByPhone(context.Context, *TLUserGetImmutableUserByPhone) (*mtproto.ImmutableUser, error)
-	UserGetImmutableUserByToken(context.Context, *TLUserGetImmutableUserByToken) (*mtproto.ImmutableUser, error)
-	UserSetAccountDaysTTL(context.Context, *TLUserSetAccountDaysTTL) (*mtproto.Bool, error)
-	UserGetAccountDaysTTL(context.Context, *TLUserGetAccountDaysTTL) (*mtproto.AccountDaysTTL, error)
-	UserGetNotifySettings(context.Context, *TLUserGetNotifySettings) (*mtproto.PeerNotifySettings, error)
-	UserGetNotifySettingsList(context.Context, *TLUserGetNotifySettingsList) (*Vector_PeerPeerNotifySettings, error)
-	UserSetNotifySettings(context.Context, *TLUserSetNotifySettings) (*mtproto.Bool, error)
-	UserResetNotifySettings(context.Context, *TLUserResetNotifySettings) (*mtproto.Bool, error)
-	UserGetAllNotifySettings(context.Context, *TLUserGetAllNotifySettings) (*Vector_PeerPeerNotifySettings, error)
-	UserGetGlobalPrivacySettings(context.Context, *TLUserGetGlobalPrivacySettings) (*mtproto.GlobalPrivacySettings, error)
-	UserSetGlobalPrivacySettings(context.Context, *TLUserSetGlobalPrivacySettings) (*mtproto.Bool, error)
-	UserGetPrivacy(context.Context, *TLUserGetPrivacy) (*Vector_PrivacyRule, error)
-	UserSetPrivacy(context.Context, *TLUserSetPrivacy) (*mtproto.Bool, error)
-	UserCheckPrivacy(context.Context, *TLUserCheckPrivacy) (*mtproto.Bool, error)
-	UserAddPeerSettings(context.Context, *TLUserAddPeerSettings) (*mtproto.Bool, error)
-	UserGetPeerSettings(context.Context, *TLUserGetPeerSettings) (*mtproto.PeerSettings, error)
-	UserDeletePeerSettings(context.Context, *TLUserDeletePeerSettings) (*mtproto.Bool, error)
-	UserChangePhone(context.Context, *TLUserChangePhone) (*mtproto.Bool, error)
-	UserCreateNewPredefinedUser(context.Context, *TLUserCreateNewPredefinedUser) (*mtproto.PredefinedUser, error)
-	UserGetPredefinedUser(context.Context, *TLUserGetPredefinedUser) (*mtproto.PredefinedUser, error)
-	UserGetAllPredefinedUser(context.Context, *TLUserGetAllPredefinedUser) (*Vector_PredefinedUser, error)
-	UserUpdatePredefinedFirstAndLastName(context.Context, *TLUserUpdatePredefinedFirstAndLastName) (*mtproto.PredefinedUser, error)
+func (c *rPCUserClient) UserCreateBot(ctx context.Context, in *TLUserCreateBot, opts ...grpc.CallOption) (*mtproto.ImmutableUser, error) {
+	out := new(mtproto.ImmutableUser)
+	err := c.cc.Invoke(ctx, "/user.RPCUser/user_createBot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCUserClient) UserGetBotIdByToken(ctx context.Context, in *TLUserGetBotIdByToken, opts ...grpc.CallOption) (*mtproto.Int64, error) {
+	out := new(mtproto.Int64)
+	err := c.cc.Invoke(ctx, "/user.RPCUser/user_getBotIdByToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCUserClient) UserGetBotsByCreator(ctx context.Context, in *TLUserGetBotsByCreator, opts ...grpc.CallOption) (*Vector_Long, error) {
+	out := new(Vector_Long)
+	err := c.cc.Invoke(ctx, "/user.RPCUser/user_getBotsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCUserClient) UserResetBotToken(ctx context.Context, in *TLUserResetBotToken, opts ...grpc.CallOption) (*mtproto.String, error) {
+	out := new(mtproto.String)
+	err := c.cc.Invoke(ctx, "/user.RPCUser/user_resetBotToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCUserClient) UserSetDefaultHistoryTTL(ctx context.Context, in *TLUserSetDefaultHistoryTTL, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/user.RPCUser/user_setDefaultHistoryTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCUserClient) UserGetDefaultHistoryTTL(ctx context.Context, in *TLUserGetDefaultHistoryTTL, opts ...grpc.CallOption) (*mtproto.DefaultHistoryTTL, error) {
+	out := new(mtproto.DefaultHistoryTTL)
+	err := c.cc.Invoke(ctx, "/user.RPCUser/user_getDefaultHistoryTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCUserServer is the server API for RPCUser service.
+type RPCUserServer interface {
+	UserGetLastSeens(context.Context, *TLUserGetLastSeens) (*Vector_LastSeenData, error)
+	UserUpdateLastSeen(context.Context, *TLUserUpdateLastSeen) (*mtproto.Bool, error)
+	UserGetLastSeen(context.Context, *TLUserGetLastSeen) (*LastSeenData, error)
+	UserGetImmutableUser(context.Context, *TLUserGetImmutableUser) (*mtproto.ImmutableUser, error)
+	UserGetMutableUsers(context.Context, *TLUserGetMutableUsers) (*Vector_ImmutableUser, error)
+	UserGetImmutableUserByPhone(context.Context, *TLUserGetImmutableUserByPhone) (*mtproto.ImmutableUser, error)
+	UserGetImmutableUserByToken(context.Context, *TLUserGetImmutableUserByToken) (*mtproto.ImmutableUser, error)
+	UserSetAccountDaysTTL(context.Context, *TLUserSetAccountDaysTTL) (*mtproto.Bool, error)
+	UserGetAccountDaysTTL(context.Context, *TLUserGetAccountDaysTTL) (*mtproto.AccountDaysTTL, error)
+	UserGetNotifySettings(context.Context, *TLUserGetNotifySettings) (*mtproto.PeerNotifySettings, error)
+	UserGetNotifySettingsList(context.Context, *TLUserGetNotifySettingsList) (*Vector_PeerPeerNotifySettings, error)
+	UserSetNotifySettings(context.Context, *TLUserSetNotifySettings) (*mtproto.Bool, error)
+	UserResetNotifySettings(context.Context, *TLUserResetNotifySettings) (*mtproto.Bool, error)
+	UserGetAllNotifySettings(context.Context, *TLUserGetAllNotifySettings) (*Vector_PeerPeerNotifySettings, error)
+	UserGetGlobalPrivacySettings(context.Context, *TLUserGetGlobalPrivacySettings) (*mtproto.GlobalPrivacySettings, error)
+	UserSetGlobalPrivacySettings(context.Context, *TLUserSetGlobalPrivacySettings) (*mtproto.Bool, error)
+	UserGetPrivacy(context.Context, *TLUserGetPrivacy) (*Vector_PrivacyRule, error)
+	UserSetPrivacy(context.Context, *TLUserSetPrivacy) (*mtproto.Bool, error)
+	UserCheckPrivacy(context.Context, *TLUserCheckPrivacy) (*mtproto.Bool, error)
+	UserAddPeerSettings(context.Context, *TLUserAddPeerSettings) (*mtproto.Bool, error)
+	UserGetPeerSettings(context.Context, *TLUserGetPeerSettings) (*mtproto.PeerSettings, error)
+	UserDeletePeerSettings(context.Context, *TLUserDeletePeerSettings) (*mtproto.Bool, error)
+	UserChangePhone(context.Context, *TLUserChangePhone) (*mtproto.Bool, error)
+	UserCreateNewPredefinedUser(context.Context, *TLUserCreateNewPredefinedUser) (*mtproto.PredefinedUser, error)
+	UserGetPredefinedUser(context.Context, *TLUserGetPredefinedUser) (*mtproto.PredefinedUser, error)
+	UserGetAllPredefinedUser(context.Context, *TLUserGetAllPredefinedUser) (*Vector_PredefinedUser, error)
+	UserUpdatePredefinedFirstAndLastName(context.Context, *TLUserUpdatePredefinedFirstAndLastName) (*mtproto.PredefinedUser, error)
 	UserUpdatePredefinedVerified(context.Context, *TLUserUpdatePredefinedVerified) (*mtproto.PredefinedUser, error)
 	UserUpdatePredefinedUsername(context.Context, *TLUserUpdatePredefinedUsername) (*mtproto.PredefinedUser, error)
 	UserUpdatePredefinedCode(context.Context, *TLUserUpdatePredefinedCode) (*mtproto.PredefinedUser, error)
@@ -8729,6 +9277,16 @@ type RPCUserServer interface {
 	UserUpdateBotData(context.Context, *TLUserUpdateBotData) (*mtproto.Bool, error)
 	UserGetImmutableUserV2(context.Context, *TLUserGetImmutableUserV2) (*mtproto.ImmutableUser, error)
 	UserGetMutableUsersV2(context.Context, *TLUserGetMutableUsersV2) (*mtproto.MutableUsers, error)
+	// user.createBot creator_user_id:long first_name:string username:string = ImmutableUser;
+	UserCreateBot(context.Context, *TLUserCreateBot) (*mtproto.ImmutableUser, error)
+	// user.getBotIdByToken token:string = Int64;
+	UserGetBotIdByToken(context.Context, *TLUserGetBotIdByToken) (*mtproto.Int64, error)
+	// user.getBotsByCreator creator_user_id:long = Vector<long>;
+	UserGetBotsByCreator(context.Context, *TLUserGetBotsByCreator) (*Vector_Long, error)
+	// user.resetBotToken bot_id:long = String;
+	UserResetBotToken(context.Context, *TLUserResetBotToken) (*mtproto.String, error)
+	UserSetDefaultHistoryTTL(context.Context, *TLUserSetDefaultHistoryTTL) (*mtproto.Bool, error)
+	UserGetDefaultHistoryTTL(context.Context, *TLUserGetDefaultHistoryTTL) (*mtproto.DefaultHistoryTTL, error)
 }
 
 // UnimplementedRPCUserServer can be embedded to have forward compatible implementations.
@@ -8951,6 +9509,26 @@ func (*UnimplementedRPCUserServer) UserGetImmutableUserV2(ctx context.Context, r
 func (*UnimplementedRPCUserServer) UserGetMutableUsersV2(ctx context.Context, req *TLUserGetMutableUsersV2) (*mtproto.MutableUsers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserGetMutableUsersV2 not implemented")
 }
+func (*UnimplementedRPCUserServer) UserCreateBot(ctx context.Context, req *TLUserCreateBot) (*mtproto.ImmutableUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCreateBot not implemented")
+}
+func (*UnimplementedRPCUserServer) UserGetBotIdByToken(ctx context.Context, req *TLUserGetBotIdByToken) (*mtproto.Int64, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetBotIdByToken not implemented")
+}
+func (*UnimplementedRPCUserServer) UserGetBotsByCreator(ctx context.Context, req *TLUserGetBotsByCreator) (*Vector_Long, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetBotsByCreator not implemented")
+}
+func (*UnimplementedRPCUserServer) UserResetBotToken(ctx context.Context, req *TLUserResetBotToken) (*mtproto.String, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserResetBotToken not implemented")
+}
+
+func (*UnimplementedRPCUserServer) UserSetDefaultHistoryTTL(ctx context.Context, req *TLUserSetDefaultHistoryTTL) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserSetDefaultHistoryTTL not implemented")
+}
+
+func (*UnimplementedRPCUserServer) UserGetDefaultHistoryTTL(ctx context.Context, req *TLUserGetDefaultHistoryTTL) (*mtproto.DefaultHistoryTTL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetDefaultHistoryTTL not implemented")
+}
 
 func RegisterRPCUserServer(s *grpc.Server, srv RPCUserServer) {
 	s.RegisterService(&_RPCUser_serviceDesc, srv)
@@ -10252,6 +10830,114 @@ func _RPCUser_UserGetMutableUsersV2_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCUser_UserCreateBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLUserCreateBot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCUserServer).UserCreateBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.RPCUser/UserCreateBot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCUserServer).UserCreateBot(ctx, req.(*TLUserCreateBot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCUser_UserGetBotIdByToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLUserGetBotIdByToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCUserServer).UserGetBotIdByToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.RPCUser/UserGetBotIdByToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCUserServer).UserGetBotIdByToken(ctx, req.(*TLUserGetBotIdByToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCUser_UserGetBotsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLUserGetBotsByCreator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCUserServer).UserGetBotsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.RPCUser/UserGetBotsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCUserServer).UserGetBotsByCreator(ctx, req.(*TLUserGetBotsByCreator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCUser_UserResetBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLUserResetBotToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCUserServer).UserResetBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.RPCUser/UserResetBotToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCUserServer).UserResetBotToken(ctx, req.(*TLUserResetBotToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCUser_UserSetDefaultHistoryTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLUserSetDefaultHistoryTTL)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCUserServer).UserSetDefaultHistoryTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.RPCUser/UserSetDefaultHistoryTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCUserServer).UserSetDefaultHistoryTTL(ctx, req.(*TLUserSetDefaultHistoryTTL))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCUser_UserGetDefaultHistoryTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLUserGetDefaultHistoryTTL)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCUserServer).UserGetDefaultHistoryTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.RPCUser/UserGetDefaultHistoryTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCUserServer).UserGetDefaultHistoryTTL(ctx, req.(*TLUserGetDefaultHistoryTTL))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RPCUser_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "user.RPCUser",
 	HandlerType: (*RPCUserServer)(nil),
@@ -10544,6 +11230,30 @@ var _RPCUser_serviceDesc = grpc.ServiceDesc{
 			MethodName: "user_getMutableUsersV2",
 			Handler:    _RPCUser_UserGetMutableUsersV2_Handler,
 		},
+		{
+			MethodName: "user_createBot",
+			Handler:    _RPCUser_UserCreateBot_Handler,
+		},
+		{
+			MethodName: "user_getBotIdByToken",
+			Handler:    _RPCUser_UserGetBotIdByToken_Handler,
+		},
+		{
+			MethodName: "user_getBotsByCreator",
+			Handler:    _RPCUser_UserGetBotsByCreator_Handler,
+		},
+		{
+			MethodName: "user_resetBotToken",
+			Handler:    _RPCUser_UserResetBotToken_Handler,
+		},
+		{
+			MethodName: "user_setDefaultHistoryTTL",
+			Handler:    _RPCUser_UserSetDefaultHistoryTTL_Handler,
+		},
+		{
+			MethodName: "user_getDefaultHistoryTTL",
+			Handler:    _RPCUser_UserGetDefaultHistoryTTL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.tl.proto",
@@ -14551,7 +15261,7 @@ func (m *TLUserGetMutableUsersV2) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *Vector_LastSeenData) Marshal() (dAtA []byte, err error) {
+func (m *TLUserCreateBot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14561,12 +15271,12 @@ func (m *Vector_LastSeenData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vector_LastSeenData) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLUserCreateBot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vector_LastSeenData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLUserCreateBot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14575,24 +15285,34 @@ func (m *Vector_LastSeenData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Datas) > 0 {
-		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUserTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintUserTl(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintUserTl(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatorUserId != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.CreatorUserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Vector_ImmutableUser) Marshal() (dAtA []byte, err error) {
+func (m *TLUserGetBotIdByToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14602,12 +15322,12 @@ func (m *Vector_ImmutableUser) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vector_ImmutableUser) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLUserGetBotIdByToken) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vector_ImmutableUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLUserGetBotIdByToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14616,24 +15336,22 @@ func (m *Vector_ImmutableUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Datas) > 0 {
-		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUserTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintUserTl(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Vector_PeerPeerNotifySettings) Marshal() (dAtA []byte, err error) {
+func (m *TLUserGetBotsByCreator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14643,12 +15361,12 @@ func (m *Vector_PeerPeerNotifySettings) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vector_PeerPeerNotifySettings) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLUserGetBotsByCreator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vector_PeerPeerNotifySettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLUserGetBotsByCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14657,24 +15375,259 @@ func (m *Vector_PeerPeerNotifySettings) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Datas) > 0 {
-		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUserTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.CreatorUserId != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.CreatorUserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Vector_PrivacyRule) Marshal() (dAtA []byte, err error) {
+func (m *TLUserResetBotToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLUserResetBotToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLUserResetBotToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BotId != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.BotId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLUserSetDefaultHistoryTTL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLUserSetDefaultHistoryTTL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLUserSetDefaultHistoryTTL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLUserGetDefaultHistoryTTL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLUserGetDefaultHistoryTTL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLUserGetDefaultHistoryTTL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintUserTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_LastSeenData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_LastSeenData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_LastSeenData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUserTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_ImmutableUser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_ImmutableUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_ImmutableUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUserTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_PeerPeerNotifySettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_PeerPeerNotifySettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_PeerPeerNotifySettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUserTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_PrivacyRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -16862,17 +17815,25 @@ func (m *TLUserGetMutableUsersV2) Size() (n int) {
 	return n
 }
 
-func (m *Vector_LastSeenData) Size() (n int) {
+func (m *TLUserCreateBot) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Datas) > 0 {
-		for _, e := range m.Datas {
-			l = e.Size()
-			n += 1 + l + sovUserTl(uint64(l))
-		}
+	if m.Constructor != 0 {
+		n += 1 + sovUserTl(uint64(m.Constructor))
+	}
+	if m.CreatorUserId != 0 {
+		n += 1 + sovUserTl(uint64(m.CreatorUserId))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovUserTl(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovUserTl(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -16880,17 +17841,129 @@ func (m *Vector_LastSeenData) Size() (n int) {
 	return n
 }
 
-func (m *Vector_ImmutableUser) Size() (n int) {
+func (m *TLUserGetBotIdByToken) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Datas) > 0 {
-		for _, e := range m.Datas {
-			l = e.Size()
-			n += 1 + l + sovUserTl(uint64(l))
-		}
+	if m.Constructor != 0 {
+		n += 1 + sovUserTl(uint64(m.Constructor))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovUserTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLUserGetBotsByCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovUserTl(uint64(m.Constructor))
+	}
+	if m.CreatorUserId != 0 {
+		n += 1 + sovUserTl(uint64(m.CreatorUserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLUserResetBotToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovUserTl(uint64(m.Constructor))
+	}
+	if m.BotId != 0 {
+		n += 1 + sovUserTl(uint64(m.BotId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLUserSetDefaultHistoryTTL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovUserTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovUserTl(uint64(m.UserId))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovUserTl(uint64(m.Ttl))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLUserGetDefaultHistoryTTL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovUserTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovUserTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_LastSeenData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovUserTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_ImmutableUser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovUserTl(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -27835,6 +28908,636 @@ func (m *TLUserGetMutableUsersV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TLUserCreateBot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_user_createBot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_user_createBot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorUserId", wireType)
+			}
+			m.CreatorUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorUserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLUserGetBotIdByToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_user_getBotIdByToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_user_getBotIdByToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLUserGetBotsByCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_user_getBotsByCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_user_getBotsByCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorUserId", wireType)
+			}
+			m.CreatorUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorUserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLUserResetBotToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_user_resetBotToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_user_resetBotToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BotId", wireType)
+			}
+			m.BotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BotId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLUserSetDefaultHistoryTTL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_user_setDefaultHistoryTTL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_user_setDefaultHistoryTTL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLUserGetDefaultHistoryTTL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_user_getDefaultHistoryTTL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_user_getDefaultHistoryTTL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vector_LastSeenData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -47,9 +47,13 @@ func (c *DfsCore) DfsDownloadFile(in *dfs.TLDfsDownloadFile) (*mtproto.Upload_Fi
 
 		bytes, err = c.svcCtx.Dao.GetCacheFile(c.ctx, "encryptedfiles", location.GetId(), offset, limit)
 		if err != nil {
-			c.Logger.Errorf("download file: %v", err)
-			err = nil
-			bytes = []byte{}
+			path := fmt.Sprintf("%d.dat", location.GetId())
+			bytes, err = c.svcCtx.Dao.GetFile(c.ctx, "encryptedfiles", path, offset, limit)
+			if err != nil {
+				c.Logger.Errorf("download file: %v", err)
+				err = nil
+				bytes = []byte{}
+			}
 		}
 		sType = int32(mtproto.CRC32_storage_filePartial)
 	case mtproto.Predicate_inputDocumentFileLocation:
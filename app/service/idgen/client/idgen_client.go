@@ -30,6 +30,8 @@ type IdgenClient interface {
 	IdgenGetNextNSeqId(ctx context.Context, in *idgen.TLIdgenGetNextNSeqId) (*mtproto.Int64, error)
 	IdgenGetNextIdValList(ctx context.Context, in *idgen.TLIdgenGetNextIdValList) (*idgen.Vector_IdVal, error)
 	IdgenGetCurrentSeqIdList(ctx context.Context, in *idgen.TLIdgenGetCurrentSeqIdList) (*idgen.Vector_IdVal, error)
+	IdgenGetSeqIdState(ctx context.Context, in *idgen.TLIdgenGetSeqIdState) (*mtproto.DataJSON, error)
+	IdgenRepairSeqId(ctx context.Context, in *idgen.TLIdgenRepairSeqId) (*mtproto.DataJSON, error)
 }
 
 type defaultIdgenClient struct {
@@ -97,3 +99,17 @@ func (m *defaultIdgenClient) IdgenGetCurrentSeqIdList(ctx context.Context, in *i
 	client := idgen.NewRPCIdgenClient(m.cli.Conn())
 	return client.IdgenGetCurrentSeqIdList(ctx, in)
 }
+
+// IdgenGetSeqIdState
+// idgen.getSeqIdState key:string = DataJSON;
+func (m *defaultIdgenClient) IdgenGetSeqIdState(ctx context.Context, in *idgen.TLIdgenGetSeqIdState) (*mtproto.DataJSON, error) {
+	client := idgen.NewRPCIdgenClient(m.cli.Conn())
+	return client.IdgenGetSeqIdState(ctx, in)
+}
+
+// IdgenRepairSeqId
+// idgen.repairSeqId key:string = DataJSON;
+func (m *defaultIdgenClient) IdgenRepairSeqId(ctx context.Context, in *idgen.TLIdgenRepairSeqId) (*mtproto.DataJSON, error) {
+	client := idgen.NewRPCIdgenClient(m.cli.Conn())
+	return client.IdgenRepairSeqId(ctx, in)
+}
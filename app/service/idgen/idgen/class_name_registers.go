@@ -26,6 +26,8 @@ const (
 	Predicate_idgen_getNextNSeqId       = "idgen_getNextNSeqId"
 	Predicate_idgen_getNextIdValList    = "idgen_getNextIdValList"
 	Predicate_idgen_getCurrentSeqIdList = "idgen_getCurrentSeqIdList"
+	Predicate_idgen_getSeqIdState       = "idgen_getSeqIdState"
+	Predicate_idgen_repairSeqId         = "idgen_repairSeqId"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -89,6 +91,14 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: -769020349, // 0xd229ae43
 
 	},
+	Predicate_idgen_getSeqIdState: {
+		0: 1763287216, // 0x6919a0b0
+
+	},
+	Predicate_idgen_repairSeqId: {
+		0: -1558790960, // 0xa316bcd0
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
@@ -107,6 +117,8 @@ var clazzIdNameRegisters2 = map[int32]string{
 	-1479226258: Predicate_idgen_getNextNSeqId,       // 0xa7d4cc6e
 	-1434062537: Predicate_idgen_getNextIdValList,    // 0xaa85f137
 	-769020349:  Predicate_idgen_getCurrentSeqIdList, // 0xd229ae43
+	1763287216:  Predicate_idgen_getSeqIdState,       // 0x6919a0b0
+	-1558790960: Predicate_idgen_repairSeqId,         // 0xa316bcd0
 
 }
 
@@ -107,6 +107,16 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: -769020349,
 		}
 	},
+	1763287216: func() mtproto.TLObject { // 0x6919a0b0
+		return &TLIdgenGetSeqIdState{
+			Constructor: 1763287216,
+		}
+	},
+	-1558790960: func() mtproto.TLObject { // 0xa316bcd0
+		return &TLIdgenRepairSeqId{
+			Constructor: -1558790960,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -1270,9 +1280,107 @@ func (m *TLIdgenGetCurrentSeqIdList) DebugString() string {
 	return dbgString
 }
 
-//----------------------------------------------------------------------------------------------------------------
-// Vector_Long
+// TLIdgenGetSeqIdState
 ///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLIdgenGetSeqIdState) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_idgen_getSeqIdState))
+
+	switch uint32(m.Constructor) {
+	case 0x6919a0b0:
+		// idgen.getSeqIdState key:string = DataJSON;
+		x.UInt(0x6919a0b0)
+
+		// no flags
+
+		x.String(m.GetKey())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLIdgenGetSeqIdState) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLIdgenGetSeqIdState) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6919a0b0:
+		// idgen.getSeqIdState key:string = DataJSON;
+
+		// not has flags
+
+		m.Key = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLIdgenGetSeqIdState) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLIdgenRepairSeqId
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLIdgenRepairSeqId) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_idgen_repairSeqId))
+
+	switch uint32(m.Constructor) {
+	case 0xa316bcd0:
+		// idgen.repairSeqId key:string = DataJSON;
+		x.UInt(0xa316bcd0)
+
+		// no flags
+
+		x.String(m.GetKey())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLIdgenRepairSeqId) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLIdgenRepairSeqId) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xa316bcd0:
+		// idgen.repairSeqId key:string = DataJSON;
+
+		// not has flags
+
+		m.Key = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLIdgenRepairSeqId) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// ----------------------------------------------------------------------------------------------------------------
+// Vector_Long
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_Long) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.VectorLong(m.Datas)
@@ -1297,7 +1405,7 @@ func (m *Vector_Long) DebugString() string {
 }
 
 // Vector_IdVal
-///////////////////////////////////////////////////////////////////////////////
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_IdVal) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
@@ -50,6 +50,8 @@ const (
 	CRC32_idgen_getNextNSeqId       TLConstructor = -1479226258
 	CRC32_idgen_getNextIdValList    TLConstructor = -1434062537
 	CRC32_idgen_getCurrentSeqIdList TLConstructor = -769020349
+	CRC32_idgen_getSeqIdState       TLConstructor = 1763287216
+	CRC32_idgen_repairSeqId         TLConstructor = -1558790960
 )
 
 var TLConstructor_name = map[int32]string{
@@ -69,6 +71,8 @@ var TLConstructor_name = map[int32]string{
 	-1479226258: "CRC32_idgen_getNextNSeqId",
 	-1434062537: "CRC32_idgen_getNextIdValList",
 	-769020349:  "CRC32_idgen_getCurrentSeqIdList",
+	1763287216:  "CRC32_idgen_getSeqIdState",
+	-1558790960: "CRC32_idgen_repairSeqId",
 }
 
 var TLConstructor_value = map[string]int32{
@@ -88,6 +92,8 @@ var TLConstructor_value = map[string]int32{
 	"CRC32_idgen_getNextNSeqId":       -1479226258,
 	"CRC32_idgen_getNextIdValList":    -1434062537,
 	"CRC32_idgen_getCurrentSeqIdList": -769020349,
+	"CRC32_idgen_getSeqIdState":       1763287216,
+	"CRC32_idgen_repairSeqId":         -1558790960,
 }
 
 func (x TLConstructor) String() string {
@@ -99,10 +105,9 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 }
 
 // IdVal <--
-//  + TL_idVal
-//  + TL_idVals
-//  + TL_seqIdVal
-//
+//   - TL_idVal
+//   - TL_idVals
+//   - TL_seqIdVal
 type IdVal struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
@@ -316,11 +321,10 @@ func (m *TLSeqIdVal) GetData2() *IdVal {
 }
 
 // InputId <--
-//  + TL_inputId
-//  + TL_inputIds
-//  + TL_inputSeqId
-//  + TL_inputNSeqId
-//
+//   - TL_inputId
+//   - TL_inputIds
+//   - TL_inputSeqId
+//   - TL_inputNSeqId
 type InputId struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
@@ -588,7 +592,7 @@ func (m *TLInputNSeqId) GetData2() *InputId {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLIdgenNextId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -636,7 +640,7 @@ func (m *TLIdgenNextId) GetConstructor() TLConstructor {
 	return CRC32_UNKNOWN
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLIdgenNextIds struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
 	Num                  int32         `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
@@ -692,7 +696,7 @@ func (m *TLIdgenNextIds) GetNum() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLIdgenGetCurrentSeqId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
 	Key                  string        `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
@@ -748,7 +752,7 @@ func (m *TLIdgenGetCurrentSeqId) GetKey() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLIdgenSetCurrentSeqId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
 	Key                  string        `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
@@ -812,7 +816,7 @@ func (m *TLIdgenSetCurrentSeqId) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLIdgenGetNextSeqId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
 	Key                  string        `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
@@ -868,7 +872,7 @@ func (m *TLIdgenGetNextSeqId) GetKey() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLIdgenGetNextNSeqId struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
 	Key                  string        `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
@@ -932,7 +936,7 @@ func (m *TLIdgenGetNextNSeqId) GetN() int32 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLIdgenGetNextIdValList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
 	Id                   []*InputId    `protobuf:"bytes,3,rep,name=id,proto3" json:"id,omitempty"`
@@ -988,7 +992,7 @@ func (m *TLIdgenGetNextIdValList) GetId() []*InputId {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLIdgenGetCurrentSeqIdList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
 	Id                   []*InputId    `protobuf:"bytes,3,rep,name=id,proto3" json:"id,omitempty"`
@@ -1044,7 +1048,7 @@ func (m *TLIdgenGetCurrentSeqIdList) GetId() []*InputId {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // Vector api result type
 type Vector_Long struct {
 	Datas                []int64  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
@@ -1140,6 +1144,120 @@ func (m *Vector_IdVal) GetDatas() []*IdVal {
 	return nil
 }
 
+// --------------------------------------------------------------------------------------------
+// idgen.getSeqIdState key:string = DataJSON;
+type TLIdgenGetSeqIdState struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
+	Key                  string        `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLIdgenGetSeqIdState) Reset()         { *m = TLIdgenGetSeqIdState{} }
+func (m *TLIdgenGetSeqIdState) String() string { return proto.CompactTextString(m) }
+func (*TLIdgenGetSeqIdState) ProtoMessage()    {}
+func (*TLIdgenGetSeqIdState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6875902fed70331d, []int{19}
+}
+func (m *TLIdgenGetSeqIdState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLIdgenGetSeqIdState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLIdgenGetSeqIdState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLIdgenGetSeqIdState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLIdgenGetSeqIdState.Merge(m, src)
+}
+func (m *TLIdgenGetSeqIdState) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLIdgenGetSeqIdState) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLIdgenGetSeqIdState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLIdgenGetSeqIdState proto.InternalMessageInfo
+
+func (m *TLIdgenGetSeqIdState) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLIdgenGetSeqIdState) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// --------------------------------------------------------------------------------------------
+// idgen.repairSeqId key:string = DataJSON;
+type TLIdgenRepairSeqId struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=idgen.TLConstructor" json:"constructor,omitempty"`
+	Key                  string        `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLIdgenRepairSeqId) Reset()         { *m = TLIdgenRepairSeqId{} }
+func (m *TLIdgenRepairSeqId) String() string { return proto.CompactTextString(m) }
+func (*TLIdgenRepairSeqId) ProtoMessage()    {}
+func (*TLIdgenRepairSeqId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6875902fed70331d, []int{20}
+}
+func (m *TLIdgenRepairSeqId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLIdgenRepairSeqId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLIdgenRepairSeqId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLIdgenRepairSeqId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLIdgenRepairSeqId.Merge(m, src)
+}
+func (m *TLIdgenRepairSeqId) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLIdgenRepairSeqId) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLIdgenRepairSeqId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLIdgenRepairSeqId proto.InternalMessageInfo
+
+func (m *TLIdgenRepairSeqId) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLIdgenRepairSeqId) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("idgen.TLConstructor", TLConstructor_name, TLConstructor_value)
 	proto.RegisterType((*IdVal)(nil), "idgen.IdVal")
@@ -1159,6 +1277,8 @@ func init() {
 	proto.RegisterType((*TLIdgenGetNextNSeqId)(nil), "idgen.TL_idgen_getNextNSeqId")
 	proto.RegisterType((*TLIdgenGetNextIdValList)(nil), "idgen.TL_idgen_getNextIdValList")
 	proto.RegisterType((*TLIdgenGetCurrentSeqIdList)(nil), "idgen.TL_idgen_getCurrentSeqIdList")
+	proto.RegisterType((*TLIdgenGetSeqIdState)(nil), "idgen.TL_idgen_getSeqIdState")
+	proto.RegisterType((*TLIdgenRepairSeqId)(nil), "idgen.TL_idgen_repairSeqId")
 	proto.RegisterType((*Vector_Long)(nil), "idgen.Vector_Long")
 	proto.RegisterType((*Vector_IdVal)(nil), "idgen.Vector_IdVal")
 }
@@ -1488,6 +1608,34 @@ func (this *TLIdgenGetCurrentSeqIdList) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TLIdgenGetSeqIdState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&idgen.TLIdgenGetSeqIdState{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLIdgenRepairSeqId) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&idgen.TLIdgenRepairSeqId{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *Vector_Long) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1545,6 +1693,8 @@ type RPCIdgenClient interface {
 	IdgenGetNextNSeqId(ctx context.Context, in *TLIdgenGetNextNSeqId, opts ...grpc.CallOption) (*mtproto.Int64, error)
 	IdgenGetNextIdValList(ctx context.Context, in *TLIdgenGetNextIdValList, opts ...grpc.CallOption) (*Vector_IdVal, error)
 	IdgenGetCurrentSeqIdList(ctx context.Context, in *TLIdgenGetCurrentSeqIdList, opts ...grpc.CallOption) (*Vector_IdVal, error)
+	IdgenGetSeqIdState(ctx context.Context, in *TLIdgenGetSeqIdState, opts ...grpc.CallOption) (*mtproto.DataJSON, error)
+	IdgenRepairSeqId(ctx context.Context, in *TLIdgenRepairSeqId, opts ...grpc.CallOption) (*mtproto.DataJSON, error)
 }
 
 type rPCIdgenClient struct {
@@ -1627,6 +1777,24 @@ func (c *rPCIdgenClient) IdgenGetCurrentSeqIdList(ctx context.Context, in *TLIdg
 	return out, nil
 }
 
+func (c *rPCIdgenClient) IdgenGetSeqIdState(ctx context.Context, in *TLIdgenGetSeqIdState, opts ...grpc.CallOption) (*mtproto.DataJSON, error) {
+	out := new(mtproto.DataJSON)
+	err := c.cc.Invoke(ctx, "/idgen.RPCIdgen/idgen_getSeqIdState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCIdgenClient) IdgenRepairSeqId(ctx context.Context, in *TLIdgenRepairSeqId, opts ...grpc.CallOption) (*mtproto.DataJSON, error) {
+	out := new(mtproto.DataJSON)
+	err := c.cc.Invoke(ctx, "/idgen.RPCIdgen/idgen_repairSeqId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RPCIdgenServer is the server API for RPCIdgen service.
 type RPCIdgenServer interface {
 	IdgenNextId(context.Context, *TLIdgenNextId) (*mtproto.Int64, error)
@@ -1637,6 +1805,8 @@ type RPCIdgenServer interface {
 	IdgenGetNextNSeqId(context.Context, *TLIdgenGetNextNSeqId) (*mtproto.Int64, error)
 	IdgenGetNextIdValList(context.Context, *TLIdgenGetNextIdValList) (*Vector_IdVal, error)
 	IdgenGetCurrentSeqIdList(context.Context, *TLIdgenGetCurrentSeqIdList) (*Vector_IdVal, error)
+	IdgenGetSeqIdState(context.Context, *TLIdgenGetSeqIdState) (*mtproto.DataJSON, error)
+	IdgenRepairSeqId(context.Context, *TLIdgenRepairSeqId) (*mtproto.DataJSON, error)
 }
 
 // UnimplementedRPCIdgenServer can be embedded to have forward compatible implementations.
@@ -1668,6 +1838,14 @@ func (*UnimplementedRPCIdgenServer) IdgenGetCurrentSeqIdList(ctx context.Context
 	return nil, status.Errorf(codes.Unimplemented, "method IdgenGetCurrentSeqIdList not implemented")
 }
 
+func (*UnimplementedRPCIdgenServer) IdgenGetSeqIdState(ctx context.Context, req *TLIdgenGetSeqIdState) (*mtproto.DataJSON, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdgenGetSeqIdState not implemented")
+}
+
+func (*UnimplementedRPCIdgenServer) IdgenRepairSeqId(ctx context.Context, req *TLIdgenRepairSeqId) (*mtproto.DataJSON, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdgenRepairSeqId not implemented")
+}
+
 func RegisterRPCIdgenServer(s *grpc.Server, srv RPCIdgenServer) {
 	s.RegisterService(&_RPCIdgen_serviceDesc, srv)
 }
@@ -1816,6 +1994,42 @@ func _RPCIdgen_IdgenGetCurrentSeqIdList_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCIdgen_IdgenGetSeqIdState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLIdgenGetSeqIdState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCIdgenServer).IdgenGetSeqIdState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/idgen.RPCIdgen/IdgenGetSeqIdState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCIdgenServer).IdgenGetSeqIdState(ctx, req.(*TLIdgenGetSeqIdState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCIdgen_IdgenRepairSeqId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLIdgenRepairSeqId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCIdgenServer).IdgenRepairSeqId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/idgen.RPCIdgen/IdgenRepairSeqId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCIdgenServer).IdgenRepairSeqId(ctx, req.(*TLIdgenRepairSeqId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RPCIdgen_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "idgen.RPCIdgen",
 	HandlerType: (*RPCIdgenServer)(nil),
@@ -1852,6 +2066,14 @@ var _RPCIdgen_serviceDesc = grpc.ServiceDesc{
 			MethodName: "idgen_getCurrentSeqIdList",
 			Handler:    _RPCIdgen_IdgenGetCurrentSeqIdList_Handler,
 		},
+		{
+			MethodName: "idgen_getSeqIdState",
+			Handler:    _RPCIdgen_IdgenGetSeqIdState_Handler,
+		},
+		{
+			MethodName: "idgen_repairSeqId",
+			Handler:    _RPCIdgen_IdgenRepairSeqId_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "idgen.tl.proto",
@@ -2576,6 +2798,84 @@ func (m *TLIdgenGetCurrentSeqIdList) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *TLIdgenGetSeqIdState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLIdgenGetSeqIdState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLIdgenGetSeqIdState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintIdgenTl(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintIdgenTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLIdgenRepairSeqId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLIdgenRepairSeqId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLIdgenRepairSeqId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintIdgenTl(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintIdgenTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vector_Long) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3001,6 +3301,44 @@ func (m *TLIdgenGetCurrentSeqIdList) Size() (n int) {
 	return n
 }
 
+func (m *TLIdgenGetSeqIdState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovIdgenTl(uint64(m.Constructor))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovIdgenTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLIdgenRepairSeqId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovIdgenTl(uint64(m.Constructor))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovIdgenTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Vector_Long) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4835,6 +5173,210 @@ func (m *TLIdgenGetCurrentSeqIdList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TLIdgenGetSeqIdState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_idgen_getSeqIdState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_idgen_getSeqIdState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdgenTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLIdgenRepairSeqId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_idgen_repairSeqId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_idgen_repairSeqId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdgenTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vector_Long) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,6 +34,8 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLIdgenGetNextNSeqId":       RPCContextTuple{"/mtproto.RPCIdgen/idgen_getNextNSeqId", func() interface{} { return new(mtproto.Int64) }},
 	"TLIdgenGetNextIdValList":    RPCContextTuple{"/mtproto.RPCIdgen/idgen_getNextIdValList", func() interface{} { return new(Vector_IdVal) }},
 	"TLIdgenGetCurrentSeqIdList": RPCContextTuple{"/mtproto.RPCIdgen/idgen_getCurrentSeqIdList", func() interface{} { return new(Vector_IdVal) }},
+	"TLIdgenGetSeqIdState":       RPCContextTuple{"/mtproto.RPCIdgen/idgen_getSeqIdState", func() interface{} { return new(mtproto.DataJSON) }},
+	"TLIdgenRepairSeqId":         RPCContextTuple{"/mtproto.RPCIdgen/idgen_repairSeqId", func() interface{} { return new(mtproto.DataJSON) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
@@ -10,6 +10,7 @@
 package config
 
 import (
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -18,4 +19,7 @@ type Config struct {
 	zrpc.RpcServerConf
 	NodeId   int64 // snowflake
 	SeqIDGen kv.KvConf
+	Mysql    sqlx.Config
+	// SeqIDGen is backed by Mysql in segments of SeqStep ids
+	SeqStep int64 `json:",default=100"`
 }
@@ -21,7 +21,6 @@ package core
 import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/idgen/idgen"
-	"strconv"
 )
 
 // IdgenGetCurrentSeqIdList
@@ -34,22 +33,15 @@ func (c *IdgenCore) IdgenGetCurrentSeqIdList(in *idgen.TLIdgenGetCurrentSeqIdLis
 	for i, id := range in.GetId() {
 		switch id.GetPredicateName() {
 		case idgen.Predicate_inputSeqId:
-			sid, err := c.svcCtx.Dao.KV.GetCtx(c.ctx, id.Key)
+			sid, err := c.svcCtx.Dao.CurrentSeqId(c.ctx, id.Key)
 			if err != nil {
 				c.Logger.Errorf("idgen.getCurrentSeqIdList(%s) error: %v", id.Key, err)
 				return nil, err
 			}
 
-			if sid == "" {
-				idList[i] = idgen.MakeTLSeqIdVal(&idgen.IdVal{
-					Id_INT64: 0,
-				}).To_IdVal()
-			} else {
-				iV, _ := strconv.ParseInt(sid, 10, 64)
-				idList[i] = idgen.MakeTLSeqIdVal(&idgen.IdVal{
-					Id_INT64: iV,
-				}).To_IdVal()
-			}
+			idList[i] = idgen.MakeTLSeqIdVal(&idgen.IdVal{
+				Id_INT64: sid,
+			}).To_IdVal()
 		default:
 			err := mtproto.ErrInputRequestInvalid
 			c.Logger.Errorf("idgen.getCurrentSeqIdList - error: %v", err)
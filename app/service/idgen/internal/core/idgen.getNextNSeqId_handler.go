@@ -17,7 +17,7 @@ import (
 // IdgenGetNextNSeqId
 // idgen.getNextNSeqId key:string n:int = Int64;
 func (c *IdgenCore) IdgenGetNextNSeqId(in *idgen.TLIdgenGetNextNSeqId) (*mtproto.Int64, error) {
-	id, err := c.svcCtx.Dao.KV.IncrbyCtx(c.ctx, in.GetKey(), int64(in.GetN()))
+	id, err := c.svcCtx.Dao.NextSeqId(c.ctx, in.GetKey(), int64(in.GetN()))
 	if err != nil {
 		c.Logger.Errorf("dgen.getNextNSeqId(%s, %d) error: %v", in.GetKey(), in.GetN(), err)
 		return nil, err
@@ -0,0 +1,39 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/idgen/idgen"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// IdgenRepairSeqId
+// idgen.repairSeqId key:string = DataJSON;
+func (c *IdgenCore) IdgenRepairSeqId(in *idgen.TLIdgenRepairSeqId) (*mtproto.DataJSON, error) {
+	if in.GetKey() == "" {
+		err := mtproto.ErrInputRequestInvalid
+		c.Logger.Errorf("idgen.repairSeqId - error: %v", err)
+		return nil, err
+	}
+
+	state, err := c.svcCtx.Dao.RepairSeqId(c.ctx, in.GetKey())
+	if err != nil {
+		c.Logger.Errorf("idgen.repairSeqId(%s) error: %v", in.GetKey(), err)
+		return nil, err
+	}
+
+	data, _ := jsonx.MarshalToString(state)
+
+	return mtproto.MakeTLDataJSON(&mtproto.DataJSON{
+		Data: data,
+	}).To_DataJSON(), nil
+}
@@ -0,0 +1,101 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/idgen/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type AuthSeqUpdatesDAO struct {
+	db *sqlx.DB
+}
+
+func NewAuthSeqUpdatesDAO(db *sqlx.DB) *AuthSeqUpdatesDAO {
+	return &AuthSeqUpdatesDAO{db}
+}
+
+// SelectLastSeq
+// select auth_id, seq from auth_seq_updates where auth_id = :auth_id order by seq desc limit 1
+// TODO(@benqi): sqlmap
+func (dao *AuthSeqUpdatesDAO) SelectLastSeq(ctx context.Context, auth_id int64) (rValue *dataobject.AuthSeqUpdatesDO, err error) {
+	var (
+		query = "select auth_id, seq from auth_seq_updates where auth_id = ? order by seq desc limit 1"
+		do    = &dataobject.AuthSeqUpdatesDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, auth_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectLastSeq(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectLastSeqList
+// select auth_id, max(seq) as seq from auth_seq_updates where auth_id > :auth_id group by auth_id order by auth_id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *AuthSeqUpdatesDAO) SelectLastSeqList(ctx context.Context, auth_id int64, limit int32) (rList []dataobject.AuthSeqUpdatesDO, err error) {
+	var (
+		query  = "select auth_id, max(seq) as seq from auth_seq_updates where auth_id > ? group by auth_id order by auth_id asc limit ?"
+		values []dataobject.AuthSeqUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectLastSeqList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectLastSeqListWithCB
+// select auth_id, max(seq) as seq from auth_seq_updates where auth_id > :auth_id group by auth_id order by auth_id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *AuthSeqUpdatesDAO) SelectLastSeqListWithCB(ctx context.Context, auth_id int64, limit int32, cb func(i int, v *dataobject.AuthSeqUpdatesDO)) (rList []dataobject.AuthSeqUpdatesDO, err error) {
+	var (
+		query  = "select auth_id, max(seq) as seq from auth_seq_updates where auth_id > ? group by auth_id order by auth_id asc limit ?"
+		values []dataobject.AuthSeqUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectLastSeqList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,203 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/idgen/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type IdgenSeqSegmentsDAO struct {
+	db *sqlx.DB
+}
+
+func NewIdgenSeqSegmentsDAO(db *sqlx.DB) *IdgenSeqSegmentsDAO {
+	return &IdgenSeqSegmentsDAO{db}
+}
+
+// InsertOrUpdate
+// insert into idgen_seq_segments(seq_key, max_id) values (:seq_key, :max_id) on duplicate key update max_id = greatest(max_id, values(max_id))
+// TODO(@benqi): sqlmap
+func (dao *IdgenSeqSegmentsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.IdgenSeqSegmentsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into idgen_seq_segments(seq_key, max_id) values (:seq_key, :max_id) on duplicate key update max_id = greatest(max_id, values(max_id))"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into idgen_seq_segments(seq_key, max_id) values (:seq_key, :max_id) on duplicate key update max_id = greatest(max_id, values(max_id))
+// TODO(@benqi): sqlmap
+func (dao *IdgenSeqSegmentsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.IdgenSeqSegmentsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into idgen_seq_segments(seq_key, max_id) values (:seq_key, :max_id) on duplicate key update max_id = greatest(max_id, values(max_id))"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByKey
+// select id, seq_key, max_id from idgen_seq_segments where seq_key = :seq_key
+// TODO(@benqi): sqlmap
+func (dao *IdgenSeqSegmentsDAO) SelectByKey(ctx context.Context, seq_key string) (rValue *dataobject.IdgenSeqSegmentsDO, err error) {
+	var (
+		query = "select id, seq_key, max_id from idgen_seq_segments where seq_key = ?"
+		do    = &dataobject.IdgenSeqSegmentsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, seq_key)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByKey(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectList
+// select id, seq_key, max_id from idgen_seq_segments where id > :id order by id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *IdgenSeqSegmentsDAO) SelectList(ctx context.Context, id int64, limit int32) (rList []dataobject.IdgenSeqSegmentsDO, err error) {
+	var (
+		query  = "select id, seq_key, max_id from idgen_seq_segments where id > ? order by id asc limit ?"
+		values []dataobject.IdgenSeqSegmentsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListWithCB
+// select id, seq_key, max_id from idgen_seq_segments where id > :id order by id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *IdgenSeqSegmentsDAO) SelectListWithCB(ctx context.Context, id int64, limit int32, cb func(i int, v *dataobject.IdgenSeqSegmentsDO)) (rList []dataobject.IdgenSeqSegmentsDO, err error) {
+	var (
+		query  = "select id, seq_key, max_id from idgen_seq_segments where id > ? order by id asc limit ?"
+		values []dataobject.IdgenSeqSegmentsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateMaxId
+// update idgen_seq_segments set max_id = :max_id where seq_key = :seq_key
+// TODO(@benqi): sqlmap
+func (dao *IdgenSeqSegmentsDAO) UpdateMaxId(ctx context.Context, max_id int64, seq_key string) (rowsAffected int64, err error) {
+	var (
+		query   = "update idgen_seq_segments set max_id = ? where seq_key = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, max_id, seq_key)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateMaxId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateMaxId(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateMaxIdTx
+// update idgen_seq_segments set max_id = :max_id where seq_key = :seq_key
+// TODO(@benqi): sqlmap
+func (dao *IdgenSeqSegmentsDAO) UpdateMaxIdTx(tx *sqlx.Tx, max_id int64, seq_key string) (rowsAffected int64, err error) {
+	var (
+		query   = "update idgen_seq_segments set max_id = ? where seq_key = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, max_id, seq_key)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateMaxId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateMaxId(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,101 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/idgen/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type UserPtsUpdatesDAO struct {
+	db *sqlx.DB
+}
+
+func NewUserPtsUpdatesDAO(db *sqlx.DB) *UserPtsUpdatesDAO {
+	return &UserPtsUpdatesDAO{db}
+}
+
+// SelectLastPts
+// select user_id, pts from user_pts_updates where user_id = :user_id order by pts desc limit 1
+// TODO(@benqi): sqlmap
+func (dao *UserPtsUpdatesDAO) SelectLastPts(ctx context.Context, user_id int64) (rValue *dataobject.UserPtsUpdatesDO, err error) {
+	var (
+		query = "select user_id, pts from user_pts_updates where user_id = ? order by pts desc limit 1"
+		do    = &dataobject.UserPtsUpdatesDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, user_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectLastPts(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectLastPtsList
+// select user_id, max(pts) as pts from user_pts_updates where user_id > :user_id group by user_id order by user_id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *UserPtsUpdatesDAO) SelectLastPtsList(ctx context.Context, user_id int64, limit int32) (rList []dataobject.UserPtsUpdatesDO, err error) {
+	var (
+		query  = "select user_id, max(pts) as pts from user_pts_updates where user_id > ? group by user_id order by user_id asc limit ?"
+		values []dataobject.UserPtsUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectLastPtsList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectLastPtsListWithCB
+// select user_id, max(pts) as pts from user_pts_updates where user_id > :user_id group by user_id order by user_id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *UserPtsUpdatesDAO) SelectLastPtsListWithCB(ctx context.Context, user_id int64, limit int32, cb func(i int, v *dataobject.UserPtsUpdatesDO)) (rList []dataobject.UserPtsUpdatesDO, err error) {
+	var (
+		query  = "select user_id, max(pts) as pts from user_pts_updates where user_id > ? group by user_id order by user_id asc limit ?"
+		values []dataobject.UserPtsUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectLastPtsList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
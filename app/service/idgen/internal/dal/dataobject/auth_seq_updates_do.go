@@ -0,0 +1,17 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type AuthSeqUpdatesDO struct {
+	Id     int64 `db:"id"`
+	AuthId int64 `db:"auth_id"`
+	Seq    int32 `db:"seq"`
+}
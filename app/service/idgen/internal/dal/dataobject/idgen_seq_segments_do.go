@@ -0,0 +1,17 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type IdgenSeqSegmentsDO struct {
+	Id     int64  `db:"id"`
+	SeqKey string `db:"seq_key"`
+	MaxId  int64  `db:"max_id"`
+}
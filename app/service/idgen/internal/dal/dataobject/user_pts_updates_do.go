@@ -0,0 +1,17 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type UserPtsUpdatesDO struct {
+	Id     int64 `db:"id"`
+	UserId int64 `db:"user_id"`
+	Pts    int32 `db:"pts"`
+}
@@ -11,15 +11,29 @@ package dao
 
 import (
 	"log"
+	"time"
 
 	"github.com/bwmarrin/snowflake"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	"github.com/teamgram/teamgram-server/app/service/idgen/internal/config"
+	"github.com/zeromicro/go-zero/core/collection"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 )
 
+const (
+	seqLeaseExpire = time.Hour
+	seqLeaseLimit  = 1000000
+)
+
 type Dao struct {
 	*snowflake.Node
 	KV kv.Store
+	*Mysql
+	seqStore seqStore
+	seqStep  int64
+	// seqLeases caches the durable max_id of the keys this node has written,
+	// ids up to it are handed out without going to Mysql.
+	seqLeases *collection.Cache
 }
 
 func New(c config.Config) *Dao {
@@ -33,6 +47,16 @@ func New(c config.Config) *Dao {
 		log.Fatal("new snowflake node error: ", err)
 	}
 	d.KV = kv.NewStore(c.SeqIDGen)
+	d.Mysql = newMysqlDao(sqlx.NewMySQL(&c.Mysql))
+	d.seqStore = d.Mysql
+	d.seqStep = c.SeqStep
+	if d.seqStep <= 0 {
+		d.seqStep = 100
+	}
+	d.seqLeases, err = collection.NewCache(seqLeaseExpire, collection.WithLimit(seqLeaseLimit))
+	if err != nil {
+		log.Fatal("new seq lease cache error: ", err)
+	}
 
 	return d
 }
@@ -0,0 +1,31 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dao
+
+import (
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/idgen/internal/dal/dao/mysql_dao"
+)
+
+type Mysql struct {
+	*sqlx.DB
+	*mysql_dao.IdgenSeqSegmentsDAO
+	*mysql_dao.UserPtsUpdatesDAO
+	*mysql_dao.AuthSeqUpdatesDAO
+}
+
+func newMysqlDao(db *sqlx.DB) *Mysql {
+	return &Mysql{
+		DB:                  db,
+		IdgenSeqSegmentsDAO: mysql_dao.NewIdgenSeqSegmentsDAO(db),
+		UserPtsUpdatesDAO:   mysql_dao.NewUserPtsUpdatesDAO(db),
+		AuthSeqUpdatesDAO:   mysql_dao.NewAuthSeqUpdatesDAO(db),
+	}
+}
@@ -0,0 +1,339 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/teamgram/teamgram-server/app/service/idgen/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// Sequences live in SeqIDGen (redis), every id handed out is covered by the
+// max_id of its key in idgen_seq_segments, so a key lost from redis is
+// restored from there without handing out an id twice.
+
+const (
+	// keep in sync with idgen_client
+	ptsUpdatesNgenId = "pts_updates_ngen_"
+	seqUpdatesNgenId = "seq_updates_ngen_"
+
+	// seqRecoveredKey is set once SeqIDGen has been restored,
+	// a redis without it lost its data or was never restored.
+	seqRecoveredKey = "idgen_seq_recovered"
+
+	seqRecoverBatchSize = 1000
+)
+
+const (
+	// incrSeqIdScript increments an existing key, a missing key returns nil
+	// and has to be restored first.
+	incrSeqIdScript = `if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("INCRBY", KEYS[1], ARGV[1])
+end
+return false`
+
+	// setMaxSeqIdScript creates a key or raises it to ARGV[1],
+	// it never moves a key back.
+	setMaxSeqIdScript = `local v = redis.call("GET", KEYS[1])
+if v == false or tonumber(v) < tonumber(ARGV[1]) then
+	redis.call("SET", KEYS[1], ARGV[1])
+	return tonumber(ARGV[1])
+end
+return tonumber(v)`
+)
+
+// seqStore is the durable side of SeqIDGen, *Mysql in production.
+type seqStore interface {
+	SelectByKey(ctx context.Context, seqKey string) (*dataobject.IdgenSeqSegmentsDO, error)
+	SelectList(ctx context.Context, id int64, limit int32) ([]dataobject.IdgenSeqSegmentsDO, error)
+	InsertOrUpdate(ctx context.Context, do *dataobject.IdgenSeqSegmentsDO) (int64, int64, error)
+	SelectLastPts(ctx context.Context, userId int64) (*dataobject.UserPtsUpdatesDO, error)
+	SelectLastPtsList(ctx context.Context, userId int64, limit int32) ([]dataobject.UserPtsUpdatesDO, error)
+	SelectLastSeq(ctx context.Context, authId int64) (*dataobject.AuthSeqUpdatesDO, error)
+	SelectLastSeqList(ctx context.Context, authId int64, limit int32) ([]dataobject.AuthSeqUpdatesDO, error)
+}
+
+// SeqIdState is what idgen knows about a sequence key.
+type SeqIdState struct {
+	Key string `json:"key"`
+	// Current is the last id handed out
+	Current int64 `json:"current"`
+	// MaxId is the durable high-water mark of the key
+	MaxId int64 `json:"max_id"`
+	// LastUpdate is the last pts or seq stored in the updates tables,
+	// 0 for the other keys.
+	LastUpdate int64 `json:"last_update"`
+}
+
+// NextSeqId adds n to key and returns the new value.
+func (d *Dao) NextSeqId(ctx context.Context, key string, n int64) (int64, error) {
+	id, err := d.incrSeqId(ctx, key, n)
+	if err == redis.Nil {
+		if _, err = d.restoreSeqId(ctx, key); err != nil {
+			return 0, err
+		}
+		id, err = d.incrSeqId(ctx, key, n)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if err = d.commitSeqId(ctx, key, id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// CurrentSeqId returns the last id handed out for key.
+func (d *Dao) CurrentSeqId(ctx context.Context, key string) (int64, error) {
+	v, err := d.KV.GetCtx(ctx, key)
+	if err != nil {
+		return 0, err
+	} else if v == "" {
+		return d.restoreSeqId(ctx, key)
+	}
+
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("the value %q cannot parsed as int", v)
+	}
+
+	return id, nil
+}
+
+// SetCurrentSeqId moves key to id, id is made durable before it is set.
+func (d *Dao) SetCurrentSeqId(ctx context.Context, key string, id int64) error {
+	if err := d.commitSeqId(ctx, key, id); err != nil {
+		return err
+	}
+
+	return d.KV.SetCtx(ctx, key, strconv.FormatInt(id, 10))
+}
+
+// GetSeqIdState returns the redis, durable and updates view of key.
+func (d *Dao) GetSeqIdState(ctx context.Context, key string) (*SeqIdState, error) {
+	state := &SeqIdState{Key: key}
+
+	v, err := d.KV.GetCtx(ctx, key)
+	if err != nil {
+		return nil, err
+	} else if v != "" {
+		state.Current, _ = strconv.ParseInt(v, 10, 64)
+	}
+
+	segmentDO, err := d.seqStore.SelectByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	} else if segmentDO != nil {
+		state.MaxId = segmentDO.MaxId
+	}
+
+	state.LastUpdate, err = d.getLastUpdateSeqId(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// RepairSeqId moves key forward to the last pts or seq stored in the updates
+// tables, a key that is already past them is left alone.
+func (d *Dao) RepairSeqId(ctx context.Context, key string) (*SeqIdState, error) {
+	state, err := d.GetSeqIdState(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.LastUpdate <= state.Current {
+		return state, nil
+	}
+
+	logx.WithContext(ctx).Infof("repairSeqId(%s) - %d -> %d", key, state.Current, state.LastUpdate)
+	if err = d.commitSeqId(ctx, key, state.LastUpdate); err != nil {
+		return nil, err
+	}
+	if _, err = d.setMaxSeqId(ctx, key, state.LastUpdate); err != nil {
+		return nil, err
+	}
+
+	return d.GetSeqIdState(ctx, key)
+}
+
+// RecoverSeqIds restores SeqIDGen from idgen_seq_segments, user_pts_updates and
+// auth_seq_updates unless it has been done since redis last lost its data.
+func (d *Dao) RecoverSeqIds(ctx context.Context) error {
+	if v, err := d.KV.GetCtx(ctx, seqRecoveredKey); err != nil {
+		return err
+	} else if v != "" {
+		return nil
+	}
+
+	logx.WithContext(ctx).Infof("recoverSeqIds - restoring %s", seqRecoveredKey)
+
+	for lastId := int64(0); ; {
+		doList, err := d.seqStore.SelectList(ctx, lastId, seqRecoverBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(doList); i++ {
+			if _, err = d.setMaxSeqId(ctx, doList[i].SeqKey, doList[i].MaxId); err != nil {
+				return err
+			}
+		}
+		if len(doList) < seqRecoverBatchSize {
+			break
+		}
+		lastId = doList[len(doList)-1].Id
+	}
+
+	for lastId := int64(0); ; {
+		doList, err := d.seqStore.SelectLastPtsList(ctx, lastId, seqRecoverBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(doList); i++ {
+			if _, err = d.setMaxSeqId(ctx, ptsUpdatesNgenId+strconv.FormatInt(doList[i].UserId, 10), int64(doList[i].Pts)); err != nil {
+				return err
+			}
+		}
+		if len(doList) < seqRecoverBatchSize {
+			break
+		}
+		lastId = doList[len(doList)-1].UserId
+	}
+
+	for lastId := int64(0); ; {
+		doList, err := d.seqStore.SelectLastSeqList(ctx, lastId, seqRecoverBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(doList); i++ {
+			if _, err = d.setMaxSeqId(ctx, seqUpdatesNgenId+strconv.FormatInt(doList[i].AuthId, 10), int64(doList[i].Seq)); err != nil {
+				return err
+			}
+		}
+		if len(doList) < seqRecoverBatchSize {
+			break
+		}
+		lastId = doList[len(doList)-1].AuthId
+	}
+
+	return d.KV.SetCtx(ctx, seqRecoveredKey, "1")
+}
+
+func (d *Dao) incrSeqId(ctx context.Context, key string, n int64) (int64, error) {
+	v, err := d.KV.EvalCtx(ctx, incrSeqIdScript, key, n)
+	if err != nil {
+		return 0, err
+	}
+
+	id, ok := v.(int64)
+	if !ok {
+		return 0, fmt.Errorf("incrSeqId(%s) - invalid reply: %v", key, v)
+	}
+
+	return id, nil
+}
+
+func (d *Dao) setMaxSeqId(ctx context.Context, key string, id int64) (int64, error) {
+	v, err := d.KV.EvalCtx(ctx, setMaxSeqIdScript, key, id)
+	if err != nil {
+		return 0, err
+	}
+
+	rId, ok := v.(int64)
+	if !ok {
+		return 0, fmt.Errorf("setMaxSeqId(%s) - invalid reply: %v", key, v)
+	}
+
+	return rId, nil
+}
+
+// commitSeqId makes sure max_id of key covers id before id is handed out,
+// it takes a new segment of seqStep ids once the lease runs out.
+func (d *Dao) commitSeqId(ctx context.Context, key string, id int64) error {
+	if maxId, ok := d.seqLeases.Get(key); ok && id <= maxId.(int64) {
+		return nil
+	}
+
+	maxId := id + d.seqStep
+	if _, _, err := d.seqStore.InsertOrUpdate(ctx, &dataobject.IdgenSeqSegmentsDO{
+		SeqKey: key,
+		MaxId:  maxId,
+	}); err != nil {
+		return err
+	}
+	d.seqLeases.Set(key, maxId)
+
+	return nil
+}
+
+// restoreSeqId puts a key lost from redis back, it starts after everything
+// that may have been handed out before.
+func (d *Dao) restoreSeqId(ctx context.Context, key string) (int64, error) {
+	var id int64
+
+	segmentDO, err := d.seqStore.SelectByKey(ctx, key)
+	if err != nil {
+		return 0, err
+	} else if segmentDO != nil {
+		id = segmentDO.MaxId
+	}
+
+	lastUpdate, err := d.getLastUpdateSeqId(ctx, key)
+	if err != nil {
+		return 0, err
+	} else if lastUpdate > id {
+		id = lastUpdate
+	}
+
+	if id > 0 {
+		logx.WithContext(ctx).Infof("restoreSeqId(%s) - restored to %d", key, id)
+	}
+
+	// the lease was taken for the lost value
+	d.seqLeases.Del(key)
+
+	return d.setMaxSeqId(ctx, key, id)
+}
+
+func (d *Dao) getLastUpdateSeqId(ctx context.Context, key string) (int64, error) {
+	switch {
+	case strings.HasPrefix(key, ptsUpdatesNgenId):
+		userId, err := strconv.ParseInt(key[len(ptsUpdatesNgenId):], 10, 64)
+		if err != nil {
+			return 0, nil
+		}
+		do, err := d.seqStore.SelectLastPts(ctx, userId)
+		if err != nil || do == nil {
+			return 0, err
+		}
+		return int64(do.Pts), nil
+	case strings.HasPrefix(key, seqUpdatesNgenId):
+		authId, err := strconv.ParseInt(key[len(seqUpdatesNgenId):], 10, 64)
+		if err != nil {
+			return 0, nil
+		}
+		do, err := d.seqStore.SelectLastSeq(ctx, authId)
+		if err != nil || do == nil {
+			return 0, err
+		}
+		return int64(do.Seq), nil
+	}
+
+	return 0, nil
+}
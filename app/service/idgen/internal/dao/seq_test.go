@@ -0,0 +1,236 @@
+// Copyright 2023 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/teamgram/teamgram-server/app/service/idgen/internal/dal/dataobject"
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/kv"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type testSeqStore struct {
+	segments map[string]int64
+	lastPts  map[int64]int32
+	lastSeq  map[int64]int32
+	writes   int
+}
+
+func newTestSeqStore() *testSeqStore {
+	return &testSeqStore{
+		segments: map[string]int64{},
+		lastPts:  map[int64]int32{},
+		lastSeq:  map[int64]int32{},
+	}
+}
+
+func (s *testSeqStore) SelectByKey(ctx context.Context, seqKey string) (*dataobject.IdgenSeqSegmentsDO, error) {
+	maxId, ok := s.segments[seqKey]
+	if !ok {
+		return nil, nil
+	}
+	return &dataobject.IdgenSeqSegmentsDO{SeqKey: seqKey, MaxId: maxId}, nil
+}
+
+func (s *testSeqStore) SelectList(ctx context.Context, id int64, limit int32) ([]dataobject.IdgenSeqSegmentsDO, error) {
+	return nil, nil
+}
+
+// InsertOrUpdate mirrors the GREATEST in idgen_seq_segments.xml, max_id never moves back.
+func (s *testSeqStore) InsertOrUpdate(ctx context.Context, do *dataobject.IdgenSeqSegmentsDO) (int64, int64, error) {
+	s.writes++
+	if do.MaxId > s.segments[do.SeqKey] {
+		s.segments[do.SeqKey] = do.MaxId
+	}
+	return 0, 1, nil
+}
+
+func (s *testSeqStore) SelectLastPts(ctx context.Context, userId int64) (*dataobject.UserPtsUpdatesDO, error) {
+	pts, ok := s.lastPts[userId]
+	if !ok {
+		return nil, nil
+	}
+	return &dataobject.UserPtsUpdatesDO{UserId: userId, Pts: pts}, nil
+}
+
+func (s *testSeqStore) SelectLastPtsList(ctx context.Context, userId int64, limit int32) ([]dataobject.UserPtsUpdatesDO, error) {
+	return nil, nil
+}
+
+func (s *testSeqStore) SelectLastSeq(ctx context.Context, authId int64) (*dataobject.AuthSeqUpdatesDO, error) {
+	seq, ok := s.lastSeq[authId]
+	if !ok {
+		return nil, nil
+	}
+	return &dataobject.AuthSeqUpdatesDO{AuthId: authId, Seq: seq}, nil
+}
+
+func (s *testSeqStore) SelectLastSeqList(ctx context.Context, authId int64, limit int32) ([]dataobject.AuthSeqUpdatesDO, error) {
+	return nil, nil
+}
+
+func newTestDao(t *testing.T, seqStep int64) (*Dao, *miniredis.Miniredis, *testSeqStore) {
+	mr := miniredis.RunT(t)
+
+	seqLeases, err := collection.NewCache(seqLeaseExpire, collection.WithLimit(seqLeaseLimit))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := newTestSeqStore()
+	d := &Dao{
+		KV: kv.NewStore(kv.KvConf{
+			cache.NodeConf{
+				RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+				Weight:    100,
+			},
+		}),
+		seqStore:  store,
+		seqStep:   seqStep,
+		seqLeases: seqLeases,
+	}
+
+	return d, mr, store
+}
+
+func TestSeqIdScripts(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		d, _, _ = newTestDao(t, 10)
+		key     = "test_seq"
+	)
+
+	if _, err := d.incrSeqId(ctx, key, 1); err != redis.Nil {
+		t.Fatalf("incrSeqId on a missing key - got error %v, want redis.Nil", err)
+	}
+
+	tests := []struct {
+		name string
+		id   int64
+		want int64
+	}{
+		{name: "creates the key", id: 10, want: 10},
+		{name: "never moves back", id: 5, want: 10},
+		{name: "raises the key", id: 20, want: 20},
+	}
+	for _, tt := range tests {
+		got, err := d.setMaxSeqId(ctx, key, tt.id)
+		if err != nil {
+			t.Fatalf("%s: setMaxSeqId - error: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: setMaxSeqId(%d) = %d, want %d", tt.name, tt.id, got, tt.want)
+		}
+	}
+
+	if id, err := d.incrSeqId(ctx, key, 3); err != nil {
+		t.Fatal(err)
+	} else if id != 23 {
+		t.Errorf("incrSeqId = %d, want 23", id)
+	}
+}
+
+func TestNextSeqIdLease(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		d, _, store = newTestDao(t, 10)
+		key         = "test_seq"
+	)
+
+	for i := int64(1); i <= 25; i++ {
+		id, err := d.NextSeqId(ctx, key, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != i {
+			t.Fatalf("NextSeqId = %d, want %d", id, i)
+		}
+		if store.segments[key] < id {
+			t.Fatalf("id %d handed out above max_id %d", id, store.segments[key])
+		}
+	}
+
+	// leases taken at 1, 12 and 23
+	if store.writes != 3 {
+		t.Errorf("got %d segment writes, want 3", store.writes)
+	}
+	if store.segments[key] != 33 {
+		t.Errorf("got max_id %d, want 33", store.segments[key])
+	}
+}
+
+func TestNextSeqIdRestore(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		lastPts int32
+	}{
+		{name: "from max_id", key: "test_seq"},
+		{name: "from max_id above the last pts", key: ptsUpdatesNgenId + "1", lastPts: 20},
+		{name: "from the last pts above max_id", key: ptsUpdatesNgenId + "1", lastPts: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx          = context.Background()
+				d, mr, store = newTestDao(t, 10)
+				handedOut    int64
+			)
+			store.lastPts[1] = tt.lastPts
+
+			for i := 0; i < 15; i++ {
+				id, err := d.NextSeqId(ctx, tt.key, 2)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if id <= handedOut {
+					t.Fatalf("NextSeqId = %d, already handed out %d", id, handedOut)
+				}
+				handedOut = id
+			}
+
+			mr.Del(tt.key)
+
+			if id, err := d.CurrentSeqId(ctx, tt.key); err != nil {
+				t.Fatal(err)
+			} else if id < handedOut || id < int64(tt.lastPts) {
+				t.Errorf("CurrentSeqId = %d after the key was lost, handed out %d, last pts %d", id, handedOut, tt.lastPts)
+			}
+
+			mr.Del(tt.key)
+
+			id, err := d.NextSeqId(ctx, tt.key, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id <= handedOut || id <= int64(tt.lastPts) {
+				t.Errorf("NextSeqId = %d after the key was lost, handed out %d, last pts %d", id, handedOut, tt.lastPts)
+			}
+			if store.segments[tt.key] < id {
+				t.Errorf("restored id %d handed out above max_id %d", id, store.segments[tt.key])
+			}
+		})
+	}
+}
@@ -13,12 +13,11 @@ package service
 import (
 	"context"
 
+	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/idgen/idgen"
 	"github.com/teamgram/teamgram-server/app/service/idgen/internal/core"
-	"github.com/teamgram/proto/mtproto"
 )
 
-
 // IdgenNextId
 // idgen.nextId = Int64;
 func (s *Service) IdgenNextId(ctx context.Context, request *idgen.TLIdgenNextId) (*mtproto.Int64, error) {
@@ -139,3 +138,32 @@ func (s *Service) IdgenGetCurrentSeqIdList(ctx context.Context, request *idgen.T
 	return r, err
 }
 
+// IdgenGetSeqIdState
+// idgen.getSeqIdState key:string = DataJSON;
+func (s *Service) IdgenGetSeqIdState(ctx context.Context, request *idgen.TLIdgenGetSeqIdState) (*mtproto.DataJSON, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("idgen.getSeqIdState - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.IdgenGetSeqIdState(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("idgen.getSeqIdState - reply: %s", r.DebugString())
+	return r, err
+}
+
+// IdgenRepairSeqId
+// idgen.repairSeqId key:string = DataJSON;
+func (s *Service) IdgenRepairSeqId(ctx context.Context, request *idgen.TLIdgenRepairSeqId) (*mtproto.DataJSON, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("idgen.repairSeqId - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.IdgenRepairSeqId(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("idgen.repairSeqId - reply: %s", r.DebugString())
+	return r, err
+}
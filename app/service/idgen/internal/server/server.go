@@ -10,6 +10,7 @@
 package server
 
 import (
+	"context"
 	"flag"
 
 	"github.com/teamgram/teamgram-server/app/service/idgen/internal/config"
@@ -39,6 +40,14 @@ func (s *Server) Initialize() error {
 	ctx := svc.NewServiceContext(c)
 	s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
 
+	// keys lost from SeqIDGen are also restored one by one on first use,
+	// so requests need not wait for this.
+	go func() {
+		if err := ctx.Dao.RecoverSeqIds(context.Background()); err != nil {
+			logx.Errorf("recoverSeqIds - error: %v", err)
+		}
+	}()
+
 	go func() {
 		s.grpcSrv.Start()
 	}()
@@ -37,6 +37,26 @@ type MediaClient interface {
 	MediaUploadThemeFile(ctx context.Context, in *media.TLMediaUploadThemeFile) (*mtproto.Document, error)
 	MediaUploadStickerFile(ctx context.Context, in *media.TLMediaUploadStickerFile) (*mtproto.Document, error)
 	MediaUploadRingtoneFile(ctx context.Context, in *media.TLMediaUploadRingtoneFile) (*mtproto.Document, error)
+	MediaCreateStickerSet(ctx context.Context, in *media.TLMediaCreateStickerSet) (*mtproto.Messages_StickerSet, error)
+	MediaAddStickerToSet(ctx context.Context, in *media.TLMediaAddStickerToSet) (*mtproto.Messages_StickerSet, error)
+	MediaRemoveStickerFromSet(ctx context.Context, in *media.TLMediaRemoveStickerFromSet) (*mtproto.Messages_StickerSet, error)
+	MediaChangeStickerPosition(ctx context.Context, in *media.TLMediaChangeStickerPosition) (*mtproto.Messages_StickerSet, error)
+	MediaSetStickerSetThumb(ctx context.Context, in *media.TLMediaSetStickerSetThumb) (*mtproto.Messages_StickerSet, error)
+	MediaCheckStickerSetShortName(ctx context.Context, in *media.TLMediaCheckStickerSetShortName) (*mtproto.Bool, error)
+	MediaGetStickerSet(ctx context.Context, in *media.TLMediaGetStickerSet) (*mtproto.Messages_StickerSet, error)
+	MediaInstallStickerSet(ctx context.Context, in *media.TLMediaInstallStickerSet) (*mtproto.Messages_StickerSetInstallResult, error)
+	MediaUninstallStickerSet(ctx context.Context, in *media.TLMediaUninstallStickerSet) (*mtproto.Bool, error)
+	MediaReorderStickerSets(ctx context.Context, in *media.TLMediaReorderStickerSets) (*mtproto.Bool, error)
+	MediaGetAllStickers(ctx context.Context, in *media.TLMediaGetAllStickers) (*mtproto.Messages_AllStickers, error)
+	MediaGetArchivedStickers(ctx context.Context, in *media.TLMediaGetArchivedStickers) (*mtproto.Messages_ArchivedStickers, error)
+	MediaSearchStickerSets(ctx context.Context, in *media.TLMediaSearchStickerSets) (*mtproto.Messages_FoundStickerSets, error)
+	MediaGetStickers(ctx context.Context, in *media.TLMediaGetStickers) (*mtproto.Messages_Stickers, error)
+	MediaGetRecentStickers(ctx context.Context, in *media.TLMediaGetRecentStickers) (*mtproto.Messages_RecentStickers, error)
+	MediaSaveRecentSticker(ctx context.Context, in *media.TLMediaSaveRecentSticker) (*mtproto.Bool, error)
+	MediaClearRecentStickers(ctx context.Context, in *media.TLMediaClearRecentStickers) (*mtproto.Bool, error)
+	MediaGetFavedStickers(ctx context.Context, in *media.TLMediaGetFavedStickers) (*mtproto.Messages_FavedStickers, error)
+	MediaFaveSticker(ctx context.Context, in *media.TLMediaFaveSticker) (*mtproto.Bool, error)
+	MediaGetStickerSetThumbDocument(ctx context.Context, in *media.TLMediaGetStickerSetThumbDocument) (*mtproto.Document, error)
 }
 
 type defaultMediaClient struct {
@@ -153,3 +173,143 @@ func (m *defaultMediaClient) MediaUploadRingtoneFile(ctx context.Context, in *me
 	client := media.NewRPCMediaClient(m.cli.Conn())
 	return client.MediaUploadRingtoneFile(ctx, in)
 }
+
+// MediaCreateStickerSet
+// media.createStickerSet flags:# masks:flags.0?true animated:flags.1?true videos:flags.4?true emojis:flags.5?true owner_id:long title:string short_name:string thumb_document_id:long stickers:Vector<InputStickerSetItem> = messages.StickerSet;
+func (m *defaultMediaClient) MediaCreateStickerSet(ctx context.Context, in *media.TLMediaCreateStickerSet) (*mtproto.Messages_StickerSet, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaCreateStickerSet(ctx, in)
+}
+
+// MediaAddStickerToSet
+// media.addStickerToSet owner_id:long stickerset:InputStickerSet sticker:InputStickerSetItem = messages.StickerSet;
+func (m *defaultMediaClient) MediaAddStickerToSet(ctx context.Context, in *media.TLMediaAddStickerToSet) (*mtproto.Messages_StickerSet, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaAddStickerToSet(ctx, in)
+}
+
+// MediaRemoveStickerFromSet
+// media.removeStickerFromSet owner_id:long document_id:long = messages.StickerSet;
+func (m *defaultMediaClient) MediaRemoveStickerFromSet(ctx context.Context, in *media.TLMediaRemoveStickerFromSet) (*mtproto.Messages_StickerSet, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaRemoveStickerFromSet(ctx, in)
+}
+
+// MediaChangeStickerPosition
+// media.changeStickerPosition owner_id:long document_id:long position:int = messages.StickerSet;
+func (m *defaultMediaClient) MediaChangeStickerPosition(ctx context.Context, in *media.TLMediaChangeStickerPosition) (*mtproto.Messages_StickerSet, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaChangeStickerPosition(ctx, in)
+}
+
+// MediaSetStickerSetThumb
+// media.setStickerSetThumb owner_id:long stickerset:InputStickerSet thumb_document_id:long = messages.StickerSet;
+func (m *defaultMediaClient) MediaSetStickerSetThumb(ctx context.Context, in *media.TLMediaSetStickerSetThumb) (*mtproto.Messages_StickerSet, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaSetStickerSetThumb(ctx, in)
+}
+
+// MediaCheckStickerSetShortName
+// media.checkStickerSetShortName short_name:string = Bool;
+func (m *defaultMediaClient) MediaCheckStickerSetShortName(ctx context.Context, in *media.TLMediaCheckStickerSetShortName) (*mtproto.Bool, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaCheckStickerSetShortName(ctx, in)
+}
+
+// MediaGetStickerSet
+// media.getStickerSet user_id:long stickerset:InputStickerSet = messages.StickerSet;
+func (m *defaultMediaClient) MediaGetStickerSet(ctx context.Context, in *media.TLMediaGetStickerSet) (*mtproto.Messages_StickerSet, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaGetStickerSet(ctx, in)
+}
+
+// MediaInstallStickerSet
+// media.installStickerSet flags:# archived:flags.0?true user_id:long stickerset:InputStickerSet = messages.StickerSetInstallResult;
+func (m *defaultMediaClient) MediaInstallStickerSet(ctx context.Context, in *media.TLMediaInstallStickerSet) (*mtproto.Messages_StickerSetInstallResult, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaInstallStickerSet(ctx, in)
+}
+
+// MediaUninstallStickerSet
+// media.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+func (m *defaultMediaClient) MediaUninstallStickerSet(ctx context.Context, in *media.TLMediaUninstallStickerSet) (*mtproto.Bool, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaUninstallStickerSet(ctx, in)
+}
+
+// MediaReorderStickerSets
+// media.reorderStickerSets flags:# masks:flags.0?true emojis:flags.1?true user_id:long order:Vector<long> = Bool;
+func (m *defaultMediaClient) MediaReorderStickerSets(ctx context.Context, in *media.TLMediaReorderStickerSets) (*mtproto.Bool, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaReorderStickerSets(ctx, in)
+}
+
+// MediaGetAllStickers
+// media.getAllStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long hash:long = messages.AllStickers;
+func (m *defaultMediaClient) MediaGetAllStickers(ctx context.Context, in *media.TLMediaGetAllStickers) (*mtproto.Messages_AllStickers, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaGetAllStickers(ctx, in)
+}
+
+// MediaGetArchivedStickers
+// media.getArchivedStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long offset_id:long limit:int = messages.ArchivedStickers;
+func (m *defaultMediaClient) MediaGetArchivedStickers(ctx context.Context, in *media.TLMediaGetArchivedStickers) (*mtproto.Messages_ArchivedStickers, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaGetArchivedStickers(ctx, in)
+}
+
+// MediaSearchStickerSets
+// media.searchStickerSets user_id:long q:string hash:long = messages.FoundStickerSets;
+func (m *defaultMediaClient) MediaSearchStickerSets(ctx context.Context, in *media.TLMediaSearchStickerSets) (*mtproto.Messages_FoundStickerSets, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaSearchStickerSets(ctx, in)
+}
+
+// MediaGetStickers
+// media.getStickers user_id:long emoticon:string hash:long = messages.Stickers;
+func (m *defaultMediaClient) MediaGetStickers(ctx context.Context, in *media.TLMediaGetStickers) (*mtproto.Messages_Stickers, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaGetStickers(ctx, in)
+}
+
+// MediaGetRecentStickers
+// media.getRecentStickers user_id:long hash:long = messages.RecentStickers;
+func (m *defaultMediaClient) MediaGetRecentStickers(ctx context.Context, in *media.TLMediaGetRecentStickers) (*mtproto.Messages_RecentStickers, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaGetRecentStickers(ctx, in)
+}
+
+// MediaSaveRecentSticker
+// media.saveRecentSticker flags:# unsave:flags.0?true user_id:long id:long = Bool;
+func (m *defaultMediaClient) MediaSaveRecentSticker(ctx context.Context, in *media.TLMediaSaveRecentSticker) (*mtproto.Bool, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaSaveRecentSticker(ctx, in)
+}
+
+// MediaClearRecentStickers
+// media.clearRecentStickers user_id:long = Bool;
+func (m *defaultMediaClient) MediaClearRecentStickers(ctx context.Context, in *media.TLMediaClearRecentStickers) (*mtproto.Bool, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaClearRecentStickers(ctx, in)
+}
+
+// MediaGetFavedStickers
+// media.getFavedStickers user_id:long hash:long = messages.FavedStickers;
+func (m *defaultMediaClient) MediaGetFavedStickers(ctx context.Context, in *media.TLMediaGetFavedStickers) (*mtproto.Messages_FavedStickers, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaGetFavedStickers(ctx, in)
+}
+
+// MediaFaveSticker
+// media.faveSticker flags:# unfave:flags.0?true user_id:long id:long = Bool;
+func (m *defaultMediaClient) MediaFaveSticker(ctx context.Context, in *media.TLMediaFaveSticker) (*mtproto.Bool, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaFaveSticker(ctx, in)
+}
+
+// MediaGetStickerSetThumbDocument
+// media.getStickerSetThumbDocument stickerset:InputStickerSet = Document;
+func (m *defaultMediaClient) MediaGetStickerSetThumbDocument(ctx context.Context, in *media.TLMediaGetStickerSetThumbDocument) (*mtproto.Document, error) {
+	client := media.NewRPCMediaClient(m.cli.Conn())
+	return client.MediaGetStickerSetThumbDocument(ctx, in)
+}
@@ -0,0 +1,253 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/pkg/hashx"
+
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrStickersTooMuch = status.Error(mtproto.ErrBadRequest, "STICKERS_TOO_MUCH")
+)
+
+const (
+	stickerSetMaxCount      = 120
+	stickerSetTitleMaxLen   = 64
+	stickerEmoticonMaxLen   = 64
+	stickerSetSearchLimit   = 50
+	archivedStickersMaxList = 100
+)
+
+var (
+	shortNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,63}$`)
+)
+
+func checkStickerSetShortName(shortName string) bool {
+	return shortNameRegexp.MatchString(shortName) &&
+		!strings.Contains(shortName, "__") &&
+		!strings.HasSuffix(shortName, "_")
+}
+
+func checkStickerSetTitle(title string) bool {
+	n := utf8.RuneCountInString(strings.TrimSpace(title))
+	return n > 0 && n <= stickerSetTitleMaxLen
+}
+
+func checkStickerEmoticon(emoticon string) bool {
+	return len(emoticon) <= stickerEmoticonMaxLen && len(dao.SplitEmoticon(emoticon)) > 0
+}
+
+// checkStickerDocument checks an uploaded document may be added to a set of the given kind
+func (c *MediaCore) checkStickerDocument(document *mtproto.InputDocument, animated, videos bool) error {
+	do, err := c.svcCtx.Dao.DocumentsDAO.SelectByDocumentId(c.ctx, document.GetId())
+	if err != nil {
+		c.Logger.Errorf("checkStickerDocument - error: %v", err)
+		return err
+	} else if do == nil || do.AccessHash != document.GetAccessHash() {
+		return mtproto.ErrStickerFileInvalid
+	}
+
+	switch {
+	case animated:
+		if do.MimeType != "application/x-tgsticker" {
+			return mtproto.ErrStickerTgsNotgs
+		}
+	case videos:
+		if do.MimeType != "video/webm" {
+			return mtproto.ErrStickerFileInvalid
+		}
+	default:
+		if do.MimeType != "image/webp" && do.MimeType != "image/png" {
+			return mtproto.ErrStickerPngNopng
+		}
+	}
+
+	// a sticker document belongs to exactly one set
+	sDO, err := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectByDocumentId(c.ctx, document.GetId())
+	if err != nil {
+		c.Logger.Errorf("checkStickerDocument - error: %v", err)
+		return err
+	} else if sDO != nil {
+		return mtproto.ErrStickerDocumentInvalid
+	}
+
+	return nil
+}
+
+func (c *MediaCore) checkStickerSetItem(item *mtproto.InputStickerSetItem, setDO *dataobject.StickerSetsDO) error {
+	if !checkStickerEmoticon(item.GetEmoji()) {
+		return mtproto.ErrStickerEmojiInvalid
+	}
+
+	return c.checkStickerDocument(item.GetDocument(), setDO.Animated, setDO.Videos)
+}
+
+func (c *MediaCore) addStickerSetItem(setDO *dataobject.StickerSetsDO, item *mtproto.InputStickerSetItem, position int32) error {
+	_, _, err := c.svcCtx.Dao.StickerSetDocumentsDAO.InsertOrUpdate(c.ctx, &dataobject.StickerSetDocumentsDO{
+		StickerSetId: setDO.StickerSetId,
+		DocumentId:   item.GetDocument().GetId(),
+		Emoticon:     item.GetEmoji(),
+		Position:     position,
+	})
+	if err != nil {
+		c.Logger.Errorf("addStickerSetItem - error: %v", err)
+		return err
+	}
+
+	var (
+		maskCoords *mtproto.MaskCoords
+	)
+	if setDO.SetType == dao.StickerSetTypeMasks {
+		maskCoords = item.GetMaskCoords()
+	}
+
+	return c.svcCtx.Dao.SetDocumentStickerAttribute(
+		c.ctx,
+		item.GetDocument().GetId(),
+		item.GetEmoji(),
+		mtproto.MakeTLInputStickerSetID(&mtproto.InputStickerSet{
+			Id:         setDO.StickerSetId,
+			AccessHash: setDO.AccessHash,
+		}).To_InputStickerSet(),
+		maskCoords)
+}
+
+// getOwnedStickerSetDO only lets the creator of a set modify it
+func (c *MediaCore) getOwnedStickerSetDO(ownerId int64, stickerSet *mtproto.InputStickerSet) (*dataobject.StickerSetsDO, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetDOByInput(c.ctx, stickerSet)
+	if err != nil {
+		return nil, err
+	} else if setDO.CreatorId != ownerId {
+		return nil, mtproto.ErrStickersetInvalid
+	}
+
+	return setDO, nil
+}
+
+// getOwnedStickerSetDOByDocumentId
+func (c *MediaCore) getOwnedStickerSetDOByDocumentId(ownerId, documentId int64) (*dataobject.StickerSetsDO, error) {
+	sDO, err := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectByDocumentId(c.ctx, documentId)
+	if err != nil {
+		return nil, err
+	} else if sDO == nil {
+		return nil, mtproto.ErrStickerInvalid
+	}
+
+	setDO, err := c.svcCtx.Dao.StickerSetsDAO.SelectBySetId(c.ctx, sDO.StickerSetId)
+	if err != nil {
+		return nil, err
+	} else if setDO == nil || setDO.CreatorId != ownerId {
+		return nil, mtproto.ErrStickersetInvalid
+	}
+
+	return setDO, nil
+}
+
+func (c *MediaCore) makeOwnerStickerSet(setDO *dataobject.StickerSetsDO) *mtproto.Messages_StickerSet {
+	installed := c.svcCtx.Dao.GetInstalledStickerSetMap(c.ctx, setDO.CreatorId, []int64{setDO.StickerSetId})
+	return c.svcCtx.Dao.MakeMessagesStickerSet(c.ctx, setDO, installed[setDO.StickerSetId])
+}
+
+// getInstalledStickerSetList returns the user's installed sets of a kind in the user's order
+func (c *MediaCore) getInstalledStickerSetList(userId int64, setType int32, archived bool) ([]*dataobject.StickerSetsDO, []*dataobject.UserInstalledStickerSetsDO) {
+	installedList, err := c.svcCtx.Dao.UserInstalledStickerSetsDAO.SelectList(c.ctx, userId, setType, archived)
+	if err != nil || len(installedList) == 0 {
+		return []*dataobject.StickerSetsDO{}, []*dataobject.UserInstalledStickerSetsDO{}
+	}
+
+	idList := make([]int64, 0, len(installedList))
+	for i := range installedList {
+		idList = append(idList, installedList[i].StickerSetId)
+	}
+
+	setDOMap := make(map[int64]*dataobject.StickerSetsDO, len(idList))
+	c.svcCtx.Dao.StickerSetsDAO.SelectBySetIdListWithCB(
+		c.ctx,
+		idList,
+		func(i int, v *dataobject.StickerSetsDO) {
+			setDOMap[v.StickerSetId] = v
+		})
+
+	var (
+		setDOList        = make([]*dataobject.StickerSetsDO, 0, len(idList))
+		installedDOList2 = make([]*dataobject.UserInstalledStickerSetsDO, 0, len(idList))
+	)
+	for i := range installedList {
+		if setDO, ok := setDOMap[installedList[i].StickerSetId]; ok {
+			setDOList = append(setDOList, setDO)
+			installedDOList2 = append(installedDOList2, &installedList[i])
+		}
+	}
+
+	return setDOList, installedDOList2
+}
+
+// getStickerDocumentDOList returns the set entries of documents, documents outside any set are skipped
+func (c *MediaCore) getStickerDocumentDOList(idList []int64) []dataobject.StickerSetDocumentsDO {
+	doList := make([]dataobject.StickerSetDocumentsDO, 0, len(idList))
+	for _, id := range idList {
+		sDO, _ := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectByDocumentId(c.ctx, id)
+		if sDO != nil {
+			doList = append(doList, *sDO)
+		}
+	}
+
+	return doList
+}
+
+func (c *MediaCore) checkDocumentExists(id int64) error {
+	do, err := c.svcCtx.Dao.DocumentsDAO.SelectByDocumentId(c.ctx, id)
+	if err != nil {
+		return err
+	} else if do == nil {
+		return mtproto.ErrStickerIdInvalid
+	}
+
+	return nil
+}
+
+func calcStickerSetListHash(setList []*mtproto.StickerSet) int64 {
+	var (
+		acc int64
+	)
+
+	for _, set := range setList {
+		acc = hashx.CombineInt64Hash2(acc, int64(uint32(set.GetHash())))
+	}
+
+	return acc
+}
+
+// normalizeEmoticon drops variation selectors so "❤" and "❤️" match the same pack
+func normalizeEmoticon(emoticon string) string {
+	return strings.NewReplacer("\ufe0f", "", "\ufe0e", "").Replace(emoticon)
+}
+
+func escapeLike(q string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(q)
+}
@@ -0,0 +1,41 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaAddStickerToSet
+// media.addStickerToSet owner_id:long stickerset:InputStickerSet sticker:InputStickerSetItem = messages.StickerSet;
+func (c *MediaCore) MediaAddStickerToSet(in *media.TLMediaAddStickerToSet) (*mtproto.Messages_StickerSet, error) {
+	setDO, err := c.getOwnedStickerSetDO(in.GetOwnerId(), in.GetStickerset())
+	if err != nil {
+		return nil, err
+	}
+
+	if setDO.Count >= stickerSetMaxCount {
+		return nil, ErrStickersTooMuch
+	}
+	if err = c.checkStickerSetItem(in.GetSticker(), setDO); err != nil {
+		return nil, err
+	}
+
+	if err = c.addStickerSetItem(setDO, in.GetSticker(), setDO.Count); err != nil {
+		return nil, err
+	}
+	if err = c.svcCtx.Dao.RefreshStickerSetHash(c.ctx, setDO); err != nil {
+		c.Logger.Errorf("media.addStickerToSet - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeOwnerStickerSet(setDO), nil
+}
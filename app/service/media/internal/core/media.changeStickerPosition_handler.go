@@ -0,0 +1,54 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaChangeStickerPosition
+// media.changeStickerPosition owner_id:long document_id:long position:int = messages.StickerSet;
+func (c *MediaCore) MediaChangeStickerPosition(in *media.TLMediaChangeStickerPosition) (*mtproto.Messages_StickerSet, error) {
+	setDO, err := c.getOwnedStickerSetDOByDocumentId(in.GetOwnerId(), in.GetDocumentId())
+	if err != nil {
+		return nil, err
+	}
+
+	doList, err := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectBySetId(c.ctx, setDO.StickerSetId)
+	if err != nil {
+		c.Logger.Errorf("media.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		idList   = make([]int64, 0, len(doList))
+		position = int(in.GetPosition())
+	)
+	for i := range doList {
+		if doList[i].DocumentId != in.GetDocumentId() {
+			idList = append(idList, doList[i].DocumentId)
+		}
+	}
+	if position < 0 || position > len(idList) {
+		return nil, mtproto.ErrStickerInvalid
+	}
+	idList = append(idList[:position], append([]int64{in.GetDocumentId()}, idList[position:]...)...)
+
+	for i, id := range idList {
+		c.svcCtx.Dao.StickerSetDocumentsDAO.UpdatePosition(c.ctx, int32(i), setDO.StickerSetId, id)
+	}
+	if err = c.svcCtx.Dao.RefreshStickerSetHash(c.ctx, setDO); err != nil {
+		c.Logger.Errorf("media.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeOwnerStickerSet(setDO), nil
+}
@@ -0,0 +1,33 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaCheckStickerSetShortName
+// media.checkStickerSetShortName short_name:string = Bool;
+func (c *MediaCore) MediaCheckStickerSetShortName(in *media.TLMediaCheckStickerSetShortName) (*mtproto.Bool, error) {
+	if !checkStickerSetShortName(in.GetShortName()) {
+		return nil, mtproto.ErrShortNameInvalid
+	}
+
+	do, err := c.svcCtx.Dao.StickerSetsDAO.SelectByShortName(c.ctx, in.GetShortName())
+	if err != nil {
+		c.Logger.Errorf("media.checkStickerSetShortName - error: %v", err)
+		return nil, err
+	} else if do != nil {
+		return nil, mtproto.ErrShortNameOccupied
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,26 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaClearRecentStickers
+// media.clearRecentStickers user_id:long = Bool;
+func (c *MediaCore) MediaClearRecentStickers(in *media.TLMediaClearRecentStickers) (*mtproto.Bool, error) {
+	if _, err := c.svcCtx.Dao.UserRecentStickersDAO.DeleteAll(c.ctx, in.GetUserId()); err != nil {
+		c.Logger.Errorf("media.clearRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaCreateStickerSet
+// media.createStickerSet flags:# masks:flags.0?true animated:flags.1?true videos:flags.4?true emojis:flags.5?true owner_id:long title:string short_name:string thumb_document_id:long stickers:Vector<InputStickerSetItem> = messages.StickerSet;
+func (c *MediaCore) MediaCreateStickerSet(in *media.TLMediaCreateStickerSet) (*mtproto.Messages_StickerSet, error) {
+	if !checkStickerSetTitle(in.GetTitle()) {
+		return nil, mtproto.ErrTitleInvalid
+	}
+	if !checkStickerSetShortName(in.GetShortName()) {
+		return nil, mtproto.ErrShortNameInvalid
+	}
+	if len(in.GetStickers()) == 0 {
+		return nil, mtproto.ErrStickersEmpty
+	} else if len(in.GetStickers()) > stickerSetMaxCount {
+		return nil, ErrStickersTooMuch
+	}
+
+	if do, err := c.svcCtx.Dao.StickerSetsDAO.SelectByShortName(c.ctx, in.GetShortName()); err != nil {
+		c.Logger.Errorf("media.createStickerSet - error: %v", err)
+		return nil, err
+	} else if do != nil {
+		return nil, mtproto.ErrShortNameOccupied
+	}
+
+	setDO := &dataobject.StickerSetsDO{
+		StickerSetId:    rand.Int63(),
+		AccessHash:      rand.Int63(),
+		CreatorId:       in.GetOwnerId(),
+		Title:           strings.TrimSpace(in.GetTitle()),
+		ShortName:       in.GetShortName(),
+		SetType:         dao.GetStickerSetType(in.GetMasks(), in.GetEmojis()),
+		Animated:        in.GetAnimated(),
+		Videos:          in.GetVideos(),
+		ThumbDocumentId: in.GetThumbDocumentId(),
+		Date2:           time.Now().Unix(),
+	}
+
+	for _, item := range in.GetStickers() {
+		if err := c.checkStickerSetItem(item, setDO); err != nil {
+			return nil, err
+		}
+	}
+	if setDO.ThumbDocumentId != 0 {
+		if err := c.checkDocumentExists(setDO.ThumbDocumentId); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, _, err := c.svcCtx.Dao.StickerSetsDAO.Insert(c.ctx, setDO); err != nil {
+		// lost the race on the short_name unique key
+		c.Logger.Errorf("media.createStickerSet - error: %v", err)
+		return nil, mtproto.ErrShortNameOccupied
+	}
+
+	for i, item := range in.GetStickers() {
+		if err := c.addStickerSetItem(setDO, item, int32(i)); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.svcCtx.Dao.RefreshStickerSetHash(c.ctx, setDO); err != nil {
+		c.Logger.Errorf("media.createStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	// the creator gets the new set installed
+	c.svcCtx.Dao.UserInstalledStickerSetsDAO.InsertOrUpdate(c.ctx, &dataobject.UserInstalledStickerSetsDO{
+		UserId:        setDO.CreatorId,
+		StickerSetId:  setDO.StickerSetId,
+		SetType:       setDO.SetType,
+		InstalledDate: setDO.Date2,
+	})
+
+	return c.makeOwnerStickerSet(setDO), nil
+}
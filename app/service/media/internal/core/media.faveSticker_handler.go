@@ -0,0 +1,53 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaFaveSticker
+// media.faveSticker flags:# unfave:flags.0?true user_id:long id:long = Bool;
+func (c *MediaCore) MediaFaveSticker(in *media.TLMediaFaveSticker) (*mtproto.Bool, error) {
+	if in.GetUnfave() {
+		if _, err := c.svcCtx.Dao.UserFavedStickersDAO.Delete(c.ctx, in.GetUserId(), in.GetId()); err != nil {
+			c.Logger.Errorf("media.faveSticker - error: %v", err)
+			return nil, err
+		}
+		return mtproto.BoolTrue, nil
+	}
+
+	if err := c.checkDocumentExists(in.GetId()); err != nil {
+		return nil, err
+	}
+
+	_, _, err := c.svcCtx.Dao.UserFavedStickersDAO.InsertOrUpdate(c.ctx, &dataobject.UserFavedStickersDO{
+		UserId:     in.GetUserId(),
+		DocumentId: in.GetId(),
+		Date2:      time.Now().Unix(),
+	})
+	if err != nil {
+		c.Logger.Errorf("media.faveSticker - error: %v", err)
+		return nil, err
+	}
+
+	// past stickers_faved_limit the oldest faved sticker is dropped
+	doList, _ := c.svcCtx.Dao.UserFavedStickersDAO.SelectList(c.ctx, in.GetUserId(), dao.StickersFavedLimit+1)
+	for i := dao.StickersFavedLimit; i < len(doList); i++ {
+		c.svcCtx.Dao.UserFavedStickersDAO.Delete(c.ctx, in.GetUserId(), doList[i].DocumentId)
+	}
+
+	return mtproto.BoolTrue, nil
+}
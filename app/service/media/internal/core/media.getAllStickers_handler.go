@@ -0,0 +1,42 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaGetAllStickers
+// media.getAllStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long hash:long = messages.AllStickers;
+func (c *MediaCore) MediaGetAllStickers(in *media.TLMediaGetAllStickers) (*mtproto.Messages_AllStickers, error) {
+	var (
+		setType                = dao.GetStickerSetType(in.GetMasks(), in.GetEmojis())
+		setDOList, installList = c.getInstalledStickerSetList(in.GetUserId(), setType, false)
+		sets                   = make([]*mtproto.StickerSet, 0, len(setDOList))
+	)
+
+	for i, setDO := range setDOList {
+		sets = append(sets, c.svcCtx.Dao.MakeStickerSet(c.ctx, setDO, installList[i]))
+	}
+
+	hash := calcStickerSetListHash(sets)
+	if in.GetHash() != 0 && in.GetHash() == hash {
+		// messages.allStickersNotModified#e86602c3 = messages.AllStickers;
+		return mtproto.MakeTLMessagesAllStickersNotModified(nil).To_Messages_AllStickers(), nil
+	}
+
+	// messages.allStickers#cdbbcebb hash:long sets:Vector<StickerSet> = messages.AllStickers;
+	return mtproto.MakeTLMessagesAllStickers(&mtproto.Messages_AllStickers{
+		Hash: hash,
+		Sets: sets,
+	}).To_Messages_AllStickers(), nil
+}
@@ -0,0 +1,51 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaGetArchivedStickers
+// media.getArchivedStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long offset_id:long limit:int = messages.ArchivedStickers;
+func (c *MediaCore) MediaGetArchivedStickers(in *media.TLMediaGetArchivedStickers) (*mtproto.Messages_ArchivedStickers, error) {
+	var (
+		setType                = dao.GetStickerSetType(in.GetMasks(), in.GetEmojis())
+		setDOList, installList = c.getInstalledStickerSetList(in.GetUserId(), setType, true)
+		limit                  = int(in.GetLimit())
+		offset                 = 0
+	)
+
+	if limit <= 0 || limit > archivedStickersMaxList {
+		limit = archivedStickersMaxList
+	}
+	if in.GetOffsetId() != 0 {
+		offset = len(setDOList)
+		for i, setDO := range setDOList {
+			if setDO.StickerSetId == in.GetOffsetId() {
+				offset = i + 1
+				break
+			}
+		}
+	}
+
+	sets := make([]*mtproto.StickerSetCovered, 0, limit)
+	for i := offset; i < len(setDOList) && len(sets) < limit; i++ {
+		sets = append(sets, c.svcCtx.Dao.MakeStickerSetCovered(c.ctx, setDOList[i], installList[i]))
+	}
+
+	// messages.archivedStickers#4fcba9c8 count:int sets:Vector<StickerSetCovered> = messages.ArchivedStickers;
+	return mtproto.MakeTLMessagesArchivedStickers(&mtproto.Messages_ArchivedStickers{
+		Count: int32(len(setDOList)),
+		Sets:  sets,
+	}).To_Messages_ArchivedStickers(), nil
+}
@@ -17,8 +17,11 @@ import (
 // MediaGetEncryptedFile
 // media.getEncryptedFile id:long access_hash:long = EncryptedFile;
 func (c *MediaCore) MediaGetEncryptedFile(in *media.TLMediaGetEncryptedFile) (*mtproto.EncryptedFile, error) {
-	// TODO: not impl
-	c.Logger.Errorf("media.getEncryptedFile blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	encryptedFile, err := c.svcCtx.Dao.GetEncryptedFile(c.ctx, in.GetId(), in.GetAccessHash())
+	if err != nil {
+		c.Logger.Errorf("media.getEncryptedFile - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	return encryptedFile, nil
 }
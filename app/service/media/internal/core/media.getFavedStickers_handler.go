@@ -0,0 +1,44 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaGetFavedStickers
+// media.getFavedStickers user_id:long hash:long = messages.FavedStickers;
+func (c *MediaCore) MediaGetFavedStickers(in *media.TLMediaGetFavedStickers) (*mtproto.Messages_FavedStickers, error) {
+	doList, err := c.svcCtx.Dao.UserFavedStickersDAO.SelectList(c.ctx, in.GetUserId(), dao.StickersFavedLimit)
+	if err != nil {
+		c.Logger.Errorf("media.getFavedStickers - error: %v", err)
+		return nil, err
+	}
+
+	idList := make([]int64, 0, len(doList))
+	for i := range doList {
+		idList = append(idList, doList[i].DocumentId)
+	}
+
+	hash := dao.CalcDocumentListHash(idList)
+	if in.GetHash() != 0 && in.GetHash() == hash {
+		// messages.favedStickersNotModified#9e8fa6d3 = messages.FavedStickers;
+		return mtproto.MakeTLMessagesFavedStickersNotModified(nil).To_Messages_FavedStickers(), nil
+	}
+
+	// messages.favedStickers#2cb51097 hash:long packs:Vector<StickerPack> stickers:Vector<Document> = messages.FavedStickers;
+	return mtproto.MakeTLMessagesFavedStickers(&mtproto.Messages_FavedStickers{
+		Hash:     hash,
+		Packs:    dao.MakeStickerPackList(c.getStickerDocumentDOList(idList)),
+		Stickers: c.svcCtx.Dao.GetSortedDocumentList(c.ctx, idList),
+	}).To_Messages_FavedStickers(), nil
+}
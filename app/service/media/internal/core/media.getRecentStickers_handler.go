@@ -0,0 +1,57 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaGetRecentStickers
+// media.getRecentStickers user_id:long hash:long = messages.RecentStickers;
+func (c *MediaCore) MediaGetRecentStickers(in *media.TLMediaGetRecentStickers) (*mtproto.Messages_RecentStickers, error) {
+	doList, err := c.svcCtx.Dao.UserRecentStickersDAO.SelectList(c.ctx, in.GetUserId(), dao.StickersRecentLimit)
+	if err != nil {
+		c.Logger.Errorf("media.getRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		idList = make([]int64, 0, len(doList))
+		dates  = make(map[int64]int32, len(doList))
+	)
+	for i := range doList {
+		idList = append(idList, doList[i].DocumentId)
+		dates[doList[i].DocumentId] = int32(doList[i].Date2)
+	}
+
+	hash := dao.CalcDocumentListHash(idList)
+	if in.GetHash() != 0 && in.GetHash() == hash {
+		// messages.recentStickersNotModified#b17f890 = messages.RecentStickers;
+		return mtproto.MakeTLMessagesRecentStickersNotModified(nil).To_Messages_RecentStickers(), nil
+	}
+
+	var (
+		stickers = c.svcCtx.Dao.GetSortedDocumentList(c.ctx, idList)
+		dateList = make([]int32, 0, len(stickers))
+	)
+	for _, sticker := range stickers {
+		dateList = append(dateList, dates[sticker.GetId()])
+	}
+
+	// messages.recentStickers#88d37c56 hash:long packs:Vector<StickerPack> stickers:Vector<Document> dates:Vector<int> = messages.RecentStickers;
+	return mtproto.MakeTLMessagesRecentStickers(&mtproto.Messages_RecentStickers{
+		Hash:     hash,
+		Packs:    []*mtproto.StickerPack{},
+		Stickers: stickers,
+		Dates:    dateList,
+	}).To_Messages_RecentStickers(), nil
+}
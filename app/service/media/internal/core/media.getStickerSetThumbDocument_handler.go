@@ -0,0 +1,40 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaGetStickerSetThumbDocument
+// media.getStickerSetThumbDocument stickerset:InputStickerSet = Document;
+func (c *MediaCore) MediaGetStickerSetThumbDocument(in *media.TLMediaGetStickerSetThumbDocument) (*mtproto.Document, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetDOByInput(c.ctx, in.GetStickerset())
+	if err != nil {
+		c.Logger.Errorf("media.getStickerSetThumbDocument - error: %v", err)
+		return nil, err
+	}
+
+	if setDO.ThumbDocumentId == 0 {
+		err = mtproto.ErrStickersetInvalid
+		c.Logger.Errorf("media.getStickerSetThumbDocument - error: %v", err)
+		return nil, err
+	}
+
+	document := c.svcCtx.Dao.GetDocumentById(c.ctx, setDO.ThumbDocumentId)
+	if document.GetPredicateName() != mtproto.Predicate_document {
+		err = mtproto.ErrStickersetInvalid
+		c.Logger.Errorf("media.getStickerSetThumbDocument - error: %v", err)
+		return nil, err
+	}
+
+	return document, nil
+}
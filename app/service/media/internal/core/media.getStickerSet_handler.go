@@ -0,0 +1,28 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaGetStickerSet
+// media.getStickerSet user_id:long stickerset:InputStickerSet = messages.StickerSet;
+func (c *MediaCore) MediaGetStickerSet(in *media.TLMediaGetStickerSet) (*mtproto.Messages_StickerSet, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetDOByInput(c.ctx, in.GetStickerset())
+	if err != nil {
+		return nil, err
+	}
+
+	installed := c.svcCtx.Dao.GetInstalledStickerSetMap(c.ctx, in.GetUserId(), []int64{setDO.StickerSetId})
+
+	return c.svcCtx.Dao.MakeMessagesStickerSet(c.ctx, setDO, installed[setDO.StickerSetId]), nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaGetStickers
+// media.getStickers user_id:long emoticon:string hash:long = messages.Stickers;
+func (c *MediaCore) MediaGetStickers(in *media.TLMediaGetStickers) (*mtproto.Messages_Stickers, error) {
+	var (
+		emoticon     = normalizeEmoticon(in.GetEmoticon())
+		setDOList, _ = c.getInstalledStickerSetList(in.GetUserId(), dao.StickerSetTypeStickers, false)
+		setIdList    = make([]int64, 0, len(setDOList))
+		idList       = make([]int64, 0)
+	)
+
+	if emoticon == "" {
+		return nil, mtproto.ErrEmoticonEmpty
+	}
+
+	for _, setDO := range setDOList {
+		setIdList = append(setIdList, setDO.StickerSetId)
+	}
+
+	// keep the user's set order, then the position inside each set
+	docDOListMap := make(map[int64][]*dataobject.StickerSetDocumentsDO, len(setIdList))
+	c.svcCtx.Dao.StickerSetDocumentsDAO.SelectBySetIdListWithCB(
+		c.ctx,
+		setIdList,
+		func(i int, v *dataobject.StickerSetDocumentsDO) {
+			for _, emoji := range dao.SplitEmoticon(v.Emoticon) {
+				if normalizeEmoticon(emoji) == emoticon {
+					docDOListMap[v.StickerSetId] = append(docDOListMap[v.StickerSetId], v)
+					break
+				}
+			}
+		})
+	for _, setId := range setIdList {
+		for _, v := range docDOListMap[setId] {
+			idList = append(idList, v.DocumentId)
+		}
+	}
+
+	hash := dao.CalcDocumentListHash(idList)
+	if in.GetHash() != 0 && in.GetHash() == hash {
+		// messages.stickersNotModified#f1749a22 = messages.Stickers;
+		return mtproto.MakeTLMessagesStickersNotModified(nil).To_Messages_Stickers(), nil
+	}
+
+	// messages.stickers#30a6ec7e hash:long stickers:Vector<Document> = messages.Stickers;
+	return mtproto.MakeTLMessagesStickers(&mtproto.Messages_Stickers{
+		Hash:     hash,
+		Stickers: c.svcCtx.Dao.GetSortedDocumentList(c.ctx, idList),
+	}).To_Messages_Stickers(), nil
+}
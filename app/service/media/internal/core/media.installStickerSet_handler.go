@@ -0,0 +1,42 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaInstallStickerSet
+// media.installStickerSet flags:# archived:flags.0?true user_id:long stickerset:InputStickerSet = messages.StickerSetInstallResult;
+func (c *MediaCore) MediaInstallStickerSet(in *media.TLMediaInstallStickerSet) (*mtproto.Messages_StickerSetInstallResult, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetDOByInput(c.ctx, in.GetStickerset())
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, err = c.svcCtx.Dao.UserInstalledStickerSetsDAO.InsertOrUpdate(c.ctx, &dataobject.UserInstalledStickerSetsDO{
+		UserId:        in.GetUserId(),
+		StickerSetId:  setDO.StickerSetId,
+		SetType:       setDO.SetType,
+		Archived:      in.GetArchived(),
+		InstalledDate: time.Now().Unix(),
+	})
+	if err != nil {
+		c.Logger.Errorf("media.installStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	// messages.stickerSetInstallResultSuccess#38641628 = messages.StickerSetInstallResult;
+	return mtproto.MakeTLMessagesStickerSetInstallResultSuccess(nil).To_Messages_StickerSetInstallResult(), nil
+}
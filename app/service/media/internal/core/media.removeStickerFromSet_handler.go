@@ -0,0 +1,35 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaRemoveStickerFromSet
+// media.removeStickerFromSet owner_id:long document_id:long = messages.StickerSet;
+func (c *MediaCore) MediaRemoveStickerFromSet(in *media.TLMediaRemoveStickerFromSet) (*mtproto.Messages_StickerSet, error) {
+	setDO, err := c.getOwnedStickerSetDOByDocumentId(in.GetOwnerId(), in.GetDocumentId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.StickerSetDocumentsDAO.Delete(c.ctx, setDO.StickerSetId, in.GetDocumentId()); err != nil {
+		c.Logger.Errorf("media.removeStickerFromSet - error: %v", err)
+		return nil, err
+	}
+	if err = c.svcCtx.Dao.RefreshStickerSetHash(c.ctx, setDO); err != nil {
+		c.Logger.Errorf("media.removeStickerFromSet - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeOwnerStickerSet(setDO), nil
+}
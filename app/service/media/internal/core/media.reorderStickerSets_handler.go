@@ -0,0 +1,29 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaReorderStickerSets
+// media.reorderStickerSets flags:# masks:flags.0?true emojis:flags.1?true user_id:long order:Vector<long> = Bool;
+func (c *MediaCore) MediaReorderStickerSets(in *media.TLMediaReorderStickerSets) (*mtproto.Bool, error) {
+	// order_num starts at 1, sets installed later keep 0 and are listed first
+	for i, id := range in.GetOrder() {
+		if _, err := c.svcCtx.Dao.UserInstalledStickerSetsDAO.UpdateOrderNum(c.ctx, int32(i+1), in.GetUserId(), id); err != nil {
+			c.Logger.Errorf("media.reorderStickerSets - error: %v", err)
+			return nil, err
+		}
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,47 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaSaveRecentSticker
+// media.saveRecentSticker flags:# unsave:flags.0?true user_id:long id:long = Bool;
+func (c *MediaCore) MediaSaveRecentSticker(in *media.TLMediaSaveRecentSticker) (*mtproto.Bool, error) {
+	if in.GetUnsave() {
+		if _, err := c.svcCtx.Dao.UserRecentStickersDAO.Delete(c.ctx, in.GetUserId(), in.GetId()); err != nil {
+			c.Logger.Errorf("media.saveRecentSticker - error: %v", err)
+			return nil, err
+		}
+		return mtproto.BoolTrue, nil
+	}
+
+	if err := c.checkDocumentExists(in.GetId()); err != nil {
+		return nil, err
+	}
+
+	// the list is capped by stickers_recent_limit when read
+	_, _, err := c.svcCtx.Dao.UserRecentStickersDAO.InsertOrUpdate(c.ctx, &dataobject.UserRecentStickersDO{
+		UserId:     in.GetUserId(),
+		DocumentId: in.GetId(),
+		Date2:      time.Now().Unix(),
+	})
+	if err != nil {
+		c.Logger.Errorf("media.saveRecentSticker - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
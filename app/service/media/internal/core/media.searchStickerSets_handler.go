@@ -0,0 +1,62 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+	"github.com/teamgram/teamgram-server/pkg/hashx"
+)
+
+// MediaSearchStickerSets
+// media.searchStickerSets user_id:long q:string hash:long = messages.FoundStickerSets;
+func (c *MediaCore) MediaSearchStickerSets(in *media.TLMediaSearchStickerSets) (*mtproto.Messages_FoundStickerSets, error) {
+	var (
+		q         = strings.TrimSpace(in.GetQ())
+		setDOList []dataobject.StickerSetsDO
+		err       error
+	)
+
+	if q != "" {
+		setDOList, err = c.svcCtx.Dao.StickerSetsDAO.SearchByTitle(c.ctx, "%"+escapeLike(q)+"%", stickerSetSearchLimit)
+		if err != nil {
+			c.Logger.Errorf("media.searchStickerSets - error: %v", err)
+			return nil, err
+		}
+	}
+
+	var (
+		idList = make([]int64, 0, len(setDOList))
+		hash   int64
+	)
+	for i := range setDOList {
+		idList = append(idList, setDOList[i].StickerSetId)
+		hash = hashx.CombineInt64Hash2(hash, int64(uint32(setDOList[i].Hash)))
+	}
+	if in.GetHash() != 0 && in.GetHash() == hash {
+		// messages.foundStickerSetsNotModified#d54b65d = messages.FoundStickerSets;
+		return mtproto.MakeTLMessagesFoundStickerSetsNotModified(nil).To_Messages_FoundStickerSets(), nil
+	}
+
+	installed := c.svcCtx.Dao.GetInstalledStickerSetMap(c.ctx, in.GetUserId(), idList)
+	sets := make([]*mtproto.StickerSetCovered, 0, len(setDOList))
+	for i := range setDOList {
+		sets = append(sets, c.svcCtx.Dao.MakeStickerSetCovered(c.ctx, &setDOList[i], installed[setDOList[i].StickerSetId]))
+	}
+
+	// messages.foundStickerSets#8af09dd2 hash:long sets:Vector<StickerSetCovered> = messages.FoundStickerSets;
+	return mtproto.MakeTLMessagesFoundStickerSets(&mtproto.Messages_FoundStickerSets{
+		Hash: hash,
+		Sets: sets,
+	}).To_Messages_FoundStickerSets(), nil
+}
@@ -0,0 +1,48 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaSetStickerSetThumb
+// media.setStickerSetThumb owner_id:long stickerset:InputStickerSet thumb_document_id:long = messages.StickerSet;
+func (c *MediaCore) MediaSetStickerSetThumb(in *media.TLMediaSetStickerSetThumb) (*mtproto.Messages_StickerSet, error) {
+	setDO, err := c.getOwnedStickerSetDO(in.GetOwnerId(), in.GetStickerset())
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetThumbDocumentId() != 0 {
+		if err = c.checkDocumentExists(in.GetThumbDocumentId()); err != nil {
+			return nil, err
+		}
+	}
+
+	doList, err := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectBySetId(c.ctx, setDO.StickerSetId)
+	if err != nil {
+		c.Logger.Errorf("media.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	// thumb_version is bumped so clients drop the cached inputStickerSetThumb
+	setDO.ThumbDocumentId = in.GetThumbDocumentId()
+	setDO.ThumbVersion += 1
+	setDO.Hash = dao.CalcStickerSetHash(doList, setDO.ThumbDocumentId, setDO.ThumbVersion)
+	if _, err = c.svcCtx.Dao.StickerSetsDAO.UpdateThumb(c.ctx, setDO.ThumbDocumentId, setDO.Hash, setDO.StickerSetId); err != nil {
+		c.Logger.Errorf("media.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeOwnerStickerSet(setDO), nil
+}
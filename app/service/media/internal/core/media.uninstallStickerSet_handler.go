@@ -0,0 +1,31 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MediaUninstallStickerSet
+// media.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+func (c *MediaCore) MediaUninstallStickerSet(in *media.TLMediaUninstallStickerSet) (*mtproto.Bool, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetDOByInput(c.ctx, in.GetStickerset())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.UserInstalledStickerSetsDAO.Delete(c.ctx, in.GetUserId(), setDO.StickerSetId); err != nil {
+		c.Logger.Errorf("media.uninstallStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -11,14 +11,47 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
 	"github.com/teamgram/teamgram-server/app/service/media/media"
 )
 
 // MediaUploadEncryptedFile
 // media.uploadEncryptedFile owner_id:long file:InputEncryptedFile = EncryptedFile;
 func (c *MediaCore) MediaUploadEncryptedFile(in *media.TLMediaUploadEncryptedFile) (*mtproto.EncryptedFile, error) {
-	// TODO: not impl
-	c.Logger.Errorf("media.uploadEncryptedFile blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	file := in.GetFile()
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	switch file.GetPredicateName() {
+	case mtproto.Predicate_inputEncryptedFileUploaded,
+		mtproto.Predicate_inputEncryptedFileBigUploaded:
+		encryptedFile, err := c.svcCtx.Dao.DfsClient.DfsUploadEncryptedFileV2(c.ctx, &dfs.TLDfsUploadEncryptedFileV2{
+			Creator: in.OwnerId,
+			File:    file,
+		})
+		if err != nil {
+			c.Logger.Errorf("media.uploadEncryptedFile - error: %v", err)
+			return nil, err
+		}
+		encryptedFile.KeyFingerprint = file.GetKeyFingerprint()
+
+		if err = c.svcCtx.Dao.SaveEncryptedFile(c.ctx, encryptedFile, file.GetMd5Checksum()); err != nil {
+			c.Logger.Errorf("media.uploadEncryptedFile - error: %v", err)
+			return nil, err
+		}
+
+		return encryptedFile, nil
+	case mtproto.Predicate_inputEncryptedFile:
+		// an already uploaded file, e.g. a forwarded one
+		encryptedFile, err := c.svcCtx.Dao.GetEncryptedFile(c.ctx, file.GetId(), file.GetAccessHash())
+		if err != nil {
+			c.Logger.Errorf("media.uploadEncryptedFile - error: %v", err)
+			return nil, err
+		}
+
+		return encryptedFile, nil
+	case mtproto.Predicate_inputEncryptedFileEmpty:
+		return mtproto.MakeTLEncryptedFileEmpty(nil).To_EncryptedFile(), nil
+	default:
+		c.Logger.Errorf("media.uploadEncryptedFile - error: invalid file (%v)", file)
+		return nil, mtproto.ErrFileEmtpy
+	}
 }
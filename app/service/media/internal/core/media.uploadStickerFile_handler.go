@@ -11,14 +11,51 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
 	"github.com/teamgram/teamgram-server/app/service/media/media"
 )
 
 // MediaUploadStickerFile
 // media.uploadStickerFile flags:# owner_id:long file:InputFile thumb:flags.0?InputFile mime_type:string file_name:string document_attribute_sticker:DocumentAttribute = Document;
 func (c *MediaCore) MediaUploadStickerFile(in *media.TLMediaUploadStickerFile) (*mtproto.Document, error) {
-	// TODO: not impl
-	c.Logger.Errorf("media.uploadStickerFile blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	var (
+		attributes = []*mtproto.DocumentAttribute{
+			mtproto.MakeTLDocumentAttributeFilename(&mtproto.DocumentAttribute{
+				FileName: in.GetFileName(),
+			}).To_DocumentAttribute(),
+		}
+	)
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	switch in.GetMimeType() {
+	case "image/webp", "image/png", "application/x-tgsticker", "video/webm":
+	default:
+		return nil, mtproto.ErrStickerFileInvalid
+	}
+
+	if in.GetDocumentAttributeSticker() != nil {
+		attributes = append(attributes, in.GetDocumentAttributeSticker())
+	}
+
+	// inputMediaUploadedDocument#5b38c6c1 flags:# nosound_video:flags.3?true force_file:flags.4?true file:InputFile thumb:flags.2?InputFile mime_type:string attributes:Vector<DocumentAttribute> stickers:flags.0?Vector<InputDocument> ttl_seconds:flags.1?int = InputMedia;
+	document, err := c.svcCtx.Dao.DfsClient.DfsUploadDocumentFileV2(c.ctx, &dfs.TLDfsUploadDocumentFileV2{
+		Creator: in.GetOwnerId(),
+		Media: mtproto.MakeTLInputMediaUploadedDocument(&mtproto.InputMedia{
+			ForceFile:  true,
+			File:       in.GetFile(),
+			Thumb:      in.GetThumb(),
+			MimeType:   in.GetMimeType(),
+			Attributes: attributes,
+		}).To_InputMedia(),
+	})
+	if err != nil {
+		c.Logger.Errorf("media.uploadStickerFile - error: %v", err)
+		return nil, err
+	}
+
+	if len(document.GetThumbs()) > 0 {
+		c.svcCtx.Dao.SavePhotoSizeV2(c.ctx, document.GetId(), document.GetThumbs())
+	}
+	c.svcCtx.Dao.SaveDocumentV2(c.ctx, in.GetFile().GetName(), document)
+
+	return document, nil
 }
@@ -2,3 +2,9 @@
 ./dalgen.sh photo_sizes
 ./dalgen.sh photos
 ./dalgen.sh video_sizes
+./dalgen.sh encrypted_files
+./dalgen.sh sticker_sets
+./dalgen.sh sticker_set_documents
+./dalgen.sh user_installed_sticker_sets
+./dalgen.sh user_recent_stickers
+./dalgen.sh user_faved_stickers
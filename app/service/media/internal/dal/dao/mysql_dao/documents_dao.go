@@ -273,3 +273,49 @@ func (dao *DocumentsDAO) SelectByIdListWithCB(ctx context.Context, idList []int6
 
 	return
 }
+
+// UpdateAttributes
+// update documents set attributes = :attributes where document_id = :document_id
+// TODO(@benqi): sqlmap
+func (dao *DocumentsDAO) UpdateAttributes(ctx context.Context, attributes string, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update documents set attributes = ? where document_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, attributes, document_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateAttributes(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateAttributes(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateAttributesTx
+// update documents set attributes = :attributes where document_id = :document_id
+// TODO(@benqi): sqlmap
+func (dao *DocumentsDAO) UpdateAttributesTx(tx *sqlx.Tx, attributes string, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update documents set attributes = ? where document_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, attributes, document_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateAttributes(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateAttributes(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,321 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type StickerSetDocumentsDAO struct {
+	db *sqlx.DB
+}
+
+func NewStickerSetDocumentsDAO(db *sqlx.DB) *StickerSetDocumentsDAO {
+	return &StickerSetDocumentsDAO{db}
+}
+
+// InsertOrUpdate
+// insert into sticker_set_documents(sticker_set_id, document_id, emoticon, position) values (:sticker_set_id, :document_id, :emoticon, :position) on duplicate key update emoticon = values(emoticon), position = values(position), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.StickerSetDocumentsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into sticker_set_documents(sticker_set_id, document_id, emoticon, position) values (:sticker_set_id, :document_id, :emoticon, :position) on duplicate key update emoticon = values(emoticon), position = values(position), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into sticker_set_documents(sticker_set_id, document_id, emoticon, position) values (:sticker_set_id, :document_id, :emoticon, :position) on duplicate key update emoticon = values(emoticon), position = values(position), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.StickerSetDocumentsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into sticker_set_documents(sticker_set_id, document_id, emoticon, position) values (:sticker_set_id, :document_id, :emoticon, :position) on duplicate key update emoticon = values(emoticon), position = values(position), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectBySetId
+// select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where sticker_set_id = :sticker_set_id and deleted = 0 order by position asc, id asc
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectBySetId(ctx context.Context, sticker_set_id int64) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where sticker_set_id = ? and deleted = 0 order by position asc, id asc"
+		values []dataobject.StickerSetDocumentsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, sticker_set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBySetId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectBySetIdWithCB
+// select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where sticker_set_id = :sticker_set_id and deleted = 0 order by position asc, id asc
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectBySetIdWithCB(ctx context.Context, sticker_set_id int64, cb func(i int, v *dataobject.StickerSetDocumentsDO)) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where sticker_set_id = ? and deleted = 0 order by position asc, id asc"
+		values []dataobject.StickerSetDocumentsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, sticker_set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBySetId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectBySetIdList
+// select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where sticker_set_id in (:idList) and deleted = 0 order by position asc, id asc
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectBySetIdList(ctx context.Context, idList []int64) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where sticker_set_id in (?) and deleted = 0 order by position asc, id asc"
+		a      []interface{}
+		values []dataobject.StickerSetDocumentsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.StickerSetDocumentsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectBySetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBySetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectBySetIdListWithCB
+// select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where sticker_set_id in (:idList) and deleted = 0 order by position asc, id asc
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectBySetIdListWithCB(ctx context.Context, idList []int64, cb func(i int, v *dataobject.StickerSetDocumentsDO)) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where sticker_set_id in (?) and deleted = 0 order by position asc, id asc"
+		a      []interface{}
+		values []dataobject.StickerSetDocumentsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.StickerSetDocumentsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectBySetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBySetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectByDocumentId
+// select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where document_id = :document_id and deleted = 0 limit 1
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectByDocumentId(ctx context.Context, document_id int64) (rValue *dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query = "select id, sticker_set_id, document_id, emoticon, position from sticker_set_documents where document_id = ? and deleted = 0 limit 1"
+		do    = &dataobject.StickerSetDocumentsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, document_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByDocumentId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// UpdatePosition
+// update sticker_set_documents set position = :position where sticker_set_id = :sticker_set_id and document_id = :document_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) UpdatePosition(ctx context.Context, position int32, sticker_set_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_set_documents set position = ? where sticker_set_id = ? and document_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, position, sticker_set_id, document_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdatePosition(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdatePosition(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdatePositionTx
+// update sticker_set_documents set position = :position where sticker_set_id = :sticker_set_id and document_id = :document_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) UpdatePositionTx(tx *sqlx.Tx, position int32, sticker_set_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_set_documents set position = ? where sticker_set_id = ? and document_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, position, sticker_set_id, document_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdatePosition(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdatePosition(_), error: %v", err)
+	}
+
+	return
+}
+
+// Delete
+// update sticker_set_documents set deleted = 1 where sticker_set_id = :sticker_set_id and document_id = :document_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) Delete(ctx context.Context, sticker_set_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_set_documents set deleted = 1 where sticker_set_id = ? and document_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, sticker_set_id, document_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteTx
+// update sticker_set_documents set deleted = 1 where sticker_set_id = :sticker_set_id and document_id = :document_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) DeleteTx(tx *sqlx.Tx, sticker_set_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_set_documents set deleted = 1 where sticker_set_id = ? and document_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, sticker_set_id, document_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
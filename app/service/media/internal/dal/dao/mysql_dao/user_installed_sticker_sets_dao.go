@@ -0,0 +1,297 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type UserInstalledStickerSetsDAO struct {
+	db *sqlx.DB
+}
+
+func NewUserInstalledStickerSetsDAO(db *sqlx.DB) *UserInstalledStickerSetsDAO {
+	return &UserInstalledStickerSetsDAO{db}
+}
+
+// InsertOrUpdate
+// insert into user_installed_sticker_sets(user_id, sticker_set_id, set_type, order_num, archived, installed_date) values (:user_id, :sticker_set_id, :set_type, 0, :archived, :installed_date) on duplicate key update order_num = 0, archived = values(archived), installed_date = values(installed_date), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.UserInstalledStickerSetsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_installed_sticker_sets(user_id, sticker_set_id, set_type, order_num, archived, installed_date) values (:user_id, :sticker_set_id, :set_type, 0, :archived, :installed_date) on duplicate key update order_num = 0, archived = values(archived), installed_date = values(installed_date), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into user_installed_sticker_sets(user_id, sticker_set_id, set_type, order_num, archived, installed_date) values (:user_id, :sticker_set_id, :set_type, 0, :archived, :installed_date) on duplicate key update order_num = 0, archived = values(archived), installed_date = values(installed_date), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.UserInstalledStickerSetsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_installed_sticker_sets(user_id, sticker_set_id, set_type, order_num, archived, installed_date) values (:user_id, :sticker_set_id, :set_type, 0, :archived, :installed_date) on duplicate key update order_num = 0, archived = values(archived), installed_date = values(installed_date), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectList
+// select id, user_id, sticker_set_id, set_type, order_num, archived, installed_date from user_installed_sticker_sets where user_id = :user_id and set_type = :set_type and archived = :archived and deleted = 0 order by order_num asc, installed_date desc
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectList(ctx context.Context, user_id int64, set_type int32, archived bool) (rList []dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query  = "select id, user_id, sticker_set_id, set_type, order_num, archived, installed_date from user_installed_sticker_sets where user_id = ? and set_type = ? and archived = ? and deleted = 0 order by order_num asc, installed_date desc"
+		values []dataobject.UserInstalledStickerSetsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, set_type, archived)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListWithCB
+// select id, user_id, sticker_set_id, set_type, order_num, archived, installed_date from user_installed_sticker_sets where user_id = :user_id and set_type = :set_type and archived = :archived and deleted = 0 order by order_num asc, installed_date desc
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectListWithCB(ctx context.Context, user_id int64, set_type int32, archived bool, cb func(i int, v *dataobject.UserInstalledStickerSetsDO)) (rList []dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query  = "select id, user_id, sticker_set_id, set_type, order_num, archived, installed_date from user_installed_sticker_sets where user_id = ? and set_type = ? and archived = ? and deleted = 0 order by order_num asc, installed_date desc"
+		values []dataobject.UserInstalledStickerSetsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, set_type, archived)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectBySetIdList
+// select id, user_id, sticker_set_id, set_type, order_num, archived, installed_date from user_installed_sticker_sets where user_id = :user_id and sticker_set_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectBySetIdList(ctx context.Context, user_id int64, idList []int64) (rList []dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query  = "select id, user_id, sticker_set_id, set_type, order_num, archived, installed_date from user_installed_sticker_sets where user_id = ? and sticker_set_id in (?) and deleted = 0"
+		a      []interface{}
+		values []dataobject.UserInstalledStickerSetsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.UserInstalledStickerSetsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectBySetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBySetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectBySetIdListWithCB
+// select id, user_id, sticker_set_id, set_type, order_num, archived, installed_date from user_installed_sticker_sets where user_id = :user_id and sticker_set_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectBySetIdListWithCB(ctx context.Context, user_id int64, idList []int64, cb func(i int, v *dataobject.UserInstalledStickerSetsDO)) (rList []dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query  = "select id, user_id, sticker_set_id, set_type, order_num, archived, installed_date from user_installed_sticker_sets where user_id = ? and sticker_set_id in (?) and deleted = 0"
+		a      []interface{}
+		values []dataobject.UserInstalledStickerSetsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.UserInstalledStickerSetsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectBySetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBySetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateOrderNum
+// update user_installed_sticker_sets set order_num = :order_num where user_id = :user_id and sticker_set_id = :sticker_set_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) UpdateOrderNum(ctx context.Context, order_num int32, user_id int64, sticker_set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_installed_sticker_sets set order_num = ? where user_id = ? and sticker_set_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, order_num, user_id, sticker_set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateOrderNum(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateOrderNum(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateOrderNumTx
+// update user_installed_sticker_sets set order_num = :order_num where user_id = :user_id and sticker_set_id = :sticker_set_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) UpdateOrderNumTx(tx *sqlx.Tx, order_num int32, user_id int64, sticker_set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_installed_sticker_sets set order_num = ? where user_id = ? and sticker_set_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, order_num, user_id, sticker_set_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateOrderNum(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateOrderNum(_), error: %v", err)
+	}
+
+	return
+}
+
+// Delete
+// update user_installed_sticker_sets set deleted = 1 where user_id = :user_id and sticker_set_id = :sticker_set_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) Delete(ctx context.Context, user_id int64, sticker_set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_installed_sticker_sets set deleted = 1 where user_id = ? and sticker_set_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, user_id, sticker_set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteTx
+// update user_installed_sticker_sets set deleted = 1 where user_id = :user_id and sticker_set_id = :sticker_set_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) DeleteTx(tx *sqlx.Tx, user_id int64, sticker_set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_installed_sticker_sets set deleted = 1 where user_id = ? and sticker_set_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, user_id, sticker_set_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
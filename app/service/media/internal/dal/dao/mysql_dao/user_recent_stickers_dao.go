@@ -0,0 +1,225 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type UserRecentStickersDAO struct {
+	db *sqlx.DB
+}
+
+func NewUserRecentStickersDAO(db *sqlx.DB) *UserRecentStickersDAO {
+	return &UserRecentStickersDAO{db}
+}
+
+// InsertOrUpdate
+// insert into user_recent_stickers(user_id, document_id, date2) values (:user_id, :document_id, :date2) on duplicate key update date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserRecentStickersDAO) InsertOrUpdate(ctx context.Context, do *dataobject.UserRecentStickersDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_recent_stickers(user_id, document_id, date2) values (:user_id, :document_id, :date2) on duplicate key update date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into user_recent_stickers(user_id, document_id, date2) values (:user_id, :document_id, :date2) on duplicate key update date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserRecentStickersDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.UserRecentStickersDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_recent_stickers(user_id, document_id, date2) values (:user_id, :document_id, :date2) on duplicate key update date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectList
+// select id, user_id, document_id, date2 from user_recent_stickers where user_id = :user_id and deleted = 0 order by date2 desc, id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *UserRecentStickersDAO) SelectList(ctx context.Context, user_id int64, limit int32) (rList []dataobject.UserRecentStickersDO, err error) {
+	var (
+		query  = "select id, user_id, document_id, date2 from user_recent_stickers where user_id = ? and deleted = 0 order by date2 desc, id desc limit ?"
+		values []dataobject.UserRecentStickersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListWithCB
+// select id, user_id, document_id, date2 from user_recent_stickers where user_id = :user_id and deleted = 0 order by date2 desc, id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *UserRecentStickersDAO) SelectListWithCB(ctx context.Context, user_id int64, limit int32, cb func(i int, v *dataobject.UserRecentStickersDO)) (rList []dataobject.UserRecentStickersDO, err error) {
+	var (
+		query  = "select id, user_id, document_id, date2 from user_recent_stickers where user_id = ? and deleted = 0 order by date2 desc, id desc limit ?"
+		values []dataobject.UserRecentStickersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// Delete
+// update user_recent_stickers set deleted = 1 where user_id = :user_id and document_id = :document_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserRecentStickersDAO) Delete(ctx context.Context, user_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_recent_stickers set deleted = 1 where user_id = ? and document_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, user_id, document_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteTx
+// update user_recent_stickers set deleted = 1 where user_id = :user_id and document_id = :document_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserRecentStickersDAO) DeleteTx(tx *sqlx.Tx, user_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_recent_stickers set deleted = 1 where user_id = ? and document_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, user_id, document_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteAll
+// update user_recent_stickers set deleted = 1 where user_id = :user_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserRecentStickersDAO) DeleteAll(ctx context.Context, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_recent_stickers set deleted = 1 where user_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteAll(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteAll(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteAllTx
+// update user_recent_stickers set deleted = 1 where user_id = :user_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserRecentStickersDAO) DeleteAllTx(tx *sqlx.Tx, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_recent_stickers set deleted = 1 where user_id = ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteAll(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteAll(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,22 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type UserInstalledStickerSetsDO struct {
+	Id            int64 `db:"id"`
+	UserId        int64 `db:"user_id"`
+	StickerSetId  int64 `db:"sticker_set_id"`
+	SetType       int32 `db:"set_type"`
+	OrderNum      int32 `db:"order_num"`
+	Archived      bool  `db:"archived"`
+	InstalledDate int64 `db:"installed_date"`
+	Deleted       bool  `db:"deleted"`
+}
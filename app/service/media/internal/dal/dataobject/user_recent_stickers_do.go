@@ -0,0 +1,19 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type UserRecentStickersDO struct {
+	Id         int64 `db:"id"`
+	UserId     int64 `db:"user_id"`
+	DocumentId int64 `db:"document_id"`
+	Date2      int64 `db:"date2"`
+	Deleted    bool  `db:"deleted"`
+}
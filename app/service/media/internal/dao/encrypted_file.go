@@ -0,0 +1,59 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+)
+
+func (m *Dao) SaveEncryptedFile(ctx context.Context, file *mtproto.EncryptedFile, md5Checksum string) error {
+	_, _, err := m.EncryptedFilesDAO.Insert(ctx, &dataobject.EncryptedFilesDO{
+		EncryptedFileId: file.GetId(),
+		AccessHash:      file.GetAccessHash(),
+		DcId:            file.GetDcId(),
+		FileSize:        file.GetSize2_INT64(),
+		KeyFingerprint:  file.GetKeyFingerprint(),
+		Md5Checksum:     md5Checksum,
+		FilePath:        fmt.Sprintf("%d.dat", file.GetId()),
+	})
+
+	return err
+}
+
+func (m *Dao) GetEncryptedFile(ctx context.Context, id, accessHash int64) (*mtproto.EncryptedFile, error) {
+	do, err := m.EncryptedFilesDAO.SelectByFileLocation(ctx, id, accessHash)
+	if err != nil {
+		return nil, err
+	} else if do == nil {
+		return nil, mtproto.ErrFileIdInvalid
+	}
+
+	return mtproto.MakeTLEncryptedFile(&mtproto.EncryptedFile{
+		Id:             do.EncryptedFileId,
+		AccessHash:     do.AccessHash,
+		Size2_INT32:    int32(do.FileSize),
+		Size2_INT64:    do.FileSize,
+		DcId:           do.DcId,
+		KeyFingerprint: do.KeyFingerprint,
+	}).To_EncryptedFile(), nil
+}
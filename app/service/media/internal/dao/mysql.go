@@ -26,19 +26,31 @@ import (
 type Mysql struct {
 	*sqlx.DB
 	*mysql_dao.DocumentsDAO
+	*mysql_dao.EncryptedFilesDAO
 	*mysql_dao.PhotosDAO
 	*mysql_dao.PhotoSizesDAO
 	*mysql_dao.VideoSizesDAO
+	*mysql_dao.StickerSetsDAO
+	*mysql_dao.StickerSetDocumentsDAO
+	*mysql_dao.UserInstalledStickerSetsDAO
+	*mysql_dao.UserRecentStickersDAO
+	*mysql_dao.UserFavedStickersDAO
 	*sqlx.CommonDAO
 }
 
 func newMysqlDao(db *sqlx.DB) *Mysql {
 	return &Mysql{
-		DB:            db,
-		DocumentsDAO:  mysql_dao.NewDocumentsDAO(db),
-		PhotosDAO:     mysql_dao.NewPhotosDAO(db),
-		PhotoSizesDAO: mysql_dao.NewPhotoSizesDAO(db),
-		VideoSizesDAO: mysql_dao.NewVideoSizesDAO(db),
-		CommonDAO:     sqlx.NewCommonDAO(db),
+		DB:                          db,
+		DocumentsDAO:                mysql_dao.NewDocumentsDAO(db),
+		EncryptedFilesDAO:           mysql_dao.NewEncryptedFilesDAO(db),
+		PhotosDAO:                   mysql_dao.NewPhotosDAO(db),
+		PhotoSizesDAO:               mysql_dao.NewPhotoSizesDAO(db),
+		VideoSizesDAO:               mysql_dao.NewVideoSizesDAO(db),
+		StickerSetsDAO:              mysql_dao.NewStickerSetsDAO(db),
+		StickerSetDocumentsDAO:      mysql_dao.NewStickerSetDocumentsDAO(db),
+		UserInstalledStickerSetsDAO: mysql_dao.NewUserInstalledStickerSetsDAO(db),
+		UserRecentStickersDAO:       mysql_dao.NewUserRecentStickersDAO(db),
+		UserFavedStickersDAO:        mysql_dao.NewUserFavedStickersDAO(db),
+		CommonDAO:                   sqlx.NewCommonDAO(db),
 	}
 }
@@ -0,0 +1,392 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/pkg/hashx"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	StickerSetTypeStickers int32 = 0
+	StickerSetTypeMasks    int32 = 1
+	StickerSetTypeEmojis   int32 = 2
+)
+
+const (
+	// StickersRecentLimit and StickersFavedLimit mirror stickers_recent_limit
+	// and stickers_faved_limit in help.getConfig
+	StickersRecentLimit = 200
+	StickersFavedLimit  = 5
+)
+
+func GetStickerSetType(masks, emojis bool) int32 {
+	switch {
+	case masks:
+		return StickerSetTypeMasks
+	case emojis:
+		return StickerSetTypeEmojis
+	default:
+		return StickerSetTypeStickers
+	}
+}
+
+// GetStickerSetDOByInput
+// resolves inputStickerSetID and inputStickerSetShortName, other constructors are not stored here
+func (m *Dao) GetStickerSetDOByInput(ctx context.Context, stickerSet *mtproto.InputStickerSet) (*dataobject.StickerSetsDO, error) {
+	var (
+		do  *dataobject.StickerSetsDO
+		err error
+	)
+
+	switch stickerSet.GetPredicateName() {
+	case mtproto.Predicate_inputStickerSetID:
+		do, err = m.StickerSetsDAO.SelectBySetId(ctx, stickerSet.GetId())
+		if do != nil && do.AccessHash != stickerSet.GetAccessHash() {
+			do = nil
+		}
+	case mtproto.Predicate_inputStickerSetShortName:
+		do, err = m.StickerSetsDAO.SelectByShortName(ctx, stickerSet.GetShortName())
+	default:
+		return nil, mtproto.ErrStickersetInvalid
+	}
+
+	if err != nil {
+		return nil, err
+	} else if do == nil {
+		return nil, mtproto.ErrStickersetInvalid
+	}
+
+	return do, nil
+}
+
+// GetInstalledStickerSetMap returns the user's install state of the given sets, keyed by set id
+func (m *Dao) GetInstalledStickerSetMap(ctx context.Context, userId int64, idList []int64) map[int64]*dataobject.UserInstalledStickerSetsDO {
+	installed := make(map[int64]*dataobject.UserInstalledStickerSetsDO)
+	if userId == 0 {
+		return installed
+	}
+
+	m.UserInstalledStickerSetsDAO.SelectBySetIdListWithCB(
+		ctx,
+		userId,
+		idList,
+		func(i int, v *dataobject.UserInstalledStickerSetsDO) {
+			installed[v.StickerSetId] = v
+		})
+
+	return installed
+}
+
+// MakeStickerSet
+// stickerSet#2dd14edc flags:# archived:flags.1?true official:flags.2?true masks:flags.3?true animated:flags.5?true videos:flags.6?true emojis:flags.7?true installed_date:flags.0?int id:long access_hash:long title:string short_name:string thumbs:flags.4?Vector<PhotoSize> thumb_dc_id:flags.4?int thumb_version:flags.4?int thumb_document_id:flags.8?long count:int hash:int = StickerSet;
+func (m *Dao) MakeStickerSet(ctx context.Context, do *dataobject.StickerSetsDO, installed *dataobject.UserInstalledStickerSetsDO) *mtproto.StickerSet {
+	set := mtproto.MakeTLStickerSet(&mtproto.StickerSet{
+		Official:   do.Official,
+		Masks:      do.SetType == StickerSetTypeMasks,
+		Animated:   do.Animated,
+		Videos:     do.Videos,
+		Emojis:     do.SetType == StickerSetTypeEmojis,
+		Id:         do.StickerSetId,
+		AccessHash: do.AccessHash,
+		Title:      do.Title,
+		ShortName:  do.ShortName,
+		Count:      do.Count,
+		Hash:       do.Hash,
+	}).To_StickerSet()
+
+	if installed != nil {
+		set.Archived = installed.Archived
+		set.InstalledDate = &types.Int32Value{Value: int32(installed.InstalledDate)}
+	}
+
+	if do.ThumbDocumentId != 0 {
+		thumb := m.GetDocumentById(ctx, do.ThumbDocumentId)
+		if thumb.GetPredicateName() == mtproto.Predicate_document {
+			// the set thumbnail is the whole thumb document, served through inputStickerSetThumb
+			w, h := getDocumentImageSize(thumb)
+			set.Thumbs = []*mtproto.PhotoSize{
+				mtproto.MakeTLPhotoSize(&mtproto.PhotoSize{
+					Type:  "s",
+					W:     w,
+					H:     h,
+					Size2: int32(thumb.GetFixedSize()),
+				}).To_PhotoSize(),
+			}
+			set.ThumbDcId = &types.Int32Value{Value: thumb.GetDcId()}
+			set.ThumbVersion = &types.Int32Value{Value: do.ThumbVersion}
+			if set.Emojis {
+				set.ThumbDocumentId = &types.Int64Value{Value: do.ThumbDocumentId}
+			}
+		}
+	}
+
+	return set
+}
+
+// GetStickerSetDocumentList returns the set's documents in set order
+func (m *Dao) GetStickerSetDocumentList(ctx context.Context, setId int64) ([]dataobject.StickerSetDocumentsDO, []*mtproto.Document) {
+	doList, _ := m.StickerSetDocumentsDAO.SelectBySetId(ctx, setId)
+	if len(doList) == 0 {
+		return doList, []*mtproto.Document{}
+	}
+
+	idList := make([]int64, 0, len(doList))
+	for i := range doList {
+		idList = append(idList, doList[i].DocumentId)
+	}
+
+	return doList, m.GetSortedDocumentList(ctx, idList)
+}
+
+// GetSortedDocumentList is GetDocumentListByIdList keeping the order of idList
+func (m *Dao) GetSortedDocumentList(ctx context.Context, idList []int64) []*mtproto.Document {
+	documents := make(map[int64]*mtproto.Document, len(idList))
+	for _, document := range m.GetDocumentListByIdList(ctx, idList) {
+		documents[document.GetId()] = document
+	}
+
+	documentList := make([]*mtproto.Document, 0, len(idList))
+	for _, id := range idList {
+		if document, ok := documents[id]; ok {
+			documentList = append(documentList, document)
+		}
+	}
+
+	return documentList
+}
+
+// MakeMessagesStickerSet
+// messages.stickerSet#6e153f16 set:StickerSet packs:Vector<StickerPack> keywords:Vector<StickerKeyword> documents:Vector<Document> = messages.StickerSet;
+func (m *Dao) MakeMessagesStickerSet(ctx context.Context, do *dataobject.StickerSetsDO, installed *dataobject.UserInstalledStickerSetsDO) *mtproto.Messages_StickerSet {
+	doList, documents := m.GetStickerSetDocumentList(ctx, do.StickerSetId)
+
+	return mtproto.MakeTLMessagesStickerSet(&mtproto.Messages_StickerSet{
+		Set:       m.MakeStickerSet(ctx, do, installed),
+		Packs:     MakeStickerPackList(doList),
+		Keywords:  []*mtproto.StickerKeyword{},
+		Documents: documents,
+	}).To_Messages_StickerSet()
+}
+
+// MakeStickerSetCovered
+// stickerSetCovered#6410a5d2 set:StickerSet cover:Document = StickerSetCovered;
+func (m *Dao) MakeStickerSetCovered(ctx context.Context, do *dataobject.StickerSetsDO, installed *dataobject.UserInstalledStickerSetsDO) *mtproto.StickerSetCovered {
+	var (
+		coverId = do.ThumbDocumentId
+	)
+
+	if coverId == 0 {
+		doList, _ := m.StickerSetDocumentsDAO.SelectBySetId(ctx, do.StickerSetId)
+		if len(doList) > 0 {
+			coverId = doList[0].DocumentId
+		}
+	}
+
+	if coverId == 0 {
+		// stickerSetMultiCovered#3407e51b set:StickerSet covers:Vector<Document> = StickerSetCovered;
+		return mtproto.MakeTLStickerSetMultiCovered(&mtproto.StickerSetCovered{
+			Set:    m.MakeStickerSet(ctx, do, installed),
+			Covers: []*mtproto.Document{},
+		}).To_StickerSetCovered()
+	}
+
+	return mtproto.MakeTLStickerSetCovered(&mtproto.StickerSetCovered{
+		Set:   m.MakeStickerSet(ctx, do, installed),
+		Cover: m.GetDocumentById(ctx, coverId),
+	}).To_StickerSetCovered()
+}
+
+// RefreshStickerSetHash recomputes count and hash after the set's contents changed
+func (m *Dao) RefreshStickerSetHash(ctx context.Context, do *dataobject.StickerSetsDO) error {
+	doList, err := m.StickerSetDocumentsDAO.SelectBySetId(ctx, do.StickerSetId)
+	if err != nil {
+		return err
+	}
+
+	do.Count = int32(len(doList))
+	do.Hash = CalcStickerSetHash(doList, do.ThumbDocumentId, do.ThumbVersion)
+	_, err = m.StickerSetsDAO.UpdateCount(ctx, do.Count, do.Hash, do.StickerSetId)
+
+	return err
+}
+
+// SetDocumentStickerAttribute points documentAttributeSticker of the document to its set,
+// clients read the owning set of a sent sticker from it.
+func (m *Dao) SetDocumentStickerAttribute(ctx context.Context, documentId int64, alt string, stickerSet *mtproto.InputStickerSet, maskCoords *mtproto.MaskCoords) error {
+	do, err := m.DocumentsDAO.SelectByDocumentId(ctx, documentId)
+	if err != nil {
+		return err
+	} else if do == nil {
+		return mtproto.ErrStickerFileInvalid
+	}
+
+	var (
+		attributes []*mtproto.DocumentAttribute
+		sticker    = mtproto.MakeTLDocumentAttributeSticker(&mtproto.DocumentAttribute{
+			Mask:       maskCoords != nil,
+			Alt:        alt,
+			Stickerset: stickerSet,
+			MaskCoords: maskCoords,
+		}).To_DocumentAttribute()
+	)
+
+	if do.Attributes != "" {
+		if err = jsonx.UnmarshalFromString(do.Attributes, &attributes); err != nil {
+			logx.WithContext(ctx).Errorf("setDocumentStickerAttribute - error: %v", err)
+		}
+	}
+
+	replaced := false
+	for i, attr := range attributes {
+		if attr.GetPredicateName() == mtproto.Predicate_documentAttributeSticker {
+			attributes[i] = sticker
+			replaced = true
+		}
+	}
+	if !replaced {
+		attributes = append(attributes, sticker)
+	}
+
+	aBuf, _ := jsonx.Marshal(attributes)
+	if _, err = m.DocumentsDAO.UpdateAttributes(ctx, hack.String(aBuf), documentId); err != nil {
+		return err
+	}
+
+	return m.CachedConn.DelCache(ctx, genCacheDocumentKey(documentId))
+}
+
+// CalcStickerSetHash
+func CalcStickerSetHash(doList []dataobject.StickerSetDocumentsDO, thumbDocumentId int64, thumbVersion int32) int32 {
+	var (
+		acc int64
+	)
+
+	for i := range doList {
+		acc = hashx.CombineInt64Hash2(acc, doList[i].DocumentId)
+		for _, emoji := range SplitEmoticon(doList[i].Emoticon) {
+			r, _ := utf8.DecodeRuneInString(emoji)
+			acc = hashx.CombineInt64Hash2(acc, int64(r))
+		}
+	}
+	acc = hashx.CombineInt64Hash2(acc, thumbDocumentId)
+	acc = hashx.CombineInt64Hash2(acc, int64(thumbVersion))
+
+	return hashx.HashInt64(acc)
+}
+
+// CalcDocumentListHash is the hash clients send back in messages.getRecentStickers and friends
+func CalcDocumentListHash(idList []int64) int64 {
+	var (
+		acc int64
+	)
+
+	for _, id := range idList {
+		acc = hashx.CombineInt64Hash2(acc, id)
+	}
+
+	return acc
+}
+
+// MakeStickerPackList groups the set's documents by emoticon, keeping set order
+func MakeStickerPackList(doList []dataobject.StickerSetDocumentsDO) []*mtproto.StickerPack {
+	var (
+		packs   = make([]*mtproto.StickerPack, 0)
+		packMap = make(map[string]*mtproto.StickerPack)
+	)
+
+	for i := range doList {
+		for _, emoji := range SplitEmoticon(doList[i].Emoticon) {
+			pack, ok := packMap[emoji]
+			if !ok {
+				pack = mtproto.MakeTLStickerPack(&mtproto.StickerPack{
+					Emoticon:  emoji,
+					Documents: []int64{},
+				}).To_StickerPack()
+				packMap[emoji] = pack
+				packs = append(packs, pack)
+			}
+			pack.Documents = append(pack.Documents, doList[i].DocumentId)
+		}
+	}
+
+	return packs
+}
+
+// SplitEmoticon splits the emoji string of a sticker into single emoji, keeping
+// modifiers, variation selectors and zero width joiner sequences together.
+func SplitEmoticon(s string) []string {
+	var (
+		emojis   []string
+		start    = -1
+		joined   = false
+		regional = 0
+	)
+
+	for i, r := range s {
+		switch {
+		case start < 0:
+		case joined,
+			r == 0x200d,
+			r == 0x20e3,
+			r >= 0xfe00 && r <= 0xfe0f,
+			r >= 0x1f3fb && r <= 0x1f3ff,
+			r >= 0xe0020 && r <= 0xe007f,
+			r >= 0x1f1e6 && r <= 0x1f1ff && regional == 1:
+		default:
+			emojis = append(emojis, s[start:i])
+			start = -1
+		}
+
+		if start < 0 {
+			start = i
+			regional = 0
+		}
+		joined = r == 0x200d
+		if r >= 0x1f1e6 && r <= 0x1f1ff {
+			regional++
+		}
+	}
+	if start >= 0 {
+		emojis = append(emojis, s[start:])
+	}
+
+	return emojis
+}
+
+func getDocumentImageSize(document *mtproto.Document) (int32, int32) {
+	for _, attr := range document.GetAttributes() {
+		switch attr.GetPredicateName() {
+		case mtproto.Predicate_documentAttributeImageSize,
+			mtproto.Predicate_documentAttributeVideo:
+			return attr.GetW(), attr.GetH()
+		}
+	}
+
+	return 100, 100
+}
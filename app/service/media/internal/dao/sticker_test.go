@@ -0,0 +1,88 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/app/service/media/internal/dal/dataobject"
+)
+
+func TestSplitEmoticon(t *testing.T) {
+	for _, c := range []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{"😀", []string{"😀"}},
+		{"😀😃", []string{"😀", "😃"}},
+		{"❤️👍🏽", []string{"❤️", "👍🏽"}},
+		{"👨‍👩‍👧😀", []string{"👨‍👩‍👧", "😀"}},
+		{"🇺🇸🇯🇵", []string{"🇺🇸", "🇯🇵"}},
+		{"1️⃣", []string{"1️⃣"}},
+	} {
+		if got := SplitEmoticon(c.s); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SplitEmoticon(%q) = %q, want %q", c.s, got, c.want)
+		}
+	}
+}
+
+func TestMakeStickerPackList(t *testing.T) {
+	doList := []dataobject.StickerSetDocumentsDO{
+		{DocumentId: 1, Emoticon: "😀😃"},
+		{DocumentId: 2, Emoticon: "😃"},
+		{DocumentId: 3, Emoticon: "❤️"},
+	}
+
+	packs := MakeStickerPackList(doList)
+	if len(packs) != 3 {
+		t.Fatalf("len(packs) = %d, want 3", len(packs))
+	}
+	for i, want := range []struct {
+		emoticon  string
+		documents []int64
+	}{
+		{"😀", []int64{1}},
+		{"😃", []int64{1, 2}},
+		{"❤️", []int64{3}},
+	} {
+		if packs[i].Emoticon != want.emoticon || !reflect.DeepEqual(packs[i].Documents, want.documents) {
+			t.Errorf("packs[%d] = %s %v, want %s %v", i, packs[i].Emoticon, packs[i].Documents, want.emoticon, want.documents)
+		}
+	}
+}
+
+func TestCalcStickerSetHash(t *testing.T) {
+	doList := []dataobject.StickerSetDocumentsDO{
+		{DocumentId: 1, Emoticon: "😀"},
+		{DocumentId: 2, Emoticon: "😃"},
+	}
+
+	hash := CalcStickerSetHash(doList, 0, 0)
+	if hash != CalcStickerSetHash(doList, 0, 0) {
+		t.Fatal("hash is not stable")
+	}
+	if hash == CalcStickerSetHash(doList[:1], 0, 0) {
+		t.Error("removing a sticker kept the hash")
+	}
+	if hash == CalcStickerSetHash(doList, 1, 1) {
+		t.Error("changing the thumb kept the hash")
+	}
+}
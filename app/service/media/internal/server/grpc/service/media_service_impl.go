@@ -242,3 +242,303 @@ func (s *Service) MediaUploadRingtoneFile(ctx context.Context, request *media.TL
 	c.Logger.Debugf("media.uploadRingtoneFile - reply: %s", r.DebugString())
 	return r, err
 }
+
+// MediaCreateStickerSet
+// media.createStickerSet flags:# masks:flags.0?true animated:flags.1?true videos:flags.4?true emojis:flags.5?true owner_id:long title:string short_name:string thumb_document_id:long stickers:Vector<InputStickerSetItem> = messages.StickerSet;
+func (s *Service) MediaCreateStickerSet(ctx context.Context, request *media.TLMediaCreateStickerSet) (*mtproto.Messages_StickerSet, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.createStickerSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaCreateStickerSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.createStickerSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaAddStickerToSet
+// media.addStickerToSet owner_id:long stickerset:InputStickerSet sticker:InputStickerSetItem = messages.StickerSet;
+func (s *Service) MediaAddStickerToSet(ctx context.Context, request *media.TLMediaAddStickerToSet) (*mtproto.Messages_StickerSet, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.addStickerToSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaAddStickerToSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.addStickerToSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaRemoveStickerFromSet
+// media.removeStickerFromSet owner_id:long document_id:long = messages.StickerSet;
+func (s *Service) MediaRemoveStickerFromSet(ctx context.Context, request *media.TLMediaRemoveStickerFromSet) (*mtproto.Messages_StickerSet, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.removeStickerFromSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaRemoveStickerFromSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.removeStickerFromSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaChangeStickerPosition
+// media.changeStickerPosition owner_id:long document_id:long position:int = messages.StickerSet;
+func (s *Service) MediaChangeStickerPosition(ctx context.Context, request *media.TLMediaChangeStickerPosition) (*mtproto.Messages_StickerSet, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.changeStickerPosition - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaChangeStickerPosition(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.changeStickerPosition - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaSetStickerSetThumb
+// media.setStickerSetThumb owner_id:long stickerset:InputStickerSet thumb_document_id:long = messages.StickerSet;
+func (s *Service) MediaSetStickerSetThumb(ctx context.Context, request *media.TLMediaSetStickerSetThumb) (*mtproto.Messages_StickerSet, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.setStickerSetThumb - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaSetStickerSetThumb(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.setStickerSetThumb - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaCheckStickerSetShortName
+// media.checkStickerSetShortName short_name:string = Bool;
+func (s *Service) MediaCheckStickerSetShortName(ctx context.Context, request *media.TLMediaCheckStickerSetShortName) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.checkStickerSetShortName - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaCheckStickerSetShortName(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.checkStickerSetShortName - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaGetStickerSet
+// media.getStickerSet user_id:long stickerset:InputStickerSet = messages.StickerSet;
+func (s *Service) MediaGetStickerSet(ctx context.Context, request *media.TLMediaGetStickerSet) (*mtproto.Messages_StickerSet, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.getStickerSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaGetStickerSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.getStickerSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaInstallStickerSet
+// media.installStickerSet flags:# archived:flags.0?true user_id:long stickerset:InputStickerSet = messages.StickerSetInstallResult;
+func (s *Service) MediaInstallStickerSet(ctx context.Context, request *media.TLMediaInstallStickerSet) (*mtproto.Messages_StickerSetInstallResult, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.installStickerSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaInstallStickerSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.installStickerSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaUninstallStickerSet
+// media.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+func (s *Service) MediaUninstallStickerSet(ctx context.Context, request *media.TLMediaUninstallStickerSet) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.uninstallStickerSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaUninstallStickerSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.uninstallStickerSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaReorderStickerSets
+// media.reorderStickerSets flags:# masks:flags.0?true emojis:flags.1?true user_id:long order:Vector<long> = Bool;
+func (s *Service) MediaReorderStickerSets(ctx context.Context, request *media.TLMediaReorderStickerSets) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.reorderStickerSets - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaReorderStickerSets(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.reorderStickerSets - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaGetAllStickers
+// media.getAllStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long hash:long = messages.AllStickers;
+func (s *Service) MediaGetAllStickers(ctx context.Context, request *media.TLMediaGetAllStickers) (*mtproto.Messages_AllStickers, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.getAllStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaGetAllStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.getAllStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaGetArchivedStickers
+// media.getArchivedStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long offset_id:long limit:int = messages.ArchivedStickers;
+func (s *Service) MediaGetArchivedStickers(ctx context.Context, request *media.TLMediaGetArchivedStickers) (*mtproto.Messages_ArchivedStickers, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.getArchivedStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaGetArchivedStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.getArchivedStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaSearchStickerSets
+// media.searchStickerSets user_id:long q:string hash:long = messages.FoundStickerSets;
+func (s *Service) MediaSearchStickerSets(ctx context.Context, request *media.TLMediaSearchStickerSets) (*mtproto.Messages_FoundStickerSets, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.searchStickerSets - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaSearchStickerSets(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.searchStickerSets - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaGetStickers
+// media.getStickers user_id:long emoticon:string hash:long = messages.Stickers;
+func (s *Service) MediaGetStickers(ctx context.Context, request *media.TLMediaGetStickers) (*mtproto.Messages_Stickers, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.getStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaGetStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.getStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaGetRecentStickers
+// media.getRecentStickers user_id:long hash:long = messages.RecentStickers;
+func (s *Service) MediaGetRecentStickers(ctx context.Context, request *media.TLMediaGetRecentStickers) (*mtproto.Messages_RecentStickers, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.getRecentStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaGetRecentStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.getRecentStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaSaveRecentSticker
+// media.saveRecentSticker flags:# unsave:flags.0?true user_id:long id:long = Bool;
+func (s *Service) MediaSaveRecentSticker(ctx context.Context, request *media.TLMediaSaveRecentSticker) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.saveRecentSticker - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaSaveRecentSticker(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.saveRecentSticker - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaClearRecentStickers
+// media.clearRecentStickers user_id:long = Bool;
+func (s *Service) MediaClearRecentStickers(ctx context.Context, request *media.TLMediaClearRecentStickers) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.clearRecentStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaClearRecentStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.clearRecentStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaGetFavedStickers
+// media.getFavedStickers user_id:long hash:long = messages.FavedStickers;
+func (s *Service) MediaGetFavedStickers(ctx context.Context, request *media.TLMediaGetFavedStickers) (*mtproto.Messages_FavedStickers, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.getFavedStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaGetFavedStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.getFavedStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaFaveSticker
+// media.faveSticker flags:# unfave:flags.0?true user_id:long id:long = Bool;
+func (s *Service) MediaFaveSticker(ctx context.Context, request *media.TLMediaFaveSticker) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.faveSticker - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaFaveSticker(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.faveSticker - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MediaGetStickerSetThumbDocument
+// media.getStickerSetThumbDocument stickerset:InputStickerSet = Document;
+func (s *Service) MediaGetStickerSetThumbDocument(ctx context.Context, request *media.TLMediaGetStickerSetThumbDocument) (*mtproto.Document, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Logger.Debugf("media.getStickerSetThumbDocument - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MediaGetStickerSetThumbDocument(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Debugf("media.getStickerSetThumbDocument - reply: %s", r.DebugString())
+	return r, err
+}
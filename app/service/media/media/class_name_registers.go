@@ -11,23 +11,43 @@
 package media
 
 const (
-	Predicate_photoSizeList                = "photoSizeList"
-	Predicate_videoSizeList                = "videoSizeList"
-	Predicate_media_uploadPhotoFile        = "media_uploadPhotoFile"
-	Predicate_media_uploadProfilePhotoFile = "media_uploadProfilePhotoFile"
-	Predicate_media_getPhoto               = "media_getPhoto"
-	Predicate_media_getPhotoSizeList       = "media_getPhotoSizeList"
-	Predicate_media_getPhotoSizeListList   = "media_getPhotoSizeListList"
-	Predicate_media_getVideoSizeList       = "media_getVideoSizeList"
-	Predicate_media_uploadedDocumentMedia  = "media_uploadedDocumentMedia"
-	Predicate_media_getDocument            = "media_getDocument"
-	Predicate_media_getDocumentList        = "media_getDocumentList"
-	Predicate_media_uploadEncryptedFile    = "media_uploadEncryptedFile"
-	Predicate_media_getEncryptedFile       = "media_getEncryptedFile"
-	Predicate_media_uploadWallPaperFile    = "media_uploadWallPaperFile"
-	Predicate_media_uploadThemeFile        = "media_uploadThemeFile"
-	Predicate_media_uploadStickerFile      = "media_uploadStickerFile"
-	Predicate_media_uploadRingtoneFile     = "media_uploadRingtoneFile"
+	Predicate_photoSizeList                    = "photoSizeList"
+	Predicate_videoSizeList                    = "videoSizeList"
+	Predicate_media_uploadPhotoFile            = "media_uploadPhotoFile"
+	Predicate_media_uploadProfilePhotoFile     = "media_uploadProfilePhotoFile"
+	Predicate_media_getPhoto                   = "media_getPhoto"
+	Predicate_media_getPhotoSizeList           = "media_getPhotoSizeList"
+	Predicate_media_getPhotoSizeListList       = "media_getPhotoSizeListList"
+	Predicate_media_getVideoSizeList           = "media_getVideoSizeList"
+	Predicate_media_uploadedDocumentMedia      = "media_uploadedDocumentMedia"
+	Predicate_media_getDocument                = "media_getDocument"
+	Predicate_media_getDocumentList            = "media_getDocumentList"
+	Predicate_media_uploadEncryptedFile        = "media_uploadEncryptedFile"
+	Predicate_media_getEncryptedFile           = "media_getEncryptedFile"
+	Predicate_media_uploadWallPaperFile        = "media_uploadWallPaperFile"
+	Predicate_media_uploadThemeFile            = "media_uploadThemeFile"
+	Predicate_media_uploadStickerFile          = "media_uploadStickerFile"
+	Predicate_media_uploadRingtoneFile         = "media_uploadRingtoneFile"
+	Predicate_media_createStickerSet           = "media_createStickerSet"
+	Predicate_media_addStickerToSet            = "media_addStickerToSet"
+	Predicate_media_removeStickerFromSet       = "media_removeStickerFromSet"
+	Predicate_media_changeStickerPosition      = "media_changeStickerPosition"
+	Predicate_media_setStickerSetThumb         = "media_setStickerSetThumb"
+	Predicate_media_checkStickerSetShortName   = "media_checkStickerSetShortName"
+	Predicate_media_getStickerSet              = "media_getStickerSet"
+	Predicate_media_installStickerSet          = "media_installStickerSet"
+	Predicate_media_uninstallStickerSet        = "media_uninstallStickerSet"
+	Predicate_media_reorderStickerSets         = "media_reorderStickerSets"
+	Predicate_media_getAllStickers             = "media_getAllStickers"
+	Predicate_media_getArchivedStickers        = "media_getArchivedStickers"
+	Predicate_media_searchStickerSets          = "media_searchStickerSets"
+	Predicate_media_getStickers                = "media_getStickers"
+	Predicate_media_getRecentStickers          = "media_getRecentStickers"
+	Predicate_media_saveRecentSticker          = "media_saveRecentSticker"
+	Predicate_media_clearRecentStickers        = "media_clearRecentStickers"
+	Predicate_media_getFavedStickers           = "media_getFavedStickers"
+	Predicate_media_faveSticker                = "media_faveSticker"
+	Predicate_media_getStickerSetThumbDocument = "media_getStickerSetThumbDocument"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -99,26 +119,126 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: 1035645449, // 0x3dbab209
 
 	},
+	Predicate_media_createStickerSet: {
+		0: -1813078409, // 0x93ee9e77
+
+	},
+	Predicate_media_addStickerToSet: {
+		0: 1842210741, // 0x6dcde7b5
+
+	},
+	Predicate_media_removeStickerFromSet: {
+		0: -931118961, // 0xc880408f
+
+	},
+	Predicate_media_changeStickerPosition: {
+		0: -1906424434, // 0x8e5e458e
+
+	},
+	Predicate_media_setStickerSetThumb: {
+		0: 293107063, // 0x11787577
+
+	},
+	Predicate_media_checkStickerSetShortName: {
+		0: -1684692206, // 0x9b95a312
+
+	},
+	Predicate_media_getStickerSet: {
+		0: -1891026737, // 0x8f4938cf
+
+	},
+	Predicate_media_installStickerSet: {
+		0: 1874573895, // 0x6fbbba47
+
+	},
+	Predicate_media_uninstallStickerSet: {
+		0: -1049715323, // 0xc16e9d85
+
+	},
+	Predicate_media_reorderStickerSets: {
+		0: 75136250, // 0x47a7cfa
+
+	},
+	Predicate_media_getAllStickers: {
+		0: 45609263, // 0x2b7f12f
+
+	},
+	Predicate_media_getArchivedStickers: {
+		0: -613484563, // 0xdb6ef7ed
+
+	},
+	Predicate_media_searchStickerSets: {
+		0: -1378646853, // 0xadd384bb
+
+	},
+	Predicate_media_getStickers: {
+		0: -1246734731, // 0xb5b05675
+
+	},
+	Predicate_media_getRecentStickers: {
+		0: -2082676446, // 0x83dce122
+
+	},
+	Predicate_media_saveRecentSticker: {
+		0: 1583392571, // 0x5e60a73b
+
+	},
+	Predicate_media_clearRecentStickers: {
+		0: -1497607170, // 0xa6bc53fe
+
+	},
+	Predicate_media_getFavedStickers: {
+		0: -542208326, // 0xdfae8eba
+
+	},
+	Predicate_media_faveSticker: {
+		0: 1407847014, // 0x53ea0a66
+
+	},
+	Predicate_media_getStickerSetThumbDocument: {
+		0: 1093072846, // 0x4126f7ce
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
-	108083635:   Predicate_photoSizeList,                // 0x67139b3
-	953261042:   Predicate_videoSizeList,                // 0x38d19bf2
-	1009453847:  Predicate_media_uploadPhotoFile,        // 0x3c2b0b17
-	-1757466844: Predicate_media_uploadProfilePhotoFile, // 0x973f2f24
-	1702803563:  Predicate_media_getPhoto,               // 0x657eb86b
-	-1578401979: Predicate_media_getPhotoSizeList,       // 0xa1eb7f45
-	-77823776:   Predicate_media_getPhotoSizeListList,   // 0xfb5c80e0
-	-998862102:  Predicate_media_getVideoSizeList,       // 0xc47692ea
-	1331671148:  Predicate_media_uploadedDocumentMedia,  // 0x4f5fb06c
-	1072011085:  Predicate_media_getDocument,            // 0x3fe5974d
-	-986721681:  Predicate_media_getDocumentList,        // 0xc52fd26f
-	-1426012517: Predicate_media_uploadEncryptedFile,    // 0xab00c69b
-	-60784431:   Predicate_media_getEncryptedFile,       // 0xfc6080d1
-	-1661293058: Predicate_media_uploadWallPaperFile,    // 0x9cfaadfe
-	1122416736:  Predicate_media_uploadThemeFile,        // 0x42e6b860
-	-1397349139: Predicate_media_uploadStickerFile,      // 0xacb624ed
-	1035645449:  Predicate_media_uploadRingtoneFile,     // 0x3dbab209
+	108083635:   Predicate_photoSizeList,                    // 0x67139b3
+	953261042:   Predicate_videoSizeList,                    // 0x38d19bf2
+	1009453847:  Predicate_media_uploadPhotoFile,            // 0x3c2b0b17
+	-1757466844: Predicate_media_uploadProfilePhotoFile,     // 0x973f2f24
+	1702803563:  Predicate_media_getPhoto,                   // 0x657eb86b
+	-1578401979: Predicate_media_getPhotoSizeList,           // 0xa1eb7f45
+	-77823776:   Predicate_media_getPhotoSizeListList,       // 0xfb5c80e0
+	-998862102:  Predicate_media_getVideoSizeList,           // 0xc47692ea
+	1331671148:  Predicate_media_uploadedDocumentMedia,      // 0x4f5fb06c
+	1072011085:  Predicate_media_getDocument,                // 0x3fe5974d
+	-986721681:  Predicate_media_getDocumentList,            // 0xc52fd26f
+	-1426012517: Predicate_media_uploadEncryptedFile,        // 0xab00c69b
+	-60784431:   Predicate_media_getEncryptedFile,           // 0xfc6080d1
+	-1661293058: Predicate_media_uploadWallPaperFile,        // 0x9cfaadfe
+	1122416736:  Predicate_media_uploadThemeFile,            // 0x42e6b860
+	-1397349139: Predicate_media_uploadStickerFile,          // 0xacb624ed
+	1035645449:  Predicate_media_uploadRingtoneFile,         // 0x3dbab209
+	-1813078409: Predicate_media_createStickerSet,           // 0x93ee9e77
+	1842210741:  Predicate_media_addStickerToSet,            // 0x6dcde7b5
+	-931118961:  Predicate_media_removeStickerFromSet,       // 0xc880408f
+	-1906424434: Predicate_media_changeStickerPosition,      // 0x8e5e458e
+	293107063:   Predicate_media_setStickerSetThumb,         // 0x11787577
+	-1684692206: Predicate_media_checkStickerSetShortName,   // 0x9b95a312
+	-1891026737: Predicate_media_getStickerSet,              // 0x8f4938cf
+	1874573895:  Predicate_media_installStickerSet,          // 0x6fbbba47
+	-1049715323: Predicate_media_uninstallStickerSet,        // 0xc16e9d85
+	75136250:    Predicate_media_reorderStickerSets,         // 0x47a7cfa
+	45609263:    Predicate_media_getAllStickers,             // 0x2b7f12f
+	-613484563:  Predicate_media_getArchivedStickers,        // 0xdb6ef7ed
+	-1378646853: Predicate_media_searchStickerSets,          // 0xadd384bb
+	-1246734731: Predicate_media_getStickers,                // 0xb5b05675
+	-2082676446: Predicate_media_getRecentStickers,          // 0x83dce122
+	1583392571:  Predicate_media_saveRecentSticker,          // 0x5e60a73b
+	-1497607170: Predicate_media_clearRecentStickers,        // 0xa6bc53fe
+	-542208326:  Predicate_media_getFavedStickers,           // 0xdfae8eba
+	1407847014:  Predicate_media_faveSticker,                // 0x53ea0a66
+	1093072846:  Predicate_media_getStickerSetThumbDocument, // 0x4126f7ce
 
 }
 
@@ -117,6 +117,106 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: 1035645449,
 		}
 	},
+	-1813078409: func() mtproto.TLObject { // 0x93ee9e77
+		return &TLMediaCreateStickerSet{
+			Constructor: -1813078409,
+		}
+	},
+	1842210741: func() mtproto.TLObject { // 0x6dcde7b5
+		return &TLMediaAddStickerToSet{
+			Constructor: 1842210741,
+		}
+	},
+	-931118961: func() mtproto.TLObject { // 0xc880408f
+		return &TLMediaRemoveStickerFromSet{
+			Constructor: -931118961,
+		}
+	},
+	-1906424434: func() mtproto.TLObject { // 0x8e5e458e
+		return &TLMediaChangeStickerPosition{
+			Constructor: -1906424434,
+		}
+	},
+	293107063: func() mtproto.TLObject { // 0x11787577
+		return &TLMediaSetStickerSetThumb{
+			Constructor: 293107063,
+		}
+	},
+	-1684692206: func() mtproto.TLObject { // 0x9b95a312
+		return &TLMediaCheckStickerSetShortName{
+			Constructor: -1684692206,
+		}
+	},
+	-1891026737: func() mtproto.TLObject { // 0x8f4938cf
+		return &TLMediaGetStickerSet{
+			Constructor: -1891026737,
+		}
+	},
+	1874573895: func() mtproto.TLObject { // 0x6fbbba47
+		return &TLMediaInstallStickerSet{
+			Constructor: 1874573895,
+		}
+	},
+	-1049715323: func() mtproto.TLObject { // 0xc16e9d85
+		return &TLMediaUninstallStickerSet{
+			Constructor: -1049715323,
+		}
+	},
+	75136250: func() mtproto.TLObject { // 0x47a7cfa
+		return &TLMediaReorderStickerSets{
+			Constructor: 75136250,
+		}
+	},
+	45609263: func() mtproto.TLObject { // 0x2b7f12f
+		return &TLMediaGetAllStickers{
+			Constructor: 45609263,
+		}
+	},
+	-613484563: func() mtproto.TLObject { // 0xdb6ef7ed
+		return &TLMediaGetArchivedStickers{
+			Constructor: -613484563,
+		}
+	},
+	-1378646853: func() mtproto.TLObject { // 0xadd384bb
+		return &TLMediaSearchStickerSets{
+			Constructor: -1378646853,
+		}
+	},
+	-1246734731: func() mtproto.TLObject { // 0xb5b05675
+		return &TLMediaGetStickers{
+			Constructor: -1246734731,
+		}
+	},
+	-2082676446: func() mtproto.TLObject { // 0x83dce122
+		return &TLMediaGetRecentStickers{
+			Constructor: -2082676446,
+		}
+	},
+	1583392571: func() mtproto.TLObject { // 0x5e60a73b
+		return &TLMediaSaveRecentSticker{
+			Constructor: 1583392571,
+		}
+	},
+	-1497607170: func() mtproto.TLObject { // 0xa6bc53fe
+		return &TLMediaClearRecentStickers{
+			Constructor: -1497607170,
+		}
+	},
+	-542208326: func() mtproto.TLObject { // 0xdfae8eba
+		return &TLMediaGetFavedStickers{
+			Constructor: -542208326,
+		}
+	},
+	1407847014: func() mtproto.TLObject { // 0x53ea0a66
+		return &TLMediaFaveSticker{
+			Constructor: 1407847014,
+		}
+	},
+	1093072846: func() mtproto.TLObject { // 0x4126f7ce
+		return &TLMediaGetStickerSetThumbDocument{
+			Constructor: 1093072846,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -468,6 +568,58 @@ func (m *TLVideoSizeList) DebugString() string {
 	return dbgString
 }
 
+// TLMediaGetStickerSetThumbDocument
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaGetStickerSetThumbDocument) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_getStickerSetThumbDocument))
+
+	switch uint32(m.Constructor) {
+	case 0x4126f7ce:
+		// media.getStickerSetThumbDocument stickerset:InputStickerSet = Document;
+		x.UInt(0x4126f7ce)
+
+		// no flags
+
+		x.Bytes(m.GetStickerset().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaGetStickerSetThumbDocument) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaGetStickerSetThumbDocument) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x4126f7ce:
+		// media.getStickerSetThumbDocument stickerset:InputStickerSet = Document;
+
+		// not has flags
+
+		m2 := &mtproto.InputStickerSet{}
+		m2.Decode(dBuf)
+		m.Stickerset = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaGetStickerSetThumbDocument) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 //----------------------------------------------------------------------------------------------------------------
 // TLMediaUploadPhotoFile
 ///////////////////////////////////////////////////////////////////////////////
@@ -1372,44 +1524,1197 @@ func (m *TLMediaUploadRingtoneFile) DebugString() string {
 	return dbgString
 }
 
-//----------------------------------------------------------------------------------------------------------------
-// Vector_PhotoSizeList
+// TLMediaCreateStickerSet
 ///////////////////////////////////////////////////////////////////////////////
-func (m *Vector_PhotoSizeList) Encode(layer int32) []byte {
+
+func (m *TLMediaCreateStickerSet) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
-	x.Int(int32(mtproto.CRC32_vector))
-	x.Int(int32(len(m.Datas)))
-	for _, v := range m.Datas {
-		x.Bytes((*v).Encode(layer))
+	// x.Int(int32(CRC32_media_createStickerSet))
+
+	switch uint32(m.Constructor) {
+	case 0x93ee9e77:
+		// media.createStickerSet flags:# masks:flags.0?true animated:flags.1?true videos:flags.4?true emojis:flags.5?true owner_id:long title:string short_name:string thumb_document_id:long stickers:Vector<InputStickerSetItem> = messages.StickerSet;
+		x.UInt(0x93ee9e77)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetMasks() == true {
+			flags |= 1 << 0
+		}
+		if m.GetAnimated() == true {
+			flags |= 1 << 1
+		}
+		if m.GetVideos() == true {
+			flags |= 1 << 4
+		}
+		if m.GetEmojis() == true {
+			flags |= 1 << 5
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetOwnerId())
+		x.String(m.GetTitle())
+		x.String(m.GetShortName())
+		x.Long(m.GetThumbDocumentId())
+
+		x.Int(int32(mtproto.CRC32_vector))
+		x.Int(int32(len(m.GetStickers())))
+		for _, v := range m.GetStickers() {
+			x.Bytes((*v).Encode(layer))
+		}
+
+	default:
+		// log.Errorf("")
 	}
 
 	return x.GetBuf()
 }
 
-func (m *Vector_PhotoSizeList) Decode(dBuf *mtproto.DecodeBuf) error {
-	dBuf.Int() // TODO(@benqi): Check crc32 invalid
-	l1 := dBuf.Int()
-	m.Datas = make([]*PhotoSizeList, l1)
-	for i := int32(0); i < l1; i++ {
-		m.Datas[i] = new(PhotoSizeList)
-		(*m.Datas[i]).Decode(dBuf)
+func (m *TLMediaCreateStickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaCreateStickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x93ee9e77:
+		// media.createStickerSet flags:# masks:flags.0?true animated:flags.1?true videos:flags.4?true emojis:flags.5?true owner_id:long title:string short_name:string thumb_document_id:long stickers:Vector<InputStickerSetItem> = messages.StickerSet;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.Masks = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.Animated = true
+		}
+		if (flags & (1 << 4)) != 0 {
+			m.Videos = true
+		}
+		if (flags & (1 << 5)) != 0 {
+			m.Emojis = true
+		}
+		m.OwnerId = dBuf.Long()
+		m.Title = dBuf.String()
+		m.ShortName = dBuf.String()
+		m.ThumbDocumentId = dBuf.Long()
+		c11 := dBuf.Int()
+		if c11 != int32(mtproto.CRC32_vector) {
+			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 11, c11)
+			return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 11, c11)
+		}
+		l11 := dBuf.Int()
+		v11 := make([]*mtproto.InputStickerSetItem, l11)
+		for i := int32(0); i < l11; i++ {
+			v11[i] = &mtproto.InputStickerSetItem{}
+			v11[i].Decode(dBuf)
+		}
+		m.Stickers = v11
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaCreateStickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaAddStickerToSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaAddStickerToSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_addStickerToSet))
+
+	switch uint32(m.Constructor) {
+	case 0x6dcde7b5:
+		// media.addStickerToSet owner_id:long stickerset:InputStickerSet sticker:InputStickerSetItem = messages.StickerSet;
+		x.UInt(0x6dcde7b5)
+
+		// no flags
+
+		x.Long(m.GetOwnerId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+		x.Bytes(m.GetSticker().Encode(layer))
+
+	default:
+		// log.Errorf("")
 	}
 
+	return x.GetBuf()
+}
+
+func (m *TLMediaAddStickerToSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaAddStickerToSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6dcde7b5:
+		// media.addStickerToSet owner_id:long stickerset:InputStickerSet sticker:InputStickerSetItem = messages.StickerSet;
+
+		// not has flags
+
+		m.OwnerId = dBuf.Long()
+
+		m3 := &mtproto.InputStickerSet{}
+		m3.Decode(dBuf)
+		m.Stickerset = m3
+
+		m4 := &mtproto.InputStickerSetItem{}
+		m4.Decode(dBuf)
+		m.Sticker = m4
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
 	return dBuf.GetError()
 }
 
-func (m *Vector_PhotoSizeList) CalcByteSize(layer int32) int {
+func (m *TLMediaAddStickerToSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaRemoveStickerFromSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaRemoveStickerFromSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_removeStickerFromSet))
+
+	switch uint32(m.Constructor) {
+	case 0xc880408f:
+		// media.removeStickerFromSet owner_id:long document_id:long = messages.StickerSet;
+		x.UInt(0xc880408f)
+
+		// no flags
+
+		x.Long(m.GetOwnerId())
+		x.Long(m.GetDocumentId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaRemoveStickerFromSet) CalcByteSize(layer int32) int {
 	return 0
 }
 
-func (m *Vector_PhotoSizeList) DebugString() string {
+func (m *TLMediaRemoveStickerFromSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc880408f:
+		// media.removeStickerFromSet owner_id:long document_id:long = messages.StickerSet;
+
+		// not has flags
+
+		m.OwnerId = dBuf.Long()
+		m.DocumentId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaRemoveStickerFromSet) DebugString() string {
 	jsonm := &jsonpb.Marshaler{OrigName: true}
 	dbgString, _ := jsonm.MarshalToString(m)
 	return dbgString
 }
 
-// Vector_Document
+// TLMediaChangeStickerPosition
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaChangeStickerPosition) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_changeStickerPosition))
+
+	switch uint32(m.Constructor) {
+	case 0x8e5e458e:
+		// media.changeStickerPosition owner_id:long document_id:long position:int = messages.StickerSet;
+		x.UInt(0x8e5e458e)
+
+		// no flags
+
+		x.Long(m.GetOwnerId())
+		x.Long(m.GetDocumentId())
+		x.Int(m.GetPosition())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaChangeStickerPosition) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaChangeStickerPosition) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8e5e458e:
+		// media.changeStickerPosition owner_id:long document_id:long position:int = messages.StickerSet;
+
+		// not has flags
+
+		m.OwnerId = dBuf.Long()
+		m.DocumentId = dBuf.Long()
+		m.Position = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaChangeStickerPosition) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaSetStickerSetThumb
 ///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaSetStickerSetThumb) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_setStickerSetThumb))
+
+	switch uint32(m.Constructor) {
+	case 0x11787577:
+		// media.setStickerSetThumb owner_id:long stickerset:InputStickerSet thumb_document_id:long = messages.StickerSet;
+		x.UInt(0x11787577)
+
+		// no flags
+
+		x.Long(m.GetOwnerId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+		x.Long(m.GetThumbDocumentId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaSetStickerSetThumb) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaSetStickerSetThumb) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x11787577:
+		// media.setStickerSetThumb owner_id:long stickerset:InputStickerSet thumb_document_id:long = messages.StickerSet;
+
+		// not has flags
+
+		m.OwnerId = dBuf.Long()
+
+		m3 := &mtproto.InputStickerSet{}
+		m3.Decode(dBuf)
+		m.Stickerset = m3
+
+		m.ThumbDocumentId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaSetStickerSetThumb) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaCheckStickerSetShortName
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaCheckStickerSetShortName) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_checkStickerSetShortName))
+
+	switch uint32(m.Constructor) {
+	case 0x9b95a312:
+		// media.checkStickerSetShortName short_name:string = Bool;
+		x.UInt(0x9b95a312)
+
+		// no flags
+
+		x.String(m.GetShortName())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaCheckStickerSetShortName) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaCheckStickerSetShortName) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x9b95a312:
+		// media.checkStickerSetShortName short_name:string = Bool;
+
+		// not has flags
+
+		m.ShortName = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaCheckStickerSetShortName) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaGetStickerSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaGetStickerSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_getStickerSet))
+
+	switch uint32(m.Constructor) {
+	case 0x8f4938cf:
+		// media.getStickerSet user_id:long stickerset:InputStickerSet = messages.StickerSet;
+		x.UInt(0x8f4938cf)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaGetStickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaGetStickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8f4938cf:
+		// media.getStickerSet user_id:long stickerset:InputStickerSet = messages.StickerSet;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m3 := &mtproto.InputStickerSet{}
+		m3.Decode(dBuf)
+		m.Stickerset = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaGetStickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaInstallStickerSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaInstallStickerSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_installStickerSet))
+
+	switch uint32(m.Constructor) {
+	case 0x6fbbba47:
+		// media.installStickerSet flags:# archived:flags.0?true user_id:long stickerset:InputStickerSet = messages.StickerSetInstallResult;
+		x.UInt(0x6fbbba47)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetArchived() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaInstallStickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaInstallStickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6fbbba47:
+		// media.installStickerSet flags:# archived:flags.0?true user_id:long stickerset:InputStickerSet = messages.StickerSetInstallResult;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.Archived = true
+		}
+		m.UserId = dBuf.Long()
+
+		m5 := &mtproto.InputStickerSet{}
+		m5.Decode(dBuf)
+		m.Stickerset = m5
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaInstallStickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaUninstallStickerSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaUninstallStickerSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_uninstallStickerSet))
+
+	switch uint32(m.Constructor) {
+	case 0xc16e9d85:
+		// media.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+		x.UInt(0xc16e9d85)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaUninstallStickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaUninstallStickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc16e9d85:
+		// media.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m3 := &mtproto.InputStickerSet{}
+		m3.Decode(dBuf)
+		m.Stickerset = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaUninstallStickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaReorderStickerSets
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaReorderStickerSets) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_reorderStickerSets))
+
+	switch uint32(m.Constructor) {
+	case 0x47a7cfa:
+		// media.reorderStickerSets flags:# masks:flags.0?true emojis:flags.1?true user_id:long order:Vector<long> = Bool;
+		x.UInt(0x47a7cfa)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetMasks() == true {
+			flags |= 1 << 0
+		}
+		if m.GetEmojis() == true {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+
+		x.VectorLong(m.GetOrder())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaReorderStickerSets) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaReorderStickerSets) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x47a7cfa:
+		// media.reorderStickerSets flags:# masks:flags.0?true emojis:flags.1?true user_id:long order:Vector<long> = Bool;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.Masks = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.Emojis = true
+		}
+		m.UserId = dBuf.Long()
+
+		m.Order = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaReorderStickerSets) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaGetAllStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaGetAllStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_getAllStickers))
+
+	switch uint32(m.Constructor) {
+	case 0x2b7f12f:
+		// media.getAllStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long hash:long = messages.AllStickers;
+		x.UInt(0x2b7f12f)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetMasks() == true {
+			flags |= 1 << 0
+		}
+		if m.GetEmojis() == true {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetHash())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaGetAllStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaGetAllStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x2b7f12f:
+		// media.getAllStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long hash:long = messages.AllStickers;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.Masks = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.Emojis = true
+		}
+		m.UserId = dBuf.Long()
+		m.Hash = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaGetAllStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaGetArchivedStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaGetArchivedStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_getArchivedStickers))
+
+	switch uint32(m.Constructor) {
+	case 0xdb6ef7ed:
+		// media.getArchivedStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long offset_id:long limit:int = messages.ArchivedStickers;
+		x.UInt(0xdb6ef7ed)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetMasks() == true {
+			flags |= 1 << 0
+		}
+		if m.GetEmojis() == true {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetOffsetId())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaGetArchivedStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaGetArchivedStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xdb6ef7ed:
+		// media.getArchivedStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long offset_id:long limit:int = messages.ArchivedStickers;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.Masks = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.Emojis = true
+		}
+		m.UserId = dBuf.Long()
+		m.OffsetId = dBuf.Long()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaGetArchivedStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaSearchStickerSets
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaSearchStickerSets) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_searchStickerSets))
+
+	switch uint32(m.Constructor) {
+	case 0xadd384bb:
+		// media.searchStickerSets user_id:long q:string hash:long = messages.FoundStickerSets;
+		x.UInt(0xadd384bb)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.String(m.GetQ())
+		x.Long(m.GetHash())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaSearchStickerSets) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaSearchStickerSets) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xadd384bb:
+		// media.searchStickerSets user_id:long q:string hash:long = messages.FoundStickerSets;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Q = dBuf.String()
+		m.Hash = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaSearchStickerSets) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaGetStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaGetStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_getStickers))
+
+	switch uint32(m.Constructor) {
+	case 0xb5b05675:
+		// media.getStickers user_id:long emoticon:string hash:long = messages.Stickers;
+		x.UInt(0xb5b05675)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.String(m.GetEmoticon())
+		x.Long(m.GetHash())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaGetStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaGetStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xb5b05675:
+		// media.getStickers user_id:long emoticon:string hash:long = messages.Stickers;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Emoticon = dBuf.String()
+		m.Hash = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaGetStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaGetRecentStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaGetRecentStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_getRecentStickers))
+
+	switch uint32(m.Constructor) {
+	case 0x83dce122:
+		// media.getRecentStickers user_id:long hash:long = messages.RecentStickers;
+		x.UInt(0x83dce122)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetHash())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaGetRecentStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaGetRecentStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x83dce122:
+		// media.getRecentStickers user_id:long hash:long = messages.RecentStickers;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Hash = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaGetRecentStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaSaveRecentSticker
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaSaveRecentSticker) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_saveRecentSticker))
+
+	switch uint32(m.Constructor) {
+	case 0x5e60a73b:
+		// media.saveRecentSticker flags:# unsave:flags.0?true user_id:long id:long = Bool;
+		x.UInt(0x5e60a73b)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetUnsave() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaSaveRecentSticker) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaSaveRecentSticker) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5e60a73b:
+		// media.saveRecentSticker flags:# unsave:flags.0?true user_id:long id:long = Bool;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.Unsave = true
+		}
+		m.UserId = dBuf.Long()
+		m.Id = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaSaveRecentSticker) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaClearRecentStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaClearRecentStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_clearRecentStickers))
+
+	switch uint32(m.Constructor) {
+	case 0xa6bc53fe:
+		// media.clearRecentStickers user_id:long = Bool;
+		x.UInt(0xa6bc53fe)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaClearRecentStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaClearRecentStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xa6bc53fe:
+		// media.clearRecentStickers user_id:long = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaClearRecentStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaGetFavedStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaGetFavedStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_getFavedStickers))
+
+	switch uint32(m.Constructor) {
+	case 0xdfae8eba:
+		// media.getFavedStickers user_id:long hash:long = messages.FavedStickers;
+		x.UInt(0xdfae8eba)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetHash())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaGetFavedStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaGetFavedStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xdfae8eba:
+		// media.getFavedStickers user_id:long hash:long = messages.FavedStickers;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Hash = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaGetFavedStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMediaFaveSticker
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMediaFaveSticker) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_media_faveSticker))
+
+	switch uint32(m.Constructor) {
+	case 0x53ea0a66:
+		// media.faveSticker flags:# unfave:flags.0?true user_id:long id:long = Bool;
+		x.UInt(0x53ea0a66)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetUnfave() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMediaFaveSticker) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMediaFaveSticker) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x53ea0a66:
+		// media.faveSticker flags:# unfave:flags.0?true user_id:long id:long = Bool;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.Unfave = true
+		}
+		m.UserId = dBuf.Long()
+		m.Id = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMediaFaveSticker) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// ----------------------------------------------------------------------------------------------------------------
+// Vector_PhotoSizeList
+// /////////////////////////////////////////////////////////////////////////////
+func (m *Vector_PhotoSizeList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_PhotoSizeList) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*PhotoSizeList, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(PhotoSizeList)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_PhotoSizeList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_PhotoSizeList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// Vector_Document
+// /////////////////////////////////////////////////////////////////////////////
 func (m *Vector_Document) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 	x.Int(int32(mtproto.CRC32_vector))
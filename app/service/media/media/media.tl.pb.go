@@ -34,24 +34,44 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type TLConstructor int32
 
 const (
-	CRC32_UNKNOWN                      TLConstructor = 0
-	CRC32_photoSizeList                TLConstructor = 108083635
-	CRC32_videoSizeList                TLConstructor = 953261042
-	CRC32_media_uploadPhotoFile        TLConstructor = 1009453847
-	CRC32_media_uploadProfilePhotoFile TLConstructor = -1757466844
-	CRC32_media_getPhoto               TLConstructor = 1702803563
-	CRC32_media_getPhotoSizeList       TLConstructor = -1578401979
-	CRC32_media_getPhotoSizeListList   TLConstructor = -77823776
-	CRC32_media_getVideoSizeList       TLConstructor = -998862102
-	CRC32_media_uploadedDocumentMedia  TLConstructor = 1331671148
-	CRC32_media_getDocument            TLConstructor = 1072011085
-	CRC32_media_getDocumentList        TLConstructor = -986721681
-	CRC32_media_uploadEncryptedFile    TLConstructor = -1426012517
-	CRC32_media_getEncryptedFile       TLConstructor = -60784431
-	CRC32_media_uploadWallPaperFile    TLConstructor = -1661293058
-	CRC32_media_uploadThemeFile        TLConstructor = 1122416736
-	CRC32_media_uploadStickerFile      TLConstructor = -1397349139
-	CRC32_media_uploadRingtoneFile     TLConstructor = 1035645449
+	CRC32_UNKNOWN                          TLConstructor = 0
+	CRC32_photoSizeList                    TLConstructor = 108083635
+	CRC32_videoSizeList                    TLConstructor = 953261042
+	CRC32_media_uploadPhotoFile            TLConstructor = 1009453847
+	CRC32_media_uploadProfilePhotoFile     TLConstructor = -1757466844
+	CRC32_media_getPhoto                   TLConstructor = 1702803563
+	CRC32_media_getPhotoSizeList           TLConstructor = -1578401979
+	CRC32_media_getPhotoSizeListList       TLConstructor = -77823776
+	CRC32_media_getVideoSizeList           TLConstructor = -998862102
+	CRC32_media_uploadedDocumentMedia      TLConstructor = 1331671148
+	CRC32_media_getDocument                TLConstructor = 1072011085
+	CRC32_media_getDocumentList            TLConstructor = -986721681
+	CRC32_media_uploadEncryptedFile        TLConstructor = -1426012517
+	CRC32_media_getEncryptedFile           TLConstructor = -60784431
+	CRC32_media_uploadWallPaperFile        TLConstructor = -1661293058
+	CRC32_media_uploadThemeFile            TLConstructor = 1122416736
+	CRC32_media_uploadStickerFile          TLConstructor = -1397349139
+	CRC32_media_uploadRingtoneFile         TLConstructor = 1035645449
+	CRC32_media_createStickerSet           TLConstructor = -1813078409
+	CRC32_media_addStickerToSet            TLConstructor = 1842210741
+	CRC32_media_removeStickerFromSet       TLConstructor = -931118961
+	CRC32_media_changeStickerPosition      TLConstructor = -1906424434
+	CRC32_media_setStickerSetThumb         TLConstructor = 293107063
+	CRC32_media_checkStickerSetShortName   TLConstructor = -1684692206
+	CRC32_media_getStickerSet              TLConstructor = -1891026737
+	CRC32_media_installStickerSet          TLConstructor = 1874573895
+	CRC32_media_uninstallStickerSet        TLConstructor = -1049715323
+	CRC32_media_reorderStickerSets         TLConstructor = 75136250
+	CRC32_media_getAllStickers             TLConstructor = 45609263
+	CRC32_media_getArchivedStickers        TLConstructor = -613484563
+	CRC32_media_searchStickerSets          TLConstructor = -1378646853
+	CRC32_media_getStickers                TLConstructor = -1246734731
+	CRC32_media_getRecentStickers          TLConstructor = -2082676446
+	CRC32_media_saveRecentSticker          TLConstructor = 1583392571
+	CRC32_media_clearRecentStickers        TLConstructor = -1497607170
+	CRC32_media_getFavedStickers           TLConstructor = -542208326
+	CRC32_media_faveSticker                TLConstructor = 1407847014
+	CRC32_media_getStickerSetThumbDocument TLConstructor = 1093072846
 )
 
 var TLConstructor_name = map[int32]string{
@@ -73,27 +93,67 @@ var TLConstructor_name = map[int32]string{
 	1122416736:  "CRC32_media_uploadThemeFile",
 	-1397349139: "CRC32_media_uploadStickerFile",
 	1035645449:  "CRC32_media_uploadRingtoneFile",
+	-1813078409: "CRC32_media_createStickerSet",
+	1842210741:  "CRC32_media_addStickerToSet",
+	-931118961:  "CRC32_media_removeStickerFromSet",
+	-1906424434: "CRC32_media_changeStickerPosition",
+	293107063:   "CRC32_media_setStickerSetThumb",
+	-1684692206: "CRC32_media_checkStickerSetShortName",
+	-1891026737: "CRC32_media_getStickerSet",
+	1874573895:  "CRC32_media_installStickerSet",
+	-1049715323: "CRC32_media_uninstallStickerSet",
+	75136250:    "CRC32_media_reorderStickerSets",
+	45609263:    "CRC32_media_getAllStickers",
+	-613484563:  "CRC32_media_getArchivedStickers",
+	-1378646853: "CRC32_media_searchStickerSets",
+	-1246734731: "CRC32_media_getStickers",
+	-2082676446: "CRC32_media_getRecentStickers",
+	1583392571:  "CRC32_media_saveRecentSticker",
+	-1497607170: "CRC32_media_clearRecentStickers",
+	-542208326:  "CRC32_media_getFavedStickers",
+	1407847014:  "CRC32_media_faveSticker",
+	1093072846:  "CRC32_media_getStickerSetThumbDocument",
 }
 
 var TLConstructor_value = map[string]int32{
-	"CRC32_UNKNOWN":                      0,
-	"CRC32_photoSizeList":                108083635,
-	"CRC32_videoSizeList":                953261042,
-	"CRC32_media_uploadPhotoFile":        1009453847,
-	"CRC32_media_uploadProfilePhotoFile": -1757466844,
-	"CRC32_media_getPhoto":               1702803563,
-	"CRC32_media_getPhotoSizeList":       -1578401979,
-	"CRC32_media_getPhotoSizeListList":   -77823776,
-	"CRC32_media_getVideoSizeList":       -998862102,
-	"CRC32_media_uploadedDocumentMedia":  1331671148,
-	"CRC32_media_getDocument":            1072011085,
-	"CRC32_media_getDocumentList":        -986721681,
-	"CRC32_media_uploadEncryptedFile":    -1426012517,
-	"CRC32_media_getEncryptedFile":       -60784431,
-	"CRC32_media_uploadWallPaperFile":    -1661293058,
-	"CRC32_media_uploadThemeFile":        1122416736,
-	"CRC32_media_uploadStickerFile":      -1397349139,
-	"CRC32_media_uploadRingtoneFile":     1035645449,
+	"CRC32_UNKNOWN":                          0,
+	"CRC32_photoSizeList":                    108083635,
+	"CRC32_videoSizeList":                    953261042,
+	"CRC32_media_uploadPhotoFile":            1009453847,
+	"CRC32_media_uploadProfilePhotoFile":     -1757466844,
+	"CRC32_media_getPhoto":                   1702803563,
+	"CRC32_media_getPhotoSizeList":           -1578401979,
+	"CRC32_media_getPhotoSizeListList":       -77823776,
+	"CRC32_media_getVideoSizeList":           -998862102,
+	"CRC32_media_uploadedDocumentMedia":      1331671148,
+	"CRC32_media_getDocument":                1072011085,
+	"CRC32_media_getDocumentList":            -986721681,
+	"CRC32_media_uploadEncryptedFile":        -1426012517,
+	"CRC32_media_getEncryptedFile":           -60784431,
+	"CRC32_media_uploadWallPaperFile":        -1661293058,
+	"CRC32_media_uploadThemeFile":            1122416736,
+	"CRC32_media_uploadStickerFile":          -1397349139,
+	"CRC32_media_uploadRingtoneFile":         1035645449,
+	"CRC32_media_createStickerSet":           -1813078409,
+	"CRC32_media_addStickerToSet":            1842210741,
+	"CRC32_media_removeStickerFromSet":       -931118961,
+	"CRC32_media_changeStickerPosition":      -1906424434,
+	"CRC32_media_setStickerSetThumb":         293107063,
+	"CRC32_media_checkStickerSetShortName":   -1684692206,
+	"CRC32_media_getStickerSet":              -1891026737,
+	"CRC32_media_installStickerSet":          1874573895,
+	"CRC32_media_uninstallStickerSet":        -1049715323,
+	"CRC32_media_reorderStickerSets":         75136250,
+	"CRC32_media_getAllStickers":             45609263,
+	"CRC32_media_getArchivedStickers":        -613484563,
+	"CRC32_media_searchStickerSets":          -1378646853,
+	"CRC32_media_getStickers":                -1246734731,
+	"CRC32_media_getRecentStickers":          -2082676446,
+	"CRC32_media_saveRecentSticker":          1583392571,
+	"CRC32_media_clearRecentStickers":        -1497607170,
+	"CRC32_media_getFavedStickers":           -542208326,
+	"CRC32_media_faveSticker":                1407847014,
+	"CRC32_media_getStickerSetThumbDocument": 1093072846,
 }
 
 func (x TLConstructor) String() string {
@@ -105,8 +165,7 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 }
 
 // PhotoSizeList <--
-//  + TL_photoSizeList
-//
+//   - TL_photoSizeList
 type PhotoSizeList struct {
 	PredicateName        string               `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor        `protobuf:"varint,2,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
@@ -234,8 +293,7 @@ func (m *TLPhotoSizeList) GetData2() *PhotoSizeList {
 }
 
 // VideoSizeList <--
-//  + TL_videoSizeList
-//
+//   - TL_videoSizeList
 type VideoSizeList struct {
 	PredicateName        string               `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor        `protobuf:"varint,2,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
@@ -362,7 +420,7 @@ func (m *TLVideoSizeList) GetData2() *VideoSizeList {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaUploadPhotoFile struct {
 	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	OwnerId              int64                    `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -442,7 +500,7 @@ func (m *TLMediaUploadPhotoFile) GetTtlSeconds() *types.Int32Value {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaUploadProfilePhotoFile struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	OwnerId              int64              `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -522,7 +580,7 @@ func (m *TLMediaUploadProfilePhotoFile) GetVideoStartTs() *types.DoubleValue {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaGetPhoto struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	PhotoId              int64         `protobuf:"varint,3,opt,name=photo_id,json=photoId,proto3" json:"photo_id,omitempty"`
@@ -578,7 +636,7 @@ func (m *TLMediaGetPhoto) GetPhotoId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaGetPhotoSizeList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	SizeId               int64         `protobuf:"varint,3,opt,name=size_id,json=sizeId,proto3" json:"size_id,omitempty"`
@@ -634,7 +692,7 @@ func (m *TLMediaGetPhotoSizeList) GetSizeId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaGetPhotoSizeListList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	IdList               []int64       `protobuf:"varint,3,rep,packed,name=id_list,json=idList,proto3" json:"id_list,omitempty"`
@@ -690,7 +748,7 @@ func (m *TLMediaGetPhotoSizeListList) GetIdList() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaGetVideoSizeList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	SizeId               int64         `protobuf:"varint,3,opt,name=size_id,json=sizeId,proto3" json:"size_id,omitempty"`
@@ -746,7 +804,7 @@ func (m *TLMediaGetVideoSizeList) GetSizeId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaUploadedDocumentMedia struct {
 	Constructor          TLConstructor       `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	OwnerId              int64               `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -810,7 +868,7 @@ func (m *TLMediaUploadedDocumentMedia) GetMedia() *mtproto.InputMedia {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaGetDocument struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -866,7 +924,7 @@ func (m *TLMediaGetDocument) GetId() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaGetDocumentList struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	IdList               []int64       `protobuf:"varint,3,rep,packed,name=id_list,json=idList,proto3" json:"id_list,omitempty"`
@@ -922,7 +980,7 @@ func (m *TLMediaGetDocumentList) GetIdList() []int64 {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaUploadEncryptedFile struct {
 	Constructor          TLConstructor               `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	OwnerId              int64                       `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -986,7 +1044,7 @@ func (m *TLMediaUploadEncryptedFile) GetFile() *mtproto.InputEncryptedFile {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaGetEncryptedFile struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	Id                   int64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -1050,7 +1108,7 @@ func (m *TLMediaGetEncryptedFile) GetAccessHash() int64 {
 	return 0
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaUploadWallPaperFile struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	OwnerId              int64              `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -1130,7 +1188,7 @@ func (m *TLMediaUploadWallPaperFile) GetAdmin() *mtproto.Bool {
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaUploadThemeFile struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	OwnerId              int64              `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -1218,7 +1276,7 @@ func (m *TLMediaUploadThemeFile) GetFileName() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaUploadStickerFile struct {
 	Constructor              TLConstructor              `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	OwnerId                  int64                      `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -1314,7 +1372,7 @@ func (m *TLMediaUploadStickerFile) GetDocumentAttributeSticker() *mtproto.Docume
 	return nil
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 type TLMediaUploadRingtoneFile struct {
 	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
 	OwnerId              int64              `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -1394,7 +1452,7 @@ func (m *TLMediaUploadRingtoneFile) GetFileName() string {
 	return ""
 }
 
-//--------------------------------------------------------------------------------------------
+// --------------------------------------------------------------------------------------------
 // Vector api result type
 type Vector_PhotoSizeList struct {
 	Datas                []*PhotoSizeList `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
@@ -1490,2728 +1548,9927 @@ func (m *Vector_Document) GetDatas() []*mtproto.Document {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("media.TLConstructor", TLConstructor_name, TLConstructor_value)
-	proto.RegisterType((*PhotoSizeList)(nil), "media.PhotoSizeList")
-	proto.RegisterType((*TLPhotoSizeList)(nil), "media.TL_photoSizeList")
-	proto.RegisterType((*VideoSizeList)(nil), "media.VideoSizeList")
-	proto.RegisterType((*TLVideoSizeList)(nil), "media.TL_videoSizeList")
-	proto.RegisterType((*TLMediaUploadPhotoFile)(nil), "media.TL_media_uploadPhotoFile")
-	proto.RegisterType((*TLMediaUploadProfilePhotoFile)(nil), "media.TL_media_uploadProfilePhotoFile")
-	proto.RegisterType((*TLMediaGetPhoto)(nil), "media.TL_media_getPhoto")
-	proto.RegisterType((*TLMediaGetPhotoSizeList)(nil), "media.TL_media_getPhotoSizeList")
-	proto.RegisterType((*TLMediaGetPhotoSizeListList)(nil), "media.TL_media_getPhotoSizeListList")
-	proto.RegisterType((*TLMediaGetVideoSizeList)(nil), "media.TL_media_getVideoSizeList")
-	proto.RegisterType((*TLMediaUploadedDocumentMedia)(nil), "media.TL_media_uploadedDocumentMedia")
-	proto.RegisterType((*TLMediaGetDocument)(nil), "media.TL_media_getDocument")
-	proto.RegisterType((*TLMediaGetDocumentList)(nil), "media.TL_media_getDocumentList")
-	proto.RegisterType((*TLMediaUploadEncryptedFile)(nil), "media.TL_media_uploadEncryptedFile")
-	proto.RegisterType((*TLMediaGetEncryptedFile)(nil), "media.TL_media_getEncryptedFile")
-	proto.RegisterType((*TLMediaUploadWallPaperFile)(nil), "media.TL_media_uploadWallPaperFile")
-	proto.RegisterType((*TLMediaUploadThemeFile)(nil), "media.TL_media_uploadThemeFile")
-	proto.RegisterType((*TLMediaUploadStickerFile)(nil), "media.TL_media_uploadStickerFile")
-	proto.RegisterType((*TLMediaUploadRingtoneFile)(nil), "media.TL_media_uploadRingtoneFile")
-	proto.RegisterType((*Vector_PhotoSizeList)(nil), "media.Vector_PhotoSizeList")
-	proto.RegisterType((*Vector_Document)(nil), "media.Vector_Document")
+// --------------------------------------------------------------------------------------------
+// media.createStickerSet flags:# masks:flags.0?true animated:flags.1?true videos:flags.4?true emojis:flags.5?true owner_id:long title:string short_name:string thumb_document_id:long stickers:Vector<InputStickerSetItem> = messages.StickerSet;
+type TLMediaCreateStickerSet struct {
+	Constructor          TLConstructor                  `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	Masks                bool                           `protobuf:"varint,3,opt,name=masks,proto3" json:"masks,omitempty"`
+	Animated             bool                           `protobuf:"varint,4,opt,name=animated,proto3" json:"animated,omitempty"`
+	Videos               bool                           `protobuf:"varint,5,opt,name=videos,proto3" json:"videos,omitempty"`
+	Emojis               bool                           `protobuf:"varint,6,opt,name=emojis,proto3" json:"emojis,omitempty"`
+	OwnerId              int64                          `protobuf:"varint,7,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title                string                         `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	ShortName            string                         `protobuf:"bytes,9,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	ThumbDocumentId      int64                          `protobuf:"varint,10,opt,name=thumb_document_id,json=thumbDocumentId,proto3" json:"thumb_document_id,omitempty"`
+	Stickers             []*mtproto.InputStickerSetItem `protobuf:"bytes,11,rep,name=stickers,proto3" json:"stickers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *TLMediaCreateStickerSet) Reset()         { *m = TLMediaCreateStickerSet{} }
+func (m *TLMediaCreateStickerSet) String() string { return proto.CompactTextString(m) }
+func (*TLMediaCreateStickerSet) ProtoMessage()    {}
+func (*TLMediaCreateStickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{21}
+}
+func (m *TLMediaCreateStickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaCreateStickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaCreateStickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLMediaCreateStickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaCreateStickerSet.Merge(m, src)
+}
+func (m *TLMediaCreateStickerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaCreateStickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaCreateStickerSet.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("media.tl.proto", fileDescriptor_c788ef787fa9e2c6) }
+var xxx_messageInfo_TLMediaCreateStickerSet proto.InternalMessageInfo
 
-var fileDescriptor_c788ef787fa9e2c6 = []byte{
-	// 1491 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x68, 0xdc, 0xd6,
-	0x16, 0xb6, 0x3c, 0x1e, 0xc7, 0x3e, 0x7e, 0xf6, 0x93, 0x6f, 0x9c, 0x44, 0xd6, 0x38, 0xe3, 0x89,
-	0xf2, 0x92, 0x37, 0x09, 0x2f, 0x33, 0x30, 0x86, 0xb7, 0x68, 0x43, 0xa1, 0x76, 0x52, 0xea, 0xd6,
-	0x76, 0x5d, 0x79, 0xf2, 0x43, 0xa0, 0x15, 0xb2, 0x74, 0x33, 0x23, 0xa2, 0x19, 0x09, 0xe9, 0x4e,
-	0x82, 0xb3, 0x4b, 0x5b, 0xe8, 0x0f, 0x94, 0xee, 0x12, 0x68, 0xa1, 0x8b, 0x26, 0x5d, 0x94, 0xfe,
-	0x6c, 0xba, 0x28, 0x5d, 0x75, 0xd3, 0x86, 0x76, 0x51, 0x48, 0x0b, 0x85, 0x90, 0x40, 0x71, 0xd2,
-	0x96, 0x42, 0xda, 0xd0, 0x90, 0xac, 0x62, 0x42, 0xa6, 0xe8, 0x6a, 0xa4, 0x99, 0xab, 0xd1, 0x4c,
-	0x43, 0x7e, 0xa8, 0xa1, 0x9b, 0x41, 0xba, 0xe7, 0xd3, 0x77, 0xce, 0xf9, 0xee, 0xbd, 0xe7, 0x9e,
-	0x3b, 0x30, 0x52, 0xc1, 0xba, 0xa1, 0xe6, 0x88, 0x99, 0xb3, 0x1d, 0x8b, 0x58, 0x28, 0x49, 0xdf,
-	0xc5, 0x3d, 0x25, 0x83, 0x94, 0x6b, 0xcb, 0x39, 0xcd, 0xaa, 0xe4, 0x4b, 0x56, 0xc9, 0xca, 0x53,
-	0xeb, 0x72, 0xed, 0x28, 0x7d, 0xa3, 0x2f, 0xf4, 0xc9, 0xff, 0x4a, 0x4c, 0x97, 0x2c, 0xab, 0x64,
-	0xe2, 0x26, 0xea, 0x84, 0xa3, 0xda, 0x36, 0x76, 0xdc, 0x86, 0x5d, 0x74, 0xb5, 0x32, 0xae, 0x50,
-	0x37, 0x9a, 0xe5, 0x60, 0x85, 0xac, 0xd8, 0x38, 0xb0, 0x8d, 0x37, 0x6d, 0xc4, 0x51, 0xab, 0xae,
-	0x6d, 0x39, 0xa4, 0x61, 0x1a, 0x6b, 0x9a, 0xdc, 0x95, 0xaa, 0xe6, 0x8f, 0x4a, 0xe7, 0x39, 0x18,
-	0x5e, 0x2c, 0x5b, 0xc4, 0x5a, 0x32, 0x4e, 0xe2, 0x39, 0xc3, 0x25, 0x68, 0x07, 0x8c, 0xd8, 0x0e,
-	0xd6, 0x0d, 0x4d, 0x25, 0x58, 0xa9, 0xaa, 0x15, 0x2c, 0x70, 0x19, 0x2e, 0x3b, 0x28, 0x0f, 0x87,
-	0xa3, 0x0b, 0x6a, 0x05, 0xa3, 0xff, 0xc3, 0x90, 0x66, 0x55, 0x5d, 0xe2, 0xd4, 0x34, 0x62, 0x39,
-	0x42, 0x6f, 0x86, 0xcb, 0x8e, 0x14, 0xc6, 0x72, 0xbe, 0x02, 0xc5, 0xb9, 0x99, 0xa6, 0x4d, 0x6e,
-	0x05, 0xa2, 0x2d, 0xb0, 0xc1, 0x35, 0x4e, 0x62, 0xc5, 0xd0, 0x85, 0x44, 0x86, 0xcb, 0x26, 0xe4,
-	0x7e, 0xef, 0x75, 0x56, 0x47, 0x59, 0x48, 0x7a, 0x4f, 0xae, 0xd0, 0x97, 0x49, 0x64, 0x87, 0x0a,
-	0x28, 0x57, 0x21, 0x34, 0xc4, 0x5c, 0x18, 0x9e, 0xec, 0x03, 0xd0, 0x46, 0x48, 0xea, 0x9a, 0x47,
-	0x90, 0xcc, 0x70, 0xd9, 0xa4, 0xdc, 0xa7, 0x6b, 0xb3, 0xba, 0xf4, 0x04, 0xf0, 0xc5, 0x39, 0xc5,
-	0x66, 0x52, 0xd9, 0x0d, 0x49, 0x5d, 0x25, 0x6a, 0x81, 0x66, 0x30, 0x14, 0x46, 0xc7, 0xe4, 0x2b,
-	0xfb, 0x10, 0x2a, 0xc4, 0x41, 0x43, 0xc7, 0xeb, 0x58, 0x88, 0x30, 0xbc, 0x7b, 0x10, 0xe2, 0x38,
-	0x93, 0x4a, 0x07, 0x21, 0x98, 0x7c, 0x03, 0x21, 0x5e, 0xed, 0x05, 0xa1, 0x38, 0xa7, 0x50, 0x84,
-	0x52, 0xb3, 0x4d, 0x4b, 0xd5, 0xa9, 0x60, 0x4f, 0x19, 0x66, 0x5b, 0xb2, 0xdc, 0xbd, 0x26, 0x3b,
-	0x0e, 0x03, 0xd6, 0x89, 0x2a, 0x76, 0x9a, 0xd9, 0x6e, 0xa0, 0xef, 0xb3, 0x3a, 0xda, 0x09, 0x7d,
-	0x47, 0x0d, 0x13, 0x0b, 0x7d, 0x34, 0xb4, 0x66, 0xb6, 0xb3, 0x55, 0xbb, 0x46, 0x3c, 0xa7, 0x32,
-	0xb5, 0xa3, 0x02, 0x0c, 0xb8, 0xc4, 0xd0, 0x8e, 0x61, 0xc7, 0x15, 0x92, 0x54, 0x99, 0xcd, 0x2c,
-	0x76, 0x9f, 0xa5, 0xd5, 0x2a, 0xb8, 0x4a, 0xe4, 0x10, 0x87, 0xf6, 0xc2, 0x10, 0x21, 0xa6, 0xe2,
-	0x62, 0xcd, 0xaa, 0xea, 0xae, 0xd0, 0x4f, 0x5d, 0xa4, 0x72, 0xfe, 0x06, 0xcb, 0x05, 0x1b, 0x2c,
-	0x37, 0x5b, 0x25, 0x53, 0x85, 0x83, 0xaa, 0x59, 0xc3, 0x32, 0x10, 0x62, 0x2e, 0xf9, 0x70, 0xe9,
-	0x8d, 0x5e, 0x98, 0x8c, 0x2a, 0xe1, 0x58, 0x5e, 0x30, 0xeb, 0x42, 0x90, 0x2c, 0x24, 0xe9, 0x2c,
-	0xd3, 0xd9, 0x8f, 0x07, 0xfa, 0x00, 0x34, 0x0d, 0x23, 0xf4, 0x41, 0x71, 0x89, 0xea, 0x10, 0x85,
-	0x04, 0x4a, 0x4c, 0xb4, 0x29, 0xb1, 0xcf, 0xaa, 0x2d, 0x9b, 0xd8, 0x97, 0xe2, 0x5f, 0xfe, 0x1a,
-	0xf2, 0x3e, 0x29, 0xba, 0xd2, 0x51, 0x18, 0x0d, 0xb5, 0x28, 0x61, 0x42, 0x15, 0x78, 0x90, 0xec,
-	0xe9, 0x4e, 0x6d, 0xc9, 0x9e, 0xbe, 0xcf, 0xea, 0x92, 0x09, 0xe3, 0x6d, 0x7e, 0xc2, 0x75, 0x7c,
-	0xbf, 0xfe, 0x3a, 0xed, 0x35, 0xc9, 0x86, 0xad, 0x1d, 0xbd, 0x3d, 0xa8, 0x47, 0x43, 0x57, 0x4c,
-	0xc3, 0x25, 0x42, 0x22, 0x93, 0xf0, 0x3c, 0x1a, 0xba, 0x47, 0x18, 0xcd, 0x8f, 0x2d, 0x39, 0x0f,
-	0x3d, 0xbf, 0x77, 0x39, 0x48, 0x47, 0x96, 0x30, 0xd6, 0x83, 0x8d, 0x32, 0xef, 0x8d, 0x3e, 0x8a,
-	0x15, 0xbc, 0x0b, 0xfc, 0x93, 0xaf, 0xb1, 0x84, 0x37, 0xb2, 0x2b, 0x93, 0xba, 0x95, 0x7d, 0x84,
-	0xf4, 0x22, 0x8c, 0xb5, 0xca, 0x11, 0x84, 0x76, 0xdf, 0x51, 0x8d, 0x40, 0x6f, 0x18, 0x4f, 0xaf,
-	0xa1, 0x4b, 0xc7, 0x5a, 0x8a, 0x59, 0x0b, 0xff, 0xa3, 0x99, 0xdb, 0xf7, 0x38, 0x98, 0x88, 0xa8,
-	0xbd, 0xbf, 0xaa, 0x39, 0x2b, 0x36, 0xc1, 0xfa, 0xa3, 0xaa, 0x16, 0x79, 0xa6, 0x5a, 0xa4, 0x58,
-	0xa9, 0x19, 0xef, 0x7e, 0xd9, 0x90, 0x5e, 0xe1, 0xd8, 0x15, 0xf8, 0x70, 0x22, 0x8c, 0xe8, 0x8e,
-	0x26, 0x61, 0x48, 0xd5, 0x34, 0xec, 0xba, 0x4a, 0x59, 0x75, 0xcb, 0x34, 0xba, 0x84, 0x0c, 0xfe,
-	0xd0, 0xd3, 0xaa, 0x5b, 0x96, 0x2e, 0xb7, 0x6b, 0x75, 0x48, 0x35, 0xcd, 0x45, 0xd5, 0xc6, 0xce,
-	0xdf, 0x5d, 0x59, 0x53, 0x30, 0x58, 0x31, 0x2a, 0x7e, 0x67, 0x45, 0xab, 0xeb, 0xa0, 0x3c, 0xe0,
-	0x0d, 0x14, 0x57, 0x6c, 0x8c, 0xb6, 0x43, 0x52, 0xd5, 0x2b, 0x46, 0xb5, 0x51, 0x43, 0x87, 0x43,
-	0x96, 0x69, 0xcb, 0x32, 0x65, 0xdf, 0x26, 0xdd, 0xe6, 0xda, 0x0e, 0xd1, 0x62, 0x19, 0x57, 0xf0,
-	0x3a, 0x38, 0x33, 0x48, 0xb9, 0x56, 0x59, 0xee, 0x76, 0x66, 0x50, 0x00, 0xab, 0x41, 0x7f, 0x44,
-	0x83, 0x14, 0x0c, 0x7a, 0x74, 0x7e, 0x57, 0xb4, 0xc1, 0x37, 0x7a, 0x03, 0x5e, 0x43, 0x24, 0xfd,
-	0xd0, 0x0b, 0x62, 0x24, 0xf7, 0x25, 0xff, 0x40, 0xfe, 0x07, 0x64, 0x8f, 0x0e, 0x83, 0xa8, 0x37,
-	0x8a, 0x8c, 0xa2, 0x12, 0xe2, 0x18, 0xcb, 0x35, 0x82, 0x95, 0x46, 0x47, 0x22, 0x0c, 0x50, 0xc7,
-	0x62, 0xe8, 0x38, 0xa8, 0x47, 0x4f, 0x06, 0x48, 0x59, 0xd0, 0xa3, 0x43, 0x0d, 0xf1, 0xa4, 0xef,
-	0x39, 0x48, 0x45, 0x74, 0x95, 0x8d, 0x6a, 0x89, 0x58, 0x55, 0xbc, 0xae, 0x37, 0x0c, 0x23, 0x57,
-	0x7f, 0x64, 0xb1, 0x4c, 0xc3, 0xd8, 0x41, 0xec, 0x85, 0xa1, 0x2c, 0xc6, 0xb5, 0xee, 0xae, 0xc0,
-	0xd1, 0x56, 0xaf, 0x4b, 0xeb, 0xee, 0x4a, 0x8f, 0xc1, 0xbf, 0x1b, 0x1c, 0xe1, 0xf1, 0xf1, 0x5f,
-	0xf6, 0xf3, 0xd1, 0x36, 0xc1, 0x1b, 0xdf, 0xee, 0xbe, 0x90, 0x84, 0x61, 0x46, 0x1b, 0x34, 0x0a,
-	0xc3, 0x33, 0xf2, 0xcc, 0x54, 0x41, 0x39, 0xb0, 0xf0, 0xec, 0xc2, 0x73, 0x87, 0x16, 0xf8, 0x1e,
-	0x24, 0xc2, 0x46, 0x7f, 0x88, 0xb9, 0x5e, 0xf0, 0x9f, 0xde, 0xfa, 0x72, 0x0a, 0xa5, 0x02, 0x1b,
-	0xd3, 0x71, 0xf3, 0x37, 0x3f, 0x3b, 0x7f, 0x3e, 0x81, 0xb6, 0x43, 0xca, 0x37, 0xc6, 0x76, 0xd3,
-	0xfc, 0x99, 0xd3, 0x1f, 0x5d, 0x49, 0xa0, 0x3c, 0x48, 0x31, 0xa0, 0x48, 0xa3, 0xc9, 0x9f, 0xfb,
-	0xf1, 0xce, 0xe7, 0xb7, 0xeb, 0xf5, 0x7a, 0x9d, 0x43, 0x13, 0x30, 0xd6, 0xfa, 0x41, 0xd0, 0xb7,
-	0xf0, 0xbf, 0xdd, 0x58, 0xfb, 0xb0, 0x1f, 0xed, 0x82, 0x89, 0x38, 0x6b, 0x18, 0xd9, 0x57, 0x77,
-	0x3f, 0x7e, 0x6b, 0xcd, 0x27, 0xda, 0x03, 0x99, 0x6e, 0x50, 0x0a, 0x5f, 0x3d, 0x75, 0xf3, 0x52,
-	0xdd, 0x87, 0xb7, 0x33, 0x33, 0xdd, 0x0b, 0x7f, 0xed, 0xfd, 0x4b, 0x67, 0xef, 0x04, 0xd0, 0x6d,
-	0xed, 0x39, 0x45, 0x3a, 0x0f, 0xfe, 0xf7, 0xd5, 0xbb, 0x6b, 0x7d, 0x68, 0x12, 0xb6, 0x44, 0x58,
-	0x03, 0x14, 0xff, 0xed, 0x27, 0xa7, 0xeb, 0x09, 0x94, 0x65, 0x45, 0x8c, 0x9c, 0xe2, 0xfc, 0x1f,
-	0xe7, 0xbe, 0xf8, 0xa0, 0xe1, 0xf5, 0x7f, 0x30, 0xd9, 0xee, 0x95, 0x39, 0xdf, 0xf8, 0x77, 0xde,
-	0x7c, 0xf9, 0xe2, 0x5a, 0xa7, 0x74, 0x58, 0xe8, 0x77, 0xa7, 0x5e, 0xfa, 0xa9, 0xde, 0x85, 0x98,
-	0x39, 0xae, 0xf8, 0xbb, 0x97, 0xaf, 0xfd, 0xda, 0x98, 0x9f, 0xd8, 0x59, 0x0f, 0xcb, 0x3f, 0xbf,
-	0x7a, 0xe3, 0xed, 0x33, 0x7d, 0x68, 0x37, 0x6c, 0x6d, 0x07, 0xb5, 0xd4, 0x49, 0xfe, 0xfa, 0x37,
-	0x17, 0x7f, 0x69, 0x44, 0xba, 0x13, 0xd2, 0xed, 0xd8, 0xd6, 0xbd, 0xcf, 0xbf, 0xfe, 0xf3, 0xb5,
-	0xeb, 0x09, 0xb1, 0xef, 0xb5, 0xb3, 0xe9, 0x9e, 0xc2, 0xad, 0x41, 0x18, 0x90, 0x17, 0x67, 0xfc,
-	0xee, 0xee, 0x19, 0xd8, 0x14, 0x7f, 0x93, 0x9b, 0x0c, 0x0b, 0x43, 0xfc, 0xe2, 0x14, 0x47, 0xd8,
-	0x0b, 0xb8, 0xd4, 0x83, 0x0e, 0xc3, 0x44, 0xd7, 0xbb, 0xd0, 0xce, 0x0e, 0x94, 0x11, 0x5c, 0x0c,
-	0xf3, 0xde, 0xc6, 0x5f, 0x27, 0xcd, 0x9b, 0x85, 0x10, 0xe5, 0x0a, 0x2c, 0x31, 0x5f, 0xcb, 0xb0,
-	0xb9, 0xc3, 0x7d, 0x21, 0xd3, 0x89, 0x25, 0x40, 0x88, 0xb1, 0x85, 0x45, 0xea, 0x41, 0x2a, 0x88,
-	0x5d, 0x6e, 0x05, 0xff, 0xf9, 0x2b, 0x5e, 0xca, 0x9d, 0x0a, 0xae, 0xd9, 0x31, 0x05, 0x2e, 0x12,
-	0x36, 0x7b, 0x0d, 0x88, 0x0b, 0x9b, 0x41, 0x88, 0xb1, 0x37, 0x78, 0xa9, 0x07, 0xbd, 0x00, 0xa9,
-	0x6e, 0xbd, 0xfe, 0x8e, 0xf8, 0x19, 0x8a, 0xc0, 0xc4, 0x4d, 0xa1, 0xc4, 0xf3, 0xd8, 0x75, 0xd5,
-	0x12, 0xa6, 0xc3, 0x52, 0x0f, 0xda, 0x0f, 0xa3, 0xed, 0xad, 0x7a, 0x2a, 0x26, 0xda, 0xc0, 0x28,
-	0xb6, 0x57, 0x5e, 0x9a, 0xf9, 0xa6, 0xf8, 0x8e, 0x7c, 0xb2, 0x0b, 0x15, 0xcd, 0x7b, 0x33, 0x2b,
-	0x69, 0x0b, 0xe7, 0x11, 0x18, 0xef, 0xdc, 0x77, 0x6f, 0x8f, 0xcf, 0x9b, 0x01, 0x89, 0xcd, 0xbf,
-	0x13, 0x98, 0x71, 0xa9, 0x07, 0x15, 0x5b, 0x66, 0x8a, 0x25, 0x8e, 0x9b, 0xa9, 0x7b, 0x65, 0x3d,
-	0xc0, 0x46, 0xcc, 0x76, 0xbf, 0x1d, 0x22, 0x66, 0x40, 0xf1, 0xe2, 0xce, 0xb3, 0x3b, 0xbe, 0xd9,
-	0x76, 0x76, 0xd8, 0xf1, 0x21, 0x20, 0x9e, 0xee, 0x79, 0xd8, 0xd2, 0xa9, 0x93, 0xdb, 0x16, 0x4f,
-	0xd8, 0x02, 0x89, 0xa7, 0x5c, 0x02, 0xa1, 0x63, 0x13, 0x23, 0xc5, 0x73, 0xb6, 0x62, 0x62, 0x49,
-	0xa7, 0xe7, 0x6f, 0x5c, 0x49, 0x73, 0x5f, 0x5f, 0x4d, 0x73, 0x17, 0xae, 0xa6, 0xb9, 0xd5, 0xab,
-	0x69, 0xee, 0xc8, 0xe3, 0x2d, 0x7f, 0xbb, 0x12, 0xac, 0x56, 0x4a, 0x8e, 0xda, 0x7c, 0xd8, 0xe3,
-	0x62, 0xe7, 0x38, 0x76, 0xf2, 0xaa, 0x6d, 0xe7, 0xbd, 0x47, 0x43, 0xc3, 0x79, 0xea, 0xd0, 0xff,
-	0x5d, 0xee, 0xa7, 0x0e, 0xa6, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x18, 0xdf, 0xb9, 0xac, 0xd3,
-	0x15, 0x00, 0x00,
+func (m *TLMediaCreateStickerSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
 }
 
-func (this *PhotoSizeList) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&media.PhotoSizeList{")
-	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "SizeId: "+fmt.Sprintf("%#v", this.SizeId)+",\n")
-	if this.Sizes != nil {
-		s = append(s, "Sizes: "+fmt.Sprintf("%#v", this.Sizes)+",\n")
+func (m *TLMediaCreateStickerSet) GetMasks() bool {
+	if m != nil {
+		return m.Masks
 	}
-	s = append(s, "DcId: "+fmt.Sprintf("%#v", this.DcId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return false
+}
+
+func (m *TLMediaCreateStickerSet) GetAnimated() bool {
+	if m != nil {
+		return m.Animated
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return false
 }
-func (this *TLPhotoSizeList) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMediaCreateStickerSet) GetVideos() bool {
+	if m != nil {
+		return m.Videos
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&media.TLPhotoSizeList{")
-	if this.Data2 != nil {
-		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	return false
+}
+
+func (m *TLMediaCreateStickerSet) GetEmojis() bool {
+	if m != nil {
+		return m.Emojis
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return false
+}
+
+func (m *TLMediaCreateStickerSet) GetOwnerId() int64 {
+	if m != nil {
+		return m.OwnerId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *VideoSizeList) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMediaCreateStickerSet) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&media.VideoSizeList{")
-	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "SizeId: "+fmt.Sprintf("%#v", this.SizeId)+",\n")
-	if this.Sizes != nil {
-		s = append(s, "Sizes: "+fmt.Sprintf("%#v", this.Sizes)+",\n")
+	return ""
+}
+
+func (m *TLMediaCreateStickerSet) GetShortName() string {
+	if m != nil {
+		return m.ShortName
 	}
-	s = append(s, "DcId: "+fmt.Sprintf("%#v", this.DcId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return ""
+}
+
+func (m *TLMediaCreateStickerSet) GetThumbDocumentId() int64 {
+	if m != nil {
+		return m.ThumbDocumentId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLVideoSizeList) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMediaCreateStickerSet) GetStickers() []*mtproto.InputStickerSetItem {
+	if m != nil {
+		return m.Stickers
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&media.TLVideoSizeList{")
-	if this.Data2 != nil {
-		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------
+// media.addStickerToSet owner_id:long stickerset:InputStickerSet sticker:InputStickerSetItem = messages.StickerSet;
+type TLMediaAddStickerToSet struct {
+	Constructor          TLConstructor                `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	OwnerId              int64                        `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet     `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	Sticker              *mtproto.InputStickerSetItem `protobuf:"bytes,5,opt,name=sticker,proto3" json:"sticker,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *TLMediaAddStickerToSet) Reset()         { *m = TLMediaAddStickerToSet{} }
+func (m *TLMediaAddStickerToSet) String() string { return proto.CompactTextString(m) }
+func (*TLMediaAddStickerToSet) ProtoMessage()    {}
+func (*TLMediaAddStickerToSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{22}
+}
+func (m *TLMediaAddStickerToSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaAddStickerToSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaAddStickerToSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLMediaAddStickerToSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaAddStickerToSet.Merge(m, src)
+}
+func (m *TLMediaAddStickerToSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaAddStickerToSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaAddStickerToSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMediaAddStickerToSet proto.InternalMessageInfo
+
+func (m *TLMediaAddStickerToSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLMediaUploadPhotoFile) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMediaAddStickerToSet) GetOwnerId() int64 {
+	if m != nil {
+		return m.OwnerId
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&media.TLMediaUploadPhotoFile{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
-	if this.File != nil {
-		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
-	}
-	if this.Stickers != nil {
-		s = append(s, "Stickers: "+fmt.Sprintf("%#v", this.Stickers)+",\n")
-	}
-	if this.TtlSeconds != nil {
-		s = append(s, "TtlSeconds: "+fmt.Sprintf("%#v", this.TtlSeconds)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMediaUploadProfilePhotoFile) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&media.TLMediaUploadProfilePhotoFile{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
-	if this.File != nil {
-		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
-	}
-	if this.Video != nil {
-		s = append(s, "Video: "+fmt.Sprintf("%#v", this.Video)+",\n")
-	}
-	if this.VideoStartTs != nil {
-		s = append(s, "VideoStartTs: "+fmt.Sprintf("%#v", this.VideoStartTs)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+func (m *TLMediaAddStickerToSet) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *TLMediaGetPhoto) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&media.TLMediaGetPhoto{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "PhotoId: "+fmt.Sprintf("%#v", this.PhotoId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+func (m *TLMediaAddStickerToSet) GetSticker() *mtproto.InputStickerSetItem {
+	if m != nil {
+		return m.Sticker
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *TLMediaGetPhotoSizeList) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&media.TLMediaGetPhotoSizeList{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "SizeId: "+fmt.Sprintf("%#v", this.SizeId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+// --------------------------------------------------------------------------------------------
+// media.removeStickerFromSet owner_id:long document_id:long = messages.StickerSet;
+type TLMediaRemoveStickerFromSet struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	OwnerId              int64         `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	DocumentId           int64         `protobuf:"varint,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (this *TLMediaGetPhotoSizeListList) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMediaRemoveStickerFromSet) Reset()         { *m = TLMediaRemoveStickerFromSet{} }
+func (m *TLMediaRemoveStickerFromSet) String() string { return proto.CompactTextString(m) }
+func (*TLMediaRemoveStickerFromSet) ProtoMessage()    {}
+func (*TLMediaRemoveStickerFromSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{23}
+}
+func (m *TLMediaRemoveStickerFromSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaRemoveStickerFromSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaRemoveStickerFromSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&media.TLMediaGetPhotoSizeListList{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "IdList: "+fmt.Sprintf("%#v", this.IdList)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLMediaRemoveStickerFromSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaRemoveStickerFromSet.Merge(m, src)
+}
+func (m *TLMediaRemoveStickerFromSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaRemoveStickerFromSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaRemoveStickerFromSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMediaRemoveStickerFromSet proto.InternalMessageInfo
+
+func (m *TLMediaRemoveStickerFromSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLMediaGetVideoSizeList) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMediaRemoveStickerFromSet) GetOwnerId() int64 {
+	if m != nil {
+		return m.OwnerId
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&media.TLMediaGetVideoSizeList{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "SizeId: "+fmt.Sprintf("%#v", this.SizeId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMediaRemoveStickerFromSet) GetDocumentId() int64 {
+	if m != nil {
+		return m.DocumentId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMediaUploadedDocumentMedia) GoString() string {
-	if this == nil {
-		return "nil"
+
+// --------------------------------------------------------------------------------------------
+// media.changeStickerPosition owner_id:long document_id:long position:int = messages.StickerSet;
+type TLMediaChangeStickerPosition struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	OwnerId              int64         `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	DocumentId           int64         `protobuf:"varint,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Position             int32         `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMediaChangeStickerPosition) Reset()         { *m = TLMediaChangeStickerPosition{} }
+func (m *TLMediaChangeStickerPosition) String() string { return proto.CompactTextString(m) }
+func (*TLMediaChangeStickerPosition) ProtoMessage()    {}
+func (*TLMediaChangeStickerPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{24}
+}
+func (m *TLMediaChangeStickerPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaChangeStickerPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaChangeStickerPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&media.TLMediaUploadedDocumentMedia{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
-	if this.Media != nil {
-		s = append(s, "Media: "+fmt.Sprintf("%#v", this.Media)+",\n")
+}
+func (m *TLMediaChangeStickerPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaChangeStickerPosition.Merge(m, src)
+}
+func (m *TLMediaChangeStickerPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaChangeStickerPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaChangeStickerPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMediaChangeStickerPosition proto.InternalMessageInfo
+
+func (m *TLMediaChangeStickerPosition) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMediaChangeStickerPosition) GetOwnerId() int64 {
+	if m != nil {
+		return m.OwnerId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMediaGetDocument) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMediaChangeStickerPosition) GetDocumentId() int64 {
+	if m != nil {
+		return m.DocumentId
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&media.TLMediaGetDocument{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMediaChangeStickerPosition) GetPosition() int32 {
+	if m != nil {
+		return m.Position
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMediaGetDocumentList) GoString() string {
-	if this == nil {
-		return "nil"
+
+// --------------------------------------------------------------------------------------------
+// media.setStickerSetThumb owner_id:long stickerset:InputStickerSet thumb_document_id:long = messages.StickerSet;
+type TLMediaSetStickerSetThumb struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	OwnerId              int64                    `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	ThumbDocumentId      int64                    `protobuf:"varint,5,opt,name=thumb_document_id,json=thumbDocumentId,proto3" json:"thumb_document_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLMediaSetStickerSetThumb) Reset()         { *m = TLMediaSetStickerSetThumb{} }
+func (m *TLMediaSetStickerSetThumb) String() string { return proto.CompactTextString(m) }
+func (*TLMediaSetStickerSetThumb) ProtoMessage()    {}
+func (*TLMediaSetStickerSetThumb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{25}
+}
+func (m *TLMediaSetStickerSetThumb) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaSetStickerSetThumb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaSetStickerSetThumb.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&media.TLMediaGetDocumentList{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "IdList: "+fmt.Sprintf("%#v", this.IdList)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLMediaSetStickerSetThumb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaSetStickerSetThumb.Merge(m, src)
+}
+func (m *TLMediaSetStickerSetThumb) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaSetStickerSetThumb) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaSetStickerSetThumb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMediaSetStickerSetThumb proto.InternalMessageInfo
+
+func (m *TLMediaSetStickerSetThumb) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLMediaUploadEncryptedFile) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMediaSetStickerSetThumb) GetOwnerId() int64 {
+	if m != nil {
+		return m.OwnerId
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&media.TLMediaUploadEncryptedFile{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
-	if this.File != nil {
-		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
+	return 0
+}
+
+func (m *TLMediaSetStickerSetThumb) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return nil
+}
+
+func (m *TLMediaSetStickerSetThumb) GetThumbDocumentId() int64 {
+	if m != nil {
+		return m.ThumbDocumentId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMediaGetEncryptedFile) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&media.TLMediaGetEncryptedFile{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "AccessHash: "+fmt.Sprintf("%#v", this.AccessHash)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TLMediaUploadWallPaperFile) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&media.TLMediaUploadWallPaperFile{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
-	if this.File != nil {
-		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
-	}
-	s = append(s, "MimeType: "+fmt.Sprintf("%#v", this.MimeType)+",\n")
-	if this.Admin != nil {
-		s = append(s, "Admin: "+fmt.Sprintf("%#v", this.Admin)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+// --------------------------------------------------------------------------------------------
+// media.checkStickerSetShortName short_name:string = Bool;
+type TLMediaCheckStickerSetShortName struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	ShortName            string        `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (this *TLMediaUploadThemeFile) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 10)
-	s = append(s, "&media.TLMediaUploadThemeFile{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
-	if this.File != nil {
-		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
-	}
-	if this.Thumb != nil {
-		s = append(s, "Thumb: "+fmt.Sprintf("%#v", this.Thumb)+",\n")
-	}
-	s = append(s, "MimeType: "+fmt.Sprintf("%#v", this.MimeType)+",\n")
-	s = append(s, "FileName: "+fmt.Sprintf("%#v", this.FileName)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *TLMediaCheckStickerSetShortName) Reset()         { *m = TLMediaCheckStickerSetShortName{} }
+func (m *TLMediaCheckStickerSetShortName) String() string { return proto.CompactTextString(m) }
+func (*TLMediaCheckStickerSetShortName) ProtoMessage()    {}
+func (*TLMediaCheckStickerSetShortName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{26}
 }
-func (this *TLMediaUploadStickerFile) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 11)
-	s = append(s, "&media.TLMediaUploadStickerFile{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
-	if this.File != nil {
-		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
-	}
-	if this.Thumb != nil {
-		s = append(s, "Thumb: "+fmt.Sprintf("%#v", this.Thumb)+",\n")
-	}
-	s = append(s, "MimeType: "+fmt.Sprintf("%#v", this.MimeType)+",\n")
-	s = append(s, "FileName: "+fmt.Sprintf("%#v", this.FileName)+",\n")
-	if this.DocumentAttributeSticker != nil {
-		s = append(s, "DocumentAttributeSticker: "+fmt.Sprintf("%#v", this.DocumentAttributeSticker)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *TLMediaCheckStickerSetShortName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *TLMediaUploadRingtoneFile) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&media.TLMediaUploadRingtoneFile{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
-	if this.File != nil {
-		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
-	}
-	s = append(s, "MimeType: "+fmt.Sprintf("%#v", this.MimeType)+",\n")
-	s = append(s, "FileName: "+fmt.Sprintf("%#v", this.FileName)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+func (m *TLMediaCheckStickerSetShortName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaCheckStickerSetShortName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *Vector_PhotoSizeList) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&media.Vector_PhotoSizeList{")
-	if this.Datas != nil {
-		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *TLMediaCheckStickerSetShortName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaCheckStickerSetShortName.Merge(m, src)
 }
-func (this *Vector_Document) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&media.Vector_Document{")
-	if this.Datas != nil {
-		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *TLMediaCheckStickerSetShortName) XXX_Size() int {
+	return m.Size()
 }
-func valueToGoStringMediaTl(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
-	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+func (m *TLMediaCheckStickerSetShortName) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaCheckStickerSetShortName.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_TLMediaCheckStickerSetShortName proto.InternalMessageInfo
 
-// RPCMediaClient is the client API for RPCMedia service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RPCMediaClient interface {
-	MediaUploadPhotoFile(ctx context.Context, in *TLMediaUploadPhotoFile, opts ...grpc.CallOption) (*mtproto.Photo, error)
-	MediaUploadProfilePhotoFile(ctx context.Context, in *TLMediaUploadProfilePhotoFile, opts ...grpc.CallOption) (*mtproto.Photo, error)
-	MediaGetPhoto(ctx context.Context, in *TLMediaGetPhoto, opts ...grpc.CallOption) (*mtproto.Photo, error)
-	MediaGetPhotoSizeList(ctx context.Context, in *TLMediaGetPhotoSizeList, opts ...grpc.CallOption) (*PhotoSizeList, error)
-	MediaGetPhotoSizeListList(ctx context.Context, in *TLMediaGetPhotoSizeListList, opts ...grpc.CallOption) (*Vector_PhotoSizeList, error)
-	MediaGetVideoSizeList(ctx context.Context, in *TLMediaGetVideoSizeList, opts ...grpc.CallOption) (*VideoSizeList, error)
-	MediaUploadedDocumentMedia(ctx context.Context, in *TLMediaUploadedDocumentMedia, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
-	MediaGetDocument(ctx context.Context, in *TLMediaGetDocument, opts ...grpc.CallOption) (*mtproto.Document, error)
-	MediaGetDocumentList(ctx context.Context, in *TLMediaGetDocumentList, opts ...grpc.CallOption) (*Vector_Document, error)
-	MediaUploadEncryptedFile(ctx context.Context, in *TLMediaUploadEncryptedFile, opts ...grpc.CallOption) (*mtproto.EncryptedFile, error)
-	MediaGetEncryptedFile(ctx context.Context, in *TLMediaGetEncryptedFile, opts ...grpc.CallOption) (*mtproto.EncryptedFile, error)
-	MediaUploadWallPaperFile(ctx context.Context, in *TLMediaUploadWallPaperFile, opts ...grpc.CallOption) (*mtproto.Document, error)
-	MediaUploadThemeFile(ctx context.Context, in *TLMediaUploadThemeFile, opts ...grpc.CallOption) (*mtproto.Document, error)
-	MediaUploadStickerFile(ctx context.Context, in *TLMediaUploadStickerFile, opts ...grpc.CallOption) (*mtproto.Document, error)
-	MediaUploadRingtoneFile(ctx context.Context, in *TLMediaUploadRingtoneFile, opts ...grpc.CallOption) (*mtproto.Document, error)
+func (m *TLMediaCheckStickerSetShortName) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
 }
 
-type rPCMediaClient struct {
-	cc *grpc.ClientConn
+func (m *TLMediaCheckStickerSetShortName) GetShortName() string {
+	if m != nil {
+		return m.ShortName
+	}
+	return ""
 }
 
-func NewRPCMediaClient(cc *grpc.ClientConn) RPCMediaClient {
-	return &rPCMediaClient{cc}
+// --------------------------------------------------------------------------------------------
+// media.getStickerSet user_id:long stickerset:InputStickerSet = messages.StickerSet;
+type TLMediaGetStickerSet struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (c *rPCMediaClient) MediaUploadPhotoFile(ctx context.Context, in *TLMediaUploadPhotoFile, opts ...grpc.CallOption) (*mtproto.Photo, error) {
-	out := new(mtproto.Photo)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadPhotoFile", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TLMediaGetStickerSet) Reset()         { *m = TLMediaGetStickerSet{} }
+func (m *TLMediaGetStickerSet) String() string { return proto.CompactTextString(m) }
+func (*TLMediaGetStickerSet) ProtoMessage()    {}
+func (*TLMediaGetStickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{27}
 }
-
-func (c *rPCMediaClient) MediaUploadProfilePhotoFile(ctx context.Context, in *TLMediaUploadProfilePhotoFile, opts ...grpc.CallOption) (*mtproto.Photo, error) {
-	out := new(mtproto.Photo)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadProfilePhotoFile", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TLMediaGetStickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *rPCMediaClient) MediaGetPhoto(ctx context.Context, in *TLMediaGetPhoto, opts ...grpc.CallOption) (*mtproto.Photo, error) {
-	out := new(mtproto.Photo)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getPhoto", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMediaGetStickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaGetStickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *rPCMediaClient) MediaGetPhotoSizeList(ctx context.Context, in *TLMediaGetPhotoSizeList, opts ...grpc.CallOption) (*PhotoSizeList, error) {
-	out := new(PhotoSizeList)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getPhotoSizeList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TLMediaGetStickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaGetStickerSet.Merge(m, src)
+}
+func (m *TLMediaGetStickerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaGetStickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaGetStickerSet.DiscardUnknown(m)
 }
 
-func (c *rPCMediaClient) MediaGetPhotoSizeListList(ctx context.Context, in *TLMediaGetPhotoSizeListList, opts ...grpc.CallOption) (*Vector_PhotoSizeList, error) {
-	out := new(Vector_PhotoSizeList)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getPhotoSizeListList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TLMediaGetStickerSet proto.InternalMessageInfo
+
+func (m *TLMediaGetStickerSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return out, nil
+	return CRC32_UNKNOWN
 }
 
-func (c *rPCMediaClient) MediaGetVideoSizeList(ctx context.Context, in *TLMediaGetVideoSizeList, opts ...grpc.CallOption) (*VideoSizeList, error) {
-	out := new(VideoSizeList)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getVideoSizeList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMediaGetStickerSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMediaClient) MediaUploadedDocumentMedia(ctx context.Context, in *TLMediaUploadedDocumentMedia, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
-	out := new(mtproto.MessageMedia)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadedDocumentMedia", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMediaGetStickerSet) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rPCMediaClient) MediaGetDocument(ctx context.Context, in *TLMediaGetDocument, opts ...grpc.CallOption) (*mtproto.Document, error) {
-	out := new(mtproto.Document)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// --------------------------------------------------------------------------------------------
+// media.installStickerSet flags:# archived:flags.0?true user_id:long stickerset:InputStickerSet = messages.StickerSetInstallResult;
+type TLMediaInstallStickerSet struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	Archived             bool                     `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	UserId               int64                    `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,5,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (c *rPCMediaClient) MediaGetDocumentList(ctx context.Context, in *TLMediaGetDocumentList, opts ...grpc.CallOption) (*Vector_Document, error) {
-	out := new(Vector_Document)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getDocumentList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TLMediaInstallStickerSet) Reset()         { *m = TLMediaInstallStickerSet{} }
+func (m *TLMediaInstallStickerSet) String() string { return proto.CompactTextString(m) }
+func (*TLMediaInstallStickerSet) ProtoMessage()    {}
+func (*TLMediaInstallStickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{28}
 }
-
-func (c *rPCMediaClient) MediaUploadEncryptedFile(ctx context.Context, in *TLMediaUploadEncryptedFile, opts ...grpc.CallOption) (*mtproto.EncryptedFile, error) {
-	out := new(mtproto.EncryptedFile)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadEncryptedFile", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TLMediaInstallStickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *rPCMediaClient) MediaGetEncryptedFile(ctx context.Context, in *TLMediaGetEncryptedFile, opts ...grpc.CallOption) (*mtproto.EncryptedFile, error) {
-	out := new(mtproto.EncryptedFile)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getEncryptedFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMediaInstallStickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaInstallStickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TLMediaInstallStickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaInstallStickerSet.Merge(m, src)
+}
+func (m *TLMediaInstallStickerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaInstallStickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaInstallStickerSet.DiscardUnknown(m)
 }
 
-func (c *rPCMediaClient) MediaUploadWallPaperFile(ctx context.Context, in *TLMediaUploadWallPaperFile, opts ...grpc.CallOption) (*mtproto.Document, error) {
-	out := new(mtproto.Document)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadWallPaperFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TLMediaInstallStickerSet proto.InternalMessageInfo
+
+func (m *TLMediaInstallStickerSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return out, nil
+	return CRC32_UNKNOWN
 }
 
-func (c *rPCMediaClient) MediaUploadThemeFile(ctx context.Context, in *TLMediaUploadThemeFile, opts ...grpc.CallOption) (*mtproto.Document, error) {
-	out := new(mtproto.Document)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadThemeFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMediaInstallStickerSet) GetArchived() bool {
+	if m != nil {
+		return m.Archived
 	}
-	return out, nil
+	return false
 }
 
-func (c *rPCMediaClient) MediaUploadStickerFile(ctx context.Context, in *TLMediaUploadStickerFile, opts ...grpc.CallOption) (*mtproto.Document, error) {
-	out := new(mtproto.Document)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadStickerFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMediaInstallStickerSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMediaClient) MediaUploadRingtoneFile(ctx context.Context, in *TLMediaUploadRingtoneFile, opts ...grpc.CallOption) (*mtproto.Document, error) {
-	out := new(mtproto.Document)
-	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadRingtoneFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMediaInstallStickerSet) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
 	}
-	return out, nil
+	return nil
 }
 
-// RPCMediaServer is the server API for RPCMedia service.
-type RPCMediaServer interface {
-	MediaUploadPhotoFile(context.Context, *TLMediaUploadPhotoFile) (*mtproto.Photo, error)
-	MediaUploadProfilePhotoFile(context.Context, *TLMediaUploadProfilePhotoFile) (*mtproto.Photo, error)
-	MediaGetPhoto(context.Context, *TLMediaGetPhoto) (*mtproto.Photo, error)
-	MediaGetPhotoSizeList(context.Context, *TLMediaGetPhotoSizeList) (*PhotoSizeList, error)
-	MediaGetPhotoSizeListList(context.Context, *TLMediaGetPhotoSizeListList) (*Vector_PhotoSizeList, error)
-	MediaGetVideoSizeList(context.Context, *TLMediaGetVideoSizeList) (*VideoSizeList, error)
-	MediaUploadedDocumentMedia(context.Context, *TLMediaUploadedDocumentMedia) (*mtproto.MessageMedia, error)
-	MediaGetDocument(context.Context, *TLMediaGetDocument) (*mtproto.Document, error)
-	MediaGetDocumentList(context.Context, *TLMediaGetDocumentList) (*Vector_Document, error)
-	MediaUploadEncryptedFile(context.Context, *TLMediaUploadEncryptedFile) (*mtproto.EncryptedFile, error)
-	MediaGetEncryptedFile(context.Context, *TLMediaGetEncryptedFile) (*mtproto.EncryptedFile, error)
-	MediaUploadWallPaperFile(context.Context, *TLMediaUploadWallPaperFile) (*mtproto.Document, error)
-	MediaUploadThemeFile(context.Context, *TLMediaUploadThemeFile) (*mtproto.Document, error)
-	MediaUploadStickerFile(context.Context, *TLMediaUploadStickerFile) (*mtproto.Document, error)
-	MediaUploadRingtoneFile(context.Context, *TLMediaUploadRingtoneFile) (*mtproto.Document, error)
+// --------------------------------------------------------------------------------------------
+// media.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+type TLMediaUninstallStickerSet struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-// UnimplementedRPCMediaServer can be embedded to have forward compatible implementations.
-type UnimplementedRPCMediaServer struct {
+func (m *TLMediaUninstallStickerSet) Reset()         { *m = TLMediaUninstallStickerSet{} }
+func (m *TLMediaUninstallStickerSet) String() string { return proto.CompactTextString(m) }
+func (*TLMediaUninstallStickerSet) ProtoMessage()    {}
+func (*TLMediaUninstallStickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{29}
 }
-
-func (*UnimplementedRPCMediaServer) MediaUploadPhotoFile(ctx context.Context, req *TLMediaUploadPhotoFile) (*mtproto.Photo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadPhotoFile not implemented")
+func (m *TLMediaUninstallStickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRPCMediaServer) MediaUploadProfilePhotoFile(ctx context.Context, req *TLMediaUploadProfilePhotoFile) (*mtproto.Photo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadProfilePhotoFile not implemented")
+func (m *TLMediaUninstallStickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaUninstallStickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRPCMediaServer) MediaGetPhoto(ctx context.Context, req *TLMediaGetPhoto) (*mtproto.Photo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaGetPhoto not implemented")
+func (m *TLMediaUninstallStickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaUninstallStickerSet.Merge(m, src)
 }
-func (*UnimplementedRPCMediaServer) MediaGetPhotoSizeList(ctx context.Context, req *TLMediaGetPhotoSizeList) (*PhotoSizeList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaGetPhotoSizeList not implemented")
+func (m *TLMediaUninstallStickerSet) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedRPCMediaServer) MediaGetPhotoSizeListList(ctx context.Context, req *TLMediaGetPhotoSizeListList) (*Vector_PhotoSizeList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaGetPhotoSizeListList not implemented")
+func (m *TLMediaUninstallStickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaUninstallStickerSet.DiscardUnknown(m)
 }
-func (*UnimplementedRPCMediaServer) MediaGetVideoSizeList(ctx context.Context, req *TLMediaGetVideoSizeList) (*VideoSizeList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaGetVideoSizeList not implemented")
+
+var xxx_messageInfo_TLMediaUninstallStickerSet proto.InternalMessageInfo
+
+func (m *TLMediaUninstallStickerSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
 }
-func (*UnimplementedRPCMediaServer) MediaUploadedDocumentMedia(ctx context.Context, req *TLMediaUploadedDocumentMedia) (*mtproto.MessageMedia, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadedDocumentMedia not implemented")
+
+func (m *TLMediaUninstallStickerSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
 }
-func (*UnimplementedRPCMediaServer) MediaGetDocument(ctx context.Context, req *TLMediaGetDocument) (*mtproto.Document, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaGetDocument not implemented")
+
+func (m *TLMediaUninstallStickerSet) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
+	}
+	return nil
 }
-func (*UnimplementedRPCMediaServer) MediaGetDocumentList(ctx context.Context, req *TLMediaGetDocumentList) (*Vector_Document, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaGetDocumentList not implemented")
+
+// --------------------------------------------------------------------------------------------
+// media.reorderStickerSets flags:# masks:flags.0?true emojis:flags.1?true user_id:long order:Vector<long> = Bool;
+type TLMediaReorderStickerSets struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	Masks                bool          `protobuf:"varint,3,opt,name=masks,proto3" json:"masks,omitempty"`
+	Emojis               bool          `protobuf:"varint,4,opt,name=emojis,proto3" json:"emojis,omitempty"`
+	UserId               int64         `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Order                []int64       `protobuf:"varint,6,rep,packed,name=order,proto3" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (*UnimplementedRPCMediaServer) MediaUploadEncryptedFile(ctx context.Context, req *TLMediaUploadEncryptedFile) (*mtproto.EncryptedFile, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadEncryptedFile not implemented")
+
+func (m *TLMediaReorderStickerSets) Reset()         { *m = TLMediaReorderStickerSets{} }
+func (m *TLMediaReorderStickerSets) String() string { return proto.CompactTextString(m) }
+func (*TLMediaReorderStickerSets) ProtoMessage()    {}
+func (*TLMediaReorderStickerSets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{30}
 }
-func (*UnimplementedRPCMediaServer) MediaGetEncryptedFile(ctx context.Context, req *TLMediaGetEncryptedFile) (*mtproto.EncryptedFile, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaGetEncryptedFile not implemented")
+func (m *TLMediaReorderStickerSets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRPCMediaServer) MediaUploadWallPaperFile(ctx context.Context, req *TLMediaUploadWallPaperFile) (*mtproto.Document, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadWallPaperFile not implemented")
+func (m *TLMediaReorderStickerSets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaReorderStickerSets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRPCMediaServer) MediaUploadThemeFile(ctx context.Context, req *TLMediaUploadThemeFile) (*mtproto.Document, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadThemeFile not implemented")
+func (m *TLMediaReorderStickerSets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaReorderStickerSets.Merge(m, src)
 }
-func (*UnimplementedRPCMediaServer) MediaUploadStickerFile(ctx context.Context, req *TLMediaUploadStickerFile) (*mtproto.Document, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadStickerFile not implemented")
+func (m *TLMediaReorderStickerSets) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedRPCMediaServer) MediaUploadRingtoneFile(ctx context.Context, req *TLMediaUploadRingtoneFile) (*mtproto.Document, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadRingtoneFile not implemented")
+func (m *TLMediaReorderStickerSets) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaReorderStickerSets.DiscardUnknown(m)
 }
 
-func RegisterRPCMediaServer(s *grpc.Server, srv RPCMediaServer) {
-	s.RegisterService(&_RPCMedia_serviceDesc, srv)
+var xxx_messageInfo_TLMediaReorderStickerSets proto.InternalMessageInfo
+
+func (m *TLMediaReorderStickerSets) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
 }
 
-func _RPCMedia_MediaUploadPhotoFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaUploadPhotoFile)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *TLMediaReorderStickerSets) GetMasks() bool {
+	if m != nil {
+		return m.Masks
 	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaUploadPhotoFile(ctx, in)
+	return false
+}
+
+func (m *TLMediaReorderStickerSets) GetEmojis() bool {
+	if m != nil {
+		return m.Emojis
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaUploadPhotoFile",
+	return false
+}
+
+func (m *TLMediaReorderStickerSets) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaUploadPhotoFile(ctx, req.(*TLMediaUploadPhotoFile))
+	return 0
+}
+
+func (m *TLMediaReorderStickerSets) GetOrder() []int64 {
+	if m != nil {
+		return m.Order
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _RPCMedia_MediaUploadProfilePhotoFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaUploadProfilePhotoFile)
-	if err := dec(in); err != nil {
-		return nil, err
+// --------------------------------------------------------------------------------------------
+// media.getAllStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long hash:long = messages.AllStickers;
+type TLMediaGetAllStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	Masks                bool          `protobuf:"varint,3,opt,name=masks,proto3" json:"masks,omitempty"`
+	Emojis               bool          `protobuf:"varint,4,opt,name=emojis,proto3" json:"emojis,omitempty"`
+	UserId               int64         `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Hash                 int64         `protobuf:"varint,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMediaGetAllStickers) Reset()         { *m = TLMediaGetAllStickers{} }
+func (m *TLMediaGetAllStickers) String() string { return proto.CompactTextString(m) }
+func (*TLMediaGetAllStickers) ProtoMessage()    {}
+func (*TLMediaGetAllStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{31}
+}
+func (m *TLMediaGetAllStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaGetAllStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaGetAllStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaUploadProfilePhotoFile(ctx, in)
+}
+func (m *TLMediaGetAllStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaGetAllStickers.Merge(m, src)
+}
+func (m *TLMediaGetAllStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaGetAllStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaGetAllStickers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMediaGetAllStickers proto.InternalMessageInfo
+
+func (m *TLMediaGetAllStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaUploadProfilePhotoFile",
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMediaGetAllStickers) GetMasks() bool {
+	if m != nil {
+		return m.Masks
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaUploadProfilePhotoFile(ctx, req.(*TLMediaUploadProfilePhotoFile))
+	return false
+}
+
+func (m *TLMediaGetAllStickers) GetEmojis() bool {
+	if m != nil {
+		return m.Emojis
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _RPCMedia_MediaGetPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaGetPhoto)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *TLMediaGetAllStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaGetPhoto(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaGetPhoto",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaGetPhoto(ctx, req.(*TLMediaGetPhoto))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _RPCMedia_MediaGetPhotoSizeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaGetPhotoSizeList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaGetPhotoSizeList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaGetPhotoSizeList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaGetPhotoSizeList(ctx, req.(*TLMediaGetPhotoSizeList))
+func (m *TLMediaGetAllStickers) GetHash() int64 {
+	if m != nil {
+		return m.Hash
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _RPCMedia_MediaGetPhotoSizeListList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaGetPhotoSizeListList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaGetPhotoSizeListList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaGetPhotoSizeListList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaGetPhotoSizeListList(ctx, req.(*TLMediaGetPhotoSizeListList))
-	}
-	return interceptor(ctx, in, info, handler)
+// --------------------------------------------------------------------------------------------
+// media.getArchivedStickers flags:# masks:flags.0?true emojis:flags.1?true user_id:long offset_id:long limit:int = messages.ArchivedStickers;
+type TLMediaGetArchivedStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	Masks                bool          `protobuf:"varint,3,opt,name=masks,proto3" json:"masks,omitempty"`
+	Emojis               bool          `protobuf:"varint,4,opt,name=emojis,proto3" json:"emojis,omitempty"`
+	UserId               int64         `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OffsetId             int64         `protobuf:"varint,6,opt,name=offset_id,json=offsetId,proto3" json:"offset_id,omitempty"`
+	Limit                int32         `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func _RPCMedia_MediaGetVideoSizeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaGetVideoSizeList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaGetVideoSizeList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaGetVideoSizeList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaGetVideoSizeList(ctx, req.(*TLMediaGetVideoSizeList))
+func (m *TLMediaGetArchivedStickers) Reset()         { *m = TLMediaGetArchivedStickers{} }
+func (m *TLMediaGetArchivedStickers) String() string { return proto.CompactTextString(m) }
+func (*TLMediaGetArchivedStickers) ProtoMessage()    {}
+func (*TLMediaGetArchivedStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{32}
+}
+func (m *TLMediaGetArchivedStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaGetArchivedStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaGetArchivedStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *TLMediaGetArchivedStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaGetArchivedStickers.Merge(m, src)
+}
+func (m *TLMediaGetArchivedStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaGetArchivedStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaGetArchivedStickers.DiscardUnknown(m)
 }
 
-func _RPCMedia_MediaUploadedDocumentMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaUploadedDocumentMedia)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaUploadedDocumentMedia(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaUploadedDocumentMedia",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaUploadedDocumentMedia(ctx, req.(*TLMediaUploadedDocumentMedia))
+var xxx_messageInfo_TLMediaGetArchivedStickers proto.InternalMessageInfo
+
+func (m *TLMediaGetArchivedStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return interceptor(ctx, in, info, handler)
+	return CRC32_UNKNOWN
 }
 
-func _RPCMedia_MediaGetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaGetDocument)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaGetDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaGetDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaGetDocument(ctx, req.(*TLMediaGetDocument))
+func (m *TLMediaGetArchivedStickers) GetMasks() bool {
+	if m != nil {
+		return m.Masks
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _RPCMedia_MediaGetDocumentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaGetDocumentList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaGetDocumentList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaGetDocumentList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaGetDocumentList(ctx, req.(*TLMediaGetDocumentList))
+func (m *TLMediaGetArchivedStickers) GetEmojis() bool {
+	if m != nil {
+		return m.Emojis
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _RPCMedia_MediaUploadEncryptedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaUploadEncryptedFile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaUploadEncryptedFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaUploadEncryptedFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaUploadEncryptedFile(ctx, req.(*TLMediaUploadEncryptedFile))
+func (m *TLMediaGetArchivedStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _RPCMedia_MediaGetEncryptedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaGetEncryptedFile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaGetEncryptedFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaGetEncryptedFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaGetEncryptedFile(ctx, req.(*TLMediaGetEncryptedFile))
+func (m *TLMediaGetArchivedStickers) GetOffsetId() int64 {
+	if m != nil {
+		return m.OffsetId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _RPCMedia_MediaUploadWallPaperFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaUploadWallPaperFile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaUploadWallPaperFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaUploadWallPaperFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaUploadWallPaperFile(ctx, req.(*TLMediaUploadWallPaperFile))
+func (m *TLMediaGetArchivedStickers) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _RPCMedia_MediaUploadThemeFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaUploadThemeFile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaUploadThemeFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaUploadThemeFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaUploadThemeFile(ctx, req.(*TLMediaUploadThemeFile))
-	}
-	return interceptor(ctx, in, info, handler)
+// --------------------------------------------------------------------------------------------
+// media.searchStickerSets user_id:long q:string hash:long = messages.FoundStickerSets;
+type TLMediaSearchStickerSets struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Q                    string        `protobuf:"bytes,4,opt,name=q,proto3" json:"q,omitempty"`
+	Hash                 int64         `protobuf:"varint,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func _RPCMedia_MediaUploadStickerFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaUploadStickerFile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaUploadStickerFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaUploadStickerFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaUploadStickerFile(ctx, req.(*TLMediaUploadStickerFile))
+func (m *TLMediaSearchStickerSets) Reset()         { *m = TLMediaSearchStickerSets{} }
+func (m *TLMediaSearchStickerSets) String() string { return proto.CompactTextString(m) }
+func (*TLMediaSearchStickerSets) ProtoMessage()    {}
+func (*TLMediaSearchStickerSets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{33}
+}
+func (m *TLMediaSearchStickerSets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaSearchStickerSets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaSearchStickerSets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *TLMediaSearchStickerSets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaSearchStickerSets.Merge(m, src)
+}
+func (m *TLMediaSearchStickerSets) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaSearchStickerSets) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaSearchStickerSets.DiscardUnknown(m)
 }
 
-func _RPCMedia_MediaUploadRingtoneFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMediaUploadRingtoneFile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMediaServer).MediaUploadRingtoneFile(ctx, in)
+var xxx_messageInfo_TLMediaSearchStickerSets proto.InternalMessageInfo
+
+func (m *TLMediaSearchStickerSets) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/media.RPCMedia/MediaUploadRingtoneFile",
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMediaSearchStickerSets) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMediaServer).MediaUploadRingtoneFile(ctx, req.(*TLMediaUploadRingtoneFile))
+	return 0
+}
+
+func (m *TLMediaSearchStickerSets) GetQ() string {
+	if m != nil {
+		return m.Q
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _RPCMedia_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "media.RPCMedia",
-	HandlerType: (*RPCMediaServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "media_uploadPhotoFile",
-			Handler:    _RPCMedia_MediaUploadPhotoFile_Handler,
-		},
-		{
-			MethodName: "media_uploadProfilePhotoFile",
-			Handler:    _RPCMedia_MediaUploadProfilePhotoFile_Handler,
-		},
-		{
-			MethodName: "media_getPhoto",
-			Handler:    _RPCMedia_MediaGetPhoto_Handler,
-		},
-		{
-			MethodName: "media_getPhotoSizeList",
-			Handler:    _RPCMedia_MediaGetPhotoSizeList_Handler,
-		},
-		{
-			MethodName: "media_getPhotoSizeListList",
-			Handler:    _RPCMedia_MediaGetPhotoSizeListList_Handler,
-		},
-		{
-			MethodName: "media_getVideoSizeList",
-			Handler:    _RPCMedia_MediaGetVideoSizeList_Handler,
-		},
-		{
-			MethodName: "media_uploadedDocumentMedia",
-			Handler:    _RPCMedia_MediaUploadedDocumentMedia_Handler,
-		},
-		{
-			MethodName: "media_getDocument",
-			Handler:    _RPCMedia_MediaGetDocument_Handler,
-		},
-		{
-			MethodName: "media_getDocumentList",
-			Handler:    _RPCMedia_MediaGetDocumentList_Handler,
-		},
-		{
-			MethodName: "media_uploadEncryptedFile",
-			Handler:    _RPCMedia_MediaUploadEncryptedFile_Handler,
-		},
-		{
-			MethodName: "media_getEncryptedFile",
-			Handler:    _RPCMedia_MediaGetEncryptedFile_Handler,
-		},
-		{
-			MethodName: "media_uploadWallPaperFile",
-			Handler:    _RPCMedia_MediaUploadWallPaperFile_Handler,
-		},
-		{
-			MethodName: "media_uploadThemeFile",
-			Handler:    _RPCMedia_MediaUploadThemeFile_Handler,
-		},
-		{
-			MethodName: "media_uploadStickerFile",
-			Handler:    _RPCMedia_MediaUploadStickerFile_Handler,
-		},
-		{
-			MethodName: "media_uploadRingtoneFile",
-			Handler:    _RPCMedia_MediaUploadRingtoneFile_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "media.tl.proto",
+func (m *TLMediaSearchStickerSets) GetHash() int64 {
+	if m != nil {
+		return m.Hash
+	}
+	return 0
 }
 
-func (m *PhotoSizeList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// --------------------------------------------------------------------------------------------
+// media.getStickers user_id:long emoticon:string hash:long = messages.Stickers;
+type TLMediaGetStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoticon             string        `protobuf:"bytes,4,opt,name=emoticon,proto3" json:"emoticon,omitempty"`
+	Hash                 int64         `protobuf:"varint,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMediaGetStickers) Reset()         { *m = TLMediaGetStickers{} }
+func (m *TLMediaGetStickers) String() string { return proto.CompactTextString(m) }
+func (*TLMediaGetStickers) ProtoMessage()    {}
+func (*TLMediaGetStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{34}
+}
+func (m *TLMediaGetStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaGetStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaGetStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *TLMediaGetStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaGetStickers.Merge(m, src)
+}
+func (m *TLMediaGetStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaGetStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaGetStickers.DiscardUnknown(m)
 }
 
-func (m *PhotoSizeList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TLMediaGetStickers proto.InternalMessageInfo
+
+func (m *TLMediaGetStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
 }
 
-func (m *PhotoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *TLMediaGetStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	if m.DcId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.DcId))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *TLMediaGetStickers) GetEmoticon() string {
+	if m != nil {
+		return m.Emoticon
 	}
-	if len(m.Sizes) > 0 {
-		for iNdEx := len(m.Sizes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMediaTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return ""
+}
+
+func (m *TLMediaGetStickers) GetHash() int64 {
+	if m != nil {
+		return m.Hash
 	}
-	if m.SizeId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.SizeId))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+// media.getRecentStickers user_id:long hash:long = messages.RecentStickers;
+type TLMediaGetRecentStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Hash                 int64         `protobuf:"varint,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMediaGetRecentStickers) Reset()         { *m = TLMediaGetRecentStickers{} }
+func (m *TLMediaGetRecentStickers) String() string { return proto.CompactTextString(m) }
+func (*TLMediaGetRecentStickers) ProtoMessage()    {}
+func (*TLMediaGetRecentStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{35}
+}
+func (m *TLMediaGetRecentStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaGetRecentStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaGetRecentStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *TLMediaGetRecentStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaGetRecentStickers.Merge(m, src)
+}
+func (m *TLMediaGetRecentStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaGetRecentStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaGetRecentStickers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMediaGetRecentStickers proto.InternalMessageInfo
+
+func (m *TLMediaGetRecentStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	if len(m.PredicateName) > 0 {
-		i -= len(m.PredicateName)
-		copy(dAtA[i:], m.PredicateName)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.PredicateName)))
-		i--
-		dAtA[i] = 0xa
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMediaGetRecentStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TLPhotoSizeList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TLMediaGetRecentStickers) GetHash() int64 {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TLPhotoSizeList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// --------------------------------------------------------------------------------------------
+// media.saveRecentSticker flags:# unsave:flags.0?true user_id:long id:long = Bool;
+type TLMediaSaveRecentSticker struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	Unsave               bool          `protobuf:"varint,3,opt,name=unsave,proto3" json:"unsave,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id                   int64         `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *TLPhotoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Data2 != nil {
-		{
-			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+func (m *TLMediaSaveRecentSticker) Reset()         { *m = TLMediaSaveRecentSticker{} }
+func (m *TLMediaSaveRecentSticker) String() string { return proto.CompactTextString(m) }
+func (*TLMediaSaveRecentSticker) ProtoMessage()    {}
+func (*TLMediaSaveRecentSticker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{36}
+}
+func (m *TLMediaSaveRecentSticker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaSaveRecentSticker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaSaveRecentSticker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TLMediaSaveRecentSticker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaSaveRecentSticker.Merge(m, src)
+}
+func (m *TLMediaSaveRecentSticker) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaSaveRecentSticker) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaSaveRecentSticker.DiscardUnknown(m)
 }
 
-func (m *VideoSizeList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TLMediaSaveRecentSticker proto.InternalMessageInfo
+
+func (m *TLMediaSaveRecentSticker) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return dAtA[:n], nil
+	return CRC32_UNKNOWN
 }
 
-func (m *VideoSizeList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TLMediaSaveRecentSticker) GetUnsave() bool {
+	if m != nil {
+		return m.Unsave
+	}
+	return false
 }
 
-func (m *VideoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *TLMediaSaveRecentSticker) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	if m.DcId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.DcId))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *TLMediaSaveRecentSticker) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Sizes) > 0 {
-		for iNdEx := len(m.Sizes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMediaTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+// media.clearRecentStickers user_id:long = Bool;
+type TLMediaClearRecentStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMediaClearRecentStickers) Reset()         { *m = TLMediaClearRecentStickers{} }
+func (m *TLMediaClearRecentStickers) String() string { return proto.CompactTextString(m) }
+func (*TLMediaClearRecentStickers) ProtoMessage()    {}
+func (*TLMediaClearRecentStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{37}
+}
+func (m *TLMediaClearRecentStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaClearRecentStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaClearRecentStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SizeId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.SizeId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.PredicateName) > 0 {
-		i -= len(m.PredicateName)
-		copy(dAtA[i:], m.PredicateName)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.PredicateName)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TLMediaClearRecentStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaClearRecentStickers.Merge(m, src)
+}
+func (m *TLMediaClearRecentStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaClearRecentStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaClearRecentStickers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMediaClearRecentStickers proto.InternalMessageInfo
+
+func (m *TLMediaClearRecentStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return len(dAtA) - i, nil
+	return CRC32_UNKNOWN
 }
 
-func (m *TLVideoSizeList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TLMediaClearRecentStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TLVideoSizeList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// --------------------------------------------------------------------------------------------
+// media.getFavedStickers user_id:long hash:long = messages.FavedStickers;
+type TLMediaGetFavedStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Hash                 int64         `protobuf:"varint,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *TLVideoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Data2 != nil {
-		{
-			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+func (m *TLMediaGetFavedStickers) Reset()         { *m = TLMediaGetFavedStickers{} }
+func (m *TLMediaGetFavedStickers) String() string { return proto.CompactTextString(m) }
+func (*TLMediaGetFavedStickers) ProtoMessage()    {}
+func (*TLMediaGetFavedStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{38}
+}
+func (m *TLMediaGetFavedStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaGetFavedStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaGetFavedStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TLMediaGetFavedStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaGetFavedStickers.Merge(m, src)
+}
+func (m *TLMediaGetFavedStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaGetFavedStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaGetFavedStickers.DiscardUnknown(m)
 }
 
-func (m *TLMediaUploadPhotoFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TLMediaGetFavedStickers proto.InternalMessageInfo
+
+func (m *TLMediaGetFavedStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return dAtA[:n], nil
+	return CRC32_UNKNOWN
 }
 
-func (m *TLMediaUploadPhotoFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TLMediaGetFavedStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
 }
 
-func (m *TLMediaUploadPhotoFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.TtlSeconds != nil {
-		{
-			size, err := m.TtlSeconds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+func (m *TLMediaGetFavedStickers) GetHash() int64 {
+	if m != nil {
+		return m.Hash
 	}
-	if len(m.Stickers) > 0 {
-		for iNdEx := len(m.Stickers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stickers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMediaTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+// media.faveSticker flags:# unfave:flags.0?true user_id:long id:long = Bool;
+type TLMediaFaveSticker struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	Unfave               bool          `protobuf:"varint,3,opt,name=unfave,proto3" json:"unfave,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id                   int64         `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMediaFaveSticker) Reset()         { *m = TLMediaFaveSticker{} }
+func (m *TLMediaFaveSticker) String() string { return proto.CompactTextString(m) }
+func (*TLMediaFaveSticker) ProtoMessage()    {}
+func (*TLMediaFaveSticker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{39}
+}
+func (m *TLMediaFaveSticker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMediaFaveSticker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaFaveSticker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+}
+func (m *TLMediaFaveSticker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaFaveSticker.Merge(m, src)
+}
+func (m *TLMediaFaveSticker) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMediaFaveSticker) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaFaveSticker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMediaFaveSticker proto.InternalMessageInfo
+
+func (m *TLMediaFaveSticker) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	if m.OwnerId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
-		i--
-		dAtA[i] = 0x18
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMediaFaveSticker) GetUnfave() bool {
+	if m != nil {
+		return m.Unfave
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *TLMediaFaveSticker) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TLMediaUploadProfilePhotoFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TLMediaFaveSticker) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TLMediaUploadProfilePhotoFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// --------------------------------------------------------------------------------------------
+// media.getStickerSetThumbDocument stickerset:InputStickerSet = Document;
+type TLMediaGetStickerSetThumbDocument struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=media.TLConstructor" json:"constructor,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,3,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *TLMediaUploadProfilePhotoFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.VideoStartTs != nil {
-		{
-			size, err := m.VideoStartTs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Video != nil {
-		{
-			size, err := m.Video.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.OwnerId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *TLMediaGetStickerSetThumbDocument) Reset()         { *m = TLMediaGetStickerSetThumbDocument{} }
+func (m *TLMediaGetStickerSetThumbDocument) String() string { return proto.CompactTextString(m) }
+func (*TLMediaGetStickerSetThumbDocument) ProtoMessage()    {}
+func (*TLMediaGetStickerSetThumbDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c788ef787fa9e2c6, []int{40}
 }
-
-func (m *TLMediaGetPhoto) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaGetPhoto) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMediaGetPhoto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PhotoId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.PhotoId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *TLMediaGetStickerSetThumbDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *TLMediaGetPhotoSizeList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TLMediaGetStickerSetThumbDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMediaGetStickerSetThumbDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaGetPhotoSizeList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-
-func (m *TLMediaGetPhotoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SizeId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.SizeId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *TLMediaGetStickerSetThumbDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMediaGetStickerSetThumbDocument.Merge(m, src)
 }
-
-func (m *TLMediaGetPhotoSizeListList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TLMediaGetStickerSetThumbDocument) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *TLMediaGetPhotoSizeListList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TLMediaGetStickerSetThumbDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMediaGetStickerSetThumbDocument.DiscardUnknown(m)
 }
 
-func (m *TLMediaGetPhotoSizeListList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.IdList) > 0 {
-		dAtA9 := make([]byte, len(m.IdList)*10)
-		var j8 int
-		for _, num1 := range m.IdList {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintMediaTl(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_TLMediaGetStickerSetThumbDocument proto.InternalMessageInfo
 
-func (m *TLMediaGetVideoSizeList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TLMediaGetStickerSetThumbDocument) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaGetVideoSizeList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return CRC32_UNKNOWN
 }
 
-func (m *TLMediaGetVideoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SizeId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.SizeId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+func (m *TLMediaGetStickerSetThumbDocument) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TLMediaUploadedDocumentMedia) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterEnum("media.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*PhotoSizeList)(nil), "media.PhotoSizeList")
+	proto.RegisterType((*TLPhotoSizeList)(nil), "media.TL_photoSizeList")
+	proto.RegisterType((*VideoSizeList)(nil), "media.VideoSizeList")
+	proto.RegisterType((*TLVideoSizeList)(nil), "media.TL_videoSizeList")
+	proto.RegisterType((*TLMediaUploadPhotoFile)(nil), "media.TL_media_uploadPhotoFile")
+	proto.RegisterType((*TLMediaUploadProfilePhotoFile)(nil), "media.TL_media_uploadProfilePhotoFile")
+	proto.RegisterType((*TLMediaGetPhoto)(nil), "media.TL_media_getPhoto")
+	proto.RegisterType((*TLMediaGetPhotoSizeList)(nil), "media.TL_media_getPhotoSizeList")
+	proto.RegisterType((*TLMediaGetPhotoSizeListList)(nil), "media.TL_media_getPhotoSizeListList")
+	proto.RegisterType((*TLMediaGetVideoSizeList)(nil), "media.TL_media_getVideoSizeList")
+	proto.RegisterType((*TLMediaUploadedDocumentMedia)(nil), "media.TL_media_uploadedDocumentMedia")
+	proto.RegisterType((*TLMediaGetDocument)(nil), "media.TL_media_getDocument")
+	proto.RegisterType((*TLMediaGetDocumentList)(nil), "media.TL_media_getDocumentList")
+	proto.RegisterType((*TLMediaUploadEncryptedFile)(nil), "media.TL_media_uploadEncryptedFile")
+	proto.RegisterType((*TLMediaGetEncryptedFile)(nil), "media.TL_media_getEncryptedFile")
+	proto.RegisterType((*TLMediaUploadWallPaperFile)(nil), "media.TL_media_uploadWallPaperFile")
+	proto.RegisterType((*TLMediaUploadThemeFile)(nil), "media.TL_media_uploadThemeFile")
+	proto.RegisterType((*TLMediaUploadStickerFile)(nil), "media.TL_media_uploadStickerFile")
+	proto.RegisterType((*TLMediaUploadRingtoneFile)(nil), "media.TL_media_uploadRingtoneFile")
+	proto.RegisterType((*TLMediaCreateStickerSet)(nil), "media.TL_media_createStickerSet")
+	proto.RegisterType((*TLMediaAddStickerToSet)(nil), "media.TL_media_addStickerToSet")
+	proto.RegisterType((*TLMediaRemoveStickerFromSet)(nil), "media.TL_media_removeStickerFromSet")
+	proto.RegisterType((*TLMediaChangeStickerPosition)(nil), "media.TL_media_changeStickerPosition")
+	proto.RegisterType((*TLMediaSetStickerSetThumb)(nil), "media.TL_media_setStickerSetThumb")
+	proto.RegisterType((*TLMediaCheckStickerSetShortName)(nil), "media.TL_media_checkStickerSetShortName")
+	proto.RegisterType((*TLMediaGetStickerSet)(nil), "media.TL_media_getStickerSet")
+	proto.RegisterType((*TLMediaInstallStickerSet)(nil), "media.TL_media_installStickerSet")
+	proto.RegisterType((*TLMediaUninstallStickerSet)(nil), "media.TL_media_uninstallStickerSet")
+	proto.RegisterType((*TLMediaReorderStickerSets)(nil), "media.TL_media_reorderStickerSets")
+	proto.RegisterType((*TLMediaGetAllStickers)(nil), "media.TL_media_getAllStickers")
+	proto.RegisterType((*TLMediaGetArchivedStickers)(nil), "media.TL_media_getArchivedStickers")
+	proto.RegisterType((*TLMediaSearchStickerSets)(nil), "media.TL_media_searchStickerSets")
+	proto.RegisterType((*TLMediaGetStickers)(nil), "media.TL_media_getStickers")
+	proto.RegisterType((*TLMediaGetRecentStickers)(nil), "media.TL_media_getRecentStickers")
+	proto.RegisterType((*TLMediaSaveRecentSticker)(nil), "media.TL_media_saveRecentSticker")
+	proto.RegisterType((*TLMediaClearRecentStickers)(nil), "media.TL_media_clearRecentStickers")
+	proto.RegisterType((*TLMediaGetFavedStickers)(nil), "media.TL_media_getFavedStickers")
+	proto.RegisterType((*TLMediaFaveSticker)(nil), "media.TL_media_faveSticker")
+	proto.RegisterType((*TLMediaGetStickerSetThumbDocument)(nil), "media.TL_media_getStickerSetThumbDocument")
+	proto.RegisterType((*Vector_PhotoSizeList)(nil), "media.Vector_PhotoSizeList")
+	proto.RegisterType((*Vector_Document)(nil), "media.Vector_Document")
 }
 
-func (m *TLMediaUploadedDocumentMedia) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func init() { proto.RegisterFile("media.tl.proto", fileDescriptor_c788ef787fa9e2c6) }
 
-func (m *TLMediaUploadedDocumentMedia) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Media != nil {
-		{
-			size, err := m.Media.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.OwnerId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_c788ef787fa9e2c6 = []byte{
+	// 1491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x68, 0xdc, 0xd6,
+	0x16, 0xb6, 0x3c, 0x1e, 0xc7, 0x3e, 0x7e, 0xf6, 0x93, 0x6f, 0x9c, 0x44, 0xd6, 0x38, 0xe3, 0x89,
+	0xf2, 0x92, 0x37, 0x09, 0x2f, 0x33, 0x30, 0x86, 0xb7, 0x68, 0x43, 0xa1, 0x76, 0x52, 0xea, 0xd6,
+	0x76, 0x5d, 0x79, 0xf2, 0x43, 0xa0, 0x15, 0xb2, 0x74, 0x33, 0x23, 0xa2, 0x19, 0x09, 0xe9, 0x4e,
+	0x82, 0xb3, 0x4b, 0x5b, 0xe8, 0x0f, 0x94, 0xee, 0x12, 0x68, 0xa1, 0x8b, 0x26, 0x5d, 0x94, 0xfe,
+	0x6c, 0xba, 0x28, 0x5d, 0x75, 0xd3, 0x86, 0x76, 0x51, 0x48, 0x0b, 0x85, 0x90, 0x40, 0x71, 0xd2,
+	0x96, 0x42, 0xda, 0xd0, 0x90, 0xac, 0x62, 0x42, 0xa6, 0xe8, 0x6a, 0xa4, 0x99, 0xab, 0xd1, 0x4c,
+	0x43, 0x7e, 0xa8, 0xa1, 0x9b, 0x41, 0xba, 0xe7, 0xd3, 0x77, 0xce, 0xf9, 0xee, 0xbd, 0xe7, 0x9e,
+	0x3b, 0x30, 0x52, 0xc1, 0xba, 0xa1, 0xe6, 0x88, 0x99, 0xb3, 0x1d, 0x8b, 0x58, 0x28, 0x49, 0xdf,
+	0xc5, 0x3d, 0x25, 0x83, 0x94, 0x6b, 0xcb, 0x39, 0xcd, 0xaa, 0xe4, 0x4b, 0x56, 0xc9, 0xca, 0x53,
+	0xeb, 0x72, 0xed, 0x28, 0x7d, 0xa3, 0x2f, 0xf4, 0xc9, 0xff, 0x4a, 0x4c, 0x97, 0x2c, 0xab, 0x64,
+	0xe2, 0x26, 0xea, 0x84, 0xa3, 0xda, 0x36, 0x76, 0xdc, 0x86, 0x5d, 0x74, 0xb5, 0x32, 0xae, 0x50,
+	0x37, 0x9a, 0xe5, 0x60, 0x85, 0xac, 0xd8, 0x38, 0xb0, 0x8d, 0x37, 0x6d, 0xc4, 0x51, 0xab, 0xae,
+	0x6d, 0x39, 0xa4, 0x61, 0x1a, 0x6b, 0x9a, 0xdc, 0x95, 0xaa, 0xe6, 0x8f, 0x4a, 0xe7, 0x39, 0x18,
+	0x5e, 0x2c, 0x5b, 0xc4, 0x5a, 0x32, 0x4e, 0xe2, 0x39, 0xc3, 0x25, 0x68, 0x07, 0x8c, 0xd8, 0x0e,
+	0xd6, 0x0d, 0x4d, 0x25, 0x58, 0xa9, 0xaa, 0x15, 0x2c, 0x70, 0x19, 0x2e, 0x3b, 0x28, 0x0f, 0x87,
+	0xa3, 0x0b, 0x6a, 0x05, 0xa3, 0xff, 0xc3, 0x90, 0x66, 0x55, 0x5d, 0xe2, 0xd4, 0x34, 0x62, 0x39,
+	0x42, 0x6f, 0x86, 0xcb, 0x8e, 0x14, 0xc6, 0x72, 0xbe, 0x02, 0xc5, 0xb9, 0x99, 0xa6, 0x4d, 0x6e,
+	0x05, 0xa2, 0x2d, 0xb0, 0xc1, 0x35, 0x4e, 0x62, 0xc5, 0xd0, 0x85, 0x44, 0x86, 0xcb, 0x26, 0xe4,
+	0x7e, 0xef, 0x75, 0x56, 0x47, 0x59, 0x48, 0x7a, 0x4f, 0xae, 0xd0, 0x97, 0x49, 0x64, 0x87, 0x0a,
+	0x28, 0x57, 0x21, 0x34, 0xc4, 0x5c, 0x18, 0x9e, 0xec, 0x03, 0xd0, 0x46, 0x48, 0xea, 0x9a, 0x47,
+	0x90, 0xcc, 0x70, 0xd9, 0xa4, 0xdc, 0xa7, 0x6b, 0xb3, 0xba, 0xf4, 0x04, 0xf0, 0xc5, 0x39, 0xc5,
+	0x66, 0x52, 0xd9, 0x0d, 0x49, 0x5d, 0x25, 0x6a, 0x81, 0x66, 0x30, 0x14, 0x46, 0xc7, 0xe4, 0x2b,
+	0xfb, 0x10, 0x2a, 0xc4, 0x41, 0x43, 0xc7, 0xeb, 0x58, 0x88, 0x30, 0xbc, 0x7b, 0x10, 0xe2, 0x38,
+	0x93, 0x4a, 0x07, 0x21, 0x98, 0x7c, 0x03, 0x21, 0x5e, 0xed, 0x05, 0xa1, 0x38, 0xa7, 0x50, 0x84,
+	0x52, 0xb3, 0x4d, 0x4b, 0xd5, 0xa9, 0x60, 0x4f, 0x19, 0x66, 0x5b, 0xb2, 0xdc, 0xbd, 0x26, 0x3b,
+	0x0e, 0x03, 0xd6, 0x89, 0x2a, 0x76, 0x9a, 0xd9, 0x6e, 0xa0, 0xef, 0xb3, 0x3a, 0xda, 0x09, 0x7d,
+	0x47, 0x0d, 0x13, 0x0b, 0x7d, 0x34, 0xb4, 0x66, 0xb6, 0xb3, 0x55, 0xbb, 0x46, 0x3c, 0xa7, 0x32,
+	0xb5, 0xa3, 0x02, 0x0c, 0xb8, 0xc4, 0xd0, 0x8e, 0x61, 0xc7, 0x15, 0x92, 0x54, 0x99, 0xcd, 0x2c,
+	0x76, 0x9f, 0xa5, 0xd5, 0x2a, 0xb8, 0x4a, 0xe4, 0x10, 0x87, 0xf6, 0xc2, 0x10, 0x21, 0xa6, 0xe2,
+	0x62, 0xcd, 0xaa, 0xea, 0xae, 0xd0, 0x4f, 0x5d, 0xa4, 0x72, 0xfe, 0x06, 0xcb, 0x05, 0x1b, 0x2c,
+	0x37, 0x5b, 0x25, 0x53, 0x85, 0x83, 0xaa, 0x59, 0xc3, 0x32, 0x10, 0x62, 0x2e, 0xf9, 0x70, 0xe9,
+	0x8d, 0x5e, 0x98, 0x8c, 0x2a, 0xe1, 0x58, 0x5e, 0x30, 0xeb, 0x42, 0x90, 0x2c, 0x24, 0xe9, 0x2c,
+	0xd3, 0xd9, 0x8f, 0x07, 0xfa, 0x00, 0x34, 0x0d, 0x23, 0xf4, 0x41, 0x71, 0x89, 0xea, 0x10, 0x85,
+	0x04, 0x4a, 0x4c, 0xb4, 0x29, 0xb1, 0xcf, 0xaa, 0x2d, 0x9b, 0xd8, 0x97, 0xe2, 0x5f, 0xfe, 0x1a,
+	0xf2, 0x3e, 0x29, 0xba, 0xd2, 0x51, 0x18, 0x0d, 0xb5, 0x28, 0x61, 0x42, 0x15, 0x78, 0x90, 0xec,
+	0xe9, 0x4e, 0x6d, 0xc9, 0x9e, 0xbe, 0xcf, 0xea, 0x92, 0x09, 0xe3, 0x6d, 0x7e, 0xc2, 0x75, 0x7c,
+	0xbf, 0xfe, 0x3a, 0xed, 0x35, 0xc9, 0x86, 0xad, 0x1d, 0xbd, 0x3d, 0xa8, 0x47, 0x43, 0x57, 0x4c,
+	0xc3, 0x25, 0x42, 0x22, 0x93, 0xf0, 0x3c, 0x1a, 0xba, 0x47, 0x18, 0xcd, 0x8f, 0x2d, 0x39, 0x0f,
+	0x3d, 0xbf, 0x77, 0x39, 0x48, 0x47, 0x96, 0x30, 0xd6, 0x83, 0x8d, 0x32, 0xef, 0x8d, 0x3e, 0x8a,
+	0x15, 0xbc, 0x0b, 0xfc, 0x93, 0xaf, 0xb1, 0x84, 0x37, 0xb2, 0x2b, 0x93, 0xba, 0x95, 0x7d, 0x84,
+	0xf4, 0x22, 0x8c, 0xb5, 0xca, 0x11, 0x84, 0x76, 0xdf, 0x51, 0x8d, 0x40, 0x6f, 0x18, 0x4f, 0xaf,
+	0xa1, 0x4b, 0xc7, 0x5a, 0x8a, 0x59, 0x0b, 0xff, 0xa3, 0x99, 0xdb, 0xf7, 0x38, 0x98, 0x88, 0xa8,
+	0xbd, 0xbf, 0xaa, 0x39, 0x2b, 0x36, 0xc1, 0xfa, 0xa3, 0xaa, 0x16, 0x79, 0xa6, 0x5a, 0xa4, 0x58,
+	0xa9, 0x19, 0xef, 0x7e, 0xd9, 0x90, 0x5e, 0xe1, 0xd8, 0x15, 0xf8, 0x70, 0x22, 0x8c, 0xe8, 0x8e,
+	0x26, 0x61, 0x48, 0xd5, 0x34, 0xec, 0xba, 0x4a, 0x59, 0x75, 0xcb, 0x34, 0xba, 0x84, 0x0c, 0xfe,
+	0xd0, 0xd3, 0xaa, 0x5b, 0x96, 0x2e, 0xb7, 0x6b, 0x75, 0x48, 0x35, 0xcd, 0x45, 0xd5, 0xc6, 0xce,
+	0xdf, 0x5d, 0x59, 0x53, 0x30, 0x58, 0x31, 0x2a, 0x7e, 0x67, 0x45, 0xab, 0xeb, 0xa0, 0x3c, 0xe0,
+	0x0d, 0x14, 0x57, 0x6c, 0x8c, 0xb6, 0x43, 0x52, 0xd5, 0x2b, 0x46, 0xb5, 0x51, 0x43, 0x87, 0x43,
+	0x96, 0x69, 0xcb, 0x32, 0x65, 0xdf, 0x26, 0xdd, 0xe6, 0xda, 0x0e, 0xd1, 0x62, 0x19, 0x57, 0xf0,
+	0x3a, 0x38, 0x33, 0x48, 0xb9, 0x56, 0x59, 0xee, 0x76, 0x66, 0x50, 0x00, 0xab, 0x41, 0x7f, 0x44,
+	0x83, 0x14, 0x0c, 0x7a, 0x74, 0x7e, 0x57, 0xb4, 0xc1, 0x37, 0x7a, 0x03, 0x5e, 0x43, 0x24, 0xfd,
+	0xd0, 0x0b, 0x62, 0x24, 0xf7, 0x25, 0xff, 0x40, 0xfe, 0x07, 0x64, 0x8f, 0x0e, 0x83, 0xa8, 0x37,
+	0x8a, 0x8c, 0xa2, 0x12, 0xe2, 0x18, 0xcb, 0x35, 0x82, 0x95, 0x46, 0x47, 0x22, 0x0c, 0x50, 0xc7,
+	0x62, 0xe8, 0x38, 0xa8, 0x47, 0x4f, 0x06, 0x48, 0x59, 0xd0, 0xa3, 0x43, 0x0d, 0xf1, 0xa4, 0xef,
+	0x39, 0x48, 0x45, 0x74, 0x95, 0x8d, 0x6a, 0x89, 0x58, 0x55, 0xbc, 0xae, 0x37, 0x0c, 0x23, 0x57,
+	0x7f, 0x64, 0xb1, 0x4c, 0xc3, 0xd8, 0x41, 0xec, 0x85, 0xa1, 0x2c, 0xc6, 0xb5, 0xee, 0xae, 0xc0,
+	0xd1, 0x56, 0xaf, 0x4b, 0xeb, 0xee, 0x4a, 0x8f, 0xc1, 0xbf, 0x1b, 0x1c, 0xe1, 0xf1, 0xf1, 0x5f,
+	0xf6, 0xf3, 0xd1, 0x36, 0xc1, 0x1b, 0xdf, 0xee, 0xbe, 0x90, 0x84, 0x61, 0x46, 0x1b, 0x34, 0x0a,
+	0xc3, 0x33, 0xf2, 0xcc, 0x54, 0x41, 0x39, 0xb0, 0xf0, 0xec, 0xc2, 0x73, 0x87, 0x16, 0xf8, 0x1e,
+	0x24, 0xc2, 0x46, 0x7f, 0x88, 0xb9, 0x5e, 0xf0, 0x9f, 0xde, 0xfa, 0x72, 0x0a, 0xa5, 0x02, 0x1b,
+	0xd3, 0x71, 0xf3, 0x37, 0x3f, 0x3b, 0x7f, 0x3e, 0x81, 0xb6, 0x43, 0xca, 0x37, 0xc6, 0x76, 0xd3,
+	0xfc, 0x99, 0xd3, 0x1f, 0x5d, 0x49, 0xa0, 0x3c, 0x48, 0x31, 0xa0, 0x48, 0xa3, 0xc9, 0x9f, 0xfb,
+	0xf1, 0xce, 0xe7, 0xb7, 0xeb, 0xf5, 0x7a, 0x9d, 0x43, 0x13, 0x30, 0xd6, 0xfa, 0x41, 0xd0, 0xb7,
+	0xf0, 0xbf, 0xdd, 0x58, 0xfb, 0xb0, 0x1f, 0xed, 0x82, 0x89, 0x38, 0x6b, 0x18, 0xd9, 0x57, 0x77,
+	0x3f, 0x7e, 0x6b, 0xcd, 0x27, 0xda, 0x03, 0x99, 0x6e, 0x50, 0x0a, 0x5f, 0x3d, 0x75, 0xf3, 0x52,
+	0xdd, 0x87, 0xb7, 0x33, 0x33, 0xdd, 0x0b, 0x7f, 0xed, 0xfd, 0x4b, 0x67, 0xef, 0x04, 0xd0, 0x6d,
+	0xed, 0x39, 0x45, 0x3a, 0x0f, 0xfe, 0xf7, 0xd5, 0xbb, 0x6b, 0x7d, 0x68, 0x12, 0xb6, 0x44, 0x58,
+	0x03, 0x14, 0xff, 0xed, 0x27, 0xa7, 0xeb, 0x09, 0x94, 0x65, 0x45, 0x8c, 0x9c, 0xe2, 0xfc, 0x1f,
+	0xe7, 0xbe, 0xf8, 0xa0, 0xe1, 0xf5, 0x7f, 0x30, 0xd9, 0xee, 0x95, 0x39, 0xdf, 0xf8, 0x77, 0xde,
+	0x7c, 0xf9, 0xe2, 0x5a, 0xa7, 0x74, 0x58, 0xe8, 0x77, 0xa7, 0x5e, 0xfa, 0xa9, 0xde, 0x85, 0x98,
+	0x39, 0xae, 0xf8, 0xbb, 0x97, 0xaf, 0xfd, 0xda, 0x98, 0x9f, 0xd8, 0x59, 0x0f, 0xcb, 0x3f, 0xbf,
+	0x7a, 0xe3, 0xed, 0x33, 0x7d, 0x68, 0x37, 0x6c, 0x6d, 0x07, 0xb5, 0xd4, 0x49, 0xfe, 0xfa, 0x37,
+	0x17, 0x7f, 0x69, 0x44, 0xba, 0x13, 0xd2, 0xed, 0xd8, 0xd6, 0xbd, 0xcf, 0xbf, 0xfe, 0xf3, 0xb5,
+	0xeb, 0x09, 0xb1, 0xef, 0xb5, 0xb3, 0xe9, 0x9e, 0xc2, 0xad, 0x41, 0x18, 0x90, 0x17, 0x67, 0xfc,
+	0xee, 0xee, 0x19, 0xd8, 0x14, 0x7f, 0x93, 0x9b, 0x0c, 0x0b, 0x43, 0xfc, 0xe2, 0x14, 0x47, 0xd8,
+	0x0b, 0xb8, 0xd4, 0x83, 0x0e, 0xc3, 0x44, 0xd7, 0xbb, 0xd0, 0xce, 0x0e, 0x94, 0x11, 0x5c, 0x0c,
+	0xf3, 0xde, 0xc6, 0x5f, 0x27, 0xcd, 0x9b, 0x85, 0x10, 0xe5, 0x0a, 0x2c, 0x31, 0x5f, 0xcb, 0xb0,
+	0xb9, 0xc3, 0x7d, 0x21, 0xd3, 0x89, 0x25, 0x40, 0x88, 0xb1, 0x85, 0x45, 0xea, 0x41, 0x2a, 0x88,
+	0x5d, 0x6e, 0x05, 0xff, 0xf9, 0x2b, 0x5e, 0xca, 0x9d, 0x0a, 0xae, 0xd9, 0x31, 0x05, 0x2e, 0x12,
+	0x36, 0x7b, 0x0d, 0x88, 0x0b, 0x9b, 0x41, 0x88, 0xb1, 0x37, 0x78, 0xa9, 0x07, 0xbd, 0x00, 0xa9,
+	0x6e, 0xbd, 0xfe, 0x8e, 0xf8, 0x19, 0x8a, 0xc0, 0xc4, 0x4d, 0xa1, 0xc4, 0xf3, 0xd8, 0x75, 0xd5,
+	0x12, 0xa6, 0xc3, 0x52, 0x0f, 0xda, 0x0f, 0xa3, 0xed, 0xad, 0x7a, 0x2a, 0x26, 0xda, 0xc0, 0x28,
+	0xb6, 0x57, 0x5e, 0x9a, 0xf9, 0xa6, 0xf8, 0x8e, 0x7c, 0xb2, 0x0b, 0x15, 0xcd, 0x7b, 0x33, 0x2b,
+	0x69, 0x0b, 0xe7, 0x11, 0x18, 0xef, 0xdc, 0x77, 0x6f, 0x8f, 0xcf, 0x9b, 0x01, 0x89, 0xcd, 0xbf,
+	0x13, 0x98, 0x71, 0xa9, 0x07, 0x15, 0x5b, 0x66, 0x8a, 0x25, 0x8e, 0x9b, 0xa9, 0x7b, 0x65, 0x3d,
+	0xc0, 0x46, 0xcc, 0x76, 0xbf, 0x1d, 0x22, 0x66, 0x40, 0xf1, 0xe2, 0xce, 0xb3, 0x3b, 0xbe, 0xd9,
+	0x76, 0x76, 0xd8, 0xf1, 0x21, 0x20, 0x9e, 0xee, 0x79, 0xd8, 0xd2, 0xa9, 0x93, 0xdb, 0x16, 0x4f,
+	0xd8, 0x02, 0x89, 0xa7, 0x5c, 0x02, 0xa1, 0x63, 0x13, 0x23, 0xc5, 0x73, 0xb6, 0x62, 0x62, 0x49,
+	0xa7, 0xe7, 0x6f, 0x5c, 0x49, 0x73, 0x5f, 0x5f, 0x4d, 0x73, 0x17, 0xae, 0xa6, 0xb9, 0xd5, 0xab,
+	0x69, 0xee, 0xc8, 0xe3, 0x2d, 0x7f, 0xbb, 0x12, 0xac, 0x56, 0x4a, 0x8e, 0xda, 0x7c, 0xd8, 0xe3,
+	0x62, 0xe7, 0x38, 0x76, 0xf2, 0xaa, 0x6d, 0xe7, 0xbd, 0x47, 0x43, 0xc3, 0x79, 0xea, 0xd0, 0xff,
+	0x5d, 0xee, 0xa7, 0x0e, 0xa6, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x18, 0xdf, 0xb9, 0xac, 0xd3,
+	0x15, 0x00, 0x00,
 }
 
-func (m *TLMediaGetDocument) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PhotoSizeList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaGetDocument) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 9)
+	s = append(s, "&media.PhotoSizeList{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "SizeId: "+fmt.Sprintf("%#v", this.SizeId)+",\n")
+	if this.Sizes != nil {
+		s = append(s, "Sizes: "+fmt.Sprintf("%#v", this.Sizes)+",\n")
+	}
+	s = append(s, "DcId: "+fmt.Sprintf("%#v", this.DcId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLPhotoSizeList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Id != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+	s := make([]string, 0, 5)
+	s = append(s, "&media.TLPhotoSizeList{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetDocumentList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *VideoSizeList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaGetDocumentList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 9)
+	s = append(s, "&media.VideoSizeList{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "SizeId: "+fmt.Sprintf("%#v", this.SizeId)+",\n")
+	if this.Sizes != nil {
+		s = append(s, "Sizes: "+fmt.Sprintf("%#v", this.Sizes)+",\n")
+	}
+	s = append(s, "DcId: "+fmt.Sprintf("%#v", this.DcId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetDocumentList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLVideoSizeList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.IdList) > 0 {
-		dAtA12 := make([]byte, len(m.IdList)*10)
-		var j11 int
-		for _, num1 := range m.IdList {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintMediaTl(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 5)
+	s = append(s, "&media.TLVideoSizeList{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadEncryptedFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMediaUploadPhotoFile) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaUploadEncryptedFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMediaUploadEncryptedFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	s := make([]string, 0, 9)
+	s = append(s, "&media.TLMediaUploadPhotoFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.File != nil {
+		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	if this.Stickers != nil {
+		s = append(s, "Stickers: "+fmt.Sprintf("%#v", this.Stickers)+",\n")
 	}
-	if m.OwnerId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
-		i--
-		dAtA[i] = 0x18
+	if this.TtlSeconds != nil {
+		s = append(s, "TtlSeconds: "+fmt.Sprintf("%#v", this.TtlSeconds)+",\n")
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetEncryptedFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMediaUploadProfilePhotoFile) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaGetEncryptedFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMediaGetEncryptedFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	s := make([]string, 0, 9)
+	s = append(s, "&media.TLMediaUploadProfilePhotoFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.File != nil {
+		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
 	}
-	if m.AccessHash != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.AccessHash))
-		i--
-		dAtA[i] = 0x20
+	if this.Video != nil {
+		s = append(s, "Video: "+fmt.Sprintf("%#v", this.Video)+",\n")
 	}
-	if m.Id != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+	if this.VideoStartTs != nil {
+		s = append(s, "VideoStartTs: "+fmt.Sprintf("%#v", this.VideoStartTs)+",\n")
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadWallPaperFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMediaGetPhoto) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaUploadWallPaperFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaGetPhoto{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "PhotoId: "+fmt.Sprintf("%#v", this.PhotoId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadWallPaperFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLMediaGetPhotoSizeList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Admin != nil {
-		{
-			size, err := m.Admin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaGetPhotoSizeList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "SizeId: "+fmt.Sprintf("%#v", this.SizeId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x2a
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaGetPhotoSizeListList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaGetPhotoSizeListList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "IdList: "+fmt.Sprintf("%#v", this.IdList)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.OwnerId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
-		i--
-		dAtA[i] = 0x18
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaGetVideoSizeList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaGetVideoSizeList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "SizeId: "+fmt.Sprintf("%#v", this.SizeId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadThemeFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMediaUploadedDocumentMedia) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaUploadThemeFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMediaUploadThemeFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	s := make([]string, 0, 7)
+	s = append(s, "&media.TLMediaUploadedDocumentMedia{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.Media != nil {
+		s = append(s, "Media: "+fmt.Sprintf("%#v", this.Media)+",\n")
 	}
-	if len(m.FileName) > 0 {
-		i -= len(m.FileName)
-		copy(dAtA[i:], m.FileName)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.FileName)))
-		i--
-		dAtA[i] = 0x3a
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Thumb != nil {
-		{
-			size, err := m.Thumb.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaGetDocument) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaGetDocument{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.OwnerId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
-		i--
-		dAtA[i] = 0x18
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaGetDocumentList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaGetDocumentList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "IdList: "+fmt.Sprintf("%#v", this.IdList)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadStickerFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMediaUploadEncryptedFile) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 7)
+	s = append(s, "&media.TLMediaUploadEncryptedFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.File != nil {
+		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadStickerFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *TLMediaGetEncryptedFile) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&media.TLMediaGetEncryptedFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "AccessHash: "+fmt.Sprintf("%#v", this.AccessHash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadStickerFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLMediaUploadWallPaperFile) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.DocumentAttributeSticker != nil {
-		{
-			size, err := m.DocumentAttributeSticker.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	s := make([]string, 0, 9)
+	s = append(s, "&media.TLMediaUploadWallPaperFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.File != nil {
+		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
 	}
-	if len(m.FileName) > 0 {
-		i -= len(m.FileName)
-		copy(dAtA[i:], m.FileName)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.FileName)))
-		i--
-		dAtA[i] = 0x3a
+	s = append(s, "MimeType: "+fmt.Sprintf("%#v", this.MimeType)+",\n")
+	if this.Admin != nil {
+		s = append(s, "Admin: "+fmt.Sprintf("%#v", this.Admin)+",\n")
 	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x32
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.Thumb != nil {
-		{
-			size, err := m.Thumb.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaUploadThemeFile) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	s := make([]string, 0, 10)
+	s = append(s, "&media.TLMediaUploadThemeFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.File != nil {
+		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
 	}
-	if m.OwnerId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
-		i--
-		dAtA[i] = 0x18
+	if this.Thumb != nil {
+		s = append(s, "Thumb: "+fmt.Sprintf("%#v", this.Thumb)+",\n")
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	s = append(s, "MimeType: "+fmt.Sprintf("%#v", this.MimeType)+",\n")
+	s = append(s, "FileName: "+fmt.Sprintf("%#v", this.FileName)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadRingtoneFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMediaUploadStickerFile) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMediaUploadRingtoneFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMediaUploadRingtoneFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	s := make([]string, 0, 11)
+	s = append(s, "&media.TLMediaUploadStickerFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.File != nil {
+		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
 	}
-	if len(m.FileName) > 0 {
-		i -= len(m.FileName)
-		copy(dAtA[i:], m.FileName)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.FileName)))
-		i--
-		dAtA[i] = 0x32
+	if this.Thumb != nil {
+		s = append(s, "Thumb: "+fmt.Sprintf("%#v", this.Thumb)+",\n")
 	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x2a
+	s = append(s, "MimeType: "+fmt.Sprintf("%#v", this.MimeType)+",\n")
+	s = append(s, "FileName: "+fmt.Sprintf("%#v", this.FileName)+",\n")
+	if this.DocumentAttributeSticker != nil {
+		s = append(s, "DocumentAttributeSticker: "+fmt.Sprintf("%#v", this.DocumentAttributeSticker)+",\n")
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMediaTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.OwnerId != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
-		i--
-		dAtA[i] = 0x18
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaUploadRingtoneFile) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	s := make([]string, 0, 9)
+	s = append(s, "&media.TLMediaUploadRingtoneFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.File != nil {
+		s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Vector_PhotoSizeList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s = append(s, "MimeType: "+fmt.Sprintf("%#v", this.MimeType)+",\n")
+	s = append(s, "FileName: "+fmt.Sprintf("%#v", this.FileName)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Vector_PhotoSizeList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Vector_PhotoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLMediaCreateStickerSet) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Datas) > 0 {
-		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMediaTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	s := make([]string, 0, 14)
+	s = append(s, "&media.TLMediaCreateStickerSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Masks: "+fmt.Sprintf("%#v", this.Masks)+",\n")
+	s = append(s, "Animated: "+fmt.Sprintf("%#v", this.Animated)+",\n")
+	s = append(s, "Videos: "+fmt.Sprintf("%#v", this.Videos)+",\n")
+	s = append(s, "Emojis: "+fmt.Sprintf("%#v", this.Emojis)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "ShortName: "+fmt.Sprintf("%#v", this.ShortName)+",\n")
+	s = append(s, "ThumbDocumentId: "+fmt.Sprintf("%#v", this.ThumbDocumentId)+",\n")
+	if this.Stickers != nil {
+		s = append(s, "Stickers: "+fmt.Sprintf("%#v", this.Stickers)+",\n")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Vector_Document) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Vector_Document) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Vector_Document) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLMediaAddStickerToSet) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Datas) > 0 {
-		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMediaTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	s := make([]string, 0, 8)
+	s = append(s, "&media.TLMediaAddStickerToSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
 	}
-	return len(dAtA) - i, nil
+	if this.Sticker != nil {
+		s = append(s, "Sticker: "+fmt.Sprintf("%#v", this.Sticker)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func encodeVarintMediaTl(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMediaTl(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *TLMediaRemoveStickerFromSet) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	s := make([]string, 0, 7)
+	s = append(s, "&media.TLMediaRemoveStickerFromSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	s = append(s, "DocumentId: "+fmt.Sprintf("%#v", this.DocumentId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *PhotoSizeList) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *TLMediaChangeStickerPosition) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&media.TLMediaChangeStickerPosition{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	s = append(s, "DocumentId: "+fmt.Sprintf("%#v", this.DocumentId)+",\n")
+	s = append(s, "Position: "+fmt.Sprintf("%#v", this.Position)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaSetStickerSetThumb) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.SizeId != 0 {
-		n += 1 + sovMediaTl(uint64(m.SizeId))
+	s := make([]string, 0, 8)
+	s = append(s, "&media.TLMediaSetStickerSetThumb{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OwnerId: "+fmt.Sprintf("%#v", this.OwnerId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
 	}
-	if len(m.Sizes) > 0 {
-		for _, e := range m.Sizes {
-			l = e.Size()
-			n += 1 + l + sovMediaTl(uint64(l))
-		}
+	s = append(s, "ThumbDocumentId: "+fmt.Sprintf("%#v", this.ThumbDocumentId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.DcId != 0 {
-		n += 1 + sovMediaTl(uint64(m.DcId))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaCheckStickerSetShortName) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaCheckStickerSetShortName{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ShortName: "+fmt.Sprintf("%#v", this.ShortName)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLPhotoSizeList) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *TLMediaGetStickerSet) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Data2 != nil {
-		l = m.Data2.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&media.TLMediaGetStickerSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *VideoSizeList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
+func (this *TLMediaInstallStickerSet) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+	s := make([]string, 0, 8)
+	s = append(s, "&media.TLMediaInstallStickerSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Archived: "+fmt.Sprintf("%#v", this.Archived)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
 	}
-	if m.SizeId != 0 {
-		n += 1 + sovMediaTl(uint64(m.SizeId))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if len(m.Sizes) > 0 {
-		for _, e := range m.Sizes {
-			l = e.Size()
-			n += 1 + l + sovMediaTl(uint64(l))
-		}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaUninstallStickerSet) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.DcId != 0 {
-		n += 1 + sovMediaTl(uint64(m.DcId))
+	s := make([]string, 0, 7)
+	s = append(s, "&media.TLMediaUninstallStickerSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLVideoSizeList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data2 != nil {
-		l = m.Data2.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+func (this *TLMediaReorderStickerSets) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 9)
+	s = append(s, "&media.TLMediaReorderStickerSets{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Masks: "+fmt.Sprintf("%#v", this.Masks)+",\n")
+	s = append(s, "Emojis: "+fmt.Sprintf("%#v", this.Emojis)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Order: "+fmt.Sprintf("%#v", this.Order)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadPhotoFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
-	}
-	if m.OwnerId != 0 {
-		n += 1 + sovMediaTl(uint64(m.OwnerId))
-	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+func (this *TLMediaGetAllStickers) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Stickers) > 0 {
-		for _, e := range m.Stickers {
-			l = e.Size()
-			n += 1 + l + sovMediaTl(uint64(l))
-		}
+	s := make([]string, 0, 9)
+	s = append(s, "&media.TLMediaGetAllStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Masks: "+fmt.Sprintf("%#v", this.Masks)+",\n")
+	s = append(s, "Emojis: "+fmt.Sprintf("%#v", this.Emojis)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.TtlSeconds != nil {
-		l = m.TtlSeconds.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaGetArchivedStickers) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 10)
+	s = append(s, "&media.TLMediaGetArchivedStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Masks: "+fmt.Sprintf("%#v", this.Masks)+",\n")
+	s = append(s, "Emojis: "+fmt.Sprintf("%#v", this.Emojis)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadProfilePhotoFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+func (this *TLMediaSearchStickerSets) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.OwnerId != 0 {
-		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	s := make([]string, 0, 8)
+	s = append(s, "&media.TLMediaSearchStickerSets{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaGetStickers) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Video != nil {
-		l = m.Video.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&media.TLMediaGetStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Emoticon: "+fmt.Sprintf("%#v", this.Emoticon)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.VideoStartTs != nil {
-		l = m.VideoStartTs.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaGetRecentStickers) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 7)
+	s = append(s, "&media.TLMediaGetRecentStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetPhoto) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *TLMediaSaveRecentSticker) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+	s := make([]string, 0, 8)
+	s = append(s, "&media.TLMediaSaveRecentSticker{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Unsave: "+fmt.Sprintf("%#v", this.Unsave)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.PhotoId != 0 {
-		n += 1 + sovMediaTl(uint64(m.PhotoId))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaClearRecentStickers) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaClearRecentStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetPhotoSizeList) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *TLMediaGetFavedStickers) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+	s := make([]string, 0, 7)
+	s = append(s, "&media.TLMediaGetFavedStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.SizeId != 0 {
-		n += 1 + sovMediaTl(uint64(m.SizeId))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMediaFaveSticker) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 8)
+	s = append(s, "&media.TLMediaFaveSticker{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Unfave: "+fmt.Sprintf("%#v", this.Unfave)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetPhotoSizeListList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+func (this *TLMediaGetStickerSetThumbDocument) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.IdList) > 0 {
-		l = 0
-		for _, e := range m.IdList {
-			l += sovMediaTl(uint64(e))
-		}
-		n += 1 + sovMediaTl(uint64(l)) + l
+	s := make([]string, 0, 6)
+	s = append(s, "&media.TLMediaGetStickerSetThumbDocument{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetVideoSizeList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+func (this *Vector_PhotoSizeList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.SizeId != 0 {
-		n += 1 + sovMediaTl(uint64(m.SizeId))
+	s := make([]string, 0, 5)
+	s = append(s, "&media.Vector_PhotoSizeList{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaUploadedDocumentMedia) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
-	}
-	if m.OwnerId != 0 {
-		n += 1 + sovMediaTl(uint64(m.OwnerId))
+func (this *Vector_Document) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Media != nil {
-		l = m.Media.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&media.Vector_Document{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLMediaGetDocument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
-	}
-	if m.Id != 0 {
-		n += 1 + sovMediaTl(uint64(m.Id))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func valueToGoStringMediaTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	return n
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 
-func (m *TLMediaGetDocumentList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
-	}
-	if len(m.IdList) > 0 {
-		l = 0
-		for _, e := range m.IdList {
-			l += sovMediaTl(uint64(e))
-		}
-		n += 1 + sovMediaTl(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCMediaClient is the client API for RPCMedia service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCMediaClient interface {
+	MediaUploadPhotoFile(ctx context.Context, in *TLMediaUploadPhotoFile, opts ...grpc.CallOption) (*mtproto.Photo, error)
+	MediaUploadProfilePhotoFile(ctx context.Context, in *TLMediaUploadProfilePhotoFile, opts ...grpc.CallOption) (*mtproto.Photo, error)
+	MediaGetPhoto(ctx context.Context, in *TLMediaGetPhoto, opts ...grpc.CallOption) (*mtproto.Photo, error)
+	MediaGetPhotoSizeList(ctx context.Context, in *TLMediaGetPhotoSizeList, opts ...grpc.CallOption) (*PhotoSizeList, error)
+	MediaGetPhotoSizeListList(ctx context.Context, in *TLMediaGetPhotoSizeListList, opts ...grpc.CallOption) (*Vector_PhotoSizeList, error)
+	MediaGetVideoSizeList(ctx context.Context, in *TLMediaGetVideoSizeList, opts ...grpc.CallOption) (*VideoSizeList, error)
+	MediaUploadedDocumentMedia(ctx context.Context, in *TLMediaUploadedDocumentMedia, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	MediaGetDocument(ctx context.Context, in *TLMediaGetDocument, opts ...grpc.CallOption) (*mtproto.Document, error)
+	MediaGetDocumentList(ctx context.Context, in *TLMediaGetDocumentList, opts ...grpc.CallOption) (*Vector_Document, error)
+	MediaUploadEncryptedFile(ctx context.Context, in *TLMediaUploadEncryptedFile, opts ...grpc.CallOption) (*mtproto.EncryptedFile, error)
+	MediaGetEncryptedFile(ctx context.Context, in *TLMediaGetEncryptedFile, opts ...grpc.CallOption) (*mtproto.EncryptedFile, error)
+	MediaUploadWallPaperFile(ctx context.Context, in *TLMediaUploadWallPaperFile, opts ...grpc.CallOption) (*mtproto.Document, error)
+	MediaUploadThemeFile(ctx context.Context, in *TLMediaUploadThemeFile, opts ...grpc.CallOption) (*mtproto.Document, error)
+	MediaUploadStickerFile(ctx context.Context, in *TLMediaUploadStickerFile, opts ...grpc.CallOption) (*mtproto.Document, error)
+	MediaUploadRingtoneFile(ctx context.Context, in *TLMediaUploadRingtoneFile, opts ...grpc.CallOption) (*mtproto.Document, error)
+	MediaCreateStickerSet(ctx context.Context, in *TLMediaCreateStickerSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error)
+	MediaAddStickerToSet(ctx context.Context, in *TLMediaAddStickerToSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error)
+	MediaRemoveStickerFromSet(ctx context.Context, in *TLMediaRemoveStickerFromSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error)
+	MediaChangeStickerPosition(ctx context.Context, in *TLMediaChangeStickerPosition, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error)
+	MediaSetStickerSetThumb(ctx context.Context, in *TLMediaSetStickerSetThumb, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error)
+	MediaCheckStickerSetShortName(ctx context.Context, in *TLMediaCheckStickerSetShortName, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	MediaGetStickerSet(ctx context.Context, in *TLMediaGetStickerSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error)
+	MediaInstallStickerSet(ctx context.Context, in *TLMediaInstallStickerSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSetInstallResult, error)
+	MediaUninstallStickerSet(ctx context.Context, in *TLMediaUninstallStickerSet, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	MediaReorderStickerSets(ctx context.Context, in *TLMediaReorderStickerSets, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	MediaGetAllStickers(ctx context.Context, in *TLMediaGetAllStickers, opts ...grpc.CallOption) (*mtproto.Messages_AllStickers, error)
+	MediaGetArchivedStickers(ctx context.Context, in *TLMediaGetArchivedStickers, opts ...grpc.CallOption) (*mtproto.Messages_ArchivedStickers, error)
+	MediaSearchStickerSets(ctx context.Context, in *TLMediaSearchStickerSets, opts ...grpc.CallOption) (*mtproto.Messages_FoundStickerSets, error)
+	MediaGetStickers(ctx context.Context, in *TLMediaGetStickers, opts ...grpc.CallOption) (*mtproto.Messages_Stickers, error)
+	MediaGetRecentStickers(ctx context.Context, in *TLMediaGetRecentStickers, opts ...grpc.CallOption) (*mtproto.Messages_RecentStickers, error)
+	MediaSaveRecentSticker(ctx context.Context, in *TLMediaSaveRecentSticker, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	MediaClearRecentStickers(ctx context.Context, in *TLMediaClearRecentStickers, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	MediaGetFavedStickers(ctx context.Context, in *TLMediaGetFavedStickers, opts ...grpc.CallOption) (*mtproto.Messages_FavedStickers, error)
+	MediaFaveSticker(ctx context.Context, in *TLMediaFaveSticker, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	MediaGetStickerSetThumbDocument(ctx context.Context, in *TLMediaGetStickerSetThumbDocument, opts ...grpc.CallOption) (*mtproto.Document, error)
+}
+
+type rPCMediaClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCMediaClient(cc *grpc.ClientConn) RPCMediaClient {
+	return &rPCMediaClient{cc}
+}
+
+func (c *rPCMediaClient) MediaUploadPhotoFile(ctx context.Context, in *TLMediaUploadPhotoFile, opts ...grpc.CallOption) (*mtproto.Photo, error) {
+	out := new(mtproto.Photo)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadPhotoFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *TLMediaUploadEncryptedFile) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *rPCMediaClient) MediaUploadProfilePhotoFile(ctx context.Context, in *TLMediaUploadProfilePhotoFile, opts ...grpc.CallOption) (*mtproto.Photo, error) {
+	out := new(mtproto.Photo)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadProfilePhotoFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetPhoto(ctx context.Context, in *TLMediaGetPhoto, opts ...grpc.CallOption) (*mtproto.Photo, error) {
+	out := new(mtproto.Photo)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getPhoto", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.OwnerId != 0 {
-		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetPhotoSizeList(ctx context.Context, in *TLMediaGetPhotoSizeList, opts ...grpc.CallOption) (*PhotoSizeList, error) {
+	out := new(PhotoSizeList)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getPhotoSizeList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetPhotoSizeListList(ctx context.Context, in *TLMediaGetPhotoSizeListList, opts ...grpc.CallOption) (*Vector_PhotoSizeList, error) {
+	out := new(Vector_PhotoSizeList)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getPhotoSizeListList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetVideoSizeList(ctx context.Context, in *TLMediaGetVideoSizeList, opts ...grpc.CallOption) (*VideoSizeList, error) {
+	out := new(VideoSizeList)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getVideoSizeList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *TLMediaGetEncryptedFile) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *rPCMediaClient) MediaUploadedDocumentMedia(ctx context.Context, in *TLMediaUploadedDocumentMedia, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadedDocumentMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetDocument(ctx context.Context, in *TLMediaGetDocument, opts ...grpc.CallOption) (*mtproto.Document, error) {
+	out := new(mtproto.Document)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovMediaTl(uint64(m.Id))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetDocumentList(ctx context.Context, in *TLMediaGetDocumentList, opts ...grpc.CallOption) (*Vector_Document, error) {
+	out := new(Vector_Document)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getDocumentList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.AccessHash != 0 {
-		n += 1 + sovMediaTl(uint64(m.AccessHash))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaUploadEncryptedFile(ctx context.Context, in *TLMediaUploadEncryptedFile, opts ...grpc.CallOption) (*mtproto.EncryptedFile, error) {
+	out := new(mtproto.EncryptedFile)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadEncryptedFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetEncryptedFile(ctx context.Context, in *TLMediaGetEncryptedFile, opts ...grpc.CallOption) (*mtproto.EncryptedFile, error) {
+	out := new(mtproto.EncryptedFile)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getEncryptedFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *TLMediaUploadWallPaperFile) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *rPCMediaClient) MediaUploadWallPaperFile(ctx context.Context, in *TLMediaUploadWallPaperFile, opts ...grpc.CallOption) (*mtproto.Document, error) {
+	out := new(mtproto.Document)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadWallPaperFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaUploadThemeFile(ctx context.Context, in *TLMediaUploadThemeFile, opts ...grpc.CallOption) (*mtproto.Document, error) {
+	out := new(mtproto.Document)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadThemeFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.OwnerId != 0 {
-		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaUploadStickerFile(ctx context.Context, in *TLMediaUploadStickerFile, opts ...grpc.CallOption) (*mtproto.Document, error) {
+	out := new(mtproto.Document)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadStickerFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaUploadRingtoneFile(ctx context.Context, in *TLMediaUploadRingtoneFile, opts ...grpc.CallOption) (*mtproto.Document, error) {
+	out := new(mtproto.Document)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uploadRingtoneFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaCreateStickerSet(ctx context.Context, in *TLMediaCreateStickerSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error) {
+	out := new(mtproto.Messages_StickerSet)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_createStickerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Admin != nil {
-		l = m.Admin.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaAddStickerToSet(ctx context.Context, in *TLMediaAddStickerToSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error) {
+	out := new(mtproto.Messages_StickerSet)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_addStickerToSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaRemoveStickerFromSet(ctx context.Context, in *TLMediaRemoveStickerFromSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error) {
+	out := new(mtproto.Messages_StickerSet)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_removeStickerFromSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *TLMediaUploadThemeFile) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *rPCMediaClient) MediaChangeStickerPosition(ctx context.Context, in *TLMediaChangeStickerPosition, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error) {
+	out := new(mtproto.Messages_StickerSet)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_changeStickerPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
-	}
-	if m.OwnerId != 0 {
-		n += 1 + sovMediaTl(uint64(m.OwnerId))
-	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
-	}
-	if m.Thumb != nil {
-		l = m.Thumb.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
-	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
-	}
-	l = len(m.FileName)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return out, nil
 }
 
-func (m *TLMediaUploadStickerFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+func (c *rPCMediaClient) MediaSetStickerSetThumb(ctx context.Context, in *TLMediaSetStickerSetThumb, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error) {
+	out := new(mtproto.Messages_StickerSet)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_setStickerSetThumb", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.OwnerId != 0 {
-		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaCheckStickerSetShortName(ctx context.Context, in *TLMediaCheckStickerSetShortName, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_checkStickerSetShortName", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetStickerSet(ctx context.Context, in *TLMediaGetStickerSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSet, error) {
+	out := new(mtproto.Messages_StickerSet)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getStickerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Thumb != nil {
-		l = m.Thumb.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaInstallStickerSet(ctx context.Context, in *TLMediaInstallStickerSet, opts ...grpc.CallOption) (*mtproto.Messages_StickerSetInstallResult, error) {
+	out := new(mtproto.Messages_StickerSetInstallResult)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_installStickerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaUninstallStickerSet(ctx context.Context, in *TLMediaUninstallStickerSet, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_uninstallStickerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FileName)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaReorderStickerSets(ctx context.Context, in *TLMediaReorderStickerSets, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_reorderStickerSets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.DocumentAttributeSticker != nil {
-		l = m.DocumentAttributeSticker.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetAllStickers(ctx context.Context, in *TLMediaGetAllStickers, opts ...grpc.CallOption) (*mtproto.Messages_AllStickers, error) {
+	out := new(mtproto.Messages_AllStickers)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getAllStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetArchivedStickers(ctx context.Context, in *TLMediaGetArchivedStickers, opts ...grpc.CallOption) (*mtproto.Messages_ArchivedStickers, error) {
+	out := new(mtproto.Messages_ArchivedStickers)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getArchivedStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *TLMediaUploadRingtoneFile) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *rPCMediaClient) MediaSearchStickerSets(ctx context.Context, in *TLMediaSearchStickerSets, opts ...grpc.CallOption) (*mtproto.Messages_FoundStickerSets, error) {
+	out := new(mtproto.Messages_FoundStickerSets)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_searchStickerSets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMediaTl(uint64(m.Constructor))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetStickers(ctx context.Context, in *TLMediaGetStickers, opts ...grpc.CallOption) (*mtproto.Messages_Stickers, error) {
+	out := new(mtproto.Messages_Stickers)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.OwnerId != 0 {
-		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetRecentStickers(ctx context.Context, in *TLMediaGetRecentStickers, opts ...grpc.CallOption) (*mtproto.Messages_RecentStickers, error) {
+	out := new(mtproto.Messages_RecentStickers)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getRecentStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaSaveRecentSticker(ctx context.Context, in *TLMediaSaveRecentSticker, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_saveRecentSticker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaClearRecentStickers(ctx context.Context, in *TLMediaClearRecentStickers, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_clearRecentStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FileName)
-	if l > 0 {
-		n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaGetFavedStickers(ctx context.Context, in *TLMediaGetFavedStickers, opts ...grpc.CallOption) (*mtproto.Messages_FavedStickers, error) {
+	out := new(mtproto.Messages_FavedStickers)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getFavedStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *rPCMediaClient) MediaFaveSticker(ctx context.Context, in *TLMediaFaveSticker, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_faveSticker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Vector_PhotoSizeList) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *rPCMediaClient) MediaGetStickerSetThumbDocument(ctx context.Context, in *TLMediaGetStickerSetThumbDocument, opts ...grpc.CallOption) (*mtproto.Document, error) {
+	out := new(mtproto.Document)
+	err := c.cc.Invoke(ctx, "/media.RPCMedia/media_getStickerSetThumbDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Datas) > 0 {
-		for _, e := range m.Datas {
-			l = e.Size()
-			n += 1 + l + sovMediaTl(uint64(l))
+	return out, nil
+}
+
+// RPCMediaServer is the server API for RPCMedia service.
+type RPCMediaServer interface {
+	MediaUploadPhotoFile(context.Context, *TLMediaUploadPhotoFile) (*mtproto.Photo, error)
+	MediaUploadProfilePhotoFile(context.Context, *TLMediaUploadProfilePhotoFile) (*mtproto.Photo, error)
+	MediaGetPhoto(context.Context, *TLMediaGetPhoto) (*mtproto.Photo, error)
+	MediaGetPhotoSizeList(context.Context, *TLMediaGetPhotoSizeList) (*PhotoSizeList, error)
+	MediaGetPhotoSizeListList(context.Context, *TLMediaGetPhotoSizeListList) (*Vector_PhotoSizeList, error)
+	MediaGetVideoSizeList(context.Context, *TLMediaGetVideoSizeList) (*VideoSizeList, error)
+	MediaUploadedDocumentMedia(context.Context, *TLMediaUploadedDocumentMedia) (*mtproto.MessageMedia, error)
+	MediaGetDocument(context.Context, *TLMediaGetDocument) (*mtproto.Document, error)
+	MediaGetDocumentList(context.Context, *TLMediaGetDocumentList) (*Vector_Document, error)
+	MediaUploadEncryptedFile(context.Context, *TLMediaUploadEncryptedFile) (*mtproto.EncryptedFile, error)
+	MediaGetEncryptedFile(context.Context, *TLMediaGetEncryptedFile) (*mtproto.EncryptedFile, error)
+	MediaUploadWallPaperFile(context.Context, *TLMediaUploadWallPaperFile) (*mtproto.Document, error)
+	MediaUploadThemeFile(context.Context, *TLMediaUploadThemeFile) (*mtproto.Document, error)
+	MediaUploadStickerFile(context.Context, *TLMediaUploadStickerFile) (*mtproto.Document, error)
+	MediaUploadRingtoneFile(context.Context, *TLMediaUploadRingtoneFile) (*mtproto.Document, error)
+	MediaCreateStickerSet(context.Context, *TLMediaCreateStickerSet) (*mtproto.Messages_StickerSet, error)
+	MediaAddStickerToSet(context.Context, *TLMediaAddStickerToSet) (*mtproto.Messages_StickerSet, error)
+	MediaRemoveStickerFromSet(context.Context, *TLMediaRemoveStickerFromSet) (*mtproto.Messages_StickerSet, error)
+	MediaChangeStickerPosition(context.Context, *TLMediaChangeStickerPosition) (*mtproto.Messages_StickerSet, error)
+	MediaSetStickerSetThumb(context.Context, *TLMediaSetStickerSetThumb) (*mtproto.Messages_StickerSet, error)
+	MediaCheckStickerSetShortName(context.Context, *TLMediaCheckStickerSetShortName) (*mtproto.Bool, error)
+	MediaGetStickerSet(context.Context, *TLMediaGetStickerSet) (*mtproto.Messages_StickerSet, error)
+	MediaInstallStickerSet(context.Context, *TLMediaInstallStickerSet) (*mtproto.Messages_StickerSetInstallResult, error)
+	MediaUninstallStickerSet(context.Context, *TLMediaUninstallStickerSet) (*mtproto.Bool, error)
+	MediaReorderStickerSets(context.Context, *TLMediaReorderStickerSets) (*mtproto.Bool, error)
+	MediaGetAllStickers(context.Context, *TLMediaGetAllStickers) (*mtproto.Messages_AllStickers, error)
+	MediaGetArchivedStickers(context.Context, *TLMediaGetArchivedStickers) (*mtproto.Messages_ArchivedStickers, error)
+	MediaSearchStickerSets(context.Context, *TLMediaSearchStickerSets) (*mtproto.Messages_FoundStickerSets, error)
+	MediaGetStickers(context.Context, *TLMediaGetStickers) (*mtproto.Messages_Stickers, error)
+	MediaGetRecentStickers(context.Context, *TLMediaGetRecentStickers) (*mtproto.Messages_RecentStickers, error)
+	MediaSaveRecentSticker(context.Context, *TLMediaSaveRecentSticker) (*mtproto.Bool, error)
+	MediaClearRecentStickers(context.Context, *TLMediaClearRecentStickers) (*mtproto.Bool, error)
+	MediaGetFavedStickers(context.Context, *TLMediaGetFavedStickers) (*mtproto.Messages_FavedStickers, error)
+	MediaFaveSticker(context.Context, *TLMediaFaveSticker) (*mtproto.Bool, error)
+	MediaGetStickerSetThumbDocument(context.Context, *TLMediaGetStickerSetThumbDocument) (*mtproto.Document, error)
+}
+
+// UnimplementedRPCMediaServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCMediaServer struct {
+}
+
+func (*UnimplementedRPCMediaServer) MediaUploadPhotoFile(ctx context.Context, req *TLMediaUploadPhotoFile) (*mtproto.Photo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadPhotoFile not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaUploadProfilePhotoFile(ctx context.Context, req *TLMediaUploadProfilePhotoFile) (*mtproto.Photo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadProfilePhotoFile not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaGetPhoto(ctx context.Context, req *TLMediaGetPhoto) (*mtproto.Photo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetPhoto not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaGetPhotoSizeList(ctx context.Context, req *TLMediaGetPhotoSizeList) (*PhotoSizeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetPhotoSizeList not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaGetPhotoSizeListList(ctx context.Context, req *TLMediaGetPhotoSizeListList) (*Vector_PhotoSizeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetPhotoSizeListList not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaGetVideoSizeList(ctx context.Context, req *TLMediaGetVideoSizeList) (*VideoSizeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetVideoSizeList not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaUploadedDocumentMedia(ctx context.Context, req *TLMediaUploadedDocumentMedia) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadedDocumentMedia not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaGetDocument(ctx context.Context, req *TLMediaGetDocument) (*mtproto.Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetDocument not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaGetDocumentList(ctx context.Context, req *TLMediaGetDocumentList) (*Vector_Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetDocumentList not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaUploadEncryptedFile(ctx context.Context, req *TLMediaUploadEncryptedFile) (*mtproto.EncryptedFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadEncryptedFile not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaGetEncryptedFile(ctx context.Context, req *TLMediaGetEncryptedFile) (*mtproto.EncryptedFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetEncryptedFile not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaUploadWallPaperFile(ctx context.Context, req *TLMediaUploadWallPaperFile) (*mtproto.Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadWallPaperFile not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaUploadThemeFile(ctx context.Context, req *TLMediaUploadThemeFile) (*mtproto.Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadThemeFile not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaUploadStickerFile(ctx context.Context, req *TLMediaUploadStickerFile) (*mtproto.Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadStickerFile not implemented")
+}
+func (*UnimplementedRPCMediaServer) MediaUploadRingtoneFile(ctx context.Context, req *TLMediaUploadRingtoneFile) (*mtproto.Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUploadRingtoneFile not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaCreateStickerSet(ctx context.Context, req *TLMediaCreateStickerSet) (*mtproto.Messages_StickerSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaCreateStickerSet not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaAddStickerToSet(ctx context.Context, req *TLMediaAddStickerToSet) (*mtproto.Messages_StickerSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaAddStickerToSet not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaRemoveStickerFromSet(ctx context.Context, req *TLMediaRemoveStickerFromSet) (*mtproto.Messages_StickerSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaRemoveStickerFromSet not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaChangeStickerPosition(ctx context.Context, req *TLMediaChangeStickerPosition) (*mtproto.Messages_StickerSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaChangeStickerPosition not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaSetStickerSetThumb(ctx context.Context, req *TLMediaSetStickerSetThumb) (*mtproto.Messages_StickerSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaSetStickerSetThumb not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaCheckStickerSetShortName(ctx context.Context, req *TLMediaCheckStickerSetShortName) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaCheckStickerSetShortName not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaGetStickerSet(ctx context.Context, req *TLMediaGetStickerSet) (*mtproto.Messages_StickerSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetStickerSet not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaInstallStickerSet(ctx context.Context, req *TLMediaInstallStickerSet) (*mtproto.Messages_StickerSetInstallResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaInstallStickerSet not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaUninstallStickerSet(ctx context.Context, req *TLMediaUninstallStickerSet) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaUninstallStickerSet not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaReorderStickerSets(ctx context.Context, req *TLMediaReorderStickerSets) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaReorderStickerSets not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaGetAllStickers(ctx context.Context, req *TLMediaGetAllStickers) (*mtproto.Messages_AllStickers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetAllStickers not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaGetArchivedStickers(ctx context.Context, req *TLMediaGetArchivedStickers) (*mtproto.Messages_ArchivedStickers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetArchivedStickers not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaSearchStickerSets(ctx context.Context, req *TLMediaSearchStickerSets) (*mtproto.Messages_FoundStickerSets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaSearchStickerSets not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaGetStickers(ctx context.Context, req *TLMediaGetStickers) (*mtproto.Messages_Stickers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetStickers not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaGetRecentStickers(ctx context.Context, req *TLMediaGetRecentStickers) (*mtproto.Messages_RecentStickers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetRecentStickers not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaSaveRecentSticker(ctx context.Context, req *TLMediaSaveRecentSticker) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaSaveRecentSticker not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaClearRecentStickers(ctx context.Context, req *TLMediaClearRecentStickers) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaClearRecentStickers not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaGetFavedStickers(ctx context.Context, req *TLMediaGetFavedStickers) (*mtproto.Messages_FavedStickers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetFavedStickers not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaFaveSticker(ctx context.Context, req *TLMediaFaveSticker) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaFaveSticker not implemented")
+}
+
+func (*UnimplementedRPCMediaServer) MediaGetStickerSetThumbDocument(ctx context.Context, req *TLMediaGetStickerSetThumbDocument) (*mtproto.Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGetStickerSetThumbDocument not implemented")
+}
+
+func RegisterRPCMediaServer(s *grpc.Server, srv RPCMediaServer) {
+	s.RegisterService(&_RPCMedia_serviceDesc, srv)
+}
+
+func _RPCMedia_MediaUploadPhotoFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUploadPhotoFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUploadPhotoFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUploadPhotoFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUploadPhotoFile(ctx, req.(*TLMediaUploadPhotoFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaUploadProfilePhotoFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUploadProfilePhotoFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUploadProfilePhotoFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUploadProfilePhotoFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUploadProfilePhotoFile(ctx, req.(*TLMediaUploadProfilePhotoFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetPhoto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetPhoto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetPhoto(ctx, req.(*TLMediaGetPhoto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetPhotoSizeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetPhotoSizeList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetPhotoSizeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetPhotoSizeList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetPhotoSizeList(ctx, req.(*TLMediaGetPhotoSizeList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetPhotoSizeListList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetPhotoSizeListList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetPhotoSizeListList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetPhotoSizeListList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetPhotoSizeListList(ctx, req.(*TLMediaGetPhotoSizeListList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetVideoSizeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetVideoSizeList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetVideoSizeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetVideoSizeList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetVideoSizeList(ctx, req.(*TLMediaGetVideoSizeList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaUploadedDocumentMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUploadedDocumentMedia)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUploadedDocumentMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUploadedDocumentMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUploadedDocumentMedia(ctx, req.(*TLMediaUploadedDocumentMedia))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetDocument)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetDocument(ctx, req.(*TLMediaGetDocument))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetDocumentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetDocumentList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetDocumentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetDocumentList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetDocumentList(ctx, req.(*TLMediaGetDocumentList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaUploadEncryptedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUploadEncryptedFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUploadEncryptedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUploadEncryptedFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUploadEncryptedFile(ctx, req.(*TLMediaUploadEncryptedFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetEncryptedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetEncryptedFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetEncryptedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetEncryptedFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetEncryptedFile(ctx, req.(*TLMediaGetEncryptedFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaUploadWallPaperFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUploadWallPaperFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUploadWallPaperFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUploadWallPaperFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUploadWallPaperFile(ctx, req.(*TLMediaUploadWallPaperFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaUploadThemeFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUploadThemeFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUploadThemeFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUploadThemeFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUploadThemeFile(ctx, req.(*TLMediaUploadThemeFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaUploadStickerFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUploadStickerFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUploadStickerFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUploadStickerFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUploadStickerFile(ctx, req.(*TLMediaUploadStickerFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaUploadRingtoneFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUploadRingtoneFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUploadRingtoneFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUploadRingtoneFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUploadRingtoneFile(ctx, req.(*TLMediaUploadRingtoneFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaCreateStickerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaCreateStickerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaCreateStickerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaCreateStickerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaCreateStickerSet(ctx, req.(*TLMediaCreateStickerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaAddStickerToSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaAddStickerToSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaAddStickerToSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaAddStickerToSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaAddStickerToSet(ctx, req.(*TLMediaAddStickerToSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaRemoveStickerFromSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaRemoveStickerFromSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaRemoveStickerFromSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaRemoveStickerFromSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaRemoveStickerFromSet(ctx, req.(*TLMediaRemoveStickerFromSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaChangeStickerPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaChangeStickerPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaChangeStickerPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaChangeStickerPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaChangeStickerPosition(ctx, req.(*TLMediaChangeStickerPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaSetStickerSetThumb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaSetStickerSetThumb)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaSetStickerSetThumb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaSetStickerSetThumb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaSetStickerSetThumb(ctx, req.(*TLMediaSetStickerSetThumb))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaCheckStickerSetShortName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaCheckStickerSetShortName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaCheckStickerSetShortName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaCheckStickerSetShortName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaCheckStickerSetShortName(ctx, req.(*TLMediaCheckStickerSetShortName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetStickerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetStickerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetStickerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetStickerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetStickerSet(ctx, req.(*TLMediaGetStickerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaInstallStickerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaInstallStickerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaInstallStickerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaInstallStickerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaInstallStickerSet(ctx, req.(*TLMediaInstallStickerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaUninstallStickerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaUninstallStickerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaUninstallStickerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaUninstallStickerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaUninstallStickerSet(ctx, req.(*TLMediaUninstallStickerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaReorderStickerSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaReorderStickerSets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaReorderStickerSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaReorderStickerSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaReorderStickerSets(ctx, req.(*TLMediaReorderStickerSets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetAllStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetAllStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetAllStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetAllStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetAllStickers(ctx, req.(*TLMediaGetAllStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetArchivedStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetArchivedStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetArchivedStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetArchivedStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetArchivedStickers(ctx, req.(*TLMediaGetArchivedStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaSearchStickerSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaSearchStickerSets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaSearchStickerSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaSearchStickerSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaSearchStickerSets(ctx, req.(*TLMediaSearchStickerSets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetStickers(ctx, req.(*TLMediaGetStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetRecentStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetRecentStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetRecentStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetRecentStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetRecentStickers(ctx, req.(*TLMediaGetRecentStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaSaveRecentSticker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaSaveRecentSticker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaSaveRecentSticker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaSaveRecentSticker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaSaveRecentSticker(ctx, req.(*TLMediaSaveRecentSticker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaClearRecentStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaClearRecentStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaClearRecentStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaClearRecentStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaClearRecentStickers(ctx, req.(*TLMediaClearRecentStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetFavedStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetFavedStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetFavedStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetFavedStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetFavedStickers(ctx, req.(*TLMediaGetFavedStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaFaveSticker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaFaveSticker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaFaveSticker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaFaveSticker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaFaveSticker(ctx, req.(*TLMediaFaveSticker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMedia_MediaGetStickerSetThumbDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMediaGetStickerSetThumbDocument)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMediaServer).MediaGetStickerSetThumbDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/media.RPCMedia/MediaGetStickerSetThumbDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMediaServer).MediaGetStickerSetThumbDocument(ctx, req.(*TLMediaGetStickerSetThumbDocument))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCMedia_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "media.RPCMedia",
+	HandlerType: (*RPCMediaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "media_uploadPhotoFile",
+			Handler:    _RPCMedia_MediaUploadPhotoFile_Handler,
+		},
+		{
+			MethodName: "media_uploadProfilePhotoFile",
+			Handler:    _RPCMedia_MediaUploadProfilePhotoFile_Handler,
+		},
+		{
+			MethodName: "media_getPhoto",
+			Handler:    _RPCMedia_MediaGetPhoto_Handler,
+		},
+		{
+			MethodName: "media_getPhotoSizeList",
+			Handler:    _RPCMedia_MediaGetPhotoSizeList_Handler,
+		},
+		{
+			MethodName: "media_getPhotoSizeListList",
+			Handler:    _RPCMedia_MediaGetPhotoSizeListList_Handler,
+		},
+		{
+			MethodName: "media_getVideoSizeList",
+			Handler:    _RPCMedia_MediaGetVideoSizeList_Handler,
+		},
+		{
+			MethodName: "media_uploadedDocumentMedia",
+			Handler:    _RPCMedia_MediaUploadedDocumentMedia_Handler,
+		},
+		{
+			MethodName: "media_getDocument",
+			Handler:    _RPCMedia_MediaGetDocument_Handler,
+		},
+		{
+			MethodName: "media_getDocumentList",
+			Handler:    _RPCMedia_MediaGetDocumentList_Handler,
+		},
+		{
+			MethodName: "media_uploadEncryptedFile",
+			Handler:    _RPCMedia_MediaUploadEncryptedFile_Handler,
+		},
+		{
+			MethodName: "media_getEncryptedFile",
+			Handler:    _RPCMedia_MediaGetEncryptedFile_Handler,
+		},
+		{
+			MethodName: "media_uploadWallPaperFile",
+			Handler:    _RPCMedia_MediaUploadWallPaperFile_Handler,
+		},
+		{
+			MethodName: "media_uploadThemeFile",
+			Handler:    _RPCMedia_MediaUploadThemeFile_Handler,
+		},
+		{
+			MethodName: "media_uploadStickerFile",
+			Handler:    _RPCMedia_MediaUploadStickerFile_Handler,
+		},
+		{
+			MethodName: "media_uploadRingtoneFile",
+			Handler:    _RPCMedia_MediaUploadRingtoneFile_Handler,
+		},
+		{
+			MethodName: "media_createStickerSet",
+			Handler:    _RPCMedia_MediaCreateStickerSet_Handler,
+		},
+		{
+			MethodName: "media_addStickerToSet",
+			Handler:    _RPCMedia_MediaAddStickerToSet_Handler,
+		},
+		{
+			MethodName: "media_removeStickerFromSet",
+			Handler:    _RPCMedia_MediaRemoveStickerFromSet_Handler,
+		},
+		{
+			MethodName: "media_changeStickerPosition",
+			Handler:    _RPCMedia_MediaChangeStickerPosition_Handler,
+		},
+		{
+			MethodName: "media_setStickerSetThumb",
+			Handler:    _RPCMedia_MediaSetStickerSetThumb_Handler,
+		},
+		{
+			MethodName: "media_checkStickerSetShortName",
+			Handler:    _RPCMedia_MediaCheckStickerSetShortName_Handler,
+		},
+		{
+			MethodName: "media_getStickerSet",
+			Handler:    _RPCMedia_MediaGetStickerSet_Handler,
+		},
+		{
+			MethodName: "media_installStickerSet",
+			Handler:    _RPCMedia_MediaInstallStickerSet_Handler,
+		},
+		{
+			MethodName: "media_uninstallStickerSet",
+			Handler:    _RPCMedia_MediaUninstallStickerSet_Handler,
+		},
+		{
+			MethodName: "media_reorderStickerSets",
+			Handler:    _RPCMedia_MediaReorderStickerSets_Handler,
+		},
+		{
+			MethodName: "media_getAllStickers",
+			Handler:    _RPCMedia_MediaGetAllStickers_Handler,
+		},
+		{
+			MethodName: "media_getArchivedStickers",
+			Handler:    _RPCMedia_MediaGetArchivedStickers_Handler,
+		},
+		{
+			MethodName: "media_searchStickerSets",
+			Handler:    _RPCMedia_MediaSearchStickerSets_Handler,
+		},
+		{
+			MethodName: "media_getStickers",
+			Handler:    _RPCMedia_MediaGetStickers_Handler,
+		},
+		{
+			MethodName: "media_getRecentStickers",
+			Handler:    _RPCMedia_MediaGetRecentStickers_Handler,
+		},
+		{
+			MethodName: "media_saveRecentSticker",
+			Handler:    _RPCMedia_MediaSaveRecentSticker_Handler,
+		},
+		{
+			MethodName: "media_clearRecentStickers",
+			Handler:    _RPCMedia_MediaClearRecentStickers_Handler,
+		},
+		{
+			MethodName: "media_getFavedStickers",
+			Handler:    _RPCMedia_MediaGetFavedStickers_Handler,
+		},
+		{
+			MethodName: "media_faveSticker",
+			Handler:    _RPCMedia_MediaFaveSticker_Handler,
+		},
+		{
+			MethodName: "media_getStickerSetThumbDocument",
+			Handler:    _RPCMedia_MediaGetStickerSetThumbDocument_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "media.tl.proto",
+}
+
+func (m *PhotoSizeList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhotoSizeList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PhotoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DcId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.DcId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Sizes) > 0 {
+		for iNdEx := len(m.Sizes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMediaTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SizeId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.SizeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLPhotoSizeList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPhotoSizeList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPhotoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoSizeList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoSizeList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DcId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.DcId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Sizes) > 0 {
+		for iNdEx := len(m.Sizes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMediaTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SizeId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.SizeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLVideoSizeList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLVideoSizeList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLVideoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUploadPhotoFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUploadPhotoFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUploadPhotoFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TtlSeconds != nil {
+		{
+			size, err := m.TtlSeconds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Stickers) > 0 {
+		for iNdEx := len(m.Stickers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stickers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMediaTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUploadProfilePhotoFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUploadProfilePhotoFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUploadProfilePhotoFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.VideoStartTs != nil {
+		{
+			size, err := m.VideoStartTs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Video != nil {
+		{
+			size, err := m.Video.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetPhoto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetPhoto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetPhoto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PhotoId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.PhotoId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetPhotoSizeList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetPhotoSizeList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetPhotoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SizeId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.SizeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetPhotoSizeListList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetPhotoSizeListList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetPhotoSizeListList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IdList) > 0 {
+		dAtA9 := make([]byte, len(m.IdList)*10)
+		var j8 int
+		for _, num1 := range m.IdList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintMediaTl(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetVideoSizeList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetVideoSizeList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetVideoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SizeId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.SizeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUploadedDocumentMedia) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUploadedDocumentMedia) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUploadedDocumentMedia) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Media != nil {
+		{
+			size, err := m.Media.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Id != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetDocumentList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetDocumentList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetDocumentList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IdList) > 0 {
+		dAtA12 := make([]byte, len(m.IdList)*10)
+		var j11 int
+		for _, num1 := range m.IdList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintMediaTl(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUploadEncryptedFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUploadEncryptedFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUploadEncryptedFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetEncryptedFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetEncryptedFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetEncryptedFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AccessHash != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.AccessHash))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUploadWallPaperFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUploadWallPaperFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUploadWallPaperFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Admin != nil {
+		{
+			size, err := m.Admin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUploadThemeFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUploadThemeFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUploadThemeFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Thumb != nil {
+		{
+			size, err := m.Thumb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUploadStickerFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUploadStickerFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUploadStickerFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DocumentAttributeSticker != nil {
+		{
+			size, err := m.DocumentAttributeSticker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Thumb != nil {
+		{
+			size, err := m.Thumb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUploadRingtoneFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUploadRingtoneFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUploadRingtoneFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaCreateStickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaCreateStickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaCreateStickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stickers) > 0 {
+		for iNdEx := len(m.Stickers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stickers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMediaTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ThumbDocumentId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.ThumbDocumentId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ShortName) > 0 {
+		i -= len(m.ShortName)
+		copy(dAtA[i:], m.ShortName)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.ShortName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Emojis {
+		i--
+		if m.Emojis {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Videos {
+		i--
+		if m.Videos {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Animated {
+		i--
+		if m.Animated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Masks {
+		i--
+		if m.Masks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaAddStickerToSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaAddStickerToSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaAddStickerToSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Sticker != nil {
+		{
+			size, err := m.Sticker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaRemoveStickerFromSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaRemoveStickerFromSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaRemoveStickerFromSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DocumentId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.DocumentId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaChangeStickerPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaChangeStickerPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaChangeStickerPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Position != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DocumentId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.DocumentId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaSetStickerSetThumb) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaSetStickerSetThumb) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaSetStickerSetThumb) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ThumbDocumentId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.ThumbDocumentId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OwnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaCheckStickerSetShortName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaCheckStickerSetShortName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaCheckStickerSetShortName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ShortName) > 0 {
+		i -= len(m.ShortName)
+		copy(dAtA[i:], m.ShortName)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.ShortName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetStickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetStickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetStickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaInstallStickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaInstallStickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaInstallStickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaUninstallStickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaUninstallStickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaUninstallStickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaReorderStickerSets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaReorderStickerSets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaReorderStickerSets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Order) > 0 {
+		dAtA6 := make([]byte, len(m.Order)*10)
+		var j6 int
+		for _, num1 := range m.Order {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA6[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA6[:j6])
+		i = encodeVarintMediaTl(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Emojis {
+		i--
+		if m.Emojis {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Masks {
+		i--
+		if m.Masks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetAllStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetAllStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetAllStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hash != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Emojis {
+		i--
+		if m.Emojis {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Masks {
+		i--
+		if m.Masks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetArchivedStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetArchivedStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetArchivedStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OffsetId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.OffsetId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Emojis {
+		i--
+		if m.Emojis {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Masks {
+		i--
+		if m.Masks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaSearchStickerSets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaSearchStickerSets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaSearchStickerSets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hash != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Q) > 0 {
+		i -= len(m.Q)
+		copy(dAtA[i:], m.Q)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.Q)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hash != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Emoticon) > 0 {
+		i -= len(m.Emoticon)
+		copy(dAtA[i:], m.Emoticon)
+		i = encodeVarintMediaTl(dAtA, i, uint64(len(m.Emoticon)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetRecentStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetRecentStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetRecentStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hash != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaSaveRecentSticker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaSaveRecentSticker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaSaveRecentSticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Id != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Unsave {
+		i--
+		if m.Unsave {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaClearRecentStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaClearRecentStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaClearRecentStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetFavedStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetFavedStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetFavedStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hash != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaFaveSticker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaFaveSticker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaFaveSticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Id != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Unfave {
+		i--
+		if m.Unfave {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMediaGetStickerSetThumbDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMediaGetStickerSetThumbDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMediaGetStickerSetThumbDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMediaTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMediaTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_PhotoSizeList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_PhotoSizeList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_PhotoSizeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMediaTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_Document) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_Document) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Document) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMediaTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMediaTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMediaTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PhotoSizeList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.SizeId != 0 {
+		n += 1 + sovMediaTl(uint64(m.SizeId))
+	}
+	if len(m.Sizes) > 0 {
+		for _, e := range m.Sizes {
+			l = e.Size()
+			n += 1 + l + sovMediaTl(uint64(l))
+		}
+	}
+	if m.DcId != 0 {
+		n += 1 + sovMediaTl(uint64(m.DcId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPhotoSizeList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VideoSizeList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.SizeId != 0 {
+		n += 1 + sovMediaTl(uint64(m.SizeId))
+	}
+	if len(m.Sizes) > 0 {
+		for _, e := range m.Sizes {
+			l = e.Size()
+			n += 1 + l + sovMediaTl(uint64(l))
+		}
+	}
+	if m.DcId != 0 {
+		n += 1 + sovMediaTl(uint64(m.DcId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLVideoSizeList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUploadPhotoFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if len(m.Stickers) > 0 {
+		for _, e := range m.Stickers {
+			l = e.Size()
+			n += 1 + l + sovMediaTl(uint64(l))
+		}
+	}
+	if m.TtlSeconds != nil {
+		l = m.TtlSeconds.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUploadProfilePhotoFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Video != nil {
+		l = m.Video.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.VideoStartTs != nil {
+		l = m.VideoStartTs.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetPhoto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.PhotoId != 0 {
+		n += 1 + sovMediaTl(uint64(m.PhotoId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetPhotoSizeList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.SizeId != 0 {
+		n += 1 + sovMediaTl(uint64(m.SizeId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetPhotoSizeListList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if len(m.IdList) > 0 {
+		l = 0
+		for _, e := range m.IdList {
+			l += sovMediaTl(uint64(e))
+		}
+		n += 1 + sovMediaTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetVideoSizeList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.SizeId != 0 {
+		n += 1 + sovMediaTl(uint64(m.SizeId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUploadedDocumentMedia) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.Media != nil {
+		l = m.Media.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMediaTl(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetDocumentList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if len(m.IdList) > 0 {
+		l = 0
+		for _, e := range m.IdList {
+			l += sovMediaTl(uint64(e))
+		}
+		n += 1 + sovMediaTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUploadEncryptedFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetEncryptedFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMediaTl(uint64(m.Id))
+	}
+	if m.AccessHash != 0 {
+		n += 1 + sovMediaTl(uint64(m.AccessHash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUploadWallPaperFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Admin != nil {
+		l = m.Admin.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUploadThemeFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Thumb != nil {
+		l = m.Thumb.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUploadStickerFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Thumb != nil {
+		l = m.Thumb.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.DocumentAttributeSticker != nil {
+		l = m.DocumentAttributeSticker.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUploadRingtoneFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaCreateStickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Masks {
+		n += 2
+	}
+	if m.Animated {
+		n += 2
+	}
+	if m.Videos {
+		n += 2
+	}
+	if m.Emojis {
+		n += 2
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	l = len(m.ShortName)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.ThumbDocumentId != 0 {
+		n += 1 + sovMediaTl(uint64(m.ThumbDocumentId))
+	}
+	if len(m.Stickers) > 0 {
+		for _, e := range m.Stickers {
+			l = e.Size()
+			n += 1 + l + sovMediaTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaAddStickerToSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Sticker != nil {
+		l = m.Sticker.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaRemoveStickerFromSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.DocumentId != 0 {
+		n += 1 + sovMediaTl(uint64(m.DocumentId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaChangeStickerPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.DocumentId != 0 {
+		n += 1 + sovMediaTl(uint64(m.DocumentId))
+	}
+	if m.Position != 0 {
+		n += 1 + sovMediaTl(uint64(m.Position))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaSetStickerSetThumb) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.OwnerId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OwnerId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.ThumbDocumentId != 0 {
+		n += 1 + sovMediaTl(uint64(m.ThumbDocumentId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaCheckStickerSetShortName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	l = len(m.ShortName)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetStickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaInstallStickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Archived {
+		n += 2
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaUninstallStickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaReorderStickerSets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Masks {
+		n += 2
+	}
+	if m.Emojis {
+		n += 2
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if len(m.Order) > 0 {
+		l = 0
+		for _, e := range m.Order {
+			l += sovMediaTl(uint64(e))
+		}
+		n += 1 + sovMediaTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetAllStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Masks {
+		n += 2
+	}
+	if m.Emojis {
+		n += 2
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovMediaTl(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetArchivedStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Masks {
+		n += 2
+	}
+	if m.Emojis {
+		n += 2
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.OffsetId != 0 {
+		n += 1 + sovMediaTl(uint64(m.OffsetId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMediaTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaSearchStickerSets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	l = len(m.Q)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovMediaTl(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	l = len(m.Emoticon)
+	if l > 0 {
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovMediaTl(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetRecentStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovMediaTl(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaSaveRecentSticker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Unsave {
+		n += 2
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMediaTl(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaClearRecentStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetFavedStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovMediaTl(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaFaveSticker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Unfave {
+		n += 2
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMediaTl(uint64(m.UserId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMediaTl(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMediaGetStickerSetThumbDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMediaTl(uint64(m.Constructor))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovMediaTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_PhotoSizeList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovMediaTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Document) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovMediaTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMediaTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMediaTl(x uint64) (n int) {
+	return sovMediaTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PhotoSizeList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PhotoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeId", wireType)
+			}
+			m.SizeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sizes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sizes = append(m.Sizes, &mtproto.PhotoSize{})
+			if err := m.Sizes[len(m.Sizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DcId", wireType)
+			}
+			m.DcId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DcId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPhotoSizeList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_photoSizeList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_photoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &PhotoSizeList{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoSizeList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoSizeList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeId", wireType)
+			}
+			m.SizeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sizes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sizes = append(m.Sizes, &mtproto.VideoSize{})
+			if err := m.Sizes[len(m.Sizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DcId", wireType)
+			}
+			m.DcId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DcId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLVideoSizeList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_videoSizeList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_videoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &VideoSizeList{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaUploadPhotoFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_uploadPhotoFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_uploadPhotoFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stickers = append(m.Stickers, &mtproto.InputDocument{})
+			if err := m.Stickers[len(m.Stickers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TtlSeconds == nil {
+				m.TtlSeconds = &types.Int32Value{}
+			}
+			if err := m.TtlSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaUploadProfilePhotoFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_uploadProfilePhotoFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_uploadProfilePhotoFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Video", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Video == nil {
+				m.Video = &mtproto.InputFile{}
+			}
+			if err := m.Video.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoStartTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VideoStartTs == nil {
+				m.VideoStartTs = &types.DoubleValue{}
+			}
+			if err := m.VideoStartTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaGetPhoto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_getPhoto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_getPhoto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhotoId", wireType)
+			}
+			m.PhotoId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhotoId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaGetPhotoSizeList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_getPhotoSizeList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_getPhotoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeId", wireType)
+			}
+			m.SizeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaGetPhotoSizeListList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_getPhotoSizeListList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_getPhotoSizeListList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMediaTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IdList = append(m.IdList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMediaTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMediaTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMediaTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IdList) == 0 {
+					m.IdList = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMediaTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IdList = append(m.IdList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdList", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaGetVideoSizeList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_getVideoSizeList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_getVideoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeId", wireType)
+			}
+			m.SizeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaUploadedDocumentMedia) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_uploadedDocumentMedia: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_uploadedDocumentMedia: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Media", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Media == nil {
+				m.Media = &mtproto.InputMedia{}
+			}
+			if err := m.Media.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaGetDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_getDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_getDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaGetDocumentList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_getDocumentList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_getDocumentList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMediaTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IdList = append(m.IdList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMediaTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMediaTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMediaTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IdList) == 0 {
+					m.IdList = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMediaTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IdList = append(m.IdList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdList", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaUploadEncryptedFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_uploadEncryptedFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_uploadEncryptedFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputEncryptedFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaGetEncryptedFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_getEncryptedFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_getEncryptedFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessHash", wireType)
+			}
+			m.AccessHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessHash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaUploadWallPaperFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_uploadWallPaperFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_uploadWallPaperFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Admin == nil {
+				m.Admin = &mtproto.Bool{}
+			}
+			if err := m.Admin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaUploadThemeFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_uploadThemeFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_uploadThemeFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thumb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Thumb == nil {
+				m.Thumb = &mtproto.InputFile{}
+			}
+			if err := m.Thumb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_uploadStickerFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_uploadStickerFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thumb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Thumb == nil {
+				m.Thumb = &mtproto.InputFile{}
+			}
+			if err := m.Thumb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentAttributeSticker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentAttributeSticker == nil {
+				m.DocumentAttributeSticker = &mtproto.DocumentAttribute{}
+			}
+			if err := m.DocumentAttributeSticker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaUploadRingtoneFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_uploadRingtoneFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_uploadRingtoneFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaCreateStickerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_createStickerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_createStickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Masks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Masks = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Animated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Animated = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Videos", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Videos = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emojis", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Emojis = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThumbDocumentId", wireType)
+			}
+			m.ThumbDocumentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThumbDocumentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stickers = append(m.Stickers, &mtproto.InputStickerSetItem{})
+			if err := m.Stickers[len(m.Stickers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *Vector_Document) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Datas) > 0 {
-		for _, e := range m.Datas {
-			l = e.Size()
-			n += 1 + l + sovMediaTl(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMediaTl(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMediaTl(x uint64) (n int) {
-	return sovMediaTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
+func (m *TLMediaAddStickerToSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4234,17 +11491,17 @@ func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PhotoSizeList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_addStickerToSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PhotoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_addStickerToSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			var stringLen uint64
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4254,29 +11511,16 @@ func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PredicateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
 			}
-			m.Constructor = 0
+			m.OwnerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4286,16 +11530,16 @@ func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.OwnerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
 			}
-			m.SizeId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4305,14 +11549,31 @@ func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sizes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sticker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4339,16 +11600,107 @@ func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sizes = append(m.Sizes, &mtproto.PhotoSize{})
-			if err := m.Sizes[len(m.Sizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Sticker == nil {
+				m.Sticker = &mtproto.InputStickerSetItem{}
+			}
+			if err := m.Sticker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaRemoveStickerFromSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_removeStickerFromSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_removeStickerFromSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DcId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
 			}
-			m.DcId = 0
+			m.DocumentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4358,7 +11710,7 @@ func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DcId |= int32(b&0x7F) << shift
+				m.DocumentId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4385,7 +11737,7 @@ func (m *PhotoSizeList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLPhotoSizeList) Unmarshal(dAtA []byte) error {
+func (m *TLMediaChangeStickerPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4408,17 +11760,17 @@ func (m *TLPhotoSizeList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_photoSizeList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_changeStickerPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_photoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_changeStickerPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			var msglen int
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4428,28 +11780,68 @@ func (m *TLPhotoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
+			m.OwnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
 			}
-			if m.Data2 == nil {
-				m.Data2 = &PhotoSizeList{}
+			m.DocumentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMediaTl(dAtA[iNdEx:])
@@ -4472,7 +11864,7 @@ func (m *TLPhotoSizeList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoSizeList) Unmarshal(dAtA []byte) error {
+func (m *TLMediaSetStickerSetThumb) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4495,45 +11887,13 @@ func (m *VideoSizeList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VideoSizeList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_setStickerSetThumb: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VideoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_setStickerSetThumb: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PredicateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
@@ -4554,9 +11914,9 @@ func (m *VideoSizeList) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
 			}
-			m.SizeId = 0
+			m.OwnerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4566,14 +11926,14 @@ func (m *VideoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeId |= int64(b&0x7F) << shift
+				m.OwnerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sizes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4600,16 +11960,18 @@ func (m *VideoSizeList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sizes = append(m.Sizes, &mtproto.VideoSize{})
-			if err := m.Sizes[len(m.Sizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DcId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThumbDocumentId", wireType)
 			}
-			m.DcId = 0
+			m.ThumbDocumentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4619,7 +11981,7 @@ func (m *VideoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DcId |= int32(b&0x7F) << shift
+				m.ThumbDocumentId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4646,7 +12008,7 @@ func (m *VideoSizeList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLVideoSizeList) Unmarshal(dAtA []byte) error {
+func (m *TLMediaCheckStickerSetShortName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4669,17 +12031,36 @@ func (m *TLVideoSizeList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_videoSizeList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_checkStickerSetShortName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_videoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_checkStickerSetShortName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4689,27 +12070,23 @@ func (m *TLVideoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMediaTl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMediaTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data2 == nil {
-				m.Data2 = &VideoSizeList{}
-			}
-			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ShortName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4733,7 +12110,7 @@ func (m *TLVideoSizeList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaUploadPhotoFile) Unmarshal(dAtA []byte) error {
+func (m *TLMediaGetStickerSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4756,10 +12133,10 @@ func (m *TLMediaUploadPhotoFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_uploadPhotoFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_getStickerSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_uploadPhotoFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_getStickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4783,96 +12160,26 @@ func (m *TLMediaUploadPhotoFile) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
-			}
-			m.OwnerId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OwnerId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stickers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stickers = append(m.Stickers, &mtproto.InputDocument{})
-			if err := m.Stickers[len(m.Stickers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4899,10 +12206,10 @@ func (m *TLMediaUploadPhotoFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TtlSeconds == nil {
-				m.TtlSeconds = &types.Int32Value{}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
 			}
-			if err := m.TtlSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4928,7 +12235,7 @@ func (m *TLMediaUploadPhotoFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaUploadProfilePhotoFile) Unmarshal(dAtA []byte) error {
+func (m *TLMediaInstallStickerSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4951,10 +12258,10 @@ func (m *TLMediaUploadProfilePhotoFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_uploadProfilePhotoFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_installStickerSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_uploadProfilePhotoFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_installStickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4978,9 +12285,9 @@ func (m *TLMediaUploadProfilePhotoFile) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
 			}
-			m.OwnerId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -4990,16 +12297,17 @@ func (m *TLMediaUploadProfilePhotoFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OwnerId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Archived = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var msglen int
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5009,67 +12317,14 @@ func (m *TLMediaUploadProfilePhotoFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Video", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Video == nil {
-				m.Video = &mtproto.InputFile{}
-			}
-			if err := m.Video.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoStartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5096,10 +12351,10 @@ func (m *TLMediaUploadProfilePhotoFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VideoStartTs == nil {
-				m.VideoStartTs = &types.DoubleValue{}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
 			}
-			if err := m.VideoStartTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5125,7 +12380,7 @@ func (m *TLMediaUploadProfilePhotoFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaGetPhoto) Unmarshal(dAtA []byte) error {
+func (m *TLMediaUninstallStickerSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5148,10 +12403,10 @@ func (m *TLMediaGetPhoto) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_getPhoto: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_uninstallStickerSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_getPhoto: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_uninstallStickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5175,9 +12430,9 @@ func (m *TLMediaGetPhoto) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhotoId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.PhotoId = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5187,11 +12442,47 @@ func (m *TLMediaGetPhoto) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PhotoId |= int64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMediaTl(dAtA[iNdEx:])
@@ -5214,7 +12505,7 @@ func (m *TLMediaGetPhoto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaGetPhotoSizeList) Unmarshal(dAtA []byte) error {
+func (m *TLMediaReorderStickerSets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5237,10 +12528,10 @@ func (m *TLMediaGetPhotoSizeList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_getPhotoSizeList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_reorderStickerSets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_getPhotoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_reorderStickerSets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5264,9 +12555,9 @@ func (m *TLMediaGetPhotoSizeList) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Masks", wireType)
 			}
-			m.SizeId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5276,67 +12567,37 @@ func (m *TLMediaGetPhotoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMediaTl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TLMediaGetPhotoSizeListList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMediaTl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Masks = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emojis", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_getPhotoSizeListList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_getPhotoSizeListList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Emojis = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.Constructor = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5346,12 +12607,12 @@ func (m *TLMediaGetPhotoSizeListList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType == 0 {
 				var v int64
 				for shift := uint(0); ; shift += 7 {
@@ -5368,7 +12629,7 @@ func (m *TLMediaGetPhotoSizeListList) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.IdList = append(m.IdList, v)
+				m.Order = append(m.Order, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -5403,8 +12664,8 @@ func (m *TLMediaGetPhotoSizeListList) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.IdList) == 0 {
-					m.IdList = make([]int64, 0, elementCount)
+				if elementCount != 0 && len(m.Order) == 0 {
+					m.Order = make([]int64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v int64
@@ -5422,10 +12683,10 @@ func (m *TLMediaGetPhotoSizeListList) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.IdList = append(m.IdList, v)
+					m.Order = append(m.Order, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5449,7 +12710,7 @@ func (m *TLMediaGetPhotoSizeListList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaGetVideoSizeList) Unmarshal(dAtA []byte) error {
+func (m *TLMediaGetAllStickers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5472,17 +12733,76 @@ func (m *TLMediaGetVideoSizeList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_getVideoSizeList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_getAllStickers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_getVideoSizeList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_getAllStickers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Masks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Masks = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emojis", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Emojis = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.Constructor = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5492,16 +12812,16 @@ func (m *TLMediaGetVideoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.SizeId = 0
+			m.Hash = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5511,7 +12831,7 @@ func (m *TLMediaGetVideoSizeList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeId |= int64(b&0x7F) << shift
+				m.Hash |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5538,7 +12858,7 @@ func (m *TLMediaGetVideoSizeList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaUploadedDocumentMedia) Unmarshal(dAtA []byte) error {
+func (m *TLMediaGetArchivedStickers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5561,10 +12881,10 @@ func (m *TLMediaUploadedDocumentMedia) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_uploadedDocumentMedia: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_getArchivedStickers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_uploadedDocumentMedia: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_getArchivedStickers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5588,9 +12908,9 @@ func (m *TLMediaUploadedDocumentMedia) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Masks", wireType)
 			}
-			m.OwnerId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5600,16 +12920,17 @@ func (m *TLMediaUploadedDocumentMedia) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OwnerId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Masks = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Media", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emojis", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5619,84 +12940,36 @@ func (m *TLMediaUploadedDocumentMedia) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Media == nil {
-				m.Media = &mtproto.InputMedia{}
-			}
-			if err := m.Media.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMediaTl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TLMediaGetDocument) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMediaTl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Emojis = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_getDocument: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_getDocument: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
 			}
-			m.Constructor = 0
+			m.OffsetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5706,16 +12979,16 @@ func (m *TLMediaGetDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.OffsetId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Id = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5725,7 +12998,7 @@ func (m *TLMediaGetDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5752,7 +13025,7 @@ func (m *TLMediaGetDocument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaGetDocumentList) Unmarshal(dAtA []byte) error {
+func (m *TLMediaSearchStickerSets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5775,10 +13048,10 @@ func (m *TLMediaGetDocumentList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_getDocumentList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_searchStickerSets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_getDocumentList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_searchStickerSets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5801,80 +13074,74 @@ func (m *TLMediaGetDocumentList) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMediaTl
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
 				}
-				m.IdList = append(m.IdList, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMediaTl
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMediaTl
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMediaTl
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.IdList) == 0 {
-					m.IdList = make([]int64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMediaTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Q = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMediaTl
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IdList = append(m.IdList, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdList", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5898,7 +13165,7 @@ func (m *TLMediaGetDocumentList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaUploadEncryptedFile) Unmarshal(dAtA []byte) error {
+func (m *TLMediaGetStickers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5921,10 +13188,10 @@ func (m *TLMediaUploadEncryptedFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_uploadEncryptedFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_getStickers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_uploadEncryptedFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_getStickers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5948,9 +13215,9 @@ func (m *TLMediaUploadEncryptedFile) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.OwnerId = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5960,16 +13227,16 @@ func (m *TLMediaUploadEncryptedFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OwnerId |= int64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emoticon", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -5979,28 +13246,43 @@ func (m *TLMediaUploadEncryptedFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMediaTl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMediaTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &mtproto.InputEncryptedFile{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Emoticon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMediaTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMediaTl(dAtA[iNdEx:])
@@ -6023,7 +13305,7 @@ func (m *TLMediaUploadEncryptedFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaGetEncryptedFile) Unmarshal(dAtA []byte) error {
+func (m *TLMediaGetRecentStickers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6046,10 +13328,10 @@ func (m *TLMediaGetEncryptedFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_getEncryptedFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_getRecentStickers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_getEncryptedFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_getRecentStickers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6073,9 +13355,9 @@ func (m *TLMediaGetEncryptedFile) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.Id = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6085,16 +13367,16 @@ func (m *TLMediaGetEncryptedFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.AccessHash = 0
+			m.Hash = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6104,7 +13386,7 @@ func (m *TLMediaGetEncryptedFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccessHash |= int64(b&0x7F) << shift
+				m.Hash |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6131,7 +13413,7 @@ func (m *TLMediaGetEncryptedFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaUploadWallPaperFile) Unmarshal(dAtA []byte) error {
+func (m *TLMediaSaveRecentSticker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6154,10 +13436,10 @@ func (m *TLMediaUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_uploadWallPaperFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_saveRecentSticker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_uploadWallPaperFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_saveRecentSticker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6181,9 +13463,9 @@ func (m *TLMediaUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsave", wireType)
 			}
-			m.OwnerId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6193,16 +13475,17 @@ func (m *TLMediaUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OwnerId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Unsave = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var msglen int
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6212,65 +13495,16 @@ func (m *TLMediaUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6280,28 +13514,11 @@ func (m *TLMediaUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Admin == nil {
-				m.Admin = &mtproto.Bool{}
-			}
-			if err := m.Admin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMediaTl(dAtA[iNdEx:])
@@ -6324,7 +13541,7 @@ func (m *TLMediaUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaUploadThemeFile) Unmarshal(dAtA []byte) error {
+func (m *TLMediaClearRecentStickers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6347,10 +13564,10 @@ func (m *TLMediaUploadThemeFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_uploadThemeFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_clearRecentStickers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_uploadThemeFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_clearRecentStickers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6374,159 +13591,23 @@ func (m *TLMediaUploadThemeFile) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
-			}
-			m.OwnerId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OwnerId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Thumb", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			if m.Thumb == nil {
-				m.Thumb = &mtproto.InputFile{}
-			}
-			if err := m.Thumb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
-			}
-			var stringLen uint64
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.FileName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMediaTl(dAtA[iNdEx:])
@@ -6549,7 +13630,7 @@ func (m *TLMediaUploadThemeFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
+func (m *TLMediaGetFavedStickers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6572,10 +13653,10 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_uploadStickerFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_getFavedStickers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_uploadStickerFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_getFavedStickers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6599,9 +13680,9 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.OwnerId = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6611,16 +13692,16 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OwnerId |= int64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			m.Hash = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6630,33 +13711,67 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Hash |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMediaTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMediaTl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMediaFaveSticker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMediaTl
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Thumb", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_media_faveSticker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_media_faveSticker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6666,33 +13781,16 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Thumb == nil {
-				m.Thumb = &mtproto.InputFile{}
-			}
-			if err := m.Thumb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unfave", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6702,29 +13800,17 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			m.Unfave = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var stringLen uint64
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6734,29 +13820,16 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FileName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentAttributeSticker", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMediaTl
@@ -6766,28 +13839,11 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DocumentAttributeSticker == nil {
-				m.DocumentAttributeSticker = &mtproto.DocumentAttribute{}
-			}
-			if err := m.DocumentAttributeSticker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMediaTl(dAtA[iNdEx:])
@@ -6810,7 +13866,7 @@ func (m *TLMediaUploadStickerFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMediaUploadRingtoneFile) Unmarshal(dAtA []byte) error {
+func (m *TLMediaGetStickerSetThumbDocument) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6833,10 +13889,10 @@ func (m *TLMediaUploadRingtoneFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_media_uploadRingtoneFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_media_getStickerSetThumbDocument: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_media_uploadRingtoneFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_media_getStickerSetThumbDocument: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6859,27 +13915,8 @@ func (m *TLMediaUploadRingtoneFile) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
-			}
-			m.OwnerId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OwnerId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6906,77 +13943,13 @@ func (m *TLMediaUploadRingtoneFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMediaTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMediaTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FileName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMediaTl(dAtA[iNdEx:])
@@ -26,21 +26,41 @@ type RPCContextTuple struct {
 }
 
 var rpcContextRegisters = map[string]RPCContextTuple{
-	"TLMediaUploadPhotoFile":        RPCContextTuple{"/mtproto.RPCMedia/media_uploadPhotoFile", func() interface{} { return new(mtproto.Photo) }},
-	"TLMediaUploadProfilePhotoFile": RPCContextTuple{"/mtproto.RPCMedia/media_uploadProfilePhotoFile", func() interface{} { return new(mtproto.Photo) }},
-	"TLMediaGetPhoto":               RPCContextTuple{"/mtproto.RPCMedia/media_getPhoto", func() interface{} { return new(mtproto.Photo) }},
-	"TLMediaGetPhotoSizeList":       RPCContextTuple{"/mtproto.RPCMedia/media_getPhotoSizeList", func() interface{} { return new(PhotoSizeList) }},
-	"TLMediaGetPhotoSizeListList":   RPCContextTuple{"/mtproto.RPCMedia/media_getPhotoSizeListList", func() interface{} { return new(Vector_PhotoSizeList) }},
-	"TLMediaGetVideoSizeList":       RPCContextTuple{"/mtproto.RPCMedia/media_getVideoSizeList", func() interface{} { return new(VideoSizeList) }},
-	"TLMediaUploadedDocumentMedia":  RPCContextTuple{"/mtproto.RPCMedia/media_uploadedDocumentMedia", func() interface{} { return new(mtproto.MessageMedia) }},
-	"TLMediaGetDocument":            RPCContextTuple{"/mtproto.RPCMedia/media_getDocument", func() interface{} { return new(mtproto.Document) }},
-	"TLMediaGetDocumentList":        RPCContextTuple{"/mtproto.RPCMedia/media_getDocumentList", func() interface{} { return new(Vector_Document) }},
-	"TLMediaUploadEncryptedFile":    RPCContextTuple{"/mtproto.RPCMedia/media_uploadEncryptedFile", func() interface{} { return new(mtproto.EncryptedFile) }},
-	"TLMediaGetEncryptedFile":       RPCContextTuple{"/mtproto.RPCMedia/media_getEncryptedFile", func() interface{} { return new(mtproto.EncryptedFile) }},
-	"TLMediaUploadWallPaperFile":    RPCContextTuple{"/mtproto.RPCMedia/media_uploadWallPaperFile", func() interface{} { return new(mtproto.Document) }},
-	"TLMediaUploadThemeFile":        RPCContextTuple{"/mtproto.RPCMedia/media_uploadThemeFile", func() interface{} { return new(mtproto.Document) }},
-	"TLMediaUploadStickerFile":      RPCContextTuple{"/mtproto.RPCMedia/media_uploadStickerFile", func() interface{} { return new(mtproto.Document) }},
-	"TLMediaUploadRingtoneFile":     RPCContextTuple{"/mtproto.RPCMedia/media_uploadRingtoneFile", func() interface{} { return new(mtproto.Document) }},
+	"TLMediaUploadPhotoFile":            RPCContextTuple{"/mtproto.RPCMedia/media_uploadPhotoFile", func() interface{} { return new(mtproto.Photo) }},
+	"TLMediaUploadProfilePhotoFile":     RPCContextTuple{"/mtproto.RPCMedia/media_uploadProfilePhotoFile", func() interface{} { return new(mtproto.Photo) }},
+	"TLMediaGetPhoto":                   RPCContextTuple{"/mtproto.RPCMedia/media_getPhoto", func() interface{} { return new(mtproto.Photo) }},
+	"TLMediaGetPhotoSizeList":           RPCContextTuple{"/mtproto.RPCMedia/media_getPhotoSizeList", func() interface{} { return new(PhotoSizeList) }},
+	"TLMediaGetPhotoSizeListList":       RPCContextTuple{"/mtproto.RPCMedia/media_getPhotoSizeListList", func() interface{} { return new(Vector_PhotoSizeList) }},
+	"TLMediaGetVideoSizeList":           RPCContextTuple{"/mtproto.RPCMedia/media_getVideoSizeList", func() interface{} { return new(VideoSizeList) }},
+	"TLMediaUploadedDocumentMedia":      RPCContextTuple{"/mtproto.RPCMedia/media_uploadedDocumentMedia", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLMediaGetDocument":                RPCContextTuple{"/mtproto.RPCMedia/media_getDocument", func() interface{} { return new(mtproto.Document) }},
+	"TLMediaGetDocumentList":            RPCContextTuple{"/mtproto.RPCMedia/media_getDocumentList", func() interface{} { return new(Vector_Document) }},
+	"TLMediaUploadEncryptedFile":        RPCContextTuple{"/mtproto.RPCMedia/media_uploadEncryptedFile", func() interface{} { return new(mtproto.EncryptedFile) }},
+	"TLMediaGetEncryptedFile":           RPCContextTuple{"/mtproto.RPCMedia/media_getEncryptedFile", func() interface{} { return new(mtproto.EncryptedFile) }},
+	"TLMediaUploadWallPaperFile":        RPCContextTuple{"/mtproto.RPCMedia/media_uploadWallPaperFile", func() interface{} { return new(mtproto.Document) }},
+	"TLMediaUploadThemeFile":            RPCContextTuple{"/mtproto.RPCMedia/media_uploadThemeFile", func() interface{} { return new(mtproto.Document) }},
+	"TLMediaUploadStickerFile":          RPCContextTuple{"/mtproto.RPCMedia/media_uploadStickerFile", func() interface{} { return new(mtproto.Document) }},
+	"TLMediaUploadRingtoneFile":         RPCContextTuple{"/mtproto.RPCMedia/media_uploadRingtoneFile", func() interface{} { return new(mtproto.Document) }},
+	"TLMediaCreateStickerSet":           RPCContextTuple{"/mtproto.RPCMedia/media_createStickerSet", func() interface{} { return new(mtproto.Messages_StickerSet) }},
+	"TLMediaAddStickerToSet":            RPCContextTuple{"/mtproto.RPCMedia/media_addStickerToSet", func() interface{} { return new(mtproto.Messages_StickerSet) }},
+	"TLMediaRemoveStickerFromSet":       RPCContextTuple{"/mtproto.RPCMedia/media_removeStickerFromSet", func() interface{} { return new(mtproto.Messages_StickerSet) }},
+	"TLMediaChangeStickerPosition":      RPCContextTuple{"/mtproto.RPCMedia/media_changeStickerPosition", func() interface{} { return new(mtproto.Messages_StickerSet) }},
+	"TLMediaSetStickerSetThumb":         RPCContextTuple{"/mtproto.RPCMedia/media_setStickerSetThumb", func() interface{} { return new(mtproto.Messages_StickerSet) }},
+	"TLMediaCheckStickerSetShortName":   RPCContextTuple{"/mtproto.RPCMedia/media_checkStickerSetShortName", func() interface{} { return new(mtproto.Bool) }},
+	"TLMediaGetStickerSet":              RPCContextTuple{"/mtproto.RPCMedia/media_getStickerSet", func() interface{} { return new(mtproto.Messages_StickerSet) }},
+	"TLMediaInstallStickerSet":          RPCContextTuple{"/mtproto.RPCMedia/media_installStickerSet", func() interface{} { return new(mtproto.Messages_StickerSetInstallResult) }},
+	"TLMediaUninstallStickerSet":        RPCContextTuple{"/mtproto.RPCMedia/media_uninstallStickerSet", func() interface{} { return new(mtproto.Bool) }},
+	"TLMediaReorderStickerSets":         RPCContextTuple{"/mtproto.RPCMedia/media_reorderStickerSets", func() interface{} { return new(mtproto.Bool) }},
+	"TLMediaGetAllStickers":             RPCContextTuple{"/mtproto.RPCMedia/media_getAllStickers", func() interface{} { return new(mtproto.Messages_AllStickers) }},
+	"TLMediaGetArchivedStickers":        RPCContextTuple{"/mtproto.RPCMedia/media_getArchivedStickers", func() interface{} { return new(mtproto.Messages_ArchivedStickers) }},
+	"TLMediaSearchStickerSets":          RPCContextTuple{"/mtproto.RPCMedia/media_searchStickerSets", func() interface{} { return new(mtproto.Messages_FoundStickerSets) }},
+	"TLMediaGetStickers":                RPCContextTuple{"/mtproto.RPCMedia/media_getStickers", func() interface{} { return new(mtproto.Messages_Stickers) }},
+	"TLMediaGetRecentStickers":          RPCContextTuple{"/mtproto.RPCMedia/media_getRecentStickers", func() interface{} { return new(mtproto.Messages_RecentStickers) }},
+	"TLMediaSaveRecentSticker":          RPCContextTuple{"/mtproto.RPCMedia/media_saveRecentSticker", func() interface{} { return new(mtproto.Bool) }},
+	"TLMediaClearRecentStickers":        RPCContextTuple{"/mtproto.RPCMedia/media_clearRecentStickers", func() interface{} { return new(mtproto.Bool) }},
+	"TLMediaGetFavedStickers":           RPCContextTuple{"/mtproto.RPCMedia/media_getFavedStickers", func() interface{} { return new(mtproto.Messages_FavedStickers) }},
+	"TLMediaFaveSticker":                RPCContextTuple{"/mtproto.RPCMedia/media_faveSticker", func() interface{} { return new(mtproto.Bool) }},
+	"TLMediaGetStickerSetThumbDocument": RPCContextTuple{"/mtproto.RPCMedia/media_getStickerSetThumbDocument", func() interface{} { return new(mtproto.Document) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
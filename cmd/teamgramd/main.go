@@ -0,0 +1,197 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// teamgramd is the operator tool of teamgram-server.
+//
+//	teamgramd deadletter list -brokers 127.0.0.1:9092 -topic Inbox-DLQ-T
+//	teamgramd deadletter replay -brokers 127.0.0.1:9092 -topic Inbox-DLQ-T -offset 42
+//	teamgramd deadletter list -bus local -dir ../data/mq -topic Sync-DLQ-T
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/pkg/mqx"
+)
+
+const usage = `usage: teamgramd <command> [arguments]
+
+commands:
+  deadletter list     list the dead letters of a dead-letter topic
+  deadletter replay   send dead letters back to the topic they came from
+`
+
+func main() {
+	if len(os.Args) < 3 || os.Args[1] != "deadletter" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[2] {
+	case "list":
+		err = deadLetterList(os.Args[3:])
+	case "replay":
+		err = deadLetterReplay(os.Args[3:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "teamgramd: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type deadLetterFlags struct {
+	bus       mqx.BusConf
+	brokers   string
+	topic     string
+	limit     int
+	partition int
+	offset    int64
+	timeout   time.Duration
+}
+
+func parseDeadLetterFlags(name string, args []string) (*deadLetterFlags, error) {
+	var (
+		f  = new(deadLetterFlags)
+		fs = flag.NewFlagSet("deadletter "+name, flag.ContinueOnError)
+	)
+
+	fs.StringVar(&f.bus.Kind, "bus", mqx.BusKafka, "message bus: kafka, local or redis")
+	fs.StringVar(&f.bus.Dir, "dir", "", "topics directory of the local bus")
+	fs.StringVar(&f.bus.Redis.Host, "redis", "", "redis host of the redis bus")
+	fs.StringVar(&f.brokers, "brokers", "127.0.0.1:9092", "comma separated kafka brokers")
+	fs.StringVar(&f.topic, "topic", "", "dead-letter topic, e.g. Inbox-DLQ-T")
+	fs.IntVar(&f.limit, "limit", 0, "read at most limit records, 0 reads all")
+	fs.IntVar(&f.partition, "partition", -1, "only dead letters of this partition, -1 for all")
+	fs.Int64Var(&f.offset, "offset", -1, "only the dead letter at this offset, -1 for all")
+	fs.DurationVar(&f.timeout, "timeout", time.Minute, "give up after timeout")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if f.topic == "" {
+		return nil, fmt.Errorf("deadletter %s: -topic is required", name)
+	}
+
+	return f, nil
+}
+
+func (f *deadLetterFlags) match(dl *mqx.DeadLetterRecord) bool {
+	if f.partition >= 0 && dl.Partition != int32(f.partition) {
+		return false
+	}
+	if f.offset >= 0 && dl.Offset != f.offset {
+		return false
+	}
+	return true
+}
+
+func printDeadLetter(dl *mqx.DeadLetterRecord) {
+	fmt.Printf("%d/%d\t%s\t%s\t%s\tretries=%d\t%s\terror=%q\n",
+		dl.Partition,
+		dl.Offset,
+		time.Unix(dl.Date, 0).Format(time.RFC3339),
+		dl.Topic,
+		dl.Group,
+		dl.Retries,
+		dl.Key,
+		dl.Error)
+}
+
+func (f *deadLetterFlags) newFetcher() (mqx.Fetcher, func(), error) {
+	fetcher, err := mqx.NewFetcher(f.bus, strings.Split(f.brokers, ","))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fetcher, func() {
+		if c, ok := fetcher.(io.Closer); ok {
+			c.Close()
+		}
+	}, nil
+}
+
+func deadLetterList(args []string) error {
+	f, err := parseDeadLetterFlags("list", args)
+	if err != nil {
+		return err
+	}
+
+	fetcher, closeFetcher, err := f.newFetcher()
+	if err != nil {
+		return err
+	}
+	defer closeFetcher()
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	defer cancel()
+
+	dlList, err := mqx.ListDeadLetters(ctx, fetcher, f.topic, f.limit)
+	if err != nil {
+		return err
+	}
+
+	for _, dl := range dlList {
+		if f.match(dl) {
+			printDeadLetter(dl)
+		}
+	}
+
+	return nil
+}
+
+func deadLetterReplay(args []string) error {
+	f, err := parseDeadLetterFlags("replay", args)
+	if err != nil {
+		return err
+	}
+
+	fetcher, closeFetcher, err := f.newFetcher()
+	if err != nil {
+		return err
+	}
+	defer closeFetcher()
+	mqx.MustSetUp(f.bus)
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	defer cancel()
+
+	replayed, err := mqx.ReplayDeadLetters(ctx, fetcher, f.topic, f.limit, f.match, func(topic string) (mqx.Producer, error) {
+		return mqx.GetCachedProducer(&kafka.KafkaProducerConf{
+			Brokers: strings.Split(f.brokers, ","),
+			Topic:   topic,
+		}), nil
+	})
+	for _, dl := range replayed {
+		printDeadLetter(dl)
+	}
+	fmt.Printf("replayed %d dead letters\n", len(replayed))
+
+	return err
+}
@@ -27,6 +27,15 @@ type SmsVerifyCodeConfig struct {
 	RegionId      string
 }
 
+type EmailCodeConfig struct {
+	Name     string
+	Host     string `json:",optional"`
+	Port     int    `json:",optional"`
+	Username string `json:",optional"`
+	Password string `json:",optional"`
+	From     string `json:",optional"`
+}
+
 type WebrtcConfig struct {
 	Turn     bool
 	Stun     bool
@@ -0,0 +1,43 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package none
+
+import (
+	"context"
+
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func NewEmailCode(c *conf.EmailCodeConfig) *noneEmailCode {
+	return &noneEmailCode{
+		conf: c,
+	}
+}
+
+// noneEmailCode doesn't deliver anything, the code only shows up in the log.
+type noneEmailCode struct {
+	conf *conf.EmailCodeConfig
+}
+
+func (m *noneEmailCode) SendEmailCode(ctx context.Context, email, code string) error {
+	logx.WithContext(ctx).Infof("sendEmailCode - email: %s, code: %s", email, code)
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package smtp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+)
+
+func New(c *conf.EmailCodeConfig) *smtpEmailCode {
+	return &smtpEmailCode{
+		conf: c,
+	}
+}
+
+type smtpEmailCode struct {
+	conf *conf.EmailCodeConfig
+}
+
+func (m *smtpEmailCode) SendEmailCode(ctx context.Context, email, code string) error {
+	var (
+		addr = net.JoinHostPort(m.conf.Host, strconv.Itoa(m.conf.Port))
+		auth smtp.Auth
+	)
+
+	if m.conf.Username != "" {
+		auth = smtp.PlainAuth("", m.conf.Username, m.conf.Password, m.conf.Host)
+	}
+
+	msg := strings.Join([]string{
+		"From: " + m.conf.From,
+		"To: " + email,
+		"Subject: Teamgram code",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		fmt.Sprintf("Your code is %s", code),
+	}, "\r\n")
+
+	return smtp.SendMail(addr, auth, m.conf.From, []string{email}, []byte(msg))
+}
@@ -0,0 +1,172 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/syncx"
+)
+
+// The message buses a process can run on.
+const (
+	// BusKafka is the default, topics and groups are kafka's
+	BusKafka = "kafka"
+	// BusLocal keeps every topic in an append-only file under Dir,
+	// for a single node running all the services
+	BusLocal = "local"
+	// BusRedis keeps every topic in a redis stream of the same name
+	BusRedis = "redis"
+)
+
+// BusConf selects the message bus of a process. Producer and consumer
+// configs keep their kafka shape on every bus, Brokers is only used by kafka.
+type BusConf struct {
+	Kind string `json:",default=kafka,options=kafka|local|redis"`
+	// Dir is where BusLocal keeps its topics
+	Dir string `json:",optional"`
+	// Retention is how long (hours) BusLocal keeps a full segment of a topic
+	Retention int64           `json:",default=168"`
+	Redis     redis.RedisConf `json:",optional"`
+	// MaxLen trims the streams of BusRedis, 0 keeps every message
+	MaxLen int64 `json:",optional"`
+}
+
+// Consumer hands the messages of its topics to the handlers registered
+// for them, *kafka.ConsumerGroup implements it.
+type Consumer interface {
+	RegisterHandlers(topic string, cb kafka.MessageHandlerF)
+	// Start consumes until Stop is called
+	Start()
+	Stop()
+}
+
+type driver interface {
+	NewProducer(c *kafka.KafkaProducerConf) (io.Closer, error)
+	NewConsumer(c *kafka.KafkaConsumerConf) (Consumer, error)
+	NewFetcher(brokers []string) (Fetcher, error)
+}
+
+var (
+	busMu         sync.RWMutex
+	busDriver     driver = kafkaDriver{}
+	producerCache        = syncx.NewResourceManager()
+)
+
+// MustSetUp selects the bus of the process, it has to be called
+// before the first producer or consumer is created.
+func MustSetUp(c BusConf) {
+	d, err := newDriver(c)
+	if err != nil {
+		panic(err)
+	}
+
+	busMu.Lock()
+	busDriver = d
+	busMu.Unlock()
+}
+
+func newDriver(c BusConf) (driver, error) {
+	switch c.Kind {
+	case "", BusKafka:
+		return kafkaDriver{}, nil
+	case BusLocal:
+		if c.Dir == "" {
+			return nil, fmt.Errorf("bus %s: Dir is required", c.Kind)
+		}
+		return newLocalDriver(c.Dir, time.Duration(c.Retention)*time.Hour), nil
+	case BusRedis:
+		if c.Redis.Host == "" {
+			return nil, fmt.Errorf("bus %s: Redis.Host is required", c.Kind)
+		}
+		return newRedisDriver(c.Redis, c.MaxLen), nil
+	default:
+		return nil, fmt.Errorf("invalid bus: %s", c.Kind)
+	}
+}
+
+func getDriver() driver {
+	busMu.RLock()
+	defer busMu.RUnlock()
+
+	return busDriver
+}
+
+// MustProducer returns a producer of c.Topic on the bus of the process.
+func MustProducer(c *kafka.KafkaProducerConf) Producer {
+	p, err := getDriver().NewProducer(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return p.(Producer)
+}
+
+// GetCachedProducer is MustProducer with one producer per topic shared
+// by the whole process.
+func GetCachedProducer(c *kafka.KafkaProducerConf) Producer {
+	val, err := producerCache.GetResource(c.Topic, func() (io.Closer, error) {
+		return getDriver().NewProducer(c)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return val.(Producer)
+}
+
+// MustConsumer returns a consumer of c.Topics for c.Group on the bus of the process.
+func MustConsumer(c *kafka.KafkaConsumerConf) Consumer {
+	s, err := getDriver().NewConsumer(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// NewFetcher returns a Fetcher of c, brokers is only used by kafka.
+func NewFetcher(c BusConf, brokers []string) (Fetcher, error) {
+	d, err := newDriver(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.NewFetcher(brokers)
+}
+
+type kafkaDriver struct{}
+
+func (kafkaDriver) NewProducer(c *kafka.KafkaProducerConf) (io.Closer, error) {
+	return kafka.MustKafkaProducer(c), nil
+}
+
+func (kafkaDriver) NewConsumer(c *kafka.KafkaConsumerConf) (Consumer, error) {
+	return kafka.MustKafkaConsumer(c), nil
+}
+
+func (kafkaDriver) NewFetcher(brokers []string) (Fetcher, error) {
+	return NewKafkaFetcher(brokers)
+}
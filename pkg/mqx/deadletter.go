@@ -0,0 +1,112 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// Record is a message read back from a topic.
+type Record struct {
+	Partition int32
+	Offset    int64
+	Key       string
+	Value     []byte
+}
+
+// Fetcher reads back what is in a topic.
+type Fetcher interface {
+	// Fetch returns up to limit records of topic, oldest first per partition,
+	// limit <= 0 returns all of them.
+	Fetch(ctx context.Context, topic string, limit int) ([]Record, error)
+}
+
+// DeadLetterRecord is a dead letter and where it is in its dead-letter topic.
+type DeadLetterRecord struct {
+	Partition int32
+	Offset    int64
+	DeadLetter
+}
+
+// ListDeadLetters returns up to limit dead letters of topic,
+// records that are not a DeadLetter are skipped.
+func ListDeadLetters(ctx context.Context, f Fetcher, topic string, limit int) ([]*DeadLetterRecord, error) {
+	records, err := f.Fetch(ctx, topic, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	rList := make([]*DeadLetterRecord, 0, len(records))
+	for _, r := range records {
+		dl := &DeadLetterRecord{
+			Partition: r.Partition,
+			Offset:    r.Offset,
+		}
+		if err = jsonx.Unmarshal(r.Value, &dl.DeadLetter); err != nil || dl.Topic == "" {
+			continue
+		}
+		rList = append(rList, dl)
+	}
+
+	return rList, nil
+}
+
+// ReplayDeadLetters sends the dead letters of topic that match back to the topic
+// they came from, with their original key and value. newProducer returns the
+// producer of a topic. A dead letter stays in topic once replayed.
+func ReplayDeadLetters(
+	ctx context.Context,
+	f Fetcher,
+	topic string,
+	limit int,
+	match func(dl *DeadLetterRecord) bool,
+	newProducer func(topic string) (Producer, error)) ([]*DeadLetterRecord, error) {
+	dlList, err := ListDeadLetters(ctx, f, topic, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		producers = make(map[string]Producer)
+		replayed  = make([]*DeadLetterRecord, 0, len(dlList))
+	)
+	for _, dl := range dlList {
+		if match != nil && !match(dl) {
+			continue
+		}
+
+		p, ok := producers[dl.Topic]
+		if !ok {
+			if p, err = newProducer(dl.Topic); err != nil {
+				return replayed, err
+			}
+			producers[dl.Topic] = p
+		}
+
+		if _, _, err = p.SendMessage(ctx, dl.Key, dl.Value); err != nil {
+			return replayed, fmt.Errorf("replay %d/%d to %s: %w", dl.Partition, dl.Offset, dl.Topic, err)
+		}
+		replayed = append(replayed, dl)
+	}
+
+	return replayed, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Shopify/sarama"
+)
+
+// KafkaFetcher reads back what is in a kafka topic, from the oldest
+// offset of every partition up to the newest one when Fetch is called.
+type KafkaFetcher struct {
+	client sarama.Client
+}
+
+func NewKafkaFetcher(brokers []string) (*KafkaFetcher, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_0_0_0
+	config.Consumer.Return.Errors = false
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KafkaFetcher{client: client}, nil
+}
+
+func (f *KafkaFetcher) Fetch(ctx context.Context, topic string, limit int) ([]Record, error) {
+	partitions, err := f.client.Partitions(topic)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+
+	consumer, err := sarama.NewConsumerFromClient(f.client)
+	if err != nil {
+		return nil, err
+	}
+	defer consumer.Close()
+
+	var rList []Record
+	for _, partition := range partitions {
+		if limit > 0 && len(rList) >= limit {
+			break
+		}
+
+		oldest, err := f.client.GetOffset(topic, partition, sarama.OffsetOldest)
+		if err != nil {
+			return nil, err
+		}
+		newest, err := f.client.GetOffset(topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return nil, err
+		}
+		if oldest >= newest {
+			continue
+		}
+
+		rList, err = f.fetchPartition(ctx, consumer, topic, partition, oldest, newest, limit, rList)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rList, nil
+}
+
+func (f *KafkaFetcher) fetchPartition(
+	ctx context.Context,
+	consumer sarama.Consumer,
+	topic string,
+	partition int32,
+	oldest, newest int64,
+	limit int,
+	rList []Record) ([]Record, error) {
+	pc, err := consumer.ConsumePartition(topic, partition, oldest)
+	if err != nil {
+		return nil, err
+	}
+	defer pc.Close()
+
+	for {
+		select {
+		case msg, ok := <-pc.Messages():
+			if !ok {
+				return rList, nil
+			}
+			rList = append(rList, Record{
+				Partition: msg.Partition,
+				Offset:    msg.Offset,
+				Key:       string(msg.Key),
+				Value:     msg.Value,
+			})
+			if msg.Offset >= newest-1 || (limit > 0 && len(rList) >= limit) {
+				return rList, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (f *KafkaFetcher) Close() error {
+	return f.client.Close()
+}
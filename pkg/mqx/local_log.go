@@ -0,0 +1,570 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/rescue"
+)
+
+// A topic of the local log is a directory of segments, every segment is named
+// after the offset of its first frame and a frame is
+//
+//	crc32(4) key length(4) value length(4) key value
+//
+// Offsets are byte positions in the topic, a group keeps the offset
+// it has to read next in <group>.offset.
+
+const (
+	localSegmentSize  = 64 << 20
+	localMaxFrameSize = 16 << 20
+	localFrameHeader  = 12
+	localSegmentExt   = ".log"
+	localPollInterval = 100 * time.Millisecond
+)
+
+var (
+	errLocalTornFrame = errors.New("torn frame")
+)
+
+type localDriver struct {
+	dir       string
+	retention time.Duration
+}
+
+func newLocalDriver(dir string, retention time.Duration) *localDriver {
+	return &localDriver{
+		dir:       dir,
+		retention: retention,
+	}
+}
+
+func (d *localDriver) NewProducer(c *kafka.KafkaProducerConf) (io.Closer, error) {
+	return newLocalProducer(filepath.Join(d.dir, c.Topic), d.retention)
+}
+
+func (d *localDriver) NewConsumer(c *kafka.KafkaConsumerConf) (Consumer, error) {
+	return newLocalConsumer(d.dir, c), nil
+}
+
+func (d *localDriver) NewFetcher(brokers []string) (Fetcher, error) {
+	return &localFetcher{dir: d.dir}, nil
+}
+
+func localSegmentPath(dir string, base int64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", base, localSegmentExt))
+}
+
+// localSegments returns the bases of the segments in dir, oldest first.
+func localSegments(dir string) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var bases []int64
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), localSegmentExt) {
+			continue
+		}
+		base, err := strconv.ParseInt(strings.TrimSuffix(e.Name(), localSegmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		bases = append(bases, base)
+	}
+	sort.Slice(bases, func(i, j int) bool { return bases[i] < bases[j] })
+
+	return bases, nil
+}
+
+func encodeLocalFrame(key string, value []byte) []byte {
+	b := make([]byte, localFrameHeader+len(key)+len(value))
+	binary.BigEndian.PutUint32(b[4:], uint32(len(key)))
+	binary.BigEndian.PutUint32(b[8:], uint32(len(value)))
+	copy(b[localFrameHeader:], key)
+	copy(b[localFrameHeader+len(key):], value)
+	binary.BigEndian.PutUint32(b, crc32.ChecksumIEEE(b[4:]))
+
+	return b
+}
+
+// readLocalFrame reads the frame at pos of f, io.EOF if there is none,
+// errLocalTornFrame if it is not completely written (yet).
+func readLocalFrame(f io.ReaderAt, pos int64) (key string, value []byte, n int64, err error) {
+	var hdr [localFrameHeader]byte
+
+	m, err := f.ReadAt(hdr[:], pos)
+	if m == 0 && err == io.EOF {
+		return "", nil, 0, io.EOF
+	} else if m < localFrameHeader {
+		if err == io.EOF {
+			err = errLocalTornFrame
+		}
+		return "", nil, 0, err
+	}
+
+	kl := int64(binary.BigEndian.Uint32(hdr[4:]))
+	vl := int64(binary.BigEndian.Uint32(hdr[8:]))
+	if kl+vl > localMaxFrameSize {
+		return "", nil, 0, errLocalTornFrame
+	}
+
+	b := make([]byte, localFrameHeader+kl+vl)
+	copy(b, hdr[:])
+	if m, err = f.ReadAt(b[localFrameHeader:], pos+localFrameHeader); int64(m) < kl+vl {
+		if err == io.EOF {
+			err = errLocalTornFrame
+		}
+		return "", nil, 0, err
+	}
+	if crc32.ChecksumIEEE(b[4:]) != binary.BigEndian.Uint32(hdr[:]) {
+		return "", nil, 0, errLocalTornFrame
+	}
+
+	return string(b[localFrameHeader : localFrameHeader+kl]), b[localFrameHeader+kl:], int64(len(b)), nil
+}
+
+// localProducer appends to a topic, producers of the topic in every
+// process on the node take turns through the flock of <topic>/lock.
+type localProducer struct {
+	mu        sync.Mutex
+	dir       string
+	retention time.Duration
+	lock      *os.File
+	f         *os.File
+	base      int64
+}
+
+func newLocalProducer(dir string, retention time.Duration) (*localProducer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	lock, err := os.OpenFile(filepath.Join(dir, "lock"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &localProducer{
+		dir:       dir,
+		retention: retention,
+		lock:      lock,
+	}
+	if err = p.withLock(p.recover); err != nil {
+		p.Close()
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *localProducer) withLock(f func() error) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := syscall.Flock(int(p.lock.Fd()), syscall.LOCK_EX); err != nil {
+		return err
+	}
+	defer syscall.Flock(int(p.lock.Fd()), syscall.LOCK_UN)
+
+	return f()
+}
+
+// recover opens the last segment and cuts off a frame a crash left torn,
+// a frame is written with a single write so only a crash of the node tears it.
+func (p *localProducer) recover() error {
+	bases, err := localSegments(p.dir)
+	if err != nil {
+		return err
+	}
+
+	var base int64
+	if len(bases) > 0 {
+		base = bases[len(bases)-1]
+	}
+	if err = p.open(base); err != nil {
+		return err
+	}
+
+	var pos int64
+	for {
+		_, _, n, err := readLocalFrame(p.f, pos)
+		if err == io.EOF {
+			return nil
+		} else if err == errLocalTornFrame {
+			logx.Errorf("localLog(%s) - truncate torn frame at %d", p.dir, base+pos)
+			return p.f.Truncate(pos)
+		} else if err != nil {
+			return err
+		}
+		pos += n
+	}
+}
+
+func (p *localProducer) open(base int64) error {
+	f, err := os.OpenFile(localSegmentPath(p.dir, base), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	if p.f != nil {
+		p.f.Close()
+	}
+	p.f = f
+	p.base = base
+
+	return nil
+}
+
+// active returns the offset the next frame is written at, it follows
+// a segment rolled by another producer and rolls a full one.
+func (p *localProducer) active() (int64, error) {
+	for {
+		fi, err := p.f.Stat()
+		if err != nil {
+			return 0, err
+		}
+		next := p.base + fi.Size()
+
+		if next == p.base {
+			return next, nil
+		}
+
+		if _, err = os.Stat(localSegmentPath(p.dir, next)); err == nil {
+			if err = p.open(next); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if fi.Size() >= localSegmentSize {
+			if err = p.open(next); err != nil {
+				return 0, err
+			}
+			p.removeExpired()
+		}
+
+		return next, nil
+	}
+}
+
+func (p *localProducer) removeExpired() {
+	bases, err := localSegments(p.dir)
+	if err != nil || p.retention <= 0 {
+		return
+	}
+
+	for _, base := range bases[:len(bases)-1] {
+		fi, err := os.Stat(localSegmentPath(p.dir, base))
+		if err != nil || time.Since(fi.ModTime()) < p.retention {
+			return
+		}
+		logx.Infof("localLog(%s) - remove expired segment %d", p.dir, base)
+		os.Remove(localSegmentPath(p.dir, base))
+	}
+}
+
+func (p *localProducer) SendMessage(ctx context.Context, key string, value []byte) (partition int32, offset int64, err error) {
+	if len(key) == 0 || len(value) == 0 {
+		return -1, -1, errors.New("key or value == 0")
+	}
+	frame := encodeLocalFrame(key, value)
+
+	err = p.withLock(func() error {
+		if offset, err = p.active(); err != nil {
+			return err
+		}
+		if _, err = p.f.Write(frame); err != nil {
+			return err
+		}
+		return p.f.Sync()
+	})
+	if err != nil {
+		return -1, -1, err
+	}
+
+	return 0, offset, nil
+}
+
+func (p *localProducer) Close() error {
+	if p.f != nil {
+		p.f.Close()
+	}
+	return p.lock.Close()
+}
+
+// localReader reads the frames of a topic from an offset on.
+type localReader struct {
+	dir  string
+	f    *os.File
+	base int64
+	pos  int64
+}
+
+func (r *localReader) Close() {
+	if r.f != nil {
+		r.f.Close()
+		r.f = nil
+	}
+}
+
+// seek moves r to offset, an offset of a removed segment moves
+// to the oldest segment left.
+func (r *localReader) seek(offset int64) error {
+	bases, err := localSegments(r.dir)
+	if err != nil || len(bases) == 0 {
+		return err
+	}
+
+	i := sort.Search(len(bases), func(i int) bool { return bases[i] > offset }) - 1
+	if i < 0 {
+		logx.Errorf("localLog(%s) - offset %d removed, move to %d", r.dir, offset, bases[0])
+		i, offset = 0, bases[0]
+	}
+
+	f, err := os.Open(localSegmentPath(r.dir, bases[i]))
+	if err != nil {
+		return err
+	}
+
+	r.Close()
+	r.f = f
+	r.base = bases[i]
+	r.pos = offset - bases[i]
+
+	return nil
+}
+
+// Offset is the offset of the next frame.
+func (r *localReader) Offset() int64 {
+	return r.base + r.pos
+}
+
+// Next returns the next frame and its offset, io.EOF if there is none yet.
+func (r *localReader) Next() (offset int64, key string, value []byte, err error) {
+	if r.f == nil {
+		if err = r.seek(r.Offset()); err != nil {
+			return
+		} else if r.f == nil {
+			return 0, "", nil, io.EOF
+		}
+	}
+
+	for {
+		var n int64
+		offset = r.Offset()
+		key, value, n, err = readLocalFrame(r.f, r.pos)
+		if err == nil {
+			r.pos += n
+			return
+		} else if err != io.EOF && err != errLocalTornFrame {
+			return
+		}
+
+		// a frame is never written across segments
+		if offset == r.base {
+			return 0, "", nil, io.EOF
+		}
+		if _, err = os.Stat(localSegmentPath(r.dir, offset)); err != nil {
+			return 0, "", nil, io.EOF
+		}
+		if err = r.seek(offset); err != nil {
+			return
+		}
+	}
+}
+
+type localConsumer struct {
+	dir    string
+	c      *kafka.KafkaConsumerConf
+	cb     map[string]kafka.MessageHandlerF
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newLocalConsumer(dir string, c *kafka.KafkaConsumerConf) *localConsumer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &localConsumer{
+		dir:    dir,
+		c:      c,
+		cb:     map[string]kafka.MessageHandlerF{},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (c *localConsumer) RegisterHandlers(topic string, cb kafka.MessageHandlerF) {
+	c.cb[topic] = cb
+}
+
+func (c *localConsumer) Start() {
+	for _, topic := range c.c.Topics {
+		c.wg.Add(1)
+		go func(topic string) {
+			defer c.wg.Done()
+			if err := c.consume(topic); err != nil {
+				logx.Errorf("localLog(%s) - group %s stopped, error: %v", topic, c.c.Group, err)
+			}
+		}(topic)
+	}
+	c.wg.Wait()
+}
+
+func (c *localConsumer) Stop() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+// consume hands the frames of topic to its handler, one consumer of a group
+// at a time reads a topic, the others wait for its flock.
+func (c *localConsumer) consume(topic string) error {
+	dir := filepath.Join(c.dir, topic)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	lock, err := os.OpenFile(filepath.Join(dir, c.c.Group+".lock"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer lock.Close()
+
+	for {
+		if err = syscall.Flock(int(lock.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err == nil {
+			break
+		} else if err != syscall.EWOULDBLOCK {
+			return err
+		}
+		select {
+		case <-time.After(time.Second):
+		case <-c.ctx.Done():
+			return nil
+		}
+	}
+	defer syscall.Flock(int(lock.Fd()), syscall.LOCK_UN)
+
+	offsetPath := filepath.Join(dir, c.c.Group+".offset")
+	offset, err := readLocalOffset(offsetPath)
+	if err != nil {
+		return err
+	}
+
+	r := &localReader{dir: dir, pos: offset}
+	defer r.Close()
+
+	for {
+		_, key, value, err := r.Next()
+		if err == io.EOF {
+			select {
+			case <-time.After(localPollInterval):
+				continue
+			case <-c.ctx.Done():
+				return nil
+			}
+		} else if err != nil {
+			return err
+		}
+
+		c.handle(topic, key, value)
+		if err = writeLocalOffset(offsetPath, r.Offset()); err != nil {
+			return err
+		}
+
+		if c.ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (c *localConsumer) handle(topic, key string, value []byte) {
+	defer rescue.Recover()
+
+	if cb, ok := c.cb[topic]; ok {
+		cb(context.Background(), key, value)
+	}
+}
+
+func readLocalOffset(path string) (int64, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+}
+
+func writeLocalOffset(path string, offset int64) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(offset, 10)), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+type localFetcher struct {
+	dir string
+}
+
+func (f *localFetcher) Fetch(ctx context.Context, topic string, limit int) ([]Record, error) {
+	r := &localReader{dir: filepath.Join(f.dir, topic)}
+	defer r.Close()
+
+	var rList []Record
+	for limit <= 0 || len(rList) < limit {
+		offset, key, value, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		rList = append(rList, Record{
+			Offset: offset,
+			Key:    key,
+			Value:  value,
+		})
+	}
+
+	return rList, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+)
+
+func startLocalConsumer(d *localDriver, handled chan<- string) Consumer {
+	s, _ := d.NewConsumer(&kafka.KafkaConsumerConf{
+		Topics: []string{"Sync-T"},
+		Group:  "Sync-S",
+	})
+	s.RegisterHandlers("Sync-T", func(ctx context.Context, key string, value []byte) {
+		handled <- key + "=" + string(value)
+	})
+	go s.Start()
+
+	return s
+}
+
+func waitHandled(t *testing.T, handled <-chan string, want ...string) {
+	for _, w := range want {
+		select {
+		case v := <-handled:
+			if v != w {
+				t.Fatalf("handled %s, want %s", v, w)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s not handled", w)
+		}
+	}
+}
+
+func TestLocalLog(t *testing.T) {
+	var (
+		d       = newLocalDriver(t.TempDir(), time.Hour)
+		handled = make(chan string, 16)
+	)
+
+	p, err := d.NewProducer(&kafka.KafkaProducerConf{Topic: "Sync-T"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	for i := 0; i < 3; i++ {
+		if _, _, err = p.(Producer).SendMessage(context.Background(), fmt.Sprintf("k%d", i), []byte("v")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := startLocalConsumer(d, handled)
+	waitHandled(t, handled, "k0=v", "k1=v", "k2=v")
+	s.Stop()
+
+	// a restarted group goes on where it stopped
+	p.(Producer).SendMessage(context.Background(), "k3", []byte("v"))
+	s = startLocalConsumer(d, handled)
+	waitHandled(t, handled, "k3=v")
+	s.Stop()
+
+	f, _ := d.NewFetcher(nil)
+	rList, err := f.Fetch(context.Background(), "Sync-T", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rList) != 4 || rList[3].Key != "k3" || rList[1].Offset != rList[2].Offset-rList[1].Offset {
+		t.Fatalf("records: %+v", rList)
+	}
+}
+
+func TestLocalLogTornFrame(t *testing.T) {
+	d := newLocalDriver(t.TempDir(), time.Hour)
+
+	p, _ := d.NewProducer(&kafka.KafkaProducerConf{Topic: "Inbox-T"})
+	p.(Producer).SendMessage(context.Background(), "k0", []byte("v"))
+	p.Close()
+
+	path := localSegmentPath(filepath.Join(d.dir, "Inbox-T"), 0)
+	frame := encodeLocalFrame("k1", []byte("v"))
+	f, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	f.Write(frame[:len(frame)-1])
+	f.Close()
+
+	p, err := d.NewProducer(&kafka.KafkaProducerConf{Topic: "Inbox-T"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	p.(Producer).SendMessage(context.Background(), "k2", []byte("v"))
+
+	fetcher, _ := d.NewFetcher(nil)
+	rList, _ := fetcher.Fetch(context.Background(), "Inbox-T", 0)
+	if len(rList) != 2 || rList[0].Key != "k0" || rList[1].Key != "k2" {
+		t.Fatalf("records: %+v", rList)
+	}
+}
+
+func TestLocalLogProducers(t *testing.T) {
+	var (
+		d  = newLocalDriver(t.TempDir(), time.Hour)
+		wg sync.WaitGroup
+	)
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			p, _ := d.NewProducer(&kafka.KafkaProducerConf{Topic: "Sync-T"})
+			defer p.Close()
+			for j := 0; j < 50; j++ {
+				p.(Producer).SendMessage(context.Background(), fmt.Sprintf("%d#%d", i, j), []byte("v"))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	f, _ := d.NewFetcher(nil)
+	rList, _ := f.Fetch(context.Background(), "Sync-T", 0)
+	if len(rList) != 200 {
+		t.Fatalf("records: %d", len(rList))
+	}
+}
+
+func TestBusLocal(t *testing.T) {
+	MustSetUp(BusConf{Kind: BusLocal, Dir: t.TempDir(), Retention: 1})
+	defer MustSetUp(BusConf{Kind: BusKafka})
+
+	handled := make(chan string, 1)
+	s := MustConsumer(&kafka.KafkaConsumerConf{Topics: []string{"Inbox-T"}, Group: "Inbox-S"})
+	s.RegisterHandlers("Inbox-T", func(ctx context.Context, key string, value []byte) {
+		handled <- key + "=" + string(value)
+	})
+	go s.Start()
+	defer s.Stop()
+
+	GetCachedProducer(&kafka.KafkaProducerConf{Topic: "Inbox-T"}).SendMessage(context.Background(), "k", []byte("v"))
+	waitHandled(t, handled, "k=v")
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+)
+
+// MemoryBroker is an in-process stand-in for kafka, every topic has one partition
+// and a message is handed to the handler of its topic as it is sent.
+type MemoryBroker struct {
+	mu     sync.Mutex
+	topics map[string][]Record
+	cb     map[string]kafka.MessageHandlerF
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		topics: make(map[string][]Record),
+		cb:     make(map[string]kafka.MessageHandlerF),
+	}
+}
+
+// RegisterHandlers hands the messages of topic sent from now on to cb.
+func (b *MemoryBroker) RegisterHandlers(topic string, cb kafka.MessageHandlerF) {
+	b.mu.Lock()
+	b.cb[topic] = cb
+	b.mu.Unlock()
+}
+
+// Producer returns a producer of topic.
+func (b *MemoryBroker) Producer(topic string) Producer {
+	return &memoryProducer{b: b, topic: topic}
+}
+
+func (b *MemoryBroker) Fetch(ctx context.Context, topic string, limit int) ([]Record, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	records := b.topics[topic]
+	if limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+
+	return append([]Record(nil), records...), nil
+}
+
+func (b *MemoryBroker) send(ctx context.Context, topic, key string, value []byte) (int32, int64, error) {
+	if len(key) == 0 || len(value) == 0 {
+		return -1, -1, errors.New("key or value == 0")
+	}
+
+	b.mu.Lock()
+	offset := int64(len(b.topics[topic]))
+	b.topics[topic] = append(b.topics[topic], Record{
+		Partition: 0,
+		Offset:    offset,
+		Key:       key,
+		Value:     append([]byte(nil), value...),
+	})
+	cb := b.cb[topic]
+	b.mu.Unlock()
+
+	if cb != nil {
+		cb(ctx, key, value)
+	}
+
+	return 0, offset, nil
+}
+
+type memoryProducer struct {
+	b     *MemoryBroker
+	topic string
+}
+
+func (p *memoryProducer) SendMessage(ctx context.Context, key string, value []byte) (int32, int64, error) {
+	return p.b.send(ctx, p.topic, key, value)
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestWithRetry(t *testing.T) {
+	var (
+		b     = NewMemoryBroker()
+		calls = make(map[string]int)
+	)
+
+	b.RegisterHandlers("inbox", WithRetry(
+		RetryConf{MaxRetries: 2, Backoff: 1, MaxBackoff: 2},
+		"inbox",
+		"inbox-group",
+		b.Producer("inbox-dlq"),
+		func(ctx context.Context, key string, value []byte) error {
+			calls[key]++
+			switch key {
+			case "flaky":
+				if calls[key] < 2 {
+					return errors.New("flaky")
+				}
+			case "failed":
+				return errors.New("failed")
+			case "invalid":
+				return Permanent(errors.New("invalid"))
+			}
+			return nil
+		}))
+
+	p := b.Producer("inbox")
+	for _, key := range []string{"ok", "flaky", "failed", "invalid"} {
+		if _, _, err := p.SendMessage(context.Background(), key, []byte(key)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if calls["ok"] != 1 || calls["flaky"] != 2 || calls["failed"] != 3 || calls["invalid"] != 1 {
+		t.Fatalf("calls: %v", calls)
+	}
+
+	dlList, err := ListDeadLetters(context.Background(), b, "inbox-dlq", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dlList) != 2 {
+		t.Fatalf("dead letters: %d", len(dlList))
+	}
+	if dl := dlList[0]; dl.Key != "failed" || string(dl.Value) != "failed" || dl.Retries != 2 ||
+		dl.Topic != "inbox" || dl.Group != "inbox-group" || dl.Error != "failed" {
+		t.Fatalf("dead letter: %+v", dl)
+	}
+	if dl := dlList[1]; dl.Key != "invalid" || dl.Retries != 0 {
+		t.Fatalf("dead letter: %+v", dl)
+	}
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	var (
+		b       = NewMemoryBroker()
+		fail    = true
+		handled []string
+	)
+
+	b.RegisterHandlers("sync", WithRetry(
+		RetryConf{MaxRetries: 1, Backoff: 1},
+		"sync",
+		"sync-group",
+		b.Producer("sync-dlq"),
+		func(ctx context.Context, key string, value []byte) error {
+			if fail {
+				return errors.New("down")
+			}
+			handled = append(handled, key)
+			return nil
+		}))
+
+	p := b.Producer("sync")
+	for _, key := range []string{"a", "b", "c"} {
+		p.SendMessage(context.Background(), key, []byte(key))
+	}
+
+	fail = false
+	replayed, err := ReplayDeadLetters(
+		context.Background(),
+		b,
+		"sync-dlq",
+		0,
+		func(dl *DeadLetterRecord) bool {
+			return dl.Offset != 1
+		},
+		func(topic string) (Producer, error) {
+			return b.Producer(topic), nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(replayed) != 2 || len(handled) != 2 || handled[0] != "a" || handled[1] != "c" {
+		t.Fatalf("replayed: %d, handled: %v", len(replayed), handled)
+	}
+}
@@ -0,0 +1,371 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/rescue"
+	zredis "github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// A topic of BusRedis is a stream of the same name, every message an entry
+// of a key and a value field, and a group a consumer group of the stream.
+// A consumer is named after its host and pid, entries read but not acked
+// by a consumer that is gone, restarted or crashed, are taken over by the
+// group once they are idle for redisStreamClaimIdle.
+
+const (
+	redisStreamBlock = time.Second
+	redisStreamCount = 64
+	// redisStreamClaimIdle is how long an entry stays pending before
+	// another consumer of the group takes it over
+	redisStreamClaimIdle = time.Minute
+	// redisSeqBits is how much of an offset the sequence of a stream id takes
+	redisSeqBits = 20
+)
+
+type redisDriver struct {
+	once   sync.Once
+	c      zredis.RedisConf
+	maxLen int64
+	client redis.UniversalClient
+}
+
+func newRedisDriver(c zredis.RedisConf, maxLen int64) *redisDriver {
+	return &redisDriver{
+		c:      c,
+		maxLen: maxLen,
+	}
+}
+
+// getClient returns the client every producer and consumer of the process shares.
+func (d *redisDriver) getClient() redis.UniversalClient {
+	d.once.Do(func() {
+		opts := &redis.UniversalOptions{
+			Addrs:    strings.Split(d.c.Host, ","),
+			Password: d.c.Pass,
+		}
+		if d.c.Type == zredis.ClusterType {
+			d.client = redis.NewClusterClient(opts.Cluster())
+			return
+		}
+		if d.c.Tls {
+			opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		d.client = redis.NewClient(opts.Simple())
+	})
+
+	return d.client
+}
+
+func (d *redisDriver) NewProducer(c *kafka.KafkaProducerConf) (io.Closer, error) {
+	return &redisProducer{
+		client: d.getClient(),
+		stream: c.Topic,
+		maxLen: d.maxLen,
+	}, nil
+}
+
+func (d *redisDriver) NewConsumer(c *kafka.KafkaConsumerConf) (Consumer, error) {
+	name, err := redisConsumerName()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &redisConsumer{
+		client:    d.getClient(),
+		c:         c,
+		name:      name,
+		claimIdle: redisStreamClaimIdle,
+		cb:        map[string]kafka.MessageHandlerF{},
+		ctx:       ctx,
+		cancel:    cancel,
+	}, nil
+}
+
+// redisConsumerName tells apart the processes of a group running on one host.
+func redisConsumerName() (string, error) {
+	host, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+
+	return host + "-" + strconv.Itoa(os.Getpid()), nil
+}
+
+func (d *redisDriver) NewFetcher(brokers []string) (Fetcher, error) {
+	return &redisFetcher{client: d.getClient()}, nil
+}
+
+// redisStreamOffset packs the stream id ms-seq into an offset.
+func redisStreamOffset(id string) int64 {
+	i := strings.IndexByte(id, '-')
+	if i < 0 {
+		return -1
+	}
+	ms, err1 := strconv.ParseInt(id[:i], 10, 64)
+	seq, err2 := strconv.ParseInt(id[i+1:], 10, 64)
+	if err1 != nil || err2 != nil {
+		return -1
+	}
+
+	return ms<<redisSeqBits | seq&(1<<redisSeqBits-1)
+}
+
+// redisStreamNextId returns the stream id right after id.
+func redisStreamNextId(id string) string {
+	i := strings.IndexByte(id, '-')
+	if i < 0 {
+		return id
+	}
+	seq, err := strconv.ParseUint(id[i+1:], 10, 64)
+	if err != nil {
+		return id
+	}
+
+	return id[:i+1] + strconv.FormatUint(seq+1, 10)
+}
+
+func redisStreamValues(msg redis.XMessage) (key string, value []byte) {
+	if v, ok := msg.Values["key"].(string); ok {
+		key = v
+	}
+	if v, ok := msg.Values["value"].(string); ok {
+		value = []byte(v)
+	}
+	return
+}
+
+type redisProducer struct {
+	client redis.UniversalClient
+	stream string
+	maxLen int64
+}
+
+func (p *redisProducer) SendMessage(ctx context.Context, key string, value []byte) (int32, int64, error) {
+	if len(key) == 0 || len(value) == 0 {
+		return -1, -1, errors.New("key or value == 0")
+	}
+
+	id, err := p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: p.maxLen > 0,
+		Values: []interface{}{"key", key, "value", value},
+	}).Result()
+	if err != nil {
+		return -1, -1, err
+	}
+
+	return 0, redisStreamOffset(id), nil
+}
+
+// Close leaves the shared client open.
+func (p *redisProducer) Close() error {
+	return nil
+}
+
+type redisConsumer struct {
+	client    redis.UniversalClient
+	c         *kafka.KafkaConsumerConf
+	name      string
+	claimIdle time.Duration
+	cb        map[string]kafka.MessageHandlerF
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+func (c *redisConsumer) RegisterHandlers(topic string, cb kafka.MessageHandlerF) {
+	c.cb[topic] = cb
+}
+
+func (c *redisConsumer) Start() {
+	for _, topic := range c.c.Topics {
+		c.wg.Add(1)
+		go func(topic string) {
+			defer c.wg.Done()
+			if err := c.consume(topic); err != nil {
+				logx.Errorf("redisStream(%s) - group %s stopped, error: %v", topic, c.c.Group, err)
+			}
+		}(topic)
+	}
+	c.wg.Wait()
+}
+
+func (c *redisConsumer) Stop() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+func (c *redisConsumer) consume(stream string) error {
+	err := c.client.XGroupCreateMkStream(c.ctx, stream, c.c.Group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+
+	var lastClaim time.Time
+	for c.ctx.Err() == nil {
+		if time.Since(lastClaim) >= c.claimIdle {
+			if err = c.claim(stream); err != nil && c.ctx.Err() == nil {
+				logx.Errorf("redisStream(%s) - claim error: %v", stream, err)
+			}
+			lastClaim = time.Now()
+		}
+
+		// ">" reads what no consumer of the group has read
+		streams, err := c.client.XReadGroup(c.ctx, &redis.XReadGroupArgs{
+			Group:    c.c.Group,
+			Consumer: c.name,
+			Streams:  []string{stream, ">"},
+			Count:    redisStreamCount,
+			Block:    redisStreamBlock,
+		}).Result()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			if c.ctx.Err() != nil {
+				return nil
+			}
+			logx.Errorf("redisStream(%s) - xreadgroup error: %v", stream, err)
+			select {
+			case <-time.After(redisStreamBlock):
+			case <-c.ctx.Done():
+			}
+			continue
+		}
+
+		for _, s := range streams {
+			c.process(stream, s.Messages)
+		}
+	}
+
+	return nil
+}
+
+// claim takes over the entries of the group pending for claimIdle,
+// they were read by a consumer that never acked them. XCLAIM checks the
+// idle time again, an entry is taken over by one consumer only.
+func (c *redisConsumer) claim(stream string) error {
+	for start := "-"; ; {
+		pList, err := c.client.XPendingExt(c.ctx, &redis.XPendingExtArgs{
+			Stream: stream,
+			Group:  c.c.Group,
+			Start:  start,
+			End:    "+",
+			Count:  redisStreamCount,
+		}).Result()
+		if err == redis.Nil {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		idList := make([]string, 0, len(pList))
+		for _, p := range pList {
+			if p.Idle >= c.claimIdle {
+				idList = append(idList, p.ID)
+			}
+		}
+		if len(idList) > 0 {
+			msgs, err := c.client.XClaim(c.ctx, &redis.XClaimArgs{
+				Stream:   stream,
+				Group:    c.c.Group,
+				Consumer: c.name,
+				MinIdle:  c.claimIdle,
+				Messages: idList,
+			}).Result()
+			if err != nil {
+				return err
+			}
+			if len(msgs) > 0 {
+				logx.Infof("redisStream(%s) - %s claimed %d entries", stream, c.name, len(msgs))
+				c.process(stream, msgs)
+			}
+		}
+
+		if len(pList) < redisStreamCount {
+			return nil
+		}
+		start = redisStreamNextId(pList[len(pList)-1].ID)
+	}
+}
+
+func (c *redisConsumer) process(stream string, msgs []redis.XMessage) {
+	for _, msg := range msgs {
+		c.handle(stream, msg)
+		if err := c.client.XAck(context.Background(), stream, c.c.Group, msg.ID).Err(); err != nil {
+			logx.Errorf("redisStream(%s) - xack(%s) error: %v", stream, msg.ID, err)
+		}
+	}
+}
+
+func (c *redisConsumer) handle(stream string, msg redis.XMessage) {
+	defer rescue.Recover()
+
+	if cb, ok := c.cb[stream]; ok {
+		key, value := redisStreamValues(msg)
+		cb(context.Background(), key, value)
+	}
+}
+
+type redisFetcher struct {
+	client redis.UniversalClient
+}
+
+func (f *redisFetcher) Fetch(ctx context.Context, topic string, limit int) ([]Record, error) {
+	var (
+		msgs []redis.XMessage
+		err  error
+	)
+	if limit > 0 {
+		msgs, err = f.client.XRangeN(ctx, topic, "-", "+", int64(limit)).Result()
+	} else {
+		msgs, err = f.client.XRange(ctx, topic, "-", "+").Result()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rList := make([]Record, 0, len(msgs))
+	for _, msg := range msgs {
+		key, value := redisStreamValues(msg)
+		rList = append(rList, Record{
+			Offset: redisStreamOffset(msg.ID),
+			Key:    key,
+			Value:  value,
+		})
+	}
+
+	return rList, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	zredis "github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func newTestRedisDriver(t *testing.T) (*redisDriver, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	return newRedisDriver(zredis.RedisConf{Host: mr.Addr(), Type: zredis.NodeType}, 0), mr
+}
+
+// startTestRedisConsumer starts a consumer of topic, the keys it handles go to the returned channel.
+func startTestRedisConsumer(t *testing.T, d *redisDriver, topic, group string) <-chan string {
+	c, err := d.NewConsumer(&kafka.KafkaConsumerConf{Topics: []string{topic}, Group: group})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handled := make(chan string, 64)
+	c.RegisterHandlers(topic, func(ctx context.Context, key string, value []byte) {
+		if key != string(value) {
+			t.Errorf("got value %q for key %q", value, key)
+		}
+		handled <- key
+	})
+
+	go c.Start()
+	t.Cleanup(c.Stop)
+
+	return handled
+}
+
+func sendTestRedisMessages(t *testing.T, d *redisDriver, topic string, keys ...string) {
+	p, err := d.NewProducer(&kafka.KafkaProducerConf{Topic: topic})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range keys {
+		if _, _, err = p.(*redisProducer).SendMessage(context.Background(), key, []byte(key)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func waitTestRedisHandled(t *testing.T, handled <-chan string, n int) []string {
+	var keys []string
+	for len(keys) < n {
+		select {
+		case key := <-handled:
+			keys = append(keys, key)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("handled %v, want %d messages", keys, n)
+		}
+	}
+
+	// nothing is handled twice
+	select {
+	case key := <-handled:
+		t.Fatalf("handled %v and then %q again", keys, key)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// waitTestRedisPending waits until the group has want entries pending.
+func waitTestRedisPending(t *testing.T, client redis.UniversalClient, stream, group string, want int64) {
+	var pending int64
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		r, err := client.XPending(context.Background(), stream, group).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pending = r.Count; pending == want {
+			return
+		}
+	}
+	t.Fatalf("%d entries pending, want %d", pending, want)
+}
+
+func TestRedisConsumerName(t *testing.T) {
+	name, err := redisConsumerName()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	host, _ := os.Hostname()
+	if name != host+"-"+strconv.Itoa(os.Getpid()) {
+		t.Errorf("got consumer name %q", name)
+	}
+}
+
+func TestRedisStreamNextId(t *testing.T) {
+	for id, want := range map[string]string{
+		"1700000000000-0":  "1700000000000-1",
+		"1700000000000-41": "1700000000000-42",
+	} {
+		if got := redisStreamNextId(id); got != want {
+			t.Errorf("redisStreamNextId(%s) = %s, want %s", id, got, want)
+		}
+	}
+}
+
+func TestRedisStreamConsume(t *testing.T) {
+	d, _ := newTestRedisDriver(t)
+
+	handled := startTestRedisConsumer(t, d, "inbox", "inbox-group")
+	sendTestRedisMessages(t, d, "inbox", "a", "b", "c")
+
+	if keys := waitTestRedisHandled(t, handled, 3); strings.Join(keys, ",") != "a,b,c" {
+		t.Errorf("handled %v", keys)
+	}
+	waitTestRedisPending(t, d.getClient(), "inbox", "inbox-group", 0)
+}
+
+func TestRedisStreamResume(t *testing.T) {
+	var (
+		d, mr  = newTestRedisDriver(t)
+		client = d.getClient()
+		ctx    = context.Background()
+		now    = time.Now()
+	)
+
+	mr.SetTime(now)
+	sendTestRedisMessages(t, d, "sync", "a", "b", "c", "d")
+
+	// a consumer that is gone read a and b, a live one read c, neither acked
+	if err := client.XGroupCreateMkStream(ctx, "sync", "sync-group", "0").Err(); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []struct {
+		consumer string
+		count    int64
+	}{
+		{consumer: "gone-1", count: 2},
+		{consumer: "live-1", count: 1},
+	} {
+		if err := client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    "sync-group",
+			Consumer: r.consumer,
+			Streams:  []string{"sync", ">"},
+			Count:    r.count,
+		}).Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mr.SetTime(now.Add(2 * redisStreamClaimIdle))
+	if err := client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   "sync",
+		Group:    "sync-group",
+		Consumer: "live-1",
+		Messages: []string{pendingTestRedisId(t, client, "live-1")},
+	}).Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	handled := startTestRedisConsumer(t, d, "sync", "sync-group")
+
+	// a and b are taken over, c is left to live-1 and d was never read
+	if keys := waitTestRedisHandled(t, handled, 3); strings.Join(keys, ",") != "a,b,d" {
+		t.Errorf("handled %v", keys)
+	}
+	waitTestRedisPending(t, client, "sync", "sync-group", 1)
+	if id := pendingTestRedisId(t, client, "live-1"); id == "" {
+		t.Errorf("c is no longer pending for live-1")
+	}
+}
+
+func pendingTestRedisId(t *testing.T, client redis.UniversalClient, consumer string) string {
+	pList, err := client.XPendingExt(context.Background(), &redis.XPendingExtArgs{
+		Stream:   "sync",
+		Group:    "sync-group",
+		Start:    "-",
+		End:      "+",
+		Count:    10,
+		Consumer: consumer,
+	}).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pList) != 1 {
+		t.Fatalf("%s has %d entries pending, want 1", consumer, len(pList))
+	}
+
+	return pList[0].ID
+}
@@ -0,0 +1,194 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mqx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
+)
+
+var (
+	metricConsumerRetries = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "mq",
+		Subsystem: "consumer",
+		Name:      "retries_total",
+		Help:      "messages handled again after their handler failed.",
+		Labels:    []string{"topic"},
+	})
+	metricConsumerDeadLetters = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "mq",
+		Subsystem: "consumer",
+		Name:      "dead_letters_total",
+		Help:      "messages given up on after their retries.",
+		Labels:    []string{"topic"},
+	})
+)
+
+// RetryConf bounds the retries of a consumer and names its dead-letter topic.
+type RetryConf struct {
+	MaxRetries int `json:",default=3"`
+	// Backoff is the wait (ms) before the first retry, it doubles on every retry
+	Backoff    int64                    `json:",default=200"`
+	MaxBackoff int64                    `json:",default=5000"`
+	DeadLetter *kafka.KafkaProducerConf `json:",optional"`
+}
+
+// Handler handles one message, a non-nil error has it retried.
+type Handler func(ctx context.Context, key string, value []byte) error
+
+// Producer sends messages to a topic of the bus,
+// *kafka.Producer implements it.
+type Producer interface {
+	SendMessage(ctx context.Context, key string, value []byte) (int32, int64, error)
+}
+
+// MustDeadLetterProducer returns the producer of c.DeadLetter,
+// nil if c has no dead-letter topic.
+func MustDeadLetterProducer(c RetryConf) Producer {
+	if c.DeadLetter == nil {
+		return nil
+	}
+	return MustProducer(c.DeadLetter)
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as one a retry cannot fix, the message goes
+// to the dead-letter topic right away.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked by Permanent or is a
+// 4xx rpc error, a request rejected once is rejected again.
+func IsPermanent(err error) bool {
+	var e *permanentError
+	if errors.As(err, &e) {
+		return true
+	}
+
+	var rpcErr interface{ Code() int }
+	if errors.As(err, &rpcErr) {
+		return rpcErr.Code() >= 400 && rpcErr.Code() < 500
+	}
+
+	return false
+}
+
+// DeadLetter is the value of a message in a dead-letter topic,
+// its key is the key of the original message.
+type DeadLetter struct {
+	Topic   string `json:"topic"`
+	Group   string `json:"group"`
+	Key     string `json:"key"`
+	Value   []byte `json:"value"`
+	Error   string `json:"error"`
+	Retries int    `json:"retries"`
+	Date    int64  `json:"date"`
+}
+
+// WithRetry turns h into a kafka.MessageHandlerF, h is retried up to
+// c.MaxRetries times unless its error IsPermanent. A message it still
+// fails on is sent to dlq, a nil dlq only logs the message.
+func WithRetry(c RetryConf, topic, group string, dlq Producer, h Handler) kafka.MessageHandlerF {
+	return func(ctx context.Context, key string, value []byte) {
+		var (
+			err     error
+			retries int
+			backoff = time.Duration(c.Backoff) * time.Millisecond
+		)
+
+		for {
+			if err = h(ctx, key, value); err == nil {
+				return
+			}
+			if IsPermanent(err) || retries >= c.MaxRetries {
+				break
+			}
+
+			retries++
+			metricConsumerRetries.Inc(topic)
+			logx.WithContext(ctx).Errorf("mqx.retry(%s, %s) - retry %d after %v, error: %v", topic, key, retries, backoff, err)
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+			}
+			if ctx.Err() != nil {
+				break
+			}
+
+			backoff *= 2
+			if max := time.Duration(c.MaxBackoff) * time.Millisecond; max > 0 && backoff > max {
+				backoff = max
+			}
+		}
+
+		metricConsumerDeadLetters.Inc(topic)
+		sendDeadLetter(ctx, dlq, &DeadLetter{
+			Topic:   topic,
+			Group:   group,
+			Key:     key,
+			Value:   value,
+			Error:   err.Error(),
+			Retries: retries,
+			Date:    time.Now().Unix(),
+		})
+	}
+}
+
+func sendDeadLetter(ctx context.Context, dlq Producer, dl *DeadLetter) {
+	if dlq == nil {
+		logx.WithContext(ctx).Errorf("mqx.deadLetter(%s, %s) - dropped after %d retries, error: %s", dl.Topic, dl.Key, dl.Retries, dl.Error)
+		return
+	}
+
+	b, err := jsonx.Marshal(dl)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("mqx.deadLetter(%s, %s) - marshal error: %v", dl.Topic, dl.Key, err)
+		return
+	}
+
+	if _, _, err = dlq.SendMessage(ctx, dl.Key, b); err != nil {
+		logx.WithContext(ctx).Errorf("mqx.deadLetter(%s, %s) - send error: %v, dropped: %s", dl.Topic, dl.Key, err, b)
+		return
+	}
+
+	logx.WithContext(ctx).Infof("mqx.deadLetter(%s, %s) - sent after %d retries, error: %s", dl.Topic, dl.Key, dl.Retries, dl.Error)
+}
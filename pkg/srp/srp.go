@@ -0,0 +1,191 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package srp implements the server side of the SRP 2048 exchange used by
+// passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow.
+//
+// The client never sends its password. It derives x from the password and the
+// two salts, uploads the verifier v = g^x mod p once, and afterwards proves the
+// knowledge of x through (A, M1) against the server's ephemeral B.
+//
+// https://core.telegram.org/api/srp
+package srp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"math/big"
+)
+
+const (
+	// PrimeSize is the size in bytes of p, and of every padded number.
+	PrimeSize = 256
+
+	// NewSalt1Size is the length of the salt1 prefix handed out in new_algo,
+	// the client appends 32 random bytes before using it.
+	NewSalt1Size = 8
+	// NewSalt2Size is the length of salt2 handed out in new_algo.
+	NewSalt2Size = 16
+)
+
+var (
+	// G is the generator of the group.
+	G = int32(3)
+
+	// P is a 2048-bit safe prime.
+	P = []byte{
+		0xc7, 0x1c, 0xae, 0xb9, 0xc6, 0xb1, 0xc9, 0x04, 0x8e, 0x6c, 0x52, 0x2f,
+		0x70, 0xf1, 0x3f, 0x73, 0x98, 0x0d, 0x40, 0x23, 0x8e, 0x3e, 0x21, 0xc1,
+		0x49, 0x34, 0xd0, 0x37, 0x56, 0x3d, 0x93, 0x0f, 0x48, 0x19, 0x8a, 0x0a,
+		0xa7, 0xc1, 0x40, 0x58, 0x22, 0x94, 0x93, 0xd2, 0x25, 0x30, 0xf4, 0xdb,
+		0xfa, 0x33, 0x6f, 0x6e, 0x0a, 0xc9, 0x25, 0x13, 0x95, 0x43, 0xae, 0xd4,
+		0x4c, 0xce, 0x7c, 0x37, 0x20, 0xfd, 0x51, 0xf6, 0x94, 0x58, 0x70, 0x5a,
+		0xc6, 0x8c, 0xd4, 0xfe, 0x6b, 0x6b, 0x13, 0xab, 0xdc, 0x97, 0x46, 0x51,
+		0x29, 0x69, 0x32, 0x84, 0x54, 0xf1, 0x8f, 0xaf, 0x8c, 0x59, 0x5f, 0x64,
+		0x24, 0x77, 0xfe, 0x96, 0xbb, 0x2a, 0x94, 0x1d, 0x5b, 0xcd, 0x1d, 0x4a,
+		0xc8, 0xcc, 0x49, 0x88, 0x07, 0x08, 0xfa, 0x9b, 0x37, 0x8e, 0x3c, 0x4f,
+		0x3a, 0x90, 0x60, 0xbe, 0xe6, 0x7c, 0xf9, 0xa4, 0xa4, 0xa6, 0x95, 0x81,
+		0x10, 0x51, 0x90, 0x7e, 0x16, 0x27, 0x53, 0xb5, 0x6b, 0x0f, 0x6b, 0x41,
+		0x0d, 0xba, 0x74, 0xd8, 0xa8, 0x4b, 0x2a, 0x14, 0xb3, 0x14, 0x4e, 0x0e,
+		0xf1, 0x28, 0x47, 0x54, 0xfd, 0x17, 0xed, 0x95, 0x0d, 0x59, 0x65, 0xb4,
+		0xb9, 0xdd, 0x46, 0x58, 0x2d, 0xb1, 0x17, 0x8d, 0x16, 0x9c, 0x6b, 0xc4,
+		0x65, 0xb0, 0xd6, 0xff, 0x9c, 0xa3, 0x92, 0x8f, 0xef, 0x5b, 0x9a, 0xe4,
+		0xe4, 0x18, 0xfc, 0x15, 0xe8, 0x3e, 0xbe, 0xa0, 0xf8, 0x7f, 0xa9, 0xff,
+		0x5e, 0xed, 0x70, 0x05, 0x0d, 0xed, 0x28, 0x49, 0xf4, 0x7b, 0xf9, 0x59,
+		0xd9, 0x56, 0x85, 0x0c, 0xe9, 0x29, 0x85, 0x1f, 0x0d, 0x81, 0x15, 0xf6,
+		0x35, 0xb1, 0x05, 0xee, 0x2e, 0x4e, 0x15, 0xd0, 0x4b, 0x24, 0x54, 0xbf,
+		0x6f, 0x4f, 0xad, 0xf0, 0x34, 0xb1, 0x04, 0x03, 0x11, 0x9c, 0xd8, 0xe3,
+		0xb9, 0x2f, 0xcc, 0x5b,
+	}
+)
+
+var (
+	bigP = new(big.Int).SetBytes(P)
+	bigG = big.NewInt(int64(G))
+
+	// k = H(p | g)
+	bigK = new(big.Int).SetBytes(H(P, pad(bigG)))
+)
+
+// H is the SHA256 of the concatenation of data.
+func H(data ...[]byte) []byte {
+	h := sha256.New()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+func pad(n *big.Int) []byte {
+	b := n.Bytes()
+	if len(b) >= PrimeSize {
+		return b
+	}
+	r := make([]byte, PrimeSize)
+	copy(r[PrimeSize-len(b):], b)
+	return r
+}
+
+// inRange reports whether 1 < n < p-1.
+func inRange(n *big.Int) bool {
+	if n.Cmp(big.NewInt(1)) <= 0 {
+		return false
+	}
+	return n.Cmp(new(big.Int).Sub(bigP, big.NewInt(1))) < 0
+}
+
+// CheckVerifier validates the new_password_hash sent by the client.
+func CheckVerifier(v []byte) bool {
+	if len(v) == 0 || len(v) > PrimeSize {
+		return false
+	}
+	return inRange(new(big.Int).SetBytes(v))
+}
+
+// CheckNewSalt1 validates the salt1 of a client new_algo: the server prefix
+// followed by 32 bytes of client randomness.
+func CheckNewSalt1(serverSalt1, clientSalt1 []byte) bool {
+	return len(clientSalt1) == len(serverSalt1)+32 && bytes.HasPrefix(clientSalt1, serverSalt1)
+}
+
+// CheckP reports whether the client algo uses the server group.
+func CheckP(g int32, p []byte) bool {
+	return g == G && bytes.Equal(p, P)
+}
+
+// NewServerEphemeral picks a random b and returns it with B = k*v + g^b mod p.
+func NewServerEphemeral(v []byte) (b, B []byte, err error) {
+	b = make([]byte, PrimeSize)
+	if _, err = rand.Read(b); err != nil {
+		return nil, nil, err
+	}
+
+	return b, ServerPublic(v, b), nil
+}
+
+// ServerPublic computes B = k*v + g^b mod p.
+func ServerPublic(v, b []byte) []byte {
+	var (
+		bigV  = new(big.Int).SetBytes(v)
+		bigB  = new(big.Int).SetBytes(b)
+		kv    = new(big.Int).Mul(bigK, bigV)
+		gb    = new(big.Int).Exp(bigG, bigB, bigP)
+		bigGB = new(big.Int).Add(kv, gb)
+	)
+
+	return pad(bigGB.Mod(bigGB, bigP))
+}
+
+// Verify checks the client proof M1 for A against the verifier v and the
+// server ephemeral b.
+func Verify(salt1, salt2, v, b, A, M1 []byte) bool {
+	if len(A) == 0 || len(A) > PrimeSize || len(M1) != sha256.Size {
+		return false
+	}
+
+	bigA := new(big.Int).SetBytes(A)
+	if !inRange(bigA) {
+		return false
+	}
+
+	var (
+		aPad = pad(bigA)
+		bPad = ServerPublic(v, b)
+		u    = new(big.Int).SetBytes(H(aPad, bPad))
+	)
+	if u.Sign() == 0 {
+		return false
+	}
+
+	// S = (A * v^u) ^ b mod p
+	S := new(big.Int).Exp(new(big.Int).SetBytes(v), u, bigP)
+	S.Mul(S, bigA)
+	S.Mod(S, bigP)
+	S.Exp(S, new(big.Int).SetBytes(b), bigP)
+	K := H(pad(S))
+
+	hp := H(P)
+	hg := H(pad(bigG))
+	for i := range hp {
+		hp[i] ^= hg[i]
+	}
+
+	return subtle.ConstantTimeCompare(M1, H(hp, H(salt1), H(salt2), aPad, bPad, K)) == 1
+}
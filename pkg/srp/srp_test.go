@@ -0,0 +1,110 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package srp
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"math/big"
+	"testing"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// client side of the exchange, as done by the official apps.
+
+func sh(data, salt []byte) []byte {
+	return H(salt, data, salt)
+}
+
+func passwordHash(password string, salt1, salt2 []byte) []byte {
+	ph1 := sh(sh([]byte(password), salt1), salt2)
+	return sh(pbkdf2.Key(ph1, salt1, 100000, 64, sha512.New), salt2)
+}
+
+func clientVerifier(password string, salt1, salt2 []byte) []byte {
+	x := new(big.Int).SetBytes(passwordHash(password, salt1, salt2))
+	return pad(new(big.Int).Exp(bigG, x, bigP))
+}
+
+func clientProof(password string, salt1, salt2, B []byte) (A, M1 []byte) {
+	a := make([]byte, PrimeSize)
+	rand.Read(a)
+
+	var (
+		bigA = new(big.Int).Exp(bigG, new(big.Int).SetBytes(a), bigP)
+		x    = new(big.Int).SetBytes(passwordHash(password, salt1, salt2))
+		v    = new(big.Int).Exp(bigG, x, bigP)
+		u    = new(big.Int).SetBytes(H(pad(bigA), B))
+	)
+
+	// S = (B - k*v) ^ (a + u*x) mod p
+	gb := new(big.Int).Sub(new(big.Int).SetBytes(B), new(big.Int).Mul(bigK, v))
+	gb.Mod(gb, bigP)
+	e := new(big.Int).Add(new(big.Int).SetBytes(a), new(big.Int).Mul(u, x))
+	S := new(big.Int).Exp(gb, e, bigP)
+	K := H(pad(S))
+
+	hp := H(P)
+	hg := H(pad(bigG))
+	for i := range hp {
+		hp[i] ^= hg[i]
+	}
+
+	A = pad(bigA)
+	M1 = H(hp, H(salt1), H(salt2), A, B, K)
+	return
+}
+
+func TestVerify(t *testing.T) {
+	salt1 := make([]byte, NewSalt1Size+32)
+	salt2 := make([]byte, NewSalt2Size)
+	rand.Read(salt1)
+	rand.Read(salt2)
+
+	v := clientVerifier("teamgram", salt1, salt2)
+	if !CheckVerifier(v) {
+		t.Fatal("CheckVerifier: want true")
+	}
+
+	b, B, err := NewServerEphemeral(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	A, M1 := clientProof("teamgram", salt1, salt2, B)
+	if !Verify(salt1, salt2, v, b, A, M1) {
+		t.Error("Verify: right password rejected")
+	}
+
+	A, M1 = clientProof("Teamgram", salt1, salt2, B)
+	if Verify(salt1, salt2, v, b, A, M1) {
+		t.Error("Verify: wrong password accepted")
+	}
+}
+
+func TestCheckNewSalt1(t *testing.T) {
+	prefix := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	if !CheckNewSalt1(prefix, append(append([]byte{}, prefix...), make([]byte, 32)...)) {
+		t.Error("CheckNewSalt1: want true")
+	}
+	if CheckNewSalt1(prefix, prefix) {
+		t.Error("CheckNewSalt1: want false")
+	}
+}
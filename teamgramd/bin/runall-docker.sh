@@ -43,3 +43,7 @@ sleep 1
 echo "run gateway ..."
 nohup ./gateway -f=../etc2/gateway.yaml >> ../logs/gateway.log  2>&1 &
 sleep 1
+
+echo "run botapi ..."
+nohup ./botapi -f=../etc2/botapi.yaml >> ../logs/botapi.log  2>&1 &
+sleep 1
@@ -43,3 +43,7 @@ sleep 1
 echo "run gateway ..."
 nohup ./gateway -f=../etc/gateway.yaml >> ../logs/gateway.log  2>&1 &
 sleep 1
+
+echo "run botapi ..."
+nohup ./botapi -f=../etc/botapi.yaml >> ../logs/botapi.log  2>&1 &
+sleep 1
@@ -30,6 +30,9 @@ cd ${TEAMGRAMAPP}/service/biz/dialog/internal/dal/dalgen
 cd ${TEAMGRAMAPP}/service/biz/username/internal/dal/dalgen
 ./dalgen_all.sh
 
+cd ${TEAMGRAMAPP}/service/biz/twofa/internal/dal/dalgen
+./dalgen_all.sh
+
 cd ${TEAMGRAMAPP}/service/authsession/internal/dal/dalgen
 ./dalgen_all.sh
 
@@ -0,0 +1,61 @@
+CREATE TABLE `channels` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `creator_user_id` bigint(20) NOT NULL,
+  `access_hash` bigint(20) NOT NULL,
+  `broadcast` tinyint(1) NOT NULL DEFAULT '0',
+  `megagroup` tinyint(1) NOT NULL DEFAULT '0',
+  `title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `about` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `photo_id` bigint(20) NOT NULL DEFAULT '0',
+  `participants_count` int(11) NOT NULL DEFAULT '0',
+  `top_message` int(11) NOT NULL DEFAULT '0',
+  `pts` int(11) NOT NULL DEFAULT '0',
+  `signatures` tinyint(1) NOT NULL DEFAULT '0',
+  `noforwards` tinyint(1) NOT NULL DEFAULT '0',
+  `deactivated` tinyint(1) NOT NULL DEFAULT '0',
+  `version` int(11) NOT NULL DEFAULT '0',
+  `date` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `creator_user_id` (`creator_user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `channel_participants` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `channel_id` bigint(20) NOT NULL,
+  `user_id` bigint(20) NOT NULL,
+  `participant_type` int(11) NOT NULL DEFAULT '0',
+  `inviter_user_id` bigint(20) NOT NULL DEFAULT '0',
+  `invited_at` bigint(20) NOT NULL DEFAULT '0',
+  `kicked_at` bigint(20) NOT NULL DEFAULT '0',
+  `left_at` bigint(20) NOT NULL DEFAULT '0',
+  `read_inbox_max_id` int(11) NOT NULL DEFAULT '0',
+  `state` int(11) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `channel_id_2` (`channel_id`,`user_id`),
+  KEY `user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `channel_messages` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `channel_id` bigint(20) NOT NULL,
+  `channel_message_id` int(11) NOT NULL,
+  `sender_user_id` bigint(20) NOT NULL,
+  `random_id` bigint(20) NOT NULL DEFAULT '0',
+  `pts` int(11) NOT NULL DEFAULT '0',
+  `message_filter_type` int(11) NOT NULL DEFAULT '0',
+  `message_data` json NOT NULL,
+  `message` varchar(6000) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `views` int(11) NOT NULL DEFAULT '0',
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `channel_id_2` (`channel_id`,`channel_message_id`),
+  KEY `sender_user_id` (`sender_user_id`,`random_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,12 @@
+CREATE TABLE `channel_pts_updates` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `channel_id` bigint(20) NOT NULL,
+  `pts` int(11) NOT NULL,
+  `pts_count` int(11) NOT NULL,
+  `update_type` tinyint(4) NOT NULL DEFAULT '0',
+  `update_data` json NOT NULL,
+  `date2` bigint(20) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `channel_id` (`channel_id`,`pts`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
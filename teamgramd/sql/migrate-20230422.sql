@@ -0,0 +1 @@
+ALTER TABLE `auth_key_infos` ADD `expires_at` BIGINT NOT NULL DEFAULT '0' AFTER `media_temp_auth_key_id`;
@@ -0,0 +1,35 @@
+CREATE TABLE `secret_chats` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `access_hash` bigint(20) NOT NULL,
+  `admin_id` bigint(20) NOT NULL,
+  `admin_auth_key_id` bigint(20) NOT NULL,
+  `participant_id` bigint(20) NOT NULL,
+  `participant_auth_key_id` bigint(20) NOT NULL DEFAULT '0',
+  `random_id` int(11) NOT NULL,
+  `g_a` varchar(512) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'base64',
+  `g_b` varchar(512) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'base64',
+  `key_fingerprint` bigint(20) NOT NULL DEFAULT '0',
+  `state` int(11) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `admin_id` (`admin_id`,`random_id`),
+  KEY `participant_id` (`participant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `secret_chat_queues` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `auth_key_id` bigint(20) NOT NULL,
+  `user_id` bigint(20) NOT NULL,
+  `chat_id` int(11) NOT NULL,
+  `qts` int(11) NOT NULL,
+  `random_id` bigint(20) NOT NULL,
+  `message_data` json NOT NULL,
+  `date2` bigint(20) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `auth_key_id` (`auth_key_id`,`qts`),
+  UNIQUE KEY `auth_key_id_random_id` (`auth_key_id`,`random_id`),
+  KEY `chat_id` (`chat_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
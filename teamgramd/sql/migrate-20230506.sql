@@ -0,0 +1,15 @@
+CREATE TABLE `message_edit_history` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL DEFAULT '0' COMMENT 'the sender box, 0 for channels',
+  `peer_type` int(11) NOT NULL,
+  `peer_id` bigint(20) NOT NULL,
+  `message_id` int(11) NOT NULL,
+  `editor_user_id` bigint(20) NOT NULL,
+  `prev_message_data` json NOT NULL,
+  `message_data` json NOT NULL,
+  `edit_date` bigint(20) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `message_id` (`user_id`,`peer_type`,`peer_id`,`message_id`),
+  KEY `peer_id` (`peer_type`,`peer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,14 @@
+CREATE TABLE `message_search_index` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL DEFAULT '0' COMMENT 'the owner box, 0 for channels',
+  `peer_type` int(11) NOT NULL,
+  `peer_id` bigint(20) NOT NULL,
+  `message_id` int(11) NOT NULL,
+  `from_id` bigint(20) NOT NULL DEFAULT '0',
+  `token` varchar(64) COLLATE utf8mb4_bin NOT NULL,
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `peer_token` (`user_id`,`peer_type`,`peer_id`,`token`,`message_id`),
+  KEY `user_token` (`user_id`,`token`,`message_id`),
+  KEY `message_id` (`user_id`,`message_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,15 @@
+CREATE TABLE `message_reactions` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `dialog_message_id` bigint(20) NOT NULL COMMENT 'shared by all boxes of a message',
+  `user_id` bigint(20) NOT NULL COMMENT 'the reactor',
+  `reaction` varchar(64) NOT NULL COMMENT 'emoticon or custom emoji document_id',
+  `big` tinyint(1) NOT NULL DEFAULT '0',
+  `chosen_order` int(11) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL,
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `dialog_message_id` (`dialog_message_id`,`user_id`,`reaction`),
+  KEY `dialog_message_id_2` (`dialog_message_id`,`date2`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,34 @@
+CREATE TABLE `polls` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'poll_id',
+  `creator_user_id` bigint(20) NOT NULL,
+  `question` varchar(512) NOT NULL,
+  `answers` text NOT NULL COMMENT 'json of Vector<PollAnswer>',
+  `closed` tinyint(1) NOT NULL DEFAULT '0',
+  `public_voters` tinyint(1) NOT NULL DEFAULT '0',
+  `multiple_choice` tinyint(1) NOT NULL DEFAULT '0',
+  `quiz` tinyint(1) NOT NULL DEFAULT '0',
+  `close_period` int(11) NOT NULL DEFAULT '0',
+  `close_date` bigint(20) NOT NULL DEFAULT '0',
+  `correct_answers` varchar(1024) NOT NULL DEFAULT '' COMMENT 'json of Vector<bytes>',
+  `solution` varchar(512) NOT NULL DEFAULT '',
+  `solution_entities` text NOT NULL COMMENT 'json of Vector<MessageEntity>',
+  `date2` bigint(20) NOT NULL,
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `poll_votes` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `poll_id` bigint(20) NOT NULL,
+  `vote_user_id` bigint(20) NOT NULL,
+  `answer_option` varchar(128) NOT NULL COMMENT 'PollAnswer.option',
+  `date2` bigint(20) NOT NULL,
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `poll_id` (`poll_id`,`vote_user_id`,`answer_option`),
+  KEY `poll_id_2` (`poll_id`,`date2`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,22 @@
+
+CREATE TABLE `scheduled_messages` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `user_message_box_id` int(11) NOT NULL COMMENT 'scheduled message id',
+  `peer_type` tinyint(4) NOT NULL,
+  `peer_id` bigint(20) NOT NULL,
+  `random_id` bigint(20) NOT NULL DEFAULT '0',
+  `no_webpage` tinyint(1) NOT NULL DEFAULT '0',
+  `background` tinyint(1) NOT NULL DEFAULT '0',
+  `message_data` text NOT NULL COMMENT 'json of Message',
+  `schedule_date` bigint(20) NOT NULL COMMENT '2147483646: send when online',
+  `state` tinyint(4) NOT NULL DEFAULT '0' COMMENT '0: pending, 1: sent',
+  `date2` bigint(20) NOT NULL,
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`,`user_message_box_id`),
+  KEY `user_id_2` (`user_id`,`peer_type`,`peer_id`),
+  KEY `state` (`state`,`schedule_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
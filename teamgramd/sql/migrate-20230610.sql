@@ -0,0 +1,77 @@
+CREATE TABLE `sticker_sets` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `sticker_set_id` bigint(20) NOT NULL,
+  `access_hash` bigint(20) NOT NULL,
+  `creator_id` bigint(20) NOT NULL,
+  `title` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `short_name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `set_type` tinyint(4) NOT NULL DEFAULT '0' COMMENT '0: stickers, 1: masks, 2: emojis',
+  `animated` tinyint(1) NOT NULL DEFAULT '0',
+  `videos` tinyint(1) NOT NULL DEFAULT '0',
+  `official` tinyint(1) NOT NULL DEFAULT '0',
+  `thumb_document_id` bigint(20) NOT NULL DEFAULT '0',
+  `thumb_version` int(11) NOT NULL DEFAULT '0',
+  `count` int(11) NOT NULL DEFAULT '0',
+  `hash` int(11) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL,
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `sticker_set_id` (`sticker_set_id`),
+  UNIQUE KEY `short_name` (`short_name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `sticker_set_documents` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `sticker_set_id` bigint(20) NOT NULL,
+  `document_id` bigint(20) NOT NULL,
+  `emoticon` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `position` int(11) NOT NULL DEFAULT '0',
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `sticker_set_id` (`sticker_set_id`,`document_id`),
+  KEY `document_id` (`document_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `user_installed_sticker_sets` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `sticker_set_id` bigint(20) NOT NULL,
+  `set_type` tinyint(4) NOT NULL DEFAULT '0' COMMENT '0: stickers, 1: masks, 2: emojis',
+  `order_num` int(11) NOT NULL DEFAULT '0',
+  `archived` tinyint(1) NOT NULL DEFAULT '0',
+  `installed_date` bigint(20) NOT NULL,
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`,`sticker_set_id`),
+  KEY `user_id_2` (`user_id`,`set_type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `user_recent_stickers` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `document_id` bigint(20) NOT NULL,
+  `date2` bigint(20) NOT NULL,
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`,`document_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `user_faved_stickers` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `document_id` bigint(20) NOT NULL,
+  `date2` bigint(20) NOT NULL,
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`,`document_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+ALTER TABLE `messages` ADD `ttl_expire_date` BIGINT AS (IF(`ttl_period` > 0, `date2` + `ttl_period`, 0)) STORED AFTER `ttl_period`;
+ALTER TABLE `messages` ADD KEY `ttl_expire_date` (`deleted`, `ttl_expire_date`);
@@ -0,0 +1,10 @@
+
+CREATE TABLE `idgen_seq_segments` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `seq_key` varchar(128) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `max_id` bigint(20) NOT NULL DEFAULT '0' COMMENT 'ids up to max_id may have been handed out',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `seq_key` (`seq_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;